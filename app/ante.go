@@ -66,6 +66,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		subspaceante.NewRateLimitDecorator(options.SubspacesKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCkeeper),
 	), nil
 }
@@ -213,6 +213,7 @@ var (
 		{Account: ibcfeetypes.ModuleName},
 		{Account: icatypes.ModuleName},
 		{Account: tokenfactorytypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+		{Account: profilestypes.ModuleName},
 	}
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -80,6 +80,7 @@ var (
 		icatypes.ModuleName,
 		wasmtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
+		profilestypes.ModuleName,
 
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
@@ -1,12 +1,25 @@
 package single
 
 import (
+	stded25519 "crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/go-bip39"
+	"github.com/mr-tron/base58"
 
 	"github.com/desmos-labs/desmos/v7/app/desmos/cmd/chainlink/getter"
 	"github.com/desmos-labs/desmos/v7/app/desmos/cmd/chainlink/types"
@@ -39,9 +52,21 @@ func (b *AccountChainLinkJSONBuilder) BuildChainLinkJSON(cdc codec.Codec, chain
 		return utils.ChainLinkJSON{}, err
 	}
 
+	switch chain.Name {
+	case types.BitcoinChainName:
+		return b.buildBitcoinChainLinkJSON(mnemonic, chain)
+	case types.SolanaChainName:
+		return b.buildSolanaChainLinkJSON(mnemonic, chain)
+	default:
+		return b.buildCosmosChainLinkJSON(cdc, mnemonic, chain)
+	}
+}
+
+// buildCosmosChainLinkJSON builds the ChainLinkJSON for a Cosmos account generated from the given mnemonic
+func (b *AccountChainLinkJSONBuilder) buildCosmosChainLinkJSON(cdc codec.Codec, mnemonic string, chain types.Chain) (utils.ChainLinkJSON, error) {
 	// Create an in-memory keybase for signing
 	keyBase := keyring.NewInMemory(cdc)
-	_, err = keyBase.NewAccount(KeyName, mnemonic, "", chain.DerivationPath, hd.Secp256k1)
+	_, err := keyBase.NewAccount(KeyName, mnemonic, "", chain.DerivationPath, hd.Secp256k1)
 	if err != nil {
 		return utils.ChainLinkJSON{}, err
 	}
@@ -66,3 +91,112 @@ func (b *AccountChainLinkJSONBuilder) BuildChainLinkJSON(cdc codec.Codec, chain
 		profilestypes.NewChainConfig(chain.Name),
 	), nil
 }
+
+// buildBitcoinChainLinkJSON builds the ChainLinkJSON for a Bitcoin account generated from the given mnemonic.
+// If the chain has a prefix, a P2WPKH address is linked. Otherwise, a P2PKH address is used
+func (b *AccountChainLinkJSONBuilder) buildBitcoinChainLinkJSON(mnemonic string, chain types.Chain) (utils.ChainLinkJSON, error) {
+	derivedPrivKey, err := hd.Secp256k1.Derive()(mnemonic, "", chain.DerivationPath)
+	if err != nil {
+		return utils.ChainLinkJSON{}, err
+	}
+	privKey := hd.Secp256k1.Generate()(derivedPrivKey)
+	pubKey := privKey.PubKey()
+
+	// Get the address and the signature header offset based on the address type
+	address := profilestypes.NewBitcoinP2PKHAddress(pubKey)
+	headerOffset := byte(0)
+	if chain.Prefix != "" {
+		address = profilestypes.NewBitcoinP2WPKHAddress(pubKey)
+		headerOffset = 8
+	}
+
+	// Sign the value following the BIP-137 specification
+	value := profilestypes.GetBitcoinSignedMessageValue(b.owner)
+	hash := sha256.Sum256(value)
+	hash = sha256.Sum256(hash[:])
+
+	btcPrivKey, _ := btcec.PrivKeyFromBytes(privKey.Bytes())
+	sig, err := ecdsa.SignCompact(btcPrivKey, hash[:], true)
+	if err != nil {
+		return utils.ChainLinkJSON{}, err
+	}
+	sig[0] += headerOffset
+
+	return utils.NewChainLinkJSON(
+		address,
+		profilestypes.NewProof(
+			pubKey,
+			profilestypes.NewSingleSignature(profilestypes.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, sig),
+			hex.EncodeToString(value),
+		),
+		profilestypes.NewChainConfig(chain.Name),
+	), nil
+}
+
+// buildSolanaChainLinkJSON builds the ChainLinkJSON for a Solana account generated from the given mnemonic
+func (b *AccountChainLinkJSONBuilder) buildSolanaChainLinkJSON(mnemonic string, chain types.Chain) (utils.ChainLinkJSON, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return utils.ChainLinkJSON{}, err
+	}
+
+	derivedPrivKey, err := deriveEd25519PrivKey(seed, chain.DerivationPath)
+	if err != nil {
+		return utils.ChainLinkJSON{}, err
+	}
+	privKey := &ed25519.PrivKey{Key: stded25519.NewKeyFromSeed(derivedPrivKey)}
+	pubKey := privKey.PubKey()
+
+	// Sign the value following the Solana off-chain message specification
+	value := profilestypes.GetSolanaOffchainMessageValue(b.owner)
+	sig, err := privKey.Sign(value)
+	if err != nil {
+		return utils.ChainLinkJSON{}, err
+	}
+
+	return utils.NewChainLinkJSON(
+		profilestypes.NewBase58Address(base58.Encode(pubKey.Bytes())),
+		profilestypes.NewProof(
+			pubKey,
+			profilestypes.NewSingleSignature(profilestypes.SIGNATURE_VALUE_TYPE_SOLANA_OFFCHAIN_MESSAGE, sig),
+			hex.EncodeToString(value),
+		),
+		profilestypes.NewChainConfig(chain.Name),
+	), nil
+}
+
+// deriveEd25519PrivKey derives the ed25519 private key seed from the given seed and derivation path
+// following the SLIP-10 specification, which only supports hardened derivation paths
+func deriveEd25519PrivKey(seed []byte, path string) ([]byte, error) {
+	segments := strings.Split(path, "/")
+	if segments[0] != "m" {
+		return nil, fmt.Errorf("invalid derivation path: %s", path)
+	}
+
+	mac := hmac.New(sha512.New, []byte("ed25519 seed"))
+	mac.Write(seed)
+	digest := mac.Sum(nil)
+	key, chainCode := digest[:32], digest[32:]
+
+	for _, segment := range segments[1:] {
+		if !strings.HasSuffix(segment, "'") {
+			return nil, fmt.Errorf("invalid derivation path %s: only hardened indexes are supported", path)
+		}
+
+		index, err := strconv.ParseUint(strings.TrimSuffix(segment, "'"), 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation path %s: %s", path, err)
+		}
+
+		data := make([]byte, 37)
+		copy(data[1:33], key)
+		binary.BigEndian.PutUint32(data[33:], uint32(index)+0x80000000)
+
+		mac = hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		digest = mac.Sum(nil)
+		key, chainCode = digest[:32], digest[32:]
+	}
+
+	return key, nil
+}
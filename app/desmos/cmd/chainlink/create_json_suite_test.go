@@ -71,13 +71,15 @@ type MockGetter struct {
 	FileName string
 	IsSingle bool
 	TxFile   string
+	Chain    types.Chain
 }
 
-func NewMockGetter(fileName string, isSingle bool, txFile string) MockGetter {
+func NewMockGetter(fileName string, isSingle bool, txFile string, chain types.Chain) MockGetter {
 	return MockGetter{
 		FileName: fileName,
 		IsSingle: isSingle,
 		TxFile:   txFile,
+		Chain:    chain,
 	}
 }
 
@@ -88,7 +90,7 @@ func (mock MockGetter) IsSingleSignatureAccount() (bool, error) {
 
 // GetChain implements ChainLinkReferenceGetter
 func (mock MockGetter) GetChain() (types.Chain, error) {
-	return types.NewChain("Cosmos", "cosmos", "cosmos", "m/44'/118'/0'/0/0"), nil
+	return mock.Chain, nil
 }
 
 // GetFilename implements ChainLinkReferenceGetter
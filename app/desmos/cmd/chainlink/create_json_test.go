@@ -8,12 +8,14 @@ import (
 	"io/ioutil"
 
 	"github.com/desmos-labs/desmos/v7/app/desmos/cmd/chainlink/builder"
+	"github.com/desmos-labs/desmos/v7/app/desmos/cmd/chainlink/types"
 
 	cmd "github.com/desmos-labs/desmos/v7/app/desmos/cmd/chainlink"
 	"github.com/desmos-labs/desmos/v7/testutil/profilestesting"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 
 	multibuilder "github.com/desmos-labs/desmos/v7/app/desmos/cmd/chainlink/builder/multi"
@@ -35,7 +37,7 @@ func BuildMockChainLinkJSONBuilderProvider(getter MockGetter) builder.ChainLinkJ
 
 func (suite *CreateJSONChainLinkTestSuite) TestSingleSignatureAccount() {
 	fileName := suite.TempFile()
-	getter := NewMockGetter(fileName, true, "")
+	getter := NewMockGetter(fileName, true, "", types.NewChain("Cosmos", "cosmos", "cosmos", "m/44'/118'/0'/0/0"))
 	_, err := clitestutil.ExecTestCLICmd(
 		suite.ClientCtx,
 		cmd.GetCreateChainLinkJSON(getter, BuildMockChainLinkJSONBuilderProvider(getter)),
@@ -87,6 +89,85 @@ func (suite *CreateJSONChainLinkTestSuite) TestSingleSignatureAccount() {
 	)
 }
 
+func (suite *CreateJSONChainLinkTestSuite) TestBitcoinAccount() {
+	testCases := []struct {
+		name       string
+		chain      types.Chain
+		getAddress func(pubKey cryptotypes.PubKey) profilestypes.AddressData
+	}{
+		{
+			name:  "P2WPKH address is linked properly",
+			chain: types.NewChain("Bitcoin (SegWit)", types.BitcoinChainName, "bc", "m/84'/0'/0'/0/0"),
+			getAddress: func(pubKey cryptotypes.PubKey) profilestypes.AddressData {
+				return profilestypes.NewBitcoinP2WPKHAddress(pubKey)
+			},
+		},
+		{
+			name:  "P2PKH address is linked properly",
+			chain: types.NewChain("Bitcoin (Legacy)", types.BitcoinChainName, "", "m/44'/0'/0'/0/0"),
+			getAddress: func(pubKey cryptotypes.PubKey) profilestypes.AddressData {
+				return profilestypes.NewBitcoinP2PKHAddress(pubKey)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			fileName := suite.TempFile()
+			getter := NewMockGetter(fileName, true, "", tc.chain)
+			_, err := clitestutil.ExecTestCLICmd(
+				suite.ClientCtx,
+				cmd.GetCreateChainLinkJSON(getter, BuildMockChainLinkJSONBuilderProvider(getter)),
+				[]string{},
+			)
+			suite.Require().NoError(err)
+
+			out, err := ioutil.ReadFile(fileName)
+			suite.Require().NoError(err)
+
+			var data profilescliutils.ChainLinkJSON
+			err = suite.Codec.UnmarshalJSON(out, &data)
+			suite.Require().NoError(err)
+
+			// Derive the expected key
+			mnemonic, err := getter.GetMnemonic()
+			suite.Require().NoError(err)
+			derivedPrivKey, err := hd.Secp256k1.Derive()(mnemonic, "", tc.chain.DerivationPath)
+			suite.Require().NoError(err)
+			pubKey := hd.Secp256k1.Generate()(derivedPrivKey).PubKey()
+
+			address := tc.getAddress(pubKey)
+			suite.Require().Equal(address, data.Address.GetCachedValue())
+			suite.Require().Equal(profilestypes.NewChainConfig("bitcoin"), data.ChainConfig)
+			suite.Require().NoError(data.Proof.Verify(suite.Codec, suite.LegacyAmino, suite.Owner, address))
+		})
+	}
+}
+
+func (suite *CreateJSONChainLinkTestSuite) TestSolanaAccount() {
+	fileName := suite.TempFile()
+	getter := NewMockGetter(fileName, true, "", types.NewChain("Solana", types.SolanaChainName, "", "m/44'/501'/0'/0'"))
+	_, err := clitestutil.ExecTestCLICmd(
+		suite.ClientCtx,
+		cmd.GetCreateChainLinkJSON(getter, BuildMockChainLinkJSONBuilderProvider(getter)),
+		[]string{},
+	)
+	suite.Require().NoError(err)
+
+	out, err := ioutil.ReadFile(fileName)
+	suite.Require().NoError(err)
+
+	var data profilescliutils.ChainLinkJSON
+	err = suite.Codec.UnmarshalJSON(out, &data)
+	suite.Require().NoError(err)
+
+	address := profilestypes.NewBase58Address("7u12i68B69vkKrACycBqXjD8NaqDReGBuH2EXFkhNoZp")
+	suite.Require().Equal(address, data.Address.GetCachedValue())
+	suite.Require().Equal(profilestypes.NewChainConfig("solana"), data.ChainConfig)
+	suite.Require().NoError(data.Proof.Verify(suite.Codec, suite.LegacyAmino, suite.Owner, address))
+}
+
 func (suite *CreateJSONChainLinkTestSuite) TestMultiSignatureAccount() {
 	fileName := suite.TempFile()
 	txFileData := `{
@@ -165,7 +246,7 @@ func (suite *CreateJSONChainLinkTestSuite) TestMultiSignatureAccount() {
 	err := ioutil.WriteFile(txFile, []byte(txFileData), 0600)
 	suite.Require().NoError(err)
 
-	getter := NewMockGetter(fileName, false, txFile)
+	getter := NewMockGetter(fileName, false, txFile, types.NewChain("Cosmos", "cosmos", "cosmos", "m/44'/118'/0'/0/0"))
 	_, err = clitestutil.ExecTestCLICmd(
 		suite.ClientCtx,
 		cmd.GetCreateChainLinkJSON(getter, BuildMockChainLinkJSONBuilderProvider(getter)),
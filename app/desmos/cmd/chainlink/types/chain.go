@@ -1,5 +1,13 @@
 package types
 
+const (
+	// BitcoinChainName represents the name of the Bitcoin chain
+	BitcoinChainName = "bitcoin"
+
+	// SolanaChainName represents the name of the Solana chain
+	SolanaChainName = "solana"
+)
+
 // Chain contains the data of a single chain
 type Chain struct {
 	ID             string
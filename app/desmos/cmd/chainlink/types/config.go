@@ -13,6 +13,9 @@ func DefaultConfig() Config {
 			NewChain("Cosmos", "cosmos", "cosmos", "m/44'/118'/0'/0/0"),
 			NewChain("Akash", "akash", "akash", "m/44'/118'/0'/0/0"),
 			NewChain("Osmosis", "osmosis", "osmo", "m/44'/118'/0'/0/0"),
+			NewChain("Bitcoin (SegWit)", BitcoinChainName, "bc", "m/84'/0'/0'/0/0"),
+			NewChain("Bitcoin (Legacy)", BitcoinChainName, "", "m/44'/0'/0'/0/0"),
+			NewChain("Solana", SolanaChainName, "", "m/44'/501'/0'/0'"),
 			NewChain("Other", "", "", ""),
 		},
 	}
@@ -11,6 +11,12 @@ const (
 	DefaultWeightMsgLinkChainAccount          int = 75
 	DefaultWeightMsgUnlinkChainAccount        int = 25
 	DefaultWeightMsgSetDefaultExternalAddress int = 15
+	DefaultWeightMsgListDTag                  int = 30
+	DefaultWeightMsgCancelDTagListing         int = 10
+	DefaultWeightMsgBuyDTag                   int = 20
+	DefaultWeightMsgPlaceDTagBid              int = 30
+	DefaultWeightMsgCancelDTagBid             int = 10
+	DefaultWeightMsgAcceptDTagBid             int = 20
 
 	DefaultWeightMsgCreateRelationship int = 80
 	DefaultWeightMsgDeleteRelationship int = 30
@@ -23,6 +29,7 @@ const (
 	DefaultWeightMsgCreateSection               int = 20
 	DefaultWeightMsgEditSection                 int = 12
 	DefaultWeightMsgMoveSection                 int = 10
+	DefaultWeightMsgSetSectionVisibility        int = 10
 	DefaultWeightMsgDeleteSection               int = 5
 	DefaultWeightMsgCreateUserGroup             int = 10
 	DefaultWeightMsgEditUserGroup               int = 30
@@ -37,11 +44,40 @@ const (
 	DefaultWeightMsgGrantAllowance              int = 20
 	DefaultWeightMsgRevokeAllowance             int = 5
 
+	DefaultWeightMsgArchiveSubspace   int = 3
+	DefaultWeightMsgUnarchiveSubspace int = 5
+
+	DefaultWeightMsgRequestSubspaceOwnerTransfer int = 10
+	DefaultWeightMsgCancelSubspaceOwnerTransfer  int = 5
+	DefaultWeightMsgAcceptSubspaceOwnerTransfer  int = 8
+	DefaultWeightMsgRefuseSubspaceOwnerTransfer  int = 5
+
+	DefaultWeightMsgCreateInvite            int = 15
+	DefaultWeightMsgDeleteInvite            int = 5
+	DefaultWeightMsgApplyToGroup            int = 20
+	DefaultWeightMsgApproveGroupApplication int = 10
+	DefaultWeightMsgRejectGroupApplication  int = 5
+
+	DefaultWeightMsgBanUser   int = 10
+	DefaultWeightMsgUnbanUser int = 5
+
+	DefaultWeightMsgSetRateLimit    int = 5
+	DefaultWeightMsgRemoveRateLimit int = 5
+
+	DefaultWeightMsgSetFeeTokensConfig    int = 5
+	DefaultWeightMsgRemoveFeeTokensConfig int = 5
+
+	DefaultWeightMsgSetTreasuryVotingConfig int = 5
+	DefaultWeightMsgSubmitTreasuryProposal  int = 10
+	DefaultWeightMsgVoteTreasuryProposal    int = 20
+
 	DefaultWeightMsgCreateReport          int = 50
 	DefaultWeightMsgDeleteReport          int = 35
+	DefaultWeightMsgResolveReport         int = 25
 	DefaultWeightMsgSupportStandardReason int = 20
 	DefaultWeightMsgAddReason             int = 10
 	DefaultWeightMsgRemoveReason          int = 10
+	DefaultWeightMsgSetHidingThreshold    int = 10
 
 	DefaultWeightMsgAddReaction              int = 40
 	DefaultWeightMsgRemoveReaction           int = 30
@@ -113,16 +113,17 @@ func GetStargateAcceptedQueries() wasmkeeper.AcceptedStargateQueries {
 		"/desmos.relationships.v1.Query/Blocks":        &relationshipstypes.QueryBlocksResponse{},
 
 		// Register x/subspaces queries
-		"/desmos.subspaces.v3.Query/Subspaces":        &subspacestypes.QuerySubspacesResponse{},
-		"/desmos.subspaces.v3.Query/Subspace":         &subspacestypes.QuerySubspaceResponse{},
-		"/desmos.subspaces.v3.Query/Sections":         &subspacestypes.QuerySectionsResponse{},
-		"/desmos.subspaces.v3.Query/Section":          &subspacestypes.QuerySectionResponse{},
-		"/desmos.subspaces.v3.Query/UserGroups":       &subspacestypes.QueryUserGroupsResponse{},
-		"/desmos.subspaces.v3.Query/UserGroup":        &subspacestypes.QueryUserGroupResponse{},
-		"/desmos.subspaces.v3.Query/UserGroupMembers": &subspacestypes.QueryUserGroupMembersResponse{},
-		"/desmos.subspaces.v3.Query/UserPermissions":  &subspacestypes.QueryUserPermissionsResponse{},
-		"/desmos.subspaces.v3.Query/UserAllowances":   &subspacestypes.QueryUserAllowancesResponse{},
-		"/desmos.subspaces.v3.Query/GroupAllowances":  &subspacestypes.QueryGroupAllowancesResponse{},
+		"/desmos.subspaces.v3.Query/Subspaces":                             &subspacestypes.QuerySubspacesResponse{},
+		"/desmos.subspaces.v3.Query/Subspace":                              &subspacestypes.QuerySubspaceResponse{},
+		"/desmos.subspaces.v3.Query/Sections":                              &subspacestypes.QuerySectionsResponse{},
+		"/desmos.subspaces.v3.Query/Section":                               &subspacestypes.QuerySectionResponse{},
+		"/desmos.subspaces.v3.Query/UserGroups":                            &subspacestypes.QueryUserGroupsResponse{},
+		"/desmos.subspaces.v3.Query/UserGroup":                             &subspacestypes.QueryUserGroupResponse{},
+		"/desmos.subspaces.v3.Query/UserGroupMembers":                      &subspacestypes.QueryUserGroupMembersResponse{},
+		"/desmos.subspaces.v3.Query/UserPermissions":                       &subspacestypes.QueryUserPermissionsResponse{},
+		"/desmos.subspaces.v3.Query/UserAllowances":                        &subspacestypes.QueryUserAllowancesResponse{},
+		"/desmos.subspaces.v3.Query/GroupAllowances":                       &subspacestypes.QueryGroupAllowancesResponse{},
+		"/desmos.subspaces.v3.Query/IncomingSubspaceOwnerTransferRequests": &subspacestypes.QueryIncomingSubspaceOwnerTransferRequestsResponse{},
 
 		// Register x/posts queries
 		"/desmos.posts.v3.Query/SubspacePosts":                     &poststypes.QuerySubspacePostsResponse{},
@@ -11,8 +11,51 @@ import (
 	"github.com/desmos-labs/desmos/v7/x/posts/types"
 )
 
-// EndBlocker called every block, process ended polls
+// EndBlocker called every block, process ended polls, publishes scheduled posts and deletes expired ones
 func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
+	// Iterate over all the pending posts that should be published by the current block time
+	keeper.IteratePendingPostsQueue(ctx, ctx.BlockTime(), func(post types.Post) (stop bool) {
+		// Leave the posts of archived subspaces inside the queue so that they are published once unarchived
+		if keeper.IsSubspaceArchived(ctx, post.SubspaceID) {
+			return false
+		}
+
+		keeper.PublishPost(ctx, post)
+
+		// Emit an event
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePublishedPost,
+				sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, fmt.Sprintf("%d", post.SubspaceID)),
+				sdk.NewAttribute(subspacestypes.AttributeKeySectionID, fmt.Sprintf("%d", post.SectionID)),
+				sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", post.ID)),
+			),
+		)
+
+		return false
+	})
+
+	// Iterate over all the posts that have expired by the current block time
+	keeper.IterateExpiredPostsQueue(ctx, ctx.BlockTime(), func(post types.Post) (stop bool) {
+		// Leave the posts of archived subspaces inside the queue so that they are deleted once unarchived
+		if keeper.IsSubspaceArchived(ctx, post.SubspaceID) {
+			return false
+		}
+
+		keeper.DeletePost(ctx, post.SubspaceID, post.ID)
+
+		// Emit an event
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpiredPost,
+				sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, fmt.Sprintf("%d", post.SubspaceID)),
+				sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", post.ID)),
+			),
+		)
+
+		return false
+	})
+
 	// Iterate over all the active polls that have been ended by the current block time
 	keeper.IterateActivePollsQueue(ctx, ctx.BlockTime(), func(poll types.Attachment) (stop bool) {
 		keeper.EndPoll(ctx, poll)
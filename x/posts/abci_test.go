@@ -11,11 +11,13 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/desmos-labs/desmos/v7/app"
 	"github.com/desmos-labs/desmos/v7/x/posts"
 	postskeeper "github.com/desmos-labs/desmos/v7/x/posts/keeper"
+	"github.com/desmos-labs/desmos/v7/x/posts/testutil"
 	"github.com/desmos-labs/desmos/v7/x/posts/types"
 	poststypes "github.com/desmos-labs/desmos/v7/x/posts/types"
 )
@@ -39,10 +41,15 @@ func TestEndBlocker(t *testing.T) {
 	ctx := sdk.NewContext(ms, tmproto.Header{ChainID: "test-chain"}, false, log.NewNopLogger())
 	cdc, _ := app.MakeCodecs()
 
-	keeper := postskeeper.NewKeeper(cdc, keys[poststypes.StoreKey], nil, nil, nil, "authority")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sk := testutil.NewMockSubspacesKeeper(ctrl)
+	keeper := postskeeper.NewKeeper(cdc, keys[poststypes.StoreKey], nil, sk, nil, nil, "authority")
 
 	testCases := []struct {
 		name     string
+		setup    func()
 		setupCtx func(ctx sdk.Context) sdk.Context
 		store    func(ctx sdk.Context)
 		check    func(ctx sdk.Context)
@@ -66,6 +73,9 @@ func TestEndBlocker(t *testing.T) {
 
 					true,
 					false,
+					poststypes.POLL_MODE_PLURALITY,
+					0,
+					nil,
 					nil,
 				))
 				keeper.SaveAttachment(ctx, attachment)
@@ -99,6 +109,9 @@ func TestEndBlocker(t *testing.T) {
 
 					true,
 					false,
+					poststypes.POLL_MODE_PLURALITY,
+					0,
+					nil,
 					nil,
 				))
 				keeper.SaveAttachment(ctx, attachment)
@@ -114,19 +127,225 @@ func TestEndBlocker(t *testing.T) {
 					poststypes.NewAnswerResult(0, 1),
 					poststypes.NewAnswerResult(1, 2),
 					poststypes.NewAnswerResult(2, 0),
-				}), poll.FinalTallyResults)
+				}, nil), poll.FinalTallyResults)
 
 				kvStore := ctx.KVStore(keys[poststypes.StoreKey])
 				endTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
 				require.False(t, kvStore.Has(poststypes.ActivePollQueueKey(1, 1, 1, endTime)))
 			},
 		},
+		{
+			name: "pending post is not published before time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				keeper.SetPostPending(ctx, 1, 1, time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC))
+				keeper.SavePost(ctx, poststypes.NewPost(
+					1,
+					0,
+					1,
+					"External id",
+					"Text",
+					"cosmos19mkklc8arp6phlg5eydu3v49syyqyfrq2sp4at",
+					0,
+					nil,
+					nil,
+					nil,
+					poststypes.REPLY_SETTING_EVERYONE,
+					time.Date(2020, 1, 1, 11, 00, 00, 000, time.UTC),
+					nil,
+					"cosmos1eqpa6mv2jgevukaqtjmx5535vhc3mm3cf458zg",
+					nil,
+				))
+			},
+			check: func(ctx sdk.Context) {
+				require.True(t, keeper.IsPostPending(ctx, 1, 1))
+
+				kvStore := ctx.KVStore(keys[poststypes.StoreKey])
+				require.False(t, kvStore.Has(poststypes.PostSectionStoreKey(1, 0, 1)))
+			},
+		},
+		{
+			name: "pending post is published after time",
+			setup: func() {
+				sk.EXPECT().IsSubspaceArchived(gomock.Any(), uint64(1)).Return(false)
+			},
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				keeper.SetPostPending(ctx, 1, 1, time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+				keeper.SavePost(ctx, poststypes.NewPost(
+					1,
+					0,
+					1,
+					"External id",
+					"Text",
+					"cosmos19mkklc8arp6phlg5eydu3v49syyqyfrq2sp4at",
+					0,
+					nil,
+					nil,
+					nil,
+					poststypes.REPLY_SETTING_EVERYONE,
+					time.Date(2020, 1, 1, 11, 00, 00, 000, time.UTC),
+					nil,
+					"cosmos1eqpa6mv2jgevukaqtjmx5535vhc3mm3cf458zg",
+					nil,
+				))
+			},
+			check: func(ctx sdk.Context) {
+				require.False(t, keeper.IsPostPending(ctx, 1, 1))
+
+				kvStore := ctx.KVStore(keys[poststypes.StoreKey])
+				require.True(t, kvStore.Has(poststypes.PostSectionStoreKey(1, 0, 1)))
+				require.False(t, kvStore.Has(poststypes.PendingPostQueueKey(1, 1, time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))))
+			},
+		},
+		{
+			name: "pending post of archived subspace is not published",
+			setup: func() {
+				sk.EXPECT().IsSubspaceArchived(gomock.Any(), uint64(1)).Return(true)
+			},
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				keeper.SetPostPending(ctx, 1, 1, time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+				keeper.SavePost(ctx, poststypes.NewPost(
+					1,
+					0,
+					1,
+					"External id",
+					"Text",
+					"cosmos19mkklc8arp6phlg5eydu3v49syyqyfrq2sp4at",
+					0,
+					nil,
+					nil,
+					nil,
+					poststypes.REPLY_SETTING_EVERYONE,
+					time.Date(2020, 1, 1, 11, 00, 00, 000, time.UTC),
+					nil,
+					"cosmos1eqpa6mv2jgevukaqtjmx5535vhc3mm3cf458zg",
+					nil,
+				))
+			},
+			check: func(ctx sdk.Context) {
+				require.True(t, keeper.IsPostPending(ctx, 1, 1))
+
+				kvStore := ctx.KVStore(keys[poststypes.StoreKey])
+				require.False(t, kvStore.Has(poststypes.PostSectionStoreKey(1, 0, 1)))
+				require.True(t, kvStore.Has(poststypes.PendingPostQueueKey(1, 1, time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))))
+			},
+		},
+		{
+			name: "expiring post is not deleted before time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				expirationTime := time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC)
+				keeper.SavePost(ctx, poststypes.NewPost(
+					1,
+					0,
+					1,
+					"External id",
+					"Text",
+					"cosmos19mkklc8arp6phlg5eydu3v49syyqyfrq2sp4at",
+					0,
+					nil,
+					nil,
+					nil,
+					poststypes.REPLY_SETTING_EVERYONE,
+					time.Date(2020, 1, 1, 11, 00, 00, 000, time.UTC),
+					nil,
+					"cosmos1eqpa6mv2jgevukaqtjmx5535vhc3mm3cf458zg",
+					&expirationTime,
+				))
+			},
+			check: func(ctx sdk.Context) {
+				require.True(t, keeper.HasPost(ctx, 1, 1))
+			},
+		},
+		{
+			name: "expired post is deleted after time",
+			setup: func() {
+				sk.EXPECT().IsSubspaceArchived(gomock.Any(), uint64(1)).Return(false)
+			},
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				expirationTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+				keeper.SavePost(ctx, poststypes.NewPost(
+					1,
+					0,
+					1,
+					"External id",
+					"Text",
+					"cosmos19mkklc8arp6phlg5eydu3v49syyqyfrq2sp4at",
+					0,
+					nil,
+					nil,
+					nil,
+					poststypes.REPLY_SETTING_EVERYONE,
+					time.Date(2020, 1, 1, 11, 00, 00, 000, time.UTC),
+					nil,
+					"cosmos1eqpa6mv2jgevukaqtjmx5535vhc3mm3cf458zg",
+					&expirationTime,
+				))
+			},
+			check: func(ctx sdk.Context) {
+				require.False(t, keeper.HasPost(ctx, 1, 1))
+
+				kvStore := ctx.KVStore(keys[poststypes.StoreKey])
+				require.False(t, kvStore.Has(poststypes.ExpiringPostQueueKey(1, 1, time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))))
+			},
+		},
+		{
+			name: "expired post of archived subspace is not deleted",
+			setup: func() {
+				sk.EXPECT().IsSubspaceArchived(gomock.Any(), uint64(1)).Return(true)
+			},
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				expirationTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+				keeper.SavePost(ctx, poststypes.NewPost(
+					1,
+					0,
+					1,
+					"External id",
+					"Text",
+					"cosmos19mkklc8arp6phlg5eydu3v49syyqyfrq2sp4at",
+					0,
+					nil,
+					nil,
+					nil,
+					poststypes.REPLY_SETTING_EVERYONE,
+					time.Date(2020, 1, 1, 11, 00, 00, 000, time.UTC),
+					nil,
+					"cosmos1eqpa6mv2jgevukaqtjmx5535vhc3mm3cf458zg",
+					&expirationTime,
+				))
+			},
+			check: func(ctx sdk.Context) {
+				require.True(t, keeper.HasPost(ctx, 1, 1))
+
+				kvStore := ctx.KVStore(keys[poststypes.StoreKey])
+				require.True(t, kvStore.Has(poststypes.ExpiringPostQueueKey(1, 1, time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			ctx, _ := ctx.CacheContext()
+			if tc.setup != nil {
+				tc.setup()
+			}
 			if tc.setupCtx != nil {
 				ctx = tc.setupCtx(ctx)
 			}
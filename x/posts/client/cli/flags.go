@@ -0,0 +1,9 @@
+package cli
+
+const (
+	FlagPublishTime    = "publish-time"
+	FlagExpirationTime = "expiration-time"
+	FlagMediaFile      = "media-file"
+	FlagHashAlgorithm  = "hash-algorithm"
+	FlagViewer         = "viewer"
+)
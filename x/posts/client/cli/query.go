@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryPost(),
 		GetCmdQueryPosts(),
 		GetCmdQueryPostAttachments(),
+		GetCmdQueryPostRevisions(),
 		GetCmdQueryPollAnswers(),
 		GetCmdQueryParams(),
 		GetCmdQueryPostOwnerTransferRequests(),
@@ -61,7 +62,12 @@ func GetCmdQueryPost() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.Post(context.Background(), types.NewQueryPostRequest(subspaceID, postID))
+			viewer, err := cmd.Flags().GetString(FlagViewer)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Post(context.Background(), types.NewQueryPostRequest(subspaceID, postID, viewer))
 			if err != nil {
 				return err
 			}
@@ -70,6 +76,8 @@ func GetCmdQueryPost() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagViewer, "", "Address of the user viewing the post")
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -94,6 +102,11 @@ func GetCmdQueryPosts() *cobra.Command {
 				return err
 			}
 
+			viewer, err := cmd.Flags().GetString(FlagViewer)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -101,7 +114,7 @@ func GetCmdQueryPosts() *cobra.Command {
 
 			var res proto.Message
 			if len(args) == 1 {
-				res, err = queryClient.SubspacePosts(context.Background(), types.NewQuerySubspacePostsRequest(subspaceID, pageReq))
+				res, err = queryClient.SubspacePosts(context.Background(), types.NewQuerySubspacePostsRequest(subspaceID, viewer, pageReq))
 				if err != nil {
 					return err
 				}
@@ -111,7 +124,7 @@ func GetCmdQueryPosts() *cobra.Command {
 					return err
 				}
 
-				res, err = queryClient.SectionPosts(context.Background(), types.NewQuerySectionPostsRequest(subspaceID, sectionID, pageReq))
+				res, err = queryClient.SectionPosts(context.Background(), types.NewQuerySectionPostsRequest(subspaceID, sectionID, viewer, pageReq))
 				if err != nil {
 					return err
 				}
@@ -121,6 +134,8 @@ func GetCmdQueryPosts() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagViewer, "", "Address of the user viewing the posts")
+
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "posts")
 
@@ -156,7 +171,12 @@ func GetCmdQueryPostAttachments() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.PostAttachments(context.Background(), types.NewQueryPostAttachmentsRequest(subspaceID, postID, pageReq))
+			viewer, err := cmd.Flags().GetString(FlagViewer)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PostAttachments(context.Background(), types.NewQueryPostAttachmentsRequest(subspaceID, postID, viewer, pageReq))
 			if err != nil {
 				return err
 			}
@@ -165,12 +185,65 @@ func GetCmdQueryPostAttachments() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagViewer, "", "Address of the user viewing the attachments")
+
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "post attachments")
 
 	return cmd
 }
 
+// GetCmdQueryPostRevisions returns the command to query the revisions of a post
+func GetCmdQueryPostRevisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "revisions [subspace-id] [post-id]",
+		Short:   "Query the revisions of the post having the given id",
+		Example: fmt.Sprintf(`%s query posts revisions 1 1 --page=2 --limit=100`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			subspaceID, err := subspacestypes.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			postID, err := types.ParsePostID(args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			viewer, err := cmd.Flags().GetString(FlagViewer)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PostRevisions(context.Background(), types.NewQueryPostRevisionsRequest(subspaceID, postID, viewer, pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagViewer, "", "Address of the user viewing the revisions")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "post revisions")
+
+	return cmd
+}
+
 // GetCmdQueryPollAnswers returns the command to query all the answers to a given poll
 func GetCmdQueryPollAnswers() *cobra.Command {
 	cmd := &cobra.Command{
@@ -211,12 +284,17 @@ If a user address is provided, only the answer of that user will be returned (if
 				return err
 			}
 
+			viewer, err := cmd.Flags().GetString(FlagViewer)
+			if err != nil {
+				return err
+			}
+
 			var user string
 			if len(args) > 3 {
 				user = args[3]
 			}
 
-			res, err := queryClient.PollAnswers(context.Background(), types.NewQueryPollAnswersRequest(subspaceID, postID, pollID, user, pageReq))
+			res, err := queryClient.PollAnswers(context.Background(), types.NewQueryPollAnswersRequest(subspaceID, postID, pollID, user, viewer, pageReq))
 			if err != nil {
 				return err
 			}
@@ -225,6 +303,8 @@ If a user address is provided, only the answer of that user will be returned (if
 		},
 	}
 
+	cmd.Flags().String(FlagViewer, "", "Address of the user viewing the answers")
+
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "poll answers")
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -40,6 +41,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdCancelPostOwnerTransfer(),
 		GetCmdAcceptPostOwnerTransfer(),
 		GetCmdRefusePostOwnerTransfer(),
+		GetCmdUnhidePost(),
+		GetCmdSetPostRevisionsLimit(),
 	)
 
 	return subspacesTxCmd
@@ -48,11 +51,14 @@ func NewTxCmd() *cobra.Command {
 // GetCmdCreatePost returns the command allowing to create a new post
 func GetCmdCreatePost() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "create [subspace-id] [section-id] [json-file-path]",
-		Args:    cobra.ExactArgs(3),
-		Short:   "Create a new post",
-		Long:    `Create a new post containing the data specified inside the JSON file located at the provided path.`,
-		Example: fmt.Sprintf(`%s tx posts create 1 1 /path/to/my/file.json --from alice`, version.AppName),
+		Use:   "create [subspace-id] [section-id] [json-file-path]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Create a new post",
+		Long: fmt.Sprintf(`Create a new post containing the data specified inside the JSON file located at the provided path.
+The post can be scheduled to be published later by specifying an RFC3339 time using the --%s flag.
+The post can be deleted automatically after a given RFC3339 time by using the --%s flag.`, FlagPublishTime, FlagExpirationTime),
+		Example: fmt.Sprintf(`%s tx posts create 1 1 /path/to/my/file.json --%s 2030-01-01T12:00:00Z --%s 2030-01-02T12:00:00Z --from alice`,
+			version.AppName, FlagPublishTime, FlagExpirationTime),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -81,6 +87,16 @@ func GetCmdCreatePost() *cobra.Command {
 				return err
 			}
 
+			publishTime, err := getTimeFlag(cmd, FlagPublishTime)
+			if err != nil {
+				return fmt.Errorf("invalid publish time: %s", err)
+			}
+
+			expirationTime, err := getTimeFlag(cmd, FlagExpirationTime)
+			if err != nil {
+				return fmt.Errorf("invalid expiration time: %s", err)
+			}
+
 			msg := types.NewMsgCreatePost(
 				subspaceID,
 				sectionID,
@@ -92,6 +108,8 @@ func GetCmdCreatePost() *cobra.Command {
 				data.Tags,
 				attachments,
 				data.ReferencedPosts,
+				publishTime,
+				expirationTime,
 				author,
 			)
 			if err = msg.ValidateBasic(); err != nil {
@@ -102,6 +120,9 @@ func GetCmdCreatePost() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagPublishTime, "", "Optional RFC3339 time at which the post should be published")
+	cmd.Flags().String(FlagExpirationTime, "", "Optional RFC3339 time after which the post should be deleted")
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -201,11 +222,15 @@ func GetCmdDeletePost() *cobra.Command {
 // GetCmdAddPostAttachment returns the command allowing to add an attachment to an existing post
 func GetCmdAddPostAttachment() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "add-attachment [subspace-id] [post-id] [json-file-path]",
-		Args:    cobra.ExactArgs(3),
-		Short:   "Add an attachment to an existing post",
-		Long:    `Add an attachment to an existing post by using the data specified inside the JSON file located at the provided path.`,
-		Example: fmt.Sprintf(`%s tx posts add-attachment 1 1 /path/to/my/file.json --from alice`, version.AppName),
+		Use:   "add-attachment [subspace-id] [post-id] [json-file-path]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Add an attachment to an existing post",
+		Long: fmt.Sprintf(`Add an attachment to an existing post by using the data specified inside the JSON file located at the provided path.
+The attachment can be either a media, a poll or a link preview.
+If the attachment is a media, its hash and size can be computed from a local copy of the media file by using the --%s flag.
+The algorithm used to compute the hash can be specified with the --%s flag.`, FlagMediaFile, FlagHashAlgorithm),
+		Example: fmt.Sprintf(`%s tx posts add-attachment 1 1 /path/to/my/file.json --%s=/path/to/my/image.png --from alice`,
+			version.AppName, FlagMediaFile),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -227,6 +252,28 @@ func GetCmdAddPostAttachment() *cobra.Command {
 				return err
 			}
 
+			mediaFile, err := cmd.Flags().GetString(FlagMediaFile)
+			if err != nil {
+				return err
+			}
+
+			if mediaFile != "" {
+				media, isMedia := content.(*types.Media)
+				if !isMedia {
+					return fmt.Errorf("the --%s flag can only be used with media attachments", FlagMediaFile)
+				}
+
+				algorithm, err := cmd.Flags().GetString(FlagHashAlgorithm)
+				if err != nil {
+					return err
+				}
+
+				media.Hash, media.SizeBytes, err = cliutils.ComputeMediaFileHash(algorithm, mediaFile)
+				if err != nil {
+					return err
+				}
+			}
+
 			editor := clientCtx.FromAddress.String()
 
 			msg := types.NewMsgAddPostAttachment(subspaceID, postID, content, editor)
@@ -238,6 +285,9 @@ func GetCmdAddPostAttachment() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagMediaFile, "", "Optional path to a local copy of the media used to compute its hash and size")
+	cmd.Flags().String(FlagHashAlgorithm, types.ContentHashAlgorithmSHA256, "Algorithm used to compute the media hash")
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -549,3 +599,103 @@ func GetCmdRefusePostOwnerTransfer() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdUnhidePost returns the command allowing to clear the hidden flag of an existing post
+func GetCmdUnhidePost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unhide [subspace-id] [post-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Clear the hidden flag of an existing post",
+		Example: fmt.Sprintf(`%s tx posts unhide 1 1 --from alice`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := subspacestypes.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			postID, err := types.ParsePostID(args[1])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.FromAddress.String()
+
+			msg := types.NewMsgUnhidePost(subspaceID, postID, signer)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetPostRevisionsLimit returns the command allowing to set the max number of revisions kept for each post of a subspace
+func GetCmdSetPostRevisionsLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-revisions-limit [subspace-id] [limit]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set the max number of revisions kept for each post of a subspace",
+		Long: `Set the max number of revisions that are kept for each post of the subspace having the given id.
+Once a post reaches the limit, its oldest revisions are deleted when it is edited again.
+A limit of 0 disables the revisions tracking within the subspace.`,
+		Example: fmt.Sprintf(`%s tx posts set-revisions-limit 1 5 --from alice`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := subspacestypes.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			limit, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.FromAddress.String()
+
+			msg := types.NewMsgSetPostRevisionsLimit(subspaceID, uint32(limit), signer)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getTimeFlag returns the RFC3339 time specified using the flag with the given name, or nil if it has not been set
+func getTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == "" {
+		return nil, nil
+	}
+
+	parsedTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsedTime, nil
+}
@@ -58,3 +58,15 @@ func ParseAttachmentContent(cdc codec.Codec, dataFile string) (types.AttachmentC
 
 	return data, nil
 }
+
+// ComputeMediaFileHash computes the hash of the file located at the given path using the given algorithm,
+// returning it along with the file size in bytes.
+func ComputeMediaFileHash(algorithm string, filePath string) (*types.ContentHash, uint64, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer file.Close()
+
+	return types.ComputeContentHash(algorithm, file)
+}
@@ -22,6 +22,11 @@ func (k Keeper) HasSubspace(ctx sdk.Context, subspaceID uint64) bool {
 	return k.sk.HasSubspace(ctx, subspaceID)
 }
 
+// IsSubspaceArchived tells whether the subspace with the given id has been archived
+func (k Keeper) IsSubspaceArchived(ctx sdk.Context, subspaceID uint64) bool {
+	return k.sk.IsSubspaceArchived(ctx, subspaceID)
+}
+
 // HasSection tells whether the section having the given id exists inside the provided subspace
 func (k Keeper) HasSection(ctx sdk.Context, subspaceID uint64, sectionID uint32) bool {
 	return k.sk.HasSection(ctx, subspaceID, sectionID)
@@ -32,6 +37,21 @@ func (k Keeper) HasPermission(ctx sdk.Context, subspaceID uint64, sectionID uint
 	return k.sk.HasPermission(ctx, subspaceID, sectionID, user, permission)
 }
 
+// HasUserGroup tells whether the user group having the given id exists inside the provided subspace
+func (k Keeper) HasUserGroup(ctx sdk.Context, subspaceID uint64, groupID uint32) bool {
+	return k.sk.HasUserGroup(ctx, subspaceID, groupID)
+}
+
+// IsMemberOfGroup tells whether the given user is a member of the provided user group
+func (k Keeper) IsMemberOfGroup(ctx sdk.Context, subspaceID uint64, groupID uint32, user string) bool {
+	return k.sk.IsMemberOfGroup(ctx, subspaceID, groupID, user)
+}
+
+// CanUserViewSection tells whether the given user can read the contents of the section having the given id
+func (k Keeper) CanUserViewSection(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string) bool {
+	return k.sk.CanUserViewSection(ctx, subspaceID, sectionID, user)
+}
+
 // HasUserBlocked tells whether the given blocker has blocked the user inside the provided subspace
 func (k Keeper) HasUserBlocked(ctx sdk.Context, blocker, user string, subspaceID uint64) bool {
 	return k.rk.HasUserBlocked(ctx, blocker, user, subspaceID)
@@ -135,6 +155,21 @@ func (k Keeper) GetSubspacePosts(ctx sdk.Context, subspaceID uint64) []types.Pos
 	return posts
 }
 
+// IterateHiddenPosts iterates over all the posts that have been flagged as hidden and performs the provided function
+func (k Keeper) IterateHiddenPosts(ctx sdk.Context, fn func(subspaceID uint64, postID uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HiddenPostPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		subspaceID, postID := types.SplitHiddenPostStoreKey(iterator.Key())
+		stop := fn(subspaceID, postID)
+		if stop {
+			break
+		}
+	}
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // IterateActivePolls iterates over the polls in the active polls queue and performs the provided function
@@ -177,6 +212,61 @@ func (k Keeper) IterateActivePollsQueue(ctx sdk.Context, endTime time.Time, fn f
 	}
 }
 
+// IteratePendingPosts iterates over all the pending posts and performs the provided function
+func (k Keeper) IteratePendingPosts(ctx sdk.Context, fn func(subspaceID uint64, postID uint64, publishTime time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingPostQueuePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		subspaceID, postID, publishTime := types.SplitPendingPostQueueKey(iterator.Key())
+		stop := fn(subspaceID, postID, publishTime)
+		if stop {
+			break
+		}
+	}
+}
+
+// IteratePendingPostsQueue iterates over the pending posts that should be published by the time given and performs the provided function
+func (k Keeper) IteratePendingPostsQueue(ctx sdk.Context, publishTime time.Time, fn func(post types.Post) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PendingPostQueuePrefix, sdk.PrefixEndBytes(types.PendingPostsByTimeKey(publishTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		subspaceID, postID, _ := types.SplitPendingPostQueueKey(iterator.Key())
+		post, found := k.GetPost(ctx, subspaceID, postID)
+		if !found {
+			panic(fmt.Sprintf("post %d %d does not exist", subspaceID, postID))
+		}
+
+		stop := fn(post)
+		if stop {
+			break
+		}
+	}
+}
+
+// IterateExpiredPostsQueue iterates over the posts that have expired by the time given and performs the provided function
+func (k Keeper) IterateExpiredPostsQueue(ctx sdk.Context, expirationTime time.Time, fn func(post types.Post) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ExpiringPostQueuePrefix, sdk.PrefixEndBytes(types.ExpiringPostsByTimeKey(expirationTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		subspaceID, postID, _ := types.SplitExpiringPostQueueKey(iterator.Key())
+		post, found := k.GetPost(ctx, subspaceID, postID)
+		if !found {
+			panic(fmt.Sprintf("post %d %d does not exist", subspaceID, postID))
+		}
+
+		stop := fn(post)
+		if stop {
+			break
+		}
+	}
+}
+
 // IterateAttachments iterates over all the attachments in the given context and performs the provided function
 func (k Keeper) IterateAttachments(ctx sdk.Context, fn func(attachment types.Attachment) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -221,6 +311,67 @@ func (k Keeper) GetPostAttachments(ctx sdk.Context, subspaceID uint64, postID ui
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// IteratePostRevisions iterates over all the post revisions in the given context and performs the provided function
+func (k Keeper) IteratePostRevisions(ctx sdk.Context, fn func(revision types.PostRevision) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PostRevisionPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revision types.PostRevision
+		k.cdc.MustUnmarshal(iterator.Value(), &revision)
+		stop := fn(revision)
+		if stop {
+			break
+		}
+	}
+}
+
+// IteratePostRevisionsOf iterates through the revisions of the provided post, from the oldest to the most recent,
+// and performs the given function
+func (k Keeper) IteratePostRevisionsOf(ctx sdk.Context, subspaceID uint64, postID uint64, fn func(revision types.PostRevision) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PostRevisionsPrefix(subspaceID, postID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revision types.PostRevision
+		k.cdc.MustUnmarshal(iterator.Value(), &revision)
+		stop := fn(revision)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetPostRevisions returns all the revisions of the given post, from the oldest to the most recent
+func (k Keeper) GetPostRevisions(ctx sdk.Context, subspaceID uint64, postID uint64) []types.PostRevision {
+	var revisions []types.PostRevision
+	k.IteratePostRevisionsOf(ctx, subspaceID, postID, func(revision types.PostRevision) (stop bool) {
+		revisions = append(revisions, revision)
+		return false
+	})
+	return revisions
+}
+
+// IteratePostRevisionsLimits iterates over all the custom post revisions limits and performs the provided function
+func (k Keeper) IteratePostRevisionsLimits(ctx sdk.Context, fn func(subspaceID uint64, limit uint32) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PostRevisionsLimitPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		subspaceID := subspacestypes.GetSubspaceIDFromBytes(bytes.TrimPrefix(iterator.Key(), types.PostRevisionsLimitPrefix))
+		limit := uint32(sdk.BigEndianToUint64(iterator.Value()))
+		stop := fn(subspaceID, limit)
+		if stop {
+			break
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // IterateUserAnswers iterates over all the polls user answers and performs the provided function
 func (k Keeper) IterateUserAnswers(ctx sdk.Context, fn func(answer types.UserAnswer) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
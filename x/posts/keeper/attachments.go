@@ -3,6 +3,7 @@ package keeper
 import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/desmos-labs/desmos/v7/x/posts/types"
 )
@@ -94,3 +95,34 @@ func (k Keeper) DeleteAttachment(ctx sdk.Context, subspaceID uint64, postID uint
 
 	k.AfterAttachmentDeleted(ctx, subspaceID, postID, attachmentID)
 }
+
+// ValidateMediaSize makes sure that the size declared by the given content does not exceed the max media size
+// allowed by the current params. If the content is a poll, the media attached to its provided answers are checked.
+func (k Keeper) ValidateMediaSize(ctx sdk.Context, content types.AttachmentContent) error {
+	maxMediaSize := k.GetParams(ctx).MaxMediaSize
+	if maxMediaSize == 0 {
+		return nil
+	}
+
+	var medias []*types.Media
+	switch content := content.(type) {
+	case *types.Media:
+		medias = append(medias, content)
+	case *types.Poll:
+		for _, answer := range content.ProvidedAnswers {
+			for _, attachment := range answer.Attachments {
+				if media, ok := attachment.GetCachedValue().(*types.Media); ok {
+					medias = append(medias, media)
+				}
+			}
+		}
+	}
+
+	for _, media := range medias {
+		if media.SizeBytes > maxMediaSize {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "media size exceeds the max allowed size of %d bytes", maxMediaSize)
+		}
+	}
+
+	return nil
+}
@@ -37,6 +37,7 @@ type KeeperTestSuite struct {
 	ak   *testutil.MockProfilesKeeper
 	sk   *testutil.MockSubspacesKeeper
 	rk   *testutil.MockRelationshipsKeeper
+	bk   *testutil.MockBankKeeper
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
@@ -63,6 +64,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	suite.sk = testutil.NewMockSubspacesKeeper(suite.ctrl)
 	suite.rk = testutil.NewMockRelationshipsKeeper(suite.ctrl)
+	suite.bk = testutil.NewMockBankKeeper(suite.ctrl)
 	suite.ak = testutil.NewMockProfilesKeeper(suite.ctrl)
 	suite.k = keeper.NewKeeper(
 		suite.cdc,
@@ -70,6 +72,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		suite.ak,
 		suite.sk,
 		suite.rk,
+		suite.bk,
 		authtypes.NewModuleAddress("gov").String(),
 	)
 }
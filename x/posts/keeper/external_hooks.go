@@ -37,8 +37,20 @@ func (h Hooks) AfterSubspaceDeleted(ctx sdk.Context, subspaceID uint64) {
 		h.k.DeletePost(ctx, post.SubspaceID, post.ID)
 		return false
 	})
+
+	// Delete the post revisions limit
+	h.k.DeletePostRevisionsLimit(ctx, subspaceID)
 }
 
+// AfterSubspaceOwnerTransferRequestSaved implements subspacestypes.Hooks
+func (h Hooks) AfterSubspaceOwnerTransferRequestSaved(sdk.Context, uint64) {}
+
+// AfterSubspaceOwnerTransferRequestDeleted implements subspacestypes.Hooks
+func (h Hooks) AfterSubspaceOwnerTransferRequestDeleted(sdk.Context, uint64) {}
+
+// AfterSubspaceOwnerTransferred implements subspacestypes.Hooks
+func (h Hooks) AfterSubspaceOwnerTransferred(sdk.Context, uint64, string, string) {}
+
 // AfterSubspaceSectionSaved implements subspacestypes.Hooks
 func (h Hooks) AfterSubspaceSectionSaved(sdk.Context, uint64, uint32) {}
 
@@ -49,6 +61,14 @@ func (h Hooks) AfterSubspaceSectionDeleted(ctx sdk.Context, subspaceID uint64, s
 		h.k.DeletePost(ctx, post.SubspaceID, post.ID)
 		return false
 	})
+
+	// Delete all the pending posts, since they are not referenced inside the section yet
+	h.k.IterateSubspacePosts(ctx, subspaceID, func(post types.Post) (stop bool) {
+		if post.SectionID == sectionID && h.k.IsPostPending(ctx, post.SubspaceID, post.ID) {
+			h.k.DeletePost(ctx, post.SubspaceID, post.ID)
+		}
+		return false
+	})
 }
 
 // AfterSubspaceGroupSaved implements subspacestypes.Hooks
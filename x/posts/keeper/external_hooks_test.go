@@ -82,6 +82,7 @@ func (suite *KeeperTestSuite) TestKeeper_AfterSubspaceDeleted() {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					nil,
 				))
 				suite.k.SavePost(ctx, types.NewPost(
 					1,
@@ -98,7 +99,10 @@ func (suite *KeeperTestSuite) TestKeeper_AfterSubspaceDeleted() {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					nil,
 				))
+
+				suite.k.SetPostRevisionsLimit(ctx, 1, 5)
 			},
 			subspaceID: 1,
 			check: func(ctx sdk.Context) {
@@ -107,6 +111,8 @@ func (suite *KeeperTestSuite) TestKeeper_AfterSubspaceDeleted() {
 
 				suite.Require().False(suite.k.HasPost(ctx, 1, 1))
 				suite.Require().False(suite.k.HasPost(ctx, 1, 2))
+
+				suite.Require().False(suite.k.HasPostRevisionsLimit(ctx, 1))
 			},
 		},
 	}
@@ -156,6 +162,7 @@ func (suite *KeeperTestSuite) TestKeeper_AfterSectionDeleted() {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					nil,
 				))
 				suite.k.SavePost(ctx, types.NewPost(
 					1,
@@ -172,6 +179,7 @@ func (suite *KeeperTestSuite) TestKeeper_AfterSectionDeleted() {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					nil,
 				))
 			},
 			subspaceID: 1,
@@ -181,6 +189,59 @@ func (suite *KeeperTestSuite) TestKeeper_AfterSectionDeleted() {
 				suite.Require().False(suite.k.HasPost(ctx, 1, 2))
 			},
 		},
+		{
+			name: "pending section posts are deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetNextPostID(ctx, 1, 1)
+
+				suite.k.SetPostPending(ctx, 1, 1, time.Date(2030, 1, 1, 12, 00, 00, 000, time.UTC))
+				suite.k.SavePost(ctx, types.NewPost(
+					1,
+					1,
+					1,
+					"External ID",
+					"This is a text",
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					1,
+					nil,
+					nil,
+					nil,
+					types.REPLY_SETTING_EVERYONE,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					nil,
+				))
+
+				suite.k.SetPostPending(ctx, 1, 2, time.Date(2030, 1, 1, 12, 00, 00, 000, time.UTC))
+				suite.k.SavePost(ctx, types.NewPost(
+					1,
+					2,
+					2,
+					"External ID",
+					"This is a text",
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					1,
+					nil,
+					nil,
+					nil,
+					types.REPLY_SETTING_EVERYONE,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					nil,
+				))
+			},
+			subspaceID: 1,
+			sectionID:  1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasPost(ctx, 1, 1))
+				suite.Require().False(suite.k.IsPostPending(ctx, 1, 1))
+
+				// Make sure pending posts of other sections are not deleted
+				suite.Require().True(suite.k.HasPost(ctx, 1, 2))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	subspacestypes "github.com/desmos-labs/desmos/v7/x/subspaces/types"
@@ -19,6 +21,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.getAllUserAnswers(ctx),
 		k.GetParams(ctx),
 		k.GetAllPostOwnerTransferRequests(ctx),
+		k.getAllHiddenPostEntries(ctx),
+		k.getAllPendingPostEntries(ctx),
+		k.getAllPostRevisions(ctx),
+		k.getAllPostRevisionsLimitEntries(ctx),
 	)
 }
 
@@ -87,6 +93,46 @@ func (k Keeper) getAllUserAnswers(ctx sdk.Context) []types.UserAnswer {
 	return answers
 }
 
+// getAllHiddenPostEntries returns all the hidden post entries stored inside the given context
+func (k Keeper) getAllHiddenPostEntries(ctx sdk.Context) []types.HiddenPostEntry {
+	var entries []types.HiddenPostEntry
+	k.IterateHiddenPosts(ctx, func(subspaceID uint64, postID uint64) (stop bool) {
+		entries = append(entries, types.NewHiddenPostEntry(subspaceID, postID))
+		return false
+	})
+	return entries
+}
+
+// getAllPendingPostEntries returns all the pending post entries stored inside the given context
+func (k Keeper) getAllPendingPostEntries(ctx sdk.Context) []types.PendingPostEntry {
+	var entries []types.PendingPostEntry
+	k.IteratePendingPosts(ctx, func(subspaceID uint64, postID uint64, publishTime time.Time) (stop bool) {
+		entries = append(entries, types.NewPendingPostEntry(subspaceID, postID, publishTime))
+		return false
+	})
+	return entries
+}
+
+// getAllPostRevisions returns all the post revisions stored inside the given context
+func (k Keeper) getAllPostRevisions(ctx sdk.Context) []types.PostRevision {
+	var revisions []types.PostRevision
+	k.IteratePostRevisions(ctx, func(revision types.PostRevision) (stop bool) {
+		revisions = append(revisions, revision)
+		return false
+	})
+	return revisions
+}
+
+// getAllPostRevisionsLimitEntries returns all the custom post revisions limits stored inside the given context
+func (k Keeper) getAllPostRevisionsLimitEntries(ctx sdk.Context) []types.PostRevisionsLimitEntry {
+	var entries []types.PostRevisionsLimitEntry
+	k.IteratePostRevisionsLimits(ctx, func(subspaceID uint64, limit uint32) (stop bool) {
+		entries = append(entries, types.NewPostRevisionsLimitEntry(subspaceID, limit))
+		return false
+	})
+	return entries
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // InitGenesis initializes the chain state based on the given GenesisState
@@ -96,6 +142,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetNextPostID(ctx, entry.SubspaceID, entry.InitialPostID)
 	}
 
+	// Initialize the pending posts before storing them, so that they are not published
+	for _, entry := range data.PendingPosts {
+		k.SetPostPending(ctx, entry.SubspaceID, entry.PostID, entry.PublishTime)
+	}
+
 	// Initialize all the posts
 	for _, post := range data.Posts {
 		k.SavePost(ctx, post)
@@ -128,4 +179,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	for _, request := range data.PostOwnerTransferRequests {
 		k.SavePostOwnerTransferRequest(ctx, request)
 	}
+
+	// Initialize the hidden posts
+	for _, entry := range data.HiddenPosts {
+		k.HidePost(ctx, entry.SubspaceID, entry.PostID)
+	}
+
+	// Initialize the post revisions
+	for _, revision := range data.PostRevisions {
+		k.SavePostRevision(ctx, revision)
+	}
+
+	// Initialize the post revisions limits
+	for _, entry := range data.PostRevisionsLimits {
+		k.SetPostRevisionsLimit(ctx, entry.SubspaceID, entry.Limit)
+	}
 }
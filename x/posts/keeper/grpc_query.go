@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -29,14 +30,26 @@ func (k Keeper) SubspacePosts(ctx context.Context, request *types.QuerySubspaceP
 	postsStore := prefix.NewStore(store, types.SubspacePostsPrefix(request.SubspaceId))
 
 	var posts []types.Post
-	pageRes, err := query.Paginate(postsStore, request.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(postsStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var post types.Post
 		if err := k.cdc.Unmarshal(value, &post); err != nil {
-			return status.Error(codes.Internal, err.Error())
+			return false, status.Error(codes.Internal, err.Error())
 		}
 
-		posts = append(posts, post)
-		return nil
+		// Skip the posts that have not been published yet
+		if k.IsPostPending(sdkCtx, post.SubspaceID, post.ID) {
+			return false, nil
+		}
+
+		// Skip the posts placed inside sections that the viewer cannot read
+		if !k.CanUserViewSection(sdkCtx, post.SubspaceID, post.SectionID, request.Viewer) {
+			return false, nil
+		}
+
+		if accumulate {
+			posts = append(posts, post)
+		}
+		return true, nil
 	})
 
 	if err != nil {
@@ -57,6 +70,11 @@ func (k Keeper) SectionPosts(ctx context.Context, request *types.QuerySectionPos
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	// Return no post if the viewer cannot read the section
+	if !k.CanUserViewSection(sdkCtx, request.SubspaceId, request.SectionId, request.Viewer) {
+		return &types.QuerySectionPostsResponse{Pagination: &query.PageResponse{}}, nil
+	}
+
 	store := sdkCtx.KVStore(k.storeKey)
 	postsPrefix := types.SectionPostsPrefix(request.SubspaceId, request.SectionId)
 	sectionsPostsStore := prefix.NewStore(store, postsPrefix)
@@ -99,11 +117,32 @@ func (k Keeper) Post(ctx context.Context, request *types.QueryPostRequest) (*typ
 		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "post with id %d not found", request.PostId)
 	}
 
+	if !k.CanUserViewSection(sdkCtx, post.SubspaceID, post.SectionID, request.Viewer) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "viewer cannot read the post with id %d", request.PostId)
+	}
+
+	var publishTime *time.Time
+	if pendingTime, isPending := k.GetPostPublishTime(sdkCtx, request.SubspaceId, request.PostId); isPending {
+		publishTime = &pendingTime
+	}
+
 	return &types.QueryPostResponse{
-		Post: post,
+		Post:        post,
+		Hidden:      k.IsPostHidden(sdkCtx, request.SubspaceId, request.PostId),
+		PublishTime: publishTime,
 	}, nil
 }
 
+// checkPostViewer returns an error if the given viewer cannot read the section inside which the post
+// having the given id lies. If the post does not exist, no error is returned
+func (k Keeper) checkPostViewer(ctx sdk.Context, subspaceID uint64, postID uint64, viewer string) error {
+	post, found := k.GetPost(ctx, subspaceID, postID)
+	if found && !k.CanUserViewSection(ctx, post.SubspaceID, post.SectionID, viewer) {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "viewer cannot read the post with id %d", postID)
+	}
+	return nil
+}
+
 // PostAttachments implements the QueryPostAttachments gRPC method
 func (k Keeper) PostAttachments(ctx context.Context, request *types.QueryPostAttachmentsRequest) (*types.QueryPostAttachmentsResponse, error) {
 	if request.SubspaceId == 0 {
@@ -115,6 +154,12 @@ func (k Keeper) PostAttachments(ctx context.Context, request *types.QueryPostAtt
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	// Make sure the viewer can read the post
+	err := k.checkPostViewer(sdkCtx, request.SubspaceId, request.PostId, request.Viewer)
+	if err != nil {
+		return nil, err
+	}
+
 	store := sdkCtx.KVStore(k.storeKey)
 	attachmentsStore := prefix.NewStore(store, types.PostAttachmentsPrefix(request.SubspaceId, request.PostId))
 
@@ -139,6 +184,48 @@ func (k Keeper) PostAttachments(ctx context.Context, request *types.QueryPostAtt
 	}, nil
 }
 
+// PostRevisions implements the QueryPostRevisions gRPC method
+func (k Keeper) PostRevisions(ctx context.Context, request *types.QueryPostRevisionsRequest) (*types.QueryPostRevisionsResponse, error) {
+	if request.SubspaceId == 0 {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id")
+	}
+	if request.PostId == 0 {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid post id")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Make sure the viewer can read the post
+	err := k.checkPostViewer(sdkCtx, request.SubspaceId, request.PostId, request.Viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	store := sdkCtx.KVStore(k.storeKey)
+	revisionsStore := prefix.NewStore(store, types.PostRevisionsPrefix(request.SubspaceId, request.PostId))
+
+	var revisions []types.PostRevision
+	pageRes, err := query.Paginate(revisionsStore, request.Pagination, func(key []byte, value []byte) error {
+		var revision types.PostRevision
+		if err := k.cdc.Unmarshal(value, &revision); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		revisions = append(revisions, revision)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPostRevisionsResponse{
+		Revisions:      revisions,
+		RevisionsLimit: k.GetPostRevisionsLimit(sdkCtx, request.SubspaceId),
+		Pagination:     pageRes,
+	}, nil
+}
+
 // PollAnswers implements the QueryPollAnswers gRPC method
 func (k Keeper) PollAnswers(ctx context.Context, request *types.QueryPollAnswersRequest) (*types.QueryPollAnswersResponse, error) {
 	if request.SubspaceId == 0 {
@@ -153,6 +240,12 @@ func (k Keeper) PollAnswers(ctx context.Context, request *types.QueryPollAnswers
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	// Make sure the viewer can read the post
+	err := k.checkPostViewer(sdkCtx, request.SubspaceId, request.PostId, request.Viewer)
+	if err != nil {
+		return nil, err
+	}
+
 	store := sdkCtx.KVStore(k.storeKey)
 	answersPrefix := types.PollAnswersPrefix(request.SubspaceId, request.PostId, request.PollId)
 	if request.User != "" {
@@ -25,6 +25,13 @@ func (k Keeper) AfterPostDeleted(ctx sdk.Context, subspaceID uint64, postID uint
 	}
 }
 
+// AfterPostUnhidden implements types.PostsHooks
+func (k Keeper) AfterPostUnhidden(ctx sdk.Context, subspaceID uint64, postID uint64) {
+	if k.hooks != nil {
+		k.hooks.AfterPostUnhidden(ctx, subspaceID, postID)
+	}
+}
+
 // AfterAttachmentSaved implements types.PostsHooks
 func (k Keeper) AfterAttachmentSaved(ctx sdk.Context, subspaceID uint64, postID uint64, attachmentID uint32) {
 	if k.hooks != nil {
@@ -18,6 +18,7 @@ type Keeper struct {
 	ak types.ProfilesKeeper
 	sk types.SubspacesKeeper
 	rk types.RelationshipsKeeper
+	bk types.BankKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -27,7 +28,7 @@ type Keeper struct {
 // NewKeeper creates a new instance of the Posts Keeper.
 func NewKeeper(
 	cdc codec.BinaryCodec, storeKey storetypes.StoreKey,
-	ak types.ProfilesKeeper, sk types.SubspacesKeeper, rk types.RelationshipsKeeper, authority string,
+	ak types.ProfilesKeeper, sk types.SubspacesKeeper, rk types.RelationshipsKeeper, bk types.BankKeeper, authority string,
 ) *Keeper {
 	return &Keeper{
 		storeKey: storeKey,
@@ -36,6 +37,7 @@ func NewKeeper(
 		ak: ak,
 		sk: sk,
 		rk: rk,
+		bk: bk,
 
 		authority: authority,
 	}
@@ -34,6 +34,12 @@ var _ types.MsgServer = &msgServer{}
 func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the author has a profile
 	if !k.HasProfile(ctx, msg.Author) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "you cannot create a post without having a profile")
@@ -44,6 +50,11 @@ func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the section exists
 	if !k.HasSection(ctx, msg.SubspaceID, msg.SectionID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace section with id %d not found", msg.SectionID)
@@ -56,6 +67,11 @@ func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (
 		return nil, errors.Wrap(subspacestypes.ErrPermissionDenied, "you cannot create posts nor comment inside this section")
 	}
 
+	// Make sure the publish time is in the future
+	if msg.PublishTime != nil && !msg.PublishTime.After(ctx.BlockTime()) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "publish time must be after the current block time")
+	}
+
 	// Get the next post id
 	postID, err := k.GetNextPostID(ctx, msg.SubspaceID)
 	if err != nil {
@@ -78,12 +94,18 @@ func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (
 		ctx.BlockTime(),
 		nil,
 		msg.Author,
+		msg.ExpirationTime,
 	)
 	err = k.ValidatePost(ctx, post)
 	if err != nil {
 		return nil, err
 	}
 
+	// Flag the post as pending if it has been scheduled
+	if msg.PublishTime != nil {
+		k.SetPostPending(ctx, post.SubspaceID, post.ID, *msg.PublishTime)
+	}
+
 	// Store the post
 	k.SavePost(ctx, post)
 
@@ -115,6 +137,17 @@ func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (
 		),
 	})
 
+	if msg.PublishTime != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduledPost,
+				sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+				sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", post.ID)),
+				sdk.NewAttribute(types.AttributeKeyPublishTime, msg.PublishTime.Format(time.RFC3339)),
+			),
+		)
+	}
+
 	return &types.MsgCreatePostResponse{
 		PostID:       post.ID,
 		CreationDate: post.CreationDate,
@@ -125,11 +158,22 @@ func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (
 func (k msgServer) EditPost(goCtx context.Context, msg *types.MsgEditPost) (*types.MsgEditPostResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Get the post
 	post, found := k.GetPost(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
@@ -150,11 +194,14 @@ func (k msgServer) EditPost(goCtx context.Context, msg *types.MsgEditPost) (*typ
 	updateTime := ctx.BlockTime()
 	update := types.NewPostUpdate(msg.Text, msg.Entities, msg.Tags, updateTime)
 	updatedPost := post.Update(update)
-	err := k.ValidatePost(ctx, updatedPost)
+	err = k.ValidatePost(ctx, updatedPost)
 	if err != nil {
 		return nil, err
 	}
 
+	// Keep the previous contents as a revision
+	k.AddPostRevision(ctx, post, msg.Editor, updateTime)
+
 	// Store the update
 	k.SavePost(ctx, updatedPost)
 
@@ -176,11 +223,22 @@ func (k msgServer) EditPost(goCtx context.Context, msg *types.MsgEditPost) (*typ
 func (k msgServer) DeletePost(goCtx context.Context, msg *types.MsgDeletePost) (*types.MsgDeletePostResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Get the post
 	post, found := k.GetPost(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
@@ -221,6 +279,21 @@ func (k msgServer) storePostAttachment(ctx sdk.Context, subspaceID uint64, postI
 		if poll.EndDate.Before(ctx.BlockTime()) {
 			return 0, errors.Wrapf(sdkerrors.ErrInvalidRequest, "poll end date must be in the future")
 		}
+
+		// Make sure the groups allowed to answer exist
+		if poll.IsGroupRestricted() {
+			for _, groupID := range poll.Weighting.GroupIDs {
+				if !k.HasUserGroup(ctx, subspaceID, groupID) {
+					return 0, errors.Wrapf(sdkerrors.ErrInvalidRequest, "user group with id %d not found", groupID)
+				}
+			}
+		}
+	}
+
+	// Make sure the media sizes do not exceed the max allowed one
+	err = k.ValidateMediaSize(ctx, content)
+	if err != nil {
+		return 0, err
 	}
 
 	// Get the next attachment id
@@ -254,17 +327,33 @@ func (k msgServer) storePostAttachment(ctx sdk.Context, subspaceID uint64, postI
 func (k msgServer) AddPostAttachment(goCtx context.Context, msg *types.MsgAddPostAttachment) (*types.MsgAddPostAttachmentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Get the post
 	post, found := k.GetPost(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d does not exist", msg.PostID)
 	}
 
+	// Make sure the post has already been published
+	if k.IsPostPending(ctx, msg.SubspaceID, msg.PostID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d has not been published yet", msg.PostID)
+	}
+
 	// Make sure the editor matches the owner
 	if post.Owner != msg.Editor {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "you are not the author of this post")
@@ -277,7 +366,7 @@ func (k msgServer) AddPostAttachment(goCtx context.Context, msg *types.MsgAddPos
 
 	// Unpack the content
 	var content types.AttachmentContent
-	err := k.cdc.UnpackAny(msg.Content, &content)
+	err = k.cdc.UnpackAny(msg.Content, &content)
 	if err != nil {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid attachment content: %s", err)
 	}
@@ -317,11 +406,22 @@ func (k msgServer) AddPostAttachment(goCtx context.Context, msg *types.MsgAddPos
 func (k msgServer) RemovePostAttachment(goCtx context.Context, msg *types.MsgRemovePostAttachment) (*types.MsgRemovePostAttachmentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Get the post
 	post, found := k.GetPost(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
@@ -346,7 +446,7 @@ func (k msgServer) RemovePostAttachment(goCtx context.Context, msg *types.MsgRem
 	// Update the post edit time and validate it
 	updateTime := ctx.BlockTime()
 	post.LastEditedDate = &updateTime
-	err := k.ValidatePost(ctx, post)
+	err = k.ValidatePost(ctx, post)
 	if err != nil {
 		return nil, err
 	}
@@ -373,6 +473,12 @@ func (k msgServer) RemovePostAttachment(goCtx context.Context, msg *types.MsgRem
 func (k msgServer) AnswerPoll(goCtx context.Context, msg *types.MsgAnswerPoll) (*types.MsgAnswerPollResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the author has a profile
 	if !k.HasProfile(ctx, msg.Signer) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "you cannot answer a poll without having a profile")
@@ -383,12 +489,22 @@ func (k msgServer) AnswerPoll(goCtx context.Context, msg *types.MsgAnswerPoll) (
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Make sure the post exists
 	post, found := k.GetPost(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d does not exist", msg.PostID)
 	}
 
+	// Make sure the post has already been published
+	if k.IsPostPending(ctx, msg.SubspaceID, msg.PostID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d has not been published yet", msg.PostID)
+	}
+
 	// Check the permission to interact with content
 	if !k.HasPermission(ctx, msg.SubspaceID, post.SectionID, msg.Signer, types.PermissionInteractWithContent) {
 		return nil, errors.Wrap(subspacestypes.ErrPermissionDenied, "you cannot interact with content inside this subspace")
@@ -405,6 +521,11 @@ func (k msgServer) AnswerPoll(goCtx context.Context, msg *types.MsgAnswerPoll) (
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "the poll voting period has already ended")
 	}
 
+	// Make sure the user is allowed to answer the poll
+	if poll.IsGroupRestricted() && !k.isMemberOfAnyGroup(ctx, msg.SubspaceID, poll.Weighting.GroupIDs, msg.Signer) {
+		return nil, errors.Wrap(subspacestypes.ErrPermissionDenied, "only the members of the poll user groups can answer it")
+	}
+
 	alreadyAnswered := k.HasUserAnswer(ctx, msg.SubspaceID, msg.PostID, msg.PollID, msg.Signer)
 
 	// Make sure the user is not trying to edit the answer when the poll does not allow it
@@ -412,21 +533,17 @@ func (k msgServer) AnswerPoll(goCtx context.Context, msg *types.MsgAnswerPoll) (
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "you cannot edit this poll's answer")
 	}
 
-	// Make sure the user not answering with multiple options when the poll does not allow it
-	if len(msg.AnswersIndexes) > 1 && !poll.AllowsMultipleAnswers {
-		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "only one answer is allowed on this post")
+	// Make sure the answers are allowed by the poll mode and the answer indexes exist
+	err = poll.ValidateAnswersIndexes(msg.AnswersIndexes)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	// Sort the answers indexes
-	sort.Slice(msg.AnswersIndexes, func(i, j int) bool {
-		return msg.AnswersIndexes[i] < msg.AnswersIndexes[j]
-	})
-
-	// Make sure the answer indexes exist
-	maxProvidedIndex := uint32(len(poll.ProvidedAnswers) - 1)
-	maxAnswerIndex := msg.AnswersIndexes[len(msg.AnswersIndexes)-1]
-	if maxAnswerIndex > maxProvidedIndex {
-		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid answer index: %d", maxAnswerIndex)
+	// Sort the answers indexes, unless they represent the user preferences of a ranked-choice poll
+	if poll.Mode != types.POLL_MODE_RANKED_CHOICE {
+		sort.Slice(msg.AnswersIndexes, func(i, j int) bool {
+			return msg.AnswersIndexes[i] < msg.AnswersIndexes[j]
+		})
 	}
 
 	// Store the user answer
@@ -466,6 +583,12 @@ func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 func (k msgServer) MovePost(goCtx context.Context, msg *types.MsgMovePost) (*types.MsgMovePostResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Make sure the post exists
 	post, found := k.GetPost(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
@@ -477,6 +600,14 @@ func (k msgServer) MovePost(goCtx context.Context, msg *types.MsgMovePost) (*typ
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.TargetSubspaceID)
 	}
 
+	// Make sure neither the source nor the target subspace are archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+	if k.IsSubspaceArchived(ctx, msg.TargetSubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.TargetSubspaceID)
+	}
+
 	// Check if the target section exists
 	if !k.HasSection(ctx, msg.TargetSubspaceID, msg.TargetSectionID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace section with id %d not found", msg.TargetSectionID)
@@ -507,6 +638,11 @@ func (k msgServer) MovePost(goCtx context.Context, msg *types.MsgMovePost) (*typ
 		return nil, err
 	}
 
+	// Keep the post pending inside the target subspace if it has not been published yet
+	if publishTime, isPending := k.GetPostPublishTime(ctx, msg.SubspaceID, msg.PostID); isPending {
+		k.SetPostPending(ctx, updatedPost.SubspaceID, updatedPost.ID, publishTime)
+	}
+
 	k.Keeper.SavePost(ctx, updatedPost)
 
 	// Update the id for the next post
@@ -532,6 +668,14 @@ func (k msgServer) MovePost(goCtx context.Context, msg *types.MsgMovePost) (*typ
 	// Update the id for the next attachment
 	k.SetNextAttachmentID(ctx, msg.TargetSubspaceID, newPostID, maxAttachmentID+1)
 
+	// Move all post revisions
+	k.IteratePostRevisionsOf(ctx, msg.SubspaceID, msg.PostID, func(revision types.PostRevision) (stop bool) {
+		revision.SubspaceID = updatedPost.SubspaceID
+		revision.PostID = updatedPost.ID
+		k.SavePostRevision(ctx, revision)
+		return false
+	})
+
 	// Delete the post
 	k.Keeper.DeletePost(ctx, msg.SubspaceID, msg.PostID)
 
@@ -552,6 +696,12 @@ func (k msgServer) MovePost(goCtx context.Context, msg *types.MsgMovePost) (*typ
 func (k msgServer) RequestPostOwnerTransfer(goCtx context.Context, msg *types.MsgRequestPostOwnerTransfer) (*types.MsgRequestPostOwnerTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the sender has profile
 	if !k.HasProfile(ctx, msg.Sender) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "you cannot transfer a post without having a profile")
@@ -567,12 +717,22 @@ func (k msgServer) RequestPostOwnerTransfer(goCtx context.Context, msg *types.Ms
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "receiver has blocked you")
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Get the post
 	post, found := k.GetPost(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d does not exist", msg.PostID)
 	}
 
+	// Make sure the post has already been published
+	if k.IsPostPending(ctx, msg.SubspaceID, msg.PostID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d has not been published yet", msg.PostID)
+	}
+
 	// Make sure the sender matches the owner
 	if post.Owner != msg.Sender {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "you cannot transfer a post that you do not own")
@@ -608,6 +768,12 @@ func (k msgServer) RequestPostOwnerTransfer(goCtx context.Context, msg *types.Ms
 func (k msgServer) CancelPostOwnerTransferRequest(goCtx context.Context, msg *types.MsgCancelPostOwnerTransferRequest) (*types.MsgCancelPostOwnerTransferRequestResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get the post owner transfer request
 	request, found := k.GetPostOwnerTransferRequest(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
@@ -638,11 +804,22 @@ func (k msgServer) CancelPostOwnerTransferRequest(goCtx context.Context, msg *ty
 func (k msgServer) AcceptPostOwnerTransferRequest(goCtx context.Context, msg *types.MsgAcceptPostOwnerTransferRequest) (*types.MsgAcceptPostOwnerTransferRequestResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the receiver has profile
 	if !k.HasProfile(ctx, msg.Receiver) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "you cannot accept a post owner transfer request without having a profile")
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Get the post owner transfer request
 	request, found := k.GetPostOwnerTransferRequest(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
@@ -667,7 +844,7 @@ func (k msgServer) AcceptPostOwnerTransferRequest(goCtx context.Context, msg *ty
 
 	// Update the post and validate it
 	newPost := types.NewOwnerTransfer(msg.Receiver, ctx.BlockTime()).Update(post)
-	err := newPost.Validate()
+	err = newPost.Validate()
 	if err != nil {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -694,6 +871,12 @@ func (k msgServer) AcceptPostOwnerTransferRequest(goCtx context.Context, msg *ty
 func (k msgServer) RefusePostOwnerTransferRequest(goCtx context.Context, msg *types.MsgRefusePostOwnerTransferRequest) (*types.MsgRefusePostOwnerTransferRequestResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get the post owner transfer request
 	request, found := k.GetPostOwnerTransferRequest(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
@@ -719,3 +902,94 @@ func (k msgServer) RefusePostOwnerTransferRequest(goCtx context.Context, msg *ty
 
 	return &types.MsgRefusePostOwnerTransferRequestResponse{}, nil
 }
+
+// UnhidePost defines the rpc method for Msg/UnhidePost
+func (k msgServer) UnhidePost(goCtx context.Context, msg *types.MsgUnhidePost) (*types.MsgUnhidePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
+	// Get the post
+	post, found := k.GetPost(ctx, msg.SubspaceID, msg.PostID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d does not exist", msg.PostID)
+	}
+
+	// Check the permission to moderate the post
+	if !k.HasPermission(ctx, msg.SubspaceID, post.SectionID, msg.Signer, types.PermissionModerateContent) {
+		return nil, errors.Wrap(subspacestypes.ErrPermissionDenied, "you cannot moderate content inside this section")
+	}
+
+	// Make sure the post is hidden
+	if !k.IsPostHidden(ctx, msg.SubspaceID, msg.PostID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d is not hidden", msg.PostID)
+	}
+
+	// Remove the hidden flag
+	k.Keeper.UnhidePost(ctx, msg.SubspaceID, msg.PostID)
+	k.AfterPostUnhidden(ctx, msg.SubspaceID, msg.PostID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnhiddenPost,
+			sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", msg.PostID)),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.Signer),
+		),
+	})
+
+	return &types.MsgUnhidePostResponse{}, nil
+}
+
+// SetPostRevisionsLimit defines the rpc method for Msg/SetPostRevisionsLimit
+func (k msgServer) SetPostRevisionsLimit(goCtx context.Context, msg *types.MsgSetPostRevisionsLimit) (*types.MsgSetPostRevisionsLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
+	// Check the permission to moderate content
+	if !k.HasPermission(ctx, msg.SubspaceID, subspacestypes.RootSectionID, msg.Signer, types.PermissionModerateContent) {
+		return nil, errors.Wrap(subspacestypes.ErrPermissionDenied, "you cannot manage the post revisions limit inside this subspace")
+	}
+
+	// Store the limit
+	k.Keeper.SetPostRevisionsLimit(ctx, msg.SubspaceID, msg.Limit)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPostRevisionsLimit,
+			sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyRevisionsLimit, fmt.Sprintf("%d", msg.Limit)),
+		),
+	})
+
+	return &types.MsgSetPostRevisionsLimitResponse{}, nil
+}
@@ -26,10 +26,10 @@ func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 			store: func(ctx sdk.Context) {
 				suite.k.SetParams(ctx, types.DefaultParams())
 			},
-			params: types.NewParams(1),
+			params: types.NewParams(1, 0),
 			check: func(ctx sdk.Context) {
 				stored := suite.k.GetParams(ctx)
-				suite.Require().Equal(stored, types.NewParams(1))
+				suite.Require().Equal(stored, types.NewParams(1, 0))
 			},
 		},
 	}
@@ -59,9 +59,9 @@ func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 		{
 			name: "params are returned properly",
 			store: func(ctx sdk.Context) {
-				suite.k.SetParams(ctx, types.NewParams(1))
+				suite.k.SetParams(ctx, types.NewParams(1, 0))
 			},
-			expParams: types.NewParams(1),
+			expParams: types.NewParams(1, 0),
 		},
 	}
 
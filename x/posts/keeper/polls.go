@@ -30,6 +30,37 @@ func (k Keeper) GetPoll(ctx sdk.Context, subspaceID uint64, postID uint64, pollI
 	return poll, ok
 }
 
+// isMemberOfAnyGroup tells whether the given user is a member of at least one of the provided user groups
+func (k Keeper) isMemberOfAnyGroup(ctx sdk.Context, subspaceID uint64, groupIDs []uint32, user string) bool {
+	for _, groupID := range groupIDs {
+		if k.IsMemberOfGroup(ctx, subspaceID, groupID, user) {
+			return true
+		}
+	}
+	return false
+}
+
+// pollBallot represents the answers given by a user to a poll, along with their weight
+type pollBallot struct {
+	answersIndexes []uint32
+	weight         sdk.Int
+}
+
+// getAnswerWeight returns the weight of the answer given by the provided user to the given poll.
+// Answers of token-weighted polls are weighted by the user balance, while all other answers have a weight of 1.
+func (k Keeper) getAnswerWeight(ctx sdk.Context, poll *types.Poll, user string) sdk.Int {
+	if !poll.IsTokenWeighted() {
+		return sdk.OneInt()
+	}
+
+	userAddr, err := sdk.AccAddressFromBech32(user)
+	if err != nil {
+		return sdk.ZeroInt()
+	}
+
+	return k.bk.GetBalance(ctx, userAddr, poll.Weighting.Denom).Amount
+}
+
 // Tally iterates over the votes and returns the tally results of a poll
 func (k Keeper) Tally(ctx sdk.Context, subspaceID uint64, postID uint64, pollID uint32) *types.PollTallyResults {
 	poll, found := k.GetPoll(ctx, subspaceID, postID, pollID)
@@ -37,17 +68,12 @@ func (k Keeper) Tally(ctx sdk.Context, subspaceID uint64, postID uint64, pollID
 		return nil
 	}
 
-	// Create the map index -> count(votes)
-	results := make(map[uint32]uint64, len(poll.ProvidedAnswers))
-	for i := range poll.ProvidedAnswers {
-		results[uint32(i)] = 0
-	}
-
+	var ballots []pollBallot
 	k.IteratePollUserAnswers(ctx, subspaceID, postID, pollID, func(answer types.UserAnswer) (stop bool) {
-		// Update the results
-		for _, answerIndex := range answer.AnswersIndexes {
-			results[answerIndex]++
-		}
+		ballots = append(ballots, pollBallot{
+			answersIndexes: answer.AnswersIndexes,
+			weight:         k.getAnswerWeight(ctx, poll, answer.User),
+		})
 
 		// Delete the user answer
 		k.DeleteUserAnswer(ctx, answer.SubspaceID, answer.PostID, answer.PollID, answer.User)
@@ -55,12 +81,99 @@ func (k Keeper) Tally(ctx sdk.Context, subspaceID uint64, postID uint64, pollID
 		return false
 	})
 
-	tallyResults := make([]types.PollTallyResults_AnswerResult, len(results))
-	for index, count := range results {
-		tallyResults[int(index)] = types.NewAnswerResult(index, count)
+	if poll.Mode == types.POLL_MODE_RANKED_CHOICE {
+		return tallyRankedChoice(len(poll.ProvidedAnswers), ballots, poll.IsTokenWeighted())
 	}
 
-	return types.NewPollTallyResults(tallyResults)
+	// Create the slices index -> count(votes) and index -> weight(votes)
+	votes := make([]uint64, len(poll.ProvidedAnswers))
+	weights := make([]sdk.Int, len(poll.ProvidedAnswers))
+	for i := range poll.ProvidedAnswers {
+		weights[i] = sdk.ZeroInt()
+	}
+
+	// Update the results
+	for _, ballot := range ballots {
+		for _, answerIndex := range ballot.answersIndexes {
+			votes[answerIndex]++
+			weights[answerIndex] = weights[answerIndex].Add(ballot.weight)
+		}
+	}
+
+	tallyResults := make([]types.PollTallyResults_AnswerResult, len(poll.ProvidedAnswers))
+	for i := range poll.ProvidedAnswers {
+		tallyResults[i] = newAnswerResult(uint32(i), votes[i], weights[i], poll.IsTokenWeighted())
+	}
+
+	return types.NewPollTallyResults(tallyResults, nil)
+}
+
+// newAnswerResult returns a new answer result, including the given weight only if weighted is true
+func newAnswerResult(answerIndex uint32, votes uint64, weight sdk.Int, weighted bool) types.PollTallyResults_AnswerResult {
+	if weighted {
+		return types.NewWeightedAnswerResult(answerIndex, votes, weight)
+	}
+	return types.NewAnswerResult(answerIndex, votes)
+}
+
+// tallyRankedChoice computes the results of a ranked-choice poll with the given number of provided answers using
+// instant-runoff voting. During each round, every ballot counts as a vote for its most preferred answer that is still
+// running. If no answer gets the majority of the votes weight, the one with the lowest weight is eliminated (ties are
+// broken by eliminating the answer with the highest index) and a new round starts. The final results are the ones of
+// the last round.
+func tallyRankedChoice(answersCount int, ballots []pollBallot, weighted bool) *types.PollTallyResults {
+	running := make(map[uint32]bool, answersCount)
+	for i := 0; i < answersCount; i++ {
+		running[uint32(i)] = true
+	}
+
+	var rounds []types.PollTallyResults_Round
+	for {
+		// Count the votes of the running answers
+		votes := make(map[uint32]uint64, len(running))
+		weights := make(map[uint32]sdk.Int, len(running))
+		for answerIndex := range running {
+			weights[answerIndex] = sdk.ZeroInt()
+		}
+
+		totalWeight := sdk.ZeroInt()
+		for _, ballot := range ballots {
+			for _, answerIndex := range ballot.answersIndexes {
+				if running[answerIndex] {
+					votes[answerIndex]++
+					weights[answerIndex] = weights[answerIndex].Add(ballot.weight)
+					totalWeight = totalWeight.Add(ballot.weight)
+					break
+				}
+			}
+		}
+
+		var results []types.PollTallyResults_AnswerResult
+		for i := 0; i < answersCount; i++ {
+			if running[uint32(i)] {
+				results = append(results, newAnswerResult(uint32(i), votes[uint32(i)], weights[uint32(i)], weighted))
+			}
+		}
+		rounds = append(rounds, types.NewPollTallyRound(results))
+
+		if len(results) <= 1 || totalWeight.IsZero() {
+			return types.NewPollTallyResults(results, rounds)
+		}
+
+		// Stop if an answer has the majority, otherwise eliminate the one with the lowest weight
+		eliminated := results[0].AnswerIndex
+		for _, result := range results {
+			weight := weights[result.AnswerIndex]
+			if weight.MulRaw(2).GT(totalWeight) {
+				return types.NewPollTallyResults(results, rounds)
+			}
+
+			if weight.LTE(weights[eliminated]) {
+				eliminated = result.AnswerIndex
+			}
+		}
+		delete(running, eliminated)
+	}
 }
 
 // EndPoll tallies the poll then update it inside storage
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -49,6 +51,11 @@ func (k Keeper) ValidatePostReference(ctx sdk.Context, postOwner string, subspac
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d does not exist", referenceID)
 	}
 
+	// Make sure the referenced post has already been published
+	if k.IsPostPending(ctx, subspaceID, referenceID) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d has not been published yet", referenceID)
+	}
+
 	// Make sure the original owner has not blocked the post owner
 	if k.HasUserBlocked(ctx, referencedPost.Owner, postOwner, subspaceID) {
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "owner of post %d has blocked you", referenceID)
@@ -135,14 +142,25 @@ func (k Keeper) SavePost(ctx sdk.Context, post types.Post) {
 	// Store the post
 	store.Set(types.PostStoreKey(post.SubspaceID, post.ID), k.cdc.MustMarshal(&post))
 
-	// Store the section reference
-	store.Set(types.PostSectionStoreKey(post.SubspaceID, post.SectionID, post.ID), []byte{0x01})
-
 	// If the initial attachment id does not exist, create it now
 	if !k.HasNextAttachmentID(ctx, post.SubspaceID, post.ID) {
 		k.SetNextAttachmentID(ctx, post.SubspaceID, post.ID, 1)
 	}
 
+	// Insert the post inside the expiring posts queue
+	if post.ExpirationTime != nil {
+		store.Set(types.ExpiringPostQueueKey(post.SubspaceID, post.ID, *post.ExpirationTime), types.GetSubspacePostIDBytes(post.SubspaceID, post.ID))
+	}
+
+	// Pending posts are not referenced inside their section nor notified to the hooks until they are published
+	if k.IsPostPending(ctx, post.SubspaceID, post.ID) {
+		k.Logger(ctx).Debug("pending post saved", "subspace id", post.SubspaceID, "id", post.ID)
+		return
+	}
+
+	// Store the section reference
+	store.Set(types.PostSectionStoreKey(post.SubspaceID, post.SectionID, post.ID), []byte{0x01})
+
 	k.Logger(ctx).Debug("post saved", "subspace id", post.SubspaceID, "id", post.ID)
 	k.AfterPostSaved(ctx, post.SubspaceID, post.ID)
 }
@@ -183,6 +201,11 @@ func (k Keeper) DeletePost(ctx sdk.Context, subspaceID uint64, postID uint64) {
 	// Delete the section reference
 	store.Delete(types.PostSectionStoreKey(post.SubspaceID, post.SectionID, post.ID))
 
+	// Remove the post from the expiring posts queue
+	if post.ExpirationTime != nil {
+		store.Delete(types.ExpiringPostQueueKey(post.SubspaceID, post.ID, *post.ExpirationTime))
+	}
+
 	// Delete all the attachments
 	k.IteratePostAttachments(ctx, post.SubspaceID, post.ID, func(attachment types.Attachment) (stop bool) {
 		k.DeleteAttachment(ctx, attachment.SubspaceID, attachment.PostID, attachment.ID)
@@ -192,8 +215,90 @@ func (k Keeper) DeletePost(ctx sdk.Context, subspaceID uint64, postID uint64) {
 	// Delete the attachment id key
 	k.DeleteNextAttachmentID(ctx, post.SubspaceID, post.ID)
 
+	// Delete all the revisions
+	for _, revision := range k.GetPostRevisions(ctx, post.SubspaceID, post.ID) {
+		k.DeletePostRevision(ctx, revision.SubspaceID, revision.PostID, revision.ID)
+	}
+
 	// Delete the post owner transfer request
 	k.DeletePostOwnerTransferRequest(ctx, post.SubspaceID, post.ID)
 
+	// Delete the hidden flag
+	k.UnhidePost(ctx, post.SubspaceID, post.ID)
+
+	// Delete the pending status
+	k.removePostPending(ctx, post.SubspaceID, post.ID)
+
 	k.AfterPostDeleted(ctx, post.SubspaceID, post.ID)
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// HidePost flags the post having the given id as hidden
+func (k Keeper) HidePost(ctx sdk.Context, subspaceID uint64, postID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HiddenPostStoreKey(subspaceID, postID), []byte{0x01})
+}
+
+// IsPostHidden tells whether the post having the given id has been flagged as hidden
+func (k Keeper) IsPostHidden(ctx sdk.Context, subspaceID uint64, postID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.HiddenPostStoreKey(subspaceID, postID))
+}
+
+// UnhidePost removes the hidden flag from the post having the given id
+func (k Keeper) UnhidePost(ctx sdk.Context, subspaceID uint64, postID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.HiddenPostStoreKey(subspaceID, postID))
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SetPostPending flags the post having the given id as pending until the given publish time
+func (k Keeper) SetPostPending(ctx sdk.Context, subspaceID uint64, postID uint64, publishTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingPostStoreKey(subspaceID, postID), sdk.FormatTimeBytes(publishTime))
+	store.Set(types.PendingPostQueueKey(subspaceID, postID, publishTime), types.GetSubspacePostIDBytes(subspaceID, postID))
+}
+
+// IsPostPending tells whether the post having the given id is still waiting to be published
+func (k Keeper) IsPostPending(ctx sdk.Context, subspaceID uint64, postID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PendingPostStoreKey(subspaceID, postID))
+}
+
+// GetPostPublishTime returns the time at which the pending post having the given id will be published.
+// If the post is not pending the function will return an empty time and false.
+func (k Keeper) GetPostPublishTime(ctx sdk.Context, subspaceID uint64, postID uint64) (publishTime time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.PendingPostStoreKey(subspaceID, postID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	publishTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return publishTime, true
+}
+
+// removePostPending removes the pending status from the post having the given id
+func (k Keeper) removePostPending(ctx sdk.Context, subspaceID uint64, postID uint64) {
+	publishTime, found := k.GetPostPublishTime(ctx, subspaceID, postID)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingPostStoreKey(subspaceID, postID))
+	store.Delete(types.PendingPostQueueKey(subspaceID, postID, publishTime))
+}
+
+// PublishPost removes the pending status from the given post and saves it, making it visible to everyone
+func (k Keeper) PublishPost(ctx sdk.Context, post types.Post) {
+	k.removePostPending(ctx, post.SubspaceID, post.ID)
+	k.SavePost(ctx, post)
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/posts/types"
+)
+
+// SetPostRevisionsLimit sets the max number of revisions that are kept for each post of the given subspace
+func (k Keeper) SetPostRevisionsLimit(ctx sdk.Context, subspaceID uint64, limit uint32) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PostRevisionsLimitStoreKey(subspaceID), sdk.Uint64ToBigEndian(uint64(limit)))
+}
+
+// HasPostRevisionsLimit tells whether a custom post revisions limit has been set for the given subspace
+func (k Keeper) HasPostRevisionsLimit(ctx sdk.Context, subspaceID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PostRevisionsLimitStoreKey(subspaceID))
+}
+
+// GetPostRevisionsLimit returns the max number of revisions that are kept for each post of the given subspace.
+// If no custom limit has been set for the subspace, types.DefaultPostRevisionsLimit is returned instead.
+func (k Keeper) GetPostRevisionsLimit(ctx sdk.Context, subspaceID uint64) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PostRevisionsLimitStoreKey(subspaceID))
+	if bz == nil {
+		return types.DefaultPostRevisionsLimit
+	}
+
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// DeletePostRevisionsLimit deletes the custom post revisions limit of the given subspace
+func (k Keeper) DeletePostRevisionsLimit(ctx sdk.Context, subspaceID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PostRevisionsLimitStoreKey(subspaceID))
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SavePostRevision saves the given revision inside the current context
+func (k Keeper) SavePostRevision(ctx sdk.Context, revision types.PostRevision) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PostRevisionStoreKey(revision.SubspaceID, revision.PostID, revision.ID), k.cdc.MustMarshal(&revision))
+
+	k.Logger(ctx).Debug("post revision saved", "subspace id", revision.SubspaceID, "post id", revision.PostID, "id", revision.ID)
+}
+
+// HasPostRevision tells whether the given revision exists or not
+func (k Keeper) HasPostRevision(ctx sdk.Context, subspaceID uint64, postID uint64, revisionID uint32) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PostRevisionStoreKey(subspaceID, postID, revisionID))
+}
+
+// GetPostRevision returns the revision associated with the given id.
+// If there is no revision associated with the given id the function will return an empty revision and false.
+func (k Keeper) GetPostRevision(ctx sdk.Context, subspaceID uint64, postID uint64, revisionID uint32) (revision types.PostRevision, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.PostRevisionStoreKey(subspaceID, postID, revisionID)
+	if !store.Has(key) {
+		return types.PostRevision{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &revision)
+	return revision, true
+}
+
+// DeletePostRevision deletes the given revision from the current context
+func (k Keeper) DeletePostRevision(ctx sdk.Context, subspaceID uint64, postID uint64, revisionID uint32) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PostRevisionStoreKey(subspaceID, postID, revisionID))
+}
+
+// getLastPostRevisionID returns the id of the most recent revision of the given post, or 0 if the post has none
+func (k Keeper) getLastPostRevisionID(ctx sdk.Context, subspaceID uint64, postID uint64) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.PostRevisionsPrefix(subspaceID, postID))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+
+	prefixLen := len(types.PostRevisionsPrefix(subspaceID, postID))
+	return types.GetRevisionIDFromBytes(iterator.Key()[prefixLen:])
+}
+
+// AddPostRevision stores the current contents of the given post as a new revision, edited by the given editor
+// at the given time. Once the number of stored revisions exceeds the limit set for the post subspace, the
+// oldest revisions are deleted. If the limit is set to 0, no revision is stored.
+func (k Keeper) AddPostRevision(ctx sdk.Context, post types.Post, editor string, editDate time.Time) {
+	limit := k.GetPostRevisionsLimit(ctx, post.SubspaceID)
+	if limit == 0 {
+		return
+	}
+
+	revisionID := k.getLastPostRevisionID(ctx, post.SubspaceID, post.ID) + 1
+	k.SavePostRevision(ctx, types.NewPostRevision(
+		post.SubspaceID,
+		post.ID,
+		revisionID,
+		post.Text,
+		post.Entities,
+		post.Tags,
+		editor,
+		editDate,
+	))
+
+	// Prune the oldest revisions exceeding the limit
+	if revisionID > limit {
+		k.deletePostRevisionsBefore(ctx, post.SubspaceID, post.ID, revisionID-limit+1)
+	}
+}
+
+// deletePostRevisionsBefore deletes all the revisions of the given post having an id lower than the given one.
+// Since revisions are stored by ascending id, only the revisions that are going to be deleted are iterated
+func (k Keeper) deletePostRevisionsBefore(ctx sdk.Context, subspaceID uint64, postID uint64, revisionID uint32) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.PostRevisionsPrefix(subspaceID, postID),
+		types.PostRevisionStoreKey(subspaceID, postID, revisionID),
+	)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
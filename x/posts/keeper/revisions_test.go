@@ -0,0 +1,599 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/posts/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SetPostRevisionsLimit() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		limit      uint32
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing limit is set properly",
+			subspaceID: 1,
+			limit:      5,
+			check: func(ctx sdk.Context) {
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().Equal(uint64(5), sdk.BigEndianToUint64(store.Get(types.PostRevisionsLimitStoreKey(1))))
+			},
+		},
+		{
+			name: "existing limit is overridden properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetPostRevisionsLimit(ctx, 1, 5)
+			},
+			subspaceID: 1,
+			limit:      0,
+			check: func(ctx sdk.Context) {
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().Equal(uint64(0), sdk.BigEndianToUint64(store.Get(types.PostRevisionsLimitStoreKey(1))))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SetPostRevisionsLimit(ctx, tc.subspaceID, tc.limit)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_HasPostRevisionsLimit() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		expResult  bool
+	}{
+		{
+			name:       "non existing limit returns false",
+			subspaceID: 1,
+			expResult:  false,
+		},
+		{
+			name: "existing limit returns true",
+			store: func(ctx sdk.Context) {
+				suite.k.SetPostRevisionsLimit(ctx, 1, 0)
+			},
+			subspaceID: 1,
+			expResult:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			result := suite.k.HasPostRevisionsLimit(ctx, tc.subspaceID)
+			suite.Require().Equal(tc.expResult, result)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetPostRevisionsLimit() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		expLimit   uint32
+	}{
+		{
+			name:       "non existing limit returns the default value",
+			subspaceID: 1,
+			expLimit:   types.DefaultPostRevisionsLimit,
+		},
+		{
+			name: "existing limit is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetPostRevisionsLimit(ctx, 1, 3)
+			},
+			subspaceID: 1,
+			expLimit:   3,
+		},
+		{
+			name: "zero limit is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetPostRevisionsLimit(ctx, 1, 0)
+			},
+			subspaceID: 1,
+			expLimit:   0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			limit := suite.k.GetPostRevisionsLimit(ctx, tc.subspaceID)
+			suite.Require().Equal(tc.expLimit, limit)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeletePostRevisionsLimit() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing limit is deleted properly",
+			subspaceID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasPostRevisionsLimit(ctx, 1))
+			},
+		},
+		{
+			name: "existing limit is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetPostRevisionsLimit(ctx, 1, 3)
+			},
+			subspaceID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasPostRevisionsLimit(ctx, 1))
+				suite.Require().Equal(types.DefaultPostRevisionsLimit, suite.k.GetPostRevisionsLimit(ctx, 1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeletePostRevisionsLimit(ctx, tc.subspaceID)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (suite *KeeperTestSuite) TestKeeper_SavePostRevision() {
+	testCases := []struct {
+		name     string
+		store    func(ctx sdk.Context)
+		revision types.PostRevision
+		check    func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing revision is stored properly",
+			revision: types.NewPostRevision(
+				1,
+				1,
+				1,
+				"This is the original text",
+				nil,
+				nil,
+				"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetPostRevision(ctx, 1, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPostRevision(
+					1,
+					1,
+					1,
+					"This is the original text",
+					nil,
+					nil,
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				), stored)
+			},
+		},
+		{
+			name: "existing revision is overridden properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SavePostRevision(ctx, types.NewPostRevision(
+					1,
+					1,
+					1,
+					"This is the original text",
+					nil,
+					nil,
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			revision: types.NewPostRevision(
+				1,
+				1,
+				1,
+				"This is another text",
+				nil,
+				[]string{"general"},
+				"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+				time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetPostRevision(ctx, 1, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPostRevision(
+					1,
+					1,
+					1,
+					"This is another text",
+					nil,
+					[]string{"general"},
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC),
+				), stored)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SavePostRevision(ctx, tc.revision)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_HasPostRevision() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		postID     uint64
+		revisionID uint32
+		expResult  bool
+	}{
+		{
+			name:       "non existing revision returns false",
+			subspaceID: 1,
+			postID:     1,
+			revisionID: 1,
+			expResult:  false,
+		},
+		{
+			name: "existing revision returns true",
+			store: func(ctx sdk.Context) {
+				suite.k.SavePostRevision(ctx, types.NewPostRevision(
+					1,
+					1,
+					1,
+					"This is the original text",
+					nil,
+					nil,
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			subspaceID: 1,
+			postID:     1,
+			revisionID: 1,
+			expResult:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			result := suite.k.HasPostRevision(ctx, tc.subspaceID, tc.postID, tc.revisionID)
+			suite.Require().Equal(tc.expResult, result)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetPostRevision() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		subspaceID  uint64
+		postID      uint64
+		revisionID  uint32
+		expFound    bool
+		expRevision types.PostRevision
+	}{
+		{
+			name:        "non existing revision returns false",
+			subspaceID:  1,
+			postID:      1,
+			revisionID:  1,
+			expFound:    false,
+			expRevision: types.PostRevision{},
+		},
+		{
+			name: "existing revision is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SavePostRevision(ctx, types.NewPostRevision(
+					1,
+					1,
+					1,
+					"This is the original text",
+					nil,
+					nil,
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			subspaceID: 1,
+			postID:     1,
+			revisionID: 1,
+			expFound:   true,
+			expRevision: types.NewPostRevision(
+				1,
+				1,
+				1,
+				"This is the original text",
+				nil,
+				nil,
+				"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			revision, found := suite.k.GetPostRevision(ctx, tc.subspaceID, tc.postID, tc.revisionID)
+			suite.Require().Equal(tc.expFound, found)
+			suite.Require().Equal(tc.expRevision, revision)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeletePostRevision() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		postID     uint64
+		revisionID uint32
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing revision is deleted properly",
+			subspaceID: 1,
+			postID:     1,
+			revisionID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasPostRevision(ctx, 1, 1, 1))
+			},
+		},
+		{
+			name: "existing revision is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SavePostRevision(ctx, types.NewPostRevision(
+					1,
+					1,
+					1,
+					"This is the original text",
+					nil,
+					nil,
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			subspaceID: 1,
+			postID:     1,
+			revisionID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasPostRevision(ctx, 1, 1, 1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeletePostRevision(ctx, tc.subspaceID, tc.postID, tc.revisionID)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_AddPostRevision() {
+	post := types.NewPost(
+		1,
+		0,
+		1,
+		"External ID",
+		"This is the current text",
+		"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+		0,
+		nil,
+		[]string{"general"},
+		nil,
+		types.REPLY_SETTING_EVERYONE,
+		time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+		nil,
+		"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+		nil,
+	)
+
+	testCases := []struct {
+		name     string
+		store    func(ctx sdk.Context)
+		post     types.Post
+		editor   string
+		editDate time.Time
+		check    func(ctx sdk.Context)
+	}{
+		{
+			name:     "first revision is stored properly",
+			post:     post,
+			editor:   "cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+			editDate: time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC),
+			check: func(ctx sdk.Context) {
+				suite.Require().Equal([]types.PostRevision{
+					types.NewPostRevision(
+						1,
+						1,
+						1,
+						"This is the current text",
+						nil,
+						[]string{"general"},
+						"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+						time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC),
+					),
+				}, suite.k.GetPostRevisions(ctx, 1, 1))
+			},
+		},
+		{
+			name: "new revision gets the next id",
+			store: func(ctx sdk.Context) {
+				suite.k.SavePostRevision(ctx, types.NewPostRevision(
+					1,
+					1,
+					3,
+					"This is the original text",
+					nil,
+					nil,
+					"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+					time.Date(2020, 6, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			post:     post,
+			editor:   "cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+			editDate: time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC),
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.HasPostRevision(ctx, 1, 1, 3))
+
+				revision, found := suite.k.GetPostRevision(ctx, 1, 1, 4)
+				suite.Require().True(found)
+				suite.Require().Equal("This is the current text", revision.Text)
+			},
+		},
+		{
+			name: "oldest revisions are pruned when the limit is exceeded",
+			store: func(ctx sdk.Context) {
+				suite.k.SetPostRevisionsLimit(ctx, 1, 2)
+				for i := uint32(1); i <= 3; i++ {
+					suite.k.SavePostRevision(ctx, types.NewPostRevision(
+						1,
+						1,
+						i,
+						"This is an old text",
+						nil,
+						nil,
+						"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+						time.Date(2020, 6, 1, 12, 00, 00, 000, time.UTC),
+					))
+				}
+			},
+			post:     post,
+			editor:   "cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+			editDate: time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC),
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasPostRevision(ctx, 1, 1, 1))
+				suite.Require().False(suite.k.HasPostRevision(ctx, 1, 1, 2))
+				suite.Require().True(suite.k.HasPostRevision(ctx, 1, 1, 3))
+				suite.Require().True(suite.k.HasPostRevision(ctx, 1, 1, 4))
+			},
+		},
+		{
+			name: "lowering the limit prunes all the exceeding revisions at once",
+			store: func(ctx sdk.Context) {
+				suite.k.SetPostRevisionsLimit(ctx, 1, 1)
+				for _, id := range []uint32{5, 7, 9} {
+					suite.k.SavePostRevision(ctx, types.NewPostRevision(
+						1,
+						1,
+						id,
+						"This is an old text",
+						nil,
+						nil,
+						"cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+						time.Date(2020, 6, 1, 12, 00, 00, 000, time.UTC),
+					))
+				}
+			},
+			post:     post,
+			editor:   "cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+			editDate: time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC),
+			check: func(ctx sdk.Context) {
+				revisions := suite.k.GetPostRevisions(ctx, 1, 1)
+				suite.Require().Len(revisions, 1)
+				suite.Require().Equal(uint32(10), revisions[0].ID)
+			},
+		},
+		{
+			name: "no revision is stored when the limit is zero",
+			store: func(ctx sdk.Context) {
+				suite.k.SetPostRevisionsLimit(ctx, 1, 0)
+			},
+			post:     post,
+			editor:   "cosmos1r9jamre0x0qqy562rhhckt6sryztwhnvhafyz4",
+			editDate: time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC),
+			check: func(ctx sdk.Context) {
+				suite.Require().Empty(suite.k.GetPostRevisions(ctx, 1, 1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.AddPostRevision(ctx, tc.post, tc.editor, tc.editDate)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
@@ -43,9 +43,12 @@ func TestMigrateStore(t *testing.T) {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					false,
 					false,
+					types.POLL_MODE_PLURALITY,
+					0,
+					nil,
 					types.NewPollTallyResults([]types.PollTallyResults_AnswerResult{
 						types.NewAnswerResult(0, 1),
-					}),
+					}, nil),
 				))
 
 				userAnswer := types.NewUserAnswer(
@@ -76,9 +79,12 @@ func TestMigrateStore(t *testing.T) {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					false,
 					false,
+					types.POLL_MODE_PLURALITY,
+					0,
+					nil,
 					types.NewPollTallyResults([]types.PollTallyResults_AnswerResult{
 						types.NewAnswerResult(0, 1),
-					}),
+					}, nil),
 				)), stored)
 
 				require.False(t, store.Has(types.PollAnswerStoreKey(1, 1, 1, "cosmos1jseuux3pktht0kkhlcsv4kqff3mql65udqs4jw")))
@@ -96,6 +102,9 @@ func TestMigrateStore(t *testing.T) {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					false,
 					false,
+					types.POLL_MODE_PLURALITY,
+					0,
+					nil,
 					nil,
 				))
 
@@ -127,6 +136,9 @@ func TestMigrateStore(t *testing.T) {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					false,
 					false,
+					types.POLL_MODE_PLURALITY,
+					0,
+					nil,
 					nil,
 				)), stored)
 
@@ -60,7 +60,7 @@ func migrateAttachments(store sdk.KVStore, cdc codec.BinaryCodec) error {
 
 // migrateMedia migrates the given media to the new format
 func migrateMedia(media *v4.Media) *types.Media {
-	return types.NewMedia(media.Uri, media.MimeType)
+	return types.NewMedia(media.Uri, media.MimeType, nil, 0)
 }
 
 // migratePoll migrates the given poll to the new format
@@ -81,6 +81,9 @@ func migratePoll(poll *v4.Poll, cdc codec.BinaryCodec) (*types.Poll, error) {
 		poll.EndDate,
 		poll.AllowsMultipleAnswers,
 		poll.AllowsAnswerEdits,
+		types.POLL_MODE_PLURALITY,
+		0,
+		nil,
 		migratePollFinalTallyResults(poll.FinalTallyResults),
 	), nil
 }
@@ -103,7 +106,7 @@ func migrateProvidedAnswerAttachments(attachments []v4.Attachment, cdc codec.Bin
 
 		// Convert the media only
 		if media, isMedia := content.(*v4.Media); isMedia {
-			attachmentContents = append(attachmentContents, types.NewMedia(media.Uri, media.MimeType))
+			attachmentContents = append(attachmentContents, types.NewMedia(media.Uri, media.MimeType, nil, 0))
 		}
 	}
 
@@ -120,5 +123,5 @@ func migratePollFinalTallyResults(results *v4.PollTallyResults) *types.PollTally
 	for i, result := range results.Results {
 		answersResults[i] = types.NewAnswerResult(result.AnswerIndex, result.Votes)
 	}
-	return types.NewPollTallyResults(answersResults)
+	return types.NewPollTallyResults(answersResults, nil)
 }
@@ -46,6 +46,7 @@ func TestMigrate(t *testing.T) {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 					"",
+					nil,
 				)
 				store.Set(types.PostStoreKey(post.SubspaceID, post.ID), cdc.MustMarshal(&post))
 			},
@@ -73,6 +74,7 @@ func TestMigrate(t *testing.T) {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 					"cosmos19mkklc8arp6phlg5eydu3v49syyqyfrq2sp4at",
+					nil,
 				), stored)
 			},
 		},
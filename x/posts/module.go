@@ -304,6 +304,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.ProfilesKeeper,
 		in.SubspacesKeeper,
 		in.RelationshipsKeeper,
+		in.BankKeeper,
 		authority.String(),
 	)
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/desmos-labs/desmos/v7/x/posts/types"
@@ -27,6 +28,45 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &postB)
 			return fmt.Sprintf("PostA: %s\nPostB: %s\n", &postA, &postB)
 
+		case bytes.HasPrefix(kvA.Key, types.HiddenPostPrefix):
+			subspaceIDA, postIDA := types.SplitHiddenPostStoreKey(kvA.Key)
+			subspaceIDB, postIDB := types.SplitHiddenPostStoreKey(kvB.Key)
+			return fmt.Sprintf("HiddenPostA: %d, %d\nHiddenPostB: %d, %d\n", subspaceIDA, postIDA, subspaceIDB, postIDB)
+
+		case bytes.HasPrefix(kvA.Key, types.PendingPostPrefix):
+			publishTimeA, err := sdk.ParseTimeBytes(kvA.Value)
+			if err != nil {
+				panic(err)
+			}
+			publishTimeB, err := sdk.ParseTimeBytes(kvB.Value)
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("PublishTimeA: %s\nPublishTimeB: %s\n", publishTimeA, publishTimeB)
+
+		case bytes.HasPrefix(kvA.Key, types.PendingPostQueuePrefix):
+			subspaceIDA, postIDA := types.GetSubspacePostIDFromBytes(kvA.Value)
+			subspaceIDB, postIDB := types.GetSubspacePostIDFromBytes(kvB.Value)
+			return fmt.Sprintf("SubspaceIDA: %d, PostIDA: %d\nSubspaceIDB: %d, PostIDB: %d\n",
+				subspaceIDA, postIDA, subspaceIDB, postIDB)
+
+		case bytes.HasPrefix(kvA.Key, types.ExpiringPostQueuePrefix):
+			subspaceIDA, postIDA := types.GetSubspacePostIDFromBytes(kvA.Value)
+			subspaceIDB, postIDB := types.GetSubspacePostIDFromBytes(kvB.Value)
+			return fmt.Sprintf("SubspaceIDA: %d, PostIDA: %d\nSubspaceIDB: %d, PostIDB: %d\n",
+				subspaceIDA, postIDA, subspaceIDB, postIDB)
+
+		case bytes.HasPrefix(kvA.Key, types.PostRevisionPrefix):
+			var revisionA, revisionB types.PostRevision
+			cdc.MustUnmarshal(kvA.Value, &revisionA)
+			cdc.MustUnmarshal(kvB.Value, &revisionB)
+			return fmt.Sprintf("PostRevisionA: %s\nPostRevisionB: %s\n", &revisionA, &revisionB)
+
+		case bytes.HasPrefix(kvA.Key, types.PostRevisionsLimitPrefix):
+			limitA := sdk.BigEndianToUint64(kvA.Value)
+			limitB := sdk.BigEndianToUint64(kvB.Value)
+			return fmt.Sprintf("PostRevisionsLimitA: %d\nPostRevisionsLimitB: %d\n", limitA, limitB)
+
 		case bytes.HasPrefix(kvA.Key, types.NextAttachmentIDPrefix):
 			var idA, idB uint32
 			idA = types.GetAttachmentIDFromBytes(kvA.Value)
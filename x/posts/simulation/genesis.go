@@ -21,7 +21,7 @@ func RandomizeGenState(simState *module.SimulationState) {
 	var subspacesGenesis subspacestypes.GenesisState
 	simState.Cdc.MustUnmarshalJSON(subspacesGenesisBz, &subspacesGenesis)
 
-	params := types.NewParams(RandomMaxTextLength(simState.Rand))
+	params := types.NewParams(RandomMaxTextLength(simState.Rand), RandomMaxMediaSize(simState.Rand))
 	posts := randomPosts(simState.Rand, subspacesGenesis.Subspaces, simState.Accounts, params)
 	subspacesDataEntries := getSubspacesData(posts)
 	attachments := randomAttachments(simState.Rand, posts)
@@ -40,6 +40,10 @@ func RandomizeGenState(simState *module.SimulationState) {
 		userAnswers,
 		params,
 		postOwnerTransferRequests,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(postsGenesis)
 }
@@ -31,6 +31,8 @@ const (
 	DefaultWeightMsgCancelPostOwnerTransferRequest int = 10
 	DefaultWeightMsgAcceptPostOwnerTransferRequest int = 10
 	DefaultWeightMsgRefusePostOwnerTransferRequest int = 10
+	DefaultWeightMsgUnhidePost                     int = 10
+	DefaultWeightMsgSetPostRevisionsLimit          int = 10
 
 	OpWeightMsgCreatePost                     = "op_weight_msg_create_post"
 	OpWeightMsgEditPost                       = "op_weight_msg_edit_post"
@@ -43,6 +45,8 @@ const (
 	OpWeightMsgCancelPostOwnerTransferRequest = "op_weight_msg_cancel_post_owner_transfer_request"
 	OpWeightMsgAcceptPostOwnerTransferRequest = "op_weight_msg_accept_post_owner_transfer_request"
 	OpWeightMsgRefusePostOwnerTransferRequest = "op_weight_msg_refuse_post_owner_transfer_request"
+	OpWeightMsgUnhidePost                     = "op_weight_msg_unhide_post"
+	OpWeightMsgSetPostRevisionsLimit          = "op_weight_msg_set_post_revisions_limit"
 
 	DefaultGasValue = 200000
 )
@@ -129,6 +133,20 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgUnhidePost int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUnhidePost, &weightMsgUnhidePost, nil,
+		func(r *rand.Rand) {
+			weightMsgUnhidePost = DefaultWeightMsgUnhidePost
+		},
+	)
+
+	var weightMsgSetPostRevisionsLimit int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetPostRevisionsLimit, &weightMsgSetPostRevisionsLimit, nil,
+		func(r *rand.Rand) {
+			weightMsgSetPostRevisionsLimit = DefaultWeightMsgSetPostRevisionsLimit
+		},
+	)
+
 	return sim.WeightedOperations{
 		sim.NewWeightedOperation(
 			weightMsgCreatePost,
@@ -174,5 +192,13 @@ func WeightedOperations(
 			weightMsgRefusePostOwnerTransferRequest,
 			SimulateMsgRefusePostOwnerTransferRequest(k, ak, bk),
 		),
+		sim.NewWeightedOperation(
+			weightMsgUnhidePost,
+			SimulateMsgUnhidePost(k, sk, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgSetPostRevisionsLimit,
+			SimulateMsgSetPostRevisionsLimit(k, sk, ak, bk),
+		),
 	}
 }
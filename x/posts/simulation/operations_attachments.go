@@ -58,6 +58,11 @@ func randomAddPostAttachmentFields(
 		return
 	}
 	post := RandomPost(r, posts)
+	if k.IsSubspaceArchived(ctx, post.SubspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 	subspaceID = post.SubspaceID
 	postID = post.ID
 
@@ -122,6 +127,11 @@ func randomRemovePostAttachmentFields(
 		return
 	}
 	post := RandomPost(r, posts)
+	if sk.IsSubspaceArchived(ctx, post.SubspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 	subspaceID = post.SubspaceID
 	sectionID := post.SectionID
 	postID = post.ID
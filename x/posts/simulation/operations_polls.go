@@ -65,6 +65,11 @@ func randomAnswerPollFields(
 
 	// Get a random poll
 	poll := RandomAttachment(r, polls)
+	if sk.IsSubspaceArchived(ctx, poll.SubspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 	if content, ok := poll.Content.GetCachedValue().(*types.Poll); ok && content.EndDate.Before(time.Now().Add(time.Minute*1)) {
 		// Skip because the poll voting period has already ended
 		skip = true
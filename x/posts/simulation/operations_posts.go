@@ -46,6 +46,8 @@ func SimulateMsgCreatePost(
 			data.Tags,
 			nil,
 			data.ReferencedPosts,
+			nil,
+			nil,
 			author.Address.String(),
 		)
 		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, author)
@@ -70,6 +72,11 @@ func randomPostCreateFields(
 		return
 	}
 	section := subspacessim.RandomSection(r, sections)
+	if sk.IsSubspaceArchived(ctx, section.SubspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get an author
 	users := sk.GetUsersWithRootPermissions(ctx, section.SubspaceID, subspacestypes.NewPermissions(types.PermissionWrite))
@@ -142,6 +149,11 @@ func randomPostEditFields(
 		return
 	}
 	post := RandomPost(r, posts)
+	if sk.IsSubspaceArchived(ctx, post.SubspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 	subspaceID = post.SubspaceID
 	sectionID := post.SectionID
 	postID = post.ID
@@ -211,6 +223,11 @@ func randomPostDeleteFields(
 		return
 	}
 	post := RandomPost(r, posts)
+	if sk.IsSubspaceArchived(ctx, post.SubspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 	subspaceID = post.SubspaceID
 	sectionID := post.SectionID
 	postID = post.ID
@@ -240,6 +257,136 @@ func randomPostDeleteFields(
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// SimulateMsgUnhidePost tests and runs a single msg unhide post
+func SimulateMsgUnhidePost(
+	k *keeper.Keeper, sk types.SubspacesKeeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+
+		subspaceID, postID, moderator, skip := randomPostUnhideFields(r, ctx, accs, k, sk)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "unhide post", "skip"), nil, nil
+		}
+
+		msg := types.NewMsgUnhidePost(subspaceID, postID, moderator.Address.String())
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, moderator)
+	}
+}
+
+// randomPostUnhideFields returns the data needed to unhide a post
+func randomPostUnhideFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper, sk types.SubspacesKeeper,
+) (subspaceID uint64, postID uint64, user simtypes.Account, skip bool) {
+	if len(accs) == 0 {
+		// Skip because there are no accounts
+		skip = true
+		return
+	}
+
+	// Get a random hidden post
+	var hiddenPosts []types.HiddenPostEntry
+	k.IterateHiddenPosts(ctx, func(subspaceID uint64, postID uint64) (stop bool) {
+		hiddenPosts = append(hiddenPosts, types.NewHiddenPostEntry(subspaceID, postID))
+		return false
+	})
+	if len(hiddenPosts) == 0 {
+		// Skip because there are no hidden posts
+		skip = true
+		return
+	}
+	hiddenPost := hiddenPosts[r.Intn(len(hiddenPosts))]
+	subspaceID = hiddenPost.SubspaceID
+	postID = hiddenPost.PostID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
+
+	// Get a moderator
+	moderators := sk.GetUsersWithRootPermissions(ctx, subspaceID, subspacestypes.NewPermissions(types.PermissionModerateContent))
+	moderatorAcc := subspacessim.GetAccount(subspacessim.RandomAddress(r, moderators), accs)
+	if moderatorAcc == nil {
+		// Skip because the moderator is not an account we have access to
+		skip = true
+		return
+	}
+	user = *moderatorAcc
+
+	return subspaceID, postID, user, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgSetPostRevisionsLimit tests and runs a single msg set post revisions limit
+func SimulateMsgSetPostRevisionsLimit(
+	k *keeper.Keeper, sk types.SubspacesKeeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+
+		subspaceID, limit, moderator, skip := randomSetPostRevisionsLimitFields(r, ctx, accs, sk)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "set post revisions limit", "skip"), nil, nil
+		}
+
+		msg := types.NewMsgSetPostRevisionsLimit(subspaceID, limit, moderator.Address.String())
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, moderator)
+	}
+}
+
+// randomSetPostRevisionsLimitFields returns the data needed to set the post revisions limit of a subspace
+func randomSetPostRevisionsLimitFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, sk types.SubspacesKeeper,
+) (subspaceID uint64, limit uint32, user simtypes.Account, skip bool) {
+	if len(accs) == 0 {
+		// Skip because there are no accounts
+		skip = true
+		return
+	}
+
+	// Get a random subspace
+	var subspaces []subspacestypes.Subspace
+	sk.IterateSubspaces(ctx, func(subspace subspacestypes.Subspace) (stop bool) {
+		subspaces = append(subspaces, subspace)
+		return false
+	})
+	if len(subspaces) == 0 {
+		// Skip because there are no subspaces
+		skip = true
+		return
+	}
+	subspace := subspacessim.RandomSubspace(r, subspaces)
+	if sk.IsSubspaceArchived(ctx, subspace.ID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
+	subspaceID = subspace.ID
+
+	// Get a random limit
+	limit = uint32(r.Intn(20))
+
+	// Get a moderator
+	moderators := sk.GetUsersWithRootPermissions(ctx, subspaceID, subspacestypes.NewPermissions(types.PermissionModerateContent))
+	moderatorAcc := subspacessim.GetAccount(subspacessim.RandomAddress(r, moderators), accs)
+	if moderatorAcc == nil {
+		// Skip because the moderator is not an account we have access to
+		skip = true
+		return
+	}
+	user = *moderatorAcc
+
+	return subspaceID, limit, user, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // SimulateMsgMovePost tests and runs a single msg move post
 func SimulateMsgMovePost(
 	k *keeper.Keeper, sk types.SubspacesKeeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
@@ -277,6 +424,11 @@ func randomPostMoveFields(
 		return
 	}
 	section := subspacessim.RandomSection(r, sections)
+	if sk.IsSubspaceArchived(ctx, section.SubspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a random post
 	posts := k.GetPosts(ctx)
@@ -286,6 +438,11 @@ func randomPostMoveFields(
 		return
 	}
 	post := RandomPost(r, posts)
+	if sk.IsSubspaceArchived(ctx, post.SubspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	if post.SubspaceID == section.SubspaceID {
 		// Skip because moved post is already inside the subspace
@@ -36,7 +36,7 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	// use the default gov module account address as authority
 	var authority sdk.AccAddress = address.Module("gov")
 
-	params := types.NewParams(RandomMaxTextLength(r))
+	params := types.NewParams(RandomMaxTextLength(r), RandomMaxMediaSize(r))
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
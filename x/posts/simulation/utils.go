@@ -3,7 +3,9 @@ package simulation
 // DONTCOVER
 
 import (
+	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -18,6 +20,11 @@ var (
 		"image/gif",
 		"video/mp4",
 	}
+	pollModes = []types.PollMode{
+		types.POLL_MODE_PLURALITY,
+		types.POLL_MODE_RANKED_CHOICE,
+		types.POLL_MODE_APPROVAL,
+	}
 	allowedReplySettings = []types.ReplySetting{
 		types.REPLY_SETTING_EVERYONE,
 		types.REPLY_SETTING_MENTIONS,
@@ -45,6 +52,7 @@ func GenerateRandomPost(r *rand.Rand, accounts []simtypes.Account, subspaceID ui
 		time.Now(),
 		nil,
 		owner.Address.String(),
+		nil,
 	)
 }
 
@@ -89,14 +97,33 @@ func GenerateRandomAttachmentContent(r *rand.Rand, currentTime time.Time) types.
 		return GenerateRandomPoll(r, currentTime)
 	}
 
+	// 25% of being a link preview
+	if r.Intn(101) < 50 {
+		return GenerateRandomLinkPreview(r)
+	}
+
 	return GenerateRandomMedia(r)
 }
 
+// GenerateRandomLinkPreview returns a randomly generated link preview content
+func GenerateRandomLinkPreview(r *rand.Rand) *types.LinkPreview {
+	siteURL := fmt.Sprintf("https://%s.com", strings.ToLower(simtypes.RandStringOfLength(r, 10)))
+	return types.NewLinkPreview(
+		siteURL,
+		GenerateRandomText(r, 50),
+		GenerateRandomText(r, 100),
+		fmt.Sprintf("%s/image.png", siteURL),
+		GenerateRandomText(r, 20),
+	)
+}
+
 // GenerateRandomMedia returns a randomly generated media content
 func GenerateRandomMedia(r *rand.Rand) *types.Media {
 	return types.NewMedia(
 		GenerateRandomText(r, 50),
 		mediaTypes[r.Intn(len(mediaTypes))],
+		nil,
+		0,
 	)
 }
 
@@ -108,18 +135,29 @@ func GenerateRandomPoll(r *rand.Rand, currentTime time.Time) *types.Poll {
 		answers[index] = types.NewProvidedAnswer(GenerateRandomText(r, 10), nil)
 	}
 
-	// 50% of accepting multiple answer edits
-	acceptsMultipleAnswers := r.Intn(101) < 50
-
 	// 50% of allowing answers edits
 	allowAnswerEdits := r.Intn(101) < 50
 
+	mode := pollModes[r.Intn(len(pollModes))]
+
+	// 50% of accepting multiple answers, only for plurality polls
+	acceptsMultipleAnswers := mode == types.POLL_MODE_PLURALITY && r.Intn(101) < 50
+
+	// Approval polls require a max selections number
+	var maxSelections uint32
+	if mode == types.POLL_MODE_APPROVAL {
+		maxSelections = uint32(r.Intn(answersNumber)) + 1
+	}
+
 	return types.NewPoll(
 		GenerateRandomText(r, 30),
 		answers,
 		currentTime.Add(30*24*time.Hour),
 		acceptsMultipleAnswers,
 		allowAnswerEdits,
+		mode,
+		maxSelections,
+		nil,
 		nil,
 	)
 }
@@ -132,8 +170,11 @@ func RandomAttachment(r *rand.Rand, attachments []types.Attachment) types.Attach
 // RandomAnswersIndexes returns a random answers indexes slice based on the given poll
 func RandomAnswersIndexes(r *rand.Rand, poll *types.Poll) (answersIndexes []uint32) {
 	maxAnswersNumber := 1
-	if poll.AllowsMultipleAnswers {
+	switch {
+	case poll.AllowsMultipleAnswers, poll.Mode == types.POLL_MODE_RANKED_CHOICE:
 		maxAnswersNumber = r.Intn(len(poll.ProvidedAnswers)) + 1
+	case poll.Mode == types.POLL_MODE_APPROVAL:
+		maxAnswersNumber = r.Intn(int(poll.MaxSelections)) + 1
 	}
 
 	// Generate some answer indexes
@@ -159,6 +200,11 @@ func RandomMaxTextLength(r *rand.Rand) uint32 {
 	return uint32(r.Intn(950)) + 30
 }
 
+// RandomMaxMediaSize returns a random max media size in the [1 MiB, 1 GiB] range
+func RandomMaxMediaSize(r *rand.Rand) uint64 {
+	return uint64(r.Int63n(1023*1024*1024)) + 1024*1024
+}
+
 // RandomPostOwnerTransferRequest returns a random post owner transfer request from the ones given
 func RandomPostOwnerTransferRequest(r *rand.Rand, requests []types.PostOwnerTransferRequest) types.PostOwnerTransferRequest {
 	return requests[r.Intn(len(requests))]
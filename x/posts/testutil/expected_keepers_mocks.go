@@ -73,6 +73,34 @@ func (m *MockSubspacesKeeper) EXPECT() *MockSubspacesKeeperMockRecorder {
 	return m.recorder
 }
 
+// CanUserViewSection mocks base method.
+func (m *MockSubspacesKeeper) CanUserViewSection(ctx types.Context, subspaceID uint64, sectionID uint32, user string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanUserViewSection", ctx, subspaceID, sectionID, user)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanUserViewSection indicates an expected call of CanUserViewSection.
+func (mr *MockSubspacesKeeperMockRecorder) CanUserViewSection(ctx, subspaceID, sectionID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanUserViewSection", reflect.TypeOf((*MockSubspacesKeeper)(nil).CanUserViewSection), ctx, subspaceID, sectionID, user)
+}
+
+// ConsumeMsgRateLimits mocks base method.
+func (m *MockSubspacesKeeper) ConsumeMsgRateLimits(ctx types.Context, msg types0.SubspaceMsg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeMsgRateLimits", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeMsgRateLimits indicates an expected call of ConsumeMsgRateLimits.
+func (mr *MockSubspacesKeeperMockRecorder) ConsumeMsgRateLimits(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeMsgRateLimits", reflect.TypeOf((*MockSubspacesKeeper)(nil).ConsumeMsgRateLimits), ctx, msg)
+}
+
 // GetAllSections mocks base method.
 func (m *MockSubspacesKeeper) GetAllSections(ctx types.Context) []types0.Section {
 	m.ctrl.T.Helper()
@@ -143,6 +171,48 @@ func (mr *MockSubspacesKeeperMockRecorder) HasSubspace(ctx, subspaceID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSubspace", reflect.TypeOf((*MockSubspacesKeeper)(nil).HasSubspace), ctx, subspaceID)
 }
 
+// HasUserGroup mocks base method.
+func (m *MockSubspacesKeeper) HasUserGroup(ctx types.Context, subspaceID uint64, groupID uint32) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasUserGroup", ctx, subspaceID, groupID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasUserGroup indicates an expected call of HasUserGroup.
+func (mr *MockSubspacesKeeperMockRecorder) HasUserGroup(ctx, subspaceID, groupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasUserGroup", reflect.TypeOf((*MockSubspacesKeeper)(nil).HasUserGroup), ctx, subspaceID, groupID)
+}
+
+// IsMemberOfGroup mocks base method.
+func (m *MockSubspacesKeeper) IsMemberOfGroup(ctx types.Context, subspaceID uint64, groupID uint32, user string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMemberOfGroup", ctx, subspaceID, groupID, user)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsMemberOfGroup indicates an expected call of IsMemberOfGroup.
+func (mr *MockSubspacesKeeperMockRecorder) IsMemberOfGroup(ctx, subspaceID, groupID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMemberOfGroup", reflect.TypeOf((*MockSubspacesKeeper)(nil).IsMemberOfGroup), ctx, subspaceID, groupID, user)
+}
+
+// IsSubspaceArchived mocks base method.
+func (m *MockSubspacesKeeper) IsSubspaceArchived(ctx types.Context, subspaceID uint64) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSubspaceArchived", ctx, subspaceID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsSubspaceArchived indicates an expected call of IsSubspaceArchived.
+func (mr *MockSubspacesKeeperMockRecorder) IsSubspaceArchived(ctx, subspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSubspaceArchived", reflect.TypeOf((*MockSubspacesKeeper)(nil).IsSubspaceArchived), ctx, subspaceID)
+}
+
 // IterateSubspaces mocks base method.
 func (m *MockSubspacesKeeper) IterateSubspaces(ctx types.Context, fn func(types0.Subspace) bool) {
 	m.ctrl.T.Helper()
@@ -205,3 +275,40 @@ func (mr *MockRelationshipsKeeperMockRecorder) HasUserBlocked(ctx, blocker, user
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasUserBlocked", reflect.TypeOf((*MockRelationshipsKeeper)(nil).HasUserBlocked), ctx, blocker, user, subspaceID)
 }
+
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockBankKeeper) GetBalance(ctx types.Context, addr types.AccAddress, denom string) types.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, addr, denom)
+	ret0, _ := ret[0].(types.Coin)
+	return ret0
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockBankKeeperMockRecorder) GetBalance(ctx, addr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), ctx, addr, denom)
+}
@@ -17,6 +17,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*AttachmentContent)(nil), nil)
 	cdc.RegisterConcrete(&Poll{}, "desmos/Poll", nil)
 	cdc.RegisterConcrete(&Media{}, "desmos/Media", nil)
+	cdc.RegisterConcrete(&LinkPreview{}, "desmos/LinkPreview", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgCreatePost{}, "desmos/MsgCreatePost")
 	legacy.RegisterAminoMsg(cdc, &MsgEditPost{}, "desmos/MsgEditPost")
@@ -25,6 +26,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDeletePost{}, "desmos/MsgDeletePost")
 	legacy.RegisterAminoMsg(cdc, &MsgAnswerPoll{}, "desmos/MsgAnswerPoll")
 	legacy.RegisterAminoMsg(cdc, &MsgMovePost{}, "desmos/MsgMovePost")
+	legacy.RegisterAminoMsg(cdc, &MsgUnhidePost{}, "desmos/MsgUnhidePost")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPostRevisionsLimit{}, "desmos/MsgSetPostRevisionsLimit")
 
 	legacy.RegisterAminoMsg(cdc, &MsgRequestPostOwnerTransfer{}, "desmos/MsgRequestPostOwnerTransfer")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelPostOwnerTransferRequest{}, "desmos/MsgCancelPostOwnerTransfer")
@@ -41,6 +44,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*AttachmentContent)(nil),
 		&Poll{},
 		&Media{},
+		&LinkPreview{},
 	)
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -56,6 +60,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCancelPostOwnerTransferRequest{},
 		&MsgAcceptPostOwnerTransferRequest{},
 		&MsgRefusePostOwnerTransferRequest{},
+		&MsgUnhidePost{},
+		&MsgSetPostRevisionsLimit{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -14,6 +14,11 @@ const (
 	EventTypeCanceledPostOwnerTransfer  = "canceled_post_owner_transfer"
 	EventTypeAcceptedPostOwnerTransfer  = "accepted_post_owner_transfer"
 	EventTypeRefusedPostOwnerTransfer   = "refused_post_owner_transfer"
+	EventTypeUnhiddenPost               = "unhidden_post"
+	EventTypeScheduledPost              = "scheduled_post"
+	EventTypePublishedPost              = "published_post"
+	EventTypeExpiredPost                = "expired_post"
+	EventTypeSetPostRevisionsLimit      = "set_post_revisions_limit"
 
 	AttributeKeyPostID         = "post_id"
 	AttributeKeyAuthor         = "author"
@@ -27,4 +32,7 @@ const (
 	AttributeKeyNewPostID      = "new_post_id"
 	AttributeKeySender         = "sender"
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeyModerator      = "moderator"
+	AttributeKeyPublishTime    = "publish_time"
+	AttributeKeyRevisionsLimit = "revisions_limit"
 )
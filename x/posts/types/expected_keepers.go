@@ -17,6 +17,9 @@ type SubspacesKeeper interface {
 	// HasSubspace tells whether the subspace with the given id exists or not
 	HasSubspace(ctx sdk.Context, subspaceID uint64) bool
 
+	// IsSubspaceArchived tells whether the subspace with the given id has been archived
+	IsSubspaceArchived(ctx sdk.Context, subspaceID uint64) bool
+
 	// HasSection tells whether the section having the given id exists inside the provided subspace
 	HasSection(ctx sdk.Context, subspaceID uint64, sectionID uint32) bool
 
@@ -31,6 +34,19 @@ type SubspacesKeeper interface {
 
 	// GetUsersWithRootPermissions returns all the users that have a given permission inside the specified subspace
 	GetUsersWithRootPermissions(ctx sdk.Context, subspaceID uint64, permission subspacestypes.Permissions) []string
+
+	// HasUserGroup tells whether the given subspace has a user group with the provided id
+	HasUserGroup(ctx sdk.Context, subspaceID uint64, groupID uint32) bool
+
+	// IsMemberOfGroup returns whether the given user is part of the group with
+	// the specified id inside the provided subspace
+	IsMemberOfGroup(ctx sdk.Context, subspaceID uint64, groupID uint32, user string) bool
+
+	// CanUserViewSection tells whether the given user can read the contents of the section having the given id
+	CanUserViewSection(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string) bool
+
+	// ConsumeMsgRateLimits consumes the subspace rate limits that apply to the given message for each one of its signers
+	ConsumeMsgRateLimits(ctx sdk.Context, msg subspacestypes.SubspaceMsg) error
 }
 
 // RelationshipsKeeper represents a keeper that deals with relationships
@@ -41,3 +57,9 @@ type RelationshipsKeeper interface {
 	// HasRelationship tells whether the relationship between the user and counterparty exists for the given subspace
 	HasRelationship(ctx sdk.Context, user, counterparty string, subspaceID uint64) bool
 }
+
+// BankKeeper represents a keeper that deals with balances
+type BankKeeper interface {
+	// GetBalance returns the balance of the given denom owned by the provided address
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
@@ -17,6 +17,10 @@ func NewGenesisState(
 	userAnswers []UserAnswer,
 	params Params,
 	transferRequests []PostOwnerTransferRequest,
+	hiddenPosts []HiddenPostEntry,
+	pendingPosts []PendingPostEntry,
+	postRevisions []PostRevision,
+	postRevisionsLimits []PostRevisionsLimitEntry,
 ) *GenesisState {
 	return &GenesisState{
 		SubspacesData:             subspacesData,
@@ -27,12 +31,16 @@ func NewGenesisState(
 		UserAnswers:               userAnswers,
 		Params:                    params,
 		PostOwnerTransferRequests: transferRequests,
+		HiddenPosts:               hiddenPosts,
+		PendingPosts:              pendingPosts,
+		PostRevisions:             postRevisions,
+		PostRevisionsLimits:       postRevisionsLimits,
 	}
 }
 
 // DefaultGenesisState returns a default GenesisState
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(nil, nil, nil, nil, nil, nil, DefaultParams(), nil)
+	return NewGenesisState(nil, nil, nil, nil, nil, nil, DefaultParams(), nil, nil, nil, nil, nil)
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
@@ -136,6 +144,51 @@ func ValidateGenesis(data *GenesisState) error {
 		}
 	}
 
+	for _, entry := range data.HiddenPosts {
+		if containsDuplicatedHiddenPostEntry(data.HiddenPosts, entry) {
+			return fmt.Errorf("duplicated hidden post entry: subspace id %d, post id %d", entry.SubspaceID, entry.PostID)
+		}
+
+		err := entry.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range data.PendingPosts {
+		if containsDuplicatedPendingPostEntry(data.PendingPosts, entry) {
+			return fmt.Errorf("duplicated pending post entry: subspace id %d, post id %d", entry.SubspaceID, entry.PostID)
+		}
+
+		err := entry.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, revision := range data.PostRevisions {
+		if containsDuplicatedPostRevision(data.PostRevisions, revision) {
+			return fmt.Errorf("duplicated post revision: subspace id %d, post id %d, revision id %d",
+				revision.SubspaceID, revision.PostID, revision.ID)
+		}
+
+		err := revision.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range data.PostRevisionsLimits {
+		if containsDuplicatedPostRevisionsLimitEntry(data.PostRevisionsLimits, entry) {
+			return fmt.Errorf("duplicated post revisions limit entry: subspace id %d", entry.SubspaceID)
+		}
+
+		err := entry.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -223,6 +276,54 @@ func containDuplicatedRequest(requests []PostOwnerTransferRequest, request PostO
 	return count > 1
 }
 
+// containsDuplicatedHiddenPostEntry tells whether the given entries slice contains two or more entries
+// referencing the same post as the given one
+func containsDuplicatedHiddenPostEntry(entries []HiddenPostEntry, entry HiddenPostEntry) bool {
+	var count = 0
+	for _, e := range entries {
+		if e.SubspaceID == entry.SubspaceID && e.PostID == entry.PostID {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedPendingPostEntry tells whether the given entries slice contains two or more entries
+// referencing the same post as the given one
+func containsDuplicatedPendingPostEntry(entries []PendingPostEntry, entry PendingPostEntry) bool {
+	var count = 0
+	for _, e := range entries {
+		if e.SubspaceID == entry.SubspaceID && e.PostID == entry.PostID {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedPostRevision tells whether the given revisions slice contains two or more revisions
+// having the same id of the given one
+func containsDuplicatedPostRevision(revisions []PostRevision, revision PostRevision) bool {
+	var count = 0
+	for _, r := range revisions {
+		if r.SubspaceID == revision.SubspaceID && r.PostID == revision.PostID && r.ID == revision.ID {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedPostRevisionsLimitEntry tells whether the given entries slice contains
+// two or more entries for the same subspace
+func containsDuplicatedPostRevisionsLimitEntry(entries []PostRevisionsLimitEntry, entry PostRevisionsLimitEntry) bool {
+	var count = 0
+	for _, e := range entries {
+		if e.SubspaceID == entry.SubspaceID {
+			count++
+		}
+	}
+	return count > 1
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewSubspaceDataEntry returns a new SubspaceDataEntry instance
@@ -306,3 +407,77 @@ func (d ActivePollData) Validate() error {
 
 	return nil
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewHiddenPostEntry returns a new HiddenPostEntry instance
+func NewHiddenPostEntry(subspaceID uint64, postID uint64) HiddenPostEntry {
+	return HiddenPostEntry{
+		SubspaceID: subspaceID,
+		PostID:     postID,
+	}
+}
+
+// Validate returns an error if something is wrong within the entry
+func (e HiddenPostEntry) Validate() error {
+	if e.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", e.SubspaceID)
+	}
+
+	if e.PostID == 0 {
+		return fmt.Errorf("invalid post id: %d", e.PostID)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewPendingPostEntry returns a new PendingPostEntry instance
+func NewPendingPostEntry(subspaceID uint64, postID uint64, publishTime time.Time) PendingPostEntry {
+	return PendingPostEntry{
+		SubspaceID:  subspaceID,
+		PostID:      postID,
+		PublishTime: publishTime,
+	}
+}
+
+// Validate returns an error if something is wrong within the entry
+func (e PendingPostEntry) Validate() error {
+	if e.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", e.SubspaceID)
+	}
+
+	if e.PostID == 0 {
+		return fmt.Errorf("invalid post id: %d", e.PostID)
+	}
+
+	if e.PublishTime.IsZero() {
+		return fmt.Errorf("invalid publish time: %s", e.PublishTime)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewPostRevisionsLimitEntry returns a new PostRevisionsLimitEntry instance
+func NewPostRevisionsLimitEntry(subspaceID uint64, limit uint32) PostRevisionsLimitEntry {
+	return PostRevisionsLimitEntry{
+		SubspaceID: subspaceID,
+		Limit:      limit,
+	}
+}
+
+// Validate returns an error if something is wrong within the entry
+func (e PostRevisionsLimitEntry) Validate() error {
+	if e.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", e.SubspaceID)
+	}
+
+	if e.Limit > MaxPostRevisionsLimit {
+		return fmt.Errorf("revisions limit cannot be greater than %d: %d", MaxPostRevisionsLimit, e.Limit)
+	}
+
+	return nil
+}
@@ -38,6 +38,10 @@ type GenesisState struct {
 	UserAnswers               []UserAnswer               `protobuf:"bytes,6,rep,name=user_answers,json=userAnswers,proto3" json:"user_answers"`
 	Params                    Params                     `protobuf:"bytes,7,opt,name=params,proto3" json:"params"`
 	PostOwnerTransferRequests []PostOwnerTransferRequest `protobuf:"bytes,8,rep,name=post_owner_transfer_requests,json=postOwnerTransferRequests,proto3" json:"post_owner_transfer_requests"`
+	HiddenPosts               []HiddenPostEntry          `protobuf:"bytes,9,rep,name=hidden_posts,json=hiddenPosts,proto3" json:"hidden_posts"`
+	PendingPosts              []PendingPostEntry         `protobuf:"bytes,10,rep,name=pending_posts,json=pendingPosts,proto3" json:"pending_posts"`
+	PostRevisions             []PostRevision             `protobuf:"bytes,11,rep,name=post_revisions,json=postRevisions,proto3" json:"post_revisions"`
+	PostRevisionsLimits       []PostRevisionsLimitEntry  `protobuf:"bytes,12,rep,name=post_revisions_limits,json=postRevisionsLimits,proto3" json:"post_revisions_limits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +133,34 @@ func (m *GenesisState) GetPostOwnerTransferRequests() []PostOwnerTransferRequest
 	return nil
 }
 
+func (m *GenesisState) GetHiddenPosts() []HiddenPostEntry {
+	if m != nil {
+		return m.HiddenPosts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingPosts() []PendingPostEntry {
+	if m != nil {
+		return m.PendingPosts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPostRevisions() []PostRevision {
+	if m != nil {
+		return m.PostRevisions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPostRevisionsLimits() []PostRevisionsLimitEntry {
+	if m != nil {
+		return m.PostRevisionsLimits
+	}
+	return nil
+}
+
 // SubspaceDataEntry contains the data for a given subspace
 type SubspaceDataEntry struct {
 	SubspaceID    uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
@@ -312,59 +344,242 @@ func (m *ActivePollData) GetEndDate() time.Time {
 	return time.Time{}
 }
 
+// HiddenPostEntry contains the reference to a post that has been hidden
+type HiddenPostEntry struct {
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
+	PostID     uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (m *HiddenPostEntry) Reset()         { *m = HiddenPostEntry{} }
+func (m *HiddenPostEntry) String() string { return proto.CompactTextString(m) }
+func (*HiddenPostEntry) ProtoMessage()    {}
+func (*HiddenPostEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9604ecc42366da8, []int{4}
+}
+func (m *HiddenPostEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HiddenPostEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HiddenPostEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HiddenPostEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HiddenPostEntry.Merge(m, src)
+}
+func (m *HiddenPostEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HiddenPostEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HiddenPostEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HiddenPostEntry proto.InternalMessageInfo
+
+func (m *HiddenPostEntry) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
+}
+
+func (m *HiddenPostEntry) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
+	}
+	return 0
+}
+
+// PendingPostEntry contains the data of a post that has been scheduled to be
+// published in the future
+type PendingPostEntry struct {
+	SubspaceID  uint64    `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
+	PostID      uint64    `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PublishTime time.Time `protobuf:"bytes,3,opt,name=publish_time,json=publishTime,proto3,stdtime" json:"publish_time"`
+}
+
+func (m *PendingPostEntry) Reset()         { *m = PendingPostEntry{} }
+func (m *PendingPostEntry) String() string { return proto.CompactTextString(m) }
+func (*PendingPostEntry) ProtoMessage()    {}
+func (*PendingPostEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9604ecc42366da8, []int{5}
+}
+func (m *PendingPostEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPostEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPostEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPostEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPostEntry.Merge(m, src)
+}
+func (m *PendingPostEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPostEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPostEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPostEntry proto.InternalMessageInfo
+
+func (m *PendingPostEntry) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
+}
+
+func (m *PendingPostEntry) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
+	}
+	return 0
+}
+
+func (m *PendingPostEntry) GetPublishTime() time.Time {
+	if m != nil {
+		return m.PublishTime
+	}
+	return time.Time{}
+}
+
+// PostRevisionsLimitEntry contains the max number of revisions that are kept
+// for each post inside a subspace
+type PostRevisionsLimitEntry struct {
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
+	Limit      uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *PostRevisionsLimitEntry) Reset()         { *m = PostRevisionsLimitEntry{} }
+func (m *PostRevisionsLimitEntry) String() string { return proto.CompactTextString(m) }
+func (*PostRevisionsLimitEntry) ProtoMessage()    {}
+func (*PostRevisionsLimitEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9604ecc42366da8, []int{6}
+}
+func (m *PostRevisionsLimitEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostRevisionsLimitEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostRevisionsLimitEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostRevisionsLimitEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostRevisionsLimitEntry.Merge(m, src)
+}
+func (m *PostRevisionsLimitEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostRevisionsLimitEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostRevisionsLimitEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostRevisionsLimitEntry proto.InternalMessageInfo
+
+func (m *PostRevisionsLimitEntry) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
+}
+
+func (m *PostRevisionsLimitEntry) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "desmos.posts.v3.GenesisState")
 	proto.RegisterType((*SubspaceDataEntry)(nil), "desmos.posts.v3.SubspaceDataEntry")
 	proto.RegisterType((*PostDataEntry)(nil), "desmos.posts.v3.PostDataEntry")
 	proto.RegisterType((*ActivePollData)(nil), "desmos.posts.v3.ActivePollData")
+	proto.RegisterType((*HiddenPostEntry)(nil), "desmos.posts.v3.HiddenPostEntry")
+	proto.RegisterType((*PendingPostEntry)(nil), "desmos.posts.v3.PendingPostEntry")
+	proto.RegisterType((*PostRevisionsLimitEntry)(nil), "desmos.posts.v3.PostRevisionsLimitEntry")
 }
 
 func init() { proto.RegisterFile("desmos/posts/v3/genesis.proto", fileDescriptor_c9604ecc42366da8) }
 
 var fileDescriptor_c9604ecc42366da8 = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
-	0x14, 0xcd, 0xf0, 0x13, 0x60, 0x42, 0x40, 0x98, 0x0f, 0xe1, 0x8f, 0x52, 0x3b, 0xa2, 0x9b, 0xb4,
-	0x52, 0x6d, 0x09, 0xa4, 0x56, 0x65, 0x47, 0x94, 0xaa, 0x58, 0x55, 0x55, 0x14, 0xe8, 0xa6, 0x1b,
-	0x6b, 0x12, 0x0f, 0x61, 0xa4, 0xb1, 0xc7, 0xf5, 0x1d, 0x87, 0xf2, 0x04, 0x55, 0x77, 0x2c, 0xbb,
-	0x64, 0xd9, 0x65, 0x1f, 0xa0, 0x0f, 0xc0, 0x92, 0x65, 0xa5, 0x4a, 0x69, 0x65, 0x16, 0xed, 0x63,
-	0x54, 0x1e, 0x3b, 0x89, 0x43, 0x60, 0xd1, 0x45, 0x37, 0xd6, 0xcc, 0xdc, 0x73, 0xce, 0x3d, 0x33,
-	0x3a, 0xbe, 0xf8, 0xbe, 0x47, 0xc1, 0x17, 0x60, 0x87, 0x02, 0x24, 0xd8, 0xbd, 0x1d, 0xbb, 0x4b,
-	0x03, 0x0a, 0x0c, 0xac, 0x30, 0x12, 0x52, 0x68, 0xcb, 0x59, 0xd9, 0x52, 0x65, 0xab, 0xb7, 0xb3,
-	0xb1, 0x42, 0x7c, 0x16, 0x08, 0x5b, 0x7d, 0x33, 0xcc, 0xc6, 0x7f, 0x5d, 0xd1, 0x15, 0x6a, 0x69,
-	0xa7, 0xab, 0xfc, 0xd4, 0xec, 0x0a, 0xd1, 0xe5, 0xd4, 0x56, 0xbb, 0x76, 0x7c, 0x6c, 0x4b, 0xe6,
-	0x53, 0x90, 0xc4, 0x0f, 0x73, 0xc0, 0xe6, 0xcd, 0xce, 0xbe, 0xf0, 0x28, 0xcf, 0x1b, 0x6f, 0x7d,
-	0x98, 0xc5, 0x8b, 0x2f, 0x32, 0x2b, 0x87, 0x92, 0x48, 0xaa, 0x1d, 0xe1, 0x25, 0x88, 0xdb, 0x10,
-	0x92, 0x0e, 0x05, 0xd7, 0x23, 0x92, 0xe8, 0xa8, 0x36, 0x5d, 0xaf, 0x6c, 0x6f, 0x59, 0x37, 0x2c,
-	0x5a, 0x87, 0x39, 0xac, 0x49, 0x24, 0x79, 0x1e, 0xc8, 0xe8, 0xac, 0xb1, 0x70, 0xd9, 0x37, 0x4b,
-	0x9f, 0x7f, 0x7d, 0x79, 0x84, 0x5a, 0xd5, 0xa1, 0x48, 0x5a, 0xd6, 0xf6, 0x31, 0x56, 0xbc, 0x4c,
-	0x71, 0x4a, 0x29, 0x1a, 0x13, 0x8a, 0x07, 0x02, 0xe4, 0xad, 0x6a, 0x0b, 0x0a, 0xa2, 0x94, 0x9e,
-	0xe0, 0x59, 0xb5, 0xd1, 0xa7, 0x95, 0xc8, 0xda, 0xad, 0x22, 0x45, 0x6e, 0x06, 0xd7, 0xf6, 0x71,
-	0x85, 0x48, 0x49, 0x3a, 0x27, 0x3e, 0x0d, 0x24, 0xe8, 0x33, 0x8a, 0x7d, 0x6f, 0x82, 0xbd, 0x37,
-	0xc4, 0x14, 0x35, 0x8a, 0x54, 0xed, 0x15, 0x5e, 0x24, 0x1d, 0xc9, 0x7a, 0xd4, 0x0d, 0x05, 0xe7,
-	0xa0, 0xcf, 0x2a, 0x29, 0x73, 0x52, 0x4a, 0x81, 0x0e, 0x04, 0xe7, 0xa9, 0xf1, 0x71, 0xb9, 0x61,
-	0x09, 0x34, 0x07, 0x2f, 0xc6, 0x40, 0x23, 0x97, 0x04, 0x70, 0x4a, 0x23, 0xd0, 0xcb, 0x77, 0x38,
-	0x7b, 0x03, 0x34, 0xda, 0x53, 0x98, 0x31, 0xa9, 0x78, 0x78, 0x0c, 0xda, 0x2e, 0x2e, 0x87, 0x24,
-	0x22, 0x3e, 0xe8, 0x73, 0x35, 0x54, 0xaf, 0x6c, 0xaf, 0x4f, 0x3e, 0x8e, 0x2a, 0x17, 0x05, 0x72,
-	0x86, 0x16, 0xe3, 0xcd, 0x14, 0xe5, 0x8a, 0xd3, 0x80, 0x46, 0xae, 0x8c, 0x48, 0x00, 0xc7, 0x34,
-	0x72, 0x23, 0xfa, 0x2e, 0xa6, 0xe9, 0x73, 0xcf, 0x2b, 0x5b, 0x0f, 0x6f, 0x7d, 0xee, 0xd7, 0x29,
-	0xe7, 0x28, 0xa7, 0xb4, 0x32, 0x46, 0xb1, 0xc7, 0xff, 0xe1, 0x1d, 0x20, 0xd8, 0x9d, 0xff, 0x74,
-	0x61, 0xa2, 0xdf, 0x17, 0x26, 0xda, 0xfa, 0x88, 0xf0, 0xca, 0x44, 0xa4, 0x34, 0x1b, 0x57, 0x06,
-	0x49, 0x72, 0x99, 0xa7, 0xa3, 0x1a, 0xaa, 0xcf, 0x34, 0x96, 0x92, 0xbe, 0x89, 0x07, 0x58, 0xa7,
-	0xd9, 0xc2, 0x03, 0x88, 0xe3, 0x69, 0xcf, 0xf0, 0x32, 0x0b, 0x98, 0x64, 0x84, 0xbb, 0xea, 0x3e,
-	0xcc, 0xd3, 0xa7, 0x14, 0x69, 0x25, 0xe9, 0x9b, 0x55, 0x27, 0x2b, 0xa5, 0xa6, 0x9d, 0x66, 0xab,
-	0xca, 0x0a, 0x5b, 0xaf, 0xe0, 0xe5, 0x2b, 0xc2, 0xd5, 0xb1, 0x30, 0xfe, 0xbd, 0x8f, 0x07, 0x78,
-	0x6e, 0xbc, 0x3f, 0x4e, 0xfa, 0x66, 0x39, 0x6f, 0x5c, 0x0e, 0x55, 0x47, 0xed, 0x25, 0x5e, 0x1b,
-	0x98, 0x1d, 0x25, 0x2c, 0xa5, 0x4c, 0xd7, 0x50, 0xbd, 0xda, 0x58, 0x4f, 0xfa, 0xe6, 0x6a, 0x6e,
-	0x79, 0x14, 0x4c, 0xa7, 0xd9, 0x5a, 0x65, 0x13, 0x87, 0x45, 0xfb, 0xdf, 0x11, 0x5e, 0x1a, 0x4f,
-	0xdf, 0x3f, 0xf2, 0xaf, 0x40, 0x9c, 0x8f, 0x1c, 0xe7, 0x20, 0xce, 0x33, 0x10, 0xe7, 0x8e, 0xa7,
-	0x35, 0xf1, 0x3c, 0x0d, 0xbc, 0xf4, 0xcf, 0xa7, 0xfa, 0x8c, 0xca, 0xe5, 0x86, 0x95, 0x0d, 0x2d,
-	0x6b, 0x30, 0xb4, 0xac, 0xa3, 0xc1, 0xd0, 0x6a, 0x54, 0xd3, 0xd8, 0x9c, 0xff, 0x30, 0x51, 0x16,
-	0x9d, 0x39, 0x1a, 0x78, 0x4d, 0x22, 0xe9, 0xe8, 0x76, 0x8d, 0xfd, 0xcb, 0xc4, 0x40, 0x57, 0x89,
-	0x81, 0x7e, 0x26, 0x06, 0x3a, 0xbf, 0x36, 0x4a, 0x57, 0xd7, 0x46, 0xe9, 0xdb, 0xb5, 0x51, 0x7a,
-	0x6b, 0x75, 0x99, 0x3c, 0x89, 0xdb, 0x56, 0x47, 0xf8, 0x76, 0x96, 0xd3, 0xc7, 0x9c, 0xb4, 0x21,
-	0x5f, 0xdb, 0xbd, 0xa7, 0xf6, 0xfb, 0x7c, 0x0c, 0xca, 0xb3, 0x90, 0x42, 0xbb, 0xac, 0xfa, 0xef,
-	0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x4e, 0x4c, 0xe5, 0x9d, 0x05, 0x00, 0x00,
+	// 839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x34, 0x89, 0x93, 0x3c, 0xdb, 0x09, 0x99, 0x34, 0xca, 0x12, 0x5a, 0xaf, 0x09, 0x17,
+	0x83, 0xc4, 0xae, 0xd4, 0x48, 0x20, 0x7a, 0xab, 0x65, 0x44, 0x2c, 0x0a, 0x2d, 0x6e, 0xb8, 0x70,
+	0x59, 0x8d, 0xb3, 0x53, 0x7b, 0xd0, 0xec, 0xce, 0x76, 0xdf, 0xac, 0x4b, 0x7f, 0x02, 0xb7, 0x1e,
+	0x39, 0xf6, 0xc8, 0x91, 0x1f, 0xc0, 0x81, 0x63, 0x8f, 0x39, 0x22, 0x21, 0x19, 0xe4, 0x20, 0xc1,
+	0xcf, 0x40, 0x3b, 0xbb, 0xb6, 0xd7, 0x5e, 0x07, 0x29, 0x87, 0x5c, 0xa2, 0x9d, 0x79, 0xdf, 0xf7,
+	0xbd, 0xef, 0xbd, 0xcc, 0x9b, 0x31, 0xdc, 0xf7, 0x39, 0x06, 0x0a, 0xdd, 0x48, 0xa1, 0x46, 0x77,
+	0x74, 0xea, 0x0e, 0x78, 0xc8, 0x51, 0xa0, 0x13, 0xc5, 0x4a, 0x2b, 0xba, 0x97, 0x85, 0x1d, 0x13,
+	0x76, 0x46, 0xa7, 0xc7, 0xfb, 0x2c, 0x10, 0xa1, 0x72, 0xcd, 0xdf, 0x0c, 0x73, 0x7c, 0x77, 0xa0,
+	0x06, 0xca, 0x7c, 0xba, 0xe9, 0x57, 0xbe, 0x6b, 0x0f, 0x94, 0x1a, 0x48, 0xee, 0x9a, 0x55, 0x3f,
+	0x79, 0xee, 0x6a, 0x11, 0x70, 0xd4, 0x2c, 0x88, 0x72, 0xc0, 0xbd, 0xe5, 0xcc, 0x81, 0xf2, 0xb9,
+	0xcc, 0x13, 0x9f, 0xfc, 0xbd, 0x05, 0xb5, 0x2f, 0x32, 0x2b, 0xcf, 0x34, 0xd3, 0x9c, 0x9e, 0xc3,
+	0x2e, 0x26, 0x7d, 0x8c, 0xd8, 0x05, 0x47, 0xcf, 0x67, 0x9a, 0x59, 0xa4, 0xb9, 0xde, 0xaa, 0x3e,
+	0x38, 0x71, 0x96, 0x2c, 0x3a, 0xcf, 0x72, 0x58, 0x87, 0x69, 0xf6, 0x79, 0xa8, 0xe3, 0x57, 0xed,
+	0x9d, 0xb7, 0x63, 0x7b, 0xed, 0xe7, 0x7f, 0x7e, 0xf9, 0x88, 0xf4, 0xea, 0x33, 0x91, 0x34, 0x4c,
+	0xcf, 0x00, 0x0c, 0x2f, 0x53, 0xbc, 0x63, 0x14, 0x1b, 0x25, 0xc5, 0xa7, 0x0a, 0xf5, 0x4a, 0xb5,
+	0x1d, 0x03, 0x31, 0x4a, 0x9f, 0xc0, 0xa6, 0x59, 0x58, 0xeb, 0x46, 0xe4, 0x70, 0xa5, 0x48, 0x91,
+	0x9b, 0xc1, 0xe9, 0x19, 0x54, 0x99, 0xd6, 0xec, 0x62, 0x18, 0xf0, 0x50, 0xa3, 0xb5, 0x61, 0xd8,
+	0xef, 0x95, 0xd8, 0x8f, 0x66, 0x98, 0xa2, 0x46, 0x91, 0x4a, 0xbf, 0x82, 0x1a, 0xbb, 0xd0, 0x62,
+	0xc4, 0xbd, 0x48, 0x49, 0x89, 0xd6, 0xa6, 0x91, 0xb2, 0xcb, 0x52, 0x06, 0xf4, 0x54, 0x49, 0x99,
+	0x1a, 0x5f, 0x94, 0x9b, 0x85, 0x90, 0x76, 0xa1, 0x96, 0x20, 0x8f, 0x3d, 0x16, 0xe2, 0x4b, 0x1e,
+	0xa3, 0x55, 0xb9, 0xc6, 0xd9, 0xb7, 0xc8, 0xe3, 0x47, 0x06, 0xb3, 0x20, 0x95, 0xcc, 0xb6, 0x91,
+	0x3e, 0x84, 0x4a, 0xc4, 0x62, 0x16, 0xa0, 0xb5, 0xd5, 0x24, 0xad, 0xea, 0x83, 0xa3, 0x72, 0x73,
+	0x4c, 0xb8, 0x28, 0x90, 0x33, 0x68, 0x02, 0xf7, 0x52, 0x94, 0xa7, 0x5e, 0x86, 0x3c, 0xf6, 0x74,
+	0xcc, 0x42, 0x7c, 0xce, 0x63, 0x2f, 0xe6, 0x2f, 0x12, 0x9e, 0xb6, 0x7b, 0xdb, 0xd8, 0xfa, 0x70,
+	0x65, 0xbb, 0x9f, 0xa4, 0x9c, 0xf3, 0x9c, 0xd2, 0xcb, 0x18, 0xc5, 0x1c, 0xef, 0x46, 0xd7, 0x80,
+	0x90, 0x7e, 0x0d, 0xb5, 0xa1, 0xf0, 0x7d, 0x1e, 0x7a, 0xd9, 0x7f, 0x75, 0xc7, 0xa4, 0x69, 0x96,
+	0xd2, 0x9c, 0x19, 0x50, 0x9a, 0xac, 0x74, 0x38, 0xaa, 0xc3, 0x59, 0x0c, 0xe9, 0x37, 0x50, 0x8f,
+	0x78, 0xe8, 0x8b, 0x70, 0x90, 0x0b, 0x82, 0x11, 0x7c, 0xbf, 0xec, 0x3b, 0x43, 0xad, 0x54, 0xac,
+	0x45, 0xf3, 0x20, 0xd2, 0x27, 0xb0, 0x6b, 0x3a, 0x13, 0xf3, 0x91, 0x40, 0xa1, 0x42, 0xb4, 0xaa,
+	0x46, 0xf3, 0xfe, 0xca, 0x5e, 0xf4, 0x72, 0xd4, 0xc2, 0x30, 0x44, 0x85, 0x00, 0xd2, 0x01, 0x1c,
+	0x2e, 0x0a, 0x7a, 0x52, 0x04, 0x42, 0xa3, 0x55, 0x33, 0xba, 0xad, 0xff, 0xd5, 0xc5, 0xc7, 0x29,
+	0xb6, 0x64, 0xf9, 0x20, 0x2a, 0x61, 0xf0, 0xe1, 0xf6, 0x4f, 0x6f, 0x6c, 0xf2, 0xef, 0x1b, 0x9b,
+	0x9c, 0xfc, 0x48, 0x60, 0xbf, 0x34, 0xaf, 0xd4, 0x85, 0xea, 0x74, 0x4c, 0x3d, 0xe1, 0x5b, 0xa4,
+	0x49, 0x5a, 0x1b, 0xed, 0xdd, 0xc9, 0xd8, 0x86, 0x29, 0xb6, 0xdb, 0xe9, 0xc1, 0x14, 0xd2, 0xf5,
+	0xe9, 0x67, 0xb0, 0x27, 0x42, 0xa1, 0x05, 0x93, 0xa6, 0xbb, 0x29, 0xe9, 0x8e, 0x21, 0xed, 0x4f,
+	0xc6, 0x76, 0xbd, 0x9b, 0x85, 0x52, 0xb7, 0xdd, 0x4e, 0xaf, 0x2e, 0x0a, 0x4b, 0xbf, 0xe0, 0xe5,
+	0x57, 0x02, 0xf5, 0x85, 0x49, 0xbf, 0xb9, 0x8f, 0x0f, 0x60, 0x6b, 0x31, 0x3f, 0x4c, 0xc6, 0x76,
+	0x25, 0x4f, 0x5c, 0x89, 0x4c, 0x46, 0xfa, 0x25, 0x1c, 0x4e, 0xcd, 0xce, 0xc7, 0x37, 0xa5, 0xac,
+	0x37, 0x49, 0xab, 0xde, 0x3e, 0x9a, 0x8c, 0xed, 0x83, 0xdc, 0xf2, 0x7c, 0xea, 0xbb, 0x9d, 0xde,
+	0x81, 0x28, 0x6d, 0x16, 0xed, 0xff, 0x41, 0x60, 0x77, 0x71, 0xb4, 0x6f, 0xc9, 0xbf, 0x01, 0x49,
+	0x39, 0x77, 0x9c, 0x83, 0xa4, 0xcc, 0x40, 0x52, 0x76, 0x7d, 0xda, 0x81, 0x6d, 0x1e, 0xfa, 0xe9,
+	0xb5, 0xca, 0xad, 0x0d, 0x33, 0xf4, 0xc7, 0x4e, 0xf6, 0x22, 0x38, 0xd3, 0x17, 0xc1, 0x39, 0x9f,
+	0xbe, 0x08, 0xed, 0x7a, 0x7a, 0x60, 0x5e, 0xff, 0x69, 0x93, 0xec, 0xd0, 0x6c, 0xf1, 0xd0, 0xef,
+	0x30, 0xcd, 0x0b, 0xd5, 0xbd, 0x80, 0xbd, 0xa5, 0x51, 0xbb, 0x9d, 0xea, 0x0a, 0x29, 0x7f, 0x23,
+	0xf0, 0xce, 0xf2, 0x34, 0xde, 0x52, 0x4b, 0x1f, 0x43, 0x2d, 0x4a, 0xfa, 0x52, 0xe0, 0xd0, 0x4b,
+	0x9f, 0x49, 0x6b, 0xfd, 0xa6, 0x1d, 0xab, 0xe6, 0xf4, 0x14, 0x50, 0x28, 0xe1, 0x7b, 0x38, 0xba,
+	0x66, 0x46, 0x6f, 0x5e, 0xc8, 0x5d, 0xd8, 0x34, 0xd7, 0x81, 0x29, 0xa3, 0xde, 0xcb, 0x16, 0xf3,
+	0x5c, 0xed, 0xb3, 0xb7, 0x93, 0x06, 0xb9, 0x9c, 0x34, 0xc8, 0x5f, 0x93, 0x06, 0x79, 0x7d, 0xd5,
+	0x58, 0xbb, 0xbc, 0x6a, 0xac, 0xfd, 0x7e, 0xd5, 0x58, 0xfb, 0xce, 0x19, 0x08, 0x3d, 0x4c, 0xfa,
+	0xce, 0x85, 0x0a, 0xdc, 0xec, 0x0a, 0xf9, 0x58, 0xb2, 0x3e, 0xe6, 0xdf, 0xee, 0xe8, 0x53, 0xf7,
+	0x87, 0xfc, 0x57, 0x80, 0x7e, 0x15, 0x71, 0xec, 0x57, 0x4c, 0xbd, 0xa7, 0xff, 0x0d, 0x00, 0x14,
+	0x2a, 0xeb, 0x9e, 0x9c, 0x08, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -445,6 +660,38 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.HiddenPosts) != len(that1.HiddenPosts) {
+		return false
+	}
+	for i := range this.HiddenPosts {
+		if !this.HiddenPosts[i].Equal(&that1.HiddenPosts[i]) {
+			return false
+		}
+	}
+	if len(this.PendingPosts) != len(that1.PendingPosts) {
+		return false
+	}
+	for i := range this.PendingPosts {
+		if !this.PendingPosts[i].Equal(&that1.PendingPosts[i]) {
+			return false
+		}
+	}
+	if len(this.PostRevisions) != len(that1.PostRevisions) {
+		return false
+	}
+	for i := range this.PostRevisions {
+		if !this.PostRevisions[i].Equal(&that1.PostRevisions[i]) {
+			return false
+		}
+	}
+	if len(this.PostRevisionsLimits) != len(that1.PostRevisionsLimits) {
+		return false
+	}
+	for i := range this.PostRevisionsLimits {
+		if !this.PostRevisionsLimits[i].Equal(&that1.PostRevisionsLimits[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *SubspaceDataEntry) Equal(that interface{}) bool {
@@ -537,6 +784,90 @@ func (this *ActivePollData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HiddenPostEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HiddenPostEntry)
+	if !ok {
+		that2, ok := that.(HiddenPostEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.PostID != that1.PostID {
+		return false
+	}
+	return true
+}
+func (this *PendingPostEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingPostEntry)
+	if !ok {
+		that2, ok := that.(PendingPostEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.PostID != that1.PostID {
+		return false
+	}
+	if !this.PublishTime.Equal(that1.PublishTime) {
+		return false
+	}
+	return true
+}
+func (this *PostRevisionsLimitEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PostRevisionsLimitEntry)
+	if !ok {
+		that2, ok := that.(PostRevisionsLimitEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -557,6 +888,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PostRevisionsLimits) > 0 {
+		for iNdEx := len(m.PostRevisionsLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostRevisionsLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PostRevisions) > 0 {
+		for iNdEx := len(m.PostRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostRevisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PendingPosts) > 0 {
+		for iNdEx := len(m.PendingPosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.HiddenPosts) > 0 {
+		for iNdEx := len(m.HiddenPosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HiddenPosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.PostOwnerTransferRequests) > 0 {
 		for iNdEx := len(m.PostOwnerTransferRequests) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -785,6 +1172,113 @@ func (m *ActivePollData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HiddenPostEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HiddenPostEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HiddenPostEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPostEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPostEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPostEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PublishTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.PostID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostRevisionsLimitEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostRevisionsLimitEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostRevisionsLimitEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -846,6 +1340,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.HiddenPosts) > 0 {
+		for _, e := range m.HiddenPosts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingPosts) > 0 {
+		for _, e := range m.PendingPosts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PostRevisions) > 0 {
+		for _, e := range m.PostRevisions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PostRevisionsLimits) > 0 {
+		for _, e := range m.PostRevisionsLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -902,6 +1420,53 @@ func (m *ActivePollData) Size() (n int) {
 	return n
 }
 
+func (m *HiddenPostEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovGenesis(uint64(m.SubspaceID))
+	}
+	if m.PostID != 0 {
+		n += 1 + sovGenesis(uint64(m.PostID))
+	}
+	return n
+}
+
+func (m *PendingPostEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovGenesis(uint64(m.SubspaceID))
+	}
+	if m.PostID != 0 {
+		n += 1 + sovGenesis(uint64(m.PostID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PostRevisionsLimitEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovGenesis(uint64(m.SubspaceID))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovGenesis(uint64(m.Limit))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1208,61 +1773,45 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenPosts", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubspaceDataEntry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.HiddenPosts = append(m.HiddenPosts, HiddenPostEntry{})
+			if err := m.HiddenPosts[len(m.HiddenPosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubspaceDataEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubspaceDataEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPosts", wireType)
 			}
-			m.SubspaceID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1272,16 +1821,31 @@ func (m *SubspaceDataEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubspaceID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialPostID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.InitialPostID = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPosts = append(m.PendingPosts, PendingPostEntry{})
+			if err := m.PendingPosts[len(m.PendingPosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRevisions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1291,25 +1855,162 @@ func (m *SubspaceDataEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialPostID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
+			m.PostRevisions = append(m.PostRevisions, PostRevision{})
+			if err := m.PostRevisions[len(m.PostRevisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRevisionsLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostRevisionsLimits = append(m.PostRevisionsLimits, PostRevisionsLimitEntry{})
+			if err := m.PostRevisionsLimits[len(m.PostRevisionsLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubspaceDataEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubspaceDataEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubspaceDataEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialPostID", wireType)
+			}
+			m.InitialPostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialPostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -1564,6 +2265,303 @@ func (m *ActivePollData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HiddenPostEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HiddenPostEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HiddenPostEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingPostEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPostEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPostEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PublishTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostRevisionsLimitEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostRevisionsLimitEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostRevisionsLimitEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,8 +14,9 @@ import (
 
 // PostsHooks event hooks for posts objects (noalias)
 type PostsHooks interface {
-	AfterPostSaved(ctx sdk.Context, subspaceID uint64, postID uint64)   // Must be called when a post is saved
-	AfterPostDeleted(ctx sdk.Context, subspaceID uint64, postID uint64) // Must be called when a post is deleted
+	AfterPostSaved(ctx sdk.Context, subspaceID uint64, postID uint64)    // Must be called when a post is saved
+	AfterPostDeleted(ctx sdk.Context, subspaceID uint64, postID uint64)  // Must be called when a post is deleted
+	AfterPostUnhidden(ctx sdk.Context, subspaceID uint64, postID uint64) // Must be called when a post is unhidden by a moderator
 
 	AfterAttachmentSaved(ctx sdk.Context, subspaceID uint64, postID uint64, attachmentID uint32)   // Must be called when a post attachment is saved
 	AfterAttachmentDeleted(ctx sdk.Context, subspaceID uint64, postID uint64, attachmentID uint32) // Must be called when a post attachment is deleted
@@ -49,6 +50,13 @@ func (h MultiPostsHooks) AfterPostDeleted(ctx sdk.Context, subspaceID uint64, po
 	}
 }
 
+// AfterPostUnhidden implements PostsHooks
+func (h MultiPostsHooks) AfterPostUnhidden(ctx sdk.Context, subspaceID uint64, postID uint64) {
+	for _, hook := range h {
+		hook.AfterPostUnhidden(ctx, subspaceID, postID)
+	}
+}
+
 // AfterAttachmentSaved implements PostsHooks
 func (h MultiPostsHooks) AfterAttachmentSaved(ctx sdk.Context, subspaceID uint64, postID uint64, attachmentID uint32) {
 	for _, hook := range h {
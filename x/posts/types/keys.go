@@ -26,6 +26,9 @@ const (
 	ActionAnswerPoll           = "answer_poll"
 	ActionUpdateParams         = "update_params"
 	ActionMovePost             = "move_post"
+	ActionUnhidePost           = "unhide_post"
+
+	ActionSetPostRevisionsLimit = "set_post_revisions_limit"
 
 	ActionRequestPostOwnerTransfer = "request_post_owner_transfer"
 	ActionCancelPostOwnerTransfer  = "cancel_post_owner_transfer"
@@ -39,6 +42,14 @@ var (
 	NextPostIDPrefix  = []byte{0x00}
 	PostPrefix        = []byte{0x01}
 	PostSectionPrefix = []byte{0x02}
+	HiddenPostPrefix  = []byte{0x03}
+
+	PendingPostPrefix       = []byte{0x04}
+	PendingPostQueuePrefix  = []byte{0x05}
+	ExpiringPostQueuePrefix = []byte{0x06}
+
+	PostRevisionPrefix       = []byte{0x07}
+	PostRevisionsLimitPrefix = []byte{0x08}
 
 	NextAttachmentIDPrefix = []byte{0x10}
 	AttachmentPrefix       = []byte{0x11}
@@ -68,6 +79,17 @@ func GetSubspacePostIDBytes(subspaceID uint64, postID uint64) []byte {
 	return append(subspacestypes.GetSubspaceIDBytes(subspaceID), GetPostIDBytes(postID)...)
 }
 
+// GetSubspacePostIDFromBytes returns the subspace id and post id from the given byte array
+func GetSubspacePostIDFromBytes(bz []byte) (subspaceID uint64, postID uint64) {
+	if len(bz) != lenSubspaceID+lenPostID {
+		panic(fmt.Errorf("unexpected key length (%d ≠ %d", len(bz), lenSubspaceID+lenPostID))
+	}
+
+	subspaceID = subspacestypes.GetSubspaceIDFromBytes(bz[:lenSubspaceID])
+	postID = GetPostIDFromBytes(bz[lenSubspaceID:])
+	return subspaceID, postID
+}
+
 // NextPostIDStoreKey returns the key uses to store the next post id for the given subspace
 func NextPostIDStoreKey(subspaceID uint64) []byte {
 	return append(NextPostIDPrefix, subspacestypes.GetSubspaceIDBytes(subspaceID)...)
@@ -118,6 +140,129 @@ func SplitPostSectionStoreKey(key []byte) (subspaceID uint64, sectionID uint32,
 	return subspaceID, sectionID, postID
 }
 
+// HiddenPostStoreKey returns the key used to store the hidden flag of the given post
+func HiddenPostStoreKey(subspaceID uint64, postID uint64) []byte {
+	return append(HiddenPostPrefix, GetSubspacePostIDBytes(subspaceID, postID)...)
+}
+
+// SplitHiddenPostStoreKey splits the given hidden post store key and returns the subspace id and post id
+func SplitHiddenPostStoreKey(key []byte) (subspaceID uint64, postID uint64) {
+	expectedLen := len(HiddenPostPrefix) + SubspaceIDLen + PostIDLen
+	if len(key) != expectedLen {
+		panic(fmt.Errorf("invalid key length; expected %d but got %d", expectedLen, len(key)))
+	}
+
+	key = key[len(HiddenPostPrefix):]
+	subspaceID = subspacestypes.GetSubspaceIDFromBytes(key[:SubspaceIDLen])
+	postID = GetPostIDFromBytes(key[SubspaceIDLen:])
+	return subspaceID, postID
+}
+
+// PendingPostStoreKey returns the key used to store the publish time of the given pending post
+func PendingPostStoreKey(subspaceID uint64, postID uint64) []byte {
+	return append(PendingPostPrefix, GetSubspacePostIDBytes(subspaceID, postID)...)
+}
+
+// SplitPendingPostStoreKey splits the given pending post store key and returns the subspace id and post id
+func SplitPendingPostStoreKey(key []byte) (subspaceID uint64, postID uint64) {
+	expectedLen := len(PendingPostPrefix) + SubspaceIDLen + PostIDLen
+	if len(key) != expectedLen {
+		panic(fmt.Errorf("invalid key length; expected %d but got %d", expectedLen, len(key)))
+	}
+
+	key = key[len(PendingPostPrefix):]
+	subspaceID = subspacestypes.GetSubspaceIDFromBytes(key[:SubspaceIDLen])
+	postID = GetPostIDFromBytes(key[SubspaceIDLen:])
+	return subspaceID, postID
+}
+
+// PendingPostsByTimeKey returns the pending posts queue prefix for the given publish time
+func PendingPostsByTimeKey(publishTime time.Time) []byte {
+	return append(PendingPostQueuePrefix, sdk.FormatTimeBytes(publishTime)...)
+}
+
+// PendingPostQueueKey returns the key used to store the given post inside the pending posts queue
+func PendingPostQueueKey(subspaceID uint64, postID uint64, publishTime time.Time) []byte {
+	return append(PendingPostsByTimeKey(publishTime), GetSubspacePostIDBytes(subspaceID, postID)...)
+}
+
+// SplitPendingPostQueueKey splits the given pending posts queue key and returns the subspace id, post id and publish time
+func SplitPendingPostQueueKey(key []byte) (subspaceID uint64, postID uint64, publishTime time.Time) {
+	prefixLen := len(PendingPostQueuePrefix)
+	expectedLen := prefixLen + lenTime + SubspaceIDLen + PostIDLen
+	if len(key) != expectedLen {
+		panic(fmt.Errorf("invalid key length; expected %d but got %d", expectedLen, len(key)))
+	}
+
+	publishTime, err := sdk.ParseTimeBytes(key[prefixLen : prefixLen+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	key = key[prefixLen+lenTime:]
+	subspaceID = subspacestypes.GetSubspaceIDFromBytes(key[:SubspaceIDLen])
+	postID = GetPostIDFromBytes(key[SubspaceIDLen:])
+	return subspaceID, postID, publishTime
+}
+
+// ExpiringPostsByTimeKey returns the expiring posts queue prefix for the given expiration time
+func ExpiringPostsByTimeKey(expirationTime time.Time) []byte {
+	return append(ExpiringPostQueuePrefix, sdk.FormatTimeBytes(expirationTime)...)
+}
+
+// ExpiringPostQueueKey returns the key used to store the given post inside the expiring posts queue
+func ExpiringPostQueueKey(subspaceID uint64, postID uint64, expirationTime time.Time) []byte {
+	return append(ExpiringPostsByTimeKey(expirationTime), GetSubspacePostIDBytes(subspaceID, postID)...)
+}
+
+// SplitExpiringPostQueueKey splits the given expiring posts queue key and returns the subspace id, post id and expiration time
+func SplitExpiringPostQueueKey(key []byte) (subspaceID uint64, postID uint64, expirationTime time.Time) {
+	prefixLen := len(ExpiringPostQueuePrefix)
+	expectedLen := prefixLen + lenTime + SubspaceIDLen + PostIDLen
+	if len(key) != expectedLen {
+		panic(fmt.Errorf("invalid key length; expected %d but got %d", expectedLen, len(key)))
+	}
+
+	expirationTime, err := sdk.ParseTimeBytes(key[prefixLen : prefixLen+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	key = key[prefixLen+lenTime:]
+	subspaceID = subspacestypes.GetSubspaceIDFromBytes(key[:SubspaceIDLen])
+	postID = GetPostIDFromBytes(key[SubspaceIDLen:])
+	return subspaceID, postID, expirationTime
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetRevisionIDBytes returns the byte representation of the revisionID
+func GetRevisionIDBytes(revisionID uint32) (revisionIDBz []byte) {
+	revisionIDBz = make([]byte, 4)
+	binary.BigEndian.PutUint32(revisionIDBz, revisionID)
+	return
+}
+
+// GetRevisionIDFromBytes returns the revisionID in uint32 format from a byte array
+func GetRevisionIDFromBytes(bz []byte) (revisionID uint32) {
+	return binary.BigEndian.Uint32(bz)
+}
+
+// PostRevisionsPrefix returns the store prefix used to store all the revisions of the given post
+func PostRevisionsPrefix(subspaceID uint64, postID uint64) []byte {
+	return append(PostRevisionPrefix, GetSubspacePostIDBytes(subspaceID, postID)...)
+}
+
+// PostRevisionStoreKey returns the store key used to store the revision having the given id
+func PostRevisionStoreKey(subspaceID uint64, postID uint64, revisionID uint32) []byte {
+	return append(PostRevisionsPrefix(subspaceID, postID), GetRevisionIDBytes(revisionID)...)
+}
+
+// PostRevisionsLimitStoreKey returns the store key used to store the post revisions limit of the given subspace
+func PostRevisionsLimitStoreKey(subspaceID uint64) []byte {
+	return append(PostRevisionsLimitPrefix, subspacestypes.GetSubspaceIDBytes(subspaceID)...)
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetAttachmentIDBytes returns the byte representation of the attachmentID
@@ -89,3 +89,149 @@ func TestGetPollIDFromBytes(t *testing.T) {
 	}
 
 }
+
+func TestSplitHiddenPostStoreKey(t *testing.T) {
+	testCases := []struct {
+		name          string
+		key           []byte
+		shouldErr     bool
+		expSubspaceID uint64
+		expPostID     uint64
+	}{
+		{
+			name:      "invalid key length returns error",
+			key:       []byte{},
+			shouldErr: true,
+		},
+		{
+			name:          "valid key returns proper values",
+			key:           types.HiddenPostStoreKey(1, 2),
+			expSubspaceID: 1,
+			expPostID:     2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.shouldErr {
+				require.Panics(t, func() { types.SplitHiddenPostStoreKey(tc.key) })
+			} else {
+				subspaceID, postID := types.SplitHiddenPostStoreKey(tc.key)
+				require.Equal(t, tc.expSubspaceID, subspaceID)
+				require.Equal(t, tc.expPostID, postID)
+			}
+		})
+	}
+}
+
+func TestSplitPendingPostStoreKey(t *testing.T) {
+	testCases := []struct {
+		name          string
+		key           []byte
+		shouldErr     bool
+		expSubspaceID uint64
+		expPostID     uint64
+	}{
+		{
+			name:      "invalid key length returns error",
+			key:       []byte{},
+			shouldErr: true,
+		},
+		{
+			name:          "valid key returns proper values",
+			key:           types.PendingPostStoreKey(1, 2),
+			expSubspaceID: 1,
+			expPostID:     2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.shouldErr {
+				require.Panics(t, func() { types.SplitPendingPostStoreKey(tc.key) })
+			} else {
+				subspaceID, postID := types.SplitPendingPostStoreKey(tc.key)
+				require.Equal(t, tc.expSubspaceID, subspaceID)
+				require.Equal(t, tc.expPostID, postID)
+			}
+		})
+	}
+}
+
+func TestSplitPendingPostQueueKey(t *testing.T) {
+	testCases := []struct {
+		name           string
+		key            []byte
+		shouldErr      bool
+		expSubspaceID  uint64
+		expPostID      uint64
+		expPublishTime time.Time
+	}{
+		{
+			name:      "invalid key returns error",
+			key:       []byte{},
+			shouldErr: true,
+		},
+		{
+			name:           "valid key returns proper values",
+			key:            types.PendingPostQueueKey(1, 2, time.Date(2020, 1, 1, 12, 00, 00, 00, time.UTC)),
+			expSubspaceID:  1,
+			expPostID:      2,
+			expPublishTime: time.Date(2020, 1, 1, 12, 00, 00, 00, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.shouldErr {
+				require.Panics(t, func() { types.SplitPendingPostQueueKey(tc.key) })
+			} else {
+				subspaceID, postID, publishTime := types.SplitPendingPostQueueKey(tc.key)
+				require.Equal(t, tc.expSubspaceID, subspaceID)
+				require.Equal(t, tc.expPostID, postID)
+				require.Equal(t, tc.expPublishTime, publishTime)
+			}
+		})
+	}
+}
+
+func TestSplitExpiringPostQueueKey(t *testing.T) {
+	testCases := []struct {
+		name              string
+		key               []byte
+		shouldErr         bool
+		expSubspaceID     uint64
+		expPostID         uint64
+		expExpirationTime time.Time
+	}{
+		{
+			name:      "invalid key returns error",
+			key:       []byte{},
+			shouldErr: true,
+		},
+		{
+			name:              "valid key returns proper values",
+			key:               types.ExpiringPostQueueKey(1, 2, time.Date(2020, 1, 1, 12, 00, 00, 00, time.UTC)),
+			expSubspaceID:     1,
+			expPostID:         2,
+			expExpirationTime: time.Date(2020, 1, 1, 12, 00, 00, 00, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.shouldErr {
+				require.Panics(t, func() { types.SplitExpiringPostQueueKey(tc.key) })
+			} else {
+				subspaceID, postID, expirationTime := types.SplitExpiringPostQueueKey(tc.key)
+				require.Equal(t, tc.expSubspaceID, subspaceID)
+				require.Equal(t, tc.expPostID, postID)
+				require.Equal(t, tc.expExpirationTime, expirationTime)
+			}
+		})
+	}
+}
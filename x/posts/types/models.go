@@ -1,7 +1,13 @@
 package types
 
 import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
 	"fmt"
+	"hash"
+	"io"
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
@@ -43,6 +49,7 @@ func NewPost(
 	creationDate time.Time,
 	lastEditedDate *time.Time,
 	owner string,
+	expirationTime *time.Time,
 ) Post {
 	return Post{
 		SubspaceID:      subspaceID,
@@ -59,6 +66,7 @@ func NewPost(
 		CreationDate:    creationDate,
 		LastEditedDate:  lastEditedDate,
 		Owner:           owner,
+		ExpirationTime:  expirationTime,
 	}
 }
 
@@ -125,6 +133,18 @@ func (p Post) Validate() error {
 		}
 	}
 
+	if p.ExpirationTime != nil {
+		// Instead of zero, we should use nil
+		if p.ExpirationTime.IsZero() {
+			return fmt.Errorf("invalid post expiration time: %s", p.ExpirationTime)
+		}
+
+		// Make sure the expiration time is always after the creation date
+		if !p.ExpirationTime.After(p.CreationDate) {
+			return fmt.Errorf("expiration time must be after the creation date")
+		}
+	}
+
 	_, err = sdk.AccAddressFromBech32(p.Owner)
 	if err != nil {
 		return fmt.Errorf("invalid owner address: %s", err)
@@ -241,6 +261,7 @@ func (p Post) Update(update PostUpdate) Post {
 		p.CreationDate,
 		&update.UpdateTime,
 		p.Owner,
+		p.ExpirationTime,
 	)
 }
 
@@ -289,6 +310,7 @@ func (update PostMove) Update(post Post) Post {
 		post.CreationDate,
 		&update.UpdateTime,
 		post.Owner,
+		post.ExpirationTime,
 	)
 }
 
@@ -329,6 +351,7 @@ func (update OwnerTransfer) Update(post Post) Post {
 		post.CreationDate,
 		&update.UpdateTime,
 		update.NewOwner,
+		post.ExpirationTime,
 	)
 }
 
@@ -629,10 +652,12 @@ func UnpackAttachments(cdc codectypes.AnyUnpacker, attachmentAnys []*codectypes.
 var _ AttachmentContent = &Media{}
 
 // NewMedia returns a new Media instance
-func NewMedia(uri, mimeType string) *Media {
+func NewMedia(uri, mimeType string, hash *ContentHash, size uint64) *Media {
 	return &Media{
-		Uri:      uri,
-		MimeType: mimeType,
+		Uri:       uri,
+		MimeType:  mimeType,
+		Hash:      hash,
+		SizeBytes: size,
 	}
 }
 
@@ -648,6 +673,113 @@ func (m *Media) Validate() error {
 		return fmt.Errorf("invalid mime type: %s", m.MimeType)
 	}
 
+	if m.Hash != nil {
+		err := m.Hash.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid hash: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+const (
+	// ContentHashAlgorithmSHA256 represents the SHA-256 hashing algorithm
+	ContentHashAlgorithmSHA256 = "sha256"
+
+	// ContentHashAlgorithmSHA512 represents the SHA-512 hashing algorithm
+	ContentHashAlgorithmSHA512 = "sha512"
+)
+
+// getContentHashFunction returns the hash function associated with the given algorithm, or nil if not supported
+func getContentHashFunction(algorithm string) hash.Hash {
+	switch algorithm {
+	case ContentHashAlgorithmSHA256:
+		return sha256.New()
+	case ContentHashAlgorithmSHA512:
+		return sha512.New()
+	default:
+		return nil
+	}
+}
+
+// NewContentHash returns a new ContentHash instance
+func NewContentHash(algorithm, digest string) *ContentHash {
+	return &ContentHash{
+		Algorithm: algorithm,
+		Digest:    digest,
+	}
+}
+
+// ComputeContentHash computes the ContentHash of the content read from the given reader using the given algorithm,
+// returning it along with the number of bytes that have been read
+func ComputeContentHash(algorithm string, reader io.Reader) (*ContentHash, uint64, error) {
+	hasher := getContentHashFunction(algorithm)
+	if hasher == nil {
+		return nil, 0, fmt.Errorf("unsupported hash algorithm: %s", algorithm)
+	}
+
+	size, err := io.Copy(hasher, reader)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return NewContentHash(algorithm, hex.EncodeToString(hasher.Sum(nil))), uint64(size), nil
+}
+
+// Validate implements fmt.Validator
+func (h *ContentHash) Validate() error {
+	hasher := getContentHashFunction(h.Algorithm)
+	if hasher == nil {
+		return fmt.Errorf("unsupported algorithm: %s", h.Algorithm)
+	}
+
+	if strings.ToLower(h.Digest) != h.Digest {
+		return fmt.Errorf("digest must be lowercase hex encoded: %s", h.Digest)
+	}
+
+	digest, err := hex.DecodeString(h.Digest)
+	if err != nil {
+		return fmt.Errorf("invalid digest: %s", h.Digest)
+	}
+
+	if len(digest) != hasher.Size() {
+		return fmt.Errorf("invalid digest length for %s: expected %d bytes, got %d", h.Algorithm, hasher.Size(), len(digest))
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var _ AttachmentContent = &LinkPreview{}
+
+// NewLinkPreview returns a new LinkPreview instance
+func NewLinkPreview(url, title, description, imageURI, siteName string) *LinkPreview {
+	return &LinkPreview{
+		Url:         url,
+		Title:       title,
+		Description: description,
+		ImageUri:    imageURI,
+		SiteName:    siteName,
+	}
+}
+
+func (*LinkPreview) isAttachmentContent() {}
+
+// Validate implements fmt.Validator
+func (l *LinkPreview) Validate() error {
+	linkURL, err := url.ParseRequestURI(l.Url)
+	if err != nil || (linkURL.Scheme != "http" && linkURL.Scheme != "https") || linkURL.Host == "" {
+		return fmt.Errorf("invalid url: %s", l.Url)
+	}
+
+	if l.ImageUri != "" && strings.TrimSpace(l.ImageUri) == "" {
+		return fmt.Errorf("invalid image uri: %s", l.ImageUri)
+	}
+
 	return nil
 }
 
@@ -662,6 +794,9 @@ func NewPoll(
 	endDate time.Time,
 	allowsMultipleAnswers bool,
 	allowsAnswerEdits bool,
+	mode PollMode,
+	maxSelections uint32,
+	weighting *PollWeighting,
 	tallyResults *PollTallyResults,
 ) *Poll {
 	return &Poll{
@@ -670,6 +805,9 @@ func NewPoll(
 		EndDate:               endDate,
 		AllowsMultipleAnswers: allowsMultipleAnswers,
 		AllowsAnswerEdits:     allowsAnswerEdits,
+		Mode:                  mode,
+		MaxSelections:         maxSelections,
+		Weighting:             weighting,
 		FinalTallyResults:     tallyResults,
 	}
 }
@@ -703,6 +841,29 @@ func (p *Poll) Validate() error {
 		return fmt.Errorf("invalid end date: %s", p.EndDate)
 	}
 
+	if _, ok := PollMode_name[int32(p.Mode)]; !ok {
+		return fmt.Errorf("invalid poll mode: %s", p.Mode)
+	}
+
+	if p.Mode != POLL_MODE_PLURALITY && p.AllowsMultipleAnswers {
+		return fmt.Errorf("only plurality polls can allow multiple answers")
+	}
+
+	if p.Mode == POLL_MODE_APPROVAL && (p.MaxSelections == 0 || p.MaxSelections > uint32(len(p.ProvidedAnswers))) {
+		return fmt.Errorf("invalid max selections: %d", p.MaxSelections)
+	}
+
+	if p.Mode != POLL_MODE_APPROVAL && p.MaxSelections != 0 {
+		return fmt.Errorf("max selections can only be set for approval polls")
+	}
+
+	if p.Weighting != nil {
+		err := p.Weighting.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid weighting: %s", err)
+		}
+	}
+
 	if p.FinalTallyResults != nil {
 		err := p.FinalTallyResults.Validate()
 		if err != nil {
@@ -713,6 +874,39 @@ func (p *Poll) Validate() error {
 	return nil
 }
 
+// ValidateAnswersIndexes checks whether the given answers indexes represent a valid answer to this poll
+func (p *Poll) ValidateAnswersIndexes(answersIndexes []uint32) error {
+	for _, index := range answersIndexes {
+		if index >= uint32(len(p.ProvidedAnswers)) {
+			return fmt.Errorf("invalid answer index: %d", index)
+		}
+	}
+
+	switch p.Mode {
+	case POLL_MODE_PLURALITY:
+		if len(answersIndexes) > 1 && !p.AllowsMultipleAnswers {
+			return fmt.Errorf("only one answer is allowed on this poll")
+		}
+
+	case POLL_MODE_APPROVAL:
+		if uint32(len(answersIndexes)) > p.MaxSelections {
+			return fmt.Errorf("at most %d answers can be selected on this poll", p.MaxSelections)
+		}
+	}
+
+	return nil
+}
+
+// IsTokenWeighted tells whether the answers of this poll are weighted by the balance of the users
+func (p *Poll) IsTokenWeighted() bool {
+	return p.Weighting != nil && p.Weighting.Denom != ""
+}
+
+// IsGroupRestricted tells whether only the members of some user groups are allowed to answer this poll
+func (p *Poll) IsGroupRestricted() bool {
+	return p.Weighting != nil && len(p.Weighting.GroupIDs) > 0
+}
+
 // IsPoll tells whether the given attachment represents a poll or not
 func IsPoll(attachment Attachment) bool {
 	_, ok := attachment.Content.GetCachedValue().(*Poll)
@@ -848,21 +1042,77 @@ func (a UserAnswer) Validate() error {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// NewPollWeighting returns a new PollWeighting instance
+func NewPollWeighting(denom string, groupIDs []uint32) *PollWeighting {
+	return &PollWeighting{
+		Denom:    denom,
+		GroupIDs: groupIDs,
+	}
+}
+
+// Validate implements fmt.Validator
+func (w *PollWeighting) Validate() error {
+	if w.Denom == "" && len(w.GroupIDs) == 0 {
+		return fmt.Errorf("either denom or group ids must be set")
+	}
+
+	if w.Denom != "" {
+		err := sdk.ValidateDenom(w.Denom)
+		if err != nil {
+			return err
+		}
+	}
+
+	groups := map[uint32]bool{}
+	for _, groupID := range w.GroupIDs {
+		if groupID == 0 {
+			return fmt.Errorf("invalid group id: %d", groupID)
+		}
+
+		if _, ok := groups[groupID]; ok {
+			return fmt.Errorf("duplicated group id: %d", groupID)
+		}
+		groups[groupID] = true
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // NewPollTallyResults returns a new PollTallyResults instance
-func NewPollTallyResults(results []PollTallyResults_AnswerResult) *PollTallyResults {
+func NewPollTallyResults(results []PollTallyResults_AnswerResult, rounds []PollTallyResults_Round) *PollTallyResults {
 	return &PollTallyResults{
 		Results: results,
+		Rounds:  rounds,
 	}
 }
 
 // Validate implements fmt.Validator
 func (r *PollTallyResults) Validate() error {
-	if len(r.Results) == 0 {
+	err := validateAnswerResults(r.Results)
+	if err != nil {
+		return err
+	}
+
+	for i, round := range r.Rounds {
+		err = round.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid round %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateAnswerResults makes sure the given answer results are not empty and do not contain duplicates
+func validateAnswerResults(results []PollTallyResults_AnswerResult) error {
+	if len(results) == 0 {
 		return fmt.Errorf("empty answer results")
 	}
 
 	ids := map[uint32]bool{}
-	for _, answerResult := range r.Results {
+	for _, answerResult := range results {
 		if _, ok := ids[answerResult.AnswerIndex]; ok {
 			return fmt.Errorf("duplicated result for answer %d", answerResult.AnswerIndex)
 		}
@@ -872,6 +1122,18 @@ func (r *PollTallyResults) Validate() error {
 	return nil
 }
 
+// NewPollTallyRound returns a new PollTallyResults_Round instance
+func NewPollTallyRound(results []PollTallyResults_AnswerResult) PollTallyResults_Round {
+	return PollTallyResults_Round{
+		Results: results,
+	}
+}
+
+// Validate implements fmt.Validator
+func (r PollTallyResults_Round) Validate() error {
+	return validateAnswerResults(r.Results)
+}
+
 // NewAnswerResult returns a new PollTallyResults_AnswerResult instance
 func NewAnswerResult(answerIndex uint32, votes uint64) PollTallyResults_AnswerResult {
 	return PollTallyResults_AnswerResult{
@@ -880,6 +1142,15 @@ func NewAnswerResult(answerIndex uint32, votes uint64) PollTallyResults_AnswerRe
 	}
 }
 
+// NewWeightedAnswerResult returns a new PollTallyResults_AnswerResult instance having the given votes weight
+func NewWeightedAnswerResult(answerIndex uint32, votes uint64, weight sdk.Int) PollTallyResults_AnswerResult {
+	return PollTallyResults_AnswerResult{
+		AnswerIndex: answerIndex,
+		Votes:       votes,
+		Weight:      &weight,
+	}
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewPostOwnerTransferRequest returns a new PostOwnerTransferRequest instance
@@ -918,3 +1189,75 @@ func (request PostOwnerTransferRequest) Validate() error {
 
 	return nil
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+const (
+	// DefaultPostRevisionsLimit represents the default max number of revisions kept for each post
+	DefaultPostRevisionsLimit uint32 = 10
+
+	// MaxPostRevisionsLimit represents the max number of revisions that a subspace can decide to keep for each post
+	MaxPostRevisionsLimit uint32 = 100
+)
+
+// NewPostRevision returns a new PostRevision instance
+func NewPostRevision(
+	subspaceID uint64,
+	postID uint64,
+	id uint32,
+	text string,
+	entities *Entities,
+	tags []string,
+	editor string,
+	editDate time.Time,
+) PostRevision {
+	return PostRevision{
+		SubspaceID: subspaceID,
+		PostID:     postID,
+		ID:         id,
+		Text:       text,
+		Entities:   entities,
+		Tags:       tags,
+		Editor:     editor,
+		EditDate:   editDate,
+	}
+}
+
+// Validate implements fmt.Validator
+func (r PostRevision) Validate() error {
+	if r.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", r.SubspaceID)
+	}
+
+	if r.PostID == 0 {
+		return fmt.Errorf("invalid post id: %d", r.PostID)
+	}
+
+	if r.ID == 0 {
+		return fmt.Errorf("invalid revision id: %d", r.ID)
+	}
+
+	if r.Entities != nil {
+		err := r.Entities.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid entities: %s", err)
+		}
+	}
+
+	for _, tag := range r.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return fmt.Errorf("invalid revision tag: %s", tag)
+		}
+	}
+
+	_, err := sdk.AccAddressFromBech32(r.Editor)
+	if err != nil {
+		return fmt.Errorf("invalid editor address: %s", err)
+	}
+
+	if r.EditDate.IsZero() {
+		return fmt.Errorf("invalid edit date: %s", r.EditDate)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -106,6 +107,41 @@ func (ReplySetting) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e330452b10ff3223, []int{1}
 }
 
+// PollMode represents the mode used to answer and tally a poll
+type PollMode int32
+
+const (
+	// Each user selects one answer (or more, if the poll allows multiple
+	// answers), and the answer with the most votes wins
+	POLL_MODE_PLURALITY PollMode = 0
+	// Each user ranks the answers by preference, and the results are computed
+	// using instant-runoff voting
+	POLL_MODE_RANKED_CHOICE PollMode = 1
+	// Each user approves up to max_selections answers, and the answer with the
+	// most approvals wins
+	POLL_MODE_APPROVAL PollMode = 2
+)
+
+var PollMode_name = map[int32]string{
+	0: "POLL_MODE_PLURALITY",
+	1: "POLL_MODE_RANKED_CHOICE",
+	2: "POLL_MODE_APPROVAL",
+}
+
+var PollMode_value = map[string]int32{
+	"POLL_MODE_PLURALITY":     0,
+	"POLL_MODE_RANKED_CHOICE": 1,
+	"POLL_MODE_APPROVAL":      2,
+}
+
+func (x PollMode) String() string {
+	return proto.EnumName(PollMode_name, int32(x))
+}
+
+func (PollMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e330452b10ff3223, []int{2}
+}
+
 // Post contains all the information about a single post
 type Post struct {
 	// Id of the subspace inside which the post has been created
@@ -136,6 +172,8 @@ type Post struct {
 	LastEditedDate *time.Time `protobuf:"bytes,13,opt,name=last_edited_date,json=lastEditedDate,proto3,stdtime" json:"last_edited_date,omitempty" yaml:"last_edited_date"`
 	// Owner of the post
 	Owner string `protobuf:"bytes,14,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// (optional) Time after which the post will be deleted automatically
+	ExpirationTime *time.Time `protobuf:"bytes,15,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty" yaml:"expiration_time"`
 }
 
 func (m *Post) Reset()         { *m = Post{} }
@@ -269,6 +307,13 @@ func (m *Post) GetOwner() string {
 	return ""
 }
 
+func (m *Post) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
 // PostReference contains the details of a post reference
 type PostReference struct {
 	// Type of reference
@@ -613,6 +658,11 @@ func (m *Attachment) GetContent() *types.Any {
 type Media struct {
 	Uri      string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty" yaml:"uri"`
 	MimeType string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty" yaml:"mime_type"`
+	// (optional) Hash of the media content, that can be used by clients to
+	// verify the integrity of the content referenced by the uri
+	Hash *ContentHash `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	// (optional) Size of the media content, in bytes
+	SizeBytes uint64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty" yaml:"size"`
 }
 
 func (m *Media) Reset()         { *m = Media{} }
@@ -662,6 +712,157 @@ func (m *Media) GetMimeType() string {
 	return ""
 }
 
+func (m *Media) GetHash() *ContentHash {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Media) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+// ContentHash represents the hash of a media content
+type ContentHash struct {
+	// Algorithm used to compute the digest (e.g. sha256)
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty" yaml:"algorithm"`
+	// Hex encoded digest of the content
+	Digest string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty" yaml:"digest"`
+}
+
+func (m *ContentHash) Reset()         { *m = ContentHash{} }
+func (m *ContentHash) String() string { return proto.CompactTextString(m) }
+func (*ContentHash) ProtoMessage()    {}
+func (*ContentHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e330452b10ff3223, []int{7}
+}
+func (m *ContentHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContentHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContentHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContentHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentHash.Merge(m, src)
+}
+func (m *ContentHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContentHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContentHash proto.InternalMessageInfo
+
+func (m *ContentHash) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *ContentHash) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+// LinkPreview represents a rich preview of a link (e.g. an OpenGraph card)
+type LinkPreview struct {
+	// URL of the previewed link
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty" yaml:"url"`
+	// (optional) Title of the linked page
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// (optional) Description of the linked page
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// (optional) URI of the image representing the linked page
+	ImageUri string `protobuf:"bytes,4,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty" yaml:"image_uri"`
+	// (optional) Name of the site the linked page belongs to
+	SiteName string `protobuf:"bytes,5,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty" yaml:"site_name"`
+}
+
+func (m *LinkPreview) Reset()         { *m = LinkPreview{} }
+func (m *LinkPreview) String() string { return proto.CompactTextString(m) }
+func (*LinkPreview) ProtoMessage()    {}
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e330452b10ff3223, []int{8}
+}
+func (m *LinkPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinkPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkPreview.Merge(m, src)
+}
+func (m *LinkPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkPreview proto.InternalMessageInfo
+
+func (m *LinkPreview) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *LinkPreview) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *LinkPreview) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *LinkPreview) GetImageUri() string {
+	if m != nil {
+		return m.ImageUri
+	}
+	return ""
+}
+
+func (m *LinkPreview) GetSiteName() string {
+	if m != nil {
+		return m.SiteName
+	}
+	return ""
+}
+
 // Poll represents a poll attachment
 type Poll struct {
 	// Question of the poll
@@ -676,13 +877,20 @@ type Poll struct {
 	AllowsAnswerEdits bool `protobuf:"varint,5,opt,name=allows_answer_edits,json=allowsAnswerEdits,proto3" json:"allows_answer_edits,omitempty" yaml:"allows_answers_edits"`
 	// Final poll results
 	FinalTallyResults *PollTallyResults `protobuf:"bytes,6,opt,name=final_tally_results,json=finalTallyResults,proto3" json:"final_tally_results,omitempty" yaml:"final_tally_results"`
+	// Mode used to answer and tally the poll
+	Mode PollMode `protobuf:"varint,7,opt,name=mode,proto3,enum=desmos.posts.v3.PollMode" json:"mode,omitempty" yaml:"mode"`
+	// Maximum number of answers each user can select. Used only by approval
+	// polls
+	MaxSelections uint32 `protobuf:"varint,8,opt,name=max_selections,json=maxSelections,proto3" json:"max_selections,omitempty" yaml:"max_selections"`
+	// (optional) Weighting and restrictions applied to the poll answers
+	Weighting *PollWeighting `protobuf:"bytes,9,opt,name=weighting,proto3" json:"weighting,omitempty" yaml:"weighting"`
 }
 
 func (m *Poll) Reset()         { *m = Poll{} }
 func (m *Poll) String() string { return proto.CompactTextString(m) }
 func (*Poll) ProtoMessage()    {}
 func (*Poll) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e330452b10ff3223, []int{7}
+	return fileDescriptor_e330452b10ff3223, []int{9}
 }
 func (m *Poll) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,6 +961,27 @@ func (m *Poll) GetFinalTallyResults() *PollTallyResults {
 	return nil
 }
 
+func (m *Poll) GetMode() PollMode {
+	if m != nil {
+		return m.Mode
+	}
+	return POLL_MODE_PLURALITY
+}
+
+func (m *Poll) GetMaxSelections() uint32 {
+	if m != nil {
+		return m.MaxSelections
+	}
+	return 0
+}
+
+func (m *Poll) GetWeighting() *PollWeighting {
+	if m != nil {
+		return m.Weighting
+	}
+	return nil
+}
+
 // Provided answer contains the details of a possible poll answer
 type Poll_ProvidedAnswer struct {
 	// (optional) Text of the answer
@@ -765,7 +994,7 @@ func (m *Poll_ProvidedAnswer) Reset()         { *m = Poll_ProvidedAnswer{} }
 func (m *Poll_ProvidedAnswer) String() string { return proto.CompactTextString(m) }
 func (*Poll_ProvidedAnswer) ProtoMessage()    {}
 func (*Poll_ProvidedAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e330452b10ff3223, []int{7, 0}
+	return fileDescriptor_e330452b10ff3223, []int{9, 0}
 }
 func (m *Poll_ProvidedAnswer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -808,6 +1037,65 @@ func (m *Poll_ProvidedAnswer) GetAttachments() []*types.Any {
 	return nil
 }
 
+// PollWeighting contains the weighting and restrictions applied to the answers
+// of a poll
+type PollWeighting struct {
+	// (optional) Denom of the tokens used to weight the answers. If set, each
+	// answer is weighted by the balance of this denom owned by the user when the
+	// poll ends
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// (optional) Ids of the subspace user groups whose members are allowed to
+	// answer the poll. If empty, everyone can answer
+	GroupIDs []uint32 `protobuf:"varint,2,rep,packed,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty" yaml:"group_ids"`
+}
+
+func (m *PollWeighting) Reset()         { *m = PollWeighting{} }
+func (m *PollWeighting) String() string { return proto.CompactTextString(m) }
+func (*PollWeighting) ProtoMessage()    {}
+func (*PollWeighting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e330452b10ff3223, []int{10}
+}
+func (m *PollWeighting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PollWeighting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PollWeighting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PollWeighting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollWeighting.Merge(m, src)
+}
+func (m *PollWeighting) XXX_Size() int {
+	return m.Size()
+}
+func (m *PollWeighting) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollWeighting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollWeighting proto.InternalMessageInfo
+
+func (m *PollWeighting) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PollWeighting) GetGroupIDs() []uint32 {
+	if m != nil {
+		return m.GroupIDs
+	}
+	return nil
+}
+
 // UserAnswer represents a user answer to a poll
 type UserAnswer struct {
 	// Subspace id inside which the post related to this attachment is located
@@ -816,7 +1104,8 @@ type UserAnswer struct {
 	PostID uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty" yaml:"post_id"`
 	// Id of the poll to which this answer is associated
 	PollID uint32 `protobuf:"varint,3,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty" yaml:"poll_id"`
-	// Indexes of the answers inside the ProvidedAnswers array
+	// Indexes of the answers inside the ProvidedAnswers array.
+	// For ranked-choice polls, the indexes are sorted by preference
 	AnswersIndexes []uint32 `protobuf:"varint,4,rep,packed,name=answers_indexes,json=answersIndexes,proto3" json:"answers_indexes,omitempty" yaml:"answers_indexes"`
 	// Address of the user answering the poll
 	User string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
@@ -826,7 +1115,7 @@ func (m *UserAnswer) Reset()         { *m = UserAnswer{} }
 func (m *UserAnswer) String() string { return proto.CompactTextString(m) }
 func (*UserAnswer) ProtoMessage()    {}
 func (*UserAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e330452b10ff3223, []int{8}
+	return fileDescriptor_e330452b10ff3223, []int{11}
 }
 func (m *UserAnswer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,13 +1182,15 @@ func (m *UserAnswer) GetUser() string {
 // PollTallyResults contains the tally results for a poll
 type PollTallyResults struct {
 	Results []PollTallyResults_AnswerResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results" yaml:"results"`
+	// Results of each instant-runoff round. Used only by ranked-choice polls
+	Rounds []PollTallyResults_Round `protobuf:"bytes,2,rep,name=rounds,proto3" json:"rounds,omitempty" yaml:"rounds"`
 }
 
 func (m *PollTallyResults) Reset()         { *m = PollTallyResults{} }
 func (m *PollTallyResults) String() string { return proto.CompactTextString(m) }
 func (*PollTallyResults) ProtoMessage()    {}
 func (*PollTallyResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e330452b10ff3223, []int{9}
+	return fileDescriptor_e330452b10ff3223, []int{12}
 }
 func (m *PollTallyResults) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,19 +1226,29 @@ func (m *PollTallyResults) GetResults() []PollTallyResults_AnswerResult {
 	return nil
 }
 
+func (m *PollTallyResults) GetRounds() []PollTallyResults_Round {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
 // AnswerResult contains the result of a single poll provided answer
 type PollTallyResults_AnswerResult struct {
 	// Index of the answer inside the poll's ProvidedAnswers slice
 	AnswerIndex uint32 `protobuf:"varint,1,opt,name=answer_index,json=answerIndex,proto3" json:"answer_index,omitempty" yaml:"answer_index"`
 	// Number of votes the answer has received
 	Votes uint64 `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty" yaml:"votes"`
+	// Total weight of the votes the answer has received. Used only by
+	// token-weighted polls
+	Weight *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"weight,omitempty" yaml:"weight"`
 }
 
 func (m *PollTallyResults_AnswerResult) Reset()         { *m = PollTallyResults_AnswerResult{} }
 func (m *PollTallyResults_AnswerResult) String() string { return proto.CompactTextString(m) }
 func (*PollTallyResults_AnswerResult) ProtoMessage()    {}
 func (*PollTallyResults_AnswerResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e330452b10ff3223, []int{9, 0}
+	return fileDescriptor_e330452b10ff3223, []int{12, 0}
 }
 func (m *PollTallyResults_AnswerResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -990,17 +1291,66 @@ func (m *PollTallyResults_AnswerResult) GetVotes() uint64 {
 	return 0
 }
 
+// Round contains the results of a single instant-runoff round
+type PollTallyResults_Round struct {
+	// Results of the answers that were still running during the round
+	Results []PollTallyResults_AnswerResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results" yaml:"results"`
+}
+
+func (m *PollTallyResults_Round) Reset()         { *m = PollTallyResults_Round{} }
+func (m *PollTallyResults_Round) String() string { return proto.CompactTextString(m) }
+func (*PollTallyResults_Round) ProtoMessage()    {}
+func (*PollTallyResults_Round) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e330452b10ff3223, []int{12, 1}
+}
+func (m *PollTallyResults_Round) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PollTallyResults_Round) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PollTallyResults_Round.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PollTallyResults_Round) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollTallyResults_Round.Merge(m, src)
+}
+func (m *PollTallyResults_Round) XXX_Size() int {
+	return m.Size()
+}
+func (m *PollTallyResults_Round) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollTallyResults_Round.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollTallyResults_Round proto.InternalMessageInfo
+
+func (m *PollTallyResults_Round) GetResults() []PollTallyResults_AnswerResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // Params contains the parameters for the posts module
 type Params struct {
 	// Maximum length of the post text
 	MaxTextLength uint32 `protobuf:"varint,1,opt,name=max_text_length,json=maxTextLength,proto3" json:"max_text_length,omitempty" yaml:"max_text_length"`
+	// Maximum size in bytes that can be declared by a media attachment.
+	// A value of 0 means that no limit is applied
+	MaxMediaSize uint64 `protobuf:"varint,2,opt,name=max_media_size,json=maxMediaSize,proto3" json:"max_media_size,omitempty" yaml:"max_media_size"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e330452b10ff3223, []int{10}
+	return fileDescriptor_e330452b10ff3223, []int{13}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,6 +1386,13 @@ func (m *Params) GetMaxTextLength() uint32 {
 	return 0
 }
 
+func (m *Params) GetMaxMediaSize() uint64 {
+	if m != nil {
+		return m.MaxMediaSize
+	}
+	return 0
+}
+
 // PostOwnerTransferRequest represents a request to transfer the ownership of a
 // post from the sender to the receiver
 type PostOwnerTransferRequest struct {
@@ -1053,7 +1410,7 @@ func (m *PostOwnerTransferRequest) Reset()         { *m = PostOwnerTransferReque
 func (m *PostOwnerTransferRequest) String() string { return proto.CompactTextString(m) }
 func (*PostOwnerTransferRequest) ProtoMessage()    {}
 func (*PostOwnerTransferRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e330452b10ff3223, []int{11}
+	return fileDescriptor_e330452b10ff3223, []int{14}
 }
 func (m *PostOwnerTransferRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,156 +1467,309 @@ func (m *PostOwnerTransferRequest) GetReceiver() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("desmos.posts.v3.PostReferenceType", PostReferenceType_name, PostReferenceType_value)
-	proto.RegisterEnum("desmos.posts.v3.ReplySetting", ReplySetting_name, ReplySetting_value)
-	proto.RegisterType((*Post)(nil), "desmos.posts.v3.Post")
-	proto.RegisterType((*PostReference)(nil), "desmos.posts.v3.PostReference")
-	proto.RegisterType((*Entities)(nil), "desmos.posts.v3.Entities")
-	proto.RegisterType((*TextTag)(nil), "desmos.posts.v3.TextTag")
-	proto.RegisterType((*Url)(nil), "desmos.posts.v3.Url")
-	proto.RegisterType((*Attachment)(nil), "desmos.posts.v3.Attachment")
-	proto.RegisterType((*Media)(nil), "desmos.posts.v3.Media")
-	proto.RegisterType((*Poll)(nil), "desmos.posts.v3.Poll")
-	proto.RegisterType((*Poll_ProvidedAnswer)(nil), "desmos.posts.v3.Poll.ProvidedAnswer")
-	proto.RegisterType((*UserAnswer)(nil), "desmos.posts.v3.UserAnswer")
-	proto.RegisterType((*PollTallyResults)(nil), "desmos.posts.v3.PollTallyResults")
-	proto.RegisterType((*PollTallyResults_AnswerResult)(nil), "desmos.posts.v3.PollTallyResults.AnswerResult")
-	proto.RegisterType((*Params)(nil), "desmos.posts.v3.Params")
-	proto.RegisterType((*PostOwnerTransferRequest)(nil), "desmos.posts.v3.PostOwnerTransferRequest")
+// PostRevision contains the contents that a post had before being edited
+type PostRevision struct {
+	// Id of the subspace inside which the post has been created
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the edited post
+	PostID uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty" yaml:"post_id"`
+	// Id of the revision, unique within the post
+	ID uint32 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// Text that the post had before the edit
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty" yaml:"text"`
+	// Entities that the post had before the edit
+	Entities *Entities `protobuf:"bytes,5,opt,name=entities,proto3" json:"entities,omitempty" yaml:"entities"`
+	// Tags that the post had before the edit
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" yaml:"tags"`
+	// Address of the user that performed the edit
+	Editor string `protobuf:"bytes,7,opt,name=editor,proto3" json:"editor,omitempty" yaml:"editor"`
+	// Time at which the edit has been performed
+	EditDate time.Time `protobuf:"bytes,8,opt,name=edit_date,json=editDate,proto3,stdtime" json:"edit_date" yaml:"edit_date"`
+}
+
+func (m *PostRevision) Reset()         { *m = PostRevision{} }
+func (m *PostRevision) String() string { return proto.CompactTextString(m) }
+func (*PostRevision) ProtoMessage()    {}
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e330452b10ff3223, []int{15}
+}
+func (m *PostRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostRevision.Merge(m, src)
+}
+func (m *PostRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostRevision.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("desmos/posts/v3/models.proto", fileDescriptor_e330452b10ff3223) }
+var xxx_messageInfo_PostRevision proto.InternalMessageInfo
 
-var fileDescriptor_e330452b10ff3223 = []byte{
-	// 1789 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x92, 0xb4, 0x44, 0x8d, 0x44, 0x8a, 0x1a, 0xc9, 0x36, 0x4d, 0x3b, 0x5c, 0x66, 0xe2,
-	0xb6, 0xaa, 0x5b, 0x93, 0xa8, 0x8d, 0xc2, 0x80, 0x02, 0x14, 0x10, 0xed, 0xb5, 0xc3, 0x42, 0x16,
-	0xd5, 0x21, 0xd9, 0xc0, 0xb9, 0x2c, 0x56, 0xdc, 0x11, 0xb5, 0xc0, 0x72, 0x97, 0xd9, 0x19, 0xca,
-	0x22, 0x50, 0xa0, 0xed, 0xad, 0x68, 0x51, 0x20, 0xb7, 0xf6, 0xd0, 0x43, 0x90, 0x5e, 0x7a, 0x2a,
-	0x72, 0xf0, 0x1f, 0x11, 0xe4, 0x14, 0xe4, 0xd4, 0xd3, 0xb6, 0xa0, 0x0f, 0xe9, 0x99, 0x7f, 0x41,
-	0x31, 0x5f, 0xe4, 0x92, 0xfa, 0x70, 0x52, 0x04, 0xf0, 0x85, 0xe0, 0xbc, 0xf7, 0x7e, 0xef, 0xcd,
-	0xbc, 0xef, 0x05, 0x77, 0x5c, 0x42, 0xfb, 0x21, 0xad, 0x0d, 0x42, 0xca, 0x68, 0xed, 0xf4, 0x61,
-	0xad, 0x1f, 0xba, 0xc4, 0xa7, 0xd5, 0x41, 0x14, 0xb2, 0x10, 0x6e, 0x48, 0x6e, 0x55, 0x70, 0xab,
-	0xa7, 0x0f, 0x4b, 0x9b, 0x4e, 0xdf, 0x0b, 0xc2, 0x9a, 0xf8, 0x95, 0x32, 0xa5, 0xed, 0x5e, 0xd8,
-	0x0b, 0xc5, 0xdf, 0x1a, 0xff, 0xa7, 0xa8, 0xb7, 0x7a, 0x61, 0xd8, 0xf3, 0x49, 0x4d, 0x9c, 0x8e,
-	0x86, 0xc7, 0x35, 0x27, 0x18, 0x29, 0x96, 0xb9, 0xc8, 0x62, 0x5e, 0x9f, 0x50, 0xe6, 0xf4, 0x07,
-	0x1a, 0xdb, 0x0d, 0xb9, 0x55, 0x5b, 0x2a, 0x95, 0x07, 0xc9, 0x42, 0x7f, 0xc9, 0x82, 0xcc, 0x61,
-	0x48, 0x19, 0xb4, 0xc0, 0x1a, 0x1d, 0x1e, 0xd1, 0x81, 0xd3, 0x25, 0xb6, 0xe7, 0x16, 0x8d, 0x8a,
-	0xb1, 0x93, 0xa9, 0xdf, 0x1d, 0xc7, 0x26, 0x68, 0x29, 0x72, 0xe3, 0xc9, 0x24, 0x36, 0xe1, 0xc8,
-	0xe9, 0xfb, 0xbb, 0x28, 0x21, 0x8a, 0x30, 0xd0, 0xa7, 0x86, 0x0b, 0xf7, 0x00, 0xa0, 0xa4, 0xcb,
-	0xbc, 0x30, 0xe0, 0x5a, 0x52, 0x15, 0x63, 0x27, 0x57, 0x47, 0xe3, 0xd8, 0x5c, 0x6d, 0x49, 0xaa,
-	0x50, 0xb2, 0xa9, 0x94, 0x4c, 0x05, 0x11, 0x5e, 0x55, 0x87, 0x86, 0x0b, 0xdf, 0x03, 0x29, 0xcf,
-	0x2d, 0xa6, 0xc5, 0x05, 0xb6, 0xc6, 0xb1, 0x99, 0x12, 0x98, 0x55, 0x89, 0xe1, 0xb2, 0x29, 0xcf,
-	0xe5, 0xd7, 0x25, 0x67, 0x8c, 0x44, 0x81, 0xe3, 0x73, 0x43, 0x99, 0x8a, 0xb1, 0xb3, 0x2a, 0xaf,
-	0x6b, 0x29, 0x72, 0xf2, 0xba, 0x09, 0x51, 0x84, 0x81, 0x3e, 0x09, 0x5b, 0x19, 0x46, 0xce, 0x58,
-	0xf1, 0x9a, 0xc0, 0x6f, 0x4c, 0x62, 0x73, 0x4d, 0x22, 0x38, 0x15, 0x61, 0xc1, 0x84, 0xbf, 0x04,
-	0x59, 0x12, 0x30, 0x8f, 0x79, 0x84, 0x16, 0x97, 0x2b, 0xc6, 0xce, 0xda, 0x83, 0x5b, 0xd5, 0x85,
-	0x38, 0x56, 0x2d, 0x25, 0x50, 0xdf, 0x9a, 0xc4, 0xe6, 0x86, 0xb2, 0xaa, 0x68, 0x08, 0x4f, 0xf1,
-	0xc2, 0xa0, 0xd3, 0xa3, 0xc5, 0x95, 0x4a, 0x7a, 0xc1, 0xa0, 0xd3, 0xa3, 0xdc, 0xa0, 0xd3, 0xa3,
-	0x70, 0x0f, 0x2c, 0x3b, 0x43, 0x76, 0x12, 0x46, 0xc5, 0xac, 0xb8, 0xd7, 0x8f, 0x27, 0xb1, 0x99,
-	0x93, 0x62, 0x92, 0x8e, 0xbe, 0x7e, 0x75, 0x7f, 0x5b, 0xc5, 0x71, 0xcf, 0x75, 0x23, 0x42, 0x69,
-	0x8b, 0x45, 0x5e, 0xd0, 0xc3, 0x0a, 0x08, 0x3b, 0x60, 0xa3, 0x1b, 0x06, 0xa7, 0x24, 0xa2, 0x8e,
-	0x0e, 0xc6, 0xaa, 0xf0, 0xe8, 0x4f, 0xc7, 0xb1, 0x99, 0x7f, 0x9c, 0x60, 0x09, 0x3f, 0xdd, 0x90,
-	0xda, 0x17, 0x20, 0x08, 0xe7, 0x93, 0x94, 0x86, 0x0b, 0x43, 0x50, 0x88, 0xc8, 0x31, 0x89, 0x48,
-	0xd0, 0x25, 0xae, 0x2d, 0x5e, 0x5f, 0x04, 0x95, 0xf4, 0xce, 0xda, 0x83, 0xf2, 0x39, 0x97, 0xf0,
-	0xb4, 0xc2, 0x5a, 0xb8, 0x7e, 0xf7, 0x8b, 0xd8, 0x5c, 0x9a, 0xc4, 0xe6, 0x4d, 0x69, 0x69, 0x51,
-	0x0b, 0xfa, 0xc7, 0x37, 0x9f, 0xdf, 0x33, 0xf0, 0xc6, 0x8c, 0xce, 0xe1, 0x14, 0xda, 0x20, 0x1f,
-	0x91, 0x81, 0x3f, 0xb2, 0x29, 0x61, 0xcc, 0x0b, 0x7a, 0xb4, 0xb8, 0x56, 0x31, 0x76, 0xf2, 0x0f,
-	0xde, 0x39, 0x67, 0x0e, 0x73, 0xb1, 0x96, 0x94, 0xaa, 0xdf, 0x9a, 0xc4, 0xe6, 0x75, 0x6d, 0x29,
-	0x09, 0x47, 0x38, 0x17, 0x25, 0x04, 0x29, 0x3c, 0x06, 0xb9, 0x6e, 0x44, 0xe4, 0x8b, 0x5d, 0x87,
-	0x91, 0xe2, 0xba, 0x88, 0x70, 0xa9, 0x2a, 0x8b, 0xaa, 0xaa, 0x8b, 0xaa, 0xda, 0xd6, 0x45, 0x55,
-	0xff, 0x81, 0x7a, 0xca, 0xb6, 0x72, 0x5a, 0x12, 0x8e, 0x3e, 0xf9, 0xb7, 0x69, 0xc8, 0xb7, 0xac,
-	0x6b, 0xc6, 0x13, 0x87, 0x11, 0x48, 0x40, 0xc1, 0x77, 0x28, 0xb3, 0x89, 0xeb, 0x31, 0xe2, 0x4a,
-	0x53, 0xb9, 0x37, 0x9a, 0x32, 0x67, 0x1e, 0x5b, 0x44, 0x0b, 0x4b, 0x38, 0xcf, 0xc9, 0x96, 0xa0,
-	0x0a, 0x33, 0xbf, 0x00, 0xd7, 0xc2, 0x97, 0x01, 0x89, 0x8a, 0x79, 0x91, 0x39, 0x3b, 0x93, 0xd8,
-	0x5c, 0x97, 0x78, 0x41, 0xbe, 0x3c, 0x71, 0x24, 0x6c, 0x37, 0xfb, 0xd7, 0x4f, 0x4d, 0xe3, 0xbf,
-	0x9f, 0x9a, 0x06, 0xfa, 0xd2, 0x00, 0xb9, 0xb9, 0x10, 0xc2, 0x67, 0x20, 0xc3, 0x46, 0x03, 0x22,
-	0x7a, 0x43, 0xfe, 0x01, 0xba, 0x3a, 0xe0, 0xed, 0xd1, 0x80, 0xcc, 0xe5, 0xf7, 0x68, 0x40, 0x78,
-	0x7e, 0x8f, 0x06, 0x04, 0xfe, 0x1c, 0xac, 0x70, 0x90, 0xee, 0x10, 0x99, 0xfa, 0x9d, 0x71, 0x6c,
-	0x2e, 0x73, 0xb8, 0x48, 0xc6, 0xbc, 0x44, 0x28, 0x11, 0x84, 0x97, 0xf9, 0xbf, 0x86, 0x0b, 0x6b,
-	0x20, 0x3b, 0x08, 0xa9, 0xc7, 0x5d, 0xaa, 0xdb, 0xc3, 0xac, 0xd8, 0x34, 0x07, 0xe1, 0xa9, 0x50,
-	0xe2, 0x31, 0xbf, 0x4b, 0x81, 0xac, 0x2e, 0x51, 0x88, 0x41, 0xf6, 0xc4, 0xa1, 0x27, 0xa2, 0x0e,
-	0x0d, 0x91, 0xbc, 0xc5, 0x73, 0x6f, 0x69, 0x93, 0x33, 0xd6, 0x76, 0x7a, 0xf5, 0x3b, 0x2a, 0xd6,
-	0xca, 0x8a, 0xc6, 0xa9, 0x74, 0x9d, 0xea, 0xe1, 0x3a, 0xfb, 0xbc, 0xc8, 0xc3, 0x80, 0x16, 0x53,
-	0xdf, 0x4d, 0xa7, 0xc6, 0x69, 0x9d, 0xfa, 0x0c, 0xeb, 0x20, 0x33, 0x8c, 0x7c, 0x5a, 0x4c, 0x0b,
-	0x7d, 0xdb, 0xe7, 0xf4, 0x75, 0x22, 0xbf, 0x5e, 0x54, 0xba, 0x94, 0x97, 0xb9, 0xbc, 0xd2, 0x23,
-	0xb0, 0x09, 0x17, 0xfc, 0x16, 0xac, 0xa8, 0x0b, 0xc0, 0x1f, 0x82, 0x6b, 0x94, 0x39, 0x11, 0x53,
-	0x5d, 0xbe, 0x30, 0x4b, 0x12, 0x41, 0x46, 0x58, 0xb2, 0x61, 0x05, 0xa4, 0x49, 0xa0, 0x63, 0x94,
-	0x9f, 0xc4, 0x26, 0xd0, 0x8d, 0xcd, 0x45, 0x98, 0xb3, 0xb8, 0x04, 0x73, 0x7a, 0x22, 0x1a, 0xab,
-	0x49, 0x09, 0xe6, 0xf4, 0x10, 0xe6, 0xac, 0xc4, 0x05, 0x5e, 0x19, 0x20, 0xdd, 0x89, 0xfc, 0xef,
-	0xd7, 0xfa, 0x30, 0xf2, 0xcf, 0x5b, 0x1f, 0x46, 0x3e, 0xc2, 0x9c, 0x05, 0x1f, 0x81, 0x35, 0xd7,
-	0xa3, 0x03, 0xdf, 0x19, 0xd9, 0x5c, 0x52, 0x8e, 0x89, 0x1b, 0xb3, 0xc1, 0x90, 0x60, 0x22, 0x0c,
-	0xd4, 0xa9, 0x13, 0xf9, 0x89, 0x6b, 0x7f, 0x96, 0x02, 0x60, 0x8f, 0x31, 0xa7, 0x7b, 0xc2, 0x03,
-	0xf3, 0x7d, 0xcd, 0xc9, 0xff, 0xb3, 0x04, 0x66, 0xb3, 0x31, 0x77, 0xf9, 0x6c, 0xec, 0x81, 0x95,
-	0x6e, 0x18, 0x30, 0x12, 0x30, 0xf1, 0x60, 0x9e, 0x3a, 0x8b, 0x1d, 0x66, 0x2f, 0x18, 0xd5, 0x1f,
-	0xcd, 0xec, 0x28, 0x71, 0xf4, 0xe5, 0xab, 0xfb, 0xef, 0x2e, 0x66, 0xd9, 0xec, 0xed, 0x8f, 0xa5,
-	0x14, 0xd6, 0xda, 0x13, 0x4e, 0xfa, 0x93, 0x01, 0xae, 0x3d, 0x27, 0xae, 0xe7, 0xc8, 0x98, 0x78,
-	0xe2, 0x51, 0x0b, 0x31, 0xf1, 0x44, 0x4c, 0x3c, 0xf8, 0x33, 0xb0, 0xda, 0xf7, 0xfa, 0xc4, 0x16,
-	0xbd, 0x44, 0xc6, 0x6e, 0x7b, 0x12, 0x9b, 0x05, 0x55, 0x0d, 0x9a, 0x85, 0x70, 0x96, 0xff, 0xe7,
-	0x7d, 0x64, 0xf7, 0xbe, 0x36, 0xf4, 0xad, 0x2e, 0x88, 0xfe, 0xbc, 0xcc, 0x97, 0x1a, 0xdf, 0xe7,
-	0x1d, 0xe3, 0xe3, 0x21, 0xa1, 0xa2, 0x63, 0x18, 0xc2, 0x52, 0xa2, 0x63, 0x68, 0x0e, 0xc2, 0x53,
-	0x21, 0x38, 0x04, 0x85, 0x41, 0x14, 0x9e, 0x7a, 0x2e, 0x71, 0x6d, 0x27, 0xa0, 0x2f, 0x49, 0xa4,
-	0xcb, 0xf9, 0xee, 0x05, 0xed, 0xce, 0xf7, 0xab, 0x87, 0x4a, 0x7a, 0x4f, 0x08, 0x2f, 0x4e, 0xb9,
-	0x45, 0x5d, 0x7a, 0xca, 0x0d, 0xe6, 0x50, 0x14, 0x7e, 0xc8, 0x37, 0x0c, 0x35, 0x14, 0xd2, 0x6f,
-	0x1c, 0x0a, 0x95, 0xf9, 0xfe, 0xa1, 0x91, 0x89, 0xd1, 0xb3, 0x42, 0x02, 0x39, 0x0e, 0x3e, 0x02,
-	0x37, 0x1d, 0xdf, 0x0f, 0x5f, 0x52, 0xbb, 0x3f, 0xf4, 0x99, 0x37, 0xf0, 0xc9, 0xf4, 0x59, 0x3c,
-	0x35, 0xb2, 0x75, 0x34, 0x89, 0xcd, 0xb2, 0x5a, 0x2d, 0x2e, 0x16, 0x44, 0xf8, 0xba, 0xe4, 0x3c,
-	0x57, 0x0c, 0x7d, 0xe9, 0x26, 0xd8, 0x52, 0x10, 0x29, 0x29, 0x86, 0x13, 0x15, 0xab, 0x54, 0x56,
-	0x0c, 0xae, 0xdb, 0x73, 0x7a, 0x95, 0x3a, 0x29, 0x85, 0xf0, 0xa6, 0x24, 0x4b, 0x65, 0x7c, 0x80,
-	0x51, 0xf8, 0x31, 0xd8, 0x3a, 0xf6, 0xf8, 0x96, 0xc6, 0x1c, 0xdf, 0x1f, 0xd9, 0x11, 0xa1, 0x43,
-	0x9f, 0xe9, 0x95, 0xeb, 0xdd, 0x0b, 0xfd, 0xdf, 0xe6, 0x92, 0x58, 0x0a, 0xd6, 0xcb, 0x93, 0xd8,
-	0x2c, 0x49, 0x9b, 0x17, 0xe8, 0x41, 0x78, 0x53, 0x50, 0x93, 0x90, 0xd2, 0x2b, 0x03, 0xe4, 0xe7,
-	0x43, 0x38, 0x5d, 0x09, 0x8d, 0xab, 0x56, 0x42, 0x0a, 0xd6, 0x9c, 0x69, 0xda, 0xe9, 0x14, 0xb9,
-	0xb8, 0xcc, 0xde, 0x9f, 0x75, 0x83, 0x04, 0xe4, 0x5b, 0x96, 0x5a, 0xd2, 0xca, 0xac, 0xdc, 0xbe,
-	0x6b, 0x3d, 0x7c, 0x9d, 0x02, 0xa0, 0x43, 0x49, 0xa4, 0x5e, 0xf8, 0x76, 0x5b, 0x98, 0x80, 0xf9,
-	0x62, 0x6b, 0x97, 0x7d, 0x4c, 0xc1, 0x7c, 0x7f, 0x1e, 0xe6, 0xfb, 0x1a, 0xe6, 0xf3, 0x4d, 0xfd,
-	0x03, 0xb0, 0xa1, 0x33, 0xc8, 0x0b, 0x5c, 0x72, 0x46, 0x78, 0x06, 0xa7, 0x77, 0x72, 0x22, 0xd3,
-	0xd4, 0xfa, 0xba, 0x20, 0xa0, 0xaa, 0x2d, 0xaf, 0xc8, 0x0d, 0x49, 0x85, 0xef, 0x83, 0xcc, 0x90,
-	0x92, 0x48, 0xed, 0xfc, 0x3f, 0x4a, 0x0c, 0x4f, 0x7a, 0xd5, 0x82, 0x24, 0x40, 0x89, 0x96, 0xf7,
-	0xfb, 0x14, 0x28, 0x2c, 0xa6, 0x20, 0x24, 0x60, 0x45, 0xa7, 0xad, 0xdc, 0x2c, 0xaa, 0x6f, 0x4c,
-	0xdb, 0xaa, 0x8c, 0x8a, 0x3c, 0xd5, 0x6f, 0xab, 0xda, 0xce, 0xeb, 0xe5, 0x55, 0xe6, 0xae, 0x2a,
-	0x6b, 0x75, 0x2c, 0xfd, 0x06, 0xac, 0x27, 0x51, 0x70, 0x17, 0xac, 0xab, 0x1a, 0x14, 0x4f, 0x17,
-	0x21, 0xcd, 0xd5, 0x6f, 0x4e, 0x62, 0x73, 0x2b, 0xe9, 0x19, 0xc9, 0x45, 0x78, 0x4d, 0x1e, 0x85,
-	0x43, 0xf8, 0x38, 0x3e, 0x0d, 0x19, 0xa1, 0x2a, 0x88, 0x89, 0x71, 0x2c, 0xc8, 0x08, 0x4b, 0x76,
-	0x22, 0xfb, 0x66, 0x3e, 0x18, 0x80, 0xe5, 0x43, 0x27, 0x72, 0xfa, 0x7c, 0x57, 0xd9, 0xe8, 0x3b,
-	0x67, 0x36, 0x2f, 0x0e, 0xdb, 0x27, 0x41, 0x8f, 0x9d, 0xa8, 0x4b, 0x94, 0x66, 0xe1, 0x59, 0x10,
-	0x40, 0x38, 0xd7, 0x77, 0xce, 0xf8, 0x4e, 0xb2, 0x2f, 0xce, 0xbb, 0x48, 0xeb, 0xfd, 0xe3, 0x37,
-	0x9f, 0xdf, 0xbb, 0xae, 0xbe, 0xa3, 0xcf, 0xd4, 0x97, 0xb4, 0xb4, 0x83, 0xfe, 0x99, 0x02, 0x45,
-	0x9e, 0x62, 0x4d, 0xbe, 0xad, 0xb6, 0x23, 0x27, 0xa0, 0xc7, 0xdc, 0x0b, 0xa2, 0x81, 0xbf, 0xe5,
-	0xc4, 0xde, 0x03, 0xcb, 0x94, 0x04, 0x2e, 0x89, 0xd4, 0x50, 0x4b, 0x7c, 0xb5, 0x49, 0xfa, 0x15,
-	0x5f, 0x6d, 0x52, 0x00, 0x3e, 0x03, 0xd9, 0x88, 0x74, 0x89, 0x77, 0x4a, 0x22, 0xb5, 0xab, 0xfc,
-	0x64, 0xd6, 0xe7, 0x35, 0xe7, 0x72, 0x35, 0x53, 0xf0, 0x2c, 0x44, 0xf7, 0xfe, 0x66, 0x80, 0xcd,
-	0x73, 0x8b, 0x39, 0x7c, 0x0f, 0x98, 0x87, 0xcd, 0x56, 0xdb, 0xc6, 0xd6, 0x53, 0x0b, 0x5b, 0x07,
-	0x8f, 0x2d, 0xbb, 0xfd, 0xe2, 0xd0, 0xb2, 0x3b, 0x07, 0xad, 0x43, 0xeb, 0x71, 0xe3, 0x69, 0xc3,
-	0x7a, 0x52, 0x58, 0x82, 0xef, 0x80, 0x5b, 0x17, 0x09, 0x61, 0xeb, 0x70, 0xff, 0x45, 0xc1, 0xb8,
-	0x8c, 0xfd, 0xab, 0x4e, 0xb3, 0x6d, 0x15, 0x52, 0xb0, 0x0c, 0x4a, 0x97, 0xa0, 0x9b, 0xad, 0x76,
-	0x21, 0x5d, 0xca, 0xfc, 0xe1, 0xef, 0xe5, 0xa5, 0x7b, 0x9f, 0x19, 0x60, 0x3d, 0xf9, 0xe5, 0xc6,
-	0xb5, 0x0a, 0x03, 0x76, 0xcb, 0x6a, 0xb7, 0x1b, 0x07, 0xcf, 0x16, 0xee, 0x54, 0x02, 0x37, 0xe6,
-	0xd9, 0xd6, 0xaf, 0x2d, 0xfc, 0xa2, 0x79, 0x60, 0x15, 0x0c, 0x78, 0x1b, 0xdc, 0x9c, 0xe7, 0x3d,
-	0x6d, 0xee, 0xef, 0x37, 0x3f, 0xb4, 0x70, 0xab, 0x90, 0x82, 0x45, 0xb0, 0x3d, 0xcf, 0x7c, 0xde,
-	0x69, 0x77, 0xf6, 0xf6, 0x0b, 0xe9, 0xf3, 0x2a, 0x9f, 0x5b, 0x07, 0xed, 0x46, 0xf3, 0xa0, 0x55,
-	0xc8, 0xc8, 0x4b, 0xd6, 0x3f, 0xf8, 0x62, 0x5c, 0x36, 0xbe, 0x1a, 0x97, 0x8d, 0xff, 0x8c, 0xcb,
-	0xc6, 0x27, 0xaf, 0xcb, 0x4b, 0x5f, 0xbd, 0x2e, 0x2f, 0xfd, 0xeb, 0x75, 0x79, 0xe9, 0xa3, 0x6a,
-	0xcf, 0x63, 0x27, 0xc3, 0xa3, 0x6a, 0x37, 0xec, 0xd7, 0x64, 0xc2, 0xde, 0xf7, 0x9d, 0x23, 0xaa,
-	0xfe, 0xd7, 0x4e, 0x1f, 0x4d, 0xf3, 0x97, 0xaf, 0x34, 0xf4, 0x68, 0x59, 0x8c, 0x86, 0x87, 0xff,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x7b, 0xf3, 0x86, 0x26, 0x12, 0x00, 0x00,
+func (m *PostRevision) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
 }
 
-func (this *Post) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *PostRevision) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
 	}
+	return 0
+}
 
-	that1, ok := that.(*Post)
-	if !ok {
-		that2, ok := that.(Post)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *PostRevision) GetID() uint32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *PostRevision) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *PostRevision) GetEntities() *Entities {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+func (m *PostRevision) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *PostRevision) GetEditor() string {
+	if m != nil {
+		return m.Editor
+	}
+	return ""
+}
+
+func (m *PostRevision) GetEditDate() time.Time {
+	if m != nil {
+		return m.EditDate
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("desmos.posts.v3.PostReferenceType", PostReferenceType_name, PostReferenceType_value)
+	proto.RegisterEnum("desmos.posts.v3.ReplySetting", ReplySetting_name, ReplySetting_value)
+	proto.RegisterEnum("desmos.posts.v3.PollMode", PollMode_name, PollMode_value)
+	proto.RegisterType((*Post)(nil), "desmos.posts.v3.Post")
+	proto.RegisterType((*PostReference)(nil), "desmos.posts.v3.PostReference")
+	proto.RegisterType((*Entities)(nil), "desmos.posts.v3.Entities")
+	proto.RegisterType((*TextTag)(nil), "desmos.posts.v3.TextTag")
+	proto.RegisterType((*Url)(nil), "desmos.posts.v3.Url")
+	proto.RegisterType((*Attachment)(nil), "desmos.posts.v3.Attachment")
+	proto.RegisterType((*Media)(nil), "desmos.posts.v3.Media")
+	proto.RegisterType((*ContentHash)(nil), "desmos.posts.v3.ContentHash")
+	proto.RegisterType((*LinkPreview)(nil), "desmos.posts.v3.LinkPreview")
+	proto.RegisterType((*Poll)(nil), "desmos.posts.v3.Poll")
+	proto.RegisterType((*Poll_ProvidedAnswer)(nil), "desmos.posts.v3.Poll.ProvidedAnswer")
+	proto.RegisterType((*PollWeighting)(nil), "desmos.posts.v3.PollWeighting")
+	proto.RegisterType((*UserAnswer)(nil), "desmos.posts.v3.UserAnswer")
+	proto.RegisterType((*PollTallyResults)(nil), "desmos.posts.v3.PollTallyResults")
+	proto.RegisterType((*PollTallyResults_AnswerResult)(nil), "desmos.posts.v3.PollTallyResults.AnswerResult")
+	proto.RegisterType((*PollTallyResults_Round)(nil), "desmos.posts.v3.PollTallyResults.Round")
+	proto.RegisterType((*Params)(nil), "desmos.posts.v3.Params")
+	proto.RegisterType((*PostOwnerTransferRequest)(nil), "desmos.posts.v3.PostOwnerTransferRequest")
+	proto.RegisterType((*PostRevision)(nil), "desmos.posts.v3.PostRevision")
+}
+
+func init() { proto.RegisterFile("desmos/posts/v3/models.proto", fileDescriptor_e330452b10ff3223) }
+
+var fileDescriptor_e330452b10ff3223 = []byte{
+	// 2386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x52, 0x94, 0x44, 0x8e, 0x24, 0x8a, 0x1a, 0x29, 0xf6, 0x5a, 0x71, 0xb4, 0xca, 0xc4,
+	0x75, 0x64, 0x37, 0xa6, 0x5a, 0x1b, 0x85, 0x0b, 0x1b, 0x4d, 0x4b, 0x4a, 0xb4, 0xcd, 0x56, 0x12,
+	0xd9, 0x21, 0x15, 0xc3, 0x3e, 0x74, 0xb1, 0xe6, 0x8e, 0xa9, 0x6d, 0xf6, 0x87, 0xd9, 0x19, 0xca,
+	0x62, 0x2e, 0xcd, 0xb1, 0xe8, 0x29, 0xc7, 0x1e, 0x7a, 0x08, 0x52, 0xa0, 0xe8, 0xa9, 0x48, 0x01,
+	0x5f, 0x7b, 0x0f, 0x72, 0x4a, 0x73, 0x2a, 0x7a, 0x60, 0x0b, 0xf9, 0x90, 0xa2, 0xa7, 0x82, 0xe7,
+	0x1e, 0x8a, 0xf9, 0x59, 0xee, 0x72, 0x25, 0x59, 0x76, 0x61, 0x34, 0xe8, 0x85, 0xdc, 0x7d, 0xef,
+	0x7d, 0xef, 0xcd, 0xbc, 0xbf, 0x79, 0xb3, 0xe0, 0xa2, 0x4d, 0xa8, 0x17, 0xd0, 0x8d, 0x6e, 0x40,
+	0x19, 0xdd, 0x38, 0xb8, 0xb1, 0xe1, 0x05, 0x36, 0x71, 0x69, 0xa9, 0x1b, 0x06, 0x2c, 0x80, 0x0b,
+	0x92, 0x5b, 0x12, 0xdc, 0xd2, 0xc1, 0x8d, 0x95, 0x45, 0xcb, 0x73, 0xfc, 0x60, 0x43, 0xfc, 0x4a,
+	0x99, 0x95, 0xe5, 0x4e, 0xd0, 0x09, 0xc4, 0xe3, 0x06, 0x7f, 0x52, 0xd4, 0x0b, 0x9d, 0x20, 0xe8,
+	0xb8, 0x64, 0x43, 0xbc, 0x3d, 0xea, 0x3d, 0xde, 0xb0, 0xfc, 0xbe, 0x62, 0x19, 0x69, 0x16, 0x73,
+	0x3c, 0x42, 0x99, 0xe5, 0x75, 0x23, 0x6c, 0x3b, 0xe0, 0x56, 0x4d, 0xa9, 0x54, 0xbe, 0x48, 0x16,
+	0xfa, 0x77, 0x0e, 0x64, 0x1b, 0x01, 0x65, 0xb0, 0x0a, 0x66, 0x69, 0xef, 0x11, 0xed, 0x5a, 0x6d,
+	0x62, 0x3a, 0xb6, 0xae, 0xad, 0x69, 0xeb, 0xd9, 0xca, 0xa5, 0xa3, 0x81, 0x01, 0x9a, 0x8a, 0x5c,
+	0xdb, 0x1a, 0x0e, 0x0c, 0xd8, 0xb7, 0x3c, 0xf7, 0x16, 0x4a, 0x88, 0x22, 0x0c, 0xa2, 0xb7, 0x9a,
+	0x0d, 0xcb, 0x00, 0x50, 0xd2, 0x66, 0x4e, 0xe0, 0x73, 0x2d, 0x99, 0x35, 0x6d, 0x7d, 0xbe, 0x82,
+	0x8e, 0x06, 0x46, 0xbe, 0x29, 0xa9, 0x42, 0xc9, 0xa2, 0x52, 0x32, 0x12, 0x44, 0x38, 0xaf, 0x5e,
+	0x6a, 0x36, 0x7c, 0x0b, 0x64, 0x1c, 0x5b, 0x9f, 0x14, 0x0b, 0x58, 0x3a, 0x1a, 0x18, 0x19, 0x81,
+	0xc9, 0x4b, 0x0c, 0x97, 0xcd, 0x38, 0x36, 0x5f, 0x2e, 0x39, 0x64, 0x24, 0xf4, 0x2d, 0x97, 0x1b,
+	0xca, 0xae, 0x69, 0xeb, 0x79, 0xb9, 0xdc, 0xaa, 0x22, 0x27, 0x97, 0x9b, 0x10, 0x45, 0x18, 0x44,
+	0x6f, 0xc2, 0x56, 0x96, 0x91, 0x43, 0xa6, 0x4f, 0x09, 0xfc, 0xc2, 0x70, 0x60, 0xcc, 0x4a, 0x04,
+	0xa7, 0x22, 0x2c, 0x98, 0xf0, 0xc7, 0x20, 0x47, 0x7c, 0xe6, 0x30, 0x87, 0x50, 0x7d, 0x7a, 0x4d,
+	0x5b, 0x9f, 0xbd, 0x7e, 0xa1, 0x94, 0x8a, 0x63, 0xa9, 0xaa, 0x04, 0x2a, 0x4b, 0xc3, 0x81, 0xb1,
+	0xa0, 0xac, 0x2a, 0x1a, 0xc2, 0x23, 0xbc, 0x30, 0x68, 0x75, 0xa8, 0x3e, 0xb3, 0x36, 0x99, 0x32,
+	0x68, 0x75, 0x28, 0x37, 0x68, 0x75, 0x28, 0x2c, 0x83, 0x69, 0xab, 0xc7, 0xf6, 0x83, 0x50, 0xcf,
+	0x89, 0x75, 0x5d, 0x19, 0x0e, 0x8c, 0x79, 0x29, 0x26, 0xe9, 0xe8, 0xab, 0xa7, 0xd7, 0x96, 0x55,
+	0x1c, 0xcb, 0xb6, 0x1d, 0x12, 0x4a, 0x9b, 0x2c, 0x74, 0xfc, 0x0e, 0x56, 0x40, 0xb8, 0x07, 0x16,
+	0xda, 0x81, 0x7f, 0x40, 0x42, 0x6a, 0x45, 0xc1, 0xc8, 0x0b, 0x8f, 0xbe, 0x73, 0x34, 0x30, 0x0a,
+	0x9b, 0x09, 0x96, 0xf0, 0xd3, 0x39, 0xa9, 0x3d, 0x05, 0x41, 0xb8, 0x90, 0xa4, 0xd4, 0x6c, 0x18,
+	0x80, 0x62, 0x48, 0x1e, 0x93, 0x90, 0xf8, 0x6d, 0x62, 0x9b, 0x62, 0xf7, 0x3a, 0x58, 0x9b, 0x5c,
+	0x9f, 0xbd, 0xbe, 0x7a, 0xcc, 0x25, 0x3c, 0xad, 0x70, 0x24, 0x5c, 0xb9, 0xf4, 0xf9, 0xc0, 0x98,
+	0x18, 0x0e, 0x8c, 0xf3, 0xd2, 0x52, 0x5a, 0x0b, 0xfa, 0xfd, 0xd7, 0x9f, 0x5d, 0xd5, 0xf0, 0x42,
+	0x4c, 0xe7, 0x70, 0x0a, 0x4d, 0x50, 0x08, 0x49, 0xd7, 0xed, 0x9b, 0x94, 0x30, 0xe6, 0xf8, 0x1d,
+	0xaa, 0xcf, 0xae, 0x69, 0xeb, 0x85, 0xeb, 0x6f, 0x1c, 0x33, 0x87, 0xb9, 0x58, 0x53, 0x4a, 0x55,
+	0x2e, 0x0c, 0x07, 0xc6, 0x6b, 0x91, 0xa5, 0x24, 0x1c, 0xe1, 0xf9, 0x30, 0x21, 0x48, 0xe1, 0x63,
+	0x30, 0xdf, 0x0e, 0x89, 0xdc, 0xb1, 0x6d, 0x31, 0xa2, 0xcf, 0x89, 0x08, 0xaf, 0x94, 0x64, 0x51,
+	0x95, 0xa2, 0xa2, 0x2a, 0xb5, 0xa2, 0xa2, 0xaa, 0x7c, 0x4b, 0x6d, 0x65, 0x59, 0x39, 0x2d, 0x09,
+	0x47, 0x1f, 0xff, 0xcd, 0xd0, 0xe4, 0x5e, 0xe6, 0x22, 0xc6, 0x96, 0xc5, 0x08, 0x24, 0xa0, 0xe8,
+	0x5a, 0x94, 0x99, 0xc4, 0x76, 0x18, 0xb1, 0xa5, 0xa9, 0xf9, 0x33, 0x4d, 0x19, 0xb1, 0xc7, 0xd2,
+	0x68, 0x61, 0x09, 0x17, 0x38, 0xb9, 0x2a, 0xa8, 0xc2, 0xcc, 0xbb, 0x60, 0x2a, 0x78, 0xe2, 0x93,
+	0x50, 0x2f, 0x88, 0xcc, 0x59, 0x1f, 0x0e, 0x8c, 0x39, 0x89, 0x17, 0xe4, 0xd3, 0x13, 0x47, 0xc2,
+	0x60, 0x1b, 0x2c, 0x90, 0xc3, 0xae, 0x13, 0xca, 0x1d, 0xf1, 0x46, 0xa2, 0x2f, 0x9c, 0xb9, 0xca,
+	0xd5, 0x38, 0x83, 0x52, 0x60, 0xb5, 0xc8, 0x98, 0xca, 0x41, 0xb7, 0x72, 0xbf, 0xfe, 0xc4, 0xd0,
+	0xfe, 0xf1, 0x89, 0xa1, 0xa1, 0x2f, 0x34, 0x30, 0x3f, 0x96, 0x27, 0xf0, 0x2e, 0xc8, 0xb2, 0x7e,
+	0x97, 0x88, 0x06, 0x54, 0xb8, 0x8e, 0x9e, 0x9f, 0x55, 0xad, 0x7e, 0x97, 0x8c, 0x15, 0x51, 0xbf,
+	0x4b, 0x78, 0x11, 0xf5, 0xbb, 0x04, 0x7e, 0x0f, 0xcc, 0x70, 0x50, 0xd4, 0x86, 0xb2, 0x95, 0x8b,
+	0x47, 0x03, 0x63, 0x9a, 0xc3, 0x45, 0xc6, 0x17, 0x24, 0x42, 0x89, 0x20, 0x3c, 0xcd, 0x9f, 0x6a,
+	0x36, 0xdc, 0x00, 0xb9, 0x6e, 0x40, 0x1d, 0xbe, 0xd6, 0xa8, 0x07, 0xc5, 0x15, 0x1d, 0x71, 0x10,
+	0x1e, 0x09, 0x25, 0x36, 0xf3, 0x51, 0x06, 0xe4, 0xa2, 0x3e, 0x00, 0x31, 0xc8, 0xed, 0x5b, 0x74,
+	0x5f, 0x14, 0xbb, 0x26, 0x2a, 0x44, 0x3f, 0xb6, 0x97, 0x16, 0x39, 0x64, 0x2d, 0xab, 0x53, 0xb9,
+	0xa8, 0x12, 0x4a, 0x59, 0x89, 0x70, 0xaa, 0x26, 0x46, 0x7a, 0xb8, 0x4e, 0x8f, 0x77, 0x92, 0xc0,
+	0xa7, 0x7a, 0xe6, 0xe5, 0x74, 0x46, 0xb8, 0x48, 0x67, 0xf4, 0x0e, 0x2b, 0x20, 0xdb, 0x0b, 0x5d,
+	0xaa, 0x4f, 0x0a, 0x7d, 0xcb, 0xc7, 0xf4, 0xed, 0x85, 0x6e, 0x45, 0x57, 0xba, 0x94, 0x97, 0xb9,
+	0xbc, 0xd2, 0x23, 0xb0, 0x09, 0x17, 0xfc, 0x02, 0xcc, 0xa8, 0x05, 0xc0, 0xcb, 0x60, 0x8a, 0x32,
+	0x2b, 0x64, 0xea, 0x28, 0x29, 0xc6, 0x99, 0x28, 0xc8, 0x08, 0x4b, 0x36, 0x5c, 0x03, 0x93, 0xc4,
+	0x8f, 0x62, 0x54, 0x18, 0x0e, 0x0c, 0x10, 0x75, 0x4f, 0x1b, 0x61, 0xce, 0xe2, 0x12, 0xcc, 0xea,
+	0x88, 0x68, 0xe4, 0x93, 0x12, 0xcc, 0xea, 0x20, 0xcc, 0x59, 0x89, 0x05, 0x3c, 0xd5, 0xc0, 0xe4,
+	0x5e, 0xe8, 0xbe, 0x5a, 0xeb, 0xbd, 0xd0, 0x3d, 0x6e, 0xbd, 0x17, 0xba, 0x08, 0x73, 0x16, 0xbc,
+	0x09, 0x66, 0x6d, 0x87, 0x76, 0x5d, 0xab, 0x6f, 0x72, 0x49, 0x79, 0x16, 0x9d, 0x8b, 0x4f, 0x9f,
+	0x04, 0x13, 0x61, 0xa0, 0xde, 0xf6, 0x42, 0x37, 0xb1, 0xec, 0x4f, 0x33, 0x00, 0x94, 0x19, 0xb3,
+	0xda, 0xfb, 0x3c, 0x30, 0xaf, 0xea, 0x30, 0xfe, 0x2f, 0x4b, 0x20, 0x3e, 0x80, 0xe7, 0x4f, 0x3f,
+	0x80, 0x3b, 0x60, 0xa6, 0x1d, 0xf8, 0x8c, 0xf8, 0x4c, 0x6c, 0x98, 0xa7, 0x4e, 0xba, 0x41, 0x94,
+	0xfd, 0x7e, 0xe5, 0x66, 0x6c, 0x47, 0x89, 0xa3, 0x2f, 0x9e, 0x5e, 0x7b, 0x33, 0x9d, 0x65, 0xf1,
+	0xde, 0x37, 0xa5, 0x14, 0x8e, 0xb4, 0x8f, 0xd7, 0xd7, 0xd4, 0x0e, 0xb1, 0x1d, 0x4b, 0xc6, 0xc4,
+	0xd1, 0x33, 0xc7, 0x63, 0xe2, 0x88, 0x98, 0x38, 0xf0, 0xbb, 0x20, 0xef, 0x39, 0x1e, 0x31, 0x45,
+	0x2f, 0x91, 0xb1, 0x5b, 0x1e, 0x0e, 0x8c, 0xa2, 0xaa, 0x86, 0x88, 0x85, 0x70, 0x8e, 0x3f, 0xf3,
+	0x3e, 0x02, 0xcb, 0x20, 0xcb, 0x2b, 0x4d, 0x6d, 0xe7, 0xe2, 0xb1, 0x4a, 0x50, 0x2b, 0xbb, 0x67,
+	0xd1, 0xfd, 0x64, 0xcf, 0xe1, 0x18, 0x84, 0x05, 0x14, 0x7e, 0x07, 0x64, 0xa9, 0xf3, 0x21, 0xd1,
+	0xa7, 0x46, 0xde, 0xce, 0x37, 0x9d, 0x0f, 0x49, 0xa5, 0xcf, 0x08, 0x8d, 0x11, 0x5c, 0x04, 0x61,
+	0x21, 0x79, 0xeb, 0x5a, 0xb4, 0xbb, 0x17, 0xf2, 0x0a, 0x3a, 0x00, 0xb3, 0x89, 0x65, 0xc0, 0xeb,
+	0x20, 0x6f, 0xb9, 0x9d, 0x20, 0x74, 0xd8, 0xbe, 0xa7, 0x6b, 0xe9, 0x5d, 0x8e, 0x58, 0x08, 0xc7,
+	0x62, 0xf0, 0x0a, 0x98, 0xb6, 0x9d, 0x0e, 0xa1, 0x4c, 0xb9, 0x6f, 0x31, 0x1e, 0x2e, 0x24, 0x1d,
+	0x61, 0x25, 0x90, 0x70, 0xfd, 0xef, 0x32, 0x60, 0x76, 0xdb, 0xf1, 0xdf, 0x6f, 0x84, 0xe4, 0xc0,
+	0x21, 0x4f, 0xa2, 0xa2, 0xd0, 0x4e, 0x2f, 0x8a, 0xcb, 0x60, 0x8a, 0x39, 0xcc, 0x25, 0xca, 0x4a,
+	0xa2, 0x00, 0x05, 0x19, 0x61, 0xc9, 0x86, 0xdf, 0x07, 0xb3, 0x36, 0xa1, 0xed, 0xd0, 0xe9, 0x8e,
+	0x5a, 0xee, 0x78, 0xf1, 0xc4, 0x4c, 0x84, 0x93, 0xa2, 0x3c, 0xc4, 0x8e, 0x67, 0x75, 0x88, 0xc9,
+	0x53, 0x21, 0x9b, 0xde, 0xfc, 0x88, 0x85, 0x70, 0x4e, 0x3c, 0xef, 0xc9, 0xac, 0xa0, 0x0e, 0x23,
+	0xa6, 0x6f, 0x79, 0x44, 0x9f, 0x4a, 0x43, 0x46, 0x2c, 0x84, 0x73, 0xfc, 0x79, 0xd7, 0xf2, 0x5e,
+	0x3a, 0x40, 0xcf, 0x66, 0xf8, 0x3c, 0xed, 0xba, 0xfc, 0x1c, 0xf9, 0xa0, 0x47, 0xa8, 0xd8, 0x94,
+	0x74, 0x53, 0xe2, 0x1c, 0x89, 0x38, 0x08, 0x8f, 0x84, 0x60, 0x0f, 0x14, 0xbb, 0x61, 0x70, 0xe0,
+	0xd8, 0xc4, 0x36, 0x2d, 0x9f, 0x3e, 0x21, 0x61, 0xd4, 0xe4, 0x2f, 0x9d, 0x70, 0x08, 0xba, 0x6e,
+	0xa9, 0xa1, 0xa4, 0xcb, 0x42, 0x38, 0x3d, 0x60, 0xa5, 0x75, 0x45, 0x03, 0x56, 0x77, 0x0c, 0x45,
+	0xe1, 0x7d, 0x3e, 0xdc, 0xaa, 0x79, 0x64, 0xf2, 0xcc, 0x93, 0x7e, 0x6d, 0xfc, 0x54, 0x89, 0x90,
+	0x89, 0xa9, 0x67, 0x86, 0xf8, 0x72, 0x12, 0x79, 0x08, 0xce, 0x5b, 0xae, 0x1b, 0x3c, 0xa1, 0xa6,
+	0xd7, 0x73, 0x99, 0xd3, 0x75, 0xc9, 0x68, 0x5b, 0x3c, 0x58, 0xb9, 0x0a, 0x1a, 0x0e, 0x8c, 0xd5,
+	0x28, 0x53, 0x4f, 0x14, 0x44, 0xf8, 0x35, 0xc9, 0xd9, 0x51, 0x8c, 0x68, 0xd1, 0x75, 0xb0, 0xa4,
+	0x20, 0x52, 0x52, 0xcc, 0x45, 0x54, 0x44, 0x34, 0x27, 0x66, 0xa6, 0xd7, 0xc7, 0xf4, 0x2a, 0x75,
+	0x52, 0x0a, 0xe1, 0x45, 0x49, 0x96, 0xca, 0xf8, 0xec, 0x44, 0xe1, 0x07, 0x60, 0xe9, 0xb1, 0xc3,
+	0x2f, 0x08, 0xcc, 0x72, 0xdd, 0xbe, 0x19, 0x12, 0xda, 0x73, 0x59, 0x34, 0xed, 0xbf, 0x79, 0xa2,
+	0xff, 0x5b, 0x5c, 0x12, 0x4b, 0x41, 0x31, 0x01, 0xad, 0x48, 0x9b, 0x27, 0xe8, 0x41, 0x78, 0x51,
+	0x50, 0x93, 0x10, 0xf8, 0x2e, 0xc8, 0xf2, 0xab, 0xa1, 0x3e, 0x23, 0x06, 0x9d, 0x0b, 0x27, 0xda,
+	0xd8, 0x09, 0xec, 0xb1, 0xf9, 0x86, 0x03, 0x10, 0x16, 0x38, 0xf8, 0x23, 0x50, 0xf0, 0xac, 0x43,
+	0x93, 0x12, 0x57, 0xde, 0x9c, 0xa8, 0xb8, 0x2c, 0xcc, 0x27, 0x47, 0xdf, 0x71, 0x3e, 0xc2, 0xf3,
+	0x9e, 0x75, 0xd8, 0x1c, 0xbd, 0x43, 0x0c, 0xf2, 0x4f, 0x88, 0xd3, 0xd9, 0xe7, 0x83, 0xb0, 0xb8,
+	0x1d, 0x9c, 0x3c, 0xc5, 0xbb, 0xee, 0xfd, 0x48, 0x2a, 0x59, 0x2d, 0x23, 0x28, 0xc2, 0xb1, 0x9a,
+	0x95, 0xa7, 0x1a, 0x28, 0x8c, 0x27, 0xe6, 0xe8, 0x8e, 0xa5, 0x3d, 0xef, 0x8e, 0x45, 0xc1, 0xac,
+	0x35, 0x2a, 0xa6, 0x28, 0xf1, 0x4f, 0x3e, 0x52, 0x6e, 0xc7, 0xcd, 0x21, 0x01, 0x79, 0xc1, 0x63,
+	0x25, 0x69, 0x25, 0xee, 0x6f, 0x2f, 0x5b, 0xe5, 0x1f, 0x89, 0xb1, 0x35, 0xe1, 0x18, 0xde, 0xee,
+	0x6c, 0xe2, 0x07, 0x51, 0x17, 0x4e, 0xb4, 0x3b, 0x41, 0x46, 0x58, 0xb2, 0xe1, 0x0f, 0x40, 0xbe,
+	0x13, 0x06, 0xbd, 0xae, 0xe9, 0xd8, 0x72, 0x97, 0xf3, 0x95, 0xb5, 0xa3, 0x81, 0x91, 0xbb, 0xcb,
+	0x89, 0xb5, 0x2d, 0x1a, 0xfb, 0x77, 0x24, 0x86, 0x70, 0x4e, 0x3c, 0xd7, 0xec, 0xe4, 0xa4, 0xf5,
+	0x55, 0x06, 0x80, 0x3d, 0x4a, 0x42, 0xe5, 0xe4, 0x6f, 0x76, 0x62, 0x10, 0x30, 0x57, 0xdc, 0xc4,
+	0xe5, 0xd8, 0xa0, 0x60, 0xae, 0x3b, 0x0e, 0x73, 0xdd, 0x08, 0xe6, 0xf2, 0xdb, 0xf7, 0x3d, 0xb0,
+	0x10, 0x95, 0xa6, 0xe3, 0xdb, 0xe4, 0x90, 0xf0, 0xd6, 0xc0, 0x5d, 0x62, 0xc4, 0x17, 0x8a, 0x94,
+	0x80, 0x6a, 0x63, 0x05, 0x45, 0xae, 0x49, 0x2a, 0xbc, 0x0d, 0xb2, 0x3d, 0x4a, 0x42, 0xd5, 0xd3,
+	0xdf, 0x4e, 0xcc, 0xaa, 0xf4, 0x79, 0x97, 0x1e, 0x01, 0x4a, 0x38, 0xf5, 0x4f, 0x59, 0x50, 0x4c,
+	0xd7, 0x36, 0x24, 0x60, 0x26, 0xea, 0x07, 0x72, 0x90, 0x2f, 0x9d, 0xd9, 0x0f, 0x4a, 0x32, 0x2a,
+	0xf2, 0xad, 0xf2, 0xba, 0x6a, 0x9a, 0x85, 0xe8, 0x42, 0x2a, 0x64, 0xd4, 0x4e, 0x22, 0xdd, 0xf0,
+	0xe7, 0x60, 0x3a, 0x0c, 0x7a, 0xbe, 0x1d, 0x25, 0xff, 0xdb, 0x67, 0x5b, 0xc1, 0x5c, 0xbe, 0x72,
+	0x85, 0xab, 0xff, 0xe7, 0xc0, 0x28, 0x4a, 0xf8, 0x3b, 0x81, 0xe7, 0x30, 0xe2, 0x75, 0x59, 0x3f,
+	0x3e, 0xd8, 0x25, 0x07, 0x61, 0x65, 0x61, 0xe5, 0xcf, 0x1a, 0x98, 0x4b, 0x2e, 0x11, 0xde, 0x02,
+	0x73, 0xaa, 0x93, 0x0a, 0x3f, 0x8b, 0xfc, 0x99, 0xaf, 0x9c, 0x1f, 0x0e, 0x8c, 0xa5, 0x64, 0x18,
+	0x24, 0x17, 0xe1, 0x59, 0xf9, 0x2a, 0xbc, 0xcf, 0x53, 0xff, 0x20, 0x60, 0x84, 0xaa, 0x8c, 0x49,
+	0xa4, 0xbe, 0x20, 0x23, 0x2c, 0xd9, 0xb0, 0x09, 0xa6, 0x65, 0x9f, 0x10, 0x39, 0x32, 0x57, 0xb9,
+	0xfd, 0xd7, 0x81, 0x71, 0xb9, 0xe3, 0xb0, 0xfd, 0xde, 0xa3, 0x52, 0x3b, 0xf0, 0xd4, 0x77, 0x29,
+	0xf5, 0x77, 0x8d, 0xda, 0xef, 0x6f, 0xf0, 0x09, 0x8d, 0x96, 0x6a, 0x3e, 0x8b, 0x77, 0x22, 0x35,
+	0x20, 0xac, 0x54, 0xc5, 0xb1, 0x5b, 0x39, 0x04, 0x53, 0xc2, 0x1f, 0xff, 0xa3, 0x78, 0x25, 0x9a,
+	0xc7, 0xe8, 0x09, 0xfd, 0x51, 0x03, 0xd3, 0x0d, 0x2b, 0xb4, 0x3c, 0x7e, 0xaf, 0x5a, 0xe0, 0xed,
+	0x97, 0x37, 0x37, 0xd3, 0x25, 0x7e, 0x87, 0xed, 0x2b, 0xa7, 0xae, 0xc4, 0xb9, 0x9d, 0x12, 0x90,
+	0x0d, 0x9a, 0xdf, 0x9f, 0xb6, 0xc5, 0x3b, 0xfc, 0xa1, 0x6c, 0xf1, 0x1e, 0x9f, 0x79, 0x4d, 0x31,
+	0x58, 0x4a, 0x17, 0xa7, 0x5a, 0x7c, 0xcc, 0x47, 0x78, 0xce, 0xb3, 0x0e, 0xc5, 0x8c, 0xcc, 0xe7,
+	0xce, 0x5b, 0x28, 0x5a, 0xd9, 0xaf, 0xbe, 0xfe, 0xec, 0xea, 0x6b, 0xea, 0xcb, 0xe4, 0xa1, 0xfa,
+	0x36, 0x29, 0x17, 0x8a, 0xfe, 0x90, 0x01, 0x3a, 0x2f, 0xf0, 0x3a, 0xbf, 0xff, 0xb7, 0x42, 0xcb,
+	0xa7, 0x8f, 0xb9, 0x27, 0xc4, 0x5c, 0xf2, 0x0d, 0xb7, 0x95, 0x32, 0x98, 0xa6, 0xc4, 0xb7, 0x49,
+	0xa8, 0x4f, 0xa6, 0xbf, 0x83, 0x49, 0xfa, 0x73, 0xbe, 0x83, 0x49, 0x01, 0x78, 0x17, 0xe4, 0x42,
+	0xd2, 0x26, 0xce, 0x01, 0x09, 0xd5, 0x8c, 0xf8, 0xed, 0x78, 0x7c, 0x89, 0x38, 0xa7, 0xab, 0x19,
+	0x81, 0x13, 0x41, 0xfe, 0xd7, 0x24, 0x98, 0x93, 0x5f, 0x21, 0x0e, 0x1c, 0xca, 0x27, 0xb7, 0xff,
+	0x83, 0xdb, 0x5a, 0x74, 0x06, 0x67, 0x5f, 0xf4, 0x3b, 0xe7, 0xd4, 0x2b, 0xfa, 0xce, 0x39, 0x7d,
+	0xc6, 0x77, 0x4e, 0x3e, 0x92, 0x05, 0xa1, 0x3e, 0x93, 0x8e, 0xaf, 0xa4, 0x3f, 0x27, 0xbe, 0x52,
+	0x00, 0x3e, 0x04, 0x79, 0xfe, 0x24, 0xe7, 0xd7, 0xdc, 0x99, 0xf3, 0xeb, 0x9b, 0xaa, 0xb4, 0x8b,
+	0xb1, 0x95, 0xf4, 0x00, 0x9b, 0xe3, 0x44, 0x3e, 0xc1, 0xc6, 0x21, 0xbf, 0xfa, 0x1b, 0x0d, 0x2c,
+	0x1e, 0xfb, 0xf0, 0x04, 0xdf, 0x02, 0x46, 0xa3, 0xde, 0x6c, 0x99, 0xb8, 0x7a, 0xa7, 0x8a, 0xab,
+	0xbb, 0x9b, 0x55, 0xb3, 0xf5, 0xa0, 0x51, 0x35, 0xf7, 0x76, 0x9b, 0x8d, 0xea, 0x66, 0xed, 0x4e,
+	0xad, 0xba, 0x55, 0x9c, 0x80, 0x6f, 0x80, 0x0b, 0x27, 0x09, 0xe1, 0x6a, 0x63, 0xfb, 0x41, 0x51,
+	0x3b, 0x8d, 0xfd, 0xd3, 0xbd, 0x7a, 0xab, 0x5a, 0xcc, 0xc0, 0x55, 0xb0, 0x72, 0x0a, 0xba, 0xde,
+	0x6c, 0x15, 0x27, 0x57, 0xb2, 0xbf, 0xfc, 0xed, 0xea, 0xc4, 0xd5, 0x4f, 0x35, 0x30, 0x97, 0xfc,
+	0xfc, 0xc9, 0xb5, 0x0a, 0x03, 0x66, 0xb3, 0xda, 0x6a, 0xd5, 0x76, 0xef, 0xa6, 0xd6, 0xb4, 0x02,
+	0xce, 0x8d, 0xb3, 0xab, 0xef, 0x55, 0xf1, 0x83, 0xfa, 0x6e, 0xb5, 0xa8, 0xc1, 0xd7, 0xc1, 0xf9,
+	0x71, 0xde, 0x9d, 0xfa, 0xf6, 0x76, 0xfd, 0x7e, 0x15, 0x37, 0x8b, 0x19, 0xa8, 0x83, 0xe5, 0x71,
+	0xe6, 0xce, 0x5e, 0x6b, 0xaf, 0xbc, 0x5d, 0x9c, 0x3c, 0xae, 0x72, 0xa7, 0xba, 0xdb, 0xaa, 0xd5,
+	0x77, 0x9b, 0xc5, 0xac, 0x5a, 0xe4, 0xcf, 0x40, 0x2e, 0x1a, 0x69, 0xe1, 0x79, 0xb0, 0xd4, 0xa8,
+	0x6f, 0x6f, 0x9b, 0x3b, 0xf5, 0xad, 0xaa, 0xd9, 0xd8, 0xde, 0xc3, 0xe5, 0xed, 0x5a, 0xeb, 0x41,
+	0x71, 0x82, 0x5b, 0x8f, 0x19, 0xb8, 0xbc, 0xfb, 0x93, 0xea, 0x96, 0xb9, 0x79, 0xaf, 0x5e, 0xdb,
+	0xe4, 0x4b, 0x3b, 0x07, 0x60, 0xcc, 0x2c, 0x37, 0x1a, 0xb8, 0xfe, 0x5e, 0x79, 0xbb, 0x98, 0x91,
+	0xfa, 0x2b, 0xf7, 0x3e, 0x3f, 0x5a, 0xd5, 0xbe, 0x3c, 0x5a, 0xd5, 0xfe, 0x7e, 0xb4, 0xaa, 0x7d,
+	0xfc, 0x6c, 0x75, 0xe2, 0xcb, 0x67, 0xab, 0x13, 0x7f, 0x79, 0xb6, 0x3a, 0xf1, 0xb0, 0x94, 0x38,
+	0x64, 0x64, 0x3e, 0x5f, 0x73, 0xad, 0x47, 0x54, 0x3d, 0x6f, 0x1c, 0xdc, 0x1c, 0xb5, 0x44, 0x71,
+	0xe0, 0x3c, 0x9a, 0x16, 0x89, 0x73, 0xe3, 0x3f, 0x03, 0x00, 0xd3, 0xcb, 0x58, 0xf4, 0xcb, 0x19,
+	0x00, 0x00,
+}
+
+func (this *Post) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Post)
+	if !ok {
+		that2, ok := that.(Post)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
 	if that1 == nil {
 		return this == nil
@@ -1322,6 +1832,13 @@ func (this *Post) Equal(that interface{}) bool {
 	if this.Owner != that1.Owner {
 		return false
 	}
+	if that1.ExpirationTime == nil {
+		if this.ExpirationTime != nil {
+			return false
+		}
+	} else if !this.ExpirationTime.Equal(*that1.ExpirationTime) {
+		return false
+	}
 	return true
 }
 func (this *PostReference) Equal(that interface{}) bool {
@@ -1520,6 +2037,75 @@ func (this *Media) Equal(that interface{}) bool {
 	if this.MimeType != that1.MimeType {
 		return false
 	}
+	if !this.Hash.Equal(that1.Hash) {
+		return false
+	}
+	if this.SizeBytes != that1.SizeBytes {
+		return false
+	}
+	return true
+}
+func (this *ContentHash) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContentHash)
+	if !ok {
+		that2, ok := that.(ContentHash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Algorithm != that1.Algorithm {
+		return false
+	}
+	if this.Digest != that1.Digest {
+		return false
+	}
+	return true
+}
+func (this *LinkPreview) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LinkPreview)
+	if !ok {
+		that2, ok := that.(LinkPreview)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.ImageUri != that1.ImageUri {
+		return false
+	}
+	if this.SiteName != that1.SiteName {
+		return false
+	}
 	return true
 }
 func (this *Poll) Equal(that interface{}) bool {
@@ -1564,6 +2150,15 @@ func (this *Poll) Equal(that interface{}) bool {
 	if !this.FinalTallyResults.Equal(that1.FinalTallyResults) {
 		return false
 	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.MaxSelections != that1.MaxSelections {
+		return false
+	}
+	if !this.Weighting.Equal(that1.Weighting) {
+		return false
+	}
 	return true
 }
 func (this *Poll_ProvidedAnswer) Equal(that interface{}) bool {
@@ -1598,6 +2193,38 @@ func (this *Poll_ProvidedAnswer) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PollWeighting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PollWeighting)
+	if !ok {
+		that2, ok := that.(PollWeighting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.GroupIDs) != len(that1.GroupIDs) {
+		return false
+	}
+	for i := range this.GroupIDs {
+		if this.GroupIDs[i] != that1.GroupIDs[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *UserAnswer) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1666,6 +2293,14 @@ func (this *PollTallyResults) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Rounds) != len(that1.Rounds) {
+		return false
+	}
+	for i := range this.Rounds {
+		if !this.Rounds[i].Equal(&that1.Rounds[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *PollTallyResults_AnswerResult) Equal(that interface{}) bool {
@@ -1693,6 +2328,42 @@ func (this *PollTallyResults_AnswerResult) Equal(that interface{}) bool {
 	if this.Votes != that1.Votes {
 		return false
 	}
+	if that1.Weight == nil {
+		if this.Weight != nil {
+			return false
+		}
+	} else if !this.Weight.Equal(*that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *PollTallyResults_Round) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PollTallyResults_Round)
+	if !ok {
+		that2, ok := that.(PollTallyResults_Round)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(&that1.Results[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -1717,6 +2388,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxTextLength != that1.MaxTextLength {
 		return false
 	}
+	if this.MaxMediaSize != that1.MaxMediaSize {
+		return false
+	}
 	return true
 }
 func (this *PostOwnerTransferRequest) Equal(that interface{}) bool {
@@ -1752,6 +2426,56 @@ func (this *PostOwnerTransferRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PostRevision) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PostRevision)
+	if !ok {
+		that2, ok := that.(PostRevision)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.PostID != that1.PostID {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Text != that1.Text {
+		return false
+	}
+	if !this.Entities.Equal(that1.Entities) {
+		return false
+	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
+	if this.Editor != that1.Editor {
+		return false
+	}
+	if !this.EditDate.Equal(that1.EditDate) {
+		return false
+	}
+	return true
+}
 func (m *Post) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1772,6 +2496,16 @@ func (m *Post) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintModels(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -1780,21 +2514,21 @@ func (m *Post) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x72
 	}
 	if m.LastEditedDate != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastEditedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastEditedDate):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastEditedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastEditedDate):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintModels(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintModels(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x6a
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationDate):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationDate):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintModels(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintModels(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x62
 	if m.ReplySettings != 0 {
@@ -2141,8 +2875,25 @@ func (m *Media) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
+	if m.SizeBytes != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Hash != nil {
+		{
+			size, err := m.Hash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
 		copy(dAtA[i:], m.MimeType)
 		i = encodeVarintModels(dAtA, i, uint64(len(m.MimeType)))
 		i--
@@ -2158,6 +2909,101 @@ func (m *Media) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContentHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContentHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContentHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Algorithm) > 0 {
+		i -= len(m.Algorithm)
+		copy(dAtA[i:], m.Algorithm)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Algorithm)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SiteName) > 0 {
+		i -= len(m.SiteName)
+		copy(dAtA[i:], m.SiteName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SiteName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ImageUri) > 0 {
+		i -= len(m.ImageUri)
+		copy(dAtA[i:], m.ImageUri)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ImageUri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Poll) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2178,6 +3024,28 @@ func (m *Poll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Weighting != nil {
+		{
+			size, err := m.Weighting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxSelections != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxSelections))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Mode != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.FinalTallyResults != nil {
 		{
 			size, err := m.FinalTallyResults.MarshalToSizedBuffer(dAtA[:i])
@@ -2210,12 +3078,12 @@ func (m *Poll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndDate):])
-	if err6 != nil {
-		return 0, err6
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndDate):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n6
-	i = encodeVarintModels(dAtA, i, uint64(n6))
+	i -= n9
+	i = encodeVarintModels(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.ProvidedAnswers) > 0 {
@@ -2286,6 +3154,54 @@ func (m *Poll_ProvidedAnswer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PollWeighting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollWeighting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollWeighting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupIDs) > 0 {
+		dAtA11 := make([]byte, len(m.GroupIDs)*10)
+		var j10 int
+		for _, num := range m.GroupIDs {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintModels(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UserAnswer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2314,20 +3230,20 @@ func (m *UserAnswer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.AnswersIndexes) > 0 {
-		dAtA8 := make([]byte, len(m.AnswersIndexes)*10)
-		var j7 int
+		dAtA13 := make([]byte, len(m.AnswersIndexes)*10)
+		var j12 int
 		for _, num := range m.AnswersIndexes {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j12++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintModels(dAtA, i, uint64(j7))
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintModels(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -2369,6 +3285,20 @@ func (m *PollTallyResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Rounds) > 0 {
+		for iNdEx := len(m.Rounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Results) > 0 {
 		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2406,6 +3336,18 @@ func (m *PollTallyResults_AnswerResult) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Weight != nil {
+		{
+			size := m.Weight.Size()
+			i -= size
+			if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Votes != 0 {
 		i = encodeVarintModels(dAtA, i, uint64(m.Votes))
 		i--
@@ -2419,6 +3361,43 @@ func (m *PollTallyResults_AnswerResult) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *PollTallyResults_Round) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollTallyResults_Round) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollTallyResults_Round) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2439,6 +3418,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMediaSize != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxMediaSize))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MaxTextLength != 0 {
 		i = encodeVarintModels(dAtA, i, uint64(m.MaxTextLength))
 		i--
@@ -2494,6 +3478,87 @@ func (m *PostOwnerTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *PostRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EditDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EditDate):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintModels(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Entities != nil {
+		{
+			size, err := m.Entities.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PostID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModels(v)
 	base := offset
@@ -2564,6 +3629,10 @@ func (m *Post) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -2690,22 +3759,75 @@ func (m *Media) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovModels(uint64(m.SizeBytes))
+	}
 	return n
 }
 
-func (m *Poll) Size() (n int) {
+func (m *ContentHash) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Question)
+	l = len(m.Algorithm)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if len(m.ProvidedAnswers) > 0 {
-		for _, e := range m.ProvidedAnswers {
-			l = e.Size()
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *LinkPreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ImageUri)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.SiteName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *Poll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Question)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.ProvidedAnswers) > 0 {
+		for _, e := range m.ProvidedAnswers {
+			l = e.Size()
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
@@ -2721,6 +3843,16 @@ func (m *Poll) Size() (n int) {
 		l = m.FinalTallyResults.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if m.Mode != 0 {
+		n += 1 + sovModels(uint64(m.Mode))
+	}
+	if m.MaxSelections != 0 {
+		n += 1 + sovModels(uint64(m.MaxSelections))
+	}
+	if m.Weighting != nil {
+		l = m.Weighting.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -2743,6 +3875,26 @@ func (m *Poll_ProvidedAnswer) Size() (n int) {
 	return n
 }
 
+func (m *PollWeighting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.GroupIDs) > 0 {
+		l = 0
+		for _, e := range m.GroupIDs {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *UserAnswer) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2784,6 +3936,12 @@ func (m *PollTallyResults) Size() (n int) {
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
+	if len(m.Rounds) > 0 {
+		for _, e := range m.Rounds {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2799,6 +3957,25 @@ func (m *PollTallyResults_AnswerResult) Size() (n int) {
 	if m.Votes != 0 {
 		n += 1 + sovModels(uint64(m.Votes))
 	}
+	if m.Weight != nil {
+		l = m.Weight.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *PollTallyResults_Round) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2811,6 +3988,9 @@ func (m *Params) Size() (n int) {
 	if m.MaxTextLength != 0 {
 		n += 1 + sovModels(uint64(m.MaxTextLength))
 	}
+	if m.MaxMediaSize != 0 {
+		n += 1 + sovModels(uint64(m.MaxMediaSize))
+	}
 	return n
 }
 
@@ -2837,6 +4017,44 @@ func (m *PostOwnerTransferRequest) Size() (n int) {
 	return n
 }
 
+func (m *PostRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.PostID != 0 {
+		n += 1 + sovModels(uint64(m.PostID))
+	}
+	if m.ID != 0 {
+		n += 1 + sovModels(uint64(m.ID))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Entities != nil {
+		l = m.Entities.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EditDate)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
 func sovModels(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3266,6 +4484,42 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -4054,6 +5308,61 @@ func (m *Media) Unmarshal(dAtA []byte) error {
 			}
 			m.MimeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hash == nil {
+				m.Hash = &ContentHash{}
+			}
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -4075,7 +5384,7 @@ func (m *Media) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Poll) Unmarshal(dAtA []byte) error {
+func (m *ContentHash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4098,15 +5407,15 @@ func (m *Poll) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Poll: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContentHash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Poll: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContentHash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Question", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4134,13 +5443,13 @@ func (m *Poll) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Question = string(dAtA[iNdEx:postIndex])
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvidedAnswers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -4150,63 +5459,407 @@ func (m *Poll) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProvidedAnswers = append(m.ProvidedAnswers, Poll_ProvidedAnswer{})
-			if err := m.ProvidedAnswers[len(m.ProvidedAnswers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowsMultipleAnswers", wireType)
-			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinkPreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkPreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkPreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SiteName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SiteName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Poll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Poll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Poll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Question", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Question = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvidedAnswers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvidedAnswers = append(m.ProvidedAnswers, Poll_ProvidedAnswer{})
+			if err := m.ProvidedAnswers[len(m.ProvidedAnswers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowsMultipleAnswers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowsMultipleAnswers = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowsAnswerEdits", wireType)
+			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -4217,17 +5870,489 @@ func (m *Poll) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowsAnswerEdits = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResults == nil {
+				m.FinalTallyResults = &PollTallyResults{}
+			}
+			if err := m.FinalTallyResults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= PollMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSelections", wireType)
+			}
+			m.MaxSelections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSelections |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Weighting == nil {
+				m.Weighting = &PollWeighting{}
+			}
+			if err := m.Weighting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Poll_ProvidedAnswer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvidedAnswer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvidedAnswer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attachments = append(m.Attachments, &types.Any{})
+			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollWeighting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollWeighting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollWeighting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GroupIDs = append(m.GroupIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GroupIDs) == 0 {
+					m.GroupIDs = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GroupIDs = append(m.GroupIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserAnswer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserAnswer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserAnswer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowsMultipleAnswers = bool(v != 0)
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowsAnswerEdits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollID", wireType)
 			}
-			var v int
+			m.PollID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -4237,17 +6362,92 @@ func (m *Poll) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PollID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowsAnswerEdits = bool(v != 0)
-		case 6:
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AnswersIndexes = append(m.AnswersIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AnswersIndexes) == 0 {
+					m.AnswersIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AnswersIndexes = append(m.AnswersIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnswersIndexes", wireType)
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -4257,27 +6457,23 @@ func (m *Poll) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalTallyResults == nil {
-				m.FinalTallyResults = &PollTallyResults{}
-			}
-			if err := m.FinalTallyResults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4300,7 +6496,7 @@ func (m *Poll) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Poll_ProvidedAnswer) Unmarshal(dAtA []byte) error {
+func (m *PollTallyResults) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4323,17 +6519,17 @@ func (m *Poll_ProvidedAnswer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProvidedAnswer: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollTallyResults: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProvidedAnswer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollTallyResults: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -4343,27 +6539,29 @@ func (m *Poll_ProvidedAnswer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Text = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, PollTallyResults_AnswerResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4390,8 +6588,8 @@ func (m *Poll_ProvidedAnswer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attachments = append(m.Attachments, &types.Any{})
-			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rounds = append(m.Rounds, PollTallyResults_Round{})
+			if err := m.Rounds[len(m.Rounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4416,7 +6614,7 @@ func (m *Poll_ProvidedAnswer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserAnswer) Unmarshal(dAtA []byte) error {
+func (m *PollTallyResults_AnswerResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4439,17 +6637,17 @@ func (m *UserAnswer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserAnswer: wiretype end group for non-group")
+			return fmt.Errorf("proto: AnswerResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserAnswer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AnswerResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnswerIndex", wireType)
 			}
-			m.SubspaceID = 0
+			m.AnswerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -4459,35 +6657,16 @@ func (m *UserAnswer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubspaceID |= uint64(b&0x7F) << shift
+				m.AnswerIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
-			}
-			m.PostID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PostID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			m.PollID = 0
+			m.Votes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -4497,92 +6676,16 @@ func (m *UserAnswer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PollID |= uint32(b&0x7F) << shift
+				m.Votes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowModels
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.AnswersIndexes = append(m.AnswersIndexes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowModels
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthModels
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthModels
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.AnswersIndexes) == 0 {
-					m.AnswersIndexes = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowModels
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AnswersIndexes = append(m.AnswersIndexes, v)
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnswersIndexes", wireType)
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -4592,23 +6695,26 @@ func (m *UserAnswer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Weight = &v
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4631,7 +6737,7 @@ func (m *UserAnswer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollTallyResults) Unmarshal(dAtA []byte) error {
+func (m *PollTallyResults_Round) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4654,10 +6760,10 @@ func (m *PollTallyResults) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollTallyResults: wiretype end group for non-group")
+			return fmt.Errorf("proto: Round: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollTallyResults: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Round: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4715,7 +6821,7 @@ func (m *PollTallyResults) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollTallyResults_AnswerResult) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4738,17 +6844,17 @@ func (m *PollTallyResults_AnswerResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnswerResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnswerResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnswerIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTextLength", wireType)
 			}
-			m.AnswerIndex = 0
+			m.MaxTextLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -4758,16 +6864,16 @@ func (m *PollTallyResults_AnswerResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AnswerIndex |= uint32(b&0x7F) << shift
+				m.MaxTextLength |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMediaSize", wireType)
 			}
-			m.Votes = 0
+			m.MaxMediaSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -4777,7 +6883,7 @@ func (m *PollTallyResults_AnswerResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Votes |= uint64(b&0x7F) << shift
+				m.MaxMediaSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4803,7 +6909,7 @@ func (m *PollTallyResults_AnswerResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PostOwnerTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4826,17 +6932,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostOwnerTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostOwnerTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTextLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
 			}
-			m.MaxTextLength = 0
+			m.SubspaceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -4846,11 +6952,94 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTextLength |= uint32(b&0x7F) << shift
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -4872,7 +7061,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostOwnerTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *PostRevision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4895,10 +7084,10 @@ func (m *PostOwnerTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostOwnerTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostRevision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostOwnerTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostRevision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4940,8 +7129,27 @@ func (m *PostOwnerTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4969,11 +7177,47 @@ func (m *PostOwnerTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entities == nil {
+				m.Entities = &Entities{}
+			}
+			if err := m.Entities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5001,7 +7245,72 @@ func (m *PostOwnerTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EditDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
@@ -10,6 +11,8 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	subspacestypes "github.com/desmos-labs/desmos/v7/x/subspaces/types"
 )
 
 var (
@@ -19,6 +22,16 @@ var (
 	_ sdk.Msg = &MsgRemovePostAttachment{}
 	_ sdk.Msg = &MsgDeletePost{}
 	_ sdk.Msg = &MsgAnswerPoll{}
+
+	_ subspacestypes.RateLimitedMsg = &MsgCreatePost{}
+	_ subspacestypes.RateLimitedMsg = &MsgEditPost{}
+	_ subspacestypes.RateLimitedMsg = &MsgAddPostAttachment{}
+	_ subspacestypes.RateLimitedMsg = &MsgRemovePostAttachment{}
+	_ subspacestypes.RateLimitedMsg = &MsgDeletePost{}
+	_ subspacestypes.RateLimitedMsg = &MsgAnswerPoll{}
+	_ subspacestypes.RateLimitedMsg = &MsgMovePost{}
+	_ subspacestypes.RateLimitedMsg = &MsgUnhidePost{}
+	_ subspacestypes.RateLimitedMsg = &MsgSetPostRevisionsLimit{}
 )
 
 // NewMsgCreatePost returns a new MsgCreatePost instance
@@ -33,6 +46,8 @@ func NewMsgCreatePost(
 	tags []string,
 	attachments []AttachmentContent,
 	referencedPosts []PostReference,
+	publishTime *time.Time,
+	expirationTime *time.Time,
 	author string,
 ) *MsgCreatePost {
 	attachmentsAnis := make([]*codectypes.Any, len(attachments))
@@ -56,6 +71,8 @@ func NewMsgCreatePost(
 		ConversationID:  conversationID,
 		ReplySettings:   replySettings,
 		ReferencedPosts: referencedPosts,
+		PublishTime:     publishTime,
+		ExpirationTime:  expirationTime,
 	}
 }
 
@@ -107,6 +124,20 @@ func (msg *MsgCreatePost) ValidateBasic() error {
 		}
 	}
 
+	if msg.PublishTime != nil && msg.PublishTime.IsZero() {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid publish time: %s", msg.PublishTime)
+	}
+
+	if msg.ExpirationTime != nil {
+		if msg.ExpirationTime.IsZero() {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid expiration time: %s", msg.ExpirationTime)
+		}
+
+		if msg.PublishTime != nil && !msg.ExpirationTime.After(*msg.PublishTime) {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "expiration time must be after the publish time")
+		}
+	}
+
 	return nil
 }
 
@@ -121,6 +152,9 @@ func (msg *MsgCreatePost) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgCreatePost) IsRateLimitedMsg() {}
+
 // UnpackInterfaces implements codectypes.UnpackInterfacesMessage
 func (msg *MsgCreatePost) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, attachment := range msg.Attachments {
@@ -203,6 +237,9 @@ func (msg *MsgEditPost) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgEditPost) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgAddPostAttachment returns a new MsgAddPostAttachment instance
@@ -264,6 +301,9 @@ func (msg *MsgAddPostAttachment) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgAddPostAttachment) IsRateLimitedMsg() {}
+
 // UnpackInterfaces implements codectypes.UnpackInterfacesMessage
 func (msg *MsgAddPostAttachment) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var content AttachmentContent
@@ -321,6 +361,9 @@ func (msg *MsgRemovePostAttachment) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgRemovePostAttachment) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgDeletePost returns a new MsgDeletePost instance
@@ -367,6 +410,9 @@ func (msg *MsgDeletePost) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgDeletePost) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgAnswerPoll returns a new MsgAnswerPoll instance
@@ -438,6 +484,9 @@ func (msg *MsgAnswerPoll) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgAnswerPoll) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 var (
@@ -505,6 +554,117 @@ func (msg *MsgMovePost) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgMovePost) IsRateLimitedMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var (
+	_ sdk.Msg            = &MsgUnhidePost{}
+	_ legacytx.LegacyMsg = &MsgUnhidePost{}
+)
+
+// NewMsgUnhidePost returns a new MsgUnhidePost instance
+func NewMsgUnhidePost(subspaceID uint64, postID uint64, signer string) *MsgUnhidePost {
+	return &MsgUnhidePost{
+		SubspaceID: subspaceID,
+		PostID:     postID,
+		Signer:     signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgUnhidePost) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgUnhidePost) Type() string { return ActionUnhidePost }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgUnhidePost) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if msg.PostID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid post id: %d", msg.PostID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return fmt.Errorf("invalid signer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgUnhidePost) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgUnhidePost) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgUnhidePost) IsRateLimitedMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var (
+	_ sdk.Msg            = &MsgSetPostRevisionsLimit{}
+	_ legacytx.LegacyMsg = &MsgSetPostRevisionsLimit{}
+)
+
+// NewMsgSetPostRevisionsLimit returns a new MsgSetPostRevisionsLimit instance
+func NewMsgSetPostRevisionsLimit(subspaceID uint64, limit uint32, signer string) *MsgSetPostRevisionsLimit {
+	return &MsgSetPostRevisionsLimit{
+		SubspaceID: subspaceID,
+		Limit:      limit,
+		Signer:     signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgSetPostRevisionsLimit) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgSetPostRevisionsLimit) Type() string { return ActionSetPostRevisionsLimit }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSetPostRevisionsLimit) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if msg.Limit > MaxPostRevisionsLimit {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "revisions limit cannot be greater than %d: %d", MaxPostRevisionsLimit, msg.Limit)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return fmt.Errorf("invalid signer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgSetPostRevisionsLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgSetPostRevisionsLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgSetPostRevisionsLimit) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 var (
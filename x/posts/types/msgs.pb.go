@@ -60,6 +60,12 @@ type MsgCreatePost struct {
 	ReplySettings ReplySetting `protobuf:"varint,10,opt,name=reply_settings,json=replySettings,proto3,enum=desmos.posts.v3.ReplySetting" json:"reply_settings,omitempty" yaml:"reply_settings"`
 	// A list this posts references (either as a reply, repost or quote)
 	ReferencedPosts []PostReference `protobuf:"bytes,11,rep,name=referenced_posts,json=referencedPosts,proto3" json:"referenced_posts" yaml:"referenced_posts"`
+	// (optional) Time at which the post should be published.
+	// Until then, the post is kept pending and is not listed inside the
+	// subspace and section posts
+	PublishTime *time.Time `protobuf:"bytes,12,opt,name=publish_time,json=publishTime,proto3,stdtime" json:"publish_time,omitempty" yaml:"publish_time"`
+	// (optional) Time after which the post will be deleted automatically
+	ExpirationTime *time.Time `protobuf:"bytes,13,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty" yaml:"expiration_time"`
 }
 
 func (m *MsgCreatePost) Reset()         { *m = MsgCreatePost{} }
@@ -172,6 +178,20 @@ func (m *MsgCreatePost) GetReferencedPosts() []PostReference {
 	return nil
 }
 
+func (m *MsgCreatePost) GetPublishTime() *time.Time {
+	if m != nil {
+		return m.PublishTime
+	}
+	return nil
+}
+
+func (m *MsgCreatePost) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
 // MsgCreatePostResponse defines the Msg/CreatePost response type.
 type MsgCreatePostResponse struct {
 	// Id of the newly created post
@@ -726,7 +746,8 @@ type MsgAnswerPoll struct {
 	PostID uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty" yaml:"post_id"`
 	// Id of the poll to be answered
 	PollID uint32 `protobuf:"varint,3,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty" yaml:"poll_id"`
-	// Indexes of the answer inside the ProvidedAnswers array
+	// Indexes of the answer inside the ProvidedAnswers array.
+	// For ranked-choice polls, the indexes are sorted by preference
 	AnswersIndexes []uint32 `protobuf:"varint,4,rep,packed,name=answers_indexes,json=answersIndexes,proto3" json:"answers_indexes,omitempty" yaml:"answers_indexes"`
 	// Address of the user answering the poll
 	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
@@ -1519,6 +1540,211 @@ func (m *MsgRefusePostOwnerTransferRequestResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRefusePostOwnerTransferRequestResponse proto.InternalMessageInfo
 
+// MsgUnhidePost represents the message used to clear the hidden flag of a post
+type MsgUnhidePost struct {
+	// Id of the subspace containing the post
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the post to be unhidden
+	PostID uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty" yaml:"post_id"`
+	// User that is unhiding the post
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+}
+
+func (m *MsgUnhidePost) Reset()         { *m = MsgUnhidePost{} }
+func (m *MsgUnhidePost) String() string { return proto.CompactTextString(m) }
+func (*MsgUnhidePost) ProtoMessage()    {}
+func (*MsgUnhidePost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5bb13e2c778488f, []int{24}
+}
+func (m *MsgUnhidePost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnhidePost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnhidePost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnhidePost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnhidePost.Merge(m, src)
+}
+func (m *MsgUnhidePost) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnhidePost) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnhidePost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnhidePost proto.InternalMessageInfo
+
+func (m *MsgUnhidePost) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
+}
+
+func (m *MsgUnhidePost) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
+	}
+	return 0
+}
+
+func (m *MsgUnhidePost) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgUnhidePostResponse represents the Msg/UnhidePost response type
+type MsgUnhidePostResponse struct {
+}
+
+func (m *MsgUnhidePostResponse) Reset()         { *m = MsgUnhidePostResponse{} }
+func (m *MsgUnhidePostResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnhidePostResponse) ProtoMessage()    {}
+func (*MsgUnhidePostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5bb13e2c778488f, []int{25}
+}
+func (m *MsgUnhidePostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnhidePostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnhidePostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnhidePostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnhidePostResponse.Merge(m, src)
+}
+func (m *MsgUnhidePostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnhidePostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnhidePostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnhidePostResponse proto.InternalMessageInfo
+
+// MsgSetPostRevisionsLimit represents the message used to set the max number
+// of revisions that are kept for each post inside a subspace
+type MsgSetPostRevisionsLimit struct {
+	// Id of the subspace for which to set the limit
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Max number of revisions to be kept for each post.
+	// Setting this to 0 disables the revisions tracking
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" yaml:"limit"`
+	// User that is setting the limit
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+}
+
+func (m *MsgSetPostRevisionsLimit) Reset()         { *m = MsgSetPostRevisionsLimit{} }
+func (m *MsgSetPostRevisionsLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPostRevisionsLimit) ProtoMessage()    {}
+func (*MsgSetPostRevisionsLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5bb13e2c778488f, []int{26}
+}
+func (m *MsgSetPostRevisionsLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPostRevisionsLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPostRevisionsLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPostRevisionsLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPostRevisionsLimit.Merge(m, src)
+}
+func (m *MsgSetPostRevisionsLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPostRevisionsLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPostRevisionsLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPostRevisionsLimit proto.InternalMessageInfo
+
+func (m *MsgSetPostRevisionsLimit) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
+}
+
+func (m *MsgSetPostRevisionsLimit) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *MsgSetPostRevisionsLimit) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgSetPostRevisionsLimitResponse represents the Msg/SetPostRevisionsLimit
+// response type
+type MsgSetPostRevisionsLimitResponse struct {
+}
+
+func (m *MsgSetPostRevisionsLimitResponse) Reset()         { *m = MsgSetPostRevisionsLimitResponse{} }
+func (m *MsgSetPostRevisionsLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPostRevisionsLimitResponse) ProtoMessage()    {}
+func (*MsgSetPostRevisionsLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5bb13e2c778488f, []int{27}
+}
+func (m *MsgSetPostRevisionsLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPostRevisionsLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPostRevisionsLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPostRevisionsLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPostRevisionsLimitResponse.Merge(m, src)
+}
+func (m *MsgSetPostRevisionsLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPostRevisionsLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPostRevisionsLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPostRevisionsLimitResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreatePost)(nil), "desmos.posts.v3.MsgCreatePost")
 	proto.RegisterType((*MsgCreatePostResponse)(nil), "desmos.posts.v3.MsgCreatePostResponse")
@@ -1544,119 +1770,133 @@ func init() {
 	proto.RegisterType((*MsgAcceptPostOwnerTransferRequestResponse)(nil), "desmos.posts.v3.MsgAcceptPostOwnerTransferRequestResponse")
 	proto.RegisterType((*MsgRefusePostOwnerTransferRequest)(nil), "desmos.posts.v3.MsgRefusePostOwnerTransferRequest")
 	proto.RegisterType((*MsgRefusePostOwnerTransferRequestResponse)(nil), "desmos.posts.v3.MsgRefusePostOwnerTransferRequestResponse")
+	proto.RegisterType((*MsgUnhidePost)(nil), "desmos.posts.v3.MsgUnhidePost")
+	proto.RegisterType((*MsgUnhidePostResponse)(nil), "desmos.posts.v3.MsgUnhidePostResponse")
+	proto.RegisterType((*MsgSetPostRevisionsLimit)(nil), "desmos.posts.v3.MsgSetPostRevisionsLimit")
+	proto.RegisterType((*MsgSetPostRevisionsLimitResponse)(nil), "desmos.posts.v3.MsgSetPostRevisionsLimitResponse")
 }
 
 func init() { proto.RegisterFile("desmos/posts/v3/msgs.proto", fileDescriptor_c5bb13e2c778488f) }
 
 var fileDescriptor_c5bb13e2c778488f = []byte{
-	// 1703 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xbb, 0x6f, 0x1b, 0x47,
-	0x1a, 0xd7, 0x92, 0x12, 0x2d, 0x0d, 0x45, 0x51, 0x5a, 0xd3, 0x27, 0x8a, 0xd6, 0x71, 0xa9, 0xb5,
-	0x7c, 0xa0, 0x1f, 0x22, 0x4f, 0xd4, 0x19, 0x06, 0x78, 0xc0, 0x01, 0xa4, 0x2d, 0x9c, 0x69, 0x1c,
-	0xef, 0x8c, 0x95, 0xdc, 0xf8, 0x0a, 0x62, 0xc5, 0x1d, 0xad, 0x17, 0x58, 0xee, 0xd2, 0x3b, 0x23,
-	0x59, 0x2a, 0xee, 0x10, 0xa4, 0x0a, 0x52, 0xb9, 0x4b, 0x19, 0x20, 0x55, 0x8a, 0x14, 0x2e, 0x8c,
-	0xfc, 0x0b, 0x71, 0x52, 0x19, 0xa9, 0x12, 0x04, 0x60, 0x02, 0xba, 0x70, 0x95, 0x46, 0x45, 0x52,
-	0x05, 0x08, 0xe6, 0xb1, 0x0f, 0x2e, 0x97, 0x94, 0x6c, 0x2b, 0x91, 0x81, 0x34, 0xd2, 0xce, 0xf7,
-	0x9a, 0x99, 0xdf, 0xfc, 0xbe, 0x6f, 0xbe, 0x21, 0xc8, 0x69, 0x10, 0x75, 0x6c, 0x54, 0xee, 0xda,
-	0x08, 0xa3, 0xf2, 0xfe, 0x46, 0xb9, 0x83, 0x74, 0x54, 0xea, 0x3a, 0x36, 0xb6, 0xc5, 0x34, 0xd3,
-	0x95, 0xa8, 0xae, 0xb4, 0xbf, 0x91, 0x5b, 0x50, 0x3b, 0x86, 0x65, 0x97, 0xe9, 0x5f, 0x66, 0x93,
-	0xcb, 0xe8, 0xb6, 0x6e, 0xd3, 0xcf, 0x32, 0xf9, 0xe2, 0xd2, 0x25, 0xdd, 0xb6, 0x75, 0x13, 0x96,
-	0xe9, 0x68, 0x67, 0x6f, 0xb7, 0xac, 0x5a, 0x87, 0x5c, 0x25, 0x85, 0x55, 0xd8, 0xe8, 0x40, 0x84,
-	0xd5, 0x4e, 0xd7, 0xf5, 0x6d, 0xdb, 0x64, 0xd6, 0x16, 0x0b, 0xca, 0x06, 0x5c, 0xb5, 0xc8, 0x46,
-	0x64, 0x8d, 0xe5, 0xfd, 0x75, 0xf2, 0x8f, 0x2b, 0x96, 0x87, 0x76, 0x61, 0x6b, 0xd0, 0xe4, 0x6e,
-	0xf2, 0x2f, 0x09, 0x90, 0x6a, 0x22, 0xfd, 0x96, 0x03, 0x55, 0x0c, 0xef, 0xd9, 0x08, 0x8b, 0x9b,
-	0x20, 0x89, 0xf6, 0x76, 0x50, 0x57, 0x6d, 0xc3, 0x96, 0xa1, 0x65, 0x85, 0x82, 0x50, 0x9c, 0xac,
-	0xaf, 0xf6, 0x7b, 0x12, 0xd8, 0xe2, 0xe2, 0xc6, 0xed, 0xa3, 0x9e, 0x24, 0x1e, 0xaa, 0x1d, 0xb3,
-	0x2a, 0x07, 0x4c, 0x65, 0x05, 0xb8, 0xa3, 0x86, 0x26, 0xd6, 0x00, 0x40, 0xb0, 0x8d, 0x0d, 0xdb,
-	0x22, 0x51, 0x62, 0x05, 0xa1, 0x98, 0xaa, 0xcb, 0xfd, 0x9e, 0x34, 0xb3, 0xc5, 0xa4, 0x34, 0xc8,
-	0x02, 0x0f, 0xe2, 0x19, 0xca, 0xca, 0x0c, 0x1f, 0x34, 0x34, 0xb2, 0x12, 0x78, 0x80, 0xa1, 0x63,
-	0xa9, 0x26, 0x89, 0x11, 0x2f, 0x08, 0xc5, 0x19, 0xb6, 0x92, 0x4d, 0x2e, 0x0e, 0xae, 0x24, 0x60,
-	0x2a, 0x2b, 0xc0, 0x1d, 0x35, 0x34, 0xf1, 0x12, 0x98, 0xc4, 0xf0, 0x00, 0x67, 0x27, 0xa9, 0x7f,
-	0xfa, 0xa8, 0x27, 0x25, 0x99, 0x07, 0x91, 0xca, 0x0a, 0x55, 0x8a, 0x77, 0xc1, 0x34, 0xb4, 0xb0,
-	0x81, 0x0d, 0x88, 0xb2, 0x53, 0x05, 0xa1, 0x98, 0xac, 0x2c, 0x95, 0x42, 0x47, 0x5c, 0xda, 0xe4,
-	0x06, 0xf5, 0xf3, 0x47, 0x3d, 0x29, 0xcd, 0x67, 0xe5, 0x32, 0x59, 0xf1, 0xfc, 0xe9, 0x84, 0xaa,
-	0x8e, 0xb2, 0x89, 0x42, 0x3c, 0x34, 0xa1, 0xaa, 0x23, 0x32, 0xa1, 0xaa, 0x23, 0x11, 0x81, 0xa4,
-	0x8a, 0xb1, 0xda, 0x7e, 0xd8, 0x81, 0x16, 0x46, 0xd9, 0x73, 0x85, 0x78, 0x31, 0x59, 0xc9, 0x94,
-	0x18, 0x03, 0x4a, 0x2e, 0x03, 0x4a, 0x35, 0xeb, 0xb0, 0xfe, 0x77, 0x7f, 0x93, 0x01, 0x17, 0xf9,
-	0xab, 0x67, 0x6b, 0x2b, 0xe1, 0x25, 0xd6, 0x3c, 0xfd, 0x2d, 0xdb, 0xc2, 0xd0, 0xc2, 0x4a, 0x70,
-	0x16, 0xb1, 0x06, 0x12, 0xea, 0x1e, 0x7e, 0x68, 0x3b, 0xd9, 0x69, 0x0a, 0xc6, 0x95, 0xa3, 0x9e,
-	0x94, 0xe2, 0x91, 0xa9, 0x5c, 0xfe, 0xfa, 0xd9, 0x5a, 0x86, 0xf3, 0xaa, 0xa6, 0x69, 0x0e, 0x44,
-	0x68, 0x0b, 0x3b, 0x86, 0xa5, 0x2b, 0xdc, 0x51, 0xbc, 0x0f, 0xd2, 0x6d, 0xdb, 0xda, 0x87, 0x0e,
-	0x52, 0xdd, 0xc3, 0x9d, 0xa1, 0x14, 0xb9, 0xde, 0xef, 0x49, 0x73, 0xb7, 0x02, 0x2a, 0x7a, 0x38,
-	0x7f, 0x62, 0xd1, 0x43, 0x2e, 0xb2, 0x32, 0x17, 0x94, 0x34, 0x34, 0xb1, 0x05, 0xe6, 0x1c, 0xd8,
-	0x35, 0x0f, 0x5b, 0x08, 0x62, 0x6c, 0x58, 0x3a, 0xca, 0x82, 0x82, 0x50, 0x9c, 0xab, 0xfc, 0x79,
-	0xe8, 0x14, 0x14, 0x62, 0xb6, 0xc5, 0xac, 0xea, 0x4b, 0x47, 0x3d, 0xe9, 0x02, 0x9b, 0x62, 0xd0,
-	0x5d, 0x56, 0x52, 0x4e, 0xc0, 0x10, 0x89, 0x36, 0x98, 0x77, 0xe0, 0x2e, 0x74, 0xa0, 0xd5, 0x86,
-	0x5a, 0x8b, 0x46, 0xcb, 0x26, 0x29, 0xe8, 0xf9, 0xa1, 0x29, 0x48, 0x1e, 0x28, 0xae, 0x71, 0x7d,
-	0xf5, 0x79, 0x4f, 0x9a, 0x38, 0xea, 0x49, 0x8b, 0xee, 0x3c, 0x83, 0x51, 0xe4, 0x4f, 0x5f, 0x3d,
-	0xbd, 0x2a, 0x28, 0x69, 0x5f, 0x4e, 0xdc, 0x51, 0x75, 0xf5, 0xfd, 0x57, 0x4f, 0xaf, 0x72, 0xd4,
-	0x3e, 0x7c, 0xf5, 0xf4, 0x6a, 0x86, 0xe7, 0xe1, 0x40, 0xb6, 0xc9, 0x9f, 0x0b, 0xe0, 0xc2, 0x80,
-	0x44, 0x81, 0xa8, 0x6b, 0x5b, 0x08, 0x8a, 0x37, 0xc0, 0x39, 0x12, 0xdf, 0xcf, 0xc1, 0xe5, 0x7e,
-	0x4f, 0x4a, 0x10, 0x13, 0x0a, 0xec, 0x1c, 0x5b, 0x0d, 0x37, 0x91, 0x95, 0x04, 0xf9, 0x6a, 0x68,
-	0xe2, 0x2e, 0x48, 0xb5, 0x49, 0x30, 0x02, 0xb4, 0xa6, 0x62, 0x48, 0x53, 0x2f, 0x59, 0xc9, 0x0d,
-	0x31, 0x6b, 0xdb, 0xad, 0x2d, 0xf5, 0xcb, 0x7c, 0x83, 0x19, 0x7e, 0x56, 0x41, 0x77, 0xf9, 0xc9,
-	0xf7, 0x92, 0xc0, 0x76, 0x38, 0xeb, 0x2a, 0x6e, 0x13, 0xf9, 0x07, 0x71, 0x90, 0x6c, 0x22, 0x7d,
-	0x53, 0x33, 0xf0, 0x69, 0x96, 0x8d, 0xc0, 0xae, 0x63, 0xaf, 0xb1, 0x6b, 0x37, 0xc7, 0xe3, 0x27,
-	0xcd, 0xf1, 0xc9, 0x53, 0xca, 0xf1, 0xa9, 0x71, 0x39, 0x5e, 0x03, 0x09, 0xa8, 0x19, 0xd8, 0x76,
-	0xb2, 0x89, 0x70, 0xba, 0x31, 0xf9, 0x98, 0x74, 0x63, 0x06, 0x55, 0x99, 0xb2, 0x88, 0x0d, 0x08,
-	0x8b, 0x44, 0x9f, 0x45, 0x2e, 0xf4, 0xf2, 0x23, 0x70, 0x3e, 0x30, 0xf4, 0x08, 0xf4, 0x00, 0xcc,
-	0x10, 0x3f, 0xc6, 0x02, 0xe1, 0x58, 0x16, 0xac, 0x70, 0x16, 0xcc, 0xfb, 0x0b, 0x0c, 0x33, 0x60,
-	0x9a, 0x08, 0xe9, 0xe9, 0xff, 0x24, 0xd0, 0x6b, 0xe3, 0x36, 0x34, 0xe1, 0xe9, 0x5e, 0x1b, 0x6f,
-	0x78, 0xfe, 0x35, 0x90, 0x40, 0x86, 0x6e, 0x41, 0x87, 0x33, 0x20, 0x80, 0x34, 0x93, 0x8f, 0x41,
-	0x9a, 0x19, 0xf0, 0x7c, 0x65, 0x83, 0x50, 0xbe, 0xfa, 0xdb, 0x94, 0x17, 0x69, 0xba, 0xfa, 0x02,
-	0x17, 0x6d, 0xf9, 0xc7, 0x18, 0xc8, 0x34, 0x91, 0x5e, 0xd3, 0x68, 0xfa, 0xfb, 0x75, 0xf8, 0x8c,
-	0x81, 0xd1, 0xc1, 0xb9, 0x36, 0xbb, 0x09, 0x28, 0x32, 0xa3, 0xae, 0x98, 0x9b, 0x7e, 0x08, 0x6e,
-	0x7e, 0xc2, 0xeb, 0xc5, 0x8d, 0x1e, 0xe0, 0xfa, 0xe4, 0x9b, 0x72, 0xfd, 0x5a, 0x88, 0xeb, 0x17,
-	0xfd, 0x13, 0x18, 0x82, 0x55, 0xfe, 0x52, 0x00, 0xcb, 0x51, 0x0a, 0x8f, 0xfe, 0x4d, 0x90, 0xf2,
-	0xaf, 0x3e, 0x17, 0xf9, 0x54, 0xbd, 0xd8, 0xef, 0x49, 0xb3, 0xbe, 0x39, 0x05, 0x2f, 0x13, 0xbe,
-	0x5c, 0x29, 0x84, 0xb3, 0xfe, 0xb8, 0xa1, 0x0d, 0x66, 0x53, 0xec, 0x74, 0xb3, 0xe9, 0xdb, 0x18,
-	0x58, 0x6c, 0x22, 0x5d, 0x81, 0x1d, 0x7b, 0x1f, 0xbe, 0x53, 0xf4, 0x19, 0x02, 0x31, 0xfe, 0x56,
-	0x20, 0x9e, 0x02, 0x49, 0x4a, 0x21, 0x92, 0xe4, 0x7d, 0x92, 0x44, 0xe1, 0x27, 0xff, 0x0f, 0x48,
-	0x23, 0x54, 0xbf, 0x4b, 0xa1, 0xfc, 0x39, 0x46, 0x0b, 0x65, 0xcd, 0x42, 0x8f, 0xa1, 0x73, 0xcf,
-	0x36, 0xcd, 0x33, 0x3e, 0x50, 0xea, 0x66, 0x9a, 0xfe, 0x51, 0x72, 0x37, 0xd3, 0x1c, 0x74, 0x33,
-	0x4d, 0xd7, 0xcd, 0x24, 0x3d, 0xf4, 0x1d, 0x90, 0x56, 0xe9, 0x16, 0x50, 0xcb, 0xb0, 0x34, 0x78,
-	0x40, 0x6f, 0xd0, 0x78, 0x31, 0x55, 0x97, 0xfc, 0x1e, 0x2f, 0x64, 0xc0, 0xfb, 0xa2, 0x39, 0x2e,
-	0x6e, 0x30, 0x69, 0xa0, 0x52, 0x4f, 0xfd, 0x06, 0x95, 0xda, 0xc7, 0x99, 0x57, 0x6a, 0x5f, 0xe0,
-	0x55, 0xea, 0x2f, 0x04, 0x90, 0x6e, 0x22, 0xfd, 0x7e, 0x97, 0x1c, 0xda, 0x3d, 0xd5, 0x51, 0x3b,
-	0x48, 0xbc, 0x0b, 0x66, 0x58, 0xa7, 0x66, 0xe0, 0x43, 0x7a, 0x24, 0x33, 0xf5, 0xeb, 0xfe, 0x11,
-	0x7b, 0xaa, 0xd1, 0x6b, 0xf3, 0xdd, 0xc5, 0x1b, 0x20, 0xd1, 0xa5, 0x51, 0x79, 0x99, 0x58, 0x1c,
-	0xee, 0x2f, 0xa9, 0xba, 0x3e, 0x49, 0x88, 0xa4, 0x70, 0xe3, 0xea, 0x3a, 0xd9, 0x95, 0x1f, 0x26,
-	0xc8, 0xed, 0x03, 0xfe, 0x78, 0x0b, 0xad, 0x5a, 0x5e, 0xa2, 0x65, 0x23, 0x28, 0xf2, 0x36, 0xf9,
-	0x19, 0x6b, 0xcf, 0x9a, 0x9c, 0xf5, 0x67, 0xcc, 0xba, 0x16, 0x10, 0xb1, 0xea, 0xe8, 0x10, 0xb7,
-	0x82, 0x8b, 0x88, 0xd3, 0x08, 0xeb, 0xfd, 0x9e, 0x34, 0xbf, 0x4d, 0xb5, 0x03, 0x4b, 0x59, 0x72,
-	0xfb, 0xa9, 0xb0, 0x9f, 0xac, 0xcc, 0xe3, 0x41, 0x73, 0x4d, 0xfc, 0x2f, 0x58, 0x70, 0x0d, 0xfd,
-	0x47, 0xe7, 0x24, 0x25, 0x78, 0xb9, 0xdf, 0x93, 0xd2, 0x3c, 0x7e, 0xe0, 0xe9, 0x99, 0x1d, 0x0c,
-	0x1f, 0x78, 0x81, 0xa6, 0xf1, 0x80, 0xb1, 0x26, 0xfe, 0x03, 0x4c, 0xd9, 0x8f, 0x7d, 0xc6, 0x16,
-	0x8f, 0x7a, 0xd2, 0x2c, 0xf3, 0xa6, 0xe2, 0xd1, 0xa4, 0x60, 0x6e, 0xd5, 0x15, 0x72, 0xb2, 0xec,
-	0x3b, 0xd4, 0xc2, 0xb9, 0xc7, 0x23, 0xff, 0x8b, 0xb6, 0x70, 0xee, 0xf0, 0x2d, 0xdf, 0x00, 0xf2,
-	0x77, 0x31, 0x70, 0x91, 0x16, 0xbd, 0x47, 0x7b, 0x10, 0xd1, 0xa6, 0xf0, 0x3f, 0x64, 0xf2, 0x6d,
-	0x47, 0xb5, 0xd0, 0x2e, 0x74, 0xce, 0x98, 0x0c, 0xff, 0x04, 0xd3, 0x0e, 0x6c, 0x43, 0x63, 0xdf,
-	0xeb, 0xd6, 0xae, 0xf9, 0xbd, 0xb6, 0xab, 0x19, 0x0d, 0xaa, 0xe7, 0x4c, 0x4b, 0x09, 0xb4, 0x34,
-	0x18, 0x71, 0x9b, 0x30, 0xf9, 0xb8, 0x52, 0x42, 0x0d, 0xaa, 0x15, 0x56, 0x4a, 0xe8, 0x80, 0x9c,
-	0x8d, 0x1c, 0xbc, 0x4d, 0xa2, 0xd1, 0x93, 0x2f, 0x83, 0x4b, 0x63, 0xd4, 0x5e, 0x06, 0x7e, 0x14,
-	0x03, 0x2b, 0xe4, 0x65, 0xa7, 0x5a, 0x6d, 0x68, 0x46, 0x98, 0x51, 0xf7, 0x77, 0xa0, 0x6d, 0x66,
-	0x08, 0xc6, 0xdf, 0x14, 0xc1, 0xf5, 0x10, 0x82, 0x2b, 0x81, 0x67, 0x6e, 0xf4, 0xd6, 0xe5, 0x6b,
-	0xe0, 0xca, 0xb1, 0xc0, 0x78, 0x30, 0x7e, 0xcc, 0x60, 0xac, 0xb5, 0xdb, 0xb0, 0x8b, 0xdf, 0x51,
-	0x18, 0x4f, 0x8b, 0xd1, 0xd5, 0x0d, 0x02, 0xa6, 0x37, 0x0c, 0xc1, 0x39, 0x02, 0x02, 0x0e, 0xe7,
-	0x78, 0x80, 0xc2, 0x70, 0x2a, 0x70, 0x77, 0x0f, 0xc1, 0x3f, 0x34, 0x9c, 0x23, 0x20, 0xe0, 0x70,
-	0x8e, 0x07, 0xc8, 0x85, 0xb3, 0xf2, 0x09, 0x00, 0xf1, 0x26, 0xd2, 0xc5, 0x6d, 0x00, 0x02, 0x3f,
-	0xa1, 0x0e, 0xff, 0xa2, 0x34, 0xf0, 0x13, 0x4f, 0xee, 0x2f, 0xe3, 0xf5, 0x5e, 0xf9, 0xff, 0x37,
-	0x98, 0xf6, 0x7e, 0x5f, 0x59, 0x8e, 0xf2, 0x71, 0xb5, 0xb9, 0xd5, 0x71, 0x5a, 0x2f, 0xde, 0x36,
-	0x00, 0x81, 0x17, 0x7b, 0xe4, 0x2a, 0x7d, 0x7d, 0xf4, 0x2a, 0x87, 0x5f, 0xbe, 0xa2, 0x01, 0x16,
-	0x86, 0x5f, 0xbd, 0x97, 0xa3, 0x9c, 0x87, 0xcc, 0x72, 0x6b, 0x27, 0x32, 0xf3, 0xa6, 0x72, 0x40,
-	0x26, 0xf2, 0x91, 0x54, 0x8c, 0x0a, 0x13, 0x65, 0x99, 0xfb, 0xeb, 0x49, 0x2d, 0x83, 0xa0, 0x05,
-	0xba, 0xf7, 0x48, 0xd0, 0x7c, 0x7d, 0x34, 0x68, 0xc3, 0x4d, 0xa8, 0xf8, 0x00, 0xcc, 0x0e, 0x34,
-	0xa0, 0x85, 0x28, 0xbf, 0xa0, 0x45, 0xae, 0x78, 0x9c, 0x45, 0x90, 0x36, 0x5e, 0xdf, 0x17, 0x49,
-	0x1b, 0x57, 0x1b, 0x4d, 0x9b, 0xa1, 0x2e, 0xe4, 0xff, 0x20, 0x3b, 0xb2, 0x95, 0xb8, 0x1e, 0x8d,
-	0x67, 0xb4, 0x75, 0xee, 0x6f, 0xaf, 0x63, 0xed, 0xcd, 0xff, 0x44, 0x00, 0xf9, 0x63, 0xae, 0xd1,
-	0x4a, 0x64, 0x46, 0x8d, 0xf5, 0xc9, 0x55, 0x5f, 0xdf, 0x67, 0x60, 0x49, 0xc7, 0x5c, 0x49, 0x91,
-	0x4b, 0x1a, 0xef, 0x13, 0xbd, 0xa4, 0x93, 0x55, 0x76, 0xba, 0xa4, 0x63, 0xca, 0x7a, 0x25, 0x1a,
-	0xfe, 0x71, 0x3e, 0xd1, 0x4b, 0x3a, 0x59, 0x75, 0xcc, 0x4d, 0xbd, 0x47, 0x9e, 0x80, 0xf5, 0x3b,
-	0xcf, 0xfb, 0x79, 0xe1, 0x45, 0x3f, 0x2f, 0xfc, 0xd0, 0xcf, 0x0b, 0x4f, 0x5e, 0xe6, 0x27, 0x5e,
-	0xbc, 0xcc, 0x4f, 0x7c, 0xf3, 0x32, 0x3f, 0xf1, 0xa0, 0xa4, 0x1b, 0xf8, 0xe1, 0xde, 0x4e, 0xa9,
-	0x6d, 0x77, 0xca, 0x6c, 0x9a, 0x35, 0x53, 0xdd, 0x41, 0xfc, 0xbb, 0xbc, 0x7f, 0xd3, 0x7b, 0xfa,
-	0xe0, 0xc3, 0x2e, 0x44, 0x3b, 0x09, 0xfa, 0x1c, 0xdf, 0xf8, 0x35, 0x00, 0x00, 0xff, 0xff, 0xec,
-	0x64, 0xe3, 0x3b, 0x9a, 0x1b, 0x00, 0x00,
+	// 1862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x3d, 0x6c, 0x1b, 0xc9,
+	0x15, 0xf6, 0x92, 0x12, 0x2d, 0x0d, 0x45, 0xd1, 0x5e, 0xcb, 0x11, 0xb5, 0x76, 0xb8, 0xd4, 0x9c,
+	0x2c, 0xd0, 0x7f, 0x64, 0x24, 0xe7, 0x70, 0x00, 0x03, 0x04, 0x20, 0x6d, 0x23, 0xc7, 0xc3, 0x31,
+	0x31, 0x56, 0x72, 0xe3, 0x14, 0xc4, 0x8a, 0x3b, 0x5a, 0x2d, 0xb0, 0xdc, 0xe5, 0xed, 0x8c, 0x74,
+	0x52, 0x91, 0x20, 0x48, 0x15, 0xa4, 0x72, 0x97, 0x32, 0x6d, 0x8a, 0x14, 0x2e, 0x0e, 0x29, 0xd3,
+	0xe6, 0x92, 0xea, 0x90, 0x2a, 0x41, 0x00, 0x26, 0xa0, 0x0b, 0x57, 0x41, 0x00, 0x15, 0x49, 0x15,
+	0x20, 0x98, 0x9f, 0xdd, 0x59, 0x2e, 0x97, 0x94, 0x74, 0xa7, 0xc4, 0x46, 0xae, 0xb1, 0x39, 0xef,
+	0x6f, 0x66, 0xbe, 0xf7, 0xcd, 0x9b, 0x37, 0x2b, 0xa0, 0x59, 0x08, 0xf7, 0x7d, 0x5c, 0x1f, 0xf8,
+	0x98, 0xe0, 0xfa, 0xd1, 0xa3, 0x7a, 0x1f, 0xdb, 0xb8, 0x36, 0x08, 0x7c, 0xe2, 0xab, 0x45, 0xae,
+	0xab, 0x31, 0x5d, 0xed, 0xe8, 0x91, 0x76, 0xdd, 0xec, 0x3b, 0x9e, 0x5f, 0x67, 0xff, 0x72, 0x1b,
+	0x6d, 0xc5, 0xf6, 0x6d, 0x9f, 0xfd, 0xac, 0xd3, 0x5f, 0x42, 0xba, 0x66, 0xfb, 0xbe, 0xed, 0xa2,
+	0x3a, 0x1b, 0xed, 0x1d, 0xee, 0xd7, 0x4d, 0xef, 0x44, 0xa8, 0xf4, 0xa4, 0x8a, 0x38, 0x7d, 0x84,
+	0x89, 0xd9, 0x1f, 0x84, 0xbe, 0x3d, 0x9f, 0xce, 0xda, 0xe5, 0x41, 0xf9, 0x40, 0xa8, 0x56, 0xf9,
+	0x88, 0xae, 0xb1, 0x7e, 0xb4, 0x45, 0xff, 0x13, 0x8a, 0xdb, 0x13, 0xbb, 0xf0, 0x2d, 0xe4, 0x0a,
+	0x37, 0xf8, 0xdb, 0x05, 0x50, 0xe8, 0x60, 0xfb, 0x71, 0x80, 0x4c, 0x82, 0x9e, 0xf9, 0x98, 0xa8,
+	0x4f, 0x41, 0x1e, 0x1f, 0xee, 0xe1, 0x81, 0xd9, 0x43, 0x5d, 0xc7, 0x2a, 0x29, 0x15, 0xa5, 0x3a,
+	0xd7, 0xda, 0x18, 0x0d, 0x75, 0xb0, 0x23, 0xc4, 0xed, 0x27, 0xa7, 0x43, 0x5d, 0x3d, 0x31, 0xfb,
+	0x6e, 0x03, 0xc6, 0x4c, 0xa1, 0x01, 0xc2, 0x51, 0xdb, 0x52, 0x9b, 0x00, 0x60, 0xd4, 0x23, 0x8e,
+	0xef, 0xd1, 0x28, 0x99, 0x8a, 0x52, 0x2d, 0xb4, 0xe0, 0x68, 0xa8, 0x2f, 0xee, 0x70, 0x29, 0x0b,
+	0x72, 0x5d, 0x04, 0x89, 0x0c, 0xa1, 0xb1, 0x28, 0x06, 0x6d, 0x8b, 0xae, 0x04, 0x1d, 0x13, 0x14,
+	0x78, 0xa6, 0x4b, 0x63, 0x64, 0x2b, 0x4a, 0x75, 0x91, 0xaf, 0xe4, 0xa9, 0x10, 0xc7, 0x57, 0x12,
+	0x33, 0x85, 0x06, 0x08, 0x47, 0x6d, 0x4b, 0x7d, 0x0f, 0xcc, 0x11, 0x74, 0x4c, 0x4a, 0x73, 0xcc,
+	0xbf, 0x78, 0x3a, 0xd4, 0xf3, 0xdc, 0x83, 0x4a, 0xa1, 0xc1, 0x94, 0xea, 0x47, 0x60, 0x01, 0x79,
+	0xc4, 0x21, 0x0e, 0xc2, 0xa5, 0xf9, 0x8a, 0x52, 0xcd, 0x6f, 0xaf, 0xd5, 0x12, 0x29, 0xae, 0x3d,
+	0x15, 0x06, 0xad, 0x1b, 0xa7, 0x43, 0xbd, 0x28, 0x66, 0x15, 0x32, 0x68, 0x44, 0xfe, 0x6c, 0x42,
+	0xd3, 0xc6, 0xa5, 0x5c, 0x25, 0x9b, 0x98, 0xd0, 0xb4, 0x31, 0x9d, 0xd0, 0xb4, 0xb1, 0x8a, 0x41,
+	0xde, 0x24, 0xc4, 0xec, 0x1d, 0xf4, 0x91, 0x47, 0x70, 0xe9, 0x6a, 0x25, 0x5b, 0xcd, 0x6f, 0xaf,
+	0xd4, 0x38, 0x03, 0x6a, 0x21, 0x03, 0x6a, 0x4d, 0xef, 0xa4, 0xf5, 0x1d, 0xb9, 0xc9, 0x98, 0x0b,
+	0xfc, 0xc3, 0x67, 0x0f, 0xd7, 0x93, 0x4b, 0x6c, 0x46, 0xfa, 0xc7, 0xbe, 0x47, 0x90, 0x47, 0x8c,
+	0xf8, 0x2c, 0x6a, 0x13, 0xe4, 0xcc, 0x43, 0x72, 0xe0, 0x07, 0xa5, 0x05, 0x06, 0xc6, 0xdd, 0xd3,
+	0xa1, 0x5e, 0x10, 0x91, 0x99, 0x1c, 0xfe, 0xf1, 0xb3, 0x87, 0x2b, 0x82, 0x57, 0x4d, 0xcb, 0x0a,
+	0x10, 0xc6, 0x3b, 0x24, 0x70, 0x3c, 0xdb, 0x10, 0x8e, 0xea, 0x73, 0x50, 0xec, 0xf9, 0xde, 0x11,
+	0x0a, 0xb0, 0x19, 0x26, 0x77, 0x91, 0x51, 0xe4, 0xc1, 0x68, 0xa8, 0x2f, 0x3f, 0x8e, 0xa9, 0x58,
+	0x72, 0xbe, 0xc1, 0xa3, 0x27, 0x5c, 0xa0, 0xb1, 0x1c, 0x97, 0xb4, 0x2d, 0xb5, 0x0b, 0x96, 0x03,
+	0x34, 0x70, 0x4f, 0xba, 0x18, 0x11, 0xe2, 0x78, 0x36, 0x2e, 0x81, 0x8a, 0x52, 0x5d, 0xde, 0xfe,
+	0xe6, 0x44, 0x16, 0x0c, 0x6a, 0xb6, 0xc3, 0xad, 0x5a, 0x6b, 0xa7, 0x43, 0xfd, 0x26, 0x9f, 0x62,
+	0xdc, 0x1d, 0x1a, 0x85, 0x20, 0x66, 0x88, 0x55, 0x1f, 0x5c, 0x0b, 0xd0, 0x3e, 0x0a, 0x90, 0xd7,
+	0x43, 0x56, 0x97, 0x45, 0x2b, 0xe5, 0x19, 0xe8, 0xe5, 0x89, 0x29, 0xe8, 0x39, 0x30, 0x42, 0xe3,
+	0xd6, 0xc6, 0xe7, 0x43, 0xfd, 0xca, 0xe9, 0x50, 0x5f, 0x0d, 0xe7, 0x19, 0x8f, 0x02, 0x7f, 0xf5,
+	0xe6, 0xd5, 0x3d, 0xc5, 0x28, 0x4a, 0x39, 0x75, 0xc7, 0xea, 0x0b, 0xb0, 0x34, 0x38, 0xdc, 0x73,
+	0x1d, 0x7c, 0xd0, 0xa5, 0xc7, 0xb8, 0xb4, 0xc4, 0x58, 0xa5, 0x4d, 0x64, 0x78, 0x37, 0x3c, 0xe3,
+	0xad, 0x5b, 0xa7, 0x43, 0xfd, 0x06, 0x9f, 0x24, 0xee, 0x09, 0x5f, 0xfe, 0x55, 0x57, 0x8c, 0xbc,
+	0x10, 0x51, 0x73, 0xb5, 0x07, 0x8a, 0xe8, 0x78, 0xe0, 0x04, 0x1c, 0x4f, 0x16, 0xbe, 0x70, 0x66,
+	0xf8, 0xb2, 0x4c, 0x47, 0xc2, 0x99, 0xcf, 0xb0, 0x2c, 0xa5, 0xd4, 0xa9, 0xb1, 0xf1, 0xd3, 0x37,
+	0xaf, 0xee, 0x89, 0xb4, 0xff, 0xfc, 0xcd, 0xab, 0x7b, 0x2b, 0xa2, 0x90, 0x8c, 0x95, 0x0b, 0xf8,
+	0x1b, 0x05, 0xdc, 0x1c, 0x93, 0x18, 0x08, 0x0f, 0x7c, 0x0f, 0x23, 0xf5, 0x7d, 0x70, 0x95, 0x02,
+	0x24, 0x8b, 0xc8, 0xed, 0xd1, 0x50, 0xcf, 0x51, 0x13, 0xc6, 0x8c, 0x65, 0xb1, 0x53, 0x6e, 0x02,
+	0x8d, 0x1c, 0xfd, 0xd5, 0xb6, 0xd4, 0x7d, 0x50, 0xe8, 0x05, 0x88, 0x2f, 0xce, 0x32, 0x09, 0x2a,
+	0x65, 0xce, 0xdc, 0xd9, 0x1d, 0x91, 0xa1, 0x15, 0x41, 0xb6, 0xb8, 0x3b, 0xdb, 0x1b, 0x4f, 0xd1,
+	0x52, 0xa8, 0x78, 0x42, 0xe5, 0x3f, 0xcb, 0x82, 0x7c, 0x07, 0xdb, 0x4f, 0x2d, 0x87, 0x5c, 0x66,
+	0xdd, 0x8b, 0xed, 0x3a, 0x73, 0x81, 0x5d, 0x87, 0x45, 0x2a, 0x7b, 0xde, 0x22, 0x35, 0x77, 0x49,
+	0x45, 0x6a, 0x7e, 0x56, 0x91, 0x6a, 0x82, 0x1c, 0xb2, 0x1c, 0xe2, 0x07, 0xa5, 0x5c, 0xb2, 0x5e,
+	0x70, 0xf9, 0x8c, 0x7a, 0xc1, 0x0d, 0x1a, 0x90, 0xb1, 0x88, 0x0f, 0x28, 0x8b, 0x54, 0xc9, 0xa2,
+	0x10, 0x7a, 0xf8, 0x09, 0xb8, 0x11, 0x1b, 0x46, 0x04, 0x7a, 0x01, 0x16, 0xa9, 0x1f, 0x67, 0x81,
+	0x72, 0x26, 0x0b, 0xd6, 0x05, 0x0b, 0xae, 0xc9, 0x05, 0x26, 0x19, 0xb0, 0x40, 0x85, 0x2c, 0xfb,
+	0xff, 0x54, 0xd8, 0xbd, 0xf7, 0x04, 0xb9, 0x88, 0xa0, 0x77, 0x20, 0xff, 0x4d, 0x90, 0xc3, 0x8e,
+	0xed, 0xa1, 0xa0, 0x94, 0x4d, 0x22, 0xcd, 0xe5, 0x33, 0x90, 0xe6, 0x06, 0xe2, 0xbc, 0xf2, 0x41,
+	0xe2, 0xbc, 0xca, 0x6d, 0xc2, 0x55, 0x70, 0x73, 0x4c, 0x10, 0xa2, 0x0d, 0xff, 0x9e, 0x01, 0x2b,
+	0x1d, 0x6c, 0x37, 0x2d, 0x56, 0xbf, 0xe4, 0x45, 0xf2, 0x96, 0x81, 0xb1, 0xc1, 0xd5, 0x1e, 0xbf,
+	0xca, 0x18, 0x32, 0xd3, 0xee, 0xc8, 0x0f, 0x64, 0x08, 0x61, 0x7e, 0xce, 0xfb, 0x31, 0x8c, 0x1e,
+	0xe3, 0xfa, 0xdc, 0x97, 0xe5, 0xfa, 0xfd, 0x04, 0xd7, 0x6f, 0xc9, 0x0c, 0x4c, 0xc0, 0x0a, 0x7f,
+	0xaf, 0x80, 0xdb, 0x69, 0x8a, 0x88, 0xfe, 0x1d, 0x50, 0x90, 0x77, 0x77, 0x88, 0x7c, 0xa1, 0x55,
+	0x1d, 0x0d, 0xf5, 0x25, 0x69, 0xde, 0x7e, 0x22, 0x0b, 0xdf, 0x98, 0x39, 0x34, 0x96, 0xe4, 0xb8,
+	0x6d, 0x8d, 0x9f, 0xa6, 0xcc, 0xe5, 0x9e, 0xa6, 0x3f, 0x67, 0xc0, 0x6a, 0x07, 0xdb, 0x06, 0xea,
+	0xfb, 0x47, 0xe8, 0x9d, 0xa2, 0xcf, 0x04, 0x88, 0xd9, 0xaf, 0x04, 0xe2, 0x25, 0x90, 0xa4, 0x96,
+	0x20, 0x49, 0x59, 0x92, 0x24, 0x0d, 0x3f, 0xf8, 0x23, 0xa0, 0x4f, 0x51, 0xfd, 0x4f, 0x0a, 0xe5,
+	0xbf, 0x32, 0xac, 0x50, 0x36, 0x3d, 0xfc, 0x29, 0x0a, 0x9e, 0xf9, 0xae, 0xfb, 0x96, 0x13, 0xca,
+	0xdc, 0x5c, 0x57, 0xa6, 0x52, 0xb8, 0xb9, 0xee, 0xb8, 0x9b, 0xeb, 0x86, 0x6e, 0x2e, 0x7d, 0x04,
+	0x7c, 0x08, 0x8a, 0x26, 0xdb, 0x02, 0xee, 0x3a, 0x9e, 0x85, 0x8e, 0xd9, 0x0d, 0x9a, 0xad, 0x16,
+	0x5a, 0xba, 0xec, 0x8a, 0x12, 0x06, 0xa2, 0xb1, 0x5b, 0x16, 0xe2, 0x36, 0x97, 0xc6, 0x2a, 0xf5,
+	0xfc, 0x7f, 0xa1, 0x52, 0x4b, 0x9c, 0x45, 0xa5, 0x96, 0x82, 0xa8, 0x52, 0xff, 0x4e, 0x01, 0xc5,
+	0x0e, 0xb6, 0x9f, 0x0f, 0x68, 0xd2, 0x9e, 0x99, 0x81, 0xd9, 0xc7, 0xea, 0x47, 0x60, 0x91, 0x77,
+	0x6a, 0x0e, 0x39, 0x61, 0x29, 0x59, 0x6c, 0x3d, 0x90, 0x29, 0x8e, 0x54, 0xd3, 0xd7, 0x26, 0xdd,
+	0xd5, 0xf7, 0x41, 0x6e, 0xc0, 0xa2, 0x8a, 0x32, 0xb1, 0x3a, 0xd9, 0x20, 0x33, 0x75, 0x6b, 0x8e,
+	0x12, 0xc9, 0x10, 0xc6, 0x8d, 0x2d, 0xba, 0x2b, 0x19, 0x26, 0xce, 0xed, 0x63, 0xf1, 0xfa, 0x4c,
+	0xac, 0x1a, 0xae, 0x81, 0xd5, 0x84, 0x28, 0xda, 0xe4, 0xaf, 0x79, 0x7b, 0xd6, 0x11, 0xac, 0x7f,
+	0xcb, 0xac, 0xeb, 0x02, 0x95, 0x98, 0x81, 0x8d, 0x48, 0x37, 0xbe, 0x88, 0x2c, 0x8b, 0xb0, 0x35,
+	0x1a, 0xea, 0xd7, 0x76, 0x99, 0x76, 0x6c, 0x29, 0x6b, 0x61, 0x3f, 0x95, 0xf4, 0x83, 0xc6, 0x35,
+	0x32, 0x6e, 0x6e, 0xa9, 0x3f, 0x04, 0xd7, 0x43, 0x43, 0xf9, 0x6a, 0x9e, 0x63, 0x04, 0xaf, 0x8f,
+	0x86, 0x7a, 0x51, 0xc4, 0x8f, 0xbd, 0x9d, 0x4b, 0xe3, 0xe1, 0x63, 0x4f, 0xe8, 0x22, 0x19, 0x33,
+	0xb6, 0xd4, 0xef, 0x82, 0x79, 0xff, 0x53, 0xc9, 0xd8, 0xea, 0xe9, 0x50, 0x5f, 0xe2, 0xde, 0x4c,
+	0x3c, 0x9d, 0x14, 0xdc, 0xad, 0xb1, 0x4e, 0x33, 0xcb, 0x7f, 0x27, 0x5a, 0xb8, 0x30, 0x3d, 0xf0,
+	0x63, 0x70, 0x23, 0x36, 0xfc, 0x8a, 0x6f, 0x00, 0xf8, 0x97, 0x0c, 0xb8, 0xc5, 0x8a, 0xde, 0x27,
+	0x87, 0x08, 0xb3, 0xa6, 0xf0, 0x07, 0x74, 0xf2, 0xdd, 0xc0, 0xf4, 0xf0, 0x3e, 0x0a, 0xde, 0x32,
+	0x19, 0xbe, 0x07, 0x16, 0x02, 0xd4, 0x43, 0xce, 0x51, 0xd4, 0xad, 0xdd, 0x97, 0xbd, 0x76, 0xa8,
+	0x99, 0x0e, 0x6a, 0xe4, 0xcc, 0x4a, 0x09, 0xf2, 0x2c, 0x94, 0x72, 0x9b, 0x70, 0xf9, 0xac, 0x52,
+	0xc2, 0x0c, 0x1a, 0xdb, 0xbc, 0x94, 0xb0, 0x01, 0xcd, 0x0d, 0x8c, 0xdf, 0x26, 0xe9, 0xe8, 0xc1,
+	0x3b, 0xe0, 0xbd, 0x19, 0xea, 0xe8, 0x04, 0xfe, 0x22, 0x03, 0xd6, 0xe9, 0xcb, 0xce, 0xf4, 0x7a,
+	0xc8, 0x4d, 0x31, 0x63, 0xee, 0xef, 0x40, 0xdb, 0xcc, 0x11, 0xcc, 0x7e, 0x59, 0x04, 0xb7, 0x12,
+	0x08, 0xae, 0xc7, 0x9e, 0xb9, 0xe9, 0x5b, 0x87, 0xf7, 0xc1, 0xdd, 0x33, 0x81, 0x89, 0x60, 0xfc,
+	0x25, 0x87, 0xb1, 0xd9, 0xeb, 0xa1, 0x01, 0x79, 0x47, 0x61, 0xbc, 0x2c, 0x46, 0x37, 0x1e, 0x51,
+	0x30, 0xa3, 0x61, 0x02, 0xce, 0x29, 0x10, 0x08, 0x38, 0x67, 0x03, 0x94, 0x84, 0xd3, 0x40, 0xfb,
+	0x87, 0x18, 0x7d, 0xad, 0xe1, 0x9c, 0x02, 0x81, 0x80, 0x73, 0x36, 0x40, 0x11, 0x9c, 0xe2, 0x1d,
+	0xfc, 0xdc, 0x3b, 0x70, 0xac, 0xff, 0xff, 0x77, 0xb0, 0xdc, 0xa6, 0xe8, 0xae, 0xa4, 0x20, 0x42,
+	0xe4, 0xdf, 0x0a, 0x28, 0x75, 0xb0, 0xbd, 0x83, 0xc4, 0xc7, 0x88, 0x23, 0x07, 0x3b, 0xbe, 0x87,
+	0x3f, 0x76, 0xfa, 0xce, 0xa5, 0x81, 0xb3, 0x09, 0xe6, 0x5d, 0x1a, 0x4f, 0x7c, 0x17, 0xbf, 0x26,
+	0x2f, 0x64, 0x26, 0x86, 0x06, 0x57, 0x5f, 0x06, 0x1a, 0xf5, 0x04, 0x1a, 0xba, 0x44, 0x23, 0x75,
+	0x8b, 0x10, 0x82, 0xca, 0x34, 0x5d, 0x88, 0xd1, 0xf6, 0x3f, 0xf2, 0x20, 0xdb, 0xc1, 0xb6, 0xba,
+	0x0b, 0x40, 0xec, 0x2f, 0x07, 0x93, 0x1f, 0x52, 0xc7, 0x3e, 0x0c, 0x6a, 0x9b, 0xb3, 0xf5, 0x51,
+	0xd3, 0xf0, 0x7d, 0xb0, 0x10, 0x7d, 0x95, 0xbb, 0x9d, 0xe6, 0x13, 0x6a, 0xb5, 0x8d, 0x59, 0xda,
+	0x28, 0xde, 0x2e, 0x00, 0xb1, 0xef, 0x3c, 0xa9, 0xab, 0x94, 0x7a, 0x6d, 0x73, 0xb6, 0x3e, 0x8a,
+	0xea, 0x80, 0xeb, 0x93, 0xdf, 0x4a, 0xee, 0xa4, 0x39, 0x4f, 0x98, 0x69, 0x0f, 0xcf, 0x65, 0x16,
+	0x4d, 0x15, 0x80, 0x95, 0xd4, 0xa7, 0x75, 0x35, 0x2d, 0x4c, 0x9a, 0xa5, 0xf6, 0xad, 0xf3, 0x5a,
+	0xc6, 0x41, 0x8b, 0xbd, 0xf9, 0x52, 0x41, 0x93, 0x7a, 0x6d, 0x73, 0xb6, 0x3e, 0xf6, 0x52, 0x5d,
+	0x1a, 0x7b, 0xb6, 0x54, 0xd2, 0xfc, 0xe2, 0x16, 0x5a, 0xf5, 0x2c, 0x8b, 0x38, 0x6d, 0xa2, 0xd7,
+	0x42, 0x2a, 0x6d, 0x42, 0xad, 0xb6, 0x31, 0x4b, 0x1b, 0xc5, 0xfb, 0x31, 0x28, 0x4d, 0x6d, 0x40,
+	0x1f, 0xa4, 0xe3, 0x99, 0x6e, 0xad, 0x7d, 0xfb, 0x22, 0xd6, 0xd1, 0xfc, 0x2f, 0x15, 0x50, 0x3e,
+	0xa3, 0xf9, 0xda, 0x4e, 0x3d, 0x51, 0x33, 0x7d, 0xb4, 0xc6, 0xc5, 0x7d, 0xc6, 0x96, 0x74, 0x46,
+	0x23, 0x93, 0xba, 0xa4, 0xd9, 0x3e, 0x5a, 0xe3, 0xe2, 0x3e, 0x63, 0x4b, 0x3a, 0xa3, 0x19, 0xd8,
+	0x4e, 0x87, 0x7f, 0x96, 0x8f, 0xd6, 0xb8, 0xb8, 0x4f, 0xfc, 0xe8, 0xc4, 0xee, 0xd3, 0xd4, 0xa3,
+	0x23, 0xf5, 0xda, 0xe6, 0x6c, 0x7d, 0x14, 0xf5, 0x10, 0xdc, 0x4c, 0xbf, 0x93, 0xee, 0xa6, 0x05,
+	0x48, 0x35, 0xd5, 0xb6, 0xce, 0x6d, 0x1a, 0x4e, 0xab, 0xcd, 0xff, 0x84, 0x7e, 0x05, 0x69, 0x7d,
+	0xf8, 0xf9, 0xa8, 0xac, 0x7c, 0x31, 0x2a, 0x2b, 0x7f, 0x1b, 0x95, 0x95, 0x97, 0xaf, 0xcb, 0x57,
+	0xbe, 0x78, 0x5d, 0xbe, 0xf2, 0xa7, 0xd7, 0xe5, 0x2b, 0x2f, 0x6a, 0xb6, 0x43, 0x0e, 0x0e, 0xf7,
+	0x6a, 0x3d, 0xbf, 0x5f, 0xe7, 0xd1, 0x1f, 0xba, 0xe6, 0x1e, 0x16, 0xbf, 0xeb, 0x47, 0x1f, 0x44,
+	0xaf, 0x7f, 0x72, 0x32, 0x40, 0x78, 0x2f, 0xc7, 0xbe, 0x48, 0x3d, 0xfa, 0xcf, 0x00, 0x01, 0xd3,
+	0x6e, 0x06, 0x5e, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1713,6 +1953,12 @@ type MsgClient interface {
 	//
 	// Since: Desmos 6.0.0
 	RefusePostOwnerTransferRequest(ctx context.Context, in *MsgRefusePostOwnerTransferRequest, opts ...grpc.CallOption) (*MsgRefusePostOwnerTransferRequestResponse, error)
+	// UnhidePost allows moderators to clear the hidden flag of a post that has
+	// been automatically hidden after receiving too many reports
+	UnhidePost(ctx context.Context, in *MsgUnhidePost, opts ...grpc.CallOption) (*MsgUnhidePostResponse, error)
+	// SetPostRevisionsLimit allows subspace admins to set the max number of
+	// revisions that are kept for each post inside the subspace
+	SetPostRevisionsLimit(ctx context.Context, in *MsgSetPostRevisionsLimit, opts ...grpc.CallOption) (*MsgSetPostRevisionsLimitResponse, error)
 }
 
 type msgClient struct {
@@ -1831,6 +2077,24 @@ func (c *msgClient) RefusePostOwnerTransferRequest(ctx context.Context, in *MsgR
 	return out, nil
 }
 
+func (c *msgClient) UnhidePost(ctx context.Context, in *MsgUnhidePost, opts ...grpc.CallOption) (*MsgUnhidePostResponse, error) {
+	out := new(MsgUnhidePostResponse)
+	err := c.cc.Invoke(ctx, "/desmos.posts.v3.Msg/UnhidePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPostRevisionsLimit(ctx context.Context, in *MsgSetPostRevisionsLimit, opts ...grpc.CallOption) (*MsgSetPostRevisionsLimitResponse, error) {
+	out := new(MsgSetPostRevisionsLimitResponse)
+	err := c.cc.Invoke(ctx, "/desmos.posts.v3.Msg/SetPostRevisionsLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreatePost allows to create a new post
@@ -1875,6 +2139,12 @@ type MsgServer interface {
 	//
 	// Since: Desmos 6.0.0
 	RefusePostOwnerTransferRequest(context.Context, *MsgRefusePostOwnerTransferRequest) (*MsgRefusePostOwnerTransferRequestResponse, error)
+	// UnhidePost allows moderators to clear the hidden flag of a post that has
+	// been automatically hidden after receiving too many reports
+	UnhidePost(context.Context, *MsgUnhidePost) (*MsgUnhidePostResponse, error)
+	// SetPostRevisionsLimit allows subspace admins to set the max number of
+	// revisions that are kept for each post inside the subspace
+	SetPostRevisionsLimit(context.Context, *MsgSetPostRevisionsLimit) (*MsgSetPostRevisionsLimitResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1917,6 +2187,12 @@ func (*UnimplementedMsgServer) AcceptPostOwnerTransferRequest(ctx context.Contex
 func (*UnimplementedMsgServer) RefusePostOwnerTransferRequest(ctx context.Context, req *MsgRefusePostOwnerTransferRequest) (*MsgRefusePostOwnerTransferRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefusePostOwnerTransferRequest not implemented")
 }
+func (*UnimplementedMsgServer) UnhidePost(ctx context.Context, req *MsgUnhidePost) (*MsgUnhidePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnhidePost not implemented")
+}
+func (*UnimplementedMsgServer) SetPostRevisionsLimit(ctx context.Context, req *MsgSetPostRevisionsLimit) (*MsgSetPostRevisionsLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPostRevisionsLimit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2138,6 +2414,42 @@ func _Msg_RefusePostOwnerTransferRequest_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnhidePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnhidePost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnhidePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.posts.v3.Msg/UnhidePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnhidePost(ctx, req.(*MsgUnhidePost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPostRevisionsLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPostRevisionsLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPostRevisionsLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.posts.v3.Msg/SetPostRevisionsLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPostRevisionsLimit(ctx, req.(*MsgSetPostRevisionsLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "desmos.posts.v3.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2190,6 +2502,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefusePostOwnerTransferRequest",
 			Handler:    _Msg_RefusePostOwnerTransferRequest_Handler,
 		},
+		{
+			MethodName: "UnhidePost",
+			Handler:    _Msg_UnhidePost_Handler,
+		},
+		{
+			MethodName: "SetPostRevisionsLimit",
+			Handler:    _Msg_SetPostRevisionsLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "desmos/posts/v3/msgs.proto",
@@ -2215,6 +2535,26 @@ func (m *MsgCreatePost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMsgs(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PublishTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PublishTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PublishTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMsgs(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.ReferencedPosts) > 0 {
 		for iNdEx := len(m.ReferencedPosts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2328,12 +2668,12 @@ func (m *MsgCreatePostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationDate):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationDate):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintMsgs(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintMsgs(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x12
 	if m.PostID != 0 {
@@ -2432,12 +2772,12 @@ func (m *MsgEditPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EditDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EditDate):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EditDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EditDate):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintMsgs(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintMsgs(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -2578,12 +2918,12 @@ func (m *MsgAddPostAttachmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EditDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EditDate):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EditDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EditDate):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintMsgs(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintMsgs(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if m.AttachmentID != 0 {
@@ -2659,12 +2999,12 @@ func (m *MsgRemovePostAttachmentResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EditDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EditDate):])
-	if err7 != nil {
-		return 0, err7
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EditDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EditDate):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n7
-	i = encodeVarintMsgs(dAtA, i, uint64(n7))
+	i -= n9
+	i = encodeVarintMsgs(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -2698,20 +3038,20 @@ func (m *MsgAnswerPoll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.AnswersIndexes) > 0 {
-		dAtA9 := make([]byte, len(m.AnswersIndexes)*10)
-		var j8 int
+		dAtA11 := make([]byte, len(m.AnswersIndexes)*10)
+		var j10 int
 		for _, num := range m.AnswersIndexes {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j10++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintMsgs(dAtA, i, uint64(j8))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintMsgs(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -3156,38 +3496,164 @@ func (m *MsgRefusePostOwnerTransferRequestResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUnhidePost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreatePost) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUnhidePost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnhidePost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	if m.SectionID != 0 {
-		n += 1 + sovMsgs(uint64(m.SectionID))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ExternalID)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.PostID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Text)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Entities != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnhidePostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnhidePostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnhidePostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPostRevisionsLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPostRevisionsLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPostRevisionsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPostRevisionsLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPostRevisionsLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPostRevisionsLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.SectionID != 0 {
+		n += 1 + sovMsgs(uint64(m.SectionID))
+	}
+	l = len(m.ExternalID)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Entities != nil {
 		l = m.Entities.Size()
 		n += 1 + l + sovMsgs(uint64(l))
 	}
@@ -3219,6 +3685,14 @@ func (m *MsgCreatePost) Size() (n int) {
 			n += 1 + l + sovMsgs(uint64(l))
 		}
 	}
+	if m.PublishTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PublishTime)
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -3593,6 +4067,62 @@ func (m *MsgRefusePostOwnerTransferRequestResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUnhidePost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.PostID != 0 {
+		n += 1 + sovMsgs(uint64(m.PostID))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnhidePostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPostRevisionsLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMsgs(uint64(m.Limit))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPostRevisionsLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovMsgs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3936,6 +4466,78 @@ func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublishTime == nil {
+				m.PublishTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PublishTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -6381,6 +6983,346 @@ func (m *MsgRefusePostOwnerTransferRequestResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgUnhidePost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnhidePost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnhidePost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnhidePostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnhidePostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnhidePostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPostRevisionsLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPostRevisionsLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPostRevisionsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPostRevisionsLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPostRevisionsLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPostRevisionsLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,11 +5,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	subspacestypes "github.com/desmos-labs/desmos/v7/x/subspaces/types"
 )
 
 var (
-	_ sdk.Msg            = &MsgRequestPostOwnerTransfer{}
-	_ legacytx.LegacyMsg = &MsgRequestPostOwnerTransfer{}
+	_ sdk.Msg                       = &MsgRequestPostOwnerTransfer{}
+	_ legacytx.LegacyMsg            = &MsgRequestPostOwnerTransfer{}
+	_ subspacestypes.RateLimitedMsg = &MsgRequestPostOwnerTransfer{}
 )
 
 // MsgRequestPostOwnerTransfer returns a new MsgRequestPostOwnerTransfer instance
@@ -65,6 +68,9 @@ func (msg *MsgRequestPostOwnerTransfer) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgRequestPostOwnerTransfer) IsRateLimitedMsg() {}
+
 // GetSigners implements legacytx.LegacyMsg
 func (msg *MsgRequestPostOwnerTransfer) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
@@ -73,8 +79,9 @@ func (msg *MsgRequestPostOwnerTransfer) GetSignBytes() []byte {
 // --------------------------------------------------------------------------------------------------------------------
 
 var (
-	_ sdk.Msg            = &MsgCancelPostOwnerTransferRequest{}
-	_ legacytx.LegacyMsg = &MsgCancelPostOwnerTransferRequest{}
+	_ sdk.Msg                       = &MsgCancelPostOwnerTransferRequest{}
+	_ legacytx.LegacyMsg            = &MsgCancelPostOwnerTransferRequest{}
+	_ subspacestypes.RateLimitedMsg = &MsgCancelPostOwnerTransferRequest{}
 )
 
 // MsgCancelPostOwnerTransferRequest returns a new MsgCancelPostOwnerTransferRequest instance
@@ -120,6 +127,9 @@ func (msg *MsgCancelPostOwnerTransferRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgCancelPostOwnerTransferRequest) IsRateLimitedMsg() {}
+
 // GetSigners implements legacytx.LegacyMsg
 func (msg *MsgCancelPostOwnerTransferRequest) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
@@ -128,8 +138,9 @@ func (msg *MsgCancelPostOwnerTransferRequest) GetSignBytes() []byte {
 // --------------------------------------------------------------------------------------------------------------------
 
 var (
-	_ sdk.Msg            = &MsgAcceptPostOwnerTransferRequest{}
-	_ legacytx.LegacyMsg = &MsgAcceptPostOwnerTransferRequest{}
+	_ sdk.Msg                       = &MsgAcceptPostOwnerTransferRequest{}
+	_ legacytx.LegacyMsg            = &MsgAcceptPostOwnerTransferRequest{}
+	_ subspacestypes.RateLimitedMsg = &MsgAcceptPostOwnerTransferRequest{}
 )
 
 // MsgAcceptPostOwnerTransferRequest returns a new MsgAcceptPostOwnerTransferRequest instance
@@ -175,6 +186,9 @@ func (msg *MsgAcceptPostOwnerTransferRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{receiver}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgAcceptPostOwnerTransferRequest) IsRateLimitedMsg() {}
+
 // GetSigners implements legacytx.LegacyMsg
 func (msg *MsgAcceptPostOwnerTransferRequest) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
@@ -183,8 +197,9 @@ func (msg *MsgAcceptPostOwnerTransferRequest) GetSignBytes() []byte {
 // --------------------------------------------------------------------------------------------------------------------
 
 var (
-	_ sdk.Msg            = &MsgRefusePostOwnerTransferRequest{}
-	_ legacytx.LegacyMsg = &MsgRefusePostOwnerTransferRequest{}
+	_ sdk.Msg                       = &MsgRefusePostOwnerTransferRequest{}
+	_ legacytx.LegacyMsg            = &MsgRefusePostOwnerTransferRequest{}
+	_ subspacestypes.RateLimitedMsg = &MsgRefusePostOwnerTransferRequest{}
 )
 
 // MsgRefusePostOwnerTransferRequest returns a new MsgRefusePostOwnerTransferRequest instance
@@ -230,6 +245,9 @@ func (msg *MsgRefusePostOwnerTransferRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{receiver}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgRefusePostOwnerTransferRequest) IsRateLimitedMsg() {}
+
 // GetSigners implements legacytx.LegacyMsg
 func (msg *MsgRefusePostOwnerTransferRequest) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
@@ -7,14 +7,18 @@ import (
 var (
 	// DefaultMaxTextLength represents the default max length for post texts
 	DefaultMaxTextLength uint32 = 500
+
+	// DefaultMaxMediaSize represents the default max size in bytes that can be declared by media attachments
+	DefaultMaxMediaSize uint64 = 100 * 1024 * 1024
 )
 
 // -------------------------------------------------------------------------------------------------------------------
 
 // NewParams returns a new Params instance
-func NewParams(maxTextLength uint32) Params {
+func NewParams(maxTextLength uint32, maxMediaSize uint64) Params {
 	return Params{
 		MaxTextLength: maxTextLength,
+		MaxMediaSize:  maxMediaSize,
 	}
 }
 
@@ -22,12 +26,18 @@ func NewParams(maxTextLength uint32) Params {
 func DefaultParams() Params {
 	return Params{
 		MaxTextLength: DefaultMaxTextLength,
+		MaxMediaSize:  DefaultMaxMediaSize,
 	}
 }
 
 // Validate perform basic checks on all parameters to ensure they are correct
 func (params Params) Validate() error {
-	return ValidateMaxTextLength(params.MaxTextLength)
+	err := ValidateMaxTextLength(params.MaxTextLength)
+	if err != nil {
+		return err
+	}
+
+	return ValidateMaxMediaSize(params.MaxMediaSize)
 }
 
 // -------------------------------------------------------------------------------------------------------------------
@@ -40,3 +50,12 @@ func ValidateMaxTextLength(i interface{}) error {
 
 	return nil
 }
+
+func ValidateMaxMediaSize(i interface{}) error {
+	_, isUint64 := i.(uint64)
+	if !isUint64 {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	return nil
+}
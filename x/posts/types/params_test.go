@@ -21,7 +21,7 @@ func TestParams_Validate(t *testing.T) {
 		},
 		{
 			name:      "valid params returns no error",
-			params:    types.NewParams(100),
+			params:    types.NewParams(100, 0),
 			shouldErr: false,
 		},
 	}
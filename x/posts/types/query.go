@@ -8,37 +8,53 @@ import (
 )
 
 // NewQuerySubspacePostsRequest returns a new QuerySubspacePostsRequest instance
-func NewQuerySubspacePostsRequest(subspaceID uint64, pagination *query.PageRequest) *QuerySubspacePostsRequest {
+func NewQuerySubspacePostsRequest(subspaceID uint64, viewer string, pagination *query.PageRequest) *QuerySubspacePostsRequest {
 	return &QuerySubspacePostsRequest{
 		SubspaceId: subspaceID,
+		Viewer:     viewer,
 		Pagination: pagination,
 	}
 }
 
 // NewQuerySectionPostsRequest returns a new QuerySectionPostsRequest instance
-func NewQuerySectionPostsRequest(subspaceID uint64, sectionID uint32, pagination *query.PageRequest) *QuerySectionPostsRequest {
+func NewQuerySectionPostsRequest(subspaceID uint64, sectionID uint32, viewer string, pagination *query.PageRequest) *QuerySectionPostsRequest {
 	return &QuerySectionPostsRequest{
 		SubspaceId: subspaceID,
 		SectionId:  sectionID,
+		Viewer:     viewer,
 		Pagination: pagination,
 	}
 }
 
 // NewQueryPostRequest returns a new QueryPostRequest instance
-func NewQueryPostRequest(subspaceID uint64, postID uint64) *QueryPostRequest {
+func NewQueryPostRequest(subspaceID uint64, postID uint64, viewer string) *QueryPostRequest {
 	return &QueryPostRequest{
 		SubspaceId: subspaceID,
 		PostId:     postID,
+		Viewer:     viewer,
 	}
 }
 
 // NewQueryPostAttachmentsRequest returns a new QueryPostAttachmentsRequest instance
 func NewQueryPostAttachmentsRequest(
-	subspaceID uint64, postID uint64, pagination *query.PageRequest,
+	subspaceID uint64, postID uint64, viewer string, pagination *query.PageRequest,
 ) *QueryPostAttachmentsRequest {
 	return &QueryPostAttachmentsRequest{
 		SubspaceId: subspaceID,
 		PostId:     postID,
+		Viewer:     viewer,
+		Pagination: pagination,
+	}
+}
+
+// NewQueryPostRevisionsRequest returns a new QueryPostRevisionsRequest instance
+func NewQueryPostRevisionsRequest(
+	subspaceID uint64, postID uint64, viewer string, pagination *query.PageRequest,
+) *QueryPostRevisionsRequest {
+	return &QueryPostRevisionsRequest{
+		SubspaceId: subspaceID,
+		PostId:     postID,
+		Viewer:     viewer,
 		Pagination: pagination,
 	}
 }
@@ -58,13 +74,14 @@ func (r *QueryPostAttachmentsResponse) UnpackInterfaces(unpacker codectypes.AnyU
 
 // NewQueryPollAnswersRequest returns a new QueryPollAnswersRequest instance
 func NewQueryPollAnswersRequest(
-	subspaceID uint64, postID uint64, pollID uint32, user string, pagination *query.PageRequest,
+	subspaceID uint64, postID uint64, pollID uint32, user string, viewer string, pagination *query.PageRequest,
 ) *QueryPollAnswersRequest {
 	return &QueryPollAnswersRequest{
 		SubspaceId: subspaceID,
 		PostId:     postID,
 		PollId:     pollID,
 		User:       user,
+		Viewer:     viewer,
 		Pagination: pagination,
 	}
 }
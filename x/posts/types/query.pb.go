@@ -12,19 +12,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +43,9 @@ type QuerySubspacePostsRequest struct {
 	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// (optional) Address of the user viewing the posts. Posts placed inside
+	// sections that the viewer cannot read will be omitted
+	Viewer string `protobuf:"bytes,3,opt,name=viewer,proto3" json:"viewer,omitempty"`
 }
 
 func (m *QuerySubspacePostsRequest) Reset()         { *m = QuerySubspacePostsRequest{} }
@@ -88,6 +95,13 @@ func (m *QuerySubspacePostsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QuerySubspacePostsRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
 // QuerySubspacePostsResponse is the response type for the Query/SubspacePosts
 // RPC method
 type QuerySubspacePostsResponse struct {
@@ -151,6 +165,9 @@ type QuerySectionPostsRequest struct {
 	SectionId uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// (optional) Address of the user viewing the posts. If the viewer cannot
+	// read the section, no post will be returned
+	Viewer string `protobuf:"bytes,4,opt,name=viewer,proto3" json:"viewer,omitempty"`
 }
 
 func (m *QuerySectionPostsRequest) Reset()         { *m = QuerySectionPostsRequest{} }
@@ -207,6 +224,13 @@ func (m *QuerySectionPostsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QuerySectionPostsRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
 // QuerySectionPostsResponse is the response type for the Query/SectionPosts RPC
 // method
 type QuerySectionPostsResponse struct {
@@ -267,6 +291,9 @@ type QueryPostRequest struct {
 	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
 	// Id of the post to query for
 	PostId uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// (optional) Address of the user viewing the post. If the viewer cannot read
+	// the section inside which the post lies, an error will be returned
+	Viewer string `protobuf:"bytes,3,opt,name=viewer,proto3" json:"viewer,omitempty"`
 }
 
 func (m *QueryPostRequest) Reset()         { *m = QueryPostRequest{} }
@@ -316,9 +343,20 @@ func (m *QueryPostRequest) GetPostId() uint64 {
 	return 0
 }
 
+func (m *QueryPostRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
 // QueryPostResponse is the response type for the Query/Post RPC method
 type QueryPostResponse struct {
 	Post Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	// Whether the post has been hidden after receiving too many reports
+	Hidden bool `protobuf:"varint,2,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Time at which the post will be published, if it is still pending
+	PublishTime *time.Time `protobuf:"bytes,3,opt,name=publish_time,json=publishTime,proto3,stdtime" json:"publish_time,omitempty" yaml:"publish_time"`
 }
 
 func (m *QueryPostResponse) Reset()         { *m = QueryPostResponse{} }
@@ -361,6 +399,20 @@ func (m *QueryPostResponse) GetPost() Post {
 	return Post{}
 }
 
+func (m *QueryPostResponse) GetHidden() bool {
+	if m != nil {
+		return m.Hidden
+	}
+	return false
+}
+
+func (m *QueryPostResponse) GetPublishTime() *time.Time {
+	if m != nil {
+		return m.PublishTime
+	}
+	return nil
+}
+
 // QueryPostsRequest is the request type for the Query/PostAttachments RPC
 // method
 type QueryPostAttachmentsRequest struct {
@@ -370,6 +422,10 @@ type QueryPostAttachmentsRequest struct {
 	PostId uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// (optional) Address of the user viewing the attachments. If the viewer
+	// cannot read the section inside which the post lies, an error will be
+	// returned
+	Viewer string `protobuf:"bytes,4,opt,name=viewer,proto3" json:"viewer,omitempty"`
 }
 
 func (m *QueryPostAttachmentsRequest) Reset()         { *m = QueryPostAttachmentsRequest{} }
@@ -426,6 +482,13 @@ func (m *QueryPostAttachmentsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryPostAttachmentsRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
 // QueryPostAttachmentsResponse is the response type for the
 // Query/PostAttachments RPC method
 type QueryPostAttachmentsResponse struct {
@@ -493,6 +556,9 @@ type QueryPollAnswersRequest struct {
 	User string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// (optional) Address of the user viewing the answers. If the viewer cannot
+	// read the section inside which the post lies, an error will be returned
+	Viewer string `protobuf:"bytes,6,opt,name=viewer,proto3" json:"viewer,omitempty"`
 }
 
 func (m *QueryPollAnswersRequest) Reset()         { *m = QueryPollAnswersRequest{} }
@@ -563,6 +629,13 @@ func (m *QueryPollAnswersRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryPollAnswersRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
 // QueryPollAnswersResponse is the response type for the Query/PollAnswers RPC
 // method
 type QueryPollAnswersResponse struct {
@@ -809,6 +882,144 @@ func (m *QueryIncomingPostOwnerTransferRequestsResponse) GetPagination() *query.
 	return nil
 }
 
+// QueryPostRevisionsRequest is the request type for the Query/PostRevisions
+// RPC method
+type QueryPostRevisionsRequest struct {
+	// Id of the subspace where the post is stored
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
+	// Id of the post to query the revisions for
+	PostId uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// (optional) Address of the user viewing the revisions. If the viewer cannot
+	// read the section inside which the post lies, an error will be returned
+	Viewer string `protobuf:"bytes,4,opt,name=viewer,proto3" json:"viewer,omitempty"`
+}
+
+func (m *QueryPostRevisionsRequest) Reset()         { *m = QueryPostRevisionsRequest{} }
+func (m *QueryPostRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostRevisionsRequest) ProtoMessage()    {}
+func (*QueryPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac444b4e366473d7, []int{14}
+}
+func (m *QueryPostRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostRevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostRevisionsRequest.Merge(m, src)
+}
+func (m *QueryPostRevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostRevisionsRequest proto.InternalMessageInfo
+
+func (m *QueryPostRevisionsRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
+	}
+	return 0
+}
+
+func (m *QueryPostRevisionsRequest) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *QueryPostRevisionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryPostRevisionsRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
+// QueryPostRevisionsResponse is the response type for the Query/PostRevisions
+// RPC method
+type QueryPostRevisionsResponse struct {
+	Revisions []PostRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions"`
+	// Max number of revisions that are kept for each post inside the subspace
+	RevisionsLimit uint32              `protobuf:"varint,2,opt,name=revisions_limit,json=revisionsLimit,proto3" json:"revisions_limit,omitempty"`
+	Pagination     *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostRevisionsResponse) Reset()         { *m = QueryPostRevisionsResponse{} }
+func (m *QueryPostRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostRevisionsResponse) ProtoMessage()    {}
+func (*QueryPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac444b4e366473d7, []int{15}
+}
+func (m *QueryPostRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostRevisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostRevisionsResponse.Merge(m, src)
+}
+func (m *QueryPostRevisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostRevisionsResponse proto.InternalMessageInfo
+
+func (m *QueryPostRevisionsResponse) GetRevisions() []PostRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *QueryPostRevisionsResponse) GetRevisionsLimit() uint32 {
+	if m != nil {
+		return m.RevisionsLimit
+	}
+	return 0
+}
+
+func (m *QueryPostRevisionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySubspacePostsRequest)(nil), "desmos.posts.v3.QuerySubspacePostsRequest")
 	proto.RegisterType((*QuerySubspacePostsResponse)(nil), "desmos.posts.v3.QuerySubspacePostsResponse")
@@ -824,74 +1035,88 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "desmos.posts.v3.QueryParamsResponse")
 	proto.RegisterType((*QueryIncomingPostOwnerTransferRequestsRequest)(nil), "desmos.posts.v3.QueryIncomingPostOwnerTransferRequestsRequest")
 	proto.RegisterType((*QueryIncomingPostOwnerTransferRequestsResponse)(nil), "desmos.posts.v3.QueryIncomingPostOwnerTransferRequestsResponse")
+	proto.RegisterType((*QueryPostRevisionsRequest)(nil), "desmos.posts.v3.QueryPostRevisionsRequest")
+	proto.RegisterType((*QueryPostRevisionsResponse)(nil), "desmos.posts.v3.QueryPostRevisionsResponse")
 }
 
 func init() { proto.RegisterFile("desmos/posts/v3/query.proto", fileDescriptor_ac444b4e366473d7) }
 
 var fileDescriptor_ac444b4e366473d7 = []byte{
-	// 984 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0x4f, 0x6f, 0x1b, 0x55,
-	0x10, 0xc0, 0xfd, 0x1a, 0xc7, 0x6d, 0xc6, 0x54, 0xa5, 0x8f, 0xa0, 0x38, 0x9b, 0x62, 0xa7, 0x0b,
-	0x02, 0x27, 0xd4, 0xbb, 0x34, 0xa9, 0x0a, 0xca, 0x01, 0x9a, 0x08, 0xda, 0x5a, 0x2a, 0x22, 0xdd,
-	0x82, 0x84, 0xb8, 0x54, 0xcf, 0xde, 0xc7, 0x76, 0x25, 0x7b, 0xdf, 0x76, 0xdf, 0xda, 0xa5, 0xaa,
-	0x72, 0x41, 0x1c, 0xb8, 0x81, 0xc4, 0x15, 0x44, 0x84, 0x84, 0xc4, 0x11, 0x04, 0xc7, 0x7e, 0x80,
-	0x4a, 0x5c, 0x2a, 0xb8, 0xf4, 0x80, 0x10, 0x4a, 0x90, 0xe0, 0xce, 0x17, 0x40, 0xef, 0xcf, 0x3a,
-	0xeb, 0x7f, 0xc9, 0xc6, 0xf2, 0xa1, 0x97, 0xc8, 0x3b, 0x33, 0x6f, 0xe6, 0x37, 0x33, 0x6f, 0x67,
-	0x36, 0xb0, 0xe4, 0x52, 0xde, 0x66, 0xdc, 0x0e, 0x19, 0x8f, 0xb9, 0xdd, 0x5d, 0xb7, 0xef, 0x76,
-	0x68, 0x74, 0xdf, 0x0a, 0x23, 0x16, 0x33, 0x7c, 0x46, 0x29, 0x2d, 0xa9, 0xb4, 0xba, 0xeb, 0xc6,
-	0x59, 0xd2, 0xf6, 0x03, 0x66, 0xcb, 0xbf, 0xca, 0xc6, 0x98, 0xf7, 0x98, 0xc7, 0xe4, 0x4f, 0x5b,
-	0xfc, 0xd2, 0xd2, 0x73, 0x1e, 0x63, 0x5e, 0x8b, 0xda, 0x24, 0xf4, 0x6d, 0x12, 0x04, 0x2c, 0x26,
-	0xb1, 0xcf, 0x02, 0xae, 0xb5, 0xab, 0x4d, 0x26, 0x83, 0x36, 0x08, 0xa7, 0x2a, 0xa0, 0xdd, 0xbd,
-	0xd8, 0xa0, 0x31, 0xb9, 0x68, 0x87, 0xc4, 0xf3, 0x03, 0x69, 0xac, 0x6d, 0x17, 0x95, 0xed, 0x6d,
-	0x15, 0x42, 0x3d, 0x24, 0x41, 0x06, 0xd9, 0xdb, 0xcc, 0xa5, 0x2d, 0xad, 0x35, 0x3f, 0x43, 0xb0,
-	0x78, 0x53, 0xf8, 0xbe, 0xd5, 0x69, 0xf0, 0x90, 0x34, 0xe9, 0xb6, 0x30, 0x73, 0xe8, 0xdd, 0x0e,
-	0xe5, 0x31, 0xae, 0x40, 0x91, 0x6b, 0xf9, 0x6d, 0xdf, 0x2d, 0xa1, 0x65, 0x54, 0xcd, 0x3b, 0x90,
-	0x88, 0xea, 0x2e, 0xbe, 0x0a, 0x70, 0xc0, 0x52, 0x3a, 0xb1, 0x8c, 0xaa, 0xc5, 0xb5, 0x97, 0x2d,
-	0x1d, 0x5f, 0x80, 0x5b, 0xaa, 0x52, 0x1a, 0xdc, 0xda, 0x26, 0x1e, 0xd5, 0xce, 0x9d, 0xd4, 0x49,
-	0xf3, 0x1b, 0x04, 0xc6, 0x28, 0x0c, 0x1e, 0xb2, 0x80, 0x53, 0x7c, 0x19, 0x66, 0x25, 0x7e, 0x09,
-	0x2d, 0xcf, 0x54, 0x8b, 0x6b, 0xcf, 0x5b, 0x03, 0x25, 0xb7, 0x84, 0xf9, 0xd6, 0xdc, 0xa3, 0x3f,
-	0x2b, 0xb9, 0x1f, 0xfe, 0xf9, 0x71, 0x15, 0x39, 0xca, 0x1c, 0x5f, 0x1b, 0x81, 0xf7, 0xca, 0x91,
-	0x78, 0x2a, 0x68, 0x1f, 0xdf, 0x77, 0x08, 0x4a, 0x8a, 0x8f, 0x36, 0x85, 0xe0, 0x78, 0x55, 0x7a,
-	0x01, 0x80, 0xab, 0x73, 0x42, 0x2f, 0x30, 0x4e, 0x3b, 0x73, 0x5a, 0x32, 0x54, 0xc4, 0x99, 0x89,
-	0x8b, 0xf8, 0x75, 0xaf, 0x97, 0x7d, 0x90, 0x4f, 0x4b, 0x0d, 0x6f, 0xc0, 0xb3, 0x92, 0x4e, 0xc4,
-	0xc9, 0x5c, 0xba, 0x05, 0x38, 0x29, 0x30, 0x92, 0xba, 0xe5, 0x9d, 0x82, 0x78, 0xac, 0xbb, 0x66,
-	0x1d, 0xce, 0xa6, 0xbc, 0xe9, 0x1c, 0x2f, 0x41, 0x5e, 0xa8, 0xa5, 0x9f, 0x2c, 0x29, 0x4a, 0x6b,
-	0xf3, 0x5b, 0x04, 0x4b, 0x3d, 0x5f, 0x9b, 0x71, 0x4c, 0x9a, 0x77, 0xda, 0x34, 0x38, 0x46, 0x7f,
-	0xc7, 0x41, 0x4e, 0xad, 0xb3, 0x3f, 0x21, 0x38, 0x37, 0x9a, 0x50, 0x27, 0x7e, 0x1d, 0x8a, 0xe4,
-	0x40, 0xac, 0x5b, 0xbc, 0x34, 0x94, 0xff, 0xc1, 0xd1, 0x74, 0x15, 0xd2, 0x47, 0xa7, 0xd7, 0xee,
-	0x3d, 0x04, 0x0b, 0x9a, 0xb9, 0xd5, 0xda, 0x0c, 0xf8, 0x3d, 0x1a, 0x4d, 0xa1, 0xa2, 0x52, 0xd1,
-	0x6a, 0x09, 0xc5, 0x8c, 0x7c, 0x8f, 0x0a, 0xe2, 0xb1, 0xee, 0xe2, 0x0b, 0x90, 0xef, 0x70, 0x1a,
-	0x95, 0xf2, 0xcb, 0xa8, 0x3a, 0xb7, 0x55, 0xfa, 0xed, 0x97, 0xda, 0xbc, 0x86, 0xde, 0x74, 0xdd,
-	0x88, 0x72, 0x7e, 0x2b, 0x8e, 0xfc, 0xc0, 0x73, 0xa4, 0xd5, 0x40, 0x63, 0x66, 0x27, 0x6e, 0xcc,
-	0xf7, 0xc9, 0x5c, 0xe8, 0x4b, 0x52, 0x37, 0xe5, 0x0a, 0x9c, 0x24, 0x4a, 0x34, 0xb6, 0x21, 0x1f,
-	0x70, 0x1a, 0xa9, 0x63, 0xe9, 0x86, 0x24, 0xc7, 0xa6, 0xd7, 0x8c, 0x79, 0xc0, 0x0a, 0x93, 0x44,
-	0xa4, 0x9d, 0xb4, 0xc1, 0xbc, 0x09, 0xcf, 0xf5, 0x49, 0x35, 0xf7, 0x06, 0x14, 0x42, 0x29, 0xd1,
-	0xef, 0xd1, 0xc2, 0xf0, 0x7b, 0x24, 0xd5, 0x69, 0x64, 0x7d, 0xc2, 0x7c, 0x82, 0xa0, 0x26, 0x7d,
-	0xd6, 0x83, 0x26, 0x6b, 0xfb, 0x81, 0x27, 0x6e, 0xec, 0x7b, 0xf7, 0x02, 0x1a, 0xbd, 0x1f, 0x91,
-	0x80, 0x7f, 0x4c, 0x23, 0x1d, 0x3c, 0xfb, 0x5d, 0xb8, 0x04, 0xa7, 0x22, 0xda, 0xa4, 0x7e, 0x97,
-	0x46, 0xb2, 0x04, 0x87, 0x75, 0xb7, 0x67, 0x39, 0xad, 0x57, 0x6f, 0xe3, 0xd4, 0xe7, 0xbb, 0x95,
-	0xdc, 0xbf, 0xbb, 0x95, 0x9c, 0xf9, 0x2b, 0x02, 0x2b, 0x6b, 0x6a, 0xba, 0x92, 0xdb, 0x02, 0x5d,
-	0xc9, 0xf4, 0x15, 0x58, 0x19, 0x39, 0x93, 0x46, 0x79, 0x49, 0x57, 0xb7, 0xe7, 0x65, 0x6a, 0x37,
-	0x62, 0xed, 0x8f, 0x39, 0x98, 0x95, 0xd9, 0xe0, 0x5d, 0x04, 0xa7, 0xfb, 0xd6, 0x2e, 0x5e, 0x1d,
-	0x82, 0x1c, 0xfb, 0x89, 0x60, 0xbc, 0x9a, 0xc9, 0x56, 0x01, 0x98, 0x97, 0x3f, 0xfd, 0xfd, 0xef,
-	0xaf, 0x4e, 0xbc, 0x86, 0x2d, 0x7b, 0xf0, 0xa3, 0x24, 0xe9, 0x37, 0xb7, 0x1f, 0xa4, 0x6e, 0xc3,
-	0x8e, 0x32, 0xc0, 0x3f, 0x23, 0x78, 0x26, 0xbd, 0xd4, 0xf0, 0xca, 0x98, 0xa8, 0xc3, 0xdb, 0xd9,
-	0x58, 0xcd, 0x62, 0xaa, 0xf9, 0x6e, 0x48, 0xbe, 0xab, 0xf8, 0xed, 0xcc, 0x7c, 0x7a, 0x8b, 0x0b,
-	0x71, 0x6f, 0xc3, 0x27, 0xd4, 0x5f, 0x20, 0xc8, 0x0b, 0xff, 0xf8, 0xfc, 0x68, 0x84, 0xd4, 0x22,
-	0x34, 0xcc, 0xc3, 0x4c, 0x34, 0xdd, 0x15, 0x49, 0xb7, 0x81, 0xdf, 0x38, 0x5e, 0xf5, 0xec, 0x07,
-	0x7a, 0x94, 0xee, 0xe0, 0x87, 0x08, 0xce, 0x0c, 0xac, 0x10, 0x7c, 0x61, 0x7c, 0xe4, 0xe1, 0x5d,
-	0x68, 0xd4, 0x32, 0x5a, 0x4f, 0x5c, 0xd0, 0x01, 0x64, 0x3b, 0xbd, 0x9b, 0x1e, 0x22, 0x28, 0xa6,
-	0x06, 0x2d, 0xae, 0x8e, 0x83, 0x19, 0x5c, 0x38, 0xc6, 0x4a, 0x06, 0x4b, 0x8d, 0xfc, 0xa1, 0x44,
-	0x76, 0xf0, 0xf6, 0xc4, 0xc8, 0x62, 0x23, 0xc9, 0x67, 0xb9, 0xa7, 0x76, 0xec, 0x64, 0x9a, 0xc7,
-	0x50, 0x50, 0x83, 0x13, 0xbf, 0x38, 0x06, 0x27, 0x3d, 0x9d, 0x8d, 0x97, 0x0e, 0x37, 0xd2, 0xb8,
-	0x15, 0x89, 0xbb, 0x88, 0x17, 0x86, 0x70, 0xd5, 0x44, 0xc6, 0xff, 0x21, 0x38, 0x7f, 0xe4, 0xc4,
-	0xc2, 0x6f, 0x8e, 0x0e, 0x96, 0x75, 0x8a, 0x1b, 0x6f, 0x4d, 0x7c, 0x5e, 0xe7, 0xf1, 0xae, 0xcc,
-	0xe3, 0x1a, 0x7e, 0xe7, 0x58, 0x65, 0xaf, 0x31, 0xe1, 0xb4, 0x16, 0x6b, 0xaf, 0xb5, 0x64, 0x4e,
-	0x6e, 0x5d, 0x7f, 0xb4, 0x57, 0x46, 0x8f, 0xf7, 0xca, 0xe8, 0xaf, 0xbd, 0x32, 0xfa, 0x72, 0xbf,
-	0x9c, 0x7b, 0xbc, 0x5f, 0xce, 0x3d, 0xd9, 0x2f, 0xe7, 0x3e, 0xb2, 0x3c, 0x3f, 0xbe, 0xd3, 0x69,
-	0x58, 0x4d, 0xd6, 0xd6, 0xa1, 0x6a, 0x2d, 0xd2, 0xe0, 0x49, 0xd8, 0xee, 0xeb, 0xf6, 0x27, 0x3a,
-	0x76, 0x7c, 0x3f, 0xa4, 0xbc, 0x51, 0x90, 0xff, 0x28, 0xad, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff,
-	0x5a, 0x93, 0x92, 0x27, 0x04, 0x0e, 0x00, 0x00,
+	// 1175 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x41, 0x6c, 0x1b, 0x45,
+	0x14, 0x40, 0x3d, 0x89, 0xe3, 0x26, 0xe3, 0x86, 0xd0, 0x69, 0x20, 0xce, 0xa6, 0xb5, 0xd3, 0x05,
+	0xd1, 0x24, 0xad, 0x77, 0x69, 0x52, 0x15, 0x94, 0x03, 0x34, 0x51, 0x49, 0x1a, 0x54, 0x44, 0xba,
+	0x2d, 0x12, 0xea, 0x25, 0x5a, 0x7b, 0xa7, 0xce, 0x4a, 0xde, 0x5d, 0x77, 0x67, 0xed, 0x10, 0x55,
+	0xb9, 0x70, 0xe2, 0x46, 0xa5, 0x5e, 0x41, 0xca, 0x05, 0x89, 0x23, 0x88, 0x22, 0x24, 0x54, 0xee,
+	0x95, 0xb8, 0x54, 0x20, 0xa1, 0x9e, 0x0a, 0x4a, 0x90, 0xe0, 0x0c, 0x37, 0x4e, 0x68, 0x66, 0xfe,
+	0x3a, 0x6b, 0x7b, 0xdd, 0x6c, 0x8c, 0x91, 0xe0, 0x12, 0x79, 0xfe, 0xfc, 0x3f, 0xff, 0xfd, 0xff,
+	0x67, 0xe7, 0xff, 0xe0, 0x29, 0x8b, 0x32, 0xc7, 0x63, 0x7a, 0xcd, 0x63, 0x01, 0xd3, 0x1b, 0x0b,
+	0xfa, 0x9d, 0x3a, 0xf5, 0xb7, 0xb5, 0x9a, 0xef, 0x05, 0x1e, 0x19, 0x93, 0x9b, 0x9a, 0xd8, 0xd4,
+	0x1a, 0x0b, 0xca, 0x09, 0xd3, 0xb1, 0x5d, 0x4f, 0x17, 0x7f, 0xa5, 0x8e, 0x32, 0x5e, 0xf1, 0x2a,
+	0x9e, 0xf8, 0xa9, 0xf3, 0x5f, 0x20, 0x3d, 0x55, 0xf1, 0xbc, 0x4a, 0x95, 0xea, 0x66, 0xcd, 0xd6,
+	0x4d, 0xd7, 0xf5, 0x02, 0x33, 0xb0, 0x3d, 0x97, 0xc1, 0x6e, 0x01, 0x76, 0xc5, 0xaa, 0x54, 0xbf,
+	0xad, 0x07, 0xb6, 0x43, 0x59, 0x60, 0x3a, 0x35, 0x50, 0x98, 0x2b, 0x7b, 0x82, 0xaa, 0x64, 0x32,
+	0x2a, 0x89, 0xf4, 0xc6, 0x85, 0x12, 0x0d, 0xcc, 0x0b, 0x7a, 0xcd, 0xac, 0xd8, 0xae, 0x38, 0x0d,
+	0x74, 0x27, 0xa5, 0xee, 0x86, 0x64, 0x90, 0x8b, 0x90, 0xa2, 0x3d, 0x38, 0xc7, 0xb3, 0x68, 0x15,
+	0x76, 0xd5, 0x4f, 0x10, 0x9e, 0xbc, 0xce, 0xcf, 0xbe, 0x51, 0x2f, 0xb1, 0x9a, 0x59, 0xa6, 0xeb,
+	0x5c, 0xcd, 0xa0, 0x77, 0xea, 0x94, 0x05, 0xa4, 0x80, 0xb3, 0x0c, 0xe4, 0x1b, 0xb6, 0x95, 0x43,
+	0xd3, 0x68, 0x26, 0x6d, 0xe0, 0x50, 0xb4, 0x66, 0x91, 0x15, 0x8c, 0x0f, 0x58, 0x72, 0x03, 0xd3,
+	0x68, 0x26, 0x3b, 0xff, 0x8a, 0x06, 0xfe, 0x39, 0xb8, 0x26, 0x53, 0x09, 0xe0, 0xda, 0xba, 0x59,
+	0xa1, 0x70, 0xb8, 0x11, 0xb1, 0x24, 0x2f, 0xe2, 0x4c, 0xc3, 0xa6, 0x5b, 0xd4, 0xcf, 0x0d, 0x4e,
+	0xa3, 0x99, 0x11, 0x03, 0x56, 0xea, 0xa7, 0x08, 0x2b, 0x71, 0x78, 0xac, 0xe6, 0xb9, 0x8c, 0x92,
+	0x4b, 0x78, 0x48, 0x84, 0x95, 0x43, 0xd3, 0x83, 0x33, 0xd9, 0xf9, 0x17, 0xb4, 0xb6, 0x5a, 0x69,
+	0x5c, 0x7d, 0x79, 0xe4, 0xd1, 0xd3, 0x42, 0xea, 0xf3, 0xdf, 0xbe, 0x98, 0x43, 0x86, 0x54, 0x27,
+	0xab, 0x31, 0xd8, 0x67, 0x0f, 0xc5, 0x96, 0x4e, 0xa3, 0xdc, 0xea, 0xb7, 0x08, 0xe7, 0x24, 0x1f,
+	0x2d, 0x73, 0xc1, 0xd1, 0xb2, 0x77, 0x1a, 0x63, 0x26, 0xed, 0xf8, 0x3e, 0xc7, 0x18, 0x35, 0x46,
+	0x40, 0xd2, 0x91, 0xdc, 0xc1, 0x3e, 0x24, 0x37, 0xdd, 0x92, 0xdc, 0x83, 0xda, 0xb7, 0xc0, 0xff,
+	0x57, 0x72, 0x6b, 0xe1, 0xe7, 0x05, 0x1d, 0xf7, 0x93, 0x38, 0xa5, 0x13, 0xf8, 0x18, 0xc7, 0x08,
+	0xf3, 0x99, 0x36, 0x32, 0x7c, 0xb9, 0x66, 0x75, 0xbd, 0x61, 0xdf, 0x21, 0x7c, 0x22, 0xe2, 0x06,
+	0x82, 0xbf, 0x88, 0xd3, 0xdc, 0x4e, 0x38, 0x48, 0x12, 0xbb, 0xd0, 0xe6, 0x3e, 0x36, 0x6d, 0xcb,
+	0xa2, 0x32, 0xec, 0x61, 0x03, 0x56, 0xe4, 0x16, 0x3e, 0x5e, 0xab, 0x97, 0xaa, 0x36, 0xdb, 0xdc,
+	0xe0, 0x1f, 0x39, 0x94, 0x52, 0xd1, 0xe4, 0x0b, 0xa0, 0x85, 0x2f, 0x80, 0x76, 0x33, 0x7c, 0x01,
+	0x96, 0xa7, 0xfe, 0x78, 0x5a, 0x38, 0xb9, 0x6d, 0x3a, 0xd5, 0x45, 0x35, 0x6a, 0xa9, 0xde, 0xfb,
+	0xb9, 0x80, 0x8c, 0x2c, 0x88, 0xb8, 0xba, 0xfa, 0x0d, 0xc2, 0x53, 0x4d, 0xfe, 0xa5, 0x20, 0x30,
+	0xcb, 0x9b, 0x0e, 0x75, 0x03, 0xf6, 0xcf, 0x33, 0xf6, 0x6f, 0x5f, 0xbf, 0x2f, 0x11, 0x3e, 0x15,
+	0x4f, 0x0e, 0x45, 0xb8, 0x8a, 0xb3, 0xe6, 0x81, 0x18, 0xee, 0xe1, 0x54, 0x47, 0x2d, 0x0e, 0x4c,
+	0xa3, 0x15, 0x89, 0x9a, 0xf6, 0xef, 0x4e, 0xfe, 0x85, 0xf0, 0x04, 0x30, 0x57, 0xab, 0x4b, 0x2e,
+	0xdb, 0xa2, 0x7e, 0x1f, 0x32, 0x2d, 0x36, 0xaa, 0x55, 0xbe, 0x31, 0x28, 0x1e, 0x81, 0x0c, 0x5f,
+	0xae, 0x59, 0xe4, 0x3c, 0x4e, 0xd7, 0x59, 0x98, 0xb8, 0xe5, 0xdc, 0x0f, 0x0f, 0x8a, 0xe3, 0x00,
+	0xbd, 0x64, 0x59, 0x3e, 0x65, 0xec, 0x46, 0xe0, 0xdb, 0x6e, 0xc5, 0x10, 0x5a, 0x6d, 0x05, 0x1b,
+	0xea, 0x43, 0xc1, 0x32, 0x2d, 0x05, 0xfb, 0x2c, 0x7c, 0xec, 0x5a, 0x82, 0x87, 0x62, 0x5d, 0xc6,
+	0xc7, 0x4c, 0x29, 0xea, 0x5a, 0xa8, 0xf7, 0x18, 0xf5, 0xa5, 0x59, 0xb4, 0x50, 0xa1, 0x59, 0xff,
+	0x8a, 0x34, 0x8e, 0x89, 0xc4, 0x34, 0x7d, 0xd3, 0x09, 0xcb, 0xa3, 0x5e, 0xc7, 0x27, 0x5b, 0xa4,
+	0xc0, 0xbd, 0x88, 0x33, 0x35, 0x21, 0x81, 0x6f, 0x7d, 0xa2, 0xf3, 0x5b, 0x17, 0xdb, 0x51, 0x64,
+	0xb0, 0x50, 0x9f, 0x20, 0x5c, 0x14, 0x67, 0xae, 0xb9, 0x65, 0xcf, 0xb1, 0xdd, 0x0a, 0xbf, 0xc9,
+	0xef, 0x6e, 0xb9, 0xd4, 0xbf, 0xe9, 0x9b, 0x2e, 0xbb, 0x4d, 0x7d, 0x70, 0x9e, 0xfc, 0x8e, 0x5c,
+	0xc4, 0xc3, 0x3e, 0x2d, 0x53, 0xbb, 0x41, 0xfd, 0xdc, 0xc0, 0x21, 0x55, 0x6f, 0x6a, 0xf6, 0xeb,
+	0x53, 0x5d, 0x1c, 0xfe, 0x68, 0xb7, 0x90, 0xfa, 0x7d, 0xb7, 0x90, 0x52, 0xbf, 0x47, 0x58, 0x4b,
+	0x1a, 0x1a, 0x64, 0x72, 0x9d, 0xa3, 0x4b, 0x19, 0x5c, 0x81, 0xd9, 0xd8, 0x77, 0x33, 0xee, 0x94,
+	0x68, 0x76, 0x9b, 0xa7, 0xf4, 0xef, 0x46, 0x7c, 0x1d, 0x76, 0x3a, 0xf9, 0xc8, 0x37, 0x6c, 0x66,
+	0x7b, 0xee, 0xff, 0xe0, 0x89, 0xfc, 0x29, 0x1c, 0x7f, 0xda, 0xb8, 0x21, 0xe3, 0x2b, 0x78, 0xc4,
+	0x0f, 0x85, 0x90, 0xf2, 0xd3, 0xb1, 0x29, 0x0f, 0x4d, 0xa3, 0x69, 0x3e, 0x30, 0x25, 0x67, 0xf1,
+	0x58, 0x73, 0xb1, 0x51, 0xb5, 0x1d, 0x3b, 0x80, 0x61, 0xe4, 0xb9, 0xa6, 0xf8, 0x1a, 0x97, 0x92,
+	0xd5, 0x98, 0x78, 0x7b, 0x29, 0xc8, 0xfc, 0xfd, 0x2c, 0x1e, 0x12, 0x81, 0x91, 0x5d, 0x84, 0x47,
+	0x5b, 0x86, 0x3b, 0x32, 0xd7, 0x11, 0x42, 0xd7, 0x01, 0x55, 0x39, 0x97, 0x48, 0x57, 0x02, 0xa8,
+	0x97, 0x3e, 0xfc, 0xf1, 0xd7, 0xfb, 0x03, 0xaf, 0x12, 0x4d, 0x6f, 0x1f, 0x89, 0xc3, 0x5a, 0x33,
+	0xfd, 0x6e, 0xe4, 0x26, 0xec, 0x48, 0x05, 0xf2, 0x15, 0xc2, 0xc7, 0xa3, 0x23, 0x12, 0x99, 0xed,
+	0xe2, 0xb5, 0x73, 0x06, 0x54, 0xe6, 0x92, 0xa8, 0x02, 0xdf, 0x35, 0xc1, 0xb7, 0x42, 0xae, 0x24,
+	0xe6, 0x83, 0x59, 0x91, 0x8b, 0x9b, 0x73, 0x64, 0x48, 0xfd, 0x31, 0xc2, 0x69, 0x7e, 0x3e, 0x39,
+	0x13, 0x8f, 0x10, 0x19, 0xab, 0x14, 0xf5, 0x59, 0x2a, 0x40, 0x77, 0x59, 0xd0, 0x2d, 0x92, 0xd7,
+	0x8f, 0x96, 0x3d, 0xfd, 0x2e, 0x7c, 0x3a, 0x3b, 0xe4, 0x21, 0xc2, 0x63, 0x6d, 0xbd, 0x9e, 0x9c,
+	0xef, 0xee, 0xb9, 0x73, 0x98, 0x51, 0x8a, 0x09, 0xb5, 0x7b, 0x4e, 0x68, 0x1b, 0xb2, 0x1e, 0x1d,
+	0x22, 0x1e, 0x22, 0x9c, 0x8d, 0x74, 0x3e, 0x32, 0xd3, 0x0d, 0xa6, 0x7d, 0x32, 0x50, 0x66, 0x13,
+	0x68, 0x02, 0xf2, 0xfb, 0x02, 0xd9, 0x20, 0xeb, 0x3d, 0x23, 0xf3, 0xd1, 0x41, 0xac, 0xc5, 0x40,
+	0xb1, 0xa3, 0x87, 0xed, 0x35, 0xc0, 0x19, 0xd9, 0xc9, 0xc8, 0x4b, 0x5d, 0x70, 0xa2, 0xed, 0x52,
+	0x79, 0xf9, 0xd9, 0x4a, 0x80, 0x5b, 0x10, 0xb8, 0x93, 0x64, 0xa2, 0x03, 0x57, 0xb6, 0x48, 0xf2,
+	0x27, 0xc2, 0x67, 0x0e, 0x6d, 0x21, 0xe4, 0x8d, 0x78, 0x67, 0x49, 0xdb, 0xaa, 0xf2, 0x66, 0xcf,
+	0xf6, 0x10, 0xc7, 0x3b, 0x22, 0x8e, 0x55, 0xf2, 0xd6, 0x91, 0xd2, 0x5e, 0xf4, 0xf8, 0xa1, 0xc5,
+	0x00, 0x4e, 0x2d, 0x36, 0x1b, 0xd7, 0x03, 0x84, 0x47, 0x5b, 0x9e, 0xec, 0x6e, 0x8f, 0x5a, 0x5c,
+	0x3f, 0x52, 0xce, 0x25, 0xd2, 0x05, 0xf2, 0xb7, 0x05, 0xf9, 0x15, 0xb2, 0xdc, 0xf3, 0x85, 0x69,
+	0xbe, 0xf1, 0xcb, 0x57, 0x1f, 0xed, 0xe5, 0xd1, 0xe3, 0xbd, 0x3c, 0xfa, 0x65, 0x2f, 0x8f, 0xee,
+	0xed, 0xe7, 0x53, 0x8f, 0xf7, 0xf3, 0xa9, 0x27, 0xfb, 0xf9, 0xd4, 0x2d, 0xad, 0x62, 0x07, 0x9b,
+	0xf5, 0x92, 0x56, 0xf6, 0x1c, 0xf0, 0x53, 0xac, 0x9a, 0x25, 0x16, 0xfa, 0x6c, 0xbc, 0xa6, 0x7f,
+	0x00, 0xe7, 0x06, 0xdb, 0x35, 0xca, 0x4a, 0x19, 0xf1, 0x3f, 0xcd, 0xc2, 0xdf, 0x03, 0x00, 0x1e,
+	0x47, 0xa3, 0x78, 0x5a, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -921,6 +1146,8 @@ type QueryClient interface {
 	// IncomingPostOwnerTransferRequests queries all the post owner transfers
 	// requests that have been made towards the receiver with the given address
 	IncomingPostOwnerTransferRequests(ctx context.Context, in *QueryIncomingPostOwnerTransferRequestsRequest, opts ...grpc.CallOption) (*QueryIncomingPostOwnerTransferRequestsResponse, error)
+	// PostRevisions queries the revisions of the post having the given id
+	PostRevisions(ctx context.Context, in *QueryPostRevisionsRequest, opts ...grpc.CallOption) (*QueryPostRevisionsResponse, error)
 }
 
 type queryClient struct {
@@ -994,6 +1221,15 @@ func (c *queryClient) IncomingPostOwnerTransferRequests(ctx context.Context, in
 	return out, nil
 }
 
+func (c *queryClient) PostRevisions(ctx context.Context, in *QueryPostRevisionsRequest, opts ...grpc.CallOption) (*QueryPostRevisionsResponse, error) {
+	out := new(QueryPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.posts.v3.Query/PostRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// SubspacePosts queries all the posts inside a given subspace
@@ -1011,6 +1247,8 @@ type QueryServer interface {
 	// IncomingPostOwnerTransferRequests queries all the post owner transfers
 	// requests that have been made towards the receiver with the given address
 	IncomingPostOwnerTransferRequests(context.Context, *QueryIncomingPostOwnerTransferRequestsRequest) (*QueryIncomingPostOwnerTransferRequestsResponse, error)
+	// PostRevisions queries the revisions of the post having the given id
+	PostRevisions(context.Context, *QueryPostRevisionsRequest) (*QueryPostRevisionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1038,6 +1276,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) IncomingPostOwnerTransferRequests(ctx context.Context, req *QueryIncomingPostOwnerTransferRequestsRequest) (*QueryIncomingPostOwnerTransferRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncomingPostOwnerTransferRequests not implemented")
 }
+func (*UnimplementedQueryServer) PostRevisions(ctx context.Context, req *QueryPostRevisionsRequest) (*QueryPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostRevisions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1169,6 +1410,24 @@ func _Query_IncomingPostOwnerTransferRequests_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.posts.v3.Query/PostRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostRevisions(ctx, req.(*QueryPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "desmos.posts.v3.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1201,6 +1460,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IncomingPostOwnerTransferRequests",
 			Handler:    _Query_IncomingPostOwnerTransferRequests_Handler,
 		},
+		{
+			MethodName: "PostRevisions",
+			Handler:    _Query_PostRevisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "desmos/posts/v3/query.proto",
@@ -1226,6 +1489,13 @@ func (m *QuerySubspacePostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Viewer) > 0 {
+		i -= len(m.Viewer)
+		copy(dAtA[i:], m.Viewer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Viewer)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1315,6 +1585,13 @@ func (m *QuerySectionPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Viewer) > 0 {
+		i -= len(m.Viewer)
+		copy(dAtA[i:], m.Viewer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Viewer)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1409,6 +1686,13 @@ func (m *QueryPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Viewer) > 0 {
+		i -= len(m.Viewer)
+		copy(dAtA[i:], m.Viewer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Viewer)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.PostId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PostId))
 		i--
@@ -1442,6 +1726,26 @@ func (m *QueryPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PublishTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PublishTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PublishTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Hidden {
+		i--
+		if m.Hidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1475,6 +1779,13 @@ func (m *QueryPostAttachmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.Viewer) > 0 {
+		i -= len(m.Viewer)
+		copy(dAtA[i:], m.Viewer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Viewer)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1569,6 +1880,13 @@ func (m *QueryPollAnswersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Viewer) > 0 {
+		i -= len(m.Viewer)
+		copy(dAtA[i:], m.Viewer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Viewer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1807,69 +2125,183 @@ func (m *QueryIncomingPostOwnerTransferRequestsResponse) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPostRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuerySubspacePostsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryPostRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySubspacePostsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPostRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Posts) > 0 {
-		for _, e := range m.Posts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Viewer) > 0 {
+		i -= len(m.Viewer)
+		copy(dAtA[i:], m.Viewer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Viewer)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *QuerySectionPostsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PostId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
 	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
-	}
-	if m.SectionId != 0 {
-		n += 1 + sovQuery(uint64(m.SectionId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostRevisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostRevisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostRevisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RevisionsLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionsLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySubspacePostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Viewer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubspacePostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySectionPostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.SectionId != 0 {
+		n += 1 + sovQuery(uint64(m.SectionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Viewer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QuerySectionPostsResponse) Size() (n int) {
@@ -1903,6 +2335,10 @@ func (m *QueryPostRequest) Size() (n int) {
 	if m.PostId != 0 {
 		n += 1 + sovQuery(uint64(m.PostId))
 	}
+	l = len(m.Viewer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1914,6 +2350,13 @@ func (m *QueryPostResponse) Size() (n int) {
 	_ = l
 	l = m.Post.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Hidden {
+		n += 2
+	}
+	if m.PublishTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PublishTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1933,6 +2376,10 @@ func (m *QueryPostAttachmentsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Viewer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1978,6 +2425,10 @@ func (m *QueryPollAnswersRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Viewer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2059,6 +2510,51 @@ func (m *QueryIncomingPostOwnerTransferRequestsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPostRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.PostId != 0 {
+		n += 1 + sovQuery(uint64(m.PostId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Viewer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostRevisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RevisionsLimit != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionsLimit))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2149,6 +2645,38 @@ func (m *QuerySubspacePostsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Viewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Viewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2393,6 +2921,38 @@ func (m *QuerySectionPostsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Viewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Viewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2601,6 +3161,38 @@ func (m *QueryPostRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Viewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Viewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2684,13 +3276,69 @@ func (m *QueryPostResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hidden = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublishTime == nil {
+				m.PublishTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PublishTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if (iNdEx + skippy) > l {
@@ -2808,6 +3456,38 @@ func (m *QueryPostAttachmentsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Viewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Viewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3103,6 +3783,38 @@ func (m *QueryPollAnswersRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Viewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Viewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3634,6 +4346,301 @@ func (m *QueryIncomingPostOwnerTransferRequestsResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
+func (m *QueryPostRevisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostRevisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Viewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Viewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostRevisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostRevisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostRevisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, PostRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionsLimit", wireType)
+			}
+			m.RevisionsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionsLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
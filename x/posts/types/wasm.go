@@ -5,12 +5,14 @@ import (
 )
 
 type PostsMsg struct {
-	CreatePost           *json.RawMessage `json:"create_post"`
-	EditPost             *json.RawMessage `json:"edit_post"`
-	DeletePost           *json.RawMessage `json:"delete_post"`
-	AddPostAttachment    *json.RawMessage `json:"add_post_attachment"`
-	RemovePostAttachment *json.RawMessage `json:"remove_post_attachment"`
-	AnswerPoll           *json.RawMessage `json:"answer_poll"`
+	CreatePost            *json.RawMessage `json:"create_post"`
+	EditPost              *json.RawMessage `json:"edit_post"`
+	DeletePost            *json.RawMessage `json:"delete_post"`
+	AddPostAttachment     *json.RawMessage `json:"add_post_attachment"`
+	RemovePostAttachment  *json.RawMessage `json:"remove_post_attachment"`
+	AnswerPoll            *json.RawMessage `json:"answer_poll"`
+	UnhidePost            *json.RawMessage `json:"unhide_post"`
+	SetPostRevisionsLimit *json.RawMessage `json:"set_post_revisions_limit"`
 }
 
 type PostsQuery struct {
@@ -18,5 +20,6 @@ type PostsQuery struct {
 	SectionPosts    *json.RawMessage `json:"section_posts"`
 	Post            *json.RawMessage `json:"post"`
 	PostAttachments *json.RawMessage `json:"post_attachments"`
+	PostRevisions   *json.RawMessage `json:"post_revisions"`
 	PollAnswers     *json.RawMessage `json:"poll_answers"`
 }
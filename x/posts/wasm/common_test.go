@@ -10,10 +10,12 @@ import (
 
 	"github.com/desmos-labs/desmos/v7/app"
 	"github.com/desmos-labs/desmos/v7/x/posts/keeper"
+	"github.com/desmos-labs/desmos/v7/x/posts/testutil"
 
 	db "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -58,6 +60,18 @@ func buildAnswerPollRequest(cdc codec.Codec, msg sdk.Msg) json.RawMessage {
 	return bz
 }
 
+func buildUnhidePostRequest(cdc codec.Codec, msg sdk.Msg) json.RawMessage {
+	raw := json.RawMessage(cdc.MustMarshalJSON(msg))
+	bz, _ := json.Marshal(types.PostsMsg{UnhidePost: &raw})
+	return bz
+}
+
+func buildSetPostRevisionsLimitRequest(cdc codec.Codec, msg sdk.Msg) json.RawMessage {
+	raw := json.RawMessage(cdc.MustMarshalJSON(msg))
+	bz, _ := json.Marshal(types.PostsMsg{SetPostRevisionsLimit: &raw})
+	return bz
+}
+
 func buildSubspacePostsQueryRequest(cdc codec.Codec, query *types.QuerySubspacePostsRequest) json.RawMessage {
 	raw := json.RawMessage(cdc.MustMarshalJSON(query))
 	bz, _ := json.Marshal(types.PostsQuery{SubspacePosts: &raw})
@@ -82,6 +96,12 @@ func buildAppLinksQueryRequest(cdc codec.Codec, query *types.QueryPostAttachment
 	return bz
 }
 
+func buildPostRevisionsQueryRequest(cdc codec.Codec, query *types.QueryPostRevisionsRequest) json.RawMessage {
+	raw := json.RawMessage(cdc.MustMarshalJSON(query))
+	bz, _ := json.Marshal(types.PostsQuery{PostRevisions: &raw})
+	return bz
+}
+
 func buildPollAnswersQueryRequest(cdc codec.Codec, query *types.QueryPollAnswersRequest) json.RawMessage {
 	raw := json.RawMessage(cdc.MustMarshalJSON(query))
 	bz, _ := json.Marshal(types.PostsQuery{PollAnswers: &raw})
@@ -97,6 +117,9 @@ type TestSuite struct {
 
 	storeKey storetypes.StoreKey
 	k        *keeper.Keeper
+
+	ctrl *gomock.Controller
+	sk   *testutil.MockSubspacesKeeper
 }
 
 func TestTestSuite(t *testing.T) {
@@ -122,12 +145,21 @@ func (suite *TestSuite) SetupTest() {
 	suite.ctx = sdk.NewContext(ms, tmproto.Header{ChainID: "test-chain"}, false, log.NewNopLogger())
 	suite.cdc, suite.legacyAminoCdc = app.MakeCodecs()
 
+	// Setup keepers
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.sk = testutil.NewMockSubspacesKeeper(suite.ctrl)
+
 	suite.k = keeper.NewKeeper(
 		suite.cdc,
 		suite.storeKey,
 		nil,
+		suite.sk,
 		nil,
 		nil,
 		authtypes.NewModuleAddress("gov").String(),
 	)
 }
+
+func (suite *TestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
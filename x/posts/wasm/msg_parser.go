@@ -45,6 +45,10 @@ func (parser MsgsParser) ParseCustomMsgs(contractAddr sdk.AccAddress, data json.
 		return commons.HandleWasmMsg(parser.cdc, *msg.RemovePostAttachment, &types.MsgRemovePostAttachment{})
 	case msg.AnswerPoll != nil:
 		return commons.HandleWasmMsg(parser.cdc, *msg.AnswerPoll, &types.MsgAnswerPoll{})
+	case msg.UnhidePost != nil:
+		return commons.HandleWasmMsg(parser.cdc, *msg.UnhidePost, &types.MsgUnhidePost{})
+	case msg.SetPostRevisionsLimit != nil:
+		return commons.HandleWasmMsg(parser.cdc, *msg.SetPostRevisionsLimit, &types.MsgSetPostRevisionsLimit{})
 	default:
 		return nil, errors.Wrap(wasmtypes.ErrInvalidMsg, "cosmwasm-posts-msg-parser: message not supported")
 	}
@@ -52,6 +52,10 @@ func (querier PostsWasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessag
 		if response, err = querier.handlePostAttachmentsRequest(ctx, *query.PostAttachments); err != nil {
 			return nil, err
 		}
+	case query.PostRevisions != nil:
+		if response, err = querier.handlePostRevisionsRequest(ctx, *query.PostRevisions); err != nil {
+			return nil, err
+		}
 	case query.PollAnswers != nil:
 		if response, err = querier.handlePollAnswersRequest(ctx, *query.PollAnswers); err != nil {
 			return nil, err
@@ -132,6 +136,23 @@ func (querier PostsWasmQuerier) handlePostAttachmentsRequest(ctx sdk.Context, re
 	return bz, nil
 }
 
+func (querier PostsWasmQuerier) handlePostRevisionsRequest(ctx sdk.Context, request json.RawMessage) (bz []byte, err error) {
+	var postRevisionsReq types.QueryPostRevisionsRequest
+	err = querier.cdc.UnmarshalJSON(request, &postRevisionsReq)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	postRevisionsResponse, err := querier.postsKeeper.PostRevisions(sdk.WrapSDKContext(ctx), &postRevisionsReq)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	bz, err = querier.cdc.MarshalJSON(postRevisionsResponse)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 func (querier PostsWasmQuerier) handlePollAnswersRequest(ctx sdk.Context, request json.RawMessage) (bz []byte, err error) {
 	var applicationReq types.QueryPollAnswersRequest
 	err = querier.cdc.UnmarshalJSON(request, &applicationReq)
@@ -1,13 +1,15 @@
 package profiles
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/desmos-labs/desmos/v7/x/profiles/keeper"
 	"github.com/desmos-labs/desmos/v7/x/profiles/types"
 )
 
-// BeginBlocker is called every block, processes expired application links
+// BeginBlocker is called every block, processes expired application links and DTag offers
 func BeginBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	// Iterate over all the expiring application links
 	keeper.IterateExpiringApplicationLinks(ctx, func(link types.ApplicationLink) (stop bool) {
@@ -15,4 +17,59 @@ func BeginBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 		keeper.DeleteApplicationLink(ctx, link)
 		return false
 	})
+
+	// Delete all the expired DTag listings
+	var listings []types.DTagListing
+	keeper.IterateExpiredDTagListings(ctx, func(listing types.DTagListing) (stop bool) {
+		listings = append(listings, listing)
+		return false
+	})
+
+	for _, listing := range listings {
+		keeper.DeleteDTagListing(ctx, listing.Owner)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpiredDTagListing,
+				sdk.NewAttribute(types.AttributeKeyDTagToTrade, listing.DTag),
+				sdk.NewAttribute(types.AttributeKeyDTagOwner, listing.Owner),
+				sdk.NewAttribute(types.AttributeKeyDTagExpirationTime, listing.ExpirationTime.Format(time.RFC3339)),
+			),
+		)
+	}
+
+	// Refund all the expired DTag bids
+	var bids []types.DTagBid
+	keeper.IterateExpiredDTagBids(ctx, func(bid types.DTagBid) (stop bool) {
+		bids = append(bids, bid)
+		return false
+	})
+
+	for _, bid := range bids {
+		// Refund each bid inside a cached context so that a failed refund does not leave partial changes
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := keeper.RefundDTagBid(cacheCtx, bid)
+		if err != nil {
+			// Remove the bid without halting the chain so that the refund is not retried at every block.
+			// The escrowed amount is left inside the module account and can be recovered manually using the event
+			keeper.Logger(ctx).Error("failed to refund expired DTag bid",
+				"owner", bid.Owner, "buyer", bid.Buyer, "price", bid.Price, "error", err)
+
+			keeper.DeleteDTagBid(ctx, bid)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeFailedDTagBidRefund,
+					sdk.NewAttribute(types.AttributeKeyDTagToTrade, bid.DTag),
+					sdk.NewAttribute(types.AttributeKeyDTagOwner, bid.Owner),
+					sdk.NewAttribute(types.AttributeKeyDTagBuyer, bid.Buyer),
+					sdk.NewAttribute(types.AttributeKeyDTagPrice, bid.Price.String()),
+					sdk.NewAttribute(types.AttributeKeyDTagExpirationTime, bid.ExpirationTime.Format(time.RFC3339)),
+					sdk.NewAttribute(types.AttributeKeyDTagRefundError, err.Error()),
+				),
+			)
+			continue
+		}
+		writeCache()
+	}
 }
@@ -69,6 +69,8 @@ func setupBenchTest() (sdk.Context, authkeeper.AccountKeeper, *keeper.Keeper) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 		authtypes.NewModuleAddress("gov").String(),
 	)
 
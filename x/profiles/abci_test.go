@@ -6,6 +6,9 @@ import (
 
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/desmos-labs/desmos/v7/testutil/profilestesting"
 	"github.com/desmos-labs/desmos/v7/x/profiles"
@@ -17,6 +20,7 @@ import (
 	relationshipstypes "github.com/desmos-labs/desmos/v7/x/relationships/types"
 
 	db "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/store"
@@ -32,7 +36,7 @@ import (
 func TestBeginBlocker(t *testing.T) {
 	// Define store keys
 	keys := sdk.NewMemoryStoreKeys(
-		authtypes.StoreKey, relationshipstypes.StoreKey, types.StoreKey,
+		authtypes.StoreKey, banktypes.StoreKey, relationshipstypes.StoreKey, types.StoreKey,
 	)
 
 	// Create an in-memory db
@@ -50,7 +54,24 @@ func TestBeginBlocker(t *testing.T) {
 	sk := subspaceskeeper.NewKeeper(cdc, keys[subspacestypes.StoreKey], nil, nil, "authority")
 	rk := relationshipskeeper.NewKeeper(cdc, keys[relationshipstypes.StoreKey], sk)
 	ak := authkeeper.NewAccountKeeper(cdc, keys[authtypes.StoreKey], authtypes.ProtoBaseAccount, app.GetMaccPerms(), "cosmos", authtypes.NewModuleAddress("gov").String())
-	k := keeper.NewKeeper(cdc, legacyAmino, keys[types.StoreKey], ak, rk, nil, nil, nil, authtypes.NewModuleAddress("gov").String())
+	bk := bankkeeper.NewBaseKeeper(cdc, keys[banktypes.StoreKey], ak, nil, authtypes.NewModuleAddress("gov").String())
+	k := keeper.NewKeeper(cdc, legacyAmino, keys[types.StoreKey], ak, rk, bk, nil, nil, nil, nil, authtypes.NewModuleAddress("gov").String())
+
+	// fundModuleAccount mints the given amount and sends it to the profiles module account
+	fundModuleAccount := func(ctx sdk.Context, amount sdk.Coins) {
+		require.NoError(t, bk.MintCoins(ctx, minttypes.ModuleName, amount))
+		require.NoError(t, bk.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, amount))
+	}
+
+	// findEvent returns the first event having the given type that has been emitted inside the given context
+	findEvent := func(ctx sdk.Context, eventType string) (sdk.Event, bool) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				return event, true
+			}
+		}
+		return sdk.Event{}, false
+	}
 
 	testCases := []struct {
 		name      string
@@ -104,6 +125,126 @@ func TestBeginBlocker(t *testing.T) {
 				))
 			},
 		},
+		{
+			name: "expired DTag listings are deleted correctly",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 01, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				address := "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"
+				profile := profilestesting.ProfileFromAddr(address)
+				profile.DTag = "dtag"
+				require.NoError(t, k.SaveProfile(ctx, profile))
+
+				require.NoError(t, k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					address,
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+
+				// Not expired listing
+				require.NoError(t, k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				require.NoError(t, k.SaveDTagListing(ctx, types.NewDTagListing(
+					"other_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeExpiredDTagListing,
+					sdk.NewAttribute(types.AttributeKeyDTagToTrade, "dtag"),
+					sdk.NewAttribute(types.AttributeKeyDTagOwner, "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"),
+					sdk.NewAttribute(
+						types.AttributeKeyDTagExpirationTime,
+						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC).Format(time.RFC3339),
+					),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				require.False(t, k.HasDTagListing(ctx, "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"))
+				require.True(t, k.HasDTagListing(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"))
+			},
+		},
+		{
+			name: "expired DTag bids are refunded correctly",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 01, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773")
+				profile.DTag = "dtag"
+				require.NoError(t, k.SaveProfile(ctx, profile))
+
+				fundModuleAccount(ctx, sdk.NewCoins(sdk.NewCoin("udsm", sdk.NewInt(100))))
+				require.NoError(t, k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					"cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			check: func(ctx sdk.Context) {
+				require.False(t, k.HasDTagBid(ctx, "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773", "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"))
+
+				buyer := sdk.MustAccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				require.Equal(t, sdk.NewCoin("udsm", sdk.NewInt(100)), bk.GetBalance(ctx, buyer, "udsm"))
+
+				_, found := findEvent(ctx, types.EventTypeRefundedDTagBid)
+				require.True(t, found)
+			},
+		},
+		{
+			name: "expired DTag bids that cannot be refunded are removed",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 01, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773")
+				profile.DTag = "dtag"
+				require.NoError(t, k.SaveProfile(ctx, profile))
+
+				// The module account does not hold enough funds to refund the bid
+				fundModuleAccount(ctx, sdk.NewCoins(sdk.NewCoin("udsm", sdk.NewInt(50))))
+				require.NoError(t, k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					"cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			check: func(ctx sdk.Context) {
+				require.False(t, k.HasDTagBid(ctx, "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773", "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"))
+
+				// Make sure no funds have been moved
+				buyer := sdk.MustAccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				require.True(t, bk.GetBalance(ctx, buyer, "udsm").IsZero())
+
+				moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+				require.Equal(t, sdk.NewCoin("udsm", sdk.NewInt(50)), bk.GetBalance(ctx, moduleAddress, "udsm"))
+
+				// Make sure the failure has been recorded
+				event, found := findEvent(ctx, types.EventTypeFailedDTagBidRefund)
+				require.True(t, found)
+				require.Contains(t, event.Attributes, abci.EventAttribute{
+					Key:   types.AttributeKeyDTagBuyer,
+					Value: "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				})
+				require.Contains(t, event.Attributes, abci.EventAttribute{
+					Key:   types.AttributeKeyDTagPrice,
+					Value: "100udsm",
+				})
+
+				_, found = findEvent(ctx, types.EventTypeRefundedDTagBid)
+				require.False(t, found)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -124,7 +265,9 @@ func TestBeginBlocker(t *testing.T) {
 			profiles.BeginBlocker(ctx, k)
 
 			// Check the events and storage
-			require.Equal(t, tc.expEvents, ctx.EventManager().Events())
+			if tc.expEvents != nil {
+				require.Equal(t, tc.expEvents, ctx.EventManager().Events())
+			}
 			if tc.check != nil {
 				tc.check(ctx)
 			}
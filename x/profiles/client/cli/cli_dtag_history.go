@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+// GetCmdQueryDTagHistory returns the command allowing to query the past holders of a DTag
+func GetCmdQueryDTagHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dtag-history [dtag]",
+		Short: "Retrieve the users that held the given DTag in the past with optional pagination",
+		Example: fmt.Sprintf(`%s query profiles dtag-history leonardo
+%s query profiles dtag-history leonardo --page=2 --limit=100
+`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DTagHistory(
+				context.Background(),
+				types.NewQueryDTagHistoryRequest(args[0], pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "DTag history")
+
+	return cmd
+}
+
+// GetCmdQueryProfileDTagHistory returns the command allowing to query the DTags held by a user in the past
+func GetCmdQueryProfileDTagHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile-dtag-history [address]",
+		Short: "Retrieve the DTags that the given user held in the past with optional pagination",
+		Example: fmt.Sprintf(`%s query profiles profile-dtag-history desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud
+%s query profiles profile-dtag-history desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud --page=2 --limit=100
+`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProfileDTagHistory(
+				context.Background(),
+				types.NewQueryProfileDTagHistoryRequest(args[0], pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "profile DTag history")
+
+	return cmd
+}
@@ -0,0 +1,137 @@
+//go:build norace
+// +build norace
+
+package cli_test
+
+import (
+	"fmt"
+	"time"
+
+	tmcli "github.com/cometbft/cometbft/libs/cli"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/client/cli"
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+func (s *IntegrationTestSuite) TestCmdQueryDTagHistory() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name       string
+		args       []string
+		shouldErr  bool
+		expEntries []types.DTagHistoryEntry
+	}{
+		{
+			name: "empty slice is returned properly",
+			args: []string{
+				"not_existing",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr:  false,
+			expEntries: []types.DTagHistoryEntry{},
+		},
+		{
+			name: "existing entries are returned properly",
+			args: []string{
+				"old_dtag",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expEntries: []types.DTagHistoryEntry{
+				types.NewDTagHistoryEntry(
+					"old_dtag",
+					"cosmos1ftkjv8njvkekk00ehwdfl5sst8zgdpenjfm4hs",
+					1,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryDTagHistory()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryDTagHistoryResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				s.Require().Equal(tc.expEntries, response.Entries)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdQueryProfileDTagHistory() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name       string
+		args       []string
+		shouldErr  bool
+		expEntries []types.DTagHistoryEntry
+	}{
+		{
+			name: "invalid address returns error",
+			args: []string{
+				"invalid-address",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "empty slice is returned properly",
+			args: []string{
+				"cosmos1nqwf7chwfywdw2379sxmwlcgcfvvy86t6mpunz",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr:  false,
+			expEntries: []types.DTagHistoryEntry{},
+		},
+		{
+			name: "existing entries are returned properly",
+			args: []string{
+				"cosmos1ftkjv8njvkekk00ehwdfl5sst8zgdpenjfm4hs",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expEntries: []types.DTagHistoryEntry{
+				types.NewDTagHistoryEntry(
+					"old_dtag",
+					"cosmos1ftkjv8njvkekk00ehwdfl5sst8zgdpenjfm4hs",
+					1,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryProfileDTagHistory()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryProfileDTagHistoryResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				s.Require().Equal(tc.expEntries, response.Entries)
+			}
+		})
+	}
+}
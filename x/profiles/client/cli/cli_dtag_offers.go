@@ -0,0 +1,328 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+// GetCmdListDTag returns the command to put your DTag on sale
+func GetCmdListDTag() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-dtag [price] [new-dtag] [expiration-time]",
+		Short: "Put your DTag on sale for the given price",
+		Long: `Put your DTag on sale for the given price until the given RFC3339 expiration time.
+
+Once your DTag is bought, you will receive the price and the given new DTag will become your DTag.
+Listing your DTag again will replace the existing listing.`,
+		Example: fmt.Sprintf(`%s tx profiles list-dtag 100stake "leoDiCaprio" 2030-01-01T00:00:00Z`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			expirationTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgListDTag(price, args[1], expirationTime, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancelDTagListing returns the command to remove your DTag from sale
+func GetCmdCancelDTagListing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-dtag-listing",
+		Short:   "Remove your DTag from sale",
+		Example: fmt.Sprintf(`%s tx profiles cancel-dtag-listing`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelDTagListing(clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBuyDTag returns the command to buy a DTag that has been put on sale
+func GetCmdBuyDTag() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "buy-dtag [owner] [price]",
+		Short:   "Buy the DTag that the user with the given address has put on sale",
+		Long:    "Buy the DTag that the user with the given address has put on sale. The given price must match the listing price.",
+		Example: fmt.Sprintf(`%s tx profiles buy-dtag desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud 100stake`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyDTag(owner.String(), price, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPlaceDTagBid returns the command to place a bid on the DTag of another user
+func GetCmdPlaceDTagBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-dtag-bid [owner] [price] [expiration-time]",
+		Short: "Offer the given price in exchange of the DTag of the user with the given address",
+		Long: `Offer the given price in exchange of the DTag of the user with the given address.
+
+The price will be held by the module account until the bid is accepted, canceled or it expires
+after the given RFC3339 expiration time. If the bid is canceled or expires, the price will be refunded.`,
+		Example: fmt.Sprintf(`%s tx profiles place-dtag-bid desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud 100stake 2030-01-01T00:00:00Z`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			expirationTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceDTagBid(owner.String(), price, expirationTime, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancelDTagBid returns the command to cancel an outgoing DTag bid
+func GetCmdCancelDTagBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-dtag-bid [owner]",
+		Short:   "Cancel the DTag bid made towards the given owner address and get the price back",
+		Example: fmt.Sprintf(`%s tx profiles cancel-dtag-bid desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelDTagBid(owner.String(), clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAcceptDTagBid returns the command to accept an incoming DTag bid
+func GetCmdAcceptDTagBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-dtag-bid [DTag] [buyer]",
+		Short: "Accept a DTag bid made by another user towards you",
+		Long: `Accept the DTag bid made by the user with the given address, receiving the offered price.
+
+When accepting the bid, you can specify the buyer DTag as your new DTag.
+If this happens, your DTag and the other user's one will be effectively swapped.`,
+		Example: fmt.Sprintf(`%s tx profiles accept-dtag-bid "leoDiCaprio" desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			buyer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptDTagBid(args[0], buyer.String(), clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetCmdQueryDTagListings returns the command allowing to query all the DTags that have been put on sale
+func GetCmdQueryDTagListings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dtag-listings [[owner]]",
+		Short: "Retrieve the DTags that have been put on sale with optional owner address and pagination",
+		Example: fmt.Sprintf(`%s query profiles dtag-listings
+%s query profiles dtag-listings --page=2 --limit=100
+%s query profiles dtag-listings desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud
+`, version.AppName, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var owner string
+			if len(args) == 1 {
+				owner = args[0]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DTagListings(
+				context.Background(),
+				types.NewQueryDTagListingsRequest(owner, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "DTag listings")
+
+	return cmd
+}
+
+// GetCmdQueryDTagBids returns the command allowing to query all the DTag bids made towards a user
+func GetCmdQueryDTagBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dtag-bids [[owner]]",
+		Short: "Retrieve the DTag bids with optional owner address and pagination",
+		Example: fmt.Sprintf(`%s query profiles dtag-bids
+%s query profiles dtag-bids --page=2 --limit=100
+%s query profiles dtag-bids desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud
+`, version.AppName, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var owner string
+			if len(args) == 1 {
+				owner = args[0]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DTagBids(
+				context.Background(),
+				types.NewQueryDTagBidsRequest(owner, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "DTag bids")
+
+	return cmd
+}
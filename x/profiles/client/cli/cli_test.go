@@ -131,6 +131,23 @@ func (s *IntegrationTestSuite) SetupSuite() {
 			"cosmos1ftkjv8njvkekk00ehwdfl5sst8zgdpenjfm4hs",
 		),
 	}
+	profilesData.DTagListings = []types.DTagListing{
+		types.NewDTagListing(
+			"dtag",
+			"cosmos1ftkjv8njvkekk00ehwdfl5sst8zgdpenjfm4hs",
+			sdk.NewCoin("stake", sdk.NewInt(100)),
+			"new_dtag",
+			time.Date(9999, 1, 1, 00, 00, 00, 000, time.UTC),
+		),
+	}
+	profilesData.DTagHistory = []types.DTagHistoryEntry{
+		types.NewDTagHistoryEntry(
+			"old_dtag",
+			"cosmos1ftkjv8njvkekk00ehwdfl5sst8zgdpenjfm4hs",
+			1,
+			time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+		),
+	}
 	profilesData.ApplicationLinks = []types.ApplicationLink{
 		types.NewApplicationLink(
 			"cosmos1ftkjv8njvkekk00ehwdfl5sst8zgdpenjfm4hs",
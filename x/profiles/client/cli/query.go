@@ -22,6 +22,10 @@ func GetQueryCmd() *cobra.Command {
 	profileQueryCmd.AddCommand(
 		GetCmdQueryProfile(),
 		GetCmdQueryDTagRequests(),
+		GetCmdQueryDTagListings(),
+		GetCmdQueryDTagBids(),
+		GetCmdQueryDTagHistory(),
+		GetCmdQueryProfileDTagHistory(),
 		GetCmdQueryParams(),
 		GetCmdQueryChainLinks(),
 		GetCmdQueryChainLinkOwners(),
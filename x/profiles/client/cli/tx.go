@@ -27,6 +27,12 @@ func NewTxCmd() *cobra.Command {
 		GetCmdAcceptDTagTransfer(),
 		GetCmdRefuseDTagTransfer(),
 		GetCmdCancelDTagTransfer(),
+		GetCmdListDTag(),
+		GetCmdCancelDTagListing(),
+		GetCmdBuyDTag(),
+		GetCmdPlaceDTagBid(),
+		GetCmdCancelDTagBid(),
+		GetCmdAcceptDTagBid(),
 		GetCmdLinkChainAccount(),
 		GetCmdUnlinkChainAccount(),
 		GetCmdSetDefaultExternalAddress(),
@@ -81,6 +81,138 @@ func (k Keeper) IterateUserIncomingDTagTransferRequests(
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// IterateDTagListings iterates over all the DTag listings and performs the provided function
+func (k Keeper) IterateDTagListings(ctx sdk.Context, fn func(listing types.DTagListing) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DTagListingPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		listing := types.MustUnmarshalDTagListing(k.cdc, iterator.Value())
+		stop := fn(listing)
+		if stop {
+			break
+		}
+	}
+}
+
+// IterateExpiredDTagListings iterates over all the DTag listings that have expired at the current block time
+// and performs the provided function
+func (k Keeper) IterateExpiredDTagListings(ctx sdk.Context, fn func(listing types.DTagListing) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	// ExpiringDTagListingTimePrefix | Expiration Time | Owner -> Owner
+	iterator := store.Iterator(types.ExpiringDTagListingTimePrefix, types.DTagListingExpiringTimePrefix(ctx.BlockTime()))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		listing := types.MustUnmarshalDTagListing(k.cdc, store.Get(types.DTagListingStoreKey(string(iterator.Value()))))
+		stop := fn(listing)
+		if stop {
+			break
+		}
+	}
+}
+
+// IterateDTagBids iterates over all the DTag bids and performs the provided function
+func (k Keeper) IterateDTagBids(ctx sdk.Context, fn func(bid types.DTagBid) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DTagBidPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bid := types.MustUnmarshalDTagBid(k.cdc, iterator.Value())
+		stop := fn(bid)
+		if stop {
+			break
+		}
+	}
+}
+
+// IterateUserIncomingDTagBids iterates over all the DTag bids made towards the given user
+// and performs the provided function
+func (k Keeper) IterateUserIncomingDTagBids(ctx sdk.Context, user string, fn func(bid types.DTagBid) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.IncomingDTagBidsPrefix(user))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bid := types.MustUnmarshalDTagBid(k.cdc, iterator.Value())
+		stop := fn(bid)
+		if stop {
+			break
+		}
+	}
+}
+
+// IterateExpiredDTagBids iterates over all the DTag bids that have expired at the current block time
+// and performs the provided function
+func (k Keeper) IterateExpiredDTagBids(ctx sdk.Context, fn func(bid types.DTagBid) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	// ExpiringDTagBidTimePrefix | Expiration Time | Owner | Buyer -> DTagBidStoreKey
+	iterator := store.Iterator(types.ExpiringDTagBidTimePrefix, types.DTagBidExpiringTimePrefix(ctx.BlockTime()))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bid := types.MustUnmarshalDTagBid(k.cdc, store.Get(iterator.Value()))
+		stop := fn(bid)
+		if stop {
+			break
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IterateDTagHistoryEntries iterates over all the DTag history entries and performs the provided function
+func (k Keeper) IterateDTagHistoryEntries(ctx sdk.Context, fn func(entry types.DTagHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DTagHistoryPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		entry := types.MustUnmarshalDTagHistoryEntry(k.cdc, iterator.Value())
+		stop := fn(entry)
+		if stop {
+			break
+		}
+	}
+}
+
+// IterateDTagHistory iterates over all the history entries of the given DTag and performs the provided function
+func (k Keeper) IterateDTagHistory(ctx sdk.Context, dTag string, fn func(entry types.DTagHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DTagHistoryEntriesPrefix(dTag))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		entry := types.MustUnmarshalDTagHistoryEntry(k.cdc, iterator.Value())
+		stop := fn(entry)
+		if stop {
+			break
+		}
+	}
+}
+
+// IterateUserDTagHistory iterates over all the history entries of the DTags held by the given user
+// and performs the provided function
+func (k Keeper) IterateUserDTagHistory(ctx sdk.Context, user string, fn func(entry types.DTagHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UserDTagHistoryEntriesPrefix(user))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		entry := types.MustUnmarshalDTagHistoryEntry(k.cdc, store.Get(iterator.Value()))
+		stop := fn(entry)
+		if stop {
+			break
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // IterateApplicationLinks iterates through all the application links and performs the provided function
 func (k Keeper) IterateApplicationLinks(ctx sdk.Context, fn func(link types.ApplicationLink) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -257,3 +389,31 @@ func (k Keeper) GetDefaultExternalAddressEntries(ctx sdk.Context) []types.Defaul
 	})
 	return entries
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IterateUsedEIP712Nonces iterates through the used EIP-712 nonces and performs the provided function
+func (k Keeper) IterateUsedEIP712Nonces(ctx sdk.Context, fn func(entry types.UsedEIP712NonceEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.UsedEIP712NoncePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pubKey, nonce := types.GetUsedEIP712NonceData(iterator.Key())
+		stop := fn(types.NewUsedEIP712NonceEntry(pubKey, nonce))
+		if stop {
+			break
+		}
+	}
+}
+
+// GetUsedEIP712NonceEntries returns all the stored used EIP-712 nonces
+func (k Keeper) GetUsedEIP712NonceEntries(ctx sdk.Context) []types.UsedEIP712NonceEntry {
+	var entries []types.UsedEIP712NonceEntry
+	k.IterateUsedEIP712Nonces(ctx, func(entry types.UsedEIP712NonceEntry) (stop bool) {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
@@ -48,6 +48,8 @@ type KeeperTestSuite struct {
 
 	ctrl          *gomock.Controller
 	rk            *testutil.MockRelationshipsKeeper
+	bk            *testutil.MockBankKeeper
+	cpk           *testutil.MockCommunityPoolKeeper
 	channelKeeper *testutil.MockChannelKeeper
 	portKeeper    *testutil.MockPortKeeper
 	scopedKeeper  *testutil.MockScopedKeeper
@@ -110,6 +112,8 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.ctrl = gomock.NewController(suite.T())
 
 	suite.rk = testutil.NewMockRelationshipsKeeper(suite.ctrl)
+	suite.bk = testutil.NewMockBankKeeper(suite.ctrl)
+	suite.cpk = testutil.NewMockCommunityPoolKeeper(suite.ctrl)
 	suite.channelKeeper = testutil.NewMockChannelKeeper(suite.ctrl)
 	suite.portKeeper = testutil.NewMockPortKeeper(suite.ctrl)
 	suite.scopedKeeper = testutil.NewMockScopedKeeper(suite.ctrl)
@@ -121,6 +125,8 @@ func (suite *KeeperTestSuite) SetupTest() {
 		suite.storeKey,
 		suite.ak,
 		suite.rk,
+		suite.bk,
+		suite.cpk,
 		suite.channelKeeper,
 		suite.portKeeper,
 		suite.scopedKeeper,
@@ -17,6 +17,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetChainLinks(ctx),
 		k.GetDefaultExternalAddressEntries(ctx),
 		k.GetApplicationLinks(ctx),
+		k.GetDTagListings(ctx),
+		k.GetDTagBids(ctx),
+		k.GetDTagHistoryEntries(ctx),
+		k.GetUsedEIP712NonceEntries(ctx),
 	)
 }
 
@@ -76,5 +80,32 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) []abci.Val
 		}
 	}
 
+	// Store the DTag listings
+	for _, listing := range data.DTagListings {
+		err := k.SaveDTagListing(ctx, listing)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Store the DTag bids. The escrowed amounts are expected to be part of the module account balance
+	for _, bid := range data.DTagBids {
+		err := k.SaveDTagBid(ctx, bid)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Store the DTag history
+	for _, entry := range data.DTagHistory {
+		k.SaveDTagHistoryEntry(ctx, entry)
+	}
+
+	// Store the used EIP-712 nonces. This is done after storing the chain links since saving them
+	// marks their own nonces as used already
+	for _, entry := range data.UsedEIP712Nonces {
+		k.SetEIP712NonceUsed(ctx, entry.PubKey, entry.Nonce)
+	}
+
 	return nil
 }
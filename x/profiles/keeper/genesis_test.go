@@ -37,6 +37,10 @@ func (suite *KeeperTestSuite) Test_ExportGenesis() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -73,6 +77,7 @@ func (suite *KeeperTestSuite) Test_ExportGenesis() {
 						sdk.NewCoin("band", sdk.NewInt(10)),
 					),
 					types.NewAppLinksParams(types.DefaultAppLinksValidityDuration),
+					types.DefaultReservedDTagsParams(),
 				)
 				suite.k.SetParams(ctx, params)
 				suite.k.SetPort(ctx, "port-id")
@@ -117,6 +122,15 @@ func (suite *KeeperTestSuite) Test_ExportGenesis() {
 					suite.ak.SetAccount(ctx, profilestesting.ProfileFromAddr(link.User))
 					suite.Require().NoError(suite.k.SaveApplicationLink(ctx, link))
 				}
+
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"old_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				))
+
+				suite.k.SetEIP712NonceUsed(ctx, []byte{0x02, 0x01}, 5)
 			},
 			expGenesis: types.NewGenesisState(
 				[]types.DTagTransferRequest{
@@ -136,6 +150,7 @@ func (suite *KeeperTestSuite) Test_ExportGenesis() {
 						sdk.NewCoin("band", sdk.NewInt(10)),
 					),
 					types.NewAppLinksParams(types.DefaultAppLinksValidityDuration),
+					types.DefaultReservedDTagsParams(),
 				),
 				"port-id",
 				[]types.ChainLink{
@@ -167,6 +182,19 @@ func (suite *KeeperTestSuite) Test_ExportGenesis() {
 						time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC),
 					),
 				},
+				nil,
+				nil,
+				[]types.DTagHistoryEntry{
+					types.NewDTagHistoryEntry(
+						"old_dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						10,
+						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				[]types.UsedEIP712NonceEntry{
+					types.NewUsedEIP712NonceEntry([]byte{0x02, 0x01}, 5),
+				},
 			),
 		},
 	}
@@ -213,6 +241,10 @@ func (suite *KeeperTestSuite) Test_InitGenesis() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			setup: func() {
 				suite.scopedKeeper.EXPECT().GetCapability(gomock.Any(), host.PortPath(types.IBCPortID)).Return(capabilitytypes.NewCapability(1), false)
@@ -230,6 +262,10 @@ func (suite *KeeperTestSuite) Test_InitGenesis() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			setup: func() {
 				suite.scopedKeeper.EXPECT().GetCapability(gomock.Any(), host.PortPath(types.IBCPortID)).Return(capabilitytypes.NewCapability(1), false)
@@ -252,6 +288,10 @@ func (suite *KeeperTestSuite) Test_InitGenesis() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			setup: func() {
 				suite.scopedKeeper.EXPECT().GetCapability(gomock.Any(), host.PortPath(types.IBCPortID)).Return(capabilitytypes.NewCapability(1), true)
@@ -290,6 +330,10 @@ func (suite *KeeperTestSuite) Test_InitGenesis() {
 				},
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			shouldPanic: true,
 		},
@@ -333,6 +377,7 @@ func (suite *KeeperTestSuite) Test_InitGenesis() {
 						sdk.NewCoin("band", sdk.NewInt(10)),
 					),
 					types.NewAppLinksParams(types.DefaultAppLinksValidityDuration),
+					types.DefaultReservedDTagsParams(),
 				),
 				"profiles-port-id",
 				[]types.ChainLink{
@@ -382,6 +427,19 @@ func (suite *KeeperTestSuite) Test_InitGenesis() {
 						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					),
 				},
+				nil,
+				nil,
+				[]types.DTagHistoryEntry{
+					types.NewDTagHistoryEntry(
+						"old_dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						10,
+						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				[]types.UsedEIP712NonceEntry{
+					types.NewUsedEIP712NonceEntry([]byte{0x02, 0x01}, 5),
+				},
 			),
 			check: func(ctx sdk.Context) {
 				requests := []types.DTagTransferRequest{
@@ -403,6 +461,7 @@ func (suite *KeeperTestSuite) Test_InitGenesis() {
 						sdk.NewCoin("band", sdk.NewInt(10)),
 					),
 					types.NewAppLinksParams(types.DefaultAppLinksValidityDuration),
+					types.DefaultReservedDTagsParams(),
 				)
 				suite.Require().Equal(params, suite.k.GetParams(ctx))
 
@@ -464,6 +523,19 @@ func (suite *KeeperTestSuite) Test_InitGenesis() {
 					),
 				}
 				suite.Require().Equal(applicationLinks, suite.k.GetApplicationLinks(ctx))
+
+				dTagHistory := []types.DTagHistoryEntry{
+					types.NewDTagHistoryEntry(
+						"old_dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						10,
+						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				}
+				suite.Require().Equal(dTagHistory, suite.k.GetDTagHistoryEntries(ctx))
+				suite.Require().Equal(dTagHistory, suite.k.GetUserDTagHistory(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"))
+
+				suite.Require().True(suite.k.IsEIP712NonceUsed(ctx, []byte{0x02, 0x01}, 5))
 			},
 		},
 	}
@@ -88,6 +88,140 @@ func (k Keeper) IncomingDTagTransferRequests(ctx context.Context, request *types
 	return &types.QueryIncomingDTagTransferRequestsResponse{Requests: requests, Pagination: pageRes}, nil
 }
 
+// DTagListings implements the Query/DTagListings gRPC method
+func (k Keeper) DTagListings(ctx context.Context, request *types.QueryDTagListingsRequest) (*types.QueryDTagListingsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Each user can have at most one listing, so there is no need to paginate it
+	if request.Owner != "" {
+		listing, found, err := k.GetDTagListing(sdkCtx, request.Owner)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		var listings []types.DTagListing
+		if found {
+			listings = append(listings, listing)
+		}
+		return &types.QueryDTagListingsResponse{Listings: listings}, nil
+	}
+
+	// Get listings prefix store
+	store := sdkCtx.KVStore(k.storeKey)
+	listingsStore := prefix.NewStore(store, types.DTagListingPrefix)
+
+	// Get paginated listings
+	var listings []types.DTagListing
+	pageRes, err := query.Paginate(listingsStore, request.Pagination, func(key []byte, value []byte) error {
+		var listing types.DTagListing
+		if err := k.cdc.Unmarshal(value, &listing); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		listings = append(listings, listing)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDTagListingsResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+// DTagBids implements the Query/DTagBids gRPC method
+func (k Keeper) DTagBids(ctx context.Context, request *types.QueryDTagBidsRequest) (*types.QueryDTagBidsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Get bids prefix store
+	store := sdkCtx.KVStore(k.storeKey)
+	bidsStore := prefix.NewStore(store, types.DTagBidPrefix)
+	if request.Owner != "" {
+		bidsStore = prefix.NewStore(store, types.IncomingDTagBidsPrefix(request.Owner))
+	}
+
+	// Get paginated bids
+	var bids []types.DTagBid
+	pageRes, err := query.Paginate(bidsStore, request.Pagination, func(key []byte, value []byte) error {
+		var bid types.DTagBid
+		if err := k.cdc.Unmarshal(value, &bid); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		bids = append(bids, bid)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDTagBidsResponse{Bids: bids, Pagination: pageRes}, nil
+}
+
+// DTagHistory implements the Query/DTagHistory gRPC method
+func (k Keeper) DTagHistory(ctx context.Context, request *types.QueryDTagHistoryRequest) (*types.QueryDTagHistoryResponse, error) {
+	if strings.TrimSpace(request.DTag) == "" {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "DTag cannot be empty or blank")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Get DTag history prefix store
+	store := sdkCtx.KVStore(k.storeKey)
+	historyStore := prefix.NewStore(store, types.DTagHistoryEntriesPrefix(request.DTag))
+
+	// Get paginated entries
+	var entries []types.DTagHistoryEntry
+	pageRes, err := query.Paginate(historyStore, request.Pagination, func(key []byte, value []byte) error {
+		var entry types.DTagHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDTagHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// ProfileDTagHistory implements the Query/ProfileDTagHistory gRPC method
+func (k Keeper) ProfileDTagHistory(ctx context.Context, request *types.QueryProfileDTagHistoryRequest) (*types.QueryProfileDTagHistoryResponse, error) {
+	_, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, request.Address)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Get user DTag history prefix store
+	store := sdkCtx.KVStore(k.storeKey)
+	historyStore := prefix.NewStore(store, types.UserDTagHistoryEntriesPrefix(request.Address))
+
+	// Get paginated entries
+	var entries []types.DTagHistoryEntry
+	pageRes, err := query.Paginate(historyStore, request.Pagination, func(key []byte, value []byte) error {
+		var entry types.DTagHistoryEntry
+		if err := k.cdc.Unmarshal(store.Get(value), &entry); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProfileDTagHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
 // ChainLinks implements the Query/ChainLinks gRPC method
 func (k Keeper) ChainLinks(ctx context.Context, request *types.QueryChainLinksRequest) (*types.QueryChainLinksResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
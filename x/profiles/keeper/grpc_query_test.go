@@ -222,6 +222,526 @@ func (suite *KeeperTestSuite) TestQueryServer_IncomingDTagTransferRequests() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryServer_DTagListings() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		req         *types.QueryDTagListingsRequest
+		shouldErr   bool
+		expListings []types.DTagListing
+	}{
+		{
+			name: "valid request without owner",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")))
+
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			req:       types.NewQueryDTagListingsRequest("", nil),
+			shouldErr: false,
+			expListings: []types.DTagListing{
+				types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+				types.NewDTagListing(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+		{
+			name: "valid request with pagination",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")))
+
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			req:       types.NewQueryDTagListingsRequest("", &query.PageRequest{Limit: 1}),
+			shouldErr: false,
+			expListings: []types.DTagListing{
+				types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+		{
+			name: "valid request with owner",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")))
+
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			req:       types.NewQueryDTagListingsRequest("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn", nil),
+			shouldErr: false,
+			expListings: []types.DTagListing{
+				types.NewDTagListing(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.DTagListings(sdk.WrapSDKContext(ctx), tc.req)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				suite.Require().Equal(tc.expListings, res.Listings)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_DTagBids() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryDTagBidsRequest
+		shouldErr bool
+		expBids   []types.DTagBid
+	}{
+		{
+			name: "valid request without owner",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")))
+
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			req:       types.NewQueryDTagBidsRequest("", nil),
+			shouldErr: false,
+			expBids: []types.DTagBid{
+				types.NewDTagBid(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+				types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+				types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+		{
+			name: "valid request with owner",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")))
+
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			req:       types.NewQueryDTagBidsRequest("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", nil),
+			shouldErr: false,
+			expBids: []types.DTagBid{
+				types.NewDTagBid(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+				types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+		{
+			name: "valid request with owner and pagination",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")))
+
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			req:       types.NewQueryDTagBidsRequest("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", &query.PageRequest{Limit: 1}),
+			shouldErr: false,
+			expBids: []types.DTagBid{
+				types.NewDTagBid(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.DTagBids(sdk.WrapSDKContext(ctx), tc.req)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				suite.Require().Equal(tc.expBids, res.Bids)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_DTagHistory() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		req        *types.QueryDTagHistoryRequest
+		shouldErr  bool
+		expEntries []types.DTagHistoryEntry
+	}{
+		{
+			name:      "empty DTag returns error",
+			req:       types.NewQueryDTagHistoryRequest(" ", nil),
+			shouldErr: true,
+		},
+		{
+			name: "valid request returns the DTag history",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				))
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					20,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				))
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"other_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					30,
+					time.Date(2020, 1, 3, 00, 00, 00, 000, time.UTC),
+				))
+			},
+			req:       types.NewQueryDTagHistoryRequest("DTag", nil),
+			shouldErr: false,
+			expEntries: []types.DTagHistoryEntry{
+				types.NewDTagHistoryEntry(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+				types.NewDTagHistoryEntry(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					20,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+		{
+			name: "valid request with pagination",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				))
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					20,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				))
+			},
+			req:       types.NewQueryDTagHistoryRequest("dtag", &query.PageRequest{Limit: 1}),
+			shouldErr: false,
+			expEntries: []types.DTagHistoryEntry{
+				types.NewDTagHistoryEntry(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.DTagHistory(sdk.WrapSDKContext(ctx), tc.req)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				suite.Require().Equal(tc.expEntries, res.Entries)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_ProfileDTagHistory() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		req        *types.QueryProfileDTagHistoryRequest
+		shouldErr  bool
+		expEntries []types.DTagHistoryEntry
+	}{
+		{
+			name:      "invalid address returns error",
+			req:       types.NewQueryProfileDTagHistoryRequest("cosmos1", nil),
+			shouldErr: true,
+		},
+		{
+			name: "valid request returns the user DTag history",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"first_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				))
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"second_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					20,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				))
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"first_dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					30,
+					time.Date(2020, 1, 3, 00, 00, 00, 000, time.UTC),
+				))
+			},
+			req:       types.NewQueryProfileDTagHistoryRequest("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", nil),
+			shouldErr: false,
+			expEntries: []types.DTagHistoryEntry{
+				types.NewDTagHistoryEntry(
+					"first_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+				types.NewDTagHistoryEntry(
+					"second_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					20,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+		{
+			name: "valid request with pagination",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"first_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				))
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"second_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					20,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				))
+			},
+			req: types.NewQueryProfileDTagHistoryRequest(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				&query.PageRequest{Limit: 1},
+			),
+			shouldErr: false,
+			expEntries: []types.DTagHistoryEntry{
+				types.NewDTagHistoryEntry(
+					"first_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.ProfileDTagHistory(sdk.WrapSDKContext(ctx), tc.req)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				suite.Require().Equal(tc.expEntries, res.Entries)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryServer_ChainLinks() {
 	testCases := []struct {
 		name      string
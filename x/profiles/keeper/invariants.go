@@ -14,6 +14,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, keeper *Keeper) {
 	ir.RegisterRoute(types.ModuleName, "valid-dtag-transfer-requests", ValidDTagTransferRequests(keeper))
 	ir.RegisterRoute(types.ModuleName, "valid-chain-links", ValidChainLinks(keeper))
 	ir.RegisterRoute(types.ModuleName, "valid-application-links", ValidApplicationLinks(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-dtag-offers", ValidDTagOffers(keeper))
 }
 
 func AllInvariants(k *Keeper) sdk.Invariant {
@@ -38,6 +39,11 @@ func AllInvariants(k *Keeper) sdk.Invariant {
 			return res, broken
 		}
 
+		res, broken = ValidDTagOffers(k)(ctx)
+		if broken {
+			return res, broken
+		}
+
 		return "Every invariant condition is fulfilled correctly", false
 	}
 }
@@ -165,3 +171,54 @@ func formatOutputApplicationLinks(links []types.ApplicationLink) (output string)
 	}
 	return output
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidDTagOffers checks that all DTag listings and bids refer to the current DTag of their owner
+func ValidDTagOffers(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidListings []types.DTagListing
+		k.IterateDTagListings(ctx, func(listing types.DTagListing) (stop bool) {
+			if !k.isCurrentDTag(ctx, listing.Owner, listing.DTag) {
+				invalidListings = append(invalidListings, listing)
+			}
+			return false
+		})
+
+		var invalidBids []types.DTagBid
+		k.IterateDTagBids(ctx, func(bid types.DTagBid) (stop bool) {
+			if !k.isCurrentDTag(ctx, bid.Owner, bid.DTag) || bid.Buyer == bid.Owner {
+				invalidBids = append(invalidBids, bid)
+			}
+			return false
+		})
+
+		broken := len(invalidListings) != 0 || len(invalidBids) != 0
+		return sdk.FormatInvariant(types.ModuleName, "invalid dtag offers",
+			formatOutputDTagOffers(invalidListings, invalidBids)), broken
+	}
+}
+
+// isCurrentDTag tells whether the given user has a profile with the given DTag
+func (k Keeper) isCurrentDTag(ctx sdk.Context, user string, dTag string) bool {
+	profile, found, err := k.GetProfile(ctx, user)
+	return err == nil && found && profile.DTag == dTag
+}
+
+// formatOutputDTagOffers prepares the given invalid DTag listings and bids to be displayed correctly
+func formatOutputDTagOffers(listings []types.DTagListing, bids []types.DTagBid) (output string) {
+	output = "The following list contains invalid DTag offers:\n"
+	for _, listing := range listings {
+		output += fmt.Sprintf(
+			"[Listing] [Owner]: %s, [DTag]: %s\n",
+			listing.Owner, listing.DTag,
+		)
+	}
+	for _, bid := range bids {
+		output += fmt.Sprintf(
+			"[Bid] [Owner]: %s, [Buyer]: %s, [DTag]: %s\n",
+			bid.Owner, bid.Buyer, bid.DTag,
+		)
+	}
+	return output
+}
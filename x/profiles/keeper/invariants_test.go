@@ -47,6 +47,10 @@ func (suite *KeeperTestSuite) TestKeeper_RegisterInvariants() {
 		runtime.FuncForPC(reflect.ValueOf(keeper.ValidApplicationLinks(suite.k)).Pointer()).Name(),
 		runtime.FuncForPC(reflect.ValueOf(mock.RegisteredMap[types.ModuleName+"valid-application-links"]).Pointer()).Name(),
 	)
+	suite.Require().Equal(
+		runtime.FuncForPC(reflect.ValueOf(keeper.ValidDTagOffers(suite.k)).Pointer()).Name(),
+		runtime.FuncForPC(reflect.ValueOf(mock.RegisteredMap[types.ModuleName+"valid-dtag-offers"]).Pointer()).Name(),
+	)
 }
 
 func (suite *KeeperTestSuite) TestInvariants() {
@@ -200,6 +204,30 @@ func (suite *KeeperTestSuite) TestInvariants() {
 				),
 			),
 		},
+		{
+			name: "ValidDTagOffers broken",
+			store: func(ctx sdk.Context) {
+				store := ctx.KVStore(suite.storeKey)
+
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")))
+
+				listing := types.NewDTagListing(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)
+				store.Set(types.DTagListingStoreKey(listing.Owner), suite.cdc.MustMarshal(&listing))
+			},
+			expBroken: true,
+			expResponse: sdk.FormatInvariant(types.ModuleName, "invalid dtag offers",
+				fmt.Sprintf("%s%s",
+					"The following list contains invalid DTag offers:\n",
+					"[Listing] [Owner]: cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47, [DTag]: dtag\n",
+				),
+			),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 
@@ -27,8 +28,10 @@ type Keeper struct {
 	paramSubspace paramstypes.Subspace
 	hooks         types.ProfilesHooks
 
-	ak authkeeper.AccountKeeper
-	rk types.RelationshipsKeeper
+	ak  authkeeper.AccountKeeper
+	rk  types.RelationshipsKeeper
+	bk  types.BankKeeper
+	cpk types.CommunityPoolKeeper
 
 	ChannelKeeper types.ChannelKeeper
 	PortKeeper    types.PortKeeper
@@ -51,6 +54,8 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	ak authkeeper.AccountKeeper,
 	rk types.RelationshipsKeeper,
+	bk types.BankKeeper,
+	cpk types.CommunityPoolKeeper,
 	channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper,
 	scopedKeeper types.ScopedKeeper,
@@ -62,6 +67,8 @@ func NewKeeper(
 		legacyAmino: legacyAmino,
 		ak:          ak,
 		rk:          rk,
+		bk:          bk,
+		cpk:         cpk,
 
 		ChannelKeeper: channelKeeper,
 		PortKeeper:    portKeeper,
@@ -117,8 +124,24 @@ func (k Keeper) storeProfileWithoutDTagCheck(ctx sdk.Context, profile *types.Pro
 		// Remove the previous DTag association (if the DTag has changed)
 		store.Delete(types.DTagStoreKey(oldProfile.DTag))
 
+		// Keep track of the previous DTag holder, unless only the DTag case has changed
+		if !strings.EqualFold(oldProfile.DTag, profile.DTag) {
+			k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+				oldProfile.DTag,
+				oldProfile.GetAddress().String(),
+				uint64(ctx.BlockHeight()),
+				ctx.BlockTime(),
+			))
+		}
+
 		// Remove all incoming DTag transfer requests if the DTag has changed since these will be invalid now
 		k.DeleteAllUserIncomingDTagTransferRequests(ctx, profile.GetAddress().String())
+
+		// Remove the DTag listing and refund all the incoming DTag bids since these will be invalid now
+		err = k.DeleteAllUserDTagOffers(ctx, profile.GetAddress().String())
+		if err != nil {
+			return err
+		}
 	}
 
 	// Store the DTag -> Address association
@@ -190,9 +213,23 @@ func (k Keeper) RemoveProfile(ctx sdk.Context, address string) error {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.DTagStoreKey(profile.DTag))
 
+	// Keep track of the released DTag
+	k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+		profile.DTag,
+		address,
+		uint64(ctx.BlockHeight()),
+		ctx.BlockTime(),
+	))
+
 	// Delete all DTag transfer requests made towards this account
 	k.DeleteAllUserIncomingDTagTransferRequests(ctx, address)
 
+	// Delete the DTag listing and refund all the DTag bids made towards this account
+	err = k.DeleteAllUserDTagOffers(ctx, address)
+	if err != nil {
+		return err
+	}
+
 	// Delete all chains links
 	k.DeleteAllUserChainLinks(ctx, address)
 
@@ -241,6 +278,11 @@ func (k Keeper) ValidateProfile(ctx sdk.Context, profile *types.Profile) error {
 		return fmt.Errorf("profile dtag cannot exceed %d characters", maxDTagLen)
 	}
 
+	err := k.ValidateReservedDTag(ctx, profile.DTag, profile.GetAddress().String())
+	if err != nil {
+		return err
+	}
+
 	maxBioLen := params.Bio.MaxLength.Int64()
 	if int64(len(profile.Bio)) > maxBioLen {
 		return fmt.Errorf("profile biography cannot exceed %d characters", maxBioLen)
@@ -46,6 +46,19 @@ func (k Keeper) SaveChainLink(ctx sdk.Context, link types.ChainLink) error {
 		return types.ErrDuplicatedChainLink
 	}
 
+	// Make sure the EIP-712 nonce has not been used before, so that the signature cannot be replayed
+	pubKey, nonce, isEIP712, err := link.Proof.GetEIP712Nonce(k.cdc)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidProof, err.Error())
+	}
+
+	if isEIP712 {
+		if k.IsEIP712NonceUsed(ctx, pubKey.Bytes(), nonce) {
+			return errors.Wrapf(types.ErrInvalidProof, "nonce %d has already been used", nonce)
+		}
+		k.SetEIP712NonceUsed(ctx, pubKey.Bytes(), nonce)
+	}
+
 	// Store the data
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.ChainLinksStoreKey(link.User, link.ChainConfig.Name, target), types.MustMarshalChainLink(k.cdc, link))
@@ -69,6 +82,18 @@ func (k Keeper) SaveChainLink(ctx sdk.Context, link types.ChainLink) error {
 	return nil
 }
 
+// SetEIP712NonceUsed stores the given EIP-712 nonce as already used by the provided public key
+func (k Keeper) SetEIP712NonceUsed(ctx sdk.Context, pubKey []byte, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UsedEIP712NonceStoreKey(pubKey, nonce), []byte{0x01})
+}
+
+// IsEIP712NonceUsed tells whether the given EIP-712 nonce has already been used by the provided public key
+func (k Keeper) IsEIP712NonceUsed(ctx sdk.Context, pubKey []byte, nonce uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.UsedEIP712NonceStoreKey(pubKey, nonce))
+}
+
 // HasChainLink tells whether the given chain link exists or not
 func (k Keeper) HasChainLink(ctx sdk.Context, owner, chainName, target string) bool {
 	store := ctx.KVStore(k.storeKey)
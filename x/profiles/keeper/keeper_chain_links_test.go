@@ -8,6 +8,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/desmos-labs/desmos/v7/testutil/profilestesting"
+	"github.com/desmos-labs/desmos/v7/types/crypto/ethsecp256k1"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 
@@ -21,6 +22,31 @@ import (
 func (suite *KeeperTestSuite) TestKeeper_SaveChainLink() {
 	// Generate source and destination key
 	ext := suite.GetRandomProfile()
+
+	// Generate an EIP-712 signed proof
+	evmPrivKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+
+	typedData := types.NewDesmosChainLinkTypedData(
+		types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 1),
+		"cosmos19xz3mrvzvp9ymgmudhpukucg6668l5haakh04x",
+		1,
+	)
+	eip712SigBz, err := evmPrivKey.Sign(typedData.GetSigningHash())
+	suite.Require().NoError(err)
+
+	eip712Link := types.NewChainLink(
+		"cosmos19xz3mrvzvp9ymgmudhpukucg6668l5haakh04x",
+		types.NewHexAddress("0x"+hex.EncodeToString(evmPrivKey.PubKey().Address()), "0x"),
+		types.NewProof(
+			evmPrivKey.PubKey(),
+			types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, eip712SigBz),
+			hex.EncodeToString(suite.cdc.MustMarshal(&typedData)),
+		),
+		types.NewChainConfig("ethereum"),
+		time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+	)
+
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
@@ -265,6 +291,35 @@ func (suite *KeeperTestSuite) TestKeeper_SaveChainLink() {
 				suite.Require().True(string(external) == "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
 			},
 		},
+		{
+			name: "already used EIP-712 nonce returns error",
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos19xz3mrvzvp9ymgmudhpukucg6668l5haakh04x")
+				suite.ak.SetAccount(ctx, profile)
+
+				suite.k.SetEIP712NonceUsed(ctx, evmPrivKey.PubKey().Bytes(), 1)
+			},
+			link:      eip712Link,
+			shouldErr: true,
+		},
+		{
+			name: "EIP-712 chain link is stored and its nonce is set as used",
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos19xz3mrvzvp9ymgmudhpukucg6668l5haakh04x")
+				suite.ak.SetAccount(ctx, profile)
+			},
+			link:      eip712Link,
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.HasChainLink(
+					ctx,
+					"cosmos19xz3mrvzvp9ymgmudhpukucg6668l5haakh04x",
+					"ethereum",
+					"0x"+hex.EncodeToString(evmPrivKey.PubKey().Address()),
+				))
+				suite.Require().True(suite.k.IsEIP712NonceUsed(ctx, evmPrivKey.PubKey().Bytes(), 1))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
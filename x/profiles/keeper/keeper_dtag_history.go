@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+// DTag history entries are stored using the following keys:
+// 1. DTagHistoryStoreKey (DTag + height + address)       -> types.DTagHistoryEntry
+// 2. UserDTagHistoryStoreKey (address + height + DTag)   -> DTagHistoryStoreKey
+
+// SaveDTagHistoryEntry stores the given DTag history entry
+func (k Keeper) SaveDTagHistoryEntry(ctx sdk.Context, entry types.DTagHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.DTagHistoryStoreKey(entry.DTag, entry.Height, entry.Address)
+	store.Set(key, k.cdc.MustMarshal(&entry))
+	store.Set(types.UserDTagHistoryStoreKey(entry.Address, entry.Height, entry.DTag), key)
+
+	k.Logger(ctx).Debug("saved DTag history entry", "DTag", entry.DTag, "address", entry.Address)
+}
+
+// GetDTagHistory returns all the history entries of the given DTag, sorted by the height at which
+// each past holder released it
+func (k Keeper) GetDTagHistory(ctx sdk.Context, dTag string) []types.DTagHistoryEntry {
+	var entries []types.DTagHistoryEntry
+	k.IterateDTagHistory(ctx, dTag, func(entry types.DTagHistoryEntry) (stop bool) {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
+
+// GetUserDTagHistory returns all the history entries of the DTags held by the given user, sorted by the height
+// at which the user released each one of them
+func (k Keeper) GetUserDTagHistory(ctx sdk.Context, user string) []types.DTagHistoryEntry {
+	var entries []types.DTagHistoryEntry
+	k.IterateUserDTagHistory(ctx, user, func(entry types.DTagHistoryEntry) (stop bool) {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
+
+// GetDTagHistoryEntries returns all the stored DTag history entries
+func (k Keeper) GetDTagHistoryEntries(ctx sdk.Context) []types.DTagHistoryEntry {
+	var entries []types.DTagHistoryEntry
+	k.IterateDTagHistoryEntries(ctx, func(entry types.DTagHistoryEntry) (stop bool) {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
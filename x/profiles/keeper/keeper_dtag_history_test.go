@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveDTagHistoryEntry() {
+	testCases := []struct {
+		name  string
+		store func(ctx sdk.Context)
+		entry types.DTagHistoryEntry
+		check func(ctx sdk.Context)
+	}{
+		{
+			name: "new entry is saved properly",
+			entry: types.NewDTagHistoryEntry(
+				"DTag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				10,
+				time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			check: func(ctx sdk.Context) {
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().True(store.Has(types.DTagHistoryStoreKey(
+					"dtag",
+					10,
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				)))
+				suite.Require().Equal(
+					types.DTagHistoryStoreKey("DTag", 10, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+					store.Get(types.UserDTagHistoryStoreKey("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", 10, "DTag")),
+				)
+			},
+		},
+		{
+			name: "entries of the same DTag are kept separately",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				))
+			},
+			entry: types.NewDTagHistoryEntry(
+				"dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				20,
+				time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+			),
+			check: func(ctx sdk.Context) {
+				suite.Require().Equal([]types.DTagHistoryEntry{
+					types.NewDTagHistoryEntry(
+						"dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						10,
+						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+					types.NewDTagHistoryEntry(
+						"dtag",
+						"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+						20,
+						time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+					),
+				}, suite.k.GetDTagHistory(ctx, "dtag"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SaveDTagHistoryEntry(ctx, tc.entry)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetDTagHistory() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		dTag       string
+		expEntries []types.DTagHistoryEntry
+	}{
+		{
+			name:       "empty history returns nil",
+			dTag:       "dtag",
+			expEntries: nil,
+		},
+		{
+			name: "history is returned regardless of the DTag case and sorted by height",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"DTag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					20,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				))
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				))
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"dtag_other",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					30,
+					time.Date(2020, 1, 3, 00, 00, 00, 000, time.UTC),
+				))
+			},
+			dTag: "DTAG",
+			expEntries: []types.DTagHistoryEntry{
+				types.NewDTagHistoryEntry(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+				types.NewDTagHistoryEntry(
+					"DTag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					20,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.Require().Equal(tc.expEntries, suite.k.GetDTagHistory(ctx, tc.dTag))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetUserDTagHistory() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		user       string
+		expEntries []types.DTagHistoryEntry
+	}{
+		{
+			name:       "empty history returns nil",
+			user:       "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			expEntries: nil,
+		},
+		{
+			name: "user history is returned sorted by height",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"second_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					20,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				))
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"first_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				))
+				suite.k.SaveDTagHistoryEntry(ctx, types.NewDTagHistoryEntry(
+					"first_dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					30,
+					time.Date(2020, 1, 3, 00, 00, 00, 000, time.UTC),
+				))
+			},
+			user: "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			expEntries: []types.DTagHistoryEntry{
+				types.NewDTagHistoryEntry(
+					"first_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					10,
+					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				),
+				types.NewDTagHistoryEntry(
+					"second_dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					20,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.Require().Equal(tc.expEntries, suite.k.GetUserDTagHistory(ctx, tc.user))
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+// DTag offers are stored using the following keys:
+// 1. DTagListingStoreKey (owner)                                   -> types.DTagListing
+// 2. DTagListingExpiringTimeKey (expiration time + owner)          -> owner
+// 3. DTagBidStoreKey (owner + buyer)                               -> types.DTagBid
+// 4. DTagBidExpiringTimeKey (expiration time + DTagBidStoreKey)    -> DTagBidStoreKey
+//
+// The amount of each bid is held by the module account until the bid is either accepted, canceled or expired
+
+// SaveDTagListing stores the given listing replacing any existing one for the same owner.
+// It requires that the listing owner has already a profile.
+func (k Keeper) SaveDTagListing(ctx sdk.Context, listing types.DTagListing) error {
+	if !k.HasProfile(ctx, listing.Owner) {
+		return errors.Wrap(types.ErrProfileNotFound, "listing owner does not have a profile")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// Remove the expiration reference of the previous listing, if any
+	oldListing, found, err := k.GetDTagListing(ctx, listing.Owner)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		store.Delete(types.DTagListingExpiringTimeKey(oldListing.ExpirationTime, oldListing.Owner))
+	}
+
+	store.Set(types.DTagListingStoreKey(listing.Owner), k.cdc.MustMarshal(&listing))
+	store.Set(types.DTagListingExpiringTimeKey(listing.ExpirationTime, listing.Owner), []byte(listing.Owner))
+
+	k.Logger(ctx).Info("DTag listing", "owner", listing.Owner, "dtag", listing.DTag, "price", listing.Price)
+
+	return nil
+}
+
+// HasDTagListing tells whether the given owner has put their DTag on sale
+func (k Keeper) HasDTagListing(ctx sdk.Context, owner string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.DTagListingStoreKey(owner))
+}
+
+// GetDTagListing returns the DTag listing of the given owner.
+// If the listing was not found, returns false instead.
+func (k Keeper) GetDTagListing(ctx sdk.Context, owner string) (types.DTagListing, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DTagListingStoreKey(owner)
+	if !store.Has(key) {
+		return types.DTagListing{}, false, nil
+	}
+
+	var listing types.DTagListing
+	err := k.cdc.Unmarshal(store.Get(key), &listing)
+	if err != nil {
+		return types.DTagListing{}, false, err
+	}
+
+	return listing, true, nil
+}
+
+// GetDTagListings returns all the DTag listings inside the given context
+func (k Keeper) GetDTagListings(ctx sdk.Context) []types.DTagListing {
+	var listings []types.DTagListing
+	k.IterateDTagListings(ctx, func(listing types.DTagListing) (stop bool) {
+		listings = append(listings, listing)
+		return false
+	})
+	return listings
+}
+
+// DeleteDTagListing deletes the DTag listing of the given owner, if any
+func (k Keeper) DeleteDTagListing(ctx sdk.Context, owner string) {
+	listing, found, err := k.GetDTagListing(ctx, owner)
+	if err != nil || !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DTagListingStoreKey(listing.Owner))
+	store.Delete(types.DTagListingExpiringTimeKey(listing.ExpirationTime, listing.Owner))
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveDTagBid stores the given bid.
+// It returns an error if a bid from the same buyer towards the same owner already exists.
+// It requires that the owner has already a profile.
+// NOTE: This does not escrow the bid amount, which must be done separately using EscrowDTagBid
+func (k Keeper) SaveDTagBid(ctx sdk.Context, bid types.DTagBid) error {
+	if !k.HasProfile(ctx, bid.Owner) {
+		return errors.Wrap(types.ErrProfileNotFound, "bid owner does not have a profile")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.DTagBidStoreKey(bid.Owner, bid.Buyer)
+	if store.Has(key) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest,
+			"the bid from %s to %s has already been placed", bid.Buyer, bid.Owner)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&bid))
+	store.Set(types.DTagBidExpiringTimeKey(bid.ExpirationTime, bid.Owner, bid.Buyer), key)
+
+	k.Logger(ctx).Info("DTag bid", "buyer", bid.Buyer, "owner", bid.Owner, "price", bid.Price)
+
+	return nil
+}
+
+// HasDTagBid tells whether a DTag bid from the given buyer towards the given owner exists or not
+func (k Keeper) HasDTagBid(ctx sdk.Context, owner, buyer string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.DTagBidStoreKey(owner, buyer))
+}
+
+// GetDTagBid returns the DTag bid made from the given buyer towards the given owner.
+// If the bid was not found, returns false instead.
+func (k Keeper) GetDTagBid(ctx sdk.Context, owner, buyer string) (types.DTagBid, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DTagBidStoreKey(owner, buyer)
+	if !store.Has(key) {
+		return types.DTagBid{}, false, nil
+	}
+
+	var bid types.DTagBid
+	err := k.cdc.Unmarshal(store.Get(key), &bid)
+	if err != nil {
+		return types.DTagBid{}, false, err
+	}
+
+	return bid, true, nil
+}
+
+// GetDTagBids returns all the DTag bids inside the given context
+func (k Keeper) GetDTagBids(ctx sdk.Context) []types.DTagBid {
+	var bids []types.DTagBid
+	k.IterateDTagBids(ctx, func(bid types.DTagBid) (stop bool) {
+		bids = append(bids, bid)
+		return false
+	})
+	return bids
+}
+
+// DeleteDTagBid deletes the given DTag bid without refunding the escrowed amount
+func (k Keeper) DeleteDTagBid(ctx sdk.Context, bid types.DTagBid) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DTagBidStoreKey(bid.Owner, bid.Buyer))
+	store.Delete(types.DTagBidExpiringTimeKey(bid.ExpirationTime, bid.Owner, bid.Buyer))
+}
+
+// EscrowDTagBid moves the amount of the given bid from the buyer to the module account
+func (k Keeper) EscrowDTagBid(ctx sdk.Context, bid types.DTagBid) error {
+	buyer, err := sdk.AccAddressFromBech32(bid.Buyer)
+	if err != nil {
+		return err
+	}
+
+	return k.bk.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, sdk.NewCoins(bid.Price))
+}
+
+// RefundDTagBid deletes the given DTag bid and sends the escrowed amount back to the buyer
+func (k Keeper) RefundDTagBid(ctx sdk.Context, bid types.DTagBid) error {
+	buyer, err := sdk.AccAddressFromBech32(bid.Buyer)
+	if err != nil {
+		return err
+	}
+
+	err = k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, sdk.NewCoins(bid.Price))
+	if err != nil {
+		return err
+	}
+
+	k.DeleteDTagBid(ctx, bid)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundedDTagBid,
+			sdk.NewAttribute(types.AttributeKeyDTagToTrade, bid.DTag),
+			sdk.NewAttribute(types.AttributeKeyDTagOwner, bid.Owner),
+			sdk.NewAttribute(types.AttributeKeyDTagBuyer, bid.Buyer),
+			sdk.NewAttribute(types.AttributeKeyDTagPrice, bid.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyDTagExpirationTime, bid.ExpirationTime.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+// DeleteAllUserDTagOffers deletes the DTag listing of the given user and refunds all the DTag bids
+// that have been made towards them
+func (k Keeper) DeleteAllUserDTagOffers(ctx sdk.Context, user string) error {
+	k.DeleteDTagListing(ctx, user)
+
+	var bids []types.DTagBid
+	k.IterateUserIncomingDTagBids(ctx, user, func(bid types.DTagBid) (stop bool) {
+		bids = append(bids, bid)
+		return false
+	})
+
+	for _, bid := range bids {
+		err := k.RefundDTagBid(ctx, bid)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
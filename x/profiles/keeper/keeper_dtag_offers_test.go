@@ -0,0 +1,503 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/golang/mock/gomock"
+
+	"github.com/desmos-labs/desmos/v7/testutil/profilestesting"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveDTagListing() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		listing   types.DTagListing
+		shouldErr bool
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "listing of user without profile returns error",
+			listing: types.NewDTagListing(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "new listing is saved properly",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+			},
+			listing: types.NewDTagListing(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				listing, found, err := suite.k.GetDTagListing(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				), listing)
+
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().True(store.Has(types.DTagListingExpiringTimeKey(
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				)))
+			},
+		},
+		{
+			name: "existing listing is replaced properly",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			listing: types.NewDTagListing(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(200)),
+				"other_dtag",
+				time.Date(2031, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				suite.Require().Equal([]types.DTagListing{
+					types.NewDTagListing(
+						"dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						sdk.NewCoin("udsm", sdk.NewInt(200)),
+						"other_dtag",
+						time.Date(2031, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				}, suite.k.GetDTagListings(ctx))
+
+				// Make sure the old expiration reference has been removed
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().False(store.Has(types.DTagListingExpiringTimeKey(
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				)))
+				suite.Require().True(store.Has(types.DTagListingExpiringTimeKey(
+					time.Date(2031, 1, 1, 00, 00, 00, 000, time.UTC),
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.SaveDTagListing(ctx, tc.listing)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteDTagListing() {
+	testCases := []struct {
+		name  string
+		store func(ctx sdk.Context)
+		owner string
+		check func(ctx sdk.Context)
+	}{
+		{
+			name:  "non existing listing does not panic",
+			owner: "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasDTagListing(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"))
+			},
+		},
+		{
+			name: "existing listing is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			owner: "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasDTagListing(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"))
+
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().False(store.Has(types.DTagListingExpiringTimeKey(
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeleteDTagListing(ctx, tc.owner)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SaveDTagBid() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		bid       types.DTagBid
+		shouldErr bool
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "bid towards user without profile returns error",
+			bid: types.NewDTagBid(
+				"dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "already existing bid returns error",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			bid: types.NewDTagBid(
+				"dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(200)),
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "bid is saved properly",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			bid: types.NewDTagBid(
+				"dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(200)),
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				bid, found, err := suite.k.GetDTagBid(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(200)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				), bid)
+
+				suite.Require().Len(suite.k.GetDTagBids(ctx), 2)
+
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().True(store.Has(types.DTagBidExpiringTimeKey(
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.SaveDTagBid(ctx, tc.bid)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RefundDTagBid() {
+	testCases := []struct {
+		name      string
+		setup     func()
+		store     func(ctx sdk.Context)
+		bid       types.DTagBid
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "failed refund returns error and keeps the bid",
+			setup: func() {
+				suite.bk.EXPECT().
+					SendCoinsFromModuleToAccount(
+						gomock.Any(),
+						types.ModuleName,
+						sdk.MustAccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+						sdk.NewCoins(sdk.NewCoin("udsm", sdk.NewInt(100))),
+					).
+					Return(sdkerrors.ErrInsufficientFunds)
+			},
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			bid: types.NewDTagBid(
+				"dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: true,
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.HasDTagBid(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"))
+			},
+		},
+		{
+			name: "bid is refunded and deleted properly",
+			setup: func() {
+				suite.bk.EXPECT().
+					SendCoinsFromModuleToAccount(
+						gomock.Any(),
+						types.ModuleName,
+						sdk.MustAccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+						sdk.NewCoins(sdk.NewCoin("udsm", sdk.NewInt(100))),
+					).
+					Return(nil)
+			},
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			bid: types.NewDTagBid(
+				"dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRefundedDTagBid,
+					sdk.NewAttribute(types.AttributeKeyDTagToTrade, "dtag"),
+					sdk.NewAttribute(types.AttributeKeyDTagOwner, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+					sdk.NewAttribute(types.AttributeKeyDTagBuyer, "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+					sdk.NewAttribute(types.AttributeKeyDTagPrice, "100udsm"),
+					sdk.NewAttribute(types.AttributeKeyDTagExpirationTime, "2030-01-01T00:00:00Z"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasDTagBid(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"))
+
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().False(store.Has(types.DTagBidExpiringTimeKey(
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.RefundDTagBid(ctx, tc.bid)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+			}
+
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteAllUserDTagOffers() {
+	testCases := []struct {
+		name      string
+		setup     func()
+		store     func(ctx sdk.Context)
+		user      string
+		shouldErr bool
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "listing and incoming bids are removed properly",
+			setup: func() {
+				suite.bk.EXPECT().
+					SendCoinsFromModuleToAccount(
+						gomock.Any(),
+						types.ModuleName,
+						sdk.MustAccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+						sdk.NewCoins(sdk.NewCoin("udsm", sdk.NewInt(100))),
+					).
+					Return(nil)
+			},
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")))
+
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+
+				// Bid towards another user that should not be touched
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"other_dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			user:      "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				suite.Require().Empty(suite.k.GetDTagListings(ctx))
+				suite.Require().Equal([]types.DTagBid{
+					types.NewDTagBid(
+						"other_dtag",
+						"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+						"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+						sdk.NewCoin("udsm", sdk.NewInt(100)),
+						time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				}, suite.k.GetDTagBids(ctx))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.DeleteAllUserDTagOffers(ctx, tc.user)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
@@ -91,3 +91,84 @@ func (k Keeper) DeleteAllUserIncomingDTagTransferRequests(ctx sdk.Context, recei
 		k.DeleteDTagTransferRequest(ctx, request.Sender, request.Receiver)
 	}
 }
+
+// TransferDTag transfers the given DTag from the owner to the receiver, assigning the new DTag to the owner.
+// If the receiver does not have a profile, a new one will be created for them.
+// If the new DTag is a reserved one, the owner is charged the fee required to claim it.
+// It returns an error if the owner's DTag is different from the given one, or if any of the
+// resulting profiles is not valid.
+func (k Keeper) TransferDTag(ctx sdk.Context, dTag string, owner string, receiver string, newDTag string) error {
+	// Get the current owner profile
+	ownerProfile, exist, err := k.GetProfile(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "profile of %s doesn't exist", owner)
+	}
+
+	// Make sure the DTag to trade is correct
+	if ownerProfile.DTag != dTag {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "the owner's DTag is different from the one to be exchanged")
+	}
+
+	// Change the DTag and validate the profile
+	ownerProfile.DTag = newDTag
+	err = k.ValidateProfile(ctx, ownerProfile)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Charge the owner the fee required to claim the new DTag, if it's a reserved one
+	err = k.ChargeReservedDTagFee(ctx, newDTag, owner)
+	if err != nil {
+		return err
+	}
+
+	// Check for an existent profile of the receiving user
+	receiverProfile, exist, err := k.GetProfile(ctx, receiver)
+	if err != nil {
+		return err
+	}
+
+	if exist && newDTag == receiverProfile.DTag {
+		err = k.storeProfileWithoutDTagCheck(ctx, ownerProfile)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = k.SaveProfile(ctx, ownerProfile)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !exist {
+		add, err := sdk.AccAddressFromBech32(receiver)
+		if err != nil {
+			return err
+		}
+
+		receiverAcc := k.ak.GetAccount(ctx, add)
+		if receiverAcc == nil {
+			receiverAcc = k.ak.NewAccountWithAddress(ctx, add)
+		}
+
+		receiverProfile, err = types.NewProfileFromAccount(dTag, receiverAcc, ctx.BlockTime())
+		if err != nil {
+			return err
+		}
+	} else {
+		receiverProfile.DTag = dTag
+	}
+
+	// Validate the receiver profile
+	err = k.ValidateProfile(ctx, receiverProfile)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Save the receiver profile
+	return k.SaveProfile(ctx, receiverProfile)
+}
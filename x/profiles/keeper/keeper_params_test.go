@@ -20,6 +20,7 @@ func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 			sdk.NewCoin("band", sdk.NewInt(10)),
 		),
 		types.NewAppLinksParams(types.DefaultAppLinksValidityDuration),
+		types.DefaultReservedDTagsParams(),
 	)
 	suite.k.SetParams(suite.ctx, params)
 
@@ -50,6 +51,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 						sdk.NewCoin("band", sdk.NewInt(10)),
 					),
 					types.NewAppLinksParams(types.DefaultAppLinksValidityDuration),
+					types.DefaultReservedDTagsParams(),
 				)
 				suite.k.SetParams(ctx, params)
 			},
@@ -67,6 +69,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 					sdk.NewCoin("band", sdk.NewInt(10)),
 				),
 				types.NewAppLinksParams(types.DefaultAppLinksValidityDuration),
+				types.DefaultReservedDTagsParams(),
 			),
 		},
 		{
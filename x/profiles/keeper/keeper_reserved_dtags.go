@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateReservedDTag checks whether the given user is allowed to own the given DTag based on the reserved DTags params.
+// Users that already own the DTag are always allowed to keep it, even if it has been reserved afterwards.
+// Otherwise, reserved DTags having a designated address can only be owned by that address, while reserved
+// DTags without a designated address can only be owned if there is a fee that can be paid to claim them.
+func (k Keeper) ValidateReservedDTag(ctx sdk.Context, dTag string, user string) error {
+	if k.GetAddressFromDTag(ctx, dTag) == user {
+		return nil
+	}
+
+	params := k.GetParams(ctx).ReservedDTags
+	entry, reserved := params.GetMatchingEntry(dTag)
+	if !reserved {
+		return nil
+	}
+
+	if entry.DesignatedAddress != "" {
+		if entry.DesignatedAddress != user {
+			return fmt.Errorf("dtag %s is reserved", dTag)
+		}
+		return nil
+	}
+
+	if _, found := params.GetFee(len(dTag)); !found {
+		return fmt.Errorf("dtag %s is reserved and cannot be claimed", dTag)
+	}
+
+	return nil
+}
+
+// ChargeReservedDTagFee charges the given user the fee required to claim the given DTag, sending it to the
+// community pool. No fee is charged if the DTag is already owned by someone, if it is not reserved or if it has
+// a designated address.
+// It returns an error if the DTag cannot be claimed or if the user does not have enough funds to pay the fee.
+func (k Keeper) ChargeReservedDTagFee(ctx sdk.Context, dTag string, user string) error {
+	if k.GetAddressFromDTag(ctx, dTag) != "" {
+		return nil
+	}
+
+	params := k.GetParams(ctx).ReservedDTags
+	entry, reserved := params.GetMatchingEntry(dTag)
+	if !reserved || entry.DesignatedAddress != "" {
+		return nil
+	}
+
+	fee, found := params.GetFee(len(dTag))
+	if !found {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "dtag %s is reserved and cannot be claimed", dTag)
+	}
+
+	if fee.IsZero() {
+		return nil
+	}
+
+	userAddr, err := sdk.AccAddressFromBech32(user)
+	if err != nil {
+		return err
+	}
+
+	err = k.cpk.FundCommunityPool(ctx, fee, userAddr)
+	if err != nil {
+		return errors.Wrapf(err, "cannot pay the fee to claim the reserved dtag %s", dTag)
+	}
+
+	return nil
+}
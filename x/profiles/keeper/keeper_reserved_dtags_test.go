@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/golang/mock/gomock"
+
+	"github.com/desmos-labs/desmos/v7/testutil/profilestesting"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+func (suite *KeeperTestSuite) reservedDTagsParams() types.Params {
+	params := types.DefaultParams()
+	params.ReservedDTags = types.NewReservedDTagsParams([]types.ReservedDTag{
+		types.NewReservedDTag("desmos", false, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+		types.NewReservedDTag("^[A-Za-z0-9_]{3,4}$", true, ""),
+		types.NewReservedDTag("^premium_", true, ""),
+	}, []types.ReservedDTagFee{
+		types.NewReservedDTagFee(3, sdk.NewCoins(sdk.NewInt64Coin("udsm", 1000))),
+		types.NewReservedDTagFee(4, sdk.NewCoins(sdk.NewInt64Coin("udsm", 100))),
+	})
+	return params
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ValidateReservedDTag() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		dTag      string
+		user      string
+		shouldErr bool
+	}{
+		{
+			name: "not reserved dTag returns no error",
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "desmos_user",
+			user:      "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			shouldErr: false,
+		},
+		{
+			name: "reserved dTag with designated address returns error for other users",
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "Desmos",
+			user:      "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			shouldErr: true,
+		},
+		{
+			name: "reserved dTag with designated address returns no error for the designated address",
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "desmos",
+			user:      "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			shouldErr: false,
+		},
+		{
+			name: "reserved dTag without an applicable fee returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "premium_dtag",
+			user:      "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			shouldErr: true,
+		},
+		{
+			name: "reserved dTag with an applicable fee returns no error",
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "abc",
+			user:      "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			shouldErr: false,
+		},
+		{
+			name: "reserved dTag already owned by the user returns no error",
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773")
+				profile.DTag = "premium_dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "premium_dtag",
+			user:      "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.ValidateReservedDTag(ctx, tc.dTag, tc.user)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ChargeReservedDTagFee() {
+	testCases := []struct {
+		name      string
+		setup     func()
+		store     func(ctx sdk.Context)
+		dTag      string
+		user      string
+		shouldErr bool
+	}{
+		{
+			name: "not reserved dTag is not charged",
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "desmos_user",
+			user:      "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			shouldErr: false,
+		},
+		{
+			name: "reserved dTag with designated address is not charged",
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "desmos",
+			user:      "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			shouldErr: false,
+		},
+		{
+			name: "already owned reserved dTag is not charged",
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				profile.DTag = "abc"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "abc",
+			user:      "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			shouldErr: false,
+		},
+		{
+			name: "reserved dTag without an applicable fee returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "premium_dtag",
+			user:      "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			shouldErr: true,
+		},
+		{
+			name: "community pool error is returned",
+			setup: func() {
+				suite.cpk.EXPECT().
+					FundCommunityPool(
+						gomock.Any(),
+						sdk.NewCoins(sdk.NewInt64Coin("udsm", 1000)),
+						sdk.MustAccAddressFromBech32("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"),
+					).
+					Return(sdkerrors.ErrInsufficientFunds)
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "abc",
+			user:      "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			shouldErr: true,
+		},
+		{
+			name: "length based fee is charged properly",
+			setup: func() {
+				suite.cpk.EXPECT().
+					FundCommunityPool(
+						gomock.Any(),
+						sdk.NewCoins(sdk.NewInt64Coin("udsm", 100)),
+						sdk.MustAccAddressFromBech32("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"),
+					).
+					Return(nil)
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			dTag:      "abcd",
+			user:      "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.ChargeReservedDTagFee(ctx, tc.dTag, tc.user)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
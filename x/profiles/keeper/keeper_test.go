@@ -67,6 +67,36 @@ func (suite *KeeperTestSuite) TestKeeper_SaveProfile() {
 
 				// Verify the DTag transfer requests have been deleted
 				suite.Require().Empty(suite.k.GetDTagTransferRequests(ctx))
+
+				// Verify the old DTag has been tracked
+				suite.Require().Equal([]types.DTagHistoryEntry{
+					types.NewDTagHistoryEntry(
+						"Old DTag",
+						"cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+						uint64(ctx.BlockHeight()),
+						ctx.BlockTime(),
+					),
+				}, suite.k.GetDTagHistory(ctx, "old dtag"))
+			},
+		},
+		{
+			name: "DTag case change is not tracked",
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773")
+				profile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+			},
+			profile: suite.CheckProfileNoError(types.NewProfile(
+				"DTag",
+				"",
+				"",
+				types.NewPictures("", ""),
+				time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				profilestesting.AccountFromAddr("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773")),
+			),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				suite.Require().Empty(suite.k.GetDTagHistoryEntries(ctx))
 			},
 		},
 	}
@@ -306,6 +336,15 @@ func (suite *KeeperTestSuite) TestKeeper_RemoveProfile() {
 				suite.Require().False(
 					store.Has(types.UserApplicationLinkKey("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773", "twitter", "twitteruser")),
 				)
+
+				suite.Require().Equal([]types.DTagHistoryEntry{
+					types.NewDTagHistoryEntry(
+						"cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773-dtag",
+						"cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+						uint64(ctx.BlockHeight()),
+						ctx.BlockTime(),
+					),
+				}, suite.k.GetUserDTagHistory(ctx, "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"))
 			},
 		},
 	}
@@ -0,0 +1,273 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+// ListDTag defines a rpc method for MsgListDTag
+func (k MsgServer) ListDTag(goCtx context.Context, msg *types.MsgListDTag) (*types.MsgListDTagResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	profile, found, err := k.GetProfile(ctx, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errors.Wrap(types.ErrProfileNotFound, "you need a profile in order to list your DTag")
+	}
+
+	if !msg.ExpirationTime.After(ctx.BlockTime()) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "the expiration time must be in the future")
+	}
+
+	listing := types.NewDTagListing(profile.DTag, msg.Owner, msg.Price, msg.NewDTag, msg.ExpirationTime)
+	err = listing.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Make sure the new DTag is valid so that the listing can actually be bought
+	profile.DTag = msg.NewDTag
+	err = k.ValidateProfile(ctx, profile)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	err = k.SaveDTagListing(ctx, listing)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeListedDTag,
+			sdk.NewAttribute(types.AttributeKeyDTagToTrade, listing.DTag),
+			sdk.NewAttribute(types.AttributeKeyDTagOwner, listing.Owner),
+			sdk.NewAttribute(types.AttributeKeyDTagPrice, listing.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyDTagExpirationTime, listing.ExpirationTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgListDTagResponse{}, nil
+}
+
+// CancelDTagListing defines a rpc method for MsgCancelDTagListing
+func (k MsgServer) CancelDTagListing(goCtx context.Context, msg *types.MsgCancelDTagListing) (*types.MsgCancelDTagListingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the listing exists
+	if !k.HasDTagListing(ctx, msg.Owner) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "DTag listing of %s not found", msg.Owner)
+	}
+
+	// Delete the listing
+	k.DeleteDTagListing(ctx, msg.Owner)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCanceledDTagListing,
+			sdk.NewAttribute(types.AttributeKeyDTagOwner, msg.Owner),
+		),
+	})
+
+	return &types.MsgCancelDTagListingResponse{}, nil
+}
+
+// BuyDTag defines a rpc method for MsgBuyDTag
+func (k MsgServer) BuyDTag(goCtx context.Context, msg *types.MsgBuyDTag) (*types.MsgBuyDTagResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the owner has blocked the buyer
+	if k.IsUserBlocked(ctx, msg.Owner, msg.Buyer) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "the user with address %s has blocked you", msg.Owner)
+	}
+
+	listing, found, err := k.GetDTagListing(ctx, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "DTag listing of %s not found", msg.Owner)
+	}
+
+	// Make sure the buyer is paying the requested price
+	if !msg.Price.IsEqual(listing.Price) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest,
+			"the price %s is different from the listing price %s", msg.Price, listing.Price)
+	}
+
+	// Pay the owner
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.bk.SendCoins(ctx, buyer, owner, sdk.NewCoins(listing.Price))
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete the listing and transfer the DTag to the buyer
+	k.DeleteDTagListing(ctx, msg.Owner)
+
+	err = k.TransferDTag(ctx, listing.DTag, msg.Owner, msg.Buyer, listing.NewDTag)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBoughtDTag,
+			sdk.NewAttribute(types.AttributeKeyDTagToTrade, listing.DTag),
+			sdk.NewAttribute(types.AttributeKeyNewDTag, listing.NewDTag),
+			sdk.NewAttribute(types.AttributeKeyDTagOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyDTagBuyer, msg.Buyer),
+			sdk.NewAttribute(types.AttributeKeyDTagPrice, listing.Price.String()),
+		),
+	})
+
+	return &types.MsgBuyDTagResponse{}, nil
+}
+
+// PlaceDTagBid defines a rpc method for MsgPlaceDTagBid
+func (k MsgServer) PlaceDTagBid(goCtx context.Context, msg *types.MsgPlaceDTagBid) (*types.MsgPlaceDTagBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the owner has blocked the buyer
+	if k.IsUserBlocked(ctx, msg.Owner, msg.Buyer) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "the user with address %s has blocked you", msg.Owner)
+	}
+
+	profile, found, err := k.GetProfile(ctx, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "the bid owner does not have a profile")
+	}
+
+	if !msg.ExpirationTime.After(ctx.BlockTime()) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "the expiration time must be in the future")
+	}
+
+	bid := types.NewDTagBid(profile.DTag, msg.Buyer, msg.Owner, msg.Price, msg.ExpirationTime)
+	err = bid.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	err = k.SaveDTagBid(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Escrow the bid amount
+	err = k.EscrowDTagBid(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePlacedDTagBid,
+			sdk.NewAttribute(types.AttributeKeyDTagToTrade, bid.DTag),
+			sdk.NewAttribute(types.AttributeKeyDTagOwner, bid.Owner),
+			sdk.NewAttribute(types.AttributeKeyDTagBuyer, bid.Buyer),
+			sdk.NewAttribute(types.AttributeKeyDTagPrice, bid.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyDTagExpirationTime, bid.ExpirationTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgPlaceDTagBidResponse{}, nil
+}
+
+// CancelDTagBid defines a rpc method for MsgCancelDTagBid
+func (k MsgServer) CancelDTagBid(goCtx context.Context, msg *types.MsgCancelDTagBid) (*types.MsgCancelDTagBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bid, found, err := k.GetDTagBid(ctx, msg.Owner, msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "bid from %s to %s not found", msg.Buyer, msg.Owner)
+	}
+
+	// Refund the bid
+	err = k.RefundDTagBid(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCanceledDTagBid,
+			sdk.NewAttribute(types.AttributeKeyDTagOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyDTagBuyer, msg.Buyer),
+		),
+	})
+
+	return &types.MsgCancelDTagBidResponse{}, nil
+}
+
+// AcceptDTagBid defines a rpc method for MsgAcceptDTagBid
+func (k MsgServer) AcceptDTagBid(goCtx context.Context, msg *types.MsgAcceptDTagBid) (*types.MsgAcceptDTagBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bid, found, err := k.GetDTagBid(ctx, msg.Owner, msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "bid from %s to %s not found", msg.Buyer, msg.Owner)
+	}
+
+	// Delete the bid and pay the owner with the escrowed amount
+	k.DeleteDTagBid(ctx, bid)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(bid.Price))
+	if err != nil {
+		return nil, err
+	}
+
+	// Transfer the DTag to the buyer
+	err = k.TransferDTag(ctx, bid.DTag, msg.Owner, msg.Buyer, msg.NewDTag)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptedDTagBid,
+			sdk.NewAttribute(types.AttributeKeyDTagToTrade, bid.DTag),
+			sdk.NewAttribute(types.AttributeKeyNewDTag, msg.NewDTag),
+			sdk.NewAttribute(types.AttributeKeyDTagOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyDTagBuyer, msg.Buyer),
+			sdk.NewAttribute(types.AttributeKeyDTagPrice, bid.Price.String()),
+		),
+	})
+
+	return &types.MsgAcceptDTagBidResponse{}, nil
+}
@@ -0,0 +1,716 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/desmos-labs/desmos/v7/testutil/profilestesting"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/keeper"
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgServer_ListDTag() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgListDTag
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "owner without profile returns error",
+			msg: types.NewMsgListDTag(
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "past expiration time returns error",
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				profile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+			},
+			msg: types.NewMsgListDTag(
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Date(2019, 1, 1, 00, 00, 00, 000, time.UTC),
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid new DTag returns error",
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				profile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+			},
+			msg: types.NewMsgListDTag(
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new-dtag",
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "DTag is listed properly",
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				profile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+			},
+			msg: types.NewMsgListDTag(
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeListedDTag,
+					sdk.NewAttribute(types.AttributeKeyDTagToTrade, "dtag"),
+					sdk.NewAttribute(types.AttributeKeyDTagOwner, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+					sdk.NewAttribute(types.AttributeKeyDTagPrice, "100udsm"),
+					sdk.NewAttribute(types.AttributeKeyDTagExpirationTime, "2030-01-01T00:00:00Z"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().Equal([]types.DTagListing{
+					types.NewDTagListing(
+						"dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						sdk.NewCoin("udsm", sdk.NewInt(100)),
+						"new_dtag",
+						time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				}, suite.k.GetDTagListings(ctx))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC))
+			suite.k.SetParams(ctx, types.DefaultParams())
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.k)
+			_, err := server.ListDTag(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_CancelDTagListing() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgCancelDTagListing
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "listing not found returns error",
+			msg:       types.NewMsgCancelDTagListing("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+			shouldErr: true,
+		},
+		{
+			name: "listing is canceled properly",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			msg:       types.NewMsgCancelDTagListing("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeCanceledDTagListing,
+					sdk.NewAttribute(types.AttributeKeyDTagOwner, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasDTagListing(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.k)
+			_, err := server.CancelDTagListing(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_BuyDTag() {
+	testCases := []struct {
+		name      string
+		setup     func(ctx sdk.Context)
+		store     func(ctx sdk.Context)
+		msg       *types.MsgBuyDTag
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "blocked buyer returns error",
+			setup: func(ctx sdk.Context) {
+				suite.rk.EXPECT().HasUserBlocked(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47", uint64(0)).Return(true)
+			},
+			msg: types.NewMsgBuyDTag(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "listing not found returns error",
+			setup: func(ctx sdk.Context) {
+				suite.rk.EXPECT().HasUserBlocked(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47", uint64(0)).Return(false)
+			},
+			msg: types.NewMsgBuyDTag(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "different price returns error",
+			setup: func(ctx sdk.Context) {
+				suite.rk.EXPECT().HasUserBlocked(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47", uint64(0)).Return(false)
+			},
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				profile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			msg: types.NewMsgBuyDTag(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(50)),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "failed payment returns error",
+			setup: func(ctx sdk.Context) {
+				suite.rk.EXPECT().HasUserBlocked(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47", uint64(0)).Return(false)
+				suite.bk.EXPECT().
+					SendCoins(
+						ctx,
+						sdk.MustAccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+						sdk.MustAccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+						sdk.NewCoins(sdk.NewCoin("udsm", sdk.NewInt(100))),
+					).
+					Return(sdkerrors.ErrInsufficientFunds)
+			},
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				profile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			msg: types.NewMsgBuyDTag(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "DTag is bought properly",
+			setup: func(ctx sdk.Context) {
+				suite.rk.EXPECT().HasUserBlocked(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47", uint64(0)).Return(false)
+				suite.bk.EXPECT().
+					SendCoins(
+						ctx,
+						sdk.MustAccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+						sdk.MustAccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+						sdk.NewCoins(sdk.NewCoin("udsm", sdk.NewInt(100))),
+					).
+					Return(nil)
+			},
+			store: func(ctx sdk.Context) {
+				ownerProfile := profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				ownerProfile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, ownerProfile))
+
+				buyerProfile := profilestesting.ProfileFromAddr("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+				buyerProfile.DTag = "buyer_dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, buyerProfile))
+
+				suite.Require().NoError(suite.k.SaveDTagListing(ctx, types.NewDTagListing(
+					"dtag",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					"new_dtag",
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			msg: types.NewMsgBuyDTag(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeBoughtDTag,
+					sdk.NewAttribute(types.AttributeKeyDTagToTrade, "dtag"),
+					sdk.NewAttribute(types.AttributeKeyNewDTag, "new_dtag"),
+					sdk.NewAttribute(types.AttributeKeyDTagOwner, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+					sdk.NewAttribute(types.AttributeKeyDTagBuyer, "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+					sdk.NewAttribute(types.AttributeKeyDTagPrice, "100udsm"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasDTagListing(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"))
+
+				ownerProfile, _, err := suite.k.GetProfile(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				suite.Require().NoError(err)
+				suite.Require().Equal("new_dtag", ownerProfile.DTag)
+
+				buyerProfile, _, err := suite.k.GetProfile(ctx, "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+				suite.Require().NoError(err)
+				suite.Require().Equal("dtag", buyerProfile.DTag)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			suite.k.SetParams(ctx, types.DefaultParams())
+			if tc.setup != nil {
+				tc.setup(ctx)
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.k)
+			_, err := server.BuyDTag(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_PlaceDTagBid() {
+	testCases := []struct {
+		name      string
+		setup     func(ctx sdk.Context)
+		store     func(ctx sdk.Context)
+		msg       *types.MsgPlaceDTagBid
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "blocked buyer returns error",
+			setup: func(ctx sdk.Context) {
+				suite.rk.EXPECT().HasUserBlocked(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47", uint64(0)).Return(true)
+			},
+			msg: types.NewMsgPlaceDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "owner without profile returns error",
+			setup: func(ctx sdk.Context) {
+				suite.rk.EXPECT().HasUserBlocked(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47", uint64(0)).Return(false)
+			},
+			msg: types.NewMsgPlaceDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "past expiration time returns error",
+			setup: func(ctx sdk.Context) {
+				suite.rk.EXPECT().HasUserBlocked(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47", uint64(0)).Return(false)
+			},
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+			},
+			msg: types.NewMsgPlaceDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2019, 1, 1, 00, 00, 00, 000, time.UTC),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "already existing bid returns error",
+			setup: func(ctx sdk.Context) {
+				suite.rk.EXPECT().HasUserBlocked(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47", uint64(0)).Return(false)
+			},
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				profile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			msg: types.NewMsgPlaceDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(200)),
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "bid is placed properly",
+			setup: func(ctx sdk.Context) {
+				suite.rk.EXPECT().HasUserBlocked(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47", uint64(0)).Return(false)
+				suite.bk.EXPECT().
+					SendCoinsFromAccountToModule(
+						ctx,
+						sdk.MustAccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+						types.ModuleName,
+						sdk.NewCoins(sdk.NewCoin("udsm", sdk.NewInt(100))),
+					).
+					Return(nil)
+			},
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				profile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+			},
+			msg: types.NewMsgPlaceDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypePlacedDTagBid,
+					sdk.NewAttribute(types.AttributeKeyDTagToTrade, "dtag"),
+					sdk.NewAttribute(types.AttributeKeyDTagOwner, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+					sdk.NewAttribute(types.AttributeKeyDTagBuyer, "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+					sdk.NewAttribute(types.AttributeKeyDTagPrice, "100udsm"),
+					sdk.NewAttribute(types.AttributeKeyDTagExpirationTime, "2030-01-01T00:00:00Z"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().Equal([]types.DTagBid{
+					types.NewDTagBid(
+						"dtag",
+						"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						sdk.NewCoin("udsm", sdk.NewInt(100)),
+						time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				}, suite.k.GetDTagBids(ctx))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC))
+			if tc.setup != nil {
+				tc.setup(ctx)
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.k)
+			_, err := server.PlaceDTagBid(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_CancelDTagBid() {
+	testCases := []struct {
+		name      string
+		setup     func(ctx sdk.Context)
+		store     func(ctx sdk.Context)
+		msg       *types.MsgCancelDTagBid
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "bid not found returns error",
+			msg: types.NewMsgCancelDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "bid is canceled and refunded properly",
+			setup: func(ctx sdk.Context) {
+				suite.bk.EXPECT().
+					SendCoinsFromModuleToAccount(
+						ctx,
+						types.ModuleName,
+						sdk.MustAccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+						sdk.NewCoins(sdk.NewCoin("udsm", sdk.NewInt(100))),
+					).
+					Return(nil)
+			},
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			msg: types.NewMsgCancelDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRefundedDTagBid,
+					sdk.NewAttribute(types.AttributeKeyDTagToTrade, "dtag"),
+					sdk.NewAttribute(types.AttributeKeyDTagOwner, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+					sdk.NewAttribute(types.AttributeKeyDTagBuyer, "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+					sdk.NewAttribute(types.AttributeKeyDTagPrice, "100udsm"),
+					sdk.NewAttribute(types.AttributeKeyDTagExpirationTime, "2030-01-01T00:00:00Z"),
+				),
+				sdk.NewEvent(
+					types.EventTypeCanceledDTagBid,
+					sdk.NewAttribute(types.AttributeKeyDTagOwner, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+					sdk.NewAttribute(types.AttributeKeyDTagBuyer, "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasDTagBid(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.setup != nil {
+				tc.setup(ctx)
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.k)
+			_, err := server.CancelDTagBid(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_AcceptDTagBid() {
+	testCases := []struct {
+		name      string
+		setup     func(ctx sdk.Context)
+		store     func(ctx sdk.Context)
+		msg       *types.MsgAcceptDTagBid
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "bid not found returns error",
+			msg: types.NewMsgAcceptDTagBid(
+				"new_dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "bid is accepted properly",
+			setup: func(ctx sdk.Context) {
+				suite.bk.EXPECT().
+					SendCoinsFromModuleToAccount(
+						ctx,
+						types.ModuleName,
+						sdk.MustAccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+						sdk.NewCoins(sdk.NewCoin("udsm", sdk.NewInt(100))),
+					).
+					Return(nil)
+			},
+			store: func(ctx sdk.Context) {
+				profile := profilestesting.ProfileFromAddr("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				profile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profile))
+				suite.Require().NoError(suite.k.SaveDTagBid(ctx, types.NewDTagBid(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					sdk.NewCoin("udsm", sdk.NewInt(100)),
+					time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+				)))
+			},
+			msg: types.NewMsgAcceptDTagBid(
+				"new_dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeAcceptedDTagBid,
+					sdk.NewAttribute(types.AttributeKeyDTagToTrade, "dtag"),
+					sdk.NewAttribute(types.AttributeKeyNewDTag, "new_dtag"),
+					sdk.NewAttribute(types.AttributeKeyDTagOwner, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+					sdk.NewAttribute(types.AttributeKeyDTagBuyer, "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+					sdk.NewAttribute(types.AttributeKeyDTagPrice, "100udsm"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasDTagBid(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"))
+
+				ownerProfile, _, err := suite.k.GetProfile(ctx, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				suite.Require().NoError(err)
+				suite.Require().Equal("new_dtag", ownerProfile.DTag)
+
+				buyerProfile, found, err := suite.k.GetProfile(ctx, "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal("dtag", buyerProfile.DTag)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			suite.k.SetParams(ctx, types.DefaultParams())
+			if tc.setup != nil {
+				tc.setup(ctx)
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.k)
+			_, err := server.AcceptDTagBid(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
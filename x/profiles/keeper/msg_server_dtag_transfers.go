@@ -91,75 +91,9 @@ func (k MsgServer) AcceptDTagTransferRequest(goCtx context.Context, msg *types.M
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "no request made from %s", msg.Sender)
 	}
 
-	// Get the current owner profile
-	requestReceiverProfile, exist, err := k.GetProfile(ctx, msg.Receiver)
-	if err != nil {
-		return nil, err
-	}
-
-	if !exist {
-		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "profile of %s doesn't exist", msg.Receiver)
-	}
-
-	// Get the DTag to trade and make sure its correct
-	dTagWanted := request.DTagToTrade
-	dTagToTrade := requestReceiverProfile.DTag
-	if dTagWanted != dTagToTrade {
-		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "the owner's DTag is different from the one to be exchanged")
-	}
-
-	// Change the DTag and validate the profile
-	requestReceiverProfile.DTag = msg.NewDTag
-	err = k.ValidateProfile(ctx, requestReceiverProfile)
-	if err != nil {
-		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
-	}
-
-	// Check for an existent profile of the receiving user
-	requestSenderProfile, exist, err := k.GetProfile(ctx, msg.Sender)
-	if err != nil {
-		return nil, err
-	}
-
-	if exist && msg.NewDTag == requestSenderProfile.DTag {
-		err = k.storeProfileWithoutDTagCheck(ctx, requestReceiverProfile)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		err = k.Keeper.SaveProfile(ctx, requestReceiverProfile)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if !exist {
-		add, err := sdk.AccAddressFromBech32(msg.Sender)
-		if err != nil {
-			return nil, err
-		}
-
-		senderAcc := k.ak.GetAccount(ctx, add)
-		if senderAcc == nil {
-			senderAcc = k.ak.NewAccountWithAddress(ctx, add)
-		}
-
-		requestSenderProfile, err = types.NewProfileFromAccount(dTagToTrade, senderAcc, ctx.BlockTime())
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		requestSenderProfile.DTag = dTagToTrade
-	}
-
-	// Validate the request sender profile
-	err = k.ValidateProfile(ctx, requestSenderProfile)
-	if err != nil {
-		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
-	}
-
-	// Save the request sender profile
-	err = k.Keeper.SaveProfile(ctx, requestSenderProfile)
+	// Transfer the DTag to the request sender
+	dTagToTrade := request.DTagToTrade
+	err = k.TransferDTag(ctx, dTagToTrade, msg.Receiver, msg.Sender, msg.NewDTag)
 	if err != nil {
 		return nil, err
 	}
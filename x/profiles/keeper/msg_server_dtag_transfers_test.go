@@ -295,6 +295,54 @@ func (suite *KeeperTestSuite) TestMsgServer_AcceptDTagTransfer() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "reserved DTag cannot be transferred to a user other than the designated one",
+			store: func(ctx sdk.Context) {
+				request := types.NewDTagTransferRequest(
+					"desmos",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				)
+
+				receiverProfile := profilestesting.ProfileFromAddr(request.Receiver)
+				receiverProfile.DTag = "desmos"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, receiverProfile))
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr(request.Sender)))
+				suite.Require().NoError(suite.k.SaveDTagTransferRequest(ctx, request))
+
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			msg: types.NewMsgAcceptDTagTransferRequest(
+				"new_dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "reserved new DTag that cannot be claimed returns error",
+			store: func(ctx sdk.Context) {
+				request := types.NewDTagTransferRequest(
+					"dtag",
+					"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				)
+
+				receiverProfile := profilestesting.ProfileFromAddr(request.Receiver)
+				receiverProfile.DTag = "dtag"
+				suite.Require().NoError(suite.k.SaveProfile(ctx, receiverProfile))
+				suite.Require().NoError(suite.k.SaveProfile(ctx, profilestesting.ProfileFromAddr(request.Sender)))
+				suite.Require().NoError(suite.k.SaveDTagTransferRequest(ctx, request))
+
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			msg: types.NewMsgAcceptDTagTransferRequest(
+				"premium_dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
 		{
 			name: "invalid request sender profile after exchanging returns error",
 			store: func(ctx sdk.Context) {
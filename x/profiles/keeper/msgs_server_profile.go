@@ -63,6 +63,12 @@ func (k MsgServer) SaveProfile(goCtx context.Context, msg *types.MsgSaveProfile)
 		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	// Charge the fee required to claim the DTag, if it's a reserved one
+	err = k.ChargeReservedDTagFee(ctx, updated.DTag, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
 	// Save the profile
 	err = k.Keeper.SaveProfile(ctx, updated)
 	if err != nil {
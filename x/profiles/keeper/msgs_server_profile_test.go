@@ -8,6 +8,8 @@ import (
 	"github.com/desmos-labs/desmos/v7/x/profiles/keeper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/golang/mock/gomock"
 
 	"github.com/desmos-labs/desmos/v7/x/profiles/types"
 )
@@ -25,6 +27,7 @@ func (suite *KeeperTestSuite) TestMsgServer_SaveProfile() {
 	blockTime := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
 	testCases := []struct {
 		name      string
+		setup     func()
 		store     func(ctx sdk.Context)
 		msg       *types.MsgSaveProfile
 		shouldErr bool
@@ -240,6 +243,84 @@ func (suite *KeeperTestSuite) TestMsgServer_SaveProfile() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "profile not saved because the DTag is reserved to another address",
+			store: func(ctx sdk.Context) {
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			msg: types.NewMsgSaveProfile(
+				"desmos",
+				"nickname",
+				"biography",
+				"",
+				"",
+				"cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "profile not saved because the reserved DTag fee cannot be paid",
+			setup: func() {
+				suite.cpk.EXPECT().
+					FundCommunityPool(
+						gomock.Any(),
+						sdk.NewCoins(sdk.NewInt64Coin("udsm", 1000)),
+						sdk.MustAccAddressFromBech32("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"),
+					).
+					Return(sdkerrors.ErrInsufficientFunds)
+			},
+			store: func(ctx sdk.Context) {
+				suite.ak.SetAccount(ctx, profilestesting.AccountFromAddr("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"))
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			msg: types.NewMsgSaveProfile(
+				"abc",
+				"nickname",
+				"biography",
+				"",
+				"",
+				"cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "profile saved after paying the reserved DTag fee",
+			setup: func() {
+				suite.cpk.EXPECT().
+					FundCommunityPool(
+						gomock.Any(),
+						sdk.NewCoins(sdk.NewInt64Coin("udsm", 1000)),
+						sdk.MustAccAddressFromBech32("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"),
+					).
+					Return(nil)
+			},
+			store: func(ctx sdk.Context) {
+				suite.ak.SetAccount(ctx, profilestesting.AccountFromAddr("cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"))
+				suite.k.SetParams(ctx, suite.reservedDTagsParams())
+			},
+			msg: types.NewMsgSaveProfile(
+				"abc",
+				"nickname",
+				"biography",
+				"",
+				"",
+				"cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773",
+			),
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSavedProfile,
+					sdk.NewAttribute(types.AttributeKeyProfileDTag, "abc"),
+					sdk.NewAttribute(types.AttributeKeyProfileCreator, "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773"),
+					sdk.NewAttribute(types.AttributeKeyProfileCreationTime, blockTime.Format(time.RFC3339)),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				profile, found, err := suite.k.GetProfile(ctx, "cosmos10nsdxxdvy9qka3zv0lzw8z9cnu6kanld8jh773")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal("abc", profile.DTag)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -248,6 +329,10 @@ func (suite *KeeperTestSuite) TestMsgServer_SaveProfile() {
 			ctx, _ := suite.ctx.CacheContext()
 			ctx = ctx.WithBlockTime(blockTime)
 
+			if tc.setup != nil {
+				tc.setup()
+			}
+
 			suite.k.SetParams(ctx, types.DefaultParams())
 			if tc.store != nil {
 				tc.store(ctx)
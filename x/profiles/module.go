@@ -15,6 +15,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
@@ -268,6 +269,7 @@ type ModuleInputs struct {
 	AccountKeeper authkeeper.AccountKeeper
 	BankKeeper    bankkeeper.Keeper
 
+	CommunityPoolKeeper distrkeeper.Keeper
 	RelationshipsKeeper relationshipskeeper.Keeper
 
 	// LegacySubspace is used solely for migration of x/params managed parameters
@@ -295,6 +297,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Key,
 		in.AccountKeeper,
 		in.RelationshipsKeeper,
+		in.BankKeeper,
+		in.CommunityPoolKeeper,
 		nil,
 		nil,
 		nil,
@@ -51,6 +51,37 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			addressB := string(kvB.Value)
 			return fmt.Sprintf("ExternalAddressA: %s\nExternalAddressB: %s\n", addressA, addressB)
 
+		case bytes.HasPrefix(kvA.Key, types.DTagListingPrefix):
+			var listingA, listingB types.DTagListing
+			cdc.MustUnmarshal(kvA.Value, &listingA)
+			cdc.MustUnmarshal(kvB.Value, &listingB)
+			return fmt.Sprintf("DTagListingA: %s\nDTagListingB: %s\n", &listingA, &listingB)
+
+		case bytes.HasPrefix(kvA.Key, types.DTagBidPrefix):
+			var bidA, bidB types.DTagBid
+			cdc.MustUnmarshal(kvA.Value, &bidA)
+			cdc.MustUnmarshal(kvB.Value, &bidB)
+			return fmt.Sprintf("DTagBidA: %s\nDTagBidB: %s\n", &bidA, &bidB)
+
+		case bytes.HasPrefix(kvA.Key, types.ExpiringDTagListingTimePrefix):
+			ownerA := string(kvA.Value)
+			ownerB := string(kvB.Value)
+			return fmt.Sprintf("ExpiringDTagListingOwnerA: %s\nExpiringDTagListingOwnerB: %s\n", ownerA, ownerB)
+
+		case bytes.HasPrefix(kvA.Key, types.ExpiringDTagBidTimePrefix):
+			bidKeyA := string(bytes.TrimPrefix(kvA.Value, types.DTagBidPrefix))
+			bidKeyB := string(bytes.TrimPrefix(kvB.Value, types.DTagBidPrefix))
+			return fmt.Sprintf("ExpiringDTagBidA: %s\nExpiringDTagBidB: %s\n", bidKeyA, bidKeyB)
+
+		case bytes.HasPrefix(kvA.Key, types.DTagHistoryPrefix):
+			var entryA, entryB types.DTagHistoryEntry
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("DTagHistoryEntryA: %s\nDTagHistoryEntryB: %s\n", &entryA, &entryB)
+
+		case bytes.HasPrefix(kvA.Key, types.UserDTagHistoryPrefix):
+			return fmt.Sprintf("DTagHistoryKeyA: %X\nDTagHistoryKeyB: %X\n", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
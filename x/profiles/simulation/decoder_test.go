@@ -45,6 +45,26 @@ func TestDecodeStore(t *testing.T) {
 		time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 		time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
 	)
+	listing := types.NewDTagListing(
+		"dtag",
+		"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+		sdk.NewCoin("udsm", sdk.NewInt(100)),
+		"new_dtag",
+		time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC),
+	)
+	bid := types.NewDTagBid(
+		"dtag",
+		"cosmos1xmquc944hzu6n6qtljcexkuhhz76mucxtgm5x0",
+		"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+		sdk.NewCoin("udsm", sdk.NewInt(100)),
+		time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC),
+	)
+	historyEntry := types.NewDTagHistoryEntry(
+		"dtag",
+		"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+		10,
+		time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC),
+	)
 
 	kvPairs := kv.Pairs{Pairs: []kv.Pair{
 		{
@@ -88,6 +108,43 @@ func TestDecodeStore(t *testing.T) {
 			),
 			Value: []byte("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
 		},
+		{
+			Key:   types.DTagListingStoreKey("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+			Value: cdc.MustMarshal(&listing),
+		},
+		{
+			Key: types.DTagBidStoreKey(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				"cosmos1xmquc944hzu6n6qtljcexkuhhz76mucxtgm5x0",
+			),
+			Value: cdc.MustMarshal(&bid),
+		},
+		{
+			Key: types.DTagListingExpiringTimeKey(
+				time.Date(2022, 1, 1, 0, 0, 00, 000, time.UTC),
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			Value: []byte("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+		},
+		{
+			Key: types.DTagBidExpiringTimeKey(
+				time.Date(2022, 1, 1, 0, 0, 00, 000, time.UTC),
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				"cosmos1xmquc944hzu6n6qtljcexkuhhz76mucxtgm5x0",
+			),
+			Value: types.DTagBidStoreKey(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				"cosmos1xmquc944hzu6n6qtljcexkuhhz76mucxtgm5x0",
+			),
+		},
+		{
+			Key:   types.DTagHistoryStoreKey("dtag", 10, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+			Value: cdc.MustMarshal(&historyEntry),
+		},
+		{
+			Key:   types.UserDTagHistoryStoreKey("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", 10, "dtag"),
+			Value: types.DTagHistoryStoreKey("dtag", 10, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+		},
 		{
 			Key:   []byte("invalid"),
 			Value: []byte("value"),
@@ -104,6 +161,15 @@ func TestDecodeStore(t *testing.T) {
 		{"Application link", fmt.Sprintf("ApplicationLinkA: %s\nApplicationLinkB: %s\n", &applicationLink, &applicationLink)},
 		{"Expiring Application link", fmt.Sprintf("ExpiringClientIDA: %s\nExpiringClientIDB: %s\n", "client_id", "client_id")},
 		{"External address", fmt.Sprintf("ExternalAddressA: %s\nExternalAddressB: %s\n", "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")},
+		{"DTag listing", fmt.Sprintf("DTagListingA: %s\nDTagListingB: %s\n", &listing, &listing)},
+		{"DTag bid", fmt.Sprintf("DTagBidA: %s\nDTagBidB: %s\n", &bid, &bid)},
+		{"Expiring DTag listing", fmt.Sprintf("ExpiringDTagListingOwnerA: %s\nExpiringDTagListingOwnerB: %s\n", "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")},
+		{"Expiring DTag bid", fmt.Sprintf("ExpiringDTagBidA: %s\nExpiringDTagBidB: %s\n", "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7nscosmos1xmquc944hzu6n6qtljcexkuhhz76mucxtgm5x0", "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7nscosmos1xmquc944hzu6n6qtljcexkuhhz76mucxtgm5x0")},
+		{"DTag history entry", fmt.Sprintf("DTagHistoryEntryA: %s\nDTagHistoryEntryB: %s\n", &historyEntry, &historyEntry)},
+		{"User DTag history entry", fmt.Sprintf("DTagHistoryKeyA: %X\nDTagHistoryKeyB: %X\n",
+			types.DTagHistoryStoreKey("dtag", 10, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+			types.DTagHistoryStoreKey("dtag", 10, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+		)},
 		{"other", ""},
 	}
 
@@ -59,11 +59,16 @@ func RandomizedGenState(simsState *module.SimulationState) {
 			RandomBioParams(simsState.Rand),
 			RandomOracleParams(simsState.Rand),
 			RandomAppLinksParams(simsState.Rand),
+			types.DefaultReservedDTagsParams(),
 		),
 		types.IBCPortID,
 		chainLinks,
 		getDefaultExternalAddressEntries(chainLinks),
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	bz, err = simsState.Cdc.MarshalJSON(profileGenesis)
@@ -29,6 +29,12 @@ const (
 	OpWeightMsgLinkChainAccount       = "op_weight_msg_link_chain_account"
 	OpWeightMsgUnlinkChainAccount     = "op_weight_msg_unlink_chain_account"
 	OpWeightSetDefaultExternalAddress = "op_weight_set_default_external_address"
+	OpWeightMsgListDTag               = "op_weight_msg_list_dtag"
+	OpWeightMsgCancelDTagListing      = "op_weight_msg_cancel_dtag_listing"
+	OpWeightMsgBuyDTag                = "op_weight_msg_buy_dtag"
+	OpWeightMsgPlaceDTagBid           = "op_weight_msg_place_dtag_bid"
+	OpWeightMsgCancelDTagBid          = "op_weight_msg_cancel_dtag_bid"
+	OpWeightMsgAcceptDTagBid          = "op_weight_msg_accept_dtag_bid"
 
 	DefaultGasValue = 200000
 )
@@ -101,6 +107,48 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgListDTag int
+	appParams.GetOrGenerate(cdc, OpWeightMsgListDTag, &weightMsgListDTag, nil,
+		func(_ *rand.Rand) {
+			weightMsgListDTag = params.DefaultWeightMsgListDTag
+		},
+	)
+
+	var weightMsgCancelDTagListing int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelDTagListing, &weightMsgCancelDTagListing, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelDTagListing = params.DefaultWeightMsgCancelDTagListing
+		},
+	)
+
+	var weightMsgBuyDTag int
+	appParams.GetOrGenerate(cdc, OpWeightMsgBuyDTag, &weightMsgBuyDTag, nil,
+		func(_ *rand.Rand) {
+			weightMsgBuyDTag = params.DefaultWeightMsgBuyDTag
+		},
+	)
+
+	var weightMsgPlaceDTagBid int
+	appParams.GetOrGenerate(cdc, OpWeightMsgPlaceDTagBid, &weightMsgPlaceDTagBid, nil,
+		func(_ *rand.Rand) {
+			weightMsgPlaceDTagBid = params.DefaultWeightMsgPlaceDTagBid
+		},
+	)
+
+	var weightMsgCancelDTagBid int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelDTagBid, &weightMsgCancelDTagBid, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelDTagBid = params.DefaultWeightMsgCancelDTagBid
+		},
+	)
+
+	var weightMsgAcceptDTagBid int
+	appParams.GetOrGenerate(cdc, OpWeightMsgAcceptDTagBid, &weightMsgAcceptDTagBid, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptDTagBid = params.DefaultWeightMsgAcceptDTagBid
+		},
+	)
+
 	return sim.WeightedOperations{
 		sim.NewWeightedOperation(
 			weightMsgSaveProfile,
@@ -138,5 +186,29 @@ func WeightedOperations(
 			weightMsgSetDefaultExternalAddress,
 			SimulateMsgSetDefaultExternalAddress(k, ak, bk),
 		),
+		sim.NewWeightedOperation(
+			weightMsgListDTag,
+			SimulateMsgListDTag(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgCancelDTagListing,
+			SimulateMsgCancelDTagListing(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgBuyDTag,
+			SimulateMsgBuyDTag(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgPlaceDTagBid,
+			SimulateMsgPlaceDTagBid(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgCancelDTagBid,
+			SimulateMsgCancelDTagBid(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgAcceptDTagBid,
+			SimulateMsgAcceptDTagBid(k, ak, bk),
+		),
 	}
 }
@@ -0,0 +1,271 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/desmos-labs/desmos/v7/testutil/simtesting"
+	"github.com/desmos-labs/desmos/v7/x/profiles/keeper"
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+// SimulateMsgListDTag tests and runs a single MsgListDTag
+func SimulateMsgListDTag(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		owner, price, newDTag, expirationTime, skip := randomListDTagFields(r, ctx, accs, k, bk)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgListDTag", "skip"), nil, nil
+		}
+
+		msg := types.NewMsgListDTag(price, newDTag, expirationTime, owner.Address.String())
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, owner)
+	}
+}
+
+// randomListDTagFields returns random MsgListDTag data
+func randomListDTagFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper, bk bankkeeper.Keeper,
+) (owner simtypes.Account, price sdk.Coin, newDTag string, expirationTime time.Time, skip bool) {
+	profiles := k.GetProfiles(ctx)
+	if len(profiles) == 0 {
+		return simtypes.Account{}, sdk.Coin{}, "", time.Time{}, true
+	}
+
+	// Get the owner account
+	profile := RandomProfile(r, profiles)
+	ownerAcc := GetSimAccount(profile.GetAddress(), accs)
+	if ownerAcc == nil {
+		return simtypes.Account{}, sdk.Coin{}, "", time.Time{}, true
+	}
+	owner = *ownerAcc
+
+	// Get a random price
+	price, ok := RandomDTagPrice(r, bk.SpendableCoins(ctx, owner.Address))
+	if !ok {
+		return simtypes.Account{}, sdk.Coin{}, "", time.Time{}, true
+	}
+
+	// Skip if the new DTag is already taken
+	newDTag = RandomDTag(r)
+	if k.GetAddressFromDTag(ctx, newDTag) != "" {
+		return simtypes.Account{}, sdk.Coin{}, "", time.Time{}, true
+	}
+
+	return owner, price, newDTag, RandomDTagOfferExpirationTime(r, ctx.BlockTime()), false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgCancelDTagListing tests and runs a single MsgCancelDTagListing
+func SimulateMsgCancelDTagListing(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		listings := k.GetDTagListings(ctx)
+		if len(listings) == 0 {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgCancelDTagListing", "skip"), nil, nil
+		}
+
+		listing := RandomDTagListing(r, listings)
+		owner := GetSimAccount(sdk.MustAccAddressFromBech32(listing.Owner), accs)
+		if owner == nil {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgCancelDTagListing", "skip"), nil, nil
+		}
+
+		msg := types.NewMsgCancelDTagListing(listing.Owner)
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, *owner)
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgBuyDTag tests and runs a single MsgBuyDTag
+func SimulateMsgBuyDTag(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		buyer, listing, skip := randomBuyDTagFields(r, ctx, accs, k, bk)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgBuyDTag", "skip"), nil, nil
+		}
+
+		msg := types.NewMsgBuyDTag(listing.Owner, listing.Price, buyer.Address.String())
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, buyer)
+	}
+}
+
+// randomBuyDTagFields returns random MsgBuyDTag data
+func randomBuyDTagFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper, bk bankkeeper.Keeper,
+) (buyer simtypes.Account, listing types.DTagListing, skip bool) {
+	if len(accs) == 0 {
+		return simtypes.Account{}, types.DTagListing{}, true
+	}
+
+	listings := k.GetDTagListings(ctx)
+	if len(listings) == 0 {
+		return simtypes.Account{}, types.DTagListing{}, true
+	}
+	listing = RandomDTagListing(r, listings)
+
+	// Get a random buyer
+	buyer, _ = simtypes.RandomAcc(r, accs)
+	if buyer.Address.String() == listing.Owner {
+		return simtypes.Account{}, types.DTagListing{}, true
+	}
+
+	// Skip if the buyer is blocked
+	if k.IsUserBlocked(ctx, listing.Owner, buyer.Address.String()) {
+		return simtypes.Account{}, types.DTagListing{}, true
+	}
+
+	// Skip if the new DTag has been taken in the meantime
+	if k.GetAddressFromDTag(ctx, listing.NewDTag) != "" {
+		return simtypes.Account{}, types.DTagListing{}, true
+	}
+
+	// Skip if the buyer cannot pay the price and the fees
+	spendable := bk.SpendableCoins(ctx, buyer.Address)
+	if spendable.AmountOf(listing.Price.Denom).LTE(listing.Price.Amount.MulRaw(2)) {
+		return simtypes.Account{}, types.DTagListing{}, true
+	}
+
+	return buyer, listing, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgPlaceDTagBid tests and runs a single MsgPlaceDTagBid
+func SimulateMsgPlaceDTagBid(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		buyer, owner, price, skip := randomPlaceDTagBidFields(r, ctx, accs, k, bk)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgPlaceDTagBid", "skip"), nil, nil
+		}
+
+		expirationTime := RandomDTagOfferExpirationTime(r, ctx.BlockTime())
+		msg := types.NewMsgPlaceDTagBid(owner.GetAddress().String(), price, expirationTime, buyer.Address.String())
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, buyer)
+	}
+}
+
+// randomPlaceDTagBidFields returns random MsgPlaceDTagBid data
+func randomPlaceDTagBidFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper, bk bankkeeper.Keeper,
+) (buyer simtypes.Account, owner *types.Profile, price sdk.Coin, skip bool) {
+	if len(accs) == 0 {
+		return simtypes.Account{}, nil, sdk.Coin{}, true
+	}
+
+	profiles := k.GetProfiles(ctx)
+	if len(profiles) == 0 {
+		return simtypes.Account{}, nil, sdk.Coin{}, true
+	}
+
+	// Get a random owner and buyer
+	owner = RandomProfile(r, profiles)
+	buyer, _ = simtypes.RandomAcc(r, accs)
+	if owner.GetAddress().Equals(buyer.Address) {
+		return simtypes.Account{}, nil, sdk.Coin{}, true
+	}
+
+	// Skip if the buyer is blocked
+	if k.IsUserBlocked(ctx, owner.GetAddress().String(), buyer.Address.String()) {
+		return simtypes.Account{}, nil, sdk.Coin{}, true
+	}
+
+	// Skip if the bid already exists
+	if k.HasDTagBid(ctx, owner.GetAddress().String(), buyer.Address.String()) {
+		return simtypes.Account{}, nil, sdk.Coin{}, true
+	}
+
+	// Get a random price
+	price, ok := RandomDTagPrice(r, bk.SpendableCoins(ctx, buyer.Address))
+	if !ok {
+		return simtypes.Account{}, nil, sdk.Coin{}, true
+	}
+
+	return buyer, owner, price, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgCancelDTagBid tests and runs a single MsgCancelDTagBid
+func SimulateMsgCancelDTagBid(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		bids := k.GetDTagBids(ctx)
+		if len(bids) == 0 {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgCancelDTagBid", "skip"), nil, nil
+		}
+
+		bid := RandomDTagBid(r, bids)
+		buyer := GetSimAccount(sdk.MustAccAddressFromBech32(bid.Buyer), accs)
+		if buyer == nil {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgCancelDTagBid", "skip"), nil, nil
+		}
+
+		msg := types.NewMsgCancelDTagBid(bid.Owner, bid.Buyer)
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, *buyer)
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgAcceptDTagBid tests and runs a single MsgAcceptDTagBid
+func SimulateMsgAcceptDTagBid(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		bids := k.GetDTagBids(ctx)
+		if len(bids) == 0 {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgAcceptDTagBid", "skip"), nil, nil
+		}
+
+		bid := RandomDTagBid(r, bids)
+		owner := GetSimAccount(sdk.MustAccAddressFromBech32(bid.Owner), accs)
+		if owner == nil {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgAcceptDTagBid", "skip"), nil, nil
+		}
+
+		// Skip if the new DTag is already taken
+		newDTag := RandomDTag(r)
+		if k.GetAddressFromDTag(ctx, newDTag) != "" {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgAcceptDTagBid", "skip"), nil, nil
+		}
+
+		msg := types.NewMsgAcceptDTagBid(newDTag, bid.Buyer, bid.Owner)
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, *owner)
+	}
+}
@@ -42,6 +42,7 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 		RandomBioParams(r),
 		RandomOracleParams(r),
 		RandomAppLinksParams(r),
+		types.DefaultReservedDTagsParams(),
 	)
 
 	return types.NewMsgUpdateParams(
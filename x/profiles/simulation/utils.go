@@ -102,6 +102,44 @@ func RandomDTagTransferRequest(r *rand.Rand, requests []types.DTagTransferReques
 	return requests[idx]
 }
 
+// RandomDTagListing picks and returns a random DTag listing from an array of listings
+func RandomDTagListing(r *rand.Rand, listings []types.DTagListing) types.DTagListing {
+	idx := r.Intn(len(listings))
+	return listings[idx]
+}
+
+// RandomDTagBid picks and returns a random DTag bid from an array of bids
+func RandomDTagBid(r *rand.Rand, bids []types.DTagBid) types.DTagBid {
+	idx := r.Intn(len(bids))
+	return bids[idx]
+}
+
+// RandomDTagPrice returns a random price that can be paid using the given spendable coins.
+// The price amount is kept small so that the rest of the balance can be used to pay the fees.
+func RandomDTagPrice(r *rand.Rand, spendable sdk.Coins) (sdk.Coin, bool) {
+	if spendable.Empty() {
+		return sdk.Coin{}, false
+	}
+
+	coin := spendable[r.Intn(len(spendable))]
+	maxAmount := coin.Amount.QuoRaw(1000)
+	if !maxAmount.IsPositive() {
+		return sdk.Coin{}, false
+	}
+
+	amount, err := simtypes.RandPositiveInt(r, maxAmount)
+	if err != nil {
+		return sdk.Coin{}, false
+	}
+
+	return sdk.NewCoin(coin.Denom, amount), true
+}
+
+// RandomDTagOfferExpirationTime returns a random expiration time for a DTag offer made at the given time
+func RandomDTagOfferExpirationTime(r *rand.Rand, blockTime time.Time) time.Time {
+	return blockTime.Add(time.Duration(simtypes.RandIntBetween(r, 1, 72)) * time.Hour)
+}
+
 // RandomChainLink picks and returns a random chain link from an array of chain links
 func RandomChainLink(r *rand.Rand, links []types.ChainLink) types.ChainLink {
 	idx := r.Intn(len(links))
@@ -27,6 +27,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCancelDTagTransferRequest{}, "desmos/MsgCancelDTagTransferRequest")
 	legacy.RegisterAminoMsg(cdc, &MsgAcceptDTagTransferRequest{}, "desmos/MsgAcceptDTagTransferRequest")
 	legacy.RegisterAminoMsg(cdc, &MsgRefuseDTagTransferRequest{}, "desmos/MsgRefuseDTagTransferRequest")
+	legacy.RegisterAminoMsg(cdc, &MsgListDTag{}, "desmos/MsgListDTag")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelDTagListing{}, "desmos/MsgCancelDTagListing")
+	legacy.RegisterAminoMsg(cdc, &MsgBuyDTag{}, "desmos/MsgBuyDTag")
+	legacy.RegisterAminoMsg(cdc, &MsgPlaceDTagBid{}, "desmos/MsgPlaceDTagBid")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelDTagBid{}, "desmos/MsgCancelDTagBid")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptDTagBid{}, "desmos/MsgAcceptDTagBid")
 	legacy.RegisterAminoMsg(cdc, &MsgLinkChainAccount{}, "desmos/MsgLinkChainAccount")
 	legacy.RegisterAminoMsg(cdc, &MsgUnlinkChainAccount{}, "desmos/MsgUnlinkChainAccount")
 	legacy.RegisterAminoMsg(cdc, &MsgSetDefaultExternalAddress{}, "desmos/MsgSetDefaultExternalAddress")
@@ -40,6 +46,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Bech32Address{}, "desmos/Bech32Address", nil)
 	cdc.RegisterConcrete(&Base58Address{}, "desmos/Base58Address", nil)
 	cdc.RegisterConcrete(&HexAddress{}, "desmos/HexAddress", nil)
+	cdc.RegisterConcrete(&BitcoinAddress{}, "desmos/BitcoinAddress", nil)
 
 	cdc.RegisterInterface((*Signature)(nil), nil)
 	cdc.RegisterConcrete(&SingleSignature{}, "desmos/SingleSignature", nil)
@@ -58,6 +65,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&Bech32Address{},
 		&Base58Address{},
 		&HexAddress{},
+		&BitcoinAddress{},
 	)
 	registry.RegisterInterface(
 		"desmos.profiles.v3.Signature",
@@ -77,6 +85,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCancelDTagTransferRequest{},
 		&MsgAcceptDTagTransferRequest{},
 		&MsgRefuseDTagTransferRequest{},
+		&MsgListDTag{},
+		&MsgCancelDTagListing{},
+		&MsgBuyDTag{},
+		&MsgPlaceDTagBid{},
+		&MsgCancelDTagBid{},
+		&MsgAcceptDTagBid{},
 		&MsgLinkChainAccount{},
 		&MsgUnlinkChainAccount{},
 		&MsgLinkApplication{},
@@ -10,6 +10,15 @@ const (
 	EventTypeAcceptedDTagTransferRequest      = "accepted_dtag_transfer_request"
 	EventTypeRefusedDTagTransferRequest       = "refused_dtag_transfer_request"
 	EventTypeCanceledDTagTransferRequest      = "canceled_dtag_transfer_request"
+	EventTypeListedDTag                       = "listed_dtag"
+	EventTypeCanceledDTagListing              = "canceled_dtag_listing"
+	EventTypeExpiredDTagListing               = "expired_dtag_listing"
+	EventTypeBoughtDTag                       = "bought_dtag"
+	EventTypePlacedDTagBid                    = "placed_dtag_bid"
+	EventTypeCanceledDTagBid                  = "canceled_dtag_bid"
+	EventTypeRefundedDTagBid                  = "refunded_dtag_bid"
+	EventTypeFailedDTagBidRefund              = "failed_dtag_bid_refund"
+	EventTypeAcceptedDTagBid                  = "accepted_dtag_bid"
 	EventTypeCreatedChainLink                 = "created_chain_link"
 	EventTypeDeletedChainLink                 = "deleted_chain_link"
 	EventTypeSavedChainLink                   = "saved_chain_link"
@@ -28,6 +37,11 @@ const (
 	AttributeKeyRequestSender                 = "request_sender"
 	AttributeKeyDTagToTrade                   = "dtag_to_trade"
 	AttributeKeyNewDTag                       = "new_dtag"
+	AttributeKeyDTagOwner                     = "dtag_owner"
+	AttributeKeyDTagBuyer                     = "dtag_buyer"
+	AttributeKeyDTagPrice                     = "dtag_price"
+	AttributeKeyDTagExpirationTime            = "dtag_expiration_time"
+	AttributeKeyDTagRefundError               = "dtag_refund_error"
 	AttributeKeyChainLinkExternalAddress      = "chain_link_external_address"
 	AttributeKeyChainLinkOwner                = "chain_link_owner"
 	AttributeKeyChainLinkChainName            = "chain_link_chain_name"
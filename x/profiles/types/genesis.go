@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
@@ -16,6 +17,10 @@ func NewGenesisState(
 	chainLinks []ChainLink,
 	defaultExternalAddresses []DefaultExternalAddressEntry,
 	applicationLinks []ApplicationLink,
+	dTagListings []DTagListing,
+	dTagBids []DTagBid,
+	dTagHistory []DTagHistoryEntry,
+	usedEIP712Nonces []UsedEIP712NonceEntry,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                   params,
@@ -24,6 +29,10 @@ func NewGenesisState(
 		ChainLinks:               chainLinks,
 		DefaultExternalAddresses: defaultExternalAddresses,
 		ApplicationLinks:         applicationLinks,
+		DTagListings:             dTagListings,
+		DTagBids:                 dTagBids,
+		DTagHistory:              dTagHistory,
+		UsedEIP712Nonces:         usedEIP712Nonces,
 	}
 }
 
@@ -40,7 +49,7 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 
 // DefaultGenesisState returns a default GenesisState
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(nil, DefaultParams(), IBCPortID, nil, nil, nil)
+	return NewGenesisState(nil, DefaultParams(), IBCPortID, nil, nil, nil, nil, nil, nil, nil)
 }
 
 // ValidateGenesis validates the given genesis state and returns an error if something is invalid
@@ -83,6 +92,62 @@ func ValidateGenesis(data *GenesisState) error {
 		}
 	}
 
+	listingsOwners := map[string]bool{}
+	for _, listing := range data.DTagListings {
+		if listingsOwners[listing.Owner] {
+			return fmt.Errorf("duplicated DTag listing for owner %s", listing.Owner)
+		}
+		listingsOwners[listing.Owner] = true
+
+		err = listing.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	bids := map[string]bool{}
+	for _, bid := range data.DTagBids {
+		key := string(DTagBidStoreKey(bid.Owner, bid.Buyer))
+		if bids[key] {
+			return fmt.Errorf("duplicated DTag bid from %s to %s", bid.Buyer, bid.Owner)
+		}
+		bids[key] = true
+
+		err = bid.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	historyEntries := map[string]bool{}
+	for _, entry := range data.DTagHistory {
+		key := string(DTagHistoryStoreKey(entry.DTag, entry.Height, entry.Address))
+		if historyEntries[key] {
+			return fmt.Errorf("duplicated DTag history entry for DTag %s and address %s at height %d",
+				entry.DTag, entry.Address, entry.Height)
+		}
+		historyEntries[key] = true
+
+		err = entry.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	usedNonces := map[string]bool{}
+	for _, entry := range data.UsedEIP712Nonces {
+		err = entry.Validate()
+		if err != nil {
+			return err
+		}
+
+		key := string(UsedEIP712NonceStoreKey(entry.PubKey, entry.Nonce))
+		if usedNonces[key] {
+			return fmt.Errorf("duplicated used EIP-712 nonce %d for public key %X", entry.Nonce, entry.PubKey)
+		}
+		usedNonces[key] = true
+	}
+
 	return nil
 }
 
@@ -113,3 +178,21 @@ func (data DefaultExternalAddressEntry) Validate() error {
 	}
 	return nil
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewUsedEIP712NonceEntry returns a new UsedEIP712NonceEntry instance
+func NewUsedEIP712NonceEntry(pubKey []byte, nonce uint64) UsedEIP712NonceEntry {
+	return UsedEIP712NonceEntry{
+		PubKey: pubKey,
+		Nonce:  nonce,
+	}
+}
+
+// Validate implements fmt.Validator
+func (data UsedEIP712NonceEntry) Validate() error {
+	if len(data.PubKey) == 0 || len(data.PubKey) > address.MaxAddrLen {
+		return fmt.Errorf("invalid public key length: %d", len(data.PubKey))
+	}
+	return nil
+}
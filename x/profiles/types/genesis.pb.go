@@ -33,6 +33,10 @@ type GenesisState struct {
 	DefaultExternalAddresses []DefaultExternalAddressEntry `protobuf:"bytes,4,rep,name=default_external_addresses,json=defaultExternalAddresses,proto3" json:"default_external_addresses" yaml:"default_external_addresses"`
 	IBCPortID                string                        `protobuf:"bytes,5,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty" yaml:"ibc_port_id"`
 	Params                   Params                        `protobuf:"bytes,6,opt,name=params,proto3" json:"params" yaml:"params"`
+	DTagListings             []DTagListing                 `protobuf:"bytes,7,rep,name=dtag_listings,json=dtagListings,proto3" json:"dtag_listings" yaml:"dtag_listings"`
+	DTagBids                 []DTagBid                     `protobuf:"bytes,8,rep,name=dtag_bids,json=dtagBids,proto3" json:"dtag_bids" yaml:"dtag_bids"`
+	DTagHistory              []DTagHistoryEntry            `protobuf:"bytes,9,rep,name=dtag_history,json=dtagHistory,proto3" json:"dtag_history" yaml:"dtag_history"`
+	UsedEIP712Nonces         []UsedEIP712NonceEntry        `protobuf:"bytes,10,rep,name=used_eip712_nonces,json=usedEip712Nonces,proto3" json:"used_eip712_nonces" yaml:"used_eip712_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,53 +133,123 @@ func (m *DefaultExternalAddressEntry) GetTarget() string {
 	return ""
 }
 
+// UsedEIP712NonceEntry contains the data of an EIP-712 nonce that has already
+// been signed by the given public key to create a chain link
+type UsedEIP712NonceEntry struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Nonce  uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *UsedEIP712NonceEntry) Reset()         { *m = UsedEIP712NonceEntry{} }
+func (m *UsedEIP712NonceEntry) String() string { return proto.CompactTextString(m) }
+func (*UsedEIP712NonceEntry) ProtoMessage()    {}
+func (*UsedEIP712NonceEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd22d098f73f0a1c, []int{2}
+}
+func (m *UsedEIP712NonceEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsedEIP712NonceEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsedEIP712NonceEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsedEIP712NonceEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsedEIP712NonceEntry.Merge(m, src)
+}
+func (m *UsedEIP712NonceEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsedEIP712NonceEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsedEIP712NonceEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsedEIP712NonceEntry proto.InternalMessageInfo
+
+func (m *UsedEIP712NonceEntry) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *UsedEIP712NonceEntry) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "desmos.profiles.v3.GenesisState")
 	proto.RegisterType((*DefaultExternalAddressEntry)(nil), "desmos.profiles.v3.DefaultExternalAddressEntry")
+	proto.RegisterType((*UsedEIP712NonceEntry)(nil), "desmos.profiles.v3.UsedEIP712NonceEntry")
 }
 
 func init() { proto.RegisterFile("desmos/profiles/v3/genesis.proto", fileDescriptor_bd22d098f73f0a1c) }
 
 var fileDescriptor_bd22d098f73f0a1c = []byte{
-	// 607 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xe3, 0xfe, 0x89, 0x94, 0x0b, 0x48, 0xf4, 0x14, 0x55, 0x26, 0xa8, 0x76, 0x48, 0x05,
-	0x14, 0x50, 0x6d, 0xd1, 0x0e, 0x48, 0xdd, 0xea, 0xb6, 0x42, 0x15, 0xa8, 0xaa, 0xd2, 0x4e, 0x2c,
-	0xd6, 0xc5, 0xbe, 0xba, 0xa7, 0xda, 0x3e, 0x73, 0x77, 0x49, 0x9b, 0x9d, 0x81, 0x91, 0x2f, 0x80,
-	0x04, 0x1b, 0x23, 0x03, 0xdf, 0x81, 0x8e, 0x15, 0x13, 0x53, 0x84, 0x92, 0x81, 0xbd, 0x9f, 0x00,
-	0xf9, 0xee, 0x4c, 0x02, 0x75, 0x58, 0xa2, 0xb3, 0xdf, 0xdf, 0xf3, 0x3e, 0xcf, 0xbd, 0xf1, 0x0b,
-	0x5a, 0x21, 0xe6, 0x09, 0xe5, 0x6e, 0xc6, 0xe8, 0x09, 0x89, 0x31, 0x77, 0xfb, 0x9b, 0x6e, 0x84,
-	0x53, 0xcc, 0x09, 0x77, 0x32, 0x46, 0x05, 0x85, 0x50, 0x11, 0x4e, 0x41, 0x38, 0xfd, 0xcd, 0xe6,
-	0x12, 0x4a, 0x48, 0x4a, 0x5d, 0xf9, 0xab, 0xb0, 0x66, 0x23, 0xa2, 0x11, 0x95, 0x47, 0x37, 0x3f,
-	0xe9, 0xb7, 0x77, 0x03, 0x9a, 0x8b, 0x7d, 0x55, 0x50, 0x0f, 0xba, 0xf4, 0xb0, 0xc4, 0x39, 0xa1,
-	0x21, 0x8e, 0xb9, 0x9f, 0x21, 0x86, 0x92, 0x82, 0x5b, 0x9f, 0xcd, 0x85, 0x02, 0x45, 0x3e, 0xc3,
-	0x6f, 0x7a, 0x98, 0x8b, 0x02, 0x7f, 0x3a, 0x1b, 0x0f, 0x4e, 0x11, 0x49, 0xfd, 0x98, 0xa4, 0x67,
-	0x05, 0xfc, 0x78, 0x36, 0x8c, 0xb2, 0x6c, 0x1a, 0x6d, 0x7f, 0x5b, 0x04, 0xb7, 0x5e, 0xa8, 0xc1,
-	0x1c, 0x09, 0x24, 0x30, 0xfc, 0x64, 0x80, 0x65, 0x19, 0x40, 0x30, 0x94, 0xf2, 0x13, 0xcc, 0xfe,
-	0x24, 0x31, 0x8d, 0xd6, 0xfc, 0x5a, 0x7d, 0xe3, 0x91, 0x73, 0x73, 0x72, 0xce, 0xee, 0x31, 0x8a,
-	0x8e, 0xb5, 0xa0, 0xa3, 0x78, 0xcf, 0xbb, 0x1c, 0xda, 0x95, 0xd1, 0xd0, 0x6e, 0x94, 0x14, 0xf9,
-	0xf5, 0xd0, 0x5e, 0x19, 0xa0, 0x24, 0xde, 0x6a, 0x97, 0x9b, 0xb5, 0x3f, 0xff, 0xfa, 0xf2, 0xc4,
-	0xe8, 0x34, 0xf2, 0xea, 0xbf, 0x5a, 0xe8, 0x83, 0xfa, 0xd4, 0xa5, 0xcd, 0x39, 0x99, 0x6b, 0xa5,
-	0x2c, 0xd7, 0x4e, 0x8e, 0xbd, 0x22, 0xe9, 0x99, 0x67, 0xe7, 0x69, 0xae, 0x87, 0x36, 0x54, 0xae,
-	0x53, 0x7a, 0x6d, 0x05, 0x82, 0x82, 0xe5, 0xf0, 0x1c, 0x2c, 0xa1, 0x2c, 0x8b, 0x49, 0x80, 0x04,
-	0xa1, 0x85, 0xcd, 0xbc, 0xb4, 0x59, 0x2d, 0xb3, 0xd9, 0x9e, 0xc0, 0xd2, 0xec, 0x81, 0x36, 0x33,
-	0x95, 0xd9, 0x8d, 0x5e, 0xda, 0xf2, 0x0e, 0xfa, 0x5b, 0xc7, 0xe1, 0x07, 0x03, 0x34, 0x43, 0x7c,
-	0x82, 0x7a, 0xb1, 0xf0, 0xf1, 0x85, 0xc0, 0x2c, 0x45, 0xb1, 0x8f, 0xc2, 0x90, 0x61, 0xce, 0x31,
-	0x37, 0x17, 0x64, 0x04, 0xb7, 0xf4, 0x1f, 0x50, 0xaa, 0x3d, 0x2d, 0xda, 0x56, 0x9a, 0xbd, 0x54,
-	0xb0, 0x81, 0xe7, 0xe8, 0x38, 0xf7, 0xf5, 0xc4, 0x67, 0x1a, 0xe8, 0x5c, 0x66, 0x58, 0xda, 0x0c,
-	0x73, 0xb8, 0x03, 0xea, 0xa4, 0x1b, 0xf8, 0x19, 0x65, 0xc2, 0x27, 0xa1, 0xb9, 0xd8, 0x32, 0xd6,
-	0x6a, 0xde, 0xea, 0x68, 0x68, 0xd7, 0xf6, 0xbd, 0x9d, 0x43, 0xca, 0xc4, 0xfe, 0xee, 0x64, 0xc6,
-	0x53, 0x64, 0xbb, 0x53, 0x23, 0xdd, 0x40, 0x02, 0x21, 0x3c, 0x00, 0x55, 0xb5, 0x0a, 0x66, 0xb5,
-	0x65, 0xac, 0xd5, 0x37, 0x9a, 0x65, 0xf7, 0x39, 0x94, 0x84, 0xd7, 0xd4, 0xd1, 0x6f, 0xab, 0x96,
-	0x4a, 0xa7, 0x63, 0xea, 0x2e, 0x5b, 0x0b, 0xef, 0x3e, 0xda, 0x95, 0xf6, 0x5b, 0x03, 0xdc, 0xfb,
-	0xcf, 0x10, 0xa0, 0x03, 0x16, 0xe9, 0x79, 0x8a, 0x99, 0x69, 0xc8, 0xd0, 0xe6, 0xf7, 0xaf, 0xeb,
-	0x0d, 0xbd, 0xb9, 0x9a, 0x3b, 0x12, 0x8c, 0xa4, 0x51, 0x47, 0x61, 0x70, 0x05, 0xa8, 0x2f, 0xc2,
-	0x4f, 0x51, 0x82, 0xcd, 0xb9, 0x5c, 0xd4, 0xa9, 0xc9, 0x37, 0x07, 0x28, 0xc1, 0x70, 0x19, 0x54,
-	0x05, 0x62, 0x11, 0x16, 0xe6, 0xbc, 0x2c, 0xe9, 0x27, 0xef, 0xe5, 0xe5, 0xc8, 0x32, 0xae, 0x46,
-	0x96, 0xf1, 0x73, 0x64, 0x19, 0xef, 0xc7, 0x56, 0xe5, 0x6a, 0x6c, 0x55, 0x7e, 0x8c, 0xad, 0xca,
-	0xeb, 0x67, 0x11, 0x11, 0xa7, 0xbd, 0xae, 0x13, 0xd0, 0xc4, 0x55, 0x17, 0x5e, 0x8f, 0x51, 0x97,
-	0xeb, 0xb3, 0xdb, 0x7f, 0xee, 0x5e, 0x4c, 0x36, 0x56, 0x0c, 0x32, 0xcc, 0xbb, 0x55, 0xb9, 0xa4,
-	0x9b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x40, 0xdd, 0x90, 0xcf, 0x04, 0x00, 0x00,
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0x4f, 0x6f, 0xdb, 0x36,
+	0x18, 0x87, 0xad, 0x26, 0x71, 0x63, 0xda, 0x05, 0x52, 0xce, 0xe8, 0x54, 0x17, 0xb1, 0x32, 0x77,
+	0x7f, 0xd2, 0x6d, 0x91, 0x90, 0xe4, 0x10, 0x20, 0xb7, 0x2a, 0x35, 0xb6, 0xa0, 0x45, 0x10, 0xb8,
+	0xdd, 0x65, 0x17, 0x81, 0x12, 0x69, 0x85, 0x88, 0x24, 0x6a, 0x24, 0x9d, 0xc4, 0xf7, 0x1d, 0x76,
+	0x1c, 0x76, 0x1f, 0xb0, 0xdd, 0x76, 0xdc, 0xa1, 0x1f, 0xa2, 0xc7, 0x62, 0xa7, 0x9d, 0x8c, 0xc1,
+	0x39, 0xec, 0x9e, 0x4f, 0x30, 0x88, 0xa4, 0x12, 0x6d, 0x91, 0x97, 0x8b, 0x21, 0x91, 0xcf, 0xfb,
+	0x3e, 0x3f, 0x52, 0x94, 0x0c, 0x36, 0x30, 0x11, 0x29, 0x13, 0x5e, 0xce, 0xd9, 0x98, 0x26, 0x44,
+	0x78, 0x67, 0xbb, 0x5e, 0x4c, 0x32, 0x22, 0xa8, 0x70, 0x73, 0xce, 0x24, 0x83, 0x50, 0x13, 0x6e,
+	0x49, 0xb8, 0x67, 0xbb, 0xbd, 0x87, 0x28, 0xa5, 0x19, 0xf3, 0xd4, 0xaf, 0xc6, 0x7a, 0xdd, 0x98,
+	0xc5, 0x4c, 0x5d, 0x7a, 0xc5, 0x95, 0x19, 0x7d, 0x1c, 0xb1, 0xa2, 0x38, 0xd0, 0x13, 0xfa, 0xc6,
+	0x4c, 0x7d, 0x5a, 0x63, 0x4e, 0x19, 0x26, 0x89, 0x08, 0x72, 0xc4, 0x51, 0x5a, 0x72, 0x5b, 0x8b,
+	0x39, 0x2c, 0x51, 0x1c, 0x70, 0xf2, 0xdd, 0x84, 0x08, 0x59, 0xe2, 0x5f, 0xdc, 0x81, 0xb3, 0xf1,
+	0x98, 0xf0, 0x12, 0xfe, 0xf2, 0x0e, 0xf8, 0x84, 0x0a, 0xc9, 0xf8, 0xf4, 0xee, 0xd6, 0xd1, 0x09,
+	0xa2, 0x59, 0x90, 0xd0, 0xec, 0xb4, 0x6c, 0xfd, 0x6c, 0x31, 0x8c, 0xf2, 0xbc, 0x8a, 0x0e, 0xde,
+	0xb6, 0x40, 0xe7, 0x2b, 0xbd, 0xe7, 0xaf, 0x25, 0x92, 0x04, 0xfe, 0x6a, 0x81, 0x47, 0xca, 0x2f,
+	0x39, 0xca, 0xc4, 0x98, 0xf0, 0xeb, 0x45, 0xda, 0xd6, 0xc6, 0xd2, 0x66, 0x7b, 0xe7, 0x33, 0xf7,
+	0xf6, 0x43, 0x71, 0x5f, 0xbc, 0x41, 0xf1, 0x1b, 0x53, 0x30, 0xd2, 0xbc, 0xef, 0xbf, 0x9b, 0x39,
+	0x8d, 0xf9, 0xcc, 0xe9, 0xd6, 0x4c, 0x8a, 0xab, 0x99, 0xb3, 0x3e, 0x45, 0x69, 0xb2, 0x3f, 0xa8,
+	0x97, 0x0d, 0x7e, 0xfb, 0xfb, 0xf7, 0xcf, 0xad, 0x51, 0x17, 0xcb, 0xdb, 0xb5, 0x30, 0x00, 0xed,
+	0xca, 0xa2, 0xed, 0x7b, 0x2a, 0xd7, 0x7a, 0x5d, 0xae, 0x83, 0x02, 0x7b, 0x45, 0xb3, 0x53, 0xdf,
+	0x29, 0xd2, 0x5c, 0xcd, 0x1c, 0xa8, 0xad, 0x95, 0x7a, 0xa3, 0x02, 0x51, 0xc9, 0x0a, 0x78, 0x0e,
+	0x1e, 0xa2, 0x3c, 0x4f, 0x68, 0x84, 0x24, 0x65, 0xa5, 0x66, 0x49, 0x69, 0x9e, 0xd6, 0x69, 0x9e,
+	0xdf, 0xc0, 0x4a, 0xf6, 0x89, 0x91, 0xd9, 0x5a, 0x76, 0xab, 0x97, 0x51, 0xae, 0xa1, 0x7f, 0xd7,
+	0x09, 0xf8, 0xb3, 0x05, 0x7a, 0x98, 0x8c, 0xd1, 0x24, 0x91, 0x01, 0xb9, 0x90, 0x84, 0x67, 0x28,
+	0x09, 0x10, 0xc6, 0x9c, 0x08, 0x41, 0x84, 0xbd, 0xac, 0x22, 0x78, 0xb5, 0x4f, 0x40, 0x57, 0x0d,
+	0x4d, 0xd1, 0x73, 0x5d, 0x33, 0xcc, 0x24, 0x9f, 0xfa, 0xae, 0x89, 0xf3, 0x91, 0xd9, 0xf1, 0x85,
+	0x02, 0x93, 0xcb, 0xc6, 0xb5, 0xcd, 0x88, 0x80, 0x07, 0xa0, 0x4d, 0xc3, 0x28, 0xc8, 0x19, 0x97,
+	0x01, 0xc5, 0xf6, 0xca, 0x86, 0xb5, 0xd9, 0xf2, 0x9f, 0xce, 0x67, 0x4e, 0xeb, 0xd0, 0x3f, 0x38,
+	0x66, 0x5c, 0x1e, 0xbe, 0xb8, 0xd9, 0xe3, 0x0a, 0x39, 0x18, 0xb5, 0x68, 0x18, 0x29, 0x00, 0xc3,
+	0x23, 0xd0, 0xd4, 0x6f, 0x99, 0xdd, 0xdc, 0xb0, 0x36, 0xdb, 0x3b, 0xbd, 0xba, 0xf5, 0x1c, 0x2b,
+	0xc2, 0xef, 0x99, 0xe8, 0x0f, 0x74, 0x4b, 0x5d, 0x67, 0x62, 0x9a, 0x2e, 0xf0, 0x1c, 0x3c, 0x50,
+	0x87, 0x28, 0xa1, 0x42, 0xd2, 0x2c, 0x16, 0xf6, 0x7d, 0xb5, 0x4d, 0xce, 0xa2, 0x83, 0xfa, 0x4a,
+	0x73, 0xfe, 0xb6, 0x39, 0xa0, 0x9d, 0xca, 0x60, 0x71, 0x30, 0xbb, 0x95, 0x83, 0x59, 0xf6, 0x34,
+	0xca, 0x0e, 0x96, 0x37, 0x28, 0x8c, 0x40, 0x4b, 0x41, 0x21, 0xc5, 0xc2, 0x5e, 0x55, 0xd2, 0x27,
+	0x8b, 0xa4, 0x3e, 0xc5, 0xfe, 0x33, 0x23, 0x5c, 0x35, 0x03, 0x85, 0x6c, 0xad, 0x22, 0x2b, 0xfa,
+	0x18, 0xd1, 0x2a, 0x96, 0x1a, 0x81, 0x17, 0xa0, 0x53, 0xfd, 0x1e, 0xd8, 0x2d, 0xe5, 0xf9, 0x78,
+	0x91, 0xe7, 0x6b, 0x8d, 0xe9, 0x07, 0xef, 0x19, 0x61, 0xbb, 0x32, 0x73, 0x35, 0x73, 0x3e, 0xa8,
+	0x38, 0x4d, 0x5b, 0xa3, 0x6d, 0x63, 0x79, 0x0d, 0xc2, 0x9f, 0x2c, 0x00, 0x27, 0x82, 0xe0, 0x80,
+	0xd0, 0x7c, 0x6f, 0x7b, 0x27, 0xc8, 0x58, 0x16, 0x11, 0x61, 0x03, 0x15, 0x60, 0xb3, 0x2e, 0xc0,
+	0x37, 0x82, 0xe0, 0xe1, 0xe1, 0xf1, 0xde, 0xf6, 0xce, 0x51, 0xc1, 0xea, 0x10, 0xfb, 0x26, 0xc4,
+	0xda, 0x7f, 0x66, 0x8b, 0xd5, 0x3f, 0xd6, 0x49, 0x6e, 0x5b, 0xca, 0x37, 0xa4, 0x98, 0x19, 0xd2,
+	0xfc, 0xba, 0x66, 0x7f, 0xf9, 0x87, 0x5f, 0x9c, 0xc6, 0xe0, 0x7b, 0x0b, 0x3c, 0xf9, 0x9f, 0x13,
+	0x0f, 0x5d, 0xb0, 0xc2, 0xce, 0x33, 0xc2, 0x6d, 0x4b, 0x9d, 0x50, 0xfb, 0x8f, 0xb7, 0x5b, 0x5d,
+	0xf3, 0x0f, 0x60, 0xb8, 0xd7, 0x92, 0xd3, 0x2c, 0x1e, 0x69, 0x0c, 0xae, 0x03, 0xfd, 0xfa, 0x07,
+	0x19, 0x4a, 0x89, 0x7d, 0xaf, 0x28, 0x1a, 0xb5, 0xd4, 0xc8, 0x11, 0x4a, 0x09, 0x7c, 0x04, 0x9a,
+	0x12, 0xf1, 0x98, 0x48, 0x7b, 0x49, 0x4d, 0x99, 0xbb, 0xc1, 0x10, 0x74, 0xeb, 0x96, 0x0c, 0x3f,
+	0x04, 0xf7, 0xf3, 0x49, 0x18, 0x9c, 0x92, 0xa9, 0x0a, 0xd0, 0x19, 0x35, 0xf3, 0x49, 0xf8, 0x92,
+	0x4c, 0x61, 0x17, 0xac, 0xa8, 0xf5, 0x29, 0xc5, 0xf2, 0x48, 0xdf, 0xf8, 0x2f, 0xdf, 0xcd, 0xfb,
+	0xd6, 0xfb, 0x79, 0xdf, 0xfa, 0x6b, 0xde, 0xb7, 0x7e, 0xbc, 0xec, 0x37, 0xde, 0x5f, 0xf6, 0x1b,
+	0x7f, 0x5e, 0xf6, 0x1b, 0xdf, 0x6e, 0xc7, 0x54, 0x9e, 0x4c, 0x42, 0x37, 0x62, 0xa9, 0xa7, 0xf7,
+	0x7b, 0x2b, 0x41, 0xa1, 0x30, 0xd7, 0xde, 0xd9, 0x9e, 0x77, 0x71, 0xf3, 0x95, 0x97, 0xd3, 0x9c,
+	0x88, 0xb0, 0xa9, 0x3e, 0xec, 0xbb, 0xff, 0x0c, 0x00, 0xd7, 0x20, 0x0d, 0xcc, 0x5e, 0x07, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +272,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UsedEIP712Nonces) > 0 {
+		for iNdEx := len(m.UsedEIP712Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedEIP712Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DTagHistory) > 0 {
+		for iNdEx := len(m.DTagHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DTagHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DTagBids) > 0 {
+		for iNdEx := len(m.DTagBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DTagBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DTagListings) > 0 {
+		for iNdEx := len(m.DTagListings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DTagListings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -318,6 +448,41 @@ func (m *DefaultExternalAddressEntry) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *UsedEIP712NonceEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsedEIP712NonceEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsedEIP712NonceEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -365,6 +530,30 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DTagListings) > 0 {
+		for _, e := range m.DTagListings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DTagBids) > 0 {
+		for _, e := range m.DTagBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DTagHistory) > 0 {
+		for _, e := range m.DTagHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsedEIP712Nonces) > 0 {
+		for _, e := range m.UsedEIP712Nonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -389,6 +578,22 @@ func (m *DefaultExternalAddressEntry) Size() (n int) {
 	return n
 }
 
+func (m *UsedEIP712NonceEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -625,6 +830,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DTagListings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DTagListings = append(m.DTagListings, DTagListing{})
+			if err := m.DTagListings[len(m.DTagListings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DTagBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DTagBids = append(m.DTagBids, DTagBid{})
+			if err := m.DTagBids[len(m.DTagBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DTagHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DTagHistory = append(m.DTagHistory, DTagHistoryEntry{})
+			if err := m.DTagHistory[len(m.DTagHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedEIP712Nonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsedEIP712Nonces = append(m.UsedEIP712Nonces, UsedEIP712NonceEntry{})
+			if err := m.UsedEIP712Nonces[len(m.UsedEIP712Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -792,6 +1133,109 @@ func (m *DefaultExternalAddressEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UsedEIP712NonceEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsedEIP712NonceEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsedEIP712NonceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -33,11 +33,16 @@ func TestValidateGenesis(t *testing.T) {
 					types.DefaultBioParams(),
 					types.DefaultOracleParams(),
 					types.DefaultAppLinksParams(),
+					types.DefaultReservedDTagsParams(),
 				),
 				types.IBCPortID,
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			shouldErr: true,
 		},
@@ -56,6 +61,10 @@ func TestValidateGenesis(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			shouldErr: true,
 		},
@@ -80,6 +89,10 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			shouldErr: true,
 		},
@@ -92,6 +105,10 @@ func TestValidateGenesis(t *testing.T) {
 				nil,
 				[]types.DefaultExternalAddressEntry{types.NewDefaultExternalAddressEntry("", "", "")},
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			shouldErr: true,
 		},
@@ -122,6 +139,10 @@ func TestValidateGenesis(t *testing.T) {
 						time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC),
 					),
 				},
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			shouldErr: true,
 		},
@@ -134,6 +155,185 @@ func TestValidateGenesis(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated DTag listings return error",
+			genesis: types.NewGenesisState(
+				nil,
+				types.DefaultParams(),
+				types.IBCPortID,
+				nil,
+				nil,
+				nil,
+				[]types.DTagListing{
+					types.NewDTagListing(
+						"dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						sdk.NewCoin("udsm", sdk.NewInt(100)),
+						"new_dtag",
+						time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+					types.NewDTagListing(
+						"dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						sdk.NewCoin("udsm", sdk.NewInt(200)),
+						"other_dtag",
+						time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				nil,
+				nil,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid DTag bid returns error",
+			genesis: types.NewGenesisState(
+				nil,
+				types.DefaultParams(),
+				types.IBCPortID,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.DTagBid{
+					types.NewDTagBid(
+						"dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						sdk.NewCoin("udsm", sdk.NewInt(100)),
+						time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				nil,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated DTag bids return error",
+			genesis: types.NewGenesisState(
+				nil,
+				types.DefaultParams(),
+				types.IBCPortID,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.DTagBid{
+					types.NewDTagBid(
+						"dtag",
+						"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						sdk.NewCoin("udsm", sdk.NewInt(100)),
+						time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+					types.NewDTagBid(
+						"dtag",
+						"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						sdk.NewCoin("udsm", sdk.NewInt(200)),
+						time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				nil,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid DTag history entry returns error",
+			genesis: types.NewGenesisState(
+				nil,
+				types.DefaultParams(),
+				types.IBCPortID,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.DTagHistoryEntry{
+					types.NewDTagHistoryEntry(
+						"dtag",
+						"",
+						10,
+						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated DTag history entries return error",
+			genesis: types.NewGenesisState(
+				nil,
+				types.DefaultParams(),
+				types.IBCPortID,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.DTagHistoryEntry{
+					types.NewDTagHistoryEntry(
+						"dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						10,
+						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+					types.NewDTagHistoryEntry(
+						"DTag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						10,
+						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid used EIP-712 nonce entry returns error",
+			genesis: types.NewGenesisState(
+				nil,
+				types.DefaultParams(),
+				types.IBCPortID,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.UsedEIP712NonceEntry{
+					types.NewUsedEIP712NonceEntry(nil, 1),
+				},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated used EIP-712 nonce entries return error",
+			genesis: types.NewGenesisState(
+				nil,
+				types.DefaultParams(),
+				types.IBCPortID,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.UsedEIP712NonceEntry{
+					types.NewUsedEIP712NonceEntry([]byte{0x02, 0x01}, 1),
+					types.NewUsedEIP712NonceEntry([]byte{0x02, 0x01}, 1),
+				},
 			),
 			shouldErr: true,
 		},
@@ -193,6 +393,36 @@ func TestValidateGenesis(t *testing.T) {
 						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					),
 				},
+				[]types.DTagListing{
+					types.NewDTagListing(
+						"dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						sdk.NewCoin("udsm", sdk.NewInt(100)),
+						"new_dtag",
+						time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				[]types.DTagBid{
+					types.NewDTagBid(
+						"dtag",
+						"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						sdk.NewCoin("udsm", sdk.NewInt(100)),
+						time.Date(2030, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				[]types.DTagHistoryEntry{
+					types.NewDTagHistoryEntry(
+						"old_dtag",
+						"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+						10,
+						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				[]types.UsedEIP712NonceEntry{
+					types.NewUsedEIP712NonceEntry([]byte{0x02, 0x01}, 1),
+					types.NewUsedEIP712NonceEntry([]byte{0x02, 0x01}, 2),
+				},
 			),
 			shouldErr: false,
 		},
@@ -265,3 +495,41 @@ func TestDefaultExternalAddressEntry_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestUsedEIP712NonceEntry_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		entry     types.UsedEIP712NonceEntry
+		shouldErr bool
+	}{
+		{
+			name:      "empty public key returns error",
+			entry:     types.NewUsedEIP712NonceEntry(nil, 1),
+			shouldErr: true,
+		},
+		{
+			name:      "too long public key returns error",
+			entry:     types.NewUsedEIP712NonceEntry(make([]byte, 256), 1),
+			shouldErr: true,
+		},
+		{
+			name:      "valid entry returns no error",
+			entry:     types.NewUsedEIP712NonceEntry([]byte{0x02, 0x01}, 0),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.entry.Validate()
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 // DONTCOVER
@@ -26,6 +27,12 @@ const (
 	ActionLinkApplication           = "link_application"
 	ActionUnlinkApplication         = "unlink_application"
 	ActionSetDefaultExternalAddress = "set_default_external_address"
+	ActionListDTag                  = "list_dtag"
+	ActionCancelDTagListing         = "cancel_dtag_listing"
+	ActionBuyDTag                   = "buy_dtag"
+	ActionPlaceDTagBid              = "place_dtag_bid"
+	ActionCancelDTagBid             = "cancel_dtag_bid"
+	ActionAcceptDTagBid             = "accept_dtag_bid"
 	ActionUpdateParams              = "update_params"
 
 	DoNotModify = "[do-not-modify]"
@@ -51,6 +58,16 @@ var (
 	ExpiringAppLinkTimePrefix    = []byte{0x17}
 	DefaultExternalAddressPrefix = []byte{0x18}
 
+	DTagListingPrefix             = []byte{0x19}
+	DTagBidPrefix                 = []byte{0x1A}
+	ExpiringDTagListingTimePrefix = []byte{0x1B}
+	ExpiringDTagBidTimePrefix     = []byte{0x1C}
+
+	DTagHistoryPrefix     = []byte{0x1D}
+	UserDTagHistoryPrefix = []byte{0x1E}
+
+	UsedEIP712NoncePrefix = []byte{0x1F}
+
 	ParamsKey = []byte{0x20}
 )
 
@@ -186,3 +203,79 @@ func ApplicationLinkExpiringTimePrefix(expirationTime time.Time) []byte {
 func ApplicationLinkExpiringTimeKey(expirationTime time.Time, clientID string) []byte {
 	return append(ApplicationLinkExpiringTimePrefix(expirationTime), []byte(clientID)...)
 }
+
+// DTagListingStoreKey returns the store key used to save the DTag listing of the given owner
+func DTagListingStoreKey(owner string) []byte {
+	return append(DTagListingPrefix, []byte(owner)...)
+}
+
+// IncomingDTagBidsPrefix returns the prefix used to store all the DTag bids that have been made
+// towards the given owner
+func IncomingDTagBidsPrefix(owner string) []byte {
+	return append(DTagBidPrefix, []byte(owner)...)
+}
+
+// DTagBidStoreKey returns the store key used to save the DTag bid made from the buyer towards the owner
+func DTagBidStoreKey(owner, buyer string) []byte {
+	return append(IncomingDTagBidsPrefix(owner), []byte(buyer)...)
+}
+
+// DTagListingExpiringTimePrefix returns the store prefix used to identify the DTag listings
+// that expire at the given time
+func DTagListingExpiringTimePrefix(expirationTime time.Time) []byte {
+	return append(ExpiringDTagListingTimePrefix, sdk.FormatTimeBytes(expirationTime)...)
+}
+
+// DTagListingExpiringTimeKey returns the key used to store the expiration time of the DTag listing
+// of the given owner
+func DTagListingExpiringTimeKey(expirationTime time.Time, owner string) []byte {
+	return append(DTagListingExpiringTimePrefix(expirationTime), []byte(owner)...)
+}
+
+// DTagBidExpiringTimePrefix returns the store prefix used to identify the DTag bids
+// that expire at the given time
+func DTagBidExpiringTimePrefix(expirationTime time.Time) []byte {
+	return append(ExpiringDTagBidTimePrefix, sdk.FormatTimeBytes(expirationTime)...)
+}
+
+// DTagBidExpiringTimeKey returns the key used to store the expiration time of the DTag bid
+// made from the buyer towards the owner
+func DTagBidExpiringTimeKey(expirationTime time.Time, owner, buyer string) []byte {
+	return append(DTagBidExpiringTimePrefix(expirationTime), DTagBidStoreKey(owner, buyer)...)
+}
+
+// DTagHistoryEntriesPrefix returns the store prefix used to identify all the history entries of the given DTag
+func DTagHistoryEntriesPrefix(dTag string) []byte {
+	return append(DTagHistoryPrefix, append([]byte(strings.ToLower(dTag)), Separator...)...)
+}
+
+// DTagHistoryStoreKey returns the key used to store the history entry telling that the given address
+// released the provided DTag at the given height
+func DTagHistoryStoreKey(dTag string, height uint64, address string) []byte {
+	return append(DTagHistoryEntriesPrefix(dTag), append(sdk.Uint64ToBigEndian(height), []byte(address)...)...)
+}
+
+// UserDTagHistoryEntriesPrefix returns the store prefix used to identify all the history entries of the DTags
+// that have been held by the given user
+func UserDTagHistoryEntriesPrefix(user string) []byte {
+	return append(UserDTagHistoryPrefix, append([]byte(user), Separator...)...)
+}
+
+// UserDTagHistoryStoreKey returns the key used to store the reference to the history entry telling that the given
+// user released the provided DTag at the given height
+func UserDTagHistoryStoreKey(user string, height uint64, dTag string) []byte {
+	return append(UserDTagHistoryEntriesPrefix(user), append(sdk.Uint64ToBigEndian(height), []byte(strings.ToLower(dTag))...)...)
+}
+
+// UsedEIP712NonceStoreKey returns the key used to store the given EIP-712 nonce as already used
+// by the provided public key
+func UsedEIP712NonceStoreKey(pubKey []byte, nonce uint64) []byte {
+	return append(UsedEIP712NoncePrefix, append(address.MustLengthPrefix(pubKey), sdk.Uint64ToBigEndian(nonce)...)...)
+}
+
+// GetUsedEIP712NonceData returns the public key and the nonce from the given UsedEIP712NonceStoreKey
+func GetUsedEIP712NonceData(key []byte) (pubKey []byte, nonce uint64) {
+	cleanedKey := bytes.TrimPrefix(key, UsedEIP712NoncePrefix)
+	pubKeyLen := int(cleanedKey[0])
+	return cleanedKey[1 : 1+pubKeyLen], sdk.BigEndianToUint64(cleanedKey[1+pubKeyLen:])
+}
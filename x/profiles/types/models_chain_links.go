@@ -2,8 +2,11 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -15,15 +18,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	btcbech32 "github.com/cosmos/btcutil/bech32"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/gogo/protobuf/proto"
 	"github.com/mr-tron/base58"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/types/crypto/ethsecp256k1"
 )
 
 // NewChainConfig allows to build a new ChainConfig instance
@@ -81,6 +89,32 @@ func (p Proof) GetSignature() (Signature, error) {
 	return signature, nil
 }
 
+// GetEIP712Nonce returns the public key that has signed this proof along with the signed nonce, if the proof
+// contains an EIP-712 signature. If that is not the case, false is returned instead
+func (p Proof) GetEIP712Nonce(cdc codec.BinaryCodec) (pubKey cryptotypes.PubKey, nonce uint64, found bool, err error) {
+	signature, ok := p.Signature.GetCachedValue().(*SingleSignature)
+	if !ok || signature.ValueType != SIGNATURE_VALUE_TYPE_EVM_EIP712 {
+		return nil, 0, false, nil
+	}
+
+	err = cdc.UnpackAny(p.PubKey, &pubKey)
+	if err != nil {
+		return nil, 0, false, fmt.Errorf("failed to unpack the public key")
+	}
+
+	value, err := hex.DecodeString(p.PlainText)
+	if err != nil {
+		return nil, 0, false, fmt.Errorf("error while decoding proof text: %s", err)
+	}
+
+	typedData, err := unmarshalDesmosChainLinkTypedData(cdc, value)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	return pubKey, typedData.Nonce, true, nil
+}
+
 // Validate checks the validity of the Proof
 func (p Proof) Validate() error {
 	if p.PubKey == nil {
@@ -240,6 +274,233 @@ func ValidatePersonalSignValue(value []byte, expectedValue string) error {
 	return nil
 }
 
+// ValidateEIP712Value tells whether the given value has been properly encoded as a Protobuf DesmosChainLinkTypedData
+// instance containing the expected value as the owner field value
+func ValidateEIP712Value(value []byte, expectedOwner string, cdc codec.BinaryCodec) error {
+	typedData, err := unmarshalDesmosChainLinkTypedData(cdc, value)
+	if err != nil {
+		return err
+	}
+
+	err = typedData.Validate()
+	if err != nil {
+		return err
+	}
+
+	if typedData.Owner != expectedOwner {
+		return fmt.Errorf("invalid signed owner: expected %s, got %s", expectedOwner, typedData.Owner)
+	}
+
+	return nil
+}
+
+// unmarshalDesmosChainLinkTypedData deserializes the given value as a DesmosChainLinkTypedData instance,
+// making sure it has been canonically encoded
+func unmarshalDesmosChainLinkTypedData(cdc codec.BinaryCodec, value []byte) (DesmosChainLinkTypedData, error) {
+	var typedData DesmosChainLinkTypedData
+	err := cdc.Unmarshal(value, &typedData)
+	if err != nil {
+		return DesmosChainLinkTypedData{}, err
+	}
+
+	// Check to make sure the value was a DesmosChainLinkTypedData. If that's not the case, the two arrays will not match
+	if !bytes.Equal(value, cdc.MustMarshal(&typedData)) {
+		return DesmosChainLinkTypedData{}, fmt.Errorf("invalid signed typed data")
+	}
+
+	return typedData, nil
+}
+
+// ValidateBitcoinSignedMessageValue tells whether the given value has been properly encoded using the BIP-137
+// Bitcoin Signed Message specification
+func ValidateBitcoinSignedMessageValue(value []byte, expectedValue string) error {
+	expectedSignedValue := GetBitcoinSignedMessageValue(expectedValue)
+	if !bytes.Equal(value, expectedSignedValue) {
+		return fmt.Errorf("invalid signed value: expected %s but got %s", expectedSignedValue, value)
+	}
+
+	return nil
+}
+
+// GetBitcoinSignedMessageValue returns the value that must be signed in order to sign the given message
+// following the BIP-137 Bitcoin Signed Message specification
+func GetBitcoinSignedMessageValue(message string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("\x18Bitcoin Signed Message:\n")
+	buf.Write(encodeBitcoinVarInt(uint64(len(message))))
+	buf.WriteString(message)
+	return buf.Bytes()
+}
+
+// encodeBitcoinVarInt encodes the given value using the Bitcoin variable length integer encoding
+func encodeBitcoinVarInt(value uint64) []byte {
+	switch {
+	case value < 0xfd:
+		return []byte{byte(value)}
+	case value <= math.MaxUint16:
+		bz := make([]byte, 3)
+		bz[0] = 0xfd
+		binary.LittleEndian.PutUint16(bz[1:], uint16(value))
+		return bz
+	case value <= math.MaxUint32:
+		bz := make([]byte, 5)
+		bz[0] = 0xfe
+		binary.LittleEndian.PutUint32(bz[1:], uint32(value))
+		return bz
+	default:
+		bz := make([]byte, 9)
+		bz[0] = 0xff
+		binary.LittleEndian.PutUint64(bz[1:], value)
+		return bz
+	}
+}
+
+// ValidateSolanaOffchainMessageValue tells whether the given value has been properly encoded using the Solana
+// off-chain message signing specification
+func ValidateSolanaOffchainMessageValue(value []byte, expectedValue string) error {
+	expectedSignedValue := GetSolanaOffchainMessageValue(expectedValue)
+	if !bytes.Equal(value, expectedSignedValue) {
+		return fmt.Errorf("invalid signed value: expected %s but got %s", expectedSignedValue, value)
+	}
+
+	return nil
+}
+
+const (
+	// solanaOffchainMessageSigningDomain represents the prefix of all the Solana off-chain messages
+	solanaOffchainMessageSigningDomain = "\xffsolana offchain"
+
+	// solanaOffchainMessageMaxLedgerLength represents the max length of the messages that can be signed
+	// using a Ledger device, which are the only ones that can use the restricted ASCII and limited UTF-8 formats
+	solanaOffchainMessageMaxLedgerLength = 1212
+)
+
+// GetSolanaOffchainMessageValue returns the value that must be signed in order to sign the given message
+// following the version 0 of the Solana off-chain message signing specification
+func GetSolanaOffchainMessageValue(message string) []byte {
+	// Get the message format
+	var format byte
+	switch {
+	case len(message) <= solanaOffchainMessageMaxLedgerLength && isPrintableASCII(message):
+		format = 0 // Restricted ASCII
+	case len(message) <= solanaOffchainMessageMaxLedgerLength:
+		format = 1 // Limited UTF-8
+	default:
+		format = 2 // Extended UTF-8
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(solanaOffchainMessageSigningDomain)
+	buf.WriteByte(0) // Header version
+	buf.WriteByte(format)
+	lengthBz := make([]byte, 2)
+	binary.LittleEndian.PutUint16(lengthBz, uint16(len(message)))
+	buf.Write(lengthBz)
+	buf.WriteString(message)
+	return buf.Bytes()
+}
+
+// isPrintableASCII tells whether the given value contains only printable ASCII characters
+func isPrintableASCII(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < 0x20 || value[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+const (
+	// EIP712DomainName represents the name of the EIP-712 domain used to sign chain links
+	EIP712DomainName = "Desmos"
+
+	// EIP712DomainVersion represents the version of the EIP-712 domain used to sign chain links
+	EIP712DomainVersion = "1"
+)
+
+var (
+	eip712DomainTypeHash    = crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId)"))
+	desmosChainLinkTypeHash = crypto.Keccak256([]byte("DesmosChainLink(string owner,uint256 nonce)"))
+)
+
+// NewEIP712Domain returns a new EIP712Domain instance
+func NewEIP712Domain(name string, version string, chainID uint64) EIP712Domain {
+	return EIP712Domain{
+		Name:    name,
+		Version: version,
+		ChainID: chainID,
+	}
+}
+
+// Validate checks the validity of the EIP712Domain
+func (d EIP712Domain) Validate() error {
+	if d.Name != EIP712DomainName {
+		return fmt.Errorf("invalid domain name: expected %s, got %s", EIP712DomainName, d.Name)
+	}
+
+	if d.Version != EIP712DomainVersion {
+		return fmt.Errorf("invalid domain version: expected %s, got %s", EIP712DomainVersion, d.Version)
+	}
+
+	if d.ChainID == 0 {
+		return fmt.Errorf("invalid domain chain id: %d", d.ChainID)
+	}
+
+	return nil
+}
+
+// GetSeparator returns the EIP-712 domain separator of this domain
+func (d EIP712Domain) GetSeparator() []byte {
+	return crypto.Keccak256(
+		eip712DomainTypeHash,
+		crypto.Keccak256([]byte(d.Name)),
+		crypto.Keccak256([]byte(d.Version)),
+		encodeEIP712Uint256(d.ChainID),
+	)
+}
+
+// NewDesmosChainLinkTypedData returns a new DesmosChainLinkTypedData instance
+func NewDesmosChainLinkTypedData(domain EIP712Domain, owner string, nonce uint64) DesmosChainLinkTypedData {
+	return DesmosChainLinkTypedData{
+		Domain: domain,
+		Owner:  owner,
+		Nonce:  nonce,
+	}
+}
+
+// Validate checks the validity of the DesmosChainLinkTypedData
+func (data DesmosChainLinkTypedData) Validate() error {
+	err := data.Domain.Validate()
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(data.Owner) == "" {
+		return fmt.Errorf("owner cannot be empty or blank")
+	}
+
+	return nil
+}
+
+// GetSigningHash returns the EIP-712 hash that should be signed for this typed data
+func (data DesmosChainLinkTypedData) GetSigningHash() []byte {
+	structHash := crypto.Keccak256(
+		desmosChainLinkTypeHash,
+		crypto.Keccak256([]byte(data.Owner)),
+		encodeEIP712Uint256(data.Nonce),
+	)
+	return crypto.Keccak256([]byte("\x19\x01"), data.Domain.GetSeparator(), structHash)
+}
+
+// encodeEIP712Uint256 encodes the given value as an EIP-712 uint256 value
+func encodeEIP712Uint256(value uint64) []byte {
+	bz := make([]byte, 32)
+	binary.BigEndian.PutUint64(bz[24:], value)
+	return bz
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 var _ Signature = &SingleSignature{}
@@ -269,6 +530,12 @@ func (s *SingleSignature) Validate(cdc codec.BinaryCodec, amino *codec.LegacyAmi
 		return ValidateRawValue(plainText, owner)
 	case SIGNATURE_VALUE_TYPE_EVM_PERSONAL_SIGN:
 		return ValidatePersonalSignValue(plainText, owner)
+	case SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE:
+		return ValidateBitcoinSignedMessageValue(plainText, owner)
+	case SIGNATURE_VALUE_TYPE_SOLANA_OFFCHAIN_MESSAGE:
+		return ValidateSolanaOffchainMessageValue(plainText, owner)
+	case SIGNATURE_VALUE_TYPE_EVM_EIP712:
+		return ValidateEIP712Value(plainText, owner, cdc)
 	default:
 		return fmt.Errorf("invalid signature type: %s", s.ValueType)
 	}
@@ -283,6 +550,24 @@ func (s *SingleSignature) Verify(cdc codec.BinaryCodec, pubKey *codectypes.Any,
 		return nil, fmt.Errorf("failed to unpack the public key")
 	}
 
+	// Bitcoin signed messages use compact recoverable signatures that must be verified separately
+	if s.ValueType == SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE {
+		err = verifyBitcoinSignedMessage(pubkey, plainText, s.Signature)
+		if err != nil {
+			return nil, err
+		}
+		return pubkey, nil
+	}
+
+	// EIP-712 signatures are verified by recovering the public key from the typed data hash
+	if s.ValueType == SIGNATURE_VALUE_TYPE_EVM_EIP712 {
+		err = verifyEIP712Signature(cdc, pubkey, plainText, s.Signature)
+		if err != nil {
+			return nil, err
+		}
+		return pubkey, nil
+	}
+
 	// Verify the signature
 	if !pubkey.VerifySignature(plainText, s.Signature) {
 		return nil, fmt.Errorf("failed to verify the signature")
@@ -291,6 +576,82 @@ func (s *SingleSignature) Verify(cdc codec.BinaryCodec, pubKey *codectypes.Any,
 	return pubkey, nil
 }
 
+// verifyEIP712Signature verifies the given EIP-712 signature of the provided Protobuf-encoded
+// DesmosChainLinkTypedData, making sure it has been created using the given public key
+func verifyEIP712Signature(cdc codec.BinaryCodec, pubKey cryptotypes.PubKey, value []byte, signature []byte) error {
+	typedData, err := unmarshalDesmosChainLinkTypedData(cdc, value)
+	if err != nil {
+		return err
+	}
+
+	if len(signature) != crypto.SignatureLength {
+		return fmt.Errorf("invalid signature length: expected %d but got %d", crypto.SignatureLength, len(signature))
+	}
+
+	// Wallets might return the recovery id using the legacy 27/28 values
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	recovered, err := crypto.SigToPub(typedData.GetSigningHash(), sig)
+	if err != nil {
+		return fmt.Errorf("failed to recover the public key: %s", err)
+	}
+
+	recoveredPubKey := &ethsecp256k1.PubKey{Key: crypto.CompressPubkey(recovered)}
+	if !recoveredPubKey.Equals(pubKey) {
+		return fmt.Errorf("failed to verify the signature")
+	}
+
+	return nil
+}
+
+// verifyBitcoinSignedMessage verifies the given BIP-137 compact signature of the provided signed message,
+// making sure it has been created using the given public key
+func verifyBitcoinSignedMessage(pubKey cryptotypes.PubKey, signedMessage []byte, signature []byte) error {
+	if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+		return fmt.Errorf("invalid public key type: %T", pubKey)
+	}
+
+	if len(signature) != 65 {
+		return fmt.Errorf("invalid signature length: expected 65 but got %d", len(signature))
+	}
+
+	// BIP-137 uses the header byte to also tell the type of address used. Since the address is verified
+	// separately, we normalize the SegWit headers to the P2PKH ones before recovering the public key
+	header := signature[0]
+	switch {
+	case header >= 27 && header <= 34:
+		// P2PKH header
+	case header >= 35 && header <= 38:
+		header -= 4 // P2SH-P2WPKH header
+	case header >= 39 && header <= 42:
+		header -= 8 // P2WPKH header
+	default:
+		return fmt.Errorf("invalid signature header: %d", header)
+	}
+
+	hash := sha256.Sum256(signedMessage)
+	hash = sha256.Sum256(hash[:])
+
+	recovered, wasCompressed, err := ecdsa.RecoverCompact(append([]byte{header}, signature[1:]...), hash[:])
+	if err != nil {
+		return fmt.Errorf("failed to recover the public key: %s", err)
+	}
+
+	if !wasCompressed {
+		return fmt.Errorf("uncompressed public keys are not supported")
+	}
+
+	if !bytes.Equal(recovered.SerializeCompressed(), pubKey.Bytes()) {
+		return fmt.Errorf("failed to verify the signature")
+	}
+
+	return nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 var _ Signature = &CosmosMultiSignature{}
@@ -637,6 +998,139 @@ func (h HexAddress) VerifyPubKey(key cryptotypes.PubKey) (bool, error) {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+const (
+	bitcoinMainNetP2PKHVersion = 0x00
+	bitcoinTestNetP2PKHVersion = 0x6f
+
+	bitcoinMainNetHRP = "bc"
+	bitcoinTestNetHRP = "tb"
+)
+
+var _ AddressData = &BitcoinAddress{}
+
+// NewBitcoinAddress returns a new BitcoinAddress instance
+func NewBitcoinAddress(value string) *BitcoinAddress {
+	return &BitcoinAddress{Value: value}
+}
+
+// NewBitcoinP2PKHAddress returns the main net P2PKH BitcoinAddress associated with the given public key
+func NewBitcoinP2PKHAddress(pubKey cryptotypes.PubKey) *BitcoinAddress {
+	payload := append([]byte{bitcoinMainNetP2PKHVersion}, pubKey.Address().Bytes()...)
+	return NewBitcoinAddress(base58.Encode(append(payload, bitcoinChecksum(payload)...)))
+}
+
+// NewBitcoinP2WPKHAddress returns the main net P2WPKH BitcoinAddress associated with the given public key
+func NewBitcoinP2WPKHAddress(pubKey cryptotypes.PubKey) *BitcoinAddress {
+	program, err := btcbech32.ConvertBits(pubKey.Address().Bytes(), 8, 5, true)
+	if err != nil {
+		panic("failed to convert the witness program")
+	}
+
+	value, err := btcbech32.Encode(bitcoinMainNetHRP, append([]byte{0}, program...))
+	if err != nil {
+		panic("failed to encode the Bech32 address")
+	}
+
+	return NewBitcoinAddress(value)
+}
+
+// Validate implements AddressData
+func (b BitcoinAddress) Validate() error {
+	if strings.TrimSpace(b.Value) == "" {
+		return fmt.Errorf("address cannot be empty or blank")
+	}
+
+	_, err := b.getPubKeyHash()
+	if err != nil {
+		return fmt.Errorf("invalid Bitcoin address: %s", err)
+	}
+
+	return nil
+}
+
+// GetValue implements AddressData
+func (b BitcoinAddress) GetValue() string {
+	return b.Value
+}
+
+// VerifyPubKey implements AddressData
+func (b BitcoinAddress) VerifyPubKey(key cryptotypes.PubKey) (bool, error) {
+	pubKeyHash, err := b.getPubKeyHash()
+	if err != nil {
+		return false, err
+	}
+
+	// Both P2PKH and P2WPKH addresses contain the HASH160 of the compressed public key,
+	// which is the same value used as the address of Cosmos secp256k1 keys
+	if _, ok := key.(*secp256k1.PubKey); !ok {
+		return false, nil
+	}
+
+	return bytes.Equal(pubKeyHash, key.Address().Bytes()), nil
+}
+
+// getPubKeyHash returns the public key hash contained inside this address
+func (b BitcoinAddress) getPubKeyHash() ([]byte, error) {
+	hrp, data, err := btcbech32.Decode(b.Value, 90)
+	if err == nil {
+		return getBitcoinWitnessPubKeyHash(hrp, data)
+	}
+	return getBitcoinBase58PubKeyHash(b.Value)
+}
+
+// getBitcoinWitnessPubKeyHash returns the public key hash contained inside the given P2WPKH address data
+func getBitcoinWitnessPubKeyHash(hrp string, data []byte) ([]byte, error) {
+	if hrp != bitcoinMainNetHRP && hrp != bitcoinTestNetHRP {
+		return nil, fmt.Errorf("invalid human readable part: %s", hrp)
+	}
+
+	if len(data) == 0 || data[0] != 0 {
+		return nil, fmt.Errorf("unsupported witness version")
+	}
+
+	program, err := btcbech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(program) != 20 {
+		return nil, fmt.Errorf("unsupported witness program length: %d", len(program))
+	}
+
+	return program, nil
+}
+
+// getBitcoinBase58PubKeyHash returns the public key hash contained inside the given P2PKH address
+func getBitcoinBase58PubKeyHash(address string) ([]byte, error) {
+	bz, err := base58.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) != 25 {
+		return nil, fmt.Errorf("invalid address length: %d", len(bz))
+	}
+
+	if bz[0] != bitcoinMainNetP2PKHVersion && bz[0] != bitcoinTestNetP2PKHVersion {
+		return nil, fmt.Errorf("unsupported address version: %d", bz[0])
+	}
+
+	if !bytes.Equal(bitcoinChecksum(bz[:21]), bz[21:]) {
+		return nil, fmt.Errorf("invalid checksum")
+	}
+
+	return bz[1:21], nil
+}
+
+// bitcoinChecksum returns the Base58Check checksum of the given payload
+func bitcoinChecksum(payload []byte) []byte {
+	hash := sha256.Sum256(payload)
+	hash = sha256.Sum256(hash[:])
+	return hash[:4]
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // UnpackAddressData deserializes the given any type value as an address data using the provided unpacker
 func UnpackAddressData(unpacker codectypes.AnyUnpacker, addressAny *codectypes.Any) (AddressData, error) {
 	var address AddressData
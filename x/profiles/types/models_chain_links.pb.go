@@ -53,6 +53,19 @@ const (
 	// SIGNATURE_VALUE_TYPE_EVM_PERSONAL_SIGN should be used when the value
 	// has been encoded following the EVM personal_sign specification
 	SIGNATURE_VALUE_TYPE_EVM_PERSONAL_SIGN SignatureValueType = 4
+	// SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE should be used when the value
+	// has been encoded following the BIP-137 Bitcoin Signed Message
+	// specification. The signature must be the 65 bytes compact recoverable
+	// signature
+	SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE SignatureValueType = 5
+	// SIGNATURE_VALUE_TYPE_SOLANA_OFFCHAIN_MESSAGE should be used when the value
+	// has been encoded following the Solana off-chain message signing
+	// specification
+	SIGNATURE_VALUE_TYPE_SOLANA_OFFCHAIN_MESSAGE SignatureValueType = 6
+	// SIGNATURE_VALUE_TYPE_EVM_EIP712 should be used when the value has been
+	// signed following the EIP-712 typed data specification. In this case, the
+	// signed value must be a Protobuf-encoded DesmosChainLinkTypedData instance
+	SIGNATURE_VALUE_TYPE_EVM_EIP712 SignatureValueType = 7
 )
 
 var SignatureValueType_name = map[int32]string{
@@ -61,14 +74,20 @@ var SignatureValueType_name = map[int32]string{
 	2: "SIGNATURE_VALUE_TYPE_COSMOS_DIRECT",
 	3: "SIGNATURE_VALUE_TYPE_COSMOS_AMINO",
 	4: "SIGNATURE_VALUE_TYPE_EVM_PERSONAL_SIGN",
+	5: "SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE",
+	6: "SIGNATURE_VALUE_TYPE_SOLANA_OFFCHAIN_MESSAGE",
+	7: "SIGNATURE_VALUE_TYPE_EVM_EIP712",
 }
 
 var SignatureValueType_value = map[string]int32{
-	"SIGNATURE_VALUE_TYPE_UNSPECIFIED":       0,
-	"SIGNATURE_VALUE_TYPE_RAW":               1,
-	"SIGNATURE_VALUE_TYPE_COSMOS_DIRECT":     2,
-	"SIGNATURE_VALUE_TYPE_COSMOS_AMINO":      3,
-	"SIGNATURE_VALUE_TYPE_EVM_PERSONAL_SIGN": 4,
+	"SIGNATURE_VALUE_TYPE_UNSPECIFIED":             0,
+	"SIGNATURE_VALUE_TYPE_RAW":                     1,
+	"SIGNATURE_VALUE_TYPE_COSMOS_DIRECT":           2,
+	"SIGNATURE_VALUE_TYPE_COSMOS_AMINO":            3,
+	"SIGNATURE_VALUE_TYPE_EVM_PERSONAL_SIGN":       4,
+	"SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE":  5,
+	"SIGNATURE_VALUE_TYPE_SOLANA_OFFCHAIN_MESSAGE": 6,
+	"SIGNATURE_VALUE_TYPE_EVM_EIP712":              7,
 }
 
 func (x SignatureValueType) String() string {
@@ -335,6 +354,47 @@ func (m *HexAddress) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HexAddress proto.InternalMessageInfo
 
+// BitcoinAddress represents a Bitcoin address.
+// NOTE: Currently it only supports P2PKH (Base58Check-encoded) and P2WPKH
+// (Bech32-encoded) addresses derived from compressed public keys
+type BitcoinAddress struct {
+	// Value represents the Bitcoin address value
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+}
+
+func (m *BitcoinAddress) Reset()         { *m = BitcoinAddress{} }
+func (m *BitcoinAddress) String() string { return proto.CompactTextString(m) }
+func (*BitcoinAddress) ProtoMessage()    {}
+func (*BitcoinAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29bee920e792da29, []int{6}
+}
+func (m *BitcoinAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BitcoinAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BitcoinAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BitcoinAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BitcoinAddress.Merge(m, src)
+}
+func (m *BitcoinAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *BitcoinAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BitcoinAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BitcoinAddress proto.InternalMessageInfo
+
 // SingleSignature is the signature data for a single signer
 type SingleSignature struct {
 	// Type represents the type of the signature value
@@ -347,7 +407,7 @@ func (m *SingleSignature) Reset()         { *m = SingleSignature{} }
 func (m *SingleSignature) String() string { return proto.CompactTextString(m) }
 func (*SingleSignature) ProtoMessage()    {}
 func (*SingleSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29bee920e792da29, []int{6}
+	return fileDescriptor_29bee920e792da29, []int{7}
 }
 func (m *SingleSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +448,7 @@ func (m *CosmosMultiSignature) Reset()         { *m = CosmosMultiSignature{} }
 func (m *CosmosMultiSignature) String() string { return proto.CompactTextString(m) }
 func (*CosmosMultiSignature) ProtoMessage()    {}
 func (*CosmosMultiSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29bee920e792da29, []int{7}
+	return fileDescriptor_29bee920e792da29, []int{8}
 }
 func (m *CosmosMultiSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +477,97 @@ func (m *CosmosMultiSignature) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CosmosMultiSignature proto.InternalMessageInfo
 
+// DesmosChainLinkTypedData contains the data of the DesmosChainLink EIP-712
+// typed data message that should be signed when using the
+// SIGNATURE_VALUE_TYPE_EVM_EIP712 signature value type
+type DesmosChainLinkTypedData struct {
+	// Domain represents the EIP-712 domain of the message
+	Domain EIP712Domain `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain" yaml:"domain"`
+	// Owner represents the Desmos address of the chain link owner
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// Nonce represents a value chosen by the signer that can be used only once
+	// for each external address, so that the signature cannot be replayed
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty" yaml:"nonce"`
+}
+
+func (m *DesmosChainLinkTypedData) Reset()         { *m = DesmosChainLinkTypedData{} }
+func (m *DesmosChainLinkTypedData) String() string { return proto.CompactTextString(m) }
+func (*DesmosChainLinkTypedData) ProtoMessage()    {}
+func (*DesmosChainLinkTypedData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29bee920e792da29, []int{9}
+}
+func (m *DesmosChainLinkTypedData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesmosChainLinkTypedData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesmosChainLinkTypedData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesmosChainLinkTypedData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesmosChainLinkTypedData.Merge(m, src)
+}
+func (m *DesmosChainLinkTypedData) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesmosChainLinkTypedData) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesmosChainLinkTypedData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesmosChainLinkTypedData proto.InternalMessageInfo
+
+// EIP712Domain contains the data of an EIP-712 domain
+type EIP712Domain struct {
+	// Name represents the name of the signing domain
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Version represents the current major version of the signing domain
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" yaml:"version"`
+	// ChainID represents the EVM chain id of the signer wallet. It is required
+	// by wallets to sign the message, but it is not checked since chain links
+	// are not bound to any specific EVM chain
+	ChainID uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+}
+
+func (m *EIP712Domain) Reset()         { *m = EIP712Domain{} }
+func (m *EIP712Domain) String() string { return proto.CompactTextString(m) }
+func (*EIP712Domain) ProtoMessage()    {}
+func (*EIP712Domain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29bee920e792da29, []int{10}
+}
+func (m *EIP712Domain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EIP712Domain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EIP712Domain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EIP712Domain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EIP712Domain.Merge(m, src)
+}
+func (m *EIP712Domain) XXX_Size() int {
+	return m.Size()
+}
+func (m *EIP712Domain) XXX_DiscardUnknown() {
+	xxx_messageInfo_EIP712Domain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EIP712Domain proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("desmos.profiles.v3.SignatureValueType", SignatureValueType_name, SignatureValueType_value)
 	proto.RegisterType((*ChainLink)(nil), "desmos.profiles.v3.ChainLink")
@@ -425,8 +576,11 @@ func init() {
 	proto.RegisterType((*Bech32Address)(nil), "desmos.profiles.v3.Bech32Address")
 	proto.RegisterType((*Base58Address)(nil), "desmos.profiles.v3.Base58Address")
 	proto.RegisterType((*HexAddress)(nil), "desmos.profiles.v3.HexAddress")
+	proto.RegisterType((*BitcoinAddress)(nil), "desmos.profiles.v3.BitcoinAddress")
 	proto.RegisterType((*SingleSignature)(nil), "desmos.profiles.v3.SingleSignature")
 	proto.RegisterType((*CosmosMultiSignature)(nil), "desmos.profiles.v3.CosmosMultiSignature")
+	proto.RegisterType((*DesmosChainLinkTypedData)(nil), "desmos.profiles.v3.DesmosChainLinkTypedData")
+	proto.RegisterType((*EIP712Domain)(nil), "desmos.profiles.v3.EIP712Domain")
 }
 
 func init() {
@@ -434,70 +588,84 @@ func init() {
 }
 
 var fileDescriptor_29bee920e792da29 = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdb, 0x54,
-	0x1c, 0x8f, 0xdb, 0xb4, 0x23, 0xaf, 0xe9, 0x96, 0x3e, 0x02, 0x4a, 0xcb, 0x14, 0x17, 0xc3, 0xca,
-	0x28, 0xaa, 0xad, 0xb6, 0x9b, 0x40, 0xe1, 0x42, 0x9c, 0x86, 0x2d, 0xac, 0xbf, 0xe4, 0xa4, 0x45,
-	0x20, 0x24, 0xcb, 0x4e, 0x5e, 0x5c, 0xab, 0xfe, 0x25, 0xdb, 0x89, 0x92, 0x1b, 0xc7, 0x89, 0xd3,
-	0x8e, 0x1c, 0x2b, 0x21, 0x24, 0x24, 0x2e, 0x43, 0xda, 0x1f, 0x31, 0x71, 0x9a, 0x38, 0x20, 0xb8,
-	0x04, 0xd4, 0x1e, 0xb6, 0x73, 0x4e, 0x1c, 0xd1, 0xfb, 0xe1, 0xd8, 0xa4, 0x69, 0xab, 0x0a, 0xed,
-	0x12, 0xf9, 0xbd, 0xef, 0xe7, 0xfb, 0xf9, 0xfe, 0xfa, 0xbc, 0xf7, 0x02, 0x3e, 0x6a, 0xa1, 0xc0,
-	0x76, 0x03, 0xc9, 0xf3, 0xdd, 0xb6, 0x69, 0xa1, 0x40, 0xea, 0x6e, 0x4a, 0xb6, 0xdb, 0x42, 0x56,
-	0xa0, 0x36, 0x8f, 0x34, 0xd3, 0x51, 0x2d, 0xd3, 0x39, 0x0e, 0x44, 0xcf, 0x77, 0x43, 0x17, 0x42,
-	0x0a, 0x16, 0x23, 0xb0, 0xd8, 0xdd, 0x5c, 0x5a, 0xd0, 0x6c, 0xd3, 0x71, 0x25, 0xf2, 0x4b, 0x61,
-	0x4b, 0x79, 0xc3, 0x35, 0x5c, 0xf2, 0x29, 0xe1, 0x2f, 0xb6, 0xbb, 0x68, 0xb8, 0xae, 0x61, 0x21,
-	0x89, 0xac, 0xf4, 0x4e, 0x5b, 0xd2, 0x9c, 0x3e, 0x33, 0xf1, 0xe3, 0xa6, 0xd0, 0xb4, 0x51, 0x10,
-	0x6a, 0xb6, 0x17, 0xf9, 0x36, 0x5d, 0x1c, 0x58, 0xa5, 0xa4, 0x74, 0xc1, 0x4c, 0x6b, 0x74, 0x25,
-	0x35, 0xfd, 0xbe, 0x17, 0xba, 0x92, 0xdd, 0xb1, 0x42, 0x33, 0x30, 0x0d, 0xa9, 0xbb, 0xae, 0xa3,
-	0x50, 0x5b, 0x1f, 0x6d, 0x50, 0xb8, 0xf0, 0xfb, 0x34, 0xc8, 0x54, 0x70, 0x61, 0xdb, 0xa6, 0x73,
-	0x0c, 0x3f, 0x05, 0xe9, 0x4e, 0x80, 0xfc, 0x02, 0xb7, 0xcc, 0xdd, 0xcd, 0xc8, 0x1f, 0x0c, 0x07,
-	0xfc, 0x5c, 0x5f, 0xb3, 0xad, 0x92, 0x80, 0x77, 0x85, 0xdf, 0x9e, 0xad, 0xe5, 0x59, 0xac, 0x72,
-	0xab, 0xe5, 0xa3, 0x20, 0xa8, 0x87, 0xbe, 0xe9, 0x18, 0x0a, 0x71, 0x82, 0x2d, 0x70, 0x43, 0xa3,
-	0xdb, 0x85, 0xa9, 0x65, 0xee, 0xee, 0xdc, 0x46, 0x5e, 0xa4, 0x75, 0x88, 0x51, 0x1d, 0x62, 0xd9,
-	0xe9, 0xcb, 0xf7, 0x86, 0x03, 0xfe, 0x26, 0x65, 0x65, 0x70, 0xe1, 0xd7, 0x67, 0x6b, 0xc5, 0xf3,
-	0xad, 0x8c, 0x82, 0x6c, 0x69, 0xa1, 0xa6, 0x44, 0xd4, 0xf0, 0x0b, 0x30, 0xe3, 0xf9, 0xae, 0xdb,
-	0x2e, 0x4c, 0x93, 0x18, 0x8b, 0xe2, 0x04, 0xc7, 0x7d, 0x0c, 0x90, 0x17, 0x9f, 0x0f, 0xf8, 0xd4,
-	0x70, 0xc0, 0x67, 0x69, 0x30, 0xe2, 0x25, 0xfc, 0xf4, 0xf2, 0xe9, 0x2a, 0xa7, 0x50, 0x0a, 0xd8,
-	0x02, 0x59, 0x3a, 0xd4, 0xa6, 0xeb, 0xb4, 0x4d, 0xa3, 0x90, 0x26, 0x94, 0xfc, 0x24, 0x4a, 0xd2,
-	0xa3, 0x0a, 0x81, 0xc9, 0xcb, 0x8c, 0xf8, 0x4d, 0x4a, 0x9c, 0xa4, 0x60, 0xfc, 0x73, 0xcd, 0x18,
-	0x0e, 0xdb, 0x60, 0xbe, 0xe9, 0x23, 0x2d, 0x34, 0x5d, 0x47, 0xc5, 0x83, 0x2c, 0xcc, 0x90, 0x30,
-	0x4b, 0xe7, 0xba, 0xd3, 0x88, 0xa6, 0x2c, 0xdf, 0x61, 0x11, 0xf2, 0x2c, 0x42, 0xd2, 0x5d, 0x78,
-	0xf2, 0x17, 0xcf, 0xd1, 0x30, 0xd9, 0xc8, 0x80, 0x3d, 0x4b, 0xd9, 0xc7, 0x27, 0x7c, 0xea, 0xfb,
-	0x13, 0x9e, 0x7b, 0x75, 0xc2, 0x73, 0xc2, 0x67, 0x60, 0x2e, 0x91, 0x33, 0x7c, 0x0f, 0xa4, 0x1d,
-	0xcd, 0x46, 0x6c, 0xb2, 0xb7, 0xe2, 0xc9, 0xe2, 0x5d, 0x41, 0x21, 0xc6, 0x31, 0x86, 0x7f, 0x38,
-	0x30, 0x43, 0x3a, 0x09, 0xcb, 0xe0, 0x86, 0xd7, 0xd1, 0xd5, 0x63, 0xd4, 0x27, 0xfe, 0x17, 0x4d,
-	0x16, 0xc6, 0x93, 0x65, 0x70, 0x41, 0x99, 0xf5, 0x3a, 0xfa, 0x23, 0xd4, 0x87, 0x47, 0x20, 0x13,
-	0x98, 0x86, 0xa3, 0x85, 0x1d, 0x1f, 0x5d, 0x29, 0x8f, 0x1c, 0x25, 0x19, 0x39, 0x60, 0x81, 0xdc,
-	0x9e, 0x30, 0x94, 0x7a, 0x04, 0x50, 0x62, 0x72, 0x78, 0x0f, 0x00, 0xcf, 0xc2, 0x13, 0x09, 0x51,
-	0x2f, 0x24, 0x2a, 0xc9, 0xc8, 0x6f, 0x0d, 0x07, 0xfc, 0x02, 0xcb, 0x6c, 0x64, 0x13, 0x94, 0x0c,
-	0x59, 0x34, 0x50, 0x2f, 0x1c, 0x2b, 0xfd, 0x67, 0x0e, 0xcc, 0xcb, 0xa8, 0x79, 0xb4, 0xb9, 0xc1,
-	0x34, 0x08, 0x57, 0xc0, 0x4c, 0x57, 0xb3, 0x3a, 0x51, 0x03, 0x73, 0xb1, 0xae, 0xc8, 0xb6, 0xa0,
-	0x50, 0x33, 0xfc, 0x10, 0xcc, 0x7a, 0x3e, 0x6a, 0x9b, 0x3d, 0x52, 0x64, 0x46, 0x5e, 0x18, 0x0e,
-	0xf8, 0xf9, 0x48, 0x80, 0x78, 0x1f, 0xb7, 0x84, 0x7c, 0x94, 0x1e, 0x24, 0x43, 0x5e, 0x7d, 0x04,
-	0xbe, 0x7b, 0xf9, 0x74, 0x35, 0xcf, 0x6e, 0xa7, 0xff, 0xe4, 0x26, 0x7c, 0x8b, 0xb3, 0xd5, 0x02,
-	0x74, 0xff, 0x93, 0x6b, 0x66, 0xfb, 0xbf, 0x52, 0x48, 0x06, 0x14, 0x7e, 0xe4, 0x00, 0x78, 0x88,
-	0x7a, 0xaf, 0xb1, 0x5b, 0x5b, 0xd7, 0x4f, 0x75, 0x81, 0xa5, 0x1a, 0x27, 0x26, 0xbc, 0xe2, 0xc0,
-	0xad, 0xba, 0xe9, 0x18, 0x16, 0x1a, 0x69, 0x07, 0x7e, 0x03, 0x00, 0xc9, 0x46, 0x0d, 0xfb, 0x1e,
-	0xcd, 0xf8, 0xe6, 0xc6, 0x8a, 0x78, 0x99, 0xdc, 0x0e, 0x31, 0xbc, 0xd1, 0xf7, 0x50, 0x52, 0x58,
-	0x31, 0x87, 0xa0, 0x64, 0xba, 0x11, 0x02, 0x6e, 0x8c, 0x0b, 0x3f, 0x2b, 0xe7, 0x27, 0x49, 0x3c,
-	0x21, 0xe1, 0x52, 0x05, 0xd7, 0xca, 0xea, 0xbc, 0x54, 0xf7, 0xb8, 0xca, 0xb7, 0x59, 0x95, 0x63,
-	0x65, 0x09, 0xbf, 0x4c, 0x81, 0x7c, 0x85, 0xdc, 0xd6, 0x3b, 0xf8, 0xca, 0x8f, 0xeb, 0xd5, 0x41,
-	0x46, 0x37, 0x43, 0x55, 0xf3, 0x7d, 0x2d, 0x3a, 0xcf, 0x92, 0xc8, 0xee, 0x75, 0xfa, 0x6a, 0x88,
-	0xa3, 0x47, 0x82, 0xbd, 0x1a, 0x62, 0xc5, 0xb5, 0x3d, 0xad, 0x19, 0xca, 0x66, 0x58, 0xc6, 0x6e,
-	0xc9, 0x12, 0x46, 0x5c, 0x82, 0xf2, 0x86, 0xce, 0xec, 0xf0, 0x18, 0x80, 0x51, 0x39, 0xf8, 0x39,
-	0x98, 0xbe, 0xf0, 0xbc, 0xdf, 0x8f, 0x3b, 0x18, 0x7b, 0x5c, 0x7d, 0xe0, 0x13, 0xf4, 0xa5, 0x87,
-	0xd7, 0x69, 0xd7, 0x3b, 0xac, 0x5d, 0x93, 0x5a, 0xb3, 0xfa, 0x27, 0x07, 0xe0, 0xf9, 0x29, 0xc3,
-	0xf7, 0xc1, 0x72, 0xbd, 0xf6, 0x60, 0xb7, 0xdc, 0x38, 0x50, 0xaa, 0xea, 0x61, 0x79, 0xfb, 0xa0,
-	0xaa, 0x36, 0xbe, 0xda, 0xaf, 0xaa, 0x07, 0xbb, 0xf5, 0xfd, 0x6a, 0xa5, 0xf6, 0x79, 0xad, 0xba,
-	0x95, 0x4b, 0xc1, 0xdb, 0xa0, 0x30, 0x11, 0xa5, 0x94, 0xbf, 0xcc, 0x71, 0x70, 0x05, 0x08, 0x13,
-	0xad, 0x95, 0xbd, 0xfa, 0xce, 0x5e, 0x5d, 0xdd, 0xaa, 0x29, 0xd5, 0x4a, 0x23, 0x37, 0x05, 0xef,
-	0x80, 0x77, 0x2f, 0xc3, 0x95, 0x77, 0x6a, 0xbb, 0x7b, 0xb9, 0x69, 0xb8, 0x0a, 0x56, 0x26, 0xc2,
-	0xaa, 0x87, 0x3b, 0xea, 0x7e, 0x55, 0xa9, 0xef, 0xed, 0x96, 0xb7, 0x55, 0x8c, 0xc8, 0xa5, 0x97,
-	0xd2, 0x8f, 0x7f, 0x28, 0xa6, 0xe4, 0x47, 0xcf, 0x4f, 0x8b, 0xdc, 0x8b, 0xd3, 0x22, 0xf7, 0xf7,
-	0x69, 0x91, 0x7b, 0x72, 0x56, 0x4c, 0xbd, 0x38, 0x2b, 0xa6, 0xfe, 0x38, 0x2b, 0xa6, 0xbe, 0x5e,
-	0x37, 0xcc, 0xf0, 0xa8, 0xa3, 0x8b, 0x4d, 0xd7, 0x96, 0x68, 0x77, 0xd6, 0x2c, 0x4d, 0x0f, 0xd8,
-	0xb7, 0xd4, 0xfd, 0x58, 0xea, 0xc5, 0xff, 0x87, 0xb0, 0xc0, 0x03, 0x7d, 0x96, 0xcc, 0x70, 0xf3,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x86, 0xf3, 0x2e, 0x7c, 0x2f, 0x09, 0x00, 0x00,
+	// 1232 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdb, 0xe4,
+	0x1b, 0x8f, 0xfb, 0x73, 0x79, 0x9b, 0x6d, 0xe9, 0xfb, 0xcd, 0xbe, 0xca, 0xca, 0x14, 0x97, 0x77,
+	0xac, 0x8c, 0x6e, 0xb5, 0x69, 0xbb, 0x69, 0x10, 0x24, 0x84, 0x9d, 0x78, 0x6b, 0x58, 0x9b, 0x54,
+	0x4e, 0x36, 0x04, 0x42, 0xb2, 0x9c, 0xe4, 0x4d, 0x6a, 0x35, 0xb1, 0x2d, 0xdb, 0x09, 0xcd, 0x0d,
+	0x71, 0x9a, 0x38, 0xed, 0xc8, 0x71, 0x12, 0x02, 0x21, 0x71, 0x19, 0xd2, 0xfe, 0x88, 0x89, 0xd3,
+	0xc4, 0x01, 0x71, 0x0a, 0xd0, 0x1d, 0xb6, 0x73, 0x4e, 0x1c, 0xd1, 0xfb, 0xc3, 0xb1, 0xd7, 0x65,
+	0x1d, 0x15, 0xe2, 0x12, 0xbd, 0x7e, 0x9f, 0xcf, 0xf3, 0x79, 0x7e, 0xbf, 0x4f, 0xc0, 0x95, 0x26,
+	0xf6, 0xbb, 0x8e, 0x2f, 0xbb, 0x9e, 0xd3, 0xb2, 0x3a, 0xd8, 0x97, 0xfb, 0x9b, 0x72, 0xd7, 0x69,
+	0xe2, 0x8e, 0x6f, 0x34, 0xf6, 0x4c, 0xcb, 0x36, 0x3a, 0x96, 0xbd, 0xef, 0x4b, 0xae, 0xe7, 0x04,
+	0x0e, 0x84, 0x0c, 0x2c, 0x85, 0x60, 0xa9, 0xbf, 0xb9, 0xb4, 0x68, 0x76, 0x2d, 0xdb, 0x91, 0xe9,
+	0x2f, 0x83, 0x2d, 0x65, 0xda, 0x4e, 0xdb, 0xa1, 0x47, 0x99, 0x9c, 0xf8, 0xed, 0xf9, 0xb6, 0xe3,
+	0xb4, 0x3b, 0x58, 0xa6, 0x5f, 0xf5, 0x5e, 0x4b, 0x36, 0xed, 0x01, 0x17, 0x89, 0x47, 0x45, 0x81,
+	0xd5, 0xc5, 0x7e, 0x60, 0x76, 0xdd, 0x50, 0xb7, 0xe1, 0x10, 0xc3, 0x06, 0x23, 0x65, 0x1f, 0x5c,
+	0xb4, 0xc6, 0xbe, 0xe4, 0x86, 0x37, 0x70, 0x03, 0x47, 0xee, 0xf6, 0x3a, 0x81, 0xe5, 0x5b, 0x6d,
+	0xb9, 0xbf, 0x5e, 0xc7, 0x81, 0xb9, 0x3e, 0xbe, 0x60, 0x70, 0xf4, 0xeb, 0x34, 0x48, 0x16, 0x48,
+	0x60, 0xdb, 0x96, 0xbd, 0x0f, 0x3f, 0x00, 0x33, 0x3d, 0x1f, 0x7b, 0x59, 0x61, 0x59, 0xb8, 0x9c,
+	0x54, 0xdf, 0x1e, 0x0d, 0xc5, 0x85, 0x81, 0xd9, 0xed, 0xe4, 0x11, 0xb9, 0x45, 0xbf, 0x3c, 0x5a,
+	0xcb, 0x70, 0x5b, 0x4a, 0xb3, 0xe9, 0x61, 0xdf, 0xaf, 0x06, 0x9e, 0x65, 0xb7, 0x75, 0xaa, 0x04,
+	0x9b, 0x60, 0xde, 0x64, 0xd7, 0xd9, 0xa9, 0x65, 0xe1, 0xf2, 0xc2, 0x46, 0x46, 0x62, 0x71, 0x48,
+	0x61, 0x1c, 0x92, 0x62, 0x0f, 0xd4, 0x6b, 0xa3, 0xa1, 0x78, 0x86, 0xb1, 0x72, 0x38, 0xfa, 0xf9,
+	0xd1, 0x5a, 0xee, 0xe5, 0x54, 0x86, 0x46, 0x8a, 0x66, 0x60, 0xea, 0x21, 0x35, 0xfc, 0x18, 0xcc,
+	0xba, 0x9e, 0xe3, 0xb4, 0xb2, 0xd3, 0xd4, 0xc6, 0x79, 0x69, 0x82, 0xe2, 0x2e, 0x01, 0xa8, 0xe7,
+	0x1f, 0x0f, 0xc5, 0xc4, 0x68, 0x28, 0xa6, 0x98, 0x31, 0xaa, 0x85, 0x7e, 0x78, 0xf6, 0x70, 0x55,
+	0xd0, 0x19, 0x05, 0x6c, 0x82, 0x14, 0x2b, 0x6a, 0xc3, 0xb1, 0x5b, 0x56, 0x3b, 0x3b, 0x43, 0x29,
+	0xc5, 0x49, 0x94, 0x34, 0x47, 0x05, 0x0a, 0x53, 0x97, 0x39, 0xf1, 0xff, 0x18, 0x71, 0x9c, 0x82,
+	0xf3, 0x2f, 0x34, 0x22, 0x38, 0x6c, 0x81, 0xd3, 0x0d, 0x0f, 0x9b, 0x81, 0xe5, 0xd8, 0x06, 0x29,
+	0x64, 0x76, 0x96, 0x9a, 0x59, 0x7a, 0x29, 0x3b, 0xb5, 0xb0, 0xca, 0xea, 0x25, 0x6e, 0x21, 0xc3,
+	0x2d, 0xc4, 0xd5, 0xd1, 0xfd, 0xdf, 0x45, 0x81, 0x99, 0x49, 0x85, 0x02, 0xa2, 0x99, 0x4f, 0xdd,
+	0x7b, 0x20, 0x26, 0xbe, 0x79, 0x20, 0x0a, 0xcf, 0x1f, 0x88, 0x02, 0xfa, 0x08, 0x2c, 0xc4, 0x7c,
+	0x86, 0x17, 0xc1, 0x8c, 0x6d, 0x76, 0x31, 0xaf, 0xec, 0xd9, 0xa8, 0xb2, 0xe4, 0x16, 0xe9, 0x54,
+	0x78, 0x84, 0xe1, 0x2f, 0x01, 0xcc, 0xd2, 0x4c, 0x42, 0x05, 0xcc, 0xbb, 0xbd, 0xba, 0xb1, 0x8f,
+	0x07, 0x59, 0xe1, 0x98, 0xca, 0xc2, 0xa8, 0xb2, 0x1c, 0x8e, 0xf4, 0x39, 0xb7, 0x57, 0xbf, 0x8d,
+	0x07, 0x70, 0x0f, 0x24, 0x7d, 0xab, 0x6d, 0x9b, 0x41, 0xcf, 0xc3, 0xaf, 0x6d, 0x8f, 0x34, 0x23,
+	0x19, 0x2b, 0x90, 0x06, 0xb9, 0x30, 0xa1, 0x28, 0xd5, 0x10, 0xa0, 0x47, 0xe4, 0xf0, 0x1a, 0x00,
+	0x6e, 0x87, 0x54, 0x24, 0xc0, 0x07, 0x01, 0xed, 0x92, 0xa4, 0x7a, 0x6e, 0x34, 0x14, 0x17, 0xb9,
+	0x67, 0x63, 0x19, 0xd2, 0x93, 0xf4, 0xa3, 0x86, 0x0f, 0x82, 0x23, 0xa1, 0xff, 0x28, 0x80, 0xd3,
+	0x2a, 0x6e, 0xec, 0x6d, 0x6e, 0xf0, 0x1e, 0x84, 0x2b, 0x60, 0xb6, 0x6f, 0x76, 0x7a, 0x61, 0x02,
+	0xd3, 0x51, 0x5f, 0xd1, 0x6b, 0xa4, 0x33, 0x31, 0x7c, 0x07, 0xcc, 0xb9, 0x1e, 0x6e, 0x59, 0x07,
+	0x34, 0xc8, 0xa4, 0xba, 0x38, 0x1a, 0x8a, 0xa7, 0xc3, 0x06, 0x24, 0xf7, 0x24, 0x25, 0xf4, 0x90,
+	0xbf, 0x15, 0x37, 0xf9, 0xfa, 0x11, 0xf8, 0xfa, 0xd9, 0xc3, 0xd5, 0x0c, 0x7f, 0x9d, 0x5e, 0xf0,
+	0x0d, 0x7d, 0x49, 0xbc, 0x35, 0x7d, 0x7c, 0xfd, 0xbd, 0x13, 0x7a, 0xfb, 0xaf, 0x5c, 0x88, 0x1b,
+	0x44, 0xdf, 0x09, 0x00, 0x6c, 0xe1, 0x83, 0xff, 0x30, 0x5b, 0xc5, 0x93, 0xbb, 0xba, 0xc8, 0x5d,
+	0x8d, 0x1c, 0x43, 0x5f, 0x09, 0xe0, 0x8c, 0x6a, 0x05, 0x0d, 0xc7, 0xb2, 0x4f, 0x9a, 0xab, 0xad,
+	0x93, 0x3b, 0x70, 0x2e, 0xcc, 0xd5, 0x0b, 0x16, 0xd1, 0x73, 0x01, 0x9c, 0xad, 0x5a, 0x76, 0xbb,
+	0x83, 0xc7, 0x0d, 0x0c, 0x3f, 0x07, 0x80, 0x9a, 0x31, 0x82, 0x81, 0xcb, 0x5c, 0x39, 0xb3, 0xb1,
+	0x22, 0x1d, 0xd7, 0xf3, 0x77, 0x09, 0xbc, 0x36, 0x70, 0x71, 0xbc, 0xbb, 0x23, 0x0e, 0xa4, 0x27,
+	0xfb, 0x21, 0x02, 0x6e, 0x1c, 0x9d, 0xbe, 0x94, 0x9a, 0x99, 0x34, 0x67, 0xb1, 0x39, 0xca, 0x17,
+	0x48, 0xbc, 0x3c, 0xd6, 0x63, 0x87, 0x8f, 0x44, 0xfa, 0x7f, 0x1e, 0xe9, 0x91, 0xb0, 0xd0, 0x4f,
+	0x53, 0x20, 0x53, 0xa0, 0x2b, 0x63, 0x87, 0xec, 0x9d, 0x28, 0xde, 0x3a, 0x48, 0xd6, 0xad, 0xc0,
+	0x30, 0x3d, 0xcf, 0x0c, 0x1f, 0x15, 0x59, 0xe2, 0xcb, 0x85, 0xad, 0x2e, 0x69, 0xbc, 0xa9, 0xf8,
+	0xea, 0x92, 0x0a, 0x4e, 0xd7, 0x35, 0x1b, 0x81, 0x6a, 0x05, 0x0a, 0x51, 0x8b, 0x87, 0x30, 0xe6,
+	0x42, 0xfa, 0xa9, 0x3a, 0x97, 0xc3, 0x7d, 0x00, 0xc6, 0xe1, 0x90, 0x9d, 0x34, 0xfd, 0xca, 0x47,
+	0xe7, 0x7a, 0x94, 0xc1, 0x48, 0xe3, 0xf5, 0xaf, 0x4e, 0x8c, 0x3e, 0xbf, 0x75, 0x92, 0x74, 0xbd,
+	0xc1, 0xd3, 0x35, 0x29, 0x35, 0xe8, 0x50, 0x00, 0xd9, 0x22, 0x95, 0x8f, 0x17, 0x33, 0x29, 0x62,
+	0x93, 0x74, 0x15, 0xac, 0x81, 0xb9, 0xa6, 0xd3, 0x35, 0x2d, 0x9b, 0x27, 0x6d, 0x79, 0x52, 0x8f,
+	0x68, 0xa5, 0xdd, 0x1b, 0xeb, 0x1b, 0x45, 0x8a, 0x53, 0x97, 0xf8, 0x2e, 0xe1, 0x73, 0xc5, 0xb4,
+	0xf9, 0x9e, 0xe2, 0x5c, 0xf0, 0x43, 0x30, 0xeb, 0x7c, 0x61, 0x63, 0x8f, 0x8f, 0xe1, 0xe5, 0x68,
+	0x06, 0xe8, 0xf5, 0xab, 0x37, 0x3f, 0x53, 0x23, 0x33, 0x64, 0x3b, 0x76, 0x03, 0xd3, 0xe7, 0x76,
+	0x26, 0x3e, 0x43, 0xf4, 0x1a, 0xe9, 0x4c, 0x9c, 0x3f, 0x15, 0x26, 0x09, 0x7d, 0x2f, 0x80, 0x54,
+	0xdc, 0xcd, 0x7f, 0xb4, 0xa0, 0xe0, 0x55, 0x30, 0xdf, 0xc7, 0x9e, 0x6f, 0x39, 0x36, 0xf7, 0x34,
+	0xb6, 0x72, 0xb8, 0x00, 0xe9, 0x21, 0x04, 0xbe, 0x0f, 0x4e, 0xb1, 0xdd, 0x6c, 0x35, 0xb9, 0x63,
+	0xb9, 0xc3, 0xa1, 0x38, 0x4f, 0xb3, 0x5a, 0x2a, 0x8e, 0x86, 0xe2, 0xd9, 0xf8, 0x02, 0xb7, 0x9a,
+	0x48, 0x9f, 0xa7, 0xc7, 0x52, 0x33, 0x72, 0x74, 0xf5, 0xcf, 0x29, 0x00, 0x5f, 0x9e, 0x39, 0xf8,
+	0x16, 0x58, 0xae, 0x96, 0x6e, 0x95, 0x95, 0xda, 0x1d, 0x5d, 0x33, 0xee, 0x2a, 0xdb, 0x77, 0x34,
+	0xa3, 0xf6, 0xe9, 0xae, 0x66, 0xdc, 0x29, 0x57, 0x77, 0xb5, 0x42, 0xe9, 0x66, 0x49, 0x2b, 0xa6,
+	0x13, 0xf0, 0x02, 0xc8, 0x4e, 0x44, 0xe9, 0xca, 0x27, 0x69, 0x01, 0xae, 0x00, 0x34, 0x51, 0x5a,
+	0xa8, 0x54, 0x77, 0x2a, 0x55, 0xa3, 0x58, 0xd2, 0xb5, 0x42, 0x2d, 0x3d, 0x05, 0x2f, 0x81, 0x37,
+	0x8f, 0xc3, 0x29, 0x3b, 0xa5, 0x72, 0x25, 0x3d, 0x0d, 0x57, 0xc1, 0xca, 0x44, 0x98, 0x76, 0x77,
+	0xc7, 0xd8, 0xd5, 0xf4, 0x6a, 0xa5, 0xac, 0x6c, 0x1b, 0x04, 0x91, 0x9e, 0x81, 0x32, 0xb8, 0x32,
+	0x11, 0xab, 0x96, 0x6a, 0x85, 0x4a, 0xa9, 0x4c, 0x61, 0x5a, 0xd1, 0xd8, 0xd1, 0xaa, 0x55, 0xe5,
+	0x96, 0x96, 0x9e, 0x85, 0xef, 0x82, 0xab, 0x13, 0x15, 0xaa, 0x95, 0x6d, 0xa5, 0xac, 0x18, 0x95,
+	0x9b, 0x37, 0x0b, 0x5b, 0x4a, 0xa9, 0x3c, 0xd6, 0x98, 0x83, 0x17, 0x81, 0xf8, 0x4a, 0x77, 0x58,
+	0xe5, 0xd3, 0xf3, 0x4b, 0x33, 0xf7, 0xbe, 0xcd, 0x25, 0xd4, 0xdb, 0x8f, 0x0f, 0x73, 0xc2, 0x93,
+	0xc3, 0x9c, 0xf0, 0xc7, 0x61, 0x4e, 0xb8, 0xff, 0x34, 0x97, 0x78, 0xf2, 0x34, 0x97, 0xf8, 0xed,
+	0x69, 0x2e, 0xf1, 0xd9, 0x7a, 0xdb, 0x0a, 0xf6, 0x7a, 0x75, 0xa9, 0xe1, 0x74, 0x65, 0xd6, 0xe8,
+	0x6b, 0x1d, 0xb3, 0xee, 0xf3, 0xb3, 0xdc, 0xbf, 0x21, 0x1f, 0x44, 0x7f, 0xd5, 0xc9, 0xb3, 0xe7,
+	0xd7, 0xe7, 0xe8, 0x64, 0x6f, 0xfe, 0x3d, 0x00, 0xc0, 0x7b, 0xe8, 0x71, 0xca, 0x0b, 0x00, 0x00,
 }
 
 func (this *ChainLink) Equal(that interface{}) bool {
@@ -668,6 +836,30 @@ func (this *HexAddress) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *BitcoinAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BitcoinAddress)
+	if !ok {
+		that2, ok := that.(BitcoinAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
 func (this *SingleSignature) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -727,6 +919,66 @@ func (this *CosmosMultiSignature) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DesmosChainLinkTypedData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesmosChainLinkTypedData)
+	if !ok {
+		that2, ok := that.(DesmosChainLinkTypedData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Domain.Equal(&that1.Domain) {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	return true
+}
+func (this *EIP712Domain) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EIP712Domain)
+	if !ok {
+		that2, ok := that.(EIP712Domain)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.ChainID != that1.ChainID {
+		return false
+	}
+	return true
+}
 func (m *ChainLink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -985,6 +1237,36 @@ func (m *HexAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BitcoinAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BitcoinAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BitcoinAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintModelsChainLinks(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SingleSignature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1069,6 +1351,93 @@ func (m *CosmosMultiSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DesmosChainLinkTypedData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesmosChainLinkTypedData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesmosChainLinkTypedData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintModelsChainLinks(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintModelsChainLinks(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModelsChainLinks(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EIP712Domain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EIP712Domain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EIP712Domain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainID != 0 {
+		i = encodeVarintModelsChainLinks(dAtA, i, uint64(m.ChainID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintModelsChainLinks(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModelsChainLinks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModelsChainLinks(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModelsChainLinks(v)
 	base := offset
@@ -1184,6 +1553,19 @@ func (m *HexAddress) Size() (n int) {
 	return n
 }
 
+func (m *BitcoinAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovModelsChainLinks(uint64(l))
+	}
+	return n
+}
+
 func (m *SingleSignature) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1219,11 +1601,49 @@ func (m *CosmosMultiSignature) Size() (n int) {
 	return n
 }
 
-func sovModelsChainLinks(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModelsChainLinks(x uint64) (n int) {
-	return sovModelsChainLinks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *DesmosChainLinkTypedData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Domain.Size()
+	n += 1 + l + sovModelsChainLinks(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovModelsChainLinks(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovModelsChainLinks(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *EIP712Domain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModelsChainLinks(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovModelsChainLinks(uint64(l))
+	}
+	if m.ChainID != 0 {
+		n += 1 + sovModelsChainLinks(uint64(m.ChainID))
+	}
+	return n
+}
+
+func sovModelsChainLinks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModelsChainLinks(x uint64) (n int) {
+	return sovModelsChainLinks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *ChainLink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1988,6 +2408,88 @@ func (m *HexAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BitcoinAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModelsChainLinks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BitcoinAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BitcoinAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsChainLinks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModelsChainLinks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SingleSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2211,6 +2713,273 @@ func (m *CosmosMultiSignature) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DesmosChainLinkTypedData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModelsChainLinks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesmosChainLinkTypedData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesmosChainLinkTypedData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsChainLinks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsChainLinks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsChainLinks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModelsChainLinks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EIP712Domain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModelsChainLinks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EIP712Domain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EIP712Domain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsChainLinks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsChainLinks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			m.ChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsChainLinks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModelsChainLinks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModelsChainLinks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipModelsChainLinks(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,12 +1,15 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"testing"
 	"time"
 
 	"github.com/desmos-labs/desmos/v7/testutil/profilestesting"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/mr-tron/base58"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -22,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 
 	"github.com/desmos-labs/desmos/v7/app"
+	"github.com/desmos-labs/desmos/v7/types/crypto/ethsecp256k1"
 
 	"github.com/stretchr/testify/require"
 
@@ -229,6 +233,27 @@ func generateCosmosMultiSigSignature(t *testing.T, privKeys []cryptotypes.PrivKe
 	return signature.(*types.CosmosMultiSignature)
 }
 
+// bitcoinPrivKeyBz returns the bytes of the Bitcoin private key having value 1, whose addresses are well known
+func bitcoinPrivKeyBz(t *testing.T) []byte {
+	bz, err := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	return bz
+}
+
+// signBitcoinMessage signs the given message following the BIP-137 specification, adding the given offset
+// to the compressed P2PKH signature header in order to identify the different address types
+func signBitcoinMessage(t *testing.T, privKey *secp256k1.PrivKey, message string, headerOffset byte) []byte {
+	hash := sha256.Sum256(types.GetBitcoinSignedMessageValue(message))
+	hash = sha256.Sum256(hash[:])
+
+	key, _ := btcec.PrivKeyFromBytes(privKey.Key)
+	sigBz, err := ecdsa.SignCompact(key, hash[:], true)
+	require.NoError(t, err)
+
+	sigBz[0] += headerOffset
+	return sigBz
+}
+
 func TestProof_Verify(t *testing.T) {
 	privKeyBz, err := hex.DecodeString("bb98111da675930d32f79451fa8d05f188289699558c17148a5d9c82cdb31d1fe04fb0a0d9e689b436b59eff9676d7f2d788244cc4ccfc5768fe117efbd0f9d3")
 	require.NoError(t, err)
@@ -251,6 +276,37 @@ func TestProof_Verify(t *testing.T) {
 	invalidAny, err := codectypes.NewAnyWithValue(&types.SingleSignature{})
 	require.NoError(t, err)
 
+	solanaSigBz, err := privKey.Sign(types.GetSolanaOffchainMessageValue("cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx"))
+	require.NoError(t, err)
+	solanaSigAny, err := codectypes.NewAnyWithValue(
+		types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_SOLANA_OFFCHAIN_MESSAGE, solanaSigBz),
+	)
+	require.NoError(t, err)
+
+	bitcoinPrivKey := &secp256k1.PrivKey{Key: bitcoinPrivKeyBz(t)}
+	bitcoinPubKeyAny, err := codectypes.NewAnyWithValue(bitcoinPrivKey.PubKey())
+	require.NoError(t, err)
+	bitcoinSigAny, err := codectypes.NewAnyWithValue(types.NewSingleSignature(
+		types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE,
+		signBitcoinMessage(t, bitcoinPrivKey, "cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx", 8),
+	))
+	require.NoError(t, err)
+
+	cdc, _ := app.MakeCodecs()
+	evmPrivKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	evmPubKeyAny, err := codectypes.NewAnyWithValue(evmPrivKey.PubKey())
+	require.NoError(t, err)
+	typedData := types.NewDesmosChainLinkTypedData(
+		types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 1),
+		"cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx",
+		1,
+	)
+	eip712SigBz, err := evmPrivKey.Sign(typedData.GetSigningHash())
+	require.NoError(t, err)
+	eip712SigAny, err := codectypes.NewAnyWithValue(types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, eip712SigBz))
+	require.NoError(t, err)
+
 	testCases := []struct {
 		name        string
 		proof       types.Proof
@@ -323,6 +379,61 @@ func TestProof_Verify(t *testing.T) {
 			addressData: types.NewBase58Address(base58.Encode(pubKey.Bytes())),
 			shouldErr:   false,
 		},
+		{
+			name: "valid Solana off-chain message data returns no error",
+			proof: types.Proof{
+				PubKey:    pubKeyAny,
+				Signature: solanaSigAny,
+				PlainText: hex.EncodeToString(types.GetSolanaOffchainMessageValue("cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx")),
+			},
+			owner:       "cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx",
+			addressData: types.NewBase58Address(base58.Encode(pubKey.Bytes())),
+			shouldErr:   false,
+		},
+		{
+			name: "Bitcoin signed message with wrong address returns error",
+			proof: types.Proof{
+				PubKey:    bitcoinPubKeyAny,
+				Signature: bitcoinSigAny,
+				PlainText: hex.EncodeToString(types.GetBitcoinSignedMessageValue("cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx")),
+			},
+			owner:       "cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx",
+			addressData: types.NewBitcoinP2WPKHAddress(anotherPubKey),
+			shouldErr:   true,
+		},
+		{
+			name: "valid Bitcoin signed message data returns no error",
+			proof: types.Proof{
+				PubKey:    bitcoinPubKeyAny,
+				Signature: bitcoinSigAny,
+				PlainText: hex.EncodeToString(types.GetBitcoinSignedMessageValue("cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx")),
+			},
+			owner:       "cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx",
+			addressData: types.NewBitcoinAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"),
+			shouldErr:   false,
+		},
+		{
+			name: "EIP-712 signature with wrong owner returns error",
+			proof: types.Proof{
+				PubKey:    evmPubKeyAny,
+				Signature: eip712SigAny,
+				PlainText: hex.EncodeToString(cdc.MustMarshal(&typedData)),
+			},
+			owner:       "cosmos10m20h8fy0qp2a8f46zzjpvg8pfl8flajgxsvmk",
+			addressData: types.NewHexAddress("0x"+hex.EncodeToString(evmPrivKey.PubKey().Address()), "0x"),
+			shouldErr:   true,
+		},
+		{
+			name: "valid EIP-712 signature data returns no error",
+			proof: types.Proof{
+				PubKey:    evmPubKeyAny,
+				Signature: eip712SigAny,
+				PlainText: hex.EncodeToString(cdc.MustMarshal(&typedData)),
+			},
+			owner:       "cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx",
+			addressData: types.NewHexAddress("0x"+hex.EncodeToString(evmPrivKey.PubKey().Address()), "0x"),
+			shouldErr:   false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -339,6 +450,78 @@ func TestProof_Verify(t *testing.T) {
 	}
 }
 
+func TestProof_GetEIP712Nonce(t *testing.T) {
+	cdc, _ := app.MakeCodecs()
+
+	evmPrivKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	typedData := types.NewDesmosChainLinkTypedData(
+		types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 1),
+		"cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx",
+		7,
+	)
+	eip712SigBz, err := evmPrivKey.Sign(typedData.GetSigningHash())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		proof     types.Proof
+		shouldErr bool
+		expFound  bool
+		expPubKey cryptotypes.PubKey
+		expNonce  uint64
+	}{
+		{
+			name: "non EIP-712 signature returns false",
+			proof: types.NewProof(
+				evmPrivKey.PubKey(),
+				types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_PERSONAL_SIGN, eip712SigBz),
+				hex.EncodeToString([]byte("cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx")),
+			),
+			shouldErr: false,
+			expFound:  false,
+		},
+		{
+			name: "invalid typed data returns error",
+			proof: types.NewProof(
+				evmPrivKey.PubKey(),
+				types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, eip712SigBz),
+				hex.EncodeToString([]byte("cosmos1u55ywhk6thmhnxs7yn8vh8v7eznckcqjevnadx")),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "EIP-712 signature returns the signed nonce",
+			proof: types.NewProof(
+				evmPrivKey.PubKey(),
+				types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, eip712SigBz),
+				hex.EncodeToString(cdc.MustMarshal(&typedData)),
+			),
+			shouldErr: false,
+			expFound:  true,
+			expPubKey: evmPrivKey.PubKey(),
+			expNonce:  7,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pubKey, nonce, found, err := tc.proof.GetEIP712Nonce(cdc)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expFound, found)
+				if tc.expFound {
+					require.True(t, tc.expPubKey.Equals(pubKey))
+					require.Equal(t, tc.expNonce, nonce)
+				}
+			}
+		})
+	}
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 func TestValidateDirectTxValue(t *testing.T) {
@@ -491,6 +674,237 @@ func TestValidatePersonalSignValue(t *testing.T) {
 	}
 }
 
+func TestValidateBitcoinSignedMessageValue(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         []byte
+		expectedValue string
+		shouldErr     bool
+	}{
+		{
+			name:          "wrong value returns error",
+			value:         []byte("desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd"),
+			expectedValue: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			shouldErr:     true,
+		},
+		{
+			name:          "wrong length returns error",
+			value:         []byte("\x18Bitcoin Signed Message:\n\x2cdesmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd"),
+			expectedValue: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			shouldErr:     true,
+		},
+		{
+			name:          "correct value returns no error",
+			value:         []byte("\x18Bitcoin Signed Message:\n\x2ddesmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd"),
+			expectedValue: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			shouldErr:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateBitcoinSignedMessageValue(tc.value, tc.expectedValue)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSolanaOffchainMessageValue(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         []byte
+		expectedValue string
+		shouldErr     bool
+	}{
+		{
+			name:          "wrong value returns error",
+			value:         []byte("desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd"),
+			expectedValue: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			shouldErr:     true,
+		},
+		{
+			name:          "wrong format returns error",
+			value:         []byte("\xffsolana offchain\x00\x01\x2d\x00desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd"),
+			expectedValue: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			shouldErr:     true,
+		},
+		{
+			name:          "correct value returns no error",
+			value:         []byte("\xffsolana offchain\x00\x00\x2d\x00desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd"),
+			expectedValue: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			shouldErr:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateSolanaOffchainMessageValue(tc.value, tc.expectedValue)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateEIP712Value(t *testing.T) {
+	cdc, _ := app.MakeCodecs()
+	domain := types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 1)
+	testCases := []struct {
+		name          string
+		value         []byte
+		expectedValue string
+		shouldErr     bool
+	}{
+		{
+			name:          "invalid message returns error",
+			value:         cdc.MustMarshal(&types.Bech32Address{Prefix: "cosmos"}),
+			expectedValue: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			shouldErr:     true,
+		},
+		{
+			name: "invalid typed data returns error",
+			value: cdc.MustMarshal(&types.DesmosChainLinkTypedData{
+				Domain: types.NewEIP712Domain("Other", types.EIP712DomainVersion, 1),
+				Owner:  "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			}),
+			expectedValue: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			shouldErr:     true,
+		},
+		{
+			name:          "wrong owner returns error",
+			value:         cdc.MustMarshal(&types.DesmosChainLinkTypedData{Domain: domain, Owner: "desmos1n8345tvzkg3jumkm859r2qz0v6xsc3henzddcj"}),
+			expectedValue: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			shouldErr:     true,
+		},
+		{
+			name:          "correct value returns no error",
+			value:         cdc.MustMarshal(&types.DesmosChainLinkTypedData{Domain: domain, Owner: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd", Nonce: 1}),
+			expectedValue: "desmos16c60y8t8vra27zjg2arlcd58dck9cwn7p6fwtd",
+			shouldErr:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateEIP712Value(tc.value, tc.expectedValue, cdc)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestEIP712Domain_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		domain    types.EIP712Domain
+		shouldErr bool
+	}{
+		{
+			name:      "invalid name returns error",
+			domain:    types.NewEIP712Domain("Ethereum", types.EIP712DomainVersion, 1),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid version returns error",
+			domain:    types.NewEIP712Domain(types.EIP712DomainName, "2", 1),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid chain id returns error",
+			domain:    types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "valid domain returns no error",
+			domain:    types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 1),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.domain.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDesmosChainLinkTypedData_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		data      types.DesmosChainLinkTypedData
+		shouldErr bool
+	}{
+		{
+			name: "invalid domain returns error",
+			data: types.NewDesmosChainLinkTypedData(
+				types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 0),
+				"desmos1n8345tvzkg3jumkm859r2qz0v6xsc3henzddcj",
+				5,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid owner returns error",
+			data: types.NewDesmosChainLinkTypedData(
+				types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 1),
+				" ",
+				5,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			data: types.NewDesmosChainLinkTypedData(
+				types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 1),
+				"desmos1n8345tvzkg3jumkm859r2qz0v6xsc3henzddcj",
+				5,
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.data.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDesmosChainLinkTypedData_GetSigningHash(t *testing.T) {
+	data := types.NewDesmosChainLinkTypedData(
+		types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 1),
+		"desmos1n8345tvzkg3jumkm859r2qz0v6xsc3henzddcj",
+		5,
+	)
+	require.Equal(t, "85913448a64ff8a72b47ca0c0debfcff2a70f7312a9eefd0979cd2e7126ebd92", hex.EncodeToString(data.GetSigningHash()))
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 func TestSingleSignature_Validate(t *testing.T) {
@@ -558,6 +972,51 @@ func TestSingleSignature_Validate(t *testing.T) {
 			owner:     "cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae",
 			shouldErr: false,
 		},
+		{
+			name:      "invalid Bitcoin signed message value returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, nil),
+			plainText: []byte("cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae"),
+			owner:     "cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae",
+			shouldErr: true,
+		},
+		{
+			name:      "valid Bitcoin signed message value returns no error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, nil),
+			plainText: []byte("\x18Bitcoin Signed Message:\n\x2dcosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae"),
+			owner:     "cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae",
+			shouldErr: false,
+		},
+		{
+			name:      "invalid Solana off-chain message value returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_SOLANA_OFFCHAIN_MESSAGE, nil),
+			plainText: []byte("cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae"),
+			owner:     "cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae",
+			shouldErr: true,
+		},
+		{
+			name:      "valid Solana off-chain message value returns no error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_SOLANA_OFFCHAIN_MESSAGE, nil),
+			plainText: []byte("\xffsolana offchain\x00\x00\x2d\x00cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae"),
+			owner:     "cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae",
+			shouldErr: false,
+		},
+		{
+			name:      "invalid EIP-712 value returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, nil),
+			plainText: []byte("cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae"),
+			owner:     "cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae",
+			shouldErr: true,
+		},
+		{
+			name:      "valid EIP-712 value returns no error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, nil),
+			plainText: cdc.MustMarshal(&types.DesmosChainLinkTypedData{
+				Domain: types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 1),
+				Owner:  "cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae",
+			}),
+			owner:     "cosmos1s3p4hlhfnlsynauak7ggqv2y4hafwc0y6u0hae",
+			shouldErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -587,6 +1046,31 @@ func TestSingleSignature_Verify(t *testing.T) {
 	invalidAny, err := codectypes.NewAnyWithValue(privKey)
 	require.NoError(t, err)
 
+	bitcoinMessage := types.GetBitcoinSignedMessageValue("cosmos10m20h8fy0qp2a8f46zzjpvg8pfl8flajgxsvmk")
+	bitcoinSigBz := signBitcoinMessage(t, privKey, "cosmos10m20h8fy0qp2a8f46zzjpvg8pfl8flajgxsvmk", 0)
+
+	ed25519PubKeyAny, err := codectypes.NewAnyWithValue(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	otherPubKeyAny, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	evmPrivKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	evmPubKey := evmPrivKey.PubKey()
+	evmPubKeyAny, err := codectypes.NewAnyWithValue(evmPubKey)
+	require.NoError(t, err)
+
+	typedData := types.NewDesmosChainLinkTypedData(
+		types.NewEIP712Domain(types.EIP712DomainName, types.EIP712DomainVersion, 1),
+		"cosmos10m20h8fy0qp2a8f46zzjpvg8pfl8flajgxsvmk",
+		1,
+	)
+	typedDataBz := cdc.MustMarshal(&typedData)
+	eip712SigBz, err := evmPrivKey.Sign(typedData.GetSigningHash())
+	require.NoError(t, err)
+	legacyEIP712SigBz := append(append([]byte{}, eip712SigBz[:64]...), eip712SigBz[64]+27)
+
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
@@ -618,6 +1102,101 @@ func TestSingleSignature_Verify(t *testing.T) {
 			shouldErr: false,
 			expPubKey: pubKey,
 		},
+		{
+			name:      "non secp256k1 key with Bitcoin signature returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, bitcoinSigBz),
+			pubKey:    ed25519PubKeyAny,
+			plainText: bitcoinMessage,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid Bitcoin signature length returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, bitcoinSigBz[1:]),
+			pubKey:    pubKeyAny,
+			plainText: bitcoinMessage,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid Bitcoin signature header returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, append([]byte{43}, bitcoinSigBz[1:]...)),
+			pubKey:    pubKeyAny,
+			plainText: bitcoinMessage,
+			shouldErr: true,
+		},
+		{
+			name:      "uncompressed Bitcoin signature header returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, append([]byte{bitcoinSigBz[0] - 4}, bitcoinSigBz[1:]...)),
+			pubKey:    pubKeyAny,
+			plainText: bitcoinMessage,
+			shouldErr: true,
+		},
+		{
+			name:      "Bitcoin signature of different key returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, bitcoinSigBz),
+			pubKey:    otherPubKeyAny,
+			plainText: bitcoinMessage,
+			shouldErr: true,
+		},
+		{
+			name:      "Bitcoin signature of different message returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, bitcoinSigBz),
+			pubKey:    pubKeyAny,
+			plainText: []byte("cosmos10m20h8fy0qp2a8f46zzjpvg8pfl8flajgxsvmk"),
+			shouldErr: true,
+		},
+		{
+			name:      "valid P2PKH Bitcoin signature returns no error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, bitcoinSigBz),
+			pubKey:    pubKeyAny,
+			plainText: bitcoinMessage,
+			shouldErr: false,
+			expPubKey: pubKey,
+		},
+		{
+			name:      "valid P2WPKH Bitcoin signature returns no error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_BITCOIN_SIGNED_MESSAGE, append([]byte{bitcoinSigBz[0] + 8}, bitcoinSigBz[1:]...)),
+			pubKey:    pubKeyAny,
+			plainText: bitcoinMessage,
+			shouldErr: false,
+			expPubKey: pubKey,
+		},
+		{
+			name:      "invalid EIP-712 value returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, eip712SigBz),
+			pubKey:    evmPubKeyAny,
+			plainText: []byte("cosmos10m20h8fy0qp2a8f46zzjpvg8pfl8flajgxsvmk"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid EIP-712 signature length returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, eip712SigBz[1:]),
+			pubKey:    evmPubKeyAny,
+			plainText: typedDataBz,
+			shouldErr: true,
+		},
+		{
+			name:      "EIP-712 signature of different key returns error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, eip712SigBz),
+			pubKey:    pubKeyAny,
+			plainText: typedDataBz,
+			shouldErr: true,
+		},
+		{
+			name:      "valid EIP-712 signature returns no error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, eip712SigBz),
+			pubKey:    evmPubKeyAny,
+			plainText: typedDataBz,
+			shouldErr: false,
+			expPubKey: evmPubKey,
+		},
+		{
+			name:      "valid EIP-712 signature with legacy recovery id returns no error",
+			signature: types.NewSingleSignature(types.SIGNATURE_VALUE_TYPE_EVM_EIP712, legacyEIP712SigBz),
+			pubKey:    evmPubKeyAny,
+			plainText: typedDataBz,
+			shouldErr: false,
+			expPubKey: evmPubKey,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1054,6 +1633,158 @@ func TestHexAddress_GetValue(t *testing.T) {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+func TestNewBitcoinP2PKHAddress(t *testing.T) {
+	privKey := &secp256k1.PrivKey{Key: bitcoinPrivKeyBz(t)}
+	address := types.NewBitcoinP2PKHAddress(privKey.PubKey())
+	require.Equal(t, types.NewBitcoinAddress("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"), address)
+}
+
+func TestNewBitcoinP2WPKHAddress(t *testing.T) {
+	privKey := &secp256k1.PrivKey{Key: bitcoinPrivKeyBz(t)}
+	address := types.NewBitcoinP2WPKHAddress(privKey.PubKey())
+	require.Equal(t, types.NewBitcoinAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"), address)
+}
+
+func TestBitcoinAddress_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		address   *types.BitcoinAddress
+		shouldErr bool
+	}{
+		{
+			name:      "empty address returns error",
+			address:   types.NewBitcoinAddress(" "),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid address returns error",
+			address:   types.NewBitcoinAddress("0OiIjJ"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid P2PKH checksum returns error",
+			address:   types.NewBitcoinAddress("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ"),
+			shouldErr: true,
+		},
+		{
+			name:      "P2SH address returns error",
+			address:   types.NewBitcoinAddress("3CNHUhP3uyB9EUtRLsmvFUmvGdjGdkTxJw"),
+			shouldErr: true,
+		},
+		{
+			name:      "P2WSH address returns error",
+			address:   types.NewBitcoinAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kqqqqqqqqqqqqqqqqqqqqrtz5fq"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid Bech32 prefix returns error",
+			address:   types.NewBitcoinAddress("ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9"),
+			shouldErr: true,
+		},
+		{
+			name:      "valid main net P2PKH address returns no error",
+			address:   types.NewBitcoinAddress("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"),
+			shouldErr: false,
+		},
+		{
+			name:      "valid test net P2PKH address returns no error",
+			address:   types.NewBitcoinAddress("mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r"),
+			shouldErr: false,
+		},
+		{
+			name:      "valid main net P2WPKH address returns no error",
+			address:   types.NewBitcoinAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"),
+			shouldErr: false,
+		},
+		{
+			name:      "valid test net P2WPKH address returns no error",
+			address:   types.NewBitcoinAddress("tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.address.Validate()
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBitcoinAddress_GetValue(t *testing.T) {
+	data := types.NewBitcoinAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")
+	require.Equal(t, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", data.GetValue())
+}
+
+func TestBitcoinAddress_VerifyPubKey(t *testing.T) {
+	pubKey := (&secp256k1.PrivKey{Key: bitcoinPrivKeyBz(t)}).PubKey()
+
+	testCases := []struct {
+		name      string
+		address   *types.BitcoinAddress
+		pubKey    cryptotypes.PubKey
+		shouldErr bool
+		expValid  bool
+	}{
+		{
+			name:      "invalid address returns error",
+			address:   types.NewBitcoinAddress("0OiIjJ"),
+			pubKey:    pubKey,
+			shouldErr: true,
+		},
+		{
+			name:      "non secp256k1 key returns false",
+			address:   types.NewBitcoinAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"),
+			pubKey:    ed25519.GenPrivKey().PubKey(),
+			shouldErr: false,
+			expValid:  false,
+		},
+		{
+			name:      "different key returns false",
+			address:   types.NewBitcoinAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"),
+			pubKey:    secp256k1.GenPrivKey().PubKey(),
+			shouldErr: false,
+			expValid:  false,
+		},
+		{
+			name:      "P2PKH address of the key returns true",
+			address:   types.NewBitcoinAddress("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"),
+			pubKey:    pubKey,
+			shouldErr: false,
+			expValid:  true,
+		},
+		{
+			name:      "P2WPKH address of the key returns true",
+			address:   types.NewBitcoinAddress("tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"),
+			pubKey:    pubKey,
+			shouldErr: false,
+			expValid:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			valid, err := tc.address.VerifyPubKey(tc.pubKey)
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expValid, valid)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 func TestUnpackAddressData(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -1075,6 +1806,11 @@ func TestUnpackAddressData(t *testing.T) {
 			address:   profilestesting.NewAny(types.NewBase58Address("5AfetAwZzftP8i5JBNatzWeccfXd4KvKq6TRfAvacFaN")),
 			shouldErr: false,
 		},
+		{
+			name:      "valid Bitcoin data returns no error",
+			address:   profilestesting.NewAny(types.NewBitcoinAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")),
+			shouldErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDTagHistoryEntry returns a new DTagHistoryEntry instance
+func NewDTagHistoryEntry(dTag string, address string, height uint64, time time.Time) DTagHistoryEntry {
+	return DTagHistoryEntry{
+		DTag:    dTag,
+		Address: address,
+		Height:  height,
+		Time:    time,
+	}
+}
+
+// Validate checks the entry validity
+func (entry DTagHistoryEntry) Validate() error {
+	if strings.TrimSpace(entry.DTag) == "" {
+		return fmt.Errorf("invalid DTag: %s", entry.DTag)
+	}
+
+	_, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return fmt.Errorf("invalid address: %s", entry.Address)
+	}
+
+	if entry.Time.IsZero() {
+		return fmt.Errorf("invalid time: %s", entry.Time)
+	}
+
+	return nil
+}
+
+// MustUnmarshalDTagHistoryEntry unmarshalls the given byte array as a DTagHistoryEntry using the provided marshaller
+func MustUnmarshalDTagHistoryEntry(cdc codec.BinaryCodec, bz []byte) DTagHistoryEntry {
+	var entry DTagHistoryEntry
+	cdc.MustUnmarshal(bz, &entry)
+	return entry
+}
@@ -0,0 +1,486 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: desmos/profiles/v3/models_dtag_history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DTagHistoryEntry represents a past association between a DTag and the user
+// that held it
+type DTagHistoryEntry struct {
+	// DTag contains the value of the DTag that was held
+	DTag string `protobuf:"bytes,1,opt,name=dtag,proto3" json:"dtag,omitempty" yaml:"dtag"`
+	// Address represents the address of the user that held the DTag
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// Height represents the block height at which the user released the DTag
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// Time represents the block time at which the user released the DTag
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+}
+
+func (m *DTagHistoryEntry) Reset()         { *m = DTagHistoryEntry{} }
+func (m *DTagHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*DTagHistoryEntry) ProtoMessage()    {}
+func (*DTagHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_605ede243a60993c, []int{0}
+}
+func (m *DTagHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DTagHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DTagHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DTagHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DTagHistoryEntry.Merge(m, src)
+}
+func (m *DTagHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DTagHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DTagHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DTagHistoryEntry proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DTagHistoryEntry)(nil), "desmos.profiles.v3.DTagHistoryEntry")
+}
+
+func init() {
+	proto.RegisterFile("desmos/profiles/v3/models_dtag_history.proto", fileDescriptor_605ede243a60993c)
+}
+
+var fileDescriptor_605ede243a60993c = []byte{
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0x31, 0x6b, 0xdb, 0x40,
+	0x14, 0xc7, 0x75, 0xae, 0x70, 0x5b, 0x99, 0x96, 0x5a, 0x18, 0xaa, 0x9a, 0xa2, 0x33, 0x9a, 0x5c,
+	0xb7, 0xd6, 0xd1, 0x7a, 0x28, 0x78, 0xab, 0x70, 0xa1, 0xd0, 0x4c, 0x8a, 0xa7, 0x2c, 0x46, 0xb2,
+	0xce, 0x27, 0x81, 0xe4, 0x13, 0xba, 0xb3, 0x89, 0xbe, 0x81, 0x47, 0x7f, 0x04, 0x8f, 0x19, 0x33,
+	0xe4, 0x43, 0x78, 0x34, 0x99, 0x32, 0x29, 0x41, 0x1e, 0x92, 0xd9, 0x9f, 0x20, 0x48, 0x27, 0xe1,
+	0xe5, 0x78, 0xf7, 0xfe, 0xbf, 0xf7, 0xf8, 0xff, 0x79, 0xca, 0x0f, 0x0f, 0xb3, 0x88, 0x32, 0x14,
+	0x27, 0x74, 0x11, 0x84, 0x98, 0xa1, 0xf5, 0x08, 0x45, 0xd4, 0xc3, 0x21, 0x9b, 0x79, 0xdc, 0x21,
+	0x33, 0x3f, 0x60, 0x9c, 0x26, 0xa9, 0x19, 0x27, 0x94, 0x53, 0x55, 0x15, 0xb4, 0x59, 0xd3, 0xe6,
+	0x7a, 0xd4, 0x6d, 0x3b, 0x51, 0xb0, 0xa4, 0xa8, 0x7c, 0x05, 0xd6, 0xed, 0x10, 0x4a, 0x68, 0x59,
+	0xa2, 0xa2, 0xaa, 0xba, 0x5f, 0xe6, 0xb4, 0x18, 0x9e, 0x09, 0x41, 0x7c, 0x2a, 0x09, 0x12, 0x4a,
+	0x49, 0x88, 0x51, 0xf9, 0x73, 0x57, 0x0b, 0xc4, 0x83, 0x08, 0x33, 0xee, 0x44, 0xb1, 0x00, 0x8c,
+	0x4d, 0x43, 0xf9, 0x34, 0x99, 0x3a, 0xe4, 0x9f, 0xb0, 0xf3, 0x77, 0xc9, 0x93, 0x54, 0xfd, 0xae,
+	0xc8, 0x85, 0x47, 0x0d, 0xf4, 0x40, 0xff, 0xbd, 0xf5, 0x39, 0xcf, 0xa0, 0x5c, 0x30, 0xa7, 0x0c,
+	0xb6, 0x52, 0x27, 0x0a, 0xc7, 0x46, 0xa1, 0x1a, 0x76, 0x09, 0xa9, 0x13, 0xe5, 0xad, 0xe3, 0x79,
+	0x09, 0x66, 0x4c, 0x6b, 0x94, 0xfc, 0xe0, 0x94, 0xc1, 0x8f, 0x82, 0xab, 0x04, 0xe3, 0xfe, 0x6e,
+	0xd8, 0xa9, 0x7c, 0xfd, 0x11, 0xad, 0x4b, 0x9e, 0x04, 0x4b, 0x62, 0xd7, 0xa3, 0xea, 0x37, 0xa5,
+	0xe9, 0xe3, 0x80, 0xf8, 0x5c, 0x7b, 0xd3, 0x03, 0x7d, 0xd9, 0x6a, 0x9f, 0x32, 0xf8, 0x41, 0x2c,
+	0x11, 0x7d, 0xc3, 0xae, 0x00, 0xf5, 0x42, 0x91, 0x8b, 0x14, 0x9a, 0xdc, 0x03, 0xfd, 0xd6, 0xaf,
+	0xae, 0x29, 0x22, 0x9a, 0x75, 0x44, 0x73, 0x5a, 0x47, 0xb4, 0xbe, 0xee, 0x33, 0x28, 0x9d, 0x5d,
+	0x17, 0x53, 0xc6, 0xf6, 0x11, 0x82, 0x9b, 0xe7, 0xdb, 0x01, 0xb0, 0xcb, 0x2d, 0xe3, 0x77, 0x9b,
+	0x1d, 0x94, 0x5e, 0x76, 0x10, 0x58, 0xff, 0xf7, 0xb9, 0x0e, 0x0e, 0xb9, 0x0e, 0x9e, 0x72, 0x1d,
+	0x6c, 0x8f, 0xba, 0x74, 0x38, 0xea, 0xd2, 0xc3, 0x51, 0x97, 0xae, 0x7e, 0x92, 0x80, 0xfb, 0x2b,
+	0xd7, 0x9c, 0xd3, 0x08, 0x89, 0x43, 0x0d, 0x43, 0xc7, 0x65, 0x55, 0x8d, 0xd6, 0xbf, 0xd1, 0xf5,
+	0xf9, 0xce, 0x3c, 0x8d, 0x31, 0x73, 0x9b, 0xa5, 0x9d, 0xd1, 0xeb, 0x00, 0xd8, 0xd8, 0xe5, 0x3f,
+	0x07, 0x02, 0x00, 0x00,
+}
+
+func (this *DTagHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DTagHistoryEntry)
+	if !ok {
+		that2, ok := that.(DTagHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DTag != that1.DTag {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (m *DTagHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DTagHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DTagHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintModelsDtagHistory(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintModelsDtagHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintModelsDtagHistory(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DTag) > 0 {
+		i -= len(m.DTag)
+		copy(dAtA[i:], m.DTag)
+		i = encodeVarintModelsDtagHistory(dAtA, i, uint64(len(m.DTag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModelsDtagHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModelsDtagHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DTagHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DTag)
+	if l > 0 {
+		n += 1 + l + sovModelsDtagHistory(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovModelsDtagHistory(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovModelsDtagHistory(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovModelsDtagHistory(uint64(l))
+	return n
+}
+
+func sovModelsDtagHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModelsDtagHistory(x uint64) (n int) {
+	return sovModelsDtagHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DTagHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModelsDtagHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DTagHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DTagHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsDtagHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsDtagHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModelsDtagHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModelsDtagHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModelsDtagHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipModelsDtagHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowModelsDtagHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModelsDtagHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModelsDtagHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthModelsDtagHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupModelsDtagHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthModelsDtagHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthModelsDtagHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowModelsDtagHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupModelsDtagHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+func TestDTagHistoryEntry_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		entry     types.DTagHistoryEntry
+		shouldErr bool
+	}{
+		{
+			name: "empty DTag returns error",
+			entry: types.NewDTagHistoryEntry(
+				"",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				10,
+				time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid address returns error",
+			entry: types.NewDTagHistoryEntry(
+				"dtag",
+				"cosmos1",
+				10,
+				time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero time returns error",
+			entry: types.NewDTagHistoryEntry(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				10,
+				time.Time{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid entry returns no error",
+			entry: types.NewDTagHistoryEntry(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				10,
+				time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.entry.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
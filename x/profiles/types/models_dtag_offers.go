@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDTagListing returns a new DTagListing instance
+func NewDTagListing(dTag string, owner string, price sdk.Coin, newDTag string, expirationTime time.Time) DTagListing {
+	return DTagListing{
+		DTag:           dTag,
+		Owner:          owner,
+		Price:          price,
+		NewDTag:        newDTag,
+		ExpirationTime: expirationTime,
+	}
+}
+
+// Validate checks the listing validity
+func (listing DTagListing) Validate() error {
+	if strings.TrimSpace(listing.DTag) == "" {
+		return fmt.Errorf("invalid DTag: %s", listing.DTag)
+	}
+
+	_, err := sdk.AccAddressFromBech32(listing.Owner)
+	if err != nil {
+		return fmt.Errorf("invalid owner address: %s", listing.Owner)
+	}
+
+	err = validateDTagPrice(listing.Price)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(listing.NewDTag) == "" {
+		return fmt.Errorf("invalid new DTag: %s", listing.NewDTag)
+	}
+
+	if strings.EqualFold(listing.NewDTag, listing.DTag) {
+		return fmt.Errorf("the new DTag must be different from the one being sold")
+	}
+
+	if listing.ExpirationTime.IsZero() {
+		return fmt.Errorf("invalid expiration time: %s", listing.ExpirationTime)
+	}
+
+	return nil
+}
+
+// MustUnmarshalDTagListing unmarshalls the given byte array as a DTagListing using the provided marshaller
+func MustUnmarshalDTagListing(cdc codec.BinaryCodec, bz []byte) DTagListing {
+	var listing DTagListing
+	cdc.MustUnmarshal(bz, &listing)
+	return listing
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewDTagBid returns a new DTagBid instance
+func NewDTagBid(dTag string, buyer string, owner string, price sdk.Coin, expirationTime time.Time) DTagBid {
+	return DTagBid{
+		DTag:           dTag,
+		Buyer:          buyer,
+		Owner:          owner,
+		Price:          price,
+		ExpirationTime: expirationTime,
+	}
+}
+
+// Validate checks the bid validity
+func (bid DTagBid) Validate() error {
+	if strings.TrimSpace(bid.DTag) == "" {
+		return fmt.Errorf("invalid DTag: %s", bid.DTag)
+	}
+
+	_, err := sdk.AccAddressFromBech32(bid.Buyer)
+	if err != nil {
+		return fmt.Errorf("invalid buyer address: %s", bid.Buyer)
+	}
+
+	_, err = sdk.AccAddressFromBech32(bid.Owner)
+	if err != nil {
+		return fmt.Errorf("invalid owner address: %s", bid.Owner)
+	}
+
+	if bid.Buyer == bid.Owner {
+		return fmt.Errorf("the buyer and owner must be different")
+	}
+
+	err = validateDTagPrice(bid.Price)
+	if err != nil {
+		return err
+	}
+
+	if bid.ExpirationTime.IsZero() {
+		return fmt.Errorf("invalid expiration time: %s", bid.ExpirationTime)
+	}
+
+	return nil
+}
+
+// MustUnmarshalDTagBid unmarshalls the given byte array as a DTagBid using the provided marshaller
+func MustUnmarshalDTagBid(cdc codec.BinaryCodec, bz []byte) DTagBid {
+	var bid DTagBid
+	cdc.MustUnmarshal(bz, &bid)
+	return bid
+}
+
+// validateDTagPrice checks that the given price is a valid positive amount
+func validateDTagPrice(price sdk.Coin) error {
+	if err := price.Validate(); err != nil {
+		return fmt.Errorf("invalid price: %s", err)
+	}
+
+	if !price.IsPositive() {
+		return fmt.Errorf("invalid price: %s", price)
+	}
+
+	return nil
+}
@@ -0,0 +1,948 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: desmos/profiles/v3/models_dtag_offers.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DTagListing represents a DTag that has been put on sale by its owner
+type DTagListing struct {
+	// DTag contains the value of the DTag that is being sold
+	DTag string `protobuf:"bytes,1,opt,name=dtag,proto3" json:"dtag,omitempty" yaml:"dtag"`
+	// Owner represents the address of the user that owns the DTag
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// Price represents the amount that must be paid to buy the DTag
+	Price types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price" yaml:"price"`
+	// NewDTag represents the DTag that the owner will obtain once the DTag is
+	// sold
+	NewDTag string `protobuf:"bytes,4,opt,name=new_dtag,json=newDtag,proto3" json:"new_dtag,omitempty" yaml:"new_dtag"`
+	// ExpirationTime represents the time after which the listing will be
+	// removed
+	ExpirationTime time.Time `protobuf:"bytes,5,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time" yaml:"expiration_time"`
+}
+
+func (m *DTagListing) Reset()         { *m = DTagListing{} }
+func (m *DTagListing) String() string { return proto.CompactTextString(m) }
+func (*DTagListing) ProtoMessage()    {}
+func (*DTagListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5426a269d25c4c7, []int{0}
+}
+func (m *DTagListing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DTagListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DTagListing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DTagListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DTagListing.Merge(m, src)
+}
+func (m *DTagListing) XXX_Size() int {
+	return m.Size()
+}
+func (m *DTagListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_DTagListing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DTagListing proto.InternalMessageInfo
+
+// DTagBid represents an offer made by a user to buy the DTag of another user.
+// The offered amount is held by the module account until the bid is accepted,
+// canceled or expires
+type DTagBid struct {
+	// DTag contains the value of the DTag that the buyer wants to obtain
+	DTag string `protobuf:"bytes,1,opt,name=dtag,proto3" json:"dtag,omitempty" yaml:"dtag"`
+	// Buyer represents the address of the user that placed the bid
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty" yaml:"buyer"`
+	// Owner represents the address of the user that owns the DTag
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// Price represents the escrowed amount that will be paid to the owner if the
+	// bid is accepted
+	Price types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price" yaml:"price"`
+	// ExpirationTime represents the time after which the bid will be removed and
+	// the escrowed amount refunded to the buyer
+	ExpirationTime time.Time `protobuf:"bytes,5,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time" yaml:"expiration_time"`
+}
+
+func (m *DTagBid) Reset()         { *m = DTagBid{} }
+func (m *DTagBid) String() string { return proto.CompactTextString(m) }
+func (*DTagBid) ProtoMessage()    {}
+func (*DTagBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5426a269d25c4c7, []int{1}
+}
+func (m *DTagBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DTagBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DTagBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DTagBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DTagBid.Merge(m, src)
+}
+func (m *DTagBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *DTagBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_DTagBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DTagBid proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DTagListing)(nil), "desmos.profiles.v3.DTagListing")
+	proto.RegisterType((*DTagBid)(nil), "desmos.profiles.v3.DTagBid")
+}
+
+func init() {
+	proto.RegisterFile("desmos/profiles/v3/models_dtag_offers.proto", fileDescriptor_f5426a269d25c4c7)
+}
+
+var fileDescriptor_f5426a269d25c4c7 = []byte{
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0x3f, 0x8f, 0xd3, 0x4e,
+	0x10, 0xf5, 0xe6, 0x92, 0x5f, 0x7e, 0x38, 0x27, 0x4e, 0x58, 0x27, 0x70, 0x52, 0x78, 0x4f, 0x6e,
+	0x88, 0x38, 0xdd, 0xae, 0x42, 0x0a, 0xc4, 0x15, 0x48, 0x98, 0xab, 0x00, 0x51, 0x84, 0xab, 0x68,
+	0x22, 0x3b, 0xde, 0x98, 0x95, 0x6c, 0xaf, 0xe5, 0xdd, 0x24, 0x97, 0x6f, 0x40, 0x79, 0x25, 0x65,
+	0x4a, 0x4a, 0x0a, 0x3e, 0xc4, 0x95, 0x27, 0x2a, 0x2a, 0x83, 0x9c, 0x02, 0xea, 0xeb, 0x41, 0x68,
+	0xff, 0x44, 0x91, 0x10, 0x42, 0x42, 0x57, 0xd0, 0xac, 0x66, 0xe6, 0xbd, 0x19, 0xbd, 0x79, 0xa3,
+	0xb5, 0x0f, 0x63, 0xc2, 0x33, 0xc6, 0x71, 0x51, 0xb2, 0x29, 0x4d, 0x09, 0xc7, 0xf3, 0x21, 0xce,
+	0x58, 0x4c, 0x52, 0x3e, 0x8e, 0x45, 0x98, 0x8c, 0xd9, 0x74, 0x4a, 0x4a, 0x8e, 0x8a, 0x92, 0x09,
+	0xe6, 0x38, 0x9a, 0x8c, 0x36, 0x64, 0x34, 0x1f, 0xf6, 0x6e, 0x85, 0x19, 0xcd, 0x19, 0x56, 0xaf,
+	0xa6, 0xf5, 0xf6, 0x13, 0x96, 0x30, 0x15, 0x62, 0x19, 0x99, 0x6a, 0x77, 0xc2, 0x64, 0xf3, 0x58,
+	0x03, 0x3a, 0x31, 0x90, 0xa7, 0x33, 0x1c, 0x85, 0x9c, 0xe0, 0xf9, 0x20, 0x22, 0x22, 0x1c, 0xe0,
+	0x09, 0xa3, 0xb9, 0xc1, 0x61, 0xc2, 0x58, 0x92, 0x12, 0xac, 0xb2, 0x68, 0x36, 0xc5, 0x82, 0x66,
+	0x84, 0x8b, 0x30, 0x2b, 0x34, 0xc1, 0xff, 0xde, 0xb0, 0x3b, 0x27, 0xa7, 0x61, 0xf2, 0x9c, 0x72,
+	0x41, 0xf3, 0xc4, 0x39, 0xb4, 0x9b, 0x52, 0xbd, 0x0b, 0x0e, 0x40, 0xff, 0x46, 0x70, 0xa7, 0xae,
+	0x60, 0x53, 0xc2, 0x57, 0x15, 0xec, 0x2c, 0xc3, 0x2c, 0x3d, 0xf6, 0x25, 0xea, 0x8f, 0x14, 0xc9,
+	0x79, 0x64, 0xb7, 0xd8, 0x22, 0x27, 0xa5, 0xdb, 0x50, 0xec, 0xfe, 0x55, 0x05, 0x77, 0x35, 0x4b,
+	0x95, 0xfd, 0x8f, 0x1f, 0x8e, 0xf6, 0x8d, 0xdc, 0xc7, 0x71, 0x5c, 0x12, 0xce, 0x5f, 0x8a, 0x92,
+	0xe6, 0xc9, 0x48, 0xb7, 0x39, 0x4f, 0xed, 0x56, 0x51, 0xd2, 0x09, 0x71, 0x77, 0x0e, 0x40, 0xbf,
+	0x73, 0xbf, 0x8b, 0x0c, 0x59, 0x6e, 0x83, 0xcc, 0x36, 0xe8, 0x09, 0xa3, 0x79, 0xd0, 0xbd, 0xa8,
+	0xa0, 0xb5, 0x1d, 0xaf, 0xba, 0xfc, 0x77, 0x5f, 0xdf, 0xdf, 0x03, 0x23, 0x3d, 0xc2, 0x79, 0x68,
+	0xff, 0x9f, 0x93, 0x85, 0xb2, 0xde, 0x6d, 0x2a, 0x39, 0x5e, 0x5d, 0xc1, 0xf6, 0x0b, 0xb2, 0x30,
+	0xfa, 0xf7, 0x74, 0xeb, 0x86, 0xe4, 0x8f, 0xda, 0x39, 0x59, 0x9c, 0xc8, 0x35, 0x52, 0x7b, 0x8f,
+	0x9c, 0x15, 0xb4, 0x0c, 0x05, 0x65, 0xf9, 0x58, 0x3a, 0xe4, 0xb6, 0x94, 0xa0, 0x1e, 0xd2, 0xf6,
+	0xa1, 0x8d, 0x7d, 0xe8, 0x74, 0x63, 0x5f, 0x70, 0xd7, 0x28, 0xba, 0xad, 0xc7, 0xfe, 0x32, 0xc0,
+	0x3f, 0xff, 0x0c, 0x81, 0xd6, 0x77, 0x73, 0x0b, 0xc9, 0xee, 0xe3, 0xdd, 0x37, 0x2b, 0x68, 0xbd,
+	0x5d, 0x41, 0xf0, 0x6d, 0x05, 0x81, 0xff, 0xa3, 0x61, 0xb7, 0xa5, 0xc0, 0x80, 0xc6, 0x7f, 0xed,
+	0x7d, 0x34, 0x5b, 0xfe, 0xce, 0x7b, 0x55, 0xfe, 0x83, 0xf7, 0x0a, 0xdf, 0xde, 0x6e, 0xe7, 0x9a,
+	0xb7, 0x6b, 0x5e, 0xff, 0x76, 0xff, 0xf0, 0x00, 0xc1, 0xb3, 0x8b, 0xda, 0x03, 0x97, 0xb5, 0x07,
+	0xbe, 0xd4, 0x1e, 0x38, 0x5f, 0x7b, 0xd6, 0xe5, 0xda, 0xb3, 0x3e, 0xad, 0x3d, 0xeb, 0xd5, 0x20,
+	0xa1, 0xe2, 0xf5, 0x2c, 0x42, 0x13, 0x96, 0x61, 0xfd, 0x7d, 0x8f, 0xd2, 0x30, 0xe2, 0x26, 0xc6,
+	0xf3, 0x07, 0xf8, 0x6c, 0xfb, 0xf9, 0xc5, 0xb2, 0x20, 0x3c, 0xfa, 0x4f, 0xe9, 0x1c, 0xfe, 0x1c,
+	0x00, 0x31, 0x64, 0xf5, 0x50, 0x1c, 0x04, 0x00, 0x00,
+}
+
+func (this *DTagListing) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DTagListing)
+	if !ok {
+		that2, ok := that.(DTagListing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DTag != that1.DTag {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if !this.Price.Equal(&that1.Price) {
+		return false
+	}
+	if this.NewDTag != that1.NewDTag {
+		return false
+	}
+	if !this.ExpirationTime.Equal(that1.ExpirationTime) {
+		return false
+	}
+	return true
+}
+func (this *DTagBid) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DTagBid)
+	if !ok {
+		that2, ok := that.(DTagBid)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DTag != that1.DTag {
+		return false
+	}
+	if this.Buyer != that1.Buyer {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if !this.Price.Equal(&that1.Price) {
+		return false
+	}
+	if !this.ExpirationTime.Equal(that1.ExpirationTime) {
+		return false
+	}
+	return true
+}
+func (m *DTagListing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DTagListing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DTagListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintModelsDtagOffers(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.NewDTag) > 0 {
+		i -= len(m.NewDTag)
+		copy(dAtA[i:], m.NewDTag)
+		i = encodeVarintModelsDtagOffers(dAtA, i, uint64(len(m.NewDTag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModelsDtagOffers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintModelsDtagOffers(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DTag) > 0 {
+		i -= len(m.DTag)
+		copy(dAtA[i:], m.DTag)
+		i = encodeVarintModelsDtagOffers(dAtA, i, uint64(len(m.DTag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DTagBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DTagBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DTagBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintModelsDtagOffers(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModelsDtagOffers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintModelsDtagOffers(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintModelsDtagOffers(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DTag) > 0 {
+		i -= len(m.DTag)
+		copy(dAtA[i:], m.DTag)
+		i = encodeVarintModelsDtagOffers(dAtA, i, uint64(len(m.DTag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModelsDtagOffers(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModelsDtagOffers(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DTagListing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DTag)
+	if l > 0 {
+		n += 1 + l + sovModelsDtagOffers(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovModelsDtagOffers(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovModelsDtagOffers(uint64(l))
+	l = len(m.NewDTag)
+	if l > 0 {
+		n += 1 + l + sovModelsDtagOffers(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovModelsDtagOffers(uint64(l))
+	return n
+}
+
+func (m *DTagBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DTag)
+	if l > 0 {
+		n += 1 + l + sovModelsDtagOffers(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovModelsDtagOffers(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovModelsDtagOffers(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovModelsDtagOffers(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovModelsDtagOffers(uint64(l))
+	return n
+}
+
+func sovModelsDtagOffers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModelsDtagOffers(x uint64) (n int) {
+	return sovModelsDtagOffers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DTagListing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModelsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DTagListing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DTagListing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModelsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DTagBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModelsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DTagBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DTagBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModelsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModelsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipModelsDtagOffers(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowModelsDtagOffers
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModelsDtagOffers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthModelsDtagOffers
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupModelsDtagOffers
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthModelsDtagOffers
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthModelsDtagOffers        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowModelsDtagOffers          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupModelsDtagOffers = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,240 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/v7/app"
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+func TestDTagListing_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		listing   types.DTagListing
+		shouldErr bool
+	}{
+		{
+			name: "empty DTag returns error",
+			listing: types.NewDTagListing(
+				"",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid owner returns error",
+			listing: types.NewDTagListing(
+				"dtag",
+				"",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero price returns error",
+			listing: types.NewDTagListing(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(0)),
+				"new_dtag",
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "empty new DTag returns error",
+			listing: types.NewDTagListing(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"",
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "new DTag equal to the listed one returns error",
+			listing: types.NewDTagListing(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"DTag",
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero expiration time returns error",
+			listing: types.NewDTagListing(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Time{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid listing returns no error",
+			listing: types.NewDTagListing(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.listing.Validate()
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMustUnmarshalDTagListing(t *testing.T) {
+	cdc, _ := app.MakeCodecs()
+	listing := types.NewDTagListing(
+		"dtag",
+		"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+		sdk.NewCoin("udsm", sdk.NewInt(100)),
+		"new_dtag",
+		time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	)
+	marshalled := cdc.MustMarshal(&listing)
+	unmarshalled := types.MustUnmarshalDTagListing(cdc, marshalled)
+	require.Equal(t, listing, unmarshalled)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestDTagBid_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		bid       types.DTagBid
+		shouldErr bool
+	}{
+		{
+			name: "empty DTag returns error",
+			bid: types.NewDTagBid(
+				"",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid buyer returns error",
+			bid: types.NewDTagBid(
+				"dtag",
+				"",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid owner returns error",
+			bid: types.NewDTagBid(
+				"dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "equal buyer and owner returns error",
+			bid: types.NewDTagBid(
+				"dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid price returns error",
+			bid: types.NewDTagBid(
+				"dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.Coin{Denom: "udsm"},
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero expiration time returns error",
+			bid: types.NewDTagBid(
+				"dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Time{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid bid returns no error",
+			bid: types.NewDTagBid(
+				"dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bid.Validate()
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMustUnmarshalDTagBid(t *testing.T) {
+	cdc, _ := app.MakeCodecs()
+	bid := types.NewDTagBid(
+		"dtag",
+		"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+		"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+		sdk.NewCoin("udsm", sdk.NewInt(100)),
+		time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	)
+	marshalled := cdc.MustMarshal(&bid)
+	unmarshalled := types.MustUnmarshalDTagBid(cdc, marshalled)
+	require.Equal(t, bid, unmarshalled)
+}
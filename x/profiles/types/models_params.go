@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"cosmossdk.io/math"
@@ -30,24 +32,29 @@ var (
 // ___________________________________________________________________________________________________________________
 
 // NewParams creates a new ProfileParams obj
-func NewParams(nickname NicknameParams, dTag DTagParams, bio BioParams, oracle OracleParams, appLinks AppLinksParams) Params {
+func NewParams(
+	nickname NicknameParams, dTag DTagParams, bio BioParams, oracle OracleParams, appLinks AppLinksParams,
+	reservedDTags ReservedDTagsParams,
+) Params {
 	return Params{
-		Nickname: nickname,
-		DTag:     dTag,
-		Bio:      bio,
-		Oracle:   oracle,
-		AppLinks: appLinks,
+		Nickname:      nickname,
+		DTag:          dTag,
+		Bio:           bio,
+		Oracle:        oracle,
+		AppLinks:      appLinks,
+		ReservedDTags: reservedDTags,
 	}
 }
 
 // DefaultParams return default paramsModule
 func DefaultParams() Params {
 	return Params{
-		Nickname: DefaultNicknameParams(),
-		DTag:     DefaultDTagParams(),
-		Bio:      DefaultBioParams(),
-		Oracle:   DefaultOracleParams(),
-		AppLinks: DefaultAppLinksParams(),
+		Nickname:      DefaultNicknameParams(),
+		DTag:          DefaultDTagParams(),
+		Bio:           DefaultBioParams(),
+		Oracle:        DefaultOracleParams(),
+		AppLinks:      DefaultAppLinksParams(),
+		ReservedDTags: DefaultReservedDTagsParams(),
 	}
 }
 
@@ -69,7 +76,11 @@ func (params Params) Validate() error {
 		return err
 	}
 
-	return ValidateAppLinksParams(params.AppLinks)
+	if err := ValidateAppLinksParams(params.AppLinks); err != nil {
+		return err
+	}
+
+	return ValidateReservedDTagsParams(params.ReservedDTags)
 }
 
 // ___________________________________________________________________________________________________________________
@@ -266,3 +277,170 @@ func ValidateAppLinksParams(i interface{}) error {
 
 	return nil
 }
+
+// ___________________________________________________________________________________________________________________
+
+// NewReservedDTagsParams creates a new ReservedDTagsParams instance
+func NewReservedDTagsParams(entries []ReservedDTag, fees []ReservedDTagFee) ReservedDTagsParams {
+	return ReservedDTagsParams{
+		Entries: entries,
+		Fees:    fees,
+	}
+}
+
+// DefaultReservedDTagsParams returns the default instance of ReservedDTagsParams
+func DefaultReservedDTagsParams() ReservedDTagsParams {
+	return NewReservedDTagsParams(nil, nil)
+}
+
+// ValidateReservedDTagsParams returns an error if interface does not represent a valid ReservedDTagsParams instance
+func ValidateReservedDTagsParams(i interface{}) error {
+	params, isReservedDTagsParams := i.(ReservedDTagsParams)
+	if !isReservedDTagsParams {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	values := map[string]bool{}
+	for _, entry := range params.Entries {
+		value := entry.Value
+		if !entry.IsPattern {
+			value = strings.ToLower(value)
+		}
+
+		if values[value] {
+			return fmt.Errorf("duplicated reserved dTag entry: %s", entry.Value)
+		}
+		values[value] = true
+
+		err := entry.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, fee := range params.Fees {
+		if i > 0 && fee.MaxLength <= params.Fees[i-1].MaxLength {
+			return fmt.Errorf("reserved dTag fees must be sorted by strictly increasing max length")
+		}
+
+		err := fee.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetMatchingEntry returns the first reserved entry matching the given DTag, if any
+func (params ReservedDTagsParams) GetMatchingEntry(dTag string) (ReservedDTag, bool) {
+	for _, entry := range params.Entries {
+		if entry.Matches(dTag) {
+			return entry, true
+		}
+	}
+	return ReservedDTag{}, false
+}
+
+// GetFee returns the fee that must be paid in order to claim a reserved DTag having the given length.
+// It returns false if no fee applies to such length, meaning that the DTag cannot be claimed.
+func (params ReservedDTagsParams) GetFee(dTagLength int) (sdk.Coins, bool) {
+	for _, fee := range params.Fees {
+		if uint64(dTagLength) <= uint64(fee.MaxLength) {
+			return fee.Amount, true
+		}
+	}
+	return nil, false
+}
+
+// NewReservedDTag returns a new ReservedDTag instance
+func NewReservedDTag(value string, isPattern bool, designatedAddress string) ReservedDTag {
+	return ReservedDTag{
+		Value:             value,
+		IsPattern:         isPattern,
+		DesignatedAddress: designatedAddress,
+	}
+}
+
+// Validate implements fmt.Validator
+func (entry ReservedDTag) Validate() error {
+	if strings.TrimSpace(entry.Value) == "" {
+		return fmt.Errorf("empty reserved dTag value")
+	}
+
+	if entry.IsPattern {
+		_, err := compileReservedDTagPattern(entry.Value)
+		if err != nil {
+			return fmt.Errorf("invalid reserved dTag pattern %s: %s", entry.Value, err)
+		}
+	}
+
+	if entry.DesignatedAddress != "" {
+		_, err := sdk.AccAddressFromBech32(entry.DesignatedAddress)
+		if err != nil {
+			return fmt.Errorf("invalid reserved dTag designated address: %s", entry.DesignatedAddress)
+		}
+	}
+
+	return nil
+}
+
+// Matches tells whether the given DTag is reserved by this entry.
+// Both exact values and patterns are matched case-insensitively, as DTags are unique regardless of their case.
+func (entry ReservedDTag) Matches(dTag string) bool {
+	if entry.IsPattern {
+		pattern, err := getReservedDTagPattern(entry.Value)
+		if err != nil {
+			panic(err)
+		}
+		return pattern.MatchString(dTag)
+	}
+	return strings.EqualFold(entry.Value, dTag)
+}
+
+// reservedDTagPatterns caches the compiled reserved DTag patterns, so that they are not compiled again
+// every time a DTag is checked. Only the patterns that are matched against DTags are cached, which are
+// the ones set inside the module params
+var reservedDTagPatterns sync.Map
+
+// compileReservedDTagPattern compiles the given pattern into a case-insensitive regular expression
+func compileReservedDTagPattern(value string) (*regexp.Regexp, error) {
+	return regexp.Compile("(?i)" + value)
+}
+
+// getReservedDTagPattern returns the case-insensitive regular expression compiled from the given pattern,
+// compiling it only the first time it is requested
+func getReservedDTagPattern(value string) (*regexp.Regexp, error) {
+	if cached, ok := reservedDTagPatterns.Load(value); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+
+	pattern, err := compileReservedDTagPattern(value)
+	if err != nil {
+		return nil, err
+	}
+
+	reservedDTagPatterns.Store(value, pattern)
+	return pattern, nil
+}
+
+// NewReservedDTagFee returns a new ReservedDTagFee instance
+func NewReservedDTagFee(maxLength uint32, amount sdk.Coins) ReservedDTagFee {
+	return ReservedDTagFee{
+		MaxLength: maxLength,
+		Amount:    amount,
+	}
+}
+
+// Validate implements fmt.Validator
+func (fee ReservedDTagFee) Validate() error {
+	if fee.MaxLength == 0 {
+		return fmt.Errorf("invalid reserved dTag fee max length: %d", fee.MaxLength)
+	}
+
+	if !fee.Amount.IsValid() {
+		return fmt.Errorf("invalid reserved dTag fee amount: %s", fee.Amount)
+	}
+
+	return nil
+}
@@ -32,11 +32,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params contains the parameters for the profiles module
 type Params struct {
-	Nickname NicknameParams `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
-	DTag     DTagParams     `protobuf:"bytes,2,opt,name=dtag,proto3" json:"dtag" yaml:"dtag"`
-	Bio      BioParams      `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio" yaml:"bio"`
-	Oracle   OracleParams   `protobuf:"bytes,4,opt,name=oracle,proto3" json:"oracle" yaml:"oracle"`
-	AppLinks AppLinksParams `protobuf:"bytes,5,opt,name=app_links,json=appLinks,proto3" json:"app_links" yaml:"app_links"`
+	Nickname      NicknameParams      `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
+	DTag          DTagParams          `protobuf:"bytes,2,opt,name=dtag,proto3" json:"dtag" yaml:"dtag"`
+	Bio           BioParams           `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio" yaml:"bio"`
+	Oracle        OracleParams        `protobuf:"bytes,4,opt,name=oracle,proto3" json:"oracle" yaml:"oracle"`
+	AppLinks      AppLinksParams      `protobuf:"bytes,5,opt,name=app_links,json=appLinks,proto3" json:"app_links" yaml:"app_links"`
+	ReservedDTags ReservedDTagsParams `protobuf:"bytes,6,opt,name=reserved_dtags,json=reservedDtags,proto3" json:"reserved_dtags" yaml:"reserved_dtags"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -284,6 +285,137 @@ func (m *AppLinksParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AppLinksParams proto.InternalMessageInfo
 
+// ReservedDTagsParams defines the parameters related to the reserved DTags
+type ReservedDTagsParams struct {
+	// Entries represents the list of DTags that are reserved
+	Entries []ReservedDTag `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries" yaml:"entries"`
+	// Fees represents the length-based fees that must be paid to the community
+	// pool in order to claim a reserved DTag that has no designated address
+	Fees []ReservedDTagFee `protobuf:"bytes,2,rep,name=fees,proto3" json:"fees" yaml:"fees"`
+}
+
+func (m *ReservedDTagsParams) Reset()         { *m = ReservedDTagsParams{} }
+func (m *ReservedDTagsParams) String() string { return proto.CompactTextString(m) }
+func (*ReservedDTagsParams) ProtoMessage()    {}
+func (*ReservedDTagsParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_924c89959f3aa975, []int{6}
+}
+func (m *ReservedDTagsParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReservedDTagsParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReservedDTagsParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReservedDTagsParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReservedDTagsParams.Merge(m, src)
+}
+func (m *ReservedDTagsParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReservedDTagsParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReservedDTagsParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReservedDTagsParams proto.InternalMessageInfo
+
+// ReservedDTag represents a single reserved DTag entry
+type ReservedDTag struct {
+	// Value represents either the exact DTag that is reserved or, if IsPattern
+	// is true, the regular expression matching all the reserved DTags. Both
+	// are matched regardless of the DTag case
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+	// IsPattern tells whether the value should be considered a regular
+	// expression instead of an exact DTag
+	IsPattern bool `protobuf:"varint,2,opt,name=is_pattern,json=isPattern,proto3" json:"is_pattern,omitempty" yaml:"is_pattern"`
+	// (optional) Address of the only user that can claim the reserved DTags.
+	// When empty, the DTags can be claimed by anyone paying the reserved DTag fee
+	DesignatedAddress string `protobuf:"bytes,3,opt,name=designated_address,json=designatedAddress,proto3" json:"designated_address,omitempty" yaml:"designated_address"`
+}
+
+func (m *ReservedDTag) Reset()         { *m = ReservedDTag{} }
+func (m *ReservedDTag) String() string { return proto.CompactTextString(m) }
+func (*ReservedDTag) ProtoMessage()    {}
+func (*ReservedDTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_924c89959f3aa975, []int{7}
+}
+func (m *ReservedDTag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReservedDTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReservedDTag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReservedDTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReservedDTag.Merge(m, src)
+}
+func (m *ReservedDTag) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReservedDTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReservedDTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReservedDTag proto.InternalMessageInfo
+
+// ReservedDTagFee represents the fee that must be paid in order to claim a
+// reserved DTag whose length is not greater than the given max length
+type ReservedDTagFee struct {
+	// MaxLength represents the maximum length of the DTags this fee applies to
+	MaxLength uint32 `protobuf:"varint,1,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty" yaml:"max_length"`
+	// Amount represents the amount that must be paid to the community pool
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *ReservedDTagFee) Reset()         { *m = ReservedDTagFee{} }
+func (m *ReservedDTagFee) String() string { return proto.CompactTextString(m) }
+func (*ReservedDTagFee) ProtoMessage()    {}
+func (*ReservedDTagFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_924c89959f3aa975, []int{8}
+}
+func (m *ReservedDTagFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReservedDTagFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReservedDTagFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReservedDTagFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReservedDTagFee.Merge(m, src)
+}
+func (m *ReservedDTagFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReservedDTagFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReservedDTagFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReservedDTagFee proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "desmos.profiles.v3.Params")
 	proto.RegisterType((*NicknameParams)(nil), "desmos.profiles.v3.NicknameParams")
@@ -291,6 +423,9 @@ func init() {
 	proto.RegisterType((*BioParams)(nil), "desmos.profiles.v3.BioParams")
 	proto.RegisterType((*OracleParams)(nil), "desmos.profiles.v3.OracleParams")
 	proto.RegisterType((*AppLinksParams)(nil), "desmos.profiles.v3.AppLinksParams")
+	proto.RegisterType((*ReservedDTagsParams)(nil), "desmos.profiles.v3.ReservedDTagsParams")
+	proto.RegisterType((*ReservedDTag)(nil), "desmos.profiles.v3.ReservedDTag")
+	proto.RegisterType((*ReservedDTagFee)(nil), "desmos.profiles.v3.ReservedDTagFee")
 }
 
 func init() {
@@ -298,61 +433,76 @@ func init() {
 }
 
 var fileDescriptor_924c89959f3aa975 = []byte{
-	// 858 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0xe3, 0x3a, 0x8d, 0x92, 0x49, 0x59, 0x36, 0xd6, 0x0a, 0x65, 0x2b, 0xd6, 0x0e, 0x3e,
-	0xac, 0x42, 0xa5, 0xb5, 0x95, 0xed, 0x61, 0xa5, 0x4a, 0x1c, 0xd6, 0x2d, 0x82, 0x8a, 0xe5, 0x45,
-	0xa6, 0x5c, 0x00, 0x61, 0x8d, 0xed, 0x89, 0x77, 0x14, 0xdb, 0x63, 0x3c, 0x4e, 0x94, 0xf0, 0x09,
-	0x80, 0x13, 0x17, 0x24, 0xc4, 0x89, 0xe3, 0x8a, 0x53, 0xbf, 0x03, 0x97, 0x3d, 0xee, 0x11, 0xed,
-	0xc1, 0x8b, 0xd2, 0x43, 0x39, 0xe7, 0x13, 0xa0, 0x79, 0x71, 0x5e, 0xda, 0x22, 0xb5, 0xa0, 0xbd,
-	0x24, 0xe3, 0x99, 0xe7, 0xff, 0x7b, 0xfe, 0x7e, 0x1e, 0xcf, 0x0c, 0xb8, 0x1f, 0x22, 0x9a, 0x10,
-	0x6a, 0x67, 0x39, 0x19, 0xe2, 0x18, 0x51, 0x7b, 0xb2, 0x6f, 0x27, 0x24, 0x44, 0x31, 0xf5, 0x32,
-	0x98, 0xc3, 0x84, 0x5a, 0x59, 0x4e, 0x0a, 0xa2, 0x69, 0x22, 0xce, 0xaa, 0xe2, 0xac, 0xc9, 0xfe,
-	0x6e, 0x07, 0x26, 0x38, 0x25, 0x36, 0xff, 0x15, 0x61, 0xbb, 0x77, 0x22, 0x12, 0x11, 0x3e, 0xb4,
-	0xd9, 0x48, 0xce, 0xea, 0x01, 0xe1, 0x49, 0x7c, 0x48, 0x91, 0x3d, 0x19, 0xf8, 0xa8, 0x80, 0x03,
-	0x3b, 0x20, 0x38, 0xad, 0xd6, 0x23, 0x42, 0xa2, 0x18, 0xd9, 0xfc, 0xc9, 0x1f, 0x0f, 0xed, 0x70,
-	0x9c, 0xc3, 0x02, 0x13, 0xb9, 0x6e, 0xbe, 0x54, 0x41, 0xe3, 0x33, 0xee, 0x46, 0xfb, 0x1a, 0x34,
-	0x53, 0x1c, 0x8c, 0x52, 0x98, 0xa0, 0xae, 0xd2, 0x53, 0xfa, 0xed, 0x87, 0xa6, 0x75, 0xd9, 0x9a,
-	0xf5, 0x89, 0x8c, 0x11, 0x2a, 0xe7, 0xed, 0xe7, 0xa5, 0x51, 0x5b, 0x94, 0xc6, 0x9b, 0x33, 0x98,
-	0xc4, 0x07, 0x66, 0x45, 0x30, 0x9f, 0x9d, 0x9f, 0xee, 0x29, 0xee, 0x92, 0xa8, 0x9d, 0x80, 0x7a,
-	0x58, 0xc0, 0xa8, 0xbb, 0xc5, 0xc9, 0xfa, 0x55, 0xe4, 0xa3, 0x13, 0x18, 0x49, 0x6a, 0x8f, 0x51,
-	0xe7, 0xa5, 0x51, 0x67, 0x73, 0x8b, 0xd2, 0x68, 0x0b, 0x3a, 0x23, 0x48, 0x32, 0xa7, 0x69, 0x87,
-	0x40, 0xf5, 0x31, 0xe9, 0xaa, 0x1c, 0x7a, 0xef, 0x2a, 0xa8, 0x83, 0x89, 0x64, 0x6a, 0xd2, 0x29,
-	0x10, 0x2c, 0x1f, 0x13, 0xd3, 0x65, 0x6a, 0xed, 0x04, 0x34, 0x48, 0x0e, 0x83, 0x18, 0x75, 0xeb,
-	0x9c, 0xd3, 0xbb, 0x8a, 0xf3, 0x29, 0x8f, 0x90, 0xa8, 0x5d, 0x89, 0x7a, 0x43, 0xa0, 0x84, 0x5a,
-	0x1a, 0x93, 0x2c, 0xed, 0x1b, 0xd0, 0x82, 0x59, 0xe6, 0xc5, 0x38, 0x1d, 0xd1, 0xee, 0xf6, 0xbf,
-	0xd7, 0xf3, 0x71, 0x96, 0x3d, 0x61, 0x31, 0x12, 0x7d, 0x4f, 0xa2, 0x6f, 0x0b, 0xf4, 0x12, 0x51,
-	0x15, 0x14, 0xca, 0xf0, 0x83, 0x77, 0xbe, 0xff, 0xcd, 0xa8, 0xfd, 0x78, 0x7e, 0xba, 0xd7, 0x95,
-	0xdf, 0xd9, 0x74, 0xf5, 0xa5, 0x09, 0x96, 0xf9, 0xb7, 0x02, 0x6e, 0x6d, 0xb6, 0x4b, 0x8b, 0x00,
-	0x48, 0x70, 0xea, 0xc5, 0x28, 0x8d, 0x8a, 0xa7, 0xbc, 0xcd, 0x3b, 0xce, 0x87, 0x2c, 0xe5, 0xcb,
-	0xd2, 0xb8, 0x1f, 0xe1, 0xe2, 0xe9, 0xd8, 0xb7, 0x02, 0x92, 0xd8, 0xf2, 0xb3, 0x12, 0x7f, 0x0f,
-	0x68, 0x38, 0xb2, 0x8b, 0x59, 0x86, 0xa8, 0x75, 0x9c, 0x16, 0x8b, 0xd2, 0xe8, 0x08, 0x73, 0x2b,
-	0x92, 0x74, 0xd7, 0x4a, 0x70, 0xfa, 0x84, 0x4f, 0xf0, 0x44, 0x70, 0x5a, 0x25, 0xda, 0xfa, 0x9f,
-	0x89, 0x96, 0xa4, 0x65, 0x22, 0x38, 0x15, 0x89, 0x0e, 0xea, 0xac, 0x0e, 0xe6, 0xb3, 0x2d, 0x00,
-	0x56, 0xdf, 0x8f, 0xd6, 0x07, 0x8d, 0x1c, 0x45, 0x1e, 0x9a, 0xf2, 0x57, 0x6c, 0x39, 0x9d, 0x55,
-	0xb3, 0xc4, 0xbc, 0xe9, 0x6e, 0xe7, 0x28, 0x7a, 0x7f, 0xaa, 0x15, 0x1b, 0x05, 0x11, 0x3e, 0xbf,
-	0xb8, 0x99, 0xcf, 0x79, 0x69, 0xb4, 0x3e, 0xae, 0x5e, 0xfc, 0x7a, 0xd5, 0x29, 0x36, 0xaa, 0xa3,
-	0xfe, 0xe7, 0xac, 0x55, 0x15, 0x6e, 0x52, 0xaa, 0xef, 0x40, 0x6b, 0xb9, 0x29, 0x2e, 0xb4, 0x49,
-	0x7d, 0xdd, 0x6d, 0xfa, 0x43, 0x05, 0x3b, 0xeb, 0x3b, 0x49, 0x7b, 0x0f, 0xb4, 0x68, 0x90, 0xe3,
-	0xac, 0xf0, 0x70, 0xc8, 0x7b, 0x55, 0x77, 0x7a, 0xf3, 0xd2, 0x68, 0x7e, 0xce, 0x27, 0x8f, 0x8f,
-	0x56, 0x3b, 0x61, 0x19, 0x66, 0xba, 0x4d, 0x31, 0x3e, 0x0e, 0xb5, 0x01, 0x68, 0x41, 0x3a, 0xf2,
-	0x02, 0x32, 0x4e, 0x0b, 0xde, 0xbc, 0xba, 0x73, 0x67, 0x6d, 0xf3, 0x54, 0x4b, 0xa6, 0xdb, 0x84,
-	0x74, 0x74, 0xc8, 0x86, 0x4c, 0xc2, 0x3a, 0x23, 0x24, 0xea, 0x45, 0xc9, 0x72, 0xc9, 0x74, 0x9b,
-	0x09, 0x4e, 0x85, 0xe4, 0x11, 0x68, 0x67, 0x39, 0xca, 0x60, 0x8e, 0xbc, 0x08, 0x52, 0x7e, 0x4a,
-	0xd4, 0x9d, 0xb7, 0x16, 0xa5, 0xa1, 0x09, 0xd1, 0xda, 0xa2, 0xe9, 0x02, 0xf9, 0xf4, 0x01, 0xa4,
-	0x4c, 0x88, 0xa6, 0x28, 0x18, 0x17, 0x42, 0xb8, 0x7d, 0x51, 0xb8, 0xb6, 0x68, 0xba, 0x40, 0x3e,
-	0x31, 0xe1, 0xcf, 0x0a, 0x00, 0x43, 0x84, 0x3c, 0x98, 0x70, 0x9b, 0x8d, 0x9e, 0xda, 0x6f, 0x3f,
-	0xbc, 0x6b, 0x89, 0xf2, 0x5b, 0xec, 0xb0, 0xb7, 0xe4, 0x61, 0x6f, 0x1d, 0x12, 0x9c, 0x3a, 0x5f,
-	0xc9, 0x53, 0x43, 0x36, 0x62, 0x25, 0x35, 0x7f, 0x7f, 0x65, 0xf4, 0xaf, 0xd1, 0x47, 0x46, 0xa1,
-	0xbf, 0x9e, 0x9f, 0xee, 0xed, 0xc4, 0x28, 0x82, 0xc1, 0xcc, 0x63, 0x77, 0x08, 0x95, 0x5d, 0x1c,
-	0x22, 0xf4, 0x98, 0xd3, 0x64, 0x17, 0x7f, 0x50, 0xc0, 0xad, 0xcd, 0x63, 0x4b, 0xfb, 0x16, 0x74,
-	0x26, 0x30, 0xc6, 0x21, 0x2e, 0x66, 0x5e, 0x75, 0xc5, 0xc8, 0x5b, 0xe4, 0xae, 0x25, 0xee, 0x20,
-	0xab, 0xba, 0x83, 0xac, 0x23, 0x19, 0xe0, 0xbc, 0x2b, 0x6d, 0x77, 0x85, 0xed, 0x4b, 0x04, 0xf3,
-	0x97, 0x57, 0x86, 0x22, 0x4c, 0xdc, 0xae, 0x16, 0x2b, 0xb1, 0xf0, 0xe2, 0x7c, 0xf4, 0x7c, 0xae,
-	0x2b, 0x2f, 0xe6, 0xba, 0xf2, 0xd7, 0x5c, 0x57, 0x7e, 0x3a, 0xd3, 0x6b, 0x2f, 0xce, 0xf4, 0xda,
-	0x9f, 0x67, 0x7a, 0xed, 0xcb, 0xc1, 0xda, 0x6b, 0x8b, 0x23, 0xf2, 0x41, 0x0c, 0x7d, 0x2a, 0xc7,
-	0xf6, 0xe4, 0xd1, 0xfa, 0x89, 0xc9, 0xab, 0xe0, 0x37, 0xb8, 0xc5, 0xfd, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x35, 0x07, 0x1e, 0xb9, 0xbb, 0x07, 0x00, 0x00,
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xb3, 0x9b, 0x65, 0x77, 0xf2, 0xd1, 0x64, 0x68, 0x91, 0x13, 0xc8, 0x7a, 0x31, 0x52,
+	0x08, 0x91, 0x6a, 0x2b, 0x0d, 0x52, 0xa5, 0x48, 0x1c, 0xe2, 0x84, 0x8f, 0x88, 0x00, 0xc5, 0x04,
+	0x55, 0x02, 0x84, 0x35, 0xbb, 0x9e, 0xb8, 0xa3, 0xf8, 0x0b, 0x8f, 0x77, 0xb5, 0xe1, 0xca, 0x05,
+	0x7a, 0xe2, 0x82, 0x84, 0x38, 0x71, 0xac, 0x38, 0xe5, 0x4f, 0x40, 0xe2, 0x40, 0x8f, 0x3d, 0xa2,
+	0x1e, 0xdc, 0x6a, 0x73, 0x08, 0xe7, 0xfd, 0x0b, 0xd0, 0x7c, 0x78, 0xed, 0x4d, 0xb6, 0x4a, 0xf9,
+	0xba, 0x24, 0xb3, 0xf3, 0xde, 0xef, 0xf7, 0x7e, 0xf3, 0xde, 0x9b, 0x79, 0x06, 0x6b, 0x2e, 0xa6,
+	0x41, 0x44, 0xcd, 0x38, 0x89, 0x8e, 0x88, 0x8f, 0xa9, 0xd9, 0xdb, 0x32, 0x83, 0xc8, 0xc5, 0x3e,
+	0x75, 0x62, 0x94, 0xa0, 0x80, 0x1a, 0x71, 0x12, 0xa5, 0x11, 0x84, 0xc2, 0xcf, 0xc8, 0xfd, 0x8c,
+	0xde, 0xd6, 0xca, 0x12, 0x0a, 0x48, 0x18, 0x99, 0xfc, 0xaf, 0x70, 0x5b, 0xb9, 0xee, 0x45, 0x5e,
+	0xc4, 0x97, 0x26, 0x5b, 0xc9, 0xdd, 0x66, 0x27, 0xe2, 0x41, 0xda, 0x88, 0x62, 0xb3, 0xb7, 0xd9,
+	0xc6, 0x29, 0xda, 0x34, 0x3b, 0x11, 0x09, 0x73, 0xbb, 0x17, 0x45, 0x9e, 0x8f, 0x4d, 0xfe, 0xab,
+	0xdd, 0x3d, 0x32, 0xdd, 0x6e, 0x82, 0x52, 0x12, 0x49, 0xbb, 0xfe, 0xb4, 0x0a, 0x6a, 0x77, 0xb8,
+	0x1a, 0xf8, 0x05, 0xa8, 0x87, 0xa4, 0x73, 0x1c, 0xa2, 0x00, 0xab, 0x4a, 0x4b, 0x59, 0x9f, 0xbd,
+	0xa5, 0x1b, 0x97, 0xa5, 0x19, 0x1f, 0x4a, 0x1f, 0x81, 0xb2, 0x5e, 0x79, 0x98, 0x69, 0x53, 0xc3,
+	0x4c, 0xbb, 0x76, 0x82, 0x02, 0x7f, 0x5b, 0xcf, 0x19, 0xf4, 0x07, 0xe7, 0xa7, 0x1b, 0x8a, 0x3d,
+	0x62, 0x84, 0x87, 0xa0, 0xea, 0xa6, 0xc8, 0x53, 0xa7, 0x39, 0x73, 0x73, 0x12, 0xf3, 0xde, 0x21,
+	0xf2, 0x24, 0x6b, 0x8b, 0xb1, 0x0e, 0x32, 0xad, 0xca, 0xf6, 0x86, 0x99, 0x36, 0x2b, 0xd8, 0x19,
+	0x83, 0x64, 0xe6, 0x6c, 0x70, 0x17, 0x54, 0xda, 0x24, 0x52, 0x2b, 0x9c, 0x74, 0x75, 0x12, 0xa9,
+	0x45, 0x22, 0xc9, 0x09, 0xa5, 0x52, 0x20, 0xb8, 0xda, 0x24, 0xd2, 0x6d, 0x86, 0x86, 0x87, 0xa0,
+	0x16, 0x25, 0xa8, 0xe3, 0x63, 0xb5, 0xca, 0x79, 0x5a, 0x93, 0x78, 0x3e, 0xe2, 0x1e, 0x92, 0x6a,
+	0x45, 0x52, 0xcd, 0x0b, 0x2a, 0x81, 0x96, 0xc2, 0x24, 0x17, 0xfc, 0x12, 0x34, 0x50, 0x1c, 0x3b,
+	0x3e, 0x09, 0x8f, 0xa9, 0x3a, 0xf3, 0xec, 0x7c, 0xee, 0xc4, 0xf1, 0x01, 0xf3, 0x91, 0xd4, 0xab,
+	0x92, 0x7a, 0x51, 0x50, 0x8f, 0x28, 0xf2, 0x84, 0x22, 0xe9, 0x0e, 0xbf, 0x51, 0xc0, 0x42, 0x82,
+	0x29, 0x4e, 0x7a, 0xd8, 0x75, 0x58, 0x32, 0xa8, 0x5a, 0xe3, 0x51, 0x5e, 0x9f, 0x14, 0xc5, 0x96,
+	0x9e, 0x2c, 0x9f, 0x79, 0xa8, 0x2d, 0x99, 0xe4, 0xf9, 0x31, 0xe3, 0x30, 0xd3, 0x6e, 0x88, 0xd8,
+	0xe3, 0xec, 0x52, 0xc0, 0x7c, 0xbe, 0xbb, 0xc7, 0x36, 0xb7, 0x5f, 0xfd, 0xf6, 0x67, 0x6d, 0xea,
+	0xfe, 0xf9, 0xe9, 0x86, 0x2a, 0xbb, 0xbd, 0x5f, 0xf4, 0xbb, 0x08, 0xa3, 0xff, 0xa9, 0x80, 0x85,
+	0xf1, 0xa6, 0x81, 0x1e, 0x00, 0x01, 0x09, 0x1d, 0x1f, 0x87, 0x5e, 0x7a, 0x8f, 0x37, 0xdb, 0x9c,
+	0xf5, 0x1e, 0x53, 0xf3, 0x38, 0xd3, 0xd6, 0x3c, 0x92, 0xde, 0xeb, 0xb6, 0x8d, 0x4e, 0x14, 0x98,
+	0xb2, 0xb9, 0xc5, 0xbf, 0x9b, 0xd4, 0x3d, 0x36, 0xd3, 0x93, 0x18, 0x53, 0x63, 0x3f, 0x4c, 0x87,
+	0x99, 0xb6, 0x24, 0x64, 0x16, 0x4c, 0x52, 0x62, 0x23, 0x20, 0xe1, 0x01, 0xdf, 0xe0, 0x81, 0x50,
+	0x3f, 0x0f, 0x34, 0xfd, 0x2f, 0x03, 0xa1, 0xfe, 0xc5, 0x40, 0xa8, 0x2f, 0x02, 0x6d, 0x57, 0x59,
+	0x1e, 0xf4, 0x07, 0xd3, 0x00, 0x14, 0x5d, 0x0c, 0xd7, 0x41, 0x2d, 0xc1, 0x9e, 0x83, 0xfb, 0xfc,
+	0x88, 0x0d, 0x6b, 0xa9, 0x68, 0x19, 0xb1, 0xaf, 0xdb, 0x33, 0x09, 0xf6, 0xde, 0xee, 0xc3, 0x74,
+	0x2c, 0x21, 0x42, 0xe7, 0xa7, 0x7f, 0x4f, 0xe7, 0x20, 0xd3, 0x1a, 0x1f, 0xe4, 0x07, 0x7f, 0xbe,
+	0xec, 0xa4, 0x63, 0xd9, 0xa9, 0xfc, 0xe3, 0xa8, 0xa8, 0x7f, 0x29, 0xea, 0x95, 0xa9, 0xfa, 0x1a,
+	0x34, 0x46, 0x57, 0x13, 0x7a, 0x13, 0x84, 0xfc, 0x8f, 0x65, 0xfa, 0xad, 0x02, 0xe6, 0xca, 0xf7,
+	0x19, 0xbe, 0x05, 0x1a, 0xb4, 0x93, 0x90, 0x38, 0x75, 0x88, 0xcb, 0x6b, 0x55, 0xb5, 0x5a, 0x83,
+	0x4c, 0xab, 0x7f, 0xc2, 0x37, 0xf7, 0xf7, 0x8a, 0xfb, 0x38, 0x72, 0xd3, 0xed, 0xba, 0x58, 0xef,
+	0xbb, 0x70, 0x13, 0x34, 0x10, 0x3d, 0x76, 0x3a, 0x51, 0x37, 0x4c, 0x79, 0xf1, 0xaa, 0xd6, 0xf5,
+	0x02, 0x32, 0x32, 0xe9, 0x76, 0x1d, 0xd1, 0xe3, 0x5d, 0xb6, 0x64, 0x10, 0x56, 0x19, 0x01, 0xa9,
+	0x5c, 0x84, 0x8c, 0x4c, 0xba, 0x5d, 0x0f, 0x48, 0x28, 0x20, 0xb7, 0xc1, 0x6c, 0x9c, 0xe0, 0x18,
+	0x25, 0xd8, 0xf1, 0x10, 0xe5, 0x6f, 0x55, 0xd5, 0x7a, 0x69, 0x98, 0x69, 0x50, 0x80, 0x4a, 0x46,
+	0xdd, 0x06, 0xf2, 0xd7, 0xbb, 0x88, 0x32, 0x20, 0xee, 0xe3, 0x4e, 0x37, 0x15, 0xc0, 0x99, 0x8b,
+	0xc0, 0x92, 0x51, 0xb7, 0x81, 0xfc, 0xc5, 0x80, 0x3f, 0x28, 0x00, 0x1c, 0x61, 0xec, 0xa0, 0x80,
+	0xcb, 0xac, 0xb5, 0x2a, 0xeb, 0xb3, 0xb7, 0x96, 0x0d, 0x91, 0x7e, 0x83, 0x8d, 0x1c, 0x43, 0x8e,
+	0x1c, 0x63, 0x37, 0x22, 0xa1, 0xf5, 0xb9, 0x7c, 0xbb, 0x64, 0x21, 0x0a, 0xa8, 0xfe, 0xcb, 0x13,
+	0x6d, 0xfd, 0x39, 0xea, 0xc8, 0x58, 0xe8, 0x4f, 0xe7, 0xa7, 0x1b, 0x73, 0x3e, 0xf6, 0x50, 0xe7,
+	0xc4, 0x61, 0x93, 0x8c, 0xca, 0x2a, 0x1e, 0x61, 0xbc, 0xc3, 0xd9, 0x64, 0x15, 0xbf, 0x53, 0xc0,
+	0xc2, 0xf8, 0xe3, 0x09, 0xbf, 0x02, 0x4b, 0x3d, 0xe4, 0x13, 0x97, 0xa4, 0x27, 0x4e, 0x3e, 0xe8,
+	0xe4, 0x2c, 0x5b, 0x36, 0xc4, 0x24, 0x34, 0xf2, 0x49, 0x68, 0xec, 0x49, 0x07, 0xeb, 0x0d, 0x29,
+	0x5b, 0x15, 0xb2, 0x2f, 0x31, 0xe8, 0x3f, 0x3e, 0xd1, 0x14, 0x21, 0x62, 0x31, 0x37, 0xe6, 0x60,
+	0xa9, 0xe5, 0x77, 0x05, 0xbc, 0x38, 0xe1, 0x89, 0x85, 0x77, 0xc1, 0x0b, 0x38, 0x4c, 0x13, 0x82,
+	0xa9, 0xaa, 0xb4, 0x2a, 0xcf, 0x9a, 0x2d, 0x65, 0xa4, 0xf5, 0xb2, 0x54, 0xb3, 0x20, 0x8b, 0x23,
+	0xe0, 0xb2, 0x95, 0x73, 0x36, 0xf8, 0x31, 0xa8, 0x1e, 0x61, 0x4c, 0xd5, 0x69, 0xce, 0xfa, 0xda,
+	0x55, 0xac, 0xef, 0x60, 0x6c, 0xa9, 0x92, 0x78, 0x76, 0x54, 0x9d, 0x9c, 0x95, 0x53, 0xc9, 0x93,
+	0xfc, 0xaa, 0x80, 0xb9, 0x32, 0x12, 0xae, 0x81, 0x99, 0x1e, 0xf2, 0xbb, 0x58, 0xbe, 0x61, 0x8b,
+	0xc3, 0x4c, 0x9b, 0x1b, 0x25, 0xaa, 0x8b, 0x75, 0x5b, 0x98, 0xe1, 0x9b, 0x00, 0x10, 0xf6, 0x65,
+	0x93, 0xa6, 0x38, 0x09, 0xf9, 0x2d, 0xa8, 0x5b, 0x37, 0x8a, 0x66, 0x28, 0x6c, 0xba, 0xdd, 0x20,
+	0xf4, 0x8e, 0x58, 0xc3, 0x03, 0xc0, 0x3e, 0x7f, 0x88, 0x17, 0xa2, 0x14, 0xbb, 0x0e, 0x72, 0xdd,
+	0x04, 0x53, 0xca, 0x2f, 0x44, 0xc3, 0x5a, 0x1d, 0x66, 0xda, 0xb2, 0x1c, 0xfc, 0x97, 0x7c, 0x74,
+	0x7b, 0xa9, 0xd8, 0xdc, 0x11, 0x7b, 0xf2, 0x08, 0x8f, 0x15, 0x70, 0xed, 0xc2, 0xe1, 0x99, 0xba,
+	0xd2, 0x0b, 0xc3, 0x8e, 0x32, 0x5f, 0x56, 0x57, 0xd8, 0xf4, 0xd2, 0x73, 0x01, 0xef, 0x2b, 0xa0,
+	0x26, 0x9b, 0x7f, 0xfa, 0xaa, 0xe6, 0xbf, 0x3b, 0xfe, 0x4d, 0xf0, 0xdf, 0x35, 0xbe, 0x54, 0x20,
+	0x0e, 0x67, 0xbd, 0xff, 0x70, 0xd0, 0x54, 0x1e, 0x0d, 0x9a, 0xca, 0xd3, 0x41, 0x53, 0xf9, 0xfe,
+	0xac, 0x39, 0xf5, 0xe8, 0xac, 0x39, 0xf5, 0xc7, 0x59, 0x73, 0xea, 0xb3, 0xcd, 0x52, 0x1c, 0xd1,
+	0x0e, 0x37, 0x7d, 0xd4, 0xa6, 0x72, 0x6d, 0xf6, 0x6e, 0x97, 0x67, 0x33, 0x0f, 0xdb, 0xae, 0xf1,
+	0xcb, 0xb0, 0xf5, 0xd7, 0x00, 0x98, 0x8c, 0xbf, 0x88, 0xab, 0x0a, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -375,6 +525,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ReservedDTags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModelsParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size, err := m.AppLinks.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -636,17 +796,157 @@ func (m *AppLinksParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ValidityDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ValidityDuration):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ValidityDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ValidityDuration):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintModelsParams(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintModelsParams(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *ReservedDTagsParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReservedDTagsParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReservedDTagsParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModelsParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModelsParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReservedDTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReservedDTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReservedDTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DesignatedAddress) > 0 {
+		i -= len(m.DesignatedAddress)
+		copy(dAtA[i:], m.DesignatedAddress)
+		i = encodeVarintModelsParams(dAtA, i, uint64(len(m.DesignatedAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsPattern {
+		i--
+		if m.IsPattern {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintModelsParams(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReservedDTagFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReservedDTagFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReservedDTagFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModelsParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxLength != 0 {
+		i = encodeVarintModelsParams(dAtA, i, uint64(m.MaxLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModelsParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModelsParams(v)
 	base := offset
@@ -674,6 +974,8 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovModelsParams(uint64(l))
 	l = m.AppLinks.Size()
 	n += 1 + l + sovModelsParams(uint64(l))
+	l = m.ReservedDTags.Size()
+	n += 1 + l + sovModelsParams(uint64(l))
 	return n
 }
 
@@ -759,6 +1061,65 @@ func (m *AppLinksParams) Size() (n int) {
 	return n
 }
 
+func (m *ReservedDTagsParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovModelsParams(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovModelsParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReservedDTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovModelsParams(uint64(l))
+	}
+	if m.IsPattern {
+		n += 2
+	}
+	l = len(m.DesignatedAddress)
+	if l > 0 {
+		n += 1 + l + sovModelsParams(uint64(l))
+	}
+	return n
+}
+
+func (m *ReservedDTagFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxLength != 0 {
+		n += 1 + sovModelsParams(uint64(m.MaxLength))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovModelsParams(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovModelsParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -959,6 +1320,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedDTags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservedDTags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModelsParams(dAtA[iNdEx:])
@@ -1589,6 +1983,361 @@ func (m *AppLinksParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReservedDTagsParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModelsParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReservedDTagsParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReservedDTagsParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, ReservedDTag{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, ReservedDTagFee{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModelsParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReservedDTag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModelsParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReservedDTag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReservedDTag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPattern", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPattern = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesignatedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesignatedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModelsParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReservedDTagFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModelsParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReservedDTagFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReservedDTagFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLength", wireType)
+			}
+			m.MaxLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModelsParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModelsParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModelsParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipModelsParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25,6 +25,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultBioParams(),
 				types.DefaultOracleParams(),
 				types.DefaultAppLinksParams(),
+				types.DefaultReservedDTagsParams(),
 			),
 			shouldErr: true,
 		},
@@ -36,6 +37,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultBioParams(),
 				types.DefaultOracleParams(),
 				types.DefaultAppLinksParams(),
+				types.DefaultReservedDTagsParams(),
 			),
 			shouldErr: true,
 		},
@@ -47,6 +49,7 @@ func TestValidateParams(t *testing.T) {
 				types.NewBioParams(sdk.NewInt(-1000)),
 				types.DefaultOracleParams(),
 				types.DefaultAppLinksParams(),
+				types.DefaultReservedDTagsParams(),
 			),
 			shouldErr: true,
 		},
@@ -64,7 +67,9 @@ func TestValidateParams(t *testing.T) {
 					0,
 					sdk.NewCoins()...,
 				),
-				types.DefaultAppLinksParams()),
+				types.DefaultAppLinksParams(),
+				types.DefaultReservedDTagsParams(),
+			),
 			shouldErr: true,
 		},
 		{
@@ -75,6 +80,21 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultBioParams(),
 				types.DefaultOracleParams(),
 				types.NewAppLinksParams(time.Duration(0)),
+				types.DefaultReservedDTagsParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid reserved dTags params return error",
+			params: types.NewParams(
+				types.DefaultNicknameParams(),
+				types.DefaultDTagParams(),
+				types.DefaultBioParams(),
+				types.DefaultOracleParams(),
+				types.DefaultAppLinksParams(),
+				types.NewReservedDTagsParams([]types.ReservedDTag{
+					types.NewReservedDTag("", false, ""),
+				}, nil),
 			),
 			shouldErr: true,
 		},
@@ -86,6 +106,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultBioParams(),
 				types.DefaultOracleParams(),
 				types.DefaultAppLinksParams(),
+				types.DefaultReservedDTagsParams(),
 			),
 			shouldErr: false,
 		},
@@ -361,3 +382,182 @@ func TestValidateAppLinksParams(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateReservedDTagsParams(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    types.ReservedDTagsParams
+		shouldErr bool
+	}{
+		{
+			name: "duplicated exact entry returns error",
+			params: types.NewReservedDTagsParams([]types.ReservedDTag{
+				types.NewReservedDTag("desmos", false, ""),
+				types.NewReservedDTag("Desmos", false, ""),
+			}, nil),
+			shouldErr: true,
+		},
+		{
+			name: "empty entry value returns error",
+			params: types.NewReservedDTagsParams([]types.ReservedDTag{
+				types.NewReservedDTag(" ", false, ""),
+			}, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid entry pattern returns error",
+			params: types.NewReservedDTagsParams([]types.ReservedDTag{
+				types.NewReservedDTag("^[a-z", true, ""),
+			}, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid entry designated address returns error",
+			params: types.NewReservedDTagsParams([]types.ReservedDTag{
+				types.NewReservedDTag("desmos", false, "cosmos1"),
+			}, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid fee max length returns error",
+			params: types.NewReservedDTagsParams(nil, []types.ReservedDTagFee{
+				types.NewReservedDTagFee(0, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "invalid fee amount returns error",
+			params: types.NewReservedDTagsParams(nil, []types.ReservedDTagFee{
+				types.NewReservedDTagFee(3, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)}}),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "unsorted fees return error",
+			params: types.NewReservedDTagsParams(nil, []types.ReservedDTagFee{
+				types.NewReservedDTagFee(5, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+				types.NewReservedDTagFee(3, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))),
+			}),
+			shouldErr: true,
+		},
+		{
+			name:      "default params return no error",
+			params:    types.DefaultReservedDTagsParams(),
+			shouldErr: false,
+		},
+		{
+			name: "valid params return no error",
+			params: types.NewReservedDTagsParams([]types.ReservedDTag{
+				types.NewReservedDTag("desmos", false, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+				types.NewReservedDTag("^[A-Za-z0-9_]{3}$", true, ""),
+			}, []types.ReservedDTagFee{
+				types.NewReservedDTagFee(3, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))),
+				types.NewReservedDTagFee(5, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+			}),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateReservedDTagsParams(tc.params)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReservedDTagsParams_GetMatchingEntry(t *testing.T) {
+	params := types.NewReservedDTagsParams([]types.ReservedDTag{
+		types.NewReservedDTag("desmos", false, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+		types.NewReservedDTag("^[A-Za-z0-9_]{3}$", true, ""),
+		types.NewReservedDTag("^official_.*$", true, ""),
+	}, nil)
+
+	testCases := []struct {
+		name     string
+		dTag     string
+		expFound bool
+		expEntry types.ReservedDTag
+	}{
+		{
+			name:     "not reserved dTag returns false",
+			dTag:     "desmos_user",
+			expFound: false,
+		},
+		{
+			name:     "exact entry is matched regardless of case",
+			dTag:     "DESMOS",
+			expFound: true,
+			expEntry: types.NewReservedDTag("desmos", false, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+		},
+		{
+			name:     "pattern entry is matched",
+			dTag:     "abc",
+			expFound: true,
+			expEntry: types.NewReservedDTag("^[A-Za-z0-9_]{3}$", true, ""),
+		},
+		{
+			name:     "pattern entry is matched regardless of case",
+			dTag:     "Official_Desmos",
+			expFound: true,
+			expEntry: types.NewReservedDTag("^official_.*$", true, ""),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			entry, found := params.GetMatchingEntry(tc.dTag)
+			require.Equal(t, tc.expFound, found)
+			if tc.expFound {
+				require.Equal(t, tc.expEntry, entry)
+			}
+		})
+	}
+}
+
+func TestReservedDTagsParams_GetFee(t *testing.T) {
+	params := types.NewReservedDTagsParams(nil, []types.ReservedDTagFee{
+		types.NewReservedDTagFee(3, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))),
+		types.NewReservedDTagFee(5, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+	})
+
+	testCases := []struct {
+		name     string
+		length   int
+		expFound bool
+		expFee   sdk.Coins
+	}{
+		{
+			name:     "shortest tier is returned",
+			length:   3,
+			expFound: true,
+			expFee:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+		},
+		{
+			name:     "longer tier is returned",
+			length:   4,
+			expFound: true,
+			expFee:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		},
+		{
+			name:     "length exceeding all the tiers returns false",
+			length:   6,
+			expFound: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fee, found := params.GetFee(tc.length)
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expFee, fee)
+		})
+	}
+}
@@ -31,39 +31,46 @@ func init() {
 }
 
 var fileDescriptor_869194438e2ecf0d = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x1b, 0x21, 0x18, 0xae, 0x20, 0xe0, 0x18, 0x10, 0x37, 0x78, 0xa0, 0x85, 0xd2, 0x16,
-	0xec, 0xa6, 0x19, 0x60, 0x0d, 0x0d, 0x13, 0x54, 0xaa, 0xd2, 0x76, 0x41, 0x42, 0xe1, 0x72, 0x79,
-	0xe3, 0x5a, 0xbd, 0xdc, 0x1d, 0x77, 0x67, 0xab, 0x6c, 0x6c, 0x8c, 0xf0, 0x51, 0xf8, 0x18, 0x8c,
-	0x1d, 0x19, 0x51, 0x32, 0xf0, 0x35, 0x50, 0xe2, 0xc4, 0xd8, 0x6d, 0xdf, 0xe2, 0x4c, 0x89, 0xec,
-	0xdf, 0xf3, 0x47, 0x8f, 0xe4, 0x97, 0xac, 0x0d, 0xc0, 0x8d, 0xb4, 0x8b, 0x8c, 0xd5, 0xc3, 0x44,
-	0x82, 0x8b, 0xb2, 0x56, 0x34, 0x72, 0x71, 0xcf, 0x81, 0xcd, 0xc0, 0x86, 0xc6, 0x6a, 0xaf, 0x29,
-	0xcd, 0xa1, 0x70, 0x01, 0x85, 0x59, 0x8b, 0x3d, 0x14, 0x7a, 0x26, 0x1c, 0xb9, 0x38, 0xca, 0x9a,
-	0xd3, 0x9f, 0x1c, 0x66, 0x4f, 0xae, 0x76, 0x74, 0xbd, 0xf9, 0x83, 0x39, 0xb6, 0x8d, 0x61, 0x03,
-	0xcf, 0xe3, 0x9e, 0x85, 0x4f, 0x29, 0x38, 0xef, 0xe6, 0xf0, 0x26, 0x06, 0x8b, 0x13, 0x9e, 0xa8,
-	0x9e, 0x4c, 0xd4, 0xe9, 0x02, 0xdd, 0xc0, 0x50, 0x6e, 0x4c, 0x05, 0x5c, 0x47, 0x7b, 0x72, 0xcb,
-	0x47, 0x73, 0x6a, 0xf7, 0xdb, 0x2a, 0xb9, 0xb1, 0xef, 0x62, 0xfa, 0x81, 0xac, 0x1e, 0xf2, 0x0c,
-	0x0e, 0x72, 0x98, 0x3e, 0x0e, 0x2f, 0x4f, 0x12, 0xee, 0xbb, 0xb8, 0xc4, 0xb0, 0xad, 0xff, 0x33,
-	0x5d, 0x70, 0x46, 0x2b, 0x07, 0x54, 0x90, 0x3b, 0x1d, 0x90, 0xe0, 0x8b, 0x80, 0x75, 0x44, 0x5c,
-	0xa1, 0xd8, 0xf3, 0x3a, 0x54, 0x11, 0x92, 0x92, 0x07, 0xdd, 0x7c, 0xd7, 0xce, 0x11, 0x8f, 0x8f,
-	0x2c, 0x57, 0x6e, 0x08, 0x96, 0x62, 0x3d, 0xaf, 0x60, 0xd9, 0x6e, 0x7d, 0xb6, 0x88, 0xfd, 0xda,
-	0x20, 0x8f, 0xf6, 0xb8, 0x12, 0x20, 0xab, 0xaf, 0x67, 0x0a, 0xba, 0x83, 0x38, 0xa2, 0x0a, 0xf6,
-	0x6a, 0x59, 0x45, 0xa5, 0x49, 0x5b, 0x08, 0x30, 0x7e, 0x99, 0x26, 0xa8, 0x02, 0x6d, 0x82, 0x2a,
-	0x2a, 0x4d, 0xba, 0x30, 0x4c, 0x1d, 0x2c, 0xd3, 0x04, 0x55, 0xa0, 0x4d, 0x50, 0x45, 0xd1, 0x44,
-	0x92, 0x7b, 0xef, 0x12, 0x75, 0xba, 0x37, 0xfd, 0x90, 0xda, 0x42, 0xe8, 0x54, 0x79, 0xba, 0x81,
-	0xb8, 0x5d, 0x04, 0x59, 0x54, 0x13, 0x2c, 0xd2, 0x2c, 0xa1, 0xc7, 0x4a, 0x5e, 0xcc, 0xdb, 0x44,
-	0x6c, 0x2e, 0xa3, 0xac, 0x59, 0x1b, 0xad, 0x6c, 0x7d, 0x08, 0xbe, 0x03, 0x43, 0x9e, 0x4a, 0xff,
-	0xe6, 0xcc, 0x83, 0x55, 0x5c, 0xb6, 0x07, 0x03, 0x0b, 0xce, 0xa1, 0x5b, 0xa3, 0x0a, 0x74, 0x6b,
-	0x54, 0x51, 0x34, 0x49, 0xc8, 0xdd, 0xe9, 0x32, 0x6d, 0x63, 0x64, 0x22, 0xb8, 0x4f, 0xb4, 0xa2,
-	0x4f, 0xaf, 0x59, 0xb0, 0xc4, 0xb1, 0xb0, 0x1e, 0x57, 0x44, 0x69, 0x72, 0x3f, 0x9f, 0xa4, 0x1c,
-	0xf6, 0xec, 0xda, 0xf1, 0xca, 0x71, 0x3b, 0x75, 0xc9, 0x22, 0xf0, 0x23, 0xb9, 0x7d, 0x6c, 0x06,
-	0xdc, 0xc3, 0xc1, 0xec, 0x7c, 0xd2, 0x35, 0xcc, 0xa1, 0x04, 0xb1, 0xed, 0x1a, 0xd0, 0x22, 0x81,
-	0xdd, 0xfc, 0xf2, 0xe7, 0xc7, 0x56, 0xe3, 0xf5, 0xdb, 0x9f, 0xe3, 0xa0, 0x71, 0x3e, 0x0e, 0x1a,
-	0xbf, 0xc7, 0x41, 0xe3, 0xfb, 0x24, 0x58, 0x39, 0x9f, 0x04, 0x2b, 0xbf, 0x26, 0xc1, 0xca, 0xfb,
-	0x66, 0x9c, 0xf8, 0x93, 0xb4, 0x1f, 0x0a, 0x3d, 0x8a, 0x72, 0xdf, 0x17, 0x92, 0xf7, 0xdd, 0xfc,
-	0x7f, 0x94, 0xbd, 0x8c, 0xce, 0xfe, 0x5d, 0x7b, 0xff, 0xd9, 0x80, 0xeb, 0xdf, 0x9a, 0x5d, 0xf9,
-	0xd6, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x84, 0x6d, 0x4e, 0x07, 0x07, 0x00, 0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x1b, 0x21, 0x5e, 0x74, 0x80, 0x0a, 0xc7, 0x80, 0xb8, 0xc1, 0x48, 0xb4, 0xf4, 0x2d,
+	0xc1, 0x4e, 0x9a, 0x81, 0xac, 0x49, 0xc3, 0x44, 0x2b, 0x85, 0x34, 0x5d, 0x90, 0x50, 0xb8, 0xd8,
+	0x8f, 0x5d, 0xab, 0x8e, 0x6d, 0xee, 0xce, 0x56, 0xbb, 0xb1, 0xb1, 0xf2, 0x51, 0xf8, 0x18, 0x8c,
+	0x5d, 0x90, 0x18, 0x51, 0x32, 0xf0, 0x35, 0x90, 0x5f, 0xe2, 0xd8, 0xb4, 0x97, 0xd8, 0x53, 0xa2,
+	0xdc, 0xef, 0xff, 0xfc, 0x9f, 0x17, 0x3f, 0xf1, 0xa1, 0x2d, 0x03, 0xf8, 0xd4, 0xe3, 0x9a, 0xcf,
+	0x3c, 0xd3, 0x76, 0x80, 0x6b, 0x61, 0x5b, 0x9b, 0x72, 0x6b, 0xcc, 0x81, 0x85, 0xc0, 0x54, 0x9f,
+	0x79, 0xc2, 0xc3, 0x38, 0x81, 0xd4, 0x05, 0xa4, 0x86, 0x6d, 0xf2, 0x5c, 0xf7, 0x62, 0xe1, 0x94,
+	0x5b, 0x5a, 0xd8, 0x8a, 0x3e, 0x12, 0x98, 0xbc, 0xbe, 0x3d, 0x22, 0x1f, 0xa7, 0x3f, 0xa4, 0x58,
+	0x5d, 0x86, 0x19, 0x82, 0x5a, 0x63, 0x06, 0x5f, 0x02, 0xe0, 0x82, 0xa7, 0xf0, 0xfe, 0x4a, 0xd8,
+	0x33, 0x4d, 0x60, 0x6b, 0x51, 0xfd, 0x9c, 0xda, 0xee, 0xd8, 0xb1, 0xdd, 0x8b, 0x05, 0xba, 0x2b,
+	0x43, 0xa9, 0xef, 0x17, 0xc0, 0x6d, 0x69, 0x49, 0x94, 0xd1, 0x69, 0x4a, 0x1d, 0xfe, 0xda, 0x44,
+	0x77, 0x4e, 0xb8, 0x85, 0x3f, 0xa1, 0x87, 0xa7, 0x34, 0x84, 0x41, 0x02, 0xe3, 0x57, 0xea, 0xcd,
+	0xee, 0xa9, 0x27, 0xdc, 0xca, 0x31, 0xe4, 0x60, 0x3d, 0x33, 0x04, 0xee, 0x7b, 0x2e, 0x07, 0xac,
+	0xa3, 0xc7, 0x7d, 0x70, 0x40, 0x64, 0x06, 0xdb, 0x12, 0x71, 0x81, 0x22, 0x8d, 0x32, 0x54, 0x66,
+	0x12, 0xa0, 0x67, 0xc3, 0x64, 0x04, 0xfd, 0x11, 0xb5, 0x46, 0x8c, 0xba, 0xdc, 0x04, 0x86, 0x65,
+	0x79, 0xde, 0xc2, 0x92, 0xc3, 0xf2, 0x6c, 0x66, 0xfb, 0xad, 0x86, 0x5e, 0x1c, 0x51, 0x57, 0x07,
+	0xa7, 0x78, 0x1c, 0x2b, 0x70, 0x53, 0x12, 0x51, 0xaa, 0x20, 0x9d, 0xaa, 0x8a, 0x42, 0x26, 0x5d,
+	0x5d, 0x07, 0x5f, 0x54, 0xc9, 0x44, 0xaa, 0x20, 0x9d, 0xaa, 0x8a, 0x42, 0x26, 0x43, 0x30, 0x03,
+	0x0e, 0x55, 0x32, 0x91, 0x2a, 0x48, 0xa7, 0xaa, 0x22, 0xcb, 0x64, 0x84, 0x1e, 0x1c, 0xdb, 0xc9,
+	0xe4, 0xf0, 0x4b, 0x49, 0x94, 0x05, 0x40, 0x76, 0xd7, 0x00, 0x59, 0x54, 0x0f, 0x3d, 0x5d, 0x8e,
+	0x23, 0x3a, 0xb5, 0x5d, 0x0b, 0xef, 0xad, 0x1d, 0x5c, 0x4a, 0x92, 0x66, 0x59, 0x32, 0x33, 0xfc,
+	0x80, 0xee, 0xf7, 0x82, 0xab, 0xb8, 0x0a, 0x45, 0x22, 0x4e, 0xcf, 0xc9, 0xce, 0xea, 0xf3, 0x2c,
+	0xe4, 0x67, 0xf4, 0x68, 0xe0, 0x50, 0x3d, 0xee, 0x5e, 0xcf, 0x36, 0xf0, 0x96, 0x44, 0x97, 0x87,
+	0x48, 0xbd, 0x04, 0x94, 0xdf, 0xfa, 0x65, 0x45, 0x91, 0xc5, 0xf6, 0xda, 0xba, 0x23, 0x8f, 0x46,
+	0x19, 0x2a, 0x6f, 0xb2, 0x7c, 0x1e, 0x57, 0x99, 0x14, 0x28, 0xd2, 0x28, 0x43, 0x65, 0x26, 0x0e,
+	0x7a, 0x72, 0x6c, 0xbb, 0x17, 0x47, 0xd1, 0xdf, 0x71, 0x57, 0xd7, 0xbd, 0xc0, 0x15, 0x58, 0xfe,
+	0xb0, 0x14, 0x41, 0xa2, 0x95, 0x04, 0x33, 0x37, 0x86, 0xf0, 0x99, 0xeb, 0xfc, 0xef, 0xb7, 0x2f,
+	0x09, 0x73, 0x13, 0x25, 0xad, 0xd2, 0x68, 0x61, 0x63, 0x4f, 0x41, 0xf4, 0xc1, 0xa4, 0x81, 0x23,
+	0xde, 0x5d, 0x0a, 0x60, 0x2e, 0x75, 0xba, 0x86, 0xc1, 0x80, 0x73, 0xe9, 0xc6, 0x4a, 0x15, 0xa4,
+	0x53, 0x55, 0x91, 0x65, 0x62, 0xa3, 0xcd, 0xa8, 0x33, 0x5d, 0xdf, 0x77, 0x6c, 0x9d, 0x0a, 0xdb,
+	0x73, 0xf1, 0xce, 0x8a, 0x0e, 0xe6, 0x38, 0xa2, 0x96, 0xe3, 0xf2, 0x6b, 0x9c, 0xb4, 0x24, 0x6f,
+	0xb6, 0xb7, 0xb2, 0x79, 0x79, 0xbb, 0x66, 0x59, 0x32, 0xbf, 0x73, 0x67, 0xbe, 0x41, 0x05, 0x0c,
+	0xe2, 0x97, 0xb0, 0x74, 0xe7, 0xf2, 0x10, 0xa9, 0x97, 0x80, 0x16, 0x0e, 0xe4, 0xee, 0xd7, 0xbf,
+	0x3f, 0x0e, 0x6a, 0xbd, 0xf7, 0x3f, 0x67, 0x4a, 0xed, 0x7a, 0xa6, 0xd4, 0xfe, 0xcc, 0x94, 0xda,
+	0xf7, 0xb9, 0xb2, 0x71, 0x3d, 0x57, 0x36, 0x7e, 0xcf, 0x95, 0x8d, 0x8f, 0x2d, 0xcb, 0x16, 0xe7,
+	0xc1, 0x44, 0xd5, 0xbd, 0xa9, 0x96, 0xc4, 0x7d, 0xe3, 0xd0, 0x09, 0x4f, 0xbf, 0x6b, 0xe1, 0x5b,
+	0xed, 0x72, 0x79, 0x67, 0x10, 0x57, 0x3e, 0xf0, 0xc9, 0xbd, 0xf8, 0xae, 0xd0, 0xfe, 0x37, 0x00,
+	0x98, 0x8d, 0x3b, 0x36, 0x78, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -94,6 +101,19 @@ type MsgClient interface {
 	// RefuseDTagTransferRequest defines the method to refuse an incoming DTag
 	// transfer request
 	RefuseDTagTransferRequest(ctx context.Context, in *MsgRefuseDTagTransferRequest, opts ...grpc.CallOption) (*MsgRefuseDTagTransferRequestResponse, error)
+	// ListDTag defines the method to put your DTag on sale
+	ListDTag(ctx context.Context, in *MsgListDTag, opts ...grpc.CallOption) (*MsgListDTagResponse, error)
+	// CancelDTagListing defines the method to remove your DTag from sale
+	CancelDTagListing(ctx context.Context, in *MsgCancelDTagListing, opts ...grpc.CallOption) (*MsgCancelDTagListingResponse, error)
+	// BuyDTag defines the method to buy a DTag that has been put on sale
+	BuyDTag(ctx context.Context, in *MsgBuyDTag, opts ...grpc.CallOption) (*MsgBuyDTagResponse, error)
+	// PlaceDTagBid defines the method to offer an escrowed amount in exchange of
+	// another user's DTag
+	PlaceDTagBid(ctx context.Context, in *MsgPlaceDTagBid, opts ...grpc.CallOption) (*MsgPlaceDTagBidResponse, error)
+	// CancelDTagBid defines the method to cancel an outgoing DTag bid
+	CancelDTagBid(ctx context.Context, in *MsgCancelDTagBid, opts ...grpc.CallOption) (*MsgCancelDTagBidResponse, error)
+	// AcceptDTagBid defines the method to accept an incoming DTag bid
+	AcceptDTagBid(ctx context.Context, in *MsgAcceptDTagBid, opts ...grpc.CallOption) (*MsgAcceptDTagBidResponse, error)
 	// LinkChainAccount defines a method to link an external chain account to a
 	// profile
 	LinkChainAccount(ctx context.Context, in *MsgLinkChainAccount, opts ...grpc.CallOption) (*MsgLinkChainAccountResponse, error)
@@ -178,6 +198,60 @@ func (c *msgClient) RefuseDTagTransferRequest(ctx context.Context, in *MsgRefuse
 	return out, nil
 }
 
+func (c *msgClient) ListDTag(ctx context.Context, in *MsgListDTag, opts ...grpc.CallOption) (*MsgListDTagResponse, error) {
+	out := new(MsgListDTagResponse)
+	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Msg/ListDTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDTagListing(ctx context.Context, in *MsgCancelDTagListing, opts ...grpc.CallOption) (*MsgCancelDTagListingResponse, error) {
+	out := new(MsgCancelDTagListingResponse)
+	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Msg/CancelDTagListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BuyDTag(ctx context.Context, in *MsgBuyDTag, opts ...grpc.CallOption) (*MsgBuyDTagResponse, error) {
+	out := new(MsgBuyDTagResponse)
+	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Msg/BuyDTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceDTagBid(ctx context.Context, in *MsgPlaceDTagBid, opts ...grpc.CallOption) (*MsgPlaceDTagBidResponse, error) {
+	out := new(MsgPlaceDTagBidResponse)
+	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Msg/PlaceDTagBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDTagBid(ctx context.Context, in *MsgCancelDTagBid, opts ...grpc.CallOption) (*MsgCancelDTagBidResponse, error) {
+	out := new(MsgCancelDTagBidResponse)
+	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Msg/CancelDTagBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptDTagBid(ctx context.Context, in *MsgAcceptDTagBid, opts ...grpc.CallOption) (*MsgAcceptDTagBidResponse, error) {
+	out := new(MsgAcceptDTagBidResponse)
+	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Msg/AcceptDTagBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LinkChainAccount(ctx context.Context, in *MsgLinkChainAccount, opts ...grpc.CallOption) (*MsgLinkChainAccountResponse, error) {
 	out := new(MsgLinkChainAccountResponse)
 	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Msg/LinkChainAccount", in, out, opts...)
@@ -250,6 +324,19 @@ type MsgServer interface {
 	// RefuseDTagTransferRequest defines the method to refuse an incoming DTag
 	// transfer request
 	RefuseDTagTransferRequest(context.Context, *MsgRefuseDTagTransferRequest) (*MsgRefuseDTagTransferRequestResponse, error)
+	// ListDTag defines the method to put your DTag on sale
+	ListDTag(context.Context, *MsgListDTag) (*MsgListDTagResponse, error)
+	// CancelDTagListing defines the method to remove your DTag from sale
+	CancelDTagListing(context.Context, *MsgCancelDTagListing) (*MsgCancelDTagListingResponse, error)
+	// BuyDTag defines the method to buy a DTag that has been put on sale
+	BuyDTag(context.Context, *MsgBuyDTag) (*MsgBuyDTagResponse, error)
+	// PlaceDTagBid defines the method to offer an escrowed amount in exchange of
+	// another user's DTag
+	PlaceDTagBid(context.Context, *MsgPlaceDTagBid) (*MsgPlaceDTagBidResponse, error)
+	// CancelDTagBid defines the method to cancel an outgoing DTag bid
+	CancelDTagBid(context.Context, *MsgCancelDTagBid) (*MsgCancelDTagBidResponse, error)
+	// AcceptDTagBid defines the method to accept an incoming DTag bid
+	AcceptDTagBid(context.Context, *MsgAcceptDTagBid) (*MsgAcceptDTagBidResponse, error)
 	// LinkChainAccount defines a method to link an external chain account to a
 	// profile
 	LinkChainAccount(context.Context, *MsgLinkChainAccount) (*MsgLinkChainAccountResponse, error)
@@ -294,6 +381,24 @@ func (*UnimplementedMsgServer) AcceptDTagTransferRequest(ctx context.Context, re
 func (*UnimplementedMsgServer) RefuseDTagTransferRequest(ctx context.Context, req *MsgRefuseDTagTransferRequest) (*MsgRefuseDTagTransferRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefuseDTagTransferRequest not implemented")
 }
+func (*UnimplementedMsgServer) ListDTag(ctx context.Context, req *MsgListDTag) (*MsgListDTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDTag not implemented")
+}
+func (*UnimplementedMsgServer) CancelDTagListing(ctx context.Context, req *MsgCancelDTagListing) (*MsgCancelDTagListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDTagListing not implemented")
+}
+func (*UnimplementedMsgServer) BuyDTag(ctx context.Context, req *MsgBuyDTag) (*MsgBuyDTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyDTag not implemented")
+}
+func (*UnimplementedMsgServer) PlaceDTagBid(ctx context.Context, req *MsgPlaceDTagBid) (*MsgPlaceDTagBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceDTagBid not implemented")
+}
+func (*UnimplementedMsgServer) CancelDTagBid(ctx context.Context, req *MsgCancelDTagBid) (*MsgCancelDTagBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDTagBid not implemented")
+}
+func (*UnimplementedMsgServer) AcceptDTagBid(ctx context.Context, req *MsgAcceptDTagBid) (*MsgAcceptDTagBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDTagBid not implemented")
+}
 func (*UnimplementedMsgServer) LinkChainAccount(ctx context.Context, req *MsgLinkChainAccount) (*MsgLinkChainAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkChainAccount not implemented")
 }
@@ -425,6 +530,114 @@ func _Msg_RefuseDTagTransferRequest_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ListDTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgListDTag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ListDTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.profiles.v3.Msg/ListDTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ListDTag(ctx, req.(*MsgListDTag))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDTagListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDTagListing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDTagListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.profiles.v3.Msg/CancelDTagListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDTagListing(ctx, req.(*MsgCancelDTagListing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyDTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyDTag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyDTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.profiles.v3.Msg/BuyDTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyDTag(ctx, req.(*MsgBuyDTag))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceDTagBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceDTagBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceDTagBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.profiles.v3.Msg/PlaceDTagBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceDTagBid(ctx, req.(*MsgPlaceDTagBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDTagBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDTagBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDTagBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.profiles.v3.Msg/CancelDTagBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDTagBid(ctx, req.(*MsgCancelDTagBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptDTagBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptDTagBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptDTagBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.profiles.v3.Msg/AcceptDTagBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptDTagBid(ctx, req.(*MsgAcceptDTagBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_LinkChainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgLinkChainAccount)
 	if err := dec(in); err != nil {
@@ -561,6 +774,30 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefuseDTagTransferRequest",
 			Handler:    _Msg_RefuseDTagTransferRequest_Handler,
 		},
+		{
+			MethodName: "ListDTag",
+			Handler:    _Msg_ListDTag_Handler,
+		},
+		{
+			MethodName: "CancelDTagListing",
+			Handler:    _Msg_CancelDTagListing_Handler,
+		},
+		{
+			MethodName: "BuyDTag",
+			Handler:    _Msg_BuyDTag_Handler,
+		},
+		{
+			MethodName: "PlaceDTagBid",
+			Handler:    _Msg_PlaceDTagBid_Handler,
+		},
+		{
+			MethodName: "CancelDTagBid",
+			Handler:    _Msg_CancelDTagBid_Handler,
+		},
+		{
+			MethodName: "AcceptDTagBid",
+			Handler:    _Msg_AcceptDTagBid_Handler,
+		},
 		{
 			MethodName: "LinkChainAccount",
 			Handler:    _Msg_LinkChainAccount_Handler,
@@ -0,0 +1,313 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg = &MsgListDTag{}
+	_ sdk.Msg = &MsgCancelDTagListing{}
+	_ sdk.Msg = &MsgBuyDTag{}
+	_ sdk.Msg = &MsgPlaceDTagBid{}
+	_ sdk.Msg = &MsgCancelDTagBid{}
+	_ sdk.Msg = &MsgAcceptDTagBid{}
+
+	_ legacytx.LegacyMsg = &MsgListDTag{}
+	_ legacytx.LegacyMsg = &MsgCancelDTagListing{}
+	_ legacytx.LegacyMsg = &MsgBuyDTag{}
+	_ legacytx.LegacyMsg = &MsgPlaceDTagBid{}
+	_ legacytx.LegacyMsg = &MsgCancelDTagBid{}
+	_ legacytx.LegacyMsg = &MsgAcceptDTagBid{}
+)
+
+// NewMsgListDTag is a constructor for MsgListDTag
+func NewMsgListDTag(price sdk.Coin, newDTag string, expirationTime time.Time, owner string) *MsgListDTag {
+	return &MsgListDTag{
+		Price:          price,
+		NewDTag:        newDTag,
+		ExpirationTime: expirationTime,
+		Owner:          owner,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgListDTag) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgListDTag) Type() string { return ActionListDTag }
+
+func (msg *MsgListDTag) ValidateBasic() error {
+	err := validateDTagPrice(msg.Price)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if strings.TrimSpace(msg.NewDTag) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "new DTag can't be empty")
+	}
+
+	if msg.ExpirationTime.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "expiration time can't be empty")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", msg.Owner)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgListDTag) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgListDTag) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ___________________________________________________________________________________________________________________
+
+// NewMsgCancelDTagListing is a constructor for MsgCancelDTagListing
+func NewMsgCancelDTagListing(owner string) *MsgCancelDTagListing {
+	return &MsgCancelDTagListing{
+		Owner: owner,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgCancelDTagListing) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgCancelDTagListing) Type() string { return ActionCancelDTagListing }
+
+func (msg *MsgCancelDTagListing) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", msg.Owner)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelDTagListing) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgCancelDTagListing) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ___________________________________________________________________________________________________________________
+
+// NewMsgBuyDTag is a constructor for MsgBuyDTag
+func NewMsgBuyDTag(owner string, price sdk.Coin, buyer string) *MsgBuyDTag {
+	return &MsgBuyDTag{
+		Owner: owner,
+		Price: price,
+		Buyer: buyer,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgBuyDTag) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgBuyDTag) Type() string { return ActionBuyDTag }
+
+func (msg *MsgBuyDTag) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", msg.Owner)
+	}
+
+	err = validateDTagPrice(msg.Price)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address: %s", msg.Buyer)
+	}
+
+	if msg.Owner == msg.Buyer {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "the owner and buyer must be different")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgBuyDTag) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgBuyDTag) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Buyer)
+	return []sdk.AccAddress{addr}
+}
+
+// ___________________________________________________________________________________________________________________
+
+// NewMsgPlaceDTagBid is a constructor for MsgPlaceDTagBid
+func NewMsgPlaceDTagBid(owner string, price sdk.Coin, expirationTime time.Time, buyer string) *MsgPlaceDTagBid {
+	return &MsgPlaceDTagBid{
+		Owner:          owner,
+		Price:          price,
+		ExpirationTime: expirationTime,
+		Buyer:          buyer,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgPlaceDTagBid) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgPlaceDTagBid) Type() string { return ActionPlaceDTagBid }
+
+func (msg *MsgPlaceDTagBid) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", msg.Owner)
+	}
+
+	err = validateDTagPrice(msg.Price)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.ExpirationTime.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "expiration time can't be empty")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address: %s", msg.Buyer)
+	}
+
+	if msg.Owner == msg.Buyer {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "the owner and buyer must be different")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgPlaceDTagBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgPlaceDTagBid) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Buyer)
+	return []sdk.AccAddress{addr}
+}
+
+// ___________________________________________________________________________________________________________________
+
+// NewMsgCancelDTagBid is a constructor for MsgCancelDTagBid
+func NewMsgCancelDTagBid(owner string, buyer string) *MsgCancelDTagBid {
+	return &MsgCancelDTagBid{
+		Owner: owner,
+		Buyer: buyer,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgCancelDTagBid) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgCancelDTagBid) Type() string { return ActionCancelDTagBid }
+
+func (msg *MsgCancelDTagBid) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", msg.Owner)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address: %s", msg.Buyer)
+	}
+
+	if msg.Owner == msg.Buyer {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "the owner and buyer must be different")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelDTagBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgCancelDTagBid) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Buyer)
+	return []sdk.AccAddress{addr}
+}
+
+// ___________________________________________________________________________________________________________________
+
+// NewMsgAcceptDTagBid is a constructor for MsgAcceptDTagBid
+func NewMsgAcceptDTagBid(newDTag string, buyer string, owner string) *MsgAcceptDTagBid {
+	return &MsgAcceptDTagBid{
+		NewDTag: newDTag,
+		Buyer:   buyer,
+		Owner:   owner,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgAcceptDTagBid) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgAcceptDTagBid) Type() string { return ActionAcceptDTagBid }
+
+func (msg *MsgAcceptDTagBid) ValidateBasic() error {
+	if strings.TrimSpace(msg.NewDTag) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "new DTag can't be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address: %s", msg.Buyer)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", msg.Owner)
+	}
+
+	if msg.Owner == msg.Buyer {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "the owner and buyer must be different")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgAcceptDTagBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgAcceptDTagBid) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,2528 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: desmos/profiles/v3/msgs_dtag_offers.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgListDTag represents the message used to put a DTag on sale
+type MsgListDTag struct {
+	// Price represents the amount that must be paid to buy the DTag
+	Price types.Coin `protobuf:"bytes,1,opt,name=price,proto3" json:"price" yaml:"price"`
+	// NewDTag represents the DTag that the owner will obtain once the DTag is
+	// sold
+	NewDTag string `protobuf:"bytes,2,opt,name=new_dtag,json=newDtag,proto3" json:"new_dtag,omitempty" yaml:"new_dtag"`
+	// ExpirationTime represents the time after which the listing will be
+	// removed
+	ExpirationTime time.Time `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time" yaml:"expiration_time"`
+	// Owner represents the address of the user that owns the DTag
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *MsgListDTag) Reset()         { *m = MsgListDTag{} }
+func (m *MsgListDTag) String() string { return proto.CompactTextString(m) }
+func (*MsgListDTag) ProtoMessage()    {}
+func (*MsgListDTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{0}
+}
+func (m *MsgListDTag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgListDTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgListDTag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgListDTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListDTag.Merge(m, src)
+}
+func (m *MsgListDTag) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgListDTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListDTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgListDTag proto.InternalMessageInfo
+
+func (m *MsgListDTag) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *MsgListDTag) GetNewDTag() string {
+	if m != nil {
+		return m.NewDTag
+	}
+	return ""
+}
+
+func (m *MsgListDTag) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgListDTag) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgListDTagResponse defines the Msg/ListDTag response type.
+type MsgListDTagResponse struct {
+}
+
+func (m *MsgListDTagResponse) Reset()         { *m = MsgListDTagResponse{} }
+func (m *MsgListDTagResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgListDTagResponse) ProtoMessage()    {}
+func (*MsgListDTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{1}
+}
+func (m *MsgListDTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgListDTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgListDTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgListDTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListDTagResponse.Merge(m, src)
+}
+func (m *MsgListDTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgListDTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListDTagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgListDTagResponse proto.InternalMessageInfo
+
+// MsgCancelDTagListing represents the message used to remove a DTag from sale
+type MsgCancelDTagListing struct {
+	// Owner represents the address of the user that owns the DTag
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *MsgCancelDTagListing) Reset()         { *m = MsgCancelDTagListing{} }
+func (m *MsgCancelDTagListing) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDTagListing) ProtoMessage()    {}
+func (*MsgCancelDTagListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{2}
+}
+func (m *MsgCancelDTagListing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDTagListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDTagListing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDTagListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDTagListing.Merge(m, src)
+}
+func (m *MsgCancelDTagListing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDTagListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDTagListing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDTagListing proto.InternalMessageInfo
+
+func (m *MsgCancelDTagListing) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgCancelDTagListingResponse defines the Msg/CancelDTagListing response
+// type.
+type MsgCancelDTagListingResponse struct {
+}
+
+func (m *MsgCancelDTagListingResponse) Reset()         { *m = MsgCancelDTagListingResponse{} }
+func (m *MsgCancelDTagListingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDTagListingResponse) ProtoMessage()    {}
+func (*MsgCancelDTagListingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{3}
+}
+func (m *MsgCancelDTagListingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDTagListingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDTagListingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDTagListingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDTagListingResponse.Merge(m, src)
+}
+func (m *MsgCancelDTagListingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDTagListingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDTagListingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDTagListingResponse proto.InternalMessageInfo
+
+// MsgBuyDTag represents the message used to buy a DTag that has been put on
+// sale
+type MsgBuyDTag struct {
+	// Owner represents the address of the user that owns the DTag
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// Price represents the amount the buyer is willing to pay. It must match the
+	// price of the listing
+	Price types.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price" yaml:"price"`
+	// Buyer represents the address of the user buying the DTag
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty" yaml:"buyer"`
+}
+
+func (m *MsgBuyDTag) Reset()         { *m = MsgBuyDTag{} }
+func (m *MsgBuyDTag) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyDTag) ProtoMessage()    {}
+func (*MsgBuyDTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{4}
+}
+func (m *MsgBuyDTag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyDTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyDTag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyDTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyDTag.Merge(m, src)
+}
+func (m *MsgBuyDTag) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyDTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyDTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyDTag proto.InternalMessageInfo
+
+func (m *MsgBuyDTag) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgBuyDTag) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *MsgBuyDTag) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+// MsgBuyDTagResponse defines the Msg/BuyDTag response type.
+type MsgBuyDTagResponse struct {
+}
+
+func (m *MsgBuyDTagResponse) Reset()         { *m = MsgBuyDTagResponse{} }
+func (m *MsgBuyDTagResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyDTagResponse) ProtoMessage()    {}
+func (*MsgBuyDTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{5}
+}
+func (m *MsgBuyDTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyDTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyDTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyDTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyDTagResponse.Merge(m, src)
+}
+func (m *MsgBuyDTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyDTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyDTagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyDTagResponse proto.InternalMessageInfo
+
+// MsgPlaceDTagBid represents the message used to offer an escrowed amount in
+// exchange of the DTag of another user
+type MsgPlaceDTagBid struct {
+	// Owner represents the address of the user that owns the DTag
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// Price represents the amount that will be escrowed and paid to the owner if
+	// the bid is accepted
+	Price types.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price" yaml:"price"`
+	// ExpirationTime represents the time after which the bid will be removed and
+	// the escrowed amount refunded
+	ExpirationTime time.Time `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time" yaml:"expiration_time"`
+	// Buyer represents the address of the user placing the bid
+	Buyer string `protobuf:"bytes,4,opt,name=buyer,proto3" json:"buyer,omitempty" yaml:"buyer"`
+}
+
+func (m *MsgPlaceDTagBid) Reset()         { *m = MsgPlaceDTagBid{} }
+func (m *MsgPlaceDTagBid) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceDTagBid) ProtoMessage()    {}
+func (*MsgPlaceDTagBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{6}
+}
+func (m *MsgPlaceDTagBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceDTagBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceDTagBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceDTagBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceDTagBid.Merge(m, src)
+}
+func (m *MsgPlaceDTagBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceDTagBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceDTagBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceDTagBid proto.InternalMessageInfo
+
+func (m *MsgPlaceDTagBid) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgPlaceDTagBid) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *MsgPlaceDTagBid) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgPlaceDTagBid) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+// MsgPlaceDTagBidResponse defines the Msg/PlaceDTagBid response type.
+type MsgPlaceDTagBidResponse struct {
+}
+
+func (m *MsgPlaceDTagBidResponse) Reset()         { *m = MsgPlaceDTagBidResponse{} }
+func (m *MsgPlaceDTagBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceDTagBidResponse) ProtoMessage()    {}
+func (*MsgPlaceDTagBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{7}
+}
+func (m *MsgPlaceDTagBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceDTagBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceDTagBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceDTagBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceDTagBidResponse.Merge(m, src)
+}
+func (m *MsgPlaceDTagBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceDTagBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceDTagBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceDTagBidResponse proto.InternalMessageInfo
+
+// MsgCancelDTagBid represents the message used to cancel a DTag bid and get
+// the escrowed amount back
+type MsgCancelDTagBid struct {
+	// Owner represents the address of the user that owns the DTag
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// Buyer represents the address of the user that placed the bid
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty" yaml:"buyer"`
+}
+
+func (m *MsgCancelDTagBid) Reset()         { *m = MsgCancelDTagBid{} }
+func (m *MsgCancelDTagBid) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDTagBid) ProtoMessage()    {}
+func (*MsgCancelDTagBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{8}
+}
+func (m *MsgCancelDTagBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDTagBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDTagBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDTagBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDTagBid.Merge(m, src)
+}
+func (m *MsgCancelDTagBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDTagBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDTagBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDTagBid proto.InternalMessageInfo
+
+func (m *MsgCancelDTagBid) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCancelDTagBid) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+// MsgCancelDTagBidResponse defines the Msg/CancelDTagBid response type.
+type MsgCancelDTagBidResponse struct {
+}
+
+func (m *MsgCancelDTagBidResponse) Reset()         { *m = MsgCancelDTagBidResponse{} }
+func (m *MsgCancelDTagBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDTagBidResponse) ProtoMessage()    {}
+func (*MsgCancelDTagBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{9}
+}
+func (m *MsgCancelDTagBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDTagBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDTagBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDTagBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDTagBidResponse.Merge(m, src)
+}
+func (m *MsgCancelDTagBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDTagBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDTagBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDTagBidResponse proto.InternalMessageInfo
+
+// MsgAcceptDTagBid represents the message used to accept a DTag bid
+type MsgAcceptDTagBid struct {
+	// NewDTag represents the DTag that the owner will obtain once the bid is
+	// accepted
+	NewDTag string `protobuf:"bytes,1,opt,name=new_dtag,json=newDtag,proto3" json:"new_dtag,omitempty" yaml:"new_dtag"`
+	// Buyer represents the address of the user that placed the bid
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty" yaml:"buyer"`
+	// Owner represents the address of the user that owns the DTag
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *MsgAcceptDTagBid) Reset()         { *m = MsgAcceptDTagBid{} }
+func (m *MsgAcceptDTagBid) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDTagBid) ProtoMessage()    {}
+func (*MsgAcceptDTagBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{10}
+}
+func (m *MsgAcceptDTagBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDTagBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDTagBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptDTagBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDTagBid.Merge(m, src)
+}
+func (m *MsgAcceptDTagBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDTagBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDTagBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptDTagBid proto.InternalMessageInfo
+
+func (m *MsgAcceptDTagBid) GetNewDTag() string {
+	if m != nil {
+		return m.NewDTag
+	}
+	return ""
+}
+
+func (m *MsgAcceptDTagBid) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *MsgAcceptDTagBid) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgAcceptDTagBidResponse defines the Msg/AcceptDTagBid response type.
+type MsgAcceptDTagBidResponse struct {
+}
+
+func (m *MsgAcceptDTagBidResponse) Reset()         { *m = MsgAcceptDTagBidResponse{} }
+func (m *MsgAcceptDTagBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDTagBidResponse) ProtoMessage()    {}
+func (*MsgAcceptDTagBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99a082600a423e7, []int{11}
+}
+func (m *MsgAcceptDTagBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDTagBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDTagBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptDTagBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDTagBidResponse.Merge(m, src)
+}
+func (m *MsgAcceptDTagBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDTagBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDTagBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptDTagBidResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgListDTag)(nil), "desmos.profiles.v3.MsgListDTag")
+	proto.RegisterType((*MsgListDTagResponse)(nil), "desmos.profiles.v3.MsgListDTagResponse")
+	proto.RegisterType((*MsgCancelDTagListing)(nil), "desmos.profiles.v3.MsgCancelDTagListing")
+	proto.RegisterType((*MsgCancelDTagListingResponse)(nil), "desmos.profiles.v3.MsgCancelDTagListingResponse")
+	proto.RegisterType((*MsgBuyDTag)(nil), "desmos.profiles.v3.MsgBuyDTag")
+	proto.RegisterType((*MsgBuyDTagResponse)(nil), "desmos.profiles.v3.MsgBuyDTagResponse")
+	proto.RegisterType((*MsgPlaceDTagBid)(nil), "desmos.profiles.v3.MsgPlaceDTagBid")
+	proto.RegisterType((*MsgPlaceDTagBidResponse)(nil), "desmos.profiles.v3.MsgPlaceDTagBidResponse")
+	proto.RegisterType((*MsgCancelDTagBid)(nil), "desmos.profiles.v3.MsgCancelDTagBid")
+	proto.RegisterType((*MsgCancelDTagBidResponse)(nil), "desmos.profiles.v3.MsgCancelDTagBidResponse")
+	proto.RegisterType((*MsgAcceptDTagBid)(nil), "desmos.profiles.v3.MsgAcceptDTagBid")
+	proto.RegisterType((*MsgAcceptDTagBidResponse)(nil), "desmos.profiles.v3.MsgAcceptDTagBidResponse")
+}
+
+func init() {
+	proto.RegisterFile("desmos/profiles/v3/msgs_dtag_offers.proto", fileDescriptor_b99a082600a423e7)
+}
+
+var fileDescriptor_b99a082600a423e7 = []byte{
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xce, 0xa5, 0xed, 0xaf, 0x3f, 0xae, 0x88, 0x52, 0x13, 0xda, 0x24, 0x20, 0xa7, 0x58, 0xa2,
+	0x94, 0x48, 0xf5, 0x29, 0x74, 0x40, 0x74, 0xa8, 0xd4, 0xb4, 0x13, 0x10, 0x84, 0x42, 0x27, 0x96,
+	0xc8, 0x76, 0x2e, 0xc7, 0x49, 0xb6, 0xcf, 0xf2, 0x39, 0x49, 0xb3, 0x76, 0x64, 0xea, 0xc7, 0x60,
+	0xec, 0xc0, 0xc4, 0x27, 0xe8, 0x58, 0x31, 0x31, 0x05, 0x94, 0x0a, 0x75, 0xaf, 0xc4, 0xc2, 0x84,
+	0x7c, 0x77, 0x89, 0xe3, 0x50, 0x21, 0x91, 0x22, 0xc4, 0x92, 0xdc, 0xbd, 0x7f, 0xee, 0x7d, 0x9e,
+	0xe7, 0x7d, 0xef, 0x0c, 0x1f, 0x36, 0x31, 0xf7, 0x18, 0x47, 0x41, 0xc8, 0x5a, 0xd4, 0xc5, 0x1c,
+	0x75, 0x36, 0x91, 0xc7, 0x09, 0x6f, 0x34, 0x23, 0x8b, 0x34, 0x58, 0xab, 0x85, 0x43, 0x6e, 0x06,
+	0x21, 0x8b, 0x98, 0xa6, 0xc9, 0x50, 0x73, 0x18, 0x6a, 0x76, 0x36, 0x8b, 0x4b, 0x96, 0x47, 0x7d,
+	0x86, 0xc4, 0xaf, 0x0c, 0x2b, 0xe6, 0x08, 0x23, 0x4c, 0x2c, 0x51, 0xbc, 0x52, 0xd6, 0x15, 0x87,
+	0x89, 0x3a, 0x1e, 0x27, 0xa8, 0x53, 0x89, 0xff, 0x94, 0xa3, 0x20, 0x1d, 0x0d, 0x99, 0x21, 0x37,
+	0xca, 0xa5, 0xab, 0x1c, 0xdb, 0xe2, 0x18, 0x75, 0x2a, 0x36, 0x8e, 0xac, 0x0a, 0x72, 0x18, 0xf5,
+	0x95, 0xbf, 0x44, 0x18, 0x23, 0x2e, 0x46, 0x62, 0x67, 0xb7, 0x5b, 0x28, 0xa2, 0x1e, 0xe6, 0x91,
+	0xe5, 0x05, 0x32, 0xc0, 0xf8, 0x9a, 0x85, 0x0b, 0x35, 0x4e, 0x9e, 0x53, 0x1e, 0xed, 0xed, 0x5b,
+	0x44, 0x7b, 0x0a, 0xe7, 0x82, 0x90, 0x3a, 0x38, 0x0f, 0x56, 0xc1, 0xfa, 0xc2, 0xa3, 0x82, 0xa9,
+	0xca, 0xc5, 0x05, 0x4c, 0x55, 0xc0, 0xdc, 0x65, 0xd4, 0xaf, 0x16, 0x4e, 0xfa, 0xa5, 0xcc, 0x45,
+	0xbf, 0x74, 0xbd, 0x67, 0x79, 0xee, 0x96, 0x21, 0xb2, 0x8c, 0x77, 0xe7, 0xc7, 0x65, 0x50, 0x97,
+	0x47, 0x68, 0x4f, 0xe0, 0xff, 0x3e, 0xee, 0x0a, 0x99, 0xf2, 0xd9, 0x55, 0xb0, 0x7e, 0xad, 0xaa,
+	0x0f, 0xfa, 0xa5, 0xf9, 0x17, 0xb8, 0x1b, 0x97, 0xba, 0xe8, 0x97, 0x16, 0x65, 0xea, 0x30, 0xc8,
+	0xa8, 0xcf, 0xfb, 0xb8, 0xbb, 0x17, 0x59, 0x44, 0x73, 0xe1, 0x22, 0x3e, 0x08, 0x68, 0x68, 0x45,
+	0x94, 0xf9, 0x8d, 0x18, 0x74, 0x7e, 0x46, 0x00, 0x2a, 0x9a, 0x92, 0x91, 0x39, 0x64, 0x64, 0xee,
+	0x0f, 0x19, 0x55, 0x1f, 0x28, 0x44, 0xcb, 0xf2, 0xd8, 0x89, 0x03, 0x8c, 0xa3, 0xcf, 0x25, 0x20,
+	0xf1, 0xdd, 0x48, 0x5c, 0x71, 0xb6, 0xb6, 0x0d, 0xe7, 0x58, 0xd7, 0xc7, 0x61, 0x7e, 0x56, 0xa0,
+	0x5c, 0x4f, 0x58, 0x09, 0xb3, 0xf1, 0xf1, 0xfd, 0x46, 0x4e, 0xe9, 0xb0, 0xd3, 0x6c, 0x86, 0x98,
+	0xf3, 0x57, 0x51, 0x48, 0x7d, 0x52, 0x97, 0x69, 0x5b, 0xf7, 0x0e, 0xcf, 0x8f, 0xcb, 0x72, 0xfd,
+	0xf6, 0xfc, 0xb8, 0xac, 0xa6, 0x00, 0x8d, 0xe9, 0x6a, 0xdc, 0x86, 0xb7, 0xc6, 0xb6, 0x75, 0xcc,
+	0x03, 0xe6, 0x73, 0x6c, 0x1c, 0x02, 0x98, 0xab, 0x71, 0xb2, 0x6b, 0xf9, 0x0e, 0x76, 0x63, 0x4f,
+	0x1c, 0x41, 0x7d, 0x92, 0x40, 0x02, 0xd3, 0x41, 0x2a, 0xa7, 0x21, 0xdd, 0x49, 0x20, 0xfd, 0x54,
+	0xcb, 0xd0, 0xe1, 0xdd, 0xcb, 0xec, 0x23, 0x90, 0xdf, 0x01, 0x84, 0x35, 0x4e, 0xaa, 0xed, 0x9e,
+	0x18, 0x91, 0x2b, 0x42, 0x4b, 0x46, 0x2c, 0x7b, 0xf5, 0x11, 0xdb, 0x86, 0x73, 0x76, 0xbb, 0x87,
+	0xc3, 0xfc, 0xcc, 0x24, 0x16, 0x61, 0xfe, 0x05, 0x16, 0xe1, 0xdf, 0x5a, 0x15, 0x32, 0x89, 0x75,
+	0x2c, 0xd3, 0x52, 0x22, 0x93, 0x62, 0x6b, 0xe4, 0xa0, 0x96, 0xec, 0x46, 0x92, 0x5c, 0x64, 0xe1,
+	0x62, 0x8d, 0x93, 0x97, 0xae, 0xe5, 0xe0, 0xd8, 0x51, 0xa5, 0xcd, 0x7f, 0x4a, 0x97, 0xbf, 0x7e,
+	0x7f, 0x64, 0x17, 0x66, 0xa7, 0xeb, 0xc2, 0xfd, 0x74, 0x17, 0x96, 0x93, 0x2e, 0x8c, 0x0b, 0x6c,
+	0x14, 0xe0, 0xca, 0x84, 0x69, 0xd4, 0x8f, 0x0f, 0x00, 0xde, 0x4c, 0xcd, 0xf0, 0x9f, 0x68, 0xc8,
+	0x88, 0x56, 0x76, 0x3a, 0x5a, 0x6b, 0x69, 0x5a, 0x2b, 0x97, 0xdd, 0xc1, 0x98, 0x57, 0x11, 0xe6,
+	0x27, 0x6d, 0x23, 0x62, 0xdf, 0x24, 0xb1, 0x1d, 0xc7, 0xc1, 0x41, 0x34, 0x24, 0x36, 0xfe, 0xb0,
+	0x82, 0xdf, 0x7b, 0x58, 0xaf, 0xc8, 0x29, 0xd1, 0x74, 0x66, 0xba, 0x77, 0x69, 0x2d, 0xfd, 0x2e,
+	0x8d, 0x69, 0x92, 0xa2, 0xa8, 0x34, 0x49, 0xd9, 0x86, 0x9a, 0x54, 0x9f, 0x9d, 0x0c, 0x74, 0x70,
+	0x3a, 0xd0, 0xc1, 0x97, 0x81, 0x0e, 0x8e, 0xce, 0xf4, 0xcc, 0xe9, 0x99, 0x9e, 0xf9, 0x74, 0xa6,
+	0x67, 0x5e, 0x57, 0x08, 0x8d, 0xde, 0xb4, 0x6d, 0xd3, 0x61, 0x1e, 0x92, 0x27, 0x6f, 0xb8, 0x96,
+	0xcd, 0xd5, 0x1a, 0x75, 0x1e, 0xa3, 0x83, 0xe4, 0x33, 0x1e, 0xf5, 0x02, 0xcc, 0xed, 0xff, 0xc4,
+	0x45, 0xd8, 0xfc, 0x31, 0x00, 0x81, 0xac, 0xf5, 0xa2, 0xe6, 0x07, 0x00, 0x00,
+}
+
+func (m *MsgListDTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgListDTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgListDTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.NewDTag) > 0 {
+		i -= len(m.NewDTag)
+		copy(dAtA[i:], m.NewDTag)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.NewDTag)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgListDTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgListDTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgListDTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDTagListing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDTagListing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDTagListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDTagListingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDTagListingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDTagListingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyDTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyDTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyDTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyDTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyDTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyDTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceDTagBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceDTagBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceDTagBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceDTagBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceDTagBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceDTagBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDTagBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDTagBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDTagBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDTagBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDTagBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDTagBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptDTagBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptDTagBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptDTagBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewDTag) > 0 {
+		i -= len(m.NewDTag)
+		copy(dAtA[i:], m.NewDTag)
+		i = encodeVarintMsgsDtagOffers(dAtA, i, uint64(len(m.NewDTag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptDTagBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptDTagBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptDTagBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgsDtagOffers(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgsDtagOffers(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgListDTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	l = len(m.NewDTag)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgListDTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDTagListing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDTagListingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBuyDTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBuyDTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceDTagBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPlaceDTagBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDTagBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDTagBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptDTagBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewDTag)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgsDtagOffers(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptDTagBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgsDtagOffers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgsDtagOffers(x uint64) (n int) {
+	return sovMsgsDtagOffers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgListDTag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgListDTag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgListDTag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgListDTagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgListDTagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgListDTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDTagListing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDTagListing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDTagListing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDTagListingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDTagListingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDTagListingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyDTag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyDTag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyDTag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyDTagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyDTagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyDTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceDTagBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceDTagBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceDTagBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceDTagBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceDTagBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceDTagBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDTagBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDTagBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDTagBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDTagBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDTagBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDTagBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptDTagBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptDTagBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptDTagBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptDTagBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptDTagBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptDTagBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgsDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgsDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgsDtagOffers(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgsDtagOffers
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgsDtagOffers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgsDtagOffers
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgsDtagOffers
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgsDtagOffers
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgsDtagOffers        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgsDtagOffers          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgsDtagOffers = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,519 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/v7/x/profiles/types"
+)
+
+var msgListDTag = types.NewMsgListDTag(
+	sdk.NewCoin("udsm", sdk.NewInt(100)),
+	"new_dtag",
+	time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+)
+
+func TestMsgListDTag_Route(t *testing.T) {
+	require.Equal(t, "profiles", msgListDTag.Route())
+}
+
+func TestMsgListDTag_Type(t *testing.T) {
+	require.Equal(t, "list_dtag", msgListDTag.Type())
+}
+
+func TestMsgListDTag_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgListDTag
+		shouldErr bool
+	}{
+		{
+			name: "invalid price returns error",
+			msg: types.NewMsgListDTag(
+				sdk.Coin{Denom: "udsm"},
+				"new_dtag",
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "empty new DTag returns error",
+			msg: types.NewMsgListDTag(
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"",
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "empty expiration time returns error",
+			msg: types.NewMsgListDTag(
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Time{},
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid owner returns error",
+			msg: types.NewMsgListDTag(
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"new_dtag",
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				"",
+			),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message returns no error",
+			msg:       msgListDTag,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgListDTag_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgListDTag","value":{"expiration_time":"2030-01-01T00:00:00Z","new_dtag":"new_dtag","owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","price":{"amount":"100","denom":"udsm"}}}`
+	require.Equal(t, expected, string(msgListDTag.GetSignBytes()))
+}
+
+func TestMsgListDTag_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgListDTag.Owner)
+	require.Equal(t, []sdk.AccAddress{addr}, msgListDTag.GetSigners())
+}
+
+// ___________________________________________________________________________________________________________________
+
+var msgCancelDTagListing = types.NewMsgCancelDTagListing(
+	"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+)
+
+func TestMsgCancelDTagListing_Route(t *testing.T) {
+	require.Equal(t, "profiles", msgCancelDTagListing.Route())
+}
+
+func TestMsgCancelDTagListing_Type(t *testing.T) {
+	require.Equal(t, "cancel_dtag_listing", msgCancelDTagListing.Type())
+}
+
+func TestMsgCancelDTagListing_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCancelDTagListing
+		shouldErr bool
+	}{
+		{
+			name: "invalid owner returns error",
+			msg: types.NewMsgCancelDTagListing(
+				"",
+			),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message returns no error",
+			msg:       msgCancelDTagListing,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelDTagListing_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgCancelDTagListing","value":{"owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"}}`
+	require.Equal(t, expected, string(msgCancelDTagListing.GetSignBytes()))
+}
+
+func TestMsgCancelDTagListing_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgCancelDTagListing.Owner)
+	require.Equal(t, []sdk.AccAddress{addr}, msgCancelDTagListing.GetSigners())
+}
+
+// ___________________________________________________________________________________________________________________
+
+var msgBuyDTag = types.NewMsgBuyDTag(
+	"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+	sdk.NewCoin("udsm", sdk.NewInt(100)),
+	"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+)
+
+func TestMsgBuyDTag_Route(t *testing.T) {
+	require.Equal(t, "profiles", msgBuyDTag.Route())
+}
+
+func TestMsgBuyDTag_Type(t *testing.T) {
+	require.Equal(t, "buy_dtag", msgBuyDTag.Type())
+}
+
+func TestMsgBuyDTag_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgBuyDTag
+		shouldErr bool
+	}{
+		{
+			name: "invalid owner returns error",
+			msg: types.NewMsgBuyDTag(
+				"",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid price returns error",
+			msg: types.NewMsgBuyDTag(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(0)),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid buyer returns error",
+			msg: types.NewMsgBuyDTag(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "equal owner and buyer returns error",
+			msg: types.NewMsgBuyDTag(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message returns no error",
+			msg:       msgBuyDTag,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgBuyDTag_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgBuyDTag","value":{"buyer":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","price":{"amount":"100","denom":"udsm"}}}`
+	require.Equal(t, expected, string(msgBuyDTag.GetSignBytes()))
+}
+
+func TestMsgBuyDTag_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgBuyDTag.Buyer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgBuyDTag.GetSigners())
+}
+
+// ___________________________________________________________________________________________________________________
+
+var msgPlaceDTagBid = types.NewMsgPlaceDTagBid(
+	"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+	sdk.NewCoin("udsm", sdk.NewInt(100)),
+	time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+)
+
+func TestMsgPlaceDTagBid_Route(t *testing.T) {
+	require.Equal(t, "profiles", msgPlaceDTagBid.Route())
+}
+
+func TestMsgPlaceDTagBid_Type(t *testing.T) {
+	require.Equal(t, "place_dtag_bid", msgPlaceDTagBid.Type())
+}
+
+func TestMsgPlaceDTagBid_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgPlaceDTagBid
+		shouldErr bool
+	}{
+		{
+			name: "invalid owner returns error",
+			msg: types.NewMsgPlaceDTagBid(
+				"",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid price returns error",
+			msg: types.NewMsgPlaceDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(0)),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "empty expiration time returns error",
+			msg: types.NewMsgPlaceDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Time{},
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid buyer returns error",
+			msg: types.NewMsgPlaceDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				"",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "equal owner and buyer returns error",
+			msg: types.NewMsgPlaceDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				sdk.NewCoin("udsm", sdk.NewInt(100)),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message returns no error",
+			msg:       msgPlaceDTagBid,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgPlaceDTagBid_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgPlaceDTagBid","value":{"buyer":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","expiration_time":"2030-01-01T00:00:00Z","owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","price":{"amount":"100","denom":"udsm"}}}`
+	require.Equal(t, expected, string(msgPlaceDTagBid.GetSignBytes()))
+}
+
+func TestMsgPlaceDTagBid_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgPlaceDTagBid.Buyer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgPlaceDTagBid.GetSigners())
+}
+
+// ___________________________________________________________________________________________________________________
+
+var msgCancelDTagBid = types.NewMsgCancelDTagBid(
+	"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+	"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+)
+
+func TestMsgCancelDTagBid_Route(t *testing.T) {
+	require.Equal(t, "profiles", msgCancelDTagBid.Route())
+}
+
+func TestMsgCancelDTagBid_Type(t *testing.T) {
+	require.Equal(t, "cancel_dtag_bid", msgCancelDTagBid.Type())
+}
+
+func TestMsgCancelDTagBid_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCancelDTagBid
+		shouldErr bool
+	}{
+		{
+			name: "invalid owner returns error",
+			msg: types.NewMsgCancelDTagBid(
+				"",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid buyer returns error",
+			msg: types.NewMsgCancelDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				"",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "equal owner and buyer returns error",
+			msg: types.NewMsgCancelDTagBid(
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message returns no error",
+			msg:       msgCancelDTagBid,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelDTagBid_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgCancelDTagBid","value":{"buyer":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"}}`
+	require.Equal(t, expected, string(msgCancelDTagBid.GetSignBytes()))
+}
+
+func TestMsgCancelDTagBid_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgCancelDTagBid.Buyer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgCancelDTagBid.GetSigners())
+}
+
+// ___________________________________________________________________________________________________________________
+
+var msgAcceptDTagBid = types.NewMsgAcceptDTagBid(
+	"new_dtag",
+	"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+	"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+)
+
+func TestMsgAcceptDTagBid_Route(t *testing.T) {
+	require.Equal(t, "profiles", msgAcceptDTagBid.Route())
+}
+
+func TestMsgAcceptDTagBid_Type(t *testing.T) {
+	require.Equal(t, "accept_dtag_bid", msgAcceptDTagBid.Type())
+}
+
+func TestMsgAcceptDTagBid_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgAcceptDTagBid
+		shouldErr bool
+	}{
+		{
+			name: "empty new DTag returns error",
+			msg: types.NewMsgAcceptDTagBid(
+				"",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid buyer returns error",
+			msg: types.NewMsgAcceptDTagBid(
+				"new_dtag",
+				"",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid owner returns error",
+			msg: types.NewMsgAcceptDTagBid(
+				"new_dtag",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "equal owner and buyer returns error",
+			msg: types.NewMsgAcceptDTagBid(
+				"new_dtag",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message returns no error",
+			msg:       msgAcceptDTagBid,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgAcceptDTagBid_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgAcceptDTagBid","value":{"buyer":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","new_dtag":"new_dtag","owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"}}`
+	require.Equal(t, expected, string(msgAcceptDTagBid.GetSignBytes()))
+}
+
+func TestMsgAcceptDTagBid_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgAcceptDTagBid.Owner)
+	require.Equal(t, []sdk.AccAddress{addr}, msgAcceptDTagBid.GetSigners())
+}
@@ -29,46 +29,55 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("desmos/profiles/v3/query.proto", fileDescriptor_bcbdebc2a1cf2f2b) }
 
 var fileDescriptor_bcbdebc2a1cf2f2b = []byte{
-	// 616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0x3f, 0xf8, 0x55, 0x18, 0x04, 0xe5, 0x01, 0x41, 0x43, 0xdc, 0x62, 0x35, 0x7f,
-	0x48, 0xb3, 0xbb, 0x4d, 0x83, 0xd5, 0x88, 0x1e, 0xda, 0xc6, 0x43, 0x51, 0xb0, 0x4a, 0x4f, 0x5e,
-	0xc2, 0x24, 0x99, 0x6c, 0x17, 0x37, 0x33, 0xdb, 0x9d, 0x4d, 0x6c, 0x28, 0xb9, 0x78, 0xf3, 0x52,
-	0x04, 0xef, 0x9e, 0x7c, 0x09, 0xbe, 0x07, 0xbd, 0x88, 0x05, 0x2f, 0x1e, 0x25, 0xf1, 0x85, 0x48,
-	0x66, 0x67, 0x12, 0x8d, 0x3b, 0x49, 0x9a, 0xdb, 0x92, 0xf9, 0x7e, 0x9f, 0xe7, 0xf3, 0x9d, 0xe7,
-	0x61, 0x82, 0xcc, 0x26, 0xe1, 0x6d, 0xc6, 0x9d, 0x20, 0x64, 0x2d, 0xcf, 0x27, 0xdc, 0xe9, 0x96,
-	0x9d, 0xe3, 0x0e, 0x09, 0x7b, 0x76, 0x10, 0xb2, 0x88, 0x01, 0xc4, 0xe7, 0xb6, 0x3a, 0xb7, 0xbb,
-	0xe5, 0x54, 0xda, 0x65, 0xcc, 0xf5, 0x89, 0x83, 0x03, 0xcf, 0xc1, 0x94, 0xb2, 0x08, 0x47, 0x1e,
-	0xa3, 0x3c, 0x76, 0xa4, 0xb2, 0xba, 0x8a, 0x35, 0xf9, 0x8b, 0xd4, 0x15, 0xb5, 0xba, 0x66, 0x84,
-	0xdd, 0x5a, 0x48, 0x8e, 0x3b, 0x84, 0x47, 0xaa, 0x6a, 0x46, 0x5f, 0x15, 0x87, 0xb8, 0xad, 0x64,
-	0x05, 0xad, 0xac, 0x71, 0x84, 0x3d, 0x5a, 0xf3, 0x3d, 0xfa, 0x4a, 0x69, 0xf3, 0x5a, 0x2d, 0x0e,
-	0x82, 0x3f, 0x95, 0x5b, 0x67, 0x97, 0xd1, 0xff, 0xcf, 0x47, 0x27, 0xf0, 0xd6, 0x40, 0x97, 0x0e,
-	0x62, 0x3d, 0xe4, 0xec, 0x7f, 0xef, 0xc6, 0x16, 0x32, 0xa9, 0x78, 0x11, 0x47, 0x48, 0xe5, 0xe7,
-	0x0b, 0x79, 0xc0, 0x28, 0x27, 0xeb, 0x1b, 0x6f, 0xbe, 0xff, 0x7a, 0xff, 0x5f, 0x06, 0x6e, 0x3b,
-	0x09, 0x6c, 0xe3, 0xef, 0xd3, 0x0e, 0x27, 0x61, 0x1f, 0xbe, 0x19, 0x28, 0xbd, 0x4f, 0x1b, 0xac,
-	0xed, 0x51, 0xb7, 0x7a, 0x88, 0xdd, 0xc3, 0x10, 0x53, 0xde, 0x22, 0xa1, 0x6c, 0xcb, 0xe1, 0xa1,
-	0xb6, 0xef, 0x2c, 0x9b, 0xa2, 0x7e, 0xb4, 0xa4, 0x5b, 0x46, 0xd9, 0x12, 0x51, 0x8a, 0x50, 0x48,
-	0x8a, 0x32, 0x9a, 0xb0, 0x15, 0x49, 0xab, 0xa5, 0x46, 0x0d, 0x67, 0x06, 0x42, 0x7b, 0xa3, 0x39,
-	0x3d, 0x1d, 0x5d, 0x3e, 0x14, 0xb4, 0x04, 0x13, 0x91, 0xa2, 0xdd, 0x58, 0x48, 0x2b, 0xd9, 0x72,
-	0x82, 0xed, 0x16, 0xac, 0x25, 0xb1, 0x89, 0x45, 0xb1, 0xc4, 0xf8, 0xe1, 0xa3, 0x81, 0xae, 0x8c,
-	0xfd, 0xcf, 0x5e, 0x53, 0x12, 0x72, 0x70, 0xe6, 0x77, 0x8a, 0x95, 0x0a, 0x6d, 0x73, 0x71, 0x83,
-	0xe4, 0xb3, 0x05, 0x5f, 0x1e, 0xb2, 0x73, 0xf8, 0x1c, 0x16, 0x23, 0x7d, 0x36, 0xd0, 0xf5, 0x2a,
-	0x69, 0xe1, 0x8e, 0x1f, 0x3d, 0x3e, 0x89, 0x48, 0x48, 0xb1, 0xbf, 0xd3, 0x6c, 0x86, 0x84, 0x73,
-	0xc2, 0xe1, 0xbe, 0xb6, 0xbd, 0xce, 0xa2, 0xc0, 0x2b, 0x4b, 0x38, 0x65, 0x82, 0x6d, 0x91, 0x60,
-	0x13, 0xec, 0xc4, 0xe9, 0xc7, 0x6e, 0x8b, 0x48, 0xbb, 0x85, 0xc7, 0xb0, 0x1f, 0x0c, 0x74, 0x75,
-	0x27, 0x08, 0x7c, 0xaf, 0x21, 0xde, 0x94, 0x78, 0x0f, 0xf4, 0x17, 0x38, 0x2d, 0x55, 0xe4, 0xa5,
-	0x0b, 0x38, 0x24, 0x71, 0x46, 0x10, 0xaf, 0xc1, 0xcd, 0x24, 0x62, 0x1c, 0x04, 0x72, 0x23, 0xbe,
-	0x1a, 0xe8, 0xc6, 0x54, 0x8d, 0xdd, 0xde, 0x9e, 0xef, 0x11, 0x1a, 0xed, 0x57, 0xa1, 0xb2, 0x68,
-	0xdf, 0x89, 0x47, 0x21, 0x3f, 0x58, 0xc6, 0x2a, 0xd9, 0x2b, 0x82, 0xbd, 0x0c, 0xa5, 0x99, 0xec,
-	0x4e, 0x43, 0xf8, 0xb8, 0x73, 0x1a, 0x7f, 0xd4, 0xbc, 0x66, 0x1f, 0x3e, 0x19, 0xe8, 0xda, 0x54,
-	0x03, 0xb9, 0xe7, 0x77, 0x17, 0x05, 0xfa, 0x7b, 0xdb, 0xb7, 0x2f, 0x6a, 0x93, 0x19, 0x8a, 0x22,
-	0x43, 0x16, 0xee, 0xcc, 0xce, 0x20, 0x37, 0xbe, 0x8f, 0x56, 0x0f, 0xc4, 0xbb, 0x0f, 0x59, 0xfd,
-	0xe3, 0x2a, 0x04, 0x8a, 0x2b, 0x37, 0x57, 0x27, 0x41, 0xd6, 0x05, 0x48, 0x1a, 0x52, 0x89, 0x6f,
-	0xb0, 0xd0, 0xee, 0x3e, 0xf9, 0x32, 0x30, 0x8d, 0xf3, 0x81, 0x69, 0xfc, 0x1c, 0x98, 0xc6, 0xbb,
-	0xa1, 0xb9, 0x72, 0x3e, 0x34, 0x57, 0x7e, 0x0c, 0xcd, 0x95, 0x97, 0x25, 0xd7, 0x8b, 0x8e, 0x3a,
-	0x75, 0xbb, 0xc1, 0xda, 0xd2, 0x6f, 0xf9, 0xb8, 0xce, 0x55, 0xad, 0xee, 0x3d, 0xe7, 0x64, 0x52,
-	0x30, 0xea, 0x05, 0x84, 0xd7, 0x57, 0xc5, 0x9f, 0x4c, 0xf9, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x24, 0x12, 0x6d, 0x26, 0x8b, 0x07, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xc7, 0x19, 0x13, 0xd1, 0x8c, 0x26, 0xea, 0x93, 0x98, 0x68, 0x83, 0x25, 0x80, 0xb4, 0x85,
+	0xb6, 0xbb, 0x40, 0x03, 0x8a, 0x2f, 0x07, 0x5e, 0x4c, 0x24, 0x92, 0x88, 0x86, 0x93, 0x97, 0x66,
+	0xda, 0x4e, 0x97, 0x89, 0xdb, 0x9d, 0x65, 0x67, 0x8b, 0x10, 0xd2, 0x8b, 0x07, 0x13, 0x2e, 0xc6,
+	0xc4, 0xc4, 0xc4, 0x8b, 0x27, 0x3f, 0x82, 0x27, 0xbf, 0x80, 0x5e, 0x8c, 0x24, 0x5e, 0x3c, 0x1a,
+	0xf0, 0x83, 0x98, 0x9d, 0x9d, 0xe1, 0xcd, 0xce, 0x76, 0xe1, 0xb6, 0xb0, 0xbf, 0xff, 0x3c, 0xbf,
+	0x67, 0x78, 0x66, 0x58, 0x9c, 0x6d, 0x50, 0xd1, 0xe2, 0xc2, 0xf6, 0x03, 0xde, 0x64, 0x2e, 0x15,
+	0xf6, 0x46, 0xc5, 0x5e, 0x6f, 0xd3, 0x60, 0xcb, 0xf2, 0x03, 0x1e, 0x72, 0x80, 0xf8, 0xbd, 0xa5,
+	0xdf, 0x5b, 0x1b, 0x95, 0xcc, 0x80, 0xc3, 0xb9, 0xe3, 0x52, 0x9b, 0xf8, 0xcc, 0x26, 0x9e, 0xc7,
+	0x43, 0x12, 0x32, 0xee, 0x89, 0x38, 0x91, 0xc9, 0x99, 0x56, 0xac, 0xaa, 0xdf, 0x28, 0xae, 0x64,
+	0xe4, 0x1a, 0x21, 0x71, 0xaa, 0x01, 0x5d, 0x6f, 0x53, 0x11, 0xea, 0x55, 0xc7, 0x93, 0x69, 0xde,
+	0x6c, 0xd2, 0x40, 0xb3, 0xc5, 0x64, 0x76, 0x8d, 0x89, 0x90, 0xeb, 0x06, 0x33, 0xa3, 0x66, 0x5d,
+	0x12, 0x90, 0x56, 0xef, 0xfa, 0xf5, 0x35, 0xc2, 0xbc, 0xaa, 0xcb, 0xbc, 0x97, 0x9a, 0x2d, 0x18,
+	0x59, 0xe2, 0xfb, 0x47, 0xc9, 0xa9, 0x9d, 0x6b, 0xf8, 0xfc, 0xb3, 0xe8, 0x0d, 0xec, 0x20, 0x7c,
+	0x61, 0x25, 0xe6, 0x21, 0x6f, 0xfd, 0xbf, 0xe9, 0x96, 0xc4, 0x14, 0xf1, 0x3c, 0xde, 0x9b, 0x4c,
+	0xa1, 0x37, 0x28, 0x7c, 0xee, 0x09, 0x3a, 0x5c, 0x7c, 0xfd, 0xeb, 0xef, 0xfb, 0x73, 0xa3, 0x30,
+	0x62, 0x77, 0x71, 0x3b, 0x78, 0xde, 0x6e, 0x0b, 0x1a, 0x74, 0xe0, 0x27, 0xc2, 0x03, 0x4b, 0x5e,
+	0x9d, 0xb7, 0x98, 0xe7, 0x2c, 0xae, 0x12, 0x67, 0x35, 0x20, 0x9e, 0x68, 0xd2, 0x40, 0x95, 0x15,
+	0xf0, 0xc0, 0x58, 0x37, 0x29, 0xa6, 0xad, 0x1f, 0x9e, 0x31, 0xad, 0x5a, 0x99, 0x92, 0xad, 0x94,
+	0x60, 0xbc, 0x5b, 0x2b, 0xd1, 0x1f, 0xb8, 0x1c, 0xaa, 0x68, 0x59, 0xcf, 0x10, 0x7c, 0x40, 0xf8,
+	0x72, 0xb4, 0xe8, 0x32, 0x13, 0x21, 0xf3, 0x1c, 0x01, 0x25, 0xa3, 0xc3, 0x51, 0x4c, 0x1b, 0x97,
+	0x53, 0xd2, 0xca, 0x70, 0x4c, 0x1a, 0x8e, 0xc0, 0x90, 0xd1, 0xd0, 0xd5, 0x1e, 0x6f, 0x10, 0xbe,
+	0x18, 0xad, 0x31, 0xcf, 0x1a, 0x02, 0x0a, 0x89, 0x65, 0x22, 0x44, 0x0b, 0x8d, 0xa5, 0x20, 0x95,
+	0xcc, 0xa8, 0x94, 0x19, 0x84, 0x5b, 0x46, 0x99, 0x5a, 0x54, 0xfb, 0x23, 0xc2, 0x97, 0xa2, 0xec,
+	0xe3, 0xf8, 0x70, 0x40, 0x31, 0xb1, 0x82, 0xa2, 0xb4, 0x4e, 0x29, 0x1d, 0xac, 0x8c, 0x6c, 0x69,
+	0x34, 0x06, 0x79, 0xa3, 0x91, 0x3a, 0xa1, 0xf6, 0x76, 0xf4, 0x53, 0x07, 0xbe, 0x22, 0x0c, 0x6a,
+	0xa0, 0x8f, 0x2a, 0x4e, 0xf5, 0x9a, 0xfe, 0x2e, 0xa6, 0x95, 0x53, 0x65, 0x94, 0xf0, 0x7d, 0x29,
+	0x3c, 0x0d, 0x95, 0xe4, 0xc3, 0x43, 0x1a, 0x8d, 0x80, 0x0a, 0xd1, 0x39, 0xd6, 0x03, 0xbc, 0x45,
+	0x18, 0x2f, 0x44, 0x57, 0xc4, 0x72, 0x74, 0xee, 0x61, 0xdc, 0x28, 0x70, 0x08, 0x69, 0xd9, 0x62,
+	0x2a, 0x56, 0x49, 0xe6, 0xa5, 0xe4, 0x10, 0x0c, 0x76, 0x93, 0x94, 0x77, 0x54, 0x59, 0xde, 0x3c,
+	0xf0, 0x19, 0xe1, 0x2b, 0x07, 0xf9, 0xa7, 0xaf, 0x3c, 0x1a, 0x08, 0xb0, 0x7b, 0x57, 0x8a, 0x49,
+	0xad, 0x36, 0x91, 0x3e, 0xa0, 0xfc, 0x2c, 0xe9, 0x57, 0x80, 0x5c, 0x0f, 0x3f, 0x9b, 0xc7, 0x4a,
+	0xdf, 0x10, 0xbe, 0xb1, 0x48, 0x9b, 0xa4, 0xed, 0x86, 0x8f, 0x36, 0x43, 0x1a, 0x78, 0xc4, 0x9d,
+	0x8b, 0x37, 0x98, 0x0a, 0xb8, 0x6b, 0x1e, 0x38, 0x43, 0x44, 0x8b, 0xcf, 0x9e, 0x21, 0xa9, 0x3a,
+	0x98, 0x91, 0x1d, 0x4c, 0x80, 0xd5, 0x75, 0x6e, 0xe3, 0x74, 0x99, 0xaa, 0x78, 0x99, 0x1c, 0xc8,
+	0x7e, 0x42, 0xf8, 0xea, 0x9c, 0xef, 0xbb, 0xac, 0x2e, 0xff, 0x4f, 0xc6, 0x73, 0x60, 0xde, 0xc0,
+	0x93, 0xa8, 0x36, 0x9f, 0x3c, 0x45, 0x22, 0xcd, 0xd9, 0x27, 0xbe, 0xaf, 0x26, 0xe2, 0x07, 0xc2,
+	0x37, 0x4f, 0xac, 0x31, 0xbf, 0xb5, 0xe0, 0x32, 0xea, 0x85, 0x4b, 0x8b, 0x30, 0x9b, 0xb6, 0xee,
+	0x61, 0x46, 0x2b, 0xdf, 0x3b, 0x4b, 0x54, 0xb9, 0xcf, 0x4a, 0xf7, 0x0a, 0x4c, 0x26, 0xba, 0xdb,
+	0x75, 0x99, 0x13, 0xf6, 0x76, 0xfc, 0x50, 0x65, 0x8d, 0x0e, 0x7c, 0x41, 0xf8, 0xfa, 0x89, 0x02,
+	0x6a, 0xce, 0xa7, 0xd3, 0x0a, 0x1d, 0x9f, 0xf6, 0x99, 0xd3, 0xc6, 0x54, 0x0f, 0x25, 0xd9, 0x43,
+	0x0e, 0x6e, 0x27, 0xf7, 0xa0, 0x26, 0xbe, 0x83, 0xfb, 0x57, 0xe4, 0x27, 0x07, 0xe4, 0xcc, 0xb7,
+	0x94, 0x04, 0xb4, 0x57, 0xbe, 0x27, 0xa7, 0x44, 0x86, 0xa5, 0xc8, 0x00, 0x64, 0xba, 0xde, 0x60,
+	0x92, 0x9d, 0x7f, 0xf2, 0x7d, 0x2f, 0x8b, 0x76, 0xf7, 0xb2, 0xe8, 0xcf, 0x5e, 0x16, 0xbd, 0xdb,
+	0xcf, 0xf6, 0xed, 0xee, 0x67, 0xfb, 0x7e, 0xef, 0x67, 0xfb, 0x5e, 0x4c, 0x3a, 0x2c, 0x5c, 0x6b,
+	0xd7, 0xac, 0x3a, 0x6f, 0xa9, 0x7c, 0xd9, 0x25, 0x35, 0xa1, 0xd7, 0xda, 0xb8, 0x63, 0x6f, 0x1e,
+	0x2e, 0x18, 0x6e, 0xf9, 0x54, 0xd4, 0xfa, 0xe5, 0xf7, 0x4d, 0xe5, 0xdf, 0x00, 0x37, 0x1b, 0x79,
+	0x79, 0x5f, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -90,6 +99,19 @@ type QueryClient interface {
 	// IncomingDTagTransferRequests queries all the DTag transfers requests that
 	// have been made towards the user with the given address
 	IncomingDTagTransferRequests(ctx context.Context, in *QueryIncomingDTagTransferRequestsRequest, opts ...grpc.CallOption) (*QueryIncomingDTagTransferRequestsResponse, error)
+	// DTagListings queries all the DTags that have been put on sale, optionally
+	// filtering by owner
+	DTagListings(ctx context.Context, in *QueryDTagListingsRequest, opts ...grpc.CallOption) (*QueryDTagListingsResponse, error)
+	// DTagBids queries all the DTag bids that have been made, optionally
+	// filtering by the owner of the DTag
+	DTagBids(ctx context.Context, in *QueryDTagBidsRequest, opts ...grpc.CallOption) (*QueryDTagBidsResponse, error)
+	// DTagHistory queries the users that held the given DTag in the past, along
+	// with the block height and time at which they released it
+	DTagHistory(ctx context.Context, in *QueryDTagHistoryRequest, opts ...grpc.CallOption) (*QueryDTagHistoryResponse, error)
+	// ProfileDTagHistory queries the DTags that the user with the given address
+	// held in the past, along with the block height and time at which they
+	// released them
+	ProfileDTagHistory(ctx context.Context, in *QueryProfileDTagHistoryRequest, opts ...grpc.CallOption) (*QueryProfileDTagHistoryResponse, error)
 	// ChainLinks queries the chain links associated to the given user, if
 	// provided. Otherwise it queries all the chain links stored.
 	ChainLinks(ctx context.Context, in *QueryChainLinksRequest, opts ...grpc.CallOption) (*QueryChainLinksResponse, error)
@@ -138,6 +160,42 @@ func (c *queryClient) IncomingDTagTransferRequests(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *queryClient) DTagListings(ctx context.Context, in *QueryDTagListingsRequest, opts ...grpc.CallOption) (*QueryDTagListingsResponse, error) {
+	out := new(QueryDTagListingsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Query/DTagListings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DTagBids(ctx context.Context, in *QueryDTagBidsRequest, opts ...grpc.CallOption) (*QueryDTagBidsResponse, error) {
+	out := new(QueryDTagBidsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Query/DTagBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DTagHistory(ctx context.Context, in *QueryDTagHistoryRequest, opts ...grpc.CallOption) (*QueryDTagHistoryResponse, error) {
+	out := new(QueryDTagHistoryResponse)
+	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Query/DTagHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProfileDTagHistory(ctx context.Context, in *QueryProfileDTagHistoryRequest, opts ...grpc.CallOption) (*QueryProfileDTagHistoryResponse, error) {
+	out := new(QueryProfileDTagHistoryResponse)
+	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Query/ProfileDTagHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ChainLinks(ctx context.Context, in *QueryChainLinksRequest, opts ...grpc.CallOption) (*QueryChainLinksResponse, error) {
 	out := new(QueryChainLinksResponse)
 	err := c.cc.Invoke(ctx, "/desmos.profiles.v3.Query/ChainLinks", in, out, opts...)
@@ -210,6 +268,19 @@ type QueryServer interface {
 	// IncomingDTagTransferRequests queries all the DTag transfers requests that
 	// have been made towards the user with the given address
 	IncomingDTagTransferRequests(context.Context, *QueryIncomingDTagTransferRequestsRequest) (*QueryIncomingDTagTransferRequestsResponse, error)
+	// DTagListings queries all the DTags that have been put on sale, optionally
+	// filtering by owner
+	DTagListings(context.Context, *QueryDTagListingsRequest) (*QueryDTagListingsResponse, error)
+	// DTagBids queries all the DTag bids that have been made, optionally
+	// filtering by the owner of the DTag
+	DTagBids(context.Context, *QueryDTagBidsRequest) (*QueryDTagBidsResponse, error)
+	// DTagHistory queries the users that held the given DTag in the past, along
+	// with the block height and time at which they released it
+	DTagHistory(context.Context, *QueryDTagHistoryRequest) (*QueryDTagHistoryResponse, error)
+	// ProfileDTagHistory queries the DTags that the user with the given address
+	// held in the past, along with the block height and time at which they
+	// released them
+	ProfileDTagHistory(context.Context, *QueryProfileDTagHistoryRequest) (*QueryProfileDTagHistoryResponse, error)
 	// ChainLinks queries the chain links associated to the given user, if
 	// provided. Otherwise it queries all the chain links stored.
 	ChainLinks(context.Context, *QueryChainLinksRequest) (*QueryChainLinksResponse, error)
@@ -242,6 +313,18 @@ func (*UnimplementedQueryServer) Profile(ctx context.Context, req *QueryProfileR
 func (*UnimplementedQueryServer) IncomingDTagTransferRequests(ctx context.Context, req *QueryIncomingDTagTransferRequestsRequest) (*QueryIncomingDTagTransferRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncomingDTagTransferRequests not implemented")
 }
+func (*UnimplementedQueryServer) DTagListings(ctx context.Context, req *QueryDTagListingsRequest) (*QueryDTagListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DTagListings not implemented")
+}
+func (*UnimplementedQueryServer) DTagBids(ctx context.Context, req *QueryDTagBidsRequest) (*QueryDTagBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DTagBids not implemented")
+}
+func (*UnimplementedQueryServer) DTagHistory(ctx context.Context, req *QueryDTagHistoryRequest) (*QueryDTagHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DTagHistory not implemented")
+}
+func (*UnimplementedQueryServer) ProfileDTagHistory(ctx context.Context, req *QueryProfileDTagHistoryRequest) (*QueryProfileDTagHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileDTagHistory not implemented")
+}
 func (*UnimplementedQueryServer) ChainLinks(ctx context.Context, req *QueryChainLinksRequest) (*QueryChainLinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChainLinks not implemented")
 }
@@ -304,6 +387,78 @@ func _Query_IncomingDTagTransferRequests_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DTagListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDTagListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DTagListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.profiles.v3.Query/DTagListings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DTagListings(ctx, req.(*QueryDTagListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DTagBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDTagBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DTagBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.profiles.v3.Query/DTagBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DTagBids(ctx, req.(*QueryDTagBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DTagHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDTagHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DTagHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.profiles.v3.Query/DTagHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DTagHistory(ctx, req.(*QueryDTagHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProfileDTagHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProfileDTagHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProfileDTagHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.profiles.v3.Query/ProfileDTagHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProfileDTagHistory(ctx, req.(*QueryProfileDTagHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ChainLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryChainLinksRequest)
 	if err := dec(in); err != nil {
@@ -442,6 +597,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IncomingDTagTransferRequests",
 			Handler:    _Query_IncomingDTagTransferRequests_Handler,
 		},
+		{
+			MethodName: "DTagListings",
+			Handler:    _Query_DTagListings_Handler,
+		},
+		{
+			MethodName: "DTagBids",
+			Handler:    _Query_DTagBids_Handler,
+		},
+		{
+			MethodName: "DTagHistory",
+			Handler:    _Query_DTagHistory_Handler,
+		},
+		{
+			MethodName: "ProfileDTagHistory",
+			Handler:    _Query_ProfileDTagHistory_Handler,
+		},
 		{
 			MethodName: "ChainLinks",
 			Handler:    _Query_ChainLinks_Handler,
@@ -0,0 +1,23 @@
+package types
+
+// DONTCOVER
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// NewQueryDTagHistoryRequest returns a new QueryDTagHistoryRequest instance
+func NewQueryDTagHistoryRequest(dTag string, pagination *query.PageRequest) *QueryDTagHistoryRequest {
+	return &QueryDTagHistoryRequest{
+		DTag:       dTag,
+		Pagination: pagination,
+	}
+}
+
+// NewQueryProfileDTagHistoryRequest returns a new QueryProfileDTagHistoryRequest instance
+func NewQueryProfileDTagHistoryRequest(address string, pagination *query.PageRequest) *QueryProfileDTagHistoryRequest {
+	return &QueryProfileDTagHistoryRequest{
+		Address:    address,
+		Pagination: pagination,
+	}
+}
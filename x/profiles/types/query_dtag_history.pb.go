@@ -0,0 +1,1100 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: desmos/profiles/v3/query_dtag_history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryDTagHistoryRequest is the request type for the Query/DTagHistory RPC
+// endpoint
+type QueryDTagHistoryRequest struct {
+	// DTag represents the DTag to query the past holders for
+	DTag string `protobuf:"bytes,1,opt,name=dtag,proto3" json:"dtag,omitempty"`
+	// Pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDTagHistoryRequest) Reset()         { *m = QueryDTagHistoryRequest{} }
+func (m *QueryDTagHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDTagHistoryRequest) ProtoMessage()    {}
+func (*QueryDTagHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8b0a18a7e21522d, []int{0}
+}
+func (m *QueryDTagHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDTagHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDTagHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDTagHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDTagHistoryRequest.Merge(m, src)
+}
+func (m *QueryDTagHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDTagHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDTagHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDTagHistoryRequest proto.InternalMessageInfo
+
+// QueryDTagHistoryResponse is the response type for the Query/DTagHistory
+// RPC method.
+type QueryDTagHistoryResponse struct {
+	// Entries represent the past holders of the DTag, sorted by the height at
+	// which they released it
+	Entries []DTagHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// Pagination defines the pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDTagHistoryResponse) Reset()         { *m = QueryDTagHistoryResponse{} }
+func (m *QueryDTagHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDTagHistoryResponse) ProtoMessage()    {}
+func (*QueryDTagHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8b0a18a7e21522d, []int{1}
+}
+func (m *QueryDTagHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDTagHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDTagHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDTagHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDTagHistoryResponse.Merge(m, src)
+}
+func (m *QueryDTagHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDTagHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDTagHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDTagHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryDTagHistoryResponse) GetEntries() []DTagHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryDTagHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProfileDTagHistoryRequest is the request type for the
+// Query/ProfileDTagHistory RPC endpoint
+type QueryProfileDTagHistoryRequest struct {
+	// Address represents the address of the user to query the past DTags for
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProfileDTagHistoryRequest) Reset()         { *m = QueryProfileDTagHistoryRequest{} }
+func (m *QueryProfileDTagHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProfileDTagHistoryRequest) ProtoMessage()    {}
+func (*QueryProfileDTagHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8b0a18a7e21522d, []int{2}
+}
+func (m *QueryProfileDTagHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProfileDTagHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProfileDTagHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProfileDTagHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProfileDTagHistoryRequest.Merge(m, src)
+}
+func (m *QueryProfileDTagHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProfileDTagHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProfileDTagHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProfileDTagHistoryRequest proto.InternalMessageInfo
+
+// QueryProfileDTagHistoryResponse is the response type for the
+// Query/ProfileDTagHistory RPC method.
+type QueryProfileDTagHistoryResponse struct {
+	// Entries represent the DTags that the user held in the past, sorted by the
+	// height at which they released them
+	Entries []DTagHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// Pagination defines the pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProfileDTagHistoryResponse) Reset()         { *m = QueryProfileDTagHistoryResponse{} }
+func (m *QueryProfileDTagHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProfileDTagHistoryResponse) ProtoMessage()    {}
+func (*QueryProfileDTagHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8b0a18a7e21522d, []int{3}
+}
+func (m *QueryProfileDTagHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProfileDTagHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProfileDTagHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProfileDTagHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProfileDTagHistoryResponse.Merge(m, src)
+}
+func (m *QueryProfileDTagHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProfileDTagHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProfileDTagHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProfileDTagHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryProfileDTagHistoryResponse) GetEntries() []DTagHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryProfileDTagHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryDTagHistoryRequest)(nil), "desmos.profiles.v3.QueryDTagHistoryRequest")
+	proto.RegisterType((*QueryDTagHistoryResponse)(nil), "desmos.profiles.v3.QueryDTagHistoryResponse")
+	proto.RegisterType((*QueryProfileDTagHistoryRequest)(nil), "desmos.profiles.v3.QueryProfileDTagHistoryRequest")
+	proto.RegisterType((*QueryProfileDTagHistoryResponse)(nil), "desmos.profiles.v3.QueryProfileDTagHistoryResponse")
+}
+
+func init() {
+	proto.RegisterFile("desmos/profiles/v3/query_dtag_history.proto", fileDescriptor_c8b0a18a7e21522d)
+}
+
+var fileDescriptor_c8b0a18a7e21522d = []byte{
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x33, 0xba, 0xb8, 0xbb, 0xb3, 0x27, 0xc3, 0x82, 0xb1, 0x48, 0x52, 0x8a, 0x68, 0x59,
+	0xdd, 0x19, 0xda, 0x1e, 0x04, 0x6f, 0x16, 0xff, 0xe2, 0x65, 0x8d, 0x9e, 0xbc, 0x94, 0xc9, 0x66,
+	0x9c, 0x1d, 0x68, 0x32, 0xd9, 0x99, 0x69, 0x30, 0xdf, 0x40, 0x6f, 0x7e, 0x84, 0x9e, 0xc4, 0x93,
+	0x78, 0xd0, 0xef, 0xd0, 0x63, 0xf1, 0xe4, 0xa9, 0x48, 0x7a, 0xd0, 0x8f, 0x21, 0xc9, 0x4c, 0x69,
+	0xa1, 0x11, 0x4f, 0xc2, 0x5e, 0xc2, 0x9b, 0xf7, 0x7d, 0x9e, 0xf7, 0xfd, 0xf1, 0x90, 0xc0, 0x3b,
+	0x31, 0x55, 0x89, 0x50, 0x38, 0x93, 0xe2, 0x0d, 0x1f, 0x53, 0x85, 0xf3, 0x01, 0x3e, 0x9f, 0x50,
+	0x59, 0x8c, 0x62, 0x4d, 0xd8, 0xe8, 0x8c, 0x2b, 0x2d, 0x64, 0x81, 0x32, 0x29, 0xb4, 0x70, 0x5d,
+	0x23, 0x46, 0x2b, 0x31, 0xca, 0x07, 0xad, 0xab, 0x24, 0xe1, 0xa9, 0xc0, 0xf5, 0xd3, 0xc8, 0x5a,
+	0x87, 0x4c, 0x30, 0x51, 0x97, 0xb8, 0xaa, 0x6c, 0xf7, 0xfa, 0xa9, 0xa8, 0xcc, 0x23, 0x33, 0x30,
+	0x2f, 0x76, 0x74, 0xb7, 0x01, 0x22, 0x11, 0x31, 0x1d, 0xab, 0x06, 0x8a, 0xd6, 0x91, 0xf1, 0xe2,
+	0x88, 0x28, 0x6a, 0x58, 0x71, 0xde, 0x8b, 0xa8, 0x26, 0x3d, 0x9c, 0x11, 0xc6, 0x53, 0xa2, 0xb9,
+	0x48, 0x8d, 0xb6, 0xf3, 0x1e, 0xc0, 0x6b, 0x2f, 0x2a, 0xc9, 0xc3, 0x57, 0x84, 0x3d, 0x35, 0x6b,
+	0x42, 0x7a, 0x3e, 0xa1, 0x4a, 0xbb, 0x37, 0xe0, 0x4e, 0xb5, 0xdd, 0x03, 0x6d, 0xd0, 0xdd, 0x1f,
+	0xee, 0x95, 0x8b, 0x60, 0xa7, 0x52, 0x85, 0x75, 0xd7, 0x7d, 0x0c, 0xe1, 0x7a, 0x9b, 0x77, 0xa9,
+	0x0d, 0xba, 0x07, 0xfd, 0x5b, 0xc8, 0x62, 0x57, 0xa7, 0x51, 0x7d, 0x1a, 0xd9, 0xd3, 0xe8, 0x84,
+	0x30, 0x6a, 0x37, 0x87, 0x1b, 0xce, 0xfb, 0x7b, 0xef, 0xa6, 0x81, 0xf3, 0x7b, 0x1a, 0x38, 0x9d,
+	0xcf, 0x00, 0x7a, 0xdb, 0x2c, 0x2a, 0x13, 0xa9, 0xa2, 0xee, 0x33, 0xb8, 0x4b, 0x53, 0x2d, 0x39,
+	0x55, 0x1e, 0x68, 0x5f, 0xee, 0x1e, 0xf4, 0x6f, 0xa2, 0xed, 0xb0, 0xd1, 0x86, 0xf3, 0x51, 0xaa,
+	0x65, 0x31, 0xdc, 0x9f, 0x2d, 0x02, 0xe7, 0xd3, 0xaf, 0x2f, 0x47, 0x20, 0x5c, 0xf9, 0xdd, 0x27,
+	0x0d, 0xe4, 0xb7, 0xff, 0x49, 0x6e, 0x38, 0x36, 0xd1, 0x3b, 0x1f, 0x01, 0xf4, 0x6b, 0xe0, 0x13,
+	0x83, 0xd0, 0x90, 0x61, 0x1f, 0xee, 0x92, 0x38, 0x96, 0x54, 0x29, 0x1b, 0xa3, 0xf7, 0xfd, 0xeb,
+	0xf1, 0xa1, 0xbd, 0xf5, 0xc0, 0x4c, 0x5e, 0x6a, 0xc9, 0x53, 0x16, 0xae, 0x84, 0xff, 0x21, 0xd9,
+	0x6f, 0x00, 0x06, 0x7f, 0x05, 0xbd, 0xb8, 0x01, 0x0f, 0x9f, 0xcf, 0x4a, 0x1f, 0xcc, 0x4b, 0x1f,
+	0xfc, 0x2c, 0x7d, 0xf0, 0x61, 0xe9, 0x3b, 0xf3, 0xa5, 0xef, 0xfc, 0x58, 0xfa, 0xce, 0xeb, 0x1e,
+	0xe3, 0xfa, 0x6c, 0x12, 0xa1, 0x53, 0x91, 0x60, 0x83, 0x79, 0x3c, 0x26, 0x91, 0xb2, 0x35, 0xce,
+	0xef, 0xe1, 0xb7, 0xeb, 0xbf, 0x45, 0x17, 0x19, 0x55, 0xd1, 0x95, 0xfa, 0x8b, 0x1f, 0xfc, 0x19,
+	0x00, 0x1e, 0xb0, 0xf9, 0x17, 0xd2, 0x03, 0x00, 0x00,
+}
+
+func (m *QueryDTagHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDTagHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDTagHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryDtagHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DTag) > 0 {
+		i -= len(m.DTag)
+		copy(dAtA[i:], m.DTag)
+		i = encodeVarintQueryDtagHistory(dAtA, i, uint64(len(m.DTag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDTagHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDTagHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDTagHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryDtagHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryDtagHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProfileDTagHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProfileDTagHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProfileDTagHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryDtagHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQueryDtagHistory(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProfileDTagHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProfileDTagHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProfileDTagHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryDtagHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryDtagHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryDtagHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryDtagHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDTagHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DTag)
+	if l > 0 {
+		n += 1 + l + sovQueryDtagHistory(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryDtagHistory(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDTagHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQueryDtagHistory(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryDtagHistory(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProfileDTagHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQueryDtagHistory(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryDtagHistory(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProfileDTagHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQueryDtagHistory(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryDtagHistory(uint64(l))
+	}
+	return n
+}
+
+func sovQueryDtagHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryDtagHistory(x uint64) (n int) {
+	return sovQueryDtagHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDTagHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryDtagHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDTagHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDTagHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryDtagHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDTagHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryDtagHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDTagHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDTagHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, DTagHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryDtagHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProfileDTagHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryDtagHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfileDTagHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfileDTagHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryDtagHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProfileDTagHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryDtagHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfileDTagHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfileDTagHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, DTagHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryDtagHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryDtagHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryDtagHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryDtagHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryDtagHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryDtagHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryDtagHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryDtagHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryDtagHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryDtagHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryDtagHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryDtagHistory = fmt.Errorf("proto: unexpected end of group")
+)
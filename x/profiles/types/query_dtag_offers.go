@@ -0,0 +1,23 @@
+package types
+
+// DONTCOVER
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// NewQueryDTagListingsRequest returns a new QueryDTagListingsRequest instance
+func NewQueryDTagListingsRequest(owner string, pagination *query.PageRequest) *QueryDTagListingsRequest {
+	return &QueryDTagListingsRequest{
+		Owner:      owner,
+		Pagination: pagination,
+	}
+}
+
+// NewQueryDTagBidsRequest returns a new QueryDTagBidsRequest instance
+func NewQueryDTagBidsRequest(owner string, pagination *query.PageRequest) *QueryDTagBidsRequest {
+	return &QueryDTagBidsRequest{
+		Owner:      owner,
+		Pagination: pagination,
+	}
+}
@@ -0,0 +1,1100 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: desmos/profiles/v3/query_dtag_offers.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryDTagListingsRequest is the request type for the Query/DTagListings RPC
+// endpoint
+type QueryDTagListingsRequest struct {
+	// (optional) Owner represents the address of the user to which query the
+	// listing for
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDTagListingsRequest) Reset()         { *m = QueryDTagListingsRequest{} }
+func (m *QueryDTagListingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDTagListingsRequest) ProtoMessage()    {}
+func (*QueryDTagListingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9c71e20a8c8e9d6, []int{0}
+}
+func (m *QueryDTagListingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDTagListingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDTagListingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDTagListingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDTagListingsRequest.Merge(m, src)
+}
+func (m *QueryDTagListingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDTagListingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDTagListingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDTagListingsRequest proto.InternalMessageInfo
+
+// QueryDTagListingsResponse is the response type for the Query/DTagListings
+// RPC method.
+type QueryDTagListingsResponse struct {
+	// Listings represent the list of all the DTags that are on sale
+	Listings []DTagListing `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings"`
+	// Pagination defines the pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDTagListingsResponse) Reset()         { *m = QueryDTagListingsResponse{} }
+func (m *QueryDTagListingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDTagListingsResponse) ProtoMessage()    {}
+func (*QueryDTagListingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9c71e20a8c8e9d6, []int{1}
+}
+func (m *QueryDTagListingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDTagListingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDTagListingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDTagListingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDTagListingsResponse.Merge(m, src)
+}
+func (m *QueryDTagListingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDTagListingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDTagListingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDTagListingsResponse proto.InternalMessageInfo
+
+func (m *QueryDTagListingsResponse) GetListings() []DTagListing {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+func (m *QueryDTagListingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDTagBidsRequest is the request type for the Query/DTagBids RPC
+// endpoint
+type QueryDTagBidsRequest struct {
+	// (optional) Owner represents the address of the user to which query the
+	// incoming bids for
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDTagBidsRequest) Reset()         { *m = QueryDTagBidsRequest{} }
+func (m *QueryDTagBidsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDTagBidsRequest) ProtoMessage()    {}
+func (*QueryDTagBidsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9c71e20a8c8e9d6, []int{2}
+}
+func (m *QueryDTagBidsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDTagBidsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDTagBidsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDTagBidsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDTagBidsRequest.Merge(m, src)
+}
+func (m *QueryDTagBidsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDTagBidsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDTagBidsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDTagBidsRequest proto.InternalMessageInfo
+
+// QueryDTagBidsResponse is the response type for the Query/DTagBids RPC
+// method.
+type QueryDTagBidsResponse struct {
+	// Bids represent the list of all the DTag bids made towards the user
+	Bids []DTagBid `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids"`
+	// Pagination defines the pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDTagBidsResponse) Reset()         { *m = QueryDTagBidsResponse{} }
+func (m *QueryDTagBidsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDTagBidsResponse) ProtoMessage()    {}
+func (*QueryDTagBidsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9c71e20a8c8e9d6, []int{3}
+}
+func (m *QueryDTagBidsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDTagBidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDTagBidsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDTagBidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDTagBidsResponse.Merge(m, src)
+}
+func (m *QueryDTagBidsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDTagBidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDTagBidsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDTagBidsResponse proto.InternalMessageInfo
+
+func (m *QueryDTagBidsResponse) GetBids() []DTagBid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *QueryDTagBidsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryDTagListingsRequest)(nil), "desmos.profiles.v3.QueryDTagListingsRequest")
+	proto.RegisterType((*QueryDTagListingsResponse)(nil), "desmos.profiles.v3.QueryDTagListingsResponse")
+	proto.RegisterType((*QueryDTagBidsRequest)(nil), "desmos.profiles.v3.QueryDTagBidsRequest")
+	proto.RegisterType((*QueryDTagBidsResponse)(nil), "desmos.profiles.v3.QueryDTagBidsResponse")
+}
+
+func init() {
+	proto.RegisterFile("desmos/profiles/v3/query_dtag_offers.proto", fileDescriptor_a9c71e20a8c8e9d6)
+}
+
+var fileDescriptor_a9c71e20a8c8e9d6 = []byte{
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x63, 0xfe, 0xe9, 0xce, 0x37, 0x11, 0x15, 0x29, 0x57, 0xa4, 0xa4, 0xea, 0x00, 0x55,
+	0xd1, 0xd9, 0xea, 0x75, 0x40, 0xba, 0x8d, 0x08, 0x1d, 0x03, 0x0c, 0x10, 0x98, 0x58, 0x2a, 0xe7,
+	0xec, 0x1a, 0x4b, 0x49, 0x9c, 0x8b, 0xdd, 0xc0, 0x7d, 0x03, 0x46, 0x46, 0x86, 0x1b, 0x3a, 0x32,
+	0x30, 0x30, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x4c, 0x08, 0xb5, 0x03, 0x7c, 0x0c, 0x94, 0xd8, 0x6d,
+	0x23, 0x5a, 0xc4, 0xc2, 0xc0, 0x12, 0xbd, 0x79, 0xdf, 0xe7, 0xd1, 0xfb, 0xd3, 0x63, 0x1b, 0xf6,
+	0x29, 0x53, 0xa9, 0x54, 0x38, 0x2f, 0xe4, 0x58, 0x24, 0x4c, 0xe1, 0x72, 0x88, 0xcf, 0x27, 0xac,
+	0xb8, 0x18, 0x51, 0x4d, 0xf8, 0x48, 0x8e, 0xc7, 0xac, 0x50, 0x28, 0x2f, 0xa4, 0x96, 0xae, 0x6b,
+	0xb4, 0x68, 0xa5, 0x45, 0xe5, 0xb0, 0x7d, 0x93, 0xa4, 0x22, 0x93, 0xb8, 0xfe, 0x1a, 0x59, 0xbb,
+	0xc5, 0x25, 0x97, 0x75, 0x89, 0xab, 0xca, 0x76, 0x0f, 0xcf, 0x64, 0x65, 0x1e, 0x99, 0x81, 0xf9,
+	0xb1, 0xa3, 0x7b, 0x3b, 0x18, 0x52, 0x49, 0x59, 0xa2, 0xb6, 0x21, 0xda, 0x7d, 0x63, 0xc5, 0x31,
+	0x51, 0xcc, 0x90, 0xe2, 0x72, 0x10, 0x33, 0x4d, 0x06, 0x38, 0x27, 0x5c, 0x64, 0x44, 0x0b, 0x99,
+	0x19, 0x6d, 0xf7, 0x12, 0x40, 0xef, 0x59, 0x25, 0x79, 0xf8, 0x82, 0xf0, 0x27, 0x42, 0x69, 0x91,
+	0x71, 0x15, 0xb1, 0xf3, 0x09, 0x53, 0xda, 0x45, 0xf0, 0xba, 0x7c, 0x9d, 0xb1, 0xc2, 0x03, 0x1d,
+	0xd0, 0xdb, 0x0f, 0xbd, 0x2f, 0x9f, 0x8f, 0x5a, 0x16, 0xeb, 0x01, 0xa5, 0x05, 0x53, 0xea, 0xb9,
+	0x2e, 0x44, 0xc6, 0x23, 0x23, 0x73, 0x4f, 0x21, 0xdc, 0x2c, 0xf0, 0xae, 0x74, 0x40, 0xef, 0xe0,
+	0xf8, 0x0e, 0xb2, 0x8e, 0x8a, 0x06, 0xd5, 0x34, 0xc8, 0xd2, 0xa0, 0xa7, 0x84, 0x33, 0xbb, 0x2b,
+	0x6a, 0x38, 0x4f, 0xf6, 0xde, 0x4e, 0x03, 0xe7, 0xe7, 0x34, 0x70, 0xba, 0x1f, 0x01, 0x3c, 0xdc,
+	0x81, 0xa7, 0x72, 0x99, 0x29, 0xe6, 0x9e, 0xc2, 0xbd, 0xc4, 0xf6, 0x3c, 0xd0, 0xb9, 0xda, 0x3b,
+	0x38, 0x0e, 0xd0, 0xf6, 0x01, 0xa0, 0x86, 0x37, 0xdc, 0x9f, 0x7d, 0x0b, 0x9c, 0x0f, 0x3f, 0x3e,
+	0xf5, 0x41, 0xb4, 0xf6, 0xba, 0x8f, 0x76, 0x70, 0xdf, 0xfd, 0x2b, 0xb7, 0x81, 0x68, 0x82, 0x77,
+	0xdf, 0x03, 0xd8, 0x5a, 0xe3, 0x86, 0x82, 0xfe, 0x47, 0x49, 0x5e, 0x02, 0x78, 0xeb, 0x37, 0x34,
+	0x9b, 0xe2, 0x09, 0xbc, 0x16, 0x0b, 0xba, 0x4a, 0xf0, 0xf6, 0x9f, 0x12, 0x0c, 0x05, 0x6d, 0xa6,
+	0x57, 0x7b, 0xfe, 0x59, 0x72, 0xe1, 0xe3, 0xd9, 0xc2, 0x07, 0xf3, 0x85, 0x0f, 0xbe, 0x2f, 0x7c,
+	0xf0, 0x6e, 0xe9, 0x3b, 0xf3, 0xa5, 0xef, 0x7c, 0x5d, 0xfa, 0xce, 0xcb, 0x01, 0x17, 0xfa, 0xd5,
+	0x24, 0x46, 0x67, 0x32, 0xc5, 0x06, 0xed, 0x28, 0x21, 0xb1, 0xb2, 0x35, 0x2e, 0xef, 0xe3, 0x37,
+	0x9b, 0x67, 0xa1, 0x2f, 0x72, 0xa6, 0xe2, 0x1b, 0xf5, 0xdd, 0x1e, 0xfe, 0x1a, 0x00, 0xdd, 0x43,
+	0x21, 0xdc, 0xba, 0x03, 0x00, 0x00,
+}
+
+func (m *QueryDTagListingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDTagListingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDTagListingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryDtagOffers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQueryDtagOffers(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDTagListingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDTagListingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDTagListingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryDtagOffers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryDtagOffers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDTagBidsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDTagBidsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDTagBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryDtagOffers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQueryDtagOffers(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDTagBidsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDTagBidsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDTagBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryDtagOffers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryDtagOffers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryDtagOffers(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryDtagOffers(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDTagListingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQueryDtagOffers(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryDtagOffers(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDTagListingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovQueryDtagOffers(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryDtagOffers(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDTagBidsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQueryDtagOffers(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryDtagOffers(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDTagBidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQueryDtagOffers(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryDtagOffers(uint64(l))
+	}
+	return n
+}
+
+func sovQueryDtagOffers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryDtagOffers(x uint64) (n int) {
+	return sovQueryDtagOffers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDTagListingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDTagListingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDTagListingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDTagListingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDTagListingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDTagListingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Listings = append(m.Listings, DTagListing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDTagBidsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDTagBidsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDTagBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDTagBidsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryDtagOffers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDTagBidsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDTagBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, DTagBid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDtagOffers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryDtagOffers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryDtagOffers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryDtagOffers(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryDtagOffers
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryDtagOffers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryDtagOffers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryDtagOffers
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryDtagOffers
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryDtagOffers
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryDtagOffers        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryDtagOffers          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryDtagOffers = fmt.Errorf("proto: unexpected end of group")
+)
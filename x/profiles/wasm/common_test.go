@@ -190,6 +190,8 @@ func (suite *TestSuite) SetupTest() {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 		authtypes.NewModuleAddress("gov").String(),
 	)
 }
@@ -67,6 +67,16 @@ func (s *IntegrationTestSuite) SetupSuite() {
 			),
 		},
 		nil, nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	subspacesDataBz, err := cfg.Codec.MarshalJSON(subspacesGenesis)
 	s.Require().NoError(err)
@@ -93,6 +103,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 				nil,
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				nil,
 			),
 			poststypes.NewPost(
 				1,
@@ -109,6 +120,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 				nil,
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				nil,
 			),
 		},
 		[]poststypes.PostDataEntry{
@@ -120,6 +132,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		nil,
 		poststypes.DefaultParams(),
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	postsDataBz, err := cfg.Codec.MarshalJSON(postsGenesis)
 	s.Require().NoError(err)
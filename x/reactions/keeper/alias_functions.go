@@ -19,6 +19,11 @@ func (k Keeper) HasSubspace(ctx sdk.Context, subspaceID uint64) bool {
 	return k.sk.HasSubspace(ctx, subspaceID)
 }
 
+// IsSubspaceArchived tells whether the subspace with the given id has been archived
+func (k Keeper) IsSubspaceArchived(ctx sdk.Context, subspaceID uint64) bool {
+	return k.sk.IsSubspaceArchived(ctx, subspaceID)
+}
+
 // HasPermission tells whether the given user has the provided permission inside the subspace with the specified id
 func (k Keeper) HasPermission(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string, permission subspacestypes.Permission) bool {
 	return k.sk.HasPermission(ctx, subspaceID, sectionID, user, permission)
@@ -39,6 +44,11 @@ func (k Keeper) GetPost(ctx sdk.Context, subspaceID uint64, postID uint64) (post
 	return k.pk.GetPost(ctx, subspaceID, postID)
 }
 
+// IsPostPending tells whether the post having the given id is still waiting to be published
+func (k Keeper) IsPostPending(ctx sdk.Context, subspaceID uint64, postID uint64) bool {
+	return k.pk.IsPostPending(ctx, subspaceID, postID)
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // IterateRegisteredReactions iterates over all the registered reactions and performs the provided function
@@ -54,6 +54,15 @@ func (h Hooks) AfterSubspaceGroupSaved(sdk.Context, uint64, uint32) {}
 // AfterSubspaceGroupDeleted implements subspacestypes.Hooks
 func (h Hooks) AfterSubspaceGroupDeleted(sdk.Context, uint64, uint32) {}
 
+// AfterSubspaceOwnerTransferRequestSaved implements subspacestypes.Hooks
+func (h Hooks) AfterSubspaceOwnerTransferRequestSaved(sdk.Context, uint64) {}
+
+// AfterSubspaceOwnerTransferRequestDeleted implements subspacestypes.Hooks
+func (h Hooks) AfterSubspaceOwnerTransferRequestDeleted(sdk.Context, uint64) {}
+
+// AfterSubspaceOwnerTransferred implements subspacestypes.Hooks
+func (h Hooks) AfterSubspaceOwnerTransferred(sdk.Context, uint64, string, string) {}
+
 // AfterSubspaceSectionSaved implements subspacestypes.Hooks
 func (h Hooks) AfterSubspaceSectionSaved(sdk.Context, uint64, uint32) {}
 
@@ -96,6 +105,9 @@ func (h Hooks) AfterPostDeleted(ctx sdk.Context, subspaceID uint64, postID uint6
 	})
 }
 
+// AfterPostUnhidden implements poststypes.PostsHooks
+func (h Hooks) AfterPostUnhidden(sdk.Context, uint64, uint64) {}
+
 // AfterAttachmentSaved implements poststypes.PostsHooks
 func (h Hooks) AfterAttachmentSaved(sdk.Context, uint64, uint64, uint32) {}
 
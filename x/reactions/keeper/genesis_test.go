@@ -100,6 +100,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					),
 				}
 				suite.pk.EXPECT().
@@ -29,6 +29,12 @@ var _ types.MsgServer = &msgServer{}
 func (k msgServer) AddReaction(goCtx context.Context, msg *types.MsgAddReaction) (*types.MsgAddReactionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the user has a profile
 	if !k.HasProfile(ctx, msg.User) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "you cannot add a reaction without a profile")
@@ -39,12 +45,22 @@ func (k msgServer) AddReaction(goCtx context.Context, msg *types.MsgAddReaction)
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the post exists
 	post, found := k.GetPost(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d not found inside subspace %d", msg.PostID, msg.SubspaceID)
 	}
 
+	// Make sure the post has already been published
+	if k.IsPostPending(ctx, msg.SubspaceID, msg.PostID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "post with id %d has not been published yet", msg.PostID)
+	}
+
 	// Make sure the post author has not blocked the user
 	if k.HasUserBlocked(ctx, post.Owner, msg.User, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "the post author has blocked you on this subspace")
@@ -109,11 +125,22 @@ func (k msgServer) AddReaction(goCtx context.Context, msg *types.MsgAddReaction)
 func (k msgServer) RemoveReaction(goCtx context.Context, msg *types.MsgRemoveReaction) (*types.MsgRemoveReactionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the post exists
 	post, found := k.GetPost(ctx, msg.SubspaceID, msg.PostID)
 	if !found {
@@ -155,11 +182,22 @@ func (k msgServer) RemoveReaction(goCtx context.Context, msg *types.MsgRemoveRea
 func (k msgServer) AddRegisteredReaction(goCtx context.Context, msg *types.MsgAddRegisteredReaction) (*types.MsgAddRegisteredReactionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check the permission to manage the registered reactions
 	if !k.HasPermission(ctx, msg.SubspaceID, subspacestypes.RootSectionID, msg.User, types.PermissionManageRegisteredReactions) {
 		return nil, errors.Wrap(subspacestypes.ErrPermissionDenied, "you cannot manage the registered reactions inside this subspace")
@@ -206,11 +244,22 @@ func (k msgServer) AddRegisteredReaction(goCtx context.Context, msg *types.MsgAd
 func (k msgServer) EditRegisteredReaction(goCtx context.Context, msg *types.MsgEditRegisteredReaction) (*types.MsgEditRegisteredReactionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the registered reaction exists
 	reaction, found := k.GetRegisteredReaction(ctx, msg.SubspaceID, msg.RegisteredReactionID)
 	if !found {
@@ -224,7 +273,7 @@ func (k msgServer) EditRegisteredReaction(goCtx context.Context, msg *types.MsgE
 
 	// Update the reaction and validate it
 	updated := reaction.Update(types.NewRegisteredReactionUpdate(msg.ShorthandCode, msg.DisplayValue))
-	err := updated.Validate()
+	err = updated.Validate()
 	if err != nil {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -247,11 +296,22 @@ func (k msgServer) EditRegisteredReaction(goCtx context.Context, msg *types.MsgE
 func (k msgServer) RemoveRegisteredReaction(goCtx context.Context, msg *types.MsgRemoveRegisteredReaction) (*types.MsgRemoveRegisteredReactionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the registered reaction exists
 	if !k.HasRegisteredReaction(ctx, msg.SubspaceID, msg.RegisteredReactionID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "registered reaction with id %d not found", msg.RegisteredReactionID)
@@ -280,11 +340,22 @@ func (k msgServer) RemoveRegisteredReaction(goCtx context.Context, msg *types.Ms
 func (k msgServer) SetReactionsParams(goCtx context.Context, msg *types.MsgSetReactionsParams) (*types.MsgSetReactionsParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check the permission to manage the reaction params
 	if !k.HasPermission(ctx, msg.SubspaceID, subspacestypes.RootSectionID, msg.User, types.PermissionManageReactionParams) {
 		return nil, errors.Wrap(subspacestypes.ErrPermissionDenied, "you cannot manage the reactions params inside this subspace")
@@ -292,7 +363,7 @@ func (k msgServer) SetReactionsParams(goCtx context.Context, msg *types.MsgSetRe
 
 	// Create and validate the params
 	params := types.NewSubspaceReactionsParams(msg.SubspaceID, msg.RegisteredReaction, msg.FreeText)
-	err := params.Validate()
+	err = params.Validate()
 	if err != nil {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
 	}
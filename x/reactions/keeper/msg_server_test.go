@@ -16,15 +16,27 @@ import (
 
 func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 	testCases := []struct {
-		name        string
-		setup       func()
-		store       func(ctx sdk.Context)
-		msg         *types.MsgAddReaction
-		shouldErr   bool
-		expResponse *types.MsgAddReactionResponse
-		expEvents   sdk.Events
-		check       func(ctx sdk.Context)
+		name         string
+		setup        func()
+		rateLimitErr error
+		store        func(ctx sdk.Context)
+		msg          *types.MsgAddReaction
+		shouldErr    bool
+		expResponse  *types.MsgAddReactionResponse
+		expEvents    sdk.Events
+		check        func(ctx sdk.Context)
 	}{
+		{
+			name:         "exceeded rate limit returns error",
+			rateLimitErr: subspacestypes.ErrRateLimitExceeded,
+			msg: types.NewMsgAddReaction(
+				1,
+				1,
+				types.NewRegisteredReactionValue(1),
+				"cosmos1efa8l9h4p6hmkps6vk8lu7nxydr46npr8qtg5f",
+			),
+			shouldErr: true,
+		},
 		{
 			name: "user without profile returns error",
 			setup: func() {
@@ -59,6 +71,29 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "archived subspace returns error",
+			setup: func() {
+				suite.ak.EXPECT().
+					HasProfile(gomock.Any(), "cosmos1efa8l9h4p6hmkps6vk8lu7nxydr46npr8qtg5f").
+					Return(true)
+
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(true)
+			},
+			msg: types.NewMsgAddReaction(
+				1,
+				1,
+				types.NewRegisteredReactionValue(1),
+				"cosmos1efa8l9h4p6hmkps6vk8lu7nxydr46npr8qtg5f",
+			),
+			shouldErr: true,
+		},
 		{
 			name: "non existing post returns error",
 			setup: func() {
@@ -70,6 +105,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.Post{}, false)
@@ -82,6 +121,53 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "pending post returns error",
+			setup: func() {
+				suite.ak.EXPECT().
+					HasProfile(gomock.Any(), "cosmos1efa8l9h4p6hmkps6vk8lu7nxydr46npr8qtg5f").
+					Return(true)
+
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
+				suite.pk.EXPECT().
+					GetPost(gomock.Any(), uint64(1), uint64(1)).
+					Return(poststypes.NewPost(
+						1,
+						0,
+						1,
+						"External ID",
+						"This is a text",
+						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						1,
+						nil,
+						nil,
+						nil,
+						poststypes.REPLY_SETTING_EVERYONE,
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						nil,
+						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
+					), true)
+
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(true)
+			},
+			msg: types.NewMsgAddReaction(
+				1,
+				1,
+				types.NewRegisteredReactionValue(1),
+				"cosmos1efa8l9h4p6hmkps6vk8lu7nxydr46npr8qtg5f",
+			),
+			shouldErr: true,
+		},
 		{
 			name: "blocked user returns error",
 			setup: func() {
@@ -93,6 +179,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -110,8 +200,13 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
 				suite.rk.EXPECT().
 					HasUserBlocked(gomock.Any(),
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
@@ -138,6 +233,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -155,8 +254,13 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
 				suite.rk.EXPECT().
 					HasUserBlocked(gomock.Any(),
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
@@ -191,6 +295,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -208,8 +316,13 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
 				suite.rk.EXPECT().
 					HasUserBlocked(gomock.Any(),
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
@@ -253,6 +366,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -270,8 +387,13 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
 				suite.rk.EXPECT().
 					HasUserBlocked(gomock.Any(),
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
@@ -306,6 +428,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -323,8 +449,13 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
 				suite.rk.EXPECT().
 					HasUserBlocked(gomock.Any(),
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
@@ -362,6 +493,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -379,8 +514,13 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
 				suite.rk.EXPECT().
 					HasUserBlocked(gomock.Any(),
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
@@ -454,6 +594,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -471,8 +615,13 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
 				suite.rk.EXPECT().
 					HasUserBlocked(gomock.Any(),
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
@@ -542,6 +691,8 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReaction() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(tc.rateLimitErr)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			res, err := msgServer.AddReaction(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
@@ -590,6 +741,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.Post{}, false)
@@ -609,6 +764,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -626,6 +785,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 			},
 			msg: types.NewMsgRemoveReaction(
@@ -643,6 +803,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -660,6 +824,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 			},
 			store: func(ctx sdk.Context) {
@@ -686,6 +851,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -703,6 +872,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 
 				suite.sk.EXPECT().
@@ -737,6 +907,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.pk.EXPECT().
 					GetPost(gomock.Any(), uint64(1), uint64(1)).
 					Return(poststypes.NewPost(
@@ -754,6 +928,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReaction() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 
 				suite.sk.EXPECT().
@@ -806,6 +981,8 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReaction() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			_, err := msgServer.RemoveReaction(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
@@ -854,6 +1031,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddRegisteredReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -877,6 +1058,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddRegisteredReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -900,6 +1085,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddRegisteredReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -926,6 +1115,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddRegisteredReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -984,6 +1177,8 @@ func (suite *KeeperTestSuite) TestMsgServer_AddRegisteredReaction() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			res, err := msgServer.AddRegisteredReaction(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
@@ -1032,6 +1227,10 @@ func (suite *KeeperTestSuite) TestMsgServer_EditRegisteredReaction() {
 				suite.sk.EXPECT().
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
 			},
 			msg: types.NewMsgEditRegisteredReaction(
 				1,
@@ -1049,6 +1248,10 @@ func (suite *KeeperTestSuite) TestMsgServer_EditRegisteredReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -1081,6 +1284,10 @@ func (suite *KeeperTestSuite) TestMsgServer_EditRegisteredReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -1113,6 +1320,10 @@ func (suite *KeeperTestSuite) TestMsgServer_EditRegisteredReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -1169,6 +1380,8 @@ func (suite *KeeperTestSuite) TestMsgServer_EditRegisteredReaction() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			_, err := msgServer.EditRegisteredReaction(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
@@ -1214,6 +1427,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveRegisteredReaction() {
 				suite.sk.EXPECT().
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
 			},
 			msg: types.NewMsgRemoveRegisteredReaction(
 				1,
@@ -1229,6 +1446,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveRegisteredReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -1259,6 +1480,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveRegisteredReaction() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -1306,6 +1531,8 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveRegisteredReaction() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			_, err := msgServer.RemoveRegisteredReaction(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
@@ -1353,6 +1580,10 @@ func (suite *KeeperTestSuite) TestMsgServer_SetReactionsParams() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -1376,6 +1607,10 @@ func (suite *KeeperTestSuite) TestMsgServer_SetReactionsParams() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -1399,6 +1634,10 @@ func (suite *KeeperTestSuite) TestMsgServer_SetReactionsParams() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(gomock.Any(),
 						uint64(1),
@@ -1444,6 +1683,8 @@ func (suite *KeeperTestSuite) TestMsgServer_SetReactionsParams() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			_, err := msgServer.SetReactionsParams(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
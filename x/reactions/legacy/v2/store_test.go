@@ -39,8 +39,8 @@ func TestMigrateStore(t *testing.T) {
 
 	sk := subspaceskeeper.NewKeeper(cdc, keys[subspacestypes.StoreKey], nil, nil, "authority")
 	rk := relationshipskeeper.NewKeeper(cdc, keys[relationshipstypes.StoreKey], sk)
-	ak := profileskeeper.NewKeeper(cdc, legacyAminoCdc, keys[profilestypes.StoreKey], authKeeper, rk, nil, nil, nil, authtypes.NewModuleAddress("gov").String())
-	pk := postskeeper.NewKeeper(cdc, keys[poststypes.StoreKey], ak, sk, rk, authtypes.NewModuleAddress("gov").String())
+	ak := profileskeeper.NewKeeper(cdc, legacyAminoCdc, keys[profilestypes.StoreKey], authKeeper, rk, nil, nil, nil, nil, nil, authtypes.NewModuleAddress("gov").String())
+	pk := postskeeper.NewKeeper(cdc, keys[poststypes.StoreKey], ak, sk, rk, nil, authtypes.NewModuleAddress("gov").String())
 
 	testCases := []struct {
 		name      string
@@ -136,6 +136,7 @@ func TestMigrateStore(t *testing.T) {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					nil,
 				))
 
 				pk.SavePost(ctx, poststypes.NewPost(
@@ -153,6 +154,7 @@ func TestMigrateStore(t *testing.T) {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					nil,
 				))
 			},
 			shouldErr: false,
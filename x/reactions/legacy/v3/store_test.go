@@ -117,6 +117,7 @@ func TestMigrateStore(t *testing.T) {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					),
 				}
 
@@ -154,6 +155,7 @@ func TestMigrateStore(t *testing.T) {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					),
 				}
 
@@ -196,6 +198,7 @@ func TestMigrateStore(t *testing.T) {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					),
 				}
 
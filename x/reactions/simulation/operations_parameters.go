@@ -68,6 +68,11 @@ func randomSetReactionsParamsFields(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID := subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a user
 	users := sk.GetUsersWithRootPermissions(ctx, subspace.ID, subspacestypes.NewPermissions(types.PermissionsReact))
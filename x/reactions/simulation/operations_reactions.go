@@ -71,6 +71,11 @@ func randomAddReactionFields(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID := subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get the posts
 	posts := pk.GetSubspacePosts(ctx, subspaceID)
@@ -198,6 +203,11 @@ func randomRemoveReactionFields(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID := subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get the reactions
 	reactions := k.GetSubspaceReactions(ctx, subspaceID)
@@ -69,6 +69,11 @@ func randomAddRegisteredReactionFields(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID := subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a user
 	users := sk.GetUsersWithRootPermissions(ctx, subspace.ID, subspacestypes.NewPermissions(types.PermissionManageRegisteredReactions))
@@ -144,6 +149,11 @@ func randomEditRegisteredReactionFields(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID := subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a random reaction
 	reactions := k.GetSubspaceRegisteredReactions(ctx, subspaceID)
@@ -226,6 +236,11 @@ func randomRemoveRegisteredReactionFields(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID := subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a random reaction
 	reactions := k.GetSubspaceRegisteredReactions(ctx, subspaceID)
@@ -21,6 +21,9 @@ type SubspacesKeeper interface {
 	// HasSubspace tells whether the subspace with the given id exists or not
 	HasSubspace(ctx sdk.Context, subspaceID uint64) bool
 
+	// IsSubspaceArchived tells whether the subspace with the given id has been archived
+	IsSubspaceArchived(ctx sdk.Context, subspaceID uint64) bool
+
 	// HasPermission tells whether the given user has the provided permission inside the subspace with the specified id
 	HasPermission(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string, permission subspacestypes.Permission) bool
 
@@ -32,6 +35,9 @@ type SubspacesKeeper interface {
 
 	// GetUsersWithRootPermissions returns all the users that have a given permission inside the specified subspace
 	GetUsersWithRootPermissions(ctx sdk.Context, subspaceID uint64, permission subspacestypes.Permissions) []string
+
+	// ConsumeMsgRateLimits consumes the subspace rate limits that apply to the given message for each one of its signers
+	ConsumeMsgRateLimits(ctx sdk.Context, msg subspacestypes.SubspaceMsg) error
 }
 
 // RelationshipsKeeper represents a keeper that deals with relationships
@@ -52,6 +58,9 @@ type PostsKeeper interface {
 	// If there is no post associated with the given id the function will return an empty post and false.
 	GetPost(ctx sdk.Context, subspaceID uint64, postID uint64) (post poststypes.Post, found bool)
 
+	// IsPostPending tells whether the post having the given id is still waiting to be published
+	IsPostPending(ctx sdk.Context, subspaceID uint64, postID uint64) bool
+
 	// IteratePosts iterates over all the posts stored inside the context and performs the provided function
 	IteratePosts(ctx sdk.Context, fn func(post poststypes.Post) (stop bool))
 
@@ -7,6 +7,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	subspacestypes "github.com/desmos-labs/desmos/v7/x/subspaces/types"
 )
 
 var (
@@ -16,6 +18,13 @@ var (
 	_ sdk.Msg = &MsgEditRegisteredReaction{}
 	_ sdk.Msg = &MsgRemoveRegisteredReaction{}
 	_ sdk.Msg = &MsgSetReactionsParams{}
+
+	_ subspacestypes.RateLimitedMsg = &MsgAddReaction{}
+	_ subspacestypes.RateLimitedMsg = &MsgRemoveReaction{}
+	_ subspacestypes.RateLimitedMsg = &MsgAddRegisteredReaction{}
+	_ subspacestypes.RateLimitedMsg = &MsgEditRegisteredReaction{}
+	_ subspacestypes.RateLimitedMsg = &MsgRemoveRegisteredReaction{}
+	_ subspacestypes.RateLimitedMsg = &MsgSetReactionsParams{}
 )
 
 // NewMsgAddReaction returns a new MsgAddReaction instance
@@ -77,6 +86,9 @@ func (msg *MsgAddReaction) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgAddReaction) IsRateLimitedMsg() {}
+
 // UnpackInterfaces implements codectypes.UnpackInterfacesMessage
 func (msg *MsgAddReaction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var target ReactionValue
@@ -134,6 +146,9 @@ func (msg *MsgRemoveReaction) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgRemoveReaction) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgAddRegisteredReaction returns a new MsgAddRegisteredReaction instance
@@ -193,6 +208,9 @@ func (msg *MsgAddRegisteredReaction) GetSigners() []sdk.AccAddress {
 // IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
 func (msg *MsgAddRegisteredReaction) IsManageSubspaceMsg() {}
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgAddRegisteredReaction) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgEditRegisteredReaction returns a new MsgEditRegisteredReaction instance
@@ -258,6 +276,9 @@ func (msg *MsgEditRegisteredReaction) GetSigners() []sdk.AccAddress {
 // IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
 func (msg *MsgEditRegisteredReaction) IsManageSubspaceMsg() {}
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgEditRegisteredReaction) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgRemoveRegisteredReaction returns a new MsgRemoveRegisteredReaction instance
@@ -311,6 +332,9 @@ func (msg *MsgRemoveRegisteredReaction) GetSigners() []sdk.AccAddress {
 // IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
 func (msg *MsgRemoveRegisteredReaction) IsManageSubspaceMsg() {}
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgRemoveRegisteredReaction) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgSetReactionsParams returns a new MsgSetReactionsParams instance
@@ -366,3 +390,6 @@ func (msg *MsgSetReactionsParams) GetSigners() []sdk.AccAddress {
 
 // IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
 func (msg *MsgSetReactionsParams) IsManageSubspaceMsg() {}
+
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgSetReactionsParams) IsRateLimitedMsg() {}
@@ -42,6 +42,9 @@ func (h Hooks) AfterSubspaceDeleted(ctx sdk.Context, subspaceID uint64) {
 	}
 }
 
+func (h Hooks) AfterSubspaceOwnerTransferRequestSaved(sdk.Context, uint64)          {}
+func (h Hooks) AfterSubspaceOwnerTransferRequestDeleted(sdk.Context, uint64)        {}
+func (h Hooks) AfterSubspaceOwnerTransferred(sdk.Context, uint64, string, string)   {}
 func (h Hooks) AfterSubspaceSectionSaved(sdk.Context, uint64, uint32)               {}
 func (h Hooks) AfterSubspaceSectionDeleted(sdk.Context, uint64, uint32)             {}
 func (h Hooks) AfterSubspaceGroupSaved(sdk.Context, uint64, uint32)                 {}
@@ -34,6 +34,11 @@ func (k msgServer) CreateRelationship(goCtx context.Context, msg *types.MsgCreat
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Check if the sender has been banned from the subspace
+	if msg.SubspaceID != 0 && k.sk.IsUserBanned(ctx, msg.SubspaceID, msg.Signer) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is banned from subspace %d", msg.Signer, msg.SubspaceID)
+	}
+
 	// Check if the receiver has blocked the sender before
 	if k.HasUserBlocked(ctx, msg.Counterparty, msg.Signer, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is blocked by %s", msg.Signer, msg.Counterparty)
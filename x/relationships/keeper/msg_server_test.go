@@ -32,10 +32,24 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateRelationship() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "banned user returns error",
+			setup: func() {
+				suite.sk.EXPECT().HasSubspace(gomock.Any(), uint64(1)).Return(true)
+				suite.sk.EXPECT().IsUserBanned(gomock.Any(), uint64(1), "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47").Return(true)
+			},
+			msg: types.NewMsgCreateRelationship(
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+				1,
+			),
+			shouldErr: true,
+		},
 		{
 			name: "blocked user returns error",
 			setup: func() {
 				suite.sk.EXPECT().HasSubspace(gomock.Any(), uint64(1)).Return(true)
+				suite.sk.EXPECT().IsUserBanned(gomock.Any(), uint64(1), "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47").Return(false)
 			},
 			store: func(ctx sdk.Context) {
 				suite.k.SaveUserBlock(ctx, types.NewUserBlock(
@@ -56,6 +70,7 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateRelationship() {
 			name: "existing relationship returns error",
 			setup: func() {
 				suite.sk.EXPECT().HasSubspace(gomock.Any(), uint64(1)).Return(true)
+				suite.sk.EXPECT().IsUserBanned(gomock.Any(), uint64(1), "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47").Return(false)
 			},
 			store: func(ctx sdk.Context) {
 				suite.k.SaveRelationship(ctx, types.NewRelationship(
@@ -75,6 +90,7 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateRelationship() {
 			name: "non existing relationship is created correctly",
 			setup: func() {
 				suite.sk.EXPECT().HasSubspace(gomock.Any(), uint64(1)).Return(true)
+				suite.sk.EXPECT().IsUserBanned(gomock.Any(), uint64(1), "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47").Return(false)
 			},
 			msg: types.NewMsgCreateRelationship(
 				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
@@ -70,6 +70,12 @@ func randomCreateRelationshipFields(
 		return
 	}
 
+	// Skip if the sender is banned from the subspace
+	if sk.IsUserBanned(ctx, subspaceID, senderAddr) {
+		skip = true
+		return
+	}
+
 	// Skip if the receiver has blocked the sender
 	if k.HasUserBlocked(ctx, receiverAddr, senderAddr, subspaceID) {
 		skip = true
@@ -63,3 +63,17 @@ func (mr *MockSubspacesKeeperMockRecorder) HasSubspace(ctx, subspaceID interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSubspace", reflect.TypeOf((*MockSubspacesKeeper)(nil).HasSubspace), ctx, subspaceID)
 }
+
+// IsUserBanned mocks base method.
+func (m *MockSubspacesKeeper) IsUserBanned(ctx types.Context, subspaceID uint64, user string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserBanned", ctx, subspaceID, user)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsUserBanned indicates an expected call of IsUserBanned.
+func (mr *MockSubspacesKeeperMockRecorder) IsUserBanned(ctx, subspaceID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserBanned", reflect.TypeOf((*MockSubspacesKeeper)(nil).IsUserBanned), ctx, subspaceID, user)
+}
@@ -8,5 +8,6 @@ import (
 
 type SubspacesKeeper interface {
 	HasSubspace(ctx sdk.Context, subspaceID uint64) bool
+	IsUserBanned(ctx sdk.Context, subspaceID uint64, user string) bool
 	GetAllSubspaces(ctx sdk.Context) []subspacestypes.Subspace
 }
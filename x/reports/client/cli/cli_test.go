@@ -62,6 +62,16 @@ func (s *IntegrationTestSuite) SetupSuite() {
 			),
 		},
 		nil, nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	subspacesDataBz, err := cfg.Codec.MarshalJSON(subspacesGenesis)
 	s.Require().NoError(err)
@@ -88,6 +98,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 				nil,
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				nil,
 			),
 		},
 		[]poststypes.PostDataEntry{
@@ -98,6 +109,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		nil,
 		poststypes.DefaultParams(),
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	postsDataBz, err := cfg.Codec.MarshalJSON(postsGenesis)
 	s.Require().NoError(err)
@@ -145,6 +160,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		types.NewParams([]types.StandardReason{
 			types.NewStandardReason(1, "Spam", "This content is spam/This user is a spammer"),
 		}),
+		[]types.HidingThreshold{
+			types.NewHidingThreshold(1, 5, []uint32{1}),
+		},
+		nil,
 	)
 
 	// Store the genesis data
@@ -448,6 +467,63 @@ func (s *IntegrationTestSuite) TestCmdQueryReasons() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdQueryHidingThreshold() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name        string
+		args        []string
+		shouldErr   bool
+		expResponse types.QueryHidingThresholdResponse
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"0",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "not found threshold returns error",
+			args: []string{
+				"2",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "hiding threshold is returned properly",
+			args: []string{
+				"1",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QueryHidingThresholdResponse{
+				HidingThreshold: types.NewHidingThreshold(1, 5, []uint32{1}),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryHidingThreshold()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryHidingThresholdResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				s.Require().Equal(tc.expResponse.HidingThreshold, response.HidingThreshold)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestCmdQueryParams() {
 	val := s.network.Validators[0]
 	testCases := []struct {
@@ -624,6 +700,130 @@ func (s *IntegrationTestSuite) TestCmdDeleteReport() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdResolveReport() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"0", "1", "dismissed",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid report id returns error",
+			args: []string{
+				"1", "0", "dismissed",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid status returns error",
+			args: []string{
+				"1", "1", "open",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1", "1", "dismissed",
+				fmt.Sprintf("--%s=%s", cli.FlagMessage, "The reported content does not violate the rules"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdResolveReport()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdSetHidingThreshold() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"0", "5",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid threshold returns error",
+			args: []string{
+				"1", "-1",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid reasons returns error",
+			args: []string{
+				"1", "5",
+				fmt.Sprintf("--%s=%s", cli.FlagReasons, "1,1"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1", "5",
+				fmt.Sprintf("--%s=%s", cli.FlagReasons, "1,2"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdSetHidingThreshold()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestCmdSupportStandardReason() {
 	val := s.network.Validators[0]
 	testCases := []struct {
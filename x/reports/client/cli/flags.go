@@ -13,6 +13,8 @@ const (
 	FlagPostID   = "post-id"
 	FlagReporter = "reporter"
 	FlagMessage  = "message"
+	FlagStatuses = "statuses"
+	FlagReasons  = "reasons"
 )
 
 // ReadReportTarget reads the given flags and returns the report target
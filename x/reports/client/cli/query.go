@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryReports(),
 		GetCmdQueryReason(),
 		GetCmdQueryReasons(),
+		GetCmdQueryHidingThreshold(),
 		GetCmdQueryParams(),
 	)
 	return subspaceQueryCmd
@@ -109,9 +110,19 @@ func GetCmdQueryReports() *cobra.Command {
 				return err
 			}
 
+			statusesValue, err := cmd.Flags().GetString(FlagStatuses)
+			if err != nil {
+				return err
+			}
+
+			statuses, err := types.ParseReportStatuses(statusesValue)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Reports(
 				context.Background(),
-				types.NewQueryReportsRequest(subspaceID, target, reporter, pageReq),
+				types.NewQueryReportsRequest(subspaceID, target, reporter, statuses, pageReq),
 			)
 			if err != nil {
 				return err
@@ -124,6 +135,7 @@ func GetCmdQueryReports() *cobra.Command {
 	cmd.Flags().String(FlagUser, "", "Optional address of the reported user to query the reports for")
 	cmd.Flags().Uint64(FlagPostID, 0, "Optional id of the post to query the reports for")
 	cmd.Flags().String(FlagReporter, "", fmt.Sprintf("Optional address of the reporter, used only if either --%s or --%s is specified", FlagUser, FlagPostID))
+	cmd.Flags().String(FlagStatuses, "", "Optional comma-separated list of statuses (open, under-review, actioned, dismissed) to filter the reports with")
 
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "reports")
@@ -211,6 +223,42 @@ func GetCmdQueryReasons() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryHidingThreshold returns the command to query the hiding threshold of a subspace
+func GetCmdQueryHidingThreshold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hiding-threshold [subspace-id]",
+		Short:   "Query the hiding threshold of the subspace having the given id",
+		Example: fmt.Sprintf(`%s query reports hiding-threshold 1`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			subspaceID, err := subspacestypes.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HidingThreshold(
+				context.Background(),
+				types.NewQueryHidingThresholdRequest(subspaceID),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams returns the command to query the params of the module
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
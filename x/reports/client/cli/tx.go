@@ -4,6 +4,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -28,6 +29,8 @@ func NewTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdCreateReport(),
 		GetCmdDeleteReport(),
+		GetCmdResolveReport(),
+		GetCmdSetHidingThreshold(),
 		NewReasonsTxCmd(),
 	)
 
@@ -147,6 +150,125 @@ func GetCmdDeleteReport() *cobra.Command {
 	return cmd
 }
 
+// GetCmdResolveReport returns the command allowing to resolve a report
+func GetCmdResolveReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve [subspace-id] [report-id] [status]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Resolve a report",
+		Long: `Update the moderation status of the report having the given id from the specified subspace.
+The status must be one of: under-review, actioned, dismissed.`,
+		Example: fmt.Sprintf(`
+%s tx reports resolve 1 1 dismissed \
+  --%s "The reported post does not violate the rules" \
+  --from alice
+`, version.AppName, FlagMessage),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := subspacestypes.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			reportID, err := types.ParseReportID(args[1])
+			if err != nil {
+				return err
+			}
+
+			status, err := types.ParseReportStatus(args[2])
+			if err != nil {
+				return err
+			}
+
+			note, err := cmd.Flags().GetString(FlagMessage)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.FromAddress.String()
+
+			msg := types.NewMsgResolveReport(subspaceID, reportID, status, note, signer)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMessage, "", "Optional note associated with the resolution")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetHidingThreshold returns the command allowing to set the hiding threshold of a subspace
+func GetCmdSetHidingThreshold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-hiding-threshold [subspace-id] [threshold]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set the number of reports after which a post is automatically hidden",
+		Long: fmt.Sprintf(`Set the number of reports after which a post of the given subspace is automatically hidden.
+Only the reports having at least one of the reasons specified using --%[1]s will be counted.
+If --%[1]s is not used, all the reports will be counted instead.
+To disable the automatic hiding, set the threshold to 0.`, FlagReasons),
+		Example: fmt.Sprintf(`
+%s tx reports set-hiding-threshold 1 5 \
+  --%s 1,2 \
+  --from alice
+`, version.AppName, FlagReasons),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := subspacestypes.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid threshold: %s", err)
+			}
+
+			reasonsValue, err := cmd.Flags().GetString(FlagReasons)
+			if err != nil {
+				return err
+			}
+
+			var reasons []uint32
+			if reasonsValue != "" {
+				reasons, err = types.ParseReasonsIDs(reasonsValue)
+				if err != nil {
+					return err
+				}
+			}
+
+			signer := clientCtx.FromAddress.String()
+
+			msg := types.NewMsgSetHidingThreshold(subspaceID, uint32(threshold), reasons, signer)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReasons, "", "Comma-separated ids of the reasons whose reports should be counted")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewReasonsTxCmd returns a new command to perform reasons transactions
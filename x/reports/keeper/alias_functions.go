@@ -22,6 +22,11 @@ func (k Keeper) HasSubspace(ctx sdk.Context, subspaceID uint64) bool {
 	return k.sk.HasSubspace(ctx, subspaceID)
 }
 
+// IsSubspaceArchived tells whether the subspace with the given id has been archived
+func (k Keeper) IsSubspaceArchived(ctx sdk.Context, subspaceID uint64) bool {
+	return k.sk.IsSubspaceArchived(ctx, subspaceID)
+}
+
 // HasPermission tells whether the given user has the provided permission inside the subspace with the specified id
 func (k Keeper) HasPermission(ctx sdk.Context, subspaceID uint64, user string, permission subspacestypes.Permission) bool {
 	// Report-related permissions are checked only against the root section
@@ -43,6 +48,21 @@ func (k Keeper) GetPost(ctx sdk.Context, subspaceID uint64, postID uint64) (post
 	return k.pk.GetPost(ctx, subspaceID, postID)
 }
 
+// IsPostPending tells whether the post having the given id is still waiting to be published
+func (k Keeper) IsPostPending(ctx sdk.Context, subspaceID uint64, postID uint64) bool {
+	return k.pk.IsPostPending(ctx, subspaceID, postID)
+}
+
+// HidePost flags the post having the given id as hidden
+func (k Keeper) HidePost(ctx sdk.Context, subspaceID uint64, postID uint64) {
+	k.pk.HidePost(ctx, subspaceID, postID)
+}
+
+// IsPostHidden tells whether the post having the given id has been flagged as hidden
+func (k Keeper) IsPostHidden(ctx sdk.Context, subspaceID uint64, postID uint64) bool {
+	return k.pk.IsPostHidden(ctx, subspaceID, postID)
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetStandardReason returns the standard reason with the given id.
@@ -167,3 +187,39 @@ func (k Keeper) IteratePostReports(ctx sdk.Context, subspaceID uint64, postID ui
 		}
 	}
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IterateHidingThresholds iterates over all the stored hiding thresholds and performs the provided function
+func (k Keeper) IterateHidingThresholds(ctx sdk.Context, fn func(threshold types.HidingThreshold) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HidingThresholdPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var threshold types.HidingThreshold
+		k.cdc.MustUnmarshal(iterator.Value(), &threshold)
+
+		stop := fn(threshold)
+		if stop {
+			break
+		}
+	}
+}
+
+// IterateUnhiddenPosts iterates over all the stored unhidden posts data and performs the provided function
+func (k Keeper) IterateUnhiddenPosts(ctx sdk.Context, fn func(entry types.UnhiddenPostEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnhiddenPostPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		subspaceID, postID := types.SplitUnhiddenPostStoreKey(iterator.Key())
+		firstReportID := types.GetReportIDFromBytes(iterator.Value())
+
+		stop := fn(types.NewUnhiddenPostEntry(subspaceID, postID, firstReportID))
+		if stop {
+			break
+		}
+	}
+}
@@ -53,6 +53,9 @@ func (h Hooks) AfterSubspaceDeleted(ctx sdk.Context, subspaceID uint64) {
 		h.k.DeleteReason(ctx, reason.SubspaceID, reason.ID)
 		return false
 	})
+
+	// Delete the hiding threshold
+	h.k.DeleteHidingThreshold(ctx, subspaceID)
 }
 
 // AfterSubspaceGroupSaved implements subspacestypes.Hooks
@@ -61,6 +64,15 @@ func (h Hooks) AfterSubspaceGroupSaved(sdk.Context, uint64, uint32) {}
 // AfterSubspaceGroupDeleted implements subspacestypes.Hooks
 func (h Hooks) AfterSubspaceGroupDeleted(sdk.Context, uint64, uint32) {}
 
+// AfterSubspaceOwnerTransferRequestSaved implements subspacestypes.Hooks
+func (h Hooks) AfterSubspaceOwnerTransferRequestSaved(sdk.Context, uint64) {}
+
+// AfterSubspaceOwnerTransferRequestDeleted implements subspacestypes.Hooks
+func (h Hooks) AfterSubspaceOwnerTransferRequestDeleted(sdk.Context, uint64) {}
+
+// AfterSubspaceOwnerTransferred implements subspacestypes.Hooks
+func (h Hooks) AfterSubspaceOwnerTransferred(sdk.Context, uint64, string, string) {}
+
 // AfterSubspaceSectionSaved implements subspacestypes.Hooks
 func (h Hooks) AfterSubspaceSectionSaved(sdk.Context, uint64, uint32) {}
 
@@ -93,6 +105,19 @@ func (h Hooks) AfterPostDeleted(ctx sdk.Context, subspaceID uint64, postID uint6
 		h.k.DeleteReport(ctx, report.SubspaceID, report.ID)
 		return false
 	})
+
+	// Delete the unhidden post data
+	h.k.DeleteUnhiddenPost(ctx, subspaceID, postID)
+}
+
+// AfterPostUnhidden implements poststypes.PostsHooks
+func (h Hooks) AfterPostUnhidden(ctx sdk.Context, subspaceID uint64, postID uint64) {
+	// Only count the reports created from now on towards hiding the post again
+	nextReportID, err := h.k.GetNextReportID(ctx, subspaceID)
+	if err != nil {
+		return
+	}
+	h.k.SaveUnhiddenPost(ctx, subspaceID, postID, nextReportID)
 }
 
 // AfterAttachmentSaved implements poststypes.PostsHooks
@@ -91,11 +91,16 @@ func (suite *KeeperTestSuite) TestKeeper_AfterSubspaceDeleted() {
 					"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 				))
+
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 5, []uint32{1}))
 			},
 			subspaceID: 1,
 			check: func(ctx sdk.Context) {
 				store := ctx.KVStore(suite.storeKey)
 
+				// Check the hiding threshold
+				suite.Require().False(store.Has(types.HidingThresholdStoreKey(1)))
+
 				// Check the reasons data
 				suite.Require().False(store.Has(types.NextReasonIDStoreKey(1)))
 				suite.Require().False(store.Has(types.ReasonStoreKey(1, 1)))
@@ -153,6 +158,18 @@ func (suite *KeeperTestSuite) TestKeeper_AfterPostDeleted() {
 				suite.Require().False(store.Has(types.ReportStoreKey(1, 1)))
 			},
 		},
+		{
+			name: "deleting a post removes its unhidden post data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveUnhiddenPost(ctx, 1, 1, 3)
+			},
+			subspaceID: 1,
+			postID:     1,
+			check: func(ctx sdk.Context) {
+				_, found := suite.k.GetUnhiddenPostFirstReportID(ctx, 1, 1)
+				suite.Require().False(found)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -170,3 +187,56 @@ func (suite *KeeperTestSuite) TestKeeper_AfterPostDeleted() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestKeeper_AfterPostUnhidden() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		postID     uint64
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name: "unhiding a post stores the id of the first report to be counted",
+			store: func(ctx sdk.Context) {
+				suite.k.SetNextReportID(ctx, 1, 4)
+			},
+			subspaceID: 1,
+			postID:     1,
+			check: func(ctx sdk.Context) {
+				firstReportID, found := suite.k.GetUnhiddenPostFirstReportID(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(4), firstReportID)
+			},
+		},
+		{
+			name: "unhiding a post again updates the id of the first report to be counted",
+			store: func(ctx sdk.Context) {
+				suite.k.SetNextReportID(ctx, 1, 7)
+				suite.k.SaveUnhiddenPost(ctx, 1, 1, 4)
+			},
+			subspaceID: 1,
+			postID:     1,
+			check: func(ctx sdk.Context) {
+				firstReportID, found := suite.k.GetUnhiddenPostFirstReportID(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(7), firstReportID)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.Hooks().AfterPostUnhidden(ctx, tc.subspaceID, tc.postID)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
@@ -14,6 +14,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.getAllReasons(ctx),
 		k.getAllReports(ctx),
 		k.GetParams(ctx),
+		k.getAllHidingThresholds(ctx),
+		k.getAllUnhiddenPosts(ctx),
 	)
 }
 
@@ -62,6 +64,26 @@ func (k Keeper) getAllReports(ctx sdk.Context) []types.Report {
 	return reports
 }
 
+// getAllHidingThresholds returns all the hiding thresholds stored inside the given context
+func (k Keeper) getAllHidingThresholds(ctx sdk.Context) []types.HidingThreshold {
+	var thresholds []types.HidingThreshold
+	k.IterateHidingThresholds(ctx, func(threshold types.HidingThreshold) (stop bool) {
+		thresholds = append(thresholds, threshold)
+		return false
+	})
+	return thresholds
+}
+
+// getAllUnhiddenPosts returns all the unhidden posts data stored inside the given context
+func (k Keeper) getAllUnhiddenPosts(ctx sdk.Context) []types.UnhiddenPostEntry {
+	var entries []types.UnhiddenPostEntry
+	k.IterateUnhiddenPosts(ctx, func(entry types.UnhiddenPostEntry) (stop bool) {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // InitGenesis initializes the chain state based on the given GenesisState
@@ -84,4 +106,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 
 	// Initialize the params
 	k.SetParams(ctx, data.Params)
+
+	// Initialize the hiding thresholds.
+	// This is done after storing the reports so that importing them does not hide any post
+	for _, threshold := range data.HidingThresholds {
+		k.SaveHidingThreshold(ctx, threshold)
+	}
+
+	// Initialize the unhidden posts data
+	for _, entry := range data.UnhiddenPosts {
+		k.SaveUnhiddenPost(ctx, entry.SubspaceID, entry.PostID, entry.FirstReportID)
+	}
 }
@@ -62,7 +62,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 			expGenesis: types.NewGenesisState([]types.SubspaceDataEntry{
 				types.NewSubspacesDataEntry(1, 1, 2),
 				types.NewSubspacesDataEntry(2, 3, 4),
-			}, nil, nil, types.Params{}),
+			}, nil, nil, types.Params{}, nil, nil),
 		},
 		{
 			name: "reasons are exported properly",
@@ -93,7 +93,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					"Spam",
 					"This content is spam",
 				),
-			}, nil, types.Params{}),
+			}, nil, types.Params{}, nil, nil),
 		},
 		{
 			name: "reports are exported properly",
@@ -130,7 +130,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 				),
-			}, types.Params{}),
+			}, types.Params{}, nil, nil),
 		},
 		{
 			name: "params are exported properly",
@@ -151,7 +151,53 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 			},
 			expGenesis: types.NewGenesisState(nil, nil, nil, types.NewParams([]types.StandardReason{
 				types.NewStandardReason(1, "Spam", "This content is spam"),
-			})),
+			}), nil, nil),
+		},
+		{
+			name: "hiding thresholds are exported properly",
+			setup: func() {
+				subspaces := []subspacestypes.Subspace{}
+				suite.sk.EXPECT().
+					IterateSubspaces(gomock.Any(), gomock.Any()).
+					Do(func(ctx sdk.Context, fn func(subspace subspacestypes.Subspace) (stop bool)) {
+						for _, subspace := range subspaces {
+							fn(subspace)
+						}
+					})
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 3, nil))
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(2, 5, []uint32{1, 2}))
+
+				suite.k.SetParams(ctx, types.Params{})
+			},
+			expGenesis: types.NewGenesisState(nil, nil, nil, types.Params{}, []types.HidingThreshold{
+				types.NewHidingThreshold(1, 3, nil),
+				types.NewHidingThreshold(2, 5, []uint32{1, 2}),
+			}, nil),
+		},
+		{
+			name: "unhidden posts are exported properly",
+			setup: func() {
+				subspaces := []subspacestypes.Subspace{}
+				suite.sk.EXPECT().
+					IterateSubspaces(gomock.Any(), gomock.Any()).
+					Do(func(ctx sdk.Context, fn func(subspace subspacestypes.Subspace) (stop bool)) {
+						for _, subspace := range subspaces {
+							fn(subspace)
+						}
+					})
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveUnhiddenPost(ctx, 1, 1, 3)
+				suite.k.SaveUnhiddenPost(ctx, 2, 4, 7)
+
+				suite.k.SetParams(ctx, types.Params{})
+			},
+			expGenesis: types.NewGenesisState(nil, nil, nil, types.Params{}, nil, []types.UnhiddenPostEntry{
+				types.NewUnhiddenPostEntry(1, 1, 3),
+				types.NewUnhiddenPostEntry(2, 4, 7),
+			}),
 		},
 	}
 
@@ -262,6 +308,32 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 				}), stored)
 			},
 		},
+		{
+			name: "hiding thresholds are imported properly",
+			data: types.GenesisState{
+				HidingThresholds: []types.HidingThreshold{
+					types.NewHidingThreshold(1, 3, []uint32{1}),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetHidingThreshold(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewHidingThreshold(1, 3, []uint32{1}), stored)
+			},
+		},
+		{
+			name: "unhidden posts are imported properly",
+			data: types.GenesisState{
+				UnhiddenPosts: []types.UnhiddenPostEntry{
+					types.NewUnhiddenPostEntry(1, 1, 3),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				firstReportID, found := suite.k.GetUnhiddenPostFirstReportID(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(3), firstReportID)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -59,7 +59,7 @@ func (k Keeper) Reports(ctx context.Context, request *types.QueryReportsRequest)
 	reportsStore := prefix.NewStore(store, storePrefix)
 
 	var reports []types.Report
-	pageRes, err := query.Paginate(reportsStore, request.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(reportsStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var report types.Report
 
 		switch {
@@ -78,11 +78,19 @@ func (k Keeper) Reports(ctx context.Context, request *types.QueryReportsRequest)
 		}
 
 		if err != nil {
-			return err
+			return false, err
 		}
 
-		reports = append(reports, report)
-		return nil
+		// Skip all the reports having a different status if the statuses are specified
+		if len(request.Statuses) > 0 && !types.ContainsReportStatus(request.Statuses, report.Status) {
+			return false, nil
+		}
+
+		if accumulate {
+			reports = append(reports, report)
+		}
+
+		return true, nil
 	})
 
 	if err != nil {
@@ -164,6 +172,21 @@ func (k Keeper) Reason(ctx context.Context, request *types.QueryReasonRequest) (
 	return &types.QueryReasonResponse{Reason: reason}, nil
 }
 
+// HidingThreshold implements the QueryHidingThreshold gRPC method
+func (k Keeper) HidingThreshold(ctx context.Context, request *types.QueryHidingThresholdRequest) (*types.QueryHidingThresholdResponse, error) {
+	if request.SubspaceId == 0 {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	threshold, found := k.GetHidingThreshold(sdkCtx, request.SubspaceId)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "hiding threshold not found inside subspace %d", request.SubspaceId)
+	}
+
+	return &types.QueryHidingThresholdResponse{HidingThreshold: threshold}, nil
+}
+
 // Params implements the QueryParams gRPC method
 func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
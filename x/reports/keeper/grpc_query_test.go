@@ -19,7 +19,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Reports() {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			request:   types.NewQueryReportsRequest(0, nil, "", nil),
+			request:   types.NewQueryReportsRequest(0, nil, "", nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -50,6 +50,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Reports() {
 				types.NewUserTarget("cosmos1z0glns8fv5h0xgghg4nkq0jjy9gp0l682tcf79"),
 				"cosmos1ggzk8tnte9lmzgpvyzzdtmwmn6rjlct4spmjjd",
 				nil,
+				nil,
 			),
 			shouldErr: false,
 			expReports: []types.Report{
@@ -91,6 +92,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Reports() {
 				1,
 				types.NewUserTarget("cosmos1z0glns8fv5h0xgghg4nkq0jjy9gp0l682tcf79"),
 				"",
+				nil,
 				&query.PageRequest{
 					Limit: 1,
 				},
@@ -136,6 +138,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Reports() {
 				types.NewPostTarget(1),
 				"cosmos1ggzk8tnte9lmzgpvyzzdtmwmn6rjlct4spmjjd",
 				nil,
+				nil,
 			),
 			shouldErr: false,
 			expReports: []types.Report{
@@ -177,6 +180,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Reports() {
 				1,
 				types.NewPostTarget(1),
 				"",
+				nil,
 				&query.PageRequest{
 					Limit: 1,
 				},
@@ -221,6 +225,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Reports() {
 				1,
 				nil,
 				"",
+				nil,
 				&query.PageRequest{
 					Limit: 1,
 				},
@@ -238,6 +243,63 @@ func (suite *KeeperTestSuite) TestQueryServer_Reports() {
 				),
 			},
 		},
+		{
+			name: "status filtered reports request returns correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveReport(ctx, types.NewReport(
+					1,
+					1,
+					[]uint32{1},
+					"This user is spamming",
+					types.NewUserTarget("cosmos1z0glns8fv5h0xgghg4nkq0jjy9gp0l682tcf79"),
+					"cosmos1ggzk8tnte9lmzgpvyzzdtmwmn6rjlct4spmjjd",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+
+				suite.k.SaveReport(ctx, types.NewReport(
+					1,
+					2,
+					[]uint32{1},
+					"This post is spam",
+					types.NewPostTarget(1),
+					"cosmos1ggzk8tnte9lmzgpvyzzdtmwmn6rjlct4spmjjd",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				).Resolve(
+					types.ReportStatusDismissed,
+					types.NewReportResolution(
+						"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+						"This post is not spam",
+						time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					),
+				))
+			},
+			request: types.NewQueryReportsRequest(
+				1,
+				nil,
+				"",
+				[]types.ReportStatus{types.ReportStatusDismissed},
+				nil,
+			),
+			shouldErr: false,
+			expReports: []types.Report{
+				types.NewReport(
+					1,
+					2,
+					[]uint32{1},
+					"This post is spam",
+					types.NewPostTarget(1),
+					"cosmos1ggzk8tnte9lmzgpvyzzdtmwmn6rjlct4spmjjd",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				).Resolve(
+					types.ReportStatusDismissed,
+					types.NewReportResolution(
+						"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+						"This post is not spam",
+						time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					),
+				),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -485,6 +547,54 @@ func (suite *KeeperTestSuite) TestQueryServer_Reason() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryServer_HidingThreshold() {
+	testCases := []struct {
+		name         string
+		store        func(ctx sdk.Context)
+		request      *types.QueryHidingThresholdRequest
+		shouldErr    bool
+		expThreshold types.HidingThreshold
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			request:   types.NewQueryHidingThresholdRequest(0),
+			shouldErr: true,
+		},
+		{
+			name:      "not found threshold returns error",
+			request:   types.NewQueryHidingThresholdRequest(1),
+			shouldErr: true,
+		},
+		{
+			name: "valid request returns correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 5, []uint32{1, 2}))
+			},
+			request:      types.NewQueryHidingThresholdRequest(1),
+			shouldErr:    false,
+			expThreshold: types.NewHidingThreshold(1, 5, []uint32{1, 2}),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.HidingThreshold(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expThreshold, res.HidingThreshold)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryServer_Params() {
 	testCases := []struct {
 		name      string
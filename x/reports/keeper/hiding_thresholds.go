@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poststypes "github.com/desmos-labs/desmos/v7/x/posts/types"
+	"github.com/desmos-labs/desmos/v7/x/reports/types"
+	subspacestypes "github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SaveHidingThreshold saves the given hiding threshold inside the current context
+func (k Keeper) SaveHidingThreshold(ctx sdk.Context, threshold types.HidingThreshold) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HidingThresholdStoreKey(threshold.SubspaceID), k.cdc.MustMarshal(&threshold))
+
+	k.Logger(ctx).Debug("hiding threshold saved", "subspace id", threshold.SubspaceID, "threshold", threshold.Threshold)
+}
+
+// HasHidingThreshold tells whether a hiding threshold has been set for the given subspace
+func (k Keeper) HasHidingThreshold(ctx sdk.Context, subspaceID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.HidingThresholdStoreKey(subspaceID))
+}
+
+// GetHidingThreshold returns the hiding threshold of the given subspace.
+// If no threshold has been set for the subspace the function will return an empty threshold and false.
+func (k Keeper) GetHidingThreshold(ctx sdk.Context, subspaceID uint64) (threshold types.HidingThreshold, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HidingThresholdStoreKey(subspaceID))
+	if bz == nil {
+		return types.HidingThreshold{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &threshold)
+	return threshold, true
+}
+
+// DeleteHidingThreshold deletes the hiding threshold of the given subspace
+func (k Keeper) DeleteHidingThreshold(ctx sdk.Context, subspaceID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.HidingThresholdStoreKey(subspaceID))
+}
+
+// removeHidingThresholdReason removes the given reason from the hiding threshold of the subspace.
+// If the threshold was limited to the removed reason only, the threshold itself is deleted
+// so that it does not start counting reports having any reason.
+func (k Keeper) removeHidingThresholdReason(ctx sdk.Context, subspaceID uint64, reasonID uint32) {
+	threshold, found := k.GetHidingThreshold(ctx, subspaceID)
+	if !found || !types.ContainsReason(threshold.ReasonsIDs, reasonID) {
+		return
+	}
+
+	var reasonsIDs []uint32
+	for _, id := range threshold.ReasonsIDs {
+		if id != reasonID {
+			reasonsIDs = append(reasonsIDs, id)
+		}
+	}
+
+	if len(reasonsIDs) == 0 {
+		k.DeleteHidingThreshold(ctx, subspaceID)
+		return
+	}
+
+	k.SaveHidingThreshold(ctx, types.NewHidingThreshold(threshold.SubspaceID, threshold.Threshold, reasonsIDs))
+}
+
+// SaveUnhiddenPost stores the id of the first report that counts towards hiding again the given post
+// after it has been unhidden by a moderator
+func (k Keeper) SaveUnhiddenPost(ctx sdk.Context, subspaceID uint64, postID uint64, firstReportID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UnhiddenPostStoreKey(subspaceID, postID), types.GetReportIDBytes(firstReportID))
+}
+
+// GetUnhiddenPostFirstReportID returns the id of the first report that counts towards hiding again the given post.
+// If the post has never been unhidden, false is returned instead
+func (k Keeper) GetUnhiddenPostFirstReportID(ctx sdk.Context, subspaceID uint64, postID uint64) (firstReportID uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UnhiddenPostStoreKey(subspaceID, postID))
+	if bz == nil {
+		return 0, false
+	}
+	return types.GetReportIDFromBytes(bz), true
+}
+
+// DeleteUnhiddenPost deletes the unhidden post data of the given post
+func (k Keeper) DeleteUnhiddenPost(ctx sdk.Context, subspaceID uint64, postID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UnhiddenPostStoreKey(subspaceID, postID))
+}
+
+// countHidingReports returns the number of reports of the given post that count towards the provided threshold.
+// If the post has been unhidden, only the reports created after that count towards the threshold
+func (k Keeper) countHidingReports(ctx sdk.Context, threshold types.HidingThreshold, postID uint64) (count uint32) {
+	firstReportID, _ := k.GetUnhiddenPostFirstReportID(ctx, threshold.SubspaceID, postID)
+	k.IteratePostReports(ctx, threshold.SubspaceID, postID, func(report types.Report) (stop bool) {
+		if report.ID >= firstReportID && threshold.CountsReport(report) {
+			count++
+		}
+		return false
+	})
+	return count
+}
+
+// hidePostIfThresholdReached hides the post reported with the given report if the number of
+// reports it has received has reached the hiding threshold set for its subspace
+func (k Keeper) hidePostIfThresholdReached(ctx sdk.Context, report types.Report, postID uint64) {
+	threshold, found := k.GetHidingThreshold(ctx, report.SubspaceID)
+	if !found || !threshold.CountsReport(report) {
+		return
+	}
+
+	if k.IsPostHidden(ctx, report.SubspaceID, postID) {
+		return
+	}
+
+	count := k.countHidingReports(ctx, threshold, postID)
+	if count < threshold.Threshold {
+		return
+	}
+
+	k.HidePost(ctx, report.SubspaceID, postID)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeHiddenPost,
+		sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, fmt.Sprintf("%d", report.SubspaceID)),
+		sdk.NewAttribute(poststypes.AttributeKeyPostID, fmt.Sprintf("%d", postID)),
+		sdk.NewAttribute(types.AttributeKeyReportsCount, fmt.Sprintf("%d", count)),
+	))
+}
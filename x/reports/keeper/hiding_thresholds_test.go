@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/reports/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveHidingThreshold() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		threshold types.HidingThreshold
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "non existing threshold is stored properly",
+			threshold: types.NewHidingThreshold(1, 5, []uint32{1}),
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetHidingThreshold(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewHidingThreshold(1, 5, []uint32{1}), stored)
+			},
+		},
+		{
+			name: "existing threshold is overridden properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 5, []uint32{1}))
+			},
+			threshold: types.NewHidingThreshold(1, 10, nil),
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetHidingThreshold(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewHidingThreshold(1, 10, nil), stored)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SaveHidingThreshold(ctx, tc.threshold)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_HasHidingThreshold() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		expResult  bool
+	}{
+		{
+			name:       "non existing threshold returns false",
+			subspaceID: 1,
+			expResult:  false,
+		},
+		{
+			name: "existing threshold returns true",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 5, []uint32{1}))
+			},
+			subspaceID: 1,
+			expResult:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			result := suite.k.HasHidingThreshold(ctx, tc.subspaceID)
+			suite.Require().Equal(tc.expResult, result)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetHidingThreshold() {
+	testCases := []struct {
+		name         string
+		store        func(ctx sdk.Context)
+		subspaceID   uint64
+		expFound     bool
+		expThreshold types.HidingThreshold
+	}{
+		{
+			name:         "non existing threshold returns false and empty threshold",
+			subspaceID:   1,
+			expFound:     false,
+			expThreshold: types.HidingThreshold{},
+		},
+		{
+			name: "existing threshold is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 5, []uint32{1}))
+			},
+			subspaceID:   1,
+			expFound:     true,
+			expThreshold: types.NewHidingThreshold(1, 5, []uint32{1}),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			threshold, found := suite.k.GetHidingThreshold(ctx, tc.subspaceID)
+			suite.Require().Equal(tc.expFound, found)
+			suite.Require().Equal(tc.expThreshold, threshold)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteHidingThreshold() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing threshold is deleted properly",
+			subspaceID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasHidingThreshold(ctx, 1))
+			},
+		},
+		{
+			name: "existing threshold is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 5, []uint32{1}))
+			},
+			subspaceID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasHidingThreshold(ctx, 1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeleteHidingThreshold(ctx, tc.subspaceID)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
@@ -31,6 +31,12 @@ var _ types.MsgServer = &msgServer{}
 func (k msgServer) CreateReport(goCtx context.Context, msg *types.MsgCreateReport) (*types.MsgCreateReportResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the reporter has a profile
 	if !k.HasProfile(ctx, msg.Reporter) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "you cannot create a report without having a profile")
@@ -41,6 +47,11 @@ func (k msgServer) CreateReport(goCtx context.Context, msg *types.MsgCreateRepor
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the reasons exist
 	for _, reasonID := range msg.ReasonsIDs {
 		if !k.HasReason(ctx, msg.SubspaceID, reasonID) {
@@ -132,11 +143,22 @@ func (k msgServer) CreateReport(goCtx context.Context, msg *types.MsgCreateRepor
 func (k msgServer) DeleteReport(goCtx context.Context, msg *types.MsgDeleteReport) (*types.MsgDeleteReportResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the report exists
 	report, found := k.GetReport(ctx, msg.SubspaceID, msg.ReportID)
 	if !found {
@@ -164,15 +186,84 @@ func (k msgServer) DeleteReport(goCtx context.Context, msg *types.MsgDeleteRepor
 	return &types.MsgDeleteReportResponse{}, nil
 }
 
+// ResolveReport defines the rpc method for Msg/ResolveReport
+func (k msgServer) ResolveReport(goCtx context.Context, msg *types.MsgResolveReport) (*types.MsgResolveReportResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
+	// Check if the report exists
+	report, found := k.GetReport(ctx, msg.SubspaceID, msg.ReportID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "report with id %d not found inside subspace %d", msg.ReportID, msg.SubspaceID)
+	}
+
+	// Check the permission to manage reports
+	if !k.HasPermission(ctx, msg.SubspaceID, msg.Signer, types.PermissionManageReports) {
+		return nil, errors.Wrap(subspacestypes.ErrPermissionDenied, "you cannot resolve reports inside this subspace")
+	}
+
+	// Update the report and validate it
+	resolution := types.NewReportResolution(msg.Signer, msg.Note, ctx.BlockTime())
+	updatedReport := report.Resolve(msg.Status, resolution)
+	err = updatedReport.Validate()
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Store the report
+	k.SaveReport(ctx, updatedReport)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeResolvedReport,
+			sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyReportID, fmt.Sprintf("%d", msg.ReportID)),
+			sdk.NewAttribute(types.AttributeKeyResolver, msg.Signer),
+			sdk.NewAttribute(types.AttributeKeyReportStatus, msg.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyResolutionTime, resolution.ResolutionDate.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgResolveReportResponse{
+		ResolutionDate: resolution.ResolutionDate,
+	}, nil
+}
+
 // SupportStandardReason defines the rpc method for Msg/SupportStandardReason
 func (k msgServer) SupportStandardReason(goCtx context.Context, msg *types.MsgSupportStandardReason) (*types.MsgSupportStandardReasonResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the standard reason exists
 	standardReason, found := k.GetStandardReason(ctx, msg.StandardReasonID)
 	if !found {
@@ -221,11 +312,22 @@ func (k msgServer) SupportStandardReason(goCtx context.Context, msg *types.MsgSu
 func (k msgServer) AddReason(goCtx context.Context, msg *types.MsgAddReason) (*types.MsgAddReasonResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check the permission to manage reasons
 	if !k.HasPermission(ctx, msg.SubspaceID, msg.Signer, types.PermissionManageReasons) {
 		return nil, errors.Wrap(subspacestypes.ErrPermissionDenied, "you cannot manage reasons inside this subspace")
@@ -267,11 +369,22 @@ func (k msgServer) AddReason(goCtx context.Context, msg *types.MsgAddReason) (*t
 func (k msgServer) RemoveReason(goCtx context.Context, msg *types.MsgRemoveReason) (*types.MsgRemoveReasonResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the subspace exists
 	if !k.HasSubspace(ctx, msg.SubspaceID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the reason exists
 	if !k.HasReason(ctx, msg.SubspaceID, msg.ReasonID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "reason with id %d does not existing inside subspace %d", msg.ReasonID, msg.SubspaceID)
@@ -296,6 +409,64 @@ func (k msgServer) RemoveReason(goCtx context.Context, msg *types.MsgRemoveReaso
 	return &types.MsgRemoveReasonResponse{}, nil
 }
 
+// SetHidingThreshold defines the rpc method for Msg/SetHidingThreshold
+func (k msgServer) SetHidingThreshold(goCtx context.Context, msg *types.MsgSetHidingThreshold) (*types.MsgSetHidingThresholdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Consume the subspace rate limits
+	err := k.sk.ConsumeMsgRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(subspacestypes.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
+	// Check the permission to manage reports
+	if !k.HasPermission(ctx, msg.SubspaceID, msg.Signer, types.PermissionManageReports) {
+		return nil, errors.Wrap(subspacestypes.ErrPermissionDenied, "you cannot manage the hiding threshold inside this subspace")
+	}
+
+	// Check if all the reasons exist
+	for _, reasonID := range msg.ReasonsIDs {
+		if !k.HasReason(ctx, msg.SubspaceID, reasonID) {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "reason with id %d does not exist inside subspace %d", reasonID, msg.SubspaceID)
+		}
+	}
+
+	if msg.Threshold == 0 {
+		// A zero threshold disables the automatic hiding
+		k.DeleteHidingThreshold(ctx, msg.SubspaceID)
+	} else {
+		// Create the threshold and validate it
+		threshold := types.NewHidingThreshold(msg.SubspaceID, msg.Threshold, msg.ReasonsIDs)
+		err := threshold.Validate()
+		if err != nil {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		// Store the threshold
+		k.SaveHidingThreshold(ctx, threshold)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetHidingThreshold,
+			sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", msg.Threshold)),
+		),
+	})
+
+	return &types.MsgSetHidingThresholdResponse{}, nil
+}
+
 // UpdateParams updates the module parameters
 func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	authority := m.authority
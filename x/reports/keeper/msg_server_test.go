@@ -17,16 +17,29 @@ import (
 
 func (suite *KeeperTestSuite) TestMsgServer_CreateReport() {
 	testCases := []struct {
-		name        string
-		setup       func()
-		setupCtx    func(ctx sdk.Context) sdk.Context
-		store       func(ctx sdk.Context)
-		msg         *types.MsgCreateReport
-		shouldErr   bool
-		expResponse *types.MsgCreateReportResponse
-		expEvents   sdk.Events
-		check       func(ctx sdk.Context)
+		name         string
+		setup        func()
+		rateLimitErr error
+		setupCtx     func(ctx sdk.Context) sdk.Context
+		store        func(ctx sdk.Context)
+		msg          *types.MsgCreateReport
+		shouldErr    bool
+		expResponse  *types.MsgCreateReportResponse
+		expEvents    sdk.Events
+		check        func(ctx sdk.Context)
 	}{
+		{
+			name:         "exceeded rate limit returns error",
+			rateLimitErr: subspacestypes.ErrRateLimitExceeded,
+			msg: types.NewMsgCreateReport(
+				1,
+				[]uint32{1},
+				"This content is spam!",
+				types.NewUserTarget("cosmos1ggzk8tnte9lmzgpvyzzdtmwmn6rjlct4spmjjd"),
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: true,
+		},
 		{
 			name: "user without profile returns error",
 			setup: func() {
@@ -63,6 +76,30 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateReport() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "archived subspace returns error",
+			setup: func() {
+				suite.ak.EXPECT().
+					HasProfile(gomock.Any(), "cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh").
+					Return(true)
+
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(true)
+			},
+			msg: types.NewMsgCreateReport(
+				1,
+				[]uint32{1},
+				"This content is spam!",
+				types.NewUserTarget("cosmos1ggzk8tnte9lmzgpvyzzdtmwmn6rjlct4spmjjd"),
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: true,
+		},
 		{
 			name: "non existing reason returns error",
 			setup: func() {
@@ -73,6 +110,10 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateReport() {
 				suite.sk.EXPECT().
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
 			},
 			store: func(ctx sdk.Context) {
 				suite.k.SetNextReportID(ctx, 1, 1)
@@ -97,6 +138,10 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateReport() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -137,6 +182,10 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateReport() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -180,6 +229,10 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateReport() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -233,6 +286,10 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateReport() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -310,6 +367,10 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateReport() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -337,8 +398,13 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateReport() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						nil,
 					), true)
 
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
 				suite.rk.
 					EXPECT().
 					HasUserBlocked(
@@ -411,6 +477,8 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateReport() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(tc.rateLimitErr)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			res, err := msgServer.CreateReport(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
@@ -457,6 +525,10 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteReport() {
 				suite.sk.EXPECT().
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
 			},
 			msg: types.NewMsgDeleteReport(
 				1,
@@ -472,6 +544,10 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteReport() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -507,6 +583,10 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteReport() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -552,6 +632,10 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteReport() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -604,6 +688,10 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteReport() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -652,6 +740,8 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteReport() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			_, err := msgServer.DeleteReport(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
@@ -663,6 +753,251 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteReport() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgServer_ResolveReport() {
+	testCases := []struct {
+		name        string
+		setup       func()
+		setupCtx    func(ctx sdk.Context) sdk.Context
+		store       func(ctx sdk.Context)
+		msg         *types.MsgResolveReport
+		shouldErr   bool
+		expResponse *types.MsgResolveReportResponse
+		expEvents   sdk.Events
+		check       func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing subspace returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(false)
+			},
+			msg: types.NewMsgResolveReport(
+				1,
+				1,
+				types.ReportStatusDismissed,
+				"This content is not spam",
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing report returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+			},
+			msg: types.NewMsgResolveReport(
+				1,
+				1,
+				types.ReportStatusDismissed,
+				"This content is not spam",
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
+				suite.sk.EXPECT().
+					HasPermission(
+						gomock.Any(),
+						uint64(1),
+						uint32(subspacestypes.RootSectionID),
+						"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+						types.PermissionManageReports,
+					).
+					Return(false)
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveReport(ctx, types.NewReport(
+					1,
+					1,
+					[]uint32{1},
+					"This content is spam",
+					types.NewUserTarget("cosmos1pjffdtweghpyxru9alssyqtdkq8mn6sepgstgm"),
+					"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			msg: types.NewMsgResolveReport(
+				1,
+				1,
+				types.ReportStatusDismissed,
+				"This content is not spam",
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid resolution date returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
+				suite.sk.EXPECT().
+					HasPermission(
+						gomock.Any(),
+						uint64(1),
+						uint32(subspacestypes.RootSectionID),
+						"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+						types.PermissionManageReports,
+					).
+					Return(true)
+			},
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2019, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveReport(ctx, types.NewReport(
+					1,
+					1,
+					[]uint32{1},
+					"This content is spam",
+					types.NewUserTarget("cosmos1pjffdtweghpyxru9alssyqtdkq8mn6sepgstgm"),
+					"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			msg: types.NewMsgResolveReport(
+				1,
+				1,
+				types.ReportStatusDismissed,
+				"This content is not spam",
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "moderator can resolve the report properly",
+			setup: func() {
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
+				suite.sk.EXPECT().
+					HasPermission(
+						gomock.Any(),
+						uint64(1),
+						uint32(subspacestypes.RootSectionID),
+						"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+						types.PermissionManageReports,
+					).
+					Return(true)
+			},
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveReport(ctx, types.NewReport(
+					1,
+					1,
+					[]uint32{1},
+					"This content is spam",
+					types.NewUserTarget("cosmos1pjffdtweghpyxru9alssyqtdkq8mn6sepgstgm"),
+					"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			msg: types.NewMsgResolveReport(
+				1,
+				1,
+				types.ReportStatusDismissed,
+				"This content is not spam",
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: false,
+			expResponse: &types.MsgResolveReportResponse{
+				ResolutionDate: time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+			},
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeResolvedReport,
+					sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyReportID, "1"),
+					sdk.NewAttribute(types.AttributeKeyResolver, "cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh"),
+					sdk.NewAttribute(types.AttributeKeyReportStatus, types.ReportStatusDismissed.String()),
+					sdk.NewAttribute(types.AttributeKeyResolutionTime, "2020-01-02T12:00:00Z"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				report, found := suite.k.GetReport(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewReport(
+					1,
+					1,
+					[]uint32{1},
+					"This content is spam",
+					types.NewUserTarget("cosmos1pjffdtweghpyxru9alssyqtdkq8mn6sepgstgm"),
+					"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				).Resolve(
+					types.ReportStatusDismissed,
+					types.NewReportResolution(
+						"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+						"This content is not spam",
+						time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					),
+				), report)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.setupCtx != nil {
+				ctx = tc.setupCtx(ctx)
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
+			msgServer := keeper.NewMsgServerImpl(suite.k)
+			res, err := msgServer.ResolveReport(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResponse, res)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgServer_SupportStandardReason() {
 	testCases := []struct {
 		name        string
@@ -694,6 +1029,10 @@ func (suite *KeeperTestSuite) TestMsgServer_SupportStandardReason() {
 				suite.sk.EXPECT().
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
 			},
 			store: func(ctx sdk.Context) {
 				suite.k.SetParams(ctx, types.NewParams(nil))
@@ -712,6 +1051,10 @@ func (suite *KeeperTestSuite) TestMsgServer_SupportStandardReason() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -741,6 +1084,10 @@ func (suite *KeeperTestSuite) TestMsgServer_SupportStandardReason() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -770,6 +1117,10 @@ func (suite *KeeperTestSuite) TestMsgServer_SupportStandardReason() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -823,6 +1174,8 @@ func (suite *KeeperTestSuite) TestMsgServer_SupportStandardReason() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			res, err := msgServer.SupportStandardReason(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
@@ -873,6 +1226,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReason() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -898,6 +1255,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReason() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -923,6 +1284,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReason() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -951,6 +1316,10 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReason() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -1000,6 +1369,8 @@ func (suite *KeeperTestSuite) TestMsgServer_AddReason() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			res, err := msgServer.AddReason(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
@@ -1042,6 +1413,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReason() {
 				suite.sk.EXPECT().
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
 			},
 			msg: types.NewMsgRemoveReason(
 				1,
@@ -1057,6 +1432,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReason() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -1089,6 +1468,10 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReason() {
 					HasSubspace(gomock.Any(), uint64(1)).
 					Return(true)
 
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
 				suite.sk.EXPECT().
 					HasPermission(
 						gomock.Any(),
@@ -1134,6 +1517,8 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReason() {
 				tc.store(ctx)
 			}
 
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
 			msgServer := keeper.NewMsgServerImpl(suite.k)
 			_, err := msgServer.RemoveReason(sdk.WrapSDKContext(ctx), tc.msg)
 			if tc.shouldErr {
@@ -1146,6 +1531,210 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveReason() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgServer_SetHidingThreshold() {
+	testCases := []struct {
+		name      string
+		setup     func()
+		store     func(ctx sdk.Context)
+		msg       *types.MsgSetHidingThreshold
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing subspace returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(false)
+			},
+			msg: types.NewMsgSetHidingThreshold(
+				1,
+				5,
+				[]uint32{1},
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
+				suite.sk.EXPECT().
+					HasPermission(
+						gomock.Any(),
+						uint64(1),
+						uint32(subspacestypes.RootSectionID),
+						"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+						types.PermissionManageReports,
+					).
+					Return(false)
+			},
+			msg: types.NewMsgSetHidingThreshold(
+				1,
+				5,
+				[]uint32{1},
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing reason returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
+				suite.sk.EXPECT().
+					HasPermission(
+						gomock.Any(),
+						uint64(1),
+						uint32(subspacestypes.RootSectionID),
+						"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+						types.PermissionManageReports,
+					).
+					Return(true)
+			},
+			msg: types.NewMsgSetHidingThreshold(
+				1,
+				5,
+				[]uint32{1},
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid request sets the threshold properly",
+			setup: func() {
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
+				suite.sk.EXPECT().
+					HasPermission(
+						gomock.Any(),
+						uint64(1),
+						uint32(subspacestypes.RootSectionID),
+						"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+						types.PermissionManageReports,
+					).
+					Return(true)
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveReason(ctx, types.NewReason(
+					1,
+					1,
+					"Spam",
+					"This content is spam",
+				))
+			},
+			msg: types.NewMsgSetHidingThreshold(
+				1,
+				5,
+				[]uint32{1},
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSetHidingThreshold,
+					sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyThreshold, "5"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetHidingThreshold(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewHidingThreshold(1, 5, []uint32{1}), stored)
+			},
+		},
+		{
+			name: "zero threshold deletes the existing one",
+			setup: func() {
+				suite.sk.EXPECT().
+					HasSubspace(gomock.Any(), uint64(1)).
+					Return(true)
+
+				suite.sk.EXPECT().
+					IsSubspaceArchived(gomock.Any(), uint64(1)).
+					Return(false)
+
+				suite.sk.EXPECT().
+					HasPermission(
+						gomock.Any(),
+						uint64(1),
+						uint32(subspacestypes.RootSectionID),
+						"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+						types.PermissionManageReports,
+					).
+					Return(true)
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 5, nil))
+			},
+			msg: types.NewMsgSetHidingThreshold(
+				1,
+				0,
+				nil,
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSetHidingThreshold,
+					sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyThreshold, "0"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasHidingThreshold(ctx, 1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.sk.EXPECT().ConsumeMsgRateLimits(gomock.Any(), tc.msg).Return(nil)
+
+			msgServer := keeper.NewMsgServerImpl(suite.k)
+			_, err := msgServer.SetHidingThreshold(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgServer_UpdateParams() {
 	testCases := []struct {
 		name      string
@@ -87,6 +87,9 @@ func (k Keeper) DeleteReason(ctx sdk.Context, subspaceID uint64, reasonID uint32
 		return false
 	})
 
+	// Remove the reason from the hiding threshold
+	k.removeHidingThresholdReason(ctx, subspaceID, reasonID)
+
 	k.Logger(ctx).Debug("reason deleted", "subspace id", reason.SubspaceID, "id", reason.ID)
 	k.AfterReasonDeleted(ctx, reason.SubspaceID, reason.ID)
 }
@@ -361,6 +361,42 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteReason() {
 				suite.Require().False(suite.k.HasReport(ctx, 1, 1))
 			},
 		},
+		{
+			name: "deleting a reason removes it from the hiding threshold",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveReason(ctx, types.NewReason(
+					1,
+					1,
+					"Spam",
+					"This content is spam",
+				))
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 5, []uint32{1, 2}))
+			},
+			subspaceID: 1,
+			reasonID:   1,
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetHidingThreshold(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewHidingThreshold(1, 5, []uint32{2}), stored)
+			},
+		},
+		{
+			name: "deleting the only reason of the hiding threshold deletes the threshold",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveReason(ctx, types.NewReason(
+					1,
+					1,
+					"Spam",
+					"This content is spam",
+				))
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 5, []uint32{1}))
+			},
+			subspaceID: 1,
+			reasonID:   1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasHidingThreshold(ctx, 1))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
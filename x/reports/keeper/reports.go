@@ -53,6 +53,7 @@ func (k Keeper) validateUserReportContent(ctx sdk.Context, report types.Report,
 
 // validatePostReportContent validates the given post report making sure that:
 // - the post exists inside the given subspace
+// - the post has already been published
 // - the post author has not blocked the reporter
 func (k Keeper) validatePostReportContent(ctx sdk.Context, report types.Report, data *types.PostTarget) error {
 	post, found := k.GetPost(ctx, report.SubspaceID, data.PostID)
@@ -60,6 +61,10 @@ func (k Keeper) validatePostReportContent(ctx sdk.Context, report types.Report,
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "post %d does not exist inside subspace %d", data.PostID, report.SubspaceID)
 	}
 
+	if k.IsPostPending(ctx, report.SubspaceID, data.PostID) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "post %d has not been published yet", data.PostID)
+	}
+
 	if k.HasUserBlocked(ctx, post.Owner, report.Reporter, report.ID) {
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "post author has blocked you on this subspace")
 	}
@@ -113,10 +118,16 @@ func (k Keeper) SaveReport(ctx sdk.Context, report types.Report) {
 
 	// Set the reference for the content
 	contentKey := k.getContentKey(report.SubspaceID, report.Target.GetCachedValue().(types.ReportTarget), report.Reporter)
+	isNewReport := !store.Has(contentKey)
 	store.Set(contentKey, types.GetReportIDBytes(report.ID))
 
 	k.Logger(ctx).Debug("report saved", "subspace id", report.SubspaceID, "id", report.ID)
 	k.AfterReportSaved(ctx, report.SubspaceID, report.ID)
+
+	// Hide the reported post if it has received too many reports
+	if target, ok := report.Target.GetCachedValue().(*types.PostTarget); ok && isNewReport {
+		k.hidePostIfThresholdReached(ctx, report, target.PostID)
+	}
 }
 
 // HasReport tells whether the given report exists or not
@@ -6,6 +6,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	poststypes "github.com/desmos-labs/desmos/v7/x/posts/types"
+	subspacestypes "github.com/desmos-labs/desmos/v7/x/subspaces/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -224,6 +225,44 @@ func (suite *KeeperTestSuite) TestKeeper_ValidateReport() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "PostTarget - pending post returns error",
+			setup: func() {
+				suite.pk.EXPECT().
+					GetPost(gomock.Any(), uint64(1), uint64(1)).
+					Return(poststypes.NewPost(
+						1,
+						0,
+						1,
+						"",
+						"This is a new post",
+						"cosmos10s22qjua2n3law0ymstm3txm7764mfk2cjawq5",
+						0,
+						nil,
+						nil,
+						nil,
+						poststypes.REPLY_SETTING_EVERYONE,
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						nil,
+						"cosmos10s22qjua2n3law0ymstm3txm7764mfk2cjawq5",
+						nil,
+					), true)
+
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(true)
+			},
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"This user is spamming",
+				types.NewPostTarget(1),
+				"cosmos1wprgptc8ktt0eemrn2znpxv8crdxm8tdpkdr7w",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			shouldErr: true,
+		},
 		{
 			name: "PostTarget - blocked user returns error",
 			setup: func() {
@@ -244,8 +283,13 @@ func (suite *KeeperTestSuite) TestKeeper_ValidateReport() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos10s22qjua2n3law0ymstm3txm7764mfk2cjawq5",
+						nil,
 					), true)
 
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
 				suite.rk.EXPECT().
 					HasUserBlocked(gomock.Any(),
 						"cosmos10s22qjua2n3law0ymstm3txm7764mfk2cjawq5",
@@ -285,8 +329,13 @@ func (suite *KeeperTestSuite) TestKeeper_ValidateReport() {
 						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 						nil,
 						"cosmos10s22qjua2n3law0ymstm3txm7764mfk2cjawq5",
+						nil,
 					), true)
 
+				suite.pk.EXPECT().
+					IsPostPending(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
 				suite.rk.EXPECT().
 					HasUserBlocked(gomock.Any(),
 						"cosmos10s22qjua2n3law0ymstm3txm7764mfk2cjawq5",
@@ -328,10 +377,12 @@ func (suite *KeeperTestSuite) TestKeeper_ValidateReport() {
 
 func (suite *KeeperTestSuite) TestKeeper_SaveReport() {
 	testCases := []struct {
-		name   string
-		store  func(ctx sdk.Context)
-		report types.Report
-		check  func(ctx sdk.Context)
+		name      string
+		setup     func()
+		store     func(ctx sdk.Context)
+		report    types.Report
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
 	}{
 		{
 			name: "post report is stored properly",
@@ -391,17 +442,182 @@ func (suite *KeeperTestSuite) TestKeeper_SaveReport() {
 				suite.Require().True(store.Has(types.UserReportStoreKey(1, "cosmos1pjffdtweghpyxru9alssyqtdkq8mn6sepgstgm", "cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z")))
 			},
 		},
+		{
+			name: "post report below the hiding threshold does not hide the post",
+			setup: func() {
+				suite.pk.EXPECT().
+					IsPostHidden(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 2, nil))
+			},
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"This content is spam",
+				types.NewPostTarget(1),
+				"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			expEvents: sdk.EmptyEvents(),
+		},
+		{
+			name: "post report with a reason not counted does not hide the post",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 1, []uint32{2}))
+			},
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"This content is spam",
+				types.NewPostTarget(1),
+				"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			expEvents: sdk.EmptyEvents(),
+		},
+		{
+			name: "post report on an already hidden post does not hide it again",
+			setup: func() {
+				suite.pk.EXPECT().
+					IsPostHidden(gomock.Any(), uint64(1), uint64(1)).
+					Return(true)
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 1, nil))
+			},
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"This content is spam",
+				types.NewPostTarget(1),
+				"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			expEvents: sdk.EmptyEvents(),
+		},
+		{
+			name: "post report reaching the hiding threshold hides the post",
+			setup: func() {
+				suite.pk.EXPECT().
+					IsPostHidden(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+
+				suite.pk.EXPECT().
+					HidePost(gomock.Any(), uint64(1), uint64(1))
+			},
+			store: func(ctx sdk.Context) {
+				// Dismissed reports should not be counted
+				suite.k.SaveReport(ctx, types.NewReport(
+					1,
+					1,
+					[]uint32{1},
+					"This content is spam",
+					types.NewPostTarget(1),
+					"cosmos1pjffdtweghpyxru9alssyqtdkq8mn6sepgstgm",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				).Resolve(types.REPORT_STATUS_DISMISSED, types.NewReportResolution(
+					"cosmos1pjffdtweghpyxru9alssyqtdkq8mn6sepgstgm",
+					"",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				)))
+
+				suite.k.SaveReport(ctx, types.NewReport(
+					1,
+					2,
+					[]uint32{1},
+					"This content is spam",
+					types.NewPostTarget(1),
+					"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 2, []uint32{1}))
+			},
+			report: types.NewReport(
+				1,
+				3,
+				[]uint32{1},
+				"This content is spam",
+				types.NewPostTarget(1),
+				"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeHiddenPost,
+					sdk.NewAttribute(subspacestypes.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(poststypes.AttributeKeyPostID, "1"),
+					sdk.NewAttribute(types.AttributeKeyReportsCount, "2"),
+				),
+			},
+		},
+		{
+			name: "post report after the post has been unhidden only counts the newer reports",
+			setup: func() {
+				suite.pk.EXPECT().
+					IsPostHidden(gomock.Any(), uint64(1), uint64(1)).
+					Return(false)
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveReport(ctx, types.NewReport(
+					1,
+					1,
+					[]uint32{1},
+					"This content is spam",
+					types.NewPostTarget(1),
+					"cosmos1pjffdtweghpyxru9alssyqtdkq8mn6sepgstgm",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+
+				suite.k.SaveReport(ctx, types.NewReport(
+					1,
+					2,
+					[]uint32{1},
+					"This content is spam",
+					types.NewPostTarget(1),
+					"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 2, []uint32{1}))
+
+				// The post was hidden by the two reports above and then unhidden by a moderator
+				suite.k.SaveUnhiddenPost(ctx, 1, 1, 3)
+			},
+			report: types.NewReport(
+				1,
+				3,
+				[]uint32{1},
+				"This content is spam",
+				types.NewPostTarget(1),
+				"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			expEvents: sdk.EmptyEvents(),
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
 			ctx, _ := suite.ctx.CacheContext()
+			if tc.setup != nil {
+				tc.setup()
+			}
 			if tc.store != nil {
 				tc.store(ctx)
 			}
 
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			suite.k.SaveReport(ctx, tc.report)
+			if tc.expEvents != nil {
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+			}
 			if tc.check != nil {
 				tc.check(ctx)
 			}
@@ -51,6 +51,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &reasonB)
 			return fmt.Sprintf("ReasonA: %s\nReasonB: %s\n", &reasonA, &reasonB)
 
+		case bytes.HasPrefix(kvA.Key, types.HidingThresholdPrefix):
+			var thresholdA, thresholdB types.HidingThreshold
+			cdc.MustUnmarshal(kvA.Value, &thresholdA)
+			cdc.MustUnmarshal(kvB.Value, &thresholdB)
+			return fmt.Sprintf("HidingThresholdA: %s\nHidingThresholdB: %s\n", &thresholdA, &thresholdB)
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -33,6 +33,7 @@ func TestDecodeStore(t *testing.T) {
 		"Spam",
 		"This content is spam",
 	)
+	threshold := types.NewHidingThreshold(1, 5, []uint32{1})
 
 	kvPairs := kv.Pairs{Pairs: []kv.Pair{
 		{
@@ -59,6 +60,10 @@ func TestDecodeStore(t *testing.T) {
 			Key:   types.ReasonStoreKey(1, 1),
 			Value: cdc.MustMarshal(&reason),
 		},
+		{
+			Key:   types.HidingThresholdStoreKey(1),
+			Value: cdc.MustMarshal(&threshold),
+		},
 		{
 			Key:   []byte("Unknown key"),
 			Value: nil,
@@ -81,6 +86,8 @@ func TestDecodeStore(t *testing.T) {
 			1, 1)},
 		{"Reason", fmt.Sprintf("ReasonA: %s\nReasonB: %s\n",
 			&reason, &reason)},
+		{"Hiding Threshold", fmt.Sprintf("HidingThresholdA: %s\nHidingThresholdB: %s\n",
+			&threshold, &threshold)},
 		{"other", ""},
 	}
 
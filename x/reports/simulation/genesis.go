@@ -41,7 +41,7 @@ func RandomizeGenState(simState *module.SimulationState) {
 	params := types.NewParams(GetRandomStandardReasons(simState.Rand, 10))
 
 	// Create the genesis and sanitize it
-	reportsGenesis := types.NewGenesisState(subspacesDataEntries, reasons, reports, params)
+	reportsGenesis := types.NewGenesisState(subspacesDataEntries, reasons, reports, params, nil, nil)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(reportsGenesis)
 }
 
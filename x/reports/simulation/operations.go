@@ -22,9 +22,11 @@ import (
 const (
 	OpWeightMsgCreateReport          = "op_weight_msg_create_report"
 	OpWeightMsgDeleteReport          = "op_weight_msg_delete_report"
+	OpWeightMsgResolveReport         = "op_weight_msg_resolve_report"
 	OpWeightMsgSupportStandardReason = "op_weight_msg_support_standard_reason"
 	OpWeightMsgAddReason             = "op_weight_msg_add_reason"
 	OpWeightMsgRemoveReason          = "op_weight_msg_remove_reason"
+	OpWeightMsgSetHidingThreshold    = "op_weight_msg_set_hiding_threshold"
 
 	DefaultGasValue = 200_000
 )
@@ -50,6 +52,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgResolveReport int
+	appParams.GetOrGenerate(cdc, OpWeightMsgResolveReport, &weightMsgResolveReport, nil,
+		func(_ *rand.Rand) {
+			weightMsgResolveReport = params.DefaultWeightMsgResolveReport
+		},
+	)
+
 	var weightMsgSupportStandardReason int
 	appParams.GetOrGenerate(cdc, OpWeightMsgSupportStandardReason, &weightMsgSupportStandardReason, nil,
 		func(_ *rand.Rand) {
@@ -71,6 +80,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgSetHidingThreshold int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetHidingThreshold, &weightMsgSetHidingThreshold, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetHidingThreshold = params.DefaultWeightMsgSetHidingThreshold
+		},
+	)
+
 	return sim.WeightedOperations{
 		sim.NewWeightedOperation(
 			weightMsgCreateReport,
@@ -80,6 +96,10 @@ func WeightedOperations(
 			weightMsgDeleteReport,
 			SimulateMsgDeleteReport(k, sk, ak, bk),
 		),
+		sim.NewWeightedOperation(
+			weightMsgResolveReport,
+			SimulateMsgResolveReport(k, sk, ak, bk),
+		),
 		sim.NewWeightedOperation(
 			weightMsgSupportStandardReason,
 			SimulateMsgSupportStandardReason(k, sk, ak, bk),
@@ -92,5 +112,9 @@ func WeightedOperations(
 			weightMsgRemoveReason,
 			SimulateMsgRemoveReason(k, sk, ak, bk),
 		),
+		sim.NewWeightedOperation(
+			weightMsgSetHidingThreshold,
+			SimulateMsgSetHidingThreshold(k, sk, ak, bk),
+		),
 	}
 }
@@ -65,6 +65,11 @@ func randomSupportStandardReasonFields(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID = subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a reason
 	reasons := k.GetParams(ctx).StandardReasons
@@ -135,6 +140,11 @@ func randomAddReasonFields(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID := subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Generate a random reason
 	reason := types.NewReason(
@@ -204,6 +214,11 @@ func randomRemoveReason(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID = subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a random reason
 	reasons := k.GetSubspaceReasons(ctx, subspaceID)
@@ -73,6 +73,11 @@ func randomCreateReportFields(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID := subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a reason
 	reasons := k.GetSubspaceReasons(ctx, subspaceID)
@@ -175,6 +180,11 @@ func randomDeleteReportFields(
 	}
 	subspace := subspacessim.RandomSubspace(r, subspaces)
 	subspaceID = subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a report
 	reports := k.GetSubspaceReports(ctx, subspaceID)
@@ -198,3 +208,100 @@ func randomDeleteReportFields(
 
 	return subspaceID, reportID, account, false
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgResolveReport tests and runs a single MsgResolveReport
+func SimulateMsgResolveReport(
+	k keeper.Keeper, sk types.SubspacesKeeper,
+	ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, reportID, moderator, skip := randomDeleteReportFields(r, ctx, accs, k, sk)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgResolveReport", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgResolveReport(subspaceID, reportID, RandomResolutionStatus(r), GetRandomMessage(r), moderator.Address.String())
+
+		// Send the data
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, moderator)
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgSetHidingThreshold tests and runs a single MsgSetHidingThreshold
+func SimulateMsgSetHidingThreshold(
+	k keeper.Keeper, sk types.SubspacesKeeper,
+	ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, threshold, reasonsIDs, signer, skip := randomSetHidingThresholdFields(r, ctx, accs, k, sk)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgSetHidingThreshold", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgSetHidingThreshold(subspaceID, threshold, reasonsIDs, signer.Address.String())
+
+		// Send the data
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomSetHidingThresholdFields returns the data used to build a random MsgSetHidingThreshold
+func randomSetHidingThresholdFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k keeper.Keeper, sk types.SubspacesKeeper,
+) (subspaceID uint64, threshold uint32, reasonsIDs []uint32, user simtypes.Account, skip bool) {
+	// Get a subspace id
+	subspaces := sk.GetAllSubspaces(ctx)
+	if len(subspaces) == 0 {
+		// Skip because there are no subspaces
+		skip = true
+		return
+	}
+	subspace := subspacessim.RandomSubspace(r, subspaces)
+	subspaceID = subspace.ID
+	if sk.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
+
+	// Get a random threshold, where zero disables the automatic hiding
+	threshold = uint32(r.Intn(10))
+
+	// Get some random reasons to be counted
+	if threshold > 0 {
+		reasons := k.GetSubspaceReasons(ctx, subspaceID)
+		if len(reasons) > 0 {
+			for _, reason := range reasons[:r.Intn(len(reasons))] {
+				reasonsIDs = append(reasonsIDs, reason.ID)
+			}
+		}
+	}
+
+	// Get a user
+	users := sk.GetUsersWithRootPermissions(ctx, subspace.ID, subspacestypes.NewPermissions(types.PermissionManageReports))
+	acc := subspacessim.GetAccount(subspacessim.RandomAddress(r, users), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	user = *acc
+
+	return subspaceID, threshold, reasonsIDs, user, false
+}
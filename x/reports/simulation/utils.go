@@ -40,6 +40,16 @@ func RandomReport(r *rand.Rand, reports []types.Report) types.Report {
 	return reports[r.Intn(len(reports))]
 }
 
+// RandomResolutionStatus returns a random status that can be used to resolve a report
+func RandomResolutionStatus(r *rand.Rand) types.ReportStatus {
+	statuses := []types.ReportStatus{
+		types.ReportStatusUnderReview,
+		types.ReportStatusActioned,
+		types.ReportStatusDismissed,
+	}
+	return statuses[r.Intn(len(statuses))]
+}
+
 // GetRandomStandardReasons returns a randomly generated slice of standard reason
 func GetRandomStandardReasons(r *rand.Rand, num int) []types.StandardReason {
 	standardReasons := make([]types.StandardReason, num)
@@ -74,6 +74,20 @@ func (m *MockSubspacesKeeper) EXPECT() *MockSubspacesKeeperMockRecorder {
 	return m.recorder
 }
 
+// ConsumeMsgRateLimits mocks base method.
+func (m *MockSubspacesKeeper) ConsumeMsgRateLimits(ctx types.Context, msg types1.SubspaceMsg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeMsgRateLimits", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeMsgRateLimits indicates an expected call of ConsumeMsgRateLimits.
+func (mr *MockSubspacesKeeperMockRecorder) ConsumeMsgRateLimits(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeMsgRateLimits", reflect.TypeOf((*MockSubspacesKeeper)(nil).ConsumeMsgRateLimits), ctx, msg)
+}
+
 // GetAllSubspaces mocks base method.
 func (m *MockSubspacesKeeper) GetAllSubspaces(ctx types.Context) []types1.Subspace {
 	m.ctrl.T.Helper()
@@ -130,6 +144,20 @@ func (mr *MockSubspacesKeeperMockRecorder) HasSubspace(ctx, subspaceID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSubspace", reflect.TypeOf((*MockSubspacesKeeper)(nil).HasSubspace), ctx, subspaceID)
 }
 
+// IsSubspaceArchived mocks base method.
+func (m *MockSubspacesKeeper) IsSubspaceArchived(ctx types.Context, subspaceID uint64) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSubspaceArchived", ctx, subspaceID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsSubspaceArchived indicates an expected call of IsSubspaceArchived.
+func (mr *MockSubspacesKeeperMockRecorder) IsSubspaceArchived(ctx, subspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSubspaceArchived", reflect.TypeOf((*MockSubspacesKeeper)(nil).IsSubspaceArchived), ctx, subspaceID)
+}
+
 // IterateSubspaces mocks base method.
 func (m *MockSubspacesKeeper) IterateSubspaces(ctx types.Context, fn func(types1.Subspace) bool) {
 	m.ctrl.T.Helper()
@@ -244,3 +272,43 @@ func (mr *MockPostsKeeperMockRecorder) HasPost(ctx, subspaceID, postID interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPost", reflect.TypeOf((*MockPostsKeeper)(nil).HasPost), ctx, subspaceID, postID)
 }
+
+// HidePost mocks base method.
+func (m *MockPostsKeeper) HidePost(ctx types.Context, subspaceID, postID uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HidePost", ctx, subspaceID, postID)
+}
+
+// HidePost indicates an expected call of HidePost.
+func (mr *MockPostsKeeperMockRecorder) HidePost(ctx, subspaceID, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HidePost", reflect.TypeOf((*MockPostsKeeper)(nil).HidePost), ctx, subspaceID, postID)
+}
+
+// IsPostHidden mocks base method.
+func (m *MockPostsKeeper) IsPostHidden(ctx types.Context, subspaceID, postID uint64) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPostHidden", ctx, subspaceID, postID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPostHidden indicates an expected call of IsPostHidden.
+func (mr *MockPostsKeeperMockRecorder) IsPostHidden(ctx, subspaceID, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPostHidden", reflect.TypeOf((*MockPostsKeeper)(nil).IsPostHidden), ctx, subspaceID, postID)
+}
+
+// IsPostPending mocks base method.
+func (m *MockPostsKeeper) IsPostPending(ctx types.Context, subspaceID, postID uint64) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPostPending", ctx, subspaceID, postID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPostPending indicates an expected call of IsPostPending.
+func (mr *MockPostsKeeperMockRecorder) IsPostPending(ctx, subspaceID, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPostPending", reflect.TypeOf((*MockPostsKeeper)(nil).IsPostPending), ctx, subspaceID, postID)
+}
@@ -18,9 +18,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	legacy.RegisterAminoMsg(cdc, &MsgCreateReport{}, "desmos/MsgCreateReport")
 	legacy.RegisterAminoMsg(cdc, &MsgDeleteReport{}, "desmos/MsgDeleteReport")
+	legacy.RegisterAminoMsg(cdc, &MsgResolveReport{}, "desmos/MsgResolveReport")
 	legacy.RegisterAminoMsg(cdc, &MsgSupportStandardReason{}, "desmos/MsgSupportStandardReason")
 	legacy.RegisterAminoMsg(cdc, &MsgAddReason{}, "desmos/MsgAddReason")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveReason{}, "desmos/MsgRemoveReason")
+	legacy.RegisterAminoMsg(cdc, &MsgSetHidingThreshold{}, "desmos/MsgSetHidingThreshold")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "desmos/x/reports/MsgUpdateParams")
 
 	cdc.RegisterConcrete(&Params{}, "desmos/x/reports/Params", nil)
@@ -37,9 +39,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateReport{},
 		&MsgDeleteReport{},
+		&MsgResolveReport{},
 		&MsgSupportStandardReason{},
 		&MsgAddReason{},
 		&MsgRemoveReason{},
+		&MsgSetHidingThreshold{},
 		&MsgUpdateParams{},
 	)
 
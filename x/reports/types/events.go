@@ -7,9 +7,12 @@ const (
 	EventTypeReportedPost            = "reported_post"
 	EventTypeReportedUser            = "reported_user"
 	EventTypeDeletedReport           = "deleted_report"
+	EventTypeResolvedReport          = "resolved_report"
 	EventTypeSupportedStandardReason = "supported_standard_reason"
 	EventTypeAddedReportingReason    = "added_reporting_reason"
 	EventTypeRemovedReportingReason  = "removed_reporting_reason"
+	EventTypeSetHidingThreshold      = "set_hiding_threshold"
+	EventTypeHiddenPost              = "hidden_post"
 
 	AttributeKeyReportID         = "report_id"
 	AttributeKeyReporter         = "reporter"
@@ -17,4 +20,9 @@ const (
 	AttributeKeyStandardReasonID = "standard_reason_id"
 	AttributeKeyReasonID         = "reason_id"
 	AttributeKeyUser             = "user"
+	AttributeKeyResolver         = "resolver"
+	AttributeKeyReportStatus     = "report_status"
+	AttributeKeyResolutionTime   = "resolution_time"
+	AttributeKeyThreshold        = "threshold"
+	AttributeKeyReportsCount     = "reports_count"
 )
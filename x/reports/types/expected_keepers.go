@@ -19,6 +19,9 @@ type SubspacesKeeper interface {
 	// HasSubspace tells whether the subspace with the given id exists or not
 	HasSubspace(ctx sdk.Context, subspaceID uint64) bool
 
+	// IsSubspaceArchived tells whether the subspace with the given id has been archived
+	IsSubspaceArchived(ctx sdk.Context, subspaceID uint64) bool
+
 	// HasPermission tells whether the given user has the provided permission inside the subspace with the specified id
 	HasPermission(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string, permission subspacestypes.Permission) bool
 
@@ -30,6 +33,9 @@ type SubspacesKeeper interface {
 
 	// GetUsersWithRootPermissions returns all the users that have a given permission inside the specified subspace
 	GetUsersWithRootPermissions(ctx sdk.Context, subspaceID uint64, permission subspacestypes.Permissions) []string
+
+	// ConsumeMsgRateLimits consumes the subspace rate limits that apply to the given message for each one of its signers
+	ConsumeMsgRateLimits(ctx sdk.Context, msg subspacestypes.SubspaceMsg) error
 }
 
 // RelationshipsKeeper represents a keeper that deals with relationships
@@ -46,6 +52,15 @@ type PostsKeeper interface {
 	// GetPost returns the post associated with the given id.
 	GetPost(ctx sdk.Context, subspaceID uint64, postID uint64) (poststypes.Post, bool)
 
+	// IsPostPending tells whether the post having the given id is still waiting to be published
+	IsPostPending(ctx sdk.Context, subspaceID uint64, postID uint64) bool
+
 	// GetSubspacePosts returns all the posts for the given subspace
 	GetSubspacePosts(ctx sdk.Context, subspaceID uint64) []poststypes.Post
+
+	// HidePost flags the post having the given id as hidden
+	HidePost(ctx sdk.Context, subspaceID uint64, postID uint64)
+
+	// IsPostHidden tells whether the post having the given id has been flagged as hidden
+	IsPostHidden(ctx sdk.Context, subspaceID uint64, postID uint64) bool
 }
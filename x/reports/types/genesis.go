@@ -7,12 +7,17 @@ import (
 )
 
 // NewGenesisState returns a new genesis state instance
-func NewGenesisState(subspaces []SubspaceDataEntry, reasons []Reason, reports []Report, params Params) *GenesisState {
+func NewGenesisState(
+	subspaces []SubspaceDataEntry, reasons []Reason, reports []Report, params Params, thresholds []HidingThreshold,
+	unhiddenPosts []UnhiddenPostEntry,
+) *GenesisState {
 	return &GenesisState{
-		SubspacesData: subspaces,
-		Reasons:       reasons,
-		Reports:       reports,
-		Params:        params,
+		SubspacesData:    subspaces,
+		Reasons:          reasons,
+		Reports:          reports,
+		Params:           params,
+		HidingThresholds: thresholds,
+		UnhiddenPosts:    unhiddenPosts,
 	}
 }
 
@@ -29,7 +34,7 @@ func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 
 // DefaultGenesisState returns a DefaultGenesisState
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(nil, nil, nil, DefaultParams())
+	return NewGenesisState(nil, nil, nil, DefaultParams(), nil, nil)
 }
 
 // ValidateGenesis validates the given genesis state and returns an error if something is invalid
@@ -67,6 +72,28 @@ func ValidateGenesis(data *GenesisState) error {
 		}
 	}
 
+	for _, threshold := range data.HidingThresholds {
+		if containsDuplicatedHidingThreshold(data.HidingThresholds, threshold) {
+			return fmt.Errorf("duplicated hiding threshold for subspace id %d", threshold.SubspaceID)
+		}
+
+		err := threshold.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid hiding threshold: %s", err)
+		}
+	}
+
+	for _, entry := range data.UnhiddenPosts {
+		if containsDuplicatedUnhiddenPost(data.UnhiddenPosts, entry) {
+			return fmt.Errorf("duplicated unhidden post: subspace id %d, post id %d", entry.SubspaceID, entry.PostID)
+		}
+
+		err := entry.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid unhidden post: %s", err)
+		}
+	}
+
 	return data.Params.Validate()
 }
 
@@ -106,6 +133,30 @@ func containsDuplicatedReport(reports []Report, report Report) bool {
 	return count > 1
 }
 
+// containsDuplicatedHidingThreshold tells whether the given thresholds slice contains
+// a duplicated threshold for the same subspace id as the one given
+func containsDuplicatedHidingThreshold(thresholds []HidingThreshold, threshold HidingThreshold) bool {
+	var count = 0
+	for _, t := range thresholds {
+		if t.SubspaceID == threshold.SubspaceID {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedUnhiddenPost tells whether the given entries slice contains
+// a duplicated entry for the same subspace id and post id as the one given
+func containsDuplicatedUnhiddenPost(entries []UnhiddenPostEntry, entry UnhiddenPostEntry) bool {
+	var count = 0
+	for _, e := range entries {
+		if e.SubspaceID == entry.SubspaceID && e.PostID == entry.PostID {
+			count++
+		}
+	}
+	return count > 1
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewSubspacesDataEntry returns a new SubspaceDataEntry instance
@@ -133,3 +184,31 @@ func (data SubspaceDataEntry) Validate() error {
 
 	return nil
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewUnhiddenPostEntry returns a new UnhiddenPostEntry instance
+func NewUnhiddenPostEntry(subspaceID uint64, postID uint64, firstReportID uint64) UnhiddenPostEntry {
+	return UnhiddenPostEntry{
+		SubspaceID:    subspaceID,
+		PostID:        postID,
+		FirstReportID: firstReportID,
+	}
+}
+
+// Validate implements fmt.Validator
+func (entry UnhiddenPostEntry) Validate() error {
+	if entry.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", entry.SubspaceID)
+	}
+
+	if entry.PostID == 0 {
+		return fmt.Errorf("invalid post id: %d", entry.PostID)
+	}
+
+	if entry.FirstReportID == 0 {
+		return fmt.Errorf("invalid first report id: %d", entry.FirstReportID)
+	}
+
+	return nil
+}
@@ -26,10 +26,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the reports module's genesis state.
 type GenesisState struct {
-	SubspacesData []SubspaceDataEntry `protobuf:"bytes,1,rep,name=subspaces_data,json=subspacesData,proto3" json:"subspaces_data"`
-	Reasons       []Reason            `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons"`
-	Reports       []Report            `protobuf:"bytes,3,rep,name=reports,proto3" json:"reports"`
-	Params        Params              `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	SubspacesData    []SubspaceDataEntry `protobuf:"bytes,1,rep,name=subspaces_data,json=subspacesData,proto3" json:"subspaces_data"`
+	Reasons          []Reason            `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons"`
+	Reports          []Report            `protobuf:"bytes,3,rep,name=reports,proto3" json:"reports"`
+	Params           Params              `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	HidingThresholds []HidingThreshold   `protobuf:"bytes,5,rep,name=hiding_thresholds,json=hidingThresholds,proto3" json:"hiding_thresholds"`
+	UnhiddenPosts    []UnhiddenPostEntry `protobuf:"bytes,6,rep,name=unhidden_posts,json=unhiddenPosts,proto3" json:"unhidden_posts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,40 +131,115 @@ func (m *SubspaceDataEntry) GetReportID() uint64 {
 	return 0
 }
 
+// UnhiddenPostEntry contains the data of a post that has been unhidden by a
+// moderator after reaching the hiding threshold of its subspace
+type UnhiddenPostEntry struct {
+	// Id of the subspace containing the post
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
+	// Id of the post
+	PostID uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Id of the first report that counts towards hiding the post again. Reports
+	// created before the post was unhidden have lower ids and are not counted
+	FirstReportID uint64 `protobuf:"varint,3,opt,name=first_report_id,json=firstReportId,proto3" json:"first_report_id,omitempty"`
+}
+
+func (m *UnhiddenPostEntry) Reset()         { *m = UnhiddenPostEntry{} }
+func (m *UnhiddenPostEntry) String() string { return proto.CompactTextString(m) }
+func (*UnhiddenPostEntry) ProtoMessage()    {}
+func (*UnhiddenPostEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4c495c10dc36e45, []int{2}
+}
+func (m *UnhiddenPostEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnhiddenPostEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnhiddenPostEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnhiddenPostEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnhiddenPostEntry.Merge(m, src)
+}
+func (m *UnhiddenPostEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnhiddenPostEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnhiddenPostEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnhiddenPostEntry proto.InternalMessageInfo
+
+func (m *UnhiddenPostEntry) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
+}
+
+func (m *UnhiddenPostEntry) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
+	}
+	return 0
+}
+
+func (m *UnhiddenPostEntry) GetFirstReportID() uint64 {
+	if m != nil {
+		return m.FirstReportID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "desmos.reports.v1.GenesisState")
 	proto.RegisterType((*SubspaceDataEntry)(nil), "desmos.reports.v1.SubspaceDataEntry")
+	proto.RegisterType((*UnhiddenPostEntry)(nil), "desmos.reports.v1.UnhiddenPostEntry")
 }
 
 func init() { proto.RegisterFile("desmos/reports/v1/genesis.proto", fileDescriptor_e4c495c10dc36e45) }
 
 var fileDescriptor_e4c495c10dc36e45 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x6b, 0xdb, 0x40,
-	0x18, 0xc6, 0x75, 0xb6, 0x71, 0xed, 0xf3, 0x1f, 0xb0, 0xe8, 0xa0, 0x7a, 0x90, 0x84, 0xe9, 0xe0,
-	0x16, 0xaa, 0xab, 0xdb, 0xa1, 0x50, 0x4a, 0x07, 0xe1, 0x10, 0x9c, 0x29, 0xc8, 0x90, 0x21, 0x8b,
-	0x39, 0x59, 0x87, 0x22, 0xb0, 0x74, 0x42, 0x77, 0x36, 0xf1, 0x37, 0xc8, 0x98, 0x39, 0x53, 0xc6,
-	0x90, 0x29, 0x1f, 0xc3, 0xa3, 0xc7, 0x4c, 0x26, 0xc8, 0x43, 0xbe, 0x46, 0xd0, 0x9d, 0x64, 0x4c,
-	0x9c, 0x64, 0x11, 0x0f, 0xf7, 0xfe, 0x9e, 0x47, 0xef, 0x03, 0x2f, 0x34, 0x3c, 0xc2, 0x42, 0xca,
-	0x50, 0x42, 0x62, 0x9a, 0x70, 0x86, 0x16, 0x03, 0xe4, 0x93, 0x88, 0xb0, 0x80, 0x59, 0x71, 0x42,
-	0x39, 0x55, 0x3b, 0x12, 0xb0, 0x72, 0xc0, 0x5a, 0x0c, 0xba, 0x1d, 0x1c, 0x06, 0x11, 0x45, 0xe2,
-	0x2b, 0xa9, 0xee, 0x67, 0x9f, 0xfa, 0x54, 0x48, 0x94, 0xa9, 0xfc, 0x55, 0x3f, 0x0c, 0x0f, 0xa9,
-	0x47, 0x66, 0x79, 0x76, 0xef, 0xbe, 0x04, 0x9b, 0xc7, 0xf2, 0x6f, 0x63, 0x8e, 0x39, 0x51, 0xcf,
-	0x60, 0x9b, 0xcd, 0x5d, 0x16, 0xe3, 0x29, 0x61, 0x13, 0x0f, 0x73, 0xac, 0x01, 0xb3, 0xdc, 0x6f,
-	0xfc, 0xfa, 0x6a, 0x1d, 0x6c, 0x61, 0x8d, 0x73, 0x70, 0x88, 0x39, 0x3e, 0x8a, 0x78, 0xb2, 0xb4,
-	0xeb, 0xab, 0x8d, 0xa1, 0xdc, 0x3d, 0x3f, 0x7c, 0x07, 0x4e, 0x6b, 0x17, 0x93, 0x8d, 0xd5, 0xff,
-	0xf0, 0x53, 0x42, 0x30, 0xa3, 0x11, 0xd3, 0x4a, 0x22, 0xf0, 0xcb, 0x1b, 0x81, 0x8e, 0x20, 0xf6,
-	0x53, 0x0a, 0x93, 0xf4, 0x0b, 0x50, 0x2b, 0x7f, 0xe0, 0xcf, 0xe4, 0x2b, 0xbf, 0x98, 0xaa, 0xff,
-	0x60, 0x35, 0xc6, 0x09, 0x0e, 0x99, 0x56, 0x31, 0xc1, 0x3b, 0xf6, 0x53, 0x01, 0xec, 0xdb, 0x73,
-	0xcf, 0xdf, 0xca, 0xd5, 0xad, 0xa1, 0xf4, 0x6e, 0x00, 0xec, 0x1c, 0x74, 0x56, 0x11, 0x6c, 0x14,
-	0x55, 0x27, 0x81, 0xa7, 0x01, 0x13, 0xf4, 0x2b, 0x76, 0x3b, 0xdd, 0x18, 0xb0, 0x60, 0x47, 0x43,
-	0x07, 0x16, 0xc8, 0xc8, 0x53, 0xbf, 0xc1, 0xba, 0x6c, 0x95, 0xe1, 0x25, 0x13, 0xf4, 0x5b, 0x76,
-	0x33, 0xdd, 0x18, 0x35, 0xd9, 0x7e, 0x34, 0x74, 0x6a, 0x72, 0x5c, 0xa0, 0xd9, 0x7e, 0x19, 0x5a,
-	0x16, 0xc9, 0x39, 0x9a, 0x3d, 0x4a, 0x54, 0x28, 0xcf, 0x3e, 0x59, 0xa5, 0x3a, 0x58, 0xa7, 0x3a,
-	0x78, 0x4a, 0x75, 0x70, 0xbd, 0xd5, 0x95, 0xf5, 0x56, 0x57, 0x1e, 0xb7, 0xba, 0x72, 0xfe, 0xd3,
-	0x0f, 0xf8, 0xc5, 0xdc, 0xb5, 0xa6, 0x34, 0x44, 0xb2, 0xf4, 0x8f, 0x19, 0x76, 0x59, 0xae, 0xd1,
-	0xe2, 0x0f, 0xba, 0xdc, 0xdd, 0x07, 0x5f, 0xc6, 0x84, 0xb9, 0x55, 0x71, 0x1c, 0xbf, 0x5f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xd0, 0xc7, 0xeb, 0xf1, 0x9b, 0x02, 0x00, 0x00,
+	// 514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0x73, 0x4d, 0x7e, 0x69, 0x7b, 0x6d, 0xfa, 0xc3, 0x16, 0x83, 0xe9, 0x60, 0x47, 0x81,
+	0x21, 0x20, 0x61, 0x53, 0x18, 0x10, 0x08, 0x31, 0x44, 0xe1, 0x8f, 0x99, 0x2a, 0x17, 0x18, 0xba,
+	0x58, 0x97, 0xde, 0xd5, 0xb6, 0x14, 0xfb, 0x2c, 0x3f, 0x97, 0x88, 0xbe, 0x03, 0x46, 0x66, 0x26,
+	0x06, 0x06, 0x46, 0x5e, 0x46, 0xc7, 0x8e, 0x4c, 0x16, 0x72, 0x06, 0xde, 0x06, 0xba, 0xb3, 0x1d,
+	0x99, 0xba, 0x45, 0x62, 0xb1, 0x1e, 0xdf, 0xf3, 0xf9, 0x7e, 0xfd, 0xf5, 0xf3, 0xe8, 0xb0, 0x45,
+	0x19, 0xc4, 0x1c, 0x9c, 0x8c, 0xa5, 0x3c, 0x13, 0xe0, 0x2c, 0x0f, 0x9c, 0x80, 0x25, 0x0c, 0x22,
+	0xb0, 0xd3, 0x8c, 0x0b, 0xae, 0x6b, 0x25, 0x60, 0x57, 0x80, 0xbd, 0x3c, 0xd8, 0xd7, 0x48, 0x1c,
+	0x25, 0xdc, 0x51, 0xcf, 0x92, 0xda, 0xbf, 0x19, 0xf0, 0x80, 0xab, 0xd2, 0x91, 0x55, 0x75, 0x6a,
+	0xb6, 0xcd, 0x63, 0x4e, 0xd9, 0xbc, 0xf2, 0x1e, 0xe5, 0x5d, 0xbc, 0xfb, 0xaa, 0xfc, 0xda, 0x91,
+	0x20, 0x82, 0xe9, 0xef, 0xf1, 0x1e, 0x2c, 0x66, 0x90, 0x92, 0x13, 0x06, 0x3e, 0x25, 0x82, 0x18,
+	0x68, 0xd8, 0x1d, 0xef, 0x3c, 0xbc, 0x63, 0xb7, 0x52, 0xd8, 0x47, 0x15, 0x38, 0x25, 0x82, 0xbc,
+	0x48, 0x44, 0x76, 0x36, 0xd9, 0x3e, 0xcf, 0xad, 0xce, 0xb7, 0x5f, 0xdf, 0xef, 0x21, 0x6f, 0xb0,
+	0xb6, 0x91, 0x6d, 0xfd, 0x39, 0xde, 0xcc, 0x18, 0x01, 0x9e, 0x80, 0xb1, 0xa1, 0x0c, 0x6f, 0x5d,
+	0x61, 0xe8, 0x29, 0xa2, 0xe9, 0x52, 0x8b, 0x4a, 0xbd, 0x02, 0x8d, 0xee, 0x5f, 0xf4, 0xb2, 0xbc,
+	0xa4, 0x57, 0x5d, 0xfd, 0x19, 0xee, 0xa7, 0x24, 0x23, 0x31, 0x18, 0xbd, 0x21, 0xba, 0x46, 0x7e,
+	0xa8, 0x80, 0xa6, 0xbc, 0xd2, 0xe8, 0xc7, 0x58, 0x0b, 0x23, 0x1a, 0x25, 0x81, 0x2f, 0xc2, 0x8c,
+	0x41, 0xc8, 0xe7, 0x14, 0x8c, 0xff, 0x54, 0x8e, 0xd1, 0x15, 0x46, 0xaf, 0x15, 0xfb, 0xb6, 0x46,
+	0x9b, 0x8e, 0x37, 0xc2, 0x3f, 0x7b, 0x20, 0x27, 0xbe, 0x48, 0xc2, 0x88, 0x52, 0x96, 0xf8, 0x29,
+	0x07, 0x01, 0x46, 0xff, 0xda, 0x89, 0xbf, 0xab, 0xc0, 0x43, 0x0e, 0xa2, 0x3d, 0xf1, 0x45, 0xa3,
+	0x0b, 0x4f, 0x7b, 0x1f, 0xbf, 0x58, 0x9d, 0xd1, 0x67, 0x84, 0xb5, 0xd6, 0x9e, 0x74, 0x07, 0xef,
+	0xd4, 0xeb, 0xf1, 0x23, 0x6a, 0xa0, 0x21, 0x1a, 0xf7, 0x26, 0x7b, 0x45, 0x6e, 0xe1, 0x9a, 0x75,
+	0xa7, 0x1e, 0xae, 0x11, 0x97, 0xea, 0x77, 0xf1, 0x76, 0xb9, 0x09, 0x89, 0x6f, 0x0c, 0xd1, 0x78,
+	0x30, 0xd9, 0x2d, 0x72, 0x6b, 0xab, 0xdc, 0x98, 0x3b, 0xf5, 0xb6, 0xca, 0x76, 0x8d, 0xca, 0xc4,
+	0x12, 0xed, 0x2a, 0xe7, 0x0a, 0x95, 0x87, 0x25, 0xaa, 0x2a, 0x3a, 0xfa, 0x8a, 0xb0, 0xd6, 0xfa,
+	0xa5, 0x7f, 0x0f, 0x77, 0x1b, 0x6f, 0xca, 0xc1, 0xd5, 0xd1, 0x7a, 0x13, 0x5c, 0xe4, 0x56, 0x5f,
+	0x1a, 0xba, 0x53, 0xaf, 0x2f, 0x5b, 0x2e, 0xd5, 0x9f, 0xe0, 0xff, 0x4f, 0xa3, 0x0c, 0x84, 0x7f,
+	0x39, 0x9c, 0x56, 0xe4, 0xd6, 0xe0, 0xa5, 0x6c, 0xad, 0x13, 0x0e, 0x4e, 0x1b, 0xaf, 0x74, 0xf2,
+	0xe6, 0xbc, 0x30, 0xd1, 0x45, 0x61, 0xa2, 0x9f, 0x85, 0x89, 0x3e, 0xad, 0xcc, 0xce, 0xc5, 0xca,
+	0xec, 0xfc, 0x58, 0x99, 0x9d, 0xe3, 0x07, 0x41, 0x24, 0xc2, 0xc5, 0xcc, 0x3e, 0xe1, 0xb1, 0x53,
+	0x6e, 0xeb, 0xfe, 0x9c, 0xcc, 0xa0, 0xaa, 0x9d, 0xe5, 0x63, 0xe7, 0xc3, 0xfa, 0xea, 0x89, 0xb3,
+	0x94, 0xc1, 0xac, 0xaf, 0xee, 0xdd, 0xa3, 0xdf, 0x03, 0x00, 0xd8, 0xc6, 0x6e, 0x27, 0xf6, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +262,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnhiddenPosts) > 0 {
+		for iNdEx := len(m.UnhiddenPosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnhiddenPosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.HidingThresholds) > 0 {
+		for iNdEx := len(m.HidingThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HidingThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -278,6 +383,44 @@ func (m *SubspaceDataEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnhiddenPostEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnhiddenPostEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnhiddenPostEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FirstReportID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FirstReportID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PostID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -315,6 +458,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.HidingThresholds) > 0 {
+		for _, e := range m.HidingThresholds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnhiddenPosts) > 0 {
+		for _, e := range m.UnhiddenPosts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,6 +491,24 @@ func (m *SubspaceDataEntry) Size() (n int) {
 	return n
 }
 
+func (m *UnhiddenPostEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovGenesis(uint64(m.SubspaceID))
+	}
+	if m.PostID != 0 {
+		n += 1 + sovGenesis(uint64(m.PostID))
+	}
+	if m.FirstReportID != 0 {
+		n += 1 + sovGenesis(uint64(m.FirstReportID))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -506,6 +679,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HidingThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HidingThresholds = append(m.HidingThresholds, HidingThreshold{})
+			if err := m.HidingThresholds[len(m.HidingThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnhiddenPosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnhiddenPosts = append(m.UnhiddenPosts, UnhiddenPostEntry{})
+			if err := m.UnhiddenPosts[len(m.UnhiddenPosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -634,6 +875,113 @@ func (m *SubspaceDataEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnhiddenPostEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnhiddenPostEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnhiddenPostEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstReportID", wireType)
+			}
+			m.FirstReportID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstReportID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
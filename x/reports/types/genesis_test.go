@@ -20,14 +20,14 @@ func TestValidateGenesis(t *testing.T) {
 			data: types.NewGenesisState([]types.SubspaceDataEntry{
 				types.NewSubspacesDataEntry(1, 1, 1),
 				types.NewSubspacesDataEntry(1, 1, 1),
-			}, nil, nil, types.DefaultParams()),
+			}, nil, nil, types.DefaultParams(), nil, nil),
 			shouldErr: true,
 		},
 		{
 			name: "invalid subspaces data returns error",
 			data: types.NewGenesisState([]types.SubspaceDataEntry{
 				types.NewSubspacesDataEntry(0, 1, 1),
-			}, nil, nil, types.DefaultParams()),
+			}, nil, nil, types.DefaultParams(), nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -35,7 +35,7 @@ func TestValidateGenesis(t *testing.T) {
 			data: types.NewGenesisState(nil, []types.Reason{
 				types.NewReason(1, 1, "Spam", ""),
 				types.NewReason(1, 1, "Spam", ""),
-			}, nil, types.DefaultParams()),
+			}, nil, types.DefaultParams(), nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -59,7 +59,7 @@ func TestValidateGenesis(t *testing.T) {
 					"cosmos1x85xq5m2ehkjzw928j9zfv3awdy0hqtnhrp9r6",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 				),
-			}, types.DefaultParams()),
+			}, types.DefaultParams(), nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -81,6 +81,8 @@ func TestValidateGenesis(t *testing.T) {
 					),
 				},
 				types.DefaultParams(),
+				nil,
+				nil,
 			),
 			shouldErr: true,
 		},
@@ -88,7 +90,37 @@ func TestValidateGenesis(t *testing.T) {
 			name: "invalid params returns error",
 			data: types.NewGenesisState(nil, nil, nil, types.NewParams(types.NewStandardReasons(
 				types.NewStandardReason(0, "", ""),
-			))),
+			)), nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid hiding threshold returns error",
+			data: types.NewGenesisState(nil, nil, nil, types.DefaultParams(), []types.HidingThreshold{
+				types.NewHidingThreshold(1, 0, nil),
+			}, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated hiding threshold returns error",
+			data: types.NewGenesisState(nil, nil, nil, types.DefaultParams(), []types.HidingThreshold{
+				types.NewHidingThreshold(1, 3, nil),
+				types.NewHidingThreshold(1, 5, nil),
+			}, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid unhidden post returns error",
+			data: types.NewGenesisState(nil, nil, nil, types.DefaultParams(), nil, []types.UnhiddenPostEntry{
+				types.NewUnhiddenPostEntry(1, 1, 0),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated unhidden post returns error",
+			data: types.NewGenesisState(nil, nil, nil, types.DefaultParams(), nil, []types.UnhiddenPostEntry{
+				types.NewUnhiddenPostEntry(1, 1, 2),
+				types.NewUnhiddenPostEntry(1, 1, 3),
+			}),
 			shouldErr: true,
 		},
 		{
@@ -112,6 +144,12 @@ func TestValidateGenesis(t *testing.T) {
 					),
 				},
 				types.DefaultParams(),
+				[]types.HidingThreshold{
+					types.NewHidingThreshold(1, 3, []uint32{1}),
+				},
+				[]types.UnhiddenPostEntry{
+					types.NewUnhiddenPostEntry(1, 1, 2),
+				},
 			),
 			shouldErr: false,
 		},
@@ -172,3 +210,45 @@ func TestSubspaceDataEntry_Validate(t *testing.T) {
 	}
 
 }
+
+func TestUnhiddenPostEntry_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		data      types.UnhiddenPostEntry
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			data:      types.NewUnhiddenPostEntry(0, 1, 1),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid post id returns error",
+			data:      types.NewUnhiddenPostEntry(1, 0, 1),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid first report id returns error",
+			data:      types.NewUnhiddenPostEntry(1, 1, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "valid data returns no error",
+			data:      types.NewUnhiddenPostEntry(1, 1, 2),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.data.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+}
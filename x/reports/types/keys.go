@@ -18,9 +18,11 @@ const (
 
 	ActionCreateReport          = "create_report"
 	ActionDeleteReport          = "delete_report"
+	ActionResolveReport         = "resolve_report"
 	ActionSupportStandardReason = "support_standard_reason"
 	ActionAddReason             = "add_reason"
 	ActionRemoveReason          = "remove_reason"
+	ActionSetHidingThreshold    = "set_hiding_threshold"
 	ActionUpdateParams          = "update_params"
 )
 
@@ -34,6 +36,9 @@ var (
 	ReasonPrefix       = []byte{0x11}
 
 	ParamsKey = []byte{0x20}
+
+	HidingThresholdPrefix = []byte{0x30}
+	UnhiddenPostPrefix    = []byte{0x31}
 )
 
 // GetReportIDBytes returns the byte representation of the reportID
@@ -118,3 +123,23 @@ func SubspaceReasonsPrefix(subspaceID uint64) []byte {
 func ReasonStoreKey(subspaceID uint64, reasonID uint32) []byte {
 	return append(SubspaceReasonsPrefix(subspaceID), GetReasonIDBytes(reasonID)...)
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// HidingThresholdStoreKey returns the key used to store the hiding threshold of the given subspace
+func HidingThresholdStoreKey(subspaceID uint64) []byte {
+	return append(HidingThresholdPrefix, subspacestypes.GetSubspaceIDBytes(subspaceID)...)
+}
+
+// UnhiddenPostStoreKey returns the key used to store the id of the first report that counts towards
+// hiding again the given post after it has been unhidden
+func UnhiddenPostStoreKey(subspaceID uint64, postID uint64) []byte {
+	unhiddenPostSuffix := append(subspacestypes.GetSubspaceIDBytes(subspaceID), poststypes.GetPostIDBytes(postID)...)
+	return append(UnhiddenPostPrefix, unhiddenPostSuffix...)
+}
+
+// SplitUnhiddenPostStoreKey returns the subspace id and post id from the given UnhiddenPostStoreKey
+func SplitUnhiddenPostStoreKey(key []byte) (subspaceID uint64, postID uint64) {
+	key = key[len(UnhiddenPostPrefix):]
+	return subspacestypes.GetSubspaceIDFromBytes(key[:8]), poststypes.GetPostIDFromBytes(key[8:])
+}
@@ -84,9 +84,48 @@ func (r Report) Validate() error {
 		return fmt.Errorf("invalid report creation date: %s", r.CreationDate)
 	}
 
+	if !IsValidReportStatus(r.Status) {
+		return fmt.Errorf("invalid report status: %s", r.Status)
+	}
+
+	if r.Status == ReportStatusOpen && r.Resolution != nil {
+		return fmt.Errorf("open reports cannot have a resolution")
+	}
+
+	if r.Status != ReportStatusOpen && r.Resolution == nil {
+		return fmt.Errorf("missing resolution for report with status %s", r.Status)
+	}
+
+	if r.Resolution != nil {
+		err = r.Resolution.Validate()
+		if err != nil {
+			return err
+		}
+
+		if r.Resolution.ResolutionDate.Before(r.CreationDate) {
+			return fmt.Errorf("report resolution date cannot be before the creation date")
+		}
+	}
+
 	return nil
 }
 
+// Resolve returns a copy of the report having the given status and resolution, without validating it.
+// Before storing the updated report, a validation with Report.Validate should be performed.
+func (r Report) Resolve(status ReportStatus, resolution *ReportResolution) Report {
+	return Report{
+		SubspaceID:   r.SubspaceID,
+		ID:           r.ID,
+		ReasonsIDs:   r.ReasonsIDs,
+		Message:      r.Message,
+		Reporter:     r.Reporter,
+		Target:       r.Target,
+		CreationDate: r.CreationDate,
+		Status:       status,
+		Resolution:   resolution,
+	}
+}
+
 // UnpackInterfaces implements codectypes.UnpackInterfacesMessage
 func (r *Report) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var target ReportTarget
@@ -95,6 +134,86 @@ func (r *Report) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// IsValidReportStatus tells whether the given status is a valid report status
+func IsValidReportStatus(status ReportStatus) bool {
+	_, ok := ReportStatus_name[int32(status)]
+	return ok
+}
+
+// IsValidResolutionStatus tells whether the given status can be used when resolving a report
+func IsValidResolutionStatus(status ReportStatus) bool {
+	return status != ReportStatusOpen && IsValidReportStatus(status)
+}
+
+// ParseReportStatus parses the given value as a report status, returning an error if it's invalid
+func ParseReportStatus(value string) (ReportStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "open":
+		return ReportStatusOpen, nil
+	case "under-review", "under_review":
+		return ReportStatusUnderReview, nil
+	case "actioned":
+		return ReportStatusActioned, nil
+	case "dismissed":
+		return ReportStatusDismissed, nil
+	default:
+		return ReportStatusOpen, fmt.Errorf("invalid report status: %s", value)
+	}
+}
+
+// ParseReportStatuses parses the given comma-separated values as a list of report statuses
+func ParseReportStatuses(value string) ([]ReportStatus, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	strValues := strings.Split(value, ",")
+	statuses := make([]ReportStatus, len(strValues))
+	for i, str := range strValues {
+		status, err := ParseReportStatus(str)
+		if err != nil {
+			return nil, err
+		}
+		statuses[i] = status
+	}
+	return statuses, nil
+}
+
+// ContainsReportStatus returns true iff the given statuses contain the provided status
+func ContainsReportStatus(statuses []ReportStatus, status ReportStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// NewReportResolution returns a new ReportResolution instance
+func NewReportResolution(resolver string, note string, resolutionDate time.Time) *ReportResolution {
+	return &ReportResolution{
+		Resolver:       resolver,
+		Note:           note,
+		ResolutionDate: resolutionDate,
+	}
+}
+
+// Validate implements fmt.Validator
+func (r *ReportResolution) Validate() error {
+	_, err := sdk.AccAddressFromBech32(r.Resolver)
+	if err != nil {
+		return fmt.Errorf("invalid resolver address: %s", err)
+	}
+
+	if r.ResolutionDate.IsZero() {
+		return fmt.Errorf("invalid report resolution date: %s", r.ResolutionDate)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // ReportTarget represents a generic report target
 type ReportTarget interface {
 	proto.Message
@@ -215,3 +334,55 @@ func (r Reason) Validate() error {
 
 	return nil
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewHidingThreshold returns a new HidingThreshold instance
+func NewHidingThreshold(subspaceID uint64, threshold uint32, reasonsIDs []uint32) HidingThreshold {
+	return HidingThreshold{
+		SubspaceID: subspaceID,
+		Threshold:  threshold,
+		ReasonsIDs: reasonsIDs,
+	}
+}
+
+// Validate implements fmt.Validator
+func (t HidingThreshold) Validate() error {
+	if t.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", t.SubspaceID)
+	}
+
+	if t.Threshold == 0 {
+		return fmt.Errorf("invalid threshold: %d", t.Threshold)
+	}
+
+	for i, reasonID := range t.ReasonsIDs {
+		if reasonID == 0 {
+			return fmt.Errorf("invalid reason id: %d", reasonID)
+		}
+
+		if ContainsReason(t.ReasonsIDs[i+1:], reasonID) {
+			return fmt.Errorf("duplicated reason id: %d", reasonID)
+		}
+	}
+
+	return nil
+}
+
+// CountsReport tells whether the given report should be counted towards the threshold
+func (t HidingThreshold) CountsReport(report Report) bool {
+	if report.Status == ReportStatusDismissed {
+		return false
+	}
+
+	if len(t.ReasonsIDs) == 0 {
+		return true
+	}
+
+	for _, reasonID := range report.ReasonsIDs {
+		if ContainsReason(t.ReasonsIDs, reasonID) {
+			return true
+		}
+	}
+	return false
+}
@@ -30,6 +30,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ReportStatus contains the possible moderation statuses of a report
+type ReportStatus int32
+
+const (
+	// The report has been created and has not been reviewed yet
+	ReportStatusOpen ReportStatus = 0
+	// The report is currently being reviewed by a moderator
+	ReportStatusUnderReview ReportStatus = 1
+	// The report has been reviewed and an action has been taken
+	ReportStatusActioned ReportStatus = 2
+	// The report has been reviewed and dismissed without taking any action
+	ReportStatusDismissed ReportStatus = 3
+)
+
+var ReportStatus_name = map[int32]string{
+	0: "REPORT_STATUS_OPEN_UNSPECIFIED",
+	1: "REPORT_STATUS_UNDER_REVIEW",
+	2: "REPORT_STATUS_ACTIONED",
+	3: "REPORT_STATUS_DISMISSED",
+}
+
+var ReportStatus_value = map[string]int32{
+	"REPORT_STATUS_OPEN_UNSPECIFIED": 0,
+	"REPORT_STATUS_UNDER_REVIEW":     1,
+	"REPORT_STATUS_ACTIONED":         2,
+	"REPORT_STATUS_DISMISSED":        3,
+}
+
+func (x ReportStatus) String() string {
+	return proto.EnumName(ReportStatus_name, int32(x))
+}
+
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_908cff0b05e14c5f, []int{0}
+}
+
 // Report contains the data of a generic report
 type Report struct {
 	// Id of the subspace for which the report has been created
@@ -46,6 +82,11 @@ type Report struct {
 	Target *types.Any `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty" yaml:"target"`
 	// Time in which the report was created
 	CreationDate time.Time `protobuf:"bytes,7,opt,name=creation_date,json=creationDate,proto3,stdtime" json:"creation_date" yaml:"creation_date"`
+	// Current moderation status of the report
+	Status ReportStatus `protobuf:"varint,8,opt,name=status,proto3,enum=desmos.reports.v1.ReportStatus" json:"status,omitempty" yaml:"status"`
+	// (optional) Resolution of the report.
+	// This is set only when a moderator has reviewed the report
+	Resolution *ReportResolution `protobuf:"bytes,9,opt,name=resolution,proto3" json:"resolution,omitempty" yaml:"resolution"`
 }
 
 func (m *Report) Reset()         { *m = Report{} }
@@ -130,6 +171,85 @@ func (m *Report) GetCreationDate() time.Time {
 	return time.Time{}
 }
 
+func (m *Report) GetStatus() ReportStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ReportStatusOpen
+}
+
+func (m *Report) GetResolution() *ReportResolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return nil
+}
+
+// ReportResolution contains the data about the review of a report made by a
+// moderator
+type ReportResolution struct {
+	// Address of the moderator that has reviewed the report
+	Resolver string `protobuf:"bytes,1,opt,name=resolver,proto3" json:"resolver,omitempty" yaml:"resolver"`
+	// (optional) Note attached by the moderator to the resolution
+	Note string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty" yaml:"note"`
+	// Time in which the report has been reviewed
+	ResolutionDate time.Time `protobuf:"bytes,3,opt,name=resolution_date,json=resolutionDate,proto3,stdtime" json:"resolution_date" yaml:"resolution_date"`
+}
+
+func (m *ReportResolution) Reset()         { *m = ReportResolution{} }
+func (m *ReportResolution) String() string { return proto.CompactTextString(m) }
+func (*ReportResolution) ProtoMessage()    {}
+func (*ReportResolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908cff0b05e14c5f, []int{1}
+}
+func (m *ReportResolution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportResolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportResolution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportResolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportResolution.Merge(m, src)
+}
+func (m *ReportResolution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportResolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportResolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportResolution proto.InternalMessageInfo
+
+func (m *ReportResolution) GetResolver() string {
+	if m != nil {
+		return m.Resolver
+	}
+	return ""
+}
+
+func (m *ReportResolution) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *ReportResolution) GetResolutionDate() time.Time {
+	if m != nil {
+		return m.ResolutionDate
+	}
+	return time.Time{}
+}
+
 // UserTarget contains the data of a report about a user
 type UserTarget struct {
 	// Address of the reported user
@@ -140,7 +260,7 @@ func (m *UserTarget) Reset()         { *m = UserTarget{} }
 func (m *UserTarget) String() string { return proto.CompactTextString(m) }
 func (*UserTarget) ProtoMessage()    {}
 func (*UserTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_908cff0b05e14c5f, []int{1}
+	return fileDescriptor_908cff0b05e14c5f, []int{2}
 }
 func (m *UserTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,7 +306,7 @@ func (m *PostTarget) Reset()         { *m = PostTarget{} }
 func (m *PostTarget) String() string { return proto.CompactTextString(m) }
 func (*PostTarget) ProtoMessage()    {}
 func (*PostTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_908cff0b05e14c5f, []int{2}
+	return fileDescriptor_908cff0b05e14c5f, []int{3}
 }
 func (m *PostTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +358,7 @@ func (m *Reason) Reset()         { *m = Reason{} }
 func (m *Reason) String() string { return proto.CompactTextString(m) }
 func (*Reason) ProtoMessage()    {}
 func (*Reason) Descriptor() ([]byte, []int) {
-	return fileDescriptor_908cff0b05e14c5f, []int{3}
+	return fileDescriptor_908cff0b05e14c5f, []int{4}
 }
 func (m *Reason) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,6 +415,72 @@ func (m *Reason) GetDescription() string {
 	return ""
 }
 
+// HidingThreshold contains the settings used to automatically hide the posts
+// that receive too many reports inside a subspace
+type HidingThreshold struct {
+	// Id of the subspace for which this threshold is valid
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Number of distinct reports that a post must receive before being hidden
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty" yaml:"threshold"`
+	// (optional) Ids of the reasons that should be taken into account when
+	// counting the reports. If empty, reports with any reason are counted
+	ReasonsIDs []uint32 `protobuf:"varint,3,rep,packed,name=reasons_ids,json=reasonsIds,proto3" json:"reasons_ids,omitempty" yaml:"reasons_ids"`
+}
+
+func (m *HidingThreshold) Reset()         { *m = HidingThreshold{} }
+func (m *HidingThreshold) String() string { return proto.CompactTextString(m) }
+func (*HidingThreshold) ProtoMessage()    {}
+func (*HidingThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908cff0b05e14c5f, []int{5}
+}
+func (m *HidingThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HidingThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HidingThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HidingThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HidingThreshold.Merge(m, src)
+}
+func (m *HidingThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *HidingThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_HidingThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HidingThreshold proto.InternalMessageInfo
+
+func (m *HidingThreshold) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
+}
+
+func (m *HidingThreshold) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *HidingThreshold) GetReasonsIDs() []uint32 {
+	if m != nil {
+		return m.ReasonsIDs
+	}
+	return nil
+}
+
 // Params contains the module parameters
 type Params struct {
 	// List of available reasons from which new subspaces can pick their default
@@ -306,7 +492,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_908cff0b05e14c5f, []int{4}
+	return fileDescriptor_908cff0b05e14c5f, []int{6}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +543,7 @@ func (m *StandardReason) Reset()         { *m = StandardReason{} }
 func (m *StandardReason) String() string { return proto.CompactTextString(m) }
 func (*StandardReason) ProtoMessage()    {}
 func (*StandardReason) Descriptor() ([]byte, []int) {
-	return fileDescriptor_908cff0b05e14c5f, []int{5}
+	return fileDescriptor_908cff0b05e14c5f, []int{7}
 }
 func (m *StandardReason) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,10 +594,13 @@ func (m *StandardReason) GetDescription() string {
 }
 
 func init() {
+	proto.RegisterEnum("desmos.reports.v1.ReportStatus", ReportStatus_name, ReportStatus_value)
 	proto.RegisterType((*Report)(nil), "desmos.reports.v1.Report")
+	proto.RegisterType((*ReportResolution)(nil), "desmos.reports.v1.ReportResolution")
 	proto.RegisterType((*UserTarget)(nil), "desmos.reports.v1.UserTarget")
 	proto.RegisterType((*PostTarget)(nil), "desmos.reports.v1.PostTarget")
 	proto.RegisterType((*Reason)(nil), "desmos.reports.v1.Reason")
+	proto.RegisterType((*HidingThreshold)(nil), "desmos.reports.v1.HidingThreshold")
 	proto.RegisterType((*Params)(nil), "desmos.reports.v1.Params")
 	proto.RegisterType((*StandardReason)(nil), "desmos.reports.v1.StandardReason")
 }
@@ -419,54 +608,74 @@ func init() {
 func init() { proto.RegisterFile("desmos/reports/v1/models.proto", fileDescriptor_908cff0b05e14c5f) }
 
 var fileDescriptor_908cff0b05e14c5f = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xdb, 0x48,
-	0x14, 0xce, 0x24, 0x21, 0xc0, 0x84, 0xf0, 0xc3, 0x1b, 0x2d, 0x5e, 0xb4, 0xb2, 0xb3, 0x5e, 0x76,
-	0x37, 0x4b, 0x8b, 0x5d, 0x40, 0x55, 0xab, 0xf4, 0x84, 0x45, 0x55, 0x85, 0x13, 0x32, 0xf4, 0xd2,
-	0x4b, 0x34, 0xc9, 0x0c, 0xae, 0xa5, 0x38, 0x13, 0xcd, 0x4c, 0x50, 0xf3, 0x2f, 0x54, 0x3d, 0x70,
-	0xec, 0x91, 0xf6, 0xd4, 0x23, 0x07, 0xfe, 0x08, 0xd4, 0x13, 0xea, 0xa9, 0x27, 0x17, 0x85, 0x03,
-	0x3d, 0xe7, 0x2f, 0xa8, 0xec, 0x19, 0x43, 0x00, 0xa1, 0x96, 0xaa, 0x17, 0x6b, 0xe6, 0x7d, 0xdf,
-	0x7b, 0x6f, 0xe6, 0x7b, 0xdf, 0x18, 0x1a, 0x98, 0xf0, 0x90, 0x72, 0x87, 0x91, 0x2e, 0x65, 0x82,
-	0x3b, 0x7b, 0x2b, 0x4e, 0x48, 0x31, 0x69, 0x73, 0xbb, 0xcb, 0xa8, 0xa0, 0xda, 0x9c, 0xc4, 0x6d,
-	0x85, 0xdb, 0x7b, 0x2b, 0x0b, 0x73, 0x28, 0x0c, 0x3a, 0xd4, 0x49, 0xbe, 0x92, 0xb5, 0x50, 0xf6,
-	0xa9, 0x4f, 0x93, 0xa5, 0x13, 0xaf, 0x54, 0xf4, 0x0f, 0x9f, 0x52, 0xbf, 0x4d, 0x9c, 0x64, 0xd7,
-	0xec, 0xed, 0x3a, 0xa8, 0xd3, 0x57, 0x90, 0x79, 0x1d, 0x12, 0x41, 0x48, 0xb8, 0x40, 0x61, 0x37,
-	0xcd, 0x6d, 0xd1, 0xb8, 0x6f, 0x43, 0x16, 0x95, 0x1b, 0x09, 0x59, 0x6f, 0xf2, 0xb0, 0xe0, 0x25,
-	0xc7, 0xd1, 0x9e, 0xc2, 0x22, 0xef, 0x35, 0x79, 0x17, 0xb5, 0x48, 0x23, 0xc0, 0x3a, 0xa8, 0x80,
-	0x6a, 0xde, 0x5d, 0x1c, 0x44, 0x26, 0xdc, 0x56, 0xe1, 0xfa, 0xc6, 0x30, 0x32, 0xb5, 0x3e, 0x0a,
-	0xdb, 0x35, 0x6b, 0x84, 0x6a, 0x79, 0x30, 0xdd, 0xd5, 0xb1, 0xf6, 0x37, 0xcc, 0x06, 0x58, 0xcf,
-	0x26, 0xd9, 0xbf, 0x0d, 0x22, 0x33, 0x9b, 0x64, 0x4d, 0xca, 0xac, 0x98, 0x9c, 0x0d, 0xb0, 0xb6,
-	0x09, 0x8b, 0x8c, 0x20, 0x4e, 0x3b, 0xbc, 0x11, 0x60, 0xae, 0xe7, 0x2a, 0xb9, 0x6a, 0xc9, 0xfd,
-	0x3f, 0xee, 0xe5, 0xc9, 0x70, 0x7d, 0x83, 0x5f, 0xf6, 0x1a, 0xa1, 0x5a, 0x1f, 0xce, 0x0f, 0x97,
-	0x80, 0x07, 0x55, 0xa8, 0x8e, 0xb9, 0x76, 0x1f, 0x8e, 0x87, 0x84, 0x73, 0xe4, 0x13, 0x3d, 0x5f,
-	0x01, 0xd5, 0x49, 0x57, 0x1b, 0x46, 0xe6, 0xb4, 0xcc, 0x54, 0x80, 0xe5, 0xa5, 0x14, 0xed, 0x19,
-	0x9c, 0x90, 0xf2, 0x13, 0xa6, 0x8f, 0x25, 0xf4, 0x7b, 0xc3, 0xc8, 0x9c, 0x49, 0x1b, 0x49, 0xc4,
-	0xfa, 0x74, 0xb4, 0x5c, 0x56, 0x3a, 0xad, 0x63, 0xcc, 0x08, 0xe7, 0xdb, 0x82, 0x05, 0x1d, 0xdf,
-	0xbb, 0x48, 0xd6, 0x10, 0x2c, 0x08, 0xc4, 0x7c, 0x22, 0xf4, 0x42, 0x05, 0x54, 0x8b, 0xab, 0x65,
-	0x5b, 0x8e, 0xc1, 0x4e, 0xc7, 0x60, 0xaf, 0x77, 0xfa, 0xee, 0xda, 0x30, 0x32, 0x4b, 0xb2, 0xb8,
-	0x64, 0x5b, 0x1f, 0x8f, 0x96, 0x8d, 0x1b, 0x3e, 0xb0, 0xe5, 0x0c, 0x76, 0x12, 0x8a, 0xa7, 0x0a,
-	0x6b, 0xbb, 0xb0, 0xd4, 0x62, 0x04, 0x89, 0x80, 0x76, 0x1a, 0x18, 0x09, 0xa2, 0x8f, 0x27, 0x9d,
-	0x16, 0x6e, 0x74, 0xda, 0x49, 0x07, 0xee, 0xfe, 0x73, 0x1c, 0x99, 0x99, 0x61, 0x64, 0x96, 0x65,
-	0xcf, 0x2b, 0xe9, 0xd6, 0xfe, 0x17, 0x13, 0x48, 0xfd, 0xa6, 0x52, 0x60, 0x03, 0x09, 0x52, 0x9b,
-	0x78, 0x7b, 0x60, 0x82, 0xaf, 0x07, 0x26, 0xb0, 0x7a, 0x10, 0x3e, 0xe7, 0x84, 0xc9, 0x73, 0x68,
-	0x4f, 0x60, 0xbe, 0xc7, 0x09, 0x4b, 0xac, 0x30, 0xe9, 0xfe, 0x37, 0x8c, 0xcc, 0xa2, 0x2c, 0x1b,
-	0x47, 0x6f, 0xd7, 0x28, 0x49, 0xaa, 0x2d, 0xa5, 0x45, 0xbf, 0x7f, 0x61, 0x8b, 0x42, 0xb8, 0x45,
-	0xb9, 0xda, 0x69, 0x0f, 0xe1, 0x78, 0x97, 0x72, 0x71, 0x69, 0xc2, 0x3f, 0x07, 0x91, 0x59, 0x88,
-	0x09, 0x89, 0x95, 0xd4, 0x68, 0x15, 0xc5, 0xf2, 0x0a, 0xf1, 0xaa, 0x8e, 0xef, 0xd4, 0xf0, 0x14,
-	0xc4, 0xb6, 0x8f, 0x2d, 0xf4, 0xeb, 0x6d, 0x5f, 0xba, 0xdd, 0xf6, 0xff, 0xc2, 0x31, 0x11, 0x88,
-	0x36, 0xd1, 0x73, 0x89, 0xa2, 0xb3, 0xc3, 0xc8, 0x9c, 0x52, 0xe6, 0x88, 0xc3, 0x96, 0x27, 0x61,
-	0xed, 0x31, 0x2c, 0x62, 0xc2, 0x5b, 0x2c, 0xe8, 0xc6, 0x33, 0x52, 0xb6, 0xfe, 0xfd, 0xf2, 0x14,
-	0x23, 0xa0, 0xe5, 0x8d, 0x52, 0x47, 0x46, 0xf9, 0x1e, 0xc0, 0xc2, 0x16, 0x62, 0x28, 0xe4, 0x1a,
-	0x83, 0xb3, 0x5c, 0xa0, 0x0e, 0x46, 0x0c, 0x37, 0xd4, 0xc3, 0xd1, 0x41, 0x25, 0x57, 0x2d, 0xae,
-	0xfe, 0x65, 0xdf, 0x14, 0x6a, 0x5b, 0x51, 0xa5, 0x3e, 0xee, 0xa2, 0x72, 0xd4, 0xbc, 0x12, 0xe0,
-	0x5a, 0x21, 0xf5, 0x20, 0x67, 0xf8, 0x95, 0x2c, 0x5e, 0x5b, 0x4c, 0x0f, 0xf2, 0xfa, 0xfc, 0x70,
-	0x69, 0x5e, 0xfd, 0x18, 0x5f, 0x5d, 0xfc, 0x1a, 0xe5, 0xc9, 0xac, 0x77, 0x00, 0x4e, 0x5f, 0xed,
-	0xa7, 0x84, 0x04, 0x3f, 0x28, 0x64, 0xf6, 0x4e, 0x42, 0xe6, 0x7e, 0x42, 0x48, 0x77, 0xf3, 0x78,
-	0x60, 0x80, 0x93, 0x81, 0x01, 0x4e, 0x07, 0x06, 0xd8, 0x3f, 0x33, 0x32, 0x27, 0x67, 0x46, 0xe6,
-	0xf3, 0x99, 0x91, 0x79, 0xf1, 0xc0, 0x0f, 0xc4, 0xcb, 0x5e, 0xd3, 0x6e, 0xd1, 0xd0, 0x91, 0x37,
-	0x5c, 0x6e, 0xa3, 0x26, 0x57, 0x6b, 0x67, 0xef, 0xd1, 0xc8, 0x85, 0x45, 0xbf, 0x4b, 0x78, 0xb3,
-	0x90, 0x3c, 0xd9, 0xb5, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x24, 0x77, 0x11, 0x2a, 0x06,
-	0x00, 0x00,
+	// 1067 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0xcf, 0x24, 0x6d, 0xda, 0x4c, 0xfa, 0x91, 0x0e, 0xd9, 0xd6, 0x1b, 0x50, 0x1c, 0xdc, 0xc2,
+	0x86, 0x42, 0x13, 0xb6, 0xcb, 0xc7, 0xaa, 0x7b, 0xaa, 0x37, 0x01, 0x5c, 0x89, 0xb6, 0x72, 0x52,
+	0x90, 0xf6, 0x12, 0xb9, 0x99, 0xd9, 0xd4, 0x52, 0xec, 0x89, 0x3c, 0x93, 0x42, 0xff, 0x03, 0xd4,
+	0xd3, 0x1e, 0xb9, 0x54, 0x5a, 0xe0, 0xc2, 0x71, 0x0f, 0xfd, 0x23, 0x56, 0x9c, 0x56, 0x9c, 0xb8,
+	0x60, 0x56, 0xe9, 0x61, 0x39, 0xe7, 0x04, 0x37, 0x64, 0xcf, 0xb8, 0x71, 0x5a, 0xba, 0x5f, 0xda,
+	0x8b, 0x35, 0xf3, 0xde, 0xef, 0xf7, 0x3e, 0xfc, 0x7b, 0x7e, 0x86, 0x45, 0x4c, 0x98, 0x43, 0x59,
+	0xd5, 0x23, 0x3d, 0xea, 0x71, 0x56, 0x3d, 0xbc, 0x59, 0x75, 0x28, 0x26, 0x5d, 0x56, 0xe9, 0x79,
+	0x94, 0x53, 0xb4, 0x20, 0xfc, 0x15, 0xe9, 0xaf, 0x1c, 0xde, 0x2c, 0x2c, 0x58, 0x8e, 0xed, 0xd2,
+	0x6a, 0xf8, 0x14, 0xa8, 0x42, 0xbe, 0x43, 0x3b, 0x34, 0x3c, 0x56, 0x83, 0x93, 0xb4, 0x5e, 0xef,
+	0x50, 0xda, 0xe9, 0x92, 0x6a, 0x78, 0xdb, 0xef, 0xdf, 0xaf, 0x5a, 0xee, 0x91, 0x74, 0xa9, 0x17,
+	0x5d, 0xdc, 0x76, 0x08, 0xe3, 0x96, 0xd3, 0x8b, 0xb8, 0x6d, 0x1a, 0xe4, 0x6d, 0x89, 0xa0, 0xe2,
+	0x22, 0x5c, 0xda, 0xe9, 0x24, 0x4c, 0x9b, 0x61, 0x39, 0xa8, 0x0e, 0xb3, 0xac, 0xbf, 0xcf, 0x7a,
+	0x56, 0x9b, 0xb4, 0x6c, 0xac, 0x80, 0x12, 0x28, 0x4f, 0xe8, 0x2b, 0x03, 0x5f, 0x85, 0x0d, 0x69,
+	0x36, 0x6a, 0x43, 0x5f, 0x45, 0x47, 0x96, 0xd3, 0xdd, 0xd0, 0x62, 0x50, 0xcd, 0x84, 0xd1, 0xcd,
+	0xc0, 0x68, 0x19, 0x26, 0x6d, 0xac, 0x24, 0x43, 0xf6, 0x5b, 0x03, 0x5f, 0x4d, 0x86, 0xac, 0x8c,
+	0x60, 0x05, 0xe0, 0xa4, 0x8d, 0xd1, 0x16, 0xcc, 0x7a, 0xc4, 0x62, 0xd4, 0x65, 0x2d, 0x1b, 0x33,
+	0x25, 0x55, 0x4a, 0x95, 0x67, 0xf5, 0x0f, 0x82, 0x5c, 0xa6, 0x30, 0x1b, 0x35, 0x36, 0xca, 0x15,
+	0x83, 0x6a, 0xbf, 0x3e, 0x7b, 0xb4, 0x0a, 0x4c, 0x28, 0x4d, 0x06, 0x66, 0xe8, 0x23, 0x38, 0xe5,
+	0x10, 0xc6, 0xac, 0x0e, 0x51, 0x26, 0x4a, 0xa0, 0x9c, 0xd1, 0xd1, 0xd0, 0x57, 0xe7, 0x04, 0x53,
+	0x3a, 0x34, 0x33, 0x82, 0xa0, 0x2f, 0xe1, 0xb4, 0x78, 0xfd, 0xc4, 0x53, 0x26, 0x43, 0xf8, 0x87,
+	0x43, 0x5f, 0x9d, 0x8f, 0x12, 0x09, 0x8f, 0xf6, 0xfb, 0xe9, 0x5a, 0x5e, 0xbe, 0xa7, 0x4d, 0x8c,
+	0x3d, 0xc2, 0x58, 0x83, 0x7b, 0xb6, 0xdb, 0x31, 0xcf, 0xc9, 0xc8, 0x82, 0x69, 0x6e, 0x79, 0x1d,
+	0xc2, 0x95, 0x74, 0x09, 0x94, 0xb3, 0xeb, 0xf9, 0x8a, 0x90, 0xa1, 0x12, 0xc9, 0x50, 0xd9, 0x74,
+	0x8f, 0xf4, 0x5b, 0x43, 0x5f, 0x9d, 0x15, 0xc1, 0x05, 0x5a, 0xfb, 0xed, 0x74, 0xad, 0x78, 0x69,
+	0x0e, 0x2a, 0x42, 0x83, 0x66, 0x08, 0x31, 0x65, 0x60, 0x74, 0x1f, 0xce, 0xb6, 0x3d, 0x62, 0x71,
+	0x9b, 0xba, 0x2d, 0x6c, 0x71, 0xa2, 0x4c, 0x85, 0x99, 0x0a, 0x97, 0x32, 0x35, 0x23, 0xc1, 0xf5,
+	0xf7, 0x1e, 0xfb, 0x6a, 0x62, 0xe8, 0xab, 0x79, 0x91, 0x73, 0x8c, 0xae, 0x3d, 0xf8, 0x4b, 0x05,
+	0xe2, 0xfd, 0xcd, 0x44, 0x8e, 0x9a, 0xc5, 0x09, 0xda, 0x82, 0x69, 0xc6, 0x2d, 0xde, 0x67, 0xca,
+	0x74, 0x09, 0x94, 0xe7, 0xd6, 0xd5, 0xca, 0x55, 0x05, 0x36, 0x42, 0x98, 0xbe, 0x30, 0xea, 0x4a,
+	0x10, 0x35, 0x53, 0x46, 0x40, 0xf7, 0x20, 0xf4, 0x08, 0xa3, 0xdd, 0x7e, 0x10, 0x5d, 0xc9, 0x84,
+	0x05, 0x2f, 0x5f, 0x19, 0xcf, 0x3c, 0x87, 0xea, 0xd7, 0x86, 0xbe, 0xba, 0x10, 0xc9, 0x10, 0x59,
+	0x35, 0x33, 0x16, 0x6d, 0x63, 0xfa, 0xc7, 0x87, 0x2a, 0xf8, 0xfb, 0xa1, 0x0a, 0xb4, 0x7f, 0x00,
+	0xcc, 0x5d, 0x8c, 0x20, 0xa4, 0x65, 0xb4, 0x7b, 0x48, 0x3c, 0x05, 0x5c, 0x96, 0x56, 0x78, 0x9e,
+	0x2b, 0xad, 0x80, 0xa0, 0x65, 0x38, 0xe1, 0x52, 0x4e, 0xc2, 0x21, 0xce, 0xe8, 0xf3, 0x43, 0x5f,
+	0xcd, 0x8a, 0x20, 0x81, 0x55, 0x33, 0x43, 0x27, 0xea, 0xc2, 0xf9, 0x51, 0x69, 0x42, 0x9e, 0xd4,
+	0x0b, 0xe5, 0xb9, 0x21, 0xe5, 0x59, 0xbc, 0xd8, 0xe8, 0x45, 0x81, 0xe6, 0x46, 0xae, 0x40, 0xa2,
+	0x58, 0xeb, 0x7d, 0x08, 0xf7, 0x18, 0xf1, 0xc4, 0xa8, 0xa0, 0x3b, 0x70, 0xa2, 0xcf, 0xce, 0xfb,
+	0xbd, 0x31, 0x2a, 0xb5, 0xcf, 0x9e, 0xd7, 0x6b, 0x48, 0xda, 0x58, 0x8d, 0x82, 0xbe, 0x78, 0x26,
+	0x35, 0x0a, 0xe1, 0x2e, 0x65, 0xf2, 0x86, 0x3e, 0x85, 0x53, 0x3d, 0xca, 0xf8, 0x68, 0x4f, 0xbc,
+	0x33, 0xf0, 0xd5, 0x74, 0x00, 0x30, 0x6a, 0xa3, 0xaf, 0x4f, 0x42, 0x34, 0x33, 0x1d, 0x9c, 0x0c,
+	0xfc, 0x4a, 0x09, 0x9f, 0x82, 0x60, 0x33, 0x05, 0x5f, 0xf9, 0x9b, 0xdf, 0x4c, 0xb3, 0x57, 0x6f,
+	0xa6, 0xf7, 0xe1, 0x24, 0xb7, 0x79, 0x57, 0x88, 0x99, 0xd1, 0x73, 0x43, 0x5f, 0x9d, 0x11, 0x88,
+	0xd0, 0xac, 0x99, 0xc2, 0x8d, 0x6e, 0xc3, 0x2c, 0x26, 0xac, 0xed, 0xd9, 0xbd, 0x70, 0xd0, 0xc5,
+	0xe6, 0x59, 0x1c, 0x55, 0x11, 0x73, 0x6a, 0x66, 0x1c, 0x1a, 0x93, 0xf2, 0x4f, 0x00, 0xe7, 0xbf,
+	0xb2, 0xb1, 0xed, 0x76, 0x9a, 0x07, 0x1e, 0x61, 0x07, 0xb4, 0x8b, 0xdf, 0x54, 0xaf, 0xeb, 0x30,
+	0xc3, 0xa3, 0x98, 0xb2, 0xe5, 0xfc, 0xd0, 0x57, 0x73, 0xb2, 0x95, 0xc8, 0xa5, 0x99, 0x19, 0x1e,
+	0x4f, 0x7d, 0x79, 0x29, 0xaf, 0xbc, 0xcc, 0x52, 0x8e, 0xef, 0xe3, 0x58, 0x7f, 0x3f, 0x03, 0x98,
+	0xde, 0xb5, 0x3c, 0xcb, 0x61, 0xc8, 0x83, 0x39, 0xc6, 0x2d, 0x17, 0x5b, 0x1e, 0x6e, 0x49, 0xac,
+	0x02, 0x4a, 0xa9, 0x72, 0x76, 0xfd, 0xdd, 0xff, 0x59, 0x0e, 0x0d, 0x09, 0x15, 0x79, 0xf5, 0x15,
+	0xf9, 0xd5, 0x2c, 0x9d, 0xaf, 0x9c, 0xb1, 0x40, 0xf2, 0x9f, 0x30, 0xcf, 0xc6, 0x58, 0x6c, 0x63,
+	0x25, 0x2a, 0xe4, 0xf8, 0xd9, 0xa3, 0xd5, 0x25, 0xf9, 0x6f, 0xfe, 0xfe, 0xfc, 0xef, 0x2c, 0x2a,
+	0xd3, 0x7e, 0x02, 0x70, 0x6e, 0x3c, 0x9f, 0x1c, 0x14, 0xf0, 0x92, 0x83, 0x92, 0x7c, 0xa5, 0x41,
+	0x49, 0xbd, 0xc6, 0xa0, 0xac, 0xfe, 0x0b, 0xe0, 0x4c, 0x7c, 0x01, 0xa3, 0xdb, 0xb0, 0x68, 0xd6,
+	0x77, 0x77, 0xcc, 0x66, 0xab, 0xd1, 0xdc, 0x6c, 0xee, 0x35, 0x5a, 0x3b, 0xbb, 0xf5, 0xed, 0xd6,
+	0xde, 0x76, 0x63, 0xb7, 0x7e, 0xd7, 0xf8, 0xc2, 0xa8, 0xd7, 0x72, 0x89, 0x42, 0xfe, 0xf8, 0xa4,
+	0x94, 0x8b, 0xb3, 0x76, 0x7a, 0xc4, 0x45, 0x77, 0x60, 0x61, 0x9c, 0xb9, 0xb7, 0x5d, 0xab, 0x9b,
+	0x2d, 0xb3, 0xfe, 0x8d, 0x51, 0xff, 0x36, 0x07, 0x0a, 0x6f, 0x1f, 0x9f, 0x94, 0x96, 0xe2, 0xac,
+	0x3d, 0x17, 0x13, 0xcf, 0x24, 0x87, 0x36, 0xf9, 0x0e, 0x7d, 0x02, 0x17, 0xc7, 0xc9, 0x9b, 0x77,
+	0x9b, 0xc6, 0xce, 0x76, 0xbd, 0x96, 0x4b, 0x16, 0x94, 0xe3, 0x93, 0x52, 0x3e, 0x4e, 0xdc, 0x6c,
+	0x07, 0x5d, 0x10, 0x8c, 0x3e, 0x83, 0x4b, 0xe3, 0xac, 0x9a, 0xd1, 0xf8, 0xda, 0x68, 0x34, 0xea,
+	0xb5, 0x5c, 0xaa, 0x70, 0xfd, 0xf8, 0xa4, 0x74, 0x2d, 0x4e, 0xab, 0xd9, 0xcc, 0xb1, 0x19, 0x23,
+	0xb8, 0x30, 0xf1, 0xc3, 0x2f, 0xc5, 0x84, 0xbe, 0xf5, 0x78, 0x50, 0x04, 0x4f, 0x06, 0x45, 0xf0,
+	0x74, 0x50, 0x04, 0x0f, 0xce, 0x8a, 0x89, 0x27, 0x67, 0xc5, 0xc4, 0x1f, 0x67, 0xc5, 0xc4, 0xbd,
+	0x8f, 0x3b, 0x36, 0x3f, 0xe8, 0xef, 0x57, 0xda, 0xd4, 0xa9, 0x0a, 0x75, 0xd7, 0xba, 0xd6, 0x3e,
+	0x93, 0xe7, 0xea, 0xe1, 0xe7, 0x31, 0xb1, 0xf9, 0x51, 0x8f, 0xb0, 0xfd, 0x74, 0xb8, 0x92, 0x6f,
+	0xfd, 0x37, 0x00, 0x80, 0xda, 0x55, 0x39, 0xa9, 0x09, 0x00, 0x00,
 }
 
 func (this *Report) Equal(that interface{}) bool {
@@ -514,6 +723,42 @@ func (this *Report) Equal(that interface{}) bool {
 	if !this.CreationDate.Equal(that1.CreationDate) {
 		return false
 	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if !this.Resolution.Equal(that1.Resolution) {
+		return false
+	}
+	return true
+}
+func (this *ReportResolution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReportResolution)
+	if !ok {
+		that2, ok := that.(ReportResolution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Resolver != that1.Resolver {
+		return false
+	}
+	if this.Note != that1.Note {
+		return false
+	}
+	if !this.ResolutionDate.Equal(that1.ResolutionDate) {
+		return false
+	}
 	return true
 }
 func (this *UserTarget) Equal(that interface{}) bool {
@@ -597,6 +842,41 @@ func (this *Reason) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HidingThreshold) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HidingThreshold)
+	if !ok {
+		that2, ok := that.(HidingThreshold)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	if len(this.ReasonsIDs) != len(that1.ReasonsIDs) {
+		return false
+	}
+	for i := range this.ReasonsIDs {
+		if this.ReasonsIDs[i] != that1.ReasonsIDs[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -676,12 +956,29 @@ func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationDate):])
-	if err1 != nil {
-		return 0, err1
+	if m.Resolution != nil {
+		{
+			size, err := m.Resolution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Status != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationDate):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintModels(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintModels(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x3a
 	if m.Target != nil {
@@ -711,20 +1008,20 @@ func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.ReasonsIDs) > 0 {
-		dAtA4 := make([]byte, len(m.ReasonsIDs)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.ReasonsIDs)*10)
+		var j4 int
 		for _, num := range m.ReasonsIDs {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintModels(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintModels(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -741,6 +1038,51 @@ func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReportResolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportResolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportResolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ResolutionDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResolutionDate):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintModels(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resolver) > 0 {
+		i -= len(m.Resolver)
+		copy(dAtA[i:], m.Resolver)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Resolver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UserTarget) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -846,6 +1188,57 @@ func (m *Reason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HidingThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HidingThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HidingThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReasonsIDs) > 0 {
+		dAtA8 := make([]byte, len(m.ReasonsIDs)*10)
+		var j7 int
+		for _, num := range m.ReasonsIDs {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintModels(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -969,32 +1362,58 @@ func (m *Report) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationDate)
 	n += 1 + l + sovModels(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovModels(uint64(m.Status))
+	}
+	if m.Resolution != nil {
+		l = m.Resolution.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
-func (m *UserTarget) Size() (n int) {
+func (m *ReportResolution) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.User)
+	l = len(m.Resolver)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Note)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResolutionDate)
+	n += 1 + l + sovModels(uint64(l))
 	return n
 }
 
-func (m *PostTarget) Size() (n int) {
+func (m *UserTarget) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PostID != 0 {
-		n += 1 + sovModels(uint64(m.PostID))
-	}
-	return n
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *PostTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		n += 1 + sovModels(uint64(m.PostID))
+	}
+	return n
 }
 
 func (m *Reason) Size() (n int) {
@@ -1020,6 +1439,28 @@ func (m *Reason) Size() (n int) {
 	return n
 }
 
+func (m *HidingThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovModels(uint64(m.Threshold))
+	}
+	if len(m.ReasonsIDs) > 0 {
+		l = 0
+		for _, e := range m.ReasonsIDs {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1337,6 +1778,208 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ReportStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resolution == nil {
+				m.Resolution = &ReportResolution{}
+			}
+			if err := m.Resolution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportResolution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportResolution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportResolution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ResolutionDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -1661,6 +2304,170 @@ func (m *Reason) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HidingThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HidingThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HidingThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReasonsIDs = append(m.ReasonsIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReasonsIDs) == 0 {
+					m.ReasonsIDs = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReasonsIDs = append(m.ReasonsIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonsIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
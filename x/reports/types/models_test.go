@@ -106,6 +106,100 @@ func TestReport_Validate(t *testing.T) {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "invalid status returns error",
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"",
+				types.NewPostTarget(1),
+				"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			).Resolve(types.ReportStatus(10), nil),
+			shouldErr: true,
+		},
+		{
+			name: "open report with resolution returns error",
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"",
+				types.NewPostTarget(1),
+				"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			).Resolve(types.ReportStatusOpen, types.NewReportResolution(
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+				"",
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+			)),
+			shouldErr: true,
+		},
+		{
+			name: "resolved report without resolution returns error",
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"",
+				types.NewPostTarget(1),
+				"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			).Resolve(types.ReportStatusActioned, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid resolver returns error",
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"",
+				types.NewPostTarget(1),
+				"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			).Resolve(types.ReportStatusActioned, types.NewReportResolution(
+				"",
+				"",
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+			)),
+			shouldErr: true,
+		},
+		{
+			name: "resolution date before creation date returns error",
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"",
+				types.NewPostTarget(1),
+				"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			).Resolve(types.ReportStatusActioned, types.NewReportResolution(
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+				"",
+				time.Date(2019, 1, 1, 12, 00, 00, 000, time.UTC),
+			)),
+			shouldErr: true,
+		},
+		{
+			name: "valid resolved report returns no error",
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"",
+				types.NewPostTarget(1),
+				"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			).Resolve(types.ReportStatusActioned, types.NewReportResolution(
+				"cosmos1qycmg40ju50fx2mcc82qtkzuswjs3mj3mqekeh",
+				"The post has been removed",
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+			)),
+			shouldErr: false,
+		},
 		{
 			name: "valid report returns no error",
 			report: types.NewReport(
@@ -245,3 +339,133 @@ func TestReason_Validate(t *testing.T) {
 	}
 
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestHidingThreshold_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		threshold types.HidingThreshold
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			threshold: types.NewHidingThreshold(0, 3, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid threshold returns error",
+			threshold: types.NewHidingThreshold(1, 0, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid reason id returns error",
+			threshold: types.NewHidingThreshold(1, 3, []uint32{0}),
+			shouldErr: true,
+		},
+		{
+			name:      "duplicated reason id returns error",
+			threshold: types.NewHidingThreshold(1, 3, []uint32{1, 2, 1}),
+			shouldErr: true,
+		},
+		{
+			name:      "valid threshold without reasons returns no error",
+			threshold: types.NewHidingThreshold(1, 3, nil),
+			shouldErr: false,
+		},
+		{
+			name:      "valid threshold with reasons returns no error",
+			threshold: types.NewHidingThreshold(1, 3, []uint32{1, 2}),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.threshold.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHidingThreshold_CountsReport(t *testing.T) {
+	testCases := []struct {
+		name      string
+		threshold types.HidingThreshold
+		report    types.Report
+		expResult bool
+	}{
+		{
+			name:      "dismissed report returns false",
+			threshold: types.NewHidingThreshold(1, 3, nil),
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"",
+				types.NewPostTarget(1),
+				"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			).Resolve(types.ReportStatusDismissed, types.NewReportResolution(
+				"cosmos1zkmf50jq4lzvhvp5ekl0sdf2p4g3v9v8edt24z",
+				"",
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+			)),
+			expResult: false,
+		},
+		{
+			name:      "threshold without reasons counts any report",
+			threshold: types.NewHidingThreshold(1, 3, nil),
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"",
+				types.NewPostTarget(1),
+				"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			expResult: true,
+		},
+		{
+			name:      "report without matching reasons returns false",
+			threshold: types.NewHidingThreshold(1, 3, []uint32{2, 3}),
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1},
+				"",
+				types.NewPostTarget(1),
+				"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			expResult: false,
+		},
+		{
+			name:      "report with matching reasons returns true",
+			threshold: types.NewHidingThreshold(1, 3, []uint32{2, 3}),
+			report: types.NewReport(
+				1,
+				1,
+				[]uint32{1, 3},
+				"",
+				types.NewPostTarget(1),
+				"cosmos1atdl3cpms89md5qa3rxtql0drtgftch2zgkr7v",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			expResult: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expResult, tc.threshold.CountsReport(tc.report))
+		})
+	}
+}
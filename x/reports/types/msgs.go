@@ -13,13 +13,24 @@ import (
 var (
 	_ sdk.Msg = &MsgCreateReport{}
 	_ sdk.Msg = &MsgDeleteReport{}
+	_ sdk.Msg = &MsgResolveReport{}
 	_ sdk.Msg = &MsgSupportStandardReason{}
 	_ sdk.Msg = &MsgAddReason{}
 	_ sdk.Msg = &MsgRemoveReason{}
+	_ sdk.Msg = &MsgSetHidingThreshold{}
 
 	_ subspacestypes.ManageSubspaceMsg = &MsgSupportStandardReason{}
 	_ subspacestypes.ManageSubspaceMsg = &MsgAddReason{}
 	_ subspacestypes.ManageSubspaceMsg = &MsgRemoveReason{}
+	_ subspacestypes.ManageSubspaceMsg = &MsgSetHidingThreshold{}
+
+	_ subspacestypes.RateLimitedMsg = &MsgCreateReport{}
+	_ subspacestypes.RateLimitedMsg = &MsgDeleteReport{}
+	_ subspacestypes.RateLimitedMsg = &MsgResolveReport{}
+	_ subspacestypes.RateLimitedMsg = &MsgSupportStandardReason{}
+	_ subspacestypes.RateLimitedMsg = &MsgAddReason{}
+	_ subspacestypes.RateLimitedMsg = &MsgRemoveReason{}
+	_ subspacestypes.RateLimitedMsg = &MsgSetHidingThreshold{}
 )
 
 // NewMsgCreateReport returns a new MsgCreateReport instance
@@ -93,6 +104,9 @@ func (msg *MsgCreateReport) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgCreateReport) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgDeleteReport returns a new MsgDeleteReport instance
@@ -141,6 +155,66 @@ func (msg *MsgDeleteReport) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgDeleteReport) IsRateLimitedMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgResolveReport returns a new MsgResolveReport instance
+func NewMsgResolveReport(subspaceID uint64, reportID uint64, status ReportStatus, note string, signer string) *MsgResolveReport {
+	return &MsgResolveReport{
+		SubspaceID: subspaceID,
+		ReportID:   reportID,
+		Status:     status,
+		Note:       note,
+		Signer:     signer,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgResolveReport) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgResolveReport) Type() string {
+	return ActionResolveReport
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg *MsgResolveReport) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if msg.ReportID == 0 {
+		return fmt.Errorf("invalid report id: %d", msg.ReportID)
+	}
+
+	if !IsValidResolutionStatus(msg.Status) {
+		return fmt.Errorf("invalid resolution status: %s", msg.Status)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return fmt.Errorf("invalid signer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgResolveReport) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgResolveReport) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{sender}
+}
+
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgResolveReport) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgSupportStandardReason returns a new MsgSupportStandardReason instance
@@ -192,6 +266,9 @@ func (msg *MsgSupportStandardReason) GetSigners() []sdk.AccAddress {
 // IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
 func (msg *MsgSupportStandardReason) IsManageSubspaceMsg() {}
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgSupportStandardReason) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgAddReason returns a new MsgAddReason instance
@@ -244,6 +321,9 @@ func (msg *MsgAddReason) GetSigners() []sdk.AccAddress {
 // IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
 func (msg *MsgAddReason) IsManageSubspaceMsg() {}
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgAddReason) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgRemoveReason returns a new MsgRemoveReason instance
@@ -295,6 +375,74 @@ func (msg *MsgRemoveReason) GetSigners() []sdk.AccAddress {
 // IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
 func (msg *MsgRemoveReason) IsManageSubspaceMsg() {}
 
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgRemoveReason) IsRateLimitedMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgSetHidingThreshold returns a new MsgSetHidingThreshold instance
+func NewMsgSetHidingThreshold(subspaceID uint64, threshold uint32, reasonsIDs []uint32, signer string) *MsgSetHidingThreshold {
+	return &MsgSetHidingThreshold{
+		SubspaceID: subspaceID,
+		Threshold:  threshold,
+		ReasonsIDs: reasonsIDs,
+		Signer:     signer,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgSetHidingThreshold) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSetHidingThreshold) Type() string {
+	return ActionSetHidingThreshold
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg *MsgSetHidingThreshold) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if msg.Threshold == 0 && len(msg.ReasonsIDs) > 0 {
+		return fmt.Errorf("reasons ids cannot be specified when disabling the hiding threshold")
+	}
+
+	for i, reasonID := range msg.ReasonsIDs {
+		if reasonID == 0 {
+			return fmt.Errorf("invalid reason id: %d", reasonID)
+		}
+
+		if ContainsReason(msg.ReasonsIDs[i+1:], reasonID) {
+			return fmt.Errorf("duplicated reason id: %d", reasonID)
+		}
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return fmt.Errorf("invalid signer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetHidingThreshold) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSetHidingThreshold) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{sender}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgSetHidingThreshold) IsManageSubspaceMsg() {}
+
+// IsRateLimitedMsg implements subspacestypes.RateLimitedMsg
+func (msg *MsgSetHidingThreshold) IsRateLimitedMsg() {}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 func NewMsgUpdateParams(params Params, authority string) *MsgUpdateParams {
@@ -274,6 +274,134 @@ func (m *MsgDeleteReportResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteReportResponse proto.InternalMessageInfo
 
+// MsgResolveReport represents the message to be used when reviewing a report
+type MsgResolveReport struct {
+	// Id of the subspace that contains the report to be resolved
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the report to be resolved
+	ReportID uint64 `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty" yaml:"report_id"`
+	// New moderation status of the report
+	Status ReportStatus `protobuf:"varint,3,opt,name=status,proto3,enum=desmos.reports.v1.ReportStatus" json:"status,omitempty" yaml:"status"`
+	// (optional) Note to be attached to the resolution
+	Note string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty" yaml:"note"`
+	// Address of the moderator resolving the report
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+}
+
+func (m *MsgResolveReport) Reset()         { *m = MsgResolveReport{} }
+func (m *MsgResolveReport) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveReport) ProtoMessage()    {}
+func (*MsgResolveReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7165cc8d939a535, []int{4}
+}
+func (m *MsgResolveReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveReport.Merge(m, src)
+}
+func (m *MsgResolveReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveReport proto.InternalMessageInfo
+
+func (m *MsgResolveReport) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
+}
+
+func (m *MsgResolveReport) GetReportID() uint64 {
+	if m != nil {
+		return m.ReportID
+	}
+	return 0
+}
+
+func (m *MsgResolveReport) GetStatus() ReportStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ReportStatusOpen
+}
+
+func (m *MsgResolveReport) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *MsgResolveReport) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgResolveReportResponse represents the Msg/ResolveReport response type
+type MsgResolveReportResponse struct {
+	// Time in which the report has been resolved
+	ResolutionDate time.Time `protobuf:"bytes,1,opt,name=resolution_date,json=resolutionDate,proto3,stdtime" json:"resolution_date" yaml:"resolution_date"`
+}
+
+func (m *MsgResolveReportResponse) Reset()         { *m = MsgResolveReportResponse{} }
+func (m *MsgResolveReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveReportResponse) ProtoMessage()    {}
+func (*MsgResolveReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7165cc8d939a535, []int{5}
+}
+func (m *MsgResolveReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveReportResponse.Merge(m, src)
+}
+func (m *MsgResolveReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveReportResponse proto.InternalMessageInfo
+
+func (m *MsgResolveReportResponse) GetResolutionDate() time.Time {
+	if m != nil {
+		return m.ResolutionDate
+	}
+	return time.Time{}
+}
+
 // MsgSupportStandardReason represents the message to be used when wanting to
 // support one reason from the module params
 type MsgSupportStandardReason struct {
@@ -289,7 +417,7 @@ func (m *MsgSupportStandardReason) Reset()         { *m = MsgSupportStandardReas
 func (m *MsgSupportStandardReason) String() string { return proto.CompactTextString(m) }
 func (*MsgSupportStandardReason) ProtoMessage()    {}
 func (*MsgSupportStandardReason) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c7165cc8d939a535, []int{4}
+	return fileDescriptor_c7165cc8d939a535, []int{6}
 }
 func (m *MsgSupportStandardReason) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +478,7 @@ func (m *MsgSupportStandardReasonResponse) Reset()         { *m = MsgSupportStan
 func (m *MsgSupportStandardReasonResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSupportStandardReasonResponse) ProtoMessage()    {}
 func (*MsgSupportStandardReasonResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c7165cc8d939a535, []int{5}
+	return fileDescriptor_c7165cc8d939a535, []int{7}
 }
 func (m *MsgSupportStandardReasonResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +531,7 @@ func (m *MsgAddReason) Reset()         { *m = MsgAddReason{} }
 func (m *MsgAddReason) String() string { return proto.CompactTextString(m) }
 func (*MsgAddReason) ProtoMessage()    {}
 func (*MsgAddReason) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c7165cc8d939a535, []int{6}
+	return fileDescriptor_c7165cc8d939a535, []int{8}
 }
 func (m *MsgAddReason) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,7 +598,7 @@ func (m *MsgAddReasonResponse) Reset()         { *m = MsgAddReasonResponse{} }
 func (m *MsgAddReasonResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddReasonResponse) ProtoMessage()    {}
 func (*MsgAddReasonResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c7165cc8d939a535, []int{7}
+	return fileDescriptor_c7165cc8d939a535, []int{9}
 }
 func (m *MsgAddReasonResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +649,7 @@ func (m *MsgRemoveReason) Reset()         { *m = MsgRemoveReason{} }
 func (m *MsgRemoveReason) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveReason) ProtoMessage()    {}
 func (*MsgRemoveReason) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c7165cc8d939a535, []int{8}
+	return fileDescriptor_c7165cc8d939a535, []int{10}
 }
 func (m *MsgRemoveReason) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -579,7 +707,7 @@ func (m *MsgRemoveReasonResponse) Reset()         { *m = MsgRemoveReasonResponse
 func (m *MsgRemoveReasonResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveReasonResponse) ProtoMessage()    {}
 func (*MsgRemoveReasonResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c7165cc8d939a535, []int{9}
+	return fileDescriptor_c7165cc8d939a535, []int{11}
 }
 func (m *MsgRemoveReasonResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,6 +736,120 @@ func (m *MsgRemoveReasonResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveReasonResponse proto.InternalMessageInfo
 
+// MsgSetHidingThreshold represents the message to be used when setting the
+// threshold after which reported posts are automatically hidden
+type MsgSetHidingThreshold struct {
+	// Id of the subspace for which to set the threshold
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Number of distinct reports after which a post gets hidden.
+	// If zero, the automatic hiding is disabled for the subspace
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty" yaml:"threshold"`
+	// (optional) Ids of the reasons that should be taken into account when
+	// counting the reports. If empty, reports with any reason are counted
+	ReasonsIDs []uint32 `protobuf:"varint,3,rep,packed,name=reasons_ids,json=reasonsIds,proto3" json:"reasons_ids,omitempty" yaml:"reasons_ids"`
+	// Address of the user setting the threshold
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+}
+
+func (m *MsgSetHidingThreshold) Reset()         { *m = MsgSetHidingThreshold{} }
+func (m *MsgSetHidingThreshold) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHidingThreshold) ProtoMessage()    {}
+func (*MsgSetHidingThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7165cc8d939a535, []int{12}
+}
+func (m *MsgSetHidingThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHidingThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHidingThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHidingThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHidingThreshold.Merge(m, src)
+}
+func (m *MsgSetHidingThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHidingThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHidingThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHidingThreshold proto.InternalMessageInfo
+
+func (m *MsgSetHidingThreshold) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
+}
+
+func (m *MsgSetHidingThreshold) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MsgSetHidingThreshold) GetReasonsIDs() []uint32 {
+	if m != nil {
+		return m.ReasonsIDs
+	}
+	return nil
+}
+
+func (m *MsgSetHidingThreshold) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgSetHidingThresholdResponse represents the Msg/SetHidingThreshold response
+// type
+type MsgSetHidingThresholdResponse struct {
+}
+
+func (m *MsgSetHidingThresholdResponse) Reset()         { *m = MsgSetHidingThresholdResponse{} }
+func (m *MsgSetHidingThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHidingThresholdResponse) ProtoMessage()    {}
+func (*MsgSetHidingThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7165cc8d939a535, []int{13}
+}
+func (m *MsgSetHidingThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHidingThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHidingThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHidingThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHidingThresholdResponse.Merge(m, src)
+}
+func (m *MsgSetHidingThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHidingThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHidingThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHidingThresholdResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 //
 // Since: Desmos 5.0.0
@@ -625,7 +867,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c7165cc8d939a535, []int{10}
+	return fileDescriptor_c7165cc8d939a535, []int{14}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -679,7 +921,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c7165cc8d939a535, []int{11}
+	return fileDescriptor_c7165cc8d939a535, []int{15}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,12 +955,16 @@ func init() {
 	proto.RegisterType((*MsgCreateReportResponse)(nil), "desmos.reports.v1.MsgCreateReportResponse")
 	proto.RegisterType((*MsgDeleteReport)(nil), "desmos.reports.v1.MsgDeleteReport")
 	proto.RegisterType((*MsgDeleteReportResponse)(nil), "desmos.reports.v1.MsgDeleteReportResponse")
+	proto.RegisterType((*MsgResolveReport)(nil), "desmos.reports.v1.MsgResolveReport")
+	proto.RegisterType((*MsgResolveReportResponse)(nil), "desmos.reports.v1.MsgResolveReportResponse")
 	proto.RegisterType((*MsgSupportStandardReason)(nil), "desmos.reports.v1.MsgSupportStandardReason")
 	proto.RegisterType((*MsgSupportStandardReasonResponse)(nil), "desmos.reports.v1.MsgSupportStandardReasonResponse")
 	proto.RegisterType((*MsgAddReason)(nil), "desmos.reports.v1.MsgAddReason")
 	proto.RegisterType((*MsgAddReasonResponse)(nil), "desmos.reports.v1.MsgAddReasonResponse")
 	proto.RegisterType((*MsgRemoveReason)(nil), "desmos.reports.v1.MsgRemoveReason")
 	proto.RegisterType((*MsgRemoveReasonResponse)(nil), "desmos.reports.v1.MsgRemoveReasonResponse")
+	proto.RegisterType((*MsgSetHidingThreshold)(nil), "desmos.reports.v1.MsgSetHidingThreshold")
+	proto.RegisterType((*MsgSetHidingThresholdResponse)(nil), "desmos.reports.v1.MsgSetHidingThresholdResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "desmos.reports.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "desmos.reports.v1.MsgUpdateParamsResponse")
 }
@@ -726,71 +972,84 @@ func init() {
 func init() { proto.RegisterFile("desmos/reports/v1/msgs.proto", fileDescriptor_c7165cc8d939a535) }
 
 var fileDescriptor_c7165cc8d939a535 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0x36, 0xe5, 0x1f, 0x8d, 0xce, 0x72, 0xa3, 0xb0, 0x6a, 0x22, 0x0b, 0x85, 0x28, 0x5c, 0xda,
-	0x54, 0x71, 0x6a, 0xb2, 0x8e, 0x81, 0xb6, 0x30, 0xda, 0xc1, 0xac, 0x8a, 0x42, 0x01, 0x0c, 0x14,
-	0x74, 0xbc, 0x74, 0x88, 0x40, 0x89, 0x17, 0x9a, 0x80, 0xc8, 0x23, 0x78, 0x94, 0x51, 0x6d, 0x45,
-	0xc6, 0x4e, 0xf9, 0x0f, 0xba, 0x66, 0xf4, 0x10, 0x74, 0xea, 0xd0, 0x31, 0xe8, 0x14, 0x74, 0xea,
-	0xc4, 0x16, 0xf2, 0xe0, 0x5d, 0x7f, 0x41, 0xc1, 0xbb, 0x23, 0x79, 0x94, 0x49, 0x18, 0x2e, 0x94,
-	0x45, 0xd0, 0xbd, 0xf7, 0xdd, 0x77, 0xf7, 0xbe, 0xf7, 0xe3, 0x08, 0x3e, 0xb2, 0x10, 0x71, 0x31,
-	0xd1, 0x02, 0xe4, 0xe3, 0x20, 0x24, 0xda, 0xd9, 0x9e, 0xe6, 0x12, 0x9b, 0xa8, 0x7e, 0x80, 0x43,
-	0x2c, 0xdf, 0x61, 0x5e, 0x95, 0x7b, 0xd5, 0xb3, 0xbd, 0xd6, 0x1d, 0xd3, 0x75, 0x3c, 0xac, 0xd1,
-	0x5f, 0x86, 0x6a, 0x35, 0x6c, 0x6c, 0x63, 0xfa, 0x57, 0x8b, 0xff, 0x71, 0xeb, 0xb6, 0x8d, 0xb1,
-	0x3d, 0x46, 0x1a, 0x5d, 0x0d, 0x27, 0xcf, 0x35, 0xd3, 0x9b, 0x72, 0x97, 0xb2, 0xe8, 0x0a, 0x1d,
-	0x17, 0x91, 0xd0, 0x74, 0xfd, 0x64, 0xef, 0x08, 0xc7, 0xe7, 0x0e, 0x18, 0x29, 0x5b, 0x70, 0xd7,
-	0x3d, 0xb6, 0x8a, 0x6f, 0xc9, 0x2f, 0xcb, 0x1d, 0xed, 0x82, 0x48, 0xb0, 0x85, 0xc6, 0x7c, 0x23,
-	0x7c, 0xb5, 0x0a, 0x6e, 0x1f, 0x11, 0xfb, 0xdb, 0x00, 0x99, 0x21, 0x32, 0x28, 0x48, 0xfe, 0x0e,
-	0x6c, 0x92, 0xc9, 0x90, 0xf8, 0xe6, 0x08, 0x0d, 0x1c, 0xab, 0x29, 0x75, 0xa4, 0xee, 0x9a, 0xfe,
-	0xf1, 0x2c, 0x52, 0xc0, 0x31, 0x37, 0xf7, 0x7b, 0xf3, 0x48, 0x91, 0xa7, 0xa6, 0x3b, 0x3e, 0x80,
-	0x02, 0x14, 0x1a, 0x20, 0x59, 0xf5, 0x2d, 0xf9, 0x09, 0xd8, 0x0c, 0x90, 0x49, 0xb0, 0x47, 0x06,
-	0x8e, 0x45, 0x9a, 0x95, 0xce, 0x6a, 0x77, 0x4b, 0x7f, 0x18, 0xd3, 0x18, 0xcc, 0xdc, 0xef, 0x91,
-	0x8c, 0x46, 0x80, 0xc2, 0x57, 0x97, 0xe7, 0x3b, 0x92, 0x01, 0xb8, 0xa9, 0x6f, 0x11, 0xf9, 0x33,
-	0xf0, 0x9e, 0x8b, 0x08, 0x31, 0x6d, 0xd4, 0x5c, 0xed, 0x48, 0xdd, 0xaa, 0x2e, 0xcf, 0x23, 0xe5,
-	0x7d, 0xb6, 0x93, 0x3b, 0xa0, 0x91, 0x40, 0xe4, 0xef, 0xc1, 0x2d, 0x16, 0x2f, 0x0a, 0x9a, 0x6b,
-	0x14, 0xfe, 0x68, 0x1e, 0x29, 0xb7, 0x93, 0x83, 0x98, 0x07, 0xfe, 0xf5, 0x7a, 0xb7, 0xc1, 0x45,
-	0x3c, 0xb4, 0xac, 0x00, 0x11, 0x72, 0x1c, 0x06, 0x8e, 0x67, 0x1b, 0xe9, 0x66, 0xd9, 0x04, 0x1b,
-	0xa1, 0x19, 0xd8, 0x28, 0x6c, 0xae, 0x77, 0xa4, 0xee, 0xe6, 0xe3, 0x86, 0xca, 0x72, 0xa4, 0x26,
-	0x39, 0x52, 0x0f, 0xbd, 0xa9, 0xbe, 0x3f, 0x8f, 0x94, 0x2d, 0x46, 0xce, 0xd0, 0xf0, 0xcf, 0xd7,
-	0xbb, 0xed, 0x2b, 0x45, 0xa2, 0x32, 0x79, 0x9f, 0x52, 0x88, 0xc1, 0x89, 0x0f, 0xba, 0x2f, 0x2e,
-	0xcf, 0x77, 0xd2, 0x13, 0x7f, 0xb9, 0x3c, 0xdf, 0xb9, 0xcb, 0x53, 0xb6, 0x90, 0x16, 0xf8, 0x87,
-	0x04, 0xee, 0x2d, 0xd8, 0x0c, 0x44, 0x7c, 0xec, 0x11, 0x24, 0x7f, 0x03, 0xaa, 0x8c, 0x22, 0x4b,
-	0x58, 0x67, 0x16, 0x29, 0xb7, 0x18, 0x8c, 0xa6, 0xab, 0x2e, 0x86, 0x4f, 0x93, 0xc5, 0x4f, 0xed,
-	0x5b, 0xf2, 0x73, 0xb0, 0x35, 0x8a, 0x69, 0x1d, 0xec, 0x0d, 0x2c, 0x33, 0x44, 0xcd, 0x0a, 0x0d,
-	0xb7, 0x75, 0x25, 0xdc, 0xa7, 0x49, 0x49, 0xea, 0x9f, 0xbc, 0x89, 0x94, 0x95, 0x79, 0xa4, 0x34,
-	0x18, 0x6d, 0x6e, 0x3b, 0x7c, 0xf9, 0x8f, 0x22, 0xb1, 0x24, 0xd6, 0x12, 0x47, 0x2f, 0xb6, 0xbf,
-	0xa8, 0xd0, 0x6a, 0xeb, 0xa1, 0x31, 0x5a, 0x76, 0xb5, 0xe5, 0x14, 0xa8, 0xdc, 0x58, 0x81, 0x43,
-	0xb0, 0x41, 0x1c, 0xdb, 0x43, 0x01, 0xaf, 0xaf, 0x87, 0x59, 0x4e, 0x99, 0xbd, 0xbc, 0x5c, 0xf8,
-	0xc6, 0x83, 0x07, 0x71, 0x26, 0xf9, 0x62, 0x21, 0x8f, 0x62, 0xc0, 0x70, 0x9b, 0xa6, 0x51, 0x34,
-	0x25, 0x69, 0x84, 0xbf, 0x55, 0x40, 0xf3, 0x88, 0xd8, 0xc7, 0x13, 0x3f, 0x36, 0x1f, 0x87, 0xa6,
-	0x67, 0x99, 0x81, 0xc5, 0xda, 0x65, 0x59, 0x42, 0x0d, 0x80, 0x4c, 0x38, 0xf1, 0x80, 0x75, 0x58,
-	0xa2, 0xd8, 0x96, 0xbe, 0x37, 0x8b, 0x94, 0x7a, 0xfe, 0x58, 0xca, 0xb9, 0xcd, 0x39, 0xaf, 0xec,
-	0x83, 0x46, 0x9d, 0xe4, 0xe1, 0x4b, 0x91, 0x52, 0x5b, 0x90, 0x52, 0xc9, 0xa4, 0x2c, 0xd4, 0x06,
-	0x9e, 0x82, 0x4e, 0x99, 0x2f, 0xed, 0x91, 0x5e, 0x7e, 0x1e, 0x49, 0x34, 0xe2, 0xfb, 0xb3, 0x48,
-	0xa9, 0xa6, 0xf3, 0xa8, 0x78, 0x1c, 0x89, 0x93, 0x08, 0xfe, 0x5a, 0x01, 0xb5, 0x23, 0x62, 0x1f,
-	0x5a, 0x4b, 0x4e, 0xcb, 0x03, 0xb0, 0x1e, 0x3a, 0xe1, 0x98, 0xb5, 0x5e, 0x55, 0xaf, 0xcf, 0x23,
-	0xa5, 0xc6, 0x67, 0x4a, 0x6c, 0x86, 0x06, 0x73, 0xcb, 0x5f, 0x81, 0x4d, 0x0b, 0x91, 0x51, 0xe0,
-	0xf8, 0x71, 0x57, 0x71, 0x89, 0xef, 0x66, 0x07, 0x08, 0x4e, 0x68, 0x88, 0x50, 0x21, 0x2f, 0x6b,
-	0xff, 0x37, 0x2f, 0xf7, 0x17, 0xf2, 0xf2, 0x41, 0x96, 0x97, 0x54, 0x10, 0x78, 0x02, 0x1a, 0xe2,
-	0x3a, 0x3f, 0xa3, 0x92, 0x7a, 0x63, 0xea, 0xf3, 0x0e, 0x4d, 0xeb, 0xac, 0x2e, 0x8a, 0x9f, 0x74,
-	0x28, 0x2b, 0xab, 0x64, 0x76, 0x18, 0xc8, 0xc5, 0x67, 0x68, 0xb9, 0xda, 0xe7, 0x6e, 0x56, 0xb9,
-	0xe9, 0xcd, 0xde, 0xf1, 0xec, 0x10, 0x03, 0xe6, 0xb3, 0x43, 0x34, 0xa5, 0xb3, 0xe3, 0x77, 0x89,
-	0xea, 0x73, 0xe2, 0xc7, 0x13, 0xf8, 0x07, 0x33, 0x30, 0x5d, 0x22, 0x7f, 0x01, 0xaa, 0xe6, 0x24,
-	0x3c, 0xc5, 0x81, 0x13, 0x4e, 0xa9, 0x3a, 0x55, 0xbd, 0x59, 0x7a, 0x97, 0x0c, 0x2a, 0x7f, 0x0d,
-	0x36, 0x7c, 0xca, 0xc0, 0x1f, 0x82, 0x6d, 0xf5, 0xea, 0x6b, 0xc6, 0x8e, 0xd0, 0xab, 0xf1, 0x3b,
-	0xc0, 0x66, 0x3d, 0xdf, 0x73, 0xb0, 0x1f, 0x07, 0x93, 0xb1, 0xc5, 0xf1, 0x74, 0x78, 0x3c, 0x3f,
-	0xa5, 0x1f, 0x22, 0x0b, 0x57, 0xe5, 0x91, 0x89, 0xa6, 0x24, 0xb2, 0xc7, 0xd1, 0x1a, 0x58, 0x3d,
-	0x22, 0xb6, 0xfc, 0x0c, 0xd4, 0x72, 0xdf, 0x29, 0xb0, 0xe0, 0x56, 0x0b, 0x0f, 0x64, 0x6b, 0xe7,
-	0x7a, 0x4c, 0x5a, 0xa0, 0xcf, 0x40, 0x2d, 0xf7, 0x32, 0x95, 0xf0, 0x8b, 0x98, 0x32, 0xfe, 0xa2,
-	0xe9, 0x2e, 0x4f, 0xc1, 0x87, 0xc5, 0x93, 0xfd, 0x51, 0x31, 0x49, 0x21, 0xb8, 0xb5, 0x7f, 0x03,
-	0x70, 0x7a, 0xf4, 0x09, 0xa8, 0x66, 0x13, 0x4b, 0x29, 0x66, 0x48, 0x01, 0xad, 0x4f, 0xaf, 0x01,
-	0x88, 0x8a, 0xe5, 0xfa, 0xb1, 0x44, 0x31, 0x11, 0x53, 0xa6, 0x58, 0x51, 0x4d, 0xc7, 0xfc, 0xb9,
-	0x7a, 0x2e, 0xe1, 0x17, 0x31, 0x65, 0xfc, 0x45, 0x95, 0xd5, 0x5a, 0xff, 0x39, 0x2e, 0x5c, 0xfd,
-	0xc9, 0x9b, 0x59, 0x5b, 0x7a, 0x3b, 0x6b, 0x4b, 0xff, 0xce, 0xda, 0xd2, 0xcb, 0x8b, 0xf6, 0xca,
-	0xdb, 0x8b, 0xf6, 0xca, 0xdf, 0x17, 0xed, 0x95, 0x1f, 0x3f, 0xb7, 0x9d, 0xf0, 0x74, 0x32, 0x54,
-	0x47, 0xd8, 0xd5, 0x18, 0xed, 0xee, 0xd8, 0x1c, 0x12, 0xfe, 0x5f, 0x3b, 0xfb, 0x52, 0xa8, 0xe8,
-	0x70, 0xea, 0x23, 0x32, 0xdc, 0xa0, 0xdf, 0x4a, 0xfb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0e,
-	0x31, 0xc8, 0x47, 0x43, 0x0c, 0x00, 0x00,
+	// 1228 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x41, 0x4f, 0xe3, 0x46,
+	0x14, 0xc6, 0x01, 0xd2, 0xcd, 0x00, 0x4b, 0x70, 0xb3, 0x10, 0xac, 0x6d, 0x1c, 0x0d, 0xdb, 0x25,
+	0x9b, 0x5d, 0x92, 0x05, 0xa4, 0xb6, 0x42, 0xed, 0x01, 0x97, 0xaa, 0x05, 0x09, 0xa9, 0x32, 0x70,
+	0xe9, 0x61, 0x23, 0x83, 0x67, 0x1d, 0x4b, 0x89, 0x6d, 0x79, 0x1c, 0xd4, 0xdc, 0xaa, 0x3d, 0x55,
+	0x3d, 0xed, 0x3f, 0xe8, 0x75, 0x8f, 0x48, 0x5d, 0xf5, 0xd4, 0x43, 0x8f, 0xab, 0x3d, 0xad, 0x7a,
+	0xea, 0xc9, 0xad, 0xc2, 0x81, 0x7b, 0xa4, 0xde, 0x2b, 0xcf, 0x8c, 0xed, 0xb1, 0x71, 0x14, 0x58,
+	0xa5, 0x52, 0x2f, 0x28, 0x7e, 0xef, 0x9b, 0x37, 0xf3, 0xbe, 0xef, 0xbd, 0x79, 0x03, 0xb8, 0xaf,
+	0x23, 0xdc, 0xb5, 0x71, 0xd3, 0x45, 0x8e, 0xed, 0x7a, 0xb8, 0x79, 0xbe, 0xd9, 0xec, 0x62, 0x03,
+	0x37, 0x1c, 0xd7, 0xf6, 0x6c, 0x71, 0x89, 0x7a, 0x1b, 0xcc, 0xdb, 0x38, 0xdf, 0x94, 0x96, 0xb4,
+	0xae, 0x69, 0xd9, 0x4d, 0xf2, 0x97, 0xa2, 0xa4, 0x92, 0x61, 0x1b, 0x36, 0xf9, 0xd9, 0x0c, 0x7e,
+	0x31, 0xeb, 0xaa, 0x61, 0xdb, 0x46, 0x07, 0x35, 0xc9, 0xd7, 0x69, 0xef, 0x79, 0x53, 0xb3, 0xfa,
+	0xcc, 0x25, 0xa7, 0x5d, 0x9e, 0xd9, 0x45, 0xd8, 0xd3, 0xba, 0x4e, 0xb8, 0xf6, 0xcc, 0x0e, 0xf6,
+	0x6d, 0xd1, 0xa0, 0xf4, 0x83, 0xb9, 0x56, 0xe8, 0x57, 0x70, 0x4a, 0x76, 0x58, 0xe6, 0xa8, 0x64,
+	0x64, 0x62, 0xeb, 0xa8, 0xc3, 0x16, 0xc2, 0x57, 0xd3, 0x60, 0xf1, 0x10, 0x1b, 0x5f, 0xba, 0x48,
+	0xf3, 0x90, 0x4a, 0x40, 0xe2, 0x57, 0x60, 0x0e, 0xf7, 0x4e, 0xb1, 0xa3, 0x9d, 0xa1, 0x96, 0xa9,
+	0x97, 0x85, 0xaa, 0x50, 0x9b, 0x51, 0x1e, 0x0c, 0x7c, 0x19, 0x1c, 0x31, 0xf3, 0xfe, 0xde, 0xd0,
+	0x97, 0xc5, 0xbe, 0xd6, 0xed, 0xec, 0x40, 0x0e, 0x0a, 0x55, 0x10, 0x7e, 0xed, 0xeb, 0xe2, 0x01,
+	0x98, 0x73, 0x91, 0x86, 0x6d, 0x0b, 0xb7, 0x4c, 0x1d, 0x97, 0x73, 0xd5, 0xe9, 0xda, 0x82, 0xf2,
+	0x28, 0x08, 0xa3, 0x52, 0xf3, 0xfe, 0x1e, 0x8e, 0xc3, 0x70, 0x50, 0xf8, 0xea, 0xea, 0xa2, 0x2e,
+	0xa8, 0x80, 0x99, 0xf6, 0x75, 0x2c, 0x3e, 0x01, 0x1f, 0x74, 0x11, 0xc6, 0x9a, 0x81, 0xca, 0xd3,
+	0x55, 0xa1, 0x56, 0x50, 0xc4, 0xa1, 0x2f, 0xdf, 0xa5, 0x2b, 0x99, 0x03, 0xaa, 0x21, 0x44, 0xfc,
+	0x1a, 0xdc, 0xa1, 0xf9, 0x22, 0xb7, 0x3c, 0x43, 0xe0, 0x8f, 0x87, 0xbe, 0xbc, 0x18, 0x6e, 0x44,
+	0x3d, 0xf0, 0x8f, 0xd7, 0x1b, 0x25, 0x46, 0xe2, 0xae, 0xae, 0xbb, 0x08, 0xe3, 0x23, 0xcf, 0x35,
+	0x2d, 0x43, 0x8d, 0x16, 0x8b, 0x1a, 0xc8, 0x7b, 0x9a, 0x6b, 0x20, 0xaf, 0x3c, 0x5b, 0x15, 0x6a,
+	0x73, 0x5b, 0xa5, 0x06, 0xd5, 0xa8, 0x11, 0x6a, 0xd4, 0xd8, 0xb5, 0xfa, 0xca, 0xf6, 0xd0, 0x97,
+	0x17, 0x68, 0x70, 0x8a, 0x86, 0x6f, 0x5f, 0x6f, 0x54, 0xae, 0x15, 0x49, 0x83, 0xd2, 0x7b, 0x4c,
+	0x20, 0x2a, 0x0b, 0xbc, 0x53, 0x7b, 0x71, 0x75, 0x51, 0x8f, 0x76, 0xfc, 0xe9, 0xea, 0xa2, 0xbe,
+	0xcc, 0x24, 0x4b, 0xc9, 0x02, 0x7f, 0x17, 0xc0, 0x4a, 0xca, 0xa6, 0x22, 0xec, 0xd8, 0x16, 0x46,
+	0xe2, 0x17, 0xa0, 0x40, 0x43, 0xc4, 0x82, 0x55, 0x07, 0xbe, 0x7c, 0x87, 0xc2, 0x88, 0x5c, 0x45,
+	0x3e, 0x7d, 0x22, 0x16, 0xdb, 0x75, 0x5f, 0x17, 0x9f, 0x83, 0x85, 0xb3, 0x20, 0xac, 0x69, 0x5b,
+	0x2d, 0x5d, 0xf3, 0x50, 0x39, 0x47, 0xd2, 0x95, 0xae, 0xa5, 0x7b, 0x1c, 0x96, 0xa4, 0xf2, 0xf1,
+	0x1b, 0x5f, 0x9e, 0x1a, 0xfa, 0x72, 0x89, 0x86, 0x4d, 0x2c, 0x87, 0x2f, 0xff, 0x92, 0x05, 0x2a,
+	0xe2, 0x7c, 0xe8, 0xd8, 0x0b, 0xec, 0x2f, 0x72, 0xa4, 0xda, 0xf6, 0x50, 0x07, 0x4d, 0xba, 0xda,
+	0x12, 0x0c, 0xe4, 0x6e, 0xcd, 0xc0, 0x2e, 0xc8, 0x63, 0xd3, 0xb0, 0x90, 0xcb, 0xea, 0xeb, 0x51,
+	0xac, 0x29, 0xb5, 0x8f, 0x2e, 0x17, 0xb6, 0x70, 0xe7, 0x61, 0xa0, 0x24, 0xfb, 0x48, 0xe9, 0xc8,
+	0x27, 0x0c, 0x57, 0xc1, 0x4a, 0xca, 0x14, 0xca, 0x08, 0xff, 0xc9, 0x81, 0xe2, 0x21, 0x36, 0x54,
+	0x84, 0xed, 0xce, 0xf9, 0xff, 0x8b, 0xa0, 0x03, 0x90, 0xc7, 0x9e, 0xe6, 0xf5, 0x30, 0x21, 0xe8,
+	0xee, 0x96, 0xdc, 0x18, 0x55, 0xe0, 0x47, 0x04, 0xa6, 0x2c, 0x71, 0x0c, 0x12, 0x0b, 0x54, 0x59,
+	0x04, 0x71, 0x0d, 0xcc, 0x58, 0xb6, 0x87, 0x58, 0x6f, 0x2e, 0x0e, 0x7d, 0x79, 0x8e, 0x02, 0x03,
+	0x2b, 0x54, 0x89, 0x93, 0x53, 0x64, 0xf6, 0x7d, 0x15, 0x59, 0x4f, 0x29, 0xb2, 0x12, 0x2b, 0x92,
+	0xa0, 0x18, 0xfe, 0x28, 0x80, 0x72, 0xda, 0x18, 0xf5, 0x56, 0x07, 0x2c, 0xba, 0x81, 0xa3, 0x17,
+	0xb7, 0x87, 0x30, 0xb6, 0x3d, 0xd6, 0x59, 0x7b, 0x2c, 0x87, 0x94, 0x26, 0x02, 0x70, 0x0d, 0x72,
+	0x37, 0x76, 0x91, 0x16, 0xf9, 0x35, 0x47, 0x8e, 0x72, 0xd4, 0x73, 0x18, 0x97, 0x96, 0xae, 0xb9,
+	0x3a, 0xbd, 0x31, 0x27, 0x55, 0x0a, 0x2d, 0x20, 0x62, 0x16, 0xb8, 0x45, 0x2f, 0xd9, 0xb0, 0x26,
+	0x16, 0x94, 0xcd, 0x81, 0x2f, 0x17, 0x93, 0xdb, 0x92, 0x98, 0xab, 0x91, 0x94, 0xa9, 0x75, 0x50,
+	0x2d, 0xe2, 0x24, 0x7c, 0x22, 0xdd, 0xd4, 0x4c, 0x69, 0x27, 0xc7, 0xda, 0x65, 0x72, 0x03, 0xdb,
+	0xa0, 0x3a, 0xca, 0x17, 0x49, 0xb9, 0x97, 0x1c, 0x49, 0x02, 0xc9, 0x78, 0x6d, 0xe0, 0xcb, 0x85,
+	0x68, 0x24, 0x65, 0x4f, 0x24, 0x7e, 0x18, 0xc1, 0x9f, 0x73, 0x60, 0xfe, 0x10, 0x1b, 0xbb, 0xfa,
+	0x84, 0x65, 0x79, 0x08, 0x66, 0x3d, 0xd3, 0xeb, 0xd0, 0xdb, 0xb7, 0xa0, 0x14, 0x87, 0xbe, 0x3c,
+	0x4f, 0x97, 0x10, 0x33, 0x54, 0xa9, 0x5b, 0xfc, 0x0c, 0xcc, 0xe9, 0x08, 0x9f, 0xb9, 0xa6, 0x13,
+	0x54, 0x0d, 0xa3, 0x78, 0x39, 0xde, 0x80, 0x73, 0x42, 0x95, 0x87, 0x72, 0xba, 0xcc, 0xbc, 0xaf,
+	0x2e, 0x6b, 0x29, 0x5d, 0x3e, 0x8c, 0x75, 0x89, 0x08, 0x81, 0x27, 0xa0, 0xc4, 0x7f, 0x27, 0xc7,
+	0x54, 0x58, 0x6f, 0x94, 0x7d, 0x76, 0x07, 0x45, 0x75, 0x56, 0xe4, 0xc9, 0x0f, 0xef, 0x20, 0xe2,
+	0xd5, 0xc3, 0xf1, 0xa1, 0xa2, 0xae, 0x1d, 0x74, 0xe9, 0x24, 0xb9, 0x4f, 0x9c, 0x2c, 0x77, 0xdb,
+	0x93, 0xfd, 0xc7, 0xe3, 0x83, 0x4f, 0x98, 0x8d, 0x0f, 0xde, 0x14, 0x8d, 0x8f, 0xb7, 0x39, 0x70,
+	0x2f, 0xe8, 0x01, 0xe4, 0x7d, 0x63, 0xea, 0xa6, 0x65, 0x1c, 0xb7, 0x5d, 0x84, 0xdb, 0x76, 0x47,
+	0x9f, 0x14, 0x4b, 0x5b, 0xa0, 0xe0, 0x85, 0x31, 0x19, 0x4b, 0xa5, 0x98, 0x99, 0xc8, 0x05, 0xd5,
+	0x82, 0xc7, 0x6f, 0xcd, 0xf7, 0xdc, 0x34, 0x79, 0x06, 0x3e, 0xb8, 0xc9, 0x33, 0x30, 0xf1, 0x02,
+	0x9c, 0x40, 0xe9, 0x3e, 0x49, 0x31, 0x7c, 0x9f, 0xbb, 0x52, 0xae, 0x51, 0x06, 0x65, 0xf0, 0x51,
+	0xa6, 0x23, 0x62, 0xfb, 0x37, 0x81, 0x54, 0xe3, 0x89, 0x13, 0xdc, 0xe8, 0xdf, 0x6a, 0xae, 0xd6,
+	0xc5, 0xe2, 0x27, 0xa0, 0xa0, 0xf5, 0xbc, 0xb6, 0xed, 0x9a, 0x5e, 0x9f, 0xb0, 0x5c, 0x50, 0xca,
+	0x23, 0xcf, 0x15, 0x43, 0xc5, 0xcf, 0x41, 0xde, 0x21, 0x11, 0xd8, 0xcb, 0x6b, 0x35, 0x63, 0xba,
+	0xd2, 0x2d, 0x94, 0x42, 0x30, 0x59, 0xe8, 0xec, 0x60, 0x6b, 0x76, 0xb6, 0x83, 0xc4, 0xe2, 0x68,
+	0x41, 0x6e, 0x55, 0x96, 0xdb, 0xf7, 0xd1, 0xcb, 0x3f, 0x75, 0x54, 0x56, 0x47, 0xbc, 0x29, 0xcc,
+	0x6c, 0xeb, 0x97, 0x3c, 0x98, 0x3e, 0xc4, 0x86, 0xf8, 0x0c, 0xcc, 0x27, 0xfe, 0x31, 0x80, 0x19,
+	0xa7, 0x4a, 0xbd, 0x48, 0xa5, 0xfa, 0x78, 0x4c, 0x74, 0x1d, 0x3c, 0x03, 0xf3, 0x89, 0xa7, 0xe0,
+	0x88, 0xf8, 0x3c, 0x46, 0xaa, 0x8f, 0xc7, 0x44, 0xf1, 0x35, 0xb0, 0x90, 0x7c, 0x4a, 0xad, 0x65,
+	0x2f, 0x4e, 0x80, 0xa4, 0xc7, 0x37, 0x00, 0x45, 0x5b, 0xf4, 0xc1, 0xbd, 0xec, 0x51, 0x3d, 0x22,
+	0x4a, 0x26, 0x58, 0xda, 0xbe, 0x05, 0x38, 0xda, 0xfa, 0x04, 0x14, 0xe2, 0x11, 0x24, 0x67, 0x47,
+	0x88, 0x00, 0xd2, 0xfa, 0x18, 0x00, 0x2f, 0x4a, 0xe2, 0x82, 0x85, 0xa3, 0xe8, 0x88, 0x31, 0x52,
+	0x7d, 0x3c, 0x26, 0x8a, 0xef, 0x00, 0x31, 0xe3, 0x82, 0xaa, 0x8d, 0x60, 0xe0, 0x1a, 0x52, 0x7a,
+	0x7a, 0x53, 0x24, 0x9f, 0x51, 0xa2, 0x49, 0x47, 0x64, 0xc4, 0x63, 0xa4, 0xfa, 0x78, 0x4c, 0x18,
+	0x5f, 0x9a, 0xfd, 0x21, 0xe8, 0x46, 0xe5, 0xe0, 0xcd, 0xa0, 0x22, 0xbc, 0x1b, 0x54, 0x84, 0xbf,
+	0x07, 0x15, 0xe1, 0xe5, 0x65, 0x65, 0xea, 0xdd, 0x65, 0x65, 0xea, 0xcf, 0xcb, 0xca, 0xd4, 0x77,
+	0x4f, 0x0d, 0xd3, 0x6b, 0xf7, 0x4e, 0x1b, 0x67, 0x76, 0xb7, 0x49, 0xc3, 0x6e, 0x74, 0xb4, 0x53,
+	0xcc, 0x7e, 0x37, 0xcf, 0x3f, 0xe5, 0xda, 0xd4, 0xeb, 0x3b, 0x08, 0x9f, 0xe6, 0xc9, 0x93, 0x72,
+	0xfb, 0xdf, 0x01, 0x00, 0x36, 0x4e, 0x24, 0xdb, 0x89, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -809,6 +1068,8 @@ type MsgClient interface {
 	CreateReport(ctx context.Context, in *MsgCreateReport, opts ...grpc.CallOption) (*MsgCreateReportResponse, error)
 	// DeleteReport allows to delete an existing report
 	DeleteReport(ctx context.Context, in *MsgDeleteReport, opts ...grpc.CallOption) (*MsgDeleteReportResponse, error)
+	// ResolveReport allows to update the moderation status of an existing report
+	ResolveReport(ctx context.Context, in *MsgResolveReport, opts ...grpc.CallOption) (*MsgResolveReportResponse, error)
 	// SupportStandardReason allows to support one of the reasons present inside
 	// the module params
 	SupportStandardReason(ctx context.Context, in *MsgSupportStandardReason, opts ...grpc.CallOption) (*MsgSupportStandardReasonResponse, error)
@@ -816,6 +1077,9 @@ type MsgClient interface {
 	AddReason(ctx context.Context, in *MsgAddReason, opts ...grpc.CallOption) (*MsgAddReasonResponse, error)
 	// RemoveReason allows to remove a supported reporting reason
 	RemoveReason(ctx context.Context, in *MsgRemoveReason, opts ...grpc.CallOption) (*MsgRemoveReasonResponse, error)
+	// SetHidingThreshold allows to set the number of reports after which a post
+	// gets automatically hidden inside a subspace
+	SetHidingThreshold(ctx context.Context, in *MsgSetHidingThreshold, opts ...grpc.CallOption) (*MsgSetHidingThresholdResponse, error)
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -850,6 +1114,15 @@ func (c *msgClient) DeleteReport(ctx context.Context, in *MsgDeleteReport, opts
 	return out, nil
 }
 
+func (c *msgClient) ResolveReport(ctx context.Context, in *MsgResolveReport, opts ...grpc.CallOption) (*MsgResolveReportResponse, error) {
+	out := new(MsgResolveReportResponse)
+	err := c.cc.Invoke(ctx, "/desmos.reports.v1.Msg/ResolveReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SupportStandardReason(ctx context.Context, in *MsgSupportStandardReason, opts ...grpc.CallOption) (*MsgSupportStandardReasonResponse, error) {
 	out := new(MsgSupportStandardReasonResponse)
 	err := c.cc.Invoke(ctx, "/desmos.reports.v1.Msg/SupportStandardReason", in, out, opts...)
@@ -877,6 +1150,15 @@ func (c *msgClient) RemoveReason(ctx context.Context, in *MsgRemoveReason, opts
 	return out, nil
 }
 
+func (c *msgClient) SetHidingThreshold(ctx context.Context, in *MsgSetHidingThreshold, opts ...grpc.CallOption) (*MsgSetHidingThresholdResponse, error) {
+	out := new(MsgSetHidingThresholdResponse)
+	err := c.cc.Invoke(ctx, "/desmos.reports.v1.Msg/SetHidingThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/desmos.reports.v1.Msg/UpdateParams", in, out, opts...)
@@ -892,6 +1174,8 @@ type MsgServer interface {
 	CreateReport(context.Context, *MsgCreateReport) (*MsgCreateReportResponse, error)
 	// DeleteReport allows to delete an existing report
 	DeleteReport(context.Context, *MsgDeleteReport) (*MsgDeleteReportResponse, error)
+	// ResolveReport allows to update the moderation status of an existing report
+	ResolveReport(context.Context, *MsgResolveReport) (*MsgResolveReportResponse, error)
 	// SupportStandardReason allows to support one of the reasons present inside
 	// the module params
 	SupportStandardReason(context.Context, *MsgSupportStandardReason) (*MsgSupportStandardReasonResponse, error)
@@ -899,6 +1183,9 @@ type MsgServer interface {
 	AddReason(context.Context, *MsgAddReason) (*MsgAddReasonResponse, error)
 	// RemoveReason allows to remove a supported reporting reason
 	RemoveReason(context.Context, *MsgRemoveReason) (*MsgRemoveReasonResponse, error)
+	// SetHidingThreshold allows to set the number of reports after which a post
+	// gets automatically hidden inside a subspace
+	SetHidingThreshold(context.Context, *MsgSetHidingThreshold) (*MsgSetHidingThresholdResponse, error)
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -917,6 +1204,9 @@ func (*UnimplementedMsgServer) CreateReport(ctx context.Context, req *MsgCreateR
 func (*UnimplementedMsgServer) DeleteReport(ctx context.Context, req *MsgDeleteReport) (*MsgDeleteReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteReport not implemented")
 }
+func (*UnimplementedMsgServer) ResolveReport(ctx context.Context, req *MsgResolveReport) (*MsgResolveReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
 func (*UnimplementedMsgServer) SupportStandardReason(ctx context.Context, req *MsgSupportStandardReason) (*MsgSupportStandardReasonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupportStandardReason not implemented")
 }
@@ -926,6 +1216,9 @@ func (*UnimplementedMsgServer) AddReason(ctx context.Context, req *MsgAddReason)
 func (*UnimplementedMsgServer) RemoveReason(ctx context.Context, req *MsgRemoveReason) (*MsgRemoveReasonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveReason not implemented")
 }
+func (*UnimplementedMsgServer) SetHidingThreshold(ctx context.Context, req *MsgSetHidingThreshold) (*MsgSetHidingThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHidingThreshold not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -970,6 +1263,24 @@ func _Msg_DeleteReport_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.reports.v1.Msg/ResolveReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveReport(ctx, req.(*MsgResolveReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SupportStandardReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSupportStandardReason)
 	if err := dec(in); err != nil {
@@ -1024,6 +1335,24 @@ func _Msg_RemoveReason_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetHidingThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHidingThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHidingThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.reports.v1.Msg/SetHidingThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHidingThreshold(ctx, req.(*MsgSetHidingThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1054,6 +1383,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteReport",
 			Handler:    _Msg_DeleteReport_Handler,
 		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _Msg_ResolveReport_Handler,
+		},
 		{
 			MethodName: "SupportStandardReason",
 			Handler:    _Msg_SupportStandardReason_Handler,
@@ -1066,6 +1399,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveReason",
 			Handler:    _Msg_RemoveReason_Handler,
 		},
+		{
+			MethodName: "SetHidingThreshold",
+			Handler:    _Msg_SetHidingThreshold_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1246,7 +1583,7 @@ func (m *MsgDeleteReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSupportStandardReason) Marshal() (dAtA []byte, err error) {
+func (m *MsgResolveReport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1256,12 +1593,12 @@ func (m *MsgSupportStandardReason) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSupportStandardReason) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgResolveReport) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSupportStandardReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgResolveReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1271,10 +1608,22 @@ func (m *MsgSupportStandardReason) MarshalToSizedBuffer(dAtA []byte) (int, error
 		copy(dAtA[i:], m.Signer)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if m.StandardReasonID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.StandardReasonID))
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReportID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ReportID))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -1286,7 +1635,7 @@ func (m *MsgSupportStandardReason) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSupportStandardReasonResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgResolveReportResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1296,25 +1645,28 @@ func (m *MsgSupportStandardReasonResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSupportStandardReasonResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgResolveReportResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSupportStandardReasonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgResolveReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReasonsID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.ReasonsID))
-		i--
-		dAtA[i] = 0x8
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ResolutionDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResolutionDate):])
+	if err5 != nil {
+		return 0, err5
 	}
+	i -= n5
+	i = encodeVarintMsgs(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddReason) Marshal() (dAtA []byte, err error) {
+func (m *MsgSupportStandardReason) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1324,12 +1676,12 @@ func (m *MsgAddReason) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddReason) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSupportStandardReason) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSupportStandardReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1339,21 +1691,12 @@ func (m *MsgAddReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.Signer)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Title)))
+	if m.StandardReasonID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.StandardReasonID))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.SubspaceID != 0 {
 		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
@@ -1363,7 +1706,7 @@ func (m *MsgAddReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddReasonResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSupportStandardReasonResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1373,25 +1716,102 @@ func (m *MsgAddReasonResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddReasonResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSupportStandardReasonResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddReasonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSupportStandardReasonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReasonID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.ReasonID))
+	if m.ReasonsID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ReasonsID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveReason) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddReason) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddReason) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddReasonResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddReasonResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddReasonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReasonID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ReasonID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveReason) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1454,6 +1874,87 @@ func (m *MsgRemoveReasonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetHidingThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHidingThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHidingThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReasonsIDs) > 0 {
+		dAtA7 := make([]byte, len(m.ReasonsIDs)*10)
+		var j6 int
+		for _, num := range m.ReasonsIDs {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintMsgs(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHidingThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHidingThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHidingThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1601,6 +2102,43 @@ func (m *MsgDeleteReportResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgResolveReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.ReportID != 0 {
+		n += 1 + sovMsgs(uint64(m.ReportID))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMsgs(uint64(m.Status))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResolutionDate)
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
 func (m *MsgSupportStandardReason) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1696,6 +2234,41 @@ func (m *MsgRemoveReasonResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetHidingThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovMsgs(uint64(m.Threshold))
+	}
+	if len(m.ReasonsIDs) > 0 {
+		l = 0
+		for _, e := range m.ReasonsIDs {
+			l += sovMsgs(uint64(e))
+		}
+		n += 1 + sovMsgs(uint64(l)) + l
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetHidingThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2243,7 +2816,7 @@ func (m *MsgDeleteReportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupportStandardReason) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2266,10 +2839,10 @@ func (m *MsgSupportStandardReason) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupportStandardReason: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupportStandardReason: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2293,9 +2866,9 @@ func (m *MsgSupportStandardReason) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StandardReasonID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportID", wireType)
 			}
-			m.StandardReasonID = 0
+			m.ReportID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2305,14 +2878,33 @@ func (m *MsgSupportStandardReason) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StandardReasonID |= uint32(b&0x7F) << shift
+				m.ReportID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ReportStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2340,15 +2932,250 @@ func (m *MsgSupportStandardReason) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Note = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ResolutionDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupportStandardReason) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupportStandardReason: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupportStandardReason: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardReasonID", wireType)
+			}
+			m.StandardReasonID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StandardReasonID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if (iNdEx + skippy) > l {
@@ -2836,6 +3663,252 @@ func (m *MsgRemoveReasonResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetHidingThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHidingThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHidingThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReasonsIDs = append(m.ReasonsIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReasonsIDs) == 0 {
+					m.ReasonsIDs = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReasonsIDs = append(m.ReasonsIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonsIDs", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHidingThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHidingThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHidingThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
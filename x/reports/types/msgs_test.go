@@ -197,6 +197,115 @@ func TestMsgDeleteReport_GetSigners(t *testing.T) {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+var msgResolveReport = types.NewMsgResolveReport(
+	1,
+	1,
+	types.ReportStatusDismissed,
+	"This post is not spam",
+	"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+)
+
+func TestMsgResolveReport_Route(t *testing.T) {
+	require.Equal(t, types.ModuleName, msgResolveReport.Route())
+}
+
+func TestMsgResolveReport_Type(t *testing.T) {
+	require.Equal(t, types.ActionResolveReport, msgResolveReport.Type())
+}
+
+func TestMsgResolveReport_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgResolveReport
+		shouldErr bool
+	}{
+		{
+			name: "invalid subspace id returns error",
+			msg: types.NewMsgResolveReport(
+				0,
+				1,
+				types.ReportStatusDismissed,
+				"This post is not spam",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid report id returns error",
+			msg: types.NewMsgResolveReport(
+				1,
+				0,
+				types.ReportStatusDismissed,
+				"This post is not spam",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "open status returns error",
+			msg: types.NewMsgResolveReport(
+				1,
+				1,
+				types.ReportStatusOpen,
+				"This post is not spam",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid status returns error",
+			msg: types.NewMsgResolveReport(
+				1,
+				1,
+				types.ReportStatus(10),
+				"This post is not spam",
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid signer returns error",
+			msg: types.NewMsgResolveReport(
+				1,
+				1,
+				types.ReportStatusDismissed,
+				"This post is not spam",
+				"",
+			),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message returns no error",
+			msg:       msgResolveReport,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgResolveReport_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgResolveReport","value":{"note":"This post is not spam","report_id":"1","signer":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","status":3,"subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgResolveReport.GetSignBytes()))
+}
+
+func TestMsgResolveReport_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgResolveReport.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgResolveReport.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 var msgSupportStandardReason = types.NewMsgSupportStandardReason(
 	1,
 	1,
@@ -438,6 +547,119 @@ func TestMsgRemoveReason_GetSigners(t *testing.T) {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+var msgSetHidingThreshold = types.NewMsgSetHidingThreshold(
+	1,
+	5,
+	[]uint32{1, 2},
+	"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+)
+
+func TestMsgSetHidingThreshold_Route(t *testing.T) {
+	require.Equal(t, types.ModuleName, msgSetHidingThreshold.Route())
+}
+
+func TestMsgSetHidingThreshold_Type(t *testing.T) {
+	require.Equal(t, types.ActionSetHidingThreshold, msgSetHidingThreshold.Type())
+}
+
+func TestMsgSetHidingThreshold_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetHidingThreshold
+		shouldErr bool
+	}{
+		{
+			name: "invalid subspace id returns error",
+			msg: types.NewMsgSetHidingThreshold(
+				0,
+				5,
+				[]uint32{1, 2},
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "reasons with zero threshold returns error",
+			msg: types.NewMsgSetHidingThreshold(
+				1,
+				0,
+				[]uint32{1, 2},
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid reason id returns error",
+			msg: types.NewMsgSetHidingThreshold(
+				1,
+				5,
+				[]uint32{0},
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated reason id returns error",
+			msg: types.NewMsgSetHidingThreshold(
+				1,
+				5,
+				[]uint32{1, 1},
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid signer returns error",
+			msg: types.NewMsgSetHidingThreshold(
+				1,
+				5,
+				[]uint32{1, 2},
+				"",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero threshold returns no error",
+			msg: types.NewMsgSetHidingThreshold(
+				1,
+				0,
+				nil,
+				"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+			shouldErr: false,
+		},
+		{
+			name:      "valid message returns no error",
+			msg:       msgSetHidingThreshold,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetHidingThreshold_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgSetHidingThreshold","value":{"reasons_ids":[1,2],"signer":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","subspace_id":"1","threshold":5}}`
+	require.Equal(t, expected, string(msgSetHidingThreshold.GetSignBytes()))
+}
+
+func TestMsgSetHidingThreshold_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgSetHidingThreshold.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgSetHidingThreshold.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 var msgUpdateParams = types.NewMsgUpdateParams(
 	types.DefaultParams(),
 	"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
@@ -12,7 +12,9 @@ func (r *QueryReportsRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 }
 
 // NewQueryReportsRequest returns a new QueryReportsRequest instance
-func NewQueryReportsRequest(subspaceID uint64, target ReportTarget, reporter string, pagination *query.PageRequest) *QueryReportsRequest {
+func NewQueryReportsRequest(
+	subspaceID uint64, target ReportTarget, reporter string, statuses []ReportStatus, pagination *query.PageRequest,
+) *QueryReportsRequest {
 	var targetAny *codectypes.Any
 	if target != nil {
 		any, err := codectypes.NewAnyWithValue(target)
@@ -26,6 +28,7 @@ func NewQueryReportsRequest(subspaceID uint64, target ReportTarget, reporter str
 		SubspaceId: subspaceID,
 		Target:     targetAny,
 		Reporter:   reporter,
+		Statuses:   statuses,
 		Pagination: pagination,
 	}
 }
@@ -70,6 +73,13 @@ func NewQueryReasonRequest(subspaceID uint64, reasonID uint32) *QueryReasonReque
 	}
 }
 
+// NewQueryHidingThresholdRequest returns a new QueryHidingThresholdRequest instance
+func NewQueryHidingThresholdRequest(subspaceID uint64) *QueryHidingThresholdRequest {
+	return &QueryHidingThresholdRequest{
+		SubspaceId: subspaceID,
+	}
+}
+
 // NewQueryParamsRequest returns a new QueryParamsRequest instance
 func NewQueryParamsRequest() *QueryParamsRequest {
 	return &QueryParamsRequest{}
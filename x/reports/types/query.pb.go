@@ -44,6 +44,9 @@ type QueryReportsRequest struct {
 	Reporter string `protobuf:"bytes,3,opt,name=reporter,proto3" json:"reporter,omitempty" yaml:"reporter"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty" yaml:"pagination"`
+	// (optional) Statuses to filter the reports with.
+	// If empty, reports with any status are returned
+	Statuses []ReportStatus `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=desmos.reports.v1.ReportStatus" json:"statuses,omitempty" yaml:"statuses"`
 }
 
 func (m *QueryReportsRequest) Reset()         { *m = QueryReportsRequest{} }
@@ -107,6 +110,13 @@ func (m *QueryReportsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryReportsRequest) GetStatuses() []ReportStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
 // QueryReportsResponse is the response type for Query/Reports RPC method
 type QueryReportsResponse struct {
 	Reports    []Report            `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports" yaml:"reports"`
@@ -468,6 +478,99 @@ func (m *QueryReasonResponse) GetReason() Reason {
 	return Reason{}
 }
 
+// QueryHidingThresholdRequest is the request type for Query/HidingThreshold
+// RPC method
+type QueryHidingThresholdRequest struct {
+	// Id of the subspace to query the threshold for
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+}
+
+func (m *QueryHidingThresholdRequest) Reset()         { *m = QueryHidingThresholdRequest{} }
+func (m *QueryHidingThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHidingThresholdRequest) ProtoMessage()    {}
+func (*QueryHidingThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0e9c7b1802c3e20, []int{8}
+}
+func (m *QueryHidingThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHidingThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHidingThresholdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHidingThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHidingThresholdRequest.Merge(m, src)
+}
+func (m *QueryHidingThresholdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHidingThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHidingThresholdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHidingThresholdRequest proto.InternalMessageInfo
+
+func (m *QueryHidingThresholdRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
+	}
+	return 0
+}
+
+// QueryHidingThresholdResponse is the response type for Query/HidingThreshold
+// RPC method
+type QueryHidingThresholdResponse struct {
+	HidingThreshold HidingThreshold `protobuf:"bytes,1,opt,name=hiding_threshold,json=hidingThreshold,proto3" json:"hiding_threshold" yaml:"hiding_threshold"`
+}
+
+func (m *QueryHidingThresholdResponse) Reset()         { *m = QueryHidingThresholdResponse{} }
+func (m *QueryHidingThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHidingThresholdResponse) ProtoMessage()    {}
+func (*QueryHidingThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0e9c7b1802c3e20, []int{9}
+}
+func (m *QueryHidingThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHidingThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHidingThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHidingThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHidingThresholdResponse.Merge(m, src)
+}
+func (m *QueryHidingThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHidingThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHidingThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHidingThresholdResponse proto.InternalMessageInfo
+
+func (m *QueryHidingThresholdResponse) GetHidingThreshold() HidingThreshold {
+	if m != nil {
+		return m.HidingThreshold
+	}
+	return HidingThreshold{}
+}
+
 // QueryParamsRequest is the request type for Query/Params RPC method
 type QueryParamsRequest struct {
 }
@@ -476,7 +579,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0e9c7b1802c3e20, []int{8}
+	return fileDescriptor_f0e9c7b1802c3e20, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,7 +617,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0e9c7b1802c3e20, []int{9}
+	return fileDescriptor_f0e9c7b1802c3e20, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,6 +662,8 @@ func init() {
 	proto.RegisterType((*QueryReasonsResponse)(nil), "desmos.reports.v1.QueryReasonsResponse")
 	proto.RegisterType((*QueryReasonRequest)(nil), "desmos.reports.v1.QueryReasonRequest")
 	proto.RegisterType((*QueryReasonResponse)(nil), "desmos.reports.v1.QueryReasonResponse")
+	proto.RegisterType((*QueryHidingThresholdRequest)(nil), "desmos.reports.v1.QueryHidingThresholdRequest")
+	proto.RegisterType((*QueryHidingThresholdResponse)(nil), "desmos.reports.v1.QueryHidingThresholdResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "desmos.reports.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "desmos.reports.v1.QueryParamsResponse")
 }
@@ -566,59 +671,67 @@ func init() {
 func init() { proto.RegisterFile("desmos/reports/v1/query.proto", fileDescriptor_f0e9c7b1802c3e20) }
 
 var fileDescriptor_f0e9c7b1802c3e20 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0x13, 0x4b,
-	0x1c, 0xcf, 0xa6, 0x6d, 0xfa, 0x3a, 0xa5, 0xef, 0xbd, 0xce, 0xcb, 0x7b, 0xa4, 0xe9, 0x73, 0x13,
-	0x17, 0x4c, 0x43, 0xa5, 0xbb, 0x26, 0x15, 0x0a, 0x3d, 0x88, 0xcd, 0x41, 0xa9, 0x20, 0xd4, 0xe8,
-	0x49, 0xd0, 0x32, 0xe9, 0x8e, 0x6b, 0x20, 0xd9, 0xd9, 0xee, 0x6c, 0x82, 0xb1, 0x14, 0xc4, 0xbf,
-	0x40, 0x10, 0xbc, 0x7a, 0xf5, 0x22, 0x78, 0xe8, 0x1f, 0x51, 0x3c, 0x15, 0xbd, 0xe8, 0x25, 0x48,
-	0x2b, 0x78, 0xcf, 0xcd, 0x9b, 0xec, 0x7c, 0x67, 0x93, 0x5d, 0xd3, 0x6e, 0xd4, 0xaa, 0x97, 0x30,
-	0x33, 0xdf, 0x1f, 0x9f, 0xcf, 0xf7, 0xb3, 0x9f, 0xd9, 0x0d, 0x3a, 0x63, 0x52, 0xde, 0x64, 0xdc,
-	0x70, 0xa9, 0xc3, 0x5c, 0x8f, 0x1b, 0xed, 0x92, 0xb1, 0xdd, 0xa2, 0x6e, 0x47, 0x77, 0x5c, 0xe6,
-	0x31, 0x3c, 0x0b, 0x61, 0x5d, 0x86, 0xf5, 0x76, 0x29, 0x3b, 0x4b, 0x9a, 0x75, 0x9b, 0x19, 0xe2,
-	0x17, 0xb2, 0xb2, 0x69, 0x8b, 0x59, 0x4c, 0x2c, 0x0d, 0x7f, 0x25, 0x4f, 0xff, 0xb7, 0x18, 0xb3,
-	0x1a, 0xd4, 0x20, 0x4e, 0xdd, 0x20, 0xb6, 0xcd, 0x3c, 0xe2, 0xd5, 0x99, 0xcd, 0x65, 0x74, 0x4e,
-	0x46, 0xc5, 0xae, 0xd6, 0xba, 0x67, 0x10, 0xbb, 0x13, 0x84, 0xb6, 0x98, 0x0f, 0xba, 0x09, 0x1d,
-	0x61, 0x23, 0x43, 0x8b, 0xb0, 0x33, 0x6a, 0x84, 0x53, 0x20, 0x6a, 0xb4, 0x4b, 0x35, 0xea, 0x91,
-	0x92, 0xe1, 0x10, 0xab, 0x6e, 0x0b, 0x08, 0x99, 0xab, 0x0e, 0x8f, 0xd6, 0x64, 0x26, 0x6d, 0xc8,
-	0x5e, 0xda, 0xfb, 0x24, 0xfa, 0xe7, 0x86, 0xdf, 0xa2, 0x0a, 0x09, 0x55, 0xba, 0xdd, 0xa2, 0xdc,
-	0xc3, 0x2b, 0x68, 0x9a, 0xb7, 0x6a, 0xdc, 0x21, 0x5b, 0x74, 0xb3, 0x6e, 0x66, 0x94, 0xbc, 0x52,
-	0x1c, 0xaf, 0xfc, 0xd7, 0xeb, 0xe6, 0x70, 0x87, 0x34, 0x1b, 0xab, 0x5a, 0x28, 0xa8, 0x55, 0x51,
-	0xb0, 0x5b, 0x37, 0x31, 0x41, 0x29, 0x8f, 0xb8, 0x16, 0xf5, 0x32, 0xc9, 0xbc, 0x52, 0x9c, 0x2e,
-	0xa7, 0x75, 0x98, 0x51, 0x0f, 0x66, 0xd4, 0xd7, 0xec, 0x4e, 0x65, 0xb9, 0xd7, 0xcd, 0xcd, 0x40,
-	0x27, 0xc8, 0xd6, 0x5e, 0xef, 0x2d, 0xa9, 0x43, 0x3a, 0xeb, 0xc0, 0xea, 0x96, 0x48, 0xa9, 0xca,
-	0xc6, 0xf8, 0x2a, 0xfa, 0x03, 0x52, 0xa8, 0x9b, 0x19, 0xcb, 0x2b, 0xc5, 0xa9, 0xca, 0xf9, 0x5e,
-	0x37, 0xf7, 0x17, 0xb4, 0x0b, 0x22, 0xda, 0x9b, 0xbd, 0xa5, 0xb4, 0x94, 0x6d, 0xcd, 0x34, 0x5d,
-	0xca, 0xf9, 0x4d, 0xcf, 0xad, 0xdb, 0x56, 0xb5, 0x5f, 0x8c, 0xef, 0x20, 0x34, 0x10, 0x2c, 0x33,
-	0x2e, 0xf8, 0x16, 0x74, 0x59, 0xe4, 0xab, 0xab, 0x83, 0x0d, 0xa4, 0xba, 0xfa, 0x06, 0xb1, 0xa8,
-	0x14, 0xa8, 0xf2, 0x6f, 0xaf, 0x9b, 0x9b, 0x05, 0xc8, 0x41, 0x0f, 0xad, 0x1a, 0x6a, 0xa8, 0xed,
-	0x2b, 0x28, 0x1d, 0xd5, 0x96, 0x3b, 0xcc, 0xe6, 0x14, 0x6f, 0xa0, 0x49, 0x39, 0x63, 0x46, 0xc9,
-	0x8f, 0x15, 0xa7, 0xcb, 0x73, 0xfa, 0x49, 0xa3, 0x57, 0xe6, 0xf7, 0xbb, 0xb9, 0x44, 0xaf, 0x9b,
-	0xfb, 0x33, 0x3c, 0x1e, 0xd7, 0x5e, 0x7c, 0x7a, 0xb5, 0xa8, 0x54, 0x83, 0x36, 0xf8, 0x6e, 0x64,
-	0x12, 0x50, 0x7e, 0x61, 0xe4, 0x24, 0x40, 0xe7, 0x5b, 0x46, 0x79, 0xa4, 0x20, 0x1c, 0x1a, 0xe5,
-	0xd4, 0x2e, 0x29, 0xa1, 0x29, 0xa0, 0xee, 0x97, 0x25, 0x45, 0x59, 0xba, 0xd7, 0xcd, 0xfd, 0x1d,
-	0x1e, 0x52, 0x14, 0xc9, 0x87, 0xb5, 0x6e, 0x6a, 0x66, 0xc4, 0xa8, 0x7d, 0x2d, 0xaf, 0xa3, 0x14,
-	0xa4, 0x08, 0xf4, 0x58, 0x29, 0xb3, 0x52, 0xca, 0x99, 0x30, 0x8a, 0x54, 0x52, 0x36, 0xd1, 0x5e,
-	0x2a, 0x7d, 0x18, 0xc2, 0x99, 0x7d, 0xfa, 0xfb, 0x10, 0xf5, 0xd8, 0xd8, 0x2f, 0xf4, 0x98, 0xe4,
-	0x1b, 0xf6, 0x98, 0x38, 0x8a, 0xf5, 0x98, 0x9f, 0x31, 0xec, 0x31, 0x51, 0x37, 0xf0, 0x98, 0xd8,
-	0xfe, 0x4e, 0x8f, 0xf9, 0x80, 0x3f, 0xc7, 0x63, 0x7e, 0xa7, 0xc0, 0x63, 0x33, 0x51, 0x8f, 0xc9,
-	0x90, 0xf0, 0x98, 0xbf, 0x8e, 0x78, 0x0c, 0x18, 0x84, 0x3d, 0xe6, 0x9f, 0xc4, 0x7a, 0x4c, 0x48,
-	0x39, 0xe4, 0x31, 0xff, 0x74, 0xe0, 0x31, 0xb1, 0x4b, 0xcb, 0x39, 0x37, 0x88, 0x4b, 0x9a, 0x81,
-	0xc3, 0xfa, 0xd8, 0xc1, 0xe9, 0x00, 0xdb, 0x11, 0x27, 0x31, 0xd8, 0x50, 0xf2, 0x35, 0x36, 0x94,
-	0x05, 0xd8, 0xb0, 0x2b, 0x7f, 0x9e, 0x40, 0x13, 0x02, 0x06, 0x3f, 0x53, 0xd0, 0xa4, 0x7c, 0x31,
-	0xe1, 0xc2, 0x31, 0x4d, 0x8f, 0xf9, 0x2a, 0x64, 0x17, 0x46, 0xe6, 0x01, 0x6b, 0x6d, 0xf5, 0xf1,
-	0xdb, 0x8f, 0x4f, 0x93, 0x17, 0x71, 0xd9, 0x18, 0xfe, 0xfe, 0x04, 0x8f, 0x88, 0x1b, 0x3b, 0xa1,
-	0x67, 0xb7, 0x1b, 0xa4, 0xe0, 0xe7, 0x0a, 0x4a, 0x41, 0x3f, 0x7c, 0x2e, 0x1e, 0x2f, 0xa0, 0x55,
-	0x18, 0x95, 0x26, 0x59, 0x5d, 0x11, 0xac, 0x2e, 0xe3, 0x4b, 0xdf, 0xcf, 0xca, 0xd8, 0xe9, 0xbf,
-	0x94, 0x76, 0xa5, 0x74, 0x70, 0x2b, 0x62, 0xb0, 0xc3, 0x2f, 0x90, 0x38, 0xe9, 0x22, 0x17, 0xf7,
-	0x07, 0xa5, 0x03, 0x32, 0x20, 0x9d, 0xbf, 0x8e, 0x93, 0x2e, 0x74, 0xbb, 0xb2, 0x85, 0x51, 0x69,
-	0xa7, 0x92, 0x4e, 0xb0, 0xf2, 0xa5, 0x93, 0x77, 0x6d, 0x17, 0x3f, 0x44, 0x29, 0x70, 0xeb, 0xc9,
-	0x04, 0x23, 0xd7, 0xe2, 0x64, 0x82, 0xd1, 0x7b, 0xa2, 0x9d, 0x15, 0x04, 0xe7, 0xf1, 0xdc, 0x31,
-	0x04, 0xc1, 0xfb, 0x95, 0x6b, 0xfb, 0x87, 0xaa, 0x72, 0x70, 0xa8, 0x2a, 0x1f, 0x0e, 0x55, 0xe5,
-	0xc9, 0x91, 0x9a, 0x38, 0x38, 0x52, 0x13, 0xef, 0x8e, 0xd4, 0xc4, 0xed, 0x0b, 0x56, 0xdd, 0xbb,
-	0xdf, 0xaa, 0xe9, 0x5b, 0xac, 0x29, 0xcb, 0x97, 0x1a, 0xa4, 0xc6, 0x83, 0x56, 0xed, 0x15, 0xe3,
-	0x41, 0xbf, 0x9f, 0xd7, 0x71, 0x28, 0xaf, 0xa5, 0xc4, 0xdf, 0x99, 0xe5, 0x2f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x26, 0x36, 0xb3, 0x08, 0x3b, 0x0a, 0x00, 0x00,
+	// 954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x8b, 0x5b, 0x45,
+	0x14, 0xde, 0xd9, 0xb4, 0xd9, 0x76, 0x96, 0xed, 0x76, 0xa7, 0x51, 0xb3, 0xd9, 0x9a, 0xc4, 0x41,
+	0xb3, 0xa1, 0xb2, 0x77, 0x4c, 0x2a, 0x14, 0x2a, 0x8a, 0x8d, 0xa0, 0x56, 0x10, 0xd6, 0xb4, 0xf8,
+	0x20, 0xe8, 0x32, 0xd9, 0x3b, 0xde, 0x5c, 0x48, 0xee, 0xa4, 0x77, 0x6e, 0x16, 0xe3, 0x52, 0x10,
+	0xff, 0x02, 0xa1, 0xe0, 0xab, 0xaf, 0x82, 0x08, 0x82, 0x05, 0xff, 0x85, 0xc5, 0xa7, 0xa2, 0x2f,
+	0x3e, 0x05, 0xd9, 0x15, 0x7c, 0xcf, 0x5f, 0x20, 0x77, 0xce, 0xdc, 0x9b, 0x7b, 0x37, 0xbf, 0x5a,
+	0x52, 0x7c, 0x59, 0xee, 0xcc, 0x39, 0xe7, 0x3b, 0xdf, 0xf9, 0xe6, 0x9b, 0xc9, 0xe2, 0x97, 0x6d,
+	0xa1, 0xba, 0x52, 0x31, 0x5f, 0xf4, 0xa4, 0x1f, 0x28, 0x76, 0x54, 0x63, 0x0f, 0xfa, 0xc2, 0x1f,
+	0x58, 0x3d, 0x5f, 0x06, 0x92, 0x6c, 0x41, 0xd8, 0x32, 0x61, 0xeb, 0xa8, 0x56, 0xd8, 0xe2, 0x5d,
+	0xd7, 0x93, 0x4c, 0xff, 0x85, 0xac, 0x42, 0xce, 0x91, 0x8e, 0xd4, 0x9f, 0x2c, 0xfc, 0x32, 0xbb,
+	0xd7, 0x1d, 0x29, 0x9d, 0x8e, 0x60, 0xbc, 0xe7, 0x32, 0xee, 0x79, 0x32, 0xe0, 0x81, 0x2b, 0x3d,
+	0x65, 0xa2, 0xdb, 0x26, 0xaa, 0x57, 0xad, 0xfe, 0x97, 0x8c, 0x7b, 0x83, 0x28, 0x74, 0x28, 0xc3,
+	0xa6, 0x07, 0x80, 0x08, 0x0b, 0x13, 0xba, 0x01, 0x2b, 0xd6, 0xe2, 0x4a, 0x00, 0x51, 0x76, 0x54,
+	0x6b, 0x89, 0x80, 0xd7, 0x58, 0x8f, 0x3b, 0xae, 0xa7, 0x5b, 0x98, 0xdc, 0xe2, 0xe4, 0x68, 0x5d,
+	0x69, 0x8b, 0x8e, 0xc1, 0xa2, 0x3f, 0x65, 0xf0, 0xb5, 0x4f, 0x42, 0x88, 0x26, 0x24, 0x34, 0xc5,
+	0x83, 0xbe, 0x50, 0x01, 0xb9, 0x85, 0xd7, 0x55, 0xbf, 0xa5, 0x7a, 0xfc, 0x50, 0x1c, 0xb8, 0x76,
+	0x1e, 0x95, 0x51, 0xf5, 0x42, 0xe3, 0xc5, 0xd1, 0xb0, 0x44, 0x06, 0xbc, 0xdb, 0xb9, 0x4d, 0x13,
+	0x41, 0xda, 0xc4, 0xd1, 0xea, 0xae, 0x4d, 0x38, 0xce, 0x06, 0xdc, 0x77, 0x44, 0x90, 0x5f, 0x2d,
+	0xa3, 0xea, 0x7a, 0x3d, 0x67, 0xc1, 0x8c, 0x56, 0x34, 0xa3, 0x75, 0xc7, 0x1b, 0x34, 0x6e, 0x8e,
+	0x86, 0xa5, 0x0d, 0x40, 0x82, 0x6c, 0xfa, 0xfb, 0xe3, 0xbd, 0xe2, 0x84, 0xce, 0x16, 0xb0, 0xba,
+	0xaf, 0x53, 0x9a, 0x06, 0x98, 0x7c, 0x80, 0x2f, 0x41, 0x8a, 0xf0, 0xf3, 0x99, 0x32, 0xaa, 0x5e,
+	0x6e, 0xbc, 0x3e, 0x1a, 0x96, 0x36, 0x01, 0x2e, 0x8a, 0xd0, 0x3f, 0x1e, 0xef, 0xe5, 0x8c, 0x6c,
+	0x77, 0x6c, 0xdb, 0x17, 0x4a, 0xdd, 0x0b, 0x7c, 0xd7, 0x73, 0x9a, 0x71, 0x31, 0xf9, 0x1c, 0xe3,
+	0xb1, 0x60, 0xf9, 0x0b, 0x9a, 0x6f, 0xc5, 0x32, 0x45, 0xa1, 0xba, 0x16, 0xd8, 0xc0, 0xa8, 0x6b,
+	0xed, 0x73, 0x47, 0x18, 0x81, 0x1a, 0x2f, 0x8c, 0x86, 0xa5, 0x2d, 0x68, 0x39, 0xc6, 0xa0, 0xcd,
+	0x04, 0x20, 0xd9, 0xc7, 0x97, 0x54, 0xc0, 0x83, 0xbe, 0x12, 0x2a, 0x7f, 0xb1, 0x9c, 0xa9, 0x5e,
+	0xa9, 0x97, 0xac, 0x59, 0x23, 0xde, 0xd3, 0x89, 0x8d, 0x6b, 0xe3, 0x41, 0xa2, 0x52, 0xda, 0x8c,
+	0x51, 0xe8, 0x09, 0xc2, 0xb9, 0xf4, 0x69, 0xa9, 0x9e, 0xf4, 0x94, 0x20, 0xfb, 0x78, 0xcd, 0x40,
+	0xe6, 0x51, 0x39, 0x53, 0x5d, 0xaf, 0x6f, 0xcf, 0xec, 0xd4, 0xd8, 0x39, 0x19, 0x96, 0x56, 0x46,
+	0xc3, 0xd2, 0x95, 0xa4, 0x60, 0x8a, 0xfe, 0xf8, 0xef, 0x2f, 0x37, 0x50, 0x33, 0x82, 0x21, 0x5f,
+	0xa4, 0xb4, 0x81, 0xb3, 0xdc, 0x5d, 0xa8, 0x0d, 0xd0, 0x79, 0x0a, 0x71, 0xe8, 0x37, 0x08, 0x93,
+	0xc4, 0x28, 0x4b, 0xfb, 0xae, 0x86, 0x2f, 0x03, 0xf5, 0xb0, 0x6c, 0x55, 0x97, 0xe5, 0x46, 0xc3,
+	0xd2, 0xd5, 0xe4, 0x90, 0xba, 0xc8, 0x1c, 0xff, 0x5d, 0x9b, 0xda, 0x29, 0xeb, 0xc7, 0x5a, 0x7e,
+	0x8c, 0xb3, 0x90, 0xa2, 0xbb, 0xcf, 0x95, 0xb2, 0x60, 0xa4, 0xdc, 0x48, 0x76, 0x31, 0x4a, 0x1a,
+	0x10, 0xfa, 0x33, 0x8a, 0xdb, 0x70, 0x25, 0xbd, 0xe5, 0x6f, 0x58, 0xda, 0xb5, 0x99, 0xe7, 0xec,
+	0xda, 0xa4, 0xc7, 0x0c, 0xdf, 0xa4, 0xc7, 0xf4, 0xd6, 0x5c, 0x8f, 0x85, 0x19, 0x93, 0x1e, 0xd3,
+	0x75, 0x63, 0x8f, 0xe9, 0xe5, 0xff, 0xe9, 0xb1, 0xb0, 0xe1, 0xf3, 0xf1, 0x58, 0x88, 0x14, 0x79,
+	0x6c, 0x23, 0xed, 0x31, 0x13, 0xd2, 0x1e, 0x0b, 0xbf, 0x53, 0x1e, 0x03, 0x06, 0x49, 0x8f, 0x85,
+	0x3b, 0x73, 0x3d, 0xa6, 0xa5, 0x9c, 0xf0, 0x58, 0xb8, 0x3b, 0xf6, 0x98, 0x5e, 0x7d, 0x8a, 0x77,
+	0x74, 0x97, 0x0f, 0x5d, 0xdb, 0xf5, 0x9c, 0xfb, 0x6d, 0x5f, 0xa8, 0xb6, 0xec, 0xd8, 0xcb, 0x0e,
+	0x4c, 0x1f, 0x21, 0x7c, 0x7d, 0x3a, 0xb0, 0x99, 0x43, 0xe1, 0xab, 0x6d, 0x1d, 0x3a, 0x08, 0xa2,
+	0x98, 0x99, 0x88, 0x4e, 0x99, 0xe8, 0x1c, 0x4a, 0xe3, 0x55, 0x33, 0xda, 0x4b, 0x40, 0xe3, 0x3c,
+	0x92, 0x19, 0x72, 0xb3, 0x9d, 0x2e, 0xa3, 0x39, 0x73, 0xaa, 0xfb, 0xdc, 0xe7, 0xdd, 0xe8, 0x3e,
+	0xc5, 0x4a, 0x47, 0xbb, 0x63, 0xa5, 0x7b, 0x7a, 0x67, 0x8e, 0xd2, 0x50, 0x72, 0x5e, 0x69, 0x28,
+	0x8b, 0x94, 0x86, 0x55, 0xfd, 0xd7, 0x35, 0x7c, 0x51, 0xb7, 0x21, 0xdf, 0x23, 0xbc, 0x66, 0x9e,
+	0x61, 0x52, 0x99, 0x02, 0x3a, 0xe5, 0x57, 0xb5, 0xb0, 0xbb, 0x30, 0x0f, 0x58, 0xd3, 0xdb, 0xdf,
+	0xfe, 0xf9, 0xcf, 0xa3, 0xd5, 0x37, 0x49, 0x9d, 0x4d, 0xfe, 0x7e, 0x47, 0xe7, 0xa3, 0xd8, 0x71,
+	0xe2, 0xe0, 0x1e, 0x46, 0x29, 0xe4, 0x07, 0x84, 0xb3, 0x80, 0x47, 0x5e, 0x9b, 0xdf, 0x2f, 0xa2,
+	0x55, 0x59, 0x94, 0x66, 0x58, 0xbd, 0xaf, 0x59, 0xbd, 0x4b, 0xde, 0x79, 0x76, 0x56, 0xec, 0x38,
+	0x7e, 0x82, 0x1f, 0x1a, 0xe9, 0xe0, 0x0d, 0x98, 0xd3, 0x3b, 0xf9, 0x5c, 0x16, 0x76, 0x17, 0xe6,
+	0x2d, 0x25, 0x1d, 0x90, 0x01, 0xe9, 0xc2, 0xef, 0x79, 0xd2, 0x25, 0xde, 0x92, 0x42, 0x65, 0x51,
+	0xda, 0x52, 0xd2, 0x69, 0x56, 0xec, 0x18, 0x3e, 0xb4, 0x74, 0xbf, 0x21, 0xbc, 0x79, 0xee, 0x1a,
+	0x11, 0x6b, 0x16, 0x87, 0xe9, 0xcf, 0x41, 0x81, 0x3d, 0x75, 0xbe, 0x21, 0xff, 0x9e, 0x26, 0xff,
+	0x36, 0x79, 0xeb, 0x19, 0xc8, 0xc3, 0xa5, 0xdd, 0x8b, 0x2f, 0x33, 0xf9, 0x1a, 0x67, 0xe1, 0x9e,
+	0xcd, 0x96, 0x36, 0x75, 0xa1, 0x0b, 0x95, 0x45, 0x69, 0x86, 0xdd, 0x2b, 0x9a, 0xdd, 0x0e, 0xd9,
+	0x9e, 0xc2, 0x0e, 0x6e, 0x6d, 0xe3, 0xa3, 0x93, 0xd3, 0x22, 0x7a, 0x72, 0x5a, 0x44, 0x7f, 0x9f,
+	0x16, 0xd1, 0x77, 0x67, 0xc5, 0x95, 0x27, 0x67, 0xc5, 0x95, 0xbf, 0xce, 0x8a, 0x2b, 0x9f, 0xbd,
+	0xe1, 0xb8, 0x41, 0xbb, 0xdf, 0xb2, 0x0e, 0x65, 0xd7, 0x94, 0xef, 0x75, 0x78, 0x4b, 0x45, 0x50,
+	0x47, 0xb7, 0xd8, 0x57, 0x31, 0x5e, 0x30, 0xe8, 0x09, 0xd5, 0xca, 0xea, 0x7f, 0x64, 0x6f, 0xfe,
+	0x37, 0x00, 0x10, 0x30, 0x4c, 0xda, 0x35, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -641,6 +754,9 @@ type QueryClient interface {
 	Reasons(ctx context.Context, in *QueryReasonsRequest, opts ...grpc.CallOption) (*QueryReasonsResponse, error)
 	// Reason allows to query the reason having the given id
 	Reason(ctx context.Context, in *QueryReasonRequest, opts ...grpc.CallOption) (*QueryReasonResponse, error)
+	// HidingThreshold allows to query the threshold after which reported posts
+	// are automatically hidden inside a subspace
+	HidingThreshold(ctx context.Context, in *QueryHidingThresholdRequest, opts ...grpc.CallOption) (*QueryHidingThresholdResponse, error)
 	// Params allows to query the module parameters
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -689,6 +805,15 @@ func (c *queryClient) Reason(ctx context.Context, in *QueryReasonRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) HidingThreshold(ctx context.Context, in *QueryHidingThresholdRequest, opts ...grpc.CallOption) (*QueryHidingThresholdResponse, error) {
+	out := new(QueryHidingThresholdResponse)
+	err := c.cc.Invoke(ctx, "/desmos.reports.v1.Query/HidingThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/desmos.reports.v1.Query/Params", in, out, opts...)
@@ -708,6 +833,9 @@ type QueryServer interface {
 	Reasons(context.Context, *QueryReasonsRequest) (*QueryReasonsResponse, error)
 	// Reason allows to query the reason having the given id
 	Reason(context.Context, *QueryReasonRequest) (*QueryReasonResponse, error)
+	// HidingThreshold allows to query the threshold after which reported posts
+	// are automatically hidden inside a subspace
+	HidingThreshold(context.Context, *QueryHidingThresholdRequest) (*QueryHidingThresholdResponse, error)
 	// Params allows to query the module parameters
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -728,6 +856,9 @@ func (*UnimplementedQueryServer) Reasons(ctx context.Context, req *QueryReasonsR
 func (*UnimplementedQueryServer) Reason(ctx context.Context, req *QueryReasonRequest) (*QueryReasonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reason not implemented")
 }
+func (*UnimplementedQueryServer) HidingThreshold(ctx context.Context, req *QueryHidingThresholdRequest) (*QueryHidingThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HidingThreshold not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -808,6 +939,24 @@ func _Query_Reason_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HidingThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHidingThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HidingThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.reports.v1.Query/HidingThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HidingThreshold(ctx, req.(*QueryHidingThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -846,6 +995,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Reason",
 			Handler:    _Query_Reason_Handler,
 		},
+		{
+			MethodName: "HidingThreshold",
+			Handler:    _Query_HidingThreshold_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -875,6 +1028,24 @@ func (m *QueryReportsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Statuses) > 0 {
+		dAtA2 := make([]byte, len(m.Statuses)*10)
+		var j1 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1184,6 +1355,67 @@ func (m *QueryReasonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryHidingThresholdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHidingThresholdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHidingThresholdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHidingThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHidingThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHidingThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HidingThreshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1272,6 +1504,13 @@ func (m *QueryReportsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1381,6 +1620,29 @@ func (m *QueryReasonResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryHidingThresholdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	return n
+}
+
+func (m *QueryHidingThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HidingThreshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1559,6 +1821,75 @@ func (m *QueryReportsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v ReportStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ReportStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]ReportStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ReportStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ReportStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2267,6 +2598,158 @@ func (m *QueryReasonResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHidingThresholdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHidingThresholdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHidingThresholdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHidingThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHidingThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHidingThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HidingThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HidingThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -50,6 +50,12 @@ func buildRemoveReasonRequest(cdc codec.Codec, msg sdk.Msg) json.RawMessage {
 	return bz
 }
 
+func buildSetHidingThresholdRequest(cdc codec.Codec, msg sdk.Msg) json.RawMessage {
+	raw := json.RawMessage(cdc.MustMarshalJSON(msg))
+	bz, _ := json.Marshal(types.ReportsMsg{SetHidingThreshold: &raw})
+	return bz
+}
+
 func buildReportsQueryRequest(cdc codec.Codec, query *types.QueryReportsRequest) json.RawMessage {
 	raw := json.RawMessage(cdc.MustMarshalJSON(query))
 	bz, _ := json.Marshal(types.ReportsQuery{Reports: &raw})
@@ -74,6 +80,12 @@ func buildReasonQueryRequest(cdc codec.Codec, query *types.QueryReasonRequest) j
 	return bz
 }
 
+func buildHidingThresholdQueryRequest(cdc codec.Codec, query *types.QueryHidingThresholdRequest) json.RawMessage {
+	raw := json.RawMessage(cdc.MustMarshalJSON(query))
+	bz, _ := json.Marshal(types.ReportsQuery{HidingThreshold: &raw})
+	return bz
+}
+
 type Testsuite struct {
 	suite.Suite
 
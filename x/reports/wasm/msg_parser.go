@@ -34,12 +34,16 @@ func (parser MsgsParser) ParseCustomMsgs(contractAddr sdk.AccAddress, data json.
 		return commons.HandleWasmMsg(parser.cdc, *msg.CreateReport, &types.MsgCreateReport{})
 	case msg.DeleteReport != nil:
 		return commons.HandleWasmMsg(parser.cdc, *msg.DeleteReport, &types.MsgDeleteReport{})
+	case msg.ResolveReport != nil:
+		return commons.HandleWasmMsg(parser.cdc, *msg.ResolveReport, &types.MsgResolveReport{})
 	case msg.SupportStandardReason != nil:
 		return commons.HandleWasmMsg(parser.cdc, *msg.SupportStandardReason, &types.MsgSupportStandardReason{})
 	case msg.AddReason != nil:
 		return commons.HandleWasmMsg(parser.cdc, *msg.AddReason, &types.MsgAddReason{})
 	case msg.RemoveReason != nil:
 		return commons.HandleWasmMsg(parser.cdc, *msg.RemoveReason, &types.MsgRemoveReason{})
+	case msg.SetHidingThreshold != nil:
+		return commons.HandleWasmMsg(parser.cdc, *msg.SetHidingThreshold, &types.MsgSetHidingThreshold{})
 	default:
 		return nil, errors.Wrap(wasmtypes.ErrInvalidMsg, "cosmwasm-reports-msg-parser: message not supported")
 	}
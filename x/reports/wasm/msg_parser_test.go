@@ -112,6 +112,22 @@ func TestMsgsParser_ParseCustomMsgs(t *testing.T) {
 				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 			)},
 		},
+		{
+			name: "set hiding threshold message is parsed correctly",
+			msg: buildSetHidingThresholdRequest(cdc, types.NewMsgSetHidingThreshold(
+				1,
+				5,
+				[]uint32{1, 2},
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+			)),
+			shouldErr: false,
+			expMsgs: []sdk.Msg{types.NewMsgSetHidingThreshold(
+				1,
+				5,
+				[]uint32{1, 2},
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+			)},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -39,6 +39,8 @@ func (querier ReportsWasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMess
 		return querier.handleReasonsRequest(ctx, *query.Reasons)
 	case query.Reason != nil:
 		return querier.handleReasonRequest(ctx, *query.Reason)
+	case query.HidingThreshold != nil:
+		return querier.handleHidingThresholdRequest(ctx, *query.HidingThreshold)
 	default:
 		return nil, sdkerrors.ErrInvalidRequest
 	}
@@ -95,3 +97,16 @@ func (querier ReportsWasmQuerier) handleReasonRequest(ctx sdk.Context, data json
 	}
 	return querier.cdc.MarshalJSON(res)
 }
+
+func (querier ReportsWasmQuerier) handleHidingThresholdRequest(ctx sdk.Context, data json.RawMessage) (json.RawMessage, error) {
+	var req types.QueryHidingThresholdRequest
+	err := querier.cdc.UnmarshalJSON(data, &req)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	res, err := querier.reportskeeper.HidingThreshold(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return querier.cdc.MarshalJSON(res)
+}
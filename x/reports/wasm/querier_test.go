@@ -35,7 +35,7 @@ func (suite *Testsuite) TestReportsWasmQuerier_QueryCustom() {
 		},
 		{
 			name:    "reports request is parsed correctly",
-			request: buildReportsQueryRequest(suite.cdc, types.NewQueryReportsRequest(1, nil, "", nil)),
+			request: buildReportsQueryRequest(suite.cdc, types.NewQueryReportsRequest(1, nil, "", nil, nil)),
 			store: func(ctx sdk.Context) {
 				suite.k.SaveReport(ctx, types.NewReport(
 					1,
@@ -119,6 +119,19 @@ func (suite *Testsuite) TestReportsWasmQuerier_QueryCustom() {
 				},
 			),
 		},
+		{
+			name:    "hiding threshold request is parsed correctly",
+			request: buildHidingThresholdQueryRequest(suite.cdc, types.NewQueryHidingThresholdRequest(1)),
+			store: func(ctx sdk.Context) {
+				suite.k.SaveHidingThreshold(ctx, types.NewHidingThreshold(1, 5, []uint32{1}))
+			},
+			shouldErr: false,
+			expResponse: suite.cdc.MustMarshalJSON(
+				&types.QueryHidingThresholdResponse{
+					HidingThreshold: types.NewHidingThreshold(1, 5, []uint32{1}),
+				},
+			),
+		},
 	}
 
 	querier := wasm.NewReportsWasmQuerier(suite.k, suite.cdc)
@@ -6,7 +6,14 @@ import (
 	"github.com/desmos-labs/desmos/v7/x/subspaces/keeper"
 )
 
-// BeginBlocker is called every block and takes care of removing expired allowances
+// BeginBlocker is called every block and takes care of removing expired allowances,
+// user permissions, group memberships, subspace bans and group invites, as well as
+// rejecting the treasury proposals whose voting period has ended
 func BeginBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	keeper.RemoveExpiredAllowances(ctx, ctx.BlockTime())
+	keeper.RemoveExpiredUserPermissions(ctx, ctx.BlockTime())
+	keeper.RemoveExpiredGroupMembers(ctx, ctx.BlockTime())
+	keeper.RemoveExpiredSubspaceBans(ctx, ctx.BlockTime())
+	keeper.RemoveExpiredGroupInvites(ctx, ctx.BlockTime())
+	keeper.RejectExpiredTreasuryProposals(ctx, ctx.BlockTime())
 }
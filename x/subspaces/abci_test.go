@@ -100,6 +100,204 @@ func TestBeginBlocker(t *testing.T) {
 				require.False(t, kvStore.Has(types.ExpiringAllowanceKey(&expiration, key)))
 			},
 		},
+		{
+			name: "user permission is not expired before time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				// Just 1 nanosecond after time
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC)
+
+				keeper.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionEditSubspace),
+					&expiration,
+				)
+			},
+			check: func(ctx sdk.Context) {
+				kvStore := ctx.KVStore(keys[types.StoreKey])
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC)
+				key := types.UserPermissionStoreKey(1, 0, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				require.True(t, kvStore.Has(key))
+				require.True(t, kvStore.Has(types.ExpiringUserPermissionKey(&expiration, key)))
+			},
+		},
+		{
+			name: "user permission is expired after time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				// Just 1 nanosecond before time
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+				keeper.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionEditSubspace),
+					&expiration,
+				)
+			},
+			check: func(ctx sdk.Context) {
+				kvStore := ctx.KVStore(keys[types.StoreKey])
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+				key := types.UserPermissionStoreKey(1, 0, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				require.False(t, kvStore.Has(key))
+				require.False(t, kvStore.Has(types.ExpiringUserPermissionKey(&expiration, key)))
+			},
+		},
+		{
+			name: "group member is not expired before time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				// Just 1 nanosecond after time
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC)
+
+				kvStore := ctx.KVStore(keys[types.StoreKey])
+				key := types.GroupMemberStoreKey(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				kvStore.Set(key, types.GetGroupMemberValue(&expiration))
+				kvStore.Set(types.ExpiringGroupMemberKey(&expiration, key), []byte{0x1})
+			},
+			check: func(ctx sdk.Context) {
+				kvStore := ctx.KVStore(keys[types.StoreKey])
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC)
+				key := types.GroupMemberStoreKey(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				require.True(t, kvStore.Has(key))
+				require.True(t, kvStore.Has(types.ExpiringGroupMemberKey(&expiration, key)))
+			},
+		},
+		{
+			name: "group member is expired after time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				// Just 1 nanosecond before time
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+				kvStore := ctx.KVStore(keys[types.StoreKey])
+				key := types.GroupMemberStoreKey(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				kvStore.Set(key, types.GetGroupMemberValue(&expiration))
+				kvStore.Set(types.ExpiringGroupMemberKey(&expiration, key), []byte{0x1})
+			},
+			check: func(ctx sdk.Context) {
+				kvStore := ctx.KVStore(keys[types.StoreKey])
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+				key := types.GroupMemberStoreKey(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				require.False(t, kvStore.Has(key))
+				require.False(t, kvStore.Has(types.ExpiringGroupMemberKey(&expiration, key)))
+			},
+		},
+		{
+			name: "subspace ban is not expired before time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				// Just 1 nanosecond after time
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC)
+
+				keeper.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					&expiration,
+				))
+			},
+			check: func(ctx sdk.Context) {
+				kvStore := ctx.KVStore(keys[types.StoreKey])
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC)
+				key := types.SubspaceBanStoreKey(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				require.True(t, kvStore.Has(key))
+				require.True(t, kvStore.Has(types.ExpiringSubspaceBanKey(&expiration, key)))
+			},
+		},
+		{
+			name: "subspace ban is expired after time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				// Just 1 nanosecond before time
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+				keeper.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					&expiration,
+				))
+			},
+			check: func(ctx sdk.Context) {
+				kvStore := ctx.KVStore(keys[types.StoreKey])
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+				key := types.SubspaceBanStoreKey(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				require.False(t, kvStore.Has(key))
+				require.False(t, kvStore.Has(types.ExpiringSubspaceBanKey(&expiration, key)))
+			},
+		},
+		{
+			name: "group invite is not expired before time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				// Just 1 nanosecond after time
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC)
+
+				keeper.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					&expiration,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			check: func(ctx sdk.Context) {
+				kvStore := ctx.KVStore(keys[types.StoreKey])
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC)
+				key := types.GroupInviteStoreKey(1, types.GetInviteSecretHash("secret"))
+				require.True(t, kvStore.Has(key))
+				require.True(t, kvStore.Has(types.ExpiringGroupInviteKey(&expiration, key)))
+			},
+		},
+		{
+			name: "group invite is expired after time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 001, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				// Just 1 nanosecond before time
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+				keeper.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					&expiration,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			check: func(ctx sdk.Context) {
+				kvStore := ctx.KVStore(keys[types.StoreKey])
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+				key := types.GroupInviteStoreKey(1, types.GetInviteSecretHash("secret"))
+				require.False(t, kvStore.Has(key))
+				require.False(t, kvStore.Has(types.ExpiringGroupInviteKey(&expiration, key)))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -83,7 +83,7 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	}
 
 	// If the custom handling was not successful, fallback to the default handling
-	return dfd.authDeductFeeDecorator.AnteHandle(ctx, tx, simulate, next)
+	return dfd.authDeductFeeDecorator.AnteHandle(ctx, tx, simulate, dfd.withFeeTokensRouting(subspaceID, feeTx.GetFee(), next))
 }
 
 // GetTxSubspaceID  returns the valid subspace id, returns false if it is invalid
@@ -140,6 +140,11 @@ func (dfd DeductFeeDecorator) tryHandleSubspaceTx(ctx sdk.Context, tx sdk.FeeTx,
 		if err != nil {
 			return ctx, false, err
 		}
+
+		err = dfd.routeFeeTokens(ctx, subspaceID, fees)
+		if err != nil {
+			return ctx, false, err
+		}
 	}
 
 	// Emit the fee deduction events
@@ -152,3 +157,41 @@ func (dfd DeductFeeDecorator) tryHandleSubspaceTx(ctx sdk.Context, tx sdk.FeeTx,
 	})
 	return ctx, true, err
 }
+
+// withFeeTokensRouting returns an AnteHandler that routes the subspace fee tokens included inside the given fees
+// before calling the next AnteHandler
+func (dfd DeductFeeDecorator) withFeeTokensRouting(subspaceID uint64, fees sdk.Coins, next sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+		err = dfd.routeFeeTokens(ctx, subspaceID, fees)
+		if err != nil {
+			return ctx, err
+		}
+		return next(ctx, tx, simulate)
+	}
+}
+
+// routeFeeTokens sends the subspace fee tokens included inside the given fees from the fee collector
+// to the subspace treasury, if the subspace fee tokens config requires so
+func (dfd DeductFeeDecorator) routeFeeTokens(ctx sdk.Context, subspaceID uint64, fees sdk.Coins) error {
+	config, found := dfd.sk.GetFeeTokensConfig(ctx, subspaceID)
+	if !found || config.Destination != types.FEE_TOKENS_DESTINATION_TREASURY {
+		return nil
+	}
+
+	tokens := config.FilterFeeTokens(fees)
+	if tokens.IsZero() {
+		return nil
+	}
+
+	subspace, found := dfd.sk.GetSubspace(ctx, subspaceID)
+	if !found || subspace.Treasury == "" {
+		return nil
+	}
+
+	treasury, err := sdk.AccAddressFromBech32(subspace.Treasury)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid treasury address: %s", subspace.Treasury)
+	}
+
+	return dfd.bk.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, treasury, tokens)
+}
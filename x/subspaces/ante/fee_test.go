@@ -20,8 +20,8 @@ func (suite *AnteTestSuite) TestAnte_Ante() {
 	module := sdk.MustAccAddressFromBech32("cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
 	nonSubspaceMsg := testdata.NewTestMsg(signer)
 	subspaceID, otherSubspaceID := uint64(1), uint64(2)
-	subspaceMsg := types.NewMsgAddUserToUserGroup(subspaceID, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
-	otherSubspaceMsg := types.NewMsgAddUserToUserGroup(otherSubspaceID, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+	subspaceMsg := types.NewMsgAddUserToUserGroup(subspaceID, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+	otherSubspaceMsg := types.NewMsgAddUserToUserGroup(otherSubspaceID, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
 
 	testCases := []struct {
 		name      string
@@ -178,6 +178,55 @@ func (suite *AnteTestSuite) TestAnte_Ante() {
 				suite.bk.EXPECT().
 					SendCoinsFromAccountToModule(gomock.Any(), granter, authtypes.FeeCollectorName, feeAmount).
 					Return(nil)
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), subspaceID).
+					Return(types.FeeTokensConfig{}, false)
+			},
+			buildTx: func() sdk.Tx {
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				txBuilder.SetMsgs(subspaceMsg)
+				txBuilder.SetFeeGranter(granter)
+				txBuilder.SetFeeAmount(feeAmount)
+				return txBuilder.GetTx()
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().Equal(int64(10), ctx.Priority())
+			},
+			shouldErr: false,
+		},
+		{
+			name: "non-zero fees valid tx routes fee tokens to the treasury",
+			setup: func() {
+				suite.ak.EXPECT().
+					GetModuleAddress(authtypes.FeeCollectorName).
+					Return(module)
+
+				suite.sk.EXPECT().
+					UseGrantedFees(gomock.Any(), subspaceID, signer, feeAmount, []sdk.Msg{subspaceMsg}).
+					Return(true)
+
+				suite.ak.EXPECT().
+					GetAccount(gomock.Any(), granter).
+					Return(authtypes.NewBaseAccountWithAddress(granter))
+
+				suite.bk.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), granter, authtypes.FeeCollectorName, feeAmount).
+					Return(nil)
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), subspaceID).
+					Return(types.NewFeeTokensConfig(subspaceID, "udsm", []types.FeeTokenRate{
+						types.NewFeeTokenRate("stake", sdk.NewDec(2), sdk.ZeroInt()),
+					}, types.FEE_TOKENS_DESTINATION_TREASURY), true)
+
+				suite.sk.EXPECT().
+					GetSubspace(gomock.Any(), subspaceID).
+					Return(types.Subspace{ID: subspaceID, Treasury: granter.String()}, true)
+
+				suite.bk.EXPECT().
+					SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, granter, feeAmount).
+					Return(nil)
 			},
 			buildTx: func() sdk.Tx {
 				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
@@ -233,6 +282,37 @@ func (suite *AnteTestSuite) TestAnte_Ante() {
 			},
 			shouldErr: false,
 		},
+		{
+			name: "valid tx without granter routes fee tokens to the treasury",
+			setup: func() {
+				suite.authDeductFeeDecorator.EXPECT().
+					AnteHandle(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+						return next(ctx, tx, simulate)
+					})
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), subspaceID).
+					Return(types.NewFeeTokensConfig(subspaceID, "udsm", []types.FeeTokenRate{
+						types.NewFeeTokenRate("stake", sdk.NewDec(2), sdk.ZeroInt()),
+					}, types.FEE_TOKENS_DESTINATION_TREASURY), true)
+
+				suite.sk.EXPECT().
+					GetSubspace(gomock.Any(), subspaceID).
+					Return(types.Subspace{ID: subspaceID, Treasury: granter.String()}, true)
+
+				suite.bk.EXPECT().
+					SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, granter, feeAmount).
+					Return(nil)
+			},
+			buildTx: func() sdk.Tx {
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				txBuilder.SetMsgs(subspaceMsg)
+				txBuilder.SetFeeAmount(feeAmount)
+				return txBuilder.GetTx()
+			},
+			shouldErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,73 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	antetypes "github.com/desmos-labs/desmos/v7/x/subspaces/ante/types"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+var _ sdk.AnteDecorator = &RateLimitDecorator{}
+
+// RateLimitDecorator represents the decorator used to enforce the rate limits set inside subspaces
+type RateLimitDecorator struct {
+	sk antetypes.SubspacesKeeper
+}
+
+// NewRateLimitDecorator returns a new RateLimitDecorator instance
+func NewRateLimitDecorator(sk antetypes.SubspacesKeeper) RateLimitDecorator {
+	return RateLimitDecorator{
+		sk: sk,
+	}
+}
+
+// AnteHandle implements AnteDecorator
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// Messages have already been counted during the first check
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		err = rld.consumeRateLimits(ctx, msg, simulate)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// consumeRateLimits consumes the rate limits of the given message, as well as the ones of
+// all the messages that it wraps when it is an authz.MsgExec
+func (rld RateLimitDecorator) consumeRateLimits(ctx sdk.Context, msg sdk.Msg, simulate bool) error {
+	if execMsg, ok := msg.(*authz.MsgExec); ok {
+		msgs, err := execMsg.GetMessages()
+		if err != nil {
+			return err
+		}
+
+		for _, innerMsg := range msgs {
+			err = rld.consumeRateLimits(ctx, innerMsg, simulate)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	subspaceMsg, ok := msg.(types.SubspaceMsg)
+	if !ok {
+		return nil
+	}
+
+	// Messages that consume their own rate limits when executed are only checked here during CheckTx,
+	// so that they are kept out of the mempool without being counted twice when they are delivered.
+	// Simulations execute the messages as well, so they are not checked here either
+	if _, ok := msg.(types.RateLimitedMsg); ok && (simulate || !ctx.IsCheckTx()) {
+		return nil
+	}
+
+	return rld.sk.ConsumeMsgRateLimits(ctx, subspaceMsg)
+}
@@ -0,0 +1,134 @@
+package ante_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/golang/mock/gomock"
+
+	reportstypes "github.com/desmos-labs/desmos/v7/x/reports/types"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/ante"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+func (suite *AnteTestSuite) TestRateLimitDecorator_AnteHandle() {
+	signer := sdk.MustAccAddressFromBech32("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+	nonSubspaceMsg := testdata.NewTestMsg(signer)
+	subspaceMsg := types.NewMsgAddUserToUserGroup(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+	rateLimitedMsg := reportstypes.NewMsgDeleteReport(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+	execMsg := authz.NewMsgExec(sdk.MustAccAddressFromBech32("cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"), []sdk.Msg{subspaceMsg})
+
+	testCases := []struct {
+		name      string
+		setupCtx  func(ctx sdk.Context) sdk.Context
+		setup     func()
+		msgs      []sdk.Msg
+		simulate  bool
+		shouldErr bool
+	}{
+		{
+			name:      "non subspace msg is not rate limited",
+			msgs:      []sdk.Msg{nonSubspaceMsg},
+			shouldErr: false,
+		},
+		{
+			name: "recheck tx is not rate limited",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithIsReCheckTx(true)
+			},
+			msgs:      []sdk.Msg{subspaceMsg},
+			shouldErr: false,
+		},
+		{
+			name: "exceeded rate limit returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					ConsumeMsgRateLimits(gomock.Any(), subspaceMsg).
+					Return(types.ErrRateLimitExceeded)
+			},
+			msgs:      []sdk.Msg{subspaceMsg},
+			shouldErr: true,
+		},
+		{
+			name: "exceeded rate limit inside authz exec returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					ConsumeMsgRateLimits(gomock.Any(), subspaceMsg).
+					Return(types.ErrRateLimitExceeded)
+			},
+			msgs:      []sdk.Msg{&execMsg},
+			shouldErr: true,
+		},
+		{
+			name: "rate limited msg is checked during check tx",
+			setup: func() {
+				suite.sk.EXPECT().
+					ConsumeMsgRateLimits(gomock.Any(), rateLimitedMsg).
+					Return(types.ErrRateLimitExceeded)
+			},
+			msgs:      []sdk.Msg{rateLimitedMsg},
+			shouldErr: true,
+		},
+		{
+			name: "rate limited msg is not counted during deliver tx",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithIsCheckTx(false)
+			},
+			msgs:      []sdk.Msg{rateLimitedMsg},
+			shouldErr: false,
+		},
+		{
+			name:      "rate limited msg is not counted during simulation",
+			msgs:      []sdk.Msg{rateLimitedMsg},
+			simulate:  true,
+			shouldErr: false,
+		},
+		{
+			name: "subspace msg is counted during simulation",
+			setup: func() {
+				suite.sk.EXPECT().
+					ConsumeMsgRateLimits(gomock.Any(), subspaceMsg).
+					Return(types.ErrRateLimitExceeded)
+			},
+			msgs:      []sdk.Msg{subspaceMsg},
+			simulate:  true,
+			shouldErr: true,
+		},
+		{
+			name: "valid subspace msg is counted properly",
+			setup: func() {
+				suite.sk.EXPECT().
+					ConsumeMsgRateLimits(gomock.Any(), subspaceMsg).
+					Return(nil)
+			},
+			msgs:      []sdk.Msg{subspaceMsg, nonSubspaceMsg},
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.setupCtx != nil {
+				ctx = tc.setupCtx(ctx)
+			}
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+
+			decorator := ante.NewRateLimitDecorator(suite.sk)
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), tc.simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
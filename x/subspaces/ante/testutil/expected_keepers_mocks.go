@@ -161,6 +161,20 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAd
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
 }
 
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx types.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
 // MockSubspacesKeeper is a mock of SubspacesKeeper interface.
 type MockSubspacesKeeper struct {
 	ctrl     *gomock.Controller
@@ -184,6 +198,35 @@ func (m *MockSubspacesKeeper) EXPECT() *MockSubspacesKeeperMockRecorder {
 	return m.recorder
 }
 
+// ConsumeMsgRateLimits mocks base method.
+func (m *MockSubspacesKeeper) ConsumeMsgRateLimits(ctx types.Context, msg types1.SubspaceMsg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeMsgRateLimits", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeMsgRateLimits indicates an expected call of ConsumeMsgRateLimits.
+func (mr *MockSubspacesKeeperMockRecorder) ConsumeMsgRateLimits(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeMsgRateLimits", reflect.TypeOf((*MockSubspacesKeeper)(nil).ConsumeMsgRateLimits), ctx, msg)
+}
+
+// GetFeeTokensConfig mocks base method.
+func (m *MockSubspacesKeeper) GetFeeTokensConfig(ctx types.Context, subspaceID uint64) (types1.FeeTokensConfig, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeTokensConfig", ctx, subspaceID)
+	ret0, _ := ret[0].(types1.FeeTokensConfig)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetFeeTokensConfig indicates an expected call of GetFeeTokensConfig.
+func (mr *MockSubspacesKeeperMockRecorder) GetFeeTokensConfig(ctx, subspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeTokensConfig", reflect.TypeOf((*MockSubspacesKeeper)(nil).GetFeeTokensConfig), ctx, subspaceID)
+}
+
 // GetSubspace mocks base method.
 func (m *MockSubspacesKeeper) GetSubspace(ctx types.Context, subspaceID uint64) (types1.Subspace, bool) {
 	m.ctrl.T.Helper()
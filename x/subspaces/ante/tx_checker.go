@@ -1,7 +1,9 @@
 package ante
 
 import (
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	antetypes "github.com/desmos-labs/desmos/v7/x/subspaces/ante/types"
@@ -19,7 +21,9 @@ func hasManageSubspaceMsg(tx sdk.Tx) bool {
 	return false
 }
 
-// CheckTxFeeWithSubspaceMinPrices returns the tx checker that including the subspace allowed tokens into minimum prices list
+// CheckTxFeeWithSubspaceMinPrices returns the tx checker that including the subspace allowed tokens into minimum prices list.
+// If the subspace has a fee tokens config, the prices of its fee tokens are converted from the base denom price and the
+// fees are checked against the configured maximum amounts.
 func CheckTxFeeWithSubspaceMinPrices(txFeeChecker ante.TxFeeChecker, sk antetypes.SubspacesKeeper) ante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		// Perform default tx fee checker if tx is not subspace tx
@@ -39,8 +43,27 @@ func CheckTxFeeWithSubspaceMinPrices(txFeeChecker ante.TxFeeChecker, sk antetype
 			return txFeeChecker(ctx, tx)
 		}
 
+		minPrices := ctx.MinGasPrices()
+
+		config, hasConfig := sk.GetFeeTokensConfig(ctx, subspaceID)
+		if hasConfig {
+			feeTx, ok := tx.(sdk.FeeTx)
+			if !ok {
+				return nil, 0, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+			}
+
+			// Make sure the fees do not exceed the maximum amounts accepted by the subspace
+			err := config.ValidateFees(feeTx.GetFee())
+			if err != nil {
+				return nil, 0, errors.Wrap(types.ErrFeeTokenCapExceeded, err.Error())
+			}
+
+			// Use the prices converted from the base denom price for the tokens the validator has not priced
+			minPrices = MergeMinPrices(minPrices, config.ConvertMinGasPrices(minPrices, subspace.AdditionalFeeTokens))
+		}
+
 		// Perform custom tx fee checker with subspace additional fee tokens
-		newMinPrices := MergeMinPrices(ctx.MinGasPrices(), sdk.NewDecCoinsFromCoins(subspace.AdditionalFeeTokens...))
+		newMinPrices := MergeMinPrices(minPrices, sdk.NewDecCoinsFromCoins(subspace.AdditionalFeeTokens...))
 		newCtx := ctx.WithMinGasPrices(newMinPrices)
 		return txFeeChecker(newCtx, tx)
 	}
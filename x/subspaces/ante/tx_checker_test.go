@@ -17,12 +17,13 @@ import (
 func (suite *AnteTestSuite) TestCheckTxFeeWithSubspaceMinPrices() {
 	signer := sdk.MustAccAddressFromBech32("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
 	msg := relationshipstypes.NewMsgCreateRelationship("cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", 1)
-	manageSubspaceMsg := types.NewMsgAddUserToUserGroup(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+	manageSubspaceMsg := types.NewMsgAddUserToUserGroup(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
 	nonSubspaceMsg := testdata.NewTestMsg(signer)
 
 	testCases := []struct {
 		name      string
 		setup     func()
+		setupCtx  func(ctx sdk.Context) sdk.Context
 		fees      sdk.Coins
 		buildTx   func(fees sdk.Coins) sdk.Tx
 		check     func(ctx sdk.Context)
@@ -133,6 +134,10 @@ func (suite *AnteTestSuite) TestCheckTxFeeWithSubspaceMinPrices() {
 						},
 						true,
 					)
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), uint64(1)).
+					Return(types.FeeTokensConfig{}, false)
 			},
 			fees: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(100))),
 			buildTx: func(fees sdk.Coins) sdk.Tx {
@@ -155,6 +160,10 @@ func (suite *AnteTestSuite) TestCheckTxFeeWithSubspaceMinPrices() {
 						},
 						true,
 					)
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), uint64(1)).
+					Return(types.FeeTokensConfig{}, false)
 			},
 			fees: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
 			buildTx: func(fees sdk.Coins) sdk.Tx {
@@ -177,6 +186,10 @@ func (suite *AnteTestSuite) TestCheckTxFeeWithSubspaceMinPrices() {
 						},
 						true,
 					)
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), uint64(1)).
+					Return(types.FeeTokensConfig{}, false)
 			},
 			fees: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(100))),
 			buildTx: func(fees sdk.Coins) sdk.Tx {
@@ -188,6 +201,158 @@ func (suite *AnteTestSuite) TestCheckTxFeeWithSubspaceMinPrices() {
 			},
 			shouldErr: false,
 		},
+		{
+			name: "non manage subspace tx with insufficient converted subspace fees returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					GetSubspace(gomock.Any(), uint64(1)).
+					Return(
+						types.Subspace{
+							AdditionalFeeTokens: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(1))),
+						},
+						true,
+					)
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), uint64(1)).
+					Return(types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+						types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+					}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR), true)
+			},
+			fees: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(100))),
+			buildTx: func(fees sdk.Coins) sdk.Tx {
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				txBuilder.SetMsgs(msg)
+				txBuilder.SetFeeAmount(fees)
+				txBuilder.SetGasLimit(100)
+				return txBuilder.GetTx()
+			},
+			shouldErr: true,
+		},
+		{
+			name: "non manage subspace tx with fees exceeding the fee token cap returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					GetSubspace(gomock.Any(), uint64(1)).
+					Return(
+						types.Subspace{
+							AdditionalFeeTokens: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(1))),
+						},
+						true,
+					)
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), uint64(1)).
+					Return(types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+						types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(150)),
+					}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR), true)
+			},
+			fees: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(200))),
+			buildTx: func(fees sdk.Coins) sdk.Tx {
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				txBuilder.SetMsgs(msg)
+				txBuilder.SetFeeAmount(fees)
+				txBuilder.SetGasLimit(100)
+				return txBuilder.GetTx()
+			},
+			shouldErr: true,
+		},
+		{
+			name: "non manage subspace tx with converted subspace fees returns no error",
+			setup: func() {
+				suite.sk.EXPECT().
+					GetSubspace(gomock.Any(), uint64(1)).
+					Return(
+						types.Subspace{
+							AdditionalFeeTokens: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(1))),
+						},
+						true,
+					)
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), uint64(1)).
+					Return(types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+						types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(200)),
+					}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR), true)
+			},
+			fees: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(200))),
+			buildTx: func(fees sdk.Coins) sdk.Tx {
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				txBuilder.SetMsgs(msg)
+				txBuilder.SetFeeAmount(fees)
+				txBuilder.SetGasLimit(100)
+				return txBuilder.GetTx()
+			},
+			shouldErr: false,
+		},
+		{
+			name: "non manage subspace tx with fees below the validator price of the fee token returns error",
+			setup: func() {
+				suite.sk.EXPECT().
+					GetSubspace(gomock.Any(), uint64(1)).
+					Return(
+						types.Subspace{
+							AdditionalFeeTokens: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(1))),
+						},
+						true,
+					)
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), uint64(1)).
+					Return(types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+						types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(300)),
+					}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR), true)
+			},
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithMinGasPrices(sdk.NewDecCoins(
+					sdk.NewDecCoin("stake", sdk.NewInt(1)),
+					sdk.NewDecCoin("minttoken", sdk.NewInt(3)),
+				))
+			},
+			fees: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(200))),
+			buildTx: func(fees sdk.Coins) sdk.Tx {
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				txBuilder.SetMsgs(msg)
+				txBuilder.SetFeeAmount(fees)
+				txBuilder.SetGasLimit(100)
+				return txBuilder.GetTx()
+			},
+			shouldErr: true,
+		},
+		{
+			name: "non manage subspace tx with fees matching the validator price of the fee token returns no error",
+			setup: func() {
+				suite.sk.EXPECT().
+					GetSubspace(gomock.Any(), uint64(1)).
+					Return(
+						types.Subspace{
+							AdditionalFeeTokens: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(1))),
+						},
+						true,
+					)
+
+				suite.sk.EXPECT().
+					GetFeeTokensConfig(gomock.Any(), uint64(1)).
+					Return(types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+						types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(300)),
+					}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR), true)
+			},
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithMinGasPrices(sdk.NewDecCoins(
+					sdk.NewDecCoin("stake", sdk.NewInt(1)),
+					sdk.NewDecCoin("minttoken", sdk.NewInt(3)),
+				))
+			},
+			fees: sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(300))),
+			buildTx: func(fees sdk.Coins) sdk.Tx {
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				txBuilder.SetMsgs(msg)
+				txBuilder.SetFeeAmount(fees)
+				txBuilder.SetGasLimit(100)
+				return txBuilder.GetTx()
+			},
+			shouldErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -197,8 +362,13 @@ func (suite *AnteTestSuite) TestCheckTxFeeWithSubspaceMinPrices() {
 				tc.setup()
 			}
 
+			ctx := suite.ctx
+			if tc.setupCtx != nil {
+				ctx = tc.setupCtx(ctx)
+			}
+
 			tx := tc.buildTx(tc.fees)
-			coins, _, err := subspacesante.CheckTxFeeWithSubspaceMinPrices(ante.CheckTxFeeWithValidatorMinGasPrices, suite.sk)(suite.ctx, tx)
+			coins, _, err := subspacesante.CheckTxFeeWithSubspaceMinPrices(ante.CheckTxFeeWithValidatorMinGasPrices, suite.sk)(ctx, tx)
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
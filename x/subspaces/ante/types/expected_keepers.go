@@ -20,6 +20,7 @@ type AccountKeeper interface {
 // BankKeeper represents the expected keeper used to interact with x/bank
 type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 
 	// Required by auth AnteHandler
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
@@ -30,6 +31,8 @@ type BankKeeper interface {
 type SubspacesKeeper interface {
 	UseGrantedFees(ctx sdk.Context, subspaceID uint64, grantee sdk.AccAddress, fees sdk.Coins, msgs []sdk.Msg) bool
 	GetSubspace(ctx sdk.Context, subspaceID uint64) (types.Subspace, bool)
+	GetFeeTokensConfig(ctx sdk.Context, subspaceID uint64) (types.FeeTokensConfig, bool)
+	ConsumeMsgRateLimits(ctx sdk.Context, msg types.SubspaceMsg) error
 }
 
 // AuthDeductFeeDecorator represents the expected keeper used to interact with auth.DeductFeeDecorator
@@ -36,6 +36,7 @@ func TestGenericSubspaceAuthorization_Accept(t *testing.T) {
 				0,
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
 				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
 			),
 			shouldErr:   false,
@@ -49,6 +50,7 @@ func TestGenericSubspaceAuthorization_Accept(t *testing.T) {
 				0,
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
 				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
 			),
 			shouldErr:   false,
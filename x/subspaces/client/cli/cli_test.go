@@ -95,6 +95,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 				0,
 				"Test section",
 				"Test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 			types.NewSection(
 				1,
@@ -102,11 +103,12 @@ func (s *IntegrationTestSuite) SetupSuite() {
 				0,
 				"Another test section",
 				"Another test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 		},
 		[]types.UserPermission{
-			types.NewUserPermission(1, 0, "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e", types.NewPermissions(poststypes.PermissionWrite)),
-			types.NewUserPermission(2, 0, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionManageGroups)),
+			types.NewUserPermission(1, 0, "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e", types.NewPermissions(poststypes.PermissionWrite), nil),
+			types.NewUserPermission(2, 0, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionManageGroups), nil),
 		},
 		[]types.UserGroup{
 			types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions(poststypes.PermissionWrite)),
@@ -114,9 +116,9 @@ func (s *IntegrationTestSuite) SetupSuite() {
 			types.NewUserGroup(2, 0, 2, "Third group", "", types.NewPermissions(poststypes.PermissionWrite)),
 		},
 		[]types.UserGroupMemberEntry{
-			types.NewUserGroupMemberEntry(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
-			types.NewUserGroupMemberEntry(2, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
-			types.NewUserGroupMemberEntry(2, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			types.NewUserGroupMemberEntry(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil),
+			types.NewUserGroupMemberEntry(2, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil),
+			types.NewUserGroupMemberEntry(2, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil),
 		},
 		[]types.Grant{
 			types.NewGrant(
@@ -144,6 +146,54 @@ func (s *IntegrationTestSuite) SetupSuite() {
 				&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
 			),
 		},
+		[]types.SubspaceOwnerTransferRequest{
+			types.NewSubspaceOwnerTransferRequest(
+				2,
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+			),
+		},
+		[]types.GroupInvite{
+			types.NewGroupInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				0,
+				nil,
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			),
+		},
+		[]types.GroupApplication{
+			types.NewGroupApplication(
+				2,
+				1,
+				"cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e",
+				"Let me in",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+		},
+		[]types.SubspaceBan{
+			types.NewSubspaceBan(
+				1,
+				"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+				"Spam",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				nil,
+			),
+		},
+		[]types.RateLimit{
+			types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+		},
+		nil,
+		nil,
+		nil,
+		[]types.FeeTokensConfig{
+			types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+				types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000)),
+			}, types.FEE_TOKENS_DESTINATION_TREASURY),
+		},
+		nil,
 	)
 
 	// Store the genesis data
@@ -280,6 +330,64 @@ func (s *IntegrationTestSuite) TestCmdQuerySubspaces() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdQueryIncomingSubspaceOwnerTransferRequests() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name        string
+		args        []string
+		shouldErr   bool
+		expResponse types.QueryIncomingSubspaceOwnerTransferRequestsResponse
+	}{
+		{
+			name: "requests are returned correctly",
+			args: []string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QueryIncomingSubspaceOwnerTransferRequestsResponse{
+				Requests: []types.SubspaceOwnerTransferRequest{
+					types.NewSubspaceOwnerTransferRequest(
+						2,
+						"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+					),
+				},
+			},
+		},
+		{
+			name: "requests of the given receiver are returned correctly",
+			args: []string{
+				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QueryIncomingSubspaceOwnerTransferRequestsResponse{
+				Requests: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryIncomingSubspaceOwnerTransferRequests()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryIncomingSubspaceOwnerTransferRequestsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				s.Require().Equal(tc.expResponse.Requests, response.Requests)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestCmdQuerySection() {
 	val := s.network.Validators[0]
 	testCases := []struct {
@@ -310,6 +418,7 @@ func (s *IntegrationTestSuite) TestCmdQuerySection() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				),
 			},
 		},
@@ -361,6 +470,7 @@ func (s *IntegrationTestSuite) TestCmdQuerySections() {
 						0,
 						"Test section",
 						"Test section",
+						types.SECTION_VISIBILITY_PUBLIC,
 					),
 				},
 			},
@@ -536,35 +646,49 @@ func (s *IntegrationTestSuite) TestCmdQueryUserGroupMembers() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdQueryUserPermissions() {
+func (s *IntegrationTestSuite) TestCmdQueryGroupInvites() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name        string
 		args        []string
 		shouldErr   bool
-		expResponse types.QueryUserPermissionsResponse
+		expResponse types.QueryGroupInvitesResponse
 	}{
 		{
-			name: "subspace not found returns error",
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace"},
+			shouldErr: true,
+		},
+		{
+			name: "invites are returned correctly",
 			args: []string{
-				"11", "0", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"1",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			shouldErr: true,
+			shouldErr: false,
+			expResponse: types.QueryGroupInvitesResponse{
+				Invites: []types.GroupInvite{
+					types.NewGroupInvite(
+						1,
+						1,
+						types.GetInviteSecretHash("secret"),
+						10,
+						0,
+						nil,
+						"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					),
+				},
+			},
 		},
 		{
-			name: "user permissions are returned correctly",
+			name: "invites of the given group are returned correctly",
 			args: []string{
-				"2", "0", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"1", "2",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			shouldErr: false,
-			expResponse: types.QueryUserPermissionsResponse{
-				Permissions: types.NewPermissions(types.PermissionManageGroups),
-				Details: []types.PermissionDetail{
-					types.NewPermissionDetailGroup(2, 0, 0, nil),
-					types.NewPermissionDetailGroup(2, 0, 1, types.NewPermissions(types.PermissionManageGroups)),
-				},
+			expResponse: types.QueryGroupInvitesResponse{
+				Invites: nil,
 			},
 		},
 	}
@@ -573,7 +697,7 @@ func (s *IntegrationTestSuite) TestCmdQueryUserPermissions() {
 		tc := tc
 
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryUserPermissions()
+			cmd := cli.GetCmdQueryGroupInvites()
 			clientCtx := val.ClientCtx
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 
@@ -582,76 +706,53 @@ func (s *IntegrationTestSuite) TestCmdQueryUserPermissions() {
 			} else {
 				s.Require().NoError(err)
 
-				var response types.QueryUserPermissionsResponse
+				var response types.QueryGroupInvitesResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
-				s.Require().Equal(tc.expResponse.Permissions, response.Permissions)
-				for i, detail := range tc.expResponse.Details {
-					s.Require().True(detail.Equal(response.Details[i]))
-				}
+				s.Require().Equal(tc.expResponse.Invites, response.Invites)
 			}
 		})
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdQueryUserAllowances() {
+func (s *IntegrationTestSuite) TestCmdQueryGroupInvite() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name        string
 		args        []string
 		shouldErr   bool
-		expResponse types.QueryUserAllowancesResponse
+		expResponse types.QueryGroupInviteResponse
 	}{
 		{
-			name: "invalid subspace id returns error",
-			args: []string{
-				"subspace",
-				"grantee",
-			},
+			name:      "invite not found returns error",
+			args:      []string{"1", types.GetInviteSecretHash("another-secret")},
 			shouldErr: true,
 		},
 		{
-			name: "valid query without grantee is returned correctly",
-			args: []string{
-				"1",
-				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
-			},
-			shouldErr: false,
-			expResponse: types.QueryUserAllowancesResponse{
-				Grants: []types.Grant{
-					types.NewGrant(
-						1,
-						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
-						types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
-						&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
-					),
-				},
-			},
-		},
-		{
-			name: "valid query is returned correctly",
+			name: "invite is returned correctly",
 			args: []string{
-				"1",
-				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"1", types.GetInviteSecretHash("secret"),
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			shouldErr: false,
-			expResponse: types.QueryUserAllowancesResponse{
-				Grants: []types.Grant{
-					types.NewGrant(
-						1,
-						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
-						types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
-						&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
-					),
-				},
+			expResponse: types.QueryGroupInviteResponse{
+				Invite: types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				),
 			},
 		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
+
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryUserAllowances()
+			cmd := cli.GetCmdQueryGroupInvite()
 			clientCtx := val.ClientCtx
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 
@@ -660,83 +761,106 @@ func (s *IntegrationTestSuite) TestCmdQueryUserAllowances() {
 			} else {
 				s.Require().NoError(err)
 
-				var response types.QueryUserAllowancesResponse
+				var response types.QueryGroupInviteResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
-				for i, grant := range tc.expResponse.Grants {
-					s.Require().True(grant.Equal(response.Grants[i]))
-				}
+				s.Require().Equal(tc.expResponse.Invite, response.Invite)
 			}
 		})
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdQueryGroupAllowances() {
+func (s *IntegrationTestSuite) TestCmdQueryGroupApplications() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name        string
 		args        []string
 		shouldErr   bool
-		expResponse types.QueryGroupAllowancesResponse
+		expResponse types.QueryGroupApplicationsResponse
 	}{
 		{
-			name: "invalid subspace id returns error",
-			args: []string{
-				"subspace",
-				"group",
-			},
-			shouldErr: true,
-		},
-		{
-			name: "invalid group id returns error",
-			args: []string{
-				"1",
-				"group",
-			},
+			name:      "group not found returns error",
+			args:      []string{"1", "10"},
 			shouldErr: true,
 		},
 		{
-			name: "valid query without group id is returned correctly",
+			name: "applications are returned correctly",
 			args: []string{
-				"1",
+				"2", "1",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			shouldErr: false,
-			expResponse: types.QueryGroupAllowancesResponse{
-				Grants: []types.Grant{
-					types.NewGrant(
+			expResponse: types.QueryGroupApplicationsResponse{
+				Applications: []types.GroupApplication{
+					types.NewGroupApplication(
+						2,
 						1,
-						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
-						types.NewGroupGrantee(1),
-						&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+						"cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e",
+						"Let me in",
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					),
 				},
 			},
 		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryGroupApplications()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryGroupApplicationsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				s.Require().Equal(tc.expResponse.Applications, response.Applications)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdQueryGroupApplication() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name        string
+		args        []string
+		shouldErr   bool
+		expResponse types.QueryGroupApplicationResponse
+	}{
+		{
+			name:      "application not found returns error",
+			args:      []string{"2", "1", "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"},
+			shouldErr: true,
+		},
 		{
-			name: "valid query is returned correctly",
+			name: "application is returned correctly",
 			args: []string{
-				"1",
-				"1",
+				"2", "1", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			shouldErr: false,
-			expResponse: types.QueryGroupAllowancesResponse{
-				Grants: []types.Grant{
-					types.NewGrant(
-						1,
-						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
-						types.NewGroupGrantee(1),
-						&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
-					),
-				},
+			expResponse: types.QueryGroupApplicationResponse{
+				Application: types.NewGroupApplication(
+					2,
+					1,
+					"cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				),
 			},
 		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
+
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryGroupAllowances()
+			cmd := cli.GetCmdQueryGroupApplication()
 			clientCtx := val.ClientCtx
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 
@@ -745,52 +869,1455 @@ func (s *IntegrationTestSuite) TestCmdQueryGroupAllowances() {
 			} else {
 				s.Require().NoError(err)
 
-				var response types.QueryGroupAllowancesResponse
+				var response types.QueryGroupApplicationResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
-				for i, grant := range tc.expResponse.Grants {
-					s.Require().True(grant.Equal(response.Grants[i]))
-				}
+				s.Require().Equal(tc.expResponse.Application, response.Application)
 			}
 		})
 	}
 }
 
-// --------------------------------------------------------------------------------------------------------------------
-
-func (s *IntegrationTestSuite) TestCmdCreateSubspace() {
+func (s *IntegrationTestSuite) TestCmdQueryUserPermissions() {
 	val := s.network.Validators[0]
 	testCases := []struct {
-		name      string
-		args      []string
-		shouldErr bool
-		respType  proto.Message
+		name        string
+		args        []string
+		shouldErr   bool
+		expResponse types.QueryUserPermissionsResponse
 	}{
 		{
-			name: "invalid name returns error",
+			name: "subspace not found returns error",
 			args: []string{
-				"",
+				"11", "0", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "user permissions are returned correctly",
+			args: []string{
+				"2", "0", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
+			shouldErr: false,
+			expResponse: types.QueryUserPermissionsResponse{
+				Permissions: types.NewPermissions(types.PermissionManageGroups),
+				Details: []types.PermissionDetail{
+					types.NewPermissionDetailGroup(2, 0, 0, nil),
+					types.NewPermissionDetailGroup(2, 0, 1, types.NewPermissions(types.PermissionManageGroups)),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryUserPermissions()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryUserPermissionsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				s.Require().Equal(tc.expResponse.Permissions, response.Permissions)
+				for i, detail := range tc.expResponse.Details {
+					s.Require().True(detail.Equal(response.Details[i]))
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdQueryPermissionDetails() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name        string
+		args        []string
+		shouldErr   bool
+		expResponse types.QueryPermissionDetailsResponse
+	}{
+		{
+			name: "subspace not found returns error",
+			args: []string{
+				"11", "0", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", types.PermissionManageGroups,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "permission details are returned correctly",
+			args: []string{
+				"2", "0", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", types.PermissionManageGroups,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QueryPermissionDetailsResponse{
+				HasPermission: true,
+				Banned:        false,
+				Sources: []types.PermissionSource{
+					types.NewPermissionSourceGroup(0, false, 1, types.NewPermissions(types.PermissionManageGroups)),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryPermissionDetails()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryPermissionDetailsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				s.Require().Equal(tc.expResponse.HasPermission, response.HasPermission)
+				s.Require().Equal(tc.expResponse.Banned, response.Banned)
+				for i, source := range tc.expResponse.Sources {
+					s.Require().True(source.Equal(response.Sources[i]))
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdQuerySubspaceBans() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name        string
+		args        []string
+		shouldErr   bool
+		expResponse types.QuerySubspaceBansResponse
+	}{
+		{
+			name:      "subspace not found returns error",
+			args:      []string{"11"},
+			shouldErr: true,
+		},
+		{
+			name: "bans are returned correctly",
+			args: []string{
+				"1",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QuerySubspaceBansResponse{
+				Bans: []types.SubspaceBan{
+					types.NewSubspaceBan(
+						1,
+						"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+						"Spam",
+						"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+						nil,
+					),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySubspaceBans()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QuerySubspaceBansResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				s.Require().Equal(tc.expResponse.Bans, response.Bans)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdQueryRateLimits() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name        string
+		args        []string
+		shouldErr   bool
+		expResponse types.QueryRateLimitsResponse
+	}{
+		{
+			name:      "subspace not found returns error",
+			args:      []string{"11"},
+			shouldErr: true,
+		},
+		{
+			name: "rate limits are returned correctly",
+			args: []string{
+				"1",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QueryRateLimitsResponse{
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryRateLimits()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryRateLimitsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				s.Require().Equal(tc.expResponse.RateLimits, response.RateLimits)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdQueryFeeTokensConfig() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name        string
+		args        []string
+		shouldErr   bool
+		expResponse types.QueryFeeTokensConfigResponse
+	}{
+		{
+			name:      "config not found returns error",
+			args:      []string{"11"},
+			shouldErr: true,
+		},
+		{
+			name: "config is returned correctly",
+			args: []string{
+				"1",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QueryFeeTokensConfigResponse{
+				Config: types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000)),
+				}, types.FEE_TOKENS_DESTINATION_TREASURY),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFeeTokensConfig()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryFeeTokensConfigResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				s.Require().Equal(tc.expResponse.Config, response.Config)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdQueryUserAllowances() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name        string
+		args        []string
+		shouldErr   bool
+		expResponse types.QueryUserAllowancesResponse
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"subspace",
+				"grantee",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid query without grantee is returned correctly",
+			args: []string{
+				"1",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QueryUserAllowancesResponse{
+				Grants: []types.Grant{
+					types.NewGrant(
+						1,
+						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+						types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+						&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+					),
+				},
+			},
+		},
+		{
+			name: "valid query is returned correctly",
+			args: []string{
+				"1",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QueryUserAllowancesResponse{
+				Grants: []types.Grant{
+					types.NewGrant(
+						1,
+						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+						types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+						&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+					),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryUserAllowances()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryUserAllowancesResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				for i, grant := range tc.expResponse.Grants {
+					s.Require().True(grant.Equal(response.Grants[i]))
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdQueryGroupAllowances() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name        string
+		args        []string
+		shouldErr   bool
+		expResponse types.QueryGroupAllowancesResponse
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"subspace",
+				"group",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid group id returns error",
+			args: []string{
+				"1",
+				"group",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid query without group id is returned correctly",
+			args: []string{
+				"1",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QueryGroupAllowancesResponse{
+				Grants: []types.Grant{
+					types.NewGrant(
+						1,
+						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+						types.NewGroupGrantee(1),
+						&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+					),
+				},
+			},
+		},
+		{
+			name: "valid query is returned correctly",
+			args: []string{
+				"1",
+				"1",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			shouldErr: false,
+			expResponse: types.QueryGroupAllowancesResponse{
+				Grants: []types.Grant{
+					types.NewGrant(
+						1,
+						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+						types.NewGroupGrantee(1),
+						&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+					),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryGroupAllowances()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var response types.QueryGroupAllowancesResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+				for i, grant := range tc.expResponse.Grants {
+					s.Require().True(grant.Equal(response.Grants[i]))
+				}
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (s *IntegrationTestSuite) TestCmdCreateSubspace() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name: "invalid name returns error",
+			args: []string{
+				"",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"Test subspace",
+				fmt.Sprintf("--%s=%s", cli.FlagDescription, "This is a test subspace"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+		{
+			name: "valid data returns no error with custom owner",
+			args: []string{
+				"Another test subspace",
+				fmt.Sprintf("--%s=%s", cli.FlagDescription, "Another test subspace"),
+				fmt.Sprintf("--%s=%s", cli.FlagOwner, "cosmos1et50whs236j9dacacz7feh05jjum9jk04cdt9u"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdCreateSubspace()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdEditSubspace() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace"},
+			shouldErr: true,
+		},
+		{
+			name: "invalid name returns error",
+			args: []string{
+				"1",
+				fmt.Sprintf("--%s=%s", cli.FlagName, ""),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid owner flag returns error",
+			args: []string{
+				"1",
+				fmt.Sprintf("--%s=%s", cli.FlagOwner, "abd"),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"2",
+				fmt.Sprintf("--%s=%s", cli.FlagName, "Edited name"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdEditSubspace()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdDeleteSubspace() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace"},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"3",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdDeleteSubspace()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdArchiveSubspace() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace"},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdArchiveSubspace()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdUnarchiveSubspace() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace"},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdUnarchiveSubspace()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdRequestSubspaceOwnerTransfer() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"},
+			shouldErr: true,
+		},
+		{
+			name: "invalid receiver returns error",
+			args: []string{
+				"1", "receiver",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdRequestSubspaceOwnerTransfer()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdCancelSubspaceOwnerTransfer() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace"},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"2",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdCancelSubspaceOwnerTransfer()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdAcceptSubspaceOwnerTransfer() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace"},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"2",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdAcceptSubspaceOwnerTransfer()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdRefuseSubspaceOwnerTransfer() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace"},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"2",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdRefuseSubspaceOwnerTransfer()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (s *IntegrationTestSuite) TestCmdCreateSection() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"0", "This is a new section",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid name returns error",
+			args: []string{
+				"1", "",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1", "Test section",
+				fmt.Sprintf("--%s=%s", cli.FlagDescription, "This is a test section"),
+				fmt.Sprintf("--%s=%s", cli.FlagParent, "1"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdCreateSection()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdEditSection() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"0", "1",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid name returns error",
+			args: []string{
+				"1", "1",
+				fmt.Sprintf("--%s=%s", cli.FlagName, ""),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1", "1",
+				fmt.Sprintf("--%s=%s", cli.FlagName, "Edited name"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdEditSection()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdMoveSection() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"0", "1", "2",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid section id returns error",
+			args: []string{
+				"1", "0", "2",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1", "1", "2",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdMoveSection()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdSetSectionVisibility() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"0", "1", "members-only",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid visibility returns error",
+			args: []string{
+				"1", "1", "private",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1", "1", "members-only",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdSetSectionVisibility()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdDeleteSection() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"0", "1",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid section id returns error",
+			args: []string{
+				"1", "0",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1", "1",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdDeleteSection()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (s *IntegrationTestSuite) TestCmdCreateUserGroup() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"id", "testing-group"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid name returns error",
+			args:      []string{"1", ""},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"2", "testing-group",
+				fmt.Sprintf("--%s=%s", cli.FlagPermissions, types.PermissionSetPermissions),
+				fmt.Sprintf("--%s=%s", cli.FlagInitialMembers, "cosmos1g4yzh3q3grf804t4y4fuynrvrxtshgxy7j783f"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdCreateUserGroup()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdEditUserGroup() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"0", "1"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid group id returns error",
+			args:      []string{"1", "g"},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error - group = 0",
+			args: []string{
+				"1", "0",
+				fmt.Sprintf("--%s=%s", flags.FlagName, "This is my new group name"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+		{
+			name: "valid data returns no error - group > 0",
+			args: []string{
+				"1", "1",
+				fmt.Sprintf("--%s=%s", flags.FlagName, "This is my new group name"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdEditUserGroup()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdMoveUserGroup() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name: "invalid subspace id returns error",
+			args: []string{
+				"0", "1", "2",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"1", "1", "2",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdMoveUserGroup()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdSetUserGroupPermissions() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"0", "1"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid group id returns error",
+			args:      []string{"1", "g"},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error - group id = 0",
+			args: []string{
+				"1", "0", types.SerializePermission(poststypes.PermissionWrite),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+		{
+			name: "valid data returns no error - group id > 0",
+			args: []string{
+				"1", "1", types.SerializePermission(poststypes.PermissionWrite),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdSetUserGroupPermissions()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdDeleteUserGroup() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace-id", "testing-group"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid name returns error",
+			args:      []string{"1", ""},
+			shouldErr: true,
+		},
+		{
+			name: "valid data returns no error",
+			args: []string{
+				"2", "1",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			shouldErr: false,
+			respType:  &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdDeleteUserGroup()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdAddUserToUserGroup() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		shouldErr bool
+		respType  proto.Message
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace-id", "testing-group"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid name returns error",
+			args:      []string{"1", ""},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid user returns error",
+			args:      []string{"1", "1", ""},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"Test subspace",
-				fmt.Sprintf("--%s=%s", cli.FlagDescription, "This is a test subspace"),
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-			},
-			shouldErr: false,
-			respType:  &sdk.TxResponse{},
-		},
-		{
-			name: "valid data returns no error with custom owner",
-			args: []string{
-				"Another test subspace",
-				fmt.Sprintf("--%s=%s", cli.FlagDescription, "Another test subspace"),
-				fmt.Sprintf("--%s=%s", cli.FlagOwner, "cosmos1et50whs236j9dacacz7feh05jjum9jk04cdt9u"),
+				"2", "1", "cosmos1et50whs236j9dacacz7feh05jjum9jk04cdt9u",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -804,7 +2331,7 @@ func (s *IntegrationTestSuite) TestCmdCreateSubspace() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdCreateSubspace()
+			cmd := cli.GetCmdAddUserToUserGroup()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -818,7 +2345,7 @@ func (s *IntegrationTestSuite) TestCmdCreateSubspace() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdEditSubspace() {
+func (s *IntegrationTestSuite) TestCmdRemoveUserFromUserGroup() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -828,30 +2355,23 @@ func (s *IntegrationTestSuite) TestCmdEditSubspace() {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			args:      []string{"subspace"},
+			args:      []string{"subspace-id", "testing-group"},
 			shouldErr: true,
 		},
 		{
-			name: "invalid name returns error",
-			args: []string{
-				"1",
-				fmt.Sprintf("--%s=%s", cli.FlagName, ""),
-			},
+			name:      "invalid name returns error",
+			args:      []string{"1", ""},
 			shouldErr: true,
 		},
 		{
-			name: "invalid owner flag returns error",
-			args: []string{
-				"1",
-				fmt.Sprintf("--%s=%s", cli.FlagOwner, "abd"),
-			},
+			name:      "invalid user returns error",
+			args:      []string{"1", "group", ""},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"2",
-				fmt.Sprintf("--%s=%s", cli.FlagName, "Edited name"),
+				"2", "1", "cosmos1et50whs236j9dacacz7feh05jjum9jk04cdt9u",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -865,7 +2385,7 @@ func (s *IntegrationTestSuite) TestCmdEditSubspace() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdEditSubspace()
+			cmd := cli.GetCmdRemoveUserFromUserGroup()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -879,7 +2399,9 @@ func (s *IntegrationTestSuite) TestCmdEditSubspace() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdDeleteSubspace() {
+// --------------------------------------------------------------------------------------------------------------------
+
+func (s *IntegrationTestSuite) TestCmdCreateInvite() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -889,13 +2411,29 @@ func (s *IntegrationTestSuite) TestCmdDeleteSubspace() {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			args:      []string{"subspace"},
+			args:      []string{"subspace", "1", "secret"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid group id returns error",
+			args:      []string{"1", "group", "secret"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid max uses returns error",
+			args:      []string{"1", "1", "secret", fmt.Sprintf("--%s=%d", cli.FlagMaxUses, 0)},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid expiration time returns error",
+			args:      []string{"1", "1", "secret", fmt.Sprintf("--%s=%s", cli.FlagExpirationTime, "tomorrow")},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"3",
+				"1", "1", "another-secret",
+				fmt.Sprintf("--%s=%d", cli.FlagMaxUses, 5),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -909,7 +2447,7 @@ func (s *IntegrationTestSuite) TestCmdDeleteSubspace() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdDeleteSubspace()
+			cmd := cli.GetCmdCreateInvite()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -923,9 +2461,7 @@ func (s *IntegrationTestSuite) TestCmdDeleteSubspace() {
 	}
 }
 
-// --------------------------------------------------------------------------------------------------------------------
-
-func (s *IntegrationTestSuite) TestCmdCreateSection() {
+func (s *IntegrationTestSuite) TestCmdDeleteInvite() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -934,25 +2470,19 @@ func (s *IntegrationTestSuite) TestCmdCreateSection() {
 		respType  proto.Message
 	}{
 		{
-			name: "invalid subspace id returns error",
-			args: []string{
-				"0", "This is a new section",
-			},
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace", types.GetInviteSecretHash("secret")},
 			shouldErr: true,
 		},
 		{
-			name: "invalid name returns error",
-			args: []string{
-				"1", "",
-			},
+			name:      "invalid secret hash returns error",
+			args:      []string{"1", "secret"},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"1", "Test section",
-				fmt.Sprintf("--%s=%s", cli.FlagDescription, "This is a test section"),
-				fmt.Sprintf("--%s=%s", cli.FlagParent, "1"),
+				"1", types.GetInviteSecretHash("secret"),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -966,7 +2496,7 @@ func (s *IntegrationTestSuite) TestCmdCreateSection() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdCreateSection()
+			cmd := cli.GetCmdDeleteInvite()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -980,7 +2510,7 @@ func (s *IntegrationTestSuite) TestCmdCreateSection() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdEditSection() {
+func (s *IntegrationTestSuite) TestCmdRedeemInvite() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -989,25 +2519,19 @@ func (s *IntegrationTestSuite) TestCmdEditSection() {
 		respType  proto.Message
 	}{
 		{
-			name: "invalid subspace id returns error",
-			args: []string{
-				"0", "1",
-			},
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace", "secret"},
 			shouldErr: true,
 		},
 		{
-			name: "invalid name returns error",
-			args: []string{
-				"1", "1",
-				fmt.Sprintf("--%s=%s", cli.FlagName, ""),
-			},
+			name:      "invalid secret returns error",
+			args:      []string{"1", ""},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"1", "1",
-				fmt.Sprintf("--%s=%s", cli.FlagName, "Edited name"),
+				"1", "secret",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1021,7 +2545,7 @@ func (s *IntegrationTestSuite) TestCmdEditSection() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdEditSection()
+			cmd := cli.GetCmdRedeemInvite()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -1035,7 +2559,7 @@ func (s *IntegrationTestSuite) TestCmdEditSection() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdMoveSection() {
+func (s *IntegrationTestSuite) TestCmdApplyToGroup() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -1044,23 +2568,20 @@ func (s *IntegrationTestSuite) TestCmdMoveSection() {
 		respType  proto.Message
 	}{
 		{
-			name: "invalid subspace id returns error",
-			args: []string{
-				"0", "1", "2",
-			},
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace", "1"},
 			shouldErr: true,
 		},
 		{
-			name: "invalid section id returns error",
-			args: []string{
-				"1", "0", "2",
-			},
+			name:      "invalid group id returns error",
+			args:      []string{"1", "group"},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"1", "1", "2",
+				"2", "1",
+				fmt.Sprintf("--%s=%s", cli.FlagMessage, "Let me in"),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1074,7 +2595,7 @@ func (s *IntegrationTestSuite) TestCmdMoveSection() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdMoveSection()
+			cmd := cli.GetCmdApplyToGroup()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -1088,7 +2609,7 @@ func (s *IntegrationTestSuite) TestCmdMoveSection() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdDeleteSection() {
+func (s *IntegrationTestSuite) TestCmdApproveGroupApplication() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -1097,23 +2618,24 @@ func (s *IntegrationTestSuite) TestCmdDeleteSection() {
 		respType  proto.Message
 	}{
 		{
-			name: "invalid subspace id returns error",
-			args: []string{
-				"0", "1",
-			},
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace", "1", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e"},
 			shouldErr: true,
 		},
 		{
-			name: "invalid section id returns error",
-			args: []string{
-				"1", "0",
-			},
+			name:      "invalid group id returns error",
+			args:      []string{"2", "group", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid applicant returns error",
+			args:      []string{"2", "1", "applicant"},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"1", "1",
+				"2", "1", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1127,7 +2649,7 @@ func (s *IntegrationTestSuite) TestCmdDeleteSection() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdDeleteSection()
+			cmd := cli.GetCmdApproveGroupApplication()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -1141,9 +2663,7 @@ func (s *IntegrationTestSuite) TestCmdDeleteSection() {
 	}
 }
 
-// --------------------------------------------------------------------------------------------------------------------
-
-func (s *IntegrationTestSuite) TestCmdCreateUserGroup() {
+func (s *IntegrationTestSuite) TestCmdRejectGroupApplication() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -1153,20 +2673,23 @@ func (s *IntegrationTestSuite) TestCmdCreateUserGroup() {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			args:      []string{"id", "testing-group"},
+			args:      []string{"subspace", "1", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e"},
 			shouldErr: true,
 		},
 		{
-			name:      "invalid name returns error",
-			args:      []string{"1", ""},
+			name:      "invalid group id returns error",
+			args:      []string{"2", "group", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid applicant returns error",
+			args:      []string{"2", "1", "applicant"},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"2", "testing-group",
-				fmt.Sprintf("--%s=%s", cli.FlagPermissions, types.PermissionSetPermissions),
-				fmt.Sprintf("--%s=%s", cli.FlagInitialMembers, "cosmos1g4yzh3q3grf804t4y4fuynrvrxtshgxy7j783f"),
+				"2", "1", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1180,7 +2703,7 @@ func (s *IntegrationTestSuite) TestCmdCreateUserGroup() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdCreateUserGroup()
+			cmd := cli.GetCmdRejectGroupApplication()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -1194,7 +2717,7 @@ func (s *IntegrationTestSuite) TestCmdCreateUserGroup() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdEditUserGroup() {
+func (s *IntegrationTestSuite) TestCmdSetPermissions() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -1204,32 +2727,23 @@ func (s *IntegrationTestSuite) TestCmdEditUserGroup() {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			args:      []string{"0", "1"},
+			args:      []string{"id", "group", "Write"},
 			shouldErr: true,
 		},
 		{
-			name:      "invalid group id returns error",
-			args:      []string{"1", "g"},
+			name:      "invalid target returns error",
+			args:      []string{"1", "", "Write"},
 			shouldErr: true,
 		},
 		{
-			name: "valid data returns no error - group = 0",
-			args: []string{
-				"1", "0",
-				fmt.Sprintf("--%s=%s", flags.FlagName, "This is my new group name"),
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-			},
-			shouldErr: false,
-			respType:  &sdk.TxResponse{},
+			name:      "invalid permission returns error",
+			args:      []string{"1", "group", "NonExistingPermission"},
+			shouldErr: true,
 		},
 		{
-			name: "valid data returns no error - group > 0",
+			name: "valid data returns no error",
 			args: []string{
-				"1", "1",
-				fmt.Sprintf("--%s=%s", flags.FlagName, "This is my new group name"),
+				"1", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e", "Write",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1243,7 +2757,7 @@ func (s *IntegrationTestSuite) TestCmdEditUserGroup() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdEditUserGroup()
+			cmd := cli.GetCmdSetUserPermissions()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -1257,7 +2771,7 @@ func (s *IntegrationTestSuite) TestCmdEditUserGroup() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdMoveUserGroup() {
+func (s *IntegrationTestSuite) TestCmdBanUser() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -1266,16 +2780,28 @@ func (s *IntegrationTestSuite) TestCmdMoveUserGroup() {
 		respType  proto.Message
 	}{
 		{
-			name: "invalid subspace id returns error",
+			name:      "invalid subspace id returns error",
+			args:      []string{"subspace", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid user returns error",
+			args:      []string{"1", "user"},
+			shouldErr: true,
+		},
+		{
+			name: "invalid expiration time returns error",
 			args: []string{
-				"0", "1", "2",
+				"1", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e",
+				fmt.Sprintf("--%s=%s", cli.FlagExpirationTime, "time"),
 			},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"1", "1", "2",
+				"1", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e",
+				fmt.Sprintf("--%s=%s", cli.FlagReason, "Spam"),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1289,7 +2815,7 @@ func (s *IntegrationTestSuite) TestCmdMoveUserGroup() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdMoveUserGroup()
+			cmd := cli.GetCmdBanUser()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -1303,7 +2829,7 @@ func (s *IntegrationTestSuite) TestCmdMoveUserGroup() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdSetUserGroupPermissions() {
+func (s *IntegrationTestSuite) TestCmdUnbanUser() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -1313,30 +2839,18 @@ func (s *IntegrationTestSuite) TestCmdSetUserGroupPermissions() {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			args:      []string{"0", "1"},
+			args:      []string{"subspace", "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn"},
 			shouldErr: true,
 		},
 		{
-			name:      "invalid group id returns error",
-			args:      []string{"1", "g"},
+			name:      "invalid user returns error",
+			args:      []string{"1", "user"},
 			shouldErr: true,
 		},
 		{
-			name: "valid data returns no error - group id = 0",
-			args: []string{
-				"1", "0", types.SerializePermission(poststypes.PermissionWrite),
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-			},
-			shouldErr: false,
-			respType:  &sdk.TxResponse{},
-		},
-		{
-			name: "valid data returns no error - group id > 0",
+			name: "valid data returns no error",
 			args: []string{
-				"1", "1", types.SerializePermission(poststypes.PermissionWrite),
+				"1", "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1350,7 +2864,7 @@ func (s *IntegrationTestSuite) TestCmdSetUserGroupPermissions() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdSetUserGroupPermissions()
+			cmd := cli.GetCmdUnbanUser()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -1364,7 +2878,9 @@ func (s *IntegrationTestSuite) TestCmdSetUserGroupPermissions() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdDeleteUserGroup() {
+// --------------------------------------------------------------------------------------------------------------------
+
+func (s *IntegrationTestSuite) TestCmdSetRateLimit() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -1374,18 +2890,28 @@ func (s *IntegrationTestSuite) TestCmdDeleteUserGroup() {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			args:      []string{"subspace-id", "testing-group"},
+			args:      []string{"subspace", "/desmos.posts.v3.MsgCreatePost", "10", "1h"},
 			shouldErr: true,
 		},
 		{
-			name:      "invalid name returns error",
-			args:      []string{"1", ""},
+			name:      "invalid message type url returns error",
+			args:      []string{"1", "desmos.posts.v3.MsgCreatePost", "10", "1h"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid max messages returns error",
+			args:      []string{"1", "/desmos.posts.v3.MsgCreatePost", "ten", "1h"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid window returns error",
+			args:      []string{"1", "/desmos.posts.v3.MsgCreatePost", "10", "hour"},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"2", "1",
+				"1", "/desmos.posts.v3.MsgCreatePost", "10", "1h",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1399,7 +2925,7 @@ func (s *IntegrationTestSuite) TestCmdDeleteUserGroup() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdDeleteUserGroup()
+			cmd := cli.GetCmdSetRateLimit()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -1413,7 +2939,7 @@ func (s *IntegrationTestSuite) TestCmdDeleteUserGroup() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdAddUserToUserGroup() {
+func (s *IntegrationTestSuite) TestCmdRemoveRateLimit() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -1423,23 +2949,18 @@ func (s *IntegrationTestSuite) TestCmdAddUserToUserGroup() {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			args:      []string{"subspace-id", "testing-group"},
+			args:      []string{"subspace", "/desmos.posts.v3.MsgCreatePost"},
 			shouldErr: true,
 		},
 		{
-			name:      "invalid name returns error",
+			name:      "invalid message type url returns error",
 			args:      []string{"1", ""},
 			shouldErr: true,
 		},
-		{
-			name:      "invalid user returns error",
-			args:      []string{"1", "1", ""},
-			shouldErr: true,
-		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"2", "1", "cosmos1et50whs236j9dacacz7feh05jjum9jk04cdt9u",
+				"1", "/desmos.posts.v3.MsgCreatePost",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1453,7 +2974,7 @@ func (s *IntegrationTestSuite) TestCmdAddUserToUserGroup() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdAddUserToUserGroup()
+			cmd := cli.GetCmdRemoveRateLimit()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -1467,7 +2988,7 @@ func (s *IntegrationTestSuite) TestCmdAddUserToUserGroup() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestCmdRemoveUserFromUserGroup() {
+func (s *IntegrationTestSuite) TestCmdSetFeeTokensConfig() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -1477,23 +2998,37 @@ func (s *IntegrationTestSuite) TestCmdRemoveUserFromUserGroup() {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			args:      []string{"subspace-id", "testing-group"},
+			args:      []string{"subspace", "stake", "minttoken=2"},
 			shouldErr: true,
 		},
 		{
-			name:      "invalid name returns error",
-			args:      []string{"1", ""},
+			name:      "invalid rates return error",
+			args:      []string{"1", "stake", "minttoken"},
 			shouldErr: true,
 		},
 		{
-			name:      "invalid user returns error",
-			args:      []string{"1", "group", ""},
+			name:      "invalid rate value returns error",
+			args:      []string{"1", "stake", "minttoken=two"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid max amount returns error",
+			args:      []string{"1", "stake", "minttoken=2:max"},
+			shouldErr: true,
+		},
+		{
+			name: "invalid destination returns error",
+			args: []string{
+				"1", "stake", "minttoken=2",
+				fmt.Sprintf("--%s=%s", cli.FlagDestination, "burn"),
+			},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"2", "1", "cosmos1et50whs236j9dacacz7feh05jjum9jk04cdt9u",
+				"1", "stake", "minttoken=2:1000",
+				fmt.Sprintf("--%s=%s", cli.FlagDestination, "treasury"),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1507,7 +3042,7 @@ func (s *IntegrationTestSuite) TestCmdRemoveUserFromUserGroup() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdRemoveUserFromUserGroup()
+			cmd := cli.GetCmdSetFeeTokensConfig()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -1521,9 +3056,7 @@ func (s *IntegrationTestSuite) TestCmdRemoveUserFromUserGroup() {
 	}
 }
 
-// --------------------------------------------------------------------------------------------------------------------
-
-func (s *IntegrationTestSuite) TestCmdSetPermissions() {
+func (s *IntegrationTestSuite) TestCmdRemoveFeeTokensConfig() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -1533,23 +3066,13 @@ func (s *IntegrationTestSuite) TestCmdSetPermissions() {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			args:      []string{"id", "group", "Write"},
-			shouldErr: true,
-		},
-		{
-			name:      "invalid target returns error",
-			args:      []string{"1", "", "Write"},
-			shouldErr: true,
-		},
-		{
-			name:      "invalid permission returns error",
-			args:      []string{"1", "group", "NonExistingPermission"},
+			args:      []string{"subspace"},
 			shouldErr: true,
 		},
 		{
 			name: "valid data returns no error",
 			args: []string{
-				"1", "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e", "Write",
+				"1",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -1563,7 +3086,7 @@ func (s *IntegrationTestSuite) TestCmdSetPermissions() {
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdSetUserPermissions()
+			cmd := cli.GetCmdRemoveFeeTokensConfig()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,9 +27,15 @@ func GetQueryCmd() *cobra.Command {
 	subspaceQueryCmd.AddCommand(
 		GetCmdQuerySubspace(),
 		GetCmdQuerySubspaces(),
+		GetCmdQueryIncomingSubspaceOwnerTransferRequests(),
 		GetSectionsQueryCmd(),
 		GetGroupsQueryCmd(),
 		GetCmdQueryUserPermissions(),
+		GetCmdQueryPermissionDetails(),
+		GetCmdQuerySubspaceBans(),
+		GetCmdQueryRateLimits(),
+		GetCmdQueryFeeTokensConfig(),
+		GetTreasuryQueryCmd(),
 		GetAllowancesQueryCmd(),
 	)
 	return subspaceQueryCmd
@@ -101,6 +108,50 @@ func GetCmdQuerySubspaces() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryIncomingSubspaceOwnerTransferRequests returns the command to query the subspace owner transfer requests made towards a user
+func GetCmdQueryIncomingSubspaceOwnerTransferRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incoming-owner-transfer-requests [[receiver]]",
+		Short: "Query the subspace owner transfer requests with an optional receiver and pagination",
+		Example: fmt.Sprintf(`%s query subspaces incoming-owner-transfer-requests --page=2 --limit=100
+%s query subspaces incoming-owner-transfer-requests desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud
+`, version.AppName, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var receiver string
+			if len(args) == 1 {
+				receiver = args[0]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.IncomingSubspaceOwnerTransferRequests(
+				context.Background(),
+				types.NewQueryIncomingSubspaceOwnerTransferRequestsRequest(receiver, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "subspace owner transfer requests")
+
+	return cmd
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // GetSectionsQueryCmd returns a new command to perform queries for sections
@@ -220,6 +271,10 @@ func GetGroupsQueryCmd() *cobra.Command {
 		GetCmdQueryUserGroups(),
 		GetCmdQueryUserGroup(),
 		GetCmdQueryUserGroupMembers(),
+		GetCmdQueryGroupInvites(),
+		GetCmdQueryGroupInvite(),
+		GetCmdQueryGroupApplications(),
+		GetCmdQueryGroupApplication(),
 	)
 
 	return groupsQueryCmd
@@ -364,6 +419,184 @@ func GetCmdQueryUserGroupMembers() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryGroupInvites returns the command to query the invites of a subspace
+func GetCmdQueryGroupInvites() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "invites [subspace-id] [[group-id]]",
+		Short:   "Query the invites in the given subspace, optionally filtered by group, with optional pagination",
+		Example: fmt.Sprintf(`%s query subspaces groups invites 1 2 --page=2 --limit=100`, version.AppName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var groupID uint32
+			if len(args) > 1 {
+				groupID, err = types.ParseGroupID(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.GroupInvites(
+				context.Background(),
+				types.NewQueryGroupInvitesRequest(subspaceID, groupID, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group invites")
+
+	return cmd
+}
+
+// GetCmdQueryGroupInvite returns the command to query a specific invite of a subspace
+func GetCmdQueryGroupInvite() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invite [subspace-id] [secret-hash]",
+		Short: "Query the invite with the given secret hash in the given subspace",
+		Example: fmt.Sprintf(`
+%s query subspaces groups invite 1 09510a557079d9d47de1410b5b05427afd91580ceff2e8e8cc7d2054ff16bbf2`,
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupInvite(
+				context.Background(),
+				types.NewQueryGroupInviteRequest(subspaceID, args[1]),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGroupApplications returns the command to query the pending applications to join a group
+func GetCmdQueryGroupApplications() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "applications [subspace-id] [group-id]",
+		Short:   "Query the pending applications to join the given group with optional pagination",
+		Example: fmt.Sprintf(`%s query subspaces groups applications 1 1 --page=2 --limit=100`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			groupID, err := types.ParseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupApplications(
+				context.Background(),
+				types.NewQueryGroupApplicationsRequest(subspaceID, groupID, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group applications")
+
+	return cmd
+}
+
+// GetCmdQueryGroupApplication returns the command to query the application of a user to join a group
+func GetCmdQueryGroupApplication() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "application [subspace-id] [group-id] [applicant]",
+		Short: "Query the application of the given user to join the given group",
+		Example: fmt.Sprintf(`
+%s query subspaces groups application 1 1 desmos1p8r4guvdze03md4g9zclhh6mr8ljvtd80pehr3`,
+			version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			groupID, err := types.ParseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupApplication(
+				context.Background(),
+				types.NewQueryGroupApplicationRequest(subspaceID, groupID, args[2]),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryUserPermissions returns the command to query the permissions of a specific user
 func GetCmdQueryUserPermissions() *cobra.Command {
 	cmd := &cobra.Command{
@@ -405,6 +638,355 @@ func GetCmdQueryUserPermissions() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPermissionDetails returns the command to query the sources granting a permission to a specific user
+func GetCmdQueryPermissionDetails() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "permission-details [subspace-id] [section-id] [user] [permission]",
+		Short:   "Query all the sources that grant the given permission to a user",
+		Example: fmt.Sprintf(`%s query subspaces permission-details 1 0 desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud MODERATE_CONTENT`, version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			sectionID, err := types.ParseSectionID(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PermissionDetails(
+				context.Background(),
+				types.NewQueryPermissionDetailsRequest(subspaceID, sectionID, args[2], args[3]),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySubspaceBans returns the command to query the active bans of a subspace
+func GetCmdQuerySubspaceBans() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bans [subspace-id]",
+		Short:   "Query the active bans of the given subspace with optional pagination",
+		Example: fmt.Sprintf(`%s query subspaces bans 1 --page=2 --limit=100`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SubspaceBans(
+				context.Background(),
+				types.NewQuerySubspaceBansRequest(subspaceID, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "subspace bans")
+
+	return cmd
+}
+
+// GetCmdQueryRateLimits returns the command to query the rate limits of a subspace
+func GetCmdQueryRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits [subspace-id]",
+		Short:   "Query the rate limits of the given subspace with optional pagination",
+		Example: fmt.Sprintf(`%s query subspaces rate-limits 1 --page=2 --limit=100`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RateLimits(
+				context.Background(),
+				types.NewQueryRateLimitsRequest(subspaceID, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate limits")
+
+	return cmd
+}
+
+// GetCmdQueryFeeTokensConfig returns the command to query the fee tokens config of a subspace
+func GetCmdQueryFeeTokensConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-tokens-config [subspace-id]",
+		Short:   "Query the fee tokens config of the given subspace",
+		Example: fmt.Sprintf(`%s query subspaces fee-tokens-config 1`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeeTokensConfig(
+				context.Background(),
+				types.NewQueryFeeTokensConfigRequest(subspaceID),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetTreasuryQueryCmd returns a new command to query subspace treasury proposals
+func GetTreasuryQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "treasury",
+		Short:                      "Query commands for subspace treasury proposals",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryTreasuryVotingConfig(),
+		GetCmdQueryTreasuryProposals(),
+		GetCmdQueryTreasuryProposal(),
+		GetCmdQueryTreasuryProposalVotes(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryTreasuryVotingConfig returns the command to query the treasury voting config of a subspace
+func GetCmdQueryTreasuryVotingConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "voting-config [subspace-id]",
+		Short:   "Query the treasury voting config of the given subspace",
+		Example: fmt.Sprintf(`%s query subspaces treasury voting-config 1`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TreasuryVotingConfig(
+				context.Background(),
+				types.NewQueryTreasuryVotingConfigRequest(subspaceID),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTreasuryProposals returns the command to query the treasury proposals of a subspace
+func GetCmdQueryTreasuryProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proposals [subspace-id]",
+		Short:   "Query the treasury proposals of the given subspace with optional pagination",
+		Example: fmt.Sprintf(`%s query subspaces treasury proposals 1 --page=2 --limit=100`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TreasuryProposals(
+				context.Background(),
+				types.NewQueryTreasuryProposalsRequest(subspaceID, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "treasury proposals")
+
+	return cmd
+}
+
+// GetCmdQueryTreasuryProposal returns the command to query a specific treasury proposal
+func GetCmdQueryTreasuryProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proposal [subspace-id] [proposal-id]",
+		Short:   "Query the treasury proposal with the given id",
+		Example: fmt.Sprintf(`%s query subspaces treasury proposal 1 1`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal id: %w", err)
+			}
+
+			res, err := queryClient.TreasuryProposal(
+				context.Background(),
+				types.NewQueryTreasuryProposalRequest(subspaceID, proposalID),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTreasuryProposalVotes returns the command to query the votes of a treasury proposal
+func GetCmdQueryTreasuryProposalVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "votes [subspace-id] [proposal-id]",
+		Short:   "Query the votes of the given treasury proposal with optional pagination",
+		Example: fmt.Sprintf(`%s query subspaces treasury votes 1 1 --page=2 --limit=100`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal id: %w", err)
+			}
+
+			res, err := queryClient.TreasuryProposalVotes(
+				context.Background(),
+				types.NewQueryTreasuryProposalVotesRequest(subspaceID, proposalID, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "treasury proposal votes")
+
+	return cmd
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // GetAllowancesQueryCmd returns a new command to query subspace allowances
@@ -420,6 +1002,7 @@ func GetAllowancesQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryUserAllowances(),
 		GetCmdQueryGroupAllowances(),
+		GetCmdQueryAllowanceUsages(),
 	)
 
 	return queryCmd
@@ -520,3 +1103,45 @@ func GetCmdQueryGroupAllowances() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "group allowances")
 	return cmd
 }
+
+// GetCmdQueryAllowanceUsages returns the command to query the allowance usages of a specific user
+func GetCmdQueryAllowanceUsages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "usages [subspace-id] [grantee]",
+		Short:   "Query the usages of the message limits allowances made by a user within a subspace",
+		Example: fmt.Sprintf(`%s query subspaces allowances usages 1 desmos1evj20rymftvecmgn8t0xv700wkjlgucwfy4f0c`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee := args[1]
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowanceUsages(
+				context.Background(),
+				types.NewQueryAllowanceUsagesRequest(subspaceID, grantee, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowance usages")
+	return cmd
+}
@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -31,18 +34,41 @@ const (
 	FlagName           = "name"
 	FlagDescription    = "description"
 	FlagParent         = "parent"
+	FlagVisibility     = "visibility"
 	FlagSection        = "section"
 	FlagOwner          = "owner"
 	FlagPermissions    = "permissions"
 	FlagInitialMembers = "initial-members"
 	FlagUserGrantee    = "user"
 	FlagGroupGrantee   = "group"
+	FlagExpirationTime = "expiration-time"
 
 	delegate   = "delegate"
 	redelegate = "redelegate"
 	unbond     = "unbond"
 )
 
+const (
+	FlagMaxUses = "max-uses"
+	FlagMessage = "message"
+)
+
+const (
+	FlagReason = "reason"
+)
+
+const (
+	FlagMessageLimits = "message-limits"
+)
+
+const (
+	FlagDestination = "destination"
+)
+
+const (
+	FlagQuorum = "quorum"
+)
+
 // NewTxCmd returns a new command to perform subspaces transactions
 func NewTxCmd() *cobra.Command {
 	subspacesTxCmd := &cobra.Command{
@@ -57,9 +83,21 @@ func NewTxCmd() *cobra.Command {
 		GetCmdCreateSubspace(),
 		GetCmdEditSubspace(),
 		GetCmdDeleteSubspace(),
+		GetCmdArchiveSubspace(),
+		GetCmdUnarchiveSubspace(),
+		GetCmdRequestSubspaceOwnerTransfer(),
+		GetCmdCancelSubspaceOwnerTransfer(),
+		GetCmdAcceptSubspaceOwnerTransfer(),
+		GetCmdRefuseSubspaceOwnerTransfer(),
 		NewSectionsTxCmd(),
 		NewGroupsTxCmd(),
 		GetCmdSetUserPermissions(),
+		GetCmdBanUser(),
+		GetCmdUnbanUser(),
+		GetCmdSetRateLimit(),
+		GetCmdRemoveRateLimit(),
+		GetCmdSetFeeTokensConfig(),
+		GetCmdRemoveFeeTokensConfig(),
 		GetCmdGrantAuthorization(),
 		GetTreasuryTxCmd(),
 		GetAllowancesTxCmd(),
@@ -129,7 +167,6 @@ func GetCmdEditSubspace() *cobra.Command {
   --name "Desmos - Democratizing social networks"
   --description "The official subspace of Desmos" \
   --treasury desmos1jqk5p244yl4ktukq5xhavvlfzl8z4we4qfmuyh \
-  --owner desmos1p8r4guvdze03md4g9zclhh6mr8ljvtd80pehr3 \
   --from alice
 `, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -170,6 +207,7 @@ func GetCmdEditSubspace() *cobra.Command {
 	cmd.Flags().String(FlagName, types.DoNotModify, "New human readable name of the subspace")
 	cmd.Flags().String(FlagDescription, types.DoNotModify, "Description of the subspace")
 	cmd.Flags().String(FlagOwner, types.DoNotModify, "Owner of the subspace")
+	_ = cmd.Flags().MarkDeprecated(FlagOwner, "use the owner transfer request commands instead")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -208,6 +246,198 @@ func GetCmdDeleteSubspace() *cobra.Command {
 	return cmd
 }
 
+// GetCmdArchiveSubspace returns the command to archive a subspace
+func GetCmdArchiveSubspace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "archive [subspace-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Archives the subspace with the given id, making it read-only",
+		Example: fmt.Sprintf(`%s tx subspaces archive 1 --from alice`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgArchiveSubspace(subspaceID, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUnarchiveSubspace returns the command to unarchive a subspace
+func GetCmdUnarchiveSubspace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unarchive [subspace-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Unarchives the subspace with the given id",
+		Example: fmt.Sprintf(`%s tx subspaces unarchive 1 --from alice`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnarchiveSubspace(subspaceID, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRequestSubspaceOwnerTransfer returns the command to request the transfer of a subspace ownership
+func GetCmdRequestSubspaceOwnerTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "request-owner-transfer [subspace-id] [receiver]",
+		Short:   "Request to transfer the ownership of the subspace to the given receiver",
+		Example: fmt.Sprintf(`%s tx subspaces request-owner-transfer 1 desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud --from alice`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRequestSubspaceOwnerTransfer(subspaceID, args[1], clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancelSubspaceOwnerTransfer returns the command to cancel an outgoing subspace owner transfer request
+func GetCmdCancelSubspaceOwnerTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-owner-transfer [subspace-id]",
+		Short:   "Cancel the pending owner transfer request of the subspace with the given id",
+		Example: fmt.Sprintf(`%s tx subspaces cancel-owner-transfer 1 --from alice`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSubspaceOwnerTransferRequest(subspaceID, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAcceptSubspaceOwnerTransfer returns the command to accept an incoming subspace owner transfer request
+func GetCmdAcceptSubspaceOwnerTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-owner-transfer [subspace-id]",
+		Short:   "Accept the pending owner transfer request of the subspace with the given id",
+		Example: fmt.Sprintf(`%s tx subspaces accept-owner-transfer 1 --from alice`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptSubspaceOwnerTransferRequest(subspaceID, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRefuseSubspaceOwnerTransfer returns the command to refuse an incoming subspace owner transfer request
+func GetCmdRefuseSubspaceOwnerTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "refuse-owner-transfer [subspace-id]",
+		Short:   "Refuse the pending owner transfer request of the subspace with the given id",
+		Example: fmt.Sprintf(`%s tx subspaces refuse-owner-transfer 1 --from alice`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefuseSubspaceOwnerTransferRequest(subspaceID, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // NewSectionsTxCmd returns a new command to perform subspaces sections transactions
@@ -224,6 +454,7 @@ func NewSectionsTxCmd() *cobra.Command {
 		GetCmdCreateSection(),
 		GetCmdEditSection(),
 		GetCmdMoveSection(),
+		GetCmdSetSectionVisibility(),
 		GetCmdDeleteSection(),
 	)
 
@@ -242,6 +473,7 @@ The name must be a human readable name.`,
 %s tx subspaces sections create 1 "Custom section" \
   --description "This is my custom section" \
   --parent 1 \
+  --visibility members-only \
   --from alice
 `, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -267,7 +499,17 @@ The name must be a human readable name.`,
 				return err
 			}
 
-			msg := types.NewMsgCreateSection(subspaceID, name, description, parentID, clientCtx.FromAddress.String())
+			visibilityValue, err := cmd.Flags().GetString(FlagVisibility)
+			if err != nil {
+				return err
+			}
+
+			visibility, err := types.ParseSectionVisibility(visibilityValue)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSection(subspaceID, name, description, parentID, visibility, clientCtx.FromAddress.String())
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("message validation failed: %w", err)
 			}
@@ -278,6 +520,7 @@ The name must be a human readable name.`,
 
 	cmd.Flags().String(FlagDescription, "", "Description of the section")
 	cmd.Flags().Uint32(FlagParent, 0, "Id of the parent section")
+	cmd.Flags().String(FlagVisibility, "public", "Visibility of the section (public, members-only or group-restricted)")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -381,6 +624,50 @@ func GetCmdMoveSection() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSetSectionVisibility returns the command to set the visibility of a subspace section
+func GetCmdSetSectionVisibility() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-visibility [subspace-id] [section-id] [visibility]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Set the visibility of the subspace section with the given id",
+		Long: `Set the visibility of the subspace section with the given id.
+The visibility can be one of the following: public, members-only, group-restricted.`,
+		Example: fmt.Sprintf(`%s tx subspaces sections set-visibility 1 1 members-only --from alice`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			sectionID, err := types.ParseSectionID(args[1])
+			if err != nil {
+				return err
+			}
+
+			visibility, err := types.ParseSectionVisibility(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSectionVisibility(subspaceID, sectionID, visibility, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdDeleteSection returns the command to delete a subspace section
 func GetCmdDeleteSection() *cobra.Command {
 	cmd := &cobra.Command{
@@ -438,6 +725,12 @@ func NewGroupsTxCmd() *cobra.Command {
 		GetCmdDeleteUserGroup(),
 		GetCmdAddUserToUserGroup(),
 		GetCmdRemoveUserFromUserGroup(),
+		GetCmdCreateInvite(),
+		GetCmdDeleteInvite(),
+		GetCmdRedeemInvite(),
+		GetCmdApplyToGroup(),
+		GetCmdApproveGroupApplication(),
+		GetCmdRejectGroupApplication(),
 	)
 
 	return groupsTxCmd
@@ -711,8 +1004,11 @@ func GetCmdAddUserToUserGroup() *cobra.Command {
 		Use:   "add-user [subspace-id] [group-id] [user]",
 		Args:  cobra.ExactArgs(3),
 		Short: "Add a user to a user group",
+		Long: fmt.Sprintf(`Add a user to a user group.
+The user can be removed automatically from the group after a given RFC3339 time by using the --%s flag.`, FlagExpirationTime),
 		Example: fmt.Sprintf(`
 %s tx subspaces groups add-user 1 1 desmos1p8r4guvdze03md4g9zclhh6mr8ljvtd80pehr3 \
+  --expiration-time 2030-01-01T00:00:00Z \
   --from alice
 `, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -733,7 +1029,12 @@ func GetCmdAddUserToUserGroup() *cobra.Command {
 
 			user := args[2]
 
-			msg := types.NewMsgAddUserToUserGroup(subspaceID, groupID, user, clientCtx.FromAddress.String())
+			expirationTime, err := getTimeFlag(cmd, FlagExpirationTime)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddUserToUserGroup(subspaceID, groupID, user, expirationTime, clientCtx.FromAddress.String())
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("message validation failed: %w", err)
 			}
@@ -742,6 +1043,7 @@ func GetCmdAddUserToUserGroup() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagExpirationTime, "", "Optional RFC3339 time after which the user should be removed from the group")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -789,22 +1091,20 @@ func GetCmdRemoveUserFromUserGroup() *cobra.Command {
 	return cmd
 }
 
-// --------------------------------------------------------------------------------------------------------------------
-
-// GetCmdSetUserPermissions returns the command to set the permissions for a user
-func GetCmdSetUserPermissions() *cobra.Command {
+// GetCmdCreateInvite returns the command to create an invite to join a user group
+func GetCmdCreateInvite() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set-user-permissions [subspace-id] [user] [permissions]",
+		Use:   "create-invite [subspace-id] [group-id] [secret]",
 		Args:  cobra.ExactArgs(3),
-		Short: "Set the permissions for a specific user",
-		Long: `Set the permissions for a specific user inside a given subspace.
-It is mandatory to specify at least one permission to be set.
-When specifying multiple permissions, they must be separated by a comma (,).`,
+		Short: "Create an invite code that allows users to join a user group",
+		Long: fmt.Sprintf(`Create an invite code that allows users to join a user group.
+Only the SHA-256 hash of the public key derived from the given secret is stored on chain, so the secret itself should be shared privately with the invited users.
+The maximum number of times the invite can be redeemed can be set using the --%s flag,
+while an optional RFC3339 expiration time can be set using the --%s flag.`, FlagMaxUses, FlagExpirationTime),
 		Example: fmt.Sprintf(`
-%s tx subspaces set-user-permissions 1 \
-  desmos1463vltcqk6ql6zpk0g6s595jjcrzk4804hyqw7 \
-  "WRITE_CONTENT,INTERACT_WITH_CONTENT,EDIT_OWN_CONTENT,MODERATE_CONTENT,
-EDIT_SUBSPACE,DELETE_SUBSPACE,MANAGE_SECTIONS,MANAGE_GROUPS,SET_PERMISSIONS,EVERYTHING" \
+%s tx subspaces groups create-invite 1 1 "my-super-secret" \
+  --max-uses 10 \
+  --expiration-time 2030-01-01T00:00:00Z \
   --from alice
 `, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -818,19 +1118,24 @@ EDIT_SUBSPACE,DELETE_SUBSPACE,MANAGE_SECTIONS,MANAGE_GROUPS,SET_PERMISSIONS,EVER
 				return err
 			}
 
-			sectionID, err := cmd.Flags().GetUint32(FlagSection)
+			groupID, err := types.ParseGroupID(args[1])
 			if err != nil {
 				return err
 			}
 
-			user := args[1]
+			secretHash := types.GetInviteSecretHash(args[2])
 
-			var permissions types.Permissions
-			for _, arg := range strings.Split(args[2], ",") {
-				permissions = types.CombinePermissions(append(permissions, arg)...)
+			maxUses, err := cmd.Flags().GetUint32(FlagMaxUses)
+			if err != nil {
+				return err
+			}
+
+			expirationTime, err := getTimeFlag(cmd, FlagExpirationTime)
+			if err != nil {
+				return err
 			}
 
-			msg := types.NewMsgSetUserPermissions(subspaceID, sectionID, user, permissions, clientCtx.FromAddress.String())
+			msg := types.NewMsgCreateInvite(subspaceID, groupID, secretHash, maxUses, expirationTime, clientCtx.FromAddress.String())
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("message validation failed: %w", err)
 			}
@@ -839,25 +1144,613 @@ EDIT_SUBSPACE,DELETE_SUBSPACE,MANAGE_SECTIONS,MANAGE_GROUPS,SET_PERMISSIONS,EVER
 		},
 	}
 
-	cmd.Flags().Uint32(FlagSection, 0, "Id of the section inside which to set the permissions")
+	cmd.Flags().Uint32(FlagMaxUses, 1, "Maximum number of times the invite can be redeemed")
+	cmd.Flags().String(FlagExpirationTime, "", "Optional RFC3339 time after which the invite can no longer be redeemed")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// --------------------------------------------------------------------------------------------------------------------
-
-// GetCmdGrantAuthorization returns the command to grant a subspace authorization
-func GetCmdGrantAuthorization() *cobra.Command {
+// GetCmdDeleteInvite returns the command to delete an invite
+func GetCmdDeleteInvite() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant [subspaces-ids] [grantee]",
-		Short: "Grant an authorization to an address inside one or more subspaces",
-		Long: `Grant an authorization to an address inside one or more subspaces.
-If you want to grant the same authorization inside multiple subspaces, simply specify the subspaces ids separating them with a comma (,).`,
+		Use:   "delete-invite [subspace-id] [secret-hash]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Delete an existing invite",
 		Example: fmt.Sprintf(`
-%s tx subspaces grant 1,2,3 desmos1463vltcqk6ql6zpk0g6s595jjcrzk4804hyqw7 --msg-type=%s --from alice
-`, version.AppName, sdk.MsgTypeURL(&types.MsgSetUserPermissions{})),
-		Args: cobra.ExactArgs(2),
+%s tx subspaces groups delete-invite 1 09510a557079d9d47de1410b5b05427afd91580ceff2e8e8cc7d2054ff16bbf2 \
+  --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			secretHash := args[1]
+
+			msg := types.NewMsgDeleteInvite(subspaceID, secretHash, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRedeemInvite returns the command to redeem an invite
+func GetCmdRedeemInvite() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-invite [subspace-id] [secret]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Join a user group by redeeming an invite",
+		Long: `Join a user group by redeeming an invite.
+The secret is never sent on chain: it is only used to sign the redemption on behalf of the signer, so that it cannot be used by anyone else.`,
+		Example: fmt.Sprintf(`
+%s tx subspaces groups redeem-invite 1 "my-super-secret" \
+  --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			secret := args[1]
+			if strings.TrimSpace(secret) == "" {
+				return fmt.Errorf("invalid secret: cannot be empty or blank")
+			}
+
+			redeemer := clientCtx.FromAddress.String()
+			pubKey, signature, err := types.SignInviteRedemption(secret, clientCtx.ChainID, subspaceID, redeemer)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemInvite(subspaceID, pubKey, signature, redeemer)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdApplyToGroup returns the command to apply to join a user group
+func GetCmdApplyToGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply [subspace-id] [group-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Apply to join a user group",
+		Long: fmt.Sprintf(`Apply to join a user group.
+An optional message for the group managers can be provided using the --%s flag.`, FlagMessage),
+		Example: fmt.Sprintf(`
+%s tx subspaces groups apply 1 1 \
+  --message "I would love to help moderating this subspace" \
+  --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			groupID, err := types.ParseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			message, err := cmd.Flags().GetString(FlagMessage)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApplyToGroup(subspaceID, groupID, message, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMessage, "", "Optional message for the group managers")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdApproveGroupApplication returns the command to approve an application to join a user group
+func GetCmdApproveGroupApplication() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-application [subspace-id] [group-id] [applicant]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Approve an application to join a user group",
+		Example: fmt.Sprintf(`
+%s tx subspaces groups approve-application 1 1 desmos1p8r4guvdze03md4g9zclhh6mr8ljvtd80pehr3 \
+  --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			groupID, err := types.ParseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			applicant := args[2]
+
+			msg := types.NewMsgApproveGroupApplication(subspaceID, groupID, applicant, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRejectGroupApplication returns the command to reject an application to join a user group
+func GetCmdRejectGroupApplication() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-application [subspace-id] [group-id] [applicant]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Reject an application to join a user group",
+		Example: fmt.Sprintf(`
+%s tx subspaces groups reject-application 1 1 desmos1p8r4guvdze03md4g9zclhh6mr8ljvtd80pehr3 \
+  --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			groupID, err := types.ParseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			applicant := args[2]
+
+			msg := types.NewMsgRejectGroupApplication(subspaceID, groupID, applicant, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetCmdSetUserPermissions returns the command to set the permissions for a user
+func GetCmdSetUserPermissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-user-permissions [subspace-id] [user] [permissions]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Set the permissions for a specific user",
+		Long: fmt.Sprintf(`Set the permissions for a specific user inside a given subspace.
+It is mandatory to specify at least one permission to be set.
+When specifying multiple permissions, they must be separated by a comma (,).
+The permissions can be removed automatically after a given RFC3339 time by using the --%s flag.`, FlagExpirationTime),
+		Example: fmt.Sprintf(`
+%s tx subspaces set-user-permissions 1 \
+  desmos1463vltcqk6ql6zpk0g6s595jjcrzk4804hyqw7 \
+  "WRITE_CONTENT,INTERACT_WITH_CONTENT,EDIT_OWN_CONTENT,MODERATE_CONTENT,
+EDIT_SUBSPACE,DELETE_SUBSPACE,MANAGE_SECTIONS,MANAGE_GROUPS,SET_PERMISSIONS,EVERYTHING" \
+  --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			sectionID, err := cmd.Flags().GetUint32(FlagSection)
+			if err != nil {
+				return err
+			}
+
+			user := args[1]
+
+			var permissions types.Permissions
+			for _, arg := range strings.Split(args[2], ",") {
+				permissions = types.CombinePermissions(append(permissions, arg)...)
+			}
+
+			expirationTime, err := getTimeFlag(cmd, FlagExpirationTime)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetUserPermissions(subspaceID, sectionID, user, permissions, expirationTime, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagSection, 0, "Id of the section inside which to set the permissions")
+	cmd.Flags().String(FlagExpirationTime, "", "Optional RFC3339 time after which the permissions should be removed")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetCmdBanUser returns the command to ban a user from a subspace
+func GetCmdBanUser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ban [subspace-id] [user]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Ban a user from a subspace",
+		Long: fmt.Sprintf(`Ban a user from a subspace, preventing them from performing any action inside it.
+An optional reason can be specified using the --%s flag.
+The ban can be lifted automatically after a given RFC3339 time by using the --%s flag.`, FlagReason, FlagExpirationTime),
+		Example: fmt.Sprintf(`
+%s tx subspaces ban 1 desmos1463vltcqk6ql6zpk0g6s595jjcrzk4804hyqw7 \
+  --reason "Spam" \
+  --expiration-time 2030-01-01T00:00:00Z \
+  --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			user := args[1]
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			expirationTime, err := getTimeFlag(cmd, FlagExpirationTime)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBanUser(subspaceID, user, reason, expirationTime, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "Optional reason why the user is banned")
+	cmd.Flags().String(FlagExpirationTime, "", "Optional RFC3339 time after which the ban should be lifted")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUnbanUser returns the command to lift the ban of a user from a subspace
+func GetCmdUnbanUser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unban [subspace-id] [user]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Lift the ban of a user from a subspace",
+		Example: fmt.Sprintf(`
+%s tx subspaces unban 1 desmos1463vltcqk6ql6zpk0g6s595jjcrzk4804hyqw7 \
+  --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			user := args[1]
+
+			msg := types.NewMsgUnbanUser(subspaceID, user, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetCmdSetRateLimit returns the command to set the rate limit of a message type inside a subspace
+func GetCmdSetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [subspace-id] [msg-type-url] [max-messages] [window]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Limit how many messages of the given type each user can send inside a subspace",
+		Long: `Limit how many messages of the given type each user can send inside a subspace during the given window.
+The window must be expressed as a duration (eg. 30m, 1h, 24h).
+If a rate limit for the same message type already exists, it will be replaced.`,
+		Example: fmt.Sprintf(`
+%s tx subspaces set-rate-limit 1 /desmos.posts.v3.MsgCreatePost 10 1h   --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgTypeURL := args[1]
+
+			maxMessages, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max messages: %w", err)
+			}
+
+			window, err := time.ParseDuration(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid window: %w", err)
+			}
+
+			msg := types.NewMsgSetRateLimit(subspaceID, msgTypeURL, uint32(maxMessages), window, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRemoveRateLimit returns the command to remove the rate limit of a message type from a subspace
+func GetCmdRemoveRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [subspace-id] [msg-type-url]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove the rate limit of the given message type from a subspace",
+		Example: fmt.Sprintf(`
+%s tx subspaces remove-rate-limit 1 /desmos.posts.v3.MsgCreatePost   --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveRateLimit(subspaceID, args[1], clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetCmdSetFeeTokensConfig returns the command to set the fee tokens config of a subspace
+func GetCmdSetFeeTokensConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-tokens-config [subspace-id] [base-denom] [rates]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Set the conversion rates and the destination of the additional fee tokens of a subspace",
+		Long: `Set the conversion rates and the destination of the additional fee tokens of a subspace.
+The rates must be a comma separated list of <denom>=<rate>[:<max-amount>] values, where the rate is
+the amount of tokens that are worth one unit of the base denom, and the optional max amount is the maximum
+amount of tokens accepted as fees within a single transaction.
+All the tokens must be additional fee tokens of the subspace.
+If a config already exists, it will be replaced.`,
+		Example: fmt.Sprintf(`
+%s tx subspaces set-fee-tokens-config 1 udsm minttoken=2.5:1000000,othertoken=0.5 \
+  --destination treasury \
+  --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			rates, err := parseFeeTokenRates(args[2])
+			if err != nil {
+				return err
+			}
+
+			destinationValue, err := cmd.Flags().GetString(FlagDestination)
+			if err != nil {
+				return err
+			}
+
+			destination, err := types.ParseFeeTokensDestination(destinationValue)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeeTokensConfig(subspaceID, args[1], rates, destination, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDestination, "fee-collector", "Where the fee tokens should be sent (fee-collector or treasury)")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseFeeTokenRates parses the given value as a list of fee token rates
+func parseFeeTokenRates(value string) ([]types.FeeTokenRate, error) {
+	var rates []types.FeeTokenRate
+	for _, rateValue := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(rateValue), "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid fee token rate: %s", rateValue)
+		}
+
+		rateParts := strings.Split(parts[1], ":")
+		if len(rateParts) > 2 {
+			return nil, fmt.Errorf("invalid fee token rate: %s", rateValue)
+		}
+
+		rate, err := sdk.NewDecFromStr(rateParts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate for denom %s: %w", parts[0], err)
+		}
+
+		maxAmount := sdk.ZeroInt()
+		if len(rateParts) == 2 {
+			var ok bool
+			maxAmount, ok = sdk.NewIntFromString(rateParts[1])
+			if !ok {
+				return nil, fmt.Errorf("invalid max amount for denom %s: %s", parts[0], rateParts[1])
+			}
+		}
+
+		rates = append(rates, types.NewFeeTokenRate(parts[0], rate, maxAmount))
+	}
+	return rates, nil
+}
+
+// GetCmdRemoveFeeTokensConfig returns the command to remove the fee tokens config of a subspace
+func GetCmdRemoveFeeTokensConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-fee-tokens-config [subspace-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove the fee tokens config of a subspace",
+		Example: fmt.Sprintf(`
+%s tx subspaces remove-fee-tokens-config 1 --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveFeeTokensConfig(subspaceID, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetCmdGrantAuthorization returns the command to grant a subspace authorization
+func GetCmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [subspaces-ids] [grantee]",
+		Short: "Grant an authorization to an address inside one or more subspaces",
+		Long: `Grant an authorization to an address inside one or more subspaces.
+If you want to grant the same authorization inside multiple subspaces, simply specify the subspaces ids separating them with a comma (,).`,
+		Example: fmt.Sprintf(`
+%s tx subspaces grant 1,2,3 desmos1463vltcqk6ql6zpk0g6s595jjcrzk4804hyqw7 --msg-type=%s --from alice
+`, version.AppName, sdk.MsgTypeURL(&types.MsgSetUserPermissions{})),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -918,6 +1811,9 @@ func GetTreasuryTxCmd() *cobra.Command {
 	treasuryTxCmd.AddCommand(
 		GetCmdGrantTreasuryAuthorization(),
 		GetCmdRevokeTreasuryAuthorization(),
+		GetCmdSetTreasuryVotingConfig(),
+		GetCmdSubmitTreasuryProposal(),
+		GetCmdVoteTreasuryProposal(),
 	)
 
 	return treasuryTxCmd
@@ -1143,6 +2039,198 @@ Example:
 	return cmd
 }
 
+// GetCmdSetTreasuryVotingConfig returns the command used to set the treasury voting config of a subspace
+func GetCmdSetTreasuryVotingConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-voting-config [subspace-id] [group-id] [threshold] [voting-period]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Set the user group that votes the proposals spending from the subspace treasury",
+		Long: `Set the user group whose members can submit and vote proposals spending from the subspace treasury.
+The threshold represents the fraction of group members that must vote yes for a proposal to be executed,
+and it must be greater than 0 and less or equal to 1.
+The voting period must be expressed as a duration (eg. 24h, 168h).
+Optionally, a quorum can be set using the --quorum flag. It represents the fraction of group members that must
+cast a vote for a proposal to be executed, and it must be greater than 0 and less or equal to 1.`,
+		Example: fmt.Sprintf(`
+%s tx subspaces treasury set-voting-config 1 1 0.5 168h --quorum 0.4 --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			groupID, err := types.ParseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			threshold, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid threshold: %w", err)
+			}
+
+			votingPeriod, err := time.ParseDuration(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid voting period: %w", err)
+			}
+
+			quorumStr, err := cmd.Flags().GetString(FlagQuorum)
+			if err != nil {
+				return err
+			}
+
+			var quorum *sdk.Dec
+			if quorumStr != "" {
+				quorumValue, err := sdk.NewDecFromStr(quorumStr)
+				if err != nil {
+					return fmt.Errorf("invalid quorum: %w", err)
+				}
+				quorum = &quorumValue
+			}
+
+			msg := types.NewMsgSetTreasuryVotingConfig(subspaceID, groupID, threshold, quorum, votingPeriod, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagQuorum, "", "Fraction of group members that must cast a vote for a proposal to be executed")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// treasuryProposalFile represents the file containing the messages of a treasury proposal
+type treasuryProposalFile struct {
+	Messages []json.RawMessage `json:"messages"`
+}
+
+// GetCmdSubmitTreasuryProposal returns the command used to submit a treasury proposal
+func GetCmdSubmitTreasuryProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [subspace-id] [path/to/proposal.json]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to execute some messages using the subspace treasury",
+		Long: `Submit a proposal to execute some messages using the subspace treasury as signer.
+The proposal will be voted by the members of the user group set inside the subspace treasury voting config.
+The proposal file must contain the messages to be executed, all of which must be signed by the subspace treasury:
+
+{
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "desmos1...", // The subspace treasury
+      "to_address": "desmos1...",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  ]
+}`,
+		Example: fmt.Sprintf(`
+%s tx subspaces treasury submit-proposal 1 proposal.json --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var proposal treasuryProposalFile
+			err = json.Unmarshal(bz, &proposal)
+			if err != nil {
+				return fmt.Errorf("invalid proposal file: %w", err)
+			}
+
+			msgs := make([]sdk.Msg, len(proposal.Messages))
+			for i, anyJSON := range proposal.Messages {
+				var msg sdk.Msg
+				err = clientCtx.Codec.UnmarshalInterfaceJSON(anyJSON, &msg)
+				if err != nil {
+					return fmt.Errorf("invalid message at index %d: %w", i, err)
+				}
+				msgs[i] = msg
+			}
+
+			msg := types.NewMsgSubmitTreasuryProposal(subspaceID, msgs, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdVoteTreasuryProposal returns the command used to vote a treasury proposal
+func GetCmdVoteTreasuryProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-proposal [subspace-id] [proposal-id] [yes|no]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Vote a proposal to execute some messages using the subspace treasury",
+		Long: `Vote a proposal to execute some messages using the subspace treasury.
+Once enough members of the voting group have voted yes, the proposal messages will be executed.
+Voting again on the same proposal will replace the previous vote.`,
+		Example: fmt.Sprintf(`
+%s tx subspaces treasury vote-proposal 1 1 yes --from alice
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subspaceID, err := types.ParseSubspaceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal id: %w", err)
+			}
+
+			option, err := types.ParseTreasuryVoteOption(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoteTreasuryProposal(subspaceID, proposalID, option, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetAllowancesTxCmd returns a new command to perform subspaces treasury transactions
@@ -1206,6 +2294,7 @@ func GetCmdGrantAllowance() *cobra.Command {
 	cmd.Flags().String(feegrantcli.FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(feegrantcli.FlagPeriod, 0, "Period specifies the time duration in which period_spend_limit coins can be spent before that allowance is reset")
 	cmd.Flags().String(feegrantcli.FlagPeriodLimit, "", "Period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringSlice(FlagMessageLimits, []string{}, "Maximum number of messages of each type that can be paid in the period, in the form <msg-type-url>=<max-messages> (requires period and period limit)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1308,6 +2397,16 @@ func getAllowanceFromFlags(flags *pflag.FlagSet) (feegrant.FeeAllowanceI, error)
 		return nil, err
 	}
 
+	messageLimits, err := flags.GetStringSlice(FlagMessageLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build a message limits allowance if the message limits are set
+	if len(messageLimits) > 0 {
+		return getMessageLimitsAllowance(messageLimits, limit, allowedMsgs, expired, periodClock, periodLimit)
+	}
+
 	// Build basic allowance
 	var allowance feegrant.FeeAllowanceI
 	basic := feegrant.BasicAllowance{
@@ -1368,6 +2467,51 @@ func getAllowanceFromFlags(flags *pflag.FlagSet) (feegrant.FeeAllowanceI, error)
 	return allowance, nil
 }
 
+// getMessageLimitsAllowance returns a message limits allowance built using the given flag values
+func getMessageLimitsAllowance(
+	messageLimits []string, spendLimit sdk.Coins, allowedMsgs []string, expired string, periodClock int64, periodLimit string,
+) (feegrant.FeeAllowanceI, error) {
+	if spendLimit != nil || len(allowedMsgs) > 0 {
+		return nil, fmt.Errorf("--%s cannot be used along with --%s or --%s",
+			FlagMessageLimits, feegrantcli.FlagSpendLimit, feegrantcli.FlagAllowedMsgs)
+	}
+
+	limits := make([]types.MessageLimit, len(messageLimits))
+	for i, value := range messageLimits {
+		parts := strings.Split(value, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid message limit: %s", value)
+		}
+
+		maxMessages, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max messages in message limit %s: %w", value, err)
+		}
+
+		limits[i] = types.NewMessageLimit(parts[0], uint32(maxMessages))
+	}
+
+	if periodClock <= 0 {
+		return nil, fmt.Errorf("period clock was not set")
+	}
+
+	periodSpendLimit, err := sdk.ParseCoinsNormalized(periodLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiration *time.Time
+	if expired != "" {
+		expiresAtTime, err := time.Parse(time.RFC3339, expired)
+		if err != nil {
+			return nil, err
+		}
+		expiration = &expiresAtTime
+	}
+
+	return types.NewMessageLimitsAllowance(limits, periodSpendLimit, getPeriod(periodClock), expiration), nil
+}
+
 // getPeriodReset generates a next period reset time from a duration
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
@@ -1377,3 +2521,24 @@ func getPeriodReset(duration int64) time.Time {
 func getPeriod(duration int64) time.Duration {
 	return time.Duration(duration) * time.Second
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// getTimeFlag returns the RFC3339 time specified using the flag with the given name, or nil if it has not been set
+func getTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == "" {
+		return nil, nil
+	}
+
+	parsedTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsedTime, nil
+}
@@ -52,6 +52,34 @@ func (k Keeper) GetAllSubspaces(ctx sdk.Context) []types.Subspace {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// IterateSubspaceOwnerTransferRequests iterates over all the subspace owner transfer requests and performs the provided function
+func (k Keeper) IterateSubspaceOwnerTransferRequests(ctx sdk.Context, fn func(request types.SubspaceOwnerTransferRequest) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SubspaceOwnerTransferRequestPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.SubspaceOwnerTransferRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &request)
+		stop := fn(request)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetAllSubspaceOwnerTransferRequests returns all the subspace owner transfer requests stored inside the given context
+func (k Keeper) GetAllSubspaceOwnerTransferRequests(ctx sdk.Context) []types.SubspaceOwnerTransferRequest {
+	var requests []types.SubspaceOwnerTransferRequest
+	k.IterateSubspaceOwnerTransferRequests(ctx, func(request types.SubspaceOwnerTransferRequest) (stop bool) {
+		requests = append(requests, request)
+		return false
+	})
+	return requests
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // IterateSections iterates over all the sections stored and performs the provided function
 func (k Keeper) IterateSections(ctx sdk.Context, fn func(section types.Section) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -228,7 +256,8 @@ func (k Keeper) IterateUserGroupsMembers(ctx sdk.Context, fn func(entry types.Us
 
 	for ; iterator.Valid(); iterator.Next() {
 		subspaceID, groupID, user := types.SplitGroupMemberStoreKey(iterator.Key())
-		stop := fn(types.NewUserGroupMemberEntry(subspaceID, groupID, user))
+		expirationTime := types.GetGroupMemberExpirationFromValue(iterator.Value())
+		stop := fn(types.NewUserGroupMemberEntry(subspaceID, groupID, user, expirationTime))
 		if stop {
 			break
 		}
@@ -265,6 +294,294 @@ func (k Keeper) GetUserGroupMembers(ctx sdk.Context, subspaceID uint64, groupID
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// IterateGroupInvites iterates over all the group invites and performs the provided function
+func (k Keeper) IterateGroupInvites(ctx sdk.Context, fn func(invite types.GroupInvite) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GroupInvitePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var invite types.GroupInvite
+		k.cdc.MustUnmarshal(iterator.Value(), &invite)
+		stop := fn(invite)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetAllGroupInvites returns all the group invites stored inside the given context
+func (k Keeper) GetAllGroupInvites(ctx sdk.Context) []types.GroupInvite {
+	var invites []types.GroupInvite
+	k.IterateGroupInvites(ctx, func(invite types.GroupInvite) (stop bool) {
+		invites = append(invites, invite)
+		return false
+	})
+	return invites
+}
+
+// IterateSubspaceGroupInvites iterates over all the group invites of the given subspace and performs the provided function
+func (k Keeper) IterateSubspaceGroupInvites(ctx sdk.Context, subspaceID uint64, fn func(invite types.GroupInvite) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SubspaceGroupInvitesPrefix(subspaceID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var invite types.GroupInvite
+		k.cdc.MustUnmarshal(iterator.Value(), &invite)
+		stop := fn(invite)
+		if stop {
+			break
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IterateGroupApplications iterates over all the group applications and performs the provided function
+func (k Keeper) IterateGroupApplications(ctx sdk.Context, fn func(application types.GroupApplication) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GroupApplicationPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var application types.GroupApplication
+		k.cdc.MustUnmarshal(iterator.Value(), &application)
+		stop := fn(application)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetAllGroupApplications returns all the group applications stored inside the given context
+func (k Keeper) GetAllGroupApplications(ctx sdk.Context) []types.GroupApplication {
+	var applications []types.GroupApplication
+	k.IterateGroupApplications(ctx, func(application types.GroupApplication) (stop bool) {
+		applications = append(applications, application)
+		return false
+	})
+	return applications
+}
+
+// IterateUserGroupApplications iterates over all the applications to join the given group and performs the provided function
+func (k Keeper) IterateUserGroupApplications(ctx sdk.Context, subspaceID uint64, groupID uint32, fn func(application types.GroupApplication) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GroupApplicationsPrefix(subspaceID, groupID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var application types.GroupApplication
+		k.cdc.MustUnmarshal(iterator.Value(), &application)
+		stop := fn(application)
+		if stop {
+			break
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IterateSubspaceBans iterates over all the subspace bans and performs the provided function
+func (k Keeper) IterateSubspaceBans(ctx sdk.Context, fn func(ban types.SubspaceBan) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SubspaceBanPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ban types.SubspaceBan
+		k.cdc.MustUnmarshal(iterator.Value(), &ban)
+		stop := fn(ban)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetAllSubspaceBans returns all the subspace bans stored inside the given context
+func (k Keeper) GetAllSubspaceBans(ctx sdk.Context) []types.SubspaceBan {
+	var bans []types.SubspaceBan
+	k.IterateSubspaceBans(ctx, func(ban types.SubspaceBan) (stop bool) {
+		bans = append(bans, ban)
+		return false
+	})
+	return bans
+}
+
+// IterateBannedUsers iterates over all the bans of the given subspace and performs the provided function
+func (k Keeper) IterateBannedUsers(ctx sdk.Context, subspaceID uint64, fn func(ban types.SubspaceBan) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SubspaceBansPrefix(subspaceID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ban types.SubspaceBan
+		k.cdc.MustUnmarshal(iterator.Value(), &ban)
+		stop := fn(ban)
+		if stop {
+			break
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IterateRateLimits iterates over all the rate limits and performs the provided function
+func (k Keeper) IterateRateLimits(ctx sdk.Context, fn func(limit types.RateLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RateLimitPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var limit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &limit)
+		stop := fn(limit)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetAllRateLimits returns all the rate limits stored inside the given context
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	var limits []types.RateLimit
+	k.IterateRateLimits(ctx, func(limit types.RateLimit) (stop bool) {
+		limits = append(limits, limit)
+		return false
+	})
+	return limits
+}
+
+// IterateSubspaceRateLimits iterates over all the rate limits of the given subspace and performs the provided function
+func (k Keeper) IterateSubspaceRateLimits(ctx sdk.Context, subspaceID uint64, fn func(limit types.RateLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SubspaceRateLimitsPrefix(subspaceID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var limit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &limit)
+		stop := fn(limit)
+		if stop {
+			break
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IterateTreasuryVotingConfigs iterates over all the treasury voting configs and performs the provided function
+func (k Keeper) IterateTreasuryVotingConfigs(ctx sdk.Context, fn func(config types.TreasuryVotingConfig) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TreasuryVotingConfigPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var config types.TreasuryVotingConfig
+		k.cdc.MustUnmarshal(iterator.Value(), &config)
+		stop := fn(config)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetAllTreasuryVotingConfigs returns all the treasury voting configs stored inside the given context
+func (k Keeper) GetAllTreasuryVotingConfigs(ctx sdk.Context) []types.TreasuryVotingConfig {
+	var configs []types.TreasuryVotingConfig
+	k.IterateTreasuryVotingConfigs(ctx, func(config types.TreasuryVotingConfig) (stop bool) {
+		configs = append(configs, config)
+		return false
+	})
+	return configs
+}
+
+// IterateTreasuryProposals iterates over all the treasury proposals and performs the provided function
+func (k Keeper) IterateTreasuryProposals(ctx sdk.Context, fn func(proposal types.TreasuryProposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TreasuryProposalPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.TreasuryProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		stop := fn(proposal)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetAllTreasuryProposals returns all the treasury proposals stored inside the given context
+func (k Keeper) GetAllTreasuryProposals(ctx sdk.Context) []types.TreasuryProposal {
+	var proposals []types.TreasuryProposal
+	k.IterateTreasuryProposals(ctx, func(proposal types.TreasuryProposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		return false
+	})
+	return proposals
+}
+
+// IterateSubspaceTreasuryProposals iterates over all the treasury proposals of the given subspace and performs the provided function
+func (k Keeper) IterateSubspaceTreasuryProposals(ctx sdk.Context, subspaceID uint64, fn func(proposal types.TreasuryProposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SubspaceTreasuryProposalsPrefix(subspaceID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.TreasuryProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		stop := fn(proposal)
+		if stop {
+			break
+		}
+	}
+}
+
+// IterateTreasuryVotes iterates over all the treasury proposal votes and performs the provided function
+func (k Keeper) IterateTreasuryVotes(ctx sdk.Context, fn func(vote types.TreasuryVote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TreasuryVotePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.TreasuryVote
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		stop := fn(vote)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetAllTreasuryVotes returns all the treasury proposal votes stored inside the given context
+func (k Keeper) GetAllTreasuryVotes(ctx sdk.Context) []types.TreasuryVote {
+	var votes []types.TreasuryVote
+	k.IterateTreasuryVotes(ctx, func(vote types.TreasuryVote) (stop bool) {
+		votes = append(votes, vote)
+		return false
+	})
+	return votes
+}
+
+// IterateTreasuryProposalVotes iterates over all the votes of the given treasury proposal and performs the provided function
+func (k Keeper) IterateTreasuryProposalVotes(ctx sdk.Context, subspaceID uint64, proposalID uint64, fn func(vote types.TreasuryVote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TreasuryProposalVotesPrefix(subspaceID, proposalID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.TreasuryVote
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		stop := fn(vote)
+		if stop {
+			break
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // IterateUserPermissions iterates over all the stored user permissions
 func (k Keeper) IterateUserPermissions(ctx sdk.Context, fn func(entry types.UserPermission) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -477,3 +794,59 @@ func (k Keeper) GetUserGroupGrants(ctx sdk.Context, subspaceID uint64, groupID u
 	})
 	return grants
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IterateAllowanceUsages iterates over all the allowance usages and performs the provided function
+func (k Keeper) IterateAllowanceUsages(ctx sdk.Context, fn func(usage types.AllowanceUsage) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AllowanceUsagePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.AllowanceUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		stop := fn(usage)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetAllAllowanceUsages returns all the allowance usages stored inside the given context
+func (k Keeper) GetAllAllowanceUsages(ctx sdk.Context) []types.AllowanceUsage {
+	var usages []types.AllowanceUsage
+	k.IterateAllowanceUsages(ctx, func(usage types.AllowanceUsage) (stop bool) {
+		usages = append(usages, usage)
+		return false
+	})
+	return usages
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IterateFeeTokensConfigs iterates over all the fee tokens configs and performs the provided function
+func (k Keeper) IterateFeeTokensConfigs(ctx sdk.Context, fn func(config types.FeeTokensConfig) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeTokensConfigPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var config types.FeeTokensConfig
+		k.cdc.MustUnmarshal(iterator.Value(), &config)
+		stop := fn(config)
+		if stop {
+			break
+		}
+	}
+}
+
+// GetAllFeeTokensConfigs returns all the fee tokens configs stored inside the given context
+func (k Keeper) GetAllFeeTokensConfigs(ctx sdk.Context) []types.FeeTokensConfig {
+	var configs []types.FeeTokensConfig
+	k.IterateFeeTokensConfigs(ctx, func(config types.FeeTokensConfig) (stop bool) {
+		configs = append(configs, config)
+		return false
+	})
+	return configs
+}
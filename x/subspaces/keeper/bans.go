@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SaveSubspaceBan saves the given subspace ban inside the current context
+func (k Keeper) SaveSubspaceBan(ctx sdk.Context, ban types.SubspaceBan) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SubspaceBanStoreKey(ban.SubspaceID, ban.User)
+
+	// Update the expiring queue
+	k.saveSubspaceBanToExpiringQueue(ctx, ban.ExpirationTime, key)
+
+	store.Set(key, k.cdc.MustMarshal(&ban))
+
+	k.Logger(ctx).Info("subspace ban saved", "subspace id", ban.SubspaceID, "user", ban.User)
+}
+
+// HasSubspaceBan tells whether a ban for the given user exists inside the specified subspace
+func (k Keeper) HasSubspaceBan(ctx sdk.Context, subspaceID uint64, user string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SubspaceBanStoreKey(subspaceID, user))
+}
+
+// GetSubspaceBan returns the ban of the given user from the specified subspace.
+// If there is no ban the function will return an empty ban and false.
+func (k Keeper) GetSubspaceBan(ctx sdk.Context, subspaceID uint64, user string) (ban types.SubspaceBan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.SubspaceBanStoreKey(subspaceID, user)
+	if !store.Has(key) {
+		return types.SubspaceBan{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &ban)
+	return ban, true
+}
+
+// IsUserBanned tells whether the given user is currently banned from the specified subspace
+func (k Keeper) IsUserBanned(ctx sdk.Context, subspaceID uint64, user string) bool {
+	ban, found := k.GetSubspaceBan(ctx, subspaceID, user)
+	return found && !ban.IsExpired(ctx.BlockTime())
+}
+
+// DeleteSubspaceBan deletes the ban of the given user from the specified subspace
+func (k Keeper) DeleteSubspaceBan(ctx sdk.Context, subspaceID uint64, user string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SubspaceBanStoreKey(subspaceID, user)
+
+	// Remove the ban from the expiring queue
+	k.removeSubspaceBanFromExpiringQueue(ctx, key)
+
+	store.Delete(key)
+
+	k.Logger(ctx).Info("subspace ban deleted", "subspace id", subspaceID, "user", user)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// saveSubspaceBanToExpiringQueue saves the subspace ban stored with the given key into the expiring queue
+func (k Keeper) saveSubspaceBanToExpiringQueue(ctx sdk.Context, expiration *time.Time, banKey []byte) {
+	// Make sure we remove the ban from the expiring queue to properly handle expiration updates and avoid duplicated keys
+	k.removeSubspaceBanFromExpiringQueue(ctx, banKey)
+
+	store := ctx.KVStore(k.storeKey)
+	if expiration != nil {
+		store.Set(types.ExpiringSubspaceBanKey(expiration, banKey), []byte{0x1})
+	}
+}
+
+// removeSubspaceBanFromExpiringQueue removes the subspace ban stored with the given key from the expiring queue
+func (k Keeper) removeSubspaceBanFromExpiringQueue(ctx sdk.Context, banKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	// Do nothing if the ban does not exist
+	if !store.Has(banKey) {
+		return
+	}
+
+	// Get the existing ban
+	var ban types.SubspaceBan
+	k.cdc.MustUnmarshal(store.Get(banKey), &ban)
+
+	// Delete the ban from the expiring queue
+	if ban.ExpirationTime != nil {
+		store.Delete(types.ExpiringSubspaceBanKey(ban.ExpirationTime, banKey))
+	}
+}
+
+// RemoveExpiredSubspaceBans removes all the subspace bans that have expired before the given time
+func (k Keeper) RemoveExpiredSubspaceBans(ctx sdk.Context, expiration time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ExpiringSubspaceBanQueuePrefix, types.ExpiringSubspaceBanTimePrefix(&expiration))
+
+	var expired []types.SubspaceBan
+	for ; iterator.Valid(); iterator.Next() {
+		var ban types.SubspaceBan
+		k.cdc.MustUnmarshal(store.Get(types.ParseSubspaceBanKeyFromExpiringKey(iterator.Key())), &ban)
+		expired = append(expired, ban)
+	}
+	iterator.Close()
+
+	// Remove the bans outside the iteration since removing them also updates the queue
+	for _, ban := range expired {
+		k.DeleteSubspaceBan(ctx, ban.SubspaceID, ban.User)
+	}
+}
@@ -0,0 +1,370 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveSubspaceBan() {
+	expiration := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name  string
+		store func(ctx sdk.Context)
+		ban   types.SubspaceBan
+		check func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing ban is stored properly",
+			ban: types.NewSubspaceBan(
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Spam",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				nil,
+			),
+			check: func(ctx sdk.Context) {
+				ban, found := suite.k.GetSubspaceBan(ctx, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				), ban)
+			},
+		},
+		{
+			name: "ban with expiration is added to the expiring queue",
+			ban: types.NewSubspaceBan(
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Spam",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				&expiration,
+			),
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.HasSubspaceBan(ctx, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+
+				key := types.SubspaceBanStoreKey(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(ctx.KVStore(suite.storeKey).Has(types.ExpiringSubspaceBanKey(&expiration, key)))
+			},
+		},
+		{
+			name: "existing ban is overwritten and the expiring queue is updated properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					&expiration,
+				))
+			},
+			ban: types.NewSubspaceBan(
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Harassment",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				nil,
+			),
+			check: func(ctx sdk.Context) {
+				ban, found := suite.k.GetSubspaceBan(ctx, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Harassment",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				), ban)
+
+				key := types.SubspaceBanStoreKey(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().False(ctx.KVStore(suite.storeKey).Has(types.ExpiringSubspaceBanKey(&expiration, key)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SaveSubspaceBan(ctx, tc.ban)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetSubspaceBan() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		user       string
+		expFound   bool
+		expBan     types.SubspaceBan
+	}{
+		{
+			name:       "not found ban returns false",
+			subspaceID: 1,
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			expFound:   false,
+		},
+		{
+			name: "found ban returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				))
+			},
+			subspaceID: 1,
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			expFound:   true,
+			expBan: types.NewSubspaceBan(
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Spam",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				nil,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			ban, found := suite.k.GetSubspaceBan(ctx, tc.subspaceID, tc.user)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.expBan, ban)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_IsUserBanned() {
+	testCases := []struct {
+		name       string
+		setupCtx   func(ctx sdk.Context) sdk.Context
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		user       string
+		expBanned  bool
+	}{
+		{
+			name:       "not banned user returns false",
+			subspaceID: 1,
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			expBanned:  false,
+		},
+		{
+			name: "expired ban returns false",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					&expiration,
+				))
+			},
+			subspaceID: 1,
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			expBanned:  false,
+		},
+		{
+			name: "active ban returns true",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				expiration := time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC)
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					&expiration,
+				))
+			},
+			subspaceID: 1,
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			expBanned:  true,
+		},
+		{
+			name: "permanent ban returns true",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				))
+			},
+			subspaceID: 1,
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			expBanned:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.setupCtx != nil {
+				ctx = tc.setupCtx(ctx)
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			banned := suite.k.IsUserBanned(ctx, tc.subspaceID, tc.user)
+			suite.Require().Equal(tc.expBanned, banned)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteSubspaceBan() {
+	expiration := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		user       string
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing ban is deleted properly",
+			subspaceID: 1,
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasSubspaceBan(ctx, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+			},
+		},
+		{
+			name: "existing ban is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					&expiration,
+				))
+			},
+			subspaceID: 1,
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasSubspaceBan(ctx, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+
+				key := types.SubspaceBanStoreKey(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().False(ctx.KVStore(suite.storeKey).Has(types.ExpiringSubspaceBanKey(&expiration, key)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeleteSubspaceBan(ctx, tc.subspaceID, tc.user)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RemoveExpiredSubspaceBans() {
+	expiration := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		expiration time.Time
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name: "non expired ban is kept properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					&expiration,
+				))
+			},
+			expiration: time.Date(2100, 7, 6, 0, 0, 0, 0, time.UTC),
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.HasSubspaceBan(ctx, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+
+				key := types.SubspaceBanStoreKey(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(ctx.KVStore(suite.storeKey).Has(types.ExpiringSubspaceBanKey(&expiration, key)))
+			},
+		},
+		{
+			name: "expired ban is removed properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					&expiration,
+				))
+			},
+			expiration: time.Date(2100, 7, 8, 0, 0, 0, 0, time.UTC),
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasSubspaceBan(ctx, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+
+				key := types.SubspaceBanStoreKey(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().False(ctx.KVStore(suite.storeKey).Has(types.ExpiringSubspaceBanKey(&expiration, key)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.RemoveExpiredSubspaceBans(ctx, tc.expiration)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
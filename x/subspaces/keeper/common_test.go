@@ -56,7 +56,8 @@ func (suite *KeeperTestSuite) SetupTest() {
 	}
 
 	suite.ctx = sdk.NewContext(ms, tmproto.Header{ChainID: "test-chain"}, false, log.NewNopLogger())
-	suite.cdc, suite.legacyAminoCdc = app.MakeCodecs()
+	encodingConfig := app.MakeEncodingConfig()
+	suite.cdc, suite.legacyAminoCdc = encodingConfig.Codec, encodingConfig.Amino
 
 	// Dependencies initialization
 	suite.ak = authkeeper.NewAccountKeeper(
@@ -67,10 +68,15 @@ func (suite *KeeperTestSuite) SetupTest() {
 		"cosmos",
 		authtypes.NewModuleAddress("gov").String(),
 	)
-	suite.authzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], suite.cdc, &baseapp.MsgServiceRouter{}, suite.ak)
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
+	suite.authzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], suite.cdc, router, suite.ak)
 
 	// Define keeper
 	suite.k = keeper.NewKeeper(suite.cdc, suite.storeKey, suite.ak, suite.authzKeeper, authtypes.NewModuleAddress("gov").String())
+
+	// Register the msg server so that treasury proposals can be executed
+	types.RegisterMsgServer(router, keeper.NewMsgServerImpl(suite.k))
 }
 
 func (suite *KeeperTestSuite) getAllGrantsInExpiringQueue(ctx sdk.Context) []types.Grant {
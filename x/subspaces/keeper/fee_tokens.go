@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SaveFeeTokensConfig saves the given fee tokens config inside the current context
+func (k Keeper) SaveFeeTokensConfig(ctx sdk.Context, config types.FeeTokensConfig) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeTokensConfigStoreKey(config.SubspaceID), k.cdc.MustMarshal(&config))
+
+	k.Logger(ctx).Info("fee tokens config saved", "subspace id", config.SubspaceID)
+}
+
+// HasFeeTokensConfig tells whether the subspace with the given id has a fee tokens config
+func (k Keeper) HasFeeTokensConfig(ctx sdk.Context, subspaceID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.FeeTokensConfigStoreKey(subspaceID))
+}
+
+// GetFeeTokensConfig returns the fee tokens config of the subspace having the given id.
+// If there is no config the function will return an empty config and false.
+func (k Keeper) GetFeeTokensConfig(ctx sdk.Context, subspaceID uint64) (config types.FeeTokensConfig, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.FeeTokensConfigStoreKey(subspaceID)
+	if !store.Has(key) {
+		return types.FeeTokensConfig{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &config)
+	return config, true
+}
+
+// DeleteFeeTokensConfig deletes the fee tokens config of the subspace having the given id
+func (k Keeper) DeleteFeeTokensConfig(ctx sdk.Context, subspaceID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeeTokensConfigStoreKey(subspaceID))
+
+	k.Logger(ctx).Info("fee tokens config deleted", "subspace id", subspaceID)
+}
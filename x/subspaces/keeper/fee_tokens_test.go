@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveFeeTokensConfig() {
+	testCases := []struct {
+		name   string
+		store  func(ctx sdk.Context)
+		config types.FeeTokensConfig
+		check  func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing config is stored properly",
+			config: types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+				types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+			}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR),
+			check: func(ctx sdk.Context) {
+				config, found := suite.k.GetFeeTokensConfig(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+				}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR), config)
+			},
+		},
+		{
+			name: "existing config is overwritten properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+				}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR))
+			},
+			config: types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+				types.NewFeeTokenRate("minttoken", sdk.NewDec(3), sdk.NewInt(1000)),
+			}, types.FEE_TOKENS_DESTINATION_TREASURY),
+			check: func(ctx sdk.Context) {
+				config, found := suite.k.GetFeeTokensConfig(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(3), sdk.NewInt(1000)),
+				}, types.FEE_TOKENS_DESTINATION_TREASURY), config)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SaveFeeTokensConfig(ctx, tc.config)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetFeeTokensConfig() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		expFound   bool
+		expConfig  types.FeeTokensConfig
+	}{
+		{
+			name:       "not found config returns false",
+			subspaceID: 1,
+			expFound:   false,
+		},
+		{
+			name: "found config returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+				}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR))
+			},
+			subspaceID: 1,
+			expFound:   true,
+			expConfig: types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+				types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+			}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			config, found := suite.k.GetFeeTokensConfig(ctx, tc.subspaceID)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.expConfig, config)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteFeeTokensConfig() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing config is deleted properly",
+			subspaceID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasFeeTokensConfig(ctx, 1))
+			},
+		},
+		{
+			name: "existing config is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+				}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR))
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(2, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+				}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR))
+			},
+			subspaceID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasFeeTokensConfig(ctx, 1))
+
+				// Make sure the other config is untouched
+				suite.Require().True(suite.k.HasFeeTokensConfig(ctx, 2))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeleteFeeTokensConfig(ctx, tc.subspaceID)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
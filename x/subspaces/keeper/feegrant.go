@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
 
 	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
 )
@@ -52,8 +53,9 @@ func (k Keeper) DeleteUserGrant(ctx sdk.Context, subspaceID uint64, grantee stri
 	// Remove the allowance from the list of expiring allowances
 	k.removeAllowanceFromExpiringQueue(ctx, key)
 
-	// Delete allowance
+	// Delete allowance and its usage
 	store.Delete(key)
+	k.DeleteAllowanceUsage(ctx, subspaceID, 0, grantee)
 }
 
 // GetUserGrant returns the grant associated to the given user from the provided subspace.
@@ -84,8 +86,9 @@ func (k Keeper) DeleteGroupGrant(ctx sdk.Context, subspaceID uint64, groupID uin
 	// Remove the allowance from the list of expiring allowances
 	k.removeAllowanceFromExpiringQueue(ctx, key)
 
-	// Delete allowance
+	// Delete allowance and its usages
 	store.Delete(key)
+	k.deleteGroupAllowanceUsages(ctx, subspaceID, groupID)
 }
 
 // GetGroupGrant returns the grant associated to the given group id from the provided subspace.
@@ -142,13 +145,104 @@ func (k Keeper) RemoveExpiredAllowances(ctx sdk.Context, expiration time.Time) {
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
+		grantKey := types.ParseAllowanceKeyFromExpiringKey(iterator.Key())
+
+		var grant types.Grant
+		k.cdc.MustUnmarshal(store.Get(grantKey), &grant)
+
 		store.Delete(iterator.Key())
-		store.Delete(types.ParseAllowanceKeyFromExpiringKey(iterator.Key()))
+		store.Delete(grantKey)
+		k.deleteGrantAllowanceUsages(ctx, grant)
 	}
 }
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// SaveAllowanceUsage saves the given allowance usage inside the current context
+func (k Keeper) SaveAllowanceUsage(ctx sdk.Context, usage types.AllowanceUsage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AllowanceUsageStoreKey(usage.SubspaceID, usage.GroupID, usage.Grantee), k.cdc.MustMarshal(&usage))
+}
+
+// GetAllowanceUsage returns the usage of the allowance granted to the given group that has been made by the
+// provided grantee inside the specified subspace. User allowances usages are identified using 0 as the group id.
+// If there is no usage the function will return an empty usage and false.
+func (k Keeper) GetAllowanceUsage(ctx sdk.Context, subspaceID uint64, groupID uint32, grantee string) (usage types.AllowanceUsage, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.AllowanceUsageStoreKey(subspaceID, groupID, grantee)
+	if !store.Has(key) {
+		return types.AllowanceUsage{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &usage)
+	return usage, true
+}
+
+// DeleteAllowanceUsage deletes the usage of the allowance granted to the given group that has been made by the
+// provided grantee inside the specified subspace
+func (k Keeper) DeleteAllowanceUsage(ctx sdk.Context, subspaceID uint64, groupID uint32, grantee string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AllowanceUsageStoreKey(subspaceID, groupID, grantee))
+}
+
+// deleteGroupAllowanceUsages deletes all the usages of the allowance granted to the given group
+func (k Keeper) deleteGroupAllowanceUsages(ctx sdk.Context, subspaceID uint64, groupID uint32) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GroupAllowanceUsagesPrefix(subspaceID, groupID))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// deleteGrantAllowanceUsages deletes all the usages of the given grant based on its grantee type
+func (k Keeper) deleteGrantAllowanceUsages(ctx sdk.Context, grant types.Grant) {
+	switch grantee := grant.Grantee.GetCachedValue().(type) {
+	case *types.UserGrantee:
+		k.DeleteAllowanceUsage(ctx, grant.SubspaceID, 0, grantee.User)
+
+	case *types.GroupGrantee:
+		k.deleteGroupAllowanceUsages(ctx, grant.SubspaceID, grantee.GroupID)
+	}
+}
+
+// ConsumeAllowanceUsage records that the given grantee is using the allowance granted to the provided group
+// (or 0 if it was granted to the grantee directly) to pay the given fees for the specified messages.
+// If the allowance is a MessageLimitsAllowance and the grantee has already reached any of its limits during
+// the current period, an error is returned instead. Other allowance types are not tracked.
+func (k Keeper) ConsumeAllowanceUsage(
+	ctx sdk.Context, subspaceID uint64, groupID uint32, grantee string,
+	allowance feegranttypes.FeeAllowanceI, fees sdk.Coins, msgs []sdk.Msg,
+) error {
+	limitsAllowance, isLimitsAllowance := allowance.(*types.MessageLimitsAllowance)
+	if !isLimitsAllowance {
+		return nil
+	}
+
+	usage, found := k.GetAllowanceUsage(ctx, subspaceID, groupID, grantee)
+	if !found {
+		usage = types.NewAllowanceUsage(subspaceID, groupID, grantee, time.Time{}, nil, nil)
+	}
+
+	usage = usage.Refresh(ctx.BlockTime(), limitsAllowance.Period).Consume(fees, msgs)
+	err := limitsAllowance.ValidateUsage(usage)
+	if err != nil {
+		return err
+	}
+
+	k.SaveAllowanceUsage(ctx, usage)
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // UseGrantedFees will try to pay the given fees from the treasury account as requested by the grantee.
 // If no valid allowance exists, then returns false to show the fees will not be paid in this phase.
 func (k Keeper) UseGrantedFees(ctx sdk.Context, subspaceID uint64, grantee sdk.AccAddress, fees sdk.Coins, msgs []sdk.Msg) bool {
@@ -177,6 +271,12 @@ func (k Keeper) UseUserGrantedFees(ctx sdk.Context, subspaceID uint64, grantee s
 		return false
 	}
 
+	// Make sure the grantee has not exceeded the limits of the allowance during the current period
+	err = k.ConsumeAllowanceUsage(ctx, subspaceID, 0, grantee.String(), allowance, fees, msgs)
+	if err != nil {
+		return false
+	}
+
 	// update grant if allowance accept properly and still valid after execution
 	if !remove {
 		k.SaveGrant(ctx, types.NewGrant(subspaceID,
@@ -212,6 +312,12 @@ func (k Keeper) UseGroupGrantedFees(ctx sdk.Context, subspaceID uint64, grantee
 			return false
 		}
 
+		// Make sure the grantee has not exceeded the limits of the allowance during the current period
+		err = k.ConsumeAllowanceUsage(ctx, subspaceID, groupGrantee.GroupID, grantee.String(), allowance, fee, msgs)
+		if err != nil {
+			return false
+		}
+
 		// Update the grant if the allowance was accepted properly and is still valid after execution
 		if !remove {
 			k.SaveGrant(ctx, types.NewGrant(subspaceID, grant.Granter, groupGrantee, allowance))
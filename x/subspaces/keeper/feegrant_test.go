@@ -467,6 +467,14 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteUserGrant() {
 						Expiration: &expiration,
 					},
 				))
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 1)},
+				))
 			},
 			subspaceID: 1,
 			grantee:    "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
@@ -475,6 +483,10 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteUserGrant() {
 
 				// Check no grants inside expiration queue
 				suite.Require().Empty(suite.getAllGrantsInExpiringQueue(ctx))
+
+				// Check the usage has been deleted
+				_, found := suite.k.GetAllowanceUsage(ctx, 1, 0, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().False(found)
 			},
 		},
 	}
@@ -632,6 +644,22 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteGroupGrant() {
 						Expiration: &expiration,
 					},
 				))
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 1)},
+				))
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					2,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 1)},
+				))
 			},
 			subspaceID: 1,
 			groupID:    1,
@@ -640,6 +668,13 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteGroupGrant() {
 
 				// Check no grants inside expiration queue
 				suite.Require().Empty(suite.getAllGrantsInExpiringQueue(ctx))
+
+				// Check only the usages of the group have been deleted
+				_, found := suite.k.GetAllowanceUsage(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().False(found)
+
+				_, found = suite.k.GetAllowanceUsage(ctx, 1, 2, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().True(found)
 			},
 		},
 	}
@@ -741,6 +776,162 @@ func (suite *KeeperTestSuite) TestKeeper_RemoveExpiredAllowances() {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+func (suite *KeeperTestSuite) TestKeeper_ConsumeAllowanceUsage() {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+	allowance := types.NewMessageLimitsAllowance(
+		[]types.MessageLimit{types.NewMessageLimit("/desmos.subspaces.v3.MsgEditSubspace", 2)},
+		sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+		24*time.Hour,
+		nil,
+	)
+	msg := types.NewMsgEditSubspace(1, "New name", types.DoNotModify, types.DoNotModify, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		groupID    uint32
+		grantee    string
+		allowance  feegrant.FeeAllowanceI
+		fees       sdk.Coins
+		msgs       []sdk.Msg
+		shouldErr  bool
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "other allowance types are not tracked",
+			subspaceID: 1,
+			groupID:    0,
+			grantee:    "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			allowance:  &feegrant.BasicAllowance{},
+			fees:       sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+			msgs:       []sdk.Msg{msg},
+			shouldErr:  false,
+			check: func(ctx sdk.Context) {
+				_, found := suite.k.GetAllowanceUsage(ctx, 1, 0, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().False(found)
+			},
+		},
+		{
+			name: "exceeded message limit returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					blockTime.Add(time.Hour),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(20))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.subspaces.v3.MsgEditSubspace", 2)},
+				))
+			},
+			subspaceID: 1,
+			groupID:    0,
+			grantee:    "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			allowance:  allowance,
+			fees:       sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+			msgs:       []sdk.Msg{msg},
+			shouldErr:  true,
+		},
+		{
+			name: "exceeded period spend limit returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					blockTime.Add(time.Hour),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(95))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.subspaces.v3.MsgEditSubspace", 1)},
+				))
+			},
+			subspaceID: 1,
+			groupID:    1,
+			grantee:    "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			allowance:  allowance,
+			fees:       sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+			msgs:       []sdk.Msg{msg},
+			shouldErr:  true,
+		},
+		{
+			name:       "first usage is stored properly",
+			subspaceID: 1,
+			groupID:    1,
+			grantee:    "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			allowance:  allowance,
+			fees:       sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+			msgs:       []sdk.Msg{msg},
+			shouldErr:  false,
+			check: func(ctx sdk.Context) {
+				usage, found := suite.k.GetAllowanceUsage(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					blockTime.Add(24*time.Hour),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.subspaces.v3.MsgEditSubspace", 1)},
+				), usage)
+			},
+		},
+		{
+			name: "usage of an ended period is reset properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					blockTime,
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.subspaces.v3.MsgEditSubspace", 2)},
+				))
+			},
+			subspaceID: 1,
+			groupID:    0,
+			grantee:    "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			allowance:  allowance,
+			fees:       sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+			msgs:       []sdk.Msg{msg},
+			shouldErr:  false,
+			check: func(ctx sdk.Context) {
+				usage, found := suite.k.GetAllowanceUsage(ctx, 1, 0, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewAllowanceUsage(
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					blockTime.Add(24*time.Hour),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.subspaces.v3.MsgEditSubspace", 1)},
+				), usage)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.ConsumeAllowanceUsage(ctx, tc.subspaceID, tc.groupID, tc.grantee, tc.allowance, tc.fees, tc.msgs)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 func (suite *KeeperTestSuite) TestKeeper_UseUserGrantedFees() {
 	testCases := []struct {
 		name       string
@@ -816,6 +1007,61 @@ func (suite *KeeperTestSuite) TestKeeper_UseUserGrantedFees() {
 				suite.Require().False(suite.k.HasUserGrant(ctx, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"))
 			},
 		},
+		{
+			name: "message limits allowance exceeding the period spend limit returns false",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGrant(ctx, types.NewGrant(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					types.NewMessageLimitsAllowance(
+						[]types.MessageLimit{types.NewMessageLimit("/desmos.posts.v3.MsgCreatePost", 10)},
+						sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+						24*time.Hour,
+						nil,
+					),
+				))
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					ctx.BlockTime().Add(time.Hour),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(95))),
+					nil,
+				))
+			},
+			subspaceID: 1,
+			grantee:    "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			fees:       sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+			expUsed:    false,
+		},
+		{
+			name: "message limits allowance usage is tracked properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGrant(ctx, types.NewGrant(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					types.NewMessageLimitsAllowance(
+						[]types.MessageLimit{types.NewMessageLimit("/desmos.posts.v3.MsgCreatePost", 10)},
+						sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+						24*time.Hour,
+						nil,
+					),
+				))
+			},
+			subspaceID: 1,
+			grantee:    "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			fees:       sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+			expUsed:    true,
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.HasUserGrant(ctx, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"))
+
+				usage, found := suite.k.GetAllowanceUsage(ctx, 1, 0, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))), usage.PeriodSpent)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -873,7 +1119,7 @@ func (suite *KeeperTestSuite) TestKeeper_UseGroupGrantedFees() {
 		{
 			name: "invalid grant returns false",
 			store: func(ctx sdk.Context) {
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
 				suite.k.SaveGrant(ctx, types.NewGrant(
 					1,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
@@ -889,7 +1135,7 @@ func (suite *KeeperTestSuite) TestKeeper_UseGroupGrantedFees() {
 		{
 			name: "valid grant returns true",
 			store: func(ctx sdk.Context) {
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
 				suite.k.SaveGrant(ctx, types.NewGrant(
 					1,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
@@ -914,7 +1160,7 @@ func (suite *KeeperTestSuite) TestKeeper_UseGroupGrantedFees() {
 		{
 			name: "consuming grant returns true",
 			store: func(ctx sdk.Context) {
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
 				suite.k.SaveGrant(ctx, types.NewGrant(
 					1,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
@@ -985,7 +1231,7 @@ func (suite *KeeperTestSuite) TestKeeper_UseGrantedFees() {
 		{
 			name: "valid group grant returns true",
 			store: func(ctx sdk.Context) {
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
 				suite.k.SaveGrant(ctx, types.NewGrant(
 					1,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
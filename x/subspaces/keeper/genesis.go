@@ -22,6 +22,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllUserGroups(ctx),
 		k.getAllUserGroupsMembers(ctx),
 		k.GetAllGrants(ctx),
+		k.GetAllSubspaceOwnerTransferRequests(ctx),
+		k.GetAllGroupInvites(ctx),
+		k.GetAllGroupApplications(ctx),
+		k.GetAllSubspaceBans(ctx),
+		k.GetAllRateLimits(ctx),
+		k.GetAllTreasuryVotingConfigs(ctx),
+		k.GetAllTreasuryProposals(ctx),
+		k.GetAllTreasuryVotes(ctx),
+		k.GetAllFeeTokensConfigs(ctx),
+		k.GetAllAllowanceUsages(ctx),
 	)
 }
 
@@ -104,16 +114,70 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 
 	// Initialize the group members
 	for _, entry := range data.UserGroupsMembers {
-		k.AddUserToGroup(ctx, entry.SubspaceID, entry.GroupID, entry.User)
+		k.AddUserToGroup(ctx, entry.SubspaceID, entry.GroupID, entry.User, entry.ExpirationTime)
 	}
 
 	// Initialize the permissions
 	for _, entry := range data.UserPermissions {
-		k.SetUserPermissions(ctx, entry.SubspaceID, entry.SectionID, entry.User, entry.Permissions)
+		k.SetUserPermissions(ctx, entry.SubspaceID, entry.SectionID, entry.User, entry.Permissions, entry.ExpirationTime)
 	}
 
 	// Initialize the user grants
 	for _, grant := range data.Grants {
 		k.SaveGrant(ctx, grant)
 	}
+
+	// Initialize the owner transfer requests
+	for _, request := range data.OwnerTransferRequests {
+		k.SaveSubspaceOwnerTransferRequest(ctx, request)
+	}
+
+	// Initialize the group invites
+	for _, invite := range data.GroupInvites {
+		k.SaveGroupInvite(ctx, invite)
+	}
+
+	// Initialize the group applications
+	for _, application := range data.GroupApplications {
+		k.SaveGroupApplication(ctx, application)
+	}
+
+	// Initialize the subspace bans
+	for _, ban := range data.Bans {
+		k.SaveSubspaceBan(ctx, ban)
+	}
+
+	// Initialize the rate limits
+	for _, limit := range data.RateLimits {
+		k.SaveRateLimit(ctx, limit)
+	}
+
+	// Initialize the treasury voting configs
+	for _, config := range data.TreasuryVotingConfigs {
+		k.SaveTreasuryVotingConfig(ctx, config)
+	}
+
+	// Initialize the treasury proposals, making sure the next proposal id of each subspace
+	// is greater than the ids of all its proposals
+	for _, proposal := range data.TreasuryProposals {
+		k.SaveTreasuryProposal(ctx, proposal)
+		if proposal.ID >= k.GetNextTreasuryProposalID(ctx, proposal.SubspaceID) {
+			k.SetNextTreasuryProposalID(ctx, proposal.SubspaceID, proposal.ID+1)
+		}
+	}
+
+	// Initialize the treasury votes
+	for _, vote := range data.TreasuryVotes {
+		k.SaveTreasuryVote(ctx, vote)
+	}
+
+	// Initialize the fee tokens configs
+	for _, config := range data.FeeTokensConfigs {
+		k.SaveFeeTokensConfig(ctx, config)
+	}
+
+	// Initialize the allowance usages
+	for _, usage := range data.AllowanceUsages {
+		k.SaveAllowanceUsage(ctx, usage)
+	}
 }
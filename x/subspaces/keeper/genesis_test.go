@@ -22,7 +22,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 			store: func(ctx sdk.Context) {
 				suite.k.SetSubspaceID(ctx, 1)
 			},
-			expGenesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil),
+			expGenesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 		},
 		{
 			name: "subspaces and their data are exported correctly",
@@ -93,6 +93,16 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				},
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -106,6 +116,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					0,
 					"Test section",
 					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 
 				suite.k.SaveSection(ctx, types.NewSection(
@@ -114,6 +125,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					1,
 					"Another test section",
 					"This is another test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			expGenesis: types.NewGenesisState(1, nil, nil, []types.Section{
@@ -123,6 +135,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					0,
 					"Test section",
 					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				),
 				types.NewSection(
 					2,
@@ -130,8 +143,9 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					1,
 					"Another test section",
 					"This is another test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				),
-			}, nil, nil, nil, nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 		},
 		{
 			name: "user permissions are exported correctly",
@@ -153,6 +167,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					0,
 					"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
 					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
 				)
 			},
 			expGenesis: types.NewGenesisState(
@@ -181,6 +196,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 						0,
 						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
 						types.NewPermissions(types.PermissionSetPermissions),
+						nil,
 					),
 				},
 				[]types.UserGroup{
@@ -188,6 +204,16 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				},
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -214,7 +240,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
 
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					2,
@@ -236,8 +262,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 2, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
-				suite.k.AddUserToGroup(ctx, 2, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.k.AddUserToGroup(ctx, 2, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 2, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
 			},
 			expGenesis: types.NewGenesisState(
 				3,
@@ -293,11 +319,21 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					),
 				},
 				[]types.UserGroupMemberEntry{
-					types.NewUserGroupMemberEntry(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
-					types.NewUserGroupMemberEntry(2, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
-					types.NewUserGroupMemberEntry(2, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					types.NewUserGroupMemberEntry(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil),
+					types.NewUserGroupMemberEntry(2, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil),
+					types.NewUserGroupMemberEntry(2, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil),
 				},
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -380,6 +416,16 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 						},
 					),
 				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -406,8 +452,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
 
 				suite.k.SaveGrant(ctx, types.NewGrant(
 					1,
@@ -461,8 +507,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					),
 				},
 				[]types.UserGroupMemberEntry{
-					types.NewUserGroupMemberEntry(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
-					types.NewUserGroupMemberEntry(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					types.NewUserGroupMemberEntry(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil),
+					types.NewUserGroupMemberEntry(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil),
 				},
 				[]types.Grant{
 					types.NewGrant(
@@ -484,62 +530,65 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 						},
 					),
 				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 		},
-	}
-
-	for _, tc := range testCases {
-		tc := tc
-		suite.Run(tc.name, func() {
-			ctx, _ := suite.ctx.CacheContext()
-			if tc.store != nil {
-				tc.store(ctx)
-			}
-
-			genesis := suite.k.ExportGenesis(ctx)
-			suite.Require().Equal(tc.expGenesis, genesis)
-		})
-	}
-}
-
-func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
-	testCases := []struct {
-		name    string
-		genesis types.GenesisState
-		check   func(ctx sdk.Context)
-	}{
-		{
-			name: "initial subspace id is imported properly",
-			genesis: types.GenesisState{
-				InitialSubspaceID: 2,
-			},
-			check: func(ctx sdk.Context) {
-				stored, err := suite.k.GetSubspaceID(ctx)
-				suite.Require().NoError(err)
-				suite.Require().Equal(uint64(2), stored)
-			},
-		},
 		{
-			name: "subspaces data are imported properly",
-			genesis: types.GenesisState{
-				SubspacesData: []types.SubspaceData{
-					types.NewSubspaceData(1, 10, 20),
-				},
-			},
-			check: func(ctx sdk.Context) {
-				nextSectionID, err := suite.k.GetNextSectionID(ctx, 1)
-				suite.Require().NoError(err)
-				suite.Require().Equal(uint32(10), nextSectionID)
+			name: "group invites and applications are exported properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetSubspaceID(ctx, 2)
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SetNextGroupID(ctx, 1, 2)
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
 
-				nextGroupID, err := suite.k.GetNextGroupID(ctx, 1)
-				suite.Require().NoError(err)
-				suite.Require().Equal(uint32(20), nextGroupID)
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					2,
+					&expiration,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
 			},
-		},
-		{
-			name: "subspaces are imported properly",
-			genesis: types.GenesisState{
-				Subspaces: []types.Subspace{
+			expGenesis: types.NewGenesisState(
+				2,
+				[]types.SubspaceData{
+					types.NewSubspaceData(1, 1, 2),
+				},
+				[]types.Subspace{
 					types.NewSubspace(
 						1,
 						"Test subspace",
@@ -551,51 +600,12 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 						nil,
 					),
 				},
-			},
-			check: func(ctx sdk.Context) {
-				stored, found := suite.k.GetSubspace(ctx, 1)
-				suite.Require().True(found)
-				suite.Require().Equal(types.NewSubspace(
-					1,
-					"Test subspace",
-					"This is a test subspace",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				), stored)
-			},
-		},
-		{
-			name: "sections are imported properly",
-			genesis: types.GenesisState{
-				Sections: []types.Section{
-					types.NewSection(
-						1,
-						2,
-						0,
-						"Test section",
-						"This is a test section",
-					),
+				[]types.Section{
+					types.DefaultSection(1),
 				},
-			},
-			check: func(ctx sdk.Context) {
-				stored, found := suite.k.GetSection(ctx, 1, 2)
-				suite.Require().True(found)
-				suite.Equal(types.NewSection(
-					1,
-					2,
-					0,
-					"Test section",
-					"This is a test section",
-				), stored)
-			},
-		},
-		{
-			name: "user groups are imported properly",
-			genesis: types.GenesisState{
-				UserGroups: []types.UserGroup{
+				nil,
+				[]types.UserGroup{
+					types.DefaultUserGroup(1),
 					types.NewUserGroup(
 						1,
 						0,
@@ -605,74 +615,609 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 						types.NewPermissions(types.PermissionEditSubspace),
 					),
 				},
-			},
-			check: func(ctx sdk.Context) {
-				stored, found := suite.k.GetUserGroup(ctx, 1, 1)
-				suite.Require().True(found)
-				suite.Require().Equal(types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				), stored)
-			},
-		},
-		{
-			name: "user group members are imported properly",
-			genesis: types.GenesisState{
-				UserGroupsMembers: []types.UserGroupMemberEntry{
-					types.NewUserGroupMemberEntry(2, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
-					types.NewUserGroupMemberEntry(2, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
-				},
-			},
-			check: func(ctx sdk.Context) {
-				groupMembers := suite.k.GetUserGroupMembers(ctx, 2, 1)
-				suite.Require().Len(groupMembers, 2)
-			},
-		},
-		{
-			name: "user permissions are imported properly",
-			genesis: types.GenesisState{
-				UserPermissions: []types.UserPermission{
-					types.NewUserPermission(
+				nil,
+				nil,
+				nil,
+				[]types.GroupInvite{
+					types.NewGroupInvite(
+						1,
+						1,
+						types.GetInviteSecretHash("secret"),
+						10,
 						2,
-						0,
-						"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
-						types.NewPermissions(types.PermissionSetPermissions),
+						&expiration,
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 					),
 				},
-			},
-			check: func(ctx sdk.Context) {
-				storedUserPermissions := suite.k.GetUserPermissions(ctx, 2, 0, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e")
-				suite.Require().Equal(types.NewPermissions(types.PermissionSetPermissions), storedUserPermissions)
-			},
-		},
-		{
-			name: "user grants are imported properly",
-			genesis: types.GenesisState{
-				Grants: []types.Grant{
-					types.NewGrant(
+				[]types.GroupApplication{
+					types.NewGroupApplication(
 						1,
-						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-						types.NewUserGrantee("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
-						&feegrant.BasicAllowance{
-							SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
-							Expiration: &expiration,
-						},
+						1,
+						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+						"Let me in",
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					),
 				},
-			},
-			check: func(ctx sdk.Context) {
-				stored, found := suite.k.GetUserGrant(ctx, 1, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
-				suite.Require().True(found)
-				suite.Require().Equal(types.NewGrant(
-					1,
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					types.NewUserGrantee("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
-					&feegrant.BasicAllowance{
-						SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			),
+		},
+		{
+			name: "subspace bans are exported properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetSubspaceID(ctx, 2)
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					&expiration,
+				))
+			},
+			expGenesis: types.NewGenesisState(
+				2,
+				[]types.SubspaceData{
+					types.NewSubspaceData(1, 1, 1),
+				},
+				[]types.Subspace{
+					types.NewSubspace(
+						1,
+						"Test subspace",
+						"This is a test subspace",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						nil,
+					),
+				},
+				[]types.Section{
+					types.DefaultSection(1),
+				},
+				nil,
+				[]types.UserGroup{
+					types.DefaultUserGroup(1),
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.SubspaceBan{
+					types.NewSubspaceBan(
+						1,
+						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+						"Spam",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						&expiration,
+					),
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			),
+		},
+		{
+			name: "rate limits are exported properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetSubspaceID(ctx, 2)
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour))
+			},
+			expGenesis: types.NewGenesisState(
+				2,
+				[]types.SubspaceData{
+					types.NewSubspaceData(1, 1, 1),
+				},
+				[]types.Subspace{
+					types.NewSubspace(
+						1,
+						"Test subspace",
+						"This is a test subspace",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						nil,
+					),
+				},
+				[]types.Section{
+					types.DefaultSection(1),
+				},
+				nil,
+				[]types.UserGroup{
+					types.DefaultUserGroup(1),
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.RateLimit{
+					types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			),
+		},
+		{
+			name: "treasury proposals are exported properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetSubspaceID(ctx, 2)
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour))
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES))
+			},
+			expGenesis: types.NewGenesisState(
+				2,
+				[]types.SubspaceData{
+					types.NewSubspaceData(1, 1, 1),
+				},
+				[]types.Subspace{
+					types.NewSubspace(
+						1,
+						"Test subspace",
+						"This is a test subspace",
+						"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						nil,
+					),
+				},
+				[]types.Section{
+					types.DefaultSection(1),
+				},
+				nil,
+				[]types.UserGroup{
+					types.DefaultUserGroup(1),
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.TreasuryVotingConfig{
+					types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+				},
+				[]types.TreasuryProposal{
+					types.NewTreasuryProposal(
+						1,
+						1,
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+						1,
+						sdk.NewDecWithPrec(5, 1),
+						nil,
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+						types.TREASURY_PROPOSAL_STATUS_VOTING,
+						nil,
+					),
+				},
+				[]types.TreasuryVote{
+					types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES),
+				},
+				nil,
+				nil,
+			),
+		},
+		{
+			name: "fee tokens configs are exported properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetSubspaceID(ctx, 2)
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+				))
+
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000)),
+				}, types.FEE_TOKENS_DESTINATION_TREASURY))
+			},
+			expGenesis: types.NewGenesisState(
+				2,
+				[]types.SubspaceData{
+					types.NewSubspaceData(1, 1, 1),
+				},
+				[]types.Subspace{
+					types.NewSubspace(
+						1,
+						"Test subspace",
+						"This is a test subspace",
+						"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+					),
+				},
+				[]types.Section{
+					types.DefaultSection(1),
+				},
+				nil,
+				[]types.UserGroup{
+					types.DefaultUserGroup(1),
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.FeeTokensConfig{
+					types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+						types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000)),
+					}, types.FEE_TOKENS_DESTINATION_TREASURY),
+				},
+				nil,
+			),
+		},
+		{
+			name: "allowance usages are exported properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetSubspaceID(ctx, 2)
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+				))
+
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+					[]types.MessageUsage{
+						types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 2),
+					},
+				))
+			},
+			expGenesis: types.NewGenesisState(
+				2,
+				[]types.SubspaceData{
+					types.NewSubspaceData(1, 1, 1),
+				},
+				[]types.Subspace{
+					types.NewSubspace(
+						1,
+						"Test subspace",
+						"This is a test subspace",
+						"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+					),
+				},
+				[]types.Section{
+					types.DefaultSection(1),
+				},
+				nil,
+				[]types.UserGroup{
+					types.DefaultUserGroup(1),
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.AllowanceUsage{
+					types.NewAllowanceUsage(
+						1,
+						1,
+						"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+						time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+						sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+						[]types.MessageUsage{
+							types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 2),
+						},
+					),
+				},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			genesis := suite.k.ExportGenesis(ctx)
+			suite.Require().Equal(tc.expGenesis, genesis)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		check   func(ctx sdk.Context)
+	}{
+		{
+			name: "initial subspace id is imported properly",
+			genesis: types.GenesisState{
+				InitialSubspaceID: 2,
+			},
+			check: func(ctx sdk.Context) {
+				stored, err := suite.k.GetSubspaceID(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(2), stored)
+			},
+		},
+		{
+			name: "subspaces data are imported properly",
+			genesis: types.GenesisState{
+				SubspacesData: []types.SubspaceData{
+					types.NewSubspaceData(1, 10, 20),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				nextSectionID, err := suite.k.GetNextSectionID(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint32(10), nextSectionID)
+
+				nextGroupID, err := suite.k.GetNextGroupID(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint32(20), nextGroupID)
+			},
+		},
+		{
+			name: "subspaces are imported properly",
+			genesis: types.GenesisState{
+				Subspaces: []types.Subspace{
+					types.NewSubspace(
+						1,
+						"Test subspace",
+						"This is a test subspace",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						nil,
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetSubspace(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				), stored)
+			},
+		},
+		{
+			name: "sections are imported properly",
+			genesis: types.GenesisState{
+				Sections: []types.Section{
+					types.NewSection(
+						1,
+						2,
+						0,
+						"Test section",
+						"This is a test section",
+						types.SECTION_VISIBILITY_PUBLIC,
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetSection(ctx, 1, 2)
+				suite.Require().True(found)
+				suite.Equal(types.NewSection(
+					1,
+					2,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				), stored)
+			},
+		},
+		{
+			name: "user groups are imported properly",
+			genesis: types.GenesisState{
+				UserGroups: []types.UserGroup{
+					types.NewUserGroup(
+						1,
+						0,
+						1,
+						"Test group",
+						"This is a test group",
+						types.NewPermissions(types.PermissionEditSubspace),
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetUserGroup(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				), stored)
+			},
+		},
+		{
+			name: "user group members are imported properly",
+			genesis: types.GenesisState{
+				UserGroupsMembers: []types.UserGroupMemberEntry{
+					types.NewUserGroupMemberEntry(2, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil),
+					types.NewUserGroupMemberEntry(2, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				groupMembers := suite.k.GetUserGroupMembers(ctx, 2, 1)
+				suite.Require().Len(groupMembers, 2)
+			},
+		},
+		{
+			name: "user permissions are imported properly",
+			genesis: types.GenesisState{
+				UserPermissions: []types.UserPermission{
+					types.NewUserPermission(
+						2,
+						0,
+						"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+						types.NewPermissions(types.PermissionSetPermissions),
+						nil,
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				storedUserPermissions := suite.k.GetUserPermissions(ctx, 2, 0, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e")
+				suite.Require().Equal(types.NewPermissions(types.PermissionSetPermissions), storedUserPermissions)
+			},
+		},
+		{
+			name: "expiring user group members and permissions are imported properly",
+			genesis: types.GenesisState{
+				UserGroupsMembers: []types.UserGroupMemberEntry{
+					types.NewUserGroupMemberEntry(2, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", &expiration),
+				},
+				UserPermissions: []types.UserPermission{
+					types.NewUserPermission(
+						2,
+						0,
+						"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+						types.NewPermissions(types.PermissionSetPermissions),
+						&expiration,
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				stored := suite.k.GetUserGroupMemberExpirationTime(ctx, 2, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().Equal(&expiration, stored)
+
+				// Check expirations are added properly
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().True(store.Has(types.ExpiringGroupMemberKey(
+					&expiration,
+					types.GroupMemberStoreKey(2, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
+				)))
+				suite.Require().True(store.Has(types.ExpiringUserPermissionKey(
+					&expiration,
+					types.UserPermissionStoreKey(2, 0, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e"),
+				)))
+			},
+		},
+		{
+			name: "user grants are imported properly",
+			genesis: types.GenesisState{
+				Grants: []types.Grant{
+					types.NewGrant(
+						1,
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						types.NewUserGrantee("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+						&feegrant.BasicAllowance{
+							SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+							Expiration: &expiration,
+						},
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetUserGrant(ctx, 1, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewGrant(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewUserGrantee("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+					&feegrant.BasicAllowance{
+						SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
 						Expiration: &expiration,
 					},
 				), stored)
@@ -721,6 +1266,194 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 				)
 			},
 		},
+		{
+			name: "group invites and applications are imported properly",
+			genesis: types.GenesisState{
+				GroupInvites: []types.GroupInvite{
+					types.NewGroupInvite(
+						1,
+						1,
+						types.GetInviteSecretHash("secret"),
+						10,
+						2,
+						nil,
+						"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					),
+				},
+				GroupApplications: []types.GroupApplication{
+					types.NewGroupApplication(
+						1,
+						1,
+						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+						"Let me in",
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				invite, found := suite.k.GetGroupInvite(ctx, 1, types.GetInviteSecretHash("secret"))
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					2,
+					nil,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				), invite)
+
+				application, found := suite.k.GetGroupApplication(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				), application)
+			},
+		},
+		{
+			name: "subspace bans are imported properly",
+			genesis: types.GenesisState{
+				Bans: []types.SubspaceBan{
+					types.NewSubspaceBan(
+						1,
+						"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+						"Spam",
+						"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+						nil,
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				ban, found := suite.k.GetSubspaceBan(ctx, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSubspaceBan(
+					1,
+					"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+					"Spam",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					nil,
+				), ban)
+			},
+		},
+		{
+			name: "rate limits are imported properly",
+			genesis: types.GenesisState{
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				limit, found := suite.k.GetRateLimit(ctx, 1, "/desmos.posts.v3.MsgCreatePost")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour), limit)
+			},
+		},
+		{
+			name: "treasury proposals are imported properly",
+			genesis: types.GenesisState{
+				TreasuryVotingConfigs: []types.TreasuryVotingConfig{
+					types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+				},
+				TreasuryProposals: []types.TreasuryProposal{
+					types.NewTreasuryProposal(
+						1,
+						1,
+						"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+						[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+						1,
+						sdk.NewDecWithPrec(5, 1),
+						nil,
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+						types.TREASURY_PROPOSAL_STATUS_VOTING,
+						nil,
+					),
+				},
+				TreasuryVotes: []types.TreasuryVote{
+					types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				config, found := suite.k.GetTreasuryVotingConfig(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour), config)
+
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				), proposal)
+
+				// Make sure the next proposal id is set properly
+				suite.Require().Equal(uint64(2), suite.k.GetNextTreasuryProposalID(ctx, 1))
+
+				vote, found := suite.k.GetTreasuryVote(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES), vote)
+			},
+		},
+		{
+			name: "fee tokens configs are imported properly",
+			genesis: types.GenesisState{
+				FeeTokensConfigs: []types.FeeTokensConfig{
+					types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+						types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000)),
+					}, types.FEE_TOKENS_DESTINATION_TREASURY),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				config, found := suite.k.GetFeeTokensConfig(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000)),
+				}, types.FEE_TOKENS_DESTINATION_TREASURY), config)
+			},
+		},
+		{
+			name: "allowance usages are imported properly",
+			genesis: types.GenesisState{
+				AllowanceUsages: []types.AllowanceUsage{
+					types.NewAllowanceUsage(
+						1,
+						1,
+						"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+						time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+						sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+						[]types.MessageUsage{
+							types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 2),
+						},
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				usage, found := suite.k.GetAllowanceUsage(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+					[]types.MessageUsage{
+						types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 2),
+					},
+				), usage)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
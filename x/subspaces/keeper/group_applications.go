@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SaveGroupApplication saves the given group application inside the current context
+func (k Keeper) SaveGroupApplication(ctx sdk.Context, application types.GroupApplication) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GroupApplicationStoreKey(application.SubspaceID, application.GroupID, application.Applicant)
+	store.Set(key, k.cdc.MustMarshal(&application))
+
+	k.Logger(ctx).Info("group application saved",
+		"subspace id", application.SubspaceID,
+		"group id", application.GroupID,
+		"applicant", application.Applicant)
+}
+
+// HasGroupApplication tells whether the given user has a pending application to join the specified group
+func (k Keeper) HasGroupApplication(ctx sdk.Context, subspaceID uint64, groupID uint32, applicant string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GroupApplicationStoreKey(subspaceID, groupID, applicant))
+}
+
+// GetGroupApplication returns the application made by the given user to join the specified group.
+// If there is no application the function will return an empty application and false.
+func (k Keeper) GetGroupApplication(ctx sdk.Context, subspaceID uint64, groupID uint32, applicant string) (application types.GroupApplication, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.GroupApplicationStoreKey(subspaceID, groupID, applicant)
+	if !store.Has(key) {
+		return types.GroupApplication{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &application)
+	return application, true
+}
+
+// DeleteGroupApplication deletes the application made by the given user to join the specified group
+func (k Keeper) DeleteGroupApplication(ctx sdk.Context, subspaceID uint64, groupID uint32, applicant string) {
+	if !k.HasGroupApplication(ctx, subspaceID, groupID, applicant) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GroupApplicationStoreKey(subspaceID, groupID, applicant))
+
+	k.Logger(ctx).Info("group application deleted", "subspace id", subspaceID, "group id", groupID, "applicant", applicant)
+}
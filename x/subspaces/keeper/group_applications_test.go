@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveGroupApplication() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		application types.GroupApplication
+		check       func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing application is stored properly",
+			application: types.NewGroupApplication(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Let me in",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+			check: func(ctx sdk.Context) {
+				application, found := suite.k.GetGroupApplication(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				), application)
+			},
+		},
+		{
+			name: "existing application is overwritten properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			application: types.NewGroupApplication(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Please let me in",
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+			),
+			check: func(ctx sdk.Context) {
+				application, found := suite.k.GetGroupApplication(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Please let me in",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+				), application)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SaveGroupApplication(ctx, tc.application)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetGroupApplication() {
+	testCases := []struct {
+		name           string
+		store          func(ctx sdk.Context)
+		subspaceID     uint64
+		groupID        uint32
+		applicant      string
+		expFound       bool
+		expApplication types.GroupApplication
+	}{
+		{
+			name:       "not found application returns false",
+			subspaceID: 1,
+			groupID:    1,
+			applicant:  "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			expFound:   false,
+		},
+		{
+			name: "found application returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			subspaceID: 1,
+			groupID:    1,
+			applicant:  "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			expFound:   true,
+			expApplication: types.NewGroupApplication(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Let me in",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			application, found := suite.k.GetGroupApplication(ctx, tc.subspaceID, tc.groupID, tc.applicant)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.expApplication, application)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteGroupApplication() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		groupID    uint32
+		applicant  string
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing application is deleted properly",
+			subspaceID: 1,
+			groupID:    1,
+			applicant:  "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasGroupApplication(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+			},
+		},
+		{
+			name: "existing application is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			subspaceID: 1,
+			groupID:    1,
+			applicant:  "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasGroupApplication(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeleteGroupApplication(ctx, tc.subspaceID, tc.groupID, tc.applicant)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SaveGroupInvite saves the given group invite inside the current context
+func (k Keeper) SaveGroupInvite(ctx sdk.Context, invite types.GroupInvite) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GroupInviteStoreKey(invite.SubspaceID, invite.SecretHash)
+
+	// Update the expiring queue
+	k.saveGroupInviteToExpiringQueue(ctx, invite.ExpirationTime, key)
+
+	store.Set(key, k.cdc.MustMarshal(&invite))
+
+	k.Logger(ctx).Info("group invite saved", "subspace id", invite.SubspaceID, "group id", invite.GroupID)
+}
+
+// HasGroupInvite tells whether the invite associated with the given secret hash exists inside the provided subspace
+func (k Keeper) HasGroupInvite(ctx sdk.Context, subspaceID uint64, secretHash string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GroupInviteStoreKey(subspaceID, secretHash))
+}
+
+// GetGroupInvite returns the invite associated with the given secret hash inside the provided subspace.
+// If there is no invite associated with the given secret hash the function will return an empty invite and false.
+func (k Keeper) GetGroupInvite(ctx sdk.Context, subspaceID uint64, secretHash string) (invite types.GroupInvite, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.GroupInviteStoreKey(subspaceID, secretHash)
+	if !store.Has(key) {
+		return types.GroupInvite{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &invite)
+	return invite, true
+}
+
+// DeleteGroupInvite deletes the invite associated with the given secret hash inside the provided subspace
+func (k Keeper) DeleteGroupInvite(ctx sdk.Context, subspaceID uint64, secretHash string) {
+	if !k.HasGroupInvite(ctx, subspaceID, secretHash) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GroupInviteStoreKey(subspaceID, secretHash)
+
+	// Remove the invite from the expiring queue
+	k.removeGroupInviteFromExpiringQueue(ctx, key)
+
+	store.Delete(key)
+
+	k.Logger(ctx).Info("group invite deleted", "subspace id", subspaceID, "secret hash", secretHash)
+}
+
+// RedeemGroupInvite adds the given user to the group referenced by the provided invite, increasing its uses.
+// Once the invite reaches its maximum number of uses it is deleted.
+func (k Keeper) RedeemGroupInvite(ctx sdk.Context, invite types.GroupInvite, user string) {
+	k.AddUserToGroup(ctx, invite.SubspaceID, invite.GroupID, user, nil)
+
+	invite.Uses++
+	if invite.Uses >= invite.MaxUses {
+		k.DeleteGroupInvite(ctx, invite.SubspaceID, invite.SecretHash)
+		return
+	}
+
+	k.SaveGroupInvite(ctx, invite)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// saveGroupInviteToExpiringQueue saves the group invite stored with the given key into the expiring queue
+func (k Keeper) saveGroupInviteToExpiringQueue(ctx sdk.Context, expiration *time.Time, inviteKey []byte) {
+	// Make sure we remove the invite from the expiring queue to properly handle expiration updates and avoid duplicated keys
+	k.removeGroupInviteFromExpiringQueue(ctx, inviteKey)
+
+	store := ctx.KVStore(k.storeKey)
+	if expiration != nil {
+		store.Set(types.ExpiringGroupInviteKey(expiration, inviteKey), []byte{0x1})
+	}
+}
+
+// removeGroupInviteFromExpiringQueue removes the group invite stored with the given key from the expiring queue
+func (k Keeper) removeGroupInviteFromExpiringQueue(ctx sdk.Context, inviteKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	// Do nothing if the invite does not exist
+	if !store.Has(inviteKey) {
+		return
+	}
+
+	// Get the existing invite
+	var invite types.GroupInvite
+	k.cdc.MustUnmarshal(store.Get(inviteKey), &invite)
+
+	// Delete the invite from the expiring queue
+	if invite.ExpirationTime != nil {
+		store.Delete(types.ExpiringGroupInviteKey(invite.ExpirationTime, inviteKey))
+	}
+}
+
+// RemoveExpiredGroupInvites removes all the group invites that have expired before the given time
+func (k Keeper) RemoveExpiredGroupInvites(ctx sdk.Context, expiration time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ExpiringGroupInviteQueuePrefix, types.ExpiringGroupInviteTimePrefix(&expiration))
+
+	var expired []types.GroupInvite
+	for ; iterator.Valid(); iterator.Next() {
+		var invite types.GroupInvite
+		k.cdc.MustUnmarshal(store.Get(types.ParseGroupInviteKeyFromExpiringKey(iterator.Key())), &invite)
+		expired = append(expired, invite)
+	}
+	iterator.Close()
+
+	// Remove the invites outside the iteration since removing them also updates the queue
+	for _, invite := range expired {
+		k.DeleteGroupInvite(ctx, invite.SubspaceID, invite.SecretHash)
+	}
+}
@@ -0,0 +1,368 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveGroupInvite() {
+	testCases := []struct {
+		name   string
+		store  func(ctx sdk.Context)
+		invite types.GroupInvite
+		check  func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing invite is stored properly",
+			invite: types.NewGroupInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				0,
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			check: func(ctx sdk.Context) {
+				invite, found := suite.k.GetGroupInvite(ctx, 1, types.GetInviteSecretHash("secret"))
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				), invite)
+			},
+		},
+		{
+			name: "existing invite is overwritten properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			invite: types.NewGroupInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				5,
+				&expiration,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			check: func(ctx sdk.Context) {
+				invite, found := suite.k.GetGroupInvite(ctx, 1, types.GetInviteSecretHash("secret"))
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					5,
+					&expiration,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				), invite)
+
+				key := types.GroupInviteStoreKey(1, types.GetInviteSecretHash("secret"))
+				suite.Require().True(ctx.KVStore(suite.storeKey).Has(types.ExpiringGroupInviteKey(&expiration, key)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SaveGroupInvite(ctx, tc.invite)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetGroupInvite() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		secretHash string
+		expFound   bool
+		expInvite  types.GroupInvite
+	}{
+		{
+			name:       "not found invite returns false",
+			subspaceID: 1,
+			secretHash: types.GetInviteSecretHash("secret"),
+			expFound:   false,
+		},
+		{
+			name: "found invite returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			subspaceID: 1,
+			secretHash: types.GetInviteSecretHash("secret"),
+			expFound:   true,
+			expInvite: types.NewGroupInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				0,
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			invite, found := suite.k.GetGroupInvite(ctx, tc.subspaceID, tc.secretHash)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.expInvite, invite)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteGroupInvite() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		secretHash string
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing invite is deleted properly",
+			subspaceID: 1,
+			secretHash: types.GetInviteSecretHash("secret"),
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasGroupInvite(ctx, 1, types.GetInviteSecretHash("secret")))
+			},
+		},
+		{
+			name: "existing invite is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					&expiration,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			subspaceID: 1,
+			secretHash: types.GetInviteSecretHash("secret"),
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasGroupInvite(ctx, 1, types.GetInviteSecretHash("secret")))
+
+				key := types.GroupInviteStoreKey(1, types.GetInviteSecretHash("secret"))
+				suite.Require().False(ctx.KVStore(suite.storeKey).Has(types.ExpiringGroupInviteKey(&expiration, key)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeleteGroupInvite(ctx, tc.subspaceID, tc.secretHash)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RemoveExpiredGroupInvites() {
+	expiration := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		expiration time.Time
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name: "non expired invite is kept properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					&expiration,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			expiration: time.Date(2100, 7, 6, 0, 0, 0, 0, time.UTC),
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.HasGroupInvite(ctx, 1, types.GetInviteSecretHash("secret")))
+
+				key := types.GroupInviteStoreKey(1, types.GetInviteSecretHash("secret"))
+				suite.Require().True(ctx.KVStore(suite.storeKey).Has(types.ExpiringGroupInviteKey(&expiration, key)))
+			},
+		},
+		{
+			name: "expired invite is removed properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					&expiration,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			expiration: time.Date(2100, 7, 8, 0, 0, 0, 0, time.UTC),
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasGroupInvite(ctx, 1, types.GetInviteSecretHash("secret")))
+
+				key := types.GroupInviteStoreKey(1, types.GetInviteSecretHash("secret"))
+				suite.Require().False(ctx.KVStore(suite.storeKey).Has(types.ExpiringGroupInviteKey(&expiration, key)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.RemoveExpiredGroupInvites(ctx, tc.expiration)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RedeemGroupInvite() {
+	testCases := []struct {
+		name   string
+		store  func(ctx sdk.Context)
+		invite types.GroupInvite
+		user   string
+		check  func(ctx sdk.Context)
+	}{
+		{
+			name: "invite uses are increased properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			invite: types.NewGroupInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				0,
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			user: "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+
+				invite, found := suite.k.GetGroupInvite(ctx, 1, types.GetInviteSecretHash("secret"))
+				suite.Require().True(found)
+				suite.Require().Equal(uint32(1), invite.Uses)
+			},
+		},
+		{
+			name: "invite is deleted once it reaches its max uses",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					9,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			invite: types.NewGroupInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				9,
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			user: "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+				suite.Require().False(suite.k.HasGroupInvite(ctx, 1, types.GetInviteSecretHash("secret")))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.RedeemGroupInvite(ctx, tc.invite, tc.user)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -99,6 +101,25 @@ func (k Keeper) DeleteUserGroup(ctx sdk.Context, subspaceID uint64, groupID uint
 		return false
 	})
 
+	// Delete all the invites and pending applications of this group
+	k.IterateSubspaceGroupInvites(ctx, subspaceID, func(invite types.GroupInvite) (stop bool) {
+		if invite.GroupID == groupID {
+			k.DeleteGroupInvite(ctx, subspaceID, invite.SecretHash)
+		}
+		return false
+	})
+
+	k.IterateUserGroupApplications(ctx, subspaceID, groupID, func(application types.GroupApplication) (stop bool) {
+		k.DeleteGroupApplication(ctx, subspaceID, groupID, application.Applicant)
+		return false
+	})
+
+	// Delete the treasury voting config if this group was in charge of voting the treasury proposals
+	config, found := k.GetTreasuryVotingConfig(ctx, subspaceID)
+	if found && config.GroupID == groupID {
+		k.DeleteTreasuryVotingConfig(ctx, subspaceID)
+	}
+
 	// Delete the group
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GroupStoreKey(subspaceID, group.SectionID, group.ID))
@@ -110,12 +131,21 @@ func (k Keeper) DeleteUserGroup(ctx sdk.Context, subspaceID uint64, groupID uint
 // --------------------------------------------------------------------------------------------------------------------
 
 // AddUserToGroup adds the given user to the group having the provided id inside the specified subspace.
-func (k Keeper) AddUserToGroup(ctx sdk.Context, subspaceID uint64, groupID uint32, user string) {
+// If an expiration time is provided, the user will be removed from the group once it has passed.
+func (k Keeper) AddUserToGroup(ctx sdk.Context, subspaceID uint64, groupID uint32, user string, expirationTime *time.Time) {
 	// Create account if user does not exist.
 	k.createAccountIfNotExists(ctx, user)
 
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GroupMemberStoreKey(subspaceID, groupID, user), []byte{0x01})
+	key := types.GroupMemberStoreKey(subspaceID, groupID, user)
+
+	// Save the membership to the expiring queue
+	k.saveGroupMemberToExpiringQueue(ctx, expirationTime, key)
+
+	store.Set(key, types.GetGroupMemberValue(expirationTime))
+
+	// Remove any pending application since the user is now a member
+	k.DeleteGroupApplication(ctx, subspaceID, groupID, user)
 
 	k.AfterSubspaceGroupMemberAdded(ctx, subspaceID, groupID, user)
 }
@@ -132,10 +162,74 @@ func (k Keeper) IsMemberOfGroup(ctx sdk.Context, subspaceID uint64, groupID uint
 	return store.Has(types.GroupMemberStoreKey(subspaceID, groupID, user))
 }
 
+// GetUserGroupMemberExpirationTime returns the time after which the given user will be removed from the group
+// having the provided id inside the specified subspace, or nil if the membership does not expire.
+func (k Keeper) GetUserGroupMemberExpirationTime(ctx sdk.Context, subspaceID uint64, groupID uint32, user string) *time.Time {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GroupMemberStoreKey(subspaceID, groupID, user)
+	if !store.Has(key) {
+		return nil
+	}
+
+	return types.GetGroupMemberExpirationFromValue(store.Get(key))
+}
+
 // RemoveUserFromGroup removes the specified user from the subspace group having the given id.
 func (k Keeper) RemoveUserFromGroup(ctx sdk.Context, subspaceID uint64, groupID uint32, user string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GroupMemberStoreKey(subspaceID, groupID, user))
+	key := types.GroupMemberStoreKey(subspaceID, groupID, user)
+
+	// Remove the membership from the expiring queue
+	k.removeGroupMemberFromExpiringQueue(ctx, key)
+
+	store.Delete(key)
 
 	k.AfterSubspaceGroupMemberRemoved(ctx, subspaceID, groupID, user)
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// saveGroupMemberToExpiringQueue saves the group membership stored with the given key into the expiring queue
+func (k Keeper) saveGroupMemberToExpiringQueue(ctx sdk.Context, expiration *time.Time, memberKey []byte) {
+	// Make sure we remove the membership from the expiring queue to properly handle expiration updates and avoid duplicated keys
+	k.removeGroupMemberFromExpiringQueue(ctx, memberKey)
+
+	store := ctx.KVStore(k.storeKey)
+	if expiration != nil {
+		store.Set(types.ExpiringGroupMemberKey(expiration, memberKey), []byte{0x1})
+	}
+}
+
+// removeGroupMemberFromExpiringQueue removes the group membership stored with the given key from the expiring queue
+func (k Keeper) removeGroupMemberFromExpiringQueue(ctx sdk.Context, memberKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	// Do nothing if the membership does not exist
+	if !store.Has(memberKey) {
+		return
+	}
+
+	// Delete the membership from the expiring queue
+	expiration := types.GetGroupMemberExpirationFromValue(store.Get(memberKey))
+	if expiration != nil {
+		store.Delete(types.ExpiringGroupMemberKey(expiration, memberKey))
+	}
+}
+
+// RemoveExpiredGroupMembers removes all the group members whose membership has expired before the given time
+func (k Keeper) RemoveExpiredGroupMembers(ctx sdk.Context, expiration time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ExpiringGroupMemberQueuePrefix, types.ExpiringGroupMemberTimePrefix(&expiration))
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, types.ParseGroupMemberKeyFromExpiringKey(iterator.Key()))
+	}
+	iterator.Close()
+
+	// Remove the members outside the iteration since removing them also updates the queue
+	for _, memberKey := range expired {
+		subspaceID, groupID, user := types.SplitGroupMemberStoreKey(memberKey)
+		k.RemoveUserFromGroup(ctx, subspaceID, groupID, user)
+	}
+}
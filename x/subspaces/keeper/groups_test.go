@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 
@@ -387,8 +389,8 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteUserGroup() {
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
 
 				suite.k.SaveGrant(ctx, types.NewGrant(
 					1,
@@ -410,6 +412,64 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteUserGroup() {
 				suite.Require().Empty(groupGrants)
 			},
 		},
+		{
+			name: "existing group is deleted properly and invites and applications are cleared",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					2,
+					"Another test group",
+					"This is another test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					2,
+					types.GetInviteSecretHash("another-secret"),
+					10,
+					0,
+					nil,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			subspaceID: 1,
+			groupID:    1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasUserGroup(ctx, 1, 1))
+				suite.Require().False(suite.k.HasGroupInvite(ctx, 1, types.GetInviteSecretHash("secret")))
+				suite.Require().False(suite.k.HasGroupApplication(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+
+				// Make sure the invites of other groups are not deleted
+				suite.Require().True(suite.k.HasGroupInvite(ctx, 1, types.GetInviteSecretHash("another-secret")))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -437,6 +497,7 @@ func (suite *KeeperTestSuite) TestKeeper_AddUserToGroup() {
 		subspaceID uint64
 		groupID    uint32
 		user       string
+		expiration *time.Time
 		check      func(ctx sdk.Context)
 	}{
 		{
@@ -459,6 +520,91 @@ func (suite *KeeperTestSuite) TestKeeper_AddUserToGroup() {
 				suite.Require().True(isMember)
 			},
 		},
+		{
+			name: "user with expiration is added properly to group and expiring queue",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			subspaceID: 1,
+			groupID:    1,
+			user:       "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+			expiration: &expiration,
+			check: func(ctx sdk.Context) {
+				isMember := suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().True(isMember)
+
+				stored := suite.k.GetUserGroupMemberExpirationTime(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().Equal(&expiration, stored)
+
+				store := ctx.KVStore(suite.storeKey)
+				key := types.GroupMemberStoreKey(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().True(store.Has(types.ExpiringGroupMemberKey(&expiration, key)))
+			},
+		},
+		{
+			name: "existing expiration is removed from the expiring queue when overridden",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", &expiration)
+			},
+			subspaceID: 1,
+			groupID:    1,
+			user:       "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+			check: func(ctx sdk.Context) {
+				stored := suite.k.GetUserGroupMemberExpirationTime(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().Nil(stored)
+
+				store := ctx.KVStore(suite.storeKey)
+				key := types.GroupMemberStoreKey(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().False(store.Has(types.ExpiringGroupMemberKey(&expiration, key)))
+			},
+		},
+		{
+			name: "pending application is removed when the user is added to the group",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			subspaceID: 1,
+			groupID:    1,
+			user:       "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+			check: func(ctx sdk.Context) {
+				isMember := suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().True(isMember)
+
+				hasApplication := suite.k.HasGroupApplication(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().False(hasApplication)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -469,7 +615,7 @@ func (suite *KeeperTestSuite) TestKeeper_AddUserToGroup() {
 				tc.store(ctx)
 			}
 
-			suite.k.AddUserToGroup(ctx, tc.subspaceID, tc.groupID, tc.user)
+			suite.k.AddUserToGroup(ctx, tc.subspaceID, tc.groupID, tc.user, tc.expiration)
 			if tc.check != nil {
 				tc.check(ctx)
 			}
@@ -514,7 +660,7 @@ func (suite *KeeperTestSuite) TestKeeper_IsMemberOfGroup() {
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
 			},
 			subspaceID: 1,
 			groupID:    1,
@@ -568,7 +714,7 @@ func (suite *KeeperTestSuite) TestKeeper_RemoveUserFromGroup() {
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
 			},
 			subspaceID: 1,
 			groupID:    1,
@@ -578,6 +724,32 @@ func (suite *KeeperTestSuite) TestKeeper_RemoveUserFromGroup() {
 				suite.Require().False(isMember)
 			},
 		},
+		{
+			name: "expiring user is removed from the expiring queue",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", &expiration)
+			},
+			subspaceID: 1,
+			groupID:    1,
+			user:       "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+			check: func(ctx sdk.Context) {
+				isMember := suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().False(isMember)
+
+				store := ctx.KVStore(suite.storeKey)
+				key := types.GroupMemberStoreKey(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().False(store.Has(types.ExpiringGroupMemberKey(&expiration, key)))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
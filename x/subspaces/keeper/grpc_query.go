@@ -54,6 +54,42 @@ func (k Keeper) Subspace(ctx context.Context, request *types.QuerySubspaceReques
 	return &types.QuerySubspaceResponse{Subspace: subspace}, nil
 }
 
+// IncomingSubspaceOwnerTransferRequests implements the Query/IncomingSubspaceOwnerTransferRequests gRPC method
+func (k Keeper) IncomingSubspaceOwnerTransferRequests(ctx context.Context, request *types.QueryIncomingSubspaceOwnerTransferRequestsRequest) (*types.QueryIncomingSubspaceOwnerTransferRequestsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	store := sdkCtx.KVStore(k.storeKey)
+	requestsStore := prefix.NewStore(store, types.SubspaceOwnerTransferRequestPrefix)
+
+	var requests []types.SubspaceOwnerTransferRequest
+	pageRes, err := query.FilteredPaginate(requestsStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var transferRequest types.SubspaceOwnerTransferRequest
+		if err := k.cdc.Unmarshal(value, &transferRequest); err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+
+		// Filter out the requests whose receiver does not match the given one
+		if request.Receiver != "" && request.Receiver != transferRequest.Receiver {
+			return false, nil
+		}
+
+		if accumulate {
+			requests = append(requests, transferRequest)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIncomingSubspaceOwnerTransferRequestsResponse{
+		Requests:   requests,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Sections implements the Query/Sections gRPC method
 func (k Keeper) Sections(ctx context.Context, request *types.QuerySectionsRequest) (*types.QuerySectionsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -187,6 +223,184 @@ func (k Keeper) UserGroupMembers(ctx context.Context, request *types.QueryUserGr
 	return &types.QueryUserGroupMembersResponse{Members: members, Pagination: pageRes}, nil
 }
 
+// GroupInvites implements the Query/GroupInvites gRPC method
+func (k Keeper) GroupInvites(ctx context.Context, request *types.QueryGroupInvitesRequest) (*types.QueryGroupInvitesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(sdkCtx, request.SubspaceId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", request.SubspaceId)
+	}
+
+	store := sdkCtx.KVStore(k.storeKey)
+	invitesStore := prefix.NewStore(store, types.SubspaceGroupInvitesPrefix(request.SubspaceId))
+
+	var invites []types.GroupInvite
+	pageRes, err := query.FilteredPaginate(invitesStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var invite types.GroupInvite
+		if err := k.cdc.Unmarshal(value, &invite); err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+
+		// Filter out the invites whose group does not match the given one
+		if request.GroupId != 0 && request.GroupId != invite.GroupID {
+			return false, nil
+		}
+
+		if accumulate {
+			invites = append(invites, invite)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupInvitesResponse{Invites: invites, Pagination: pageRes}, nil
+}
+
+// GroupInvite implements the Query/GroupInvite gRPC method
+func (k Keeper) GroupInvite(ctx context.Context, request *types.QueryGroupInviteRequest) (*types.QueryGroupInviteResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(sdkCtx, request.SubspaceId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", request.SubspaceId)
+	}
+
+	// Get the invite
+	invite, found := k.GetGroupInvite(sdkCtx, request.SubspaceId, request.SecretHash)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "invite could not be found")
+	}
+
+	return &types.QueryGroupInviteResponse{Invite: invite}, nil
+}
+
+// GroupApplications implements the Query/GroupApplications gRPC method
+func (k Keeper) GroupApplications(ctx context.Context, request *types.QueryGroupApplicationsRequest) (*types.QueryGroupApplicationsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(sdkCtx, request.SubspaceId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", request.SubspaceId)
+	}
+
+	// Check if the group exists
+	if !k.HasUserGroup(sdkCtx, request.SubspaceId, request.GroupId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "group %d could not be found", request.GroupId)
+	}
+
+	store := sdkCtx.KVStore(k.storeKey)
+	applicationsStore := prefix.NewStore(store, types.GroupApplicationsPrefix(request.SubspaceId, request.GroupId))
+
+	var applications []types.GroupApplication
+	pageRes, err := query.Paginate(applicationsStore, request.Pagination, func(key []byte, value []byte) error {
+		var application types.GroupApplication
+		err := k.cdc.Unmarshal(value, &application)
+		if err != nil {
+			return err
+		}
+
+		applications = append(applications, application)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupApplicationsResponse{Applications: applications, Pagination: pageRes}, nil
+}
+
+// GroupApplication implements the Query/GroupApplication gRPC method
+func (k Keeper) GroupApplication(ctx context.Context, request *types.QueryGroupApplicationRequest) (*types.QueryGroupApplicationResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(sdkCtx, request.SubspaceId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", request.SubspaceId)
+	}
+
+	// Get the application
+	application, found := k.GetGroupApplication(sdkCtx, request.SubspaceId, request.GroupId, request.Applicant)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "application could not be found")
+	}
+
+	return &types.QueryGroupApplicationResponse{Application: application}, nil
+}
+
+// SubspaceBans implements the Query/SubspaceBans gRPC method
+func (k Keeper) SubspaceBans(ctx context.Context, request *types.QuerySubspaceBansRequest) (*types.QuerySubspaceBansResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(sdkCtx, request.SubspaceId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", request.SubspaceId)
+	}
+
+	store := sdkCtx.KVStore(k.storeKey)
+	bansStore := prefix.NewStore(store, types.SubspaceBansPrefix(request.SubspaceId))
+
+	var bans []types.SubspaceBan
+	pageRes, err := query.FilteredPaginate(bansStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var ban types.SubspaceBan
+		if err := k.cdc.Unmarshal(value, &ban); err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+
+		// Filter out the bans that have already expired
+		if ban.IsExpired(sdkCtx.BlockTime()) {
+			return false, nil
+		}
+
+		if accumulate {
+			bans = append(bans, ban)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySubspaceBansResponse{Bans: bans, Pagination: pageRes}, nil
+}
+
+// RateLimits implements the Query/RateLimits gRPC method
+func (k Keeper) RateLimits(ctx context.Context, request *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(sdkCtx, request.SubspaceId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", request.SubspaceId)
+	}
+
+	store := sdkCtx.KVStore(k.storeKey)
+	limitsStore := prefix.NewStore(store, types.SubspaceRateLimitsPrefix(request.SubspaceId))
+
+	var limits []types.RateLimit
+	pageRes, err := query.Paginate(limitsStore, request.Pagination, func(key []byte, value []byte) error {
+		var limit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &limit); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		limits = append(limits, limit)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: limits, Pagination: pageRes}, nil
+}
+
 // UserPermissions implements the Query/UserPermissions gRPC method
 func (k Keeper) UserPermissions(ctx context.Context, request *types.QueryUserPermissionsRequest) (*types.QueryUserPermissionsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -220,6 +434,32 @@ func (k Keeper) UserPermissions(ctx context.Context, request *types.QueryUserPer
 	}, nil
 }
 
+// PermissionDetails implements the Query/PermissionDetails gRPC method
+func (k Keeper) PermissionDetails(ctx context.Context, request *types.QueryPermissionDetailsRequest) (*types.QueryPermissionDetailsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(sdkCtx, request.SubspaceId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", request.SubspaceId)
+	}
+
+	// Check if the section exists
+	if !k.HasSection(sdkCtx, request.SubspaceId, request.SectionId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "section with id %d not found", request.SectionId)
+	}
+
+	// Make sure the permission is valid
+	if !types.ArePermissionsValid(types.NewPermissions(request.Permission)) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid permission: %s", request.Permission)
+	}
+
+	return &types.QueryPermissionDetailsResponse{
+		HasPermission: k.HasPermission(sdkCtx, request.SubspaceId, request.SectionId, request.User, request.Permission),
+		Banned:        k.IsUserBanned(sdkCtx, request.SubspaceId, request.User),
+		Sources:       k.GetPermissionSources(sdkCtx, request.SubspaceId, request.SectionId, request.User, request.Permission),
+	}, nil
+}
+
 // UserAllowances implements the Query/UserAllowances gRPC method
 func (k Keeper) UserAllowances(ctx context.Context, request *types.QueryUserAllowancesRequest) (*types.QueryUserAllowancesResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -296,3 +536,147 @@ func (k Keeper) GroupAllowances(ctx context.Context, request *types.QueryGroupAl
 		Pagination: pageRes,
 	}, nil
 }
+
+// AllowanceUsages implements the Query/AllowanceUsages gRPC method
+func (k Keeper) AllowanceUsages(ctx context.Context, request *types.QueryAllowanceUsagesRequest) (*types.QueryAllowanceUsagesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(k.storeKey)
+
+	if request.SubspaceId == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid subspace id %d", request.SubspaceId)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(request.Grantee); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid grantee address %s", request.Grantee)
+	}
+
+	// Check if the subspace exists
+	if !k.HasSubspace(sdkCtx, request.SubspaceId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", request.SubspaceId)
+	}
+
+	usagesStore := prefix.NewStore(store, types.SubspaceAllowanceUsagesPrefix(request.SubspaceId))
+
+	var usages []types.AllowanceUsage
+	pageRes, err := query.FilteredPaginate(usagesStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var usage types.AllowanceUsage
+		if err := k.cdc.Unmarshal(value, &usage); err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+
+		// Filter out the usages made by other grantees
+		if usage.Grantee != request.Grantee {
+			return false, nil
+		}
+
+		if accumulate {
+			usages = append(usages, usage)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowanceUsagesResponse{
+		Usages:     usages,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FeeTokensConfig implements the Query/FeeTokensConfig gRPC method
+func (k Keeper) FeeTokensConfig(ctx context.Context, request *types.QueryFeeTokensConfigRequest) (*types.QueryFeeTokensConfigResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	config, found := k.GetFeeTokensConfig(sdkCtx, request.SubspaceId)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "fee tokens config for subspace %d not found", request.SubspaceId)
+	}
+
+	return &types.QueryFeeTokensConfigResponse{Config: config}, nil
+}
+
+// TreasuryVotingConfig implements the Query/TreasuryVotingConfig gRPC method
+func (k Keeper) TreasuryVotingConfig(ctx context.Context, request *types.QueryTreasuryVotingConfigRequest) (*types.QueryTreasuryVotingConfigResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	config, found := k.GetTreasuryVotingConfig(sdkCtx, request.SubspaceId)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "treasury voting config for subspace %d not found", request.SubspaceId)
+	}
+
+	return &types.QueryTreasuryVotingConfigResponse{Config: config}, nil
+}
+
+// TreasuryProposals implements the Query/TreasuryProposals gRPC method
+func (k Keeper) TreasuryProposals(ctx context.Context, request *types.QueryTreasuryProposalsRequest) (*types.QueryTreasuryProposalsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(sdkCtx, request.SubspaceId) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", request.SubspaceId)
+	}
+
+	store := sdkCtx.KVStore(k.storeKey)
+	proposalsStore := prefix.NewStore(store, types.SubspaceTreasuryProposalsPrefix(request.SubspaceId))
+
+	var proposals []types.TreasuryProposal
+	pageRes, err := query.Paginate(proposalsStore, request.Pagination, func(key []byte, value []byte) error {
+		var proposal types.TreasuryProposal
+		if err := k.cdc.Unmarshal(value, &proposal); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		proposals = append(proposals, proposal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTreasuryProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// TreasuryProposal implements the Query/TreasuryProposal gRPC method
+func (k Keeper) TreasuryProposal(ctx context.Context, request *types.QueryTreasuryProposalRequest) (*types.QueryTreasuryProposalResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	proposal, found := k.GetTreasuryProposal(sdkCtx, request.SubspaceId, request.ProposalId)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "treasury proposal with id %d not found", request.ProposalId)
+	}
+
+	return &types.QueryTreasuryProposalResponse{Proposal: proposal}, nil
+}
+
+// TreasuryProposalVotes implements the Query/TreasuryProposalVotes gRPC method
+func (k Keeper) TreasuryProposalVotes(ctx context.Context, request *types.QueryTreasuryProposalVotesRequest) (*types.QueryTreasuryProposalVotesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Check if the proposal exists
+	if !k.HasTreasuryProposal(sdkCtx, request.SubspaceId, request.ProposalId) {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "treasury proposal with id %d not found", request.ProposalId)
+	}
+
+	store := sdkCtx.KVStore(k.storeKey)
+	votesStore := prefix.NewStore(store, types.TreasuryProposalVotesPrefix(request.SubspaceId, request.ProposalId))
+
+	var votes []types.TreasuryVote
+	pageRes, err := query.Paginate(votesStore, request.Pagination, func(key []byte, value []byte) error {
+		var vote types.TreasuryVote
+		if err := k.cdc.Unmarshal(value, &vote); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		votes = append(votes, vote)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTreasuryProposalVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
@@ -150,6 +150,74 @@ func (suite *KeeperTestSuite) TestQueryServer_Subspace() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryServer_IncomingSubspaceOwnerTransferRequests() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		request     *types.QueryIncomingSubspaceOwnerTransferRequestsRequest
+		shouldErr   bool
+		expRequests []types.SubspaceOwnerTransferRequest
+	}{
+		{
+			name: "requests without receiver are returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(2, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"))
+			},
+			request:   types.NewQueryIncomingSubspaceOwnerTransferRequestsRequest("", nil),
+			shouldErr: false,
+			expRequests: []types.SubspaceOwnerTransferRequest{
+				types.NewSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+				types.NewSubspaceOwnerTransferRequest(2, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			},
+		},
+		{
+			name: "requests with receiver are returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(2, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"))
+			},
+			request:   types.NewQueryIncomingSubspaceOwnerTransferRequestsRequest("cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil),
+			shouldErr: false,
+			expRequests: []types.SubspaceOwnerTransferRequest{
+				types.NewSubspaceOwnerTransferRequest(2, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			},
+		},
+		{
+			name: "requests with pagination are returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(2, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"))
+			},
+			request: types.NewQueryIncomingSubspaceOwnerTransferRequestsRequest("", &query.PageRequest{
+				Limit: 1,
+			}),
+			shouldErr: false,
+			expRequests: []types.SubspaceOwnerTransferRequest{
+				types.NewSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			response, err := suite.k.IncomingSubspaceOwnerTransferRequests(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRequests, response.Requests)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryServer_Sections() {
 	testCases := []struct {
 		name        string
@@ -183,6 +251,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Sections() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 
 				suite.k.SaveSection(ctx, types.NewSection(
@@ -191,6 +260,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Sections() {
 					0,
 					"Another test section",
 					"Another test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			req:       types.NewQuerySectionsRequest(1, nil),
@@ -203,6 +273,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Sections() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				),
 				types.NewSection(
 					1,
@@ -210,6 +281,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Sections() {
 					0,
 					"Another test section",
 					"Another test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				),
 			},
 		},
@@ -233,6 +305,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Sections() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 
 				suite.k.SaveSection(ctx, types.NewSection(
@@ -241,6 +314,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Sections() {
 					0,
 					"Another test section",
 					"Another test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			req: types.NewQuerySectionsRequest(1, &query.PageRequest{
@@ -255,6 +329,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Sections() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				),
 			},
 		},
@@ -329,6 +404,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Section() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			req:       types.NewQuerySectionRequest(1, 1),
@@ -339,6 +415,7 @@ func (suite *KeeperTestSuite) TestQueryServer_Section() {
 				0,
 				"Test section",
 				"Test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 		},
 	}
@@ -632,9 +709,9 @@ func (suite *KeeperTestSuite) TestQueryServer_UserGroupMembers() {
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
 			},
 			req: types.NewQueryUserGroupMembersRequest(1, 1, &query.PageRequest{
 				Offset: 1,
@@ -664,52 +741,21 @@ func (suite *KeeperTestSuite) TestQueryServer_UserGroupMembers() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryServer_UserPermissions() {
+func (suite *KeeperTestSuite) TestQueryServer_GroupInvites() {
 	testCases := []struct {
-		name        string
-		store       func(ctx sdk.Context)
-		req         *types.QueryUserPermissionsRequest
-		shouldErr   bool
-		expResponse types.QueryUserPermissionsResponse
+		name       string
+		store      func(ctx sdk.Context)
+		req        *types.QueryGroupInvitesRequest
+		shouldErr  bool
+		expInvites []types.GroupInvite
 	}{
 		{
-			name:      "not found subspace returns error",
+			name:      "non existing subspace returns error",
+			req:       types.NewQueryGroupInvitesRequest(1, 0, nil),
 			shouldErr: true,
-			req: types.NewQueryUserPermissionsRequest(
-				1,
-				types.RootSectionID,
-				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-			),
-		},
-		{
-			name: "not found user returns the permission from the default group",
-			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
-					"Test subspace",
-					"This is a test subspace",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
-			},
-			req: types.NewQueryUserPermissionsRequest(
-				1,
-				types.RootSectionID,
-				"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
-			),
-			shouldErr: false,
-			expResponse: types.QueryUserPermissionsResponse{
-				Permissions: nil,
-				Details: []types.PermissionDetail{
-					types.NewPermissionDetailGroup(1, 0, 0, nil),
-				},
-			},
 		},
 		{
-			name: "existing permissions are returned correctly",
+			name: "invites filtered by group are returned properly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
@@ -722,51 +768,37 @@ func (suite *KeeperTestSuite) TestQueryServer_UserPermissions() {
 					nil,
 				))
 
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
 					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e")
-
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
 					1,
+					types.GetInviteSecretHash("secret"),
+					10,
 					0,
-					2,
-					"Another test group",
-					"This is another test group",
-					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 				))
-				suite.k.AddUserToGroup(ctx,
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
 					1,
 					2,
-					"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
-				)
-
-				suite.k.SetUserPermissions(ctx,
-					1,
+					types.GetInviteSecretHash("another-secret"),
+					10,
 					0,
-					"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
-					types.NewPermissions(types.PermissionDeleteSubspace),
-				)
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
 			},
-			req: types.NewQueryUserPermissionsRequest(
-				1,
-				types.RootSectionID,
-				"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
-			),
+			req:       types.NewQueryGroupInvitesRequest(1, 2, nil),
 			shouldErr: false,
-			expResponse: types.QueryUserPermissionsResponse{
-				Permissions: types.CombinePermissions(types.PermissionDeleteSubspace, types.PermissionEditSubspace, types.PermissionSetPermissions),
-				Details: []types.PermissionDetail{
-					types.NewPermissionDetailUser(1, 0, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e", types.NewPermissions(types.PermissionDeleteSubspace)),
-					types.NewPermissionDetailGroup(1, 0, 0, nil),
-					types.NewPermissionDetailGroup(1, 0, 1, types.NewPermissions(types.PermissionEditSubspace)),
-					types.NewPermissionDetailGroup(1, 0, 2, types.NewPermissions(types.PermissionSetPermissions)),
-				},
+			expInvites: []types.GroupInvite{
+				types.NewGroupInvite(
+					1,
+					2,
+					types.GetInviteSecretHash("another-secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				),
 			},
 		},
 	}
@@ -779,100 +811,82 @@ func (suite *KeeperTestSuite) TestQueryServer_UserPermissions() {
 				tc.store(ctx)
 			}
 
-			res, err := suite.k.UserPermissions(sdk.WrapSDKContext(ctx), tc.req)
+			res, err := suite.k.GroupInvites(sdk.WrapSDKContext(ctx), tc.req)
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
-				suite.Require().Equal(tc.expResponse.Permissions, res.Permissions)
-				suite.Require().Equal(tc.expResponse.Details, res.Details)
+				suite.Require().Equal(tc.expInvites, res.Invites)
 			}
 		})
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryServer_UserAllowances() {
+func (suite *KeeperTestSuite) TestQueryServer_GroupInvite() {
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
-		req       *types.QueryUserAllowancesRequest
+		req       *types.QueryGroupInviteRequest
 		shouldErr bool
-		expGrants []types.Grant
+		expInvite types.GroupInvite
 	}{
 		{
-			name:      "invalid subspace id returns error",
-			req:       types.NewQueryUserAllowancesRequest(0, "", nil),
-			shouldErr: true,
-		},
-		{
-			name:      "not found subspace returns error",
-			req:       types.NewQueryUserAllowancesRequest(1, "", nil),
+			name:      "non existing subspace returns error",
+			req:       types.NewQueryGroupInviteRequest(1, types.GetInviteSecretHash("secret")),
 			shouldErr: true,
 		},
 		{
-			name: "user grants query without grantee returns the correct data",
+			name: "not found invite returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1, "test", "test", "owner", "treasury", "creator", time.Now(), nil))
-
-				suite.k.SaveGrant(ctx, types.NewGrant(
-					1,
-					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
-					types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
-					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
-				))
-
-				suite.k.SaveGrant(ctx, types.NewGrant(
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
-					types.NewUserGrantee("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
-					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
 				))
 			},
-			req:       types.NewQueryUserAllowancesRequest(1, "", nil),
-			shouldErr: false,
-			expGrants: []types.Grant{
-				types.NewGrant(
-					1,
-					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
-					types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
-					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
-				),
-				types.NewGrant(
-					1,
-					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
-					types.NewUserGrantee("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
-					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
-				),
-			},
+			req:       types.NewQueryGroupInviteRequest(1, types.GetInviteSecretHash("secret")),
+			shouldErr: true,
 		},
 		{
-			name: "valid query returns the correct data",
+			name: "found invite is returned properly",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1, "test", "test", "owner", "treasury", "creator", time.Now(), nil))
-
-				suite.k.SaveGrant(ctx, types.NewGrant(1,
-					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
-					types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
-					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
 				))
 
-				suite.k.SaveGrant(ctx, types.NewGrant(
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
 					1,
-					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
-					types.NewUserGrantee("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
-					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 				))
 			},
-			req:       types.NewQueryUserAllowancesRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil),
+			req:       types.NewQueryGroupInviteRequest(1, types.GetInviteSecretHash("secret")),
 			shouldErr: false,
-			expGrants: []types.Grant{
-				types.NewGrant(
-					1,
-					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
-					types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
-					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
-				),
-			},
+			expInvite: types.NewGroupInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				0,
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
 		},
 	}
 
@@ -884,43 +898,733 @@ func (suite *KeeperTestSuite) TestQueryServer_UserAllowances() {
 				tc.store(ctx)
 			}
 
-			res, err := suite.k.UserAllowances(sdk.WrapSDKContext(ctx), tc.req)
+			res, err := suite.k.GroupInvite(sdk.WrapSDKContext(ctx), tc.req)
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
-				suite.Require().Equal(tc.expGrants, res.Grants)
+				suite.Require().Equal(tc.expInvite, res.Invite)
 			}
 		})
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryServer_GroupAllowances() {
+func (suite *KeeperTestSuite) TestQueryServer_GroupApplications() {
 	testCases := []struct {
-		name      string
-		store     func(ctx sdk.Context)
-		req       *types.QueryGroupAllowancesRequest
-		shouldErr bool
-		expGrants []types.Grant
+		name            string
+		store           func(ctx sdk.Context)
+		req             *types.QueryGroupApplicationsRequest
+		shouldErr       bool
+		expApplications []types.GroupApplication
 	}{
 		{
-			name:      "invalid subspace id returns error",
-			req:       types.NewQueryGroupAllowancesRequest(0, 1, nil),
+			name:      "non existing subspace returns error",
+			req:       types.NewQueryGroupApplicationsRequest(1, 1, nil),
 			shouldErr: true,
 		},
 		{
-			name:      "not found subspace returns error",
-			req:       types.NewQueryGroupAllowancesRequest(1, 1, nil),
+			name: "non existing group returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			req:       types.NewQueryGroupApplicationsRequest(1, 1, nil),
 			shouldErr: true,
 		},
 		{
-			name: "group grants query without group id returns the correct data",
+			name: "existing group applications are returned properly",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1, "test", "test", "owner", "treasury", "creator", time.Now(), nil))
-
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "test", "tets", nil))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 2, "test", "tets", nil))
-
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			req:       types.NewQueryGroupApplicationsRequest(1, 1, nil),
+			shouldErr: false,
+			expApplications: []types.GroupApplication{
+				types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.GroupApplications(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expApplications, res.Applications)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_GroupApplication() {
+	testCases := []struct {
+		name           string
+		store          func(ctx sdk.Context)
+		req            *types.QueryGroupApplicationRequest
+		shouldErr      bool
+		expApplication types.GroupApplication
+	}{
+		{
+			name:      "non existing subspace returns error",
+			req:       types.NewQueryGroupApplicationRequest(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+			shouldErr: true,
+		},
+		{
+			name: "not found application returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			req:       types.NewQueryGroupApplicationRequest(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+			shouldErr: true,
+		},
+		{
+			name: "found application is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			req:       types.NewQueryGroupApplicationRequest(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+			shouldErr: false,
+			expApplication: types.NewGroupApplication(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Let me in",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.GroupApplication(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expApplication, res.Application)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_SubspaceBans() {
+	testCases := []struct {
+		name      string
+		setupCtx  func(ctx sdk.Context) sdk.Context
+		store     func(ctx sdk.Context)
+		req       *types.QuerySubspaceBansRequest
+		shouldErr bool
+		expBans   []types.SubspaceBan
+	}{
+		{
+			name:      "non existing subspace returns error",
+			req:       types.NewQuerySubspaceBansRequest(1, nil),
+			shouldErr: true,
+		},
+		{
+			name: "active bans are returned properly",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				))
+
+				expiration := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					&expiration,
+				))
+			},
+			req:       types.NewQuerySubspaceBansRequest(1, nil),
+			shouldErr: false,
+			expBans: []types.SubspaceBan{
+				types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.setupCtx != nil {
+				ctx = tc.setupCtx(ctx)
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.SubspaceBans(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBans, res.Bans)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_RateLimits() {
+	testCases := []struct {
+		name          string
+		store         func(ctx sdk.Context)
+		req           *types.QueryRateLimitsRequest
+		shouldErr     bool
+		expRateLimits []types.RateLimit
+	}{
+		{
+			name:      "non existing subspace returns error",
+			req:       types.NewQueryRateLimitsRequest(1, nil),
+			shouldErr: true,
+		},
+		{
+			name: "rate limits are returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(2, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour))
+			},
+			req:       types.NewQueryRateLimitsRequest(1, nil),
+			shouldErr: false,
+			expRateLimits: []types.RateLimit{
+				types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.RateLimits(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRateLimits, res.RateLimits)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_UserPermissions() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		req         *types.QueryUserPermissionsRequest
+		shouldErr   bool
+		expResponse types.QueryUserPermissionsResponse
+	}{
+		{
+			name:      "not found subspace returns error",
+			shouldErr: true,
+			req: types.NewQueryUserPermissionsRequest(
+				1,
+				types.RootSectionID,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+		},
+		{
+			name: "not found user returns the permission from the default group",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			req: types.NewQueryUserPermissionsRequest(
+				1,
+				types.RootSectionID,
+				"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+			),
+			shouldErr: false,
+			expResponse: types.QueryUserPermissionsResponse{
+				Permissions: nil,
+				Details: []types.PermissionDetail{
+					types.NewPermissionDetailGroup(1, 0, 0, nil),
+				},
+			},
+		},
+		{
+			name: "existing permissions are returned correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e", nil)
+
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					2,
+					"Another test group",
+					"This is another test group",
+					types.NewPermissions(types.PermissionSetPermissions),
+				))
+				suite.k.AddUserToGroup(ctx,
+					1,
+					2,
+					"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+					nil,
+				)
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+					types.NewPermissions(types.PermissionDeleteSubspace),
+					nil,
+				)
+			},
+			req: types.NewQueryUserPermissionsRequest(
+				1,
+				types.RootSectionID,
+				"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+			),
+			shouldErr: false,
+			expResponse: types.QueryUserPermissionsResponse{
+				Permissions: types.CombinePermissions(types.PermissionDeleteSubspace, types.PermissionEditSubspace, types.PermissionSetPermissions),
+				Details: []types.PermissionDetail{
+					types.NewPermissionDetailUser(1, 0, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e", types.NewPermissions(types.PermissionDeleteSubspace)),
+					types.NewPermissionDetailGroup(1, 0, 0, nil),
+					types.NewPermissionDetailGroup(1, 0, 1, types.NewPermissions(types.PermissionEditSubspace)),
+					types.NewPermissionDetailGroup(1, 0, 2, types.NewPermissions(types.PermissionSetPermissions)),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.UserPermissions(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResponse.Permissions, res.Permissions)
+				suite.Require().Equal(tc.expResponse.Details, res.Details)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_PermissionDetails() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		req         *types.QueryPermissionDetailsRequest
+		shouldErr   bool
+		expResponse *types.QueryPermissionDetailsResponse
+	}{
+		{
+			name:      "non existing subspace returns error",
+			req:       types.NewQueryPermissionDetailsRequest(1, 0, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", types.PermissionManageGroups),
+			shouldErr: true,
+		},
+		{
+			name: "non existing section returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			req:       types.NewQueryPermissionDetailsRequest(1, 1, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", types.PermissionManageGroups),
+			shouldErr: true,
+		},
+		{
+			name: "invalid permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			req:       types.NewQueryPermissionDetailsRequest(1, 0, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", "NON_EXISTING"),
+			shouldErr: true,
+		},
+		{
+			name: "permission sources are returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionManageGroups),
+				))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", nil)
+			},
+			req:       types.NewQueryPermissionDetailsRequest(1, 0, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", types.PermissionManageGroups),
+			shouldErr: false,
+			expResponse: &types.QueryPermissionDetailsResponse{
+				HasPermission: true,
+				Banned:        false,
+				Sources: []types.PermissionSource{
+					types.NewPermissionSourceGroup(0, false, 1, types.NewPermissions(types.PermissionManageGroups)),
+				},
+			},
+		},
+		{
+			name: "banned user returns the sources without the permission",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				))
+			},
+			req:       types.NewQueryPermissionDetailsRequest(1, 0, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", types.PermissionManageGroups),
+			shouldErr: false,
+			expResponse: &types.QueryPermissionDetailsResponse{
+				HasPermission: false,
+				Banned:        true,
+				Sources: []types.PermissionSource{
+					types.NewPermissionSourceUser(0, false, types.NewPermissions(types.PermissionManageGroups)),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.PermissionDetails(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResponse, res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_UserAllowances() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryUserAllowancesRequest
+		shouldErr bool
+		expGrants []types.Grant
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			req:       types.NewQueryUserAllowancesRequest(0, "", nil),
+			shouldErr: true,
+		},
+		{
+			name:      "not found subspace returns error",
+			req:       types.NewQueryUserAllowancesRequest(1, "", nil),
+			shouldErr: true,
+		},
+		{
+			name: "user grants query without grantee returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1, "test", "test", "owner", "treasury", "creator", time.Now(), nil))
+
+				suite.k.SaveGrant(ctx, types.NewGrant(
+					1,
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+				))
+
+				suite.k.SaveGrant(ctx, types.NewGrant(
+					1,
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					types.NewUserGrantee("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+				))
+			},
+			req:       types.NewQueryUserAllowancesRequest(1, "", nil),
+			shouldErr: false,
+			expGrants: []types.Grant{
+				types.NewGrant(
+					1,
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+				),
+				types.NewGrant(
+					1,
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					types.NewUserGrantee("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+				),
+			},
+		},
+		{
+			name: "valid query returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1, "test", "test", "owner", "treasury", "creator", time.Now(), nil))
+
+				suite.k.SaveGrant(ctx, types.NewGrant(1,
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+				))
+
+				suite.k.SaveGrant(ctx, types.NewGrant(
+					1,
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					types.NewUserGrantee("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+				))
+			},
+			req:       types.NewQueryUserAllowancesRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil),
+			shouldErr: false,
+			expGrants: []types.Grant{
+				types.NewGrant(
+					1,
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					types.NewUserGrantee("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.UserAllowances(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expGrants, res.Grants)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_GroupAllowances() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryGroupAllowancesRequest
+		shouldErr bool
+		expGrants []types.Grant
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			req:       types.NewQueryGroupAllowancesRequest(0, 1, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "not found subspace returns error",
+			req:       types.NewQueryGroupAllowancesRequest(1, 1, nil),
+			shouldErr: true,
+		},
+		{
+			name: "group grants query without group id returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1, "test", "test", "owner", "treasury", "creator", time.Now(), nil))
+
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "test", "tets", nil))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 2, "test", "tets", nil))
+
 				suite.k.SaveGrant(ctx, types.NewGrant(
 					1,
 					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
@@ -1001,3 +1705,340 @@ func (suite *KeeperTestSuite) TestQueryServer_GroupAllowances() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryServer_AllowanceUsages() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryAllowanceUsagesRequest
+		shouldErr bool
+		expUsages []types.AllowanceUsage
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			req:       types.NewQueryAllowanceUsagesRequest(0, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid grantee returns error",
+			req:       types.NewQueryAllowanceUsagesRequest(1, "", nil),
+			shouldErr: true,
+		},
+		{
+			name:      "not found subspace returns error",
+			req:       types.NewQueryAllowanceUsagesRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil),
+			shouldErr: true,
+		},
+		{
+			name: "valid request returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1, "test", "test", "owner", "treasury", "creator", time.Now(), nil))
+
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 1)},
+				))
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(20))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.reactions.v1.MsgAddReaction", 2)},
+				))
+				suite.k.SaveAllowanceUsage(ctx, types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(30))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 3)},
+				))
+			},
+			req:       types.NewQueryAllowanceUsagesRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil),
+			shouldErr: false,
+			expUsages: []types.AllowanceUsage{
+				types.NewAllowanceUsage(
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(10))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 1)},
+				),
+				types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(20))),
+					[]types.MessageUsage{types.NewMessageUsage("/desmos.reactions.v1.MsgAddReaction", 2)},
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.AllowanceUsages(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expUsages, res.Usages)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_FeeTokensConfig() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryFeeTokensConfigRequest
+		shouldErr bool
+		expConfig types.FeeTokensConfig
+	}{
+		{
+			name:      "not found config returns error",
+			req:       types.NewQueryFeeTokensConfigRequest(1),
+			shouldErr: true,
+		},
+		{
+			name: "found config is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+				}, types.FEE_TOKENS_DESTINATION_TREASURY))
+			},
+			req:       types.NewQueryFeeTokensConfigRequest(1),
+			shouldErr: false,
+			expConfig: types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+				types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+			}, types.FEE_TOKENS_DESTINATION_TREASURY),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.FeeTokensConfig(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expConfig, res.Config)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_TreasuryVotingConfig() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryTreasuryVotingConfigRequest
+		shouldErr bool
+		expConfig types.TreasuryVotingConfig
+	}{
+		{
+			name:      "not found config returns error",
+			req:       types.NewQueryTreasuryVotingConfigRequest(1),
+			shouldErr: true,
+		},
+		{
+			name: "found config is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour))
+			},
+			req:       types.NewQueryTreasuryVotingConfigRequest(1),
+			shouldErr: false,
+			expConfig: types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.TreasuryVotingConfig(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expConfig, res.Config)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_TreasuryProposals() {
+	testCases := []struct {
+		name         string
+		store        func(ctx sdk.Context)
+		req          *types.QueryTreasuryProposalsRequest
+		shouldErr    bool
+		expProposals []types.TreasuryProposal
+	}{
+		{
+			name:      "non existing subspace returns error",
+			req:       types.NewQueryTreasuryProposalsRequest(1, nil),
+			shouldErr: true,
+		},
+		{
+			name: "proposals are returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					2,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(2, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+			},
+			req:       types.NewQueryTreasuryProposalsRequest(1, nil),
+			shouldErr: false,
+			expProposals: []types.TreasuryProposal{
+				types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.TreasuryProposals(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expProposals, res.Proposals)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryServer_TreasuryProposalVotes() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryTreasuryProposalVotesRequest
+		shouldErr bool
+		expVotes  []types.TreasuryVote
+	}{
+		{
+			name:      "non existing proposal returns error",
+			req:       types.NewQueryTreasuryProposalVotesRequest(1, 1, nil),
+			shouldErr: true,
+		},
+		{
+			name: "votes are returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES))
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 2, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_NO))
+			},
+			req:       types.NewQueryTreasuryProposalVotesRequest(1, 1, nil),
+			shouldErr: false,
+			expVotes: []types.TreasuryVote{
+				types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.TreasuryProposalVotes(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expVotes, res.Votes)
+			}
+		})
+	}
+}
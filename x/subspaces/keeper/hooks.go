@@ -25,6 +25,27 @@ func (k Keeper) AfterSubspaceDeleted(ctx sdk.Context, subspaceID uint64) {
 	}
 }
 
+// AfterSubspaceOwnerTransferRequestSaved - call if hook is registered
+func (k Keeper) AfterSubspaceOwnerTransferRequestSaved(ctx sdk.Context, subspaceID uint64) {
+	if k.hooks != nil {
+		k.hooks.AfterSubspaceOwnerTransferRequestSaved(ctx, subspaceID)
+	}
+}
+
+// AfterSubspaceOwnerTransferRequestDeleted - call if hook is registered
+func (k Keeper) AfterSubspaceOwnerTransferRequestDeleted(ctx sdk.Context, subspaceID uint64) {
+	if k.hooks != nil {
+		k.hooks.AfterSubspaceOwnerTransferRequestDeleted(ctx, subspaceID)
+	}
+}
+
+// AfterSubspaceOwnerTransferred - call if hook is registered
+func (k Keeper) AfterSubspaceOwnerTransferred(ctx sdk.Context, subspaceID uint64, previousOwner, newOwner string) {
+	if k.hooks != nil {
+		k.hooks.AfterSubspaceOwnerTransferred(ctx, subspaceID, previousOwner, newOwner)
+	}
+}
+
 // AfterSubspaceSectionSaved - call if hook is registered
 func (k Keeper) AfterSubspaceSectionSaved(ctx sdk.Context, subspaceID uint64, sectionID uint32) {
 	if k.hooks != nil {
@@ -26,6 +26,18 @@ func (h *mockHooks) AfterSubspaceDeleted(ctx sdk.Context, subspaceID uint64) {
 	h.CalledMap["AfterSubspaceDeleted"] = true
 }
 
+func (h *mockHooks) AfterSubspaceOwnerTransferRequestSaved(ctx sdk.Context, subspaceID uint64) {
+	h.CalledMap["AfterSubspaceOwnerTransferRequestSaved"] = true
+}
+
+func (h *mockHooks) AfterSubspaceOwnerTransferRequestDeleted(ctx sdk.Context, subspaceID uint64) {
+	h.CalledMap["AfterSubspaceOwnerTransferRequestDeleted"] = true
+}
+
+func (h *mockHooks) AfterSubspaceOwnerTransferred(ctx sdk.Context, subspaceID uint64, previousOwner, newOwner string) {
+	h.CalledMap["AfterSubspaceOwnerTransferred"] = true
+}
+
 func (h *mockHooks) AfterSubspaceSectionSaved(ctx sdk.Context, subspaceID uint64, sectionID uint32) {
 	h.CalledMap["AfterSubspaceSectionSaved"] = true
 }
@@ -135,6 +147,124 @@ func (suite *KeeperTestSuite) TestHooks_AfterSubspaceDeleted() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestHooks_AfterSubspaceOwnerTransferRequestSaved() {
+	testCases := []struct {
+		name    string
+		request types.SubspaceOwnerTransferRequest
+	}{
+		{
+			name: "AfterSubspaceOwnerTransferRequestSaved is called properly",
+			request: types.NewSubspaceOwnerTransferRequest(
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			hooks := newMockHooks()
+			suite.k.SetHooks(types.NewMultiSubspacesHooks(hooks))
+
+			suite.k.SaveSubspaceOwnerTransferRequest(ctx, tc.request)
+
+			suite.Require().True(hooks.CalledMap["AfterSubspaceOwnerTransferRequestSaved"])
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHooks_AfterSubspaceOwnerTransferRequestDeleted() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+	}{
+		{
+			name: "AfterSubspaceOwnerTransferRequestDeleted is called properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			subspaceID: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			hooks := newMockHooks()
+			suite.k.SetHooks(types.NewMultiSubspacesHooks(hooks))
+
+			suite.k.DeleteSubspaceOwnerTransferRequest(ctx, tc.subspaceID)
+
+			suite.Require().True(hooks.CalledMap["AfterSubspaceOwnerTransferRequestDeleted"])
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHooks_AfterSubspaceOwnerTransferred() {
+	testCases := []struct {
+		name     string
+		store    func(ctx sdk.Context)
+		subspace types.Subspace
+	}{
+		{
+			name: "AfterSubspaceOwnerTransferred is called properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			subspace: types.NewSubspace(
+				1,
+				"Test subspace",
+				"This is a test subspace",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				nil,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			hooks := newMockHooks()
+			suite.k.SetHooks(types.NewMultiSubspacesHooks(hooks))
+
+			suite.k.SaveSubspace(ctx, tc.subspace)
+
+			suite.Require().True(hooks.CalledMap["AfterSubspaceOwnerTransferred"])
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestHooks_AfterSubspaceSectionSaved() {
 	testCases := []struct {
 		name    string
@@ -148,6 +278,7 @@ func (suite *KeeperTestSuite) TestHooks_AfterSubspaceSectionSaved() {
 				0,
 				"Test section",
 				"This is a test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 		},
 	}
@@ -183,6 +314,7 @@ func (suite *KeeperTestSuite) TestHooks_AfterSubspaceSectionDeleted() {
 					0,
 					"Test section",
 					"This is a test edited section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			subspaceID: 1,
@@ -318,7 +450,7 @@ func (suite *KeeperTestSuite) TestHooks_AfterSubspaceGroupMemberAdded() {
 			hooks := newMockHooks()
 			suite.k.SetHooks(types.NewMultiSubspacesHooks(hooks))
 
-			suite.k.AddUserToGroup(ctx, tc.subspaceID, tc.groupID, tc.user)
+			suite.k.AddUserToGroup(ctx, tc.subspaceID, tc.groupID, tc.user, nil)
 
 			suite.Require().True(hooks.CalledMap["AfterSubspaceGroupMemberAdded"])
 		})
@@ -346,7 +478,7 @@ func (suite *KeeperTestSuite) TestHooks_AfterSubspaceGroupMemberRemoved() {
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
 			},
 			subspaceID: 1,
 			groupID:    1,
@@ -401,7 +533,7 @@ func (suite *KeeperTestSuite) TestHooks_AfterUserPermissionSet() {
 			hooks := newMockHooks()
 			suite.k.SetHooks(types.NewMultiSubspacesHooks(hooks))
 
-			suite.k.SetUserPermissions(ctx, tc.subspaceID, tc.sectionID, tc.user, tc.permissions)
+			suite.k.SetUserPermissions(ctx, tc.subspaceID, tc.sectionID, tc.user, tc.permissions, nil)
 
 			suite.Require().True(hooks.CalledMap["AfterUserPermissionSet"])
 		})
@@ -24,6 +24,24 @@ func RegisterInvariants(ir sdk.InvariantRegistry, keeper *Keeper) {
 		ValidUserGrantsInvariant(keeper))
 	ir.RegisterRoute(types.ModuleName, "valid-group-grants",
 		ValidGroupGrantsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-owner-transfer-requests",
+		ValidSubspaceOwnerTransferRequestsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-group-invites",
+		ValidGroupInvitesInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-group-applications",
+		ValidGroupApplicationsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-subspace-bans",
+		ValidSubspaceBansInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-rate-limits",
+		ValidRateLimitsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-treasury-voting-configs",
+		ValidTreasuryVotingConfigsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-treasury-proposals",
+		ValidTreasuryProposalsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-treasury-votes",
+		ValidTreasuryVotesInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-fee-tokens-configs",
+		ValidFeeTokensConfigsInvariant(keeper))
 }
 
 // --------------------------------------------------------------------------------------------------------------------
@@ -374,3 +392,404 @@ func formatOutputGroupGrants(entries []types.Grant) (output string) {
 	}
 	return output
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidSubspaceOwnerTransferRequestsInvariant checks that all the subspace owner transfer requests are valid
+func ValidSubspaceOwnerTransferRequestsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidRequests []types.SubspaceOwnerTransferRequest
+		k.IterateSubspaceOwnerTransferRequests(ctx, func(request types.SubspaceOwnerTransferRequest) (stop bool) {
+			invalid := false
+
+			// Check subspace existence and the request sender
+			subspace, found := k.GetSubspace(ctx, request.SubspaceID)
+			if !found || subspace.Owner != request.Sender {
+				invalid = true
+			}
+
+			// Validate the request
+			err := request.Validate()
+			if err != nil {
+				invalid = true
+			}
+
+			if invalid {
+				invalidRequests = append(invalidRequests, request)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid subspace owner transfer requests",
+			fmt.Sprintf("the following owner transfer requests are invalid:\n%s", formatOutputSubspaceOwnerTransferRequests(invalidRequests)),
+		), invalidRequests != nil
+	}
+}
+
+// formatOutputSubspaceOwnerTransferRequests concatenates the given requests information into a string
+func formatOutputSubspaceOwnerTransferRequests(requests []types.SubspaceOwnerTransferRequest) (output string) {
+	for _, request := range requests {
+		output += fmt.Sprintf("SubspaceID: %d, Sender: %s, Receiver: %s\n", request.SubspaceID, request.Sender, request.Receiver)
+	}
+	return output
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidGroupInvitesInvariant checks that all the group invites are valid
+func ValidGroupInvitesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidInvites []types.GroupInvite
+		k.IterateGroupInvites(ctx, func(invite types.GroupInvite) (stop bool) {
+			invalid := false
+
+			// Check subspace existence
+			if !k.HasSubspace(ctx, invite.SubspaceID) {
+				invalid = true
+			}
+
+			// Check the group existence
+			if !k.HasUserGroup(ctx, invite.SubspaceID, invite.GroupID) {
+				invalid = true
+			}
+
+			// Validate the invite
+			err := invite.Validate()
+			if err != nil {
+				invalid = true
+			}
+
+			if invalid {
+				invalidInvites = append(invalidInvites, invite)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid group invites",
+			fmt.Sprintf("the following group invites are invalid:\n%s", formatOutputGroupInvites(invalidInvites)),
+		), invalidInvites != nil
+	}
+}
+
+// formatOutputGroupInvites concatenates the given invites information into a string
+func formatOutputGroupInvites(invites []types.GroupInvite) (output string) {
+	for _, invite := range invites {
+		output += fmt.Sprintf("SubspaceID: %d, GroupID: %d, SecretHash: %s\n", invite.SubspaceID, invite.GroupID, invite.SecretHash)
+	}
+	return output
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidGroupApplicationsInvariant checks that all the group applications are valid
+func ValidGroupApplicationsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidApplications []types.GroupApplication
+		k.IterateGroupApplications(ctx, func(application types.GroupApplication) (stop bool) {
+			invalid := false
+
+			// Check subspace existence
+			if !k.HasSubspace(ctx, application.SubspaceID) {
+				invalid = true
+			}
+
+			// Check the group existence
+			if !k.HasUserGroup(ctx, application.SubspaceID, application.GroupID) {
+				invalid = true
+			}
+
+			// Make sure the applicant is not already a member
+			if k.IsMemberOfGroup(ctx, application.SubspaceID, application.GroupID, application.Applicant) {
+				invalid = true
+			}
+
+			// Validate the application
+			err := application.Validate()
+			if err != nil {
+				invalid = true
+			}
+
+			if invalid {
+				invalidApplications = append(invalidApplications, application)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid group applications",
+			fmt.Sprintf("the following group applications are invalid:\n%s", formatOutputGroupApplications(invalidApplications)),
+		), invalidApplications != nil
+	}
+}
+
+// formatOutputGroupApplications concatenates the given applications information into a string
+func formatOutputGroupApplications(applications []types.GroupApplication) (output string) {
+	for _, application := range applications {
+		output += fmt.Sprintf("SubspaceID: %d, GroupID: %d, Applicant: %s\n", application.SubspaceID, application.GroupID, application.Applicant)
+	}
+	return output
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidSubspaceBansInvariant checks that all the subspace bans are valid
+func ValidSubspaceBansInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidBans []types.SubspaceBan
+		k.IterateSubspaceBans(ctx, func(ban types.SubspaceBan) (stop bool) {
+			invalid := false
+
+			// Check subspace existence
+			subspace, found := k.GetSubspace(ctx, ban.SubspaceID)
+			if !found {
+				invalid = true
+			}
+
+			// Make sure the owner is not banned
+			if subspace.Owner == ban.User {
+				invalid = true
+			}
+
+			// Validate the ban
+			err := ban.Validate()
+			if err != nil {
+				invalid = true
+			}
+
+			if invalid {
+				invalidBans = append(invalidBans, ban)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid subspace bans",
+			fmt.Sprintf("the following subspace bans are invalid:\n%s", formatOutputSubspaceBans(invalidBans)),
+		), invalidBans != nil
+	}
+}
+
+// formatOutputSubspaceBans concatenates the given bans information into a string
+func formatOutputSubspaceBans(bans []types.SubspaceBan) (output string) {
+	for _, ban := range bans {
+		output += fmt.Sprintf("SubspaceID: %d, User: %s\n", ban.SubspaceID, ban.User)
+	}
+	return output
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidRateLimitsInvariant checks that all the rate limits are valid
+func ValidRateLimitsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidLimits []types.RateLimit
+		k.IterateRateLimits(ctx, func(limit types.RateLimit) (stop bool) {
+			invalid := false
+
+			// Check subspace existence
+			if !k.HasSubspace(ctx, limit.SubspaceID) {
+				invalid = true
+			}
+
+			// Validate the rate limit
+			err := limit.Validate()
+			if err != nil {
+				invalid = true
+			}
+
+			if invalid {
+				invalidLimits = append(invalidLimits, limit)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid rate limits",
+			fmt.Sprintf("the following rate limits are invalid:\n%s", formatOutputRateLimits(invalidLimits)),
+		), invalidLimits != nil
+	}
+}
+
+// formatOutputRateLimits concatenates the given rate limits information into a string
+func formatOutputRateLimits(limits []types.RateLimit) (output string) {
+	for _, limit := range limits {
+		output += fmt.Sprintf("SubspaceID: %d, MsgTypeURL: %s\n", limit.SubspaceID, limit.MsgTypeURL)
+	}
+	return output
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidTreasuryVotingConfigsInvariant checks that all the treasury voting configs are valid
+func ValidTreasuryVotingConfigsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidConfigs []types.TreasuryVotingConfig
+		k.IterateTreasuryVotingConfigs(ctx, func(config types.TreasuryVotingConfig) (stop bool) {
+			invalid := false
+
+			// Check subspace existence
+			if !k.HasSubspace(ctx, config.SubspaceID) {
+				invalid = true
+			}
+
+			// Check group existence
+			if !k.HasUserGroup(ctx, config.SubspaceID, config.GroupID) {
+				invalid = true
+			}
+
+			// Validate the config
+			err := config.Validate()
+			if err != nil {
+				invalid = true
+			}
+
+			if invalid {
+				invalidConfigs = append(invalidConfigs, config)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid treasury voting configs",
+			fmt.Sprintf("the following treasury voting configs are invalid:\n%s", formatOutputTreasuryVotingConfigs(invalidConfigs)),
+		), invalidConfigs != nil
+	}
+}
+
+// formatOutputTreasuryVotingConfigs concatenates the given treasury voting configs information into a string
+func formatOutputTreasuryVotingConfigs(configs []types.TreasuryVotingConfig) (output string) {
+	for _, config := range configs {
+		output += fmt.Sprintf("SubspaceID: %d, GroupID: %d\n", config.SubspaceID, config.GroupID)
+	}
+	return output
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidTreasuryProposalsInvariant checks that all the treasury proposals are valid
+func ValidTreasuryProposalsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidProposals []types.TreasuryProposal
+		k.IterateTreasuryProposals(ctx, func(proposal types.TreasuryProposal) (stop bool) {
+			invalid := false
+
+			// Check subspace existence
+			if !k.HasSubspace(ctx, proposal.SubspaceID) {
+				invalid = true
+			}
+
+			// Make sure the proposal id is always less than the next one
+			if proposal.ID >= k.GetNextTreasuryProposalID(ctx, proposal.SubspaceID) {
+				invalid = true
+			}
+
+			// Validate the proposal
+			err := proposal.Validate()
+			if err != nil {
+				invalid = true
+			}
+
+			if invalid {
+				invalidProposals = append(invalidProposals, proposal)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid treasury proposals",
+			fmt.Sprintf("the following treasury proposals are invalid:\n%s", formatOutputTreasuryProposals(invalidProposals)),
+		), invalidProposals != nil
+	}
+}
+
+// formatOutputTreasuryProposals concatenates the given treasury proposals information into a string
+func formatOutputTreasuryProposals(proposals []types.TreasuryProposal) (output string) {
+	for _, proposal := range proposals {
+		output += fmt.Sprintf("SubspaceID: %d, ProposalID: %d\n", proposal.SubspaceID, proposal.ID)
+	}
+	return output
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidTreasuryVotesInvariant checks that all the treasury proposal votes are valid
+func ValidTreasuryVotesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidVotes []types.TreasuryVote
+		k.IterateTreasuryVotes(ctx, func(vote types.TreasuryVote) (stop bool) {
+			invalid := false
+
+			// Check proposal existence
+			if !k.HasTreasuryProposal(ctx, vote.SubspaceID, vote.ProposalID) {
+				invalid = true
+			}
+
+			// Validate the vote
+			err := vote.Validate()
+			if err != nil {
+				invalid = true
+			}
+
+			if invalid {
+				invalidVotes = append(invalidVotes, vote)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid treasury votes",
+			fmt.Sprintf("the following treasury votes are invalid:\n%s", formatOutputTreasuryVotes(invalidVotes)),
+		), invalidVotes != nil
+	}
+}
+
+// formatOutputTreasuryVotes concatenates the given treasury votes information into a string
+func formatOutputTreasuryVotes(votes []types.TreasuryVote) (output string) {
+	for _, vote := range votes {
+		output += fmt.Sprintf("SubspaceID: %d, ProposalID: %d, Voter: %s\n", vote.SubspaceID, vote.ProposalID, vote.Voter)
+	}
+	return output
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidFeeTokensConfigsInvariant checks that all the fee tokens configs are valid
+func ValidFeeTokensConfigsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidConfigs []types.FeeTokensConfig
+		k.IterateFeeTokensConfigs(ctx, func(config types.FeeTokensConfig) (stop bool) {
+			invalid := false
+
+			// Check subspace existence
+			if !k.HasSubspace(ctx, config.SubspaceID) {
+				invalid = true
+			}
+
+			// Validate the config
+			err := config.Validate()
+			if err != nil {
+				invalid = true
+			}
+
+			if invalid {
+				invalidConfigs = append(invalidConfigs, config)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid fee tokens configs",
+			fmt.Sprintf("the following fee tokens configs are invalid:\n%s", formatOutputFeeTokensConfigs(invalidConfigs)),
+		), invalidConfigs != nil
+	}
+}
+
+// formatOutputFeeTokensConfigs concatenates the given fee tokens configs information into a string
+func formatOutputFeeTokensConfigs(configs []types.FeeTokensConfig) (output string) {
+	for _, config := range configs {
+		output += fmt.Sprintf("SubspaceID: %d\n", config.SubspaceID)
+	}
+	return output
+}
@@ -154,6 +154,7 @@ func (suite *KeeperTestSuite) TestValidSectionsInvariant() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			expBroken: true,
@@ -178,6 +179,7 @@ func (suite *KeeperTestSuite) TestValidSectionsInvariant() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			expBroken: true,
@@ -203,6 +205,7 @@ func (suite *KeeperTestSuite) TestValidSectionsInvariant() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			expBroken: true,
@@ -228,6 +231,7 @@ func (suite *KeeperTestSuite) TestValidSectionsInvariant() {
 					2,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			expBroken: true,
@@ -253,6 +257,7 @@ func (suite *KeeperTestSuite) TestValidSectionsInvariant() {
 					0,
 					"",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			expBroken: true,
@@ -278,6 +283,7 @@ func (suite *KeeperTestSuite) TestValidSectionsInvariant() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			expBroken: false,
@@ -363,6 +369,7 @@ func (suite *KeeperTestSuite) TestValidUserGroupsInvariant() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 
 				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
@@ -397,6 +404,7 @@ func (suite *KeeperTestSuite) TestValidUserGroupsInvariant() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 
 				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
@@ -431,6 +439,7 @@ func (suite *KeeperTestSuite) TestValidUserGroupsInvariant() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 
 				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
@@ -465,6 +474,7 @@ func (suite *KeeperTestSuite) TestValidUserGroupsInvariant() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 
 				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
@@ -503,7 +513,7 @@ func (suite *KeeperTestSuite) TestValidUserGroupMembersInvariant() {
 		{
 			name: "non existing subspace breaks invariant",
 			store: func(ctx sdk.Context) {
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4", nil)
 			},
 			expBroken: true,
 		},
@@ -521,7 +531,7 @@ func (suite *KeeperTestSuite) TestValidUserGroupMembersInvariant() {
 					nil,
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4", nil)
 			},
 			expBroken: true,
 		},
@@ -539,7 +549,7 @@ func (suite *KeeperTestSuite) TestValidUserGroupMembersInvariant() {
 					nil,
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 0, "cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4")
+				suite.k.AddUserToGroup(ctx, 1, 0, "cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4", nil)
 			},
 			expBroken: true,
 		},
@@ -566,7 +576,7 @@ func (suite *KeeperTestSuite) TestValidUserGroupMembersInvariant() {
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4", nil)
 			},
 			expBroken: false,
 		},
@@ -600,6 +610,7 @@ func (suite *KeeperTestSuite) TestValidUserPermissionsInvariant() {
 					0,
 					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
 					types.NewPermissions(types.PermissionEditSubspace),
+					nil,
 				)
 			},
 			expBroken: true,
@@ -623,6 +634,7 @@ func (suite *KeeperTestSuite) TestValidUserPermissionsInvariant() {
 					1,
 					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
 					types.NewPermissions(types.PermissionEditSubspace),
+					nil,
 				)
 			},
 			expBroken: true,
@@ -647,9 +659,10 @@ func (suite *KeeperTestSuite) TestValidUserPermissionsInvariant() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 
-				suite.k.SetUserPermissions(ctx, 1, 1, "", types.NewPermissions())
+				suite.k.SetUserPermissions(ctx, 1, 1, "", types.NewPermissions(), nil)
 			},
 			expBroken: true,
 		},
@@ -673,6 +686,7 @@ func (suite *KeeperTestSuite) TestValidUserPermissionsInvariant() {
 					0,
 					"Test section",
 					"Test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 
 				suite.k.SetUserPermissions(ctx,
@@ -680,6 +694,7 @@ func (suite *KeeperTestSuite) TestValidUserPermissionsInvariant() {
 					1,
 					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
 					types.NewPermissions(types.PermissionEditSubspace),
+					nil,
 				)
 			},
 			expBroken: false,
@@ -890,3 +905,896 @@ func (suite *KeeperTestSuite) TestValidGroupGrantsInvariant() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestValidSubspaceOwnerTransferRequestsInvariant() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			name: "non existing subspace breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "sender different from the subspace owner breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "invalid data breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "valid data does not break invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			expBroken: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			_, broken := keeper.ValidSubspaceOwnerTransferRequestsInvariant(suite.k)(ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidGroupInvitesInvariant() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			name: "non existing subspace breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "non existing group breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "invalid data breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					0,
+					0,
+					nil,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "valid data does not break invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			expBroken: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			_, broken := keeper.ValidGroupInvitesInvariant(suite.k)(ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidGroupApplicationsInvariant() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			name: "non existing subspace breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "non existing group breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "applicant already member breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "invalid data breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Time{},
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "valid data does not break invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+			},
+			expBroken: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			_, broken := keeper.ValidGroupApplicationsInvariant(suite.k)(ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidSubspaceBansInvariant() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			name: "non existing subspace breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					nil,
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "banned owner breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Spam",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					nil,
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "invalid data breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"",
+					nil,
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "valid data does not break invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					nil,
+				))
+			},
+			expBroken: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			_, broken := keeper.ValidSubspaceBansInvariant(suite.k)(ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidRateLimitsInvariant() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			name: "non existing subspace breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour))
+			},
+			expBroken: true,
+		},
+		{
+			name: "invalid data breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 0, time.Hour))
+			},
+			expBroken: true,
+		},
+		{
+			name: "valid data does not break invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour))
+			},
+			expBroken: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			_, broken := keeper.ValidRateLimitsInvariant(suite.k)(ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidTreasuryVotingConfigsInvariant() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			name: "non existing subspace breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour))
+			},
+			expBroken: true,
+		},
+		{
+			name: "non existing group breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour))
+			},
+			expBroken: true,
+		},
+		{
+			name: "invalid data breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, 0))
+			},
+			expBroken: true,
+		},
+		{
+			name: "valid data does not break invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour))
+			},
+			expBroken: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			_, broken := keeper.ValidTreasuryVotingConfigsInvariant(suite.k)(ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidTreasuryProposalsInvariant() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			name: "non existing subspace breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SetNextTreasuryProposalID(ctx, 1, 2)
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "proposal id not lower than the next one breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SetNextTreasuryProposalID(ctx, 1, 1)
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "invalid data breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SetNextTreasuryProposalID(ctx, 1, 2)
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")},
+					1,
+					sdk.ZeroDec(),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+			},
+			expBroken: true,
+		},
+		{
+			name: "valid data does not break invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SetNextTreasuryProposalID(ctx, 1, 2)
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+			},
+			expBroken: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			_, broken := keeper.ValidTreasuryProposalsInvariant(suite.k)(ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidTreasuryVotesInvariant() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			name: "non existing proposal breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", types.TREASURY_VOTE_OPTION_YES))
+			},
+			expBroken: true,
+		},
+		{
+			name: "invalid data breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", types.TREASURY_VOTE_OPTION_UNSPECIFIED))
+			},
+			expBroken: true,
+		},
+		{
+			name: "valid data does not break invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", types.TREASURY_VOTE_OPTION_YES))
+			},
+			expBroken: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			_, broken := keeper.ValidTreasuryVotesInvariant(suite.k)(ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidFeeTokensConfigsInvariant() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			name: "non existing subspace breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+				}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR))
+			},
+			expBroken: true,
+		},
+		{
+			name: "invalid data breaks invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+				))
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(1, "stake", nil, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR))
+			},
+			expBroken: true,
+		},
+		{
+			name: "valid data does not break invariant",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+				))
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+				}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR))
+			},
+			expBroken: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			_, broken := keeper.ValidFeeTokensConfigsInvariant(suite.k)(ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
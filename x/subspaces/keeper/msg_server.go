@@ -9,6 +9,7 @@ import (
 	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
 
 	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -85,6 +86,11 @@ func (k msgServer) EditSubspace(goCtx context.Context, msg *types.MsgEditSubspac
 		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot manage this subspace")
 	}
 
+	// Make sure the owner is not changed, since the ownership can only be transferred using an owner transfer request
+	if msg.Owner != types.DoNotModify && msg.Owner != subspace.Owner {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "subspace owner cannot be edited, use an owner transfer request instead")
+	}
+
 	// Update the subspace and validate it
 	updated := subspace.Update(types.NewSubspaceUpdate(msg.Name, msg.Description, msg.Owner))
 	err = updated.Validate()
@@ -137,6 +143,227 @@ func (k msgServer) DeleteSubspace(goCtx context.Context, msg *types.MsgDeleteSub
 	return &types.MsgDeleteSubspaceResponse{}, nil
 }
 
+// ArchiveSubspace defines a rpc method for MsgArchiveSubspace
+func (k msgServer) ArchiveSubspace(goCtx context.Context, msg *types.MsgArchiveSubspace) (*types.MsgArchiveSubspaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	subspace, found := k.GetSubspace(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the signer is the subspace owner
+	if subspace.Owner != msg.Signer {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot archive a subspace that you do not own")
+	}
+
+	// Make sure the subspace is not already archived
+	if subspace.Archived {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d is already archived", msg.SubspaceID)
+	}
+
+	// Archive the subspace
+	subspace.Archived = true
+	k.SaveSubspace(ctx, subspace)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeArchivedSubspace,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.Signer),
+		),
+	})
+
+	return &types.MsgArchiveSubspaceResponse{}, nil
+}
+
+// UnarchiveSubspace defines a rpc method for MsgUnarchiveSubspace
+func (k msgServer) UnarchiveSubspace(goCtx context.Context, msg *types.MsgUnarchiveSubspace) (*types.MsgUnarchiveSubspaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	subspace, found := k.GetSubspace(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the signer is the subspace owner
+	if subspace.Owner != msg.Signer {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot unarchive a subspace that you do not own")
+	}
+
+	// Make sure the subspace is archived
+	if !subspace.Archived {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d is not archived", msg.SubspaceID)
+	}
+
+	// Unarchive the subspace
+	subspace.Archived = false
+	k.SaveSubspace(ctx, subspace)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnarchivedSubspace,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.Signer),
+		),
+	})
+
+	return &types.MsgUnarchiveSubspaceResponse{}, nil
+}
+
+// RequestSubspaceOwnerTransfer defines a rpc method for MsgRequestSubspaceOwnerTransfer
+func (k msgServer) RequestSubspaceOwnerTransfer(goCtx context.Context, msg *types.MsgRequestSubspaceOwnerTransfer) (*types.MsgRequestSubspaceOwnerTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	subspace, found := k.GetSubspace(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the sender is the subspace owner
+	if subspace.Owner != msg.Sender {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot transfer a subspace that you do not own")
+	}
+
+	// Check if a subspace owner transfer request already exists
+	if k.HasSubspaceOwnerTransferRequest(ctx, msg.SubspaceID) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "subspace owner transfer request already exists")
+	}
+
+	// Create and validate the request
+	request := types.NewSubspaceOwnerTransferRequest(msg.SubspaceID, msg.Receiver, msg.Sender)
+	err := request.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Save the request
+	k.SaveSubspaceOwnerTransferRequest(ctx, request)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRequestedSubspaceOwnerTransfer,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRequestSubspaceOwnerTransferResponse{}, nil
+}
+
+// CancelSubspaceOwnerTransferRequest defines a rpc method for MsgCancelSubspaceOwnerTransferRequest
+func (k msgServer) CancelSubspaceOwnerTransferRequest(goCtx context.Context, msg *types.MsgCancelSubspaceOwnerTransferRequest) (*types.MsgCancelSubspaceOwnerTransferRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the subspace owner transfer request
+	request, found := k.GetSubspaceOwnerTransferRequest(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "subspace owner transfer request does not exist")
+	}
+
+	// Make sure the request sender matches the sender
+	if request.Sender != msg.Sender {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "you cannot cancel a subspace owner transfer request that you did not send")
+	}
+
+	// Delete the request
+	k.DeleteSubspaceOwnerTransferRequest(ctx, msg.SubspaceID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCanceledSubspaceOwnerTransfer,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCancelSubspaceOwnerTransferRequestResponse{}, nil
+}
+
+// AcceptSubspaceOwnerTransferRequest defines a rpc method for MsgAcceptSubspaceOwnerTransferRequest
+func (k msgServer) AcceptSubspaceOwnerTransferRequest(goCtx context.Context, msg *types.MsgAcceptSubspaceOwnerTransferRequest) (*types.MsgAcceptSubspaceOwnerTransferRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the subspace owner transfer request
+	request, found := k.GetSubspaceOwnerTransferRequest(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "subspace owner transfer request does not exist")
+	}
+
+	// Make sure the request receiver matches the receiver
+	if request.Receiver != msg.Receiver {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "you cannot accept a subspace owner transfer request that you are not the receiver of")
+	}
+
+	// Check if the subspace exists
+	subspace, found := k.GetSubspace(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the subspace owner matches the request sender
+	if subspace.Owner != request.Sender {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "the sender of the subspace owner transfer request does not own the subspace")
+	}
+
+	// Update the subspace and validate it
+	updated := subspace.Update(types.NewSubspaceUpdate(types.DoNotModify, types.DoNotModify, msg.Receiver))
+	err := updated.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Delete the request
+	k.DeleteSubspaceOwnerTransferRequest(ctx, msg.SubspaceID)
+
+	// Save the subspace
+	k.SaveSubspace(ctx, updated)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptedSubspaceOwnerTransfer,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeySender, request.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+		),
+	})
+
+	return &types.MsgAcceptSubspaceOwnerTransferRequestResponse{}, nil
+}
+
+// RefuseSubspaceOwnerTransferRequest defines a rpc method for MsgRefuseSubspaceOwnerTransferRequest
+func (k msgServer) RefuseSubspaceOwnerTransferRequest(goCtx context.Context, msg *types.MsgRefuseSubspaceOwnerTransferRequest) (*types.MsgRefuseSubspaceOwnerTransferRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the subspace owner transfer request
+	request, found := k.GetSubspaceOwnerTransferRequest(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "subspace owner transfer request does not exist")
+	}
+
+	// Make sure the request receiver matches the receiver
+	if request.Receiver != msg.Receiver {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "you cannot refuse a subspace owner transfer request that you are not the receiver of")
+	}
+
+	// Delete the request
+	k.DeleteSubspaceOwnerTransferRequest(ctx, msg.SubspaceID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefusedSubspaceOwnerTransfer,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+		),
+	})
+
+	return &types.MsgRefuseSubspaceOwnerTransferRequestResponse{}, nil
+}
+
 // CreateSection defines a rpc method for MsgCreateSection
 func (k msgServer) CreateSection(goCtx context.Context, msg *types.MsgCreateSection) (*types.MsgCreateSectionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -168,7 +395,7 @@ func (k msgServer) CreateSection(goCtx context.Context, msg *types.MsgCreateSect
 	}
 
 	// Create and validate the section
-	section := types.NewSection(msg.SubspaceID, sectionID, msg.ParentID, msg.Name, msg.Description)
+	section := types.NewSection(msg.SubspaceID, sectionID, msg.ParentID, msg.Name, msg.Description, msg.Visibility)
 	err = section.Validate()
 	if err != nil {
 		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
@@ -296,6 +523,52 @@ func (k msgServer) MoveSection(goCtx context.Context, msg *types.MsgMoveSection)
 	return &types.MsgMoveSectionResponse{}, nil
 }
 
+// SetSectionVisibility defines a rpc method for MsgSetSectionVisibility
+func (k msgServer) SetSectionVisibility(goCtx context.Context, msg *types.MsgSetSectionVisibility) (*types.MsgSetSectionVisibilityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Check if the section exists
+	section, found := k.GetSection(ctx, msg.SubspaceID, msg.SectionID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "section with id %d not found inside subspace %d", msg.SectionID, msg.SubspaceID)
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", msg.Signer)
+	}
+
+	// Check the permission to manage sections
+	if !k.HasPermission(ctx, msg.SubspaceID, types.RootSectionID, signer.String(), types.PermissionManageSections) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot manage sections within this subspace")
+	}
+
+	// Update the section visibility and validate it
+	section.Visibility = msg.Visibility
+	err = section.Validate()
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Save the section
+	k.SaveSection(ctx, section)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetSectionVisibility,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeySectionID, fmt.Sprintf("%d", msg.SectionID)),
+		),
+	})
+
+	return &types.MsgSetSectionVisibilityResponse{}, nil
+}
+
 // DeleteSection defines a rpc method for MsgDeleteSection
 func (k msgServer) DeleteSection(goCtx context.Context, msg *types.MsgDeleteSection) (*types.MsgDeleteSectionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -343,6 +616,11 @@ func (k msgServer) CreateUserGroup(goCtx context.Context, msg *types.MsgCreateUs
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "group with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the section exists
 	if !k.HasSection(ctx, msg.SubspaceID, msg.SectionID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "section with id %d not found inside subspace %d", msg.SectionID, msg.SubspaceID)
@@ -388,7 +666,7 @@ func (k msgServer) CreateUserGroup(goCtx context.Context, msg *types.MsgCreateUs
 	var userEvents sdk.Events
 	for _, member := range msg.InitialMembers {
 		// Add the user to the group
-		k.AddUserToGroup(ctx, group.SubspaceID, group.ID, member)
+		k.AddUserToGroup(ctx, group.SubspaceID, group.ID, member, nil)
 
 		// Add the events to the list of to emit
 		userEvents = append(userEvents, sdk.NewEvent(
@@ -419,6 +697,11 @@ func (k msgServer) EditUserGroup(goCtx context.Context, msg *types.MsgEditUserGr
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "group with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the group exists
 	group, found := k.GetUserGroup(ctx, msg.SubspaceID, msg.GroupID)
 	if !found {
@@ -465,6 +748,11 @@ func (k msgServer) MoveUserGroup(goCtx context.Context, msg *types.MsgMoveUserGr
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "group with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the destination section exists
 	if !k.HasSection(ctx, msg.SubspaceID, msg.NewSectionID) {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "section with id %d not found inside subspace %d", msg.NewSectionID, msg.SubspaceID)
@@ -521,6 +809,11 @@ func (k msgServer) SetUserGroupPermissions(goCtx context.Context, msg *types.Msg
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the group exists
 	group, found := k.GetUserGroup(ctx, msg.SubspaceID, msg.GroupID)
 	if !found {
@@ -572,6 +865,11 @@ func (k msgServer) DeleteUserGroup(goCtx context.Context, msg *types.MsgDeleteUs
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the group exists
 	group, found := k.GetUserGroup(ctx, msg.SubspaceID, msg.GroupID)
 	if !found {
@@ -611,6 +909,11 @@ func (k msgServer) AddUserToUserGroup(goCtx context.Context, msg *types.MsgAddUs
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the group exists
 	group, found := k.GetUserGroup(ctx, msg.SubspaceID, msg.GroupID)
 	if !found {
@@ -637,8 +940,13 @@ func (k msgServer) AddUserToUserGroup(goCtx context.Context, msg *types.MsgAddUs
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "user is already part of group %d", msg.GroupID)
 	}
 
+	// Make sure the expiration time is in the future
+	if msg.ExpirationTime != nil && !msg.ExpirationTime.After(ctx.BlockTime()) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "expiration time must be in the future")
+	}
+
 	// Set the user group
-	k.AddUserToGroup(ctx, msg.SubspaceID, msg.GroupID, user.String())
+	k.AddUserToGroup(ctx, msg.SubspaceID, msg.GroupID, user.String(), msg.ExpirationTime)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -661,6 +969,11 @@ func (k msgServer) RemoveUserFromUserGroup(goCtx context.Context, msg *types.Msg
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
 	// Check if the group exists
 	group, found := k.GetUserGroup(ctx, msg.SubspaceID, msg.GroupID)
 	if !found {
@@ -697,8 +1010,8 @@ func (k msgServer) RemoveUserFromUserGroup(goCtx context.Context, msg *types.Msg
 	return &types.MsgRemoveUserFromUserGroupResponse{}, nil
 }
 
-// SetUserPermissions defines a rpc method for MsgSetUserPermissions
-func (k msgServer) SetUserPermissions(goCtx context.Context, msg *types.MsgSetUserPermissions) (*types.MsgSetUserPermissionsResponse, error) {
+// CreateInvite defines a rpc method for MsgCreateInvite
+func (k msgServer) CreateInvite(goCtx context.Context, msg *types.MsgCreateInvite) (*types.MsgCreateInviteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Check if the subspace exists
@@ -706,85 +1019,614 @@ func (k msgServer) SetUserPermissions(goCtx context.Context, msg *types.MsgSetUs
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
-	// Check if the section exists
-	if !k.HasSection(ctx, msg.SubspaceID, msg.SectionID) {
-		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "section with id %d not found inside subspace %d", msg.SectionID, msg.SubspaceID)
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
 	}
 
-	signer, err := sdk.AccAddressFromBech32(msg.Signer)
-	if err != nil {
-		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", msg.Signer)
+	// Check if the group exists
+	group, found := k.GetUserGroup(ctx, msg.SubspaceID, msg.GroupID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "group %d could not be found", msg.GroupID)
 	}
 
 	// Check the permissions
-	if !k.HasPermission(ctx, msg.SubspaceID, msg.SectionID, signer.String(), types.PermissionSetPermissions) {
-		return nil, errors.Wrapf(types.ErrPermissionDenied, "you cannot manage permissions in this subspace")
+	if !k.HasPermission(ctx, group.SubspaceID, group.SectionID, msg.Creator, types.PermissionSetPermissions) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot manage user group invites in this subspace")
 	}
 
-	// Make sure the permission is valid
-	if !types.ArePermissionsValid(msg.Permissions) {
-		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid permission value")
+	// Check if an invite with the same secret already exists
+	if k.HasGroupInvite(ctx, msg.SubspaceID, msg.SecretHash) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "an invite with the same secret already exists")
 	}
 
-	// Set the permissions
-	if msg.Permissions == nil {
-		// Remove the permission to clear the store if empty permissions are provided
-		k.RemoveUserPermissions(ctx, msg.SubspaceID, msg.SectionID, msg.User)
-	} else {
-		k.Keeper.SetUserPermissions(ctx, msg.SubspaceID, msg.SectionID, msg.User, msg.Permissions)
+	// Make sure the expiration time is in the future
+	if msg.ExpirationTime != nil && !msg.ExpirationTime.After(ctx.BlockTime()) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "expiration time must be in the future")
+	}
+
+	// Create and validate the invite
+	invite := types.NewGroupInvite(msg.SubspaceID, msg.GroupID, msg.SecretHash, msg.MaxUses, 0, msg.ExpirationTime, msg.Creator)
+	err := invite.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	// Save the invite
+	k.SaveGroupInvite(ctx, invite)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeSetUserPermissions,
+			types.EventTypeCreatedInvite,
 			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
-			sdk.NewAttribute(types.AttributeKeySectionID, fmt.Sprintf("%d", msg.SectionID)),
-			sdk.NewAttribute(types.AttributeKeyPermissions, strings.Join(msg.Permissions, ",")),
-			sdk.NewAttribute(types.AttributeKeyUser, msg.User),
+			sdk.NewAttribute(types.AttributeKeyUserGroupID, fmt.Sprintf("%d", msg.GroupID)),
+			sdk.NewAttribute(types.AttributeKeySecretHash, msg.SecretHash),
 		),
 	})
 
-	return &types.MsgSetUserPermissionsResponse{}, nil
+	return &types.MsgCreateInviteResponse{}, nil
 }
 
-// GrantTreasuryAuthorization defines a rpc method for MsgGrantTreasuryAuthorization
-func (k msgServer) GrantTreasuryAuthorization(goCtx context.Context, msg *types.MsgGrantTreasuryAuthorization) (*types.MsgGrantTreasuryAuthorizationResponse, error) {
+// DeleteInvite defines a rpc method for MsgDeleteInvite
+func (k msgServer) DeleteInvite(goCtx context.Context, msg *types.MsgDeleteInvite) (*types.MsgDeleteInviteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	subspace, found := k.GetSubspace(ctx, msg.SubspaceID)
-	if !found {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("subspace with id %d not found", msg.SubspaceID)
-	}
 
-	if !k.HasPermission(ctx, msg.SubspaceID, types.RootSectionID, msg.Granter, types.PermissionManageTreasuryAuthorization) {
-		return nil, types.ErrPermissionDenied.Wrap("you cannot manage this subspace's treasury authorizations")
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
 	}
 
-	treasury, err := sdk.AccAddressFromBech32(subspace.Treasury)
-	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid treasury address: %s", treasury.String())
+	// Check if the invite exists
+	invite, found := k.GetGroupInvite(ctx, msg.SubspaceID, msg.SecretHash)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "invite could not be found")
 	}
 
-	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
-	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", msg.Grantee)
+	// Check if the group exists
+	group, found := k.GetUserGroup(ctx, invite.SubspaceID, invite.GroupID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "group %d could not be found", invite.GroupID)
 	}
-	k.createAccountIfNotExists(ctx, grantee.String())
 
-	authorization, err := msg.Grant.GetAuthorization()
-	if err != nil {
-		return nil, err
+	// Check the permissions
+	if !k.HasPermission(ctx, group.SubspaceID, group.SectionID, msg.Signer, types.PermissionSetPermissions) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot manage user group invites in this subspace")
 	}
 
-	err = k.authzk.SaveGrant(ctx, grantee, treasury, authorization, msg.Grant.Expiration)
-	if err != nil {
-		return nil, err
-	}
+	// Delete the invite
+	k.DeleteGroupInvite(ctx, msg.SubspaceID, msg.SecretHash)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeGrantedTreasuryAuthorization,
+			types.EventTypeDeletedInvite,
 			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
-			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyUserGroupID, fmt.Sprintf("%d", invite.GroupID)),
+			sdk.NewAttribute(types.AttributeKeySecretHash, msg.SecretHash),
+		),
+	})
+
+	return &types.MsgDeleteInviteResponse{}, nil
+}
+
+// RedeemInvite defines a rpc method for MsgRedeemInvite
+func (k msgServer) RedeemInvite(goCtx context.Context, msg *types.MsgRedeemInvite) (*types.MsgRedeemInviteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
+	// Make sure the redeemer is not banned from the subspace
+	if k.IsUserBanned(ctx, msg.SubspaceID, msg.Redeemer) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is banned from subspace %d", msg.Redeemer, msg.SubspaceID)
+	}
+
+	// Check if the invite exists
+	invite, found := k.GetGroupInvite(ctx, msg.SubspaceID, types.GetInvitePubKeyHash(msg.PublicKey))
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "invite could not be found")
+	}
+
+	// Make sure the redemption has been signed with the invite key on behalf of the redeemer
+	pubKey := &secp256k1.PubKey{Key: msg.PublicKey}
+	if !pubKey.VerifySignature(types.GetInviteRedemptionSignBytes(ctx.ChainID(), msg.SubspaceID, msg.Redeemer), msg.Signature) {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "invalid invite redemption signature")
+	}
+
+	// Make sure the invite has not expired
+	if invite.IsExpired(ctx.BlockTime()) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "invite has expired")
+	}
+
+	// Check if the user is already part of the group
+	if k.IsMemberOfGroup(ctx, invite.SubspaceID, invite.GroupID, msg.Redeemer) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "user is already part of group %d", invite.GroupID)
+	}
+
+	// Add the user to the group
+	k.RedeemGroupInvite(ctx, invite, msg.Redeemer)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemedInvite,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUserGroupID, fmt.Sprintf("%d", invite.GroupID)),
+			sdk.NewAttribute(types.AttributeKeySecretHash, invite.SecretHash),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.Redeemer),
+		),
+	})
+
+	return &types.MsgRedeemInviteResponse{}, nil
+}
+
+// ApplyToGroup defines a rpc method for MsgApplyToGroup
+func (k msgServer) ApplyToGroup(goCtx context.Context, msg *types.MsgApplyToGroup) (*types.MsgApplyToGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
+	// Make sure the applicant is not banned from the subspace
+	if k.IsUserBanned(ctx, msg.SubspaceID, msg.Applicant) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is banned from subspace %d", msg.Applicant, msg.SubspaceID)
+	}
+
+	// Check if the group exists
+	if !k.HasUserGroup(ctx, msg.SubspaceID, msg.GroupID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "group %d could not be found", msg.GroupID)
+	}
+
+	// Check if the user is already part of the group
+	if k.IsMemberOfGroup(ctx, msg.SubspaceID, msg.GroupID, msg.Applicant) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "user is already part of group %d", msg.GroupID)
+	}
+
+	// Check if the user has already applied
+	if k.HasGroupApplication(ctx, msg.SubspaceID, msg.GroupID, msg.Applicant) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "user has already applied to group %d", msg.GroupID)
+	}
+
+	// Create and validate the application
+	application := types.NewGroupApplication(msg.SubspaceID, msg.GroupID, msg.Applicant, msg.Message, ctx.BlockTime())
+	err := application.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Save the application
+	k.SaveGroupApplication(ctx, application)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAppliedToGroup,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUserGroupID, fmt.Sprintf("%d", msg.GroupID)),
+			sdk.NewAttribute(types.AttributeKeyApplicant, msg.Applicant),
+		),
+	})
+
+	return &types.MsgApplyToGroupResponse{}, nil
+}
+
+// ApproveGroupApplication defines a rpc method for MsgApproveGroupApplication
+func (k msgServer) ApproveGroupApplication(goCtx context.Context, msg *types.MsgApproveGroupApplication) (*types.MsgApproveGroupApplicationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.validateGroupApplicationReview(ctx, msg.SubspaceID, msg.GroupID, msg.Applicant, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the subspace is not archived
+	if k.IsSubspaceArchived(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(types.ErrSubspaceArchived, "subspace with id %d is archived", msg.SubspaceID)
+	}
+
+	// Make sure the applicant has not been banned since applying
+	if k.IsUserBanned(ctx, msg.SubspaceID, msg.Applicant) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is banned from subspace %d", msg.Applicant, msg.SubspaceID)
+	}
+
+	// Add the user to the group, which also removes the application
+	k.AddUserToGroup(ctx, msg.SubspaceID, msg.GroupID, msg.Applicant, nil)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApprovedGroupApplication,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUserGroupID, fmt.Sprintf("%d", msg.GroupID)),
+			sdk.NewAttribute(types.AttributeKeyApplicant, msg.Applicant),
+		),
+	})
+
+	return &types.MsgApproveGroupApplicationResponse{}, nil
+}
+
+// RejectGroupApplication defines a rpc method for MsgRejectGroupApplication
+func (k msgServer) RejectGroupApplication(goCtx context.Context, msg *types.MsgRejectGroupApplication) (*types.MsgRejectGroupApplicationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.validateGroupApplicationReview(ctx, msg.SubspaceID, msg.GroupID, msg.Applicant, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete the application
+	k.DeleteGroupApplication(ctx, msg.SubspaceID, msg.GroupID, msg.Applicant)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRejectedGroupApplication,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUserGroupID, fmt.Sprintf("%d", msg.GroupID)),
+			sdk.NewAttribute(types.AttributeKeyApplicant, msg.Applicant),
+		),
+	})
+
+	return &types.MsgRejectGroupApplicationResponse{}, nil
+}
+
+// validateGroupApplicationReview makes sure that the given application exists and
+// that the signer has the permission to review it
+func (k msgServer) validateGroupApplicationReview(ctx sdk.Context, subspaceID uint64, groupID uint32, applicant string, signer string) error {
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, subspaceID) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", subspaceID)
+	}
+
+	// Check if the group exists
+	group, found := k.GetUserGroup(ctx, subspaceID, groupID)
+	if !found {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "group %d could not be found", groupID)
+	}
+
+	// Check the permissions
+	if !k.HasPermission(ctx, group.SubspaceID, group.SectionID, signer, types.PermissionSetPermissions) {
+		return errors.Wrap(types.ErrPermissionDenied, "you cannot manage user group members in this subspace")
+	}
+
+	// Check if the application exists
+	if !k.HasGroupApplication(ctx, subspaceID, groupID, applicant) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "application could not be found")
+	}
+
+	return nil
+}
+
+// SetUserPermissions defines a rpc method for MsgSetUserPermissions
+func (k msgServer) SetUserPermissions(goCtx context.Context, msg *types.MsgSetUserPermissions) (*types.MsgSetUserPermissionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Check if the section exists
+	if !k.HasSection(ctx, msg.SubspaceID, msg.SectionID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "section with id %d not found inside subspace %d", msg.SectionID, msg.SubspaceID)
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", msg.Signer)
+	}
+
+	// Check the permissions
+	if !k.HasPermission(ctx, msg.SubspaceID, msg.SectionID, signer.String(), types.PermissionSetPermissions) {
+		return nil, errors.Wrapf(types.ErrPermissionDenied, "you cannot manage permissions in this subspace")
+	}
+
+	// Make sure the permission is valid
+	if !types.ArePermissionsValid(msg.Permissions) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid permission value")
+	}
+
+	// Make sure the expiration time is in the future
+	if msg.ExpirationTime != nil && !msg.ExpirationTime.After(ctx.BlockTime()) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "expiration time must be in the future")
+	}
+
+	// Set the permissions
+	if msg.Permissions == nil {
+		// Remove the permission to clear the store if empty permissions are provided
+		k.RemoveUserPermissions(ctx, msg.SubspaceID, msg.SectionID, msg.User)
+	} else {
+		k.Keeper.SetUserPermissions(ctx, msg.SubspaceID, msg.SectionID, msg.User, msg.Permissions, msg.ExpirationTime)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetUserPermissions,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeySectionID, fmt.Sprintf("%d", msg.SectionID)),
+			sdk.NewAttribute(types.AttributeKeyPermissions, strings.Join(msg.Permissions, ",")),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.User),
+		),
+	})
+
+	return &types.MsgSetUserPermissionsResponse{}, nil
+}
+
+// BanUser defines a rpc method for MsgBanUser
+func (k msgServer) BanUser(goCtx context.Context, msg *types.MsgBanUser) (*types.MsgBanUserResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	subspace, found := k.GetSubspace(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Check the permission to ban users
+	if !k.HasPermission(ctx, msg.SubspaceID, types.RootSectionID, msg.Signer, types.PermissionBanUsers) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot ban users in this subspace")
+	}
+
+	// Make sure the owner is not being banned
+	if subspace.Owner == msg.User {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "the subspace owner cannot be banned")
+	}
+
+	// Make sure the user is not banned already
+	if k.IsUserBanned(ctx, msg.SubspaceID, msg.User) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "user is already banned from subspace %d", msg.SubspaceID)
+	}
+
+	// Make sure the expiration time is in the future
+	if msg.ExpirationTime != nil && !msg.ExpirationTime.After(ctx.BlockTime()) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "expiration time must be in the future")
+	}
+
+	// Validate the ban
+	ban := types.NewSubspaceBan(msg.SubspaceID, msg.User, msg.Reason, msg.Signer, msg.ExpirationTime)
+	err := ban.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Save the ban
+	k.SaveSubspaceBan(ctx, ban)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBannedUser,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.User),
+		),
+	})
+
+	return &types.MsgBanUserResponse{}, nil
+}
+
+// UnbanUser defines a rpc method for MsgUnbanUser
+func (k msgServer) UnbanUser(goCtx context.Context, msg *types.MsgUnbanUser) (*types.MsgUnbanUserResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Check the permission to unban users
+	if !k.HasPermission(ctx, msg.SubspaceID, types.RootSectionID, msg.Signer, types.PermissionBanUsers) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot unban users in this subspace")
+	}
+
+	// Make sure the ban exists
+	if !k.HasSubspaceBan(ctx, msg.SubspaceID, msg.User) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "user is not banned from subspace %d", msg.SubspaceID)
+	}
+
+	// Delete the ban
+	k.DeleteSubspaceBan(ctx, msg.SubspaceID, msg.User)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbannedUser,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.User),
+		),
+	})
+
+	return &types.MsgUnbanUserResponse{}, nil
+}
+
+// SetRateLimit defines a rpc method for MsgSetRateLimit
+func (k msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Check the permission to manage rate limits
+	if !k.HasPermission(ctx, msg.SubspaceID, types.RootSectionID, msg.Signer, types.PermissionManageRateLimits) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot manage rate limits in this subspace")
+	}
+
+	// Validate the rate limit
+	limit := types.NewRateLimit(msg.SubspaceID, msg.MsgTypeURL, msg.MaxMessages, msg.Window)
+	err := limit.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Save the rate limit
+	k.SaveRateLimit(ctx, limit)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetRateLimit,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeURL),
+		),
+	})
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines a rpc method for MsgRemoveRateLimit
+func (k msgServer) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Check the permission to manage rate limits
+	if !k.HasPermission(ctx, msg.SubspaceID, types.RootSectionID, msg.Signer, types.PermissionManageRateLimits) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot manage rate limits in this subspace")
+	}
+
+	// Make sure the rate limit exists
+	if !k.HasRateLimit(ctx, msg.SubspaceID, msg.MsgTypeURL) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "rate limit for %s not found inside subspace %d", msg.MsgTypeURL, msg.SubspaceID)
+	}
+
+	// Delete the rate limit
+	k.DeleteRateLimit(ctx, msg.SubspaceID, msg.MsgTypeURL)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemovedRateLimit,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeURL),
+		),
+	})
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// SetFeeTokensConfig defines a rpc method for MsgSetFeeTokensConfig
+func (k msgServer) SetFeeTokensConfig(goCtx context.Context, msg *types.MsgSetFeeTokensConfig) (*types.MsgSetFeeTokensConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	subspace, found := k.GetSubspace(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Check the permission to manage fee tokens
+	if !k.HasPermission(ctx, msg.SubspaceID, types.RootSectionID, msg.Signer, types.PermissionManageFeeTokens) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot manage fee tokens in this subspace")
+	}
+
+	// Validate the config
+	config := types.NewFeeTokensConfig(msg.SubspaceID, msg.BaseDenom, msg.Rates, msg.Destination)
+	err := config.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Make sure all the tokens are accepted as fee tokens inside the subspace
+	for _, rate := range config.Rates {
+		if subspace.AdditionalFeeTokens.AmountOf(rate.Denom).IsZero() {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not an additional fee token of subspace %d", rate.Denom, msg.SubspaceID)
+		}
+	}
+
+	// Save the config
+	k.SaveFeeTokensConfig(ctx, config)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetFeeTokensConfig,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.Signer),
+		),
+	})
+
+	return &types.MsgSetFeeTokensConfigResponse{}, nil
+}
+
+// RemoveFeeTokensConfig defines a rpc method for MsgRemoveFeeTokensConfig
+func (k msgServer) RemoveFeeTokensConfig(goCtx context.Context, msg *types.MsgRemoveFeeTokensConfig) (*types.MsgRemoveFeeTokensConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Check the permission to manage fee tokens
+	if !k.HasPermission(ctx, msg.SubspaceID, types.RootSectionID, msg.Signer, types.PermissionManageFeeTokens) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot manage fee tokens in this subspace")
+	}
+
+	// Make sure the config exists
+	if !k.HasFeeTokensConfig(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "fee tokens config not found inside subspace %d", msg.SubspaceID)
+	}
+
+	// Delete the config
+	k.DeleteFeeTokensConfig(ctx, msg.SubspaceID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemovedFeeTokensConfig,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.Signer),
+		),
+	})
+
+	return &types.MsgRemoveFeeTokensConfigResponse{}, nil
+}
+
+// GrantTreasuryAuthorization defines a rpc method for MsgGrantTreasuryAuthorization
+func (k msgServer) GrantTreasuryAuthorization(goCtx context.Context, msg *types.MsgGrantTreasuryAuthorization) (*types.MsgGrantTreasuryAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	subspace, found := k.GetSubspace(ctx, msg.SubspaceID)
+	if !found {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("subspace with id %d not found", msg.SubspaceID)
+	}
+
+	if !k.HasPermission(ctx, msg.SubspaceID, types.RootSectionID, msg.Granter, types.PermissionManageTreasuryAuthorization) {
+		return nil, types.ErrPermissionDenied.Wrap("you cannot manage this subspace's treasury authorizations")
+	}
+
+	treasury, err := sdk.AccAddressFromBech32(subspace.Treasury)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid treasury address: %s", treasury.String())
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", msg.Grantee)
+	}
+	k.createAccountIfNotExists(ctx, grantee.String())
+
+	authorization, err := msg.Grant.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.authzk.SaveGrant(ctx, grantee, treasury, authorization, msg.Grant.Expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantedTreasuryAuthorization,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
 			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
 		),
 	})
@@ -830,6 +1672,169 @@ func (k msgServer) RevokeTreasuryAuthorization(goCtx context.Context, msg *types
 	return &types.MsgRevokeTreasuryAuthorizationResponse{}, nil
 }
 
+// SetTreasuryVotingConfig defines a rpc method for MsgSetTreasuryVotingConfig
+func (k msgServer) SetTreasuryVotingConfig(goCtx context.Context, msg *types.MsgSetTreasuryVotingConfig) (*types.MsgSetTreasuryVotingConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Check the permission to manage the treasury
+	if !k.HasPermission(ctx, msg.SubspaceID, types.RootSectionID, msg.Signer, types.PermissionManageTreasuryAuthorization) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot manage this subspace's treasury")
+	}
+
+	// Make sure the group exists
+	if !k.HasUserGroup(ctx, msg.SubspaceID, msg.GroupID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "group with id %d not found", msg.GroupID)
+	}
+
+	// Validate the config
+	config := types.NewTreasuryVotingConfig(msg.SubspaceID, msg.GroupID, msg.Threshold, msg.Quorum, msg.VotingPeriod)
+	err := config.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Save the config
+	k.SaveTreasuryVotingConfig(ctx, config)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetTreasuryVotingConfig,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyUserGroupID, fmt.Sprintf("%d", msg.GroupID)),
+		),
+	})
+
+	return &types.MsgSetTreasuryVotingConfigResponse{}, nil
+}
+
+// SubmitTreasuryProposal defines a rpc method for MsgSubmitTreasuryProposal
+func (k msgServer) SubmitTreasuryProposal(goCtx context.Context, msg *types.MsgSubmitTreasuryProposal) (*types.MsgSubmitTreasuryProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	subspace, found := k.GetSubspace(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the treasury proposals are enabled
+	config, found := k.GetTreasuryVotingConfig(ctx, msg.SubspaceID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "treasury voting config for subspace %d not found", msg.SubspaceID)
+	}
+
+	// Make sure the proposer is allowed to submit a proposal
+	if !k.CanSubmitTreasuryProposal(ctx, msg.SubspaceID, config.GroupID, msg.Proposer) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot submit treasury proposals in this subspace")
+	}
+
+	// Make sure all the messages are signed by the treasury
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	for i, m := range msgs {
+		signers := m.GetSigners()
+		if len(signers) != 1 || signers[0].String() != subspace.Treasury {
+			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "message at index %d must be signed by the subspace treasury only", i)
+		}
+	}
+
+	// Create the proposal
+	proposalID := k.GetNextTreasuryProposalID(ctx, msg.SubspaceID)
+	proposal := types.TreasuryProposal{
+		SubspaceID:    msg.SubspaceID,
+		ID:            proposalID,
+		Proposer:      msg.Proposer,
+		Messages:      msg.Messages,
+		GroupID:       config.GroupID,
+		Threshold:     config.Threshold,
+		Quorum:        config.Quorum,
+		SubmitTime:    ctx.BlockTime(),
+		VotingEndTime: ctx.BlockTime().Add(config.VotingPeriod),
+		Status:        types.TREASURY_PROPOSAL_STATUS_VOTING,
+		Voters:        k.GetTreasuryProposalVoters(ctx, msg.SubspaceID, config.GroupID),
+	}
+	err = proposal.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Save the proposal and update the next id
+	k.SaveTreasuryProposal(ctx, proposal)
+	k.SetNextTreasuryProposalID(ctx, msg.SubspaceID, proposalID+1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubmittedTreasuryProposal,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer),
+		),
+	})
+
+	return &types.MsgSubmitTreasuryProposalResponse{ProposalID: proposalID}, nil
+}
+
+// VoteTreasuryProposal defines a rpc method for MsgVoteTreasuryProposal
+func (k msgServer) VoteTreasuryProposal(goCtx context.Context, msg *types.MsgVoteTreasuryProposal) (*types.MsgVoteTreasuryProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the subspace exists
+	if !k.HasSubspace(ctx, msg.SubspaceID) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "subspace with id %d not found", msg.SubspaceID)
+	}
+
+	// Check if the proposal exists
+	proposal, found := k.GetTreasuryProposal(ctx, msg.SubspaceID, msg.ProposalID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "treasury proposal with id %d not found", msg.ProposalID)
+	}
+
+	// Make sure the proposal can still be voted
+	if proposal.Status != types.TREASURY_PROPOSAL_STATUS_VOTING || proposal.IsVotingPeriodEnded(ctx.BlockTime()) {
+		return nil, errors.Wrapf(types.ErrTreasuryProposalNotVoting, "treasury proposal with id %d cannot be voted", msg.ProposalID)
+	}
+
+	// Make sure the voter is allowed to vote
+	if !k.CanVoteTreasuryProposal(ctx, proposal, msg.Voter) {
+		return nil, errors.Wrap(types.ErrPermissionDenied, "you cannot vote this treasury proposal")
+	}
+
+	// Validate the vote
+	vote := types.NewTreasuryVote(msg.SubspaceID, msg.ProposalID, msg.Voter, msg.Option)
+	err := vote.Validate()
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Save the vote
+	k.SaveTreasuryVote(ctx, vote)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVotedTreasuryProposal,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", msg.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalID)),
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Voter),
+			sdk.NewAttribute(types.AttributeKeyVoteOption, msg.Option.String()),
+		),
+	})
+
+	// Execute the proposal if it has reached the threshold
+	if k.HasTreasuryProposalPassed(ctx, proposal) {
+		k.ExecuteTreasuryProposal(ctx, proposal)
+	}
+
+	return &types.MsgVoteTreasuryProposalResponse{}, nil
+}
+
 // GrantAllowance defines a rpc method for MsgGrantAllowance
 func (k msgServer) GrantAllowance(goCtx context.Context, msg *types.MsgGrantAllowance) (*types.MsgGrantAllowanceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
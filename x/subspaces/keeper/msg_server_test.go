@@ -327,6 +327,37 @@ func (suite *KeeperTestSuite) TestMsgServer_EditSubspace() {
 				), subspace)
 			},
 		},
+		{
+			name: "owner change returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1y4emx0mm4ncva9mnv9yvjrm7nrq3psvmwhk9ll",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					blockTime,
+					nil,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionEditSubspace),
+					nil,
+				)
+			},
+			msg: types.NewMsgEditSubspace(
+				1,
+				types.DoNotModify,
+				types.DoNotModify,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
 		{
 			name: "existing subspace is updated correctly",
 			store: func(ctx sdk.Context) {
@@ -346,6 +377,7 @@ func (suite *KeeperTestSuite) TestMsgServer_EditSubspace() {
 					0,
 					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 					types.NewPermissions(types.PermissionEditSubspace),
+					nil,
 				)
 			},
 			msg: types.NewMsgEditSubspace(
@@ -475,6 +507,7 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteSubspace() {
 					0,
 					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 					types.NewPermissions(types.PermissionDeleteSubspace),
+					nil,
 				)
 			},
 			msg:       types.NewMsgDeleteSubspace(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
@@ -518,221 +551,92 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteSubspace() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_CreateSection() {
+func (suite *KeeperTestSuite) TestMsgServer_ArchiveSubspace() {
 	testCases := []struct {
-		name        string
-		store       func(ctx sdk.Context)
-		msg         *types.MsgCreateSection
-		shouldErr   bool
-		expResponse *types.MsgCreateSectionResponse
-		expEvent    sdk.Events
-		check       func(ctx sdk.Context)
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgArchiveSubspace
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
 	}{
 		{
-			name: "non existing subspace returns error",
-			msg: types.NewMsgCreateSection(
-				1,
-				"Test section",
-				"This is a test section",
-				0,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-			),
-			shouldErr: true,
-		},
-		{
-			name: "missing parent section returns error",
-			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
-			},
-			msg: types.NewMsgCreateSection(
-				1,
-				"Test section",
-				"This is a test section",
-				1,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-			),
-			shouldErr: true,
-		},
-		{
-			name: "user without permission returns error",
-			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
-
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
-					1,
-					0,
-					"Child section",
-					"",
-				))
-			},
-			msg: types.NewMsgCreateSection(
-				1,
-				"Test section",
-				"This is a test section",
-				1,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-			),
+			name:      "subspace not found returns error",
+			msg:       types.NewMsgArchiveSubspace(1, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
 			shouldErr: true,
 		},
 		{
-			name: "missing next section id returns error",
+			name: "non owner signer returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.DeleteNextSectionID(ctx, 1)
-
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
-					1,
-					0,
-					"Child section",
-					"",
-				))
 
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-					types.NewPermissions(types.PermissionManageSections),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionEverything),
+					nil,
 				)
 			},
-			msg: types.NewMsgCreateSection(
-				1,
-				"Test section",
-				"This is a test section",
-				1,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-			),
+			msg:       types.NewMsgArchiveSubspace(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 			shouldErr: true,
 		},
 		{
-			name: "invalid data returns error",
+			name: "already archived subspace returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
+				subspace := types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
-				))
-				suite.k.SetNextSectionID(ctx, 1, 2)
-
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
-					1,
-					0,
-					"Child section",
-					"",
-				))
-
-				suite.k.SetUserPermissions(ctx,
-					1,
-					0,
-					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-					types.NewPermissions(types.PermissionManageSections),
 				)
+				subspace.Archived = true
+				suite.k.SaveSubspace(ctx, subspace)
 			},
-			msg: types.NewMsgCreateSection(
-				1,
-				"",
-				"This is a test section",
-				1,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-			),
+			msg:       types.NewMsgArchiveSubspace(1, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
 			shouldErr: true,
 		},
 		{
-			name: "section is created properly",
+			name: "subspace is archived correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SetNextSectionID(ctx, 1, 2)
-
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
-					1,
-					0,
-					"Child section",
-					"",
-				))
-
-				suite.k.SetUserPermissions(ctx,
-					1,
-					0,
-					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-					types.NewPermissions(types.PermissionManageSections),
-				)
 			},
-			msg: types.NewMsgCreateSection(
-				1,
-				"Test section",
-				"This is a test section",
-				0,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-			),
-			shouldErr:   false,
-			expResponse: &types.MsgCreateSectionResponse{SectionID: 2},
-			expEvent: sdk.Events{
+			msg:       types.NewMsgArchiveSubspace(1, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			shouldErr: false,
+			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeCreatedSection,
+					types.EventTypeArchivedSubspace,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeySectionID, "2"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				// Check the next section id
-				storedID, err := suite.k.GetNextSectionID(ctx, 1)
-				suite.Require().NoError(err)
-				suite.Require().Equal(uint32(3), storedID)
-
-				// Check the section data
-				stored, found := suite.k.GetSection(ctx, 1, 2)
+				subspace, found := suite.k.GetSubspace(ctx, 1)
 				suite.Require().True(found)
-				suite.Require().Equal(types.NewSection(
-					1,
-					2,
-					0,
-					"Test section",
-					"This is a test section",
-				), stored)
+				suite.Require().True(subspace.Archived)
+				suite.Require().True(suite.k.IsSubspaceArchived(ctx, 1))
 			},
 		},
 	}
@@ -745,14 +649,16 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateSection() {
 				tc.store(ctx)
 			}
 
-			msgServer := keeper.NewMsgServerImpl(suite.k)
-			res, err := msgServer.CreateSection(sdk.WrapSDKContext(ctx), tc.msg)
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.ArchiveSubspace(sdk.WrapSDKContext(ctx), tc.msg)
+
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
-				suite.Require().Equal(tc.expResponse, res)
-				suite.Require().Equal(tc.expEvent, ctx.EventManager().Events())
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
 				if tc.check != nil {
 					tc.check(ctx)
 				}
@@ -761,172 +667,4341 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateSection() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_EditSection() {
+func (suite *KeeperTestSuite) TestMsgServer_UnarchiveSubspace() {
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
-		msg       *types.MsgEditSection
+		msg       *types.MsgUnarchiveSubspace
 		shouldErr bool
 		expEvents sdk.Events
 		check     func(ctx sdk.Context)
 	}{
 		{
-			name: "non existing subspace returns error",
-			msg: types.NewMsgEditSection(
-				1,
-				1,
-				"Edited section",
-				"This is an edited section",
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-			),
+			name:      "subspace not found returns error",
+			msg:       types.NewMsgUnarchiveSubspace(1, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
 			shouldErr: true,
 		},
 		{
-			name: "non existing section returns error",
+			name: "non owner signer returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
+				subspace := types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				)
+				subspace.Archived = true
+				suite.k.SaveSubspace(ctx, subspace)
+			},
+			msg:       types.NewMsgUnarchiveSubspace(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: true,
+		},
+		{
+			name: "not archived subspace returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg:       types.NewMsgUnarchiveSubspace(1, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			shouldErr: true,
+		},
+		{
+			name: "subspace is unarchived correctly",
+			store: func(ctx sdk.Context) {
+				subspace := types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				)
+				subspace.Archived = true
+				suite.k.SaveSubspace(ctx, subspace)
+			},
+			msg:       types.NewMsgUnarchiveSubspace(1, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeUnarchivedSubspace,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				subspace, found := suite.k.GetSubspace(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				), subspace)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.UnarchiveSubspace(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_RequestSubspaceOwnerTransfer() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgRequestSubspaceOwnerTransfer
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "subspace not found returns error",
+			msg:       types.NewMsgRequestSubspaceOwnerTransfer(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			shouldErr: true,
+		},
+		{
+			name: "non owner sender returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg:       types.NewMsgRequestSubspaceOwnerTransfer(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+			shouldErr: true,
+		},
+		{
+			name: "already existing request returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				))
+			},
+			msg:       types.NewMsgRequestSubspaceOwnerTransfer(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			shouldErr: true,
+		},
+		{
+			name: "request is saved correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg:       types.NewMsgRequestSubspaceOwnerTransfer(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRequestedSubspaceOwnerTransfer,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyReceiver, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					sdk.NewAttribute(types.AttributeKeySender, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				request, found := suite.k.GetSubspaceOwnerTransferRequest(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				), request)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.RequestSubspaceOwnerTransfer(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_CancelSubspaceOwnerTransferRequest() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgCancelSubspaceOwnerTransferRequest
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "request not found returns error",
+			msg:       types.NewMsgCancelSubspaceOwnerTransferRequest(1, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			shouldErr: true,
+		},
+		{
+			name: "non sender returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				))
+			},
+			msg:       types.NewMsgCancelSubspaceOwnerTransferRequest(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+			shouldErr: true,
+		},
+		{
+			name: "request is canceled correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				))
+			},
+			msg:       types.NewMsgCancelSubspaceOwnerTransferRequest(1, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeCanceledSubspaceOwnerTransfer,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeySender, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasSubspaceOwnerTransferRequest(ctx, 1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.CancelSubspaceOwnerTransferRequest(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_AcceptSubspaceOwnerTransferRequest() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgAcceptSubspaceOwnerTransferRequest
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "request not found returns error",
+			msg:       types.NewMsgAcceptSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: true,
+		},
+		{
+			name: "non receiver returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				))
+			},
+			msg:       types.NewMsgAcceptSubspaceOwnerTransferRequest(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+			shouldErr: true,
+		},
+		{
+			name: "subspace not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				))
+			},
+			msg:       types.NewMsgAcceptSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: true,
+		},
+		{
+			name: "request sender not being the owner returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				))
+			},
+			msg:       types.NewMsgAcceptSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: true,
+		},
+		{
+			name: "request is accepted correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				))
+			},
+			msg:       types.NewMsgAcceptSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeAcceptedSubspaceOwnerTransfer,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeySender, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+					sdk.NewAttribute(types.AttributeKeyReceiver, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasSubspaceOwnerTransferRequest(ctx, 1))
+
+				subspace, found := suite.k.GetSubspace(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				), subspace)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.AcceptSubspaceOwnerTransferRequest(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_RefuseSubspaceOwnerTransferRequest() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgRefuseSubspaceOwnerTransferRequest
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "request not found returns error",
+			msg:       types.NewMsgRefuseSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: true,
+		},
+		{
+			name: "non receiver returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				))
+			},
+			msg:       types.NewMsgRefuseSubspaceOwnerTransferRequest(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+			shouldErr: true,
+		},
+		{
+			name: "request is refused correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				))
+			},
+			msg:       types.NewMsgRefuseSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRefusedSubspaceOwnerTransfer,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyReceiver, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasSubspaceOwnerTransferRequest(ctx, 1))
+
+				subspace, found := suite.k.GetSubspace(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				), subspace)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.RefuseSubspaceOwnerTransferRequest(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_CreateSection() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		msg         *types.MsgCreateSection
+		shouldErr   bool
+		expResponse *types.MsgCreateSectionResponse
+		expEvent    sdk.Events
+		check       func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing subspace returns error",
+			msg: types.NewMsgCreateSection(
+				1,
+				"Test section",
+				"This is a test section",
+				0,
+				types.SECTION_VISIBILITY_PUBLIC,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "missing parent section returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgCreateSection(
+				1,
+				"Test section",
+				"This is a test section",
+				1,
+				types.SECTION_VISIBILITY_PUBLIC,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user without permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Child section",
+					"",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+			},
+			msg: types.NewMsgCreateSection(
+				1,
+				"Test section",
+				"This is a test section",
+				1,
+				types.SECTION_VISIBILITY_PUBLIC,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "missing next section id returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.DeleteNextSectionID(ctx, 1)
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Child section",
+					"",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.NewPermissions(types.PermissionManageSections),
+					nil,
+				)
+			},
+			msg: types.NewMsgCreateSection(
+				1,
+				"Test section",
+				"This is a test section",
+				1,
+				types.SECTION_VISIBILITY_PUBLIC,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid data returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SetNextSectionID(ctx, 1, 2)
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Child section",
+					"",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.NewPermissions(types.PermissionManageSections),
+					nil,
+				)
+			},
+			msg: types.NewMsgCreateSection(
+				1,
+				"",
+				"This is a test section",
+				1,
+				types.SECTION_VISIBILITY_PUBLIC,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "section is created properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SetNextSectionID(ctx, 1, 2)
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Child section",
+					"",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.NewPermissions(types.PermissionManageSections),
+					nil,
+				)
+			},
+			msg: types.NewMsgCreateSection(
+				1,
+				"Test section",
+				"This is a test section",
+				0,
+				types.SECTION_VISIBILITY_PUBLIC,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr:   false,
+			expResponse: &types.MsgCreateSectionResponse{SectionID: 2},
+			expEvent: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeCreatedSection,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeySectionID, "2"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				// Check the next section id
+				storedID, err := suite.k.GetNextSectionID(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint32(3), storedID)
+
+				// Check the section data
+				stored, found := suite.k.GetSection(ctx, 1, 2)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSection(
+					1,
+					2,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				), stored)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServerImpl(suite.k)
+			res, err := msgServer.CreateSection(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResponse, res)
+				suite.Require().Equal(tc.expEvent, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_EditSection() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgEditSection
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing subspace returns error",
+			msg: types.NewMsgEditSection(
+				1,
+				1,
+				"Edited section",
+				"This is an edited section",
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing section returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgEditSection(
+				1,
+				1,
+				"Edited section",
+				"This is an edited section",
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user without permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Child section",
+					"",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+			},
+			msg: types.NewMsgEditSection(
+				1,
+				1,
+				"Edited section",
+				"This is an edited section",
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid update data returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Child section",
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.NewPermissions(types.PermissionManageSections),
+					nil,
+				)
+			},
+			msg: types.NewMsgEditSection(
+				1,
+				1,
+				"",
+				"This is an edited section",
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "section is updated properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Child section",
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.NewPermissions(types.PermissionManageSections),
+					nil,
+				)
+			},
+			msg: types.NewMsgEditSection(
+				1,
+				1,
+				"Edited section",
+				"This is an edited section",
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeEditedSection,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeySectionID, "1"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetSection(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSection(
+					1,
+					1,
+					0,
+					"Edited section",
+					"This is an edited section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				), stored)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServerImpl(suite.k)
+			_, err := msgServer.EditSection(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_MoveSection() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgMoveSection
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing subspace returns error",
+			msg: types.NewMsgMoveSection(
+				1,
+				1,
+				0,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing section returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgMoveSection(
+				1,
+				1,
+				0,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing destination section returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					2,
+					1,
+					"Child section",
+					"",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+			},
+			msg: types.NewMsgMoveSection(
+				1,
+				2,
+				3,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user without permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(1, 1, 0, "Child section", "", types.SECTION_VISIBILITY_PUBLIC))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					2,
+					1,
+					"Child section",
+					"",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+			},
+			msg: types.NewMsgMoveSection(
+				1,
+				2,
+				0,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "moving section to be its own parent returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					2,
+					1,
+					"Child section",
+					"",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+			},
+			msg: types.NewMsgMoveSection(
+				1,
+				2,
+				2,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "moving section to create a circular path returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				// Create the following subspaces sections
+				//  A
+				//  |
+				//  B - C
+				//
+				// We will then move A to be a child of C to create the following path
+				//   A
+				//  /  \
+				//  B - C
+				suite.k.SaveSection(ctx, types.NewSection(1, 1, types.RootSectionID, "A", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 2, 1, "B", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 3, 2, "C", "", types.SECTION_VISIBILITY_PUBLIC))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.NewPermissions(types.PermissionManageSections),
+					nil,
+				)
+			},
+			msg: types.NewMsgMoveSection(
+				1,
+				1,
+				3,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "section is moved properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(1, 1, 0, "Child section", "", types.SECTION_VISIBILITY_PUBLIC))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					2,
+					1,
+					"Child section",
+					"This is child section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.NewPermissions(types.PermissionManageSections),
+					nil,
+				)
+			},
+			msg: types.NewMsgMoveSection(
+				1,
+				2,
+				0,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeMovedSection,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeySectionID, "2"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetSection(ctx, 1, 2)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSection(
+					1,
+					2,
+					0,
+					"Child section",
+					"This is child section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				), stored)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServerImpl(suite.k)
+			_, err := msgServer.MoveSection(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_SetSectionVisibility() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgSetSectionVisibility
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing subspace returns error",
+			msg: types.NewMsgSetSectionVisibility(
+				1,
+				1,
+				types.SECTION_VISIBILITY_MEMBERS_ONLY,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing section returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgSetSectionVisibility(
+				1,
+				1,
+				types.SECTION_VISIBILITY_MEMBERS_ONLY,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user without permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+			},
+			msg: types.NewMsgSetSectionVisibility(
+				1,
+				1,
+				types.SECTION_VISIBILITY_MEMBERS_ONLY,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "section visibility is set properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.NewPermissions(types.PermissionManageSections),
+					nil,
+				)
+			},
+			msg: types.NewMsgSetSectionVisibility(
+				1,
+				1,
+				types.SECTION_VISIBILITY_MEMBERS_ONLY,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSetSectionVisibility,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeySectionID, "1"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				stored, found := suite.k.GetSection(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_MEMBERS_ONLY,
+				), stored)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServerImpl(suite.k)
+			_, err := msgServer.SetSectionVisibility(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_DeleteSection() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgDeleteSection
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing subspace returns error",
+			msg: types.NewMsgDeleteSection(
+				1,
+				1,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing section returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgDeleteSection(
+				1,
+				1,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user without permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+			},
+			msg: types.NewMsgDeleteSection(
+				1,
+				1,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "section is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace with another name and owner",
+					"This is a test subspace with a changed description",
+					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
+
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					types.NewPermissions(types.PermissionManageSections),
+					nil,
+				)
+			},
+			msg: types.NewMsgDeleteSection(
+				1,
+				1,
+				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeDeletedSection,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeySectionID, "1"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				result := suite.k.HasSection(ctx, 1, 1)
+				suite.Require().False(result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServerImpl(suite.k)
+			_, err := msgServer.DeleteSection(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_CreateUserGroup() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		msg         *types.MsgCreateUserGroup
+		shouldErr   bool
+		expResponse *types.MsgCreateUserGroupResponse
+		expEvents   sdk.Events
+		check       func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing subspace returns error",
+			msg: types.NewMsgCreateUserGroup(
+				1,
+				0,
+				"group",
+				"description",
+				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "archived subspace returns error",
+			store: func(ctx sdk.Context) {
+				subspace := types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				)
+				subspace.Archived = true
+				suite.k.SaveSubspace(ctx, subspace)
+			},
+			msg: types.NewMsgCreateUserGroup(
+				1,
+				0,
+				"group",
+				"description",
+				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing section returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgCreateUserGroup(
+				1,
+				1,
+				"group",
+				"description",
+				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
+				"cosmos1y4emx0mm4ncva9mnv9yvjrm7nrq3psvmwhk9ll",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user without PermissionManageGroups returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgCreateUserGroup(
+				1,
+				0,
+				"group",
+				"description",
+				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
+				"cosmos1y4emx0mm4ncva9mnv9yvjrm7nrq3psvmwhk9ll",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user without PermissionSetPermissions returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1y4emx0mm4ncva9mnv9yvjrm7nrq3psvmwhk9ll",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+			},
+			msg: types.NewMsgCreateUserGroup(
+				1,
+				0,
+				"group",
+				"description",
+				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
+				"cosmos1y4emx0mm4ncva9mnv9yvjrm7nrq3psvmwhk9ll",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid permissions value returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+				suite.k.SetNextGroupID(ctx, 1, 2)
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.CombinePermissions(types.PermissionManageGroups, types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgCreateUserGroup(
+				1,
+				0,
+				"another group",
+				"another description",
+				types.NewPermissions("invalid"),
+				nil,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "group is created properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+				suite.k.SetNextGroupID(ctx, 1, 2)
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.CombinePermissions(types.PermissionManageGroups, types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgCreateUserGroup(
+				1,
+				0,
+				"another group",
+				"another description",
+				types.NewPermissions(types.PermissionEditSubspace),
+				[]string{"cosmos16yhs7fgqnf6fjm4tftv66g2smtmee62wyg780l"},
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr:   false,
+			expResponse: &types.MsgCreateUserGroupResponse{GroupID: 2},
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeCreatedUserGroup,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "2"),
+				),
+				sdk.NewEvent(
+					types.EventTypeAddedUserToGroup,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "2"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos16yhs7fgqnf6fjm4tftv66g2smtmee62wyg780l"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.HasUserGroup(ctx, 1, 1))
+				suite.Require().True(suite.k.HasUserGroup(ctx, 1, 2))
+				suite.Require().True(suite.k.IsMemberOfGroup(ctx, 1, 2, "cosmos16yhs7fgqnf6fjm4tftv66g2smtmee62wyg780l"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			res, err := service.CreateUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResponse, res)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_EditUserGroup() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgEditUserGroup
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing subspace returns error",
+			msg: types.NewMsgEditUserGroup(
+				1,
+				1,
+				"Test group",
+				"This is a test group",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "group not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgEditUserGroup(
+				1,
+				1,
+				"Test group",
+				"This is a test group",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgEditUserGroup(
+				1,
+				1,
+				"Test group new name",
+				"This is a test group with a new name",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid update returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+			},
+			msg: types.NewMsgEditUserGroup(
+				1,
+				1,
+				"",
+				"",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "existing group is edited properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+			},
+			msg: types.NewMsgEditUserGroup(
+				1,
+				1,
+				"Admins",
+				"Group of the admins of th subspace",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeEditedUserGroup,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				group, found := suite.k.GetUserGroup(ctx, 1, 1)
+				suite.Require().True(found)
+
+				suite.Require().Equal(types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Admins",
+					"Group of the admins of th subspace",
+					types.NewPermissions(types.PermissionEditSubspace),
+				), group)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.EditUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_MoveUserGroup() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgMoveUserGroup
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing subspace returns error",
+			msg: types.NewMsgMoveUserGroup(
+				1,
+				1,
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "group not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgMoveUserGroup(
+				1,
+				1,
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing destination section returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgMoveUserGroup(
+				1,
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error - PermissionManageGroups inside current section",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgMoveUserGroup(
+				1,
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error - PermissionManageGroups inside destination section",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+			},
+			msg: types.NewMsgMoveUserGroup(
+				1,
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error - PermissionSetPermissions inside destination section",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+			},
+			msg: types.NewMsgMoveUserGroup(
+				1,
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "existing group is moved properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+				suite.k.SetUserPermissions(ctx,
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+				suite.k.SetUserPermissions(ctx,
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgMoveUserGroup(
+				1,
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeMovedUserGroup,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				group, found := suite.k.GetUserGroup(ctx, 1, 1)
+				suite.Require().True(found)
+
+				suite.Require().Equal(types.NewUserGroup(
+					1,
+					1,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				), group)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.MoveUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_SetUserGroupPermissions() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgSetUserGroupPermissions
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "subspace not found returns error",
+			msg: types.NewMsgSetUserGroupPermissions(
+				1,
+				1,
+				types.NewPermissions(types.PermissionSetPermissions),
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "group not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgSetUserGroupPermissions(
+				1,
+				1,
+				types.NewPermissions(types.PermissionSetPermissions),
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgSetUserGroupPermissions(
+				1,
+				1,
+				types.NewPermissions(types.PermissionSetPermissions),
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid permission value returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgSetUserGroupPermissions(
+				1,
+				1,
+				types.NewPermissions("invalid"),
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "setting the permissions for a group you are part of returns error if not owner",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionSetPermissions),
+				))
+
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+			},
+			msg: types.NewMsgSetUserGroupPermissions(
+				1,
+				1,
+				types.NewPermissions(types.PermissionEverything),
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "setting the permissions for a group you are part of does not return error if owner",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionSetPermissions),
+				))
+
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+			},
+			msg: types.NewMsgSetUserGroupPermissions(
+				1,
+				1,
+				types.NewPermissions(types.PermissionEverything),
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSetUserGroupPermissions,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyPermissions, "EVERYTHING"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				group, found := suite.k.GetUserGroup(ctx, 1, 1)
+				suite.Require().True(found)
+
+				suite.Require().Equal(types.NewPermissions(types.PermissionEverything), group.Permissions)
+			},
+		},
+		{
+			name: "group permissions are updated correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgSetUserGroupPermissions(
+				1,
+				1,
+				types.NewPermissions(types.PermissionSetPermissions),
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSetUserGroupPermissions,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyPermissions, "SET_PERMISSIONS"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				group, found := suite.k.GetUserGroup(ctx, 1, 1)
+				suite.Require().True(found)
+
+				suite.Require().Equal(types.NewPermissions(types.PermissionSetPermissions), group.Permissions)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.SetUserGroupPermissions(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_DeleteUserGroup() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgDeleteUserGroup
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "subspace not found returns error",
+			msg: types.NewMsgDeleteUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "group not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgDeleteUserGroup(
+				1,
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgDeleteUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "existing group is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+			},
+			msg: types.NewMsgDeleteUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeDeletedUserGroup,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				hasGroup := suite.k.HasUserGroup(ctx, 1, 1)
+				suite.Require().False(hasGroup)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.DeleteUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_AddUserToGroup() {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgAddUserToUserGroup
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "subspace not found returns error",
+			msg: types.NewMsgAddUserToUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "archived subspace returns error",
+			store: func(ctx sdk.Context) {
+				subspace := types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				)
+				subspace.Archived = true
+				suite.k.SaveSubspace(ctx, subspace)
+
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgAddUserToUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "group not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgAddUserToUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgAddUserToUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				nil,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "past expiration time returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgAddUserToUserGroup(
+				1,
+				1,
+				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+				&blockTime,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user already part of group returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+			},
+			msg: types.NewMsgAddUserToUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				nil,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user not part of group is added correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgAddUserToUserGroup(
+				1,
+				1,
+				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+				nil,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeAddedUserToGroup,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				result := suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().True(result)
+			},
+		},
+		{
+			name: "user with expiration time is added correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgAddUserToUserGroup(
+				1,
+				1,
+				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+				&expiration,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeAddedUserToGroup,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				result := suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().True(result)
+
+				stored := suite.k.GetUserGroupMemberExpirationTime(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().Equal(&expiration, stored)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.AddUserToUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_RemoveUserFromGroup() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgRemoveUserFromUserGroup
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "subspace not found returns error",
+			msg: types.NewMsgRemoveUserFromUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "group not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgRemoveUserFromUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgRemoveUserFromUserGroup(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user not part of group returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgRemoveUserFromUserGroup(
+				1,
+				1,
+				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user part of group is removed correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
+			},
+			msg: types.NewMsgRemoveUserFromUserGroup(
+				1,
+				1,
+				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRemovedUserFromGroup,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				result := suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.Require().False(result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.RemoveUserFromUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_CreateInvite() {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgCreateInvite
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "subspace not found returns error",
+			msg: types.NewMsgCreateInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "group not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgCreateInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgCreateInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				nil,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "existing invite returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			msg: types.NewMsgCreateInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "past expiration time returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgCreateInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				&blockTime,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invite is created correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgCreateInvite(
+				1,
+				1,
+				types.GetInviteSecretHash("secret"),
+				10,
+				&expiration,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeCreatedInvite,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeySecretHash, types.GetInviteSecretHash("secret")),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				invite, found := suite.k.GetGroupInvite(ctx, 1, types.GetInviteSecretHash("secret"))
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					&expiration,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				), invite)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.CreateInvite(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_DeleteInvite() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgDeleteInvite
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "subspace not found returns error",
+			msg: types.NewMsgDeleteInvite(
+				1,
+				types.GetInviteSecretHash("secret"),
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invite not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgDeleteInvite(
+				1,
+				types.GetInviteSecretHash("secret"),
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "no permission returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			msg: types.NewMsgDeleteInvite(
+				1,
+				types.GetInviteSecretHash("secret"),
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invite is deleted correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			msg: types.NewMsgDeleteInvite(
+				1,
+				types.GetInviteSecretHash("secret"),
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeDeletedInvite,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeySecretHash, types.GetInviteSecretHash("secret")),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasGroupInvite(ctx, 1, types.GetInviteSecretHash("secret")))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.DeleteInvite(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_RedeemInvite() {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	pubKey, signature, err := types.SignInviteRedemption("secret", suite.ctx.ChainID(), 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+	suite.Require().NoError(err)
+
+	wrongPubKey, wrongSignature, err := types.SignInviteRedemption("wrong-secret", suite.ctx.ChainID(), 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgRedeemInvite
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "subspace not found returns error",
+			msg: types.NewMsgRedeemInvite(
+				1,
+				pubKey,
+				signature,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "banned user returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				))
+			},
+			msg: types.NewMsgRedeemInvite(
+				1,
+				pubKey,
+				signature,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invite not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			msg: types.NewMsgRedeemInvite(
+				1,
+				wrongPubKey,
+				wrongSignature,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "signature made for another redeemer returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			msg: types.NewMsgRedeemInvite(
+				1,
+				pubKey,
+				signature,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "expired invite returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					&blockTime,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			msg: types.NewMsgRedeemInvite(
+				1,
+				pubKey,
+				signature,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user already part of the group returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+			},
+			msg: types.NewMsgRedeemInvite(
+				1,
+				pubKey,
+				signature,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invite is redeemed correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					0,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			msg: types.NewMsgRedeemInvite(
+				1,
+				pubKey,
+				signature,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRedeemedInvite,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeySecretHash, types.GetInviteSecretHash("secret")),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+
+				invite, found := suite.k.GetGroupInvite(ctx, 1, types.GetInviteSecretHash("secret"))
+				suite.Require().True(found)
+				suite.Require().Equal(uint32(1), invite.Uses)
+			},
+		},
+		{
+			name: "invite is deleted once it reaches its max uses",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveGroupInvite(ctx, types.NewGroupInvite(
+					1,
+					1,
+					types.GetInviteSecretHash("secret"),
+					10,
+					9,
+					nil,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				))
+			},
+			msg: types.NewMsgRedeemInvite(
+				1,
+				pubKey,
+				signature,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRedeemedInvite,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeySecretHash, types.GetInviteSecretHash("secret")),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().True(suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+				suite.Require().False(suite.k.HasGroupInvite(ctx, 1, types.GetInviteSecretHash("secret")))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.RedeemInvite(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_ApplyToGroup() {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgApplyToGroup
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "subspace not found returns error",
+			msg: types.NewMsgApplyToGroup(
+				1,
+				1,
+				"Let me in",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "archived subspace returns error",
+			store: func(ctx sdk.Context) {
+				subspace := types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				)
+				subspace.Archived = true
+				suite.k.SaveSubspace(ctx, subspace)
+
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+			},
+			msg: types.NewMsgApplyToGroup(
+				1,
+				1,
+				"Let me in",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "group not found returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg: types.NewMsgApplyToGroup(
+				1,
+				1,
+				"Let me in",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "banned user returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				))
 			},
-			msg: types.NewMsgEditSection(
+			msg: types.NewMsgApplyToGroup(
 				1,
 				1,
-				"Edited section",
-				"This is an edited section",
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"Let me in",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "user without permission returns error",
+			name: "user already part of the group returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
 					1,
 					0,
-					"Child section",
-					"",
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
 				))
+
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
 			},
-			msg: types.NewMsgEditSection(
+			msg: types.NewMsgApplyToGroup(
 				1,
 				1,
-				"Edited section",
-				"This is an edited section",
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"Let me in",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "invalid update data returns error",
+			name: "existing application returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
 					1,
 					0,
-					"Child section",
-					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.SetUserPermissions(ctx,
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
 					1,
-					0,
-					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-					types.NewPermissions(types.PermissionManageSections),
-				)
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
 			},
-			msg: types.NewMsgEditSection(
+			msg: types.NewMsgApplyToGroup(
 				1,
 				1,
-				"",
-				"This is an edited section",
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"Let me in",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "section is updated properly",
+			name: "application is created correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
 					1,
 					0,
-					"Child section",
-					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-				))
-
-				suite.k.SetUserPermissions(ctx,
 					1,
-					0,
-					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-					types.NewPermissions(types.PermissionManageSections),
-				)
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
 			},
-			msg: types.NewMsgEditSection(
+			msg: types.NewMsgApplyToGroup(
 				1,
 				1,
-				"Edited section",
-				"This is an edited section",
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"Let me in",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 			),
 			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeEditedSection,
+					types.EventTypeAppliedToGroup,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeySectionID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyApplicant, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				stored, found := suite.k.GetSection(ctx, 1, 1)
+				application, found := suite.k.GetGroupApplication(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
 				suite.Require().True(found)
-				suite.Require().Equal(types.NewSection(
+				suite.Require().Equal(types.NewGroupApplication(
 					1,
 					1,
-					0,
-					"Edited section",
-					"This is an edited section",
-				), stored)
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					blockTime,
+				), application)
 			},
 		},
 	}
@@ -935,17 +5010,21 @@ func (suite *KeeperTestSuite) TestMsgServer_EditSection() {
 		tc := tc
 		suite.Run(tc.name, func() {
 			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
 			if tc.store != nil {
 				tc.store(ctx)
 			}
 
-			msgServer := keeper.NewMsgServerImpl(suite.k)
-			_, err := msgServer.EditSection(sdk.WrapSDKContext(ctx), tc.msg)
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.ApplyToGroup(sdk.WrapSDKContext(ctx), tc.msg)
+
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
 				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
 				if tc.check != nil {
 					tc.check(ctx)
 				}
@@ -954,234 +5033,233 @@ func (suite *KeeperTestSuite) TestMsgServer_EditSection() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_MoveSection() {
+func (suite *KeeperTestSuite) TestMsgServer_ApproveGroupApplication() {
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
-		msg       *types.MsgMoveSection
+		msg       *types.MsgApproveGroupApplication
 		shouldErr bool
 		expEvents sdk.Events
 		check     func(ctx sdk.Context)
 	}{
 		{
-			name: "non existing subspace returns error",
-			msg: types.NewMsgMoveSection(
+			name: "subspace not found returns error",
+			msg: types.NewMsgApproveGroupApplication(
 				1,
 				1,
-				0,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "non existing section returns error",
+			name: "group not found returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
 			},
-			msg: types.NewMsgMoveSection(
+			msg: types.NewMsgApproveGroupApplication(
 				1,
 				1,
-				0,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "non existing destination section returns error",
+			name: "no permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
-					2,
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
 					1,
-					"Child section",
-					"",
-				))
-			},
-			msg: types.NewMsgMoveSection(
-				1,
-				2,
-				3,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-			),
-			shouldErr: true,
-		},
-		{
-			name: "user without permission returns error",
-			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					0,
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
 				))
-				suite.k.SaveSection(ctx, types.NewSection(1, 1, 0, "Child section", ""))
 
-				suite.k.SaveSection(ctx, types.NewSection(
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
 					1,
-					2,
 					1,
-					"Child section",
-					"",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 				))
 			},
-			msg: types.NewMsgMoveSection(
+			msg: types.NewMsgApproveGroupApplication(
 				1,
-				2,
-				0,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "moving section to be its own parent returns error",
+			name: "application not found returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-
-				suite.k.SaveSection(ctx, types.NewSection(
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
 					1,
-					2,
+					0,
 					1,
-					"Child section",
-					"",
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
 				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
 			},
-			msg: types.NewMsgMoveSection(
+			msg: types.NewMsgApproveGroupApplication(
 				1,
-				2,
-				2,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "moving section to create a circular path returns error",
+			name: "banned applicant returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-
-				// Create the following subspaces sections
-				//  A
-				//  |
-				//  B - C
-				//
-				// We will then move A to be a child of C to create the following path
-				//   A
-				//  /  \
-				//  B - C
-				suite.k.SaveSection(ctx, types.NewSection(1, 1, types.RootSectionID, "A", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 2, 1, "B", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 3, 2, "C", ""))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
 
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-					types.NewPermissions(types.PermissionManageSections),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
 				)
+
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
+
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				))
 			},
-			msg: types.NewMsgMoveSection(
+			msg: types.NewMsgApproveGroupApplication(
 				1,
 				1,
-				3,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "section is moved properly",
+			name: "application is approved correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveSection(ctx, types.NewSection(1, 1, 0, "Child section", ""))
-
-				suite.k.SaveSection(ctx, types.NewSection(
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
 					1,
-					2,
+					0,
 					1,
-					"Child section",
-					"This is child section",
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-					types.NewPermissions(types.PermissionManageSections),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
 				)
+
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
 			},
-			msg: types.NewMsgMoveSection(
+			msg: types.NewMsgApproveGroupApplication(
 				1,
-				2,
-				0,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeMovedSection,
+					types.EventTypeApprovedGroupApplication,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeySectionID, "2"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyApplicant, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				stored, found := suite.k.GetSection(ctx, 1, 2)
-				suite.Require().True(found)
-				suite.Require().Equal(types.NewSection(
-					1,
-					2,
-					0,
-					"Child section",
-					"This is child section",
-				), stored)
+				suite.Require().True(suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+				suite.Require().False(suite.k.HasGroupApplication(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
 			},
 		},
 	}
@@ -1194,13 +5272,16 @@ func (suite *KeeperTestSuite) TestMsgServer_MoveSection() {
 				tc.store(ctx)
 			}
 
-			msgServer := keeper.NewMsgServerImpl(suite.k)
-			_, err := msgServer.MoveSection(sdk.WrapSDKContext(ctx), tc.msg)
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.ApproveGroupApplication(sdk.WrapSDKContext(ctx), tc.msg)
+
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
 				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
 				if tc.check != nil {
 					tc.check(ctx)
 				}
@@ -1209,119 +5290,179 @@ func (suite *KeeperTestSuite) TestMsgServer_MoveSection() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_DeleteSection() {
+func (suite *KeeperTestSuite) TestMsgServer_RejectGroupApplication() {
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
-		msg       *types.MsgDeleteSection
+		msg       *types.MsgRejectGroupApplication
 		shouldErr bool
 		expEvents sdk.Events
 		check     func(ctx sdk.Context)
 	}{
 		{
-			name: "non existing subspace returns error",
-			msg: types.NewMsgDeleteSection(
+			name: "subspace not found returns error",
+			msg: types.NewMsgRejectGroupApplication(
 				1,
 				1,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "non existing section returns error",
+			name: "group not found returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
 			},
-			msg: types.NewMsgDeleteSection(
+			msg: types.NewMsgRejectGroupApplication(
 				1,
 				1,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "user without permission returns error",
+			name: "no permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
 
-				suite.k.SaveSection(ctx, types.NewSection(
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
 					1,
 					1,
-					0,
-					"Test section",
-					"This is a test section",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 				))
 			},
-			msg: types.NewMsgDeleteSection(
+			msg: types.NewMsgRejectGroupApplication(
 				1,
 				1,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "section is deleted properly",
+			name: "application not found returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test subspace with another name and owner",
-					"This is a test subspace with a changed description",
-					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
 
-				suite.k.SaveSection(ctx, types.NewSection(
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgRejectGroupApplication(
+				1,
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "application is rejected correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
 					1,
 					0,
-					"Test section",
-					"This is a test section",
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
-					types.NewPermissions(types.PermissionManageSections),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
 				)
+
+				suite.k.SaveGroupApplication(ctx, types.NewGroupApplication(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Let me in",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				))
 			},
-			msg: types.NewMsgDeleteSection(
+			msg: types.NewMsgRejectGroupApplication(
 				1,
 				1,
-				"cosmos1wq7mruftxd03qrrf9f7xnnzyqda9rkq5sshnr4",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeDeletedSection,
+					types.EventTypeRejectedGroupApplication,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeySectionID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyApplicant, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				result := suite.k.HasSection(ctx, 1, 1)
-				suite.Require().False(result)
+				suite.Require().False(suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+				suite.Require().False(suite.k.HasGroupApplication(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
 			},
 		},
 	}
@@ -1334,13 +5475,16 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteSection() {
 				tc.store(ctx)
 			}
 
-			msgServer := keeper.NewMsgServerImpl(suite.k)
-			_, err := msgServer.DeleteSection(sdk.WrapSDKContext(ctx), tc.msg)
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.RejectGroupApplication(sdk.WrapSDKContext(ctx), tc.msg)
+
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
 				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
 				if tc.check != nil {
 					tc.check(ctx)
 				}
@@ -1349,23 +5493,23 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteSection() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_CreateUserGroup() {
+func (suite *KeeperTestSuite) TestMsgServer_SetUserPermissions() {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
 	testCases := []struct {
-		name        string
-		store       func(ctx sdk.Context)
-		msg         *types.MsgCreateUserGroup
-		shouldErr   bool
-		expResponse *types.MsgCreateUserGroupResponse
-		expEvents   sdk.Events
-		check       func(ctx sdk.Context)
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgSetUserPermissions
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
 	}{
 		{
-			name: "non existing subspace returns error",
-			msg: types.NewMsgCreateUserGroup(
+			name: "subspace not found returns error",
+			msg: types.NewMsgSetUserPermissions(
 				1,
 				0,
-				"group",
-				"description",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 				types.NewPermissions(types.PermissionEditSubspace),
 				nil,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
@@ -1373,64 +5517,62 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateUserGroup() {
 			shouldErr: true,
 		},
 		{
-			name: "non existing section returns error",
+			name: "section not found returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
 			},
-			msg: types.NewMsgCreateUserGroup(
+			msg: types.NewMsgSetUserPermissions(
 				1,
 				1,
-				"group",
-				"description",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 				types.NewPermissions(types.PermissionEditSubspace),
 				nil,
-				"cosmos1y4emx0mm4ncva9mnv9yvjrm7nrq3psvmwhk9ll",
+				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "user without PermissionManageGroups returns error",
+			name: "no permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
 			},
-			msg: types.NewMsgCreateUserGroup(
+			msg: types.NewMsgSetUserPermissions(
 				1,
 				0,
-				"group",
-				"description",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 				types.NewPermissions(types.PermissionEditSubspace),
 				nil,
-				"cosmos1y4emx0mm4ncva9mnv9yvjrm7nrq3psvmwhk9ll",
+				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "user without PermissionSetPermissions returns error",
+			name: "invalid permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
@@ -1439,23 +5581,23 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateUserGroup() {
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1y4emx0mm4ncva9mnv9yvjrm7nrq3psvmwhk9ll",
-					types.NewPermissions(types.PermissionManageGroups),
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
 				)
 			},
-			msg: types.NewMsgCreateUserGroup(
+			msg: types.NewMsgSetUserPermissions(
 				1,
 				0,
-				"group",
-				"description",
-				types.NewPermissions(types.PermissionEditSubspace),
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				types.NewPermissions("invalid"),
 				nil,
-				"cosmos1y4emx0mm4ncva9mnv9yvjrm7nrq3psvmwhk9ll",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "invalid permissions value returns error",
+			name: "past expiration time returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
@@ -1468,36 +5610,26 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateUserGroup() {
 					nil,
 				))
 
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
-				suite.k.SetNextGroupID(ctx, 1, 2)
-
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.CombinePermissions(types.PermissionManageGroups, types.PermissionSetPermissions),
+					"cosmos17ua98rre5j9ce7hfude0y5y3rh4gtqkygm8hru",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
 				)
 			},
-			msg: types.NewMsgCreateUserGroup(
+			msg: types.NewMsgSetUserPermissions(
 				1,
 				0,
-				"another group",
-				"another description",
-				types.NewPermissions("invalid"),
-				nil,
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				types.NewPermissions(types.PermissionEditSubspace),
+				&blockTime,
+				"cosmos17ua98rre5j9ce7hfude0y5y3rh4gtqkygm8hru",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "group is created properly",
+			name: "permissions are set correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
@@ -1510,51 +5642,84 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateUserGroup() {
 					nil,
 				))
 
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
+					"cosmos17ua98rre5j9ce7hfude0y5y3rh4gtqkygm8hru",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
+				)
+			},
+			msg: types.NewMsgSetUserPermissions(
+				1,
+				0,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
+				"cosmos17ua98rre5j9ce7hfude0y5y3rh4gtqkygm8hru",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSetUserPermissions,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeySectionID, "0"),
+					sdk.NewAttribute(types.AttributeKeyPermissions, "EDIT_SUBSPACE"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				permissions := suite.k.GetUserPermissions(ctx, 1, 0, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().Equal(types.NewPermissions(types.PermissionEditSubspace), permissions)
+			},
+		},
+		{
+			name: "permissions with expiration time are set correctly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
 				))
-				suite.k.SetNextGroupID(ctx, 1, 2)
 
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.CombinePermissions(types.PermissionManageGroups, types.PermissionSetPermissions),
+					"cosmos17ua98rre5j9ce7hfude0y5y3rh4gtqkygm8hru",
+					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
 				)
 			},
-			msg: types.NewMsgCreateUserGroup(
+			msg: types.NewMsgSetUserPermissions(
 				1,
 				0,
-				"another group",
-				"another description",
-				types.NewPermissions(types.PermissionEditSubspace),
-				[]string{"cosmos16yhs7fgqnf6fjm4tftv66g2smtmee62wyg780l"},
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				types.NewPermissions(types.PermissionEditSubspace),
+				&expiration,
+				"cosmos17ua98rre5j9ce7hfude0y5y3rh4gtqkygm8hru",
 			),
-			shouldErr:   false,
-			expResponse: &types.MsgCreateUserGroupResponse{GroupID: 2},
+			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeCreatedUserGroup,
-					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUserGroupID, "2"),
-				),
-				sdk.NewEvent(
-					types.EventTypeAddedUserToGroup,
+					types.EventTypeSetUserPermissions,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUserGroupID, "2"),
-					sdk.NewAttribute(types.AttributeKeyUser, "cosmos16yhs7fgqnf6fjm4tftv66g2smtmee62wyg780l"),
+					sdk.NewAttribute(types.AttributeKeySectionID, "0"),
+					sdk.NewAttribute(types.AttributeKeyPermissions, "EDIT_SUBSPACE"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				suite.Require().True(suite.k.HasUserGroup(ctx, 1, 1))
-				suite.Require().True(suite.k.HasUserGroup(ctx, 1, 2))
-				suite.Require().True(suite.k.IsMemberOfGroup(ctx, 1, 2, "cosmos16yhs7fgqnf6fjm4tftv66g2smtmee62wyg780l"))
+				permissions := suite.k.GetUserPermissions(ctx, 1, 0, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().Equal(types.NewPermissions(types.PermissionEditSubspace), permissions)
+
+				store := ctx.KVStore(suite.storeKey)
+				key := types.UserPermissionStoreKey(1, 0, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(store.Has(types.ExpiringUserPermissionKey(&expiration, key)))
 			},
 		},
 	}
@@ -1563,19 +5728,20 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateUserGroup() {
 		tc := tc
 		suite.Run(tc.name, func() {
 			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+
 			if tc.store != nil {
 				tc.store(ctx)
 			}
 
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			res, err := service.CreateUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+			_, err := service.SetUserPermissions(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
-				suite.Require().Equal(tc.expResponse, res)
 				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
 
 				if tc.check != nil {
@@ -1586,174 +5752,202 @@ func (suite *KeeperTestSuite) TestMsgServer_CreateUserGroup() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_EditUserGroup() {
+func (suite *KeeperTestSuite) TestMsgServer_BanUser() {
+	pastTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+	expiration := time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC)
+
 	testCases := []struct {
 		name      string
+		setupCtx  func(ctx sdk.Context) sdk.Context
 		store     func(ctx sdk.Context)
-		msg       *types.MsgEditUserGroup
+		msg       *types.MsgBanUser
 		shouldErr bool
 		expEvents sdk.Events
 		check     func(ctx sdk.Context)
 	}{
 		{
-			name: "non existing subspace returns error",
-			msg: types.NewMsgEditUserGroup(
-				1,
+			name: "subspace not found returns error",
+			msg: types.NewMsgBanUser(
 				1,
-				"Test group",
-				"This is a test group",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Spam",
+				nil,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "group not found returns error",
+			name: "no permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
 			},
-			msg: types.NewMsgEditUserGroup(
+			msg: types.NewMsgBanUser(
 				1,
-				1,
-				"Test group",
-				"This is a test group",
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Spam",
+				nil,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "no permission returns error",
+			name: "banning the owner returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionBanUsers),
+					nil,
+				)
 			},
-			msg: types.NewMsgEditUserGroup(
-				1,
+			msg: types.NewMsgBanUser(
 				1,
-				"Test group new name",
-				"This is a test group with a new name",
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				"Spam",
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "invalid update returns error",
+			name: "already banned user returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
-
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionManageGroups),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionBanUsers),
+					nil,
 				)
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				))
 			},
-			msg: types.NewMsgEditUserGroup(
+			msg: types.NewMsgBanUser(
 				1,
-				1,
-				"",
-				"",
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Spam",
+				nil,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "existing group is edited properly",
+			name: "past expiration time returns error",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC))
+			},
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionBanUsers),
+					nil,
+				)
+			},
+			msg: types.NewMsgBanUser(
+				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Spam",
+				&pastTime,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "user is banned correctly",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
 				))
-
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionManageGroups),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionBanUsers),
+					nil,
 				)
 			},
-			msg: types.NewMsgEditUserGroup(
-				1,
+			msg: types.NewMsgBanUser(
 				1,
-				"Admins",
-				"Group of the admins of th subspace",
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"Spam",
+				&expiration,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeEditedUserGroup,
+					types.EventTypeBannedUser,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				group, found := suite.k.GetUserGroup(ctx, 1, 1)
+				ban, found := suite.k.GetSubspaceBan(ctx, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
 				suite.Require().True(found)
-
-				suite.Require().Equal(types.NewUserGroup(
+				suite.Require().Equal(types.NewSubspaceBan(
 					1,
-					0,
-					1,
-					"Admins",
-					"Group of the admins of th subspace",
-					types.NewPermissions(types.PermissionEditSubspace),
-				), group)
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					&expiration,
+				), ban)
 			},
 		},
 	}
@@ -1762,13 +5956,16 @@ func (suite *KeeperTestSuite) TestMsgServer_EditUserGroup() {
 		tc := tc
 		suite.Run(tc.name, func() {
 			ctx, _ := suite.ctx.CacheContext()
+			if tc.setupCtx != nil {
+				ctx = tc.setupCtx(ctx)
+			}
 			if tc.store != nil {
 				tc.store(ctx)
 			}
 
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			_, err := service.EditUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+			_, err := service.BanUser(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
@@ -1784,283 +5981,236 @@ func (suite *KeeperTestSuite) TestMsgServer_EditUserGroup() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_MoveUserGroup() {
+func (suite *KeeperTestSuite) TestMsgServer_UnbanUser() {
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
-		msg       *types.MsgMoveUserGroup
+		msg       *types.MsgUnbanUser
 		shouldErr bool
 		expEvents sdk.Events
 		check     func(ctx sdk.Context)
 	}{
 		{
-			name: "non existing subspace returns error",
-			msg: types.NewMsgMoveUserGroup(
-				1,
-				1,
+			name: "subspace not found returns error",
+			msg: types.NewMsgUnbanUser(
 				1,
+				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "group not found returns error",
+			name: "no permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-			},
-			msg: types.NewMsgMoveUserGroup(
-				1,
-				1,
-				1,
-				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-			),
-			shouldErr: true,
-		},
-		{
-			name: "non existing destination section returns error",
-			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
 					1,
-					"Test subspace",
-					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
 			},
-			msg: types.NewMsgMoveUserGroup(
-				1,
-				1,
+			msg: types.NewMsgUnbanUser(
 				1,
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "no permission returns error - PermissionManageGroups inside current section",
+			name: "ban not found returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
-					1,
-					0,
-					"Test section",
-					"This is a test section",
-				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionBanUsers),
+					nil,
+				)
 			},
-			msg: types.NewMsgMoveUserGroup(
-				1,
-				1,
+			msg: types.NewMsgUnbanUser(
 				1,
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "no permission returns error - PermissionManageGroups inside destination section",
+			name: "user is unbanned correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
-					1,
-					0,
-					"Test section",
-					"This is a test section",
-				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
-
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionManageGroups),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionBanUsers),
+					nil,
 				)
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					nil,
+				))
 			},
-			msg: types.NewMsgMoveUserGroup(
-				1,
-				1,
+			msg: types.NewMsgUnbanUser(
 				1,
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeUnbannedUser,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasSubspaceBan(ctx, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.UnbanUser(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_SetRateLimit() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgSetRateLimit
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "subspace not found returns error",
+			msg: types.NewMsgSetRateLimit(
+				1,
+				"/desmos.posts.v3.MsgCreatePost",
+				10,
+				time.Hour,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "no permission returns error - PermissionSetPermissions inside destination section",
+			name: "no permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
-					1,
-					0,
-					"Test section",
-					"This is a test section",
-				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
-
-				suite.k.SetUserPermissions(ctx,
-					1,
-					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionManageGroups),
-				)
-
-				suite.k.SetUserPermissions(ctx,
-					1,
-					1,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionManageGroups),
-				)
 			},
-			msg: types.NewMsgMoveUserGroup(
-				1,
+			msg: types.NewMsgSetRateLimit(
 				1,
-				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"/desmos.posts.v3.MsgCreatePost",
+				10,
+				time.Hour,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "existing group is moved properly",
+			name: "rate limit is set correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveSection(ctx, types.NewSection(
-					1,
-					1,
-					0,
-					"Test section",
-					"This is a test section",
-				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
-
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionManageGroups),
-				)
-				suite.k.SetUserPermissions(ctx,
-					1,
-					1,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionManageGroups),
-				)
-				suite.k.SetUserPermissions(ctx,
-					1,
-					1,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionSetPermissions),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionManageRateLimits),
+					nil,
 				)
 			},
-			msg: types.NewMsgMoveUserGroup(
-				1,
+			msg: types.NewMsgSetRateLimit(
 				1,
-				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"/desmos.posts.v3.MsgCreatePost",
+				10,
+				time.Hour,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeMovedUserGroup,
+					types.EventTypeSetRateLimit,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyMsgTypeURL, "/desmos.posts.v3.MsgCreatePost"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				group, found := suite.k.GetUserGroup(ctx, 1, 1)
+				limit, found := suite.k.GetRateLimit(ctx, 1, "/desmos.posts.v3.MsgCreatePost")
 				suite.Require().True(found)
-
-				suite.Require().Equal(types.NewUserGroup(
-					1,
-					1,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				), group)
+				suite.Require().Equal(types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour), limit)
 			},
 		},
 	}
@@ -2075,7 +6225,7 @@ func (suite *KeeperTestSuite) TestMsgServer_MoveUserGroup() {
 
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			_, err := service.MoveUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+			_, err := service.SetRateLimit(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
@@ -2091,241 +6241,259 @@ func (suite *KeeperTestSuite) TestMsgServer_MoveUserGroup() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_SetUserGroupPermissions() {
+func (suite *KeeperTestSuite) TestMsgServer_RemoveRateLimit() {
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
-		msg       *types.MsgSetUserGroupPermissions
+		msg       *types.MsgRemoveRateLimit
 		shouldErr bool
 		expEvents sdk.Events
 		check     func(ctx sdk.Context)
 	}{
 		{
 			name: "subspace not found returns error",
-			msg: types.NewMsgSetUserGroupPermissions(
-				1,
+			msg: types.NewMsgRemoveRateLimit(
 				1,
-				types.NewPermissions(types.PermissionSetPermissions),
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"/desmos.posts.v3.MsgCreatePost",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "group not found returns error",
+			name: "no permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour))
 			},
-			msg: types.NewMsgSetUserGroupPermissions(
+			msg: types.NewMsgRemoveRateLimit(
 				1,
-				1,
-				types.NewPermissions(types.PermissionSetPermissions),
+				"/desmos.posts.v3.MsgCreatePost",
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "no permission returns error",
+			name: "rate limit not found returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionManageRateLimits),
+					nil,
+				)
 			},
-			msg: types.NewMsgSetUserGroupPermissions(
+			msg: types.NewMsgRemoveRateLimit(
 				1,
-				1,
-				types.NewPermissions(types.PermissionSetPermissions),
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"/desmos.posts.v3.MsgCreatePost",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "invalid permission value returns error",
+			name: "rate limit is removed correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
-
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionSetPermissions),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionManageRateLimits),
+					nil,
 				)
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour))
 			},
-			msg: types.NewMsgSetUserGroupPermissions(
+			msg: types.NewMsgRemoveRateLimit(
 				1,
+				"/desmos.posts.v3.MsgCreatePost",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRemovedRateLimit,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyMsgTypeURL, "/desmos.posts.v3.MsgCreatePost"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasRateLimit(ctx, 1, "/desmos.posts.v3.MsgCreatePost"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			// Run the message
+			service := keeper.NewMsgServerImpl(suite.k)
+			_, err := service.RemoveRateLimit(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_SetFeeTokensConfig() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgSetFeeTokensConfig
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "subspace not found returns error",
+			msg: types.NewMsgSetFeeTokensConfig(
 				1,
-				types.NewPermissions("invalid"),
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"stake",
+				[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt())},
+				types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "setting the permissions for a group you are part of returns error if not owner",
+			name: "no permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionSetPermissions),
+					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
 				))
-
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
 			},
-			msg: types.NewMsgSetUserGroupPermissions(
-				1,
+			msg: types.NewMsgSetFeeTokensConfig(
 				1,
-				types.NewPermissions(types.PermissionEverything),
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"stake",
+				[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt())},
+				types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "setting the permissions for a group you are part of does not return error if owner",
+			name: "token that is not an additional fee token returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionSetPermissions),
-				))
-
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionManageFeeTokens),
+					nil,
+				)
 			},
-			msg: types.NewMsgSetUserGroupPermissions(
+			msg: types.NewMsgSetFeeTokensConfig(
 				1,
-				1,
-				types.NewPermissions(types.PermissionEverything),
+				"stake",
+				[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt())},
+				types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
-			shouldErr: false,
-			expEvents: sdk.Events{
-				sdk.NewEvent(
-					types.EventTypeSetUserGroupPermissions,
-					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
-					sdk.NewAttribute(types.AttributeKeyPermissions, "EVERYTHING"),
-				),
-			},
-			check: func(ctx sdk.Context) {
-				group, found := suite.k.GetUserGroup(ctx, 1, 1)
-				suite.Require().True(found)
-
-				suite.Require().Equal(types.NewPermissions(types.PermissionEverything), group.Permissions)
-			},
+			shouldErr: true,
 		},
 		{
-			name: "group permissions are updated correctly",
+			name: "fee tokens config is set correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
+					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
 				))
-
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionSetPermissions),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionManageFeeTokens),
+					nil,
 				)
 			},
-			msg: types.NewMsgSetUserGroupPermissions(
-				1,
+			msg: types.NewMsgSetFeeTokensConfig(
 				1,
-				types.NewPermissions(types.PermissionSetPermissions),
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"stake",
+				[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000))},
+				types.FEE_TOKENS_DESTINATION_TREASURY,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeSetUserGroupPermissions,
+					types.EventTypeSetFeeTokensConfig,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
-					sdk.NewAttribute(types.AttributeKeyPermissions, "SET_PERMISSIONS"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				group, found := suite.k.GetUserGroup(ctx, 1, 1)
+				config, found := suite.k.GetFeeTokensConfig(ctx, 1)
 				suite.Require().True(found)
-
-				suite.Require().Equal(types.NewPermissions(types.PermissionSetPermissions), group.Permissions)
+				suite.Require().Equal(types.NewFeeTokensConfig(
+					1,
+					"stake",
+					[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000))},
+					types.FEE_TOKENS_DESTINATION_TREASURY,
+				), config)
 			},
 		},
 	}
@@ -2340,7 +6508,7 @@ func (suite *KeeperTestSuite) TestMsgServer_SetUserGroupPermissions() {
 
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			_, err := service.SetUserGroupPermissions(sdk.WrapSDKContext(ctx), tc.msg)
+			_, err := service.SetFeeTokensConfig(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
@@ -2356,119 +6524,105 @@ func (suite *KeeperTestSuite) TestMsgServer_SetUserGroupPermissions() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_DeleteUserGroup() {
+func (suite *KeeperTestSuite) TestMsgServer_RemoveFeeTokensConfig() {
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
-		msg       *types.MsgDeleteUserGroup
+		msg       *types.MsgRemoveFeeTokensConfig
 		shouldErr bool
 		expEvents sdk.Events
 		check     func(ctx sdk.Context)
 	}{
 		{
-			name: "subspace not found returns error",
-			msg: types.NewMsgDeleteUserGroup(
-				1,
-				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-			),
+			name:      "subspace not found returns error",
+			msg:       types.NewMsgRemoveFeeTokensConfig(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 			shouldErr: true,
 		},
 		{
-			name: "group not found returns error",
+			name: "no permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
+					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+				))
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(
+					1,
+					"stake",
+					[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt())},
+					types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
 				))
 			},
-			msg: types.NewMsgDeleteUserGroup(
-				1,
-				1,
-				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-			),
+			msg:       types.NewMsgRemoveFeeTokensConfig(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 			shouldErr: true,
 		},
 		{
-			name: "no permission returns error",
+			name: "fee tokens config not found returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
+					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionManageFeeTokens),
+					nil,
+				)
 			},
-			msg: types.NewMsgDeleteUserGroup(
-				1,
-				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-			),
+			msg:       types.NewMsgRemoveFeeTokensConfig(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 			shouldErr: true,
 		},
 		{
-			name: "existing group is deleted properly",
+			name: "fee tokens config is removed correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
 				))
-
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionManageGroups),
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionManageFeeTokens),
+					nil,
 				)
+				suite.k.SaveFeeTokensConfig(ctx, types.NewFeeTokensConfig(
+					1,
+					"stake",
+					[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt())},
+					types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+				))
 			},
-			msg: types.NewMsgDeleteUserGroup(
-				1,
-				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-			),
+			msg:       types.NewMsgRemoveFeeTokensConfig(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeDeletedUserGroup,
+					types.EventTypeRemovedFeeTokensConfig,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				hasGroup := suite.k.HasUserGroup(ctx, 1, 1)
-				suite.Require().False(hasGroup)
+				suite.Require().False(suite.k.HasFeeTokensConfig(ctx, 1))
 			},
 		},
 	}
@@ -2483,7 +6637,7 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteUserGroup() {
 
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			_, err := service.DeleteUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+			_, err := service.RemoveFeeTokensConfig(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
@@ -2499,49 +6653,35 @@ func (suite *KeeperTestSuite) TestMsgServer_DeleteUserGroup() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_AddUserToGroup() {
+func (suite *KeeperTestSuite) TestMsgServer_UpdateSubspaceFeeTokens() {
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
-		msg       *types.MsgAddUserToUserGroup
+		msg       *types.MsgUpdateSubspaceFeeTokens
 		shouldErr bool
 		expEvents sdk.Events
 		check     func(ctx sdk.Context)
 	}{
 		{
-			name: "subspace not found returns error",
-			msg: types.NewMsgAddUserToUserGroup(
-				1,
+			name: "invalid authority return error",
+			msg: types.NewMsgUpdateSubspaceFeeTokens(
 				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+				"invalid",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "group not found returns error",
-			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
-					"Test subspace",
-					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
-			},
-			msg: types.NewMsgAddUserToUserGroup(
-				1,
+			name: "subspace not found returns error",
+			msg: types.NewMsgUpdateSubspaceFeeTokens(
 				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+				authtypes.NewModuleAddress("gov").String(),
 			),
 			shouldErr: true,
 		},
 		{
-			name: "no permission returns error",
+			name: "invalid allowed fee tokens returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
@@ -2553,28 +6693,19 @@ func (suite *KeeperTestSuite) TestMsgServer_AddUserToGroup() {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
 			},
-			msg: types.NewMsgAddUserToUserGroup(
-				1,
+			msg: types.NewMsgUpdateSubspaceFeeTokens(
 				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				sdk.Coins{{Denom: "minttoken", Amount: sdk.NewInt(-10)}},
+				authtypes.NewModuleAddress("gov").String(),
 			),
 			shouldErr: true,
 		},
 		{
-			name: "user already part of group returns error",
+			name: "invalid subspace returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
+					0,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
@@ -2583,27 +6714,16 @@ func (suite *KeeperTestSuite) TestMsgServer_AddUserToGroup() {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
-
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
 			},
-			msg: types.NewMsgAddUserToUserGroup(
-				1,
+			msg: types.NewMsgUpdateSubspaceFeeTokens(
 				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+				authtypes.NewModuleAddress("gov").String(),
 			),
 			shouldErr: true,
 		},
 		{
-			name: "user not part of group is added correctly",
+			name: "subspace is updated correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
@@ -2615,40 +6735,34 @@ func (suite *KeeperTestSuite) TestMsgServer_AddUserToGroup() {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
-
-				suite.k.SetUserPermissions(ctx,
-					1,
-					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionSetPermissions),
-				)
 			},
-			msg: types.NewMsgAddUserToUserGroup(
-				1,
+			msg: types.NewMsgUpdateSubspaceFeeTokens(
 				1,
-				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+				authtypes.NewModuleAddress("gov").String(),
 			),
 			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeAddedUserToGroup,
+					types.EventTypeUpdatedSubspaceFeeToken,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
+					sdk.NewAttribute(types.AttributeKeyUser, authtypes.NewModuleAddress("gov").String()),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				result := suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
-				suite.Require().True(result)
+				subspace, _ := suite.k.GetSubspace(ctx, 1)
+				expected := types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
+				)
+
+				suite.Require().Equal(expected, subspace)
 			},
 		},
 	}
@@ -2663,7 +6777,7 @@ func (suite *KeeperTestSuite) TestMsgServer_AddUserToGroup() {
 
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			_, err := service.AddUserToUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+			_, err := service.UpdateSubspaceFeeTokens(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
@@ -2679,155 +6793,157 @@ func (suite *KeeperTestSuite) TestMsgServer_AddUserToGroup() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_RemoveUserFromGroup() {
+func (suite *KeeperTestSuite) TestMsgServer_GrantTreasuryAuthorization() {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
 	testCases := []struct {
-		name      string
-		store     func(ctx sdk.Context)
-		msg       *types.MsgRemoveUserFromUserGroup
-		shouldErr bool
-		expEvents sdk.Events
-		check     func(ctx sdk.Context)
+		name        string
+		store       func(ctx sdk.Context)
+		msg         *types.MsgGrantTreasuryAuthorization
+		shouldErr   bool
+		expResponse *types.MsgGrantTreasuryAuthorizationResponse
+		expEvents   []sdk.Event
+		check       func(ctx sdk.Context)
 	}{
 		{
-			name: "subspace not found returns error",
-			msg: types.NewMsgRemoveUserFromUserGroup(
-				1,
+			name: "non existing subspace returns error",
+			msg: types.NewMsgGrantTreasuryAuthorization(
 				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
+				&expiration,
 			),
 			shouldErr: true,
 		},
 		{
-			name: "group not found returns error",
+			name: "granter without permissions returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
 					"Test subspace",
 					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
+					nil),
+				)
 			},
-			msg: types.NewMsgRemoveUserFromUserGroup(
-				1,
+			msg: types.NewMsgGrantTreasuryAuthorization(
 				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
+				&expiration,
 			),
 			shouldErr: true,
 		},
 		{
-			name: "no permission returns error",
+			name: "invalid treasury address returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
 					"Test subspace",
 					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
+					nil),
+				)
+
+				suite.k.SetUserPermissions(ctx,
 					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
+					types.RootSectionID,
+					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
+					nil,
+				)
 			},
-			msg: types.NewMsgRemoveUserFromUserGroup(
-				1,
+			msg: types.NewMsgGrantTreasuryAuthorization(
 				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
+				&expiration,
 			),
 			shouldErr: true,
 		},
 		{
-			name: "user not part of group returns error",
+			name: "invalid grantee address returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
 					"Test subspace",
 					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
+					nil),
+				)
+
+				suite.k.SetUserPermissions(ctx,
 					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
+					types.RootSectionID,
+					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
+					nil,
+				)
 			},
-			msg: types.NewMsgRemoveUserFromUserGroup(
-				1,
+			msg: types.NewMsgGrantTreasuryAuthorization(
 				1,
-				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"",
+				&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
+				&expiration,
 			),
 			shouldErr: true,
 		},
 		{
-			name: "user part of group is removed correctly",
+			name: "valid request creates authorization properly",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
 					"Test subspace",
 					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
-				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
-					1,
-					0,
-					1,
-					"Test group",
-					"This is a test group",
-					types.NewPermissions(types.PermissionEditSubspace),
-				))
+					nil),
+				)
 
 				suite.k.SetUserPermissions(ctx,
 					1,
-					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionSetPermissions),
+					types.RootSectionID,
+					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
+					nil,
 				)
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
 			},
-			msg: types.NewMsgRemoveUserFromUserGroup(
-				1,
+			msg: types.NewMsgGrantTreasuryAuthorization(
 				1,
-				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
+				&expiration,
 			),
-			shouldErr: false,
+			shouldErr:   false,
+			expResponse: &types.MsgGrantTreasuryAuthorizationResponse{},
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeRemovedUserFromGroup,
+					types.EventTypeGrantedTreasuryAuthorization,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm"),
+					sdk.NewAttribute(types.AttributeKeyGranter, "cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez"),
+					sdk.NewAttribute(types.AttributeKeyGrantee, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				result := suite.k.IsMemberOfGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
-				suite.Require().False(result)
+				treasury, err := sdk.AccAddressFromBech32("cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")
+				suite.Require().NoError(err)
+				grantee, err := sdk.AccAddressFromBech32("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69")
+				suite.Require().NoError(err)
+
+				authorizations, err := suite.authzKeeper.GetAuthorizations(ctx, grantee, treasury)
+				suite.Require().NoError(err)
+				suite.Require().Equal(1, len(authorizations))
 			},
 		},
 	}
@@ -2836,19 +6952,28 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveUserFromGroup() {
 		tc := tc
 		suite.Run(tc.name, func() {
 			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+
 			if tc.store != nil {
 				tc.store(ctx)
 			}
 
+			// Reset any event that might have been emitted during the setup
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			_, err := service.RemoveUserFromUserGroup(sdk.WrapSDKContext(ctx), tc.msg)
+			res, err := service.GrantTreasuryAuthorization(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
-				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				suite.Require().Equal(tc.expResponse, res)
+
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
 
 				if tc.check != nil {
 					tc.check(ctx)
@@ -2858,143 +6983,195 @@ func (suite *KeeperTestSuite) TestMsgServer_RemoveUserFromGroup() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_SetUserPermissions() {
+func (suite *KeeperTestSuite) TestMsgServer_RevokeTreasuryAuthorization() {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
 	testCases := []struct {
-		name      string
-		store     func(ctx sdk.Context)
-		msg       *types.MsgSetUserPermissions
-		shouldErr bool
-		expEvents sdk.Events
-		check     func(ctx sdk.Context)
+		name        string
+		store       func(ctx sdk.Context)
+		msg         *types.MsgRevokeTreasuryAuthorization
+		shouldErr   bool
+		expResponse *types.MsgRevokeTreasuryAuthorizationResponse
+		expEvents   []sdk.Event
+		check       func(ctx sdk.Context)
 	}{
 		{
-			name: "subspace not found returns error",
-			msg: types.NewMsgSetUserPermissions(
+			name: "non existing subspace returns error",
+			msg: types.NewMsgRevokeTreasuryAuthorization(
 				1,
-				0,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				types.NewPermissions(types.PermissionEditSubspace),
-				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				"/cosmos.v1beta1.MsgSend",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "section not found returns error",
+			name: "revoker with no permissions returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
 					"Test subspace",
 					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
+					nil),
+				)
 			},
-			msg: types.NewMsgSetUserPermissions(
-				1,
+			msg: types.NewMsgRevokeTreasuryAuthorization(
 				1,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				types.NewPermissions(types.PermissionEditSubspace),
-				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				"/cosmos.v1beta1.MsgSend",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "no permission returns error",
+			name: "invalid treasury address returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
 					"Test subspace",
 					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil),
+				)
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					types.RootSectionID,
+					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
 					nil,
-				))
+				)
 			},
-			msg: types.NewMsgSetUserPermissions(
+			msg: types.NewMsgRevokeTreasuryAuthorization(
 				1,
-				0,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				types.NewPermissions(types.PermissionEditSubspace),
-				"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				"/cosmos.v1beta1.MsgSend",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "invalid permission returns error",
+			name: "invalid revoker address returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
 					"Test subspace",
 					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil,
-				))
+					nil),
+				)
 
 				suite.k.SetUserPermissions(ctx,
 					1,
-					0,
-					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-					types.NewPermissions(types.PermissionSetPermissions),
+					types.RootSectionID,
+					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
+					nil,
 				)
 			},
-			msg: types.NewMsgSetUserPermissions(
+			msg: types.NewMsgRevokeTreasuryAuthorization(
 				1,
-				0,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				types.NewPermissions("invalid"),
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"",
+				"/cosmos.v1beta1.MsgSend",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "permissions are set correctly",
+			name: "non exiting authorization returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
 					"Test subspace",
 					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil),
+				)
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					types.RootSectionID,
+					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
 					nil,
-				))
+				)
+			},
+			msg: types.NewMsgRevokeTreasuryAuthorization(
+				1,
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				"/cosmos.v1beta1.MsgSend",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid request deletes authorization properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil),
+				)
 
 				suite.k.SetUserPermissions(ctx,
 					1,
-					0,
-					"cosmos17ua98rre5j9ce7hfude0y5y3rh4gtqkygm8hru",
-					types.NewPermissions(types.PermissionSetPermissions),
+					types.RootSectionID,
+					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
+					nil,
+				)
+
+				treasury, err := sdk.AccAddressFromBech32("cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")
+				suite.Require().NoError(err)
+				grantee, err := sdk.AccAddressFromBech32("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69")
+				suite.Require().NoError(err)
+
+				expiration := time.Date(2024, 1, 11, 1, 1, 1, 1, time.UTC)
+				err = suite.authzKeeper.SaveGrant(ctx,
+					grantee,
+					treasury,
+					&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
+					&expiration,
 				)
+				suite.Require().NoError(err)
 			},
-			msg: types.NewMsgSetUserPermissions(
+			msg: types.NewMsgRevokeTreasuryAuthorization(
 				1,
-				0,
-				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
-				types.NewPermissions(types.PermissionEditSubspace),
-				"cosmos17ua98rre5j9ce7hfude0y5y3rh4gtqkygm8hru",
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+				"/cosmos.bank.v1beta1.MsgSend",
 			),
-			shouldErr: false,
+			shouldErr:   false,
+			expResponse: &types.MsgRevokeTreasuryAuthorizationResponse{},
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeSetUserPermissions,
+					types.EventTypeRevokedTreasuryAuthorization,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeySectionID, "0"),
-					sdk.NewAttribute(types.AttributeKeyPermissions, "EDIT_SUBSPACE"),
-					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+					sdk.NewAttribute(types.AttributeKeyGranter, "cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez"),
+					sdk.NewAttribute(types.AttributeKeyGrantee, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				permissions := suite.k.GetUserPermissions(ctx, 1, 0, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
-				suite.Require().Equal(types.NewPermissions(types.PermissionEditSubspace), permissions)
+				treasury, err := sdk.AccAddressFromBech32("cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")
+				suite.Require().NoError(err)
+				grantee, err := sdk.AccAddressFromBech32("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69")
+				suite.Require().NoError(err)
+
+				authorizations, err := suite.authzKeeper.GetAuthorizations(ctx, grantee, treasury)
+				suite.Require().NoError(err)
+				suite.Require().Equal(0, len(authorizations))
 			},
 		},
 	}
@@ -3003,19 +7180,28 @@ func (suite *KeeperTestSuite) TestMsgServer_SetUserPermissions() {
 		tc := tc
 		suite.Run(tc.name, func() {
 			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+
 			if tc.store != nil {
 				tc.store(ctx)
 			}
 
+			// Reset any event that might have been emitted during the setup
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			_, err := service.SetUserPermissions(sdk.WrapSDKContext(ctx), tc.msg)
+			res, err := service.RevokeTreasuryAuthorization(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
-				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
+				suite.Require().Equal(tc.expResponse, res)
+
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
 
 				if tc.check != nil {
 					tc.check(ctx)
@@ -3025,116 +7211,127 @@ func (suite *KeeperTestSuite) TestMsgServer_SetUserPermissions() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_UpdateSubspaceFeeTokens() {
+var expiration = time.Date(2100, 1, 11, 0, 0, 0, 0, time.UTC)
+
+func (suite *KeeperTestSuite) TestMsgServer_SetTreasuryVotingConfig() {
 	testCases := []struct {
 		name      string
 		store     func(ctx sdk.Context)
-		msg       *types.MsgUpdateSubspaceFeeTokens
+		msg       *types.MsgSetTreasuryVotingConfig
 		shouldErr bool
 		expEvents sdk.Events
 		check     func(ctx sdk.Context)
 	}{
-		{
-			name: "invalid authority return error",
-			msg: types.NewMsgUpdateSubspaceFeeTokens(
-				1,
-				sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
-				"invalid",
-			),
-			shouldErr: true,
-		},
 		{
 			name: "subspace not found returns error",
-			msg: types.NewMsgUpdateSubspaceFeeTokens(
+			msg: types.NewMsgSetTreasuryVotingConfig(
 				1,
-				sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
-				authtypes.NewModuleAddress("gov").String(),
+				1,
+				sdk.NewDecWithPrec(5, 1),
+				nil,
+				time.Hour,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "invalid allowed fee tokens returns error",
+			name: "no permission returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
 			},
-			msg: types.NewMsgUpdateSubspaceFeeTokens(
+			msg: types.NewMsgSetTreasuryVotingConfig(
 				1,
-				sdk.Coins{{Denom: "minttoken", Amount: sdk.NewInt(-10)}},
-				authtypes.NewModuleAddress("gov").String(),
+				1,
+				sdk.NewDecWithPrec(5, 1),
+				nil,
+				time.Hour,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "invalid subspace returns error",
+			name: "group not found returns error",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
-					0,
+					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
+					nil,
+				)
 			},
-			msg: types.NewMsgUpdateSubspaceFeeTokens(
+			msg: types.NewMsgSetTreasuryVotingConfig(
 				1,
-				sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
-				authtypes.NewModuleAddress("gov").String(),
+				1,
+				sdk.NewDecWithPrec(5, 1),
+				nil,
+				time.Hour,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "subspace is updated correctly",
+			name: "config is set correctly",
 			store: func(ctx sdk.Context) {
 				suite.k.SaveSubspace(ctx, types.NewSubspace(
 					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				))
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
+					nil,
+				)
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
 			},
-			msg: types.NewMsgUpdateSubspaceFeeTokens(
+			msg: types.NewMsgSetTreasuryVotingConfig(
 				1,
-				sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
-				authtypes.NewModuleAddress("gov").String(),
+				1,
+				sdk.NewDecWithPrec(5, 1),
+				nil,
+				time.Hour,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeUpdatedSubspaceFeeToken,
+					types.EventTypeSetTreasuryVotingConfig,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyUser, authtypes.NewModuleAddress("gov").String()),
+					sdk.NewAttribute(types.AttributeKeyUserGroupID, "1"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				subspace, _ := suite.k.GetSubspace(ctx, 1)
-				expected := types.NewSubspace(
-					1,
-					"Test subspace",
-					"This is a test subspace",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
-					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					sdk.NewCoins(sdk.NewCoin("minttoken", sdk.NewInt(10))),
-				)
-
-				suite.Require().Equal(expected, subspace)
+				config, found := suite.k.GetTreasuryVotingConfig(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour), config)
 			},
 		},
 	}
@@ -3149,7 +7346,7 @@ func (suite *KeeperTestSuite) TestMsgServer_UpdateSubspaceFeeTokens() {
 
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			_, err := service.UpdateSubspaceFeeTokens(sdk.WrapSDKContext(ctx), tc.msg)
+			_, err := service.SetTreasuryVotingConfig(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
@@ -3165,154 +7362,154 @@ func (suite *KeeperTestSuite) TestMsgServer_UpdateSubspaceFeeTokens() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_GrantTreasuryAuthorization() {
+func (suite *KeeperTestSuite) TestMsgServer_SubmitTreasuryProposal() {
 	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
 	testCases := []struct {
 		name        string
 		store       func(ctx sdk.Context)
-		msg         *types.MsgGrantTreasuryAuthorization
+		msg         *types.MsgSubmitTreasuryProposal
 		shouldErr   bool
-		expResponse *types.MsgGrantTreasuryAuthorizationResponse
-		expEvents   []sdk.Event
+		expResponse *types.MsgSubmitTreasuryProposalResponse
+		expEvents   sdk.Events
 		check       func(ctx sdk.Context)
 	}{
 		{
-			name: "non existing subspace returns error",
-			msg: types.NewMsgGrantTreasuryAuthorization(
+			name: "subspace not found returns error",
+			msg: types.NewMsgSubmitTreasuryProposal(
 				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-				&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
-				&expiration,
+				[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "granter without permissions returns error",
+			name: "voting config not found returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil),
-				)
+					nil,
+				))
 			},
-			msg: types.NewMsgGrantTreasuryAuthorization(
+			msg: types.NewMsgSubmitTreasuryProposal(
 				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-				&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
-				&expiration,
+				[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "invalid treasury address returns error",
+			name: "proposer not part of the voting group returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
 					"Test subspace",
 					"This is a test subspace",
-					"",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil),
-				)
-
-				suite.k.SetUserPermissions(ctx,
-					1,
-					types.RootSectionID,
-					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
-				)
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour))
 			},
-			msg: types.NewMsgGrantTreasuryAuthorization(
+			msg: types.NewMsgSubmitTreasuryProposal(
 				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-				&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
-				&expiration,
+				[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "invalid grantee address returns error",
+			name: "message not signed by the treasury returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil),
-				)
-
-				suite.k.SetUserPermissions(ctx,
-					1,
-					types.RootSectionID,
-					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
-				)
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour))
 			},
-			msg: types.NewMsgGrantTreasuryAuthorization(
+			msg: types.NewMsgSubmitTreasuryProposal(
 				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"",
-				&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
-				&expiration,
+				[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")},
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
 		},
 		{
-			name: "valid request creates authorization properly",
+			name: "proposal is submitted correctly",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil),
-				)
-
-				suite.k.SetUserPermissions(ctx,
-					1,
-					types.RootSectionID,
-					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
-				)
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm",
+					"Spam",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					nil,
+				))
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour))
 			},
-			msg: types.NewMsgGrantTreasuryAuthorization(
+			msg: types.NewMsgSubmitTreasuryProposal(
 				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-				&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
-				&expiration,
+				[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr:   false,
-			expResponse: &types.MsgGrantTreasuryAuthorizationResponse{},
+			expResponse: &types.MsgSubmitTreasuryProposalResponse{ProposalID: 1},
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeGrantedTreasuryAuthorization,
+					types.EventTypeSubmittedTreasuryProposal,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyGranter, "cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez"),
-					sdk.NewAttribute(types.AttributeKeyGrantee, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+					sdk.NewAttribute(types.AttributeKeyProposalID, "1"),
+					sdk.NewAttribute(types.AttributeKeyProposer, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				treasury, err := sdk.AccAddressFromBech32("cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")
-				suite.Require().NoError(err)
-				grantee, err := sdk.AccAddressFromBech32("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69")
-				suite.Require().NoError(err)
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					blockTime,
+					blockTime.Add(time.Hour),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					[]string{"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"},
+				), proposal)
 
-				authorizations, err := suite.authzKeeper.GetAuthorizations(ctx, grantee, treasury)
-				suite.Require().NoError(err)
-				suite.Require().Equal(1, len(authorizations))
+				// Make sure the next proposal id has increased
+				suite.Require().Equal(uint64(2), suite.k.GetNextTreasuryProposalID(ctx, 1))
 			},
 		},
 	}
@@ -3332,17 +7529,14 @@ func (suite *KeeperTestSuite) TestMsgServer_GrantTreasuryAuthorization() {
 
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			res, err := service.GrantTreasuryAuthorization(sdk.WrapSDKContext(ctx), tc.msg)
+			res, err := service.SubmitTreasuryProposal(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
-				suite.Require().Equal(tc.expResponse, res)
-
-				for _, event := range tc.expEvents {
-					suite.Require().Contains(ctx.EventManager().Events(), event)
-				}
+				suite.Require().Equal(tc.expResponse, res)
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
 
 				if tc.check != nil {
 					tc.check(ctx)
@@ -3352,191 +7546,257 @@ func (suite *KeeperTestSuite) TestMsgServer_GrantTreasuryAuthorization() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestMsgServer_RevokeTreasuryAuthorization() {
-	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+func (suite *KeeperTestSuite) TestMsgServer_VoteTreasuryProposal() {
+	blockTime := time.Date(2020, 1, 1, 13, 00, 00, 000, time.UTC)
 	testCases := []struct {
-		name        string
-		store       func(ctx sdk.Context)
-		msg         *types.MsgRevokeTreasuryAuthorization
-		shouldErr   bool
-		expResponse *types.MsgRevokeTreasuryAuthorizationResponse
-		expEvents   []sdk.Event
-		check       func(ctx sdk.Context)
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgVoteTreasuryProposal
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
 	}{
 		{
-			name: "non existing subspace returns error",
-			msg: types.NewMsgRevokeTreasuryAuthorization(
-				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-				"/cosmos.v1beta1.MsgSend",
-			),
+			name:      "subspace not found returns error",
+			msg:       types.NewMsgVoteTreasuryProposal(1, 1, types.TREASURY_VOTE_OPTION_YES, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 			shouldErr: true,
 		},
 		{
-			name: "revoker with no permissions returns error",
+			name: "proposal not found returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil),
-				)
+					nil,
+				))
 			},
-			msg: types.NewMsgRevokeTreasuryAuthorization(
-				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-				"/cosmos.v1beta1.MsgSend",
-			),
+			msg:       types.NewMsgVoteTreasuryProposal(1, 1, types.TREASURY_VOTE_OPTION_YES, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 			shouldErr: true,
 		},
 		{
-			name: "invalid treasury address returns error",
+			name: "ended voting period returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
 					"Test subspace",
 					"This is a test subspace",
-					"",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil),
-				)
-
-				suite.k.SetUserPermissions(ctx,
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
 					1,
-					types.RootSectionID,
-					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
-				)
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 1, 12, 30, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
 			},
-			msg: types.NewMsgRevokeTreasuryAuthorization(
-				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-				"/cosmos.v1beta1.MsgSend",
-			),
+			msg:       types.NewMsgVoteTreasuryProposal(1, 1, types.TREASURY_VOTE_OPTION_YES, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 			shouldErr: true,
 		},
 		{
-			name: "invalid revoker address returns error",
+			name: "voter not part of the proposal voters returns error",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil),
-				)
-
-				suite.k.SetUserPermissions(ctx,
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
 					1,
-					types.RootSectionID,
-					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
-				)
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 1, 14, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					[]string{"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"},
+				))
 			},
-			msg: types.NewMsgRevokeTreasuryAuthorization(
-				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"",
-				"/cosmos.v1beta1.MsgSend",
-			),
+			msg:       types.NewMsgVoteTreasuryProposal(1, 1, types.TREASURY_VOTE_OPTION_YES, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
 			shouldErr: true,
 		},
 		{
-			name: "non exiting authorization returns error",
+			name: "vote not reaching the threshold is saved correctly",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil),
-				)
-
-				suite.k.SetUserPermissions(ctx,
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
 					1,
-					types.RootSectionID,
-					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
-				)
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 1, 14, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					[]string{"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"},
+				))
+			},
+			msg:       types.NewMsgVoteTreasuryProposal(1, 1, types.TREASURY_VOTE_OPTION_NO, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeVotedTreasuryProposal,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyProposalID, "1"),
+					sdk.NewAttribute(types.AttributeKeyVoter, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					sdk.NewAttribute(types.AttributeKeyVoteOption, types.TREASURY_VOTE_OPTION_NO.String()),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				vote, found := suite.k.GetTreasuryVote(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_NO), vote)
+
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.TREASURY_PROPOSAL_STATUS_VOTING, proposal.Status)
 			},
-			msg: types.NewMsgRevokeTreasuryAuthorization(
-				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-				"/cosmos.v1beta1.MsgSend",
-			),
-			shouldErr: true,
 		},
 		{
-			name: "valid request deletes authorization properly",
+			name: "vote reaching the threshold executes the proposal",
 			store: func(ctx sdk.Context) {
-				suite.k.SaveSubspace(ctx, types.NewSubspace(1,
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
 					"Test subspace",
 					"This is a test subspace",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(2, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 1, 14, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					[]string{"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"},
+				))
+			},
+			msg:       types.NewMsgVoteTreasuryProposal(1, 1, types.TREASURY_VOTE_OPTION_YES, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeVotedTreasuryProposal,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyProposalID, "1"),
+					sdk.NewAttribute(types.AttributeKeyVoter, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					sdk.NewAttribute(types.AttributeKeyVoteOption, types.TREASURY_VOTE_OPTION_YES.String()),
+				),
+				sdk.NewEvent(
+					types.EventTypeExecutedTreasuryProposal,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyProposalID, "1"),
+					sdk.NewAttribute(types.AttributeKeyProposalStatus, types.TREASURY_PROPOSAL_STATUS_FAILED.String()),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.TREASURY_PROPOSAL_STATUS_FAILED, proposal.Status)
+			},
+		},
+		{
+			name: "members added after the submission do not change the threshold",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
 					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
-					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
-					nil),
-				)
-
-				suite.k.SetUserPermissions(ctx,
+					nil,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(1, 0, 1, "Test group", "", types.NewPermissions()))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
 					1,
-					types.RootSectionID,
-					"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-					types.NewPermissions(types.PermissionManageTreasuryAuthorization),
-				)
-
-				treasury, err := sdk.AccAddressFromBech32("cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")
-				suite.Require().NoError(err)
-				grantee, err := sdk.AccAddressFromBech32("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69")
-				suite.Require().NoError(err)
-
-				expiration := time.Date(2024, 1, 11, 1, 1, 1, 1, time.UTC)
-				err = suite.authzKeeper.SaveGrant(ctx,
-					grantee,
-					treasury,
-					&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))},
-					&expiration,
-				)
-				suite.Require().NoError(err)
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(2, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 1, 14, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					[]string{"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"},
+				))
 			},
-			msg: types.NewMsgRevokeTreasuryAuthorization(
-				1,
-				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
-				"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
-				"/cosmos.bank.v1beta1.MsgSend",
-			),
-			shouldErr:   false,
-			expResponse: &types.MsgRevokeTreasuryAuthorizationResponse{},
+			msg:       types.NewMsgVoteTreasuryProposal(1, 1, types.TREASURY_VOTE_OPTION_YES, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: false,
 			expEvents: sdk.Events{
 				sdk.NewEvent(
-					types.EventTypeRevokedTreasuryAuthorization,
+					types.EventTypeVotedTreasuryProposal,
 					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
-					sdk.NewAttribute(types.AttributeKeyGranter, "cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez"),
-					sdk.NewAttribute(types.AttributeKeyGrantee, "cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69"),
+					sdk.NewAttribute(types.AttributeKeyProposalID, "1"),
+					sdk.NewAttribute(types.AttributeKeyVoter, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+					sdk.NewAttribute(types.AttributeKeyVoteOption, types.TREASURY_VOTE_OPTION_YES.String()),
+				),
+				sdk.NewEvent(
+					types.EventTypeExecutedTreasuryProposal,
+					sdk.NewAttribute(types.AttributeKeySubspaceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyProposalID, "1"),
+					sdk.NewAttribute(types.AttributeKeyProposalStatus, types.TREASURY_PROPOSAL_STATUS_FAILED.String()),
 				),
 			},
 			check: func(ctx sdk.Context) {
-				treasury, err := sdk.AccAddressFromBech32("cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")
-				suite.Require().NoError(err)
-				grantee, err := sdk.AccAddressFromBech32("cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69")
-				suite.Require().NoError(err)
-
-				authorizations, err := suite.authzKeeper.GetAuthorizations(ctx, grantee, treasury)
-				suite.Require().NoError(err)
-				suite.Require().Equal(0, len(authorizations))
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.TREASURY_PROPOSAL_STATUS_FAILED, proposal.Status)
 			},
 		},
 	}
@@ -3556,17 +7816,13 @@ func (suite *KeeperTestSuite) TestMsgServer_RevokeTreasuryAuthorization() {
 
 			// Run the message
 			service := keeper.NewMsgServerImpl(suite.k)
-			res, err := service.RevokeTreasuryAuthorization(sdk.WrapSDKContext(ctx), tc.msg)
+			_, err := service.VoteTreasuryProposal(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
-				suite.Require().Equal(tc.expResponse, res)
-
-				for _, event := range tc.expEvents {
-					suite.Require().Contains(ctx.EventManager().Events(), event)
-				}
+				suite.Require().Equal(tc.expEvents, ctx.EventManager().Events())
 
 				if tc.check != nil {
 					tc.check(ctx)
@@ -3576,8 +7832,6 @@ func (suite *KeeperTestSuite) TestMsgServer_RevokeTreasuryAuthorization() {
 	}
 }
 
-var expiration = time.Date(2100, 1, 11, 0, 0, 0, 0, time.UTC)
-
 func (suite *KeeperTestSuite) TestMsgServer_GrantAllowance() {
 	testCases := []struct {
 		name      string
@@ -3638,6 +7892,7 @@ func (suite *KeeperTestSuite) TestMsgServer_GrantAllowance() {
 					types.RootSectionID,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 					types.NewPermissions(types.PermissionManageAllowances),
+					nil,
 				)
 
 				suite.k.SaveGrant(ctx, types.NewGrant(
@@ -3674,6 +7929,7 @@ func (suite *KeeperTestSuite) TestMsgServer_GrantAllowance() {
 					types.RootSectionID,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 					types.NewPermissions(types.PermissionManageAllowances),
+					nil,
 				)
 			},
 			msg: types.NewMsgGrantAllowance(
@@ -3712,6 +7968,7 @@ func (suite *KeeperTestSuite) TestMsgServer_GrantAllowance() {
 					types.RootSectionID,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 					types.NewPermissions(types.PermissionManageAllowances),
+					nil,
 				)
 
 				suite.k.SaveGrant(ctx, types.NewGrant(
@@ -3748,6 +8005,7 @@ func (suite *KeeperTestSuite) TestMsgServer_GrantAllowance() {
 					types.RootSectionID,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 					types.NewPermissions(types.PermissionManageAllowances),
+					nil,
 				)
 			},
 			msg: types.NewMsgGrantAllowance(
@@ -3804,6 +8062,7 @@ func (suite *KeeperTestSuite) TestMsgServer_GrantAllowance() {
 					types.RootSectionID,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 					types.NewPermissions(types.PermissionManageAllowances),
+					nil,
 				)
 			},
 			msg: types.NewMsgGrantAllowance(
@@ -3908,6 +8167,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RevokeAllowance() {
 					types.RootSectionID,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 					types.NewPermissions(types.PermissionManageAllowances),
+					nil,
 				)
 			},
 			msg: types.NewMsgRevokeAllowance(
@@ -3936,6 +8196,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RevokeAllowance() {
 					types.RootSectionID,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 					types.NewPermissions(types.PermissionManageAllowances),
+					nil,
 				)
 			},
 			msg: types.NewMsgRevokeAllowance(
@@ -3964,6 +8225,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RevokeAllowance() {
 					types.RootSectionID,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 					types.NewPermissions(types.PermissionManageAllowances),
+					nil,
 				)
 
 				suite.k.SaveGrant(ctx, types.NewGrant(
@@ -4010,6 +8272,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RevokeAllowance() {
 					types.RootSectionID,
 					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 					types.NewPermissions(types.PermissionManageAllowances),
+					nil,
 				)
 
 				suite.k.SaveGrant(ctx, types.NewGrant(1,
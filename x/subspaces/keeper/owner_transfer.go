@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SaveSubspaceOwnerTransferRequest saves the given owner transfer request inside the current context
+func (k Keeper) SaveSubspaceOwnerTransferRequest(ctx sdk.Context, request types.SubspaceOwnerTransferRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SubspaceOwnerTransferRequestStoreKey(request.SubspaceID), k.cdc.MustMarshal(&request))
+
+	k.Logger(ctx).Info("subspace owner transfer request saved", "subspace id", request.SubspaceID)
+	k.AfterSubspaceOwnerTransferRequestSaved(ctx, request.SubspaceID)
+}
+
+// HasSubspaceOwnerTransferRequest tells whether an owner transfer request exists for the given subspace
+func (k Keeper) HasSubspaceOwnerTransferRequest(ctx sdk.Context, subspaceID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SubspaceOwnerTransferRequestStoreKey(subspaceID))
+}
+
+// GetSubspaceOwnerTransferRequest returns the owner transfer request associated with the given subspace.
+// If there is no request associated with the given subspace the function will return an empty request and false.
+func (k Keeper) GetSubspaceOwnerTransferRequest(ctx sdk.Context, subspaceID uint64) (request types.SubspaceOwnerTransferRequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.SubspaceOwnerTransferRequestStoreKey(subspaceID)
+	if !store.Has(key) {
+		return types.SubspaceOwnerTransferRequest{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &request)
+	return request, true
+}
+
+// DeleteSubspaceOwnerTransferRequest deletes the owner transfer request associated with the given subspace
+func (k Keeper) DeleteSubspaceOwnerTransferRequest(ctx sdk.Context, subspaceID uint64) {
+	if !k.HasSubspaceOwnerTransferRequest(ctx, subspaceID) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SubspaceOwnerTransferRequestStoreKey(subspaceID))
+
+	k.Logger(ctx).Info("subspace owner transfer request deleted", "subspace id", subspaceID)
+	k.AfterSubspaceOwnerTransferRequestDeleted(ctx, subspaceID)
+}
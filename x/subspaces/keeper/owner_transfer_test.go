@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveSubspaceOwnerTransferRequest() {
+	testCases := []struct {
+		name    string
+		store   func(ctx sdk.Context)
+		request types.SubspaceOwnerTransferRequest
+		check   func(ctx sdk.Context)
+	}{
+		{
+			name: "non existing request is stored properly",
+			request: types.NewSubspaceOwnerTransferRequest(
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			),
+			check: func(ctx sdk.Context) {
+				request, found := suite.k.GetSubspaceOwnerTransferRequest(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				), request)
+			},
+		},
+		{
+			name: "existing request is overwritten properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			request: types.NewSubspaceOwnerTransferRequest(
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			),
+			check: func(ctx sdk.Context) {
+				request, found := suite.k.GetSubspaceOwnerTransferRequest(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				), request)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SaveSubspaceOwnerTransferRequest(ctx, tc.request)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetSubspaceOwnerTransferRequest() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		expFound   bool
+		expRequest types.SubspaceOwnerTransferRequest
+	}{
+		{
+			name:       "not found request returns false",
+			subspaceID: 1,
+			expFound:   false,
+		},
+		{
+			name: "found request returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			subspaceID: 1,
+			expFound:   true,
+			expRequest: types.NewSubspaceOwnerTransferRequest(
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			request, found := suite.k.GetSubspaceOwnerTransferRequest(ctx, tc.subspaceID)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.expRequest, request)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteSubspaceOwnerTransferRequest() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing request is deleted properly",
+			subspaceID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasSubspaceOwnerTransferRequest(ctx, 1))
+			},
+		},
+		{
+			name: "existing request is deleted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			subspaceID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasSubspaceOwnerTransferRequest(ctx, 1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeleteSubspaceOwnerTransferRequest(ctx, tc.subspaceID)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
@@ -1,16 +1,24 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
 )
 
-// SetUserPermissions sets the given permission for the specific user inside a single subspace
-func (k Keeper) SetUserPermissions(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string, permissions types.Permissions) {
+// SetUserPermissions sets the given permission for the specific user inside a single subspace.
+// If an expiration time is provided, the permissions will be removed once it has passed
+func (k Keeper) SetUserPermissions(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string, permissions types.Permissions, expirationTime *time.Time) {
 	store := ctx.KVStore(k.storeKey)
-	permission := types.NewUserPermission(subspaceID, sectionID, user, permissions)
-	store.Set(types.UserPermissionStoreKey(subspaceID, sectionID, user), k.cdc.MustMarshal(&permission))
+	key := types.UserPermissionStoreKey(subspaceID, sectionID, user)
+
+	// Save the permission to the expiring queue
+	k.saveUserPermissionToExpiringQueue(ctx, expirationTime, key)
+
+	permission := types.NewUserPermission(subspaceID, sectionID, user, permissions, expirationTime)
+	store.Set(key, k.cdc.MustMarshal(&permission))
 
 	k.AfterUserPermissionSet(ctx, subspaceID, sectionID, user, permissions)
 }
@@ -28,6 +36,11 @@ func (k Keeper) HasPermission(ctx sdk.Context, subspaceID uint64, sectionID uint
 		return true
 	}
 
+	// Banned users do not have any permission inside the subspace
+	if k.IsUserBanned(ctx, subspaceID, user) {
+		return false
+	}
+
 	// Get the permissions set to the specific user
 	permissions := k.GetUserPermissions(ctx, subspaceID, sectionID, user)
 
@@ -39,6 +52,45 @@ func (k Keeper) HasPermission(ctx sdk.Context, subspaceID uint64, sectionID uint
 	return types.CheckPermission(types.CombinePermissions(permissions...), permission)
 }
 
+// GetPermissionSources returns all the sources that grant the given permission to the specified user inside
+// the provided subspace section. Note that the returned sources do not take into account whether the user is banned
+func (k Keeper) GetPermissionSources(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string, permission types.Permission) []types.PermissionSource {
+	subspace, found := k.GetSubspace(ctx, subspaceID)
+	if !found {
+		return nil
+	}
+
+	var sources []types.PermissionSource
+
+	// The owner of the subspaces has all the permissions by default
+	if subspace.Owner == user {
+		sources = append(sources, types.NewPermissionSourceOwner())
+	}
+
+	// Iterate over the section ancestors to find all the permissions that are inherited
+	k.IterateSectionPath(ctx, subspaceID, sectionID, func(section types.Section) (stop bool) {
+		inherited := section.ID != sectionID
+
+		// Check the permissions set to the specific user
+		userPermissions := k.getSectionPermissions(ctx, subspaceID, section.ID, user)
+		if types.CheckPermission(userPermissions, permission) {
+			sources = append(sources, types.NewPermissionSourceUser(section.ID, inherited, userPermissions))
+		}
+
+		// Check the permissions of the groups the user is part of
+		k.IterateSectionUserGroups(ctx, subspaceID, section.ID, func(group types.UserGroup) (stop bool) {
+			if k.IsMemberOfGroup(ctx, subspaceID, group.ID, user) && types.CheckPermission(group.Permissions, permission) {
+				sources = append(sources, types.NewPermissionSourceGroup(section.ID, inherited, group.ID, group.Permissions))
+			}
+			return false
+		})
+
+		return false
+	})
+
+	return sources
+}
+
 // getSectionPermissions gets the permissions for the given user set inside the specified section only
 func (k Keeper) getSectionPermissions(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string) types.Permissions {
 	store := ctx.KVStore(k.storeKey)
@@ -123,7 +175,63 @@ func (k Keeper) GetUsersWithRootPermissions(ctx sdk.Context, subspaceID uint64,
 // RemoveUserPermissions removes the permission for the given user inside the provided subspace
 func (k Keeper) RemoveUserPermissions(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.UserPermissionStoreKey(subspaceID, sectionID, user))
+	key := types.UserPermissionStoreKey(subspaceID, sectionID, user)
+
+	// Remove the permission from the expiring queue
+	k.removeUserPermissionFromExpiringQueue(ctx, key)
+
+	store.Delete(key)
 
 	k.AfterUserPermissionRemoved(ctx, subspaceID, sectionID, user)
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// saveUserPermissionToExpiringQueue saves the user permission stored with the given key into the expiring queue
+func (k Keeper) saveUserPermissionToExpiringQueue(ctx sdk.Context, expiration *time.Time, permissionKey []byte) {
+	// Make sure we remove the permission from the expiring queue to properly handle expiration updates and avoid duplicated keys
+	k.removeUserPermissionFromExpiringQueue(ctx, permissionKey)
+
+	store := ctx.KVStore(k.storeKey)
+	if expiration != nil {
+		store.Set(types.ExpiringUserPermissionKey(expiration, permissionKey), []byte{0x1})
+	}
+}
+
+// removeUserPermissionFromExpiringQueue removes the user permission stored with the given key from the expiring queue
+func (k Keeper) removeUserPermissionFromExpiringQueue(ctx sdk.Context, permissionKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	// Do nothing if the permission does not exist
+	if !store.Has(permissionKey) {
+		return
+	}
+
+	// Get the existing permission
+	var permission types.UserPermission
+	k.cdc.MustUnmarshal(store.Get(permissionKey), &permission)
+
+	// Delete the permission from the expiring queue
+	if permission.ExpirationTime != nil {
+		store.Delete(types.ExpiringUserPermissionKey(permission.ExpirationTime, permissionKey))
+	}
+}
+
+// RemoveExpiredUserPermissions removes all the user permissions that have expired before the given time
+func (k Keeper) RemoveExpiredUserPermissions(ctx sdk.Context, expiration time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ExpiringUserPermissionQueuePrefix, types.ExpiringUserPermissionTimePrefix(&expiration))
+
+	var expired []types.UserPermission
+	for ; iterator.Valid(); iterator.Next() {
+		var permission types.UserPermission
+		k.cdc.MustUnmarshal(store.Get(types.ParseUserPermissionKeyFromExpiringKey(iterator.Key())), &permission)
+		expired = append(expired, permission)
+	}
+	iterator.Close()
+
+	// Remove the permissions outside the iteration since removing them also updates the queue
+	for _, permission := range expired {
+		k.RemoveUserPermissions(ctx, permission.SubspaceID, permission.SectionID, permission.User)
+	}
+}
@@ -16,6 +16,7 @@ func (suite *KeeperTestSuite) TestKeeper_SetUserPermissions() {
 		sectionID   uint32
 		user        string
 		permissions types.Permissions
+		expiration  *time.Time
 		check       func(ctx sdk.Context)
 	}{
 		{
@@ -37,6 +38,7 @@ func (suite *KeeperTestSuite) TestKeeper_SetUserPermissions() {
 					0,
 					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
 					types.NewPermissions(types.PermissionManageGroups),
+					nil,
 				)
 			},
 			subspaceID:  1,
@@ -48,6 +50,43 @@ func (suite *KeeperTestSuite) TestKeeper_SetUserPermissions() {
 				suite.Require().Equal(types.NewPermissions(types.PermissionDeleteSubspace), permission)
 			},
 		},
+		{
+			name:        "expiring permissions are added to the expiring queue",
+			subspaceID:  1,
+			sectionID:   0,
+			user:        "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+			permissions: types.NewPermissions(types.PermissionEditSubspace),
+			expiration:  &expiration,
+			check: func(ctx sdk.Context) {
+				permission := suite.k.GetUserPermissions(ctx, 1, 0, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn")
+				suite.Require().Equal(types.NewPermissions(types.PermissionEditSubspace), permission)
+
+				store := ctx.KVStore(suite.storeKey)
+				key := types.UserPermissionStoreKey(1, 0, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn")
+				suite.Require().True(store.Has(types.ExpiringUserPermissionKey(&expiration, key)))
+			},
+		},
+		{
+			name: "existing expiration is removed from the expiring queue when overridden",
+			store: func(ctx sdk.Context) {
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+					types.NewPermissions(types.PermissionManageGroups),
+					&expiration,
+				)
+			},
+			subspaceID:  1,
+			sectionID:   0,
+			user:        "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+			permissions: types.NewPermissions(types.PermissionDeleteSubspace),
+			check: func(ctx sdk.Context) {
+				store := ctx.KVStore(suite.storeKey)
+				key := types.UserPermissionStoreKey(1, 0, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn")
+				suite.Require().False(store.Has(types.ExpiringUserPermissionKey(&expiration, key)))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -58,7 +97,7 @@ func (suite *KeeperTestSuite) TestKeeper_SetUserPermissions() {
 				tc.store(ctx)
 			}
 
-			suite.k.SetUserPermissions(ctx, tc.subspaceID, tc.sectionID, tc.user, tc.permissions)
+			suite.k.SetUserPermissions(ctx, tc.subspaceID, tc.sectionID, tc.user, tc.permissions, tc.expiration)
 
 			if tc.check != nil {
 				tc.check(ctx)
@@ -126,12 +165,13 @@ func (suite *KeeperTestSuite) TestKeeper_HasPermission() {
 					"This is a test group",
 					types.CombinePermissions(types.PermissionEditSubspace, types.PermissionDeleteSubspace),
 				))
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", nil)
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
 					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
 					types.NewPermissions(types.PermissionManageGroups),
+					nil,
 				)
 			},
 			subspaceID: 1,
@@ -140,6 +180,41 @@ func (suite *KeeperTestSuite) TestKeeper_HasPermission() {
 			permission: types.PermissionManageGroups,
 			expResult:  true,
 		},
+		{
+			name: "banned user with custom permission returns false",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+					"Spam",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					nil,
+				))
+			},
+			subspaceID: 1,
+			sectionID:  0,
+			user:       "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+			permission: types.PermissionManageGroups,
+			expResult:  false,
+		},
 		{
 			name: "user with inherited permission returns true",
 			store: func(ctx sdk.Context) {
@@ -163,7 +238,7 @@ func (suite *KeeperTestSuite) TestKeeper_HasPermission() {
 					types.CombinePermissions(types.PermissionEditSubspace, types.PermissionDeleteSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", nil)
 			},
 			subspaceID: 1,
 			sectionID:  0,
@@ -187,6 +262,166 @@ func (suite *KeeperTestSuite) TestKeeper_HasPermission() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_GetPermissionSources() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		sectionID  uint32
+		user       string
+		permission types.Permission
+		expSources []types.PermissionSource
+	}{
+		{
+			name:       "subspace not found returns nil",
+			subspaceID: 1,
+			sectionID:  0,
+			user:       "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+			permission: types.PermissionEditSubspace,
+			expSources: nil,
+		},
+		{
+			name: "subspace owner returns the owner source",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			subspaceID: 1,
+			sectionID:  0,
+			user:       "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			permission: types.PermissionEditSubspace,
+			expSources: []types.PermissionSource{
+				types.NewPermissionSourceOwner(),
+			},
+		},
+		{
+			name: "user without the permission returns nil",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+			},
+			subspaceID: 1,
+			sectionID:  0,
+			user:       "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+			permission: types.PermissionEditSubspace,
+			expSources: nil,
+		},
+		{
+			name: "direct, group and inherited sources are returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(1, 1, 0, "Test section", "", types.SECTION_VISIBILITY_PUBLIC))
+
+				// Direct permission set inside the requested section
+				suite.k.SetUserPermissions(ctx,
+					1,
+					1,
+					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+					types.NewPermissions(types.PermissionManageGroups),
+					nil,
+				)
+
+				// Direct permission inherited from the root section
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+					types.NewPermissions(types.PermissionEverything),
+					nil,
+				)
+
+				// Group permission set inside the requested section
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					1,
+					1,
+					"Section group",
+					"This is a section group",
+					types.NewPermissions(types.PermissionManageGroups, types.PermissionEditSubspace),
+				))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", nil)
+
+				// Group permission inherited from the root section
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					2,
+					"Root group",
+					"This is a root group",
+					types.NewPermissions(types.PermissionManageGroups),
+				))
+				suite.k.AddUserToGroup(ctx, 1, 2, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", nil)
+
+				// Group not granting the permission
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					3,
+					"Another group",
+					"This is another group",
+					types.NewPermissions(types.PermissionEditSubspace),
+				))
+				suite.k.AddUserToGroup(ctx, 1, 3, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn", nil)
+			},
+			subspaceID: 1,
+			sectionID:  1,
+			user:       "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+			permission: types.PermissionManageGroups,
+			expSources: []types.PermissionSource{
+				types.NewPermissionSourceUser(1, false, types.NewPermissions(types.PermissionManageGroups)),
+				types.NewPermissionSourceGroup(1, false, 1, types.NewPermissions(types.PermissionManageGroups, types.PermissionEditSubspace)),
+				types.NewPermissionSourceUser(0, true, types.NewPermissions(types.PermissionEverything)),
+				types.NewPermissionSourceGroup(0, true, 2, types.NewPermissions(types.PermissionManageGroups)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			sources := suite.k.GetPermissionSources(ctx, tc.subspaceID, tc.sectionID, tc.user, tc.permission)
+			suite.Require().Equal(tc.expSources, sources)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestKeeper_GetUserPermissions() {
 	testCases := []struct {
 		name           string
@@ -211,6 +446,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetUserPermissions() {
 					0,
 					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
 					types.CombinePermissions(types.PermissionEditSubspace, types.PermissionDeleteSubspace),
+					nil,
 				)
 			},
 			subspaceID:     1,
@@ -228,9 +464,9 @@ func (suite *KeeperTestSuite) TestKeeper_GetUserPermissions() {
 				//    |
 				//    C
 				suite.k.SaveSection(ctx, types.DefaultSection(1))
-				suite.k.SaveSection(ctx, types.NewSection(1, 1, 0, "A", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 2, 0, "B", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 3, 1, "C", ""))
+				suite.k.SaveSection(ctx, types.NewSection(1, 1, 0, "A", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 2, 0, "B", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 3, 1, "C", "", types.SECTION_VISIBILITY_PUBLIC))
 
 				// Set the permission inside root
 				suite.k.SetUserPermissions(ctx,
@@ -238,6 +474,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetUserPermissions() {
 					0,
 					"cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
 					types.NewPermissions(types.PermissionManageGroups),
+					nil,
 				)
 			},
 			subspaceID:     1,
@@ -290,7 +527,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetGroupsInheritedPermissions() {
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm", nil)
 			},
 			subspaceID:     1,
 			sectionID:      0,
@@ -318,8 +555,8 @@ func (suite *KeeperTestSuite) TestKeeper_GetGroupsInheritedPermissions() {
 					types.NewPermissions(types.PermissionDeleteSubspace, types.PermissionSetPermissions),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")
-				suite.k.AddUserToGroup(ctx, 1, 2, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm", nil)
+				suite.k.AddUserToGroup(ctx, 1, 2, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm", nil)
 			},
 			subspaceID:     1,
 			sectionID:      0,
@@ -336,9 +573,9 @@ func (suite *KeeperTestSuite) TestKeeper_GetGroupsInheritedPermissions() {
 				//         |
 				//         C
 				suite.k.SaveSection(ctx, types.DefaultSection(1))
-				suite.k.SaveSection(ctx, types.NewSection(1, 1, 0, "A", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 2, 0, "B", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 3, 1, "C", ""))
+				suite.k.SaveSection(ctx, types.NewSection(1, 1, 0, "A", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 2, 0, "B", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 3, 1, "C", "", types.SECTION_VISIBILITY_PUBLIC))
 
 				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
 					1,
@@ -357,8 +594,8 @@ func (suite *KeeperTestSuite) TestKeeper_GetGroupsInheritedPermissions() {
 					types.NewPermissions(types.PermissionDeleteSubspace, types.PermissionSetPermissions),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")
-				suite.k.AddUserToGroup(ctx, 1, 2, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm", nil)
+				suite.k.AddUserToGroup(ctx, 1, 2, "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm", nil)
 			},
 			subspaceID:     1,
 			sectionID:      3,
@@ -426,7 +663,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetUsersWithRootPermissions() {
 					"This is a test group",
 					types.NewPermissions(types.PermissionEditSubspace),
 				))
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", nil)
 			},
 			subspaceID:  1,
 			permissions: types.NewPermissions(types.PermissionEditSubspace),
@@ -454,6 +691,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetUsersWithRootPermissions() {
 					0,
 					"cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd",
 					types.NewPermissions(types.PermissionEditSubspace),
+					nil,
 				)
 			},
 			subspaceID:  1,
@@ -485,7 +723,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetUsersWithRootPermissions() {
 					"This is a test group",
 					types.NewPermissions(types.PermissionEditSubspace, types.PermissionSetPermissions),
 				))
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1xw69y2z3yf00rgfnly99628gn5c0x7fryyfv5e", nil)
 
 				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
 					1,
@@ -496,18 +734,20 @@ func (suite *KeeperTestSuite) TestKeeper_GetUsersWithRootPermissions() {
 					types.NewPermissions(types.PermissionSetPermissions),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 2, "cosmos1e32dfqu7k9e5wj85cjtalqdd2zs6z7adgswnrn")
+				suite.k.AddUserToGroup(ctx, 1, 2, "cosmos1e32dfqu7k9e5wj85cjtalqdd2zs6z7adgswnrn", nil)
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
 					"cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd",
 					types.CombinePermissions(types.PermissionEditSubspace, types.PermissionDeleteSubspace),
+					nil,
 				)
 				suite.k.SetUserPermissions(ctx,
 					1,
 					0,
 					"cosmos1f3e5dhpg3afanddld0kp6lkayz2qvuetf6hmv3",
 					types.NewPermissions(types.PermissionSetPermissions),
+					nil,
 				)
 			},
 			subspaceID:  1,
@@ -561,6 +801,7 @@ func (suite *KeeperTestSuite) TestKeeper_RemoveUserPermissions() {
 					1,
 					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
 					types.NewPermissions(types.PermissionManageGroups),
+					nil,
 				)
 			},
 			subspaceID: 1,
@@ -571,6 +812,29 @@ func (suite *KeeperTestSuite) TestKeeper_RemoveUserPermissions() {
 				suite.Require().Empty(permissions)
 			},
 		},
+		{
+			name: "expiring permissions are removed from the expiring queue",
+			store: func(ctx sdk.Context) {
+				suite.k.SetUserPermissions(ctx,
+					1,
+					0,
+					"cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+					types.NewPermissions(types.PermissionManageGroups),
+					&expiration,
+				)
+			},
+			subspaceID: 1,
+			sectionID:  0,
+			user:       "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn",
+			check: func(ctx sdk.Context) {
+				permissions := suite.k.GetUserPermissions(ctx, 1, 0, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn")
+				suite.Require().Empty(permissions)
+
+				store := ctx.KVStore(suite.storeKey)
+				key := types.UserPermissionStoreKey(1, 0, "cosmos1fz49f2njk28ue8geqm63g4zzsm97lahqa9vmwn")
+				suite.Require().False(store.Has(types.ExpiringUserPermissionKey(&expiration, key)))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
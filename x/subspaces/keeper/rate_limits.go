@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SaveRateLimit saves the given rate limit inside the current context
+func (k Keeper) SaveRateLimit(ctx sdk.Context, limit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RateLimitStoreKey(limit.SubspaceID, limit.MsgTypeURL), k.cdc.MustMarshal(&limit))
+
+	k.Logger(ctx).Info("rate limit saved", "subspace id", limit.SubspaceID, "msg type url", limit.MsgTypeURL)
+}
+
+// HasRateLimit tells whether a rate limit for the given message type exists inside the specified subspace
+func (k Keeper) HasRateLimit(ctx sdk.Context, subspaceID uint64, msgTypeURL string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.RateLimitStoreKey(subspaceID, msgTypeURL))
+}
+
+// GetRateLimit returns the rate limit of the given message type from the specified subspace.
+// If there is no rate limit the function will return an empty rate limit and false.
+func (k Keeper) GetRateLimit(ctx sdk.Context, subspaceID uint64, msgTypeURL string) (limit types.RateLimit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.RateLimitStoreKey(subspaceID, msgTypeURL)
+	if !store.Has(key) {
+		return types.RateLimit{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &limit)
+	return limit, true
+}
+
+// DeleteRateLimit deletes the rate limit of the given message type from the specified subspace,
+// along with all the counters that have been tracked for it
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, subspaceID uint64, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RateLimitStoreKey(subspaceID, msgTypeURL))
+
+	// Delete all the counters
+	iterator := sdk.KVStorePrefixIterator(store, types.RateLimitCountersPrefix(subspaceID, msgTypeURL))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	k.Logger(ctx).Info("rate limit deleted", "subspace id", subspaceID, "msg type url", msgTypeURL)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveRateLimitCounter saves the given counter for the specified user and message type inside the given subspace
+func (k Keeper) SaveRateLimitCounter(ctx sdk.Context, subspaceID uint64, msgTypeURL string, user string, counter types.RateLimitCounter) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RateLimitCounterStoreKey(subspaceID, msgTypeURL, user), k.cdc.MustMarshal(&counter))
+}
+
+// GetRateLimitCounter returns the counter of the given user for the specified message type inside the given subspace.
+// If there is no counter the function will return an empty counter and false.
+func (k Keeper) GetRateLimitCounter(ctx sdk.Context, subspaceID uint64, msgTypeURL string, user string) (counter types.RateLimitCounter, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.RateLimitCounterStoreKey(subspaceID, msgTypeURL, user)
+	if !store.Has(key) {
+		return types.RateLimitCounter{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &counter)
+	return counter, true
+}
+
+// ConsumeRateLimit records that the given user is sending a message of the provided type inside the specified subspace.
+// If the subspace has a rate limit for such message type and the user has already reached it during the current
+// sliding window, ErrRateLimitExceeded is returned instead.
+// Users having the PermissionBypassRateLimits permission are never limited.
+func (k Keeper) ConsumeRateLimit(ctx sdk.Context, subspaceID uint64, msgTypeURL string, user string) error {
+	limit, found := k.GetRateLimit(ctx, subspaceID, msgTypeURL)
+	if !found {
+		return nil
+	}
+
+	if k.HasPermission(ctx, subspaceID, types.RootSectionID, user, types.PermissionBypassRateLimits) {
+		return nil
+	}
+
+	counter, _ := k.GetRateLimitCounter(ctx, subspaceID, msgTypeURL, user)
+	counter = counter.Refresh(ctx.BlockTime(), limit.Window)
+
+	if counter.EstimateCount(ctx.BlockTime(), limit.Window) >= uint64(limit.MaxMessages) {
+		return errors.Wrapf(types.ErrRateLimitExceeded,
+			"%s can send at most %d %s messages every %s", user, limit.MaxMessages, msgTypeURL, limit.Window)
+	}
+
+	k.SaveRateLimitCounter(ctx, subspaceID, msgTypeURL, user, counter.Increment())
+	return nil
+}
+
+// ConsumeMsgRateLimits consumes the rate limits that apply to the given message for each one of its signers.
+// If any of the signers has already reached the rate limit, ErrRateLimitExceeded is returned instead.
+func (k Keeper) ConsumeMsgRateLimits(ctx sdk.Context, msg types.SubspaceMsg) error {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	for _, signer := range msg.GetSigners() {
+		err := k.ConsumeRateLimit(ctx, msg.GetSubspaceID(), msgTypeURL, signer.String())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,416 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveRateLimit() {
+	testCases := []struct {
+		name  string
+		store func(ctx sdk.Context)
+		limit types.RateLimit
+		check func(ctx sdk.Context)
+	}{
+		{
+			name:  "non existing rate limit is stored properly",
+			limit: types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+			check: func(ctx sdk.Context) {
+				limit, found := suite.k.GetRateLimit(ctx, 1, "/desmos.posts.v3.MsgCreatePost")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour), limit)
+			},
+		},
+		{
+			name: "existing rate limit is overwritten properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour))
+			},
+			limit: types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 5, time.Minute),
+			check: func(ctx sdk.Context) {
+				limit, found := suite.k.GetRateLimit(ctx, 1, "/desmos.posts.v3.MsgCreatePost")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 5, time.Minute), limit)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SaveRateLimit(ctx, tc.limit)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetRateLimit() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		msgTypeURL string
+		expFound   bool
+		expLimit   types.RateLimit
+	}{
+		{
+			name:       "not found rate limit returns false",
+			subspaceID: 1,
+			msgTypeURL: "/desmos.posts.v3.MsgCreatePost",
+			expFound:   false,
+		},
+		{
+			name: "found rate limit returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour))
+			},
+			subspaceID: 1,
+			msgTypeURL: "/desmos.posts.v3.MsgCreatePost",
+			expFound:   true,
+			expLimit:   types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			limit, found := suite.k.GetRateLimit(ctx, tc.subspaceID, tc.msgTypeURL)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.expLimit, limit)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteRateLimit() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		msgTypeURL string
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing rate limit is deleted properly",
+			subspaceID: 1,
+			msgTypeURL: "/desmos.posts.v3.MsgCreatePost",
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasRateLimit(ctx, 1, "/desmos.posts.v3.MsgCreatePost"))
+			},
+		},
+		{
+			name: "existing rate limit is deleted properly along with its counters",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgAnswerPoll", 10, time.Hour))
+
+				counter := types.NewRateLimitCounter(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC), 1, 0)
+				suite.k.SaveRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgCreatePost", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", counter)
+				suite.k.SaveRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgAnswerPoll", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", counter)
+			},
+			subspaceID: 1,
+			msgTypeURL: "/desmos.posts.v3.MsgCreatePost",
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasRateLimit(ctx, 1, "/desmos.posts.v3.MsgCreatePost"))
+				_, found := suite.k.GetRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgCreatePost", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().False(found)
+
+				// Make sure the other rate limit is untouched
+				suite.Require().True(suite.k.HasRateLimit(ctx, 1, "/desmos.posts.v3.MsgAnswerPoll"))
+				_, found = suite.k.GetRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgAnswerPoll", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(found)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeleteRateLimit(ctx, tc.subspaceID, tc.msgTypeURL)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ConsumeRateLimit() {
+	blockTime := time.Date(2020, 1, 1, 12, 30, 00, 000, time.UTC)
+	windowStart := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		msgTypeURL string
+		user       string
+		shouldErr  bool
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "message without rate limit is not counted",
+			subspaceID: 1,
+			msgTypeURL: "/desmos.posts.v3.MsgCreatePost",
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			shouldErr:  false,
+			check: func(ctx sdk.Context) {
+				_, found := suite.k.GetRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgCreatePost", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().False(found)
+			},
+		},
+		{
+			name: "user with bypass permission is not counted",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 1, time.Hour))
+				suite.k.SetUserPermissions(ctx,
+					1,
+					types.RootSectionID,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewPermissions(types.PermissionBypassRateLimits),
+					nil,
+				)
+				suite.k.SaveRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgCreatePost", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewRateLimitCounter(windowStart, 1, 0))
+			},
+			subspaceID: 1,
+			msgTypeURL: "/desmos.posts.v3.MsgCreatePost",
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			shouldErr:  false,
+			check: func(ctx sdk.Context) {
+				counter, found := suite.k.GetRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgCreatePost", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRateLimitCounter(windowStart, 1, 0), counter)
+			},
+		},
+		{
+			name: "user that reached the limit returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 2, time.Hour))
+				suite.k.SaveRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgCreatePost", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewRateLimitCounter(windowStart, 2, 0))
+			},
+			subspaceID: 1,
+			msgTypeURL: "/desmos.posts.v3.MsgCreatePost",
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			shouldErr:  true,
+		},
+		{
+			name: "previous window messages are weighted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 4, time.Hour))
+
+				// 4 messages in the previous window count as 2 at half of the current window
+				suite.k.SaveRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgCreatePost", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					types.NewRateLimitCounter(windowStart.Add(-time.Hour), 4, 0))
+			},
+			subspaceID: 1,
+			msgTypeURL: "/desmos.posts.v3.MsgCreatePost",
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			shouldErr:  false,
+			check: func(ctx sdk.Context) {
+				counter, found := suite.k.GetRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgCreatePost", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRateLimitCounter(windowStart, 1, 4), counter)
+			},
+		},
+		{
+			name: "user below the limit is counted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 2, time.Hour))
+			},
+			subspaceID: 1,
+			msgTypeURL: "/desmos.posts.v3.MsgCreatePost",
+			user:       "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+			shouldErr:  false,
+			check: func(ctx sdk.Context) {
+				counter, found := suite.k.GetRateLimitCounter(ctx, 1, "/desmos.posts.v3.MsgCreatePost", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRateLimitCounter(windowStart, 1, 0), counter)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.ConsumeRateLimit(ctx, tc.subspaceID, tc.msgTypeURL, tc.user)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ConsumeMsgRateLimits() {
+	blockTime := time.Date(2020, 1, 1, 12, 30, 00, 000, time.UTC)
+	windowStart := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	msg := types.NewMsgAddUserToUserGroup(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+	msgTypeURL := sdk.MsgTypeURL(msg)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       types.SubspaceMsg
+		shouldErr bool
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "msg without rate limit is not counted",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			msg:       msg,
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				_, found := suite.k.GetRateLimitCounter(ctx, 1, msgTypeURL, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().False(found)
+			},
+		},
+		{
+			name: "signer that reached the limit returns error",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, msgTypeURL, 2, time.Hour))
+				suite.k.SaveRateLimitCounter(ctx, 1, msgTypeURL, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					types.NewRateLimitCounter(windowStart, 2, 0))
+			},
+			msg:       msg,
+			shouldErr: true,
+		},
+		{
+			name: "signer below the limit is counted properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveRateLimit(ctx, types.NewRateLimit(1, msgTypeURL, 2, time.Hour))
+			},
+			msg:       msg,
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				counter, found := suite.k.GetRateLimitCounter(ctx, 1, msgTypeURL, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRateLimitCounter(windowStart, 1, 0), counter)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.ConsumeMsgRateLimits(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
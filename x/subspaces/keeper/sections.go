@@ -84,6 +84,52 @@ func (k Keeper) GetSection(ctx sdk.Context, subspaceID uint64, sectionID uint32)
 	return section, true
 }
 
+// isSubspaceMember tells whether the given user is the owner of the subspace having the given id,
+// or a member of at least one of its user groups
+func (k Keeper) isSubspaceMember(ctx sdk.Context, subspaceID uint64, user string) bool {
+	subspace, found := k.GetSubspace(ctx, subspaceID)
+	if !found {
+		return false
+	}
+
+	if subspace.Owner == user {
+		return true
+	}
+
+	isMember := false
+	k.IterateSubspaceUserGroups(ctx, subspaceID, func(group types.UserGroup) (stop bool) {
+		isMember = k.IsMemberOfGroup(ctx, subspaceID, group.ID, user)
+		return isMember
+	})
+	return isMember
+}
+
+// canUserViewSectionContents tells whether the given user satisfies the visibility of the provided section
+func (k Keeper) canUserViewSectionContents(ctx sdk.Context, section types.Section, user string) bool {
+	switch section.Visibility {
+	case types.SECTION_VISIBILITY_PUBLIC:
+		return true
+	case types.SECTION_VISIBILITY_MEMBERS_ONLY:
+		return k.isSubspaceMember(ctx, section.SubspaceID, user)
+	case types.SECTION_VISIBILITY_GROUP_RESTRICTED:
+		return k.HasPermission(ctx, section.SubspaceID, section.ID, user, types.PermissionReadContent)
+	default:
+		return false
+	}
+}
+
+// CanUserViewSection tells whether the given user can read the contents of the section having the given id.
+// A user can read a section only if they satisfy the visibility of the section itself as well as the one of all its
+// ancestors. An empty user represents an anonymous viewer, who can only read public sections.
+func (k Keeper) CanUserViewSection(ctx sdk.Context, subspaceID uint64, sectionID uint32, user string) bool {
+	canView := true
+	k.IterateSectionPath(ctx, subspaceID, sectionID, func(section types.Section) (stop bool) {
+		canView = k.canUserViewSectionContents(ctx, section, user)
+		return !canView
+	})
+	return canView
+}
+
 // DeleteSection deletes the section having the given id from the subspace with the provided id
 func (k Keeper) DeleteSection(ctx sdk.Context, subspaceID uint64, sectionID uint32) {
 	store := ctx.KVStore(k.storeKey)
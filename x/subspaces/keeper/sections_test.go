@@ -197,6 +197,7 @@ func (suite *KeeperTestSuite) TestKeeper_SaveSection() {
 				0,
 				"Test section",
 				"This is a test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 			check: func(ctx sdk.Context) {
 				stored, found := suite.k.GetSection(ctx, 1, 1)
@@ -207,6 +208,7 @@ func (suite *KeeperTestSuite) TestKeeper_SaveSection() {
 					0,
 					"Test section",
 					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				), stored)
 			},
 		},
@@ -219,6 +221,7 @@ func (suite *KeeperTestSuite) TestKeeper_SaveSection() {
 					0,
 					"Test section",
 					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			section: types.NewSection(
@@ -227,6 +230,7 @@ func (suite *KeeperTestSuite) TestKeeper_SaveSection() {
 				1,
 				"Edited section",
 				"This is an edited section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 			check: func(ctx sdk.Context) {
 				stored, found := suite.k.GetSection(ctx, 1, 1)
@@ -237,6 +241,7 @@ func (suite *KeeperTestSuite) TestKeeper_SaveSection() {
 					1,
 					"Edited section",
 					"This is an edited section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				), stored)
 			},
 		},
@@ -287,9 +292,9 @@ func (suite *KeeperTestSuite) TestKeeper_IsSectionPathValid() {
 				//     / \
 				//    B - C
 				suite.k.SaveSection(ctx, types.DefaultSection(1))
-				suite.k.SaveSection(ctx, types.NewSection(1, 1, 3, "A", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 2, 1, "B", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 3, 2, "C", ""))
+				suite.k.SaveSection(ctx, types.NewSection(1, 1, 3, "A", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 2, 1, "B", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 3, 2, "C", "", types.SECTION_VISIBILITY_PUBLIC))
 			},
 			subspaceID: 1,
 			sectionID:  3,
@@ -314,8 +319,8 @@ func (suite *KeeperTestSuite) TestKeeper_IsSectionPathValid() {
 				//
 				//    A - B
 				suite.k.SaveSection(ctx, types.DefaultSection(1))
-				suite.k.SaveSection(ctx, types.NewSection(1, 1, 2, "A", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 2, 1, "B", ""))
+				suite.k.SaveSection(ctx, types.NewSection(1, 1, 2, "A", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 2, 1, "B", "", types.SECTION_VISIBILITY_PUBLIC))
 			},
 			subspaceID: 1,
 			sectionID:  2,
@@ -342,9 +347,9 @@ func (suite *KeeperTestSuite) TestKeeper_IsSectionPathValid() {
 				//    |
 				//    C
 				suite.k.SaveSection(ctx, types.DefaultSection(1))
-				suite.k.SaveSection(ctx, types.NewSection(1, 1, types.RootSectionID, "A", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 2, types.RootSectionID, "B", ""))
-				suite.k.SaveSection(ctx, types.NewSection(1, 3, 1, "C", ""))
+				suite.k.SaveSection(ctx, types.NewSection(1, 1, types.RootSectionID, "A", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 2, types.RootSectionID, "B", "", types.SECTION_VISIBILITY_PUBLIC))
+				suite.k.SaveSection(ctx, types.NewSection(1, 3, 1, "C", "", types.SECTION_VISIBILITY_PUBLIC))
 			},
 			subspaceID: 1,
 			sectionID:  3,
@@ -389,6 +394,7 @@ func (suite *KeeperTestSuite) TestKeeper_HasSection() {
 					1,
 					"Edited section",
 					"This is an edited section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			subspaceID: 1,
@@ -436,6 +442,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetSection() {
 					1,
 					"Edited section",
 					"This is an edited section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			subspaceID: 1,
@@ -446,6 +453,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetSection() {
 				1,
 				"Edited section",
 				"This is an edited section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 			expFound: true,
 		},
@@ -466,6 +474,298 @@ func (suite *KeeperTestSuite) TestKeeper_GetSection() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_CanUserViewSection() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		sectionID  uint32
+		user       string
+		expResult  bool
+	}{
+		{
+			name:       "non existing section returns true",
+			subspaceID: 1,
+			sectionID:  1,
+			user:       "cosmos1p7ue7v0zhr3yjq0jjt3tgwasxvx4jfu8e2eul3",
+			expResult:  true,
+		},
+		{
+			name: "public section returns true for anonymous viewers",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+			},
+			subspaceID: 1,
+			sectionID:  1,
+			user:       "",
+			expResult:  true,
+		},
+		{
+			name: "members-only section returns false for anonymous viewers",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_MEMBERS_ONLY,
+				))
+			},
+			subspaceID: 1,
+			sectionID:  1,
+			user:       "",
+			expResult:  false,
+		},
+		{
+			name: "members-only section returns false for non members",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_MEMBERS_ONLY,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(),
+				))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1p7ue7v0zhr3yjq0jjt3tgwasxvx4jfu8e2eul3", nil)
+			},
+			subspaceID: 1,
+			sectionID:  1,
+			user:       "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+			expResult:  false,
+		},
+		{
+			name: "members-only section returns true for group members",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_MEMBERS_ONLY,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(),
+				))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1p7ue7v0zhr3yjq0jjt3tgwasxvx4jfu8e2eul3", nil)
+			},
+			subspaceID: 1,
+			sectionID:  1,
+			user:       "cosmos1p7ue7v0zhr3yjq0jjt3tgwasxvx4jfu8e2eul3",
+			expResult:  true,
+		},
+		{
+			name: "group-restricted section returns false for users without the read permission",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_GROUP_RESTRICTED,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionManageGroups),
+				))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1p7ue7v0zhr3yjq0jjt3tgwasxvx4jfu8e2eul3", nil)
+			},
+			subspaceID: 1,
+			sectionID:  1,
+			user:       "cosmos1p7ue7v0zhr3yjq0jjt3tgwasxvx4jfu8e2eul3",
+			expResult:  false,
+		},
+		{
+			name: "group-restricted section returns true for users with the read permission",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_GROUP_RESTRICTED,
+				))
+				suite.k.SaveUserGroup(ctx, types.NewUserGroup(
+					1,
+					0,
+					1,
+					"Test group",
+					"This is a test group",
+					types.NewPermissions(types.PermissionReadContent),
+				))
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1p7ue7v0zhr3yjq0jjt3tgwasxvx4jfu8e2eul3", nil)
+			},
+			subspaceID: 1,
+			sectionID:  1,
+			user:       "cosmos1p7ue7v0zhr3yjq0jjt3tgwasxvx4jfu8e2eul3",
+			expResult:  true,
+		},
+		{
+			name: "public section inside a restricted one returns false for non members",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_MEMBERS_ONLY,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					2,
+					1,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_PUBLIC,
+				))
+			},
+			subspaceID: 1,
+			sectionID:  2,
+			user:       "cosmos1fgppppwfjszpts4shpsfv7n2xtchcdwhycuvvm",
+			expResult:  false,
+		},
+		{
+			name: "restricted section returns true for the subspace owner",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSection(ctx, types.NewSection(
+					1,
+					1,
+					0,
+					"Test section",
+					"This is a test section",
+					types.SECTION_VISIBILITY_GROUP_RESTRICTED,
+				))
+			},
+			subspaceID: 1,
+			sectionID:  1,
+			user:       "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			expResult:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			result := suite.k.CanUserViewSection(ctx, tc.subspaceID, tc.sectionID, tc.user)
+			suite.Require().Equal(tc.expResult, result)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestKeeper_DeleteSection() {
 	testCases := []struct {
 		name       string
@@ -491,6 +791,7 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteSection() {
 					0,
 					"Test section",
 					"This is a test edited section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 
 				// User groups
@@ -509,6 +810,7 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteSection() {
 					1,
 					"cosmos1p7vudy57pw08w6plujlpqpuqea2hkqusfq5zjc",
 					types.NewPermissions(types.PermissionManageSections),
+					nil,
 				)
 
 				// Children section
@@ -518,6 +820,7 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteSection() {
 					1,
 					"Child section",
 					"This is a child section",
+					types.SECTION_VISIBILITY_PUBLIC,
 				))
 			},
 			subspaceID: 1,
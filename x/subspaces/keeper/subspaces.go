@@ -29,6 +29,9 @@ func (k Keeper) GetSubspaceID(ctx sdk.Context) (subspaceID uint64, err error) {
 
 // SaveSubspace saves the given subspace inside the current context.
 func (k Keeper) SaveSubspace(ctx sdk.Context, subspace types.Subspace) {
+	// Get the previous version of the subspace, if any
+	previous, found := k.GetSubspace(ctx, subspace.ID)
+
 	store := ctx.KVStore(k.storeKey)
 
 	// Store the subspace
@@ -56,6 +59,14 @@ func (k Keeper) SaveSubspace(ctx sdk.Context, subspace types.Subspace) {
 
 	k.Logger(ctx).Info("subspace saved", "id", subspace.ID)
 	k.AfterSubspaceSaved(ctx, subspace.ID)
+
+	if found && previous.Owner != subspace.Owner {
+		// Any pending transfer request has been made by the previous owner, so it is no longer valid
+		k.DeleteSubspaceOwnerTransferRequest(ctx, subspace.ID)
+
+		k.Logger(ctx).Info("subspace owner transferred", "id", subspace.ID, "owner", subspace.Owner)
+		k.AfterSubspaceOwnerTransferred(ctx, subspace.ID, previous.Owner, subspace.Owner)
+	}
 }
 
 // HasSubspace tells whether the given subspace exists or not
@@ -78,6 +89,12 @@ func (k Keeper) GetSubspace(ctx sdk.Context, subspaceID uint64) (subspace types.
 	return subspace, true
 }
 
+// IsSubspaceArchived tells whether the subspace with the given id exists and has been archived
+func (k Keeper) IsSubspaceArchived(ctx sdk.Context, subspaceID uint64) bool {
+	subspace, found := k.GetSubspace(ctx, subspaceID)
+	return found && subspace.Archived
+}
+
 // DeleteSubspace allows to delete the subspace with the given id
 func (k Keeper) DeleteSubspace(ctx sdk.Context, subspaceID uint64) {
 	// Delete the subspace
@@ -88,6 +105,9 @@ func (k Keeper) DeleteSubspace(ctx sdk.Context, subspaceID uint64) {
 	k.DeleteNextSectionID(ctx, subspaceID)
 	k.DeleteNextGroupID(ctx, subspaceID)
 
+	// Delete the pending owner transfer request
+	k.DeleteSubspaceOwnerTransferRequest(ctx, subspaceID)
+
 	// Delete all the user grants
 	k.IterateSubspaceUserGrants(ctx, subspaceID, func(grant types.Grant) (stop bool) {
 		grantee := grant.Grantee.GetCachedValue().(*types.UserGrantee).User
@@ -114,6 +134,29 @@ func (k Keeper) DeleteSubspace(ctx sdk.Context, subspaceID uint64) {
 		return false
 	})
 
+	// Delete all the bans
+	k.IterateBannedUsers(ctx, subspaceID, func(ban types.SubspaceBan) (stop bool) {
+		k.DeleteSubspaceBan(ctx, ban.SubspaceID, ban.User)
+		return false
+	})
+
+	// Delete all the rate limits
+	k.IterateSubspaceRateLimits(ctx, subspaceID, func(limit types.RateLimit) (stop bool) {
+		k.DeleteRateLimit(ctx, limit.SubspaceID, limit.MsgTypeURL)
+		return false
+	})
+
+	// Delete all the treasury proposals along with their votes
+	k.IterateSubspaceTreasuryProposals(ctx, subspaceID, func(proposal types.TreasuryProposal) (stop bool) {
+		k.DeleteTreasuryProposal(ctx, proposal.SubspaceID, proposal.ID)
+		return false
+	})
+	k.DeleteNextTreasuryProposalID(ctx, subspaceID)
+	k.DeleteTreasuryVotingConfig(ctx, subspaceID)
+
+	// Delete the fee tokens config
+	k.DeleteFeeTokensConfig(ctx, subspaceID)
+
 	// Log the subspace deletion
 	k.Logger(ctx).Info("subspace deleted", "id", subspaceID)
 	k.AfterSubspaceDeleted(ctx, subspaceID)
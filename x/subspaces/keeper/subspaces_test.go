@@ -167,6 +167,39 @@ func (suite *KeeperTestSuite) TestKeeper_SaveSubspace() {
 				), subspace)
 			},
 		},
+		{
+			name: "owner change deletes the pending owner transfer request",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+				suite.k.SaveSubspaceOwnerTransferRequest(ctx, types.NewSubspaceOwnerTransferRequest(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				))
+			},
+			subspace: types.NewSubspace(
+				1,
+				"Test subspace",
+				"This is a test subspace",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				nil,
+			),
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasSubspaceOwnerTransferRequest(ctx, 1))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -290,6 +323,70 @@ func (suite *KeeperTestSuite) TestKeeper_GetSubspace() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_IsSubspaceArchived() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		expResult  bool
+	}{
+		{
+			name:       "not found subspace returns false",
+			subspaceID: 1,
+			expResult:  false,
+		},
+		{
+			name: "not archived subspace returns false",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			subspaceID: 1,
+			expResult:  false,
+		},
+		{
+			name: "archived subspace returns true",
+			store: func(ctx sdk.Context) {
+				subspace := types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				)
+				subspace.Archived = true
+				suite.k.SaveSubspace(ctx, subspace)
+			},
+			subspaceID: 1,
+			expResult:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			archived := suite.k.IsSubspaceArchived(ctx, tc.subspaceID)
+			suite.Require().Equal(tc.expResult, archived)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestKeeper_DeleteSubspace() {
 	testCases := []struct {
 		name       string
@@ -333,6 +430,7 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteSubspace() {
 					0,
 					"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
 					types.NewPermissions(types.PermissionEditSubspace),
+					nil,
 				)
 
 				suite.k.SaveGrant(ctx, types.NewGrant(
@@ -347,6 +445,14 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteSubspace() {
 					types.NewGroupGrantee(1),
 					&feegrant.BasicAllowance{},
 				))
+
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					"Spam",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					nil,
+				))
 			},
 			subspaceID: 1,
 			check: func(ctx sdk.Context) {
@@ -377,6 +483,9 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteSubspace() {
 				// Make sure the group grants are deleted
 				groupsGrants := suite.k.GetSubspaceUserGroupsGrants(ctx, 1)
 				suite.Require().Empty(groupsGrants)
+
+				// Make sure the bans are deleted
+				suite.Require().False(suite.k.HasSubspaceBan(ctx, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
 			},
 		},
 	}
@@ -0,0 +1,318 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SaveTreasuryVotingConfig saves the given treasury voting config inside the current context
+func (k Keeper) SaveTreasuryVotingConfig(ctx sdk.Context, config types.TreasuryVotingConfig) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TreasuryVotingConfigStoreKey(config.SubspaceID), k.cdc.MustMarshal(&config))
+
+	k.Logger(ctx).Info("treasury voting config saved", "subspace id", config.SubspaceID, "group id", config.GroupID)
+}
+
+// HasTreasuryVotingConfig tells whether the subspace with the given id has a treasury voting config
+func (k Keeper) HasTreasuryVotingConfig(ctx sdk.Context, subspaceID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.TreasuryVotingConfigStoreKey(subspaceID))
+}
+
+// GetTreasuryVotingConfig returns the treasury voting config of the subspace having the given id.
+// If there is no config the function will return an empty config and false.
+func (k Keeper) GetTreasuryVotingConfig(ctx sdk.Context, subspaceID uint64) (config types.TreasuryVotingConfig, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.TreasuryVotingConfigStoreKey(subspaceID)
+	if !store.Has(key) {
+		return types.TreasuryVotingConfig{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &config)
+	return config, true
+}
+
+// DeleteTreasuryVotingConfig deletes the treasury voting config of the subspace having the given id
+func (k Keeper) DeleteTreasuryVotingConfig(ctx sdk.Context, subspaceID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TreasuryVotingConfigStoreKey(subspaceID))
+
+	k.Logger(ctx).Info("treasury voting config deleted", "subspace id", subspaceID)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SetNextTreasuryProposalID sets the next treasury proposal id for the given subspace
+func (k Keeper) SetNextTreasuryProposalID(ctx sdk.Context, subspaceID uint64, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextTreasuryProposalIDStoreKey(subspaceID), types.GetTreasuryProposalIDBytes(proposalID))
+}
+
+// GetNextTreasuryProposalID returns the next treasury proposal id for the given subspace.
+// If no proposal has ever been submitted inside the subspace, 1 is returned
+func (k Keeper) GetNextTreasuryProposalID(ctx sdk.Context, subspaceID uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextTreasuryProposalIDStoreKey(subspaceID))
+	if bz == nil {
+		return 1
+	}
+	return types.GetTreasuryProposalIDFromBytes(bz)
+}
+
+// DeleteNextTreasuryProposalID deletes the next treasury proposal id for the given subspace
+func (k Keeper) DeleteNextTreasuryProposalID(ctx sdk.Context, subspaceID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.NextTreasuryProposalIDStoreKey(subspaceID))
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveTreasuryProposal saves the given treasury proposal inside the current context.
+// Proposals that are still being voted are also added to the active proposals queue so
+// that they can be rejected once their voting period ends
+func (k Keeper) SaveTreasuryProposal(ctx sdk.Context, proposal types.TreasuryProposal) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.TreasuryProposalStoreKey(proposal.SubspaceID, proposal.ID)
+
+	// Update the active queue
+	store.Delete(types.ActiveTreasuryProposalKey(proposal.VotingEndTime, key))
+	if proposal.Status == types.TREASURY_PROPOSAL_STATUS_VOTING {
+		store.Set(types.ActiveTreasuryProposalKey(proposal.VotingEndTime, key), []byte{0x1})
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&proposal))
+
+	k.Logger(ctx).Info("treasury proposal saved", "subspace id", proposal.SubspaceID, "proposal id", proposal.ID)
+}
+
+// HasTreasuryProposal tells whether the treasury proposal with the given id exists inside the specified subspace
+func (k Keeper) HasTreasuryProposal(ctx sdk.Context, subspaceID uint64, proposalID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.TreasuryProposalStoreKey(subspaceID, proposalID))
+}
+
+// GetTreasuryProposal returns the treasury proposal with the given id from the specified subspace.
+// If there is no proposal the function will return an empty proposal and false.
+func (k Keeper) GetTreasuryProposal(ctx sdk.Context, subspaceID uint64, proposalID uint64) (proposal types.TreasuryProposal, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.TreasuryProposalStoreKey(subspaceID, proposalID)
+	if !store.Has(key) {
+		return types.TreasuryProposal{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &proposal)
+	return proposal, true
+}
+
+// DeleteTreasuryProposal deletes the treasury proposal with the given id from the specified subspace,
+// along with all the votes that have been cast on it
+func (k Keeper) DeleteTreasuryProposal(ctx sdk.Context, subspaceID uint64, proposalID uint64) {
+	proposal, found := k.GetTreasuryProposal(ctx, subspaceID, proposalID)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.TreasuryProposalStoreKey(subspaceID, proposalID)
+
+	// Remove the proposal from the active queue
+	store.Delete(types.ActiveTreasuryProposalKey(proposal.VotingEndTime, key))
+
+	// Delete all the votes
+	var votes []types.TreasuryVote
+	k.IterateTreasuryProposalVotes(ctx, subspaceID, proposalID, func(vote types.TreasuryVote) (stop bool) {
+		votes = append(votes, vote)
+		return false
+	})
+
+	for _, vote := range votes {
+		k.DeleteTreasuryVote(ctx, vote.SubspaceID, vote.ProposalID, vote.Voter)
+	}
+
+	store.Delete(key)
+
+	k.Logger(ctx).Info("treasury proposal deleted", "subspace id", subspaceID, "proposal id", proposalID)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveTreasuryVote saves the given treasury proposal vote inside the current context
+func (k Keeper) SaveTreasuryVote(ctx sdk.Context, vote types.TreasuryVote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TreasuryVoteStoreKey(vote.SubspaceID, vote.ProposalID, vote.Voter), k.cdc.MustMarshal(&vote))
+
+	k.Logger(ctx).Info("treasury vote saved", "subspace id", vote.SubspaceID, "proposal id", vote.ProposalID, "voter", vote.Voter)
+}
+
+// GetTreasuryVote returns the vote of the given voter on the specified treasury proposal.
+// If there is no vote the function will return an empty vote and false.
+func (k Keeper) GetTreasuryVote(ctx sdk.Context, subspaceID uint64, proposalID uint64, voter string) (vote types.TreasuryVote, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.TreasuryVoteStoreKey(subspaceID, proposalID, voter)
+	if !store.Has(key) {
+		return types.TreasuryVote{}, false
+	}
+
+	k.cdc.MustUnmarshal(store.Get(key), &vote)
+	return vote, true
+}
+
+// DeleteTreasuryVote deletes the vote of the given voter from the specified treasury proposal
+func (k Keeper) DeleteTreasuryVote(ctx sdk.Context, subspaceID uint64, proposalID uint64, voter string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TreasuryVoteStoreKey(subspaceID, proposalID, voter))
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// CanSubmitTreasuryProposal tells whether the given user is allowed to submit proposals targeting the provided group.
+// Only current members of the group that are not banned from the subspace are allowed to do so
+func (k Keeper) CanSubmitTreasuryProposal(ctx sdk.Context, subspaceID uint64, groupID uint32, user string) bool {
+	return k.IsMemberOfGroup(ctx, subspaceID, groupID, user) && !k.IsUserBanned(ctx, subspaceID, user)
+}
+
+// GetTreasuryProposalVoters returns the addresses of the users that are currently allowed to vote proposals
+// targeting the provided group. These are stored inside each proposal when it is submitted
+func (k Keeper) GetTreasuryProposalVoters(ctx sdk.Context, subspaceID uint64, groupID uint32) []string {
+	var voters []string
+	k.IterateUserGroupMembers(ctx, subspaceID, groupID, func(member string) (stop bool) {
+		if !k.IsUserBanned(ctx, subspaceID, member) {
+			voters = append(voters, member)
+		}
+		return false
+	})
+	return voters
+}
+
+// CanVoteTreasuryProposal tells whether the given user is allowed to vote the provided proposal.
+// Only the voters of the proposal that are not banned from the subspace are allowed to do so
+func (k Keeper) CanVoteTreasuryProposal(ctx sdk.Context, proposal types.TreasuryProposal, user string) bool {
+	return proposal.IsVoter(user) && !k.IsUserBanned(ctx, proposal.SubspaceID, user)
+}
+
+// TallyTreasuryProposal returns the number of yes votes and the total number of votes that have been cast on
+// the given proposal by users that are still allowed to vote it, along with the number of voters of the proposal
+func (k Keeper) TallyTreasuryProposal(ctx sdk.Context, proposal types.TreasuryProposal) (yesVotes uint64, votesCount uint64, votersCount uint64) {
+	k.IterateTreasuryProposalVotes(ctx, proposal.SubspaceID, proposal.ID, func(vote types.TreasuryVote) (stop bool) {
+		if !k.CanVoteTreasuryProposal(ctx, proposal, vote.Voter) {
+			return false
+		}
+
+		votesCount++
+		if vote.Option == types.TREASURY_VOTE_OPTION_YES {
+			yesVotes++
+		}
+		return false
+	})
+
+	return yesVotes, votesCount, uint64(len(proposal.Voters))
+}
+
+// HasTreasuryProposalPassed tells whether the given proposal has reached its quorum, if any,
+// and has collected enough yes votes to be executed
+func (k Keeper) HasTreasuryProposalPassed(ctx sdk.Context, proposal types.TreasuryProposal) bool {
+	yesVotes, votesCount, votersCount := k.TallyTreasuryProposal(ctx, proposal)
+	if votersCount == 0 {
+		return false
+	}
+
+	if proposal.Quorum != nil && sdk.NewDec(int64(votesCount)).LT(proposal.Quorum.MulInt64(int64(votersCount))) {
+		return false
+	}
+
+	return sdk.NewDec(int64(yesVotes)).GTE(proposal.Threshold.MulInt64(int64(votersCount)))
+}
+
+// ExecuteTreasuryProposal executes the messages of the given proposal using the subspace treasury as signer.
+// The messages are executed atomically: if any of them fails, none of the changes are persisted and the
+// proposal is marked as failed. Otherwise, the proposal is marked as executed
+func (k Keeper) ExecuteTreasuryProposal(ctx sdk.Context, proposal types.TreasuryProposal) types.TreasuryProposal {
+	err := k.executeTreasuryProposalMsgs(ctx, proposal)
+	if err != nil {
+		k.Logger(ctx).Info("treasury proposal execution failed",
+			"subspace id", proposal.SubspaceID, "proposal id", proposal.ID, "error", err)
+		proposal.Status = types.TREASURY_PROPOSAL_STATUS_FAILED
+	} else {
+		proposal.Status = types.TREASURY_PROPOSAL_STATUS_EXECUTED
+	}
+
+	// The executed messages might have deleted the subspace itself, in which case there is nothing left to update
+	if k.HasSubspace(ctx, proposal.SubspaceID) {
+		k.SaveTreasuryProposal(ctx, proposal)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExecutedTreasuryProposal,
+			sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", proposal.SubspaceID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyProposalStatus, proposal.Status.String()),
+		),
+	})
+
+	return proposal
+}
+
+// executeTreasuryProposalMsgs dispatches the messages of the given proposal inside a cached context,
+// writing the changes only if all of them have been executed successfully
+func (k Keeper) executeTreasuryProposalMsgs(ctx sdk.Context, proposal types.TreasuryProposal) error {
+	subspace, found := k.GetSubspace(ctx, proposal.SubspaceID)
+	if !found {
+		return fmt.Errorf("subspace with id %d not found", proposal.SubspaceID)
+	}
+
+	treasury, err := sdk.AccAddressFromBech32(subspace.Treasury)
+	if err != nil {
+		return fmt.Errorf("invalid treasury address: %s", subspace.Treasury)
+	}
+
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	_, err = k.authzk.DispatchActions(cacheCtx, treasury, msgs)
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
+
+// RejectExpiredTreasuryProposals marks as rejected all the treasury proposals whose voting period
+// has ended before the given time without reaching the threshold
+func (k Keeper) RejectExpiredTreasuryProposals(ctx sdk.Context, blockTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ActiveTreasuryProposalQueuePrefix, sdk.PrefixEndBytes(types.ActiveTreasuryProposalTimePrefix(blockTime)))
+
+	var expired []types.TreasuryProposal
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.TreasuryProposal
+		k.cdc.MustUnmarshal(store.Get(types.ParseTreasuryProposalKeyFromActiveKey(iterator.Key())), &proposal)
+		expired = append(expired, proposal)
+	}
+	iterator.Close()
+
+	// Update the proposals outside the iteration since saving them also updates the queue
+	for _, proposal := range expired {
+		proposal.Status = types.TREASURY_PROPOSAL_STATUS_REJECTED
+		k.SaveTreasuryProposal(ctx, proposal)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRejectedTreasuryProposal,
+				sdk.NewAttribute(types.AttributeKeySubspaceID, fmt.Sprintf("%d", proposal.SubspaceID)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			),
+		})
+	}
+}
@@ -0,0 +1,607 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveTreasuryVotingConfig() {
+	testCases := []struct {
+		name   string
+		store  func(ctx sdk.Context)
+		config types.TreasuryVotingConfig
+		check  func(ctx sdk.Context)
+	}{
+		{
+			name:   "non existing config is stored properly",
+			config: types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+			check: func(ctx sdk.Context) {
+				config, found := suite.k.GetTreasuryVotingConfig(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour), config)
+			},
+		},
+		{
+			name: "existing config is overwritten properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour))
+			},
+			config: types.NewTreasuryVotingConfig(1, 2, sdk.OneDec(), nil, time.Minute),
+			check: func(ctx sdk.Context) {
+				config, found := suite.k.GetTreasuryVotingConfig(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewTreasuryVotingConfig(1, 2, sdk.OneDec(), nil, time.Minute), config)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SaveTreasuryVotingConfig(ctx, tc.config)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetTreasuryVotingConfig() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		expFound   bool
+		expConfig  types.TreasuryVotingConfig
+	}{
+		{
+			name:       "not found config returns false",
+			subspaceID: 1,
+			expFound:   false,
+		},
+		{
+			name: "found config returns the correct data",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryVotingConfig(ctx, types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour))
+			},
+			subspaceID: 1,
+			expFound:   true,
+			expConfig:  types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			config, found := suite.k.GetTreasuryVotingConfig(ctx, tc.subspaceID)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.expConfig, config)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (suite *KeeperTestSuite) TestKeeper_GetNextTreasuryProposalID() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		expID      uint64
+	}{
+		{
+			name:       "not set id returns 1",
+			subspaceID: 1,
+			expID:      1,
+		},
+		{
+			name: "existing id is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SetNextTreasuryProposalID(ctx, 1, 5)
+			},
+			subspaceID: 1,
+			expID:      5,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.Require().Equal(tc.expID, suite.k.GetNextTreasuryProposalID(ctx, tc.subspaceID))
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (suite *KeeperTestSuite) TestKeeper_SaveTreasuryProposal() {
+	votingEndTime := time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name     string
+		store    func(ctx sdk.Context)
+		proposal types.TreasuryProposal
+		check    func(ctx sdk.Context)
+	}{
+		{
+			name: "voting proposal is stored properly and added to the active queue",
+			proposal: types.NewTreasuryProposal(
+				1,
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+				1,
+				sdk.NewDecWithPrec(5, 1),
+				nil,
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				votingEndTime,
+				types.TREASURY_PROPOSAL_STATUS_VOTING,
+				nil,
+			),
+			check: func(ctx sdk.Context) {
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.TREASURY_PROPOSAL_STATUS_VOTING, proposal.Status)
+
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().True(store.Has(types.ActiveTreasuryProposalKey(votingEndTime, types.TreasuryProposalStoreKey(1, 1))))
+			},
+		},
+		{
+			name: "completed proposal is removed from the active queue",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					votingEndTime,
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+			},
+			proposal: types.NewTreasuryProposal(
+				1,
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+				1,
+				sdk.NewDecWithPrec(5, 1),
+				nil,
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				votingEndTime,
+				types.TREASURY_PROPOSAL_STATUS_EXECUTED,
+				nil,
+			),
+			check: func(ctx sdk.Context) {
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.TREASURY_PROPOSAL_STATUS_EXECUTED, proposal.Status)
+
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().False(store.Has(types.ActiveTreasuryProposalKey(votingEndTime, types.TreasuryProposalStoreKey(1, 1))))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.SaveTreasuryProposal(ctx, tc.proposal)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteTreasuryProposal() {
+	votingEndTime := time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		subspaceID uint64
+		proposalID uint64
+		check      func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing proposal is deleted properly",
+			subspaceID: 1,
+			proposalID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasTreasuryProposal(ctx, 1, 1))
+			},
+		},
+		{
+			name: "existing proposal is deleted properly along with its votes",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					votingEndTime,
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES))
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", types.TREASURY_VOTE_OPTION_NO))
+			},
+			subspaceID: 1,
+			proposalID: 1,
+			check: func(ctx sdk.Context) {
+				suite.Require().False(suite.k.HasTreasuryProposal(ctx, 1, 1))
+				suite.Require().Empty(suite.k.GetAllTreasuryVotes(ctx))
+
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().False(store.Has(types.ActiveTreasuryProposalKey(votingEndTime, types.TreasuryProposalStoreKey(1, 1))))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.DeleteTreasuryProposal(ctx, tc.subspaceID, tc.proposalID)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (suite *KeeperTestSuite) TestKeeper_HasTreasuryProposalPassed() {
+	proposal := types.NewTreasuryProposal(
+		1,
+		1,
+		"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+		[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+		1,
+		sdk.NewDecWithPrec(5, 1),
+		nil,
+		time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+		time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+		types.TREASURY_PROPOSAL_STATUS_VOTING,
+		[]string{"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"},
+	)
+
+	quorum := sdk.OneDec()
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		quorum    *sdk.Dec
+		expPassed bool
+	}{
+		{
+			name:      "proposal without votes returns false",
+			expPassed: false,
+		},
+		{
+			name: "not enough yes votes returns false",
+			store: func(ctx sdk.Context) {
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
+
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES))
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", types.TREASURY_VOTE_OPTION_NO))
+			},
+			expPassed: false,
+		},
+		{
+			name: "votes of users that are not voters of the proposal are not counted",
+			store: func(ctx sdk.Context) {
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
+
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", types.TREASURY_VOTE_OPTION_YES))
+			},
+			expPassed: false,
+		},
+		{
+			name: "votes of banned users are not counted",
+			store: func(ctx sdk.Context) {
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES))
+				suite.k.SaveSubspaceBan(ctx, types.NewSubspaceBan(
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					"Spam",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					nil,
+				))
+			},
+			expPassed: false,
+		},
+		{
+			name: "members added after the submission do not change the threshold",
+			store: func(ctx sdk.Context) {
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
+
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES))
+			},
+			expPassed: true,
+		},
+		{
+			name: "reaching the threshold returns true",
+			store: func(ctx sdk.Context) {
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES))
+			},
+			expPassed: true,
+		},
+		{
+			name: "not reaching the quorum returns false",
+			store: func(ctx sdk.Context) {
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES))
+			},
+			quorum:    &quorum,
+			expPassed: false,
+		},
+		{
+			name: "reaching both the quorum and the threshold returns true",
+			store: func(ctx sdk.Context) {
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil)
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil)
+
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES))
+				suite.k.SaveTreasuryVote(ctx, types.NewTreasuryVote(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", types.TREASURY_VOTE_OPTION_NO))
+			},
+			quorum:    &quorum,
+			expPassed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			proposal := proposal
+			proposal.Quorum = tc.quorum
+
+			suite.Require().Equal(tc.expPassed, suite.k.HasTreasuryProposalPassed(ctx, proposal))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ExecuteTreasuryProposal() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		proposal  types.TreasuryProposal
+		expStatus types.TreasuryProposalStatus
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "failing message marks the proposal as failed without applying any change",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			proposal: types.NewTreasuryProposal(
+				1,
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				[]sdk.Msg{
+					types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+					types.NewMsgEditSubspace(2, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+				},
+				1,
+				sdk.NewDecWithPrec(5, 1),
+				nil,
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+				types.TREASURY_PROPOSAL_STATUS_VOTING,
+				nil,
+			),
+			expStatus: types.TREASURY_PROPOSAL_STATUS_FAILED,
+			check: func(ctx sdk.Context) {
+				subspace, found := suite.k.GetSubspace(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal("Test subspace", subspace.Name)
+
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.TREASURY_PROPOSAL_STATUS_FAILED, proposal.Status)
+			},
+		},
+		{
+			name: "messages are executed properly using the treasury as signer",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveSubspace(ctx, types.NewSubspace(
+					1,
+					"Test subspace",
+					"This is a test subspace",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					nil,
+				))
+			},
+			proposal: types.NewTreasuryProposal(
+				1,
+				1,
+				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+				[]sdk.Msg{
+					types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+				},
+				1,
+				sdk.NewDecWithPrec(5, 1),
+				nil,
+				time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+				types.TREASURY_PROPOSAL_STATUS_VOTING,
+				nil,
+			),
+			expStatus: types.TREASURY_PROPOSAL_STATUS_EXECUTED,
+			check: func(ctx sdk.Context) {
+				subspace, found := suite.k.GetSubspace(ctx, 1)
+				suite.Require().True(found)
+				suite.Require().Equal("This is a new name", subspace.Name)
+
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.TREASURY_PROPOSAL_STATUS_EXECUTED, proposal.Status)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			proposal := suite.k.ExecuteTreasuryProposal(ctx, tc.proposal)
+			suite.Require().Equal(tc.expStatus, proposal.Status)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RejectExpiredTreasuryProposals() {
+	votingEndTime := time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		blockTime time.Time
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "proposal still being voted is kept properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					votingEndTime,
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+			},
+			blockTime: votingEndTime.Add(-time.Second),
+			check: func(ctx sdk.Context) {
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.TREASURY_PROPOSAL_STATUS_VOTING, proposal.Status)
+			},
+		},
+		{
+			name: "expired proposal is rejected properly",
+			store: func(ctx sdk.Context) {
+				suite.k.SaveTreasuryProposal(ctx, types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					votingEndTime,
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				))
+			},
+			blockTime: votingEndTime,
+			check: func(ctx sdk.Context) {
+				proposal, found := suite.k.GetTreasuryProposal(ctx, 1, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(types.TREASURY_PROPOSAL_STATUS_REJECTED, proposal.Status)
+
+				store := ctx.KVStore(suite.storeKey)
+				suite.Require().False(store.Has(types.ActiveTreasuryProposalKey(votingEndTime, types.TreasuryProposalStoreKey(1, 1))))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.k.RejectExpiredTreasuryProposals(ctx, tc.blockTime)
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
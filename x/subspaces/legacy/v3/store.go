@@ -121,7 +121,7 @@ func migrateUserPermissions(store sdk.KVStore, cdc codec.BinaryCodec) error {
 		}
 
 		// Store the new permissions
-		userPermission := types.NewUserPermission(subspaceID, types.RootSectionID, user.String(), v3Permissions)
+		userPermission := types.NewUserPermission(subspaceID, types.RootSectionID, user.String(), v3Permissions, nil)
 		store.Set(types.UserPermissionStoreKey(subspaceID, types.RootSectionID, user.String()), cdc.MustMarshal(&userPermission))
 	}
 
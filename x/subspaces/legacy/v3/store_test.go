@@ -121,6 +121,7 @@ func TestMigrateStore(t *testing.T) {
 					types.RootSectionID,
 					"cosmos12e7ejq92sma437d3svemgfvl8sul8lxfs69mjv",
 					types.NewPermissions(types.PermissionEverything),
+					nil,
 				), stored)
 			},
 		},
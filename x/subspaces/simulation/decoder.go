@@ -70,6 +70,92 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 		case bytes.HasPrefix(kvA.Key, types.ExpiringAllowanceQueuePrefix):
 			return fmt.Sprintf("Expiring Allowance statusA: %X\nExpiring Allowance statusB: %X", kvA.Value, kvB.Value)
 
+		case bytes.HasPrefix(kvA.Key, types.SubspaceOwnerTransferRequestPrefix):
+			var requestA, requestB types.SubspaceOwnerTransferRequest
+			cdc.MustUnmarshal(kvA.Value, &requestA)
+			cdc.MustUnmarshal(kvB.Value, &requestB)
+			return fmt.Sprintf("OwnerTransferRequestA: %s\nOwnerTransferRequestB: %s\n", &requestA, &requestB)
+
+		case bytes.HasPrefix(kvA.Key, types.ExpiringUserPermissionQueuePrefix):
+			return fmt.Sprintf("Expiring User Permission statusA: %X\nExpiring User Permission statusB: %X", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.ExpiringGroupMemberQueuePrefix):
+			return fmt.Sprintf("Expiring Group Member statusA: %X\nExpiring Group Member statusB: %X", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.GroupInvitePrefix):
+			var inviteA, inviteB types.GroupInvite
+			cdc.MustUnmarshal(kvA.Value, &inviteA)
+			cdc.MustUnmarshal(kvB.Value, &inviteB)
+			return fmt.Sprintf("GroupInviteA: %s\nGroupInviteB: %s\n", &inviteA, &inviteB)
+
+		case bytes.HasPrefix(kvA.Key, types.GroupApplicationPrefix):
+			var applicationA, applicationB types.GroupApplication
+			cdc.MustUnmarshal(kvA.Value, &applicationA)
+			cdc.MustUnmarshal(kvB.Value, &applicationB)
+			return fmt.Sprintf("GroupApplicationA: %s\nGroupApplicationB: %s\n", &applicationA, &applicationB)
+
+		case bytes.HasPrefix(kvA.Key, types.SubspaceBanPrefix):
+			var banA, banB types.SubspaceBan
+			cdc.MustUnmarshal(kvA.Value, &banA)
+			cdc.MustUnmarshal(kvB.Value, &banB)
+			return fmt.Sprintf("SubspaceBanA: %s\nSubspaceBanB: %s\n", &banA, &banB)
+
+		case bytes.HasPrefix(kvA.Key, types.ExpiringSubspaceBanQueuePrefix):
+			return fmt.Sprintf("Expiring Subspace Ban statusA: %X\nExpiring Subspace Ban statusB: %X", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.RateLimitPrefix):
+			var limitA, limitB types.RateLimit
+			cdc.MustUnmarshal(kvA.Value, &limitA)
+			cdc.MustUnmarshal(kvB.Value, &limitB)
+			return fmt.Sprintf("RateLimitA: %s\nRateLimitB: %s\n", &limitA, &limitB)
+
+		case bytes.HasPrefix(kvA.Key, types.RateLimitCounterPrefix):
+			var counterA, counterB types.RateLimitCounter
+			cdc.MustUnmarshal(kvA.Value, &counterA)
+			cdc.MustUnmarshal(kvB.Value, &counterB)
+			return fmt.Sprintf("RateLimitCounterA: %s\nRateLimitCounterB: %s\n", &counterA, &counterB)
+
+		case bytes.HasPrefix(kvA.Key, types.TreasuryVotingConfigPrefix):
+			var configA, configB types.TreasuryVotingConfig
+			cdc.MustUnmarshal(kvA.Value, &configA)
+			cdc.MustUnmarshal(kvB.Value, &configB)
+			return fmt.Sprintf("TreasuryVotingConfigA: %s\nTreasuryVotingConfigB: %s\n", &configA, &configB)
+
+		case bytes.HasPrefix(kvA.Key, types.TreasuryProposalIDPrefix):
+			idA := types.GetTreasuryProposalIDFromBytes(kvA.Value)
+			idB := types.GetTreasuryProposalIDFromBytes(kvB.Value)
+			return fmt.Sprintf("TreasuryProposalIDA: %d\nTreasuryProposalIDB: %d\n", idA, idB)
+
+		case bytes.HasPrefix(kvA.Key, types.TreasuryProposalPrefix):
+			var proposalA, proposalB types.TreasuryProposal
+			cdc.MustUnmarshal(kvA.Value, &proposalA)
+			cdc.MustUnmarshal(kvB.Value, &proposalB)
+			return fmt.Sprintf("TreasuryProposalA: %s\nTreasuryProposalB: %s\n", &proposalA, &proposalB)
+
+		case bytes.HasPrefix(kvA.Key, types.TreasuryVotePrefix):
+			var voteA, voteB types.TreasuryVote
+			cdc.MustUnmarshal(kvA.Value, &voteA)
+			cdc.MustUnmarshal(kvB.Value, &voteB)
+			return fmt.Sprintf("TreasuryVoteA: %s\nTreasuryVoteB: %s\n", &voteA, &voteB)
+
+		case bytes.HasPrefix(kvA.Key, types.ActiveTreasuryProposalQueuePrefix):
+			return fmt.Sprintf("Active Treasury Proposal statusA: %X\nActive Treasury Proposal statusB: %X", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.AllowanceUsagePrefix):
+			var usageA, usageB types.AllowanceUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("AllowanceUsageA: %s\nAllowanceUsageB: %s\n", &usageA, &usageB)
+
+		case bytes.HasPrefix(kvA.Key, types.FeeTokensConfigPrefix):
+			var configA, configB types.FeeTokensConfig
+			cdc.MustUnmarshal(kvA.Value, &configA)
+			cdc.MustUnmarshal(kvB.Value, &configB)
+			return fmt.Sprintf("FeeTokensConfigA: %s\nFeeTokensConfigB: %s\n", &configA, &configB)
+
+		case bytes.HasPrefix(kvA.Key, types.ExpiringGroupInviteQueuePrefix):
+			return fmt.Sprintf("Expiring Group Invite statusA: %X\nExpiring Group Invite statusB: %X", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/desmos-labs/desmos/v7/x/subspaces/simulation"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/stretchr/testify/require"
@@ -44,6 +45,7 @@ func TestDecodeStore(t *testing.T) {
 		0,
 		"Test section",
 		"This is a test section",
+		types.SECTION_VISIBILITY_PUBLIC,
 	)
 
 	permission := types.NewUserPermission(
@@ -51,6 +53,7 @@ func TestDecodeStore(t *testing.T) {
 		1,
 		"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
 		types.NewPermissions(types.PermissionEverything),
+		nil,
 	)
 
 	userGrant := types.NewGrant(
@@ -69,6 +72,69 @@ func TestDecodeStore(t *testing.T) {
 
 	expiration := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
 
+	ownerTransferRequest := types.NewSubspaceOwnerTransferRequest(
+		1,
+		"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+		"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+	)
+
+	groupInvite := types.NewGroupInvite(
+		1,
+		1,
+		types.GetInviteSecretHash("secret"),
+		10,
+		0,
+		nil,
+		"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+	)
+
+	groupApplication := types.NewGroupApplication(
+		1,
+		1,
+		"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+		"Let me in",
+		time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+	)
+
+	ban := types.NewSubspaceBan(
+		1,
+		"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+		"Spam",
+		"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+		&expiration,
+	)
+	rateLimit := types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour)
+	rateLimitCounter := types.NewRateLimitCounter(time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC), 1, 2)
+	treasuryVotingConfig := types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour)
+	treasuryProposal := types.NewTreasuryProposal(
+		1,
+		1,
+		"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+		[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+		1,
+		sdk.NewDecWithPrec(5, 1),
+		nil,
+		time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+		time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+		types.TREASURY_PROPOSAL_STATUS_VOTING,
+		nil,
+	)
+	treasuryVote := types.NewTreasuryVote(1, 1, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e", types.TREASURY_VOTE_OPTION_YES)
+	allowanceUsage := types.NewAllowanceUsage(
+		1,
+		0,
+		"cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e",
+		time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+		[]types.MessageUsage{types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 1)},
+	)
+	feeTokensConfig := types.NewFeeTokensConfig(
+		1,
+		"stake",
+		[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000))},
+		types.FEE_TOKENS_DESTINATION_TREASURY,
+	)
+
 	kvPairs := kv.Pairs{Pairs: []kv.Pair{
 		{
 			Key:   types.SubspaceIDKey,
@@ -114,6 +180,74 @@ func TestDecodeStore(t *testing.T) {
 			Key:   types.ExpiringAllowanceKey(&expiration, types.UserAllowanceKey(1, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e")),
 			Value: []byte{0x1},
 		},
+		{
+			Key:   types.SubspaceOwnerTransferRequestStoreKey(1),
+			Value: cdc.MustMarshal(&ownerTransferRequest),
+		},
+		{
+			Key:   types.ExpiringUserPermissionKey(&expiration, types.UserPermissionStoreKey(1, 1, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e")),
+			Value: []byte{0x1},
+		},
+		{
+			Key:   types.ExpiringGroupMemberKey(&expiration, types.GroupMemberStoreKey(1, 1, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e")),
+			Value: []byte{0x1},
+		},
+		{
+			Key:   types.GroupInviteStoreKey(1, groupInvite.SecretHash),
+			Value: cdc.MustMarshal(&groupInvite),
+		},
+		{
+			Key:   types.GroupApplicationStoreKey(1, 1, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e"),
+			Value: cdc.MustMarshal(&groupApplication),
+		},
+		{
+			Key:   types.SubspaceBanStoreKey(1, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e"),
+			Value: cdc.MustMarshal(&ban),
+		},
+		{
+			Key:   types.ExpiringSubspaceBanKey(&expiration, types.SubspaceBanStoreKey(1, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e")),
+			Value: []byte{0x1},
+		},
+		{
+			Key:   types.RateLimitStoreKey(1, "/desmos.posts.v3.MsgCreatePost"),
+			Value: cdc.MustMarshal(&rateLimit),
+		},
+		{
+			Key:   types.RateLimitCounterStoreKey(1, "/desmos.posts.v3.MsgCreatePost", "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e"),
+			Value: cdc.MustMarshal(&rateLimitCounter),
+		},
+		{
+			Key:   types.TreasuryVotingConfigStoreKey(1),
+			Value: cdc.MustMarshal(&treasuryVotingConfig),
+		},
+		{
+			Key:   types.NextTreasuryProposalIDStoreKey(1),
+			Value: types.GetTreasuryProposalIDBytes(1),
+		},
+		{
+			Key:   types.TreasuryProposalStoreKey(1, 1),
+			Value: cdc.MustMarshal(&treasuryProposal),
+		},
+		{
+			Key:   types.TreasuryVoteStoreKey(1, 1, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e"),
+			Value: cdc.MustMarshal(&treasuryVote),
+		},
+		{
+			Key:   types.ActiveTreasuryProposalKey(time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC), types.TreasuryProposalStoreKey(1, 1)),
+			Value: []byte{0x1},
+		},
+		{
+			Key:   types.AllowanceUsageStoreKey(1, 0, "cosmos1nv9kkuads7f627q2zf4k9kwdudx709rjck3s7e"),
+			Value: cdc.MustMarshal(&allowanceUsage),
+		},
+		{
+			Key:   types.FeeTokensConfigStoreKey(1),
+			Value: cdc.MustMarshal(&feeTokensConfig),
+		},
+		{
+			Key:   types.ExpiringGroupInviteKey(&expiration, types.GroupInviteStoreKey(1, groupInvite.SecretHash)),
+			Value: []byte{0x1},
+		},
 		{
 			Key:   []byte("Unknown key"),
 			Value: nil,
@@ -141,6 +275,23 @@ func TestDecodeStore(t *testing.T) {
 		{"User grant", fmt.Sprintf("GrantA: %s\nGrantB: %s\n", &userGrant, &userGrant)},
 		{"Group grant", fmt.Sprintf("GrantA: %s\nGrantB: %s\n", &groupGrant, &groupGrant)},
 		{"Expring allowance", fmt.Sprintf("Expiring Allowance statusA: %X\nExpiring Allowance statusB: %X", []byte{0x1}, []byte{0x1})},
+		{"Owner transfer request", fmt.Sprintf("OwnerTransferRequestA: %s\nOwnerTransferRequestB: %s\n", &ownerTransferRequest, &ownerTransferRequest)},
+		{"Expiring user permission", fmt.Sprintf("Expiring User Permission statusA: %X\nExpiring User Permission statusB: %X", []byte{0x1}, []byte{0x1})},
+		{"Expiring group member", fmt.Sprintf("Expiring Group Member statusA: %X\nExpiring Group Member statusB: %X", []byte{0x1}, []byte{0x1})},
+		{"Group invite", fmt.Sprintf("GroupInviteA: %s\nGroupInviteB: %s\n", &groupInvite, &groupInvite)},
+		{"Group application", fmt.Sprintf("GroupApplicationA: %s\nGroupApplicationB: %s\n", &groupApplication, &groupApplication)},
+		{"Subspace ban", fmt.Sprintf("SubspaceBanA: %s\nSubspaceBanB: %s\n", &ban, &ban)},
+		{"Expiring subspace ban", fmt.Sprintf("Expiring Subspace Ban statusA: %X\nExpiring Subspace Ban statusB: %X", []byte{0x1}, []byte{0x1})},
+		{"Rate limit", fmt.Sprintf("RateLimitA: %s\nRateLimitB: %s\n", &rateLimit, &rateLimit)},
+		{"Rate limit counter", fmt.Sprintf("RateLimitCounterA: %s\nRateLimitCounterB: %s\n", &rateLimitCounter, &rateLimitCounter)},
+		{"Treasury voting config", fmt.Sprintf("TreasuryVotingConfigA: %s\nTreasuryVotingConfigB: %s\n", &treasuryVotingConfig, &treasuryVotingConfig)},
+		{"Treasury proposal ID", fmt.Sprintf("TreasuryProposalIDA: %d\nTreasuryProposalIDB: %d\n", 1, 1)},
+		{"Treasury proposal", fmt.Sprintf("TreasuryProposalA: %s\nTreasuryProposalB: %s\n", &treasuryProposal, &treasuryProposal)},
+		{"Treasury vote", fmt.Sprintf("TreasuryVoteA: %s\nTreasuryVoteB: %s\n", &treasuryVote, &treasuryVote)},
+		{"Active treasury proposal", fmt.Sprintf("Active Treasury Proposal statusA: %X\nActive Treasury Proposal statusB: %X", []byte{0x1}, []byte{0x1})},
+		{"Allowance usage", fmt.Sprintf("AllowanceUsageA: %s\nAllowanceUsageB: %s\n", &allowanceUsage, &allowanceUsage)},
+		{"Fee tokens config", fmt.Sprintf("FeeTokensConfigA: %s\nFeeTokensConfigB: %s\n", &feeTokensConfig, &feeTokensConfig)},
+		{"Expiring group invite", fmt.Sprintf("Expiring Group Invite statusA: %X\nExpiring Group Invite statusB: %X", []byte{0x1}, []byte{0x1})},
 		{"other", ""},
 	}
 
@@ -24,9 +24,10 @@ func RandomizeGenState(simState *module.SimulationState) {
 	acl := randomACL(simState.Rand, simState.Accounts, subspaces)
 	initialSubspaceID, subspacesData := getSubspacesDataEntries(subspaces, sections, groups)
 	grants := append(randomUserGrants(simState.Rand, simState.Accounts, subspaces), randomGroupGrants(simState.Rand, groups)...)
+	ownerTransferRequests := randomOwnerTransferRequests(simState.Rand, simState.Accounts, subspaces)
 
 	// Create the genesis and sanitize it
-	subspacesGenesis := types.NewGenesisState(initialSubspaceID, subspacesData, subspaces, sections, acl, groups, members, grants)
+	subspacesGenesis := types.NewGenesisState(initialSubspaceID, subspacesData, subspaces, sections, acl, groups, members, grants, ownerTransferRequests, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	subspacesGenesis = sanitizeGenesis(subspacesGenesis)
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(subspacesGenesis)
@@ -70,6 +71,7 @@ func randomSections(r *rand.Rand, subspaces []types.Subspace) (sections []types.
 			0,
 			RandomSectionName(r),
 			RandomSectionDescription(r),
+			RandomSectionVisibility(r),
 		)
 	}
 	return sections
@@ -108,7 +110,7 @@ func randomUserGroupsMembers(r *rand.Rand, accounts []simtypes.Account, groups [
 	for _, group := range groups {
 		for i := 0; i < r.Intn(10); i++ {
 			account, _ := simtypes.RandomAcc(r, accounts)
-			membersEntries = append(membersEntries, types.NewUserGroupMemberEntry(group.SubspaceID, group.ID, account.Address.String()))
+			membersEntries = append(membersEntries, types.NewUserGroupMemberEntry(group.SubspaceID, group.ID, account.Address.String(), nil))
 		}
 	}
 	return membersEntries
@@ -169,7 +171,7 @@ func randomACL(r *rand.Rand, accounts []simtypes.Account, subspaces []types.Subs
 		permission := RandomPermission(r, validPermissions)
 
 		// Crete the entry
-		entries[index] = types.NewUserPermission(subspace.ID, 0, account.Address.String(), permission)
+		entries[index] = types.NewUserPermission(subspace.ID, 0, account.Address.String(), permission, nil)
 	}
 
 	return entries
@@ -236,6 +238,25 @@ func containsGrant(slice []types.Grant, grant types.Grant) bool {
 	return false
 }
 
+// randomOwnerTransferRequests returns randomly generated subspace owner transfer requests
+func randomOwnerTransferRequests(r *rand.Rand, accounts []simtypes.Account, subspaces []types.Subspace) []types.SubspaceOwnerTransferRequest {
+	var requests []types.SubspaceOwnerTransferRequest
+	for _, subspace := range subspaces {
+		// 20% of chance of having a pending request for each subspace
+		if r.Intn(101) > 20 {
+			continue
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accounts)
+		if receiver.Address.String() == subspace.Owner {
+			continue
+		}
+
+		requests = append(requests, types.NewSubspaceOwnerTransferRequest(subspace.ID, receiver.Address.String(), subspace.Owner))
+	}
+	return requests
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // sanitizeGenesis sanitizes the given genesis by removing all the double subspaces,
@@ -250,6 +271,16 @@ func sanitizeGenesis(genesis *types.GenesisState) *types.GenesisState {
 		sanitizeUserGroups(genesis.UserGroups),
 		genesis.UserGroupsMembers,
 		genesis.Grants,
+		genesis.OwnerTransferRequests,
+		genesis.GroupInvites,
+		genesis.GroupApplications,
+		genesis.Bans,
+		genesis.RateLimits,
+		genesis.TreasuryVotingConfigs,
+		genesis.TreasuryProposals,
+		genesis.TreasuryVotes,
+		genesis.FeeTokensConfigs,
+		genesis.AllowanceUsages,
 	)
 }
 
@@ -26,6 +26,7 @@ const (
 	OpWeightMsgCreateSection               = "op_weight_msg_create_section"
 	OpWeightMsgEditSection                 = "op_weight_msg_edit_section"
 	OpWeightMsgMoveSection                 = "op_weight_msg_move_section"
+	OpWeightMsgSetSectionVisibility        = "op_weight_msg_set_section_visibility"
 	OpWeightMsgDeleteSection               = "op_weight_msg_delete_section"
 	OpWeightMsgCreateUserGroup             = "op_weight_msg_create_user_group"
 	OpWeightMsgEditUserGroup               = "op_weight_msg_edit_user_group"
@@ -40,6 +41,33 @@ const (
 	OpWeightMsgGrantAllowance              = "op_weight_msg_grant_allowance"
 	OpWeightMsgRevokeAllowance             = "op_weight_msg_revoke_allowance"
 
+	OpWeightMsgArchiveSubspace   = "op_weight_msg_archive_subspace"
+	OpWeightMsgUnarchiveSubspace = "op_weight_msg_unarchive_subspace"
+
+	OpWeightMsgRequestSubspaceOwnerTransfer = "op_weight_msg_request_subspace_owner_transfer"
+	OpWeightMsgCancelSubspaceOwnerTransfer  = "op_weight_msg_cancel_subspace_owner_transfer"
+	OpWeightMsgAcceptSubspaceOwnerTransfer  = "op_weight_msg_accept_subspace_owner_transfer"
+	OpWeightMsgRefuseSubspaceOwnerTransfer  = "op_weight_msg_refuse_subspace_owner_transfer"
+
+	OpWeightMsgCreateInvite            = "op_weight_msg_create_invite"
+	OpWeightMsgDeleteInvite            = "op_weight_msg_delete_invite"
+	OpWeightMsgApplyToGroup            = "op_weight_msg_apply_to_group"
+	OpWeightMsgApproveGroupApplication = "op_weight_msg_approve_group_application"
+	OpWeightMsgRejectGroupApplication  = "op_weight_msg_reject_group_application"
+
+	OpWeightMsgBanUser   = "op_weight_msg_ban_user"
+	OpWeightMsgUnbanUser = "op_weight_msg_unban_user"
+
+	OpWeightMsgSetRateLimit    = "op_weight_msg_set_rate_limit"
+	OpWeightMsgRemoveRateLimit = "op_weight_msg_remove_rate_limit"
+
+	OpWeightMsgSetFeeTokensConfig    = "op_weight_msg_set_fee_tokens_config"
+	OpWeightMsgRemoveFeeTokensConfig = "op_weight_msg_remove_fee_tokens_config"
+
+	OpWeightMsgSetTreasuryVotingConfig = "op_weight_msg_set_treasury_voting_config"
+	OpWeightMsgSubmitTreasuryProposal  = "op_weight_msg_submit_treasury_proposal"
+	OpWeightMsgVoteTreasuryProposal    = "op_weight_msg_vote_treasury_proposal"
+
 	DefaultGasValue = 200_000
 )
 
@@ -70,6 +98,48 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgArchiveSubspace int
+	appParams.GetOrGenerate(cdc, OpWeightMsgArchiveSubspace, &weightMsgArchiveSubspace, nil,
+		func(_ *rand.Rand) {
+			weightMsgArchiveSubspace = params.DefaultWeightMsgArchiveSubspace
+		},
+	)
+
+	var weightMsgUnarchiveSubspace int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUnarchiveSubspace, &weightMsgUnarchiveSubspace, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnarchiveSubspace = params.DefaultWeightMsgUnarchiveSubspace
+		},
+	)
+
+	var weightMsgRequestSubspaceOwnerTransfer int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRequestSubspaceOwnerTransfer, &weightMsgRequestSubspaceOwnerTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestSubspaceOwnerTransfer = params.DefaultWeightMsgRequestSubspaceOwnerTransfer
+		},
+	)
+
+	var weightMsgCancelSubspaceOwnerTransfer int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelSubspaceOwnerTransfer, &weightMsgCancelSubspaceOwnerTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelSubspaceOwnerTransfer = params.DefaultWeightMsgCancelSubspaceOwnerTransfer
+		},
+	)
+
+	var weightMsgAcceptSubspaceOwnerTransfer int
+	appParams.GetOrGenerate(cdc, OpWeightMsgAcceptSubspaceOwnerTransfer, &weightMsgAcceptSubspaceOwnerTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptSubspaceOwnerTransfer = params.DefaultWeightMsgAcceptSubspaceOwnerTransfer
+		},
+	)
+
+	var weightMsgRefuseSubspaceOwnerTransfer int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRefuseSubspaceOwnerTransfer, &weightMsgRefuseSubspaceOwnerTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgRefuseSubspaceOwnerTransfer = params.DefaultWeightMsgRefuseSubspaceOwnerTransfer
+		},
+	)
+
 	var weightMsgCreateSection int
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateSection, &weightMsgCreateSection, nil,
 		func(_ *rand.Rand) {
@@ -91,6 +161,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgSetSectionVisibility int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetSectionVisibility, &weightMsgSetSectionVisibility, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetSectionVisibility = params.DefaultWeightMsgSetSectionVisibility
+		},
+	)
+
 	var weightMsgDeleteSection int
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeleteSection, &weightMsgDeleteSection, nil,
 		func(_ *rand.Rand) {
@@ -146,6 +223,41 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgCreateInvite int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateInvite, &weightMsgCreateInvite, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateInvite = params.DefaultWeightMsgCreateInvite
+		},
+	)
+
+	var weightMsgDeleteInvite int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDeleteInvite, &weightMsgDeleteInvite, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeleteInvite = params.DefaultWeightMsgDeleteInvite
+		},
+	)
+
+	var weightMsgApplyToGroup int
+	appParams.GetOrGenerate(cdc, OpWeightMsgApplyToGroup, &weightMsgApplyToGroup, nil,
+		func(_ *rand.Rand) {
+			weightMsgApplyToGroup = params.DefaultWeightMsgApplyToGroup
+		},
+	)
+
+	var weightMsgApproveGroupApplication int
+	appParams.GetOrGenerate(cdc, OpWeightMsgApproveGroupApplication, &weightMsgApproveGroupApplication, nil,
+		func(_ *rand.Rand) {
+			weightMsgApproveGroupApplication = params.DefaultWeightMsgApproveGroupApplication
+		},
+	)
+
+	var weightMsgRejectGroupApplication int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRejectGroupApplication, &weightMsgRejectGroupApplication, nil,
+		func(_ *rand.Rand) {
+			weightMsgRejectGroupApplication = params.DefaultWeightMsgRejectGroupApplication
+		},
+	)
+
 	var weightMsgSetUserPermissions int
 	appParams.GetOrGenerate(cdc, OpWeightMsgSetUserPermissions, &weightMsgSetUserPermissions, nil,
 		func(_ *rand.Rand) {
@@ -153,6 +265,69 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgBanUser int
+	appParams.GetOrGenerate(cdc, OpWeightMsgBanUser, &weightMsgBanUser, nil,
+		func(_ *rand.Rand) {
+			weightMsgBanUser = params.DefaultWeightMsgBanUser
+		},
+	)
+
+	var weightMsgUnbanUser int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUnbanUser, &weightMsgUnbanUser, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnbanUser = params.DefaultWeightMsgUnbanUser
+		},
+	)
+
+	var weightMsgSetRateLimit int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetRateLimit, &weightMsgSetRateLimit, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetRateLimit = params.DefaultWeightMsgSetRateLimit
+		},
+	)
+
+	var weightMsgRemoveRateLimit int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemoveRateLimit, &weightMsgRemoveRateLimit, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveRateLimit = params.DefaultWeightMsgRemoveRateLimit
+		},
+	)
+
+	var weightMsgSetFeeTokensConfig int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetFeeTokensConfig, &weightMsgSetFeeTokensConfig, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetFeeTokensConfig = params.DefaultWeightMsgSetFeeTokensConfig
+		},
+	)
+
+	var weightMsgRemoveFeeTokensConfig int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemoveFeeTokensConfig, &weightMsgRemoveFeeTokensConfig, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveFeeTokensConfig = params.DefaultWeightMsgRemoveFeeTokensConfig
+		},
+	)
+
+	var weightMsgSetTreasuryVotingConfig int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetTreasuryVotingConfig, &weightMsgSetTreasuryVotingConfig, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetTreasuryVotingConfig = params.DefaultWeightMsgSetTreasuryVotingConfig
+		},
+	)
+
+	var weightMsgSubmitTreasuryProposal int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitTreasuryProposal, &weightMsgSubmitTreasuryProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitTreasuryProposal = params.DefaultWeightMsgSubmitTreasuryProposal
+		},
+	)
+
+	var weightMsgVoteTreasuryProposal int
+	appParams.GetOrGenerate(cdc, OpWeightMsgVoteTreasuryProposal, &weightMsgVoteTreasuryProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteTreasuryProposal = params.DefaultWeightMsgVoteTreasuryProposal
+		},
+	)
+
 	var weightMsgGrantTreasuryAuthorization int
 	appParams.GetOrGenerate(cdc, OpWeightMsgGrantTreasuryAuthorization, &weightMsgGrantTreasuryAuthorization, nil,
 		func(_ *rand.Rand) {
@@ -194,6 +369,30 @@ func WeightedOperations(
 			weightMsgDeleteSubspace,
 			SimulateMsgDeleteSubspace(k, ak, bk),
 		),
+		sim.NewWeightedOperation(
+			weightMsgArchiveSubspace,
+			SimulateMsgArchiveSubspace(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgUnarchiveSubspace,
+			SimulateMsgUnarchiveSubspace(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgRequestSubspaceOwnerTransfer,
+			SimulateMsgRequestSubspaceOwnerTransfer(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgCancelSubspaceOwnerTransfer,
+			SimulateMsgCancelSubspaceOwnerTransferRequest(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgAcceptSubspaceOwnerTransfer,
+			SimulateMsgAcceptSubspaceOwnerTransferRequest(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgRefuseSubspaceOwnerTransfer,
+			SimulateMsgRefuseSubspaceOwnerTransferRequest(k, ak, bk),
+		),
 		sim.NewWeightedOperation(
 			weightMsgCreateSection,
 			SimulateMsgCreateSection(k, ak, bk),
@@ -206,6 +405,10 @@ func WeightedOperations(
 			weightMsgMoveSection,
 			SimulateMsgMoveSection(k, ak, bk),
 		),
+		sim.NewWeightedOperation(
+			weightMsgSetSectionVisibility,
+			SimulateMsgSetSectionVisibility(k, ak, bk),
+		),
 		sim.NewWeightedOperation(
 			weightMsgDeleteSection,
 			SimulateMsgDeleteSection(k, ak, bk),
@@ -238,10 +441,66 @@ func WeightedOperations(
 			weightMsgRemoveUserFromUserGroup,
 			SimulateMsgRemoveUserFromUserGroup(k, ak, bk),
 		),
+		sim.NewWeightedOperation(
+			weightMsgCreateInvite,
+			SimulateMsgCreateInvite(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgDeleteInvite,
+			SimulateMsgDeleteInvite(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgApplyToGroup,
+			SimulateMsgApplyToGroup(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgApproveGroupApplication,
+			SimulateMsgApproveGroupApplication(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgRejectGroupApplication,
+			SimulateMsgRejectGroupApplication(k, ak, bk),
+		),
 		sim.NewWeightedOperation(
 			weightMsgSetUserPermissions,
 			SimulateMsgSetUserPermissions(k, ak, bk),
 		),
+		sim.NewWeightedOperation(
+			weightMsgBanUser,
+			SimulateMsgBanUser(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgUnbanUser,
+			SimulateMsgUnbanUser(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgSetRateLimit,
+			SimulateMsgSetRateLimit(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgRemoveRateLimit,
+			SimulateMsgRemoveRateLimit(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgSetFeeTokensConfig,
+			SimulateMsgSetFeeTokensConfig(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgRemoveFeeTokensConfig,
+			SimulateMsgRemoveFeeTokensConfig(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgSetTreasuryVotingConfig,
+			SimulateMsgSetTreasuryVotingConfig(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgSubmitTreasuryProposal,
+			SimulateMsgSubmitTreasuryProposal(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgVoteTreasuryProposal,
+			SimulateMsgVoteTreasuryProposal(k, ak, bk),
+		),
 		sim.NewWeightedOperation(
 			weightMsgGrantTreasuryAuthorization,
 			SimulateMsgGrantTreasuryAuthorization(k, ak, bk),
@@ -0,0 +1,96 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/desmos-labs/desmos/v7/testutil/simtesting"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/keeper"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SimulateMsgArchiveSubspace tests and runs a single msg archive subspace
+func SimulateMsgArchiveSubspace(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, signer, skip := randomArchiveSubspaceFields(r, ctx, accs, k, false)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgArchiveSubspace", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgArchiveSubspace(subspaceID, signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// SimulateMsgUnarchiveSubspace tests and runs a single msg unarchive subspace
+func SimulateMsgUnarchiveSubspace(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, signer, skip := randomArchiveSubspaceFields(r, ctx, accs, k, true)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgUnarchiveSubspace", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgUnarchiveSubspace(subspaceID, signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomArchiveSubspaceFields returns a random subspace having the given archived status
+// along with its owner account
+func randomArchiveSubspaceFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper, archived bool,
+) (subspaceID uint64, account simtypes.Account, skip bool) {
+	// Get a subspace
+	var subspaces []types.Subspace
+	k.IterateSubspaces(ctx, func(subspace types.Subspace) (stop bool) {
+		if subspace.Archived == archived {
+			subspaces = append(subspaces, subspace)
+		}
+		return false
+	})
+	if len(subspaces) == 0 {
+		// Skip because there are no valid subspaces
+		skip = true
+		return
+	}
+	subspace := RandomSubspace(r, subspaces)
+	subspaceID = subspace.ID
+
+	// Get the owner
+	acc := GetAccount(subspace.Owner, accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return subspaceID, account, false
+}
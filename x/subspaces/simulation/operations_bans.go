@@ -0,0 +1,133 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/desmos-labs/desmos/v7/testutil/simtesting"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/keeper"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SimulateMsgBanUser tests and runs a single msg ban user
+func SimulateMsgBanUser(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, user, signer, skip := randomBanUserFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgBanUser", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgBanUser(subspaceID, user, simtypes.RandStringOfLength(r, 20), nil, signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomBanUserFields returns the data used to build a random MsgBanUser
+func randomBanUserFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspaceID uint64, user string, account simtypes.Account, skip bool) {
+	// Get a subspace
+	subspaces := k.GetAllSubspaces(ctx)
+	if len(subspaces) == 0 {
+		// Skip because there are no subspaces
+		skip = true
+		return
+	}
+	subspace := RandomSubspace(r, subspaces)
+	subspaceID = subspace.ID
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, subspaceID, types.NewPermissions(types.PermissionBanUsers))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	// Get a user to be banned
+	userAcc, _ := simtypes.RandomAcc(r, accs)
+	user = userAcc.Address.String()
+	if user == subspace.Owner || user == account.Address.String() {
+		// Skip because the owner and the signer cannot be banned
+		skip = true
+		return
+	}
+
+	if k.IsUserBanned(ctx, subspaceID, user) {
+		// Skip because the user is already banned
+		skip = true
+		return
+	}
+
+	return subspaceID, user, account, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgUnbanUser tests and runs a single msg unban user
+func SimulateMsgUnbanUser(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, user, signer, skip := randomUnbanUserFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgUnbanUser", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgUnbanUser(subspaceID, user, signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomUnbanUserFields returns the data used to build a random MsgUnbanUser
+func randomUnbanUserFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspaceID uint64, user string, account simtypes.Account, skip bool) {
+	// Get a ban
+	bans := k.GetAllSubspaceBans(ctx)
+	if len(bans) == 0 {
+		// Skip because there are no bans
+		skip = true
+		return
+	}
+	ban := RandomSubspaceBan(r, bans)
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, ban.SubspaceID, types.NewPermissions(types.PermissionBanUsers))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return ban.SubspaceID, ban.User, account, false
+}
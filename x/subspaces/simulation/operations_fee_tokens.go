@@ -0,0 +1,123 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/desmos-labs/desmos/v7/testutil/simtesting"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/keeper"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SimulateMsgSetFeeTokensConfig tests and runs a single msg set fee tokens config
+func SimulateMsgSetFeeTokensConfig(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspace, signer, skip := randomSetFeeTokensConfigFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgSetFeeTokensConfig", "skip"), nil, nil
+		}
+
+		// Build the message
+		config := GenerateRandomFeeTokensConfig(r, subspace.ID, subspace.AdditionalFeeTokens)
+		msg := types.NewMsgSetFeeTokensConfig(subspace.ID, config.BaseDenom, config.Rates, config.Destination, signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomSetFeeTokensConfigFields returns the data used to build a random MsgSetFeeTokensConfig
+func randomSetFeeTokensConfigFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspace types.Subspace, account simtypes.Account, skip bool) {
+	// Get a subspace having some additional fee tokens
+	var subspaces []types.Subspace
+	for _, subspace := range k.GetAllSubspaces(ctx) {
+		if len(subspace.AdditionalFeeTokens) > 0 {
+			subspaces = append(subspaces, subspace)
+		}
+	}
+	if len(subspaces) == 0 {
+		// Skip because there are no subspaces with additional fee tokens
+		skip = true
+		return
+	}
+	subspace = RandomSubspace(r, subspaces)
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, subspace.ID, types.NewPermissions(types.PermissionManageFeeTokens))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return subspace, account, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgRemoveFeeTokensConfig tests and runs a single msg remove fee tokens config
+func SimulateMsgRemoveFeeTokensConfig(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, signer, skip := randomRemoveFeeTokensConfigFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgRemoveFeeTokensConfig", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgRemoveFeeTokensConfig(subspaceID, signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomRemoveFeeTokensConfigFields returns the data used to build a random MsgRemoveFeeTokensConfig
+func randomRemoveFeeTokensConfigFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspaceID uint64, account simtypes.Account, skip bool) {
+	// Get a fee tokens config
+	configs := k.GetAllFeeTokensConfigs(ctx)
+	if len(configs) == 0 {
+		// Skip because there are no fee tokens configs
+		skip = true
+		return
+	}
+	config := RandomFeeTokensConfig(r, configs)
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, config.SubspaceID, types.NewPermissions(types.PermissionManageFeeTokens))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return config.SubspaceID, account, false
+}
@@ -0,0 +1,302 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/desmos-labs/desmos/v7/testutil/simtesting"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/keeper"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SimulateMsgCreateInvite tests and runs a single msg create invite
+func SimulateMsgCreateInvite(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		invite, signer, skip := randomCreateInviteFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgCreateInvite", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgCreateInvite(
+			invite.SubspaceID,
+			invite.GroupID,
+			invite.SecretHash,
+			invite.MaxUses,
+			invite.ExpirationTime,
+			invite.Creator,
+		)
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomCreateInviteFields returns the data used to build a random MsgCreateInvite
+func randomCreateInviteFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (invite types.GroupInvite, account simtypes.Account, skip bool) {
+	// Get a group
+	groups := k.GetAllUserGroups(ctx)
+	if len(groups) == 0 {
+		// Skip if there are no groups
+		skip = true
+		return
+	}
+	group := RandomGroup(r, groups)
+	if k.IsSubspaceArchived(ctx, group.SubspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
+	if group.ID == 0 {
+		// Skip because we cannot invite users to the group with ID 0 since it's the default one
+		skip = true
+		return
+	}
+
+	// Get a secret
+	secretHash := types.GetInviteSecretHash(simtypes.RandStringOfLength(r, 20))
+	if k.HasGroupInvite(ctx, group.SubspaceID, secretHash) {
+		// Skip because an invite with the same secret already exists
+		skip = true
+		return
+	}
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, group.SubspaceID, types.NewPermissions(types.PermissionSetPermissions))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	maxUses := uint32(r.Intn(10)) + 1
+	invite = types.NewGroupInvite(group.SubspaceID, group.ID, secretHash, maxUses, 0, nil, account.Address.String())
+	return invite, account, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgDeleteInvite tests and runs a single msg delete invite
+func SimulateMsgDeleteInvite(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, secretHash, signer, skip := randomDeleteInviteFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgDeleteInvite", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgDeleteInvite(subspaceID, secretHash, signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomDeleteInviteFields returns the data used to build a random MsgDeleteInvite
+func randomDeleteInviteFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspaceID uint64, secretHash string, account simtypes.Account, skip bool) {
+	// Get an invite
+	invites := k.GetAllGroupInvites(ctx)
+	if len(invites) == 0 {
+		// Skip if there are no invites
+		skip = true
+		return
+	}
+	invite := RandomGroupInvite(r, invites)
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, invite.SubspaceID, types.NewPermissions(types.PermissionSetPermissions))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return invite.SubspaceID, invite.SecretHash, account, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgApplyToGroup tests and runs a single msg apply to group
+func SimulateMsgApplyToGroup(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, groupID, signer, skip := randomApplyToGroupFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgApplyToGroup", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgApplyToGroup(subspaceID, groupID, simtypes.RandStringOfLength(r, 30), signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomApplyToGroupFields returns the data used to build a random MsgApplyToGroup
+func randomApplyToGroupFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspaceID uint64, groupID uint32, account simtypes.Account, skip bool) {
+	// Get a group
+	groups := k.GetAllUserGroups(ctx)
+	if len(groups) == 0 {
+		// Skip if there are no groups
+		skip = true
+		return
+	}
+	group := RandomGroup(r, groups)
+	if k.IsSubspaceArchived(ctx, group.SubspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
+	if group.ID == 0 {
+		// Skip because users cannot apply to the group with ID 0 since it's the default one
+		skip = true
+		return
+	}
+
+	// Get an applicant
+	account, _ = simtypes.RandomAcc(r, accs)
+	applicant := account.Address.String()
+	if k.IsMemberOfGroup(ctx, group.SubspaceID, group.ID, applicant) {
+		// Skip if the user is already part of group
+		skip = true
+		return
+	}
+
+	if k.HasGroupApplication(ctx, group.SubspaceID, group.ID, applicant) {
+		// Skip if the user has already applied
+		skip = true
+		return
+	}
+
+	return group.SubspaceID, group.ID, account, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgApproveGroupApplication tests and runs a single msg approve group application
+func SimulateMsgApproveGroupApplication(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		application, signer, skip := randomGroupApplicationReviewFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgApproveGroupApplication", "skip"), nil, nil
+		}
+
+		// Skip archived subspaces since their groups cannot be changed
+		if k.IsSubspaceArchived(ctx, application.SubspaceID) {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgApproveGroupApplication", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgApproveGroupApplication(
+			application.SubspaceID,
+			application.GroupID,
+			application.Applicant,
+			signer.Address.String(),
+		)
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgRejectGroupApplication tests and runs a single msg reject group application
+func SimulateMsgRejectGroupApplication(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		application, signer, skip := randomGroupApplicationReviewFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgRejectGroupApplication", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgRejectGroupApplication(
+			application.SubspaceID,
+			application.GroupID,
+			application.Applicant,
+			signer.Address.String(),
+		)
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomGroupApplicationReviewFields returns a random pending group application along with
+// an account that has the permission to review it
+func randomGroupApplicationReviewFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (application types.GroupApplication, account simtypes.Account, skip bool) {
+	// Get an application
+	applications := k.GetAllGroupApplications(ctx)
+	if len(applications) == 0 {
+		// Skip if there are no applications
+		skip = true
+		return
+	}
+	application = RandomGroupApplication(r, applications)
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, application.SubspaceID, types.NewPermissions(types.PermissionSetPermissions))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return application, account, false
+}
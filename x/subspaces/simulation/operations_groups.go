@@ -63,6 +63,11 @@ func randomCreateUserGroupFields(
 	}
 	subspace := RandomSubspace(r, subspaces)
 	subspaceID = subspace.ID
+	if k.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a group name
 	groupName := RandomName(r)
@@ -125,6 +130,11 @@ func randomEditUserGroupFields(
 	}
 	group := RandomGroup(r, groups)
 	subspaceID = group.SubspaceID
+	if k.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 	groupID = group.ID
 
 	// Build the update
@@ -189,6 +199,11 @@ func randomMoveUserGroupFields(
 	}
 	subspace := RandomSubspace(r, subspaces)
 	subspaceID = subspace.ID
+	if k.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a group
 	groups := k.GetSubspaceUserGroups(ctx, subspaceID)
@@ -261,6 +276,11 @@ func randomSetUserGroupPermissionsFields(
 	}
 	subspace := RandomSubspace(r, subspaces)
 	subspaceID = subspace.ID
+	if k.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 
 	// Get a group
 	groups := k.GetSubspaceUserGroups(ctx, subspaceID)
@@ -338,6 +358,11 @@ func randomDeleteUserGroupFields(
 	}
 
 	subspaceID = group.SubspaceID
+	if k.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 	groupID = group.ID
 
 	// Get a signer
@@ -371,7 +396,7 @@ func SimulateMsgAddUserToUserGroup(
 		}
 
 		// Build the message
-		msg := types.NewMsgAddUserToUserGroup(subspaceID, groupID, user, signer.Address.String())
+		msg := types.NewMsgAddUserToUserGroup(subspaceID, groupID, user, nil, signer.Address.String())
 
 		// Send the message
 		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
@@ -397,6 +422,11 @@ func randomAddUserToUserGroupFields(
 	}
 
 	subspaceID = group.SubspaceID
+	if k.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 	groupID = group.ID
 
 	// Get a user
@@ -466,6 +496,11 @@ func randomRemoveUserFromUserGroupFields(
 	}
 
 	subspaceID = group.SubspaceID
+	if k.IsSubspaceArchived(ctx, subspaceID) {
+		// Skip because the subspace is archived
+		skip = true
+		return
+	}
 	groupID = group.ID
 
 	// Get a user
@@ -0,0 +1,186 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/desmos-labs/desmos/v7/testutil/simtesting"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/keeper"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SimulateMsgRequestSubspaceOwnerTransfer tests and runs a single msg request subspace owner transfer
+func SimulateMsgRequestSubspaceOwnerTransfer(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		request, signer, skip := randomRequestSubspaceOwnerTransferFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgRequestSubspaceOwnerTransfer", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgRequestSubspaceOwnerTransfer(request.SubspaceID, request.Receiver, request.Sender)
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomRequestSubspaceOwnerTransferFields returns the data needed to request a subspace owner transfer
+func randomRequestSubspaceOwnerTransferFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (request types.SubspaceOwnerTransferRequest, signer simtypes.Account, skip bool) {
+	// Get a subspace
+	subspaces := k.GetAllSubspaces(ctx)
+	if len(subspaces) == 0 {
+		// Skip because there are no subspaces
+		skip = true
+		return
+	}
+	subspace := RandomSubspace(r, subspaces)
+
+	if k.HasSubspaceOwnerTransferRequest(ctx, subspace.ID) {
+		// Skip because there is already a pending request
+		skip = true
+		return
+	}
+
+	// Get the sender
+	acc := GetAccount(subspace.Owner, accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	signer = *acc
+
+	// Get a receiver
+	receiver, _ := simtypes.RandomAcc(r, accs)
+	if receiver.Address.String() == subspace.Owner {
+		// Skip because the receiver is already the owner
+		skip = true
+		return
+	}
+
+	request = types.NewSubspaceOwnerTransferRequest(subspace.ID, receiver.Address.String(), subspace.Owner)
+	return request, signer, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgCancelSubspaceOwnerTransferRequest tests and runs a single msg cancel subspace owner transfer request
+func SimulateMsgCancelSubspaceOwnerTransferRequest(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		request, signer, skip := randomSubspaceOwnerTransferRequestFields(r, ctx, accs, k, false)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgCancelSubspaceOwnerTransferRequest", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgCancelSubspaceOwnerTransferRequest(request.SubspaceID, request.Sender)
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgAcceptSubspaceOwnerTransferRequest tests and runs a single msg accept subspace owner transfer request
+func SimulateMsgAcceptSubspaceOwnerTransferRequest(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		request, signer, skip := randomSubspaceOwnerTransferRequestFields(r, ctx, accs, k, true)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgAcceptSubspaceOwnerTransferRequest", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgAcceptSubspaceOwnerTransferRequest(request.SubspaceID, request.Receiver)
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgRefuseSubspaceOwnerTransferRequest tests and runs a single msg refuse subspace owner transfer request
+func SimulateMsgRefuseSubspaceOwnerTransferRequest(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		request, signer, skip := randomSubspaceOwnerTransferRequestFields(r, ctx, accs, k, true)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgRefuseSubspaceOwnerTransferRequest", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgRefuseSubspaceOwnerTransferRequest(request.SubspaceID, request.Receiver)
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomSubspaceOwnerTransferRequestFields returns a random pending owner transfer request along with
+// the account that should sign the message: the receiver when signedByReceiver is true, the sender otherwise
+func randomSubspaceOwnerTransferRequestFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper, signedByReceiver bool,
+) (request types.SubspaceOwnerTransferRequest, signer simtypes.Account, skip bool) {
+	// Get a request
+	requests := k.GetAllSubspaceOwnerTransferRequests(ctx)
+	if len(requests) == 0 {
+		// Skip because there are no requests
+		skip = true
+		return
+	}
+	request = RandomSubspaceOwnerTransferRequest(r, requests)
+
+	// Get the signer
+	address := request.Sender
+	if signedByReceiver {
+		address = request.Receiver
+	}
+
+	acc := GetAccount(address, accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	signer = *acc
+
+	return request, signer, false
+}
@@ -33,7 +33,7 @@ func SimulateMsgSetUserPermissions(
 		}
 
 		// Build the message
-		msg := types.NewMsgSetUserPermissions(subspaceID, 0, user, permissions, creator.Address.String())
+		msg := types.NewMsgSetUserPermissions(subspaceID, 0, user, permissions, nil, creator.Address.String())
 
 		// Send the message
 		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, creator)
@@ -0,0 +1,130 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/desmos-labs/desmos/v7/testutil/simtesting"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/keeper"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// rateLimitedMsgTypeURLs contains the message types that can be rate limited during simulations
+var rateLimitedMsgTypeURLs = []string{
+	"/desmos.posts.v3.MsgCreatePost",
+	"/desmos.posts.v3.MsgEditPost",
+	"/desmos.reactions.v1.MsgAddReaction",
+	"/desmos.reports.v1.MsgCreateReport",
+}
+
+// SimulateMsgSetRateLimit tests and runs a single msg set rate limit
+func SimulateMsgSetRateLimit(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, signer, skip := randomSetRateLimitFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgSetRateLimit", "skip"), nil, nil
+		}
+
+		// Build the message using a limit high enough not to interfere with the other operations
+		msgTypeURL := rateLimitedMsgTypeURLs[r.Intn(len(rateLimitedMsgTypeURLs))]
+		maxMessages := uint32(r.Intn(1000) + 1000)
+		window := time.Duration(r.Intn(24)+1) * time.Hour
+		msg := types.NewMsgSetRateLimit(subspaceID, msgTypeURL, maxMessages, window, signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomSetRateLimitFields returns the data used to build a random MsgSetRateLimit
+func randomSetRateLimitFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspaceID uint64, account simtypes.Account, skip bool) {
+	// Get a subspace
+	subspaces := k.GetAllSubspaces(ctx)
+	if len(subspaces) == 0 {
+		// Skip because there are no subspaces
+		skip = true
+		return
+	}
+	subspace := RandomSubspace(r, subspaces)
+	subspaceID = subspace.ID
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, subspaceID, types.NewPermissions(types.PermissionManageRateLimits))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return subspaceID, account, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgRemoveRateLimit tests and runs a single msg remove rate limit
+func SimulateMsgRemoveRateLimit(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, msgTypeURL, signer, skip := randomRemoveRateLimitFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgRemoveRateLimit", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgRemoveRateLimit(subspaceID, msgTypeURL, signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomRemoveRateLimitFields returns the data used to build a random MsgRemoveRateLimit
+func randomRemoveRateLimitFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspaceID uint64, msgTypeURL string, account simtypes.Account, skip bool) {
+	// Get a rate limit
+	limits := k.GetAllRateLimits(ctx)
+	if len(limits) == 0 {
+		// Skip because there are no rate limits
+		skip = true
+		return
+	}
+	limit := RandomRateLimit(r, limits)
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, limit.SubspaceID, types.NewPermissions(types.PermissionManageRateLimits))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return limit.SubspaceID, limit.MsgTypeURL, account, false
+}
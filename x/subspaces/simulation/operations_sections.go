@@ -38,6 +38,7 @@ func SimulateMsgCreateSection(
 			update.Name,
 			update.Description,
 			parentID,
+			RandomSectionVisibility(r),
 			creator.Address.String(),
 		)
 
@@ -250,6 +251,71 @@ func isChildSection(ctx sdk.Context, k *keeper.Keeper, parent types.Section, sec
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// SimulateMsgSetSectionVisibility tests and runs a single MsgSetSectionVisibility
+func SimulateMsgSetSectionVisibility(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, sectionID, visibility, signer, skip := randomSetSectionVisibilityFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgSetSectionVisibility", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgSetSectionVisibility(
+			subspaceID,
+			sectionID,
+			visibility,
+			signer.Address.String(),
+		)
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomSetSectionVisibilityFields returns the data used to build a random MsgSetSectionVisibility
+func randomSetSectionVisibilityFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspaceID uint64, sectionID uint32, visibility types.SectionVisibility, account simtypes.Account, skip bool) {
+	// Get a subspace id
+	subspaces := k.GetAllSubspaces(ctx)
+	if len(subspaces) == 0 {
+		// Skip because there are no subspaces
+		skip = true
+		return
+	}
+	subspace := RandomSubspace(r, subspaces)
+	subspaceID = subspace.ID
+
+	// Get a random section
+	sections := k.GetSubspaceSections(ctx, subspaceID)
+	section := RandomSection(r, sections)
+	sectionID = section.ID
+
+	// Get a random visibility
+	visibility = RandomSectionVisibility(r)
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, subspace.ID, types.NewPermissions(types.PermissionManageSections))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return subspaceID, sectionID, visibility, account, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // SimulateMsgDeleteSection tests and runs a single MsgDeleteSection
 func SimulateMsgDeleteSection(
 	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
@@ -0,0 +1,216 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/desmos-labs/desmos/v7/testutil/simtesting"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/keeper"
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+// SimulateMsgSetTreasuryVotingConfig tests and runs a single msg set treasury voting config
+func SimulateMsgSetTreasuryVotingConfig(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, groupID, signer, skip := randomSetTreasuryVotingConfigFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgSetTreasuryVotingConfig", "skip"), nil, nil
+		}
+
+		// Build the message
+		threshold := sdk.NewDecWithPrec(int64(r.Intn(100)+1), 2)
+		votingPeriod := time.Duration(r.Intn(24)+1) * time.Hour
+		msg := types.NewMsgSetTreasuryVotingConfig(subspaceID, groupID, threshold, nil, votingPeriod, signer.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, signer)
+	}
+}
+
+// randomSetTreasuryVotingConfigFields returns the data used to build a random MsgSetTreasuryVotingConfig
+func randomSetTreasuryVotingConfigFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspaceID uint64, groupID uint32, account simtypes.Account, skip bool) {
+	// Get a subspace
+	subspaces := k.GetAllSubspaces(ctx)
+	if len(subspaces) == 0 {
+		// Skip because there are no subspaces
+		skip = true
+		return
+	}
+	subspace := RandomSubspace(r, subspaces)
+	subspaceID = subspace.ID
+
+	// Get a group that is not the default one
+	var groups []types.UserGroup
+	for _, group := range k.GetSubspaceUserGroups(ctx, subspaceID) {
+		if group.ID != 0 {
+			groups = append(groups, group)
+		}
+	}
+	if len(groups) == 0 {
+		// Skip because there are no groups
+		skip = true
+		return
+	}
+	groupID = RandomGroup(r, groups).ID
+
+	// Get a signer
+	signers := k.GetUsersWithRootPermissions(ctx, subspaceID, types.NewPermissions(types.PermissionManageTreasuryAuthorization))
+	acc := GetAccount(RandomAddress(r, signers), accs)
+	if acc == nil {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return subspaceID, groupID, account, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgSubmitTreasuryProposal tests and runs a single msg submit treasury proposal
+func SimulateMsgSubmitTreasuryProposal(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspace, proposer, skip := randomSubmitTreasuryProposalFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgSubmitTreasuryProposal", "skip"), nil, nil
+		}
+
+		// Build the message
+		msg := types.NewMsgSubmitTreasuryProposal(
+			subspace.ID,
+			[]sdk.Msg{types.NewMsgEditSubspace(subspace.ID, RandomName(r), types.DoNotModify, types.DoNotModify, subspace.Treasury)},
+			proposer.Address.String(),
+		)
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, proposer)
+	}
+}
+
+// randomSubmitTreasuryProposalFields returns the data used to build a random MsgSubmitTreasuryProposal
+func randomSubmitTreasuryProposalFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspace types.Subspace, account simtypes.Account, skip bool) {
+	// Get a voting config
+	configs := k.GetAllTreasuryVotingConfigs(ctx)
+	if len(configs) == 0 {
+		// Skip because there are no voting configs
+		skip = true
+		return
+	}
+	config := RandomTreasuryVotingConfig(r, configs)
+
+	// Get the subspace
+	subspace, found := k.GetSubspace(ctx, config.SubspaceID)
+	if !found {
+		// Skip because the subspace does not exist
+		skip = true
+		return
+	}
+
+	// Get a proposer
+	members := k.GetUserGroupMembers(ctx, config.SubspaceID, config.GroupID)
+	if len(members) == 0 {
+		// Skip because there are no group members
+		skip = true
+		return
+	}
+	acc := GetAccount(RandomAddress(r, members), accs)
+	if acc == nil || !k.CanSubmitTreasuryProposal(ctx, config.SubspaceID, config.GroupID, acc.Address.String()) {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return subspace, account, false
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SimulateMsgVoteTreasuryProposal tests and runs a single msg vote treasury proposal
+func SimulateMsgVoteTreasuryProposal(
+	k *keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// Get the data
+		subspaceID, proposalID, voter, skip := randomVoteTreasuryProposalFields(r, ctx, accs, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, "MsgVoteTreasuryProposal", "skip"), nil, nil
+		}
+
+		// Build the message
+		option := types.TREASURY_VOTE_OPTION_YES
+		if r.Intn(2) == 0 {
+			option = types.TREASURY_VOTE_OPTION_NO
+		}
+		msg := types.NewMsgVoteTreasuryProposal(subspaceID, proposalID, option, voter.Address.String())
+
+		// Send the message
+		return simtesting.SendMsg(r, app, ak, bk, msg, ctx, voter)
+	}
+}
+
+// randomVoteTreasuryProposalFields returns the data used to build a random MsgVoteTreasuryProposal
+func randomVoteTreasuryProposalFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k *keeper.Keeper,
+) (subspaceID uint64, proposalID uint64, account simtypes.Account, skip bool) {
+	// Get a proposal that can still be voted
+	var proposals []types.TreasuryProposal
+	for _, proposal := range k.GetAllTreasuryProposals(ctx) {
+		if proposal.Status == types.TREASURY_PROPOSAL_STATUS_VOTING && !proposal.IsVotingPeriodEnded(ctx.BlockTime()) {
+			proposals = append(proposals, proposal)
+		}
+	}
+	if len(proposals) == 0 {
+		// Skip because there are no proposals to vote
+		skip = true
+		return
+	}
+	proposal := RandomTreasuryProposal(r, proposals)
+
+	// Get a voter
+	if len(proposal.Voters) == 0 {
+		// Skip because there are no voters
+		skip = true
+		return
+	}
+	acc := GetAccount(RandomAddress(r, proposal.Voters), accs)
+	if acc == nil || !k.CanVoteTreasuryProposal(ctx, proposal, acc.Address.String()) {
+		// Skip the operation without error as the account is not valid
+		skip = true
+		return
+	}
+	account = *acc
+
+	return proposal.SubspaceID, proposal.ID, account, false
+}
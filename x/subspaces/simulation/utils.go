@@ -89,6 +89,16 @@ func RandomSectionDescription(r *rand.Rand) string {
 	return simtypes.RandStringOfLength(r, 20)
 }
 
+// RandomSectionVisibility returns a random section visibility
+func RandomSectionVisibility(r *rand.Rand) types.SectionVisibility {
+	visibilities := []types.SectionVisibility{
+		types.SECTION_VISIBILITY_PUBLIC,
+		types.SECTION_VISIBILITY_MEMBERS_ONLY,
+		types.SECTION_VISIBILITY_GROUP_RESTRICTED,
+	}
+	return visibilities[r.Intn(len(visibilities))]
+}
+
 // RandomGroup returns a random group selecting it from the list of groups given
 func RandomGroup(r *rand.Rand, groups []types.UserGroup) types.UserGroup {
 	return groups[r.Intn(len(groups))]
@@ -124,6 +134,63 @@ func RandomGrant(r *rand.Rand, grants []types.Grant) types.Grant {
 	return grants[r.Intn(len(grants))]
 }
 
+// RandomSubspaceOwnerTransferRequest returns a random subspace owner transfer request from the slice given
+func RandomSubspaceOwnerTransferRequest(r *rand.Rand, requests []types.SubspaceOwnerTransferRequest) types.SubspaceOwnerTransferRequest {
+	return requests[r.Intn(len(requests))]
+}
+
+// RandomGroupInvite returns a random group invite from the slice given
+func RandomGroupInvite(r *rand.Rand, invites []types.GroupInvite) types.GroupInvite {
+	return invites[r.Intn(len(invites))]
+}
+
+// RandomGroupApplication returns a random group application from the slice given
+func RandomGroupApplication(r *rand.Rand, applications []types.GroupApplication) types.GroupApplication {
+	return applications[r.Intn(len(applications))]
+}
+
+// RandomSubspaceBan returns a random subspace ban from the slice given
+func RandomSubspaceBan(r *rand.Rand, bans []types.SubspaceBan) types.SubspaceBan {
+	return bans[r.Intn(len(bans))]
+}
+
+// RandomRateLimit returns a random rate limit from the slice given
+func RandomRateLimit(r *rand.Rand, limits []types.RateLimit) types.RateLimit {
+	return limits[r.Intn(len(limits))]
+}
+
+// RandomTreasuryVotingConfig returns a random treasury voting config from the slice given
+func RandomTreasuryVotingConfig(r *rand.Rand, configs []types.TreasuryVotingConfig) types.TreasuryVotingConfig {
+	return configs[r.Intn(len(configs))]
+}
+
+// RandomTreasuryProposal returns a random treasury proposal from the slice given
+func RandomTreasuryProposal(r *rand.Rand, proposals []types.TreasuryProposal) types.TreasuryProposal {
+	return proposals[r.Intn(len(proposals))]
+}
+
+// GenerateRandomFeeTokensConfig generates a random fee tokens config for the given subspace and fee tokens
+func GenerateRandomFeeTokensConfig(r *rand.Rand, subspaceID uint64, feeTokens sdk.Coins) types.FeeTokensConfig {
+	rates := make([]types.FeeTokenRate, len(feeTokens))
+	for i, token := range feeTokens {
+		rate := sdk.NewDecWithPrec(r.Int63n(1000)+1, 2)
+		maxAmount := sdk.NewInt(r.Int63n(1000000))
+		rates[i] = types.NewFeeTokenRate(token.Denom, rate, maxAmount)
+	}
+
+	destinations := []types.FeeTokensDestination{
+		types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+		types.FEE_TOKENS_DESTINATION_TREASURY,
+	}
+
+	return types.NewFeeTokensConfig(subspaceID, sdk.DefaultBondDenom, rates, destinations[r.Intn(len(destinations))])
+}
+
+// RandomFeeTokensConfig returns a random fee tokens config from the slice given
+func RandomFeeTokensConfig(r *rand.Rand, configs []types.FeeTokensConfig) types.FeeTokensConfig {
+	return configs[r.Intn(len(configs))]
+}
+
 // GenerateRandomFeeTokens generates a list of fee tokens
 func GenerateRandomFeeTokens(r *rand.Rand) sdk.Coins {
 	coins := make(sdk.Coins, r.Intn(10))
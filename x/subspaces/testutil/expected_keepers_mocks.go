@@ -114,6 +114,21 @@ func (mr *MockAuthzKeeperMockRecorder) DeleteGrant(ctx, grantee, granter, msgTyp
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGrant", reflect.TypeOf((*MockAuthzKeeper)(nil).DeleteGrant), ctx, grantee, granter, msgType)
 }
 
+// DispatchActions mocks base method.
+func (m *MockAuthzKeeper) DispatchActions(ctx types.Context, grantee types.AccAddress, msgs []types.Msg) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchActions", ctx, grantee, msgs)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispatchActions indicates an expected call of DispatchActions.
+func (mr *MockAuthzKeeperMockRecorder) DispatchActions(ctx, grantee, msgs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchActions", reflect.TypeOf((*MockAuthzKeeper)(nil).DispatchActions), ctx, grantee, msgs)
+}
+
 // SaveGrant mocks base method.
 func (m *MockAuthzKeeper) SaveGrant(ctx types.Context, grantee, granter types.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
 	m.ctrl.T.Helper()
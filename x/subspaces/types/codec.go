@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
 	govcodec "github.com/cosmos/cosmos-sdk/x/gov/codec"
 )
 
@@ -15,10 +16,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCreateSubspace{}, "desmos/MsgCreateSubspace")
 	legacy.RegisterAminoMsg(cdc, &MsgEditSubspace{}, "desmos/MsgEditSubspace")
 	legacy.RegisterAminoMsg(cdc, &MsgDeleteSubspace{}, "desmos/MsgDeleteSubspace")
+	legacy.RegisterAminoMsg(cdc, &MsgArchiveSubspace{}, "desmos/MsgArchiveSubspace")
+	legacy.RegisterAminoMsg(cdc, &MsgUnarchiveSubspace{}, "desmos/MsgUnarchiveSubspace")
+	legacy.RegisterAminoMsg(cdc, &MsgRequestSubspaceOwnerTransfer{}, "desmos/MsgRequestSubspaceOwnerTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelSubspaceOwnerTransferRequest{}, "desmos/MsgCancelSubspaceOwnerTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptSubspaceOwnerTransferRequest{}, "desmos/MsgAcceptSubspaceOwnerTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgRefuseSubspaceOwnerTransferRequest{}, "desmos/MsgRefuseSubspaceOwnerTransfer")
 
 	legacy.RegisterAminoMsg(cdc, &MsgCreateSection{}, "desmos/MsgCreateSection")
 	legacy.RegisterAminoMsg(cdc, &MsgEditSection{}, "desmos/MsgEditSection")
 	legacy.RegisterAminoMsg(cdc, &MsgMoveSection{}, "desmos/MsgMoveSection")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSectionVisibility{}, "desmos/MsgSetSectionVisibility")
 	legacy.RegisterAminoMsg(cdc, &MsgDeleteSection{}, "desmos/MsgDeleteSection")
 
 	legacy.RegisterAminoMsg(cdc, &MsgCreateUserGroup{}, "desmos/MsgCreateUserGroup")
@@ -30,10 +38,29 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAddUserToUserGroup{}, "desmos/MsgAddUserToUserGroup")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveUserFromUserGroup{}, "desmos/MsgRemoveUserFromUserGroup")
 
+	legacy.RegisterAminoMsg(cdc, &MsgCreateInvite{}, "desmos/MsgCreateInvite")
+	legacy.RegisterAminoMsg(cdc, &MsgDeleteInvite{}, "desmos/MsgDeleteInvite")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemInvite{}, "desmos/MsgRedeemInvite")
+	legacy.RegisterAminoMsg(cdc, &MsgApplyToGroup{}, "desmos/MsgApplyToGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveGroupApplication{}, "desmos/MsgApproveGroupApplication")
+	legacy.RegisterAminoMsg(cdc, &MsgRejectGroupApplication{}, "desmos/MsgRejectGroupApplication")
+
 	legacy.RegisterAminoMsg(cdc, &MsgSetUserPermissions{}, "desmos/MsgSetUserPermissions")
 
+	legacy.RegisterAminoMsg(cdc, &MsgBanUser{}, "desmos/MsgBanUser")
+	legacy.RegisterAminoMsg(cdc, &MsgUnbanUser{}, "desmos/MsgUnbanUser")
+
+	legacy.RegisterAminoMsg(cdc, &MsgSetRateLimit{}, "desmos/MsgSetRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveRateLimit{}, "desmos/MsgRemoveRateLimit")
+
+	legacy.RegisterAminoMsg(cdc, &MsgSetFeeTokensConfig{}, "desmos/MsgSetFeeTokensConfig")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveFeeTokensConfig{}, "desmos/MsgRemoveFeeTokensConfig")
+
 	legacy.RegisterAminoMsg(cdc, &MsgGrantTreasuryAuthorization{}, "desmos/MsgGrantTreasuryAuthorization")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeTreasuryAuthorization{}, "desmos/MsgRevokeTreasuryAuthorization")
+	legacy.RegisterAminoMsg(cdc, &MsgSetTreasuryVotingConfig{}, "desmos/MsgSetTreasuryVotingConfig")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitTreasuryProposal{}, "desmos/MsgSubmitTreasuryProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteTreasuryProposal{}, "desmos/MsgVoteTreasuryProposal")
 
 	legacy.RegisterAminoMsg(cdc, &MsgGrantAllowance{}, "desmos/MsgGrantAllowance")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeAllowance{}, "desmos/MsgRevokeAllowance")
@@ -41,6 +68,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*Grantee)(nil), nil)
 	cdc.RegisterConcrete(&UserGrantee{}, "desmos/UserGrantee", nil)
 	cdc.RegisterConcrete(&GroupGrantee{}, "desmos/GroupGrantee", nil)
+	cdc.RegisterConcrete(&MessageLimitsAllowance{}, "desmos/MessageLimitsAllowance", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateSubspaceFeeTokens{}, "desmos/MsgUpdateSubspaceFeeTokens")
 }
@@ -53,13 +81,24 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&GroupGrantee{},
 	)
 
+	registry.RegisterImplementations((*feegranttypes.FeeAllowanceI)(nil),
+		&MessageLimitsAllowance{},
+	)
+
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateSubspace{},
 		&MsgEditSubspace{},
+		&MsgArchiveSubspace{},
+		&MsgUnarchiveSubspace{},
+		&MsgRequestSubspaceOwnerTransfer{},
+		&MsgCancelSubspaceOwnerTransferRequest{},
+		&MsgAcceptSubspaceOwnerTransferRequest{},
+		&MsgRefuseSubspaceOwnerTransferRequest{},
 		&MsgCreateUserGroup{},
 		&MsgCreateSection{},
 		&MsgEditSection{},
 		&MsgMoveSection{},
+		&MsgSetSectionVisibility{},
 		&MsgDeleteSection{},
 		&MsgEditUserGroup{},
 		&MsgMoveUserGroup{},
@@ -67,9 +106,24 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeleteUserGroup{},
 		&MsgAddUserToUserGroup{},
 		&MsgRemoveUserFromUserGroup{},
+		&MsgCreateInvite{},
+		&MsgDeleteInvite{},
+		&MsgRedeemInvite{},
+		&MsgApplyToGroup{},
+		&MsgApproveGroupApplication{},
+		&MsgRejectGroupApplication{},
 		&MsgSetUserPermissions{},
+		&MsgBanUser{},
+		&MsgUnbanUser{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgSetFeeTokensConfig{},
+		&MsgRemoveFeeTokensConfig{},
 		&MsgGrantTreasuryAuthorization{},
 		&MsgRevokeTreasuryAuthorization{},
+		&MsgSetTreasuryVotingConfig{},
+		&MsgSubmitTreasuryProposal{},
+		&MsgVoteTreasuryProposal{},
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
 		&MsgUpdateSubspaceFeeTokens{},
@@ -6,4 +6,16 @@ var (
 	// ErrPermissionDenied is returned if a user cannot perform a specific action inside a subspace
 	ErrPermissionDenied = errors.Register(ModuleName, 1, "permissions denied for user")
 	ErrInvalidGenesis   = errors.Register(ModuleName, 2, "invalid genesis state")
+
+	// ErrSubspaceArchived is returned if a user tries to perform a write operation inside an archived subspace
+	ErrSubspaceArchived = errors.Register(ModuleName, 3, "subspace is archived")
+
+	// ErrRateLimitExceeded is returned if a user tries to send more messages than the ones allowed by a subspace rate limit
+	ErrRateLimitExceeded = errors.Register(ModuleName, 4, "rate limit exceeded")
+
+	// ErrTreasuryProposalNotVoting is returned if a user tries to vote a treasury proposal that is no longer in voting period
+	ErrTreasuryProposalNotVoting = errors.Register(ModuleName, 5, "treasury proposal not in voting period")
+
+	// ErrFeeTokenCapExceeded is returned if a transaction pays more fee tokens than the ones accepted by a subspace
+	ErrFeeTokenCapExceeded = errors.Register(ModuleName, 6, "fee token cap exceeded")
 )
@@ -8,6 +8,7 @@ const (
 	EventTypeCreatedSection               = "created_section"
 	EventTypeEditedSection                = "edited_section"
 	EventTypeMovedSection                 = "moved_section"
+	EventTypeSetSectionVisibility         = "set_section_visibility"
 	EventTypeDeletedSection               = "deleted_section"
 	EventTypeCreatedUserGroup             = "created_user_group"
 	EventTypeEditedUserGroup              = "edited_user_group"
@@ -23,6 +24,36 @@ const (
 	EventTypeRevokedAllowance             = "revoked_allowance"
 	EventTypeUpdatedSubspaceFeeToken      = "updated_subspace_fee_token"
 
+	EventTypeArchivedSubspace   = "archived_subspace"
+	EventTypeUnarchivedSubspace = "unarchived_subspace"
+
+	EventTypeRequestedSubspaceOwnerTransfer = "requested_subspace_owner_transfer"
+	EventTypeCanceledSubspaceOwnerTransfer  = "canceled_subspace_owner_transfer"
+	EventTypeAcceptedSubspaceOwnerTransfer  = "accepted_subspace_owner_transfer"
+	EventTypeRefusedSubspaceOwnerTransfer   = "refused_subspace_owner_transfer"
+
+	EventTypeCreatedInvite            = "created_invite"
+	EventTypeDeletedInvite            = "deleted_invite"
+	EventTypeRedeemedInvite           = "redeemed_invite"
+	EventTypeAppliedToGroup           = "applied_to_group"
+	EventTypeApprovedGroupApplication = "approved_group_application"
+	EventTypeRejectedGroupApplication = "rejected_group_application"
+
+	EventTypeBannedUser   = "banned_user"
+	EventTypeUnbannedUser = "unbanned_user"
+
+	EventTypeSetRateLimit     = "set_rate_limit"
+	EventTypeRemovedRateLimit = "removed_rate_limit"
+
+	EventTypeSetFeeTokensConfig     = "set_fee_tokens_config"
+	EventTypeRemovedFeeTokensConfig = "removed_fee_tokens_config"
+
+	EventTypeSetTreasuryVotingConfig   = "set_treasury_voting_config"
+	EventTypeSubmittedTreasuryProposal = "submitted_treasury_proposal"
+	EventTypeVotedTreasuryProposal     = "voted_treasury_proposal"
+	EventTypeExecutedTreasuryProposal  = "executed_treasury_proposal"
+	EventTypeRejectedTreasuryProposal  = "rejected_treasury_proposal"
+
 	AttributeKeySubspaceID      = "subspace_id"
 	AttributeKeySubspaceName    = "subspace_name"
 	AttributeKeySubspaceCreator = "subspace_creator"
@@ -35,4 +66,14 @@ const (
 	AttributeKeyGrantee         = "grantee"
 	AttributeKeyUserGrantee     = "user_grantee"
 	AttributeKeyGroupGrantee    = "group_grantee"
+	AttributeKeySender          = "sender"
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeySecretHash      = "secret_hash"
+	AttributeKeyApplicant       = "applicant"
+	AttributeKeyMsgTypeURL      = "msg_type_url"
+	AttributeKeyProposalID      = "proposal_id"
+	AttributeKeyProposer        = "proposer"
+	AttributeKeyVoter           = "voter"
+	AttributeKeyVoteOption      = "option"
+	AttributeKeyProposalStatus  = "proposal_status"
 )
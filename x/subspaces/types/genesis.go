@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,16 +18,36 @@ func NewGenesisState(
 	userGroups []UserGroup,
 	userGroupMembers []UserGroupMemberEntry,
 	grants []Grant,
+	ownerTransferRequests []SubspaceOwnerTransferRequest,
+	groupInvites []GroupInvite,
+	groupApplications []GroupApplication,
+	bans []SubspaceBan,
+	rateLimits []RateLimit,
+	treasuryVotingConfigs []TreasuryVotingConfig,
+	treasuryProposals []TreasuryProposal,
+	treasuryVotes []TreasuryVote,
+	feeTokensConfigs []FeeTokensConfig,
+	allowanceUsages []AllowanceUsage,
 ) *GenesisState {
 	return &GenesisState{
-		InitialSubspaceID: initialSubspaceID,
-		SubspacesData:     subspacesData,
-		Subspaces:         subspaces,
-		Sections:          sections,
-		UserPermissions:   userPermissions,
-		UserGroups:        userGroups,
-		UserGroupsMembers: userGroupMembers,
-		Grants:            grants,
+		InitialSubspaceID:     initialSubspaceID,
+		SubspacesData:         subspacesData,
+		Subspaces:             subspaces,
+		Sections:              sections,
+		UserPermissions:       userPermissions,
+		UserGroups:            userGroups,
+		UserGroupsMembers:     userGroupMembers,
+		Grants:                grants,
+		OwnerTransferRequests: ownerTransferRequests,
+		GroupInvites:          groupInvites,
+		GroupApplications:     groupApplications,
+		Bans:                  bans,
+		RateLimits:            rateLimits,
+		TreasuryVotingConfigs: treasuryVotingConfigs,
+		TreasuryProposals:     treasuryProposals,
+		TreasuryVotes:         treasuryVotes,
+		FeeTokensConfigs:      feeTokensConfigs,
+		AllowanceUsages:       allowanceUsages,
 	}
 }
 
@@ -38,6 +59,13 @@ func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 			return err
 		}
 	}
+
+	for _, proposal := range data.TreasuryProposals {
+		err := proposal.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -52,6 +80,16 @@ func DefaultGenesisState() *GenesisState {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 }
 
@@ -145,6 +183,129 @@ func ValidateGenesis(data *GenesisState) error {
 		}
 	}
 
+	// Validate the owner transfer requests
+	for _, request := range data.OwnerTransferRequests {
+		if containsDuplicatedOwnerTransferRequest(data.OwnerTransferRequests, request) {
+			return fmt.Errorf("duplicated owner transfer request for subspace %d", request.SubspaceID)
+		}
+
+		err := request.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate the group invites
+	for _, invite := range data.GroupInvites {
+		if containsDuplicatedGroupInvite(data.GroupInvites, invite) {
+			return fmt.Errorf("duplicated group invite for subspace %d and secret hash %s", invite.SubspaceID, invite.SecretHash)
+		}
+
+		err := invite.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate the group applications
+	for _, application := range data.GroupApplications {
+		if containsDuplicatedGroupApplication(data.GroupApplications, application) {
+			return fmt.Errorf("duplicated group application for group %d within subspace %d and applicant %s",
+				application.GroupID, application.SubspaceID, application.Applicant)
+		}
+
+		err := application.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate the subspace bans
+	for _, ban := range data.Bans {
+		if containsDuplicatedSubspaceBan(data.Bans, ban) {
+			return fmt.Errorf("duplicated ban for user %s within subspace %d", ban.User, ban.SubspaceID)
+		}
+
+		err := ban.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate the rate limits
+	for _, limit := range data.RateLimits {
+		if containsDuplicatedRateLimit(data.RateLimits, limit) {
+			return fmt.Errorf("duplicated rate limit for message type %s within subspace %d", limit.MsgTypeURL, limit.SubspaceID)
+		}
+
+		err := limit.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate the treasury voting configs
+	for _, config := range data.TreasuryVotingConfigs {
+		if containsDuplicatedTreasuryVotingConfig(data.TreasuryVotingConfigs, config) {
+			return fmt.Errorf("duplicated treasury voting config for subspace %d", config.SubspaceID)
+		}
+
+		err := config.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate the treasury proposals
+	for _, proposal := range data.TreasuryProposals {
+		if containsDuplicatedTreasuryProposal(data.TreasuryProposals, proposal) {
+			return fmt.Errorf("duplicated treasury proposal %d within subspace %d", proposal.ID, proposal.SubspaceID)
+		}
+
+		err := proposal.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate the treasury votes
+	for _, vote := range data.TreasuryVotes {
+		if containsDuplicatedTreasuryVote(data.TreasuryVotes, vote) {
+			return fmt.Errorf("duplicated vote of %s for treasury proposal %d within subspace %d",
+				vote.Voter, vote.ProposalID, vote.SubspaceID)
+		}
+
+		err := vote.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate the fee tokens configs
+	for _, config := range data.FeeTokensConfigs {
+		if containsDuplicatedFeeTokensConfig(data.FeeTokensConfigs, config) {
+			return fmt.Errorf("duplicated fee tokens config for subspace %d", config.SubspaceID)
+		}
+
+		err := config.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate the allowance usages
+	for _, usage := range data.AllowanceUsages {
+		if containsDuplicatedAllowanceUsage(data.AllowanceUsages, usage) {
+			return fmt.Errorf("duplicated allowance usage of %s for group %d within subspace %d",
+				usage.Grantee, usage.GroupID, usage.SubspaceID)
+		}
+
+		err := usage.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -251,11 +412,12 @@ func (data SubspaceData) Validate() error {
 // -------------------------------------------------------------------------------------------------------------------
 
 // NewUserGroupMemberEntry returns a new UserGroupMemberEntry instance
-func NewUserGroupMemberEntry(subspaceID uint64, groupID uint32, user string) UserGroupMemberEntry {
+func NewUserGroupMemberEntry(subspaceID uint64, groupID uint32, user string, expirationTime *time.Time) UserGroupMemberEntry {
 	return UserGroupMemberEntry{
-		SubspaceID: subspaceID,
-		GroupID:    groupID,
-		User:       user,
+		SubspaceID:     subspaceID,
+		GroupID:        groupID,
+		User:           user,
+		ExpirationTime: expirationTime,
 	}
 }
 
@@ -290,3 +452,123 @@ func containsDuplicatedGrants(grants []Grant, grant Grant) bool {
 	}
 	return count > 1
 }
+
+// containsDuplicatedOwnerTransferRequest tells whether the given requests slice contains two or more
+// requests for the same subspace
+func containsDuplicatedOwnerTransferRequest(requests []SubspaceOwnerTransferRequest, request SubspaceOwnerTransferRequest) bool {
+	var count = 0
+	for _, r := range requests {
+		if r.SubspaceID == request.SubspaceID {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedGroupInvite tells whether the given invites slice contains two or more
+// invites for the same subspace having the same secret hash
+func containsDuplicatedGroupInvite(invites []GroupInvite, invite GroupInvite) bool {
+	var count = 0
+	for _, i := range invites {
+		if i.SubspaceID == invite.SubspaceID && i.SecretHash == invite.SecretHash {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedGroupApplication tells whether the given applications slice contains two or more
+// applications made by the same user for the same group
+func containsDuplicatedGroupApplication(applications []GroupApplication, application GroupApplication) bool {
+	var count = 0
+	for _, a := range applications {
+		if a.SubspaceID == application.SubspaceID && a.GroupID == application.GroupID && a.Applicant == application.Applicant {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedSubspaceBan tells whether the given bans slice contains two or more
+// bans for the same user within the same subspace
+func containsDuplicatedSubspaceBan(bans []SubspaceBan, ban SubspaceBan) bool {
+	var count = 0
+	for _, b := range bans {
+		if b.SubspaceID == ban.SubspaceID && b.User == ban.User {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedRateLimit tells whether the given rate limits slice contains two or more
+// rate limits for the same message type within the same subspace
+func containsDuplicatedRateLimit(limits []RateLimit, limit RateLimit) bool {
+	var count = 0
+	for _, l := range limits {
+		if l.SubspaceID == limit.SubspaceID && l.MsgTypeURL == limit.MsgTypeURL {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedTreasuryVotingConfig tells whether the given configs slice contains two or more
+// treasury voting configs for the same subspace
+func containsDuplicatedTreasuryVotingConfig(configs []TreasuryVotingConfig, config TreasuryVotingConfig) bool {
+	var count = 0
+	for _, c := range configs {
+		if c.SubspaceID == config.SubspaceID {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedTreasuryProposal tells whether the given proposals slice contains two or more
+// treasury proposals having the same id within the same subspace
+func containsDuplicatedTreasuryProposal(proposals []TreasuryProposal, proposal TreasuryProposal) bool {
+	var count = 0
+	for _, p := range proposals {
+		if p.SubspaceID == proposal.SubspaceID && p.ID == proposal.ID {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedTreasuryVote tells whether the given votes slice contains two or more
+// votes of the same voter on the same treasury proposal
+func containsDuplicatedTreasuryVote(votes []TreasuryVote, vote TreasuryVote) bool {
+	var count = 0
+	for _, v := range votes {
+		if v.SubspaceID == vote.SubspaceID && v.ProposalID == vote.ProposalID && v.Voter == vote.Voter {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedFeeTokensConfig tells whether the given configs slice contains two or more
+// fee tokens configs for the same subspace
+func containsDuplicatedFeeTokensConfig(configs []FeeTokensConfig, config FeeTokensConfig) bool {
+	var count = 0
+	for _, c := range configs {
+		if c.SubspaceID == config.SubspaceID {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// containsDuplicatedAllowanceUsage tells whether the given usages slice contains two or more
+// usages made by the same grantee of the allowance granted to the same group of the same subspace
+func containsDuplicatedAllowanceUsage(usages []AllowanceUsage, usage AllowanceUsage) bool {
+	var count = 0
+	for _, u := range usages {
+		if u.SubspaceID == usage.SubspaceID && u.GroupID == usage.GroupID && u.Grantee == usage.Grantee {
+			count++
+		}
+	}
+	return count > 1
+}
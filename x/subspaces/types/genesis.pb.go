@@ -9,15 +9,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,14 +31,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState contains the data of the genesis state for the subspaces module
 type GenesisState struct {
-	InitialSubspaceID uint64                 `protobuf:"varint,1,opt,name=initial_subspace_id,json=initialSubspaceId,proto3" json:"initial_subspace_id,omitempty"`
-	SubspacesData     []SubspaceData         `protobuf:"bytes,2,rep,name=subspaces_data,json=subspacesData,proto3" json:"subspaces_data"`
-	Subspaces         []Subspace             `protobuf:"bytes,3,rep,name=subspaces,proto3" json:"subspaces"`
-	Sections          []Section              `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections"`
-	UserPermissions   []UserPermission       `protobuf:"bytes,5,rep,name=user_permissions,json=userPermissions,proto3" json:"user_permissions"`
-	UserGroups        []UserGroup            `protobuf:"bytes,6,rep,name=user_groups,json=userGroups,proto3" json:"user_groups"`
-	UserGroupsMembers []UserGroupMemberEntry `protobuf:"bytes,7,rep,name=user_groups_members,json=userGroupsMembers,proto3" json:"user_groups_members"`
-	Grants            []Grant                `protobuf:"bytes,8,rep,name=grants,proto3" json:"grants"`
+	InitialSubspaceID     uint64                         `protobuf:"varint,1,opt,name=initial_subspace_id,json=initialSubspaceId,proto3" json:"initial_subspace_id,omitempty"`
+	SubspacesData         []SubspaceData                 `protobuf:"bytes,2,rep,name=subspaces_data,json=subspacesData,proto3" json:"subspaces_data"`
+	Subspaces             []Subspace                     `protobuf:"bytes,3,rep,name=subspaces,proto3" json:"subspaces"`
+	Sections              []Section                      `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections"`
+	UserPermissions       []UserPermission               `protobuf:"bytes,5,rep,name=user_permissions,json=userPermissions,proto3" json:"user_permissions"`
+	UserGroups            []UserGroup                    `protobuf:"bytes,6,rep,name=user_groups,json=userGroups,proto3" json:"user_groups"`
+	UserGroupsMembers     []UserGroupMemberEntry         `protobuf:"bytes,7,rep,name=user_groups_members,json=userGroupsMembers,proto3" json:"user_groups_members"`
+	Grants                []Grant                        `protobuf:"bytes,8,rep,name=grants,proto3" json:"grants"`
+	OwnerTransferRequests []SubspaceOwnerTransferRequest `protobuf:"bytes,9,rep,name=owner_transfer_requests,json=ownerTransferRequests,proto3" json:"owner_transfer_requests"`
+	GroupInvites          []GroupInvite                  `protobuf:"bytes,10,rep,name=group_invites,json=groupInvites,proto3" json:"group_invites"`
+	GroupApplications     []GroupApplication             `protobuf:"bytes,11,rep,name=group_applications,json=groupApplications,proto3" json:"group_applications"`
+	Bans                  []SubspaceBan                  `protobuf:"bytes,12,rep,name=bans,proto3" json:"bans"`
+	RateLimits            []RateLimit                    `protobuf:"bytes,13,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	TreasuryVotingConfigs []TreasuryVotingConfig         `protobuf:"bytes,14,rep,name=treasury_voting_configs,json=treasuryVotingConfigs,proto3" json:"treasury_voting_configs"`
+	TreasuryProposals     []TreasuryProposal             `protobuf:"bytes,15,rep,name=treasury_proposals,json=treasuryProposals,proto3" json:"treasury_proposals"`
+	TreasuryVotes         []TreasuryVote                 `protobuf:"bytes,16,rep,name=treasury_votes,json=treasuryVotes,proto3" json:"treasury_votes"`
+	FeeTokensConfigs      []FeeTokensConfig              `protobuf:"bytes,17,rep,name=fee_tokens_configs,json=feeTokensConfigs,proto3" json:"fee_tokens_configs"`
+	AllowanceUsages       []AllowanceUsage               `protobuf:"bytes,18,rep,name=allowance_usages,json=allowanceUsages,proto3" json:"allowance_usages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -126,6 +140,76 @@ func (m *GenesisState) GetGrants() []Grant {
 	return nil
 }
 
+func (m *GenesisState) GetOwnerTransferRequests() []SubspaceOwnerTransferRequest {
+	if m != nil {
+		return m.OwnerTransferRequests
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGroupInvites() []GroupInvite {
+	if m != nil {
+		return m.GroupInvites
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGroupApplications() []GroupApplication {
+	if m != nil {
+		return m.GroupApplications
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBans() []SubspaceBan {
+	if m != nil {
+		return m.Bans
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTreasuryVotingConfigs() []TreasuryVotingConfig {
+	if m != nil {
+		return m.TreasuryVotingConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTreasuryProposals() []TreasuryProposal {
+	if m != nil {
+		return m.TreasuryProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTreasuryVotes() []TreasuryVote {
+	if m != nil {
+		return m.TreasuryVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTokensConfigs() []FeeTokensConfig {
+	if m != nil {
+		return m.FeeTokensConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowanceUsages() []AllowanceUsage {
+	if m != nil {
+		return m.AllowanceUsages
+	}
+	return nil
+}
+
 // SubspaceData contains the genesis data for a single subspace
 type SubspaceData struct {
 	SubspaceID    uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
@@ -192,6 +276,8 @@ type UserGroupMemberEntry struct {
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
 	GroupID    uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	User       string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// (optional) Time after which the user will be removed from the group
+	ExpirationTime *time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty" yaml:"expiration_time"`
 }
 
 func (m *UserGroupMemberEntry) Reset()         { *m = UserGroupMemberEntry{} }
@@ -248,6 +334,13 @@ func (m *UserGroupMemberEntry) GetUser() string {
 	return ""
 }
 
+func (m *UserGroupMemberEntry) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "desmos.subspaces.v3.GenesisState")
 	proto.RegisterType((*SubspaceData)(nil), "desmos.subspaces.v3.SubspaceData")
@@ -257,45 +350,67 @@ func init() {
 func init() { proto.RegisterFile("desmos/subspaces/v3/genesis.proto", fileDescriptor_94a00ef70ca23c28) }
 
 var fileDescriptor_94a00ef70ca23c28 = []byte{
-	// 602 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x9b, 0xfd, 0xd7, 0x76, 0xb2, 0xdd, 0xda, 0xb4, 0x42, 0x2c, 0x9a, 0x74, 0x57, 0x90,
-	0x2a, 0x6e, 0x02, 0xdb, 0x83, 0x28, 0x78, 0xb0, 0x76, 0x2d, 0x15, 0x15, 0x69, 0xf1, 0xa0, 0x97,
-	0x90, 0x36, 0x43, 0x0c, 0x34, 0x99, 0x92, 0x77, 0x52, 0xba, 0x1f, 0x42, 0xf0, 0xe8, 0x71, 0x6f,
-	0x7a, 0xf4, 0xe0, 0xc5, 0x6f, 0xb0, 0xc7, 0xc5, 0x93, 0xa7, 0x20, 0xe9, 0x41, 0x3f, 0x86, 0x64,
-	0x92, 0xa6, 0xe3, 0x1a, 0x17, 0xbc, 0x94, 0x99, 0xf7, 0x7d, 0x9e, 0x5f, 0x9e, 0xe9, 0xbc, 0x09,
-	0xda, 0xb7, 0x30, 0xb8, 0x04, 0x74, 0x08, 0xc6, 0x30, 0x33, 0x27, 0x18, 0xf4, 0x79, 0x47, 0xb7,
-	0xb1, 0x87, 0xc1, 0x01, 0x6d, 0xe6, 0x13, 0x4a, 0xa4, 0x7a, 0x22, 0xd1, 0x32, 0x89, 0x36, 0xef,
-	0x34, 0x6b, 0xa6, 0xeb, 0x78, 0x44, 0x67, 0xbf, 0x89, 0xae, 0xd9, 0xb0, 0x89, 0x4d, 0xd8, 0x52,
-	0x8f, 0x57, 0x69, 0xf5, 0xda, 0x84, 0xc4, 0x6e, 0x23, 0x69, 0x24, 0x9b, 0xb4, 0xd5, 0xca, 0x7b,
-	0xb6, 0x4b, 0x2c, 0x3c, 0x4d, 0x15, 0x07, 0xef, 0xb6, 0xd1, 0x6e, 0x3f, 0x09, 0x33, 0xa2, 0x26,
-	0xc5, 0xd2, 0x31, 0xaa, 0x3b, 0x9e, 0x43, 0x1d, 0x73, 0x6a, 0xac, 0x5c, 0x86, 0x63, 0xc9, 0x42,
-	0x4b, 0x68, 0x6f, 0x75, 0xaf, 0x46, 0xa1, 0x5a, 0x1b, 0x24, 0xed, 0x51, 0xda, 0x1d, 0xf4, 0x86,
-	0x35, 0xe7, 0x42, 0xc9, 0x92, 0x46, 0x68, 0x2f, 0x7b, 0xa8, 0x61, 0x99, 0xd4, 0x94, 0x37, 0x5a,
-	0x9b, 0x6d, 0xf1, 0x68, 0x5f, 0xcb, 0x39, 0xab, 0xb6, 0x32, 0xf6, 0x4c, 0x6a, 0x76, 0xcb, 0x67,
-	0xa1, 0x5a, 0xf8, 0xf4, 0xf3, 0xf3, 0x1d, 0x61, 0x58, 0xc9, 0x54, 0x71, 0x47, 0x7a, 0x82, 0xca,
-	0x59, 0x41, 0xde, 0x64, 0xbc, 0x1b, 0x97, 0xf2, 0x78, 0xd6, 0xda, 0x2a, 0x3d, 0x46, 0x25, 0xc0,
-	0x13, 0xea, 0x10, 0x0f, 0xe4, 0x2d, 0x86, 0xb9, 0x9e, 0x8f, 0x49, 0x44, 0x3c, 0x25, 0x33, 0x4a,
-	0xaf, 0xd1, 0x95, 0x00, 0xb0, 0x6f, 0xcc, 0xb0, 0xef, 0x3a, 0x00, 0x0c, 0xb6, 0xcd, 0x60, 0x37,
-	0x73, 0x61, 0xaf, 0x00, 0xfb, 0x2f, 0x33, 0x2d, 0xcf, 0xac, 0x06, 0x7f, 0xb4, 0x40, 0x7a, 0x8a,
-	0x44, 0x86, 0xb6, 0x7d, 0x12, 0xcc, 0x40, 0xde, 0x61, 0x54, 0xe5, 0x9f, 0xd4, 0x7e, 0x2c, 0xe3,
-	0x81, 0x28, 0x58, 0x55, 0x41, 0xb2, 0x50, 0x9d, 0x63, 0x19, 0x2e, 0x76, 0xc7, 0xd8, 0x07, 0xb9,
-	0xc8, 0x98, 0xb7, 0x2f, 0x67, 0x3e, 0x67, 0xe2, 0x63, 0x8f, 0xfa, 0x27, 0x3c, 0xbe, 0xb6, 0xc6,
-	0x27, 0x0a, 0x90, 0x1e, 0xa2, 0x1d, 0xdb, 0x37, 0x3d, 0x0a, 0x72, 0x89, 0x81, 0x9b, 0xb9, 0xe0,
-	0x7e, 0x2c, 0xe1, 0x49, 0xa9, 0xe9, 0x41, 0xe9, 0xc3, 0xa9, 0x2a, 0xfc, 0x3a, 0x55, 0x85, 0x83,
-	0xaf, 0x02, 0xda, 0xe5, 0xa7, 0x41, 0xd2, 0x91, 0xf8, 0xf7, 0x1c, 0xee, 0x45, 0xa1, 0x8a, 0xb8,
-	0x01, 0x44, 0xb0, 0x9e, 0xbc, 0x0e, 0xaa, 0x78, 0x78, 0x41, 0x93, 0x03, 0xc7, 0x96, 0x8d, 0x96,
-	0xd0, 0xae, 0x74, 0xab, 0x51, 0xa8, 0x8a, 0x2f, 0xf0, 0x82, 0xb2, 0xe0, 0x83, 0xde, 0x50, 0xf4,
-	0xb2, 0x8d, 0x25, 0xdd, 0x47, 0x55, 0x66, 0x4a, 0x6f, 0x37, 0xb6, 0x6d, 0x32, 0x5b, 0x2d, 0x0a,
-	0xd5, 0x4a, 0x6c, 0x4b, 0x67, 0x61, 0xd0, 0x1b, 0x32, 0xfc, 0x6a, 0x6b, 0x71, 0xd9, 0x3f, 0x0a,
-	0xa8, 0x91, 0xf7, 0xdf, 0xfd, 0xff, 0x19, 0x6e, 0xa1, 0xd2, 0x85, 0xf8, 0x62, 0x14, 0xaa, 0xc5,
-	0x55, 0xf4, 0xa2, 0x9d, 0xc6, 0xbe, 0x8b, 0xb6, 0xe2, 0xbb, 0x60, 0x59, 0xcb, 0x5d, 0xf9, 0xdb,
-	0x97, 0xc3, 0x46, 0xfa, 0xfe, 0x3f, 0xb2, 0x2c, 0x1f, 0x03, 0x8c, 0xa8, 0xef, 0x78, 0xf6, 0x90,
-	0xa9, 0xd6, 0x49, 0xbb, 0xcf, 0xce, 0x22, 0x45, 0x38, 0x8f, 0x14, 0xe1, 0x47, 0xa4, 0x08, 0xef,
-	0x97, 0x4a, 0xe1, 0x7c, 0xa9, 0x14, 0xbe, 0x2f, 0x95, 0xc2, 0x9b, 0x23, 0xdb, 0xa1, 0x6f, 0x83,
-	0xb1, 0x36, 0x21, 0xae, 0x9e, 0x5c, 0xe1, 0xe1, 0xd4, 0x1c, 0x43, 0xba, 0xd6, 0xe7, 0xf7, 0xf4,
-	0x05, 0xf7, 0x35, 0xa1, 0x27, 0x33, 0x0c, 0xe3, 0x1d, 0xf6, 0x29, 0xe9, 0xfc, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xa1, 0xde, 0xdc, 0xf8, 0xea, 0x04, 0x00, 0x00,
+	// 960 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0x36, 0xf4, 0xc7, 0x24, 0x69, 0x9a, 0x69, 0x97, 0x35, 0x15, 0xc4, 0xd9, 0xf2,
+	0x43, 0x05, 0xb1, 0xb1, 0xd8, 0x1e, 0x10, 0x2b, 0x21, 0xd4, 0x6c, 0x77, 0xab, 0xa2, 0x05, 0xaa,
+	0xb4, 0x8b, 0x04, 0x12, 0xb2, 0x26, 0xf1, 0xc4, 0x8c, 0xb0, 0x67, 0xcc, 0xbc, 0x71, 0xb6, 0xfd,
+	0x1f, 0x38, 0xec, 0x91, 0xe3, 0x72, 0xe3, 0xc8, 0x81, 0x0b, 0xff, 0xc1, 0x1e, 0x57, 0x9c, 0x38,
+	0x05, 0x94, 0x1e, 0xe0, 0xcc, 0x5f, 0x80, 0x3c, 0x76, 0x1c, 0x3b, 0x4d, 0x2d, 0x71, 0xa9, 0x3c,
+	0xf3, 0xbe, 0xdf, 0xcf, 0x3c, 0xbf, 0x8c, 0xdf, 0x2b, 0xba, 0xe3, 0x52, 0x08, 0x04, 0xd8, 0x10,
+	0xf5, 0x21, 0x24, 0x03, 0x0a, 0xf6, 0xe8, 0xc0, 0xf6, 0x28, 0xa7, 0xc0, 0xa0, 0x13, 0x4a, 0xa1,
+	0x04, 0xde, 0x4e, 0x24, 0x9d, 0x4c, 0xd2, 0x19, 0x1d, 0xec, 0x36, 0x49, 0xc0, 0xb8, 0xb0, 0xf5,
+	0xdf, 0x44, 0xb7, 0xbb, 0xe3, 0x09, 0x4f, 0xe8, 0x47, 0x3b, 0x7e, 0x4a, 0x77, 0x5f, 0x1b, 0x88,
+	0xd8, 0xed, 0x24, 0x81, 0x64, 0x91, 0x86, 0x2c, 0x4f, 0x08, 0xcf, 0xa7, 0xb6, 0x5e, 0xf5, 0xa3,
+	0xa1, 0xad, 0x58, 0x40, 0x41, 0x91, 0x20, 0x4c, 0x05, 0xed, 0x45, 0xc9, 0x05, 0xc2, 0xa5, 0x7e,
+	0x8a, 0xd8, 0xfb, 0xa9, 0x86, 0x6a, 0xc7, 0x49, 0xb6, 0x67, 0x8a, 0x28, 0x8a, 0x1f, 0xa2, 0x6d,
+	0xc6, 0x99, 0x62, 0xc4, 0x77, 0xa6, 0x2e, 0x87, 0xb9, 0xa6, 0xd1, 0x36, 0xf6, 0x2b, 0xdd, 0x5b,
+	0x93, 0xb1, 0xd5, 0x3c, 0x49, 0xc2, 0x67, 0x69, 0xf4, 0xe4, 0xa8, 0xd7, 0x64, 0x73, 0x5b, 0x2e,
+	0x3e, 0x43, 0x9b, 0xd9, 0xa1, 0x8e, 0x4b, 0x14, 0x31, 0x97, 0xdb, 0x2b, 0xfb, 0xd5, 0x7b, 0x77,
+	0x3a, 0x0b, 0x8a, 0xd1, 0x99, 0x1a, 0x8f, 0x88, 0x22, 0xdd, 0x8d, 0x17, 0x63, 0x6b, 0xe9, 0xe7,
+	0xbf, 0x7f, 0x79, 0xcf, 0xe8, 0xd5, 0x33, 0x55, 0x1c, 0xc1, 0x8f, 0xd0, 0x46, 0xb6, 0x61, 0xae,
+	0x68, 0xde, 0x1b, 0xa5, 0xbc, 0x3c, 0x6b, 0x66, 0xc5, 0x0f, 0xd0, 0x3a, 0xd0, 0x81, 0x62, 0x82,
+	0x83, 0x59, 0xd1, 0x98, 0xd7, 0x17, 0x63, 0x12, 0x51, 0x9e, 0x92, 0x19, 0xf1, 0x57, 0x68, 0x2b,
+	0x02, 0x2a, 0x9d, 0x90, 0xca, 0x80, 0x01, 0x68, 0xd8, 0x2b, 0x1a, 0xf6, 0xe6, 0x42, 0xd8, 0x13,
+	0xa0, 0xf2, 0x34, 0xd3, 0xe6, 0x99, 0x8d, 0xa8, 0x10, 0x02, 0xfc, 0x29, 0xaa, 0x6a, 0xb4, 0x27,
+	0x45, 0x14, 0x82, 0xb9, 0xaa, 0xa9, 0xad, 0x1b, 0xa9, 0xc7, 0xb1, 0x2c, 0x0f, 0x44, 0xd1, 0x74,
+	0x17, 0xb0, 0x8b, 0xb6, 0x73, 0x2c, 0x27, 0xa0, 0x41, 0x9f, 0x4a, 0x30, 0xd7, 0x34, 0xf3, 0xdd,
+	0x72, 0xe6, 0x67, 0x5a, 0xfc, 0x90, 0x2b, 0x79, 0x99, 0xc7, 0x37, 0x67, 0xf8, 0x44, 0x01, 0xf8,
+	0x63, 0xb4, 0xea, 0x49, 0xc2, 0x15, 0x98, 0xeb, 0x1a, 0xbc, 0xbb, 0x10, 0x7c, 0x1c, 0x4b, 0xf2,
+	0xa4, 0xd4, 0x84, 0x15, 0xba, 0x2d, 0x9e, 0x72, 0x2a, 0x1d, 0x25, 0x09, 0x87, 0x21, 0x95, 0x8e,
+	0xa4, 0xdf, 0x47, 0x14, 0x14, 0x98, 0x1b, 0x9a, 0xf7, 0x41, 0xe9, 0xcf, 0xfc, 0x45, 0xec, 0x3d,
+	0x4f, 0xad, 0xbd, 0xc4, 0x99, 0x3f, 0xe6, 0x96, 0x58, 0x20, 0x00, 0x7c, 0x8a, 0xea, 0xba, 0x2a,
+	0x0e, 0xe3, 0x23, 0xa6, 0x28, 0x98, 0x48, 0x9f, 0xd5, 0xbe, 0x21, 0x77, 0x11, 0x85, 0x27, 0x5a,
+	0x98, 0x47, 0xd7, 0xbc, 0xd9, 0x3e, 0x60, 0x07, 0xe1, 0x84, 0x48, 0xc2, 0xd0, 0x67, 0x03, 0x92,
+	0x5c, 0xb1, 0xaa, 0xc6, 0xbe, 0x7d, 0x33, 0xf6, 0x70, 0xa6, 0x2e, 0xd4, 0xd9, 0x9b, 0x0b, 0x02,
+	0xfe, 0x04, 0x55, 0xfa, 0x84, 0x83, 0x59, 0x2b, 0xc9, 0x34, 0xbb, 0xfc, 0xa4, 0x40, 0xd3, 0xc6,
+	0xf8, 0x6a, 0x49, 0xa2, 0xa8, 0xe3, 0xb3, 0x80, 0x29, 0x30, 0xeb, 0x25, 0x57, 0xab, 0x47, 0x14,
+	0x7d, 0x1c, 0xcb, 0x0a, 0x57, 0x4b, 0x4e, 0x77, 0x01, 0xfb, 0xe8, 0xb6, 0x92, 0x94, 0x40, 0x24,
+	0x2f, 0x9d, 0x91, 0x50, 0x8c, 0x7b, 0xce, 0x40, 0xf0, 0x21, 0xf3, 0xc0, 0xdc, 0x2c, 0xb9, 0x5e,
+	0xe7, 0xa9, 0xe7, 0x4b, 0x6d, 0x79, 0xa0, 0x1d, 0x85, 0x5f, 0x4b, 0x2d, 0x10, 0xe8, 0xda, 0x66,
+	0xa7, 0x85, 0x52, 0x84, 0x02, 0x88, 0x0f, 0x66, 0xa3, 0xa4, 0xb6, 0xd3, 0x83, 0x4e, 0x53, 0x75,
+	0xa1, 0xb6, 0x6a, 0x2e, 0x08, 0x71, 0xcb, 0xca, 0xbf, 0x0e, 0x05, 0x73, 0xab, 0xa4, 0x65, 0xe5,
+	0xde, 0xa2, 0x70, 0x21, 0xea, 0xb9, 0xec, 0x29, 0xe0, 0x6f, 0x10, 0x1e, 0x52, 0xea, 0x28, 0xf1,
+	0x1d, 0xe5, 0x90, 0x95, 0xa7, 0xa9, 0xc1, 0x6f, 0x2d, 0x04, 0x3f, 0xa2, 0xf4, 0x5c, 0xab, 0xaf,
+	0x57, 0x66, 0x6b, 0x58, 0x8c, 0xe9, 0x26, 0x44, 0x7c, 0x5f, 0x3c, 0x25, 0x7c, 0x40, 0x9d, 0x08,
+	0x88, 0x47, 0xc1, 0xc4, 0x25, 0x4d, 0xe8, 0x70, 0x2a, 0x7e, 0x12, 0x6b, 0x0b, 0x4d, 0x88, 0x14,
+	0x42, 0x70, 0x7f, 0xfd, 0xc7, 0xe7, 0x96, 0xf1, 0xcf, 0x73, 0xcb, 0xd8, 0xfb, 0xcd, 0x40, 0xb5,
+	0x7c, 0x87, 0xc6, 0x36, 0xaa, 0x5e, 0x9f, 0x0d, 0x9b, 0x93, 0xb1, 0x85, 0x72, 0x43, 0x01, 0xc1,
+	0x6c, 0x1a, 0x1c, 0xa0, 0x3a, 0xa7, 0x17, 0xca, 0x49, 0x3f, 0x37, 0xd7, 0x5c, 0x6e, 0x1b, 0xfb,
+	0xf5, 0x6e, 0x63, 0x32, 0xb6, 0xaa, 0x9f, 0xd3, 0x0b, 0x95, 0x7c, 0x5c, 0x47, 0xbd, 0x2a, 0xcf,
+	0x16, 0x2e, 0xfe, 0x08, 0x35, 0xb4, 0x29, 0xed, 0xb8, 0xb1, 0x6d, 0x45, 0xdb, 0x9a, 0x93, 0xb1,
+	0x55, 0x8f, 0x6d, 0x69, 0x7f, 0x3e, 0x39, 0xea, 0xd5, 0x79, 0x6e, 0xe9, 0xe6, 0x72, 0xff, 0x61,
+	0x19, 0xed, 0x2c, 0xea, 0x67, 0xff, 0xff, 0x1d, 0xde, 0x41, 0xeb, 0x73, 0xe9, 0x57, 0x27, 0x63,
+	0x6b, 0x6d, 0x9a, 0xfa, 0x9a, 0x97, 0xa6, 0xfd, 0x3e, 0xaa, 0xc4, 0xfd, 0x51, 0xe7, 0xba, 0xd1,
+	0x35, 0x7f, 0xff, 0xf5, 0xee, 0x4e, 0x3a, 0xb4, 0x0f, 0x5d, 0x57, 0x52, 0x80, 0x33, 0x25, 0x19,
+	0xf7, 0x7a, 0x5a, 0x85, 0x07, 0xa8, 0x41, 0x2f, 0x42, 0x26, 0xf5, 0xf7, 0xed, 0xc4, 0xf3, 0xdb,
+	0xac, 0xb4, 0x0d, 0xdd, 0x41, 0x93, 0xe1, 0xde, 0x99, 0x0e, 0xf7, 0xce, 0xf9, 0x74, 0xb8, 0x77,
+	0x5b, 0xff, 0x8e, 0xad, 0x57, 0x2f, 0x49, 0xe0, 0xdf, 0xdf, 0x9b, 0x33, 0xef, 0x3d, 0xfb, 0xd3,
+	0x32, 0x7a, 0x9b, 0xb3, 0xdd, 0xd8, 0x34, 0x2b, 0x47, 0xf7, 0xf1, 0x8b, 0x49, 0xcb, 0x78, 0x39,
+	0x69, 0x19, 0x7f, 0x4d, 0x5a, 0xc6, 0xb3, 0xab, 0xd6, 0xd2, 0xcb, 0xab, 0xd6, 0xd2, 0x1f, 0x57,
+	0xad, 0xa5, 0xaf, 0xef, 0x79, 0x4c, 0x7d, 0x1b, 0xf5, 0x3b, 0x03, 0x11, 0xd8, 0xc9, 0xcd, 0xb9,
+	0xeb, 0x93, 0x3e, 0xa4, 0xcf, 0xf6, 0xe8, 0x43, 0xfb, 0x22, 0xf7, 0x6f, 0x84, 0xba, 0x0c, 0x29,
+	0xf4, 0x57, 0x75, 0x6e, 0x07, 0xff, 0x0d, 0x00, 0xe4, 0x36, 0x74, 0xb3, 0x04, 0x09, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -376,6 +491,86 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.OwnerTransferRequests) != len(that1.OwnerTransferRequests) {
+		return false
+	}
+	for i := range this.OwnerTransferRequests {
+		if !this.OwnerTransferRequests[i].Equal(&that1.OwnerTransferRequests[i]) {
+			return false
+		}
+	}
+	if len(this.GroupInvites) != len(that1.GroupInvites) {
+		return false
+	}
+	for i := range this.GroupInvites {
+		if !this.GroupInvites[i].Equal(&that1.GroupInvites[i]) {
+			return false
+		}
+	}
+	if len(this.GroupApplications) != len(that1.GroupApplications) {
+		return false
+	}
+	for i := range this.GroupApplications {
+		if !this.GroupApplications[i].Equal(&that1.GroupApplications[i]) {
+			return false
+		}
+	}
+	if len(this.Bans) != len(that1.Bans) {
+		return false
+	}
+	for i := range this.Bans {
+		if !this.Bans[i].Equal(&that1.Bans[i]) {
+			return false
+		}
+	}
+	if len(this.RateLimits) != len(that1.RateLimits) {
+		return false
+	}
+	for i := range this.RateLimits {
+		if !this.RateLimits[i].Equal(&that1.RateLimits[i]) {
+			return false
+		}
+	}
+	if len(this.TreasuryVotingConfigs) != len(that1.TreasuryVotingConfigs) {
+		return false
+	}
+	for i := range this.TreasuryVotingConfigs {
+		if !this.TreasuryVotingConfigs[i].Equal(&that1.TreasuryVotingConfigs[i]) {
+			return false
+		}
+	}
+	if len(this.TreasuryProposals) != len(that1.TreasuryProposals) {
+		return false
+	}
+	for i := range this.TreasuryProposals {
+		if !this.TreasuryProposals[i].Equal(&that1.TreasuryProposals[i]) {
+			return false
+		}
+	}
+	if len(this.TreasuryVotes) != len(that1.TreasuryVotes) {
+		return false
+	}
+	for i := range this.TreasuryVotes {
+		if !this.TreasuryVotes[i].Equal(&that1.TreasuryVotes[i]) {
+			return false
+		}
+	}
+	if len(this.FeeTokensConfigs) != len(that1.FeeTokensConfigs) {
+		return false
+	}
+	for i := range this.FeeTokensConfigs {
+		if !this.FeeTokensConfigs[i].Equal(&that1.FeeTokensConfigs[i]) {
+			return false
+		}
+	}
+	if len(this.AllowanceUsages) != len(that1.AllowanceUsages) {
+		return false
+	}
+	for i := range this.AllowanceUsages {
+		if !this.AllowanceUsages[i].Equal(&that1.AllowanceUsages[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *SubspaceData) Equal(that interface{}) bool {
@@ -436,6 +631,13 @@ func (this *UserGroupMemberEntry) Equal(that interface{}) bool {
 	if this.User != that1.User {
 		return false
 	}
+	if that1.ExpirationTime == nil {
+		if this.ExpirationTime != nil {
+			return false
+		}
+	} else if !this.ExpirationTime.Equal(*that1.ExpirationTime) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -458,6 +660,152 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowanceUsages) > 0 {
+		for iNdEx := len(m.AllowanceUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowanceUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.FeeTokensConfigs) > 0 {
+		for iNdEx := len(m.FeeTokensConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokensConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TreasuryVotes) > 0 {
+		for iNdEx := len(m.TreasuryVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TreasuryProposals) > 0 {
+		for iNdEx := len(m.TreasuryProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TreasuryVotingConfigs) > 0 {
+		for iNdEx := len(m.TreasuryVotingConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryVotingConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Bans) > 0 {
+		for iNdEx := len(m.Bans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.GroupApplications) > 0 {
+		for iNdEx := len(m.GroupApplications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupApplications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.GroupInvites) > 0 {
+		for iNdEx := len(m.GroupInvites) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupInvites[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OwnerTransferRequests) > 0 {
+		for iNdEx := len(m.OwnerTransferRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnerTransferRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Grants) > 0 {
 		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -622,6 +970,16 @@ func (m *UserGroupMemberEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGenesis(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.User) > 0 {
 		i -= len(m.User)
 		copy(dAtA[i:], m.User)
@@ -704,6 +1062,66 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OwnerTransferRequests) > 0 {
+		for _, e := range m.OwnerTransferRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GroupInvites) > 0 {
+		for _, e := range m.GroupInvites {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GroupApplications) > 0 {
+		for _, e := range m.GroupApplications {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Bans) > 0 {
+		for _, e := range m.Bans {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TreasuryVotingConfigs) > 0 {
+		for _, e := range m.TreasuryVotingConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TreasuryProposals) > 0 {
+		for _, e := range m.TreasuryProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TreasuryVotes) > 0 {
+		for _, e := range m.TreasuryVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeTokensConfigs) > 0 {
+		for _, e := range m.FeeTokensConfigs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowanceUsages) > 0 {
+		for _, e := range m.AllowanceUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -741,6 +1159,10 @@ func (m *UserGroupMemberEntry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1036,8 +1458,348 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerTransferRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerTransferRequests = append(m.OwnerTransferRequests, SubspaceOwnerTransferRequest{})
+			if err := m.OwnerTransferRequests[len(m.OwnerTransferRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupInvites", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupInvites = append(m.GroupInvites, GroupInvite{})
+			if err := m.GroupInvites[len(m.GroupInvites)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupApplications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupApplications = append(m.GroupApplications, GroupApplication{})
+			if err := m.GroupApplications[len(m.GroupApplications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bans = append(m.Bans, SubspaceBan{})
+			if err := m.Bans[len(m.Bans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryVotingConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryVotingConfigs = append(m.TreasuryVotingConfigs, TreasuryVotingConfig{})
+			if err := m.TreasuryVotingConfigs[len(m.TreasuryVotingConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryProposals = append(m.TreasuryProposals, TreasuryProposal{})
+			if err := m.TreasuryProposals[len(m.TreasuryProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryVotes = append(m.TreasuryVotes, TreasuryVote{})
+			if err := m.TreasuryVotes[len(m.TreasuryVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokensConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokensConfigs = append(m.FeeTokensConfigs, FeeTokensConfig{})
+			if err := m.FeeTokensConfigs[len(m.FeeTokensConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowanceUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowanceUsages = append(m.AllowanceUsages, AllowanceUsage{})
+			if err := m.AllowanceUsages[len(m.AllowanceUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -1263,6 +2025,42 @@ func (m *UserGroupMemberEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 
 	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
@@ -25,7 +26,7 @@ func TestValidateGenesis(t *testing.T) {
 	}{
 		{
 			name:      "invalid initial subspace id returns error",
-			genesis:   types.NewGenesisState(0, nil, nil, nil, nil, nil, nil, nil),
+			genesis:   types.NewGenesisState(0, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -33,14 +34,14 @@ func TestValidateGenesis(t *testing.T) {
 			genesis: types.NewGenesisState(1, []types.SubspaceData{
 				types.NewSubspaceData(1, 1, 1),
 				types.NewSubspaceData(1, 1, 1),
-			}, nil, nil, nil, nil, nil, nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
 			name: "invalid subspace data returns error",
 			genesis: types.NewGenesisState(1, []types.SubspaceData{
 				types.NewSubspaceData(1, 1, 0),
-			}, nil, nil, nil, nil, nil, nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -66,7 +67,7 @@ func TestValidateGenesis(t *testing.T) {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				),
-			}, nil, nil, nil, nil, nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -82,37 +83,37 @@ func TestValidateGenesis(t *testing.T) {
 					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
 					nil,
 				),
-			}, nil, nil, nil, nil, nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
 			name: "duplication section returns error",
 			genesis: types.NewGenesisState(1, nil, nil, []types.Section{
-				types.NewSection(1, 1, 0, "Test section", "Test section"),
-				types.NewSection(1, 1, 0, "Test section", "Test section"),
-			}, nil, nil, nil, nil),
+				types.NewSection(1, 1, 0, "Test section", "Test section", types.SECTION_VISIBILITY_PUBLIC),
+				types.NewSection(1, 1, 0, "Test section", "Test section", types.SECTION_VISIBILITY_PUBLIC),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
 			name: "invalid section returns error",
 			genesis: types.NewGenesisState(1, nil, nil, []types.Section{
-				types.NewSection(0, 1, 0, "Test section", "Test section"),
-			}, nil, nil, nil, nil),
+				types.NewSection(0, 1, 0, "Test section", "Test section", types.SECTION_VISIBILITY_PUBLIC),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
 			name: "duplicated user permission returns error",
 			genesis: types.NewGenesisState(1, nil, nil, nil, []types.UserPermission{
-				types.NewUserPermission(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionEditSubspace)),
-				types.NewUserPermission(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionSetPermissions)),
-			}, nil, nil, nil),
+				types.NewUserPermission(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionEditSubspace), nil),
+				types.NewUserPermission(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionSetPermissions), nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
 			name: "invalid user permission returns error",
 			genesis: types.NewGenesisState(1, nil, nil, nil, []types.UserPermission{
-				types.NewUserPermission(0, 0, "", types.NewPermissions(types.PermissionEditSubspace)),
-			}, nil, nil, nil),
+				types.NewUserPermission(0, 0, "", types.NewPermissions(types.PermissionEditSubspace), nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -134,7 +135,7 @@ func TestValidateGenesis(t *testing.T) {
 					"This is a test group",
 					types.NewPermissions(types.PermissionEditSubspace),
 				),
-			}, nil, nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -148,23 +149,23 @@ func TestValidateGenesis(t *testing.T) {
 					"This is a test group",
 					types.NewPermissions(types.PermissionEditSubspace),
 				),
-			}, nil, nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
 			name: "duplicated group members entry returns error",
 			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, []types.UserGroupMemberEntry{
-				types.NewUserGroupMemberEntry(1, 1, ""),
-				types.NewUserGroupMemberEntry(1, 1, ""),
-			}, nil),
+				types.NewUserGroupMemberEntry(1, 1, "", nil),
+				types.NewUserGroupMemberEntry(1, 1, "", nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 
 		{
 			name: "invalid group members entry returns error",
 			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, []types.UserGroupMemberEntry{
-				types.NewUserGroupMemberEntry(1, 0, ""),
-			}, nil),
+				types.NewUserGroupMemberEntry(1, 0, "", nil),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -174,7 +175,7 @@ func TestValidateGenesis(t *testing.T) {
 				Granter:    "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd",
 				Grantee:    invalidGranteeAny,
 				Allowance:  allowanceAny,
-			}}),
+			}}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 			shouldErr: true,
 		},
 		{
@@ -192,6 +193,217 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewUserGrantee("cosmos19gz9jn5pl6ke6qg5s4gt9ga9my7w8a0x3ar0qy"),
 					&feegrant.BasicAllowance{},
 				),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated owner transfer request returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, []types.SubspaceOwnerTransferRequest{
+				types.NewSubspaceOwnerTransferRequest(1, "cosmos19gz9jn5pl6ke6qg5s4gt9ga9my7w8a0x3ar0qy", "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd"),
+				types.NewSubspaceOwnerTransferRequest(1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd"),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid owner transfer request returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, []types.SubspaceOwnerTransferRequest{
+				types.NewSubspaceOwnerTransferRequest(1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd"),
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated group invite returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, []types.GroupInvite{
+				types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 10, 0, nil, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd"),
+				types.NewGroupInvite(1, 2, types.GetInviteSecretHash("secret"), 5, 0, nil, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd"),
+			}, nil, nil, nil, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid group invite returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, []types.GroupInvite{
+				types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 0, 0, nil, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd"),
+			}, nil, nil, nil, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated group application returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.GroupApplication{
+				types.NewGroupApplication(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", "", time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)),
+				types.NewGroupApplication(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", "Let me in", time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC)),
+			}, nil, nil, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid group application returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.GroupApplication{
+				types.NewGroupApplication(1, 0, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", "", time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)),
+			}, nil, nil, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated subspace ban returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.SubspaceBan{
+				types.NewSubspaceBan(1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", "", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", nil),
+				types.NewSubspaceBan(1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", "Spam", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", nil),
+			}, nil, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid subspace ban returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.SubspaceBan{
+				types.NewSubspaceBan(0, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", "", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", nil),
+			}, nil, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated rate limit returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.RateLimit{
+				types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+				types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 5, time.Minute),
+			}, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid rate limit returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.RateLimit{
+				types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 0, time.Hour),
+			}, nil, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated treasury voting config returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.TreasuryVotingConfig{
+				types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+				types.NewTreasuryVotingConfig(1, 2, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+			}, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid treasury voting config returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.TreasuryVotingConfig{
+				types.NewTreasuryVotingConfig(1, 0, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+			}, nil, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated treasury proposal returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.TreasuryProposal{
+				types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				),
+				types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.NewDecWithPrec(5, 1),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_REJECTED,
+					nil,
+				),
+			}, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid treasury proposal returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.TreasuryProposal{
+				types.NewTreasuryProposal(
+					1,
+					1,
+					"cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd",
+					[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+					1,
+					sdk.ZeroDec(),
+					nil,
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					types.TREASURY_PROPOSAL_STATUS_VOTING,
+					nil,
+				),
+			}, nil, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated treasury vote returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.TreasuryVote{
+				types.NewTreasuryVote(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.TREASURY_VOTE_OPTION_YES),
+				types.NewTreasuryVote(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.TREASURY_VOTE_OPTION_NO),
+			}, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid treasury vote returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.TreasuryVote{
+				types.NewTreasuryVote(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.TREASURY_VOTE_OPTION_UNSPECIFIED),
+			}, nil, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated fee tokens config returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.FeeTokensConfig{
+				types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+				}, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR),
+				types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(3), sdk.ZeroInt()),
+				}, types.FEE_TOKENS_DESTINATION_TREASURY),
+			}, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid fee tokens config returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.FeeTokensConfig{
+				types.NewFeeTokensConfig(1, "stake", nil, types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR),
+			}, nil),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated allowance usage returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.AllowanceUsage{
+				types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+					nil,
+				),
+				types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(20))),
+					nil,
+				),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "invalid allowance usage returns error",
+			genesis: types.NewGenesisState(1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []types.AllowanceUsage{
+				types.NewAllowanceUsage(
+					1,
+					1,
+					"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+					time.Time{},
+					nil,
+					nil,
+				),
 			}),
 			shouldErr: true,
 		},
@@ -231,11 +443,11 @@ func TestValidateGenesis(t *testing.T) {
 					),
 				},
 				[]types.Section{
-					types.NewSection(1, 1, 0, "Test section", "Test section"),
+					types.NewSection(1, 1, 0, "Test section", "Test section", types.SECTION_VISIBILITY_PUBLIC),
 				},
 				[]types.UserPermission{
-					types.NewUserPermission(1, 0, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionEditSubspace)),
-					types.NewUserPermission(2, 0, "cosmos19gz9jn5pl6ke6qg5s4gt9ga9my7w8a0x3ar0qy", types.NewPermissions(types.PermissionManageGroups)),
+					types.NewUserPermission(1, 0, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionEditSubspace), nil),
+					types.NewUserPermission(2, 0, "cosmos19gz9jn5pl6ke6qg5s4gt9ga9my7w8a0x3ar0qy", types.NewPermissions(types.PermissionManageGroups), nil),
 				},
 				[]types.UserGroup{
 					types.NewUserGroup(
@@ -256,9 +468,9 @@ func TestValidateGenesis(t *testing.T) {
 					),
 				},
 				[]types.UserGroupMemberEntry{
-					types.NewUserGroupMemberEntry(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd"),
-					types.NewUserGroupMemberEntry(2, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd"),
-					types.NewUserGroupMemberEntry(2, 1, "cosmos19gz9jn5pl6ke6qg5s4gt9ga9my7w8a0x3ar0qy"),
+					types.NewUserGroupMemberEntry(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", nil),
+					types.NewUserGroupMemberEntry(2, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", nil),
+					types.NewUserGroupMemberEntry(2, 1, "cosmos19gz9jn5pl6ke6qg5s4gt9ga9my7w8a0x3ar0qy", nil),
 				},
 				[]types.Grant{
 					types.NewGrant(
@@ -274,6 +486,48 @@ func TestValidateGenesis(t *testing.T) {
 						&feegrant.BasicAllowance{},
 					),
 				},
+				[]types.SubspaceOwnerTransferRequest{
+					types.NewSubspaceOwnerTransferRequest(1, "cosmos19gz9jn5pl6ke6qg5s4gt9ga9my7w8a0x3ar0qy", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+				},
+				[]types.GroupInvite{
+					types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 10, 2, nil, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+				},
+				[]types.GroupApplication{
+					types.NewGroupApplication(1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", "Let me in", time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)),
+				},
+				[]types.SubspaceBan{
+					types.NewSubspaceBan(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "Spam", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", nil),
+				},
+				[]types.RateLimit{
+					types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+				},
+				[]types.TreasuryVotingConfig{
+					types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+				},
+				[]types.TreasuryProposal{
+					types.NewTreasuryProposal(
+						1,
+						1,
+						"cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd",
+						[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+						1,
+						sdk.NewDecWithPrec(5, 1),
+						nil,
+						time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+						time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+						types.TREASURY_PROPOSAL_STATUS_VOTING,
+						nil,
+					),
+				},
+				[]types.TreasuryVote{
+					types.NewTreasuryVote(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.TREASURY_VOTE_OPTION_YES),
+				},
+				[]types.FeeTokensConfig{
+					types.NewFeeTokensConfig(1, "stake", []types.FeeTokenRate{
+						types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000)),
+					}, types.FEE_TOKENS_DESTINATION_TREASURY),
+				},
+				nil,
 			),
 			shouldErr: false,
 		},
@@ -345,22 +599,22 @@ func TestUserPermission_Validate(t *testing.T) {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			entry:     types.NewUserPermission(0, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionEditSubspace)),
+			entry:     types.NewUserPermission(0, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionEditSubspace), nil),
 			shouldErr: true,
 		},
 		{
 			name:      "invalid user returns error",
-			entry:     types.NewUserPermission(1, 0, "", types.NewPermissions(types.PermissionEditSubspace)),
+			entry:     types.NewUserPermission(1, 0, "", types.NewPermissions(types.PermissionEditSubspace), nil),
 			shouldErr: true,
 		},
 		{
 			name:      "invalid permission returns error",
-			entry:     types.NewUserPermission(1, 0, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions("invalid")),
+			entry:     types.NewUserPermission(1, 0, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions("invalid"), nil),
 			shouldErr: true,
 		},
 		{
 			name:      "valid user entry returns no error",
-			entry:     types.NewUserPermission(1, 0, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionEverything)),
+			entry:     types.NewUserPermission(1, 0, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", types.NewPermissions(types.PermissionEverything), nil),
 			shouldErr: false,
 		},
 	}
@@ -388,22 +642,22 @@ func TestUserGroupMembersEntry_Validate(t *testing.T) {
 	}{
 		{
 			name:      "invalid subspace id returns error",
-			entry:     types.NewUserGroupMemberEntry(0, 1, ""),
+			entry:     types.NewUserGroupMemberEntry(0, 1, "", nil),
 			shouldErr: true,
 		},
 		{
 			name:      "invalid group id returns error",
-			entry:     types.NewUserGroupMemberEntry(1, 0, ""),
+			entry:     types.NewUserGroupMemberEntry(1, 0, "", nil),
 			shouldErr: true,
 		},
 		{
 			name:      "invalid member returns error",
-			entry:     types.NewUserGroupMemberEntry(1, 1, ""),
+			entry:     types.NewUserGroupMemberEntry(1, 1, "", nil),
 			shouldErr: true,
 		},
 		{
 			name:      "valid entry returns no error",
-			entry:     types.NewUserGroupMemberEntry(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd"),
+			entry:     types.NewUserGroupMemberEntry(1, 1, "cosmos15p3m7a93luselt80ffzpf4jwtn9ama34ray0nd", nil),
 			shouldErr: false,
 		},
 	}
@@ -17,6 +17,10 @@ type SubspacesHooks interface {
 	AfterSubspaceSaved(ctx sdk.Context, subspaceID uint64)   // Must be called when a subspace is saved
 	AfterSubspaceDeleted(ctx sdk.Context, subspaceID uint64) // Must be called when a subspace is deleted
 
+	AfterSubspaceOwnerTransferRequestSaved(ctx sdk.Context, subspaceID uint64)                        // Must be called when a subspace owner transfer request is saved
+	AfterSubspaceOwnerTransferRequestDeleted(ctx sdk.Context, subspaceID uint64)                      // Must be called when a subspace owner transfer request is deleted
+	AfterSubspaceOwnerTransferred(ctx sdk.Context, subspaceID uint64, previousOwner, newOwner string) // Must be called when a subspace ownership is transferred
+
 	AfterSubspaceSectionSaved(ctx sdk.Context, subspaceID uint64, sectionID uint32)   // Must be called when a subspace section is saved
 	AfterSubspaceSectionDeleted(ctx sdk.Context, subspaceID uint64, sectionID uint32) // Must be called when a subspace section is deleted
 
@@ -52,6 +56,27 @@ func (h MultiSubspacesHooks) AfterSubspaceDeleted(ctx sdk.Context, subspaceID ui
 	}
 }
 
+// AfterSubspaceOwnerTransferRequestSaved implements SubspacesHooks
+func (h MultiSubspacesHooks) AfterSubspaceOwnerTransferRequestSaved(ctx sdk.Context, subspaceID uint64) {
+	for _, hook := range h {
+		hook.AfterSubspaceOwnerTransferRequestSaved(ctx, subspaceID)
+	}
+}
+
+// AfterSubspaceOwnerTransferRequestDeleted implements SubspacesHooks
+func (h MultiSubspacesHooks) AfterSubspaceOwnerTransferRequestDeleted(ctx sdk.Context, subspaceID uint64) {
+	for _, hook := range h {
+		hook.AfterSubspaceOwnerTransferRequestDeleted(ctx, subspaceID)
+	}
+}
+
+// AfterSubspaceOwnerTransferred implements SubspacesHooks
+func (h MultiSubspacesHooks) AfterSubspaceOwnerTransferred(ctx sdk.Context, subspaceID uint64, previousOwner, newOwner string) {
+	for _, hook := range h {
+		hook.AfterSubspaceOwnerTransferred(ctx, subspaceID, previousOwner, newOwner)
+	}
+}
+
 // AfterSubspaceSectionSaved implements SubspacesHooks
 func (h MultiSubspacesHooks) AfterSubspaceSectionSaved(ctx sdk.Context, subspaceID uint64, sectionID uint32) {
 	for _, hook := range h {
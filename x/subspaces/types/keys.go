@@ -7,6 +7,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 // DONTCOVER
@@ -23,6 +24,7 @@ const (
 	ActionCreateSection           = "create_section"
 	ActionEditSection             = "edit_section"
 	ActionMoveSection             = "move_section"
+	ActionSetSectionVisibility    = "set_section_visibility"
 	ActionDeleteSection           = "delete_section"
 	ActionCreateUserGroup         = "create_user_group"
 	ActionEditUserGroup           = "edit_user_group"
@@ -34,9 +36,37 @@ const (
 	ActionSetUserPermissions      = "set_user_permissions"
 	ActionUpdateSubspaceFeeTokens = "update_subspace_fee_tokens"
 
+	ActionArchiveSubspace   = "archive_subspace"
+	ActionUnarchiveSubspace = "unarchive_subspace"
+
+	ActionRequestSubspaceOwnerTransfer = "request_subspace_owner_transfer"
+	ActionCancelSubspaceOwnerTransfer  = "cancel_subspace_owner_transfer"
+	ActionAcceptSubspaceOwnerTransfer  = "accept_subspace_owner_transfer"
+	ActionRefuseSubspaceOwnerTransfer  = "refuse_subspace_owner_transfer"
+
+	ActionCreateInvite            = "create_invite"
+	ActionDeleteInvite            = "delete_invite"
+	ActionRedeemInvite            = "redeem_invite"
+	ActionApplyToGroup            = "apply_to_group"
+	ActionApproveGroupApplication = "approve_group_application"
+	ActionRejectGroupApplication  = "reject_group_application"
+
+	ActionBanUser   = "ban_user"
+	ActionUnbanUser = "unban_user"
+
+	ActionSetRateLimit    = "set_rate_limit"
+	ActionRemoveRateLimit = "remove_rate_limit"
+
+	ActionSetFeeTokensConfig    = "set_fee_tokens_config"
+	ActionRemoveFeeTokensConfig = "remove_fee_tokens_config"
+
 	ActionGrantTreasuryAuthorization  = "grant_treasury_authorization"
 	ActionRevokeTreasuryAuthorization = "revoke_treasury_authorization"
 
+	ActionSetTreasuryVotingConfig = "set_treasury_voting_config"
+	ActionSubmitTreasuryProposal  = "submit_treasury_proposal"
+	ActionVoteTreasuryProposal    = "vote_treasury_proposal"
+
 	ActionGrantAllowance  = "grant_allowance"
 	ActionRevokeAllowance = "revoke_allowance"
 
@@ -55,6 +85,31 @@ var (
 	UserAllowancePrefix          = []byte{0x08}
 	GroupAllowancePrefix         = []byte{0x09}
 	ExpiringAllowanceQueuePrefix = []byte{0x10}
+
+	SubspaceOwnerTransferRequestPrefix = []byte{0x11}
+	ExpiringUserPermissionQueuePrefix  = []byte{0x12}
+	ExpiringGroupMemberQueuePrefix     = []byte{0x13}
+
+	GroupInvitePrefix      = []byte{0x14}
+	GroupApplicationPrefix = []byte{0x15}
+
+	SubspaceBanPrefix              = []byte{0x16}
+	ExpiringSubspaceBanQueuePrefix = []byte{0x17}
+
+	RateLimitPrefix        = []byte{0x18}
+	RateLimitCounterPrefix = []byte{0x19}
+
+	TreasuryVotingConfigPrefix        = []byte{0x1A}
+	TreasuryProposalIDPrefix          = []byte{0x1B}
+	TreasuryProposalPrefix            = []byte{0x1C}
+	TreasuryVotePrefix                = []byte{0x1D}
+	ActiveTreasuryProposalQueuePrefix = []byte{0x1E}
+
+	AllowanceUsagePrefix = []byte{0x1F}
+
+	FeeTokensConfigPrefix = []byte{0x20}
+
+	ExpiringGroupInviteQueuePrefix = []byte{0x21}
 )
 
 // GetSubspaceIDBytes returns the byte representation of the subspaceID
@@ -74,6 +129,11 @@ func SubspaceStoreKey(subspaceID uint64) []byte {
 	return append(SubspacePrefix, GetSubspaceIDBytes(subspaceID)...)
 }
 
+// SubspaceOwnerTransferRequestStoreKey returns the store key used to store the owner transfer request for the given subspace
+func SubspaceOwnerTransferRequestStoreKey(subspaceID uint64) []byte {
+	return append(SubspaceOwnerTransferRequestPrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetSectionIDBytes returns the byte representation of the sectionID
@@ -176,6 +236,130 @@ func SplitGroupMemberStoreKey(key []byte) (subspaceID uint64, groupID uint32, us
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// SubspaceGroupInvitesPrefix returns the prefix used to store the group invites for the subspace having the given id
+func SubspaceGroupInvitesPrefix(subspaceID uint64) []byte {
+	return append(GroupInvitePrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
+// GroupInviteStoreKey returns the key used to store the group invite associated with the given secret hash
+// inside the provided subspace
+func GroupInviteStoreKey(subspaceID uint64, secretHash string) []byte {
+	return append(SubspaceGroupInvitesPrefix(subspaceID), []byte(secretHash)...)
+}
+
+// SubspaceGroupApplicationsPrefix returns the prefix used to store the group applications for the subspace having the given id
+func SubspaceGroupApplicationsPrefix(subspaceID uint64) []byte {
+	return append(GroupApplicationPrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
+// GroupApplicationsPrefix returns the prefix used to store all the applications to join the given group
+func GroupApplicationsPrefix(subspaceID uint64, groupID uint32) []byte {
+	return append(SubspaceGroupApplicationsPrefix(subspaceID), GetGroupIDBytes(groupID)...)
+}
+
+// GroupApplicationStoreKey returns the key used to store the application of the given user to join
+// the specified group inside the provided subspace
+func GroupApplicationStoreKey(subspaceID uint64, groupID uint32, applicant string) []byte {
+	return append(GroupApplicationsPrefix(subspaceID, groupID), GetAddressBytes(applicant)...)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SubspaceBansPrefix returns the prefix used to store the bans of the subspace having the given id
+func SubspaceBansPrefix(subspaceID uint64) []byte {
+	return append(SubspaceBanPrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
+// SubspaceBanStoreKey returns the key used to store the ban of the given user from the provided subspace
+func SubspaceBanStoreKey(subspaceID uint64, user string) []byte {
+	return append(SubspaceBansPrefix(subspaceID), GetAddressBytes(user)...)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetMsgTypeURLBytes returns the length-prefixed byte representation of the given message type url
+func GetMsgTypeURLBytes(msgTypeURL string) []byte {
+	return address.MustLengthPrefix([]byte(msgTypeURL))
+}
+
+// SubspaceRateLimitsPrefix returns the prefix used to store the rate limits of the subspace having the given id
+func SubspaceRateLimitsPrefix(subspaceID uint64) []byte {
+	return append(RateLimitPrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
+// RateLimitStoreKey returns the key used to store the rate limit of the given message type inside the provided subspace
+func RateLimitStoreKey(subspaceID uint64, msgTypeURL string) []byte {
+	return append(SubspaceRateLimitsPrefix(subspaceID), GetMsgTypeURLBytes(msgTypeURL)...)
+}
+
+// SubspaceRateLimitCountersPrefix returns the prefix used to store the rate limit counters of the subspace having the given id
+func SubspaceRateLimitCountersPrefix(subspaceID uint64) []byte {
+	return append(RateLimitCounterPrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
+// RateLimitCountersPrefix returns the prefix used to store all the counters of the rate limit
+// associated to the given message type inside the provided subspace
+func RateLimitCountersPrefix(subspaceID uint64, msgTypeURL string) []byte {
+	return append(SubspaceRateLimitCountersPrefix(subspaceID), GetMsgTypeURLBytes(msgTypeURL)...)
+}
+
+// RateLimitCounterStoreKey returns the key used to store the rate limit counter of the given user
+// for the provided message type inside the specified subspace
+func RateLimitCounterStoreKey(subspaceID uint64, msgTypeURL string, user string) []byte {
+	return append(RateLimitCountersPrefix(subspaceID, msgTypeURL), GetAddressBytes(user)...)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// TreasuryVotingConfigStoreKey returns the key used to store the treasury voting config of the given subspace
+func TreasuryVotingConfigStoreKey(subspaceID uint64) []byte {
+	return append(TreasuryVotingConfigPrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
+// GetTreasuryProposalIDBytes returns the byte representation of the given treasury proposal id
+func GetTreasuryProposalIDBytes(proposalID uint64) []byte {
+	proposalIDBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(proposalIDBz, proposalID)
+	return proposalIDBz
+}
+
+// GetTreasuryProposalIDFromBytes returns the treasury proposal id in uint64 format from the given byte array
+func GetTreasuryProposalIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// NextTreasuryProposalIDStoreKey returns the key used to store the next treasury proposal id of the given subspace
+func NextTreasuryProposalIDStoreKey(subspaceID uint64) []byte {
+	return append(TreasuryProposalIDPrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
+// SubspaceTreasuryProposalsPrefix returns the prefix used to store the treasury proposals of the given subspace
+func SubspaceTreasuryProposalsPrefix(subspaceID uint64) []byte {
+	return append(TreasuryProposalPrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
+// TreasuryProposalStoreKey returns the key used to store the treasury proposal having the given id
+func TreasuryProposalStoreKey(subspaceID uint64, proposalID uint64) []byte {
+	return append(SubspaceTreasuryProposalsPrefix(subspaceID), GetTreasuryProposalIDBytes(proposalID)...)
+}
+
+// SubspaceTreasuryVotesPrefix returns the prefix used to store the treasury proposal votes of the given subspace
+func SubspaceTreasuryVotesPrefix(subspaceID uint64) []byte {
+	return append(TreasuryVotePrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
+// TreasuryProposalVotesPrefix returns the prefix used to store all the votes of the given treasury proposal
+func TreasuryProposalVotesPrefix(subspaceID uint64, proposalID uint64) []byte {
+	return append(SubspaceTreasuryVotesPrefix(subspaceID), GetTreasuryProposalIDBytes(proposalID)...)
+}
+
+// TreasuryVoteStoreKey returns the key used to store the vote of the given voter on the specified treasury proposal
+func TreasuryVoteStoreKey(subspaceID uint64, proposalID uint64, voter string) []byte {
+	return append(TreasuryProposalVotesPrefix(subspaceID, proposalID), GetAddressBytes(voter)...)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 var (
 	lenUserPermissionPrefix = len(UserPermissionsStorePrefix)
 	lenSectionID            = len(GetSectionIDBytes(1))
@@ -242,6 +426,22 @@ func GroupAllowanceKey(subspaceID uint64, groupID uint32) []byte {
 	return append(SubspaceGroupAllowancePrefix(subspaceID), GetGroupIDBytes(groupID)...)
 }
 
+// SubspaceAllowanceUsagesPrefix returns the prefix used to store the allowance usages of the given subspace
+func SubspaceAllowanceUsagesPrefix(subspaceID uint64) []byte {
+	return append(AllowanceUsagePrefix, GetSubspaceIDBytes(subspaceID)...)
+}
+
+// GroupAllowanceUsagesPrefix returns the prefix used to store the usages of the allowance granted to the given group.
+// User allowances usages are stored using 0 as the group id
+func GroupAllowanceUsagesPrefix(subspaceID uint64, groupID uint32) []byte {
+	return append(SubspaceAllowanceUsagesPrefix(subspaceID), GetGroupIDBytes(groupID)...)
+}
+
+// AllowanceUsageStoreKey returns the key used to store the usage of the allowance made by the given grantee
+func AllowanceUsageStoreKey(subspaceID uint64, groupID uint32, grantee string) []byte {
+	return append(GroupAllowanceUsagesPrefix(subspaceID, groupID), GetAddressBytes(grantee)...)
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 var (
@@ -271,3 +471,168 @@ func ParseAllowanceKeyFromExpiringKey(key []byte) []byte {
 
 	return key[lenExpiringAllowanceTimePrefix:]
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var (
+	lenExpiringUserPermissionQueuePrefix = len(ExpiringUserPermissionQueuePrefix)
+	lenExpiringUserPermissionTimePrefix  = lenExpiringUserPermissionQueuePrefix + len(sdk.FormatTimeBytes(time.Now()))
+
+	lenExpiringGroupMemberQueuePrefix = len(ExpiringGroupMemberQueuePrefix)
+	lenExpiringGroupMemberTimePrefix  = lenExpiringGroupMemberQueuePrefix + len(sdk.FormatTimeBytes(time.Now()))
+
+	lenExpiringSubspaceBanQueuePrefix = len(ExpiringSubspaceBanQueuePrefix)
+	lenExpiringSubspaceBanTimePrefix  = lenExpiringSubspaceBanQueuePrefix + len(sdk.FormatTimeBytes(time.Now()))
+
+	lenExpiringGroupInviteQueuePrefix = len(ExpiringGroupInviteQueuePrefix)
+	lenExpiringGroupInviteTimePrefix  = lenExpiringGroupInviteQueuePrefix + len(sdk.FormatTimeBytes(time.Now()))
+)
+
+// ExpiringUserPermissionTimePrefix gets the expiring user permission prefix by expiration time
+func ExpiringUserPermissionTimePrefix(expiration *time.Time) []byte {
+	return append(ExpiringUserPermissionQueuePrefix, sdk.FormatTimeBytes(*expiration)...)
+}
+
+// ExpiringUserPermissionKey returns the key used to store the user permission to the expiring queue
+func ExpiringUserPermissionKey(expiration *time.Time, key []byte) []byte {
+	return append(ExpiringUserPermissionTimePrefix(expiration), key...)
+}
+
+// ParseUserPermissionKeyFromExpiringKey parses the user permission key from the expiring key
+func ParseUserPermissionKeyFromExpiringKey(key []byte) []byte {
+	if len(key) < lenExpiringUserPermissionTimePrefix {
+		panic(fmt.Errorf("invalid key length; expected min %d got %d", lenExpiringUserPermissionTimePrefix, len(key)))
+	}
+
+	if !bytes.Equal(key[:lenExpiringUserPermissionQueuePrefix], ExpiringUserPermissionQueuePrefix) {
+		panic(fmt.Errorf("invalid key prefix; expected prefix %X prefix %X", ExpiringUserPermissionQueuePrefix, key[:lenExpiringUserPermissionQueuePrefix]))
+	}
+
+	return key[lenExpiringUserPermissionTimePrefix:]
+}
+
+// ExpiringGroupMemberTimePrefix gets the expiring group member prefix by expiration time
+func ExpiringGroupMemberTimePrefix(expiration *time.Time) []byte {
+	return append(ExpiringGroupMemberQueuePrefix, sdk.FormatTimeBytes(*expiration)...)
+}
+
+// ExpiringGroupMemberKey returns the key used to store the group membership to the expiring queue
+func ExpiringGroupMemberKey(expiration *time.Time, key []byte) []byte {
+	return append(ExpiringGroupMemberTimePrefix(expiration), key...)
+}
+
+// ParseGroupMemberKeyFromExpiringKey parses the group member key from the expiring key
+func ParseGroupMemberKeyFromExpiringKey(key []byte) []byte {
+	if len(key) < lenExpiringGroupMemberTimePrefix {
+		panic(fmt.Errorf("invalid key length; expected min %d got %d", lenExpiringGroupMemberTimePrefix, len(key)))
+	}
+
+	if !bytes.Equal(key[:lenExpiringGroupMemberQueuePrefix], ExpiringGroupMemberQueuePrefix) {
+		panic(fmt.Errorf("invalid key prefix; expected prefix %X prefix %X", ExpiringGroupMemberQueuePrefix, key[:lenExpiringGroupMemberQueuePrefix]))
+	}
+
+	return key[lenExpiringGroupMemberTimePrefix:]
+}
+
+// ExpiringSubspaceBanTimePrefix gets the expiring subspace ban prefix by expiration time
+func ExpiringSubspaceBanTimePrefix(expiration *time.Time) []byte {
+	return append(ExpiringSubspaceBanQueuePrefix, sdk.FormatTimeBytes(*expiration)...)
+}
+
+// ExpiringSubspaceBanKey returns the key used to store the subspace ban to the expiring queue
+func ExpiringSubspaceBanKey(expiration *time.Time, key []byte) []byte {
+	return append(ExpiringSubspaceBanTimePrefix(expiration), key...)
+}
+
+// ParseSubspaceBanKeyFromExpiringKey parses the subspace ban key from the expiring key
+func ParseSubspaceBanKeyFromExpiringKey(key []byte) []byte {
+	if len(key) < lenExpiringSubspaceBanTimePrefix {
+		panic(fmt.Errorf("invalid key length; expected min %d got %d", lenExpiringSubspaceBanTimePrefix, len(key)))
+	}
+
+	if !bytes.Equal(key[:lenExpiringSubspaceBanQueuePrefix], ExpiringSubspaceBanQueuePrefix) {
+		panic(fmt.Errorf("invalid key prefix; expected prefix %X prefix %X", ExpiringSubspaceBanQueuePrefix, key[:lenExpiringSubspaceBanQueuePrefix]))
+	}
+
+	return key[lenExpiringSubspaceBanTimePrefix:]
+}
+
+// ExpiringGroupInviteTimePrefix gets the expiring group invite prefix by expiration time
+func ExpiringGroupInviteTimePrefix(expiration *time.Time) []byte {
+	return append(ExpiringGroupInviteQueuePrefix, sdk.FormatTimeBytes(*expiration)...)
+}
+
+// ExpiringGroupInviteKey returns the key used to store the group invite to the expiring queue
+func ExpiringGroupInviteKey(expiration *time.Time, key []byte) []byte {
+	return append(ExpiringGroupInviteTimePrefix(expiration), key...)
+}
+
+// ParseGroupInviteKeyFromExpiringKey parses the group invite key from the expiring key
+func ParseGroupInviteKeyFromExpiringKey(key []byte) []byte {
+	if len(key) < lenExpiringGroupInviteTimePrefix {
+		panic(fmt.Errorf("invalid key length; expected min %d got %d", lenExpiringGroupInviteTimePrefix, len(key)))
+	}
+
+	if !bytes.Equal(key[:lenExpiringGroupInviteQueuePrefix], ExpiringGroupInviteQueuePrefix) {
+		panic(fmt.Errorf("invalid key prefix; expected prefix %X prefix %X", ExpiringGroupInviteQueuePrefix, key[:lenExpiringGroupInviteQueuePrefix]))
+	}
+
+	return key[lenExpiringGroupInviteTimePrefix:]
+}
+
+var (
+	lenActiveTreasuryProposalQueuePrefix = len(ActiveTreasuryProposalQueuePrefix)
+	lenActiveTreasuryProposalTimePrefix  = lenActiveTreasuryProposalQueuePrefix + len(sdk.FormatTimeBytes(time.Now()))
+)
+
+// ActiveTreasuryProposalTimePrefix gets the active treasury proposal queue prefix by voting end time
+func ActiveTreasuryProposalTimePrefix(endTime time.Time) []byte {
+	return append(ActiveTreasuryProposalQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// ActiveTreasuryProposalKey returns the key used to store the treasury proposal to the active queue
+func ActiveTreasuryProposalKey(endTime time.Time, key []byte) []byte {
+	return append(ActiveTreasuryProposalTimePrefix(endTime), key...)
+}
+
+// ParseTreasuryProposalKeyFromActiveKey parses the treasury proposal key from the active queue key
+func ParseTreasuryProposalKeyFromActiveKey(key []byte) []byte {
+	if len(key) < lenActiveTreasuryProposalTimePrefix {
+		panic(fmt.Errorf("invalid key length; expected min %d got %d", lenActiveTreasuryProposalTimePrefix, len(key)))
+	}
+
+	if !bytes.Equal(key[:lenActiveTreasuryProposalQueuePrefix], ActiveTreasuryProposalQueuePrefix) {
+		panic(fmt.Errorf("invalid key prefix; expected prefix %X prefix %X", ActiveTreasuryProposalQueuePrefix, key[:lenActiveTreasuryProposalQueuePrefix]))
+	}
+
+	return key[lenActiveTreasuryProposalTimePrefix:]
+}
+
+// GetGroupMemberValue returns the value used to store a group membership having the given expiration time.
+// Memberships without an expiration are stored using the 0x01 value for backward compatibility
+func GetGroupMemberValue(expiration *time.Time) []byte {
+	if expiration == nil {
+		return []byte{0x01}
+	}
+	return sdk.FormatTimeBytes(*expiration)
+}
+
+// GetGroupMemberExpirationFromValue returns the expiration time stored inside the given group membership value
+func GetGroupMemberExpirationFromValue(bz []byte) *time.Time {
+	if bytes.Equal(bz, []byte{0x01}) {
+		return nil
+	}
+
+	expiration, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return &expiration
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// FeeTokensConfigStoreKey returns the key used to store the fee tokens config of the given subspace
+func FeeTokensConfigStoreKey(subspaceID uint64) []byte {
+	return append(FeeTokensConfigPrefix, GetSubspaceIDBytes(subspaceID)...)
+}
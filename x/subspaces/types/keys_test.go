@@ -124,3 +124,231 @@ func TestParseAllowanceKeyFromExpiringKey(t *testing.T) {
 		})
 	}
 }
+
+func TestParseUserPermissionKeyFromExpiringKey(t *testing.T) {
+	expiration := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name        string
+		key         []byte
+		shouldPanic bool
+		expKey      []byte
+	}{
+		{
+			name:        "invalid length panics",
+			key:         []byte{},
+			shouldPanic: true,
+		},
+		{
+			name:        "invalid prefix panics",
+			key:         types.ExpiringAllowanceKey(&expiration, types.UserAllowanceKey(1, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp")),
+			shouldPanic: true,
+		},
+		{
+			name:   "valid key return proper data",
+			key:    types.ExpiringUserPermissionKey(&expiration, types.UserPermissionStoreKey(1, 2, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp")),
+			expKey: types.UserPermissionStoreKey(1, 2, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.shouldPanic {
+				require.Panics(t, func() { types.ParseUserPermissionKeyFromExpiringKey(tc.key) })
+			} else {
+				permissionKey := types.ParseUserPermissionKeyFromExpiringKey(tc.key)
+				require.Equal(t, tc.expKey, permissionKey)
+			}
+		})
+	}
+}
+
+func TestParseGroupMemberKeyFromExpiringKey(t *testing.T) {
+	expiration := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name        string
+		key         []byte
+		shouldPanic bool
+		expKey      []byte
+	}{
+		{
+			name:        "invalid length panics",
+			key:         []byte{},
+			shouldPanic: true,
+		},
+		{
+			name:        "invalid prefix panics",
+			key:         types.ExpiringUserPermissionKey(&expiration, types.UserPermissionStoreKey(1, 2, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp")),
+			shouldPanic: true,
+		},
+		{
+			name:   "valid key return proper data",
+			key:    types.ExpiringGroupMemberKey(&expiration, types.GroupMemberStoreKey(1, 2, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp")),
+			expKey: types.GroupMemberStoreKey(1, 2, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.shouldPanic {
+				require.Panics(t, func() { types.ParseGroupMemberKeyFromExpiringKey(tc.key) })
+			} else {
+				memberKey := types.ParseGroupMemberKeyFromExpiringKey(tc.key)
+				require.Equal(t, tc.expKey, memberKey)
+			}
+		})
+	}
+}
+
+func TestGetGroupMemberExpirationFromValue(t *testing.T) {
+	expiration := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name          string
+		value         []byte
+		shouldPanic   bool
+		expExpiration *time.Time
+	}{
+		{
+			name:        "invalid value panics",
+			value:       []byte("invalid"),
+			shouldPanic: true,
+		},
+		{
+			name:          "membership without expiration returns nil",
+			value:         types.GetGroupMemberValue(nil),
+			expExpiration: nil,
+		},
+		{
+			name:          "membership with expiration returns proper data",
+			value:         types.GetGroupMemberValue(&expiration),
+			expExpiration: &expiration,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.shouldPanic {
+				require.Panics(t, func() { types.GetGroupMemberExpirationFromValue(tc.value) })
+			} else {
+				expirationTime := types.GetGroupMemberExpirationFromValue(tc.value)
+				require.Equal(t, tc.expExpiration, expirationTime)
+			}
+		})
+	}
+}
+
+func TestParseSubspaceBanKeyFromExpiringKey(t *testing.T) {
+	expiration := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name        string
+		key         []byte
+		shouldPanic bool
+		expKey      []byte
+	}{
+		{
+			name:        "invalid length panics",
+			key:         []byte{},
+			shouldPanic: true,
+		},
+		{
+			name:        "invalid prefix panics",
+			key:         types.ExpiringGroupMemberKey(&expiration, types.GroupMemberStoreKey(1, 2, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp")),
+			shouldPanic: true,
+		},
+		{
+			name:   "valid key return proper data",
+			key:    types.ExpiringSubspaceBanKey(&expiration, types.SubspaceBanStoreKey(1, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp")),
+			expKey: types.SubspaceBanStoreKey(1, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.shouldPanic {
+				require.Panics(t, func() { types.ParseSubspaceBanKeyFromExpiringKey(tc.key) })
+			} else {
+				banKey := types.ParseSubspaceBanKeyFromExpiringKey(tc.key)
+				require.Equal(t, tc.expKey, banKey)
+			}
+		})
+	}
+}
+
+func TestParseGroupInviteKeyFromExpiringKey(t *testing.T) {
+	expiration := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name        string
+		key         []byte
+		shouldPanic bool
+		expKey      []byte
+	}{
+		{
+			name:        "invalid length panics",
+			key:         []byte{},
+			shouldPanic: true,
+		},
+		{
+			name:        "invalid prefix panics",
+			key:         types.ExpiringSubspaceBanKey(&expiration, types.SubspaceBanStoreKey(1, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp")),
+			shouldPanic: true,
+		},
+		{
+			name:   "valid key return proper data",
+			key:    types.ExpiringGroupInviteKey(&expiration, types.GroupInviteStoreKey(1, types.GetInviteSecretHash("secret"))),
+			expKey: types.GroupInviteStoreKey(1, types.GetInviteSecretHash("secret")),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.shouldPanic {
+				require.Panics(t, func() { types.ParseGroupInviteKeyFromExpiringKey(tc.key) })
+			} else {
+				inviteKey := types.ParseGroupInviteKeyFromExpiringKey(tc.key)
+				require.Equal(t, tc.expKey, inviteKey)
+			}
+		})
+	}
+}
+
+func TestParseTreasuryProposalKeyFromActiveKey(t *testing.T) {
+	endTime := time.Date(2100, 7, 7, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name        string
+		key         []byte
+		shouldPanic bool
+		expKey      []byte
+	}{
+		{
+			name:        "invalid length panics",
+			key:         []byte{},
+			shouldPanic: true,
+		},
+		{
+			name:        "invalid prefix panics",
+			key:         types.ExpiringSubspaceBanKey(&endTime, types.SubspaceBanStoreKey(1, "cosmos1vlknheepy5454pw4j6x53yeg57l7ec39rf8ffp")),
+			shouldPanic: true,
+		},
+		{
+			name:   "valid key return proper data",
+			key:    types.ActiveTreasuryProposalKey(endTime, types.TreasuryProposalStoreKey(1, 2)),
+			expKey: types.TreasuryProposalStoreKey(1, 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.shouldPanic {
+				require.Panics(t, func() { types.ParseTreasuryProposalKeyFromActiveKey(tc.key) })
+			} else {
+				proposalKey := types.ParseTreasuryProposalKeyFromActiveKey(tc.key)
+				require.Equal(t, tc.expKey, proposalKey)
+			}
+		})
+	}
+}
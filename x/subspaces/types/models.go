@@ -1,11 +1,15 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -109,7 +113,7 @@ func (sub Subspace) Update(update SubspaceUpdate) Subspace {
 		update.Owner = sub.Owner
 	}
 
-	return NewSubspace(
+	updated := NewSubspace(
 		sub.ID,
 		update.Name,
 		update.Description,
@@ -119,6 +123,8 @@ func (sub Subspace) Update(update SubspaceUpdate) Subspace {
 		sub.CreationTime,
 		sub.AdditionalFeeTokens,
 	)
+	updated.Archived = sub.Archived
+	return updated
 }
 
 // SubspaceUpdate contains all the data that can be updated about a subspace.
@@ -155,7 +161,7 @@ func NewAdditionalFeeTokensUpdate(tokens ...sdk.Coin) AdditionalFeeTokensUpdate
 // Before storing the updated subspace, a validation with Validate() should
 // be performed.
 func (update AdditionalFeeTokensUpdate) Update(sub Subspace) Subspace {
-	return NewSubspace(
+	updated := NewSubspace(
 		sub.ID,
 		sub.Name,
 		sub.Description,
@@ -165,6 +171,42 @@ func (update AdditionalFeeTokensUpdate) Update(sub Subspace) Subspace {
 		sub.CreationTime,
 		update.AdditionalFeeTokens,
 	)
+	updated.Archived = sub.Archived
+	return updated
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewSubspaceOwnerTransferRequest returns a new SubspaceOwnerTransferRequest instance
+func NewSubspaceOwnerTransferRequest(subspaceID uint64, receiver string, sender string) SubspaceOwnerTransferRequest {
+	return SubspaceOwnerTransferRequest{
+		SubspaceID: subspaceID,
+		Receiver:   receiver,
+		Sender:     sender,
+	}
+}
+
+// Validate checks the request validity
+func (request SubspaceOwnerTransferRequest) Validate() error {
+	if request.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", request.SubspaceID)
+	}
+
+	if request.Sender == request.Receiver {
+		return fmt.Errorf("receiver cannot be the same as sender")
+	}
+
+	_, err := sdk.AccAddressFromBech32(request.Sender)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %s", request.Sender)
+	}
+
+	_, err = sdk.AccAddressFromBech32(request.Receiver)
+	if err != nil {
+		return fmt.Errorf("invalid receiver address: %s", request.Receiver)
+	}
+
+	return nil
 }
 
 // --------------------------------------------------------------------------------------------------------------------
@@ -191,13 +233,14 @@ func ParseSectionID(value string) (uint32, error) {
 }
 
 // NewSection returns a new Section instance
-func NewSection(subspaceID uint64, id uint32, parentID uint32, name string, description string) Section {
+func NewSection(subspaceID uint64, id uint32, parentID uint32, name string, description string, visibility SectionVisibility) Section {
 	return Section{
 		SubspaceID:  subspaceID,
 		ID:          id,
 		ParentID:    parentID,
 		Name:        name,
 		Description: description,
+		Visibility:  visibility,
 	}
 }
 
@@ -209,6 +252,7 @@ func DefaultSection(subspaceID uint64) Section {
 		RootSectionID,
 		"Default section",
 		"This is the default subspace section",
+		SECTION_VISIBILITY_PUBLIC,
 	)
 }
 
@@ -226,6 +270,10 @@ func (s Section) Validate() error {
 		return fmt.Errorf("invalid section name: %s", s.Name)
 	}
 
+	if !IsValidSectionVisibility(s.Visibility) {
+		return fmt.Errorf("invalid section visibility: %s", s.Visibility)
+	}
+
 	return nil
 }
 
@@ -247,6 +295,7 @@ func (s Section) Update(update SectionUpdate) Section {
 		s.ParentID,
 		update.Name,
 		update.Description,
+		s.Visibility,
 	)
 }
 
@@ -265,6 +314,28 @@ func NewSectionUpdate(name string, description string) SectionUpdate {
 	}
 }
 
+// IsValidSectionVisibility tells whether the given visibility is a valid section visibility
+func IsValidSectionVisibility(visibility SectionVisibility) bool {
+	_, ok := SectionVisibility_name[int32(visibility)]
+	return ok
+}
+
+// ParseSectionVisibility parses the given value as a section visibility, returning an error if it's invalid.
+// The value can be either the full visibility name (eg. SECTION_VISIBILITY_MEMBERS_ONLY) or its short version
+// (eg. members-only).
+func ParseSectionVisibility(value string) (SectionVisibility, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(value), "-", "_"))
+	if !strings.HasPrefix(name, "SECTION_VISIBILITY_") {
+		name = "SECTION_VISIBILITY_" + name
+	}
+
+	visibility, ok := SectionVisibility_value[name]
+	if !ok {
+		return SECTION_VISIBILITY_PUBLIC, fmt.Errorf("invalid section visibility: %s", value)
+	}
+	return SectionVisibility(visibility), nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // ParseGroupID parses the given value as a group id, returning an error if it's invalid
@@ -354,15 +425,141 @@ func NewGroupUpdate(name, description string) GroupUpdate {
 	}
 }
 
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetInvitePrivKey returns the private key deterministically derived from the given invite secret.
+// This key is used to prove the knowledge of the secret when redeeming an invite, without revealing it
+func GetInvitePrivKey(secret string) *secp256k1.PrivKey {
+	return secp256k1.GenPrivKeyFromSecret([]byte(secret))
+}
+
+// GetInvitePubKeyHash returns the hex-encoded SHA-256 hash of the given invite public key bytes
+func GetInvitePubKeyHash(pubKey []byte) string {
+	hash := sha256.Sum256(pubKey)
+	return hex.EncodeToString(hash[:])
+}
+
+// GetInviteSecretHash returns the hex-encoded SHA-256 hash of the public key derived from the given invite secret
+func GetInviteSecretHash(secret string) string {
+	return GetInvitePubKeyHash(GetInvitePrivKey(secret).PubKey().Bytes())
+}
+
+// GetInviteRedemptionSignBytes returns the bytes that must be signed using the invite private key
+// in order to redeem an invite of the given subspace on behalf of the provided redeemer
+func GetInviteRedemptionSignBytes(chainID string, subspaceID uint64, redeemer string) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s", chainID, subspaceID, redeemer))
+}
+
+// SignInviteRedemption signs the redemption of the invite associated with the given secret on behalf of the
+// provided redeemer, returning the invite public key and the signature to be used inside a MsgRedeemInvite
+func SignInviteRedemption(secret string, chainID string, subspaceID uint64, redeemer string) (pubKey []byte, signature []byte, err error) {
+	privKey := GetInvitePrivKey(secret)
+	signature, err = privKey.Sign(GetInviteRedemptionSignBytes(chainID, subspaceID, redeemer))
+	if err != nil {
+		return nil, nil, err
+	}
+	return privKey.PubKey().Bytes(), signature, nil
+}
+
+// IsValidInviteSecretHash tells whether the given value is a valid lowercase hex-encoded SHA-256 hash
+func IsValidInviteSecretHash(value string) bool {
+	bz, err := hex.DecodeString(value)
+	return err == nil && len(bz) == sha256.Size && hex.EncodeToString(bz) == value
+}
+
+// NewGroupInvite returns a new GroupInvite instance
+func NewGroupInvite(
+	subspaceID uint64, groupID uint32, secretHash string, maxUses uint32, uses uint32, expirationTime *time.Time, creator string,
+) GroupInvite {
+	return GroupInvite{
+		SubspaceID:     subspaceID,
+		GroupID:        groupID,
+		SecretHash:     secretHash,
+		MaxUses:        maxUses,
+		Uses:           uses,
+		ExpirationTime: expirationTime,
+		Creator:        creator,
+	}
+}
+
+// Validate implements fmt.Validator
+func (invite GroupInvite) Validate() error {
+	if invite.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", invite.SubspaceID)
+	}
+
+	if invite.GroupID == 0 {
+		return fmt.Errorf("invalid group id: %d", invite.GroupID)
+	}
+
+	if !IsValidInviteSecretHash(invite.SecretHash) {
+		return fmt.Errorf("invalid secret hash: %s", invite.SecretHash)
+	}
+
+	if invite.MaxUses == 0 {
+		return fmt.Errorf("invalid max uses: %d", invite.MaxUses)
+	}
+
+	if invite.Uses >= invite.MaxUses {
+		return fmt.Errorf("invalid uses: %d, max uses: %d", invite.Uses, invite.MaxUses)
+	}
+
+	_, err := sdk.AccAddressFromBech32(invite.Creator)
+	if err != nil {
+		return fmt.Errorf("invalid creator address: %s", err)
+	}
+
+	return nil
+}
+
+// IsExpired tells whether the invite has expired at the given time
+func (invite GroupInvite) IsExpired(blockTime time.Time) bool {
+	return invite.ExpirationTime != nil && !invite.ExpirationTime.After(blockTime)
+}
+
+// NewGroupApplication returns a new GroupApplication instance
+func NewGroupApplication(subspaceID uint64, groupID uint32, applicant string, message string, creationTime time.Time) GroupApplication {
+	return GroupApplication{
+		SubspaceID:   subspaceID,
+		GroupID:      groupID,
+		Applicant:    applicant,
+		Message:      message,
+		CreationTime: creationTime,
+	}
+}
+
+// Validate implements fmt.Validator
+func (application GroupApplication) Validate() error {
+	if application.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", application.SubspaceID)
+	}
+
+	if application.GroupID == 0 {
+		return fmt.Errorf("invalid group id: %d", application.GroupID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(application.Applicant)
+	if err != nil {
+		return fmt.Errorf("invalid applicant address: %s", err)
+	}
+
+	if application.CreationTime.IsZero() {
+		return fmt.Errorf("invalid application creation time: %s", application.CreationTime)
+	}
+
+	return nil
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // NewUserPermission returns a new UserPermission instance
-func NewUserPermission(subspaceID uint64, sectionID uint32, user string, permissions Permissions) UserPermission {
+func NewUserPermission(subspaceID uint64, sectionID uint32, user string, permissions Permissions, expirationTime *time.Time) UserPermission {
 	return UserPermission{
-		SubspaceID:  subspaceID,
-		SectionID:   sectionID,
-		User:        user,
-		Permissions: permissions,
+		SubspaceID:     subspaceID,
+		SectionID:      sectionID,
+		User:           user,
+		Permissions:    permissions,
+		ExpirationTime: expirationTime,
 	}
 }
 
@@ -383,3 +580,126 @@ func (p UserPermission) Validate() error {
 
 	return nil
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// NewSubspaceBan returns a new SubspaceBan instance
+func NewSubspaceBan(subspaceID uint64, user string, reason string, bannedBy string, expirationTime *time.Time) SubspaceBan {
+	return SubspaceBan{
+		SubspaceID:     subspaceID,
+		User:           user,
+		Reason:         reason,
+		BannedBy:       bannedBy,
+		ExpirationTime: expirationTime,
+	}
+}
+
+// Validate implements fmt.Validator
+func (ban SubspaceBan) Validate() error {
+	if ban.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", ban.SubspaceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(ban.User)
+	if err != nil {
+		return fmt.Errorf("invalid user address: %s", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(ban.BannedBy)
+	if err != nil {
+		return fmt.Errorf("invalid banned by address: %s", err)
+	}
+
+	if ban.User == ban.BannedBy {
+		return fmt.Errorf("user cannot ban themselves")
+	}
+
+	return nil
+}
+
+// IsExpired tells whether the ban has expired at the given time
+func (ban SubspaceBan) IsExpired(blockTime time.Time) bool {
+	return ban.ExpirationTime != nil && !ban.ExpirationTime.After(blockTime)
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// maxMsgTypeURLLength represents the maximum length of a message type url that can be rate limited
+const maxMsgTypeURLLength = 255
+
+// NewRateLimit returns a new RateLimit instance
+func NewRateLimit(subspaceID uint64, msgTypeURL string, maxMessages uint32, window time.Duration) RateLimit {
+	return RateLimit{
+		SubspaceID:  subspaceID,
+		MsgTypeURL:  msgTypeURL,
+		MaxMessages: maxMessages,
+		Window:      window,
+	}
+}
+
+// Validate implements fmt.Validator
+func (limit RateLimit) Validate() error {
+	if limit.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", limit.SubspaceID)
+	}
+
+	if !strings.HasPrefix(limit.MsgTypeURL, "/") || strings.TrimSpace(limit.MsgTypeURL) != limit.MsgTypeURL {
+		return fmt.Errorf("invalid message type url: %s", limit.MsgTypeURL)
+	}
+
+	if len(limit.MsgTypeURL) > maxMsgTypeURLLength {
+		return fmt.Errorf("message type url cannot exceed %d characters", maxMsgTypeURLLength)
+	}
+
+	if limit.MaxMessages == 0 {
+		return fmt.Errorf("invalid max messages: %d", limit.MaxMessages)
+	}
+
+	if limit.Window <= 0 {
+		return fmt.Errorf("invalid window: %s", limit.Window)
+	}
+
+	return nil
+}
+
+// NewRateLimitCounter returns a new RateLimitCounter instance
+func NewRateLimitCounter(windowStart time.Time, currentCount uint32, previousCount uint32) RateLimitCounter {
+	return RateLimitCounter{
+		WindowStart:   windowStart,
+		CurrentCount:  currentCount,
+		PreviousCount: previousCount,
+	}
+}
+
+// Refresh returns a copy of the counter having as current window the one that contains the given time.
+// Windows are aligned to multiples of the given window duration, so that the counts of windows that
+// have already passed are either shifted to the previous count or discarded
+func (counter RateLimitCounter) Refresh(blockTime time.Time, window time.Duration) RateLimitCounter {
+	windowStart := blockTime.Truncate(window)
+	switch {
+	case windowStart.Equal(counter.WindowStart):
+		return counter
+	case windowStart.Equal(counter.WindowStart.Add(window)):
+		return NewRateLimitCounter(windowStart, 0, counter.CurrentCount)
+	default:
+		return NewRateLimitCounter(windowStart, 0, 0)
+	}
+}
+
+// EstimateCount returns the estimated number of messages that have been sent during the sliding window
+// ending at the given time. The count of the previous window is weighted based on how much it overlaps with
+// the sliding window. The counter should be refreshed using Refresh before calling this method
+func (counter RateLimitCounter) EstimateCount(blockTime time.Time, window time.Duration) uint64 {
+	overlap := window - blockTime.Sub(counter.WindowStart)
+	if overlap < 0 {
+		overlap = 0
+	}
+
+	previousCount := math.NewIntFromUint64(uint64(counter.PreviousCount)).MulRaw(int64(overlap)).QuoRaw(int64(window))
+	return previousCount.Uint64() + uint64(counter.CurrentCount)
+}
+
+// Increment returns a copy of the counter having the current count increased by one
+func (counter RateLimitCounter) Increment() RateLimitCounter {
+	return NewRateLimitCounter(counter.WindowStart, counter.CurrentCount+1, counter.PreviousCount)
+}
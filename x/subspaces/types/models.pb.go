@@ -13,6 +13,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -32,6 +33,144 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SectionVisibility contains the possible visibility settings that a section
+// can have
+type SectionVisibility int32
+
+const (
+	// Everyone will be able to read the section contents
+	SECTION_VISIBILITY_PUBLIC SectionVisibility = 0
+	// Only the subspace owner and the members of at least one of the subspace
+	// user groups will be able to read the section contents
+	SECTION_VISIBILITY_MEMBERS_ONLY SectionVisibility = 1
+	// Only the users having the read content permission within the section
+	// (either directly or through a user group) will be able to read the section
+	// contents
+	SECTION_VISIBILITY_GROUP_RESTRICTED SectionVisibility = 2
+)
+
+var SectionVisibility_name = map[int32]string{
+	0: "SECTION_VISIBILITY_PUBLIC",
+	1: "SECTION_VISIBILITY_MEMBERS_ONLY",
+	2: "SECTION_VISIBILITY_GROUP_RESTRICTED",
+}
+
+var SectionVisibility_value = map[string]int32{
+	"SECTION_VISIBILITY_PUBLIC":           0,
+	"SECTION_VISIBILITY_MEMBERS_ONLY":     1,
+	"SECTION_VISIBILITY_GROUP_RESTRICTED": 2,
+}
+
+func (x SectionVisibility) String() string {
+	return proto.EnumName(SectionVisibility_name, int32(x))
+}
+
+func (SectionVisibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{0}
+}
+
+// TreasuryProposalStatus represents the status of a treasury proposal
+type TreasuryProposalStatus int32
+
+const (
+	// The status has not been specified
+	TREASURY_PROPOSAL_STATUS_UNSPECIFIED TreasuryProposalStatus = 0
+	// The proposal is being voted
+	TREASURY_PROPOSAL_STATUS_VOTING TreasuryProposalStatus = 1
+	// The proposal reached the threshold and its messages have been executed
+	TREASURY_PROPOSAL_STATUS_EXECUTED TreasuryProposalStatus = 2
+	// The proposal reached the threshold but the execution of its messages
+	// failed
+	TREASURY_PROPOSAL_STATUS_FAILED TreasuryProposalStatus = 3
+	// The voting period ended before the proposal reached the threshold
+	TREASURY_PROPOSAL_STATUS_REJECTED TreasuryProposalStatus = 4
+)
+
+var TreasuryProposalStatus_name = map[int32]string{
+	0: "TREASURY_PROPOSAL_STATUS_UNSPECIFIED",
+	1: "TREASURY_PROPOSAL_STATUS_VOTING",
+	2: "TREASURY_PROPOSAL_STATUS_EXECUTED",
+	3: "TREASURY_PROPOSAL_STATUS_FAILED",
+	4: "TREASURY_PROPOSAL_STATUS_REJECTED",
+}
+
+var TreasuryProposalStatus_value = map[string]int32{
+	"TREASURY_PROPOSAL_STATUS_UNSPECIFIED": 0,
+	"TREASURY_PROPOSAL_STATUS_VOTING":      1,
+	"TREASURY_PROPOSAL_STATUS_EXECUTED":    2,
+	"TREASURY_PROPOSAL_STATUS_FAILED":      3,
+	"TREASURY_PROPOSAL_STATUS_REJECTED":    4,
+}
+
+func (x TreasuryProposalStatus) String() string {
+	return proto.EnumName(TreasuryProposalStatus_name, int32(x))
+}
+
+func (TreasuryProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{1}
+}
+
+// TreasuryVoteOption represents the option of a treasury proposal vote
+type TreasuryVoteOption int32
+
+const (
+	// The option has not been specified
+	TREASURY_VOTE_OPTION_UNSPECIFIED TreasuryVoteOption = 0
+	// The voter approves the proposal
+	TREASURY_VOTE_OPTION_YES TreasuryVoteOption = 1
+	// The voter does not approve the proposal
+	TREASURY_VOTE_OPTION_NO TreasuryVoteOption = 2
+)
+
+var TreasuryVoteOption_name = map[int32]string{
+	0: "TREASURY_VOTE_OPTION_UNSPECIFIED",
+	1: "TREASURY_VOTE_OPTION_YES",
+	2: "TREASURY_VOTE_OPTION_NO",
+}
+
+var TreasuryVoteOption_value = map[string]int32{
+	"TREASURY_VOTE_OPTION_UNSPECIFIED": 0,
+	"TREASURY_VOTE_OPTION_YES":         1,
+	"TREASURY_VOTE_OPTION_NO":          2,
+}
+
+func (x TreasuryVoteOption) String() string {
+	return proto.EnumName(TreasuryVoteOption_name, int32(x))
+}
+
+func (TreasuryVoteOption) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{2}
+}
+
+// FeeTokensDestination represents the possible destinations of the additional
+// fee tokens paid inside a subspace
+type FeeTokensDestination int32
+
+const (
+	// The fee tokens are sent to the fee collector, like any other fee
+	FEE_TOKENS_DESTINATION_FEE_COLLECTOR FeeTokensDestination = 0
+	// The fee tokens are sent to the subspace treasury
+	FEE_TOKENS_DESTINATION_TREASURY FeeTokensDestination = 1
+)
+
+var FeeTokensDestination_name = map[int32]string{
+	0: "FEE_TOKENS_DESTINATION_FEE_COLLECTOR",
+	1: "FEE_TOKENS_DESTINATION_TREASURY",
+}
+
+var FeeTokensDestination_value = map[string]int32{
+	"FEE_TOKENS_DESTINATION_FEE_COLLECTOR": 0,
+	"FEE_TOKENS_DESTINATION_TREASURY":      1,
+}
+
+func (x FeeTokensDestination) String() string {
+	return proto.EnumName(FeeTokensDestination_name, int32(x))
+}
+
+func (FeeTokensDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{3}
+}
+
 // Subspace contains all the data of a Desmos subspace
 type Subspace struct {
 	// Unique id that identifies the subspace
@@ -51,6 +190,10 @@ type Subspace struct {
 	// List of fee token denoms with default minimum gas prices allowed inside the
 	// subspace
 	AdditionalFeeTokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=additional_fee_tokens,json=additionalFeeTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_fee_tokens" yaml:"additional_fee_tokens"`
+	// Tells whether the subspace has been archived. Archived subspaces are
+	// read-only: their contents can still be queried but no new content can be
+	// created inside them
+	Archived bool `protobuf:"varint,9,opt,name=archived,proto3" json:"archived,omitempty" yaml:"archived"`
 }
 
 func (m *Subspace) Reset()         { *m = Subspace{} }
@@ -142,6 +285,13 @@ func (m *Subspace) GetAdditionalFeeTokens() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+func (m *Subspace) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
 // Section contains the data of a single subspace section
 type Section struct {
 	// Id of the subspace inside which the section exists
@@ -154,6 +304,8 @@ type Section struct {
 	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
 	// (optional) Description of the section
 	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Visibility of the section contents
+	Visibility SectionVisibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=desmos.subspaces.v3.SectionVisibility" json:"visibility,omitempty" yaml:"visibility"`
 }
 
 func (m *Section) Reset()         { *m = Section{} }
@@ -224,6 +376,13 @@ func (m *Section) GetDescription() string {
 	return ""
 }
 
+func (m *Section) GetVisibility() SectionVisibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return SECTION_VISIBILITY_PUBLIC
+}
+
 // UserGroup represents a group of users
 type UserGroup struct {
 	// ID of the subspace inside which this group exists
@@ -321,6 +480,8 @@ type UserPermission struct {
 	SectionID   uint32      `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
 	User        string      `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
 	Permissions Permissions `protobuf:"bytes,4,rep,name=permissions,proto3,castrepeated=Permissions" json:"permissions,omitempty"`
+	// (optional) Time after which the permissions will be removed
+	ExpirationTime *time.Time `protobuf:"bytes,5,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty" yaml:"expiration_time"`
 }
 
 func (m *UserPermission) Reset()         { *m = UserPermission{} }
@@ -384,6 +545,13 @@ func (m *UserPermission) GetPermissions() Permissions {
 	return nil
 }
 
+func (m *UserPermission) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
 // Grant represents a grant to a user or a group
 type Grant struct {
 	// Id of the subspace inside which the user was granted the allowance
@@ -548,887 +716,6657 @@ func (m *GroupGrantee) GetGroupID() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Subspace)(nil), "desmos.subspaces.v3.Subspace")
-	proto.RegisterType((*Section)(nil), "desmos.subspaces.v3.Section")
-	proto.RegisterType((*UserGroup)(nil), "desmos.subspaces.v3.UserGroup")
-	proto.RegisterType((*UserPermission)(nil), "desmos.subspaces.v3.UserPermission")
-	proto.RegisterType((*Grant)(nil), "desmos.subspaces.v3.Grant")
-	proto.RegisterType((*UserGrantee)(nil), "desmos.subspaces.v3.UserGrantee")
-	proto.RegisterType((*GroupGrantee)(nil), "desmos.subspaces.v3.GroupGrantee")
+// SubspaceOwnerTransferRequest represents a request to transfer the ownership
+// of a subspace from the sender to the receiver
+type SubspaceOwnerTransferRequest struct {
+	// Id of the subspace to transfer
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Address of the sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// Address of the receiver
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty" yaml:"receiver"`
 }
 
-func init() { proto.RegisterFile("desmos/subspaces/v3/models.proto", fileDescriptor_ca57465af0d8b734) }
-
-var fileDescriptor_ca57465af0d8b734 = []byte{
-	// 980 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0xfa, 0x23, 0xb6, 0x67, 0xf3, 0x41, 0x37, 0x01, 0x6d, 0x03, 0xf2, 0x58, 0x5b, 0xaa,
-	0x5a, 0xa1, 0xd9, 0x55, 0x92, 0x03, 0x10, 0x54, 0xa4, 0x98, 0x50, 0x2b, 0x12, 0x87, 0x6a, 0x53,
-	0x2e, 0x5c, 0xac, 0xf1, 0xee, 0x64, 0x59, 0xd5, 0xbb, 0x63, 0x76, 0xd6, 0x29, 0xbe, 0xf2, 0x0b,
-	0x7a, 0x44, 0x48, 0x48, 0x3d, 0x22, 0x4e, 0x3d, 0x94, 0xff, 0x50, 0x71, 0xaa, 0x38, 0x21, 0x0e,
-	0x5b, 0xe4, 0x1c, 0xca, 0x81, 0x93, 0xc5, 0x0f, 0x40, 0xf3, 0xb5, 0xde, 0x14, 0x3b, 0x04, 0xa9,
-	0x5c, 0x92, 0x99, 0x79, 0x9f, 0xf7, 0x79, 0x77, 0x9e, 0xf7, 0x99, 0x19, 0x83, 0x96, 0x8f, 0x69,
-	0x44, 0xa8, 0x43, 0x47, 0x7d, 0x3a, 0x44, 0x1e, 0xa6, 0xce, 0xd9, 0xbe, 0x13, 0x11, 0x1f, 0x0f,
-	0xa8, 0x3d, 0x4c, 0x48, 0x4a, 0x8c, 0x0d, 0x81, 0xb0, 0x73, 0x84, 0x7d, 0xb6, 0xbf, 0x75, 0x0d,
-	0x45, 0x61, 0x4c, 0x1c, 0xfe, 0x57, 0xe0, 0xb6, 0x36, 0x03, 0x12, 0x10, 0x3e, 0x74, 0xd8, 0x48,
-	0xae, 0x5e, 0x0f, 0x08, 0x09, 0x06, 0xd8, 0xe1, 0xb3, 0xfe, 0xe8, 0xd4, 0x41, 0xf1, 0x58, 0x85,
-	0x3c, 0xc2, 0x88, 0x7b, 0x22, 0x47, 0x4c, 0x64, 0x08, 0xbe, 0x9a, 0x95, 0x86, 0x11, 0xa6, 0x29,
-	0x8a, 0x86, 0x12, 0xd0, 0x14, 0x70, 0xa7, 0x8f, 0x28, 0x76, 0xce, 0x76, 0xfb, 0x38, 0x45, 0xbb,
-	0x8e, 0x47, 0xc2, 0x58, 0xc4, 0xad, 0x6f, 0xaa, 0xa0, 0x7e, 0x22, 0x3f, 0xd8, 0xb8, 0x01, 0x4a,
-	0xa1, 0x6f, 0x6a, 0x2d, 0xad, 0x5d, 0xe9, 0x6c, 0x4c, 0x32, 0x58, 0x3a, 0x3e, 0x9a, 0x66, 0xb0,
-	0x31, 0x46, 0xd1, 0xe0, 0xc0, 0x0a, 0x7d, 0xcb, 0x2d, 0x85, 0xbe, 0x71, 0x03, 0x54, 0x62, 0x14,
-	0x61, 0xb3, 0xd4, 0xd2, 0xda, 0x8d, 0xce, 0xfa, 0x34, 0x83, 0xba, 0x00, 0xb0, 0x55, 0xcb, 0xe5,
-	0x41, 0xe3, 0x03, 0xa0, 0xfb, 0x98, 0x7a, 0x49, 0x38, 0x4c, 0x43, 0x12, 0x9b, 0x65, 0x8e, 0x7d,
-	0x6b, 0x9a, 0x41, 0x43, 0x60, 0x0b, 0x41, 0xcb, 0x2d, 0x42, 0x8d, 0x2e, 0xa8, 0xa7, 0x09, 0x46,
-	0x74, 0x94, 0x8c, 0xcd, 0x0a, 0x4f, 0x7b, 0x6f, 0x9a, 0xc1, 0x75, 0x91, 0xa6, 0x22, 0xd6, 0x2f,
-	0x4f, 0x77, 0x36, 0xa5, 0x10, 0x87, 0xbe, 0x9f, 0x60, 0x4a, 0x4f, 0xd2, 0x24, 0x8c, 0x03, 0x37,
-	0x4f, 0x36, 0x3e, 0x06, 0x55, 0xf2, 0x30, 0xc6, 0x89, 0x59, 0xe5, 0x2c, 0xed, 0x69, 0x06, 0x57,
-	0x04, 0x0b, 0x5f, 0x5e, 0x4c, 0x21, 0xd2, 0x8c, 0x23, 0x50, 0xf3, 0x12, 0x8c, 0x52, 0x92, 0x98,
-	0xcb, 0x9c, 0x61, 0x7b, 0x9a, 0xc1, 0x35, 0xc1, 0x20, 0x03, 0x8b, 0x39, 0x54, 0xaa, 0x81, 0xc0,
-	0x2a, 0x1f, 0x86, 0x24, 0xee, 0xb1, 0xde, 0x98, 0xb5, 0x96, 0xd6, 0xd6, 0xf7, 0xb6, 0x6c, 0xd1,
-	0x38, 0x5b, 0x35, 0xce, 0xbe, 0xaf, 0x1a, 0xd7, 0x69, 0x3d, 0xcb, 0xe0, 0xd2, 0x34, 0x83, 0x9b,
-	0x85, 0x5a, 0x2a, 0xdd, 0x7a, 0xf4, 0x02, 0x6a, 0xee, 0x8a, 0x5a, 0x63, 0x49, 0xc6, 0x4f, 0x1a,
-	0x78, 0x13, 0xf9, 0x7e, 0xc8, 0x16, 0xd0, 0xa0, 0x77, 0x8a, 0x71, 0x2f, 0x25, 0x0f, 0x70, 0x4c,
-	0xcd, 0x7a, 0xab, 0xdc, 0xd6, 0xf7, 0xae, 0xdb, 0xf2, 0x13, 0x99, 0x07, 0x6c, 0xe9, 0x01, 0xfb,
-	0x13, 0x12, 0xc6, 0x9d, 0x53, 0x59, 0xea, 0x1d, 0x51, 0x6a, 0x2e, 0x8b, 0xf5, 0xe3, 0x0b, 0xd8,
-	0x0e, 0xc2, 0xf4, 0xcb, 0x51, 0xdf, 0xf6, 0x48, 0x24, 0xfd, 0x27, 0xff, 0xed, 0x50, 0xff, 0x81,
-	0x93, 0x8e, 0x87, 0x98, 0x72, 0x42, 0xfa, 0xdd, 0xcb, 0x27, 0xdb, 0x2b, 0x03, 0x1c, 0x20, 0x6f,
-	0xdc, 0x63, 0x2e, 0xa3, 0x3f, 0xbc, 0x7c, 0xb2, 0xad, 0xb9, 0x1b, 0x33, 0xe6, 0xbb, 0x18, 0xdf,
-	0xe7, 0xbc, 0x07, 0xf5, 0x6f, 0x1f, 0x43, 0xed, 0x8f, 0xc7, 0x50, 0xb3, 0xbe, 0x2f, 0x81, 0xda,
-	0x09, 0xf6, 0x78, 0xff, 0x3f, 0x05, 0xba, 0x3a, 0x40, 0xbd, 0xdc, 0x8c, 0xef, 0x4e, 0x32, 0x08,
-	0x94, 0x4d, 0xb9, 0x29, 0xa5, 0x8f, 0x0a, 0x50, 0xcb, 0x05, 0x6a, 0x76, 0xec, 0x4b, 0x2b, 0x33,
-	0x8f, 0xae, 0x2e, 0xb6, 0xf2, 0x1d, 0xd0, 0x18, 0xa2, 0x04, 0xc7, 0x29, 0xab, 0x54, 0xe6, 0xd8,
-	0xd6, 0x24, 0x83, 0xf5, 0x7b, 0x7c, 0x91, 0x67, 0xbc, 0x21, 0x32, 0x72, 0x98, 0xe5, 0xd6, 0xc5,
-	0xf8, 0x78, 0x76, 0x12, 0x2a, 0xff, 0xe1, 0x24, 0x54, 0xaf, 0x7c, 0x12, 0x0a, 0xfa, 0xfc, 0x59,
-	0x02, 0x8d, 0xcf, 0x29, 0x4e, 0xba, 0x09, 0x19, 0x0d, 0x5f, 0x97, 0x42, 0x87, 0x00, 0x50, 0xa1,
-	0x79, 0x2f, 0x57, 0xca, 0x9a, 0x64, 0xb0, 0x21, 0x3b, 0xc1, 0x49, 0xae, 0x49, 0x92, 0x1c, 0x68,
-	0xb9, 0x0d, 0x39, 0xc9, 0x45, 0x2e, 0x5f, 0x2e, 0xf2, 0xff, 0xab, 0x92, 0xd1, 0x05, 0xfa, 0x10,
-	0x27, 0x51, 0x48, 0x69, 0x48, 0x62, 0x6a, 0x2e, 0xb7, 0xca, 0xed, 0x46, 0xe7, 0xe6, 0x2c, 0xb3,
-	0x10, 0x64, 0x4e, 0xd6, 0xef, 0xcd, 0xe6, 0x6e, 0x31, 0xb3, 0x20, 0xf7, 0x6f, 0x1a, 0x58, 0x63,
-	0x72, 0xcf, 0xa0, 0x86, 0x33, 0x4f, 0xf3, 0xb5, 0x8b, 0x9a, 0x5f, 0x50, 0xf7, 0xf6, 0x1c, 0x75,
-	0x57, 0x2f, 0xa8, 0x5b, 0x14, 0xf2, 0x36, 0xa8, 0x8c, 0x28, 0x4e, 0xe4, 0x3d, 0x69, 0x2e, 0xbc,
-	0x56, 0x38, 0xca, 0xd8, 0xbd, 0xb8, 0xe5, 0x0a, 0xdf, 0xf2, 0xfa, 0x15, 0x37, 0xf7, 0x57, 0x09,
-	0x54, 0xbb, 0x09, 0x8a, 0xd3, 0xd7, 0xe5, 0xa3, 0x23, 0x50, 0x0b, 0x18, 0x1f, 0x4e, 0xe4, 0x93,
-	0x50, 0xb8, 0x27, 0x65, 0xe0, 0x92, 0x7b, 0x52, 0x22, 0x0c, 0xa4, 0x58, 0x30, 0x17, 0x41, 0xdf,
-	0xdb, 0xfc, 0xc7, 0x0d, 0x79, 0x18, 0x8f, 0x3b, 0xbb, 0xaf, 0x72, 0x63, 0xeb, 0xe7, 0xa7, 0x3b,
-	0x6f, 0xcf, 0x79, 0x79, 0xed, 0xae, 0x88, 0xab, 0x12, 0xd8, 0xf8, 0x0a, 0x34, 0xd0, 0x60, 0x40,
-	0x1e, 0xa2, 0xd8, 0x13, 0x6e, 0x5c, 0x54, 0xe4, 0xce, 0xec, 0xdc, 0xe7, 0x09, 0xac, 0xcc, 0x4d,
-	0xb9, 0x85, 0x53, 0x8c, 0x39, 0x67, 0x7e, 0x97, 0xde, 0xc5, 0xf8, 0x50, 0x01, 0x8f, 0xdd, 0x59,
-	0x95, 0x82, 0xec, 0x14, 0xe8, 0xe2, 0x04, 0x8b, 0x6f, 0xf9, 0x48, 0x36, 0x5c, 0xe3, 0x8a, 0xdd,
-	0x9a, 0x1d, 0x0a, 0xb6, 0x6a, 0x5d, 0xde, 0xff, 0x83, 0x5b, 0x8a, 0xf5, 0x5f, 0xb6, 0x6e, 0x25,
-	0x60, 0x85, 0x5f, 0x19, 0xaa, 0xea, 0x87, 0xa0, 0x1e, 0xb0, 0xb9, 0x6a, 0xf7, 0x6a, 0xa7, 0x39,
-	0xc9, 0x60, 0x8d, 0x63, 0x78, 0xaf, 0xd7, 0x95, 0xb4, 0x02, 0x64, 0x31, 0xf1, 0x58, 0xcc, 0xbf,
-	0x72, 0xcd, 0xce, 0x67, 0xcf, 0x26, 0x4d, 0xed, 0xf9, 0xa4, 0xa9, 0xfd, 0x3e, 0x69, 0x6a, 0x8f,
-	0xce, 0x9b, 0x4b, 0xcf, 0xcf, 0x9b, 0x4b, 0xbf, 0x9e, 0x37, 0x97, 0xbe, 0xd8, 0x2b, 0x3c, 0x22,
-	0x82, 0x61, 0x67, 0x80, 0xfa, 0x54, 0x8e, 0x9d, 0xb3, 0xf7, 0x9d, 0xaf, 0x0b, 0xbf, 0xae, 0xf8,
-	0xa3, 0xd2, 0x5f, 0xe6, 0x8d, 0xd9, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x66, 0x9c, 0x19,
-	0x7e, 0x09, 0x00, 0x00,
+func (m *SubspaceOwnerTransferRequest) Reset()         { *m = SubspaceOwnerTransferRequest{} }
+func (m *SubspaceOwnerTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*SubspaceOwnerTransferRequest) ProtoMessage()    {}
+func (*SubspaceOwnerTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{7}
 }
-
-func (this *Subspace) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Subspace)
-	if !ok {
-		that2, ok := that.(Subspace)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *SubspaceOwnerTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubspaceOwnerTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubspaceOwnerTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *SubspaceOwnerTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubspaceOwnerTransferRequest.Merge(m, src)
+}
+func (m *SubspaceOwnerTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubspaceOwnerTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubspaceOwnerTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubspaceOwnerTransferRequest proto.InternalMessageInfo
+
+func (m *SubspaceOwnerTransferRequest) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if this.ID != that1.ID {
-		return false
+	return 0
+}
+
+func (m *SubspaceOwnerTransferRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if this.Name != that1.Name {
-		return false
+	return ""
+}
+
+func (m *SubspaceOwnerTransferRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if this.Description != that1.Description {
-		return false
+	return ""
+}
+
+// GroupInvite represents an invite that allows users to join a user group by
+// proving the knowledge of the secret associated with it
+type GroupInvite struct {
+	// Id of the subspace inside which the group is
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the group that the invite allows to join
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Hex-encoded SHA-256 hash of the public key derived from the secret that
+	// must be known in order to redeem the invite
+	SecretHash string `protobuf:"bytes,3,opt,name=secret_hash,json=secretHash,proto3" json:"secret_hash,omitempty" yaml:"secret_hash"`
+	// Maximum number of times the invite can be redeemed
+	MaxUses uint32 `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty" yaml:"max_uses"`
+	// Number of times the invite has already been redeemed
+	Uses uint32 `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty" yaml:"uses"`
+	// (optional) Time after which the invite can no longer be redeemed
+	ExpirationTime *time.Time `protobuf:"bytes,6,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty" yaml:"expiration_time"`
+	// Address of the user that created the invite
+	Creator string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+}
+
+func (m *GroupInvite) Reset()         { *m = GroupInvite{} }
+func (m *GroupInvite) String() string { return proto.CompactTextString(m) }
+func (*GroupInvite) ProtoMessage()    {}
+func (*GroupInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{8}
+}
+func (m *GroupInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Treasury != that1.Treasury {
-		return false
+}
+func (m *GroupInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupInvite.Merge(m, src)
+}
+func (m *GroupInvite) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupInvite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupInvite proto.InternalMessageInfo
+
+func (m *GroupInvite) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if this.Owner != that1.Owner {
-		return false
+	return 0
+}
+
+func (m *GroupInvite) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
 	}
-	if this.Creator != that1.Creator {
-		return false
+	return 0
+}
+
+func (m *GroupInvite) GetSecretHash() string {
+	if m != nil {
+		return m.SecretHash
 	}
-	if !this.CreationTime.Equal(that1.CreationTime) {
-		return false
+	return ""
+}
+
+func (m *GroupInvite) GetMaxUses() uint32 {
+	if m != nil {
+		return m.MaxUses
 	}
-	if len(this.AdditionalFeeTokens) != len(that1.AdditionalFeeTokens) {
-		return false
+	return 0
+}
+
+func (m *GroupInvite) GetUses() uint32 {
+	if m != nil {
+		return m.Uses
 	}
-	for i := range this.AdditionalFeeTokens {
-		if !this.AdditionalFeeTokens[i].Equal(&that1.AdditionalFeeTokens[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *GroupInvite) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
 	}
-	return true
+	return nil
 }
-func (this *Section) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GroupInvite) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
+	return ""
+}
 
-	that1, ok := that.(*Section)
-	if !ok {
-		that2, ok := that.(Section)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// GroupApplication represents a request made by a user to join a user group
+type GroupApplication struct {
+	// Id of the subspace inside which the group is
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the group the user wants to join
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Address of the user that wants to join the group
+	Applicant string `protobuf:"bytes,3,opt,name=applicant,proto3" json:"applicant,omitempty" yaml:"applicant"`
+	// (optional) Message attached to the application
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty" yaml:"message"`
+	// Time at which the application has been submitted
+	CreationTime time.Time `protobuf:"bytes,5,opt,name=creation_time,json=creationTime,proto3,stdtime" json:"creation_time" yaml:"creation_time"`
+}
+
+func (m *GroupApplication) Reset()         { *m = GroupApplication{} }
+func (m *GroupApplication) String() string { return proto.CompactTextString(m) }
+func (*GroupApplication) ProtoMessage()    {}
+func (*GroupApplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{9}
+}
+func (m *GroupApplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupApplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.SubspaceID != that1.SubspaceID {
-		return false
-	}
-	if this.ID != that1.ID {
-		return false
-	}
-	if this.ParentID != that1.ParentID {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
+}
+func (m *GroupApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupApplication.Merge(m, src)
+}
+func (m *GroupApplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupApplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupApplication proto.InternalMessageInfo
+
+func (m *GroupApplication) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if this.Description != that1.Description {
-		return false
+	return 0
+}
+
+func (m *GroupApplication) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
 	}
-	return true
+	return 0
 }
-func (this *UserGroup) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GroupApplication) GetApplicant() string {
+	if m != nil {
+		return m.Applicant
 	}
+	return ""
+}
 
-	that1, ok := that.(*UserGroup)
-	if !ok {
-		that2, ok := that.(UserGroup)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.SubspaceID != that1.SubspaceID {
-		return false
-	}
-	if this.SectionID != that1.SectionID {
-		return false
-	}
-	if this.ID != that1.ID {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if len(this.Permissions) != len(that1.Permissions) {
-		return false
-	}
-	for i := range this.Permissions {
-		if this.Permissions[i] != that1.Permissions[i] {
-			return false
-		}
+func (m *GroupApplication) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return true
+	return ""
 }
-func (this *UserPermission) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GroupApplication) GetCreationTime() time.Time {
+	if m != nil {
+		return m.CreationTime
 	}
+	return time.Time{}
+}
 
-	that1, ok := that.(*UserPermission)
-	if !ok {
-		that2, ok := that.(UserPermission)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// SubspaceBan represents a ban that prevents a user from performing any action
+// inside a subspace
+type SubspaceBan struct {
+	// Id of the subspace from which the user has been banned
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Address of the banned user
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	// (optional) Reason why the user has been banned
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	// Address of the user that banned the user
+	BannedBy string `protobuf:"bytes,4,opt,name=banned_by,json=bannedBy,proto3" json:"banned_by,omitempty" yaml:"banned_by"`
+	// (optional) Time after which the ban will be lifted
+	ExpirationTime *time.Time `protobuf:"bytes,5,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty" yaml:"expiration_time"`
+}
+
+func (m *SubspaceBan) Reset()         { *m = SubspaceBan{} }
+func (m *SubspaceBan) String() string { return proto.CompactTextString(m) }
+func (*SubspaceBan) ProtoMessage()    {}
+func (*SubspaceBan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{10}
+}
+func (m *SubspaceBan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubspaceBan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubspaceBan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.SubspaceID != that1.SubspaceID {
-		return false
-	}
-	if this.SectionID != that1.SectionID {
-		return false
+}
+func (m *SubspaceBan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubspaceBan.Merge(m, src)
+}
+func (m *SubspaceBan) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubspaceBan) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubspaceBan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubspaceBan proto.InternalMessageInfo
+
+func (m *SubspaceBan) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if this.User != that1.User {
-		return false
+	return 0
+}
+
+func (m *SubspaceBan) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	if len(this.Permissions) != len(that1.Permissions) {
-		return false
+	return ""
+}
+
+func (m *SubspaceBan) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	for i := range this.Permissions {
-		if this.Permissions[i] != that1.Permissions[i] {
-			return false
-		}
+	return ""
+}
+
+func (m *SubspaceBan) GetBannedBy() string {
+	if m != nil {
+		return m.BannedBy
 	}
-	return true
+	return ""
 }
-func (this *Grant) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SubspaceBan) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
 	}
+	return nil
+}
 
-	that1, ok := that.(*Grant)
-	if !ok {
-		that2, ok := that.(Grant)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// RateLimit represents the maximum number of messages of a given type that
+// each user can send inside a subspace during a time window
+type RateLimit struct {
+	// Id of the subspace to which the rate limit applies
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Type URL of the message that is limited
+	// (eg. /desmos.posts.v3.MsgCreatePost)
+	MsgTypeURL string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// Maximum number of messages that each user can send within the window
+	MaxMessages uint32 `protobuf:"varint,3,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty" yaml:"max_messages"`
+	// Duration of the window used to count the messages sent
+	Window time.Duration `protobuf:"bytes,4,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{11}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.SubspaceID != that1.SubspaceID {
-		return false
-	}
-	if this.Granter != that1.Granter {
-		return false
-	}
-	if !this.Grantee.Equal(that1.Grantee) {
-		return false
-	}
-	if !this.Allowance.Equal(that1.Allowance) {
-		return false
-	}
-	return true
 }
-func (this *UserGrantee) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*UserGrantee)
-	if !ok {
-		that2, ok := that.(UserGrantee)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *RateLimit) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
 	}
-	if this.User != that1.User {
-		return false
+	return ""
+}
+
+func (m *RateLimit) GetMaxMessages() uint32 {
+	if m != nil {
+		return m.MaxMessages
 	}
-	return true
+	return 0
 }
-func (this *GroupGrantee) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RateLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
+	return 0
+}
 
-	that1, ok := that.(*GroupGrantee)
-	if !ok {
-		that2, ok := that.(GroupGrantee)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// RateLimitCounter keeps track of the messages sent by a user that are subject
+// to a rate limit, using a sliding window approximation based on the counts of
+// the current and the previous windows
+type RateLimitCounter struct {
+	// Start time of the current window
+	WindowStart time.Time `protobuf:"bytes,1,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start" yaml:"window_start"`
+	// Number of messages sent during the current window
+	CurrentCount uint32 `protobuf:"varint,2,opt,name=current_count,json=currentCount,proto3" json:"current_count,omitempty" yaml:"current_count"`
+	// Number of messages sent during the previous window
+	PreviousCount uint32 `protobuf:"varint,3,opt,name=previous_count,json=previousCount,proto3" json:"previous_count,omitempty" yaml:"previous_count"`
+}
+
+func (m *RateLimitCounter) Reset()         { *m = RateLimitCounter{} }
+func (m *RateLimitCounter) String() string { return proto.CompactTextString(m) }
+func (*RateLimitCounter) ProtoMessage()    {}
+func (*RateLimitCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{12}
+}
+func (m *RateLimitCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *RateLimitCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitCounter.Merge(m, src)
+}
+func (m *RateLimitCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitCounter proto.InternalMessageInfo
+
+func (m *RateLimitCounter) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
 	}
-	if this.GroupID != that1.GroupID {
-		return false
+	return time.Time{}
+}
+
+func (m *RateLimitCounter) GetCurrentCount() uint32 {
+	if m != nil {
+		return m.CurrentCount
 	}
-	return true
+	return 0
 }
-func (m *Subspace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *RateLimitCounter) GetPreviousCount() uint32 {
+	if m != nil {
+		return m.PreviousCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Subspace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TreasuryVotingConfig contains the settings used to vote the treasury
+// proposals of a subspace
+type TreasuryVotingConfig struct {
+	// Id of the subspace to which the configuration applies
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the user group whose members can submit and vote treasury proposals
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Minimum fraction of the group members that must vote yes for a proposal
+	// to be executed
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold" yaml:"threshold"`
+	// Duration of the voting period of each proposal
+	VotingPeriod time.Duration `protobuf:"bytes,4,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period" yaml:"voting_period"`
+	// (optional) Minimum fraction of the group members that must cast a vote,
+	// either yes or no, for a proposal to be executed. If not set, no quorum
+	// is required
+	Quorum *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum,omitempty" yaml:"quorum"`
 }
 
-func (m *Subspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AdditionalFeeTokens) > 0 {
-		for iNdEx := len(m.AdditionalFeeTokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AdditionalFeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+func (m *TreasuryVotingConfig) Reset()         { *m = TreasuryVotingConfig{} }
+func (m *TreasuryVotingConfig) String() string { return proto.CompactTextString(m) }
+func (*TreasuryVotingConfig) ProtoMessage()    {}
+func (*TreasuryVotingConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{13}
+}
+func (m *TreasuryVotingConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryVotingConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryVotingConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintModels(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Treasury) > 0 {
-		i -= len(m.Treasury)
-		copy(dAtA[i:], m.Treasury)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Treasury)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *TreasuryVotingConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryVotingConfig.Merge(m, src)
+}
+func (m *TreasuryVotingConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryVotingConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryVotingConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryVotingConfig proto.InternalMessageInfo
+
+func (m *TreasuryVotingConfig) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if m.ID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *TreasuryVotingConfig) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Section) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TreasuryVotingConfig) GetVotingPeriod() time.Duration {
+	if m != nil {
+		return m.VotingPeriod
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Section) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TreasuryProposal represents a set of messages that should be executed using
+// the subspace treasury as signer once enough members of the voting group
+// have approved them
+type TreasuryProposal struct {
+	// Id of the subspace inside which the proposal has been submitted
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Unique id of the proposal within the subspace
+	ID uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// Address of the user that submitted the proposal
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty" yaml:"proposer"`
+	// Messages that will be executed using the treasury as signer
+	Messages []*types1.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty" yaml:"messages"`
+	// Id of the user group whose members can vote the proposal
+	GroupID uint32 `protobuf:"varint,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Minimum fraction of the group members that must vote yes for the proposal
+	// to be executed
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold" yaml:"threshold"`
+	// Time at which the proposal has been submitted
+	SubmitTime time.Time `protobuf:"bytes,7,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
+	// Time at which the voting period ends
+	VotingEndTime time.Time `protobuf:"bytes,8,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// Current status of the proposal
+	Status TreasuryProposalStatus `protobuf:"varint,9,opt,name=status,proto3,enum=desmos.subspaces.v3.TreasuryProposalStatus" json:"status,omitempty" yaml:"status"`
+	// Addresses of the users that were allowed to vote the proposal when it was
+	// submitted. Only their votes are counted, and the threshold is computed
+	// over their number
+	Voters []string `protobuf:"bytes,10,rep,name=voters,proto3" json:"voters,omitempty" yaml:"voters"`
+	// (optional) Minimum fraction of the group members that must cast a vote,
+	// either yes or no, for the proposal to be executed. If not set, no quorum
+	// is required
+	Quorum *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum,omitempty" yaml:"quorum"`
 }
 
-func (m *Section) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ParentID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.ParentID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+func (m *TreasuryProposal) Reset()         { *m = TreasuryProposal{} }
+func (m *TreasuryProposal) String() string { return proto.CompactTextString(m) }
+func (*TreasuryProposal) ProtoMessage()    {}
+func (*TreasuryProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{14}
+}
+func (m *TreasuryProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TreasuryProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryProposal.Merge(m, src)
+}
+func (m *TreasuryProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryProposal.DiscardUnknown(m)
 }
 
-func (m *UserGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TreasuryProposal proto.InternalMessageInfo
+
+func (m *TreasuryProposal) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UserGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TreasuryProposal) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
 }
 
-func (m *UserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permissions[iNdEx])
-			copy(dAtA[i:], m.Permissions[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.Permissions[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
+func (m *TreasuryProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *TreasuryProposal) GetMessages() []*types1.Any {
+	if m != nil {
+		return m.Messages
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *TreasuryProposal) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
 	}
-	if m.ID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *TreasuryProposal) GetSubmitTime() time.Time {
+	if m != nil {
+		return m.SubmitTime
 	}
-	if m.SectionID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.SectionID))
-		i--
-		dAtA[i] = 0x10
+	return time.Time{}
+}
+
+func (m *TreasuryProposal) GetVotingEndTime() time.Time {
+	if m != nil {
+		return m.VotingEndTime
 	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+	return time.Time{}
+}
+
+func (m *TreasuryProposal) GetStatus() TreasuryProposalStatus {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return TREASURY_PROPOSAL_STATUS_UNSPECIFIED
 }
 
-func (m *UserPermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TreasuryProposal) GetVoters() []string {
+	if m != nil {
+		return m.Voters
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UserPermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TreasuryVote represents the vote of a group member on a treasury proposal
+type TreasuryVote struct {
+	// Id of the subspace inside which the proposal has been submitted
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the voted proposal
+	ProposalID uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	// Address of the voter
+	Voter string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	// Option chosen by the voter
+	Option TreasuryVoteOption `protobuf:"varint,4,opt,name=option,proto3,enum=desmos.subspaces.v3.TreasuryVoteOption" json:"option,omitempty" yaml:"option"`
 }
 
-func (m *UserPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permissions[iNdEx])
-			copy(dAtA[i:], m.Permissions[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.Permissions[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *TreasuryVote) Reset()         { *m = TreasuryVote{} }
+func (m *TreasuryVote) String() string { return proto.CompactTextString(m) }
+func (*TreasuryVote) ProtoMessage()    {}
+func (*TreasuryVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{15}
+}
+func (m *TreasuryVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *TreasuryVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryVote.Merge(m, src)
+}
+func (m *TreasuryVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryVote proto.InternalMessageInfo
+
+func (m *TreasuryVote) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if m.SectionID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.SectionID))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *TreasuryVote) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
 	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *TreasuryVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Grant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TreasuryVote) GetOption() TreasuryVoteOption {
+	if m != nil {
+		return m.Option
 	}
-	return dAtA[:n], nil
+	return TREASURY_VOTE_OPTION_UNSPECIFIED
 }
 
-func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MessageLimitsAllowance is a fee allowance that can only be used to pay the
+// fees of transactions containing the listed message types. Each grantee can
+// send a limited number of messages of each type and spend a limited amount of
+// fees during every period. When the allowance is granted to a user group, the
+// limits are applied to each member of the group separately rather than to the
+// group as a whole
+type MessageLimitsAllowance struct {
+	// Limits of each message type whose fees can be paid by the allowance
+	MessageLimits []MessageLimit `protobuf:"bytes,1,rep,name=message_limits,json=messageLimits,proto3" json:"message_limits" yaml:"message_limits"`
+	// Maximum amount of fees that each grantee can spend during a period. For
+	// group grants, this is the amount that each group member can spend
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit" yaml:"period_spend_limit"`
+	// Duration of each period after which the usage of a grantee is reset
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period" yaml:"period"`
+	// (optional) Time after which the allowance expires
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" yaml:"expiration"`
 }
 
-func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		{
-			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
+func (m *MessageLimitsAllowance) Reset()         { *m = MessageLimitsAllowance{} }
+func (m *MessageLimitsAllowance) String() string { return proto.CompactTextString(m) }
+func (*MessageLimitsAllowance) ProtoMessage()    {}
+func (*MessageLimitsAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{16}
+}
+func (m *MessageLimitsAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageLimitsAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageLimitsAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.Grantee != nil {
-		{
-			size, err := m.Grantee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
+}
+func (m *MessageLimitsAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageLimitsAllowance.Merge(m, src)
+}
+func (m *MessageLimitsAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageLimitsAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageLimitsAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageLimitsAllowance proto.InternalMessageInfo
+
+// MessageLimit represents the maximum number of messages of a given type whose
+// fees can be paid by a MessageLimitsAllowance during each period
+type MessageLimit struct {
+	// Type URL of the message (eg. /desmos.posts.v3.MsgCreatePost)
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// Maximum number of messages that each grantee can send during a period
+	MaxMessages uint32 `protobuf:"varint,2,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty" yaml:"max_messages"`
+}
+
+func (m *MessageLimit) Reset()         { *m = MessageLimit{} }
+func (m *MessageLimit) String() string { return proto.CompactTextString(m) }
+func (*MessageLimit) ProtoMessage()    {}
+func (*MessageLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{17}
+}
+func (m *MessageLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MessageLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageLimit.Merge(m, src)
+}
+func (m *MessageLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageLimit proto.InternalMessageInfo
+
+func (m *MessageLimit) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UserGrantee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MessageLimit) GetMaxMessages() uint32 {
+	if m != nil {
+		return m.MaxMessages
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UserGrantee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AllowanceUsage keeps track of the fees spent and the messages sent by a
+// grantee using a MessageLimitsAllowance during the current period
+type AllowanceUsage struct {
+	// Id of the subspace inside which the allowance has been granted
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the group to which the allowance has been granted, or 0 if the
+	// allowance has been granted to the user directly
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Address of the user that has used the allowance
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
+	// Time at which the current period ends
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset" yaml:"period_reset"`
+	// Amount of fees spent during the current period
+	PeriodSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=period_spent,json=periodSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spent" yaml:"period_spent"`
+	// Number of messages of each type sent during the current period
+	MessageUsages []MessageUsage `protobuf:"bytes,6,rep,name=message_usages,json=messageUsages,proto3" json:"message_usages" yaml:"message_usages"`
 }
 
-func (m *UserGrantee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+func (m *AllowanceUsage) Reset()         { *m = AllowanceUsage{} }
+func (m *AllowanceUsage) String() string { return proto.CompactTextString(m) }
+func (*AllowanceUsage) ProtoMessage()    {}
+func (*AllowanceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{18}
+}
+func (m *AllowanceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowanceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowanceUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AllowanceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowanceUsage.Merge(m, src)
+}
+func (m *AllowanceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowanceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowanceUsage.DiscardUnknown(m)
 }
 
-func (m *GroupGrantee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AllowanceUsage proto.InternalMessageInfo
+
+func (m *AllowanceUsage) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GroupGrantee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AllowanceUsage) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
 }
 
-func (m *GroupGrantee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GroupID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x8
+func (m *AllowanceUsage) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
-	offset -= sovModels(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AllowanceUsage) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return time.Time{}
 }
-func (m *Subspace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovModels(uint64(m.ID))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.Treasury)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+
+func (m *AllowanceUsage) GetPeriodSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpent
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	return nil
+}
+
+func (m *AllowanceUsage) GetMessageUsages() []MessageUsage {
+	if m != nil {
+		return m.MessageUsages
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
-	n += 1 + l + sovModels(uint64(l))
-	if len(m.AdditionalFeeTokens) > 0 {
-		for _, e := range m.AdditionalFeeTokens {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	return nil
+}
+
+// MessageUsage represents the number of messages of a given type that have
+// been sent by a grantee during the current period
+type MessageUsage struct {
+	// Type URL of the message
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// Number of messages sent
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty" yaml:"count"`
+}
+
+func (m *MessageUsage) Reset()         { *m = MessageUsage{} }
+func (m *MessageUsage) String() string { return proto.CompactTextString(m) }
+func (*MessageUsage) ProtoMessage()    {}
+func (*MessageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{19}
+}
+func (m *MessageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *Section) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageUsage.Merge(m, src)
+}
+func (m *MessageUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageUsage proto.InternalMessageInfo
+
+func (m *MessageUsage) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovModels(uint64(m.SubspaceID))
+	return ""
+}
+
+func (m *MessageUsage) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	if m.ID != 0 {
-		n += 1 + sovModels(uint64(m.ID))
+	return 0
+}
+
+// FeeTokensConfig contains the settings used to price and collect the
+// additional fee tokens accepted inside a subspace
+type FeeTokensConfig struct {
+	// Id of the subspace to which the configuration applies
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Denom of the native fee token against which the conversion rates are
+	// expressed (e.g. udsm)
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	// Conversion rates of the additional fee tokens
+	Rates []FeeTokenRate `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates" yaml:"rates"`
+	// Where the additional fee tokens paid inside the subspace should be sent
+	Destination FeeTokensDestination `protobuf:"varint,4,opt,name=destination,proto3,enum=desmos.subspaces.v3.FeeTokensDestination" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *FeeTokensConfig) Reset()         { *m = FeeTokensConfig{} }
+func (m *FeeTokensConfig) String() string { return proto.CompactTextString(m) }
+func (*FeeTokensConfig) ProtoMessage()    {}
+func (*FeeTokensConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{20}
+}
+func (m *FeeTokensConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokensConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokensConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ParentID != 0 {
-		n += 1 + sovModels(uint64(m.ParentID))
+}
+func (m *FeeTokensConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokensConfig.Merge(m, src)
+}
+func (m *FeeTokensConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokensConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokensConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokensConfig proto.InternalMessageInfo
+
+func (m *FeeTokensConfig) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	return 0
+}
+
+func (m *FeeTokensConfig) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	return ""
+}
+
+func (m *FeeTokensConfig) GetRates() []FeeTokenRate {
+	if m != nil {
+		return m.Rates
 	}
-	return n
+	return nil
 }
 
-func (m *UserGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeTokensConfig) GetDestination() FeeTokensDestination {
+	if m != nil {
+		return m.Destination
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovModels(uint64(m.SubspaceID))
+	return FEE_TOKENS_DESTINATION_FEE_COLLECTOR
+}
+
+// FeeTokenRate represents the conversion rate of an additional fee token
+type FeeTokenRate struct {
+	// Denom of the fee token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// Amount of fee tokens that are worth one unit of the base denom
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
+	// Maximum amount of fee tokens accepted as fees within a single transaction.
+	// Zero means no cap
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount" yaml:"max_amount"`
+}
+
+func (m *FeeTokenRate) Reset()         { *m = FeeTokenRate{} }
+func (m *FeeTokenRate) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenRate) ProtoMessage()    {}
+func (*FeeTokenRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca57465af0d8b734, []int{21}
+}
+func (m *FeeTokenRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokenRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SectionID != 0 {
-		n += 1 + sovModels(uint64(m.SectionID))
+}
+func (m *FeeTokenRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenRate.Merge(m, src)
+}
+func (m *FeeTokenRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokenRate proto.InternalMessageInfo
+
+func (m *FeeTokenRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.ID != 0 {
-		n += 1 + sovModels(uint64(m.ID))
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("desmos.subspaces.v3.SectionVisibility", SectionVisibility_name, SectionVisibility_value)
+	proto.RegisterEnum("desmos.subspaces.v3.TreasuryProposalStatus", TreasuryProposalStatus_name, TreasuryProposalStatus_value)
+	proto.RegisterEnum("desmos.subspaces.v3.TreasuryVoteOption", TreasuryVoteOption_name, TreasuryVoteOption_value)
+	proto.RegisterEnum("desmos.subspaces.v3.FeeTokensDestination", FeeTokensDestination_name, FeeTokensDestination_value)
+	proto.RegisterType((*Subspace)(nil), "desmos.subspaces.v3.Subspace")
+	proto.RegisterType((*Section)(nil), "desmos.subspaces.v3.Section")
+	proto.RegisterType((*UserGroup)(nil), "desmos.subspaces.v3.UserGroup")
+	proto.RegisterType((*UserPermission)(nil), "desmos.subspaces.v3.UserPermission")
+	proto.RegisterType((*Grant)(nil), "desmos.subspaces.v3.Grant")
+	proto.RegisterType((*UserGrantee)(nil), "desmos.subspaces.v3.UserGrantee")
+	proto.RegisterType((*GroupGrantee)(nil), "desmos.subspaces.v3.GroupGrantee")
+	proto.RegisterType((*SubspaceOwnerTransferRequest)(nil), "desmos.subspaces.v3.SubspaceOwnerTransferRequest")
+	proto.RegisterType((*GroupInvite)(nil), "desmos.subspaces.v3.GroupInvite")
+	proto.RegisterType((*GroupApplication)(nil), "desmos.subspaces.v3.GroupApplication")
+	proto.RegisterType((*SubspaceBan)(nil), "desmos.subspaces.v3.SubspaceBan")
+	proto.RegisterType((*RateLimit)(nil), "desmos.subspaces.v3.RateLimit")
+	proto.RegisterType((*RateLimitCounter)(nil), "desmos.subspaces.v3.RateLimitCounter")
+	proto.RegisterType((*TreasuryVotingConfig)(nil), "desmos.subspaces.v3.TreasuryVotingConfig")
+	proto.RegisterType((*TreasuryProposal)(nil), "desmos.subspaces.v3.TreasuryProposal")
+	proto.RegisterType((*TreasuryVote)(nil), "desmos.subspaces.v3.TreasuryVote")
+	proto.RegisterType((*MessageLimitsAllowance)(nil), "desmos.subspaces.v3.MessageLimitsAllowance")
+	proto.RegisterType((*MessageLimit)(nil), "desmos.subspaces.v3.MessageLimit")
+	proto.RegisterType((*AllowanceUsage)(nil), "desmos.subspaces.v3.AllowanceUsage")
+	proto.RegisterType((*MessageUsage)(nil), "desmos.subspaces.v3.MessageUsage")
+	proto.RegisterType((*FeeTokensConfig)(nil), "desmos.subspaces.v3.FeeTokensConfig")
+	proto.RegisterType((*FeeTokenRate)(nil), "desmos.subspaces.v3.FeeTokenRate")
+}
+
+func init() { proto.RegisterFile("desmos/subspaces/v3/models.proto", fileDescriptor_ca57465af0d8b734) }
+
+var fileDescriptor_ca57465af0d8b734 = []byte{
+	// 2642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x14, 0x45, 0x0e, 0x29, 0x89, 0x1e, 0xff, 0x84, 0x72, 0x12, 0x2d, 0xb3, 0xb6,
+	0x63, 0xc5, 0xb1, 0x49, 0xd8, 0x29, 0x90, 0xd6, 0x45, 0x8a, 0x8a, 0xe2, 0x5a, 0x65, 0x2a, 0x8b,
+	0xca, 0x92, 0x14, 0xea, 0x02, 0xc1, 0x76, 0xc5, 0x1d, 0x51, 0x0b, 0x93, 0xbb, 0xcc, 0xce, 0x52,
+	0xb6, 0xd0, 0x4b, 0xd1, 0x43, 0x91, 0xf6, 0x94, 0x63, 0xda, 0x22, 0x40, 0x80, 0x5e, 0x8a, 0xa2,
+	0x07, 0x03, 0x4d, 0x2f, 0xbd, 0xf4, 0x1a, 0xf4, 0x94, 0xa6, 0x97, 0xa2, 0x07, 0x26, 0x50, 0x50,
+	0xa4, 0x87, 0x9e, 0x88, 0xa2, 0x97, 0xf6, 0x50, 0xcc, 0xcf, 0xee, 0x0e, 0x29, 0xfe, 0x48, 0x09,
+	0x6b, 0xe4, 0x62, 0x73, 0xe6, 0xfd, 0xcd, 0xbc, 0xf7, 0xbd, 0x37, 0x6f, 0x66, 0x05, 0x72, 0x26,
+	0xc2, 0x6d, 0x07, 0x17, 0x70, 0x77, 0x0f, 0x77, 0x8c, 0x06, 0xc2, 0x85, 0xc3, 0x57, 0x0a, 0x6d,
+	0xc7, 0x44, 0x2d, 0x9c, 0xef, 0xb8, 0x8e, 0xe7, 0xc0, 0xf3, 0x8c, 0x23, 0x1f, 0x70, 0xe4, 0x0f,
+	0x5f, 0xb9, 0x7c, 0xce, 0x68, 0x5b, 0xb6, 0x53, 0xa0, 0xff, 0x32, 0xbe, 0xcb, 0x17, 0x9a, 0x4e,
+	0xd3, 0xa1, 0x3f, 0x0b, 0xe4, 0x17, 0x9f, 0x5d, 0x69, 0x3a, 0x4e, 0xb3, 0x85, 0x0a, 0x74, 0xb4,
+	0xd7, 0xdd, 0x2f, 0x18, 0xf6, 0x91, 0x4f, 0x6a, 0x38, 0x44, 0xb1, 0xce, 0x64, 0xd8, 0x80, 0x93,
+	0xe4, 0x61, 0x29, 0xcf, 0x6a, 0x23, 0xec, 0x19, 0xed, 0x0e, 0x67, 0x58, 0x1d, 0x66, 0x30, 0xbb,
+	0xae, 0xe1, 0x59, 0x8e, 0xed, 0xd3, 0x99, 0xba, 0xc2, 0x9e, 0x81, 0x51, 0xe1, 0xf0, 0xf6, 0x1e,
+	0xf2, 0x8c, 0xdb, 0x85, 0x86, 0x63, 0x71, 0xba, 0xf2, 0xbb, 0x79, 0x90, 0xa8, 0xf2, 0x0d, 0xc1,
+	0x2b, 0x20, 0x62, 0x99, 0x59, 0x29, 0x27, 0xad, 0xc5, 0x8a, 0xe7, 0x8f, 0x7b, 0x72, 0xa4, 0x5c,
+	0xea, 0xf7, 0xe4, 0xe4, 0x91, 0xd1, 0x6e, 0xdd, 0x55, 0x2c, 0x53, 0xd1, 0x22, 0x96, 0x09, 0xaf,
+	0x80, 0x98, 0x6d, 0xb4, 0x51, 0x36, 0x92, 0x93, 0xd6, 0x92, 0xc5, 0xe5, 0x7e, 0x4f, 0x4e, 0x31,
+	0x06, 0x32, 0xab, 0x68, 0x94, 0x08, 0xbf, 0x0e, 0x52, 0x26, 0xc2, 0x0d, 0xd7, 0xea, 0x90, 0xb5,
+	0x64, 0xa3, 0x94, 0xf7, 0x52, 0xbf, 0x27, 0x43, 0xc6, 0x2b, 0x10, 0x15, 0x4d, 0x64, 0x85, 0x9b,
+	0x20, 0xe1, 0xb9, 0xc8, 0xc0, 0x5d, 0xf7, 0x28, 0x1b, 0xa3, 0x62, 0x2f, 0xf7, 0x7b, 0xf2, 0x32,
+	0x13, 0xf3, 0x29, 0xca, 0xc7, 0x1f, 0xdc, 0xba, 0xc0, 0x1d, 0xb5, 0x6e, 0x9a, 0x2e, 0xc2, 0xb8,
+	0xea, 0xb9, 0x96, 0xdd, 0xd4, 0x02, 0x61, 0xf8, 0x2d, 0x30, 0xef, 0x3c, 0xb2, 0x91, 0x9b, 0x9d,
+	0xa7, 0x5a, 0xd6, 0xfa, 0x3d, 0x39, 0xcd, 0xb4, 0xd0, 0xe9, 0xf1, 0x2a, 0x98, 0x18, 0x2c, 0x81,
+	0x85, 0x86, 0x8b, 0x0c, 0xcf, 0x71, 0xb3, 0x71, 0xaa, 0xe1, 0x46, 0xbf, 0x27, 0x2f, 0x31, 0x0d,
+	0x9c, 0x30, 0x5e, 0x87, 0x2f, 0x0a, 0x0d, 0xb0, 0x48, 0x7f, 0x5a, 0x8e, 0xad, 0x93, 0xd8, 0x65,
+	0x17, 0x72, 0xd2, 0x5a, 0xea, 0xce, 0xe5, 0x3c, 0x8b, 0x5b, 0xde, 0x8f, 0x5b, 0xbe, 0xe6, 0x07,
+	0xb6, 0x98, 0xfb, 0xb0, 0x27, 0xcf, 0xf5, 0x7b, 0xf2, 0x05, 0xc1, 0x96, 0x2f, 0xae, 0xbc, 0xf3,
+	0x89, 0x2c, 0x69, 0x69, 0x7f, 0x8e, 0x08, 0xc1, 0xdf, 0x4b, 0xe0, 0xa2, 0x61, 0x9a, 0x16, 0x99,
+	0x30, 0x5a, 0xfa, 0x3e, 0x42, 0xba, 0xe7, 0x3c, 0x44, 0x36, 0xce, 0x26, 0x72, 0xd1, 0xb5, 0xd4,
+	0x9d, 0x95, 0x3c, 0x5f, 0x22, 0xc1, 0x40, 0x9e, 0x63, 0x20, 0xbf, 0xe1, 0x58, 0x76, 0x71, 0x9f,
+	0x9b, 0x7a, 0x8e, 0x99, 0x1a, 0xa9, 0x45, 0xf9, 0xcd, 0x27, 0xf2, 0x5a, 0xd3, 0xf2, 0x0e, 0xba,
+	0x7b, 0xf9, 0x86, 0xd3, 0xe6, 0xf8, 0xe4, 0xff, 0xdd, 0xc2, 0xe6, 0xc3, 0x82, 0x77, 0xd4, 0x41,
+	0x98, 0x2a, 0xc4, 0xbf, 0xf8, 0xfc, 0xc9, 0x8d, 0x74, 0x0b, 0x35, 0x8d, 0xc6, 0x91, 0x4e, 0x50,
+	0x86, 0x7f, 0xfd, 0xf9, 0x93, 0x1b, 0x92, 0x76, 0x3e, 0xd4, 0x7c, 0x0f, 0xa1, 0x1a, 0xd5, 0x0b,
+	0x0b, 0x20, 0x61, 0xb8, 0x8d, 0x03, 0xeb, 0x10, 0x99, 0xd9, 0x64, 0x4e, 0x5a, 0x4b, 0x14, 0xcf,
+	0x87, 0x91, 0xf6, 0x29, 0x8a, 0x16, 0x30, 0xdd, 0x4d, 0xbc, 0xfb, 0xbe, 0x2c, 0xfd, 0xe3, 0x7d,
+	0x59, 0x52, 0xfe, 0x1b, 0x01, 0x0b, 0x55, 0xd4, 0xa0, 0x80, 0x51, 0x41, 0xca, 0xcf, 0x48, 0x3d,
+	0x40, 0xef, 0xd5, 0xe3, 0x9e, 0x0c, 0x7c, 0x5c, 0x97, 0x4b, 0x21, 0xf0, 0x04, 0x56, 0x45, 0x03,
+	0xfe, 0xa8, 0x6c, 0x72, 0xec, 0x13, 0x50, 0x2f, 0x8e, 0xc7, 0xfe, 0x6b, 0x20, 0xd9, 0x31, 0x5c,
+	0x64, 0x7b, 0xc4, 0x52, 0x94, 0xf2, 0xe6, 0x8e, 0x7b, 0x72, 0x62, 0x87, 0x4e, 0x52, 0x89, 0x0c,
+	0x93, 0x08, 0xd8, 0x14, 0x2d, 0xc1, 0x7e, 0x97, 0xc3, 0xd4, 0x89, 0x9d, 0x21, 0x75, 0xe6, 0x4f,
+	0x9f, 0x3a, 0x6f, 0x02, 0x70, 0x68, 0x61, 0x6b, 0xcf, 0x6a, 0x59, 0xde, 0x11, 0x05, 0xed, 0xd2,
+	0x9d, 0x17, 0xf3, 0x23, 0xaa, 0x56, 0x9e, 0xfb, 0x6e, 0x37, 0xe0, 0x2e, 0x5e, 0xec, 0xf7, 0xe4,
+	0x73, 0xcc, 0x40, 0xa8, 0x43, 0xd1, 0x04, 0x85, 0x82, 0xfb, 0xff, 0x19, 0x01, 0xc9, 0x3a, 0x46,
+	0xee, 0xa6, 0xeb, 0x74, 0x3b, 0xb3, 0x0a, 0xc0, 0x3a, 0x00, 0x98, 0x2d, 0x4b, 0x0f, 0x02, 0xa1,
+	0x1c, 0xf7, 0xe4, 0x24, 0x5f, 0x6c, 0xb9, 0x14, 0x2e, 0x31, 0x64, 0x54, 0xb4, 0x24, 0x1f, 0x04,
+	0x31, 0x8c, 0x4e, 0x8e, 0xe1, 0xff, 0x39, 0x08, 0x9b, 0x20, 0xd5, 0x41, 0x6e, 0xdb, 0xc2, 0xd8,
+	0x72, 0x6c, 0x9c, 0x8d, 0xe7, 0xa2, 0x6b, 0xc9, 0xe2, 0xb5, 0x50, 0x52, 0x20, 0x92, 0xcc, 0x4a,
+	0xed, 0x84, 0x63, 0x4d, 0x94, 0x14, 0xdc, 0xfd, 0xc7, 0x08, 0x58, 0x22, 0xee, 0x0e, 0x59, 0x61,
+	0x61, 0x94, 0xcf, 0x97, 0x06, 0x7d, 0x3e, 0xe0, 0xdd, 0x9b, 0x23, 0xbc, 0xbb, 0x38, 0xe0, 0x5d,
+	0xd1, 0x91, 0x37, 0x41, 0xac, 0x8b, 0x91, 0xcb, 0xeb, 0x76, 0x76, 0x6c, 0x99, 0xa3, 0x5c, 0xf0,
+	0xf6, 0xe0, 0x96, 0x63, 0x74, 0xcb, 0xcb, 0x93, 0x36, 0x07, 0x1b, 0x60, 0x19, 0x3d, 0xee, 0x58,
+	0xae, 0x50, 0x18, 0xe7, 0xa7, 0x16, 0xc6, 0xd5, 0x7e, 0x4f, 0xbe, 0xc4, 0xbc, 0x38, 0x24, 0xcc,
+	0xca, 0xe2, 0x52, 0x38, 0x4b, 0x84, 0x04, 0x0f, 0xfe, 0x2b, 0x02, 0xe6, 0x37, 0x5d, 0xc3, 0xf6,
+	0x66, 0x05, 0xd6, 0x12, 0x58, 0x68, 0x12, 0x7d, 0xc8, 0xcd, 0x46, 0x86, 0x0f, 0x07, 0x4e, 0x98,
+	0x70, 0x38, 0x70, 0x0e, 0x68, 0xf8, 0x5a, 0x10, 0xf5, 0x74, 0xea, 0xce, 0x85, 0x13, 0xbb, 0x5f,
+	0xb7, 0x8f, 0x8a, 0xb7, 0x87, 0x75, 0x23, 0xe5, 0x4f, 0x1f, 0xdc, 0x7a, 0x76, 0x54, 0x62, 0x6f,
+	0x32, 0xba, 0x6f, 0x02, 0xc1, 0xb7, 0x40, 0xd2, 0x68, 0xb5, 0x9c, 0x47, 0x86, 0xdd, 0x60, 0x90,
+	0x1f, 0x67, 0xe4, 0xb5, 0xb0, 0x76, 0x05, 0x02, 0xc4, 0xcc, 0x35, 0xbe, 0x85, 0x7d, 0x84, 0xa8,
+	0xce, 0xe0, 0x00, 0xb9, 0x87, 0xd0, 0xba, 0xcf, 0x58, 0xd6, 0x42, 0x2b, 0x82, 0xdb, 0x31, 0x48,
+	0xb1, 0x32, 0xc1, 0xd6, 0xf2, 0x4d, 0x8e, 0x2a, 0x89, 0x7a, 0xec, 0x7a, 0x98, 0x79, 0x5d, 0x3c,
+	0xc9, 0x5d, 0x54, 0xe8, 0xee, 0x75, 0x5f, 0xeb, 0x94, 0xad, 0x2b, 0x2e, 0x48, 0xd3, 0xba, 0xe4,
+	0x5b, 0xfd, 0x06, 0x48, 0x34, 0xc9, 0xd8, 0x0f, 0xf7, 0x62, 0x71, 0xf5, 0xb8, 0x27, 0x2f, 0x50,
+	0x9e, 0x72, 0x29, 0x3c, 0x71, 0x7c, 0x26, 0x85, 0x38, 0x8f, 0xd0, 0xcc, 0xd3, 0xdb, 0xfc, 0x8f,
+	0x04, 0x9e, 0xf3, 0xf1, 0x53, 0x21, 0xdd, 0x43, 0xcd, 0x35, 0x6c, 0xbc, 0x8f, 0x5c, 0x0d, 0xbd,
+	0xd5, 0x45, 0xd8, 0x9b, 0x5d, 0x8d, 0x8c, 0x63, 0x64, 0x9b, 0x01, 0xea, 0x5e, 0xea, 0xf7, 0xe4,
+	0x45, 0x2e, 0x43, 0xe7, 0xc7, 0x7b, 0x91, 0x0b, 0x92, 0xfe, 0xca, 0x45, 0x0d, 0x64, 0x1d, 0x06,
+	0xe9, 0x2d, 0xf4, 0x57, 0x3e, 0x65, 0x42, 0x7f, 0xe5, 0xb3, 0x08, 0x61, 0xfe, 0x38, 0x0a, 0x52,
+	0xcc, 0x9d, 0xf6, 0xa1, 0xe5, 0xa1, 0x59, 0x6d, 0x56, 0x0c, 0x5c, 0xe4, 0x4c, 0x81, 0x83, 0xaf,
+	0x82, 0x14, 0x46, 0x0d, 0x17, 0x79, 0xfa, 0x81, 0x81, 0x0f, 0x4e, 0xb6, 0x9f, 0x02, 0x91, 0xd8,
+	0xa4, 0xa3, 0xef, 0x18, 0xf8, 0x00, 0xe6, 0x41, 0xa2, 0x6d, 0x3c, 0xd6, 0xbb, 0x18, 0x61, 0x9a,
+	0x2d, 0x8b, 0x62, 0x4f, 0xe2, 0x53, 0x14, 0x6d, 0xa1, 0x6d, 0x3c, 0xae, 0x63, 0x84, 0xc9, 0x61,
+	0x42, 0x79, 0xe7, 0x29, 0xef, 0xf2, 0x00, 0xa4, 0xb1, 0x42, 0xa1, 0x3b, 0xb2, 0xd8, 0xc5, 0x67,
+	0x5d, 0xec, 0xc4, 0x76, 0x75, 0xe1, 0x0b, 0xb7, 0xab, 0x42, 0x50, 0x7f, 0x14, 0x05, 0x19, 0xea,
+	0xea, 0xf5, 0x4e, 0xa7, 0x65, 0x35, 0x8c, 0x59, 0xf6, 0x5a, 0x5f, 0x22, 0xb2, 0xaf, 0x83, 0xa4,
+	0xc1, 0x16, 0x64, 0x7b, 0x3c, 0xae, 0x37, 0x85, 0xca, 0xe5, 0x93, 0xc6, 0x6f, 0x35, 0x14, 0x87,
+	0x37, 0xc1, 0x42, 0x1b, 0x61, 0x6c, 0x34, 0xfd, 0x66, 0x00, 0x86, 0x2e, 0xe3, 0x04, 0x12, 0x6a,
+	0xf6, 0x0b, 0xee, 0x0f, 0x77, 0xf2, 0xd3, 0x0f, 0xac, 0x6b, 0xd3, 0x3a, 0x79, 0xd6, 0x1d, 0x0f,
+	0xb4, 0xf3, 0x42, 0x08, 0xfe, 0x1d, 0x01, 0x29, 0xdf, 0xaf, 0x45, 0x63, 0x66, 0xde, 0xf7, 0xcb,
+	0x70, 0xe4, 0x0b, 0x94, 0x61, 0xf8, 0x12, 0x88, 0x93, 0x0b, 0x56, 0x70, 0xa7, 0x3b, 0x17, 0x56,
+	0x20, 0x36, 0xaf, 0x68, 0x9c, 0x01, 0x96, 0x41, 0x72, 0xcf, 0xb0, 0x6d, 0x64, 0xea, 0x7b, 0xfe,
+	0x55, 0x4e, 0x08, 0x55, 0x40, 0x9a, 0x50, 0x6b, 0x18, 0x4f, 0xf1, 0xe8, 0x69, 0xb7, 0x0b, 0x1f,
+	0x44, 0x40, 0x52, 0x33, 0x3c, 0xb4, 0x65, 0xb5, 0xad, 0x99, 0xd5, 0xee, 0x4d, 0x90, 0x6e, 0xe3,
+	0xa6, 0x4e, 0x2e, 0x4b, 0x7a, 0xd7, 0x6d, 0x71, 0xf7, 0x5f, 0x23, 0x7a, 0xee, 0xe3, 0x66, 0xed,
+	0xa8, 0x83, 0xea, 0xda, 0x56, 0xbf, 0x27, 0x9f, 0xe7, 0x00, 0x14, 0x78, 0x15, 0x0d, 0xb4, 0x39,
+	0x8b, 0xdb, 0x82, 0x77, 0x41, 0x9a, 0x54, 0x22, 0x8e, 0x4b, 0xcc, 0xfb, 0xdd, 0x67, 0x04, 0x51,
+	0x81, 0xaa, 0x68, 0xa9, 0xb6, 0xf1, 0xf8, 0x3e, 0x1f, 0xc1, 0x37, 0x40, 0xfc, 0x91, 0x65, 0x9b,
+	0xce, 0x23, 0xde, 0x0b, 0xac, 0x9c, 0xf0, 0x5f, 0x89, 0xbf, 0x1f, 0x14, 0x57, 0x39, 0x78, 0x79,
+	0x74, 0x99, 0x98, 0xf2, 0x6e, 0x80, 0x5a, 0xae, 0x48, 0x70, 0xdb, 0x8f, 0x23, 0x20, 0x13, 0xb8,
+	0x6d, 0xc3, 0xe9, 0xd2, 0x1e, 0xa7, 0x01, 0xd2, 0x8c, 0x51, 0xc7, 0x9e, 0xe1, 0x7a, 0x59, 0x69,
+	0x6a, 0xdc, 0xae, 0x72, 0xc3, 0xe7, 0x45, 0xc3, 0x4c, 0x5a, 0x48, 0x9a, 0x14, 0x9b, 0xaf, 0x92,
+	0x69, 0xf8, 0x1a, 0x58, 0x6c, 0x74, 0x5d, 0x7a, 0xe3, 0x6a, 0x10, 0xbb, 0xbc, 0xaa, 0x64, 0x85,
+	0xdc, 0x13, 0xc9, 0x8a, 0x96, 0xe6, 0x63, 0xba, 0x4a, 0xf8, 0x6d, 0xb0, 0xd4, 0x71, 0xd1, 0xa1,
+	0xe5, 0x74, 0x31, 0x97, 0x67, 0x3e, 0x5d, 0xe9, 0xf7, 0xe4, 0x8b, 0x4c, 0x7e, 0x90, 0xae, 0x68,
+	0x8b, 0xfe, 0x04, 0xd5, 0x20, 0x38, 0xe1, 0xef, 0x51, 0x70, 0xa1, 0xc6, 0xdf, 0x20, 0x76, 0x1d,
+	0xcf, 0xb2, 0x9b, 0x1b, 0x8e, 0xbd, 0x6f, 0x35, 0xbf, 0x02, 0xb5, 0x13, 0x83, 0xa4, 0x77, 0xe0,
+	0x22, 0x7c, 0xe0, 0xb4, 0x4c, 0x9e, 0xbe, 0x75, 0xe2, 0xeb, 0xbf, 0xf5, 0xe4, 0x17, 0x4f, 0x71,
+	0xc1, 0x2f, 0xa1, 0x46, 0x98, 0xbe, 0x81, 0x22, 0x92, 0xbe, 0x80, 0xa7, 0x6f, 0x09, 0x35, 0x58,
+	0x70, 0x42, 0x3b, 0x10, 0x81, 0xc5, 0x43, 0xea, 0x06, 0xbd, 0x83, 0x5c, 0xcb, 0x31, 0xa7, 0x03,
+	0x6f, 0xa8, 0x6a, 0x0e, 0x48, 0x0b, 0xf8, 0x4b, 0x33, 0xc2, 0x0e, 0x9d, 0x87, 0x08, 0xc4, 0xdf,
+	0xea, 0x3a, 0x6e, 0xb7, 0xcd, 0xef, 0x6a, 0xf7, 0x3f, 0xec, 0xc9, 0xd2, 0x99, 0x36, 0xc6, 0x71,
+	0xce, 0xb4, 0x0c, 0xed, 0x4a, 0xe3, 0xca, 0x85, 0x38, 0xff, 0x7c, 0x01, 0x64, 0xfc, 0x38, 0xef,
+	0xb8, 0x4e, 0xc7, 0xc1, 0x46, 0x6b, 0xf6, 0x6f, 0x11, 0x13, 0xde, 0xe1, 0x36, 0x41, 0xa2, 0x43,
+	0xed, 0x8e, 0x6a, 0xe4, 0x7c, 0xca, 0x84, 0xe2, 0xea, 0xb3, 0x40, 0x15, 0x24, 0x82, 0x5a, 0x12,
+	0xcb, 0x45, 0xc7, 0xde, 0x10, 0xc4, 0x4e, 0x28, 0xa8, 0x2e, 0x81, 0xe8, 0x00, 0x30, 0xe7, 0xbf,
+	0x04, 0x30, 0xe3, 0x4f, 0x09, 0x98, 0x3f, 0xa0, 0xb1, 0x6a, 0x5b, 0xde, 0x69, 0xdf, 0xe5, 0xae,
+	0x70, 0x5c, 0x86, 0xd1, 0xf3, 0x85, 0x85, 0xb2, 0x04, 0xd8, 0x34, 0x91, 0x82, 0x0f, 0xc1, 0x32,
+	0x07, 0x2f, 0xb2, 0x4d, 0x66, 0x25, 0x31, 0xd5, 0xca, 0x75, 0x6e, 0xe5, 0xd2, 0x00, 0xfa, 0x7d,
+	0x05, 0x82, 0x25, 0x9e, 0x56, 0xaa, 0x6d, 0x52, 0x63, 0xbb, 0x20, 0x8e, 0x3d, 0xc3, 0xeb, 0x62,
+	0xfa, 0x96, 0xb6, 0x74, 0xe7, 0xe5, 0x91, 0x0f, 0x3f, 0xc3, 0x88, 0xad, 0x52, 0x11, 0xf1, 0x14,
+	0x67, 0x4a, 0x14, 0x8d, 0x6b, 0x23, 0x57, 0x8e, 0x43, 0xc7, 0x43, 0x2e, 0xce, 0x82, 0x5c, 0x74,
+	0xf0, 0xca, 0xc1, 0xe6, 0x27, 0x5c, 0x39, 0x18, 0x83, 0x90, 0x9b, 0xa9, 0xa7, 0x91, 0x9b, 0x73,
+	0xca, 0x1f, 0x22, 0x20, 0x2d, 0xd4, 0xe0, 0x99, 0xdd, 0x48, 0x54, 0x90, 0xea, 0x70, 0xc7, 0xe9,
+	0x41, 0x82, 0x52, 0x35, 0xbe, 0x3f, 0x45, 0x35, 0x02, 0xab, 0xa2, 0x01, 0x7f, 0x54, 0x36, 0xc9,
+	0xcb, 0x34, 0xf5, 0x4c, 0x36, 0x3a, 0xfc, 0x32, 0x4d, 0xa7, 0x27, 0xbc, 0x4c, 0x53, 0x3a, 0xd4,
+	0x40, 0xdc, 0x61, 0xef, 0x52, 0x31, 0x1a, 0xea, 0xeb, 0x13, 0x43, 0x4d, 0x1c, 0x50, 0xa1, 0xec,
+	0x62, 0x98, 0x1d, 0xfe, 0x76, 0xc5, 0x35, 0x09, 0x85, 0xed, 0x49, 0x0c, 0x5c, 0xe2, 0xfd, 0x02,
+	0x3d, 0xc8, 0x71, 0x70, 0xcb, 0x87, 0x2d, 0xb0, 0xc4, 0xd3, 0x5d, 0x6f, 0x51, 0x52, 0x56, 0xa2,
+	0xf5, 0xe2, 0x85, 0x91, 0x0b, 0x10, 0x95, 0x14, 0x15, 0x0e, 0xeb, 0x8b, 0x03, 0x05, 0x84, 0xab,
+	0x51, 0x38, 0xa2, 0xdb, 0xa2, 0x59, 0xf8, 0x5b, 0x09, 0x40, 0x56, 0xf5, 0x75, 0xdc, 0x21, 0x09,
+	0x40, 0x99, 0xb3, 0x91, 0x69, 0x8f, 0xda, 0x0d, 0x6e, 0x6a, 0x25, 0x78, 0x70, 0x1b, 0x52, 0x31,
+	0x8b, 0x17, 0xed, 0x0c, 0x53, 0x5b, 0x25, 0x5a, 0x59, 0x9b, 0xf8, 0x06, 0x88, 0xf3, 0x13, 0x2e,
+	0x7a, 0xc6, 0xd6, 0xea, 0xc4, 0xd1, 0xc6, 0x15, 0xc1, 0x3a, 0x00, 0x61, 0x8f, 0x9a, 0x8d, 0x4d,
+	0xad, 0x1d, 0x2b, 0xe1, 0x0b, 0x69, 0x28, 0xc7, 0x9a, 0x5d, 0x41, 0xd1, 0xdd, 0xca, 0xdb, 0xef,
+	0xcb, 0x73, 0xa7, 0x7e, 0xda, 0xf9, 0xd9, 0xe7, 0x4f, 0x6e, 0x3c, 0xcf, 0x3f, 0x8e, 0x8d, 0xc6,
+	0x85, 0xf2, 0x9e, 0x04, 0xd2, 0x22, 0xe9, 0x44, 0xaf, 0x2b, 0xcd, 0xaa, 0xd7, 0x8d, 0x9c, 0xbe,
+	0xd7, 0x15, 0x20, 0xfd, 0x97, 0x18, 0x58, 0x0a, 0x56, 0x5b, 0x27, 0xd4, 0xaf, 0x40, 0x37, 0x56,
+	0x1a, 0x7c, 0xfc, 0x1b, 0xf1, 0x84, 0x88, 0xa6, 0x3e, 0x21, 0x22, 0xd2, 0x5e, 0x73, 0x80, 0xbb,
+	0x08, 0x23, 0x2f, 0x1b, 0x3b, 0x6b, 0x7b, 0x2d, 0x4a, 0x8b, 0xed, 0x35, 0x9b, 0xd7, 0xc8, 0x34,
+	0xfc, 0xa5, 0x04, 0xd2, 0x42, 0x1a, 0x79, 0xd9, 0xf9, 0x69, 0x39, 0xf8, 0xe6, 0x48, 0x23, 0x54,
+	0x78, 0x16, 0xd9, 0x97, 0x0a, 0xb3, 0xcf, 0x13, 0xab, 0x52, 0x97, 0xa1, 0x24, 0x3e, 0xbd, 0x2a,
+	0x51, 0x14, 0x8c, 0xab, 0x4a, 0x4c, 0xcd, 0x50, 0x55, 0xaa, 0x0f, 0xa3, 0xea, 0xa7, 0x21, 0xea,
+	0x19, 0xa6, 0x66, 0x86, 0xfa, 0x17, 0xc1, 0xbc, 0x78, 0x8d, 0xc9, 0x84, 0x07, 0x04, 0xbf, 0x7d,
+	0x30, 0xb2, 0xb0, 0x96, 0x3f, 0x47, 0xc0, 0x72, 0xf0, 0x65, 0x6d, 0xb6, 0x17, 0x8e, 0xaf, 0x01,
+	0x40, 0xe2, 0xab, 0x9b, 0xc8, 0x76, 0xda, 0xfc, 0xd6, 0x2a, 0x7c, 0x2d, 0x0a, 0x69, 0x8a, 0x96,
+	0x24, 0x83, 0x12, 0xf9, 0x0d, 0x35, 0x30, 0xef, 0x1a, 0x1e, 0xbd, 0x9d, 0x8e, 0x8f, 0x85, 0xbf,
+	0x62, 0x72, 0x69, 0x2c, 0xae, 0xf0, 0x58, 0xf0, 0x9d, 0x52, 0x69, 0x1e, 0x02, 0xa6, 0x0a, 0x36,
+	0xe8, 0x47, 0x19, 0xcf, 0xb2, 0x0d, 0xe1, 0xf0, 0x7b, 0x69, 0xa2, 0x66, 0x5c, 0x0a, 0x05, 0x86,
+	0xbe, 0xdf, 0xf8, 0xd3, 0xec, 0xfb, 0x8d, 0x3f, 0x12, 0x7c, 0xfa, 0x5e, 0x04, 0xa4, 0xc5, 0x15,
+	0x92, 0xb0, 0x30, 0x27, 0xb0, 0xc0, 0x0a, 0x61, 0xe1, 0xfb, 0x67, 0x64, 0x68, 0x82, 0x18, 0x59,
+	0x30, 0xf7, 0xd5, 0xce, 0x99, 0x3b, 0xd9, 0x54, 0xe8, 0x81, 0x91, 0x4d, 0x2c, 0xd5, 0x0e, 0xbb,
+	0x00, 0x90, 0xe2, 0x67, 0xb4, 0x83, 0x0b, 0x6b, 0xb2, 0xb8, 0x7b, 0x06, 0x5b, 0x65, 0xdb, 0x0b,
+	0xa3, 0x18, 0x6a, 0x12, 0x2d, 0x96, 0x6d, 0x8f, 0xb7, 0xcd, 0x6d, 0xe3, 0xf1, 0x7a, 0x7b, 0x10,
+	0x73, 0x37, 0x7e, 0x22, 0x81, 0x73, 0x27, 0x3e, 0x24, 0xc2, 0xe7, 0xc1, 0x4a, 0x55, 0xdd, 0xa8,
+	0x95, 0x2b, 0xdb, 0xfa, 0x6e, 0xb9, 0x5a, 0x2e, 0x96, 0xb7, 0xca, 0xb5, 0x07, 0xfa, 0x4e, 0xbd,
+	0xb8, 0x55, 0xde, 0xc8, 0xcc, 0xc1, 0x2b, 0x40, 0x1e, 0x41, 0xbe, 0xaf, 0xde, 0x2f, 0xaa, 0x5a,
+	0x55, 0xaf, 0x6c, 0x6f, 0x3d, 0xc8, 0x48, 0xf0, 0x3a, 0xb8, 0x32, 0x82, 0x69, 0x53, 0xab, 0xd4,
+	0x77, 0x74, 0x4d, 0xad, 0xd6, 0xb4, 0xf2, 0x46, 0x4d, 0x2d, 0x65, 0x22, 0x97, 0x63, 0x6f, 0xff,
+	0x6a, 0x75, 0xee, 0xc6, 0xa7, 0x12, 0xb8, 0x34, 0xba, 0xb1, 0x85, 0x6b, 0xe0, 0x6a, 0x4d, 0x53,
+	0xd7, 0xab, 0x75, 0xed, 0x81, 0xbe, 0xa3, 0x55, 0x76, 0x2a, 0xd5, 0xf5, 0x2d, 0xbd, 0x5a, 0x5b,
+	0xaf, 0xd5, 0xab, 0x7a, 0x7d, 0xbb, 0xba, 0xa3, 0x6e, 0x94, 0xef, 0x95, 0xd5, 0x12, 0x5b, 0xd8,
+	0x58, 0xce, 0xdd, 0x4a, 0xad, 0xbc, 0xbd, 0x99, 0x91, 0xe0, 0x35, 0xf0, 0xc2, 0x58, 0x26, 0xf5,
+	0x7b, 0xea, 0x46, 0x9d, 0x2e, 0x6b, 0xa2, 0xae, 0x7b, 0xeb, 0xe5, 0x2d, 0xb5, 0x94, 0x89, 0x4e,
+	0xd4, 0xa5, 0xa9, 0xaf, 0xab, 0x74, 0x8b, 0x31, 0xbe, 0xc5, 0x1f, 0x02, 0x78, 0xb2, 0x9f, 0x83,
+	0x57, 0x41, 0x2e, 0x50, 0xb1, 0x5b, 0xa9, 0xa9, 0x7a, 0x65, 0x87, 0x3a, 0x6d, 0x70, 0x67, 0xcf,
+	0x81, 0xec, 0x48, 0xae, 0x07, 0x6a, 0x35, 0x23, 0xc1, 0x67, 0xc1, 0x33, 0x23, 0xa9, 0xdb, 0x95,
+	0xc0, 0xbf, 0x0f, 0xc1, 0x85, 0x51, 0xf9, 0x44, 0x9c, 0x7b, 0x4f, 0x55, 0xf5, 0x5a, 0xe5, 0xbb,
+	0xea, 0x76, 0x55, 0x2f, 0xa9, 0xd5, 0x5a, 0x79, 0x7b, 0x9d, 0x0a, 0x93, 0xe9, 0x8d, 0xca, 0xd6,
+	0x96, 0xba, 0x51, 0xab, 0x68, 0xcc, 0xb9, 0x63, 0x38, 0x7d, 0xdb, 0x19, 0x89, 0x19, 0x2b, 0x6e,
+	0x7d, 0x78, 0xbc, 0x2a, 0x7d, 0x74, 0xbc, 0x2a, 0x7d, 0x7a, 0xbc, 0x2a, 0xbd, 0xf3, 0xd9, 0xea,
+	0xdc, 0x47, 0x9f, 0xad, 0xce, 0xfd, 0xf5, 0xb3, 0xd5, 0xb9, 0xef, 0xdf, 0x11, 0x40, 0xcd, 0x72,
+	0xfe, 0x56, 0xcb, 0xd8, 0xc3, 0xfc, 0x77, 0xe1, 0xf0, 0xd5, 0xc2, 0x63, 0xe1, 0xaf, 0x77, 0x28,
+	0xc8, 0xf7, 0xe2, 0xf4, 0x00, 0x7c, 0xe5, 0x7f, 0x03, 0x00, 0x92, 0x88, 0x03, 0xd7, 0xde, 0x23,
+	0x00, 0x00,
+}
+
+func (this *Subspace) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+
+	that1, ok := that.(*Subspace)
+	if !ok {
+		that2, ok := that.(Subspace)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
-		}
+	if this.ID != that1.ID {
+		return false
 	}
-	return n
-}
-
-func (m *UserPermission) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Name != that1.Name {
+		return false
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovModels(uint64(m.SubspaceID))
+	if this.Description != that1.Description {
+		return false
 	}
-	if m.SectionID != 0 {
-		n += 1 + sovModels(uint64(m.SectionID))
+	if this.Treasury != that1.Treasury {
+		return false
 	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if this.Owner != that1.Owner {
+		return false
 	}
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if !this.CreationTime.Equal(that1.CreationTime) {
+		return false
+	}
+	if len(this.AdditionalFeeTokens) != len(that1.AdditionalFeeTokens) {
+		return false
+	}
+	for i := range this.AdditionalFeeTokens {
+		if !this.AdditionalFeeTokens[i].Equal(&that1.AdditionalFeeTokens[i]) {
+			return false
 		}
 	}
-	return n
+	if this.Archived != that1.Archived {
+		return false
+	}
+	return true
 }
+func (this *Section) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Grant) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Section)
+	if !ok {
+		that2, ok := that.(Section)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovModels(uint64(m.SubspaceID))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if this.SubspaceID != that1.SubspaceID {
+		return false
 	}
-	if m.Grantee != nil {
-		l = m.Grantee.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if this.ID != that1.ID {
+		return false
 	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if this.ParentID != that1.ParentID {
+		return false
 	}
-	return n
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Visibility != that1.Visibility {
+		return false
+	}
+	return true
 }
+func (this *UserGroup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UserGrantee) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*UserGroup)
+	if !ok {
+		that2, ok := that.(UserGroup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.SectionID != that1.SectionID {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Permissions) != len(that1.Permissions) {
+		return false
+	}
+	for i := range this.Permissions {
+		if this.Permissions[i] != that1.Permissions[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *UserPermission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserPermission)
+	if !ok {
+		that2, ok := that.(UserPermission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.SectionID != that1.SectionID {
+		return false
+	}
+	if this.User != that1.User {
+		return false
+	}
+	if len(this.Permissions) != len(that1.Permissions) {
+		return false
+	}
+	for i := range this.Permissions {
+		if this.Permissions[i] != that1.Permissions[i] {
+			return false
+		}
+	}
+	if that1.ExpirationTime == nil {
+		if this.ExpirationTime != nil {
+			return false
+		}
+	} else if !this.ExpirationTime.Equal(*that1.ExpirationTime) {
+		return false
+	}
+	return true
+}
+func (this *Grant) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Grant)
+	if !ok {
+		that2, ok := that.(Grant)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.Granter != that1.Granter {
+		return false
+	}
+	if !this.Grantee.Equal(that1.Grantee) {
+		return false
+	}
+	if !this.Allowance.Equal(that1.Allowance) {
+		return false
+	}
+	return true
+}
+func (this *UserGrantee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserGrantee)
+	if !ok {
+		that2, ok := that.(UserGrantee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.User != that1.User {
+		return false
+	}
+	return true
+}
+func (this *GroupGrantee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GroupGrantee)
+	if !ok {
+		that2, ok := that.(GroupGrantee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	return true
+}
+func (this *SubspaceOwnerTransferRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubspaceOwnerTransferRequest)
+	if !ok {
+		that2, ok := that.(SubspaceOwnerTransferRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	return true
+}
+func (this *GroupInvite) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GroupInvite)
+	if !ok {
+		that2, ok := that.(GroupInvite)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	if this.SecretHash != that1.SecretHash {
+		return false
+	}
+	if this.MaxUses != that1.MaxUses {
+		return false
+	}
+	if this.Uses != that1.Uses {
+		return false
+	}
+	if that1.ExpirationTime == nil {
+		if this.ExpirationTime != nil {
+			return false
+		}
+	} else if !this.ExpirationTime.Equal(*that1.ExpirationTime) {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	return true
+}
+func (this *GroupApplication) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GroupApplication)
+	if !ok {
+		that2, ok := that.(GroupApplication)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	if this.Applicant != that1.Applicant {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if !this.CreationTime.Equal(that1.CreationTime) {
+		return false
+	}
+	return true
+}
+func (this *SubspaceBan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubspaceBan)
+	if !ok {
+		that2, ok := that.(SubspaceBan)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.User != that1.User {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.BannedBy != that1.BannedBy {
+		return false
+	}
+	if that1.ExpirationTime == nil {
+		if this.ExpirationTime != nil {
+			return false
+		}
+	} else if !this.ExpirationTime.Equal(*that1.ExpirationTime) {
+		return false
+	}
+	return true
+}
+func (this *RateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RateLimit)
+	if !ok {
+		that2, ok := that.(RateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.MsgTypeURL != that1.MsgTypeURL {
+		return false
+	}
+	if this.MaxMessages != that1.MaxMessages {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	return true
+}
+func (this *RateLimitCounter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RateLimitCounter)
+	if !ok {
+		that2, ok := that.(RateLimitCounter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return false
+	}
+	if this.CurrentCount != that1.CurrentCount {
+		return false
+	}
+	if this.PreviousCount != that1.PreviousCount {
+		return false
+	}
+	return true
+}
+func (this *TreasuryVotingConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TreasuryVotingConfig)
+	if !ok {
+		that2, ok := that.(TreasuryVotingConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	if this.VotingPeriod != that1.VotingPeriod {
+		return false
+	}
+	if that1.Quorum == nil {
+		if this.Quorum != nil {
+			return false
+		}
+	} else if !this.Quorum.Equal(*that1.Quorum) {
+		return false
+	}
+	return true
+}
+func (this *TreasuryVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TreasuryVote)
+	if !ok {
+		that2, ok := that.(TreasuryVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.ProposalID != that1.ProposalID {
+		return false
+	}
+	if this.Voter != that1.Voter {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	return true
+}
+func (this *MessageLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MessageLimit)
+	if !ok {
+		that2, ok := that.(MessageLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeURL != that1.MsgTypeURL {
+		return false
+	}
+	if this.MaxMessages != that1.MaxMessages {
+		return false
+	}
+	return true
+}
+func (this *AllowanceUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AllowanceUsage)
+	if !ok {
+		that2, ok := that.(AllowanceUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	if this.Grantee != that1.Grantee {
+		return false
+	}
+	if !this.PeriodReset.Equal(that1.PeriodReset) {
+		return false
+	}
+	if len(this.PeriodSpent) != len(that1.PeriodSpent) {
+		return false
+	}
+	for i := range this.PeriodSpent {
+		if !this.PeriodSpent[i].Equal(&that1.PeriodSpent[i]) {
+			return false
+		}
+	}
+	if len(this.MessageUsages) != len(that1.MessageUsages) {
+		return false
+	}
+	for i := range this.MessageUsages {
+		if !this.MessageUsages[i].Equal(&that1.MessageUsages[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MessageUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MessageUsage)
+	if !ok {
+		that2, ok := that.(MessageUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeURL != that1.MsgTypeURL {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *FeeTokensConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeTokensConfig)
+	if !ok {
+		that2, ok := that.(FeeTokensConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubspaceID != that1.SubspaceID {
+		return false
+	}
+	if this.BaseDenom != that1.BaseDenom {
+		return false
+	}
+	if len(this.Rates) != len(that1.Rates) {
+		return false
+	}
+	for i := range this.Rates {
+		if !this.Rates[i].Equal(&that1.Rates[i]) {
+			return false
+		}
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	return true
+}
+func (this *FeeTokenRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeTokenRate)
+	if !ok {
+		that2, ok := that.(FeeTokenRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if !this.MaxAmount.Equal(that1.MaxAmount) {
+		return false
+	}
+	return true
+}
+func (m *Subspace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subspace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.AdditionalFeeTokens) > 0 {
+		for iNdEx := len(m.AdditionalFeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalFeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintModels(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Section) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Section) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Section) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Visibility != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ParentID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ParentID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SectionID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SectionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintModels(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SectionID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SectionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Grantee != nil {
+		{
+			size, err := m.Grantee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserGrantee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserGrantee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserGrantee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupGrantee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupGrantee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupGrantee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubspaceOwnerTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubspaceOwnerTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubspaceOwnerTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpirationTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintModels(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Uses != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Uses))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SecretHash) > 0 {
+		i -= len(m.SecretHash)
+		copy(dAtA[i:], m.SecretHash)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SecretHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintModels(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Applicant) > 0 {
+		i -= len(m.Applicant)
+		copy(dAtA[i:], m.Applicant)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Applicant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubspaceBan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubspaceBan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubspaceBan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintModels(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BannedBy) > 0 {
+		i -= len(m.BannedBy)
+		copy(dAtA[i:], m.BannedBy)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.BannedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintModels(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxMessages != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxMessages))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousCount != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PreviousCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentCount != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CurrentCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintModels(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryVotingConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryVotingConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryVotingConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quorum != nil {
+		{
+			size := m.Quorum.Size()
+			i -= size
+			if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintModels(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GroupID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quorum != nil {
+		{
+			size := m.Quorum.Size()
+			i -= size
+			if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingEndTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintModels(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x42
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintModels(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.GroupID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Option != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageLimitsAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageLimitsAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageLimitsAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintModels(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x22
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintModels(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MessageLimits) > 0 {
+		for iNdEx := len(m.MessageLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMessages != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxMessages))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowanceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowanceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowanceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageUsages) > 0 {
+		for iNdEx := len(m.MessageUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PeriodSpent) > 0 {
+		for iNdEx := len(m.PeriodSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintModels(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTokensConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokensConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokensConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTokenRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModels(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Subspace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovModels(uint64(m.ID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
+	n += 1 + l + sovModels(uint64(l))
+	if len(m.AdditionalFeeTokens) > 0 {
+		for _, e := range m.AdditionalFeeTokens {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Archived {
+		n += 2
+	}
+	return n
+}
+
+func (m *Section) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.ID != 0 {
+		n += 1 + sovModels(uint64(m.ID))
+	}
+	if m.ParentID != 0 {
+		n += 1 + sovModels(uint64(m.ParentID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovModels(uint64(m.Visibility))
+	}
+	return n
+}
+
+func (m *UserGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.SectionID != 0 {
+		n += 1 + sovModels(uint64(m.SectionID))
+	}
+	if m.ID != 0 {
+		n += 1 + sovModels(uint64(m.ID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UserPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.SectionID != 0 {
+		n += 1 + sovModels(uint64(m.SectionID))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Grantee != nil {
+		l = m.Grantee.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *UserGrantee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupGrantee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupID != 0 {
+		n += 1 + sovModels(uint64(m.GroupID))
+	}
+	return n
+}
+
+func (m *SubspaceOwnerTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovModels(uint64(m.GroupID))
+	}
+	l = len(m.SecretHash)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovModels(uint64(m.MaxUses))
+	}
+	if m.Uses != 0 {
+		n += 1 + sovModels(uint64(m.Uses))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovModels(uint64(m.GroupID))
+	}
+	l = len(m.Applicant)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *SubspaceBan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.BannedBy)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.MaxMessages != 0 {
+		n += 1 + sovModels(uint64(m.MaxMessages))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *RateLimitCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovModels(uint64(l))
+	if m.CurrentCount != 0 {
+		n += 1 + sovModels(uint64(m.CurrentCount))
+	}
+	if m.PreviousCount != 0 {
+		n += 1 + sovModels(uint64(m.PreviousCount))
+	}
+	return n
+}
+
+func (m *TreasuryVotingConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovModels(uint64(m.GroupID))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovModels(uint64(l))
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *TreasuryProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.ID != 0 {
+		n += 1 + sovModels(uint64(m.ID))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovModels(uint64(m.GroupID))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovModels(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovModels(uint64(m.Status))
+	}
+	if len(m.Voters) > 0 {
+		for _, s := range m.Voters {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *TreasuryVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.ProposalID != 0 {
+		n += 1 + sovModels(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovModels(uint64(m.Option))
+	}
+	return n
+}
+
+func (m *MessageLimitsAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MessageLimits) > 0 {
+		for _, e := range m.MessageLimits {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovModels(uint64(l))
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.MaxMessages != 0 {
+		n += 1 + sovModels(uint64(m.MaxMessages))
+	}
+	return n
+}
+
+func (m *AllowanceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovModels(uint64(m.GroupID))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovModels(uint64(l))
+	if len(m.PeriodSpent) > 0 {
+		for _, e := range m.PeriodSpent {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.MessageUsages) > 0 {
+		for _, e := range m.MessageUsages {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovModels(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *FeeTokensConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovModels(uint64(m.SubspaceID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Destination != 0 {
+		n += 1 + sovModels(uint64(m.Destination))
+	}
+	return n
+}
+
+func (m *FeeTokenRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	return n
-}
+	l = m.Rate.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func sovModels(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModels(x uint64) (n int) {
+	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Subspace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subspace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subspace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalFeeTokens = append(m.AdditionalFeeTokens, types.Coin{})
+			if err := m.AdditionalFeeTokens[len(m.AdditionalFeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archived = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Section) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Section: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Section: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentID", wireType)
+			}
+			m.ParentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= SectionVisibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+			}
+			m.SectionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+			}
+			m.SectionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Grantee == nil {
+				m.Grantee = &types1.Any{}
+			}
+			if err := m.Grantee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserGrantee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserGrantee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserGrantee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupGrantee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupGrantee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupGrantee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubspaceOwnerTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubspaceOwnerTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubspaceOwnerTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupInvite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupInvite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupInvite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+			}
+			m.Uses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uses |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applicant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applicant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubspaceBan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubspaceBan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubspaceBan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
+			}
+			m.MaxMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessages |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentCount", wireType)
+			}
+			m.CurrentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousCount", wireType)
+			}
+			m.PreviousCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryVotingConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryVotingConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryVotingConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Quorum = &v
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TreasuryProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Quorum = &v
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GroupGrantee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupID != 0 {
-		n += 1 + sovModels(uint64(m.GroupID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovModels(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModels(x uint64) (n int) {
-	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Subspace) Unmarshal(dAtA []byte) error {
+func (m *TreasuryVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1451,17 +7389,17 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Subspace: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreasuryVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Subspace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreasuryVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
 			}
-			m.ID = 0
+			m.SubspaceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1471,16 +7409,16 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.SubspaceID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
-			var stringLen uint64
+			m.ProposalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1490,27 +7428,14 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1538,13 +7463,13 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			var stringLen uint64
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1554,29 +7479,66 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Option |= TreasuryVoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Treasury = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageLimitsAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageLimitsAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageLimitsAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1586,29 +7548,31 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.MessageLimits = append(m.MessageLimits, MessageLimit{})
+			if err := m.MessageLimits[len(m.MessageLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1618,27 +7582,29 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1665,13 +7631,13 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFeeTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1698,8 +7664,10 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalFeeTokens = append(m.AdditionalFeeTokens, types.Coin{})
-			if err := m.AdditionalFeeTokens[len(m.AdditionalFeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1724,7 +7692,7 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Section) Unmarshal(dAtA []byte) error {
+func (m *MessageLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1747,72 +7715,15 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Section: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Section: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
-			}
-			m.SubspaceID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubspaceID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentID", wireType)
-			}
-			m.ParentID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ParentID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1840,13 +7751,13 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
 			}
-			var stringLen uint64
+			m.MaxMessages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1856,24 +7767,11 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxMessages |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -1895,7 +7793,7 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserGroup) Unmarshal(dAtA []byte) error {
+func (m *AllowanceUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1918,10 +7816,10 @@ func (m *UserGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowanceUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowanceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1945,9 +7843,9 @@ func (m *UserGroup) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
 			}
-			m.SectionID = 0
+			m.GroupID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1957,16 +7855,16 @@ func (m *UserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SectionID |= uint32(b&0x7F) << shift
+				m.GroupID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1976,16 +7874,29 @@ func (m *UserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1995,29 +7906,30 @@ func (m *UserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2027,29 +7939,31 @@ func (m *UserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.PeriodSpent = append(m.PeriodSpent, types.Coin{})
+			if err := m.PeriodSpent[len(m.PeriodSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageUsages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2059,23 +7973,25 @@ func (m *UserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			m.MessageUsages = append(m.MessageUsages, MessageUsage{})
+			if err := m.MessageUsages[len(m.MessageUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2098,7 +8014,7 @@ func (m *UserGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserPermission) Unmarshal(dAtA []byte) error {
+func (m *MessageUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2121,53 +8037,15 @@ func (m *UserPermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
-			}
-			m.SubspaceID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubspaceID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
-			}
-			m.SectionID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SectionID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2195,13 +8073,13 @@ func (m *UserPermission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2211,24 +8089,11 @@ func (m *UserPermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -2250,7 +8115,7 @@ func (m *UserPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Grant) Unmarshal(dAtA []byte) error {
+func (m *FeeTokensConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2273,10 +8138,10 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeTokensConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeTokensConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2300,7 +8165,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2328,11 +8193,11 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2359,18 +8224,16 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Grantee == nil {
-				m.Grantee = &types1.Any{}
-			}
-			if err := m.Grantee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rates = append(m.Rates, FeeTokenRate{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var msglen int
+			m.Destination = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2380,28 +8243,11 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Destination |= FeeTokensDestination(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Allowance == nil {
-				m.Allowance = &types1.Any{}
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -2423,7 +8269,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserGrantee) Unmarshal(dAtA []byte) error {
+func (m *FeeTokenRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2446,15 +8292,15 @@ func (m *UserGrantee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserGrantee: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeTokenRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserGrantee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeTokenRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2482,63 +8328,47 @@ func (m *UserGrantee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupGrantee) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupGrantee: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupGrantee: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
 			}
-			m.GroupID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2548,11 +8378,26 @@ func (m *GroupGrantee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
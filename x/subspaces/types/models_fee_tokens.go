@@ -0,0 +1,168 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeTokensConfig returns a new FeeTokensConfig instance
+func NewFeeTokensConfig(subspaceID uint64, baseDenom string, rates []FeeTokenRate, destination FeeTokensDestination) FeeTokensConfig {
+	return FeeTokensConfig{
+		SubspaceID:  subspaceID,
+		BaseDenom:   baseDenom,
+		Rates:       rates,
+		Destination: destination,
+	}
+}
+
+// Validate implements fmt.Validator
+func (config FeeTokensConfig) Validate() error {
+	if config.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", config.SubspaceID)
+	}
+
+	err := sdk.ValidateDenom(config.BaseDenom)
+	if err != nil {
+		return fmt.Errorf("invalid base denom: %s", config.BaseDenom)
+	}
+
+	if len(config.Rates) == 0 {
+		return fmt.Errorf("rates cannot be empty")
+	}
+
+	denoms := map[string]bool{}
+	for _, rate := range config.Rates {
+		if denoms[rate.Denom] {
+			return fmt.Errorf("duplicated rate for denom %s", rate.Denom)
+		}
+		denoms[rate.Denom] = true
+
+		if rate.Denom == config.BaseDenom {
+			return fmt.Errorf("cannot set a rate for the base denom %s", config.BaseDenom)
+		}
+
+		err = rate.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	if !IsValidFeeTokensDestination(config.Destination) {
+		return fmt.Errorf("invalid destination: %d", config.Destination)
+	}
+
+	return nil
+}
+
+// GetRate returns the rate of the fee token having the given denom, if any
+func (config FeeTokensConfig) GetRate(denom string) (FeeTokenRate, bool) {
+	for _, rate := range config.Rates {
+		if rate.Denom == denom {
+			return rate, true
+		}
+	}
+	return FeeTokenRate{}, false
+}
+
+// ConvertMinGasPrices returns the minimum gas prices of the given fee tokens, computed by converting the
+// given base denom minimum gas price using the configured rates.
+// Tokens that have no rate or whose converted price is not positive are skipped, as well as all the tokens
+// if the base denom has no minimum gas price.
+func (config FeeTokensConfig) ConvertMinGasPrices(minGasPrices sdk.DecCoins, feeTokens sdk.Coins) sdk.DecCoins {
+	basePrice := minGasPrices.AmountOf(config.BaseDenom)
+	if !basePrice.IsPositive() {
+		return nil
+	}
+
+	var converted sdk.DecCoins
+	for _, token := range feeTokens {
+		rate, found := config.GetRate(token.Denom)
+		if !found {
+			continue
+		}
+
+		price := basePrice.Mul(rate.Rate)
+		if price.IsPositive() {
+			converted = append(converted, sdk.NewDecCoinFromDec(token.Denom, price))
+		}
+	}
+
+	return converted
+}
+
+// FilterFeeTokens returns the coins of the given fees that have a rate inside this config
+func (config FeeTokensConfig) FilterFeeTokens(fees sdk.Coins) sdk.Coins {
+	var tokens sdk.Coins
+	for _, fee := range fees {
+		if _, found := config.GetRate(fee.Denom); found {
+			tokens = append(tokens, fee)
+		}
+	}
+	return tokens
+}
+
+// ValidateFees checks whether the given fees do not exceed the maximum amounts of the configured fee tokens
+func (config FeeTokensConfig) ValidateFees(fees sdk.Coins) error {
+	for _, fee := range fees {
+		rate, found := config.GetRate(fee.Denom)
+		if !found || rate.MaxAmount.IsZero() {
+			continue
+		}
+
+		if fee.Amount.GT(rate.MaxAmount) {
+			return fmt.Errorf("fee amount %s is greater than the maximum allowed %s%s", fee, rate.MaxAmount, rate.Denom)
+		}
+	}
+	return nil
+}
+
+// NewFeeTokenRate returns a new FeeTokenRate instance
+func NewFeeTokenRate(denom string, rate sdk.Dec, maxAmount sdk.Int) FeeTokenRate {
+	return FeeTokenRate{
+		Denom:     denom,
+		Rate:      rate,
+		MaxAmount: maxAmount,
+	}
+}
+
+// Validate implements fmt.Validator
+func (rate FeeTokenRate) Validate() error {
+	err := sdk.ValidateDenom(rate.Denom)
+	if err != nil {
+		return fmt.Errorf("invalid fee token denom: %s", rate.Denom)
+	}
+
+	if rate.Rate.IsNil() || !rate.Rate.IsPositive() {
+		return fmt.Errorf("invalid rate for denom %s: must be positive", rate.Denom)
+	}
+
+	if rate.MaxAmount.IsNil() || rate.MaxAmount.IsNegative() {
+		return fmt.Errorf("invalid max amount for denom %s: cannot be negative", rate.Denom)
+	}
+
+	return nil
+}
+
+// IsValidFeeTokensDestination tells whether the given destination is a valid fee tokens destination
+func IsValidFeeTokensDestination(destination FeeTokensDestination) bool {
+	_, ok := FeeTokensDestination_name[int32(destination)]
+	return ok
+}
+
+// ParseFeeTokensDestination parses the given value as a fee tokens destination, returning an error if it's invalid.
+// The value can be either the full destination name (eg. FEE_TOKENS_DESTINATION_TREASURY) or its short version
+// (eg. treasury).
+func ParseFeeTokensDestination(value string) (FeeTokensDestination, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(value), "-", "_"))
+	if !strings.HasPrefix(name, "FEE_TOKENS_DESTINATION_") {
+		name = "FEE_TOKENS_DESTINATION_" + name
+	}
+
+	destination, ok := FeeTokensDestination_value[name]
+	if !ok {
+		return FEE_TOKENS_DESTINATION_FEE_COLLECTOR, fmt.Errorf("invalid fee tokens destination: %s", value)
+	}
+	return FeeTokensDestination(destination), nil
+}
@@ -0,0 +1,244 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+func TestFeeTokensConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		config    types.FeeTokensConfig
+		shouldErr bool
+	}{
+		{
+			name: "invalid subspace id returns error",
+			config: types.NewFeeTokensConfig(
+				0,
+				"stake",
+				[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt())},
+				types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid base denom returns error",
+			config: types.NewFeeTokensConfig(
+				1,
+				"",
+				[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt())},
+				types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "empty rates return error",
+			config: types.NewFeeTokensConfig(
+				1,
+				"stake",
+				nil,
+				types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated rates return error",
+			config: types.NewFeeTokensConfig(
+				1,
+				"stake",
+				[]types.FeeTokenRate{
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+					types.NewFeeTokenRate("minttoken", sdk.NewDec(3), sdk.ZeroInt()),
+				},
+				types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "rate for the base denom returns error",
+			config: types.NewFeeTokensConfig(
+				1,
+				"stake",
+				[]types.FeeTokenRate{types.NewFeeTokenRate("stake", sdk.NewDec(2), sdk.ZeroInt())},
+				types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid rate returns error",
+			config: types.NewFeeTokensConfig(
+				1,
+				"stake",
+				[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.ZeroDec(), sdk.ZeroInt())},
+				types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid destination returns error",
+			config: types.NewFeeTokensConfig(
+				1,
+				"stake",
+				[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt())},
+				10,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid config returns no error",
+			config: types.NewFeeTokensConfig(
+				1,
+				"stake",
+				[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000))},
+				types.FEE_TOKENS_DESTINATION_TREASURY,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFeeTokensConfig_ConvertMinGasPrices(t *testing.T) {
+	config := types.NewFeeTokensConfig(
+		1,
+		"stake",
+		[]types.FeeTokenRate{
+			types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.ZeroInt()),
+			types.NewFeeTokenRate("othertoken", sdk.NewDecWithPrec(5, 1), sdk.ZeroInt()),
+		},
+		types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+	)
+
+	testCases := []struct {
+		name         string
+		minGasPrices sdk.DecCoins
+		feeTokens    sdk.Coins
+		expResult    sdk.DecCoins
+	}{
+		{
+			name:         "base denom without min gas price returns nil",
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDec(1))),
+			feeTokens:    sdk.NewCoins(sdk.NewInt64Coin("minttoken", 1)),
+			expResult:    nil,
+		},
+		{
+			name:         "tokens without rate are skipped",
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDec(1))),
+			feeTokens:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)),
+			expResult:    nil,
+		},
+		{
+			name:         "prices are converted properly",
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))),
+			feeTokens:    sdk.NewCoins(sdk.NewInt64Coin("minttoken", 1), sdk.NewInt64Coin("othertoken", 1)),
+			expResult: sdk.DecCoins{
+				sdk.NewDecCoinFromDec("minttoken", sdk.NewDecWithPrec(2, 2)),
+				sdk.NewDecCoinFromDec("othertoken", sdk.NewDecWithPrec(5, 3)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := config.ConvertMinGasPrices(tc.minGasPrices, tc.feeTokens)
+			require.Equal(t, tc.expResult, result)
+		})
+	}
+}
+
+func TestFeeTokensConfig_ValidateFees(t *testing.T) {
+	config := types.NewFeeTokensConfig(
+		1,
+		"stake",
+		[]types.FeeTokenRate{
+			types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(100)),
+			types.NewFeeTokenRate("othertoken", sdk.NewDec(2), sdk.ZeroInt()),
+		},
+		types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+	)
+
+	testCases := []struct {
+		name      string
+		fees      sdk.Coins
+		shouldErr bool
+	}{
+		{
+			name:      "fees exceeding the cap return error",
+			fees:      sdk.NewCoins(sdk.NewInt64Coin("minttoken", 101)),
+			shouldErr: true,
+		},
+		{
+			name: "fees within the cap return no error",
+			fees: sdk.NewCoins(sdk.NewInt64Coin("minttoken", 100)),
+		},
+		{
+			name: "uncapped fees return no error",
+			fees: sdk.NewCoins(sdk.NewInt64Coin("othertoken", 1000000), sdk.NewInt64Coin("stake", 1000000)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := config.ValidateFees(tc.fees)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseFeeTokensDestination(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		shouldErr bool
+		expResult types.FeeTokensDestination
+	}{
+		{
+			name:      "invalid value returns error",
+			value:     "burn",
+			shouldErr: true,
+		},
+		{
+			name:      "full name is parsed properly",
+			value:     "FEE_TOKENS_DESTINATION_TREASURY",
+			expResult: types.FEE_TOKENS_DESTINATION_TREASURY,
+		},
+		{
+			name:      "short name is parsed properly",
+			value:     "fee-collector",
+			expResult: types.FEE_TOKENS_DESTINATION_FEE_COLLECTOR,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := types.ParseFeeTokensDestination(tc.value)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expResult, result)
+			}
+		})
+	}
+}
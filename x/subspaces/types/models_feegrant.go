@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	errors "cosmossdk.io/errors"
@@ -160,3 +161,220 @@ func (g Grant) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var allowance feegranttypes.FeeAllowanceI
 	return unpacker.UnpackAny(g.Allowance, &allowance)
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var _ feegranttypes.FeeAllowanceI = &MessageLimitsAllowance{}
+
+// NewMessageLimitsAllowance returns a new MessageLimitsAllowance instance
+func NewMessageLimitsAllowance(
+	messageLimits []MessageLimit, periodSpendLimit sdk.Coins, period time.Duration, expiration *time.Time,
+) *MessageLimitsAllowance {
+	return &MessageLimitsAllowance{
+		MessageLimits:    messageLimits,
+		PeriodSpendLimit: periodSpendLimit,
+		Period:           period,
+		Expiration:       expiration,
+	}
+}
+
+// ValidateBasic implements feegranttypes.FeeAllowanceI
+func (a *MessageLimitsAllowance) ValidateBasic() error {
+	if len(a.MessageLimits) == 0 {
+		return errors.Wrap(feegranttypes.ErrNoMessages, "message limits cannot be empty")
+	}
+
+	msgTypeURLs := map[string]bool{}
+	for _, limit := range a.MessageLimits {
+		if _, ok := msgTypeURLs[limit.MsgTypeURL]; ok {
+			return fmt.Errorf("duplicated message limit for %s", limit.MsgTypeURL)
+		}
+		msgTypeURLs[limit.MsgTypeURL] = true
+
+		err := limit.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	if !a.PeriodSpendLimit.IsValid() || !a.PeriodSpendLimit.IsAllPositive() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid period spend limit: %s", a.PeriodSpendLimit)
+	}
+
+	if a.Period <= 0 {
+		return errors.Wrap(feegranttypes.ErrInvalidDuration, "period must be positive")
+	}
+
+	return nil
+}
+
+// ExpiresAt implements feegranttypes.FeeAllowanceI
+func (a *MessageLimitsAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Expiration, nil
+}
+
+// Accept implements feegranttypes.FeeAllowanceI.
+// It only checks that the given fees and messages fit within the limits of a single period. The usage of each
+// grantee across the period is instead tracked by the keeper, and must be checked using ValidateUsage
+func (a *MessageLimitsAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (remove bool, err error) {
+	if a.Expiration != nil && a.Expiration.Before(ctx.BlockTime()) {
+		return true, errors.Wrap(feegranttypes.ErrFeeLimitExpired, "absolute limit")
+	}
+
+	return false, a.ValidateUsage(NewAllowanceUsage(0, 0, "", time.Time{}, nil, nil).Consume(fee, msgs))
+}
+
+// GetMessageLimit returns the limit associated to the given message type, and a boolean telling whether it was found
+func (a *MessageLimitsAllowance) GetMessageLimit(msgTypeURL string) (MessageLimit, bool) {
+	for _, limit := range a.MessageLimits {
+		if limit.MsgTypeURL == msgTypeURL {
+			return limit, true
+		}
+	}
+	return MessageLimit{}, false
+}
+
+// ValidateUsage checks whether the given usage respects the limits of the allowance
+func (a *MessageLimitsAllowance) ValidateUsage(usage AllowanceUsage) error {
+	if !usage.PeriodSpent.IsAllLTE(a.PeriodSpendLimit) {
+		return errors.Wrapf(feegranttypes.ErrFeeLimitExceeded, "period limit of %s", a.PeriodSpendLimit)
+	}
+
+	for _, msgUsage := range usage.MessageUsages {
+		limit, found := a.GetMessageLimit(msgUsage.MsgTypeURL)
+		if !found {
+			return errors.Wrapf(feegranttypes.ErrMessageNotAllowed, "message does not exist in allowed messages: %s", msgUsage.MsgTypeURL)
+		}
+
+		if msgUsage.Count > limit.MaxMessages {
+			return errors.Wrapf(feegranttypes.ErrFeeLimitExceeded, "period limit of %d %s messages", limit.MaxMessages, msgUsage.MsgTypeURL)
+		}
+	}
+
+	return nil
+}
+
+// NewMessageLimit returns a new MessageLimit instance
+func NewMessageLimit(msgTypeURL string, maxMessages uint32) MessageLimit {
+	return MessageLimit{
+		MsgTypeURL:  msgTypeURL,
+		MaxMessages: maxMessages,
+	}
+}
+
+// Validate implements fmt.Validator
+func (l MessageLimit) Validate() error {
+	if !strings.HasPrefix(l.MsgTypeURL, "/") || strings.TrimSpace(l.MsgTypeURL) != l.MsgTypeURL {
+		return fmt.Errorf("invalid message type url: %s", l.MsgTypeURL)
+	}
+
+	if len(l.MsgTypeURL) > maxMsgTypeURLLength {
+		return fmt.Errorf("message type url cannot exceed %d characters", maxMsgTypeURLLength)
+	}
+
+	if l.MaxMessages == 0 {
+		return fmt.Errorf("invalid max messages: %d", l.MaxMessages)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewAllowanceUsage returns a new AllowanceUsage instance
+func NewAllowanceUsage(
+	subspaceID uint64, groupID uint32, grantee string, periodReset time.Time, periodSpent sdk.Coins, messageUsages []MessageUsage,
+) AllowanceUsage {
+	return AllowanceUsage{
+		SubspaceID:    subspaceID,
+		GroupID:       groupID,
+		Grantee:       grantee,
+		PeriodReset:   periodReset,
+		PeriodSpent:   periodSpent,
+		MessageUsages: messageUsages,
+	}
+}
+
+// Validate implements fmt.Validator
+func (u AllowanceUsage) Validate() error {
+	if u.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", u.SubspaceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(u.Grantee)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "invalid grantee address")
+	}
+
+	if u.PeriodReset.IsZero() {
+		return fmt.Errorf("invalid period reset time: %s", u.PeriodReset)
+	}
+
+	if !u.PeriodSpent.IsValid() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid period spent: %s", u.PeriodSpent)
+	}
+
+	for _, msgUsage := range u.MessageUsages {
+		err = msgUsage.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Refresh returns a copy of the usage that is reset to a new period ending after the given duration
+// if the current period has already ended at the given time
+func (u AllowanceUsage) Refresh(blockTime time.Time, period time.Duration) AllowanceUsage {
+	if blockTime.Before(u.PeriodReset) {
+		return u
+	}
+	return NewAllowanceUsage(u.SubspaceID, u.GroupID, u.Grantee, blockTime.Add(period), nil, nil)
+}
+
+// Consume returns a copy of the usage having the given fees and messages added to the ones of the current period
+func (u AllowanceUsage) Consume(fees sdk.Coins, msgs []sdk.Msg) AllowanceUsage {
+	messageUsages := make([]MessageUsage, len(u.MessageUsages))
+	copy(messageUsages, u.MessageUsages)
+
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+
+		found := false
+		for i, msgUsage := range messageUsages {
+			if msgUsage.MsgTypeURL == msgTypeURL {
+				messageUsages[i] = NewMessageUsage(msgTypeURL, msgUsage.Count+1)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			messageUsages = append(messageUsages, NewMessageUsage(msgTypeURL, 1))
+		}
+	}
+
+	return NewAllowanceUsage(u.SubspaceID, u.GroupID, u.Grantee, u.PeriodReset, u.PeriodSpent.Add(fees...), messageUsages)
+}
+
+// NewMessageUsage returns a new MessageUsage instance
+func NewMessageUsage(msgTypeURL string, count uint32) MessageUsage {
+	return MessageUsage{
+		MsgTypeURL: msgTypeURL,
+		Count:      count,
+	}
+}
+
+// Validate implements fmt.Validator
+func (u MessageUsage) Validate() error {
+	if !strings.HasPrefix(u.MsgTypeURL, "/") || strings.TrimSpace(u.MsgTypeURL) != u.MsgTypeURL {
+		return fmt.Errorf("invalid message type url: %s", u.MsgTypeURL)
+	}
+
+	if u.Count == 0 {
+		return fmt.Errorf("invalid count: %d", u.Count)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/stretchr/testify/require"
 
@@ -224,3 +225,323 @@ func TestGrant_GetExpiration(t *testing.T) {
 		})
 	}
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestMessageLimitsAllowance_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		allowance *types.MessageLimitsAllowance
+		shouldErr bool
+	}{
+		{
+			name: "empty message limits returns error",
+			allowance: types.NewMessageLimitsAllowance(
+				nil,
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				24*time.Hour,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated message limits returns error",
+			allowance: types.NewMessageLimitsAllowance(
+				[]types.MessageLimit{
+					types.NewMessageLimit("/desmos.posts.v3.MsgCreatePost", 50),
+					types.NewMessageLimit("/desmos.posts.v3.MsgCreatePost", 10),
+				},
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				24*time.Hour,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid message limit returns error",
+			allowance: types.NewMessageLimitsAllowance(
+				[]types.MessageLimit{types.NewMessageLimit("/desmos.posts.v3.MsgCreatePost", 0)},
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				24*time.Hour,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "empty period spend limit returns error",
+			allowance: types.NewMessageLimitsAllowance(
+				[]types.MessageLimit{types.NewMessageLimit("/desmos.posts.v3.MsgCreatePost", 50)},
+				nil,
+				24*time.Hour,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid period returns error",
+			allowance: types.NewMessageLimitsAllowance(
+				[]types.MessageLimit{types.NewMessageLimit("/desmos.posts.v3.MsgCreatePost", 50)},
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				0,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid allowance returns no error",
+			allowance: types.NewMessageLimitsAllowance(
+				[]types.MessageLimit{
+					types.NewMessageLimit("/desmos.posts.v3.MsgCreatePost", 50),
+					types.NewMessageLimit("/desmos.reactions.v1.MsgAddReaction", 200),
+				},
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				24*time.Hour,
+				nil,
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.allowance.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMessageLimitsAllowance_Accept(t *testing.T) {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+	expiration := time.Date(2020, 1, 1, 11, 00, 00, 000, time.UTC)
+	msg := types.NewMsgEditSubspace(1, "New name", types.DoNotModify, types.DoNotModify, "cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez")
+
+	testCases := []struct {
+		name      string
+		allowance *types.MessageLimitsAllowance
+		fees      sdk.Coins
+		msgs      []sdk.Msg
+		expRemove bool
+		shouldErr bool
+	}{
+		{
+			name: "expired allowance returns error and is removed",
+			allowance: types.NewMessageLimitsAllowance(
+				[]types.MessageLimit{types.NewMessageLimit("/desmos.subspaces.v3.MsgEditSubspace", 1)},
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				24*time.Hour,
+				&expiration,
+			),
+			fees:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+			msgs:      []sdk.Msg{msg},
+			expRemove: true,
+			shouldErr: true,
+		},
+		{
+			name: "fees exceeding the period spend limit returns error",
+			allowance: types.NewMessageLimitsAllowance(
+				[]types.MessageLimit{types.NewMessageLimit("/desmos.subspaces.v3.MsgEditSubspace", 1)},
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				24*time.Hour,
+				nil,
+			),
+			fees:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(101))),
+			msgs:      []sdk.Msg{msg},
+			shouldErr: true,
+		},
+		{
+			name: "not allowed message returns error",
+			allowance: types.NewMessageLimitsAllowance(
+				[]types.MessageLimit{types.NewMessageLimit("/desmos.posts.v3.MsgCreatePost", 1)},
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				24*time.Hour,
+				nil,
+			),
+			fees:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+			msgs:      []sdk.Msg{msg},
+			shouldErr: true,
+		},
+		{
+			name: "too many messages returns error",
+			allowance: types.NewMessageLimitsAllowance(
+				[]types.MessageLimit{types.NewMessageLimit("/desmos.subspaces.v3.MsgEditSubspace", 1)},
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				24*time.Hour,
+				nil,
+			),
+			fees:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+			msgs:      []sdk.Msg{msg, msg},
+			shouldErr: true,
+		},
+		{
+			name: "valid fees and messages are accepted",
+			allowance: types.NewMessageLimitsAllowance(
+				[]types.MessageLimit{types.NewMessageLimit("/desmos.subspaces.v3.MsgEditSubspace", 2)},
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				24*time.Hour,
+				nil,
+			),
+			fees:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+			msgs:      []sdk.Msg{msg, msg},
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(blockTime)
+
+			remove, err := tc.allowance.Accept(ctx, tc.fees, tc.msgs)
+			require.Equal(t, tc.expRemove, remove)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestAllowanceUsage_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		usage     types.AllowanceUsage
+		shouldErr bool
+	}{
+		{
+			name: "invalid subspace id returns error",
+			usage: types.NewAllowanceUsage(
+				0,
+				0,
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid grantee returns error",
+			usage: types.NewAllowanceUsage(
+				1,
+				0,
+				"cosmos1lv3e0l66rr68k5l74mnrv",
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid period reset returns error",
+			usage: types.NewAllowanceUsage(
+				1,
+				0,
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				time.Time{},
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid message usage returns error",
+			usage: types.NewAllowanceUsage(
+				1,
+				0,
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+				[]types.MessageUsage{types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 0)},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid usage returns no error",
+			usage: types.NewAllowanceUsage(
+				1,
+				1,
+				"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+				time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+				sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+				[]types.MessageUsage{types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 1)},
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.usage.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAllowanceUsage_Refresh(t *testing.T) {
+	periodReset := time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC)
+	usage := types.NewAllowanceUsage(
+		1,
+		0,
+		"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+		periodReset,
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+		[]types.MessageUsage{types.NewMessageUsage("/desmos.posts.v3.MsgCreatePost", 1)},
+	)
+
+	// The usage is kept during the current period
+	require.Equal(t, usage, usage.Refresh(periodReset.Add(-time.Second), 24*time.Hour))
+
+	// The usage is reset once the period has ended
+	require.Equal(t, types.NewAllowanceUsage(
+		1,
+		0,
+		"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+		periodReset.Add(24*time.Hour),
+		nil,
+		nil,
+	), usage.Refresh(periodReset, 24*time.Hour))
+}
+
+func TestAllowanceUsage_Consume(t *testing.T) {
+	usage := types.NewAllowanceUsage(
+		1,
+		0,
+		"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+		time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+		[]types.MessageUsage{types.NewMessageUsage("/desmos.subspaces.v3.MsgEditSubspace", 1)},
+	)
+
+	consumed := usage.Consume(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(5))), []sdk.Msg{
+		types.NewMsgEditSubspace(1, "New name", types.DoNotModify, types.DoNotModify, "cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez"),
+		types.NewMsgDeleteSubspace(1, "cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez"),
+	})
+
+	require.Equal(t, types.NewAllowanceUsage(
+		1,
+		0,
+		"cosmos1lv3e0l66rr68k5l74mnrv4j9kyny6cz27pvnez",
+		time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(15))),
+		[]types.MessageUsage{
+			types.NewMessageUsage("/desmos.subspaces.v3.MsgEditSubspace", 2),
+			types.NewMessageUsage("/desmos.subspaces.v3.MsgDeleteSubspace", 1),
+		},
+	), consumed)
+
+	// Make sure the original usage has not been modified
+	require.Equal(t, uint32(1), usage.MessageUsages[0].Count)
+}
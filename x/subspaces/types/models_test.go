@@ -3,9 +3,11 @@ package types_test
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -250,6 +252,34 @@ func TestSubspace_Update(t *testing.T) {
 				nil,
 			),
 		},
+		{
+			name: "archived status is preserved",
+			subspace: types.Subspace{
+				ID:           1,
+				Name:         "Test subspace",
+				Description:  "This is a test subspace",
+				Treasury:     "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				Owner:        "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				Creator:      "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				CreationTime: time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				Archived:     true,
+			},
+			update: types.NewSubspaceUpdate(
+				"New subspace name",
+				types.DoNotModify,
+				types.DoNotModify,
+			),
+			expResult: types.Subspace{
+				ID:           1,
+				Name:         "New subspace name",
+				Description:  "This is a test subspace",
+				Treasury:     "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				Owner:        "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				Creator:      "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				CreationTime: time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+				Archived:     true,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -263,6 +293,74 @@ func TestSubspace_Update(t *testing.T) {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+func TestSubspaceOwnerTransferRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		request   types.SubspaceOwnerTransferRequest
+		shouldErr bool
+	}{
+		{
+			name: "invalid subspace id returns error",
+			request: types.NewSubspaceOwnerTransferRequest(
+				0,
+				"cosmos10ya9y35qkf4puaklx5fs07sxfxqncx9usgsnz6",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "same sender and receiver returns error",
+			request: types.NewSubspaceOwnerTransferRequest(
+				1,
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid sender returns error",
+			request: types.NewSubspaceOwnerTransferRequest(
+				1,
+				"cosmos10ya9y35qkf4puaklx5fs07sxfxqncx9usgsnz6",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid receiver returns error",
+			request: types.NewSubspaceOwnerTransferRequest(
+				1,
+				"cosmos10ya9y35qkf4puaklx5fs07",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid request returns no error",
+			request: types.NewSubspaceOwnerTransferRequest(
+				1,
+				"cosmos10ya9y35qkf4puaklx5fs07sxfxqncx9usgsnz6",
+				"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn",
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.request.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 func TestParseSectionID(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -317,6 +415,7 @@ func TestSection_Validate(t *testing.T) {
 				1,
 				"Test section",
 				"This is a test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 			shouldErr: true,
 		},
@@ -328,6 +427,7 @@ func TestSection_Validate(t *testing.T) {
 				1,
 				"Test section",
 				"This is a test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 			shouldErr: true,
 		},
@@ -339,6 +439,7 @@ func TestSection_Validate(t *testing.T) {
 				0,
 				"",
 				"This is a test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 			shouldErr: true,
 		},
@@ -350,6 +451,19 @@ func TestSection_Validate(t *testing.T) {
 				0,
 				"   ",
 				"This is a test section",
+				types.SECTION_VISIBILITY_PUBLIC,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid visibility returns error",
+			section: types.NewSection(
+				1,
+				1,
+				0,
+				"Test section",
+				"This is a test section",
+				types.SectionVisibility(10),
 			),
 			shouldErr: true,
 		},
@@ -371,6 +485,7 @@ func TestSection_Validate(t *testing.T) {
 				0,
 				"Test section",
 				"This is a test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 			shouldErr: false,
 		},
@@ -404,6 +519,7 @@ func TestSection_Update(t *testing.T) {
 				0,
 				"Test section",
 				"This is a test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 			update: types.NewSectionUpdate(
 				types.DoNotModify,
@@ -415,6 +531,7 @@ func TestSection_Update(t *testing.T) {
 				0,
 				"Test section",
 				"This is a test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 		},
 		{
@@ -425,6 +542,7 @@ func TestSection_Update(t *testing.T) {
 				0,
 				"Test section",
 				"This is a test section",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 			update: types.NewSectionUpdate(
 				"New section name",
@@ -436,6 +554,7 @@ func TestSection_Update(t *testing.T) {
 				0,
 				"New section name",
 				"New section description",
+				types.SECTION_VISIBILITY_PUBLIC,
 			),
 		},
 	}
@@ -449,6 +568,46 @@ func TestSection_Update(t *testing.T) {
 	}
 }
 
+func TestParseSectionVisibility(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         string
+		shouldErr     bool
+		expVisibility types.SectionVisibility
+	}{
+		{
+			name:      "invalid value returns error",
+			value:     "private",
+			shouldErr: true,
+		},
+		{
+			name:          "full name returns correct value",
+			value:         "SECTION_VISIBILITY_GROUP_RESTRICTED",
+			shouldErr:     false,
+			expVisibility: types.SECTION_VISIBILITY_GROUP_RESTRICTED,
+		},
+		{
+			name:          "short name returns correct value",
+			value:         "members-only",
+			shouldErr:     false,
+			expVisibility: types.SECTION_VISIBILITY_MEMBERS_ONLY,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			visibility, err := types.ParseSectionVisibility(tc.value)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expVisibility, visibility)
+			}
+		})
+	}
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 func TestParseUserGroupID(t *testing.T) {
@@ -612,6 +771,305 @@ func TestUserGroup_Update(t *testing.T) {
 	}
 }
 
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestGetInviteSecretHash(t *testing.T) {
+	hash := types.GetInviteSecretHash("my-super-secret")
+	require.Equal(t, "09510a557079d9d47de1410b5b05427afd91580ceff2e8e8cc7d2054ff16bbf2", hash)
+	require.True(t, types.IsValidInviteSecretHash(hash))
+}
+
+func TestSignInviteRedemption(t *testing.T) {
+	pubKey, signature, err := types.SignInviteRedemption("my-super-secret", "desmos-mainnet", 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+	require.NoError(t, err)
+	require.Equal(t, types.GetInviteSecretHash("my-super-secret"), types.GetInvitePubKeyHash(pubKey))
+
+	key := &secp256k1.PubKey{Key: pubKey}
+	require.True(t, key.VerifySignature(
+		types.GetInviteRedemptionSignBytes("desmos-mainnet", 1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+		signature,
+	))
+	require.False(t, key.VerifySignature(
+		types.GetInviteRedemptionSignBytes("desmos-mainnet", 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+		signature,
+	))
+}
+
+func TestIsValidInviteSecretHash(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		expResult bool
+	}{
+		{
+			name:      "empty value returns false",
+			value:     "",
+			expResult: false,
+		},
+		{
+			name:      "non hex value returns false",
+			value:     "my-super-secret",
+			expResult: false,
+		},
+		{
+			name:      "wrong length returns false",
+			value:     "1781e4f13fb8422ce4e21a5a80f09cee",
+			expResult: false,
+		},
+		{
+			name:      "uppercase value returns false",
+			value:     "1781E4F13FB8422CE4E21A5A80F09CEEF77828E965630D29A3B7548463DBD23D",
+			expResult: false,
+		},
+		{
+			name:      "valid value returns true",
+			value:     "09510a557079d9d47de1410b5b05427afd91580ceff2e8e8cc7d2054ff16bbf2",
+			expResult: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expResult, types.IsValidInviteSecretHash(tc.value))
+		})
+	}
+}
+
+func TestGroupInvite_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		invite    types.GroupInvite
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			invite:    types.NewGroupInvite(0, 1, types.GetInviteSecretHash("secret"), 10, 0, nil, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid group id returns error",
+			invite:    types.NewGroupInvite(1, 0, types.GetInviteSecretHash("secret"), 10, 0, nil, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid secret hash returns error",
+			invite:    types.NewGroupInvite(1, 1, "secret", 10, 0, nil, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid max uses returns error",
+			invite:    types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 0, 0, nil, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+			shouldErr: true,
+		},
+		{
+			name:      "uses equal to max uses returns error",
+			invite:    types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 10, 10, nil, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid creator returns error",
+			invite:    types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 10, 0, nil, "cosmos1s0he0z3g92zwsxdj83h0ky9w"),
+			shouldErr: true,
+		},
+		{
+			name:      "valid invite returns no error",
+			invite:    types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 10, 5, nil, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.invite.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGroupInvite_IsExpired(t *testing.T) {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+	past := blockTime.Add(-time.Hour)
+	future := blockTime.Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		invite    types.GroupInvite
+		expResult bool
+	}{
+		{
+			name:      "invite without expiration returns false",
+			invite:    types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 10, 0, nil, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+			expResult: false,
+		},
+		{
+			name:      "invite with past expiration returns true",
+			invite:    types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 10, 0, &past, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+			expResult: true,
+		},
+		{
+			name:      "invite expiring at the block time returns true",
+			invite:    types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 10, 0, &blockTime, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+			expResult: true,
+		},
+		{
+			name:      "invite with future expiration returns false",
+			invite:    types.NewGroupInvite(1, 1, types.GetInviteSecretHash("secret"), 10, 0, &future, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+			expResult: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expResult, tc.invite.IsExpired(blockTime))
+		})
+	}
+}
+
+func TestGroupApplication_Validate(t *testing.T) {
+	creationTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name        string
+		application types.GroupApplication
+		shouldErr   bool
+	}{
+		{
+			name:        "invalid subspace id returns error",
+			application: types.NewGroupApplication(0, 1, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", "", creationTime),
+			shouldErr:   true,
+		},
+		{
+			name:        "invalid group id returns error",
+			application: types.NewGroupApplication(1, 0, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", "", creationTime),
+			shouldErr:   true,
+		},
+		{
+			name:        "invalid applicant returns error",
+			application: types.NewGroupApplication(1, 1, "cosmos1s0he0z3g92zwsxdj83h0ky9w", "", creationTime),
+			shouldErr:   true,
+		},
+		{
+			name:        "invalid creation time returns error",
+			application: types.NewGroupApplication(1, 1, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", "", time.Time{}),
+			shouldErr:   true,
+		},
+		{
+			name:        "valid application returns no error",
+			application: types.NewGroupApplication(1, 1, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", "Let me in", creationTime),
+			shouldErr:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.application.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestSubspaceBan_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		ban       types.SubspaceBan
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			ban:       types.NewSubspaceBan(0, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid user returns error",
+			ban:       types.NewSubspaceBan(1, "cosmos1x5pjlvufs4znnhhkwe8v4", "", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid banned by returns error",
+			ban:       types.NewSubspaceBan(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "", "cosmos1s0he0z3g92zwsxdj83h0", nil),
+			shouldErr: true,
+		},
+		{
+			name:      "self ban returns error",
+			ban:       types.NewSubspaceBan(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", nil),
+			shouldErr: true,
+		},
+		{
+			name:      "valid ban returns no error",
+			ban:       types.NewSubspaceBan(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "Spam", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", nil),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.ban.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSubspaceBan_IsExpired(t *testing.T) {
+	blockTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+	past := blockTime.Add(-time.Hour)
+	future := blockTime.Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		ban       types.SubspaceBan
+		expResult bool
+	}{
+		{
+			name:      "ban without expiration returns false",
+			ban:       types.NewSubspaceBan(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", nil),
+			expResult: false,
+		},
+		{
+			name:      "ban with past expiration returns true",
+			ban:       types.NewSubspaceBan(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", &past),
+			expResult: true,
+		},
+		{
+			name:      "ban expiring at the block time returns true",
+			ban:       types.NewSubspaceBan(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", &blockTime),
+			expResult: true,
+		},
+		{
+			name:      "ban with future expiration returns false",
+			ban:       types.NewSubspaceBan(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "", "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn", &future),
+			expResult: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expResult, tc.ban.IsExpired(blockTime))
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 func TestGetTreasuryAddress(t *testing.T) {
 	subspaceIDs := getRandomSubspaceIDs(1000)
 	treasuryAddrSet := make(map[string]bool, 1000)
@@ -671,3 +1129,161 @@ func TestAdditionalFeeTokensUpdate_Update(t *testing.T) {
 		),
 	), updated)
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestRateLimit_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		limit     types.RateLimit
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			limit:     types.NewRateLimit(0, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "empty message type url returns error",
+			limit:     types.NewRateLimit(1, "", 10, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "message type url without leading slash returns error",
+			limit:     types.NewRateLimit(1, "desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "message type url with spaces returns error",
+			limit:     types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost ", 10, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "too long message type url returns error",
+			limit:     types.NewRateLimit(1, "/"+strings.Repeat("a", 255), 10, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid max messages returns error",
+			limit:     types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 0, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid window returns error",
+			limit:     types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, -time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "valid rate limit returns no error",
+			limit:     types.NewRateLimit(1, "/desmos.posts.v3.MsgCreatePost", 10, time.Hour),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limit.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRateLimitCounter_Refresh(t *testing.T) {
+	windowStart := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name       string
+		counter    types.RateLimitCounter
+		blockTime  time.Time
+		expCounter types.RateLimitCounter
+	}{
+		{
+			name:       "time within the current window returns the same counter",
+			counter:    types.NewRateLimitCounter(windowStart, 5, 3),
+			blockTime:  windowStart.Add(30 * time.Minute),
+			expCounter: types.NewRateLimitCounter(windowStart, 5, 3),
+		},
+		{
+			name:       "time within the next window shifts the current count",
+			counter:    types.NewRateLimitCounter(windowStart, 5, 3),
+			blockTime:  windowStart.Add(90 * time.Minute),
+			expCounter: types.NewRateLimitCounter(windowStart.Add(time.Hour), 0, 5),
+		},
+		{
+			name:       "time after the next window resets the counter",
+			counter:    types.NewRateLimitCounter(windowStart, 5, 3),
+			blockTime:  windowStart.Add(150 * time.Minute),
+			expCounter: types.NewRateLimitCounter(windowStart.Add(2*time.Hour), 0, 0),
+		},
+		{
+			name:       "empty counter is reset",
+			counter:    types.RateLimitCounter{},
+			blockTime:  windowStart.Add(30 * time.Minute),
+			expCounter: types.NewRateLimitCounter(windowStart, 0, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			counter := tc.counter.Refresh(tc.blockTime, time.Hour)
+			require.True(t, tc.expCounter.WindowStart.Equal(counter.WindowStart))
+			require.Equal(t, tc.expCounter.CurrentCount, counter.CurrentCount)
+			require.Equal(t, tc.expCounter.PreviousCount, counter.PreviousCount)
+		})
+	}
+}
+
+func TestRateLimitCounter_EstimateCount(t *testing.T) {
+	windowStart := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name      string
+		counter   types.RateLimitCounter
+		blockTime time.Time
+		expCount  uint64
+	}{
+		{
+			name:      "previous count is fully considered at the window start",
+			counter:   types.NewRateLimitCounter(windowStart, 2, 10),
+			blockTime: windowStart,
+			expCount:  12,
+		},
+		{
+			name:      "previous count is weighted based on the window overlap",
+			counter:   types.NewRateLimitCounter(windowStart, 2, 10),
+			blockTime: windowStart.Add(15 * time.Minute),
+			expCount:  9,
+		},
+		{
+			name:      "previous count is rounded down",
+			counter:   types.NewRateLimitCounter(windowStart, 2, 3),
+			blockTime: windowStart.Add(30 * time.Minute),
+			expCount:  3,
+		},
+		{
+			name:      "only the current count is considered at the window end",
+			counter:   types.NewRateLimitCounter(windowStart, 2, 10),
+			blockTime: windowStart.Add(time.Hour),
+			expCount:  2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expCount, tc.counter.EstimateCount(tc.blockTime, time.Hour))
+		})
+	}
+}
+
+func TestRateLimitCounter_Increment(t *testing.T) {
+	windowStart := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+	counter := types.NewRateLimitCounter(windowStart, 2, 10)
+	require.Equal(t, types.NewRateLimitCounter(windowStart, 3, 10), counter.Increment())
+}
@@ -0,0 +1,245 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// NewTreasuryVotingConfig returns a new TreasuryVotingConfig instance
+func NewTreasuryVotingConfig(
+	subspaceID uint64, groupID uint32, threshold sdk.Dec, quorum *sdk.Dec, votingPeriod time.Duration,
+) TreasuryVotingConfig {
+	return TreasuryVotingConfig{
+		SubspaceID:   subspaceID,
+		GroupID:      groupID,
+		Threshold:    threshold,
+		Quorum:       quorum,
+		VotingPeriod: votingPeriod,
+	}
+}
+
+// Validate implements fmt.Validator
+func (config TreasuryVotingConfig) Validate() error {
+	if config.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", config.SubspaceID)
+	}
+
+	if config.GroupID == 0 {
+		return fmt.Errorf("invalid group id: %d", config.GroupID)
+	}
+
+	err := validateTreasuryThreshold(config.Threshold)
+	if err != nil {
+		return err
+	}
+
+	err = validateTreasuryQuorum(config.Quorum)
+	if err != nil {
+		return err
+	}
+
+	if config.VotingPeriod <= 0 {
+		return fmt.Errorf("invalid voting period: %s", config.VotingPeriod)
+	}
+
+	return nil
+}
+
+// validateTreasuryThreshold validates the given treasury proposals threshold
+func validateTreasuryThreshold(threshold sdk.Dec) error {
+	if threshold.IsNil() || !threshold.IsPositive() || threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid threshold: must be greater than 0 and less or equal to 1")
+	}
+	return nil
+}
+
+// validateTreasuryQuorum validates the given treasury proposals quorum
+func validateTreasuryQuorum(quorum *sdk.Dec) error {
+	if quorum == nil {
+		return nil
+	}
+
+	if quorum.IsNil() || !quorum.IsPositive() || quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid quorum: must be greater than 0 and less or equal to 1")
+	}
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+var _ codectypes.UnpackInterfacesMessage = &TreasuryProposal{}
+
+// NewTreasuryProposal returns a new TreasuryProposal instance
+func NewTreasuryProposal(
+	subspaceID uint64,
+	id uint64,
+	proposer string,
+	messages []sdk.Msg,
+	groupID uint32,
+	threshold sdk.Dec,
+	quorum *sdk.Dec,
+	submitTime time.Time,
+	votingEndTime time.Time,
+	status TreasuryProposalStatus,
+	voters []string,
+) TreasuryProposal {
+	msgsAny, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		panic("failed to pack messages to any type")
+	}
+
+	return TreasuryProposal{
+		SubspaceID:    subspaceID,
+		ID:            id,
+		Proposer:      proposer,
+		Messages:      msgsAny,
+		GroupID:       groupID,
+		Threshold:     threshold,
+		Quorum:        quorum,
+		SubmitTime:    submitTime,
+		VotingEndTime: votingEndTime,
+		Status:        status,
+		Voters:        voters,
+	}
+}
+
+// Validate implements fmt.Validator
+func (proposal TreasuryProposal) Validate() error {
+	if proposal.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", proposal.SubspaceID)
+	}
+
+	if proposal.ID == 0 {
+		return fmt.Errorf("invalid proposal id: %d", proposal.ID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(proposal.Proposer)
+	if err != nil {
+		return fmt.Errorf("invalid proposer address: %s", err)
+	}
+
+	if len(proposal.Messages) == 0 {
+		return fmt.Errorf("proposal must contain at least one message")
+	}
+
+	if proposal.GroupID == 0 {
+		return fmt.Errorf("invalid group id: %d", proposal.GroupID)
+	}
+
+	err = validateTreasuryThreshold(proposal.Threshold)
+	if err != nil {
+		return err
+	}
+
+	err = validateTreasuryQuorum(proposal.Quorum)
+	if err != nil {
+		return err
+	}
+
+	if proposal.SubmitTime.IsZero() {
+		return fmt.Errorf("invalid submit time: %s", proposal.SubmitTime)
+	}
+
+	if !proposal.VotingEndTime.After(proposal.SubmitTime) {
+		return fmt.Errorf("invalid voting end time: %s", proposal.VotingEndTime)
+	}
+
+	if proposal.Status == TREASURY_PROPOSAL_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid proposal status: %s", proposal.Status)
+	}
+
+	voters := map[string]bool{}
+	for _, voter := range proposal.Voters {
+		_, err = sdk.AccAddressFromBech32(voter)
+		if err != nil {
+			return fmt.Errorf("invalid voter address: %s", err)
+		}
+
+		if voters[voter] {
+			return fmt.Errorf("duplicated voter: %s", voter)
+		}
+		voters[voter] = true
+	}
+
+	return nil
+}
+
+// GetMsgs returns the messages contained inside the proposal
+func (proposal TreasuryProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(proposal.Messages, "treasury proposal")
+}
+
+// IsVoter tells whether the given user was allowed to vote the proposal when it was submitted
+func (proposal TreasuryProposal) IsVoter(user string) bool {
+	for _, voter := range proposal.Voters {
+		if voter == user {
+			return true
+		}
+	}
+	return false
+}
+
+// IsVotingPeriodEnded tells whether the voting period of the proposal has ended at the given time
+func (proposal TreasuryProposal) IsVotingPeriodEnded(blockTime time.Time) bool {
+	return !proposal.VotingEndTime.After(blockTime)
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (proposal TreasuryProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, proposal.Messages)
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// NewTreasuryVote returns a new TreasuryVote instance
+func NewTreasuryVote(subspaceID uint64, proposalID uint64, voter string, option TreasuryVoteOption) TreasuryVote {
+	return TreasuryVote{
+		SubspaceID: subspaceID,
+		ProposalID: proposalID,
+		Voter:      voter,
+		Option:     option,
+	}
+}
+
+// Validate implements fmt.Validator
+func (vote TreasuryVote) Validate() error {
+	if vote.SubspaceID == 0 {
+		return fmt.Errorf("invalid subspace id: %d", vote.SubspaceID)
+	}
+
+	if vote.ProposalID == 0 {
+		return fmt.Errorf("invalid proposal id: %d", vote.ProposalID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return fmt.Errorf("invalid voter address: %s", err)
+	}
+
+	if !IsValidTreasuryVoteOption(vote.Option) {
+		return fmt.Errorf("invalid vote option: %s", vote.Option)
+	}
+
+	return nil
+}
+
+// IsValidTreasuryVoteOption tells whether the given option can be used to vote a treasury proposal
+func IsValidTreasuryVoteOption(option TreasuryVoteOption) bool {
+	return option == TREASURY_VOTE_OPTION_YES || option == TREASURY_VOTE_OPTION_NO
+}
+
+// ParseTreasuryVoteOption parses the given value as a treasury vote option, returning an error if it's invalid
+func ParseTreasuryVoteOption(value string) (TreasuryVoteOption, error) {
+	switch value {
+	case "yes", "YES", TREASURY_VOTE_OPTION_YES.String():
+		return TREASURY_VOTE_OPTION_YES, nil
+	case "no", "NO", TREASURY_VOTE_OPTION_NO.String():
+		return TREASURY_VOTE_OPTION_NO, nil
+	default:
+		return TREASURY_VOTE_OPTION_UNSPECIFIED, fmt.Errorf("invalid vote option: %s", value)
+	}
+}
@@ -0,0 +1,350 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+func TestTreasuryVotingConfig_Validate(t *testing.T) {
+	invalidQuorum := sdk.NewDecWithPrec(15, 1)
+	quorum := sdk.NewDecWithPrec(4, 1)
+
+	testCases := []struct {
+		name      string
+		config    types.TreasuryVotingConfig
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			config:    types.NewTreasuryVotingConfig(0, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "default group id returns error",
+			config:    types.NewTreasuryVotingConfig(1, 0, sdk.NewDecWithPrec(5, 1), nil, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "zero threshold returns error",
+			config:    types.NewTreasuryVotingConfig(1, 1, sdk.ZeroDec(), nil, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "threshold greater than one returns error",
+			config:    types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(15, 1), nil, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "quorum greater than one returns error",
+			config:    types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), &invalidQuorum, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid voting period returns error",
+			config:    types.NewTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, 0),
+			shouldErr: true,
+		},
+		{
+			name:   "valid config returns no error",
+			config: types.NewTreasuryVotingConfig(1, 1, sdk.OneDec(), nil, time.Hour),
+		},
+		{
+			name:   "valid config with quorum returns no error",
+			config: types.NewTreasuryVotingConfig(1, 1, sdk.OneDec(), &quorum, time.Hour),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTreasuryProposal_Validate(t *testing.T) {
+	submitTime := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+	msgs := []sdk.Msg{
+		types.NewMsgEditSubspace(1, "This is a new name", "", types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+	}
+	invalidQuorum := sdk.NewDecWithPrec(-5, 1)
+
+	testCases := []struct {
+		name      string
+		proposal  types.TreasuryProposal
+		shouldErr bool
+	}{
+		{
+			name: "invalid subspace id returns error",
+			proposal: types.NewTreasuryProposal(
+				0, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				1, sdk.NewDecWithPrec(5, 1), nil, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING, nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid proposal id returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 0, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				1, sdk.NewDecWithPrec(5, 1), nil, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING, nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid proposer returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg", msgs,
+				1, sdk.NewDecWithPrec(5, 1), nil, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING, nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "empty messages returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", nil,
+				1, sdk.NewDecWithPrec(5, 1), nil, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING, nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid group id returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				0, sdk.NewDecWithPrec(5, 1), nil, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING, nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid threshold returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				1, sdk.NewDec(2), nil, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING, nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid quorum returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				1, sdk.NewDecWithPrec(5, 1), &invalidQuorum, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING, nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid submit time returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				1, sdk.NewDecWithPrec(5, 1), nil, time.Time{}, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING, nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "voting end time before submit time returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				1, sdk.NewDecWithPrec(5, 1), nil, submitTime, submitTime.Add(-time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING, nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "unspecified status returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				1, sdk.NewDecWithPrec(5, 1), nil, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_UNSPECIFIED, nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid voter returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				1, sdk.NewDecWithPrec(5, 1), nil, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING,
+				[]string{"cosmos1m0czrla04f7rp3zg"},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated voter returns error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				1, sdk.NewDecWithPrec(5, 1), nil, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_VOTING,
+				[]string{"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid proposal returns no error",
+			proposal: types.NewTreasuryProposal(
+				1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", msgs,
+				1, sdk.NewDecWithPrec(5, 1), nil, submitTime, submitTime.Add(time.Hour), types.TREASURY_PROPOSAL_STATUS_EXECUTED,
+				[]string{"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTreasuryProposal_GetMsgs(t *testing.T) {
+	msg := types.NewMsgEditSubspace(1, "This is a new name", "", types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")
+	proposal := types.NewTreasuryProposal(
+		1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", []sdk.Msg{msg},
+		1, sdk.NewDecWithPrec(5, 1),
+		nil,
+		time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+		time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+		types.TREASURY_PROPOSAL_STATUS_VOTING,
+		nil,
+	)
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	require.NoError(t, proposal.UnpackInterfaces(registry))
+
+	msgs, err := proposal.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{msg}, msgs)
+}
+
+func TestTreasuryProposal_IsVoter(t *testing.T) {
+	proposal := types.NewTreasuryProposal(
+		1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+		[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", "", types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+		1, sdk.NewDecWithPrec(5, 1),
+		nil,
+		time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+		time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC),
+		types.TREASURY_PROPOSAL_STATUS_VOTING,
+		[]string{"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"},
+	)
+
+	require.True(t, proposal.IsVoter("cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"))
+	require.False(t, proposal.IsVoter("cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"))
+}
+
+func TestTreasuryProposal_IsVotingPeriodEnded(t *testing.T) {
+	endTime := time.Date(2020, 1, 2, 12, 00, 00, 000, time.UTC)
+	proposal := types.NewTreasuryProposal(
+		1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+		[]sdk.Msg{types.NewMsgEditSubspace(1, "This is a new name", "", types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn")},
+		1, sdk.NewDecWithPrec(5, 1),
+		nil,
+		time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC),
+		endTime,
+		types.TREASURY_PROPOSAL_STATUS_VOTING,
+		nil,
+	)
+
+	require.False(t, proposal.IsVotingPeriodEnded(endTime.Add(-time.Second)))
+	require.True(t, proposal.IsVotingPeriodEnded(endTime))
+	require.True(t, proposal.IsVotingPeriodEnded(endTime.Add(time.Second)))
+}
+
+func TestTreasuryVote_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		vote      types.TreasuryVote
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			vote:      types.NewTreasuryVote(0, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid proposal id returns error",
+			vote:      types.NewTreasuryVote(1, 0, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_YES),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid voter returns error",
+			vote:      types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg", types.TREASURY_VOTE_OPTION_YES),
+			shouldErr: true,
+		},
+		{
+			name:      "unspecified option returns error",
+			vote:      types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_UNSPECIFIED),
+			shouldErr: true,
+		},
+		{
+			name: "valid vote returns no error",
+			vote: types.NewTreasuryVote(1, 1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", types.TREASURY_VOTE_OPTION_NO),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.vote.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseTreasuryVoteOption(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		shouldErr bool
+		expOption types.TreasuryVoteOption
+	}{
+		{
+			name:      "invalid value returns error",
+			value:     "maybe",
+			shouldErr: true,
+		},
+		{
+			name:      "yes is parsed correctly",
+			value:     "yes",
+			expOption: types.TREASURY_VOTE_OPTION_YES,
+		},
+		{
+			name:      "no is parsed correctly",
+			value:     "NO",
+			expOption: types.TREASURY_VOTE_OPTION_NO,
+		},
+		{
+			name:      "enum name is parsed correctly",
+			value:     "TREASURY_VOTE_OPTION_YES",
+			expOption: types.TREASURY_VOTE_OPTION_YES,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			option, err := types.ParseTreasuryVoteOption(tc.value)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expOption, option)
+			}
+		})
+	}
+}
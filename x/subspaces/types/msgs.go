@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +26,15 @@ type ManageSubspaceMsg interface {
 	IsManageSubspaceMsg()
 }
 
+// RateLimitedMsg represents a subspace message whose rate limits are consumed by its own message server
+// when it is executed, so that they are enforced even when the message is not sent directly inside a
+// transaction (e.g. when it is executed through x/authz or dispatched by a CosmWasm contract)
+type RateLimitedMsg interface {
+	SubspaceMsg
+
+	IsRateLimitedMsg()
+}
+
 var (
 	_ sdk.Msg = &MsgCreateSubspace{}
 	_ sdk.Msg = &MsgEditSubspace{}
@@ -32,6 +42,7 @@ var (
 	_ sdk.Msg = &MsgCreateSection{}
 	_ sdk.Msg = &MsgEditSection{}
 	_ sdk.Msg = &MsgMoveSection{}
+	_ sdk.Msg = &MsgSetSectionVisibility{}
 	_ sdk.Msg = &MsgDeleteSection{}
 	_ sdk.Msg = &MsgCreateUserGroup{}
 	_ sdk.Msg = &MsgEditUserGroup{}
@@ -47,6 +58,7 @@ var (
 	_ ManageSubspaceMsg = &MsgCreateSection{}
 	_ ManageSubspaceMsg = &MsgEditSection{}
 	_ ManageSubspaceMsg = &MsgMoveSection{}
+	_ ManageSubspaceMsg = &MsgSetSectionVisibility{}
 	_ ManageSubspaceMsg = &MsgDeleteSection{}
 	_ ManageSubspaceMsg = &MsgCreateUserGroup{}
 	_ ManageSubspaceMsg = &MsgEditUserGroup{}
@@ -219,6 +231,7 @@ func NewMsgCreateSection(
 	name string,
 	description string,
 	parentID uint32,
+	visibility SectionVisibility,
 	creator string,
 ) *MsgCreateSection {
 	return &MsgCreateSection{
@@ -226,6 +239,7 @@ func NewMsgCreateSection(
 		Name:        name,
 		Description: description,
 		ParentID:    parentID,
+		Visibility:  visibility,
 		Creator:     creator,
 	}
 }
@@ -246,6 +260,10 @@ func (msg *MsgCreateSection) ValidateBasic() error {
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid section name: %s", msg.Name)
 	}
 
+	if !IsValidSectionVisibility(msg.Visibility) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid section visibility: %s", msg.Visibility)
+	}
+
 	_, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
 		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address")
@@ -382,6 +400,61 @@ func (msg *MsgMoveSection) IsManageSubspaceMsg() {}
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// NewMsgSetSectionVisibility returns a new MsgSetSectionVisibility instance
+func NewMsgSetSectionVisibility(
+	subspaceID uint64,
+	sectionID uint32,
+	visibility SectionVisibility,
+	signer string,
+) *MsgSetSectionVisibility {
+	return &MsgSetSectionVisibility{
+		SubspaceID: subspaceID,
+		SectionID:  sectionID,
+		Visibility: visibility,
+		Signer:     signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg *MsgSetSectionVisibility) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg *MsgSetSectionVisibility) Type() string { return ActionSetSectionVisibility }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSetSectionVisibility) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if !IsValidSectionVisibility(msg.Visibility) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid section visibility: %s", msg.Visibility)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgSetSectionVisibility) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgSetSectionVisibility) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgSetSectionVisibility) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // NewMsgDeleteSection returns a new MsgDeleteSection instance
 func NewMsgDeleteSection(subspaceID uint64, sectionID uint32, signer string) *MsgDeleteSection {
 	return &MsgDeleteSection{
@@ -720,13 +793,15 @@ func NewMsgAddUserToUserGroup(
 	subspaceID uint64,
 	groupID uint32,
 	user string,
+	expirationTime *time.Time,
 	signer string,
 ) *MsgAddUserToUserGroup {
 	return &MsgAddUserToUserGroup{
-		SubspaceID: subspaceID,
-		GroupID:    groupID,
-		User:       user,
-		Signer:     signer,
+		SubspaceID:     subspaceID,
+		GroupID:        groupID,
+		User:           user,
+		ExpirationTime: expirationTime,
+		Signer:         signer,
 	}
 }
 
@@ -841,14 +916,16 @@ func NewMsgSetUserPermissions(
 	sectionID uint32,
 	user string,
 	permissions Permissions,
+	expirationTime *time.Time,
 	signer string,
 ) *MsgSetUserPermissions {
 	return &MsgSetUserPermissions{
-		SubspaceID:  subspaceID,
-		SectionID:   sectionID,
-		User:        user,
-		Permissions: permissions,
-		Signer:      signer,
+		SubspaceID:     subspaceID,
+		SectionID:      sectionID,
+		User:           user,
+		Permissions:    permissions,
+		ExpirationTime: expirationTime,
+		Signer:         signer,
 	}
 }
 
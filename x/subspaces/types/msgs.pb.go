@@ -16,18 +16,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -165,8 +170,10 @@ type MsgEditSubspace struct {
 	// New description of the subspace. If it shouldn't be changed, use
 	// [do-not-modify] instead.
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	// New owner of the subspace. If it shouldn't be changed, use [do-not-modify]
-	// instead.
+	// Deprecated: the subspace ownership can only be transferred using
+	// MsgRequestSubspaceOwnerTransfer and
+	// MsgAcceptSubspaceOwnerTransferRequest. This must be either
+	// [do-not-modify] or the current owner of the subspace.
 	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	// Address of the user editing the subspace
 	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
@@ -369,33 +376,26 @@ func (m *MsgDeleteSubspaceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteSubspaceResponse proto.InternalMessageInfo
 
-// MsgCreateSection represents the message to be used when creating a subspace
-// section
-type MsgCreateSection struct {
-	// Id of the subspace inside which the section will be placed
+// MsgArchiveSubspace represents the message used to archive a subspace
+type MsgArchiveSubspace struct {
+	// Id of the subspace to archive
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Name of the section to be created
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
-	// (optional) Description of the section
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	// (optional) Id of the parent section
-	ParentID uint32 `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty" yaml:"parent_id"`
-	// User creating the section
-	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// Address of the subspace owner archiving the subspace
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgCreateSection) Reset()         { *m = MsgCreateSection{} }
-func (m *MsgCreateSection) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateSection) ProtoMessage()    {}
-func (*MsgCreateSection) Descriptor() ([]byte, []int) {
+func (m *MsgArchiveSubspace) Reset()         { *m = MsgArchiveSubspace{} }
+func (m *MsgArchiveSubspace) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveSubspace) ProtoMessage()    {}
+func (*MsgArchiveSubspace) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{6}
 }
-func (m *MsgCreateSection) XXX_Unmarshal(b []byte) error {
+func (m *MsgArchiveSubspace) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgArchiveSubspace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateSection.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgArchiveSubspace.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -405,71 +405,48 @@ func (m *MsgCreateSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateSection) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateSection.Merge(m, src)
+func (m *MsgArchiveSubspace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveSubspace.Merge(m, src)
 }
-func (m *MsgCreateSection) XXX_Size() int {
+func (m *MsgArchiveSubspace) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateSection) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateSection.DiscardUnknown(m)
+func (m *MsgArchiveSubspace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveSubspace.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateSection proto.InternalMessageInfo
+var xxx_messageInfo_MsgArchiveSubspace proto.InternalMessageInfo
 
-func (m *MsgCreateSection) GetSubspaceID() uint64 {
+func (m *MsgArchiveSubspace) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgCreateSection) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *MsgCreateSection) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
-}
-
-func (m *MsgCreateSection) GetParentID() uint32 {
-	if m != nil {
-		return m.ParentID
-	}
-	return 0
-}
-
-func (m *MsgCreateSection) GetCreator() string {
+func (m *MsgArchiveSubspace) GetSigner() string {
 	if m != nil {
-		return m.Creator
+		return m.Signer
 	}
 	return ""
 }
 
-// MsgCreateSectionResponse represents the Msg/CreateSection response type
-type MsgCreateSectionResponse struct {
-	// Id of the newly created section
-	SectionID uint32 `protobuf:"varint,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
+// MsgArchiveSubspaceResponse defines the Msg/ArchiveSubspace response type
+type MsgArchiveSubspaceResponse struct {
 }
 
-func (m *MsgCreateSectionResponse) Reset()         { *m = MsgCreateSectionResponse{} }
-func (m *MsgCreateSectionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateSectionResponse) ProtoMessage()    {}
-func (*MsgCreateSectionResponse) Descriptor() ([]byte, []int) {
+func (m *MsgArchiveSubspaceResponse) Reset()         { *m = MsgArchiveSubspaceResponse{} }
+func (m *MsgArchiveSubspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveSubspaceResponse) ProtoMessage()    {}
+func (*MsgArchiveSubspaceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{7}
 }
-func (m *MsgCreateSectionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgArchiveSubspaceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgArchiveSubspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateSectionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgArchiveSubspaceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -479,52 +456,38 @@ func (m *MsgCreateSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateSectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateSectionResponse.Merge(m, src)
+func (m *MsgArchiveSubspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveSubspaceResponse.Merge(m, src)
 }
-func (m *MsgCreateSectionResponse) XXX_Size() int {
+func (m *MsgArchiveSubspaceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateSectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateSectionResponse.DiscardUnknown(m)
+func (m *MsgArchiveSubspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveSubspaceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateSectionResponse proto.InternalMessageInfo
-
-func (m *MsgCreateSectionResponse) GetSectionID() uint32 {
-	if m != nil {
-		return m.SectionID
-	}
-	return 0
-}
+var xxx_messageInfo_MsgArchiveSubspaceResponse proto.InternalMessageInfo
 
-// MsgEditSection represents the message to be used when editing a subspace
-// section
-type MsgEditSection struct {
-	// Id of the subspace inside which the section to be edited is
+// MsgUnarchiveSubspace represents the message used to unarchive a subspace
+type MsgUnarchiveSubspace struct {
+	// Id of the subspace to unarchive
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the section to be edited
-	SectionID uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
-	// (optional) New name of the section
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
-	// (optional) New description of the section
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	// User editing the section
-	Editor string `protobuf:"bytes,5,opt,name=editor,proto3" json:"editor,omitempty" yaml:"editor"`
+	// Address of the subspace owner unarchiving the subspace
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgEditSection) Reset()         { *m = MsgEditSection{} }
-func (m *MsgEditSection) String() string { return proto.CompactTextString(m) }
-func (*MsgEditSection) ProtoMessage()    {}
-func (*MsgEditSection) Descriptor() ([]byte, []int) {
+func (m *MsgUnarchiveSubspace) Reset()         { *m = MsgUnarchiveSubspace{} }
+func (m *MsgUnarchiveSubspace) String() string { return proto.CompactTextString(m) }
+func (*MsgUnarchiveSubspace) ProtoMessage()    {}
+func (*MsgUnarchiveSubspace) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{8}
 }
-func (m *MsgEditSection) XXX_Unmarshal(b []byte) error {
+func (m *MsgUnarchiveSubspace) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgEditSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUnarchiveSubspace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgEditSection.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUnarchiveSubspace.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -534,69 +497,48 @@ func (m *MsgEditSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgEditSection) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgEditSection.Merge(m, src)
+func (m *MsgUnarchiveSubspace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnarchiveSubspace.Merge(m, src)
 }
-func (m *MsgEditSection) XXX_Size() int {
+func (m *MsgUnarchiveSubspace) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgEditSection) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgEditSection.DiscardUnknown(m)
+func (m *MsgUnarchiveSubspace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnarchiveSubspace.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgEditSection proto.InternalMessageInfo
+var xxx_messageInfo_MsgUnarchiveSubspace proto.InternalMessageInfo
 
-func (m *MsgEditSection) GetSubspaceID() uint64 {
+func (m *MsgUnarchiveSubspace) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgEditSection) GetSectionID() uint32 {
-	if m != nil {
-		return m.SectionID
-	}
-	return 0
-}
-
-func (m *MsgEditSection) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *MsgEditSection) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
-}
-
-func (m *MsgEditSection) GetEditor() string {
+func (m *MsgUnarchiveSubspace) GetSigner() string {
 	if m != nil {
-		return m.Editor
+		return m.Signer
 	}
 	return ""
 }
 
-// MsgEditSectionResponse represents the Msg/EditSection response type
-type MsgEditSectionResponse struct {
+// MsgUnarchiveSubspaceResponse defines the Msg/UnarchiveSubspace response type
+type MsgUnarchiveSubspaceResponse struct {
 }
 
-func (m *MsgEditSectionResponse) Reset()         { *m = MsgEditSectionResponse{} }
-func (m *MsgEditSectionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgEditSectionResponse) ProtoMessage()    {}
-func (*MsgEditSectionResponse) Descriptor() ([]byte, []int) {
+func (m *MsgUnarchiveSubspaceResponse) Reset()         { *m = MsgUnarchiveSubspaceResponse{} }
+func (m *MsgUnarchiveSubspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnarchiveSubspaceResponse) ProtoMessage()    {}
+func (*MsgUnarchiveSubspaceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{9}
 }
-func (m *MsgEditSectionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUnarchiveSubspaceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgEditSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUnarchiveSubspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgEditSectionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUnarchiveSubspaceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -606,43 +548,41 @@ func (m *MsgEditSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgEditSectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgEditSectionResponse.Merge(m, src)
+func (m *MsgUnarchiveSubspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnarchiveSubspaceResponse.Merge(m, src)
 }
-func (m *MsgEditSectionResponse) XXX_Size() int {
+func (m *MsgUnarchiveSubspaceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgEditSectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgEditSectionResponse.DiscardUnknown(m)
+func (m *MsgUnarchiveSubspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnarchiveSubspaceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgEditSectionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUnarchiveSubspaceResponse proto.InternalMessageInfo
 
-// MsgMoveSection represents the message to be used when moving a section to
-// another parent
-type MsgMoveSection struct {
-	// Id of the subspace inside which the section lies
+// MsgRequestSubspaceOwnerTransfer represents the message used to request the
+// transfer of a subspace ownership to receiver
+type MsgRequestSubspaceOwnerTransfer struct {
+	// Id of the subspace whose ownership should be transferred
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the section to be moved
-	SectionID uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
-	// Id of the new parent
-	NewParentID uint32 `protobuf:"varint,3,opt,name=new_parent_id,json=newParentId,proto3" json:"new_parent_id,omitempty" yaml:"new_parent_id"`
-	// Signer of the message
-	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// Address of the subspace ownership receiver
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty" yaml:"receiver"`
+	// Address of the subspace owner who is creating the transfer request
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 }
 
-func (m *MsgMoveSection) Reset()         { *m = MsgMoveSection{} }
-func (m *MsgMoveSection) String() string { return proto.CompactTextString(m) }
-func (*MsgMoveSection) ProtoMessage()    {}
-func (*MsgMoveSection) Descriptor() ([]byte, []int) {
+func (m *MsgRequestSubspaceOwnerTransfer) Reset()         { *m = MsgRequestSubspaceOwnerTransfer{} }
+func (m *MsgRequestSubspaceOwnerTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestSubspaceOwnerTransfer) ProtoMessage()    {}
+func (*MsgRequestSubspaceOwnerTransfer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{10}
 }
-func (m *MsgMoveSection) XXX_Unmarshal(b []byte) error {
+func (m *MsgRequestSubspaceOwnerTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMoveSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRequestSubspaceOwnerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMoveSection.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRequestSubspaceOwnerTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -652,62 +592,58 @@ func (m *MsgMoveSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgMoveSection) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMoveSection.Merge(m, src)
+func (m *MsgRequestSubspaceOwnerTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestSubspaceOwnerTransfer.Merge(m, src)
 }
-func (m *MsgMoveSection) XXX_Size() int {
+func (m *MsgRequestSubspaceOwnerTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMoveSection) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMoveSection.DiscardUnknown(m)
+func (m *MsgRequestSubspaceOwnerTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestSubspaceOwnerTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMoveSection proto.InternalMessageInfo
+var xxx_messageInfo_MsgRequestSubspaceOwnerTransfer proto.InternalMessageInfo
 
-func (m *MsgMoveSection) GetSubspaceID() uint64 {
+func (m *MsgRequestSubspaceOwnerTransfer) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgMoveSection) GetSectionID() uint32 {
-	if m != nil {
-		return m.SectionID
-	}
-	return 0
-}
-
-func (m *MsgMoveSection) GetNewParentID() uint32 {
+func (m *MsgRequestSubspaceOwnerTransfer) GetReceiver() string {
 	if m != nil {
-		return m.NewParentID
+		return m.Receiver
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgMoveSection) GetSigner() string {
+func (m *MsgRequestSubspaceOwnerTransfer) GetSender() string {
 	if m != nil {
-		return m.Signer
+		return m.Sender
 	}
 	return ""
 }
 
-// MsgMoveSectionResponse
-type MsgMoveSectionResponse struct {
+// MsgRequestSubspaceOwnerTransferResponse defines the
+// Msg/RequestSubspaceOwnerTransfer response type
+type MsgRequestSubspaceOwnerTransferResponse struct {
 }
 
-func (m *MsgMoveSectionResponse) Reset()         { *m = MsgMoveSectionResponse{} }
-func (m *MsgMoveSectionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMoveSectionResponse) ProtoMessage()    {}
-func (*MsgMoveSectionResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRequestSubspaceOwnerTransferResponse) Reset() {
+	*m = MsgRequestSubspaceOwnerTransferResponse{}
+}
+func (m *MsgRequestSubspaceOwnerTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestSubspaceOwnerTransferResponse) ProtoMessage()    {}
+func (*MsgRequestSubspaceOwnerTransferResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{11}
 }
-func (m *MsgMoveSectionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRequestSubspaceOwnerTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMoveSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRequestSubspaceOwnerTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMoveSectionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRequestSubspaceOwnerTransferResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -717,40 +653,39 @@ func (m *MsgMoveSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgMoveSectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMoveSectionResponse.Merge(m, src)
+func (m *MsgRequestSubspaceOwnerTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestSubspaceOwnerTransferResponse.Merge(m, src)
 }
-func (m *MsgMoveSectionResponse) XXX_Size() int {
+func (m *MsgRequestSubspaceOwnerTransferResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMoveSectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMoveSectionResponse.DiscardUnknown(m)
+func (m *MsgRequestSubspaceOwnerTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestSubspaceOwnerTransferResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMoveSectionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRequestSubspaceOwnerTransferResponse proto.InternalMessageInfo
 
-// MsgDeleteSection represents the message to be used when deleting a section
-type MsgDeleteSection struct {
-	// Id of the subspace inside which the section to be deleted is
+// MsgCancelSubspaceOwnerTransferRequest represents the message used to cancel
+// an outgoing subspace owner transfer request
+type MsgCancelSubspaceOwnerTransferRequest struct {
+	// Id of the subspace for which the request should be canceled
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the section to delete
-	SectionID uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
-	// User deleting the section
-	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// Address of the transfer request sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 }
 
-func (m *MsgDeleteSection) Reset()         { *m = MsgDeleteSection{} }
-func (m *MsgDeleteSection) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteSection) ProtoMessage()    {}
-func (*MsgDeleteSection) Descriptor() ([]byte, []int) {
+func (m *MsgCancelSubspaceOwnerTransferRequest) Reset()         { *m = MsgCancelSubspaceOwnerTransferRequest{} }
+func (m *MsgCancelSubspaceOwnerTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSubspaceOwnerTransferRequest) ProtoMessage()    {}
+func (*MsgCancelSubspaceOwnerTransferRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{12}
 }
-func (m *MsgDeleteSection) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelSubspaceOwnerTransferRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelSubspaceOwnerTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteSection.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelSubspaceOwnerTransferRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -760,55 +695,53 @@ func (m *MsgDeleteSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteSection) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteSection.Merge(m, src)
+func (m *MsgCancelSubspaceOwnerTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSubspaceOwnerTransferRequest.Merge(m, src)
 }
-func (m *MsgDeleteSection) XXX_Size() int {
+func (m *MsgCancelSubspaceOwnerTransferRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteSection) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteSection.DiscardUnknown(m)
+func (m *MsgCancelSubspaceOwnerTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSubspaceOwnerTransferRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteSection proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelSubspaceOwnerTransferRequest proto.InternalMessageInfo
 
-func (m *MsgDeleteSection) GetSubspaceID() uint64 {
+func (m *MsgCancelSubspaceOwnerTransferRequest) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgDeleteSection) GetSectionID() uint32 {
-	if m != nil {
-		return m.SectionID
-	}
-	return 0
-}
-
-func (m *MsgDeleteSection) GetSigner() string {
+func (m *MsgCancelSubspaceOwnerTransferRequest) GetSender() string {
 	if m != nil {
-		return m.Signer
+		return m.Sender
 	}
 	return ""
 }
 
-// MsgDeleteSectionResponse represents the Msg/DeleteSection response type
-type MsgDeleteSectionResponse struct {
+// MsgCancelSubspaceOwnerTransferRequestResponse defines the
+// Msg/CancelSubspaceOwnerTransferRequest response type
+type MsgCancelSubspaceOwnerTransferRequestResponse struct {
 }
 
-func (m *MsgDeleteSectionResponse) Reset()         { *m = MsgDeleteSectionResponse{} }
-func (m *MsgDeleteSectionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteSectionResponse) ProtoMessage()    {}
-func (*MsgDeleteSectionResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) Reset() {
+	*m = MsgCancelSubspaceOwnerTransferRequestResponse{}
+}
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCancelSubspaceOwnerTransferRequestResponse) ProtoMessage() {}
+func (*MsgCancelSubspaceOwnerTransferRequestResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{13}
 }
-func (m *MsgDeleteSectionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteSectionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelSubspaceOwnerTransferRequestResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -818,48 +751,39 @@ func (m *MsgDeleteSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteSectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteSectionResponse.Merge(m, src)
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSubspaceOwnerTransferRequestResponse.Merge(m, src)
 }
-func (m *MsgDeleteSectionResponse) XXX_Size() int {
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteSectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteSectionResponse.DiscardUnknown(m)
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSubspaceOwnerTransferRequestResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteSectionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelSubspaceOwnerTransferRequestResponse proto.InternalMessageInfo
 
-// MsgCreateUserGroup represents the message used to create a user group
-type MsgCreateUserGroup struct {
-	// Id of the subspace inside which the group will be created
+// MsgAcceptSubspaceOwnerTransferRequest represents the message used to accept
+// an incoming subspace owner transfer request
+type MsgAcceptSubspaceOwnerTransferRequest struct {
+	// Id of the subspace for which the request should be accepted
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// (optional) Id of the section inside which the group will be created
-	SectionID uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
-	// Name of the group
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
-	// (optional) Description of the group
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	// Default permissions to be applied to the group
-	DefaultPermissions []string `protobuf:"bytes,5,rep,name=default_permissions,json=defaultPermissions,proto3" json:"default_permissions,omitempty" yaml:"default_permissions"`
-	// Initial members to be put inside the group
-	InitialMembers []string `protobuf:"bytes,6,rep,name=initial_members,json=initialMembers,proto3" json:"initial_members,omitempty" yaml:"initial_members"`
-	// Creator of the group
-	Creator string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// Address of the request receiver
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty" yaml:"receiver"`
 }
 
-func (m *MsgCreateUserGroup) Reset()         { *m = MsgCreateUserGroup{} }
-func (m *MsgCreateUserGroup) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateUserGroup) ProtoMessage()    {}
-func (*MsgCreateUserGroup) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptSubspaceOwnerTransferRequest) Reset()         { *m = MsgAcceptSubspaceOwnerTransferRequest{} }
+func (m *MsgAcceptSubspaceOwnerTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptSubspaceOwnerTransferRequest) ProtoMessage()    {}
+func (*MsgAcceptSubspaceOwnerTransferRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{14}
 }
-func (m *MsgCreateUserGroup) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptSubspaceOwnerTransferRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptSubspaceOwnerTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateUserGroup.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptSubspaceOwnerTransferRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -869,84 +793,53 @@ func (m *MsgCreateUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateUserGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateUserGroup.Merge(m, src)
+func (m *MsgAcceptSubspaceOwnerTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptSubspaceOwnerTransferRequest.Merge(m, src)
 }
-func (m *MsgCreateUserGroup) XXX_Size() int {
+func (m *MsgAcceptSubspaceOwnerTransferRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateUserGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateUserGroup.DiscardUnknown(m)
+func (m *MsgAcceptSubspaceOwnerTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptSubspaceOwnerTransferRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateUserGroup proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptSubspaceOwnerTransferRequest proto.InternalMessageInfo
 
-func (m *MsgCreateUserGroup) GetSubspaceID() uint64 {
+func (m *MsgAcceptSubspaceOwnerTransferRequest) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgCreateUserGroup) GetSectionID() uint32 {
-	if m != nil {
-		return m.SectionID
-	}
-	return 0
-}
-
-func (m *MsgCreateUserGroup) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *MsgCreateUserGroup) GetDescription() string {
+func (m *MsgAcceptSubspaceOwnerTransferRequest) GetReceiver() string {
 	if m != nil {
-		return m.Description
+		return m.Receiver
 	}
 	return ""
 }
 
-func (m *MsgCreateUserGroup) GetDefaultPermissions() []string {
-	if m != nil {
-		return m.DefaultPermissions
-	}
-	return nil
-}
-
-func (m *MsgCreateUserGroup) GetInitialMembers() []string {
-	if m != nil {
-		return m.InitialMembers
-	}
-	return nil
+// MsgAcceptSubspaceOwnerTransferRequestResponse defines the
+// Msg/AcceptSubspaceOwnerTransferRequest response type
+type MsgAcceptSubspaceOwnerTransferRequestResponse struct {
 }
 
-func (m *MsgCreateUserGroup) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) Reset() {
+	*m = MsgAcceptSubspaceOwnerTransferRequestResponse{}
 }
-
-// MsgCreateUserGroupResponse defines the Msg/CreateUserGroup response type
-type MsgCreateUserGroupResponse struct {
-	GroupID uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *MsgCreateUserGroupResponse) Reset()         { *m = MsgCreateUserGroupResponse{} }
-func (m *MsgCreateUserGroupResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateUserGroupResponse) ProtoMessage()    {}
-func (*MsgCreateUserGroupResponse) Descriptor() ([]byte, []int) {
+func (*MsgAcceptSubspaceOwnerTransferRequestResponse) ProtoMessage() {}
+func (*MsgAcceptSubspaceOwnerTransferRequestResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{15}
 }
-func (m *MsgCreateUserGroupResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateUserGroupResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptSubspaceOwnerTransferRequestResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -956,51 +849,39 @@ func (m *MsgCreateUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateUserGroupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateUserGroupResponse.Merge(m, src)
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptSubspaceOwnerTransferRequestResponse.Merge(m, src)
 }
-func (m *MsgCreateUserGroupResponse) XXX_Size() int {
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateUserGroupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateUserGroupResponse.DiscardUnknown(m)
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptSubspaceOwnerTransferRequestResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateUserGroupResponse proto.InternalMessageInfo
-
-func (m *MsgCreateUserGroupResponse) GetGroupID() uint32 {
-	if m != nil {
-		return m.GroupID
-	}
-	return 0
-}
+var xxx_messageInfo_MsgAcceptSubspaceOwnerTransferRequestResponse proto.InternalMessageInfo
 
-// MsgEditUserGroup represents the message used to edit a user group
-type MsgEditUserGroup struct {
-	// Id of the subspace inside which the group to be edited is
+// MsgRefuseSubspaceOwnerTransferRequest represents the message used to refuse
+// an incoming subspace owner transfer request
+type MsgRefuseSubspaceOwnerTransferRequest struct {
+	// Id of the subspace for which the request should be refused
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the group to be edited
-	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
-	// (optional) New name of the group
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
-	// (optional) New description of the group
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	// User editing the group
-	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// Address of the request receiver
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty" yaml:"receiver"`
 }
 
-func (m *MsgEditUserGroup) Reset()         { *m = MsgEditUserGroup{} }
-func (m *MsgEditUserGroup) String() string { return proto.CompactTextString(m) }
-func (*MsgEditUserGroup) ProtoMessage()    {}
-func (*MsgEditUserGroup) Descriptor() ([]byte, []int) {
+func (m *MsgRefuseSubspaceOwnerTransferRequest) Reset()         { *m = MsgRefuseSubspaceOwnerTransferRequest{} }
+func (m *MsgRefuseSubspaceOwnerTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRefuseSubspaceOwnerTransferRequest) ProtoMessage()    {}
+func (*MsgRefuseSubspaceOwnerTransferRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{16}
 }
-func (m *MsgEditUserGroup) XXX_Unmarshal(b []byte) error {
+func (m *MsgRefuseSubspaceOwnerTransferRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgEditUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRefuseSubspaceOwnerTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgEditUserGroup.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRefuseSubspaceOwnerTransferRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1010,69 +891,53 @@ func (m *MsgEditUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgEditUserGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgEditUserGroup.Merge(m, src)
+func (m *MsgRefuseSubspaceOwnerTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefuseSubspaceOwnerTransferRequest.Merge(m, src)
 }
-func (m *MsgEditUserGroup) XXX_Size() int {
+func (m *MsgRefuseSubspaceOwnerTransferRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgEditUserGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgEditUserGroup.DiscardUnknown(m)
+func (m *MsgRefuseSubspaceOwnerTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefuseSubspaceOwnerTransferRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgEditUserGroup proto.InternalMessageInfo
+var xxx_messageInfo_MsgRefuseSubspaceOwnerTransferRequest proto.InternalMessageInfo
 
-func (m *MsgEditUserGroup) GetSubspaceID() uint64 {
+func (m *MsgRefuseSubspaceOwnerTransferRequest) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgEditUserGroup) GetGroupID() uint32 {
-	if m != nil {
-		return m.GroupID
-	}
-	return 0
-}
-
-func (m *MsgEditUserGroup) GetName() string {
+func (m *MsgRefuseSubspaceOwnerTransferRequest) GetReceiver() string {
 	if m != nil {
-		return m.Name
+		return m.Receiver
 	}
 	return ""
 }
 
-func (m *MsgEditUserGroup) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
+// MsgRefuseSubspaceOwnerTransferRequestResponse defines the
+// Msg/RefuseSubspaceOwnerTransferRequest response type
+type MsgRefuseSubspaceOwnerTransferRequestResponse struct {
 }
 
-func (m *MsgEditUserGroup) GetSigner() string {
-	if m != nil {
-		return m.Signer
-	}
-	return ""
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) Reset() {
+	*m = MsgRefuseSubspaceOwnerTransferRequestResponse{}
 }
-
-// MsgEditUserGroupResponse defines the Msg/EditUserGroup response type
-type MsgEditUserGroupResponse struct {
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *MsgEditUserGroupResponse) Reset()         { *m = MsgEditUserGroupResponse{} }
-func (m *MsgEditUserGroupResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgEditUserGroupResponse) ProtoMessage()    {}
-func (*MsgEditUserGroupResponse) Descriptor() ([]byte, []int) {
+func (*MsgRefuseSubspaceOwnerTransferRequestResponse) ProtoMessage() {}
+func (*MsgRefuseSubspaceOwnerTransferRequestResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{17}
 }
-func (m *MsgEditUserGroupResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgEditUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgEditUserGroupResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRefuseSubspaceOwnerTransferRequestResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1082,43 +947,47 @@ func (m *MsgEditUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgEditUserGroupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgEditUserGroupResponse.Merge(m, src)
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefuseSubspaceOwnerTransferRequestResponse.Merge(m, src)
 }
-func (m *MsgEditUserGroupResponse) XXX_Size() int {
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgEditUserGroupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgEditUserGroupResponse.DiscardUnknown(m)
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefuseSubspaceOwnerTransferRequestResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgEditUserGroupResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRefuseSubspaceOwnerTransferRequestResponse proto.InternalMessageInfo
 
-// MsgMoveUserGroup represents the message used to move one user group from a
-// section to another
-type MsgMoveUserGroup struct {
-	// Id of the subspace inside which the group to move is
+// MsgCreateSection represents the message to be used when creating a subspace
+// section
+type MsgCreateSection struct {
+	// Id of the subspace inside which the section will be placed
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the group to be moved
-	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
-	// Id of the new section where to move the group
-	NewSectionID uint32 `protobuf:"varint,3,opt,name=new_section_id,json=newSectionId,proto3" json:"new_section_id,omitempty" yaml:"new_section_id"`
-	// User signing the message
-	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// Name of the section to be created
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// (optional) Description of the section
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// (optional) Id of the parent section
+	ParentID uint32 `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty" yaml:"parent_id"`
+	// User creating the section
+	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// Visibility of the section contents
+	Visibility SectionVisibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=desmos.subspaces.v3.SectionVisibility" json:"visibility,omitempty" yaml:"visibility"`
 }
 
-func (m *MsgMoveUserGroup) Reset()         { *m = MsgMoveUserGroup{} }
-func (m *MsgMoveUserGroup) String() string { return proto.CompactTextString(m) }
-func (*MsgMoveUserGroup) ProtoMessage()    {}
-func (*MsgMoveUserGroup) Descriptor() ([]byte, []int) {
+func (m *MsgCreateSection) Reset()         { *m = MsgCreateSection{} }
+func (m *MsgCreateSection) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSection) ProtoMessage()    {}
+func (*MsgCreateSection) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{18}
 }
-func (m *MsgMoveUserGroup) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateSection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMoveUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMoveUserGroup.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSection.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1128,62 +997,78 @@ func (m *MsgMoveUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgMoveUserGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMoveUserGroup.Merge(m, src)
+func (m *MsgCreateSection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSection.Merge(m, src)
 }
-func (m *MsgMoveUserGroup) XXX_Size() int {
+func (m *MsgCreateSection) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMoveUserGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMoveUserGroup.DiscardUnknown(m)
+func (m *MsgCreateSection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMoveUserGroup proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSection proto.InternalMessageInfo
 
-func (m *MsgMoveUserGroup) GetSubspaceID() uint64 {
+func (m *MsgCreateSection) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgMoveUserGroup) GetGroupID() uint32 {
+func (m *MsgCreateSection) GetName() string {
 	if m != nil {
-		return m.GroupID
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgMoveUserGroup) GetNewSectionID() uint32 {
+func (m *MsgCreateSection) GetDescription() string {
 	if m != nil {
-		return m.NewSectionID
+		return m.Description
+	}
+	return ""
+}
+
+func (m *MsgCreateSection) GetParentID() uint32 {
+	if m != nil {
+		return m.ParentID
 	}
 	return 0
 }
 
-func (m *MsgMoveUserGroup) GetSigner() string {
+func (m *MsgCreateSection) GetCreator() string {
 	if m != nil {
-		return m.Signer
+		return m.Creator
 	}
 	return ""
 }
 
-// MsgMoveUserGroupResponse defines the Msg/MoveUserGroup response type
-type MsgMoveUserGroupResponse struct {
+func (m *MsgCreateSection) GetVisibility() SectionVisibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return SECTION_VISIBILITY_PUBLIC
 }
 
-func (m *MsgMoveUserGroupResponse) Reset()         { *m = MsgMoveUserGroupResponse{} }
-func (m *MsgMoveUserGroupResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMoveUserGroupResponse) ProtoMessage()    {}
-func (*MsgMoveUserGroupResponse) Descriptor() ([]byte, []int) {
+// MsgCreateSectionResponse represents the Msg/CreateSection response type
+type MsgCreateSectionResponse struct {
+	// Id of the newly created section
+	SectionID uint32 `protobuf:"varint,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
+}
+
+func (m *MsgCreateSectionResponse) Reset()         { *m = MsgCreateSectionResponse{} }
+func (m *MsgCreateSectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSectionResponse) ProtoMessage()    {}
+func (*MsgCreateSectionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{19}
 }
-func (m *MsgMoveUserGroupResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateSectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMoveUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMoveUserGroupResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSectionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1193,43 +1078,52 @@ func (m *MsgMoveUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgMoveUserGroupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMoveUserGroupResponse.Merge(m, src)
+func (m *MsgCreateSectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSectionResponse.Merge(m, src)
 }
-func (m *MsgMoveUserGroupResponse) XXX_Size() int {
+func (m *MsgCreateSectionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMoveUserGroupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMoveUserGroupResponse.DiscardUnknown(m)
+func (m *MsgCreateSectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMoveUserGroupResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSectionResponse proto.InternalMessageInfo
 
-// MsgSetUserGroupPermissions represents the message used to set the permissions
-// of a user group
-type MsgSetUserGroupPermissions struct {
-	// Id of the subspace inside which the group is
+func (m *MsgCreateSectionResponse) GetSectionID() uint32 {
+	if m != nil {
+		return m.SectionID
+	}
+	return 0
+}
+
+// MsgEditSection represents the message to be used when editing a subspace
+// section
+type MsgEditSection struct {
+	// Id of the subspace inside which the section to be edited is
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the group for which to set the new permissions
-	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
-	// New permissions to be set to the group
-	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty" yaml:"permissions"`
-	// User setting the new permissions
-	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// Id of the section to be edited
+	SectionID uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
+	// (optional) New name of the section
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// (optional) New description of the section
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// User editing the section
+	Editor string `protobuf:"bytes,5,opt,name=editor,proto3" json:"editor,omitempty" yaml:"editor"`
 }
 
-func (m *MsgSetUserGroupPermissions) Reset()         { *m = MsgSetUserGroupPermissions{} }
-func (m *MsgSetUserGroupPermissions) String() string { return proto.CompactTextString(m) }
-func (*MsgSetUserGroupPermissions) ProtoMessage()    {}
-func (*MsgSetUserGroupPermissions) Descriptor() ([]byte, []int) {
+func (m *MsgEditSection) Reset()         { *m = MsgEditSection{} }
+func (m *MsgEditSection) String() string { return proto.CompactTextString(m) }
+func (*MsgEditSection) ProtoMessage()    {}
+func (*MsgEditSection) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{20}
 }
-func (m *MsgSetUserGroupPermissions) XXX_Unmarshal(b []byte) error {
+func (m *MsgEditSection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetUserGroupPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgEditSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetUserGroupPermissions.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgEditSection.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1239,63 +1133,69 @@ func (m *MsgSetUserGroupPermissions) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgSetUserGroupPermissions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetUserGroupPermissions.Merge(m, src)
+func (m *MsgEditSection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditSection.Merge(m, src)
 }
-func (m *MsgSetUserGroupPermissions) XXX_Size() int {
+func (m *MsgEditSection) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetUserGroupPermissions) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetUserGroupPermissions.DiscardUnknown(m)
+func (m *MsgEditSection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditSection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetUserGroupPermissions proto.InternalMessageInfo
+var xxx_messageInfo_MsgEditSection proto.InternalMessageInfo
 
-func (m *MsgSetUserGroupPermissions) GetSubspaceID() uint64 {
+func (m *MsgEditSection) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgSetUserGroupPermissions) GetGroupID() uint32 {
+func (m *MsgEditSection) GetSectionID() uint32 {
 	if m != nil {
-		return m.GroupID
+		return m.SectionID
 	}
 	return 0
 }
 
-func (m *MsgSetUserGroupPermissions) GetPermissions() []string {
+func (m *MsgEditSection) GetName() string {
 	if m != nil {
-		return m.Permissions
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgSetUserGroupPermissions) GetSigner() string {
+func (m *MsgEditSection) GetDescription() string {
 	if m != nil {
-		return m.Signer
+		return m.Description
 	}
 	return ""
 }
 
-// MsgSetUserGroupPermissionsResponse defines the
-// Msg/SetUserGroupPermissionsResponse response type
-type MsgSetUserGroupPermissionsResponse struct {
+func (m *MsgEditSection) GetEditor() string {
+	if m != nil {
+		return m.Editor
+	}
+	return ""
 }
 
-func (m *MsgSetUserGroupPermissionsResponse) Reset()         { *m = MsgSetUserGroupPermissionsResponse{} }
-func (m *MsgSetUserGroupPermissionsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetUserGroupPermissionsResponse) ProtoMessage()    {}
-func (*MsgSetUserGroupPermissionsResponse) Descriptor() ([]byte, []int) {
+// MsgEditSectionResponse represents the Msg/EditSection response type
+type MsgEditSectionResponse struct {
+}
+
+func (m *MsgEditSectionResponse) Reset()         { *m = MsgEditSectionResponse{} }
+func (m *MsgEditSectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEditSectionResponse) ProtoMessage()    {}
+func (*MsgEditSectionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{21}
 }
-func (m *MsgSetUserGroupPermissionsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgEditSectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetUserGroupPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgEditSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetUserGroupPermissionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgEditSectionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1305,40 +1205,43 @@ func (m *MsgSetUserGroupPermissionsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgSetUserGroupPermissionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetUserGroupPermissionsResponse.Merge(m, src)
+func (m *MsgEditSectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditSectionResponse.Merge(m, src)
 }
-func (m *MsgSetUserGroupPermissionsResponse) XXX_Size() int {
+func (m *MsgEditSectionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetUserGroupPermissionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetUserGroupPermissionsResponse.DiscardUnknown(m)
+func (m *MsgEditSectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditSectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetUserGroupPermissionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgEditSectionResponse proto.InternalMessageInfo
 
-// MsgDeleteUserGroup represents the message used to delete a user group
-type MsgDeleteUserGroup struct {
-	// Id of the subspace inside which the group to delete is
+// MsgMoveSection represents the message to be used when moving a section to
+// another parent
+type MsgMoveSection struct {
+	// Id of the subspace inside which the section lies
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the group to be deleted
-	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
-	// User deleting the group
-	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// Id of the section to be moved
+	SectionID uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
+	// Id of the new parent
+	NewParentID uint32 `protobuf:"varint,3,opt,name=new_parent_id,json=newParentId,proto3" json:"new_parent_id,omitempty" yaml:"new_parent_id"`
+	// Signer of the message
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgDeleteUserGroup) Reset()         { *m = MsgDeleteUserGroup{} }
-func (m *MsgDeleteUserGroup) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteUserGroup) ProtoMessage()    {}
-func (*MsgDeleteUserGroup) Descriptor() ([]byte, []int) {
+func (m *MsgMoveSection) Reset()         { *m = MsgMoveSection{} }
+func (m *MsgMoveSection) String() string { return proto.CompactTextString(m) }
+func (*MsgMoveSection) ProtoMessage()    {}
+func (*MsgMoveSection) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{22}
 }
-func (m *MsgDeleteUserGroup) XXX_Unmarshal(b []byte) error {
+func (m *MsgMoveSection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMoveSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteUserGroup.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMoveSection.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1348,55 +1251,62 @@ func (m *MsgDeleteUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteUserGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteUserGroup.Merge(m, src)
+func (m *MsgMoveSection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMoveSection.Merge(m, src)
 }
-func (m *MsgDeleteUserGroup) XXX_Size() int {
+func (m *MsgMoveSection) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteUserGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteUserGroup.DiscardUnknown(m)
+func (m *MsgMoveSection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMoveSection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteUserGroup proto.InternalMessageInfo
+var xxx_messageInfo_MsgMoveSection proto.InternalMessageInfo
 
-func (m *MsgDeleteUserGroup) GetSubspaceID() uint64 {
+func (m *MsgMoveSection) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgDeleteUserGroup) GetGroupID() uint32 {
+func (m *MsgMoveSection) GetSectionID() uint32 {
 	if m != nil {
-		return m.GroupID
+		return m.SectionID
 	}
 	return 0
 }
 
-func (m *MsgDeleteUserGroup) GetSigner() string {
+func (m *MsgMoveSection) GetNewParentID() uint32 {
+	if m != nil {
+		return m.NewParentID
+	}
+	return 0
+}
+
+func (m *MsgMoveSection) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-// MsgDeleteUserGroupResponse defines the Msg/DeleteUserGroup response type
-type MsgDeleteUserGroupResponse struct {
+// MsgMoveSectionResponse
+type MsgMoveSectionResponse struct {
 }
 
-func (m *MsgDeleteUserGroupResponse) Reset()         { *m = MsgDeleteUserGroupResponse{} }
-func (m *MsgDeleteUserGroupResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteUserGroupResponse) ProtoMessage()    {}
-func (*MsgDeleteUserGroupResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMoveSectionResponse) Reset()         { *m = MsgMoveSectionResponse{} }
+func (m *MsgMoveSectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMoveSectionResponse) ProtoMessage()    {}
+func (*MsgMoveSectionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{23}
 }
-func (m *MsgDeleteUserGroupResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMoveSectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMoveSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteUserGroupResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMoveSectionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1406,43 +1316,43 @@ func (m *MsgDeleteUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteUserGroupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteUserGroupResponse.Merge(m, src)
+func (m *MsgMoveSectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMoveSectionResponse.Merge(m, src)
 }
-func (m *MsgDeleteUserGroupResponse) XXX_Size() int {
+func (m *MsgMoveSectionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteUserGroupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteUserGroupResponse.DiscardUnknown(m)
+func (m *MsgMoveSectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMoveSectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteUserGroupResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMoveSectionResponse proto.InternalMessageInfo
 
-// MsgAddUserToUserGroup represents the message used to add a user to a user
-// group
-type MsgAddUserToUserGroup struct {
-	// Id of the subspace inside which the group is
+// MsgSetSectionVisibility represents the message to be used when setting the
+// visibility of a section
+type MsgSetSectionVisibility struct {
+	// Id of the subspace inside which the section lies
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the group to which to add the user
-	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
-	// User to be added to the group
-	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
-	// User signing the message
+	// Id of the section whose visibility should be set
+	SectionID uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
+	// New visibility of the section
+	Visibility SectionVisibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=desmos.subspaces.v3.SectionVisibility" json:"visibility,omitempty" yaml:"visibility"`
+	// Signer of the message
 	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgAddUserToUserGroup) Reset()         { *m = MsgAddUserToUserGroup{} }
-func (m *MsgAddUserToUserGroup) String() string { return proto.CompactTextString(m) }
-func (*MsgAddUserToUserGroup) ProtoMessage()    {}
-func (*MsgAddUserToUserGroup) Descriptor() ([]byte, []int) {
+func (m *MsgSetSectionVisibility) Reset()         { *m = MsgSetSectionVisibility{} }
+func (m *MsgSetSectionVisibility) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSectionVisibility) ProtoMessage()    {}
+func (*MsgSetSectionVisibility) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{24}
 }
-func (m *MsgAddUserToUserGroup) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetSectionVisibility) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddUserToUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetSectionVisibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddUserToUserGroup.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetSectionVisibility.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1452,63 +1362,63 @@ func (m *MsgAddUserToUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgAddUserToUserGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddUserToUserGroup.Merge(m, src)
+func (m *MsgSetSectionVisibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSectionVisibility.Merge(m, src)
 }
-func (m *MsgAddUserToUserGroup) XXX_Size() int {
+func (m *MsgSetSectionVisibility) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddUserToUserGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddUserToUserGroup.DiscardUnknown(m)
+func (m *MsgSetSectionVisibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSectionVisibility.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddUserToUserGroup proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetSectionVisibility proto.InternalMessageInfo
 
-func (m *MsgAddUserToUserGroup) GetSubspaceID() uint64 {
+func (m *MsgSetSectionVisibility) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgAddUserToUserGroup) GetGroupID() uint32 {
+func (m *MsgSetSectionVisibility) GetSectionID() uint32 {
 	if m != nil {
-		return m.GroupID
+		return m.SectionID
 	}
 	return 0
 }
 
-func (m *MsgAddUserToUserGroup) GetUser() string {
+func (m *MsgSetSectionVisibility) GetVisibility() SectionVisibility {
 	if m != nil {
-		return m.User
+		return m.Visibility
 	}
-	return ""
+	return SECTION_VISIBILITY_PUBLIC
 }
 
-func (m *MsgAddUserToUserGroup) GetSigner() string {
+func (m *MsgSetSectionVisibility) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-// MsgAddUserToUserGroupResponse defines the Msg/AddUserToUserGroupResponse
+// MsgSetSectionVisibilityResponse represents the Msg/SetSectionVisibility
 // response type
-type MsgAddUserToUserGroupResponse struct {
+type MsgSetSectionVisibilityResponse struct {
 }
 
-func (m *MsgAddUserToUserGroupResponse) Reset()         { *m = MsgAddUserToUserGroupResponse{} }
-func (m *MsgAddUserToUserGroupResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddUserToUserGroupResponse) ProtoMessage()    {}
-func (*MsgAddUserToUserGroupResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetSectionVisibilityResponse) Reset()         { *m = MsgSetSectionVisibilityResponse{} }
+func (m *MsgSetSectionVisibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSectionVisibilityResponse) ProtoMessage()    {}
+func (*MsgSetSectionVisibilityResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{25}
 }
-func (m *MsgAddUserToUserGroupResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetSectionVisibilityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddUserToUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetSectionVisibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddUserToUserGroupResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetSectionVisibilityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1518,43 +1428,40 @@ func (m *MsgAddUserToUserGroupResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgAddUserToUserGroupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddUserToUserGroupResponse.Merge(m, src)
+func (m *MsgSetSectionVisibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSectionVisibilityResponse.Merge(m, src)
 }
-func (m *MsgAddUserToUserGroupResponse) XXX_Size() int {
+func (m *MsgSetSectionVisibilityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddUserToUserGroupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddUserToUserGroupResponse.DiscardUnknown(m)
+func (m *MsgSetSectionVisibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSectionVisibilityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddUserToUserGroupResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetSectionVisibilityResponse proto.InternalMessageInfo
 
-// MsgRemoveUserFromUserGroup represents the message used to remove a user from
-// a user group
-type MsgRemoveUserFromUserGroup struct {
-	// Id of the subspace inside which the group to remove the user from is
+// MsgDeleteSection represents the message to be used when deleting a section
+type MsgDeleteSection struct {
+	// Id of the subspace inside which the section to be deleted is
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the group from which to remove the user
-	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
-	// User to be removed from the group
-	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
-	// User signing the message
-	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// Id of the section to delete
+	SectionID uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
+	// User deleting the section
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgRemoveUserFromUserGroup) Reset()         { *m = MsgRemoveUserFromUserGroup{} }
-func (m *MsgRemoveUserFromUserGroup) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveUserFromUserGroup) ProtoMessage()    {}
-func (*MsgRemoveUserFromUserGroup) Descriptor() ([]byte, []int) {
+func (m *MsgDeleteSection) Reset()         { *m = MsgDeleteSection{} }
+func (m *MsgDeleteSection) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteSection) ProtoMessage()    {}
+func (*MsgDeleteSection) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{26}
 }
-func (m *MsgRemoveUserFromUserGroup) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteSection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveUserFromUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveUserFromUserGroup.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteSection.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1564,63 +1471,55 @@ func (m *MsgRemoveUserFromUserGroup) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveUserFromUserGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveUserFromUserGroup.Merge(m, src)
+func (m *MsgDeleteSection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteSection.Merge(m, src)
 }
-func (m *MsgRemoveUserFromUserGroup) XXX_Size() int {
+func (m *MsgDeleteSection) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveUserFromUserGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveUserFromUserGroup.DiscardUnknown(m)
+func (m *MsgDeleteSection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteSection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveUserFromUserGroup proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteSection proto.InternalMessageInfo
 
-func (m *MsgRemoveUserFromUserGroup) GetSubspaceID() uint64 {
+func (m *MsgDeleteSection) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgRemoveUserFromUserGroup) GetGroupID() uint32 {
+func (m *MsgDeleteSection) GetSectionID() uint32 {
 	if m != nil {
-		return m.GroupID
+		return m.SectionID
 	}
 	return 0
 }
 
-func (m *MsgRemoveUserFromUserGroup) GetUser() string {
-	if m != nil {
-		return m.User
-	}
-	return ""
-}
-
-func (m *MsgRemoveUserFromUserGroup) GetSigner() string {
+func (m *MsgDeleteSection) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-// MsgRemoveUserFromUserGroupResponse defines the
-// Msg/RemoveUserFromUserGroupResponse response type
-type MsgRemoveUserFromUserGroupResponse struct {
+// MsgDeleteSectionResponse represents the Msg/DeleteSection response type
+type MsgDeleteSectionResponse struct {
 }
 
-func (m *MsgRemoveUserFromUserGroupResponse) Reset()         { *m = MsgRemoveUserFromUserGroupResponse{} }
-func (m *MsgRemoveUserFromUserGroupResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveUserFromUserGroupResponse) ProtoMessage()    {}
-func (*MsgRemoveUserFromUserGroupResponse) Descriptor() ([]byte, []int) {
+func (m *MsgDeleteSectionResponse) Reset()         { *m = MsgDeleteSectionResponse{} }
+func (m *MsgDeleteSectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteSectionResponse) ProtoMessage()    {}
+func (*MsgDeleteSectionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{27}
 }
-func (m *MsgRemoveUserFromUserGroupResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteSectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveUserFromUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveUserFromUserGroupResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteSectionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1630,45 +1529,48 @@ func (m *MsgRemoveUserFromUserGroupResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveUserFromUserGroupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveUserFromUserGroupResponse.Merge(m, src)
+func (m *MsgDeleteSectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteSectionResponse.Merge(m, src)
 }
-func (m *MsgRemoveUserFromUserGroupResponse) XXX_Size() int {
+func (m *MsgDeleteSectionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveUserFromUserGroupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveUserFromUserGroupResponse.DiscardUnknown(m)
+func (m *MsgDeleteSectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteSectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveUserFromUserGroupResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteSectionResponse proto.InternalMessageInfo
 
-// MsgSetUserPermissions represents the message used to set the permissions of a
-// specific user
-type MsgSetUserPermissions struct {
-	// Id of the subspace inside which to set the permissions
+// MsgCreateUserGroup represents the message used to create a user group
+type MsgCreateUserGroup struct {
+	// Id of the subspace inside which the group will be created
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the section for which to set the permissions
+	// (optional) Id of the section inside which the group will be created
 	SectionID uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
-	// User for which to set the permissions
-	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
-	// Permissions to be set to the user
-	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty" yaml:"permissions"`
-	// User signing the message
-	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// Name of the group
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// (optional) Description of the group
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Default permissions to be applied to the group
+	DefaultPermissions []string `protobuf:"bytes,5,rep,name=default_permissions,json=defaultPermissions,proto3" json:"default_permissions,omitempty" yaml:"default_permissions"`
+	// Initial members to be put inside the group
+	InitialMembers []string `protobuf:"bytes,6,rep,name=initial_members,json=initialMembers,proto3" json:"initial_members,omitempty" yaml:"initial_members"`
+	// Creator of the group
+	Creator string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
 }
 
-func (m *MsgSetUserPermissions) Reset()         { *m = MsgSetUserPermissions{} }
-func (m *MsgSetUserPermissions) String() string { return proto.CompactTextString(m) }
-func (*MsgSetUserPermissions) ProtoMessage()    {}
-func (*MsgSetUserPermissions) Descriptor() ([]byte, []int) {
+func (m *MsgCreateUserGroup) Reset()         { *m = MsgCreateUserGroup{} }
+func (m *MsgCreateUserGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateUserGroup) ProtoMessage()    {}
+func (*MsgCreateUserGroup) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{28}
 }
-func (m *MsgSetUserPermissions) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateUserGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetUserPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetUserPermissions.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateUserGroup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1678,70 +1580,84 @@ func (m *MsgSetUserPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgSetUserPermissions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetUserPermissions.Merge(m, src)
+func (m *MsgCreateUserGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateUserGroup.Merge(m, src)
 }
-func (m *MsgSetUserPermissions) XXX_Size() int {
+func (m *MsgCreateUserGroup) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetUserPermissions) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetUserPermissions.DiscardUnknown(m)
+func (m *MsgCreateUserGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateUserGroup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetUserPermissions proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateUserGroup proto.InternalMessageInfo
 
-func (m *MsgSetUserPermissions) GetSubspaceID() uint64 {
+func (m *MsgCreateUserGroup) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgSetUserPermissions) GetSectionID() uint32 {
+func (m *MsgCreateUserGroup) GetSectionID() uint32 {
 	if m != nil {
 		return m.SectionID
 	}
 	return 0
 }
 
-func (m *MsgSetUserPermissions) GetUser() string {
+func (m *MsgCreateUserGroup) GetName() string {
 	if m != nil {
-		return m.User
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgSetUserPermissions) GetPermissions() []string {
+func (m *MsgCreateUserGroup) GetDescription() string {
 	if m != nil {
-		return m.Permissions
+		return m.Description
+	}
+	return ""
+}
+
+func (m *MsgCreateUserGroup) GetDefaultPermissions() []string {
+	if m != nil {
+		return m.DefaultPermissions
 	}
 	return nil
 }
 
-func (m *MsgSetUserPermissions) GetSigner() string {
+func (m *MsgCreateUserGroup) GetInitialMembers() []string {
 	if m != nil {
-		return m.Signer
+		return m.InitialMembers
+	}
+	return nil
+}
+
+func (m *MsgCreateUserGroup) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
 	return ""
 }
 
-// MsgSetUserPermissionsResponse defines the Msg/SetPermissionsResponse
-// response type
-type MsgSetUserPermissionsResponse struct {
+// MsgCreateUserGroupResponse defines the Msg/CreateUserGroup response type
+type MsgCreateUserGroupResponse struct {
+	GroupID uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
 }
 
-func (m *MsgSetUserPermissionsResponse) Reset()         { *m = MsgSetUserPermissionsResponse{} }
-func (m *MsgSetUserPermissionsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetUserPermissionsResponse) ProtoMessage()    {}
-func (*MsgSetUserPermissionsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateUserGroupResponse) Reset()         { *m = MsgCreateUserGroupResponse{} }
+func (m *MsgCreateUserGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateUserGroupResponse) ProtoMessage()    {}
+func (*MsgCreateUserGroupResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{29}
 }
-func (m *MsgSetUserPermissionsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateUserGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetUserPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetUserPermissionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateUserGroupResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1751,43 +1667,51 @@ func (m *MsgSetUserPermissionsResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgSetUserPermissionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetUserPermissionsResponse.Merge(m, src)
+func (m *MsgCreateUserGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateUserGroupResponse.Merge(m, src)
 }
-func (m *MsgSetUserPermissionsResponse) XXX_Size() int {
+func (m *MsgCreateUserGroupResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetUserPermissionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetUserPermissionsResponse.DiscardUnknown(m)
+func (m *MsgCreateUserGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateUserGroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetUserPermissionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateUserGroupResponse proto.InternalMessageInfo
 
-// MsgGrantAllowance adds grants for the grantee to spend up allowance of fees
-// from the treasury inside the given subspace
-type MsgGrantAllowance struct {
-	// Id of the subspace inside which where the allowance should be granted
+func (m *MsgCreateUserGroupResponse) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
+}
+
+// MsgEditUserGroup represents the message used to edit a user group
+type MsgEditUserGroup struct {
+	// Id of the subspace inside which the group to be edited is
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Address of the user granting the allowance
-	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty" yaml:"granter"`
-	// Target being granted the allowance
-	Grantee *types.Any `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
-	// Allowance can be any allowance type that implements AllowanceI
-	Allowance *types.Any `protobuf:"bytes,4,opt,name=allowance,proto3" json:"allowance,omitempty" yaml:"allowance"`
+	// Id of the group to be edited
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// (optional) New name of the group
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// (optional) New description of the group
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// User editing the group
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgGrantAllowance) Reset()         { *m = MsgGrantAllowance{} }
-func (m *MsgGrantAllowance) String() string { return proto.CompactTextString(m) }
-func (*MsgGrantAllowance) ProtoMessage()    {}
-func (*MsgGrantAllowance) Descriptor() ([]byte, []int) {
+func (m *MsgEditUserGroup) Reset()         { *m = MsgEditUserGroup{} }
+func (m *MsgEditUserGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgEditUserGroup) ProtoMessage()    {}
+func (*MsgEditUserGroup) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{30}
 }
-func (m *MsgGrantAllowance) XXX_Unmarshal(b []byte) error {
+func (m *MsgEditUserGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGrantAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgEditUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGrantAllowance.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgEditUserGroup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1797,63 +1721,69 @@ func (m *MsgGrantAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgGrantAllowance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGrantAllowance.Merge(m, src)
+func (m *MsgEditUserGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditUserGroup.Merge(m, src)
 }
-func (m *MsgGrantAllowance) XXX_Size() int {
+func (m *MsgEditUserGroup) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGrantAllowance) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGrantAllowance.DiscardUnknown(m)
+func (m *MsgEditUserGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditUserGroup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGrantAllowance proto.InternalMessageInfo
+var xxx_messageInfo_MsgEditUserGroup proto.InternalMessageInfo
 
-func (m *MsgGrantAllowance) GetSubspaceID() uint64 {
+func (m *MsgEditUserGroup) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgGrantAllowance) GetGranter() string {
+func (m *MsgEditUserGroup) GetGroupID() uint32 {
 	if m != nil {
-		return m.Granter
+		return m.GroupID
+	}
+	return 0
+}
+
+func (m *MsgEditUserGroup) GetName() string {
+	if m != nil {
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgGrantAllowance) GetGrantee() *types.Any {
+func (m *MsgEditUserGroup) GetDescription() string {
 	if m != nil {
-		return m.Grantee
+		return m.Description
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgGrantAllowance) GetAllowance() *types.Any {
+func (m *MsgEditUserGroup) GetSigner() string {
 	if m != nil {
-		return m.Allowance
+		return m.Signer
 	}
-	return nil
+	return ""
 }
 
-// MsgGrantAllowanceResponse defines the Msg/GrantAllowanceResponse response
-// type.
-type MsgGrantAllowanceResponse struct {
+// MsgEditUserGroupResponse defines the Msg/EditUserGroup response type
+type MsgEditUserGroupResponse struct {
 }
 
-func (m *MsgGrantAllowanceResponse) Reset()         { *m = MsgGrantAllowanceResponse{} }
-func (m *MsgGrantAllowanceResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGrantAllowanceResponse) ProtoMessage()    {}
-func (*MsgGrantAllowanceResponse) Descriptor() ([]byte, []int) {
+func (m *MsgEditUserGroupResponse) Reset()         { *m = MsgEditUserGroupResponse{} }
+func (m *MsgEditUserGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEditUserGroupResponse) ProtoMessage()    {}
+func (*MsgEditUserGroupResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{31}
 }
-func (m *MsgGrantAllowanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgEditUserGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGrantAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgEditUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGrantAllowanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgEditUserGroupResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1863,41 +1793,43 @@ func (m *MsgGrantAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgGrantAllowanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGrantAllowanceResponse.Merge(m, src)
+func (m *MsgEditUserGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditUserGroupResponse.Merge(m, src)
 }
-func (m *MsgGrantAllowanceResponse) XXX_Size() int {
+func (m *MsgEditUserGroupResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGrantAllowanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGrantAllowanceResponse.DiscardUnknown(m)
+func (m *MsgEditUserGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditUserGroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGrantAllowanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgEditUserGroupResponse proto.InternalMessageInfo
 
-// MsgRevokeAllowance removes any existing allowance to the grantee inside the
-// subspace
-type MsgRevokeAllowance struct {
-	// If of the subspace inside which the allowance to be deleted is
+// MsgMoveUserGroup represents the message used to move one user group from a
+// section to another
+type MsgMoveUserGroup struct {
+	// Id of the subspace inside which the group to move is
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Address of the user that created the allowance
-	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty" yaml:"granter"`
-	// Target being revoked the allowance
-	Grantee *types.Any `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
+	// Id of the group to be moved
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Id of the new section where to move the group
+	NewSectionID uint32 `protobuf:"varint,3,opt,name=new_section_id,json=newSectionId,proto3" json:"new_section_id,omitempty" yaml:"new_section_id"`
+	// User signing the message
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgRevokeAllowance) Reset()         { *m = MsgRevokeAllowance{} }
-func (m *MsgRevokeAllowance) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokeAllowance) ProtoMessage()    {}
-func (*MsgRevokeAllowance) Descriptor() ([]byte, []int) {
+func (m *MsgMoveUserGroup) Reset()         { *m = MsgMoveUserGroup{} }
+func (m *MsgMoveUserGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgMoveUserGroup) ProtoMessage()    {}
+func (*MsgMoveUserGroup) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{32}
 }
-func (m *MsgRevokeAllowance) XXX_Unmarshal(b []byte) error {
+func (m *MsgMoveUserGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMoveUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokeAllowance.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMoveUserGroup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1907,56 +1839,62 @@ func (m *MsgRevokeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokeAllowance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokeAllowance.Merge(m, src)
+func (m *MsgMoveUserGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMoveUserGroup.Merge(m, src)
 }
-func (m *MsgRevokeAllowance) XXX_Size() int {
+func (m *MsgMoveUserGroup) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokeAllowance) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokeAllowance.DiscardUnknown(m)
+func (m *MsgMoveUserGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMoveUserGroup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokeAllowance proto.InternalMessageInfo
+var xxx_messageInfo_MsgMoveUserGroup proto.InternalMessageInfo
 
-func (m *MsgRevokeAllowance) GetSubspaceID() uint64 {
+func (m *MsgMoveUserGroup) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgRevokeAllowance) GetGranter() string {
+func (m *MsgMoveUserGroup) GetGroupID() uint32 {
 	if m != nil {
-		return m.Granter
+		return m.GroupID
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgRevokeAllowance) GetGrantee() *types.Any {
+func (m *MsgMoveUserGroup) GetNewSectionID() uint32 {
 	if m != nil {
-		return m.Grantee
+		return m.NewSectionID
 	}
-	return nil
+	return 0
 }
 
-// MsgRevokeAllowanceResponse defines the Msg/RevokeAllowanceResponse
-// response type.
-type MsgRevokeAllowanceResponse struct {
+func (m *MsgMoveUserGroup) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgRevokeAllowanceResponse) Reset()         { *m = MsgRevokeAllowanceResponse{} }
-func (m *MsgRevokeAllowanceResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokeAllowanceResponse) ProtoMessage()    {}
-func (*MsgRevokeAllowanceResponse) Descriptor() ([]byte, []int) {
+// MsgMoveUserGroupResponse defines the Msg/MoveUserGroup response type
+type MsgMoveUserGroupResponse struct {
+}
+
+func (m *MsgMoveUserGroupResponse) Reset()         { *m = MsgMoveUserGroupResponse{} }
+func (m *MsgMoveUserGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMoveUserGroupResponse) ProtoMessage()    {}
+func (*MsgMoveUserGroupResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{33}
 }
-func (m *MsgRevokeAllowanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMoveUserGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokeAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMoveUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokeAllowanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMoveUserGroupResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1966,43 +1904,43 @@ func (m *MsgRevokeAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokeAllowanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokeAllowanceResponse.Merge(m, src)
+func (m *MsgMoveUserGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMoveUserGroupResponse.Merge(m, src)
 }
-func (m *MsgRevokeAllowanceResponse) XXX_Size() int {
+func (m *MsgMoveUserGroupResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokeAllowanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokeAllowanceResponse.DiscardUnknown(m)
+func (m *MsgMoveUserGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMoveUserGroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokeAllowanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMoveUserGroupResponse proto.InternalMessageInfo
 
-// MsgGrantTreasuryAuthorization grants an authorization on behalf of the
-// treasury to a user
-type MsgGrantTreasuryAuthorization struct {
-	// Id of the subspace where the authorization should be granted
+// MsgSetUserGroupPermissions represents the message used to set the permissions
+// of a user group
+type MsgSetUserGroupPermissions struct {
+	// Id of the subspace inside which the group is
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Address of the user granting a treasury authorization
-	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty" yaml:"granter"`
-	// Address of the user who is being granted a treasury authorization
-	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
-	// Grant represents the authorization to execute the provided methods
-	Grant authz.Grant `protobuf:"bytes,4,opt,name=grant,proto3" json:"grant" yaml:"grant"`
+	// Id of the group for which to set the new permissions
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// New permissions to be set to the group
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty" yaml:"permissions"`
+	// User setting the new permissions
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgGrantTreasuryAuthorization) Reset()         { *m = MsgGrantTreasuryAuthorization{} }
-func (m *MsgGrantTreasuryAuthorization) String() string { return proto.CompactTextString(m) }
-func (*MsgGrantTreasuryAuthorization) ProtoMessage()    {}
-func (*MsgGrantTreasuryAuthorization) Descriptor() ([]byte, []int) {
+func (m *MsgSetUserGroupPermissions) Reset()         { *m = MsgSetUserGroupPermissions{} }
+func (m *MsgSetUserGroupPermissions) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUserGroupPermissions) ProtoMessage()    {}
+func (*MsgSetUserGroupPermissions) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{34}
 }
-func (m *MsgGrantTreasuryAuthorization) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetUserGroupPermissions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGrantTreasuryAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetUserGroupPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGrantTreasuryAuthorization.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetUserGroupPermissions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2012,63 +1950,63 @@ func (m *MsgGrantTreasuryAuthorization) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgGrantTreasuryAuthorization) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGrantTreasuryAuthorization.Merge(m, src)
+func (m *MsgSetUserGroupPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUserGroupPermissions.Merge(m, src)
 }
-func (m *MsgGrantTreasuryAuthorization) XXX_Size() int {
+func (m *MsgSetUserGroupPermissions) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGrantTreasuryAuthorization) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGrantTreasuryAuthorization.DiscardUnknown(m)
+func (m *MsgSetUserGroupPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUserGroupPermissions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGrantTreasuryAuthorization proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetUserGroupPermissions proto.InternalMessageInfo
 
-func (m *MsgGrantTreasuryAuthorization) GetSubspaceID() uint64 {
+func (m *MsgSetUserGroupPermissions) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgGrantTreasuryAuthorization) GetGranter() string {
+func (m *MsgSetUserGroupPermissions) GetGroupID() uint32 {
 	if m != nil {
-		return m.Granter
+		return m.GroupID
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgGrantTreasuryAuthorization) GetGrantee() string {
+func (m *MsgSetUserGroupPermissions) GetPermissions() []string {
 	if m != nil {
-		return m.Grantee
+		return m.Permissions
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgGrantTreasuryAuthorization) GetGrant() authz.Grant {
+func (m *MsgSetUserGroupPermissions) GetSigner() string {
 	if m != nil {
-		return m.Grant
+		return m.Signer
 	}
-	return authz.Grant{}
+	return ""
 }
 
-// MsgGrantTreasuryAuthorizationResponse defines the
-// Msg/MsgGrantTreasuryAuthorization response type
-type MsgGrantTreasuryAuthorizationResponse struct {
+// MsgSetUserGroupPermissionsResponse defines the
+// Msg/SetUserGroupPermissionsResponse response type
+type MsgSetUserGroupPermissionsResponse struct {
 }
 
-func (m *MsgGrantTreasuryAuthorizationResponse) Reset()         { *m = MsgGrantTreasuryAuthorizationResponse{} }
-func (m *MsgGrantTreasuryAuthorizationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGrantTreasuryAuthorizationResponse) ProtoMessage()    {}
-func (*MsgGrantTreasuryAuthorizationResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetUserGroupPermissionsResponse) Reset()         { *m = MsgSetUserGroupPermissionsResponse{} }
+func (m *MsgSetUserGroupPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUserGroupPermissionsResponse) ProtoMessage()    {}
+func (*MsgSetUserGroupPermissionsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{35}
 }
-func (m *MsgGrantTreasuryAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetUserGroupPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGrantTreasuryAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetUserGroupPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGrantTreasuryAuthorizationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetUserGroupPermissionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2078,43 +2016,40 @@ func (m *MsgGrantTreasuryAuthorizationResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgGrantTreasuryAuthorizationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGrantTreasuryAuthorizationResponse.Merge(m, src)
+func (m *MsgSetUserGroupPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUserGroupPermissionsResponse.Merge(m, src)
 }
-func (m *MsgGrantTreasuryAuthorizationResponse) XXX_Size() int {
+func (m *MsgSetUserGroupPermissionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGrantTreasuryAuthorizationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGrantTreasuryAuthorizationResponse.DiscardUnknown(m)
+func (m *MsgSetUserGroupPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUserGroupPermissionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGrantTreasuryAuthorizationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetUserGroupPermissionsResponse proto.InternalMessageInfo
 
-// MsgRevokeTreasuryAuthorization revokes an existing treasury authorization
-// from a user
-type MsgRevokeTreasuryAuthorization struct {
-	// Id of the subspace from which the authorization should be revoked
+// MsgDeleteUserGroup represents the message used to delete a user group
+type MsgDeleteUserGroup struct {
+	// Id of the subspace inside which the group to delete is
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Address of the user revoking the treasury authorization
-	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty" yaml:"granter"`
-	// Address of the user who is being revoked the treasury authorization
-	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
-	// Type url of the authorized message which is being revoked
-	MsgTypeUrl string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// Id of the group to be deleted
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// User deleting the group
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgRevokeTreasuryAuthorization) Reset()         { *m = MsgRevokeTreasuryAuthorization{} }
-func (m *MsgRevokeTreasuryAuthorization) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokeTreasuryAuthorization) ProtoMessage()    {}
-func (*MsgRevokeTreasuryAuthorization) Descriptor() ([]byte, []int) {
+func (m *MsgDeleteUserGroup) Reset()         { *m = MsgDeleteUserGroup{} }
+func (m *MsgDeleteUserGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteUserGroup) ProtoMessage()    {}
+func (*MsgDeleteUserGroup) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{36}
 }
-func (m *MsgRevokeTreasuryAuthorization) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteUserGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokeTreasuryAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokeTreasuryAuthorization.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteUserGroup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2124,65 +2059,55 @@ func (m *MsgRevokeTreasuryAuthorization) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokeTreasuryAuthorization) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokeTreasuryAuthorization.Merge(m, src)
+func (m *MsgDeleteUserGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteUserGroup.Merge(m, src)
 }
-func (m *MsgRevokeTreasuryAuthorization) XXX_Size() int {
+func (m *MsgDeleteUserGroup) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokeTreasuryAuthorization) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokeTreasuryAuthorization.DiscardUnknown(m)
+func (m *MsgDeleteUserGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteUserGroup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokeTreasuryAuthorization proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteUserGroup proto.InternalMessageInfo
 
-func (m *MsgRevokeTreasuryAuthorization) GetSubspaceID() uint64 {
+func (m *MsgDeleteUserGroup) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgRevokeTreasuryAuthorization) GetGranter() string {
-	if m != nil {
-		return m.Granter
-	}
-	return ""
-}
-
-func (m *MsgRevokeTreasuryAuthorization) GetGrantee() string {
+func (m *MsgDeleteUserGroup) GetGroupID() uint32 {
 	if m != nil {
-		return m.Grantee
+		return m.GroupID
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgRevokeTreasuryAuthorization) GetMsgTypeUrl() string {
+func (m *MsgDeleteUserGroup) GetSigner() string {
 	if m != nil {
-		return m.MsgTypeUrl
+		return m.Signer
 	}
 	return ""
 }
 
-// MsgRevokeTreasuryAuthorizationResponse defines the
-// Msg/MsgRevokeTreasuryAuthorization response type
-type MsgRevokeTreasuryAuthorizationResponse struct {
+// MsgDeleteUserGroupResponse defines the Msg/DeleteUserGroup response type
+type MsgDeleteUserGroupResponse struct {
 }
 
-func (m *MsgRevokeTreasuryAuthorizationResponse) Reset() {
-	*m = MsgRevokeTreasuryAuthorizationResponse{}
-}
-func (m *MsgRevokeTreasuryAuthorizationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokeTreasuryAuthorizationResponse) ProtoMessage()    {}
-func (*MsgRevokeTreasuryAuthorizationResponse) Descriptor() ([]byte, []int) {
+func (m *MsgDeleteUserGroupResponse) Reset()         { *m = MsgDeleteUserGroupResponse{} }
+func (m *MsgDeleteUserGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteUserGroupResponse) ProtoMessage()    {}
+func (*MsgDeleteUserGroupResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{37}
 }
-func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteUserGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokeTreasuryAuthorizationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteUserGroupResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2192,46 +2117,45 @@ func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokeTreasuryAuthorizationResponse.Merge(m, src)
+func (m *MsgDeleteUserGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteUserGroupResponse.Merge(m, src)
 }
-func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_Size() int {
+func (m *MsgDeleteUserGroupResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokeTreasuryAuthorizationResponse.DiscardUnknown(m)
+func (m *MsgDeleteUserGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteUserGroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokeTreasuryAuthorizationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteUserGroupResponse proto.InternalMessageInfo
 
-// MsgUpdateSubspaceFeeTokens represents the message to be used to update the
-// accepted fee tokens inside a given subspace, using an on-chain governance
-// proposal
-//
-// Since: Desmos 6.0.0
-type MsgUpdateSubspaceFeeTokens struct {
-	// Id of the subspace where the list of allowed fee tokens will be updated
+// MsgAddUserToUserGroup represents the message used to add a user to a user
+// group
+type MsgAddUserToUserGroup struct {
+	// Id of the subspace inside which the group is
 	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// List of tokens allowed to be fee tokens inside the given subspace,
-	// represented as their gas prices
-	AdditionalFeeTokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=additional_fee_tokens,json=additionalFeeTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_fee_tokens" yaml:"additional_fee_tokens"`
-	// authority is the address that controls the module (defaults to x/gov unless
-	// overwritten).
-	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Id of the group to which to add the user
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// User to be added to the group
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	// User signing the message
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// (optional) Time after which the user will be removed from the group
+	ExpirationTime *time.Time `protobuf:"bytes,5,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty" yaml:"expiration_time"`
 }
 
-func (m *MsgUpdateSubspaceFeeTokens) Reset()         { *m = MsgUpdateSubspaceFeeTokens{} }
-func (m *MsgUpdateSubspaceFeeTokens) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateSubspaceFeeTokens) ProtoMessage()    {}
-func (*MsgUpdateSubspaceFeeTokens) Descriptor() ([]byte, []int) {
+func (m *MsgAddUserToUserGroup) Reset()         { *m = MsgAddUserToUserGroup{} }
+func (m *MsgAddUserToUserGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgAddUserToUserGroup) ProtoMessage()    {}
+func (*MsgAddUserToUserGroup) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{38}
 }
-func (m *MsgUpdateSubspaceFeeTokens) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddUserToUserGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateSubspaceFeeTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddUserToUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateSubspaceFeeTokens.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddUserToUserGroup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2241,58 +2165,70 @@ func (m *MsgUpdateSubspaceFeeTokens) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateSubspaceFeeTokens) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateSubspaceFeeTokens.Merge(m, src)
+func (m *MsgAddUserToUserGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddUserToUserGroup.Merge(m, src)
 }
-func (m *MsgUpdateSubspaceFeeTokens) XXX_Size() int {
+func (m *MsgAddUserToUserGroup) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateSubspaceFeeTokens) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateSubspaceFeeTokens.DiscardUnknown(m)
+func (m *MsgAddUserToUserGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddUserToUserGroup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateSubspaceFeeTokens proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddUserToUserGroup proto.InternalMessageInfo
 
-func (m *MsgUpdateSubspaceFeeTokens) GetSubspaceID() uint64 {
+func (m *MsgAddUserToUserGroup) GetSubspaceID() uint64 {
 	if m != nil {
 		return m.SubspaceID
 	}
 	return 0
 }
 
-func (m *MsgUpdateSubspaceFeeTokens) GetAdditionalFeeTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *MsgAddUserToUserGroup) GetGroupID() uint32 {
 	if m != nil {
-		return m.AdditionalFeeTokens
+		return m.GroupID
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgUpdateSubspaceFeeTokens) GetAuthority() string {
+func (m *MsgAddUserToUserGroup) GetUser() string {
 	if m != nil {
-		return m.Authority
+		return m.User
 	}
 	return ""
 }
 
-// MsgUpdateSubspaceFeeTokensResponse represents the Msg/UpdateSubspaceFeeTokens
+func (m *MsgAddUserToUserGroup) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddUserToUserGroup) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+// MsgAddUserToUserGroupResponse defines the Msg/AddUserToUserGroupResponse
 // response type
-//
-// Since: Desmos 6.0.0
-type MsgUpdateSubspaceFeeTokensResponse struct {
+type MsgAddUserToUserGroupResponse struct {
 }
 
-func (m *MsgUpdateSubspaceFeeTokensResponse) Reset()         { *m = MsgUpdateSubspaceFeeTokensResponse{} }
-func (m *MsgUpdateSubspaceFeeTokensResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateSubspaceFeeTokensResponse) ProtoMessage()    {}
-func (*MsgUpdateSubspaceFeeTokensResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAddUserToUserGroupResponse) Reset()         { *m = MsgAddUserToUserGroupResponse{} }
+func (m *MsgAddUserToUserGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddUserToUserGroupResponse) ProtoMessage()    {}
+func (*MsgAddUserToUserGroupResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_35d68359a074bdd9, []int{39}
 }
-func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddUserToUserGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddUserToUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateSubspaceFeeTokensResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddUserToUserGroupResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2302,3179 +2238,13353 @@ func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateSubspaceFeeTokensResponse.Merge(m, src)
+func (m *MsgAddUserToUserGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddUserToUserGroupResponse.Merge(m, src)
 }
-func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_Size() int {
+func (m *MsgAddUserToUserGroupResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateSubspaceFeeTokensResponse.DiscardUnknown(m)
+func (m *MsgAddUserToUserGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddUserToUserGroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateSubspaceFeeTokensResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddUserToUserGroupResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateSubspace)(nil), "desmos.subspaces.v3.MsgCreateSubspace")
-	proto.RegisterType((*MsgCreateSubspaceResponse)(nil), "desmos.subspaces.v3.MsgCreateSubspaceResponse")
-	proto.RegisterType((*MsgEditSubspace)(nil), "desmos.subspaces.v3.MsgEditSubspace")
-	proto.RegisterType((*MsgEditSubspaceResponse)(nil), "desmos.subspaces.v3.MsgEditSubspaceResponse")
-	proto.RegisterType((*MsgDeleteSubspace)(nil), "desmos.subspaces.v3.MsgDeleteSubspace")
-	proto.RegisterType((*MsgDeleteSubspaceResponse)(nil), "desmos.subspaces.v3.MsgDeleteSubspaceResponse")
-	proto.RegisterType((*MsgCreateSection)(nil), "desmos.subspaces.v3.MsgCreateSection")
-	proto.RegisterType((*MsgCreateSectionResponse)(nil), "desmos.subspaces.v3.MsgCreateSectionResponse")
-	proto.RegisterType((*MsgEditSection)(nil), "desmos.subspaces.v3.MsgEditSection")
-	proto.RegisterType((*MsgEditSectionResponse)(nil), "desmos.subspaces.v3.MsgEditSectionResponse")
-	proto.RegisterType((*MsgMoveSection)(nil), "desmos.subspaces.v3.MsgMoveSection")
-	proto.RegisterType((*MsgMoveSectionResponse)(nil), "desmos.subspaces.v3.MsgMoveSectionResponse")
-	proto.RegisterType((*MsgDeleteSection)(nil), "desmos.subspaces.v3.MsgDeleteSection")
-	proto.RegisterType((*MsgDeleteSectionResponse)(nil), "desmos.subspaces.v3.MsgDeleteSectionResponse")
-	proto.RegisterType((*MsgCreateUserGroup)(nil), "desmos.subspaces.v3.MsgCreateUserGroup")
-	proto.RegisterType((*MsgCreateUserGroupResponse)(nil), "desmos.subspaces.v3.MsgCreateUserGroupResponse")
-	proto.RegisterType((*MsgEditUserGroup)(nil), "desmos.subspaces.v3.MsgEditUserGroup")
-	proto.RegisterType((*MsgEditUserGroupResponse)(nil), "desmos.subspaces.v3.MsgEditUserGroupResponse")
-	proto.RegisterType((*MsgMoveUserGroup)(nil), "desmos.subspaces.v3.MsgMoveUserGroup")
-	proto.RegisterType((*MsgMoveUserGroupResponse)(nil), "desmos.subspaces.v3.MsgMoveUserGroupResponse")
-	proto.RegisterType((*MsgSetUserGroupPermissions)(nil), "desmos.subspaces.v3.MsgSetUserGroupPermissions")
-	proto.RegisterType((*MsgSetUserGroupPermissionsResponse)(nil), "desmos.subspaces.v3.MsgSetUserGroupPermissionsResponse")
-	proto.RegisterType((*MsgDeleteUserGroup)(nil), "desmos.subspaces.v3.MsgDeleteUserGroup")
-	proto.RegisterType((*MsgDeleteUserGroupResponse)(nil), "desmos.subspaces.v3.MsgDeleteUserGroupResponse")
-	proto.RegisterType((*MsgAddUserToUserGroup)(nil), "desmos.subspaces.v3.MsgAddUserToUserGroup")
-	proto.RegisterType((*MsgAddUserToUserGroupResponse)(nil), "desmos.subspaces.v3.MsgAddUserToUserGroupResponse")
-	proto.RegisterType((*MsgRemoveUserFromUserGroup)(nil), "desmos.subspaces.v3.MsgRemoveUserFromUserGroup")
-	proto.RegisterType((*MsgRemoveUserFromUserGroupResponse)(nil), "desmos.subspaces.v3.MsgRemoveUserFromUserGroupResponse")
-	proto.RegisterType((*MsgSetUserPermissions)(nil), "desmos.subspaces.v3.MsgSetUserPermissions")
-	proto.RegisterType((*MsgSetUserPermissionsResponse)(nil), "desmos.subspaces.v3.MsgSetUserPermissionsResponse")
-	proto.RegisterType((*MsgGrantAllowance)(nil), "desmos.subspaces.v3.MsgGrantAllowance")
-	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "desmos.subspaces.v3.MsgGrantAllowanceResponse")
-	proto.RegisterType((*MsgRevokeAllowance)(nil), "desmos.subspaces.v3.MsgRevokeAllowance")
-	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "desmos.subspaces.v3.MsgRevokeAllowanceResponse")
-	proto.RegisterType((*MsgGrantTreasuryAuthorization)(nil), "desmos.subspaces.v3.MsgGrantTreasuryAuthorization")
-	proto.RegisterType((*MsgGrantTreasuryAuthorizationResponse)(nil), "desmos.subspaces.v3.MsgGrantTreasuryAuthorizationResponse")
-	proto.RegisterType((*MsgRevokeTreasuryAuthorization)(nil), "desmos.subspaces.v3.MsgRevokeTreasuryAuthorization")
-	proto.RegisterType((*MsgRevokeTreasuryAuthorizationResponse)(nil), "desmos.subspaces.v3.MsgRevokeTreasuryAuthorizationResponse")
-	proto.RegisterType((*MsgUpdateSubspaceFeeTokens)(nil), "desmos.subspaces.v3.MsgUpdateSubspaceFeeTokens")
-	proto.RegisterType((*MsgUpdateSubspaceFeeTokensResponse)(nil), "desmos.subspaces.v3.MsgUpdateSubspaceFeeTokensResponse")
+// MsgRemoveUserFromUserGroup represents the message used to remove a user from
+// a user group
+type MsgRemoveUserFromUserGroup struct {
+	// Id of the subspace inside which the group to remove the user from is
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the group from which to remove the user
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// User to be removed from the group
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	// User signing the message
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func init() { proto.RegisterFile("desmos/subspaces/v3/msgs.proto", fileDescriptor_35d68359a074bdd9) }
-
-var fileDescriptor_35d68359a074bdd9 = []byte{
-	// 2005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x1f, 0x7f, 0x64, 0x32, 0x29, 0xe7, 0x63, 0xa6, 0xe7, 0x23, 0xb6, 0x67, 0x70, 0x87, 0xde,
-	0x71, 0xe2, 0x98, 0x8d, 0xad, 0x24, 0xb0, 0x1f, 0x5e, 0x81, 0x64, 0x6f, 0x76, 0x47, 0x11, 0x9b,
-	0xdd, 0x95, 0x27, 0x23, 0x24, 0x10, 0xb2, 0xda, 0xee, 0x4a, 0xa7, 0x15, 0xbb, 0xdb, 0xea, 0x6a,
-	0x27, 0x78, 0x4f, 0x08, 0x38, 0x00, 0x42, 0x82, 0x33, 0x12, 0x5c, 0xb8, 0x20, 0x4e, 0x39, 0x2c,
-	0x7f, 0x03, 0x2b, 0x0e, 0x68, 0xe1, 0xc4, 0xc9, 0x8b, 0x1c, 0x89, 0xdc, 0x7d, 0xe1, 0x8a, 0xba,
-	0xaa, 0xba, 0xba, 0xba, 0xed, 0x72, 0x1c, 0xaf, 0x07, 0x82, 0x86, 0x4b, 0xdc, 0xfd, 0xea, 0x57,
-	0xaf, 0xaa, 0x7e, 0xef, 0xd5, 0x7b, 0xd5, 0xaf, 0x02, 0x32, 0x1a, 0x44, 0x2d, 0x0b, 0x15, 0x51,
-	0xa7, 0x8e, 0xda, 0x6a, 0x03, 0xa2, 0xe2, 0xe9, 0x6e, 0xb1, 0x85, 0x74, 0x54, 0x68, 0xdb, 0x96,
-	0x63, 0x49, 0xf7, 0x49, 0x7b, 0x81, 0xb5, 0x17, 0x4e, 0x77, 0xd3, 0xf7, 0xd4, 0x96, 0x61, 0x5a,
-	0x45, 0xfc, 0x97, 0xe0, 0xd2, 0x0f, 0x74, 0x4b, 0xb7, 0xf0, 0x63, 0xd1, 0x7d, 0xa2, 0xd2, 0x94,
-	0x6e, 0x59, 0x7a, 0x13, 0x16, 0xf1, 0x5b, 0xbd, 0x73, 0x54, 0x54, 0xcd, 0x2e, 0x6d, 0xca, 0x34,
-	0x2c, 0x3c, 0x70, 0x5d, 0x45, 0xb0, 0x78, 0xba, 0x5d, 0x87, 0x8e, 0xba, 0x5d, 0x6c, 0x58, 0x86,
-	0xe9, 0x75, 0x25, 0xed, 0x35, 0xa2, 0x93, 0xbc, 0xd0, 0xa6, 0x55, 0xda, 0xb5, 0x85, 0xf4, 0xe2,
-	0xe9, 0xb6, 0xfb, 0x43, 0x1b, 0xd6, 0x68, 0x83, 0xda, 0x71, 0x8e, 0x3f, 0x61, 0x4a, 0xf1, 0x1b,
-	0x41, 0x28, 0xbf, 0x8b, 0x82, 0x7b, 0x07, 0x48, 0x7f, 0xd7, 0x86, 0xaa, 0x03, 0x9f, 0xd3, 0x35,
-	0x49, 0xaf, 0x81, 0xb8, 0xa9, 0xb6, 0x60, 0x32, 0xb2, 0x16, 0xc9, 0x2d, 0x54, 0x56, 0x06, 0x3d,
-	0x39, 0xd1, 0x55, 0x5b, 0xcd, 0x92, 0xe2, 0x4a, 0x95, 0x2a, 0x6e, 0x94, 0xde, 0x02, 0x09, 0x0d,
-	0xa2, 0x86, 0x6d, 0xb4, 0x1d, 0xc3, 0x32, 0x93, 0x51, 0x8c, 0x7d, 0x34, 0xe8, 0xc9, 0x12, 0xc1,
-	0x72, 0x8d, 0x4a, 0x95, 0x87, 0x4a, 0xdf, 0x02, 0x73, 0xd6, 0x99, 0x09, 0xed, 0x64, 0x0c, 0xf7,
-	0xc9, 0x0d, 0x7a, 0xf2, 0x22, 0xe9, 0x83, 0xc5, 0xca, 0xdf, 0x3e, 0xdd, 0x7a, 0x40, 0x17, 0x58,
-	0xd6, 0x34, 0x1b, 0x22, 0xf4, 0xdc, 0xb1, 0x0d, 0x53, 0xaf, 0x92, 0x6e, 0xd2, 0x1e, 0x98, 0x6f,
-	0xb8, 0x13, 0xb6, 0xec, 0x64, 0x1c, 0x6b, 0xc8, 0x0f, 0x7a, 0xf2, 0x32, 0xd1, 0x40, 0x1b, 0xc4,
-	0x3a, 0xbc, 0xae, 0xa5, 0xcd, 0x1f, 0x5d, 0x9e, 0xe7, 0xbd, 0xb7, 0x9f, 0x5f, 0x9e, 0xe7, 0x93,
-	0xd4, 0xf4, 0x43, 0x7c, 0x28, 0x75, 0x90, 0x1a, 0x12, 0x56, 0x21, 0x6a, 0x5b, 0x26, 0x82, 0xd2,
-	0x7b, 0x20, 0xe1, 0x39, 0x43, 0xcd, 0xd0, 0x30, 0x67, 0xf1, 0xca, 0xd3, 0x7e, 0x4f, 0x06, 0x1e,
-	0x74, 0x7f, 0xcf, 0x67, 0x85, 0x83, 0x2a, 0x55, 0xe0, 0xbd, 0xed, 0x6b, 0x4a, 0x3f, 0x0a, 0x56,
-	0x0e, 0x90, 0xfe, 0x9e, 0x66, 0x38, 0xcc, 0x0e, 0xb3, 0x51, 0xcd, 0xcc, 0x19, 0xbd, 0x86, 0x39,
-	0x63, 0x53, 0x98, 0x33, 0x3e, 0x9d, 0x39, 0xcb, 0xe0, 0x36, 0x32, 0x74, 0x57, 0xc1, 0x1c, 0x56,
-	0xb0, 0x39, 0xe8, 0xc9, 0x4b, 0x74, 0x49, 0x58, 0x2e, 0xd6, 0x40, 0x3b, 0x96, 0xd6, 0x5d, 0x5b,
-	0xd2, 0x17, 0xd7, 0x94, 0x8f, 0x7c, 0x53, 0xf2, 0x84, 0x2a, 0x29, 0xb0, 0x1a, 0x12, 0x79, 0x66,
-	0x54, 0xfe, 0x14, 0xc1, 0x3b, 0x61, 0x0f, 0x36, 0x21, 0xb7, 0x13, 0x66, 0x64, 0x01, 0x7f, 0x89,
-	0xd1, 0x69, 0x97, 0x98, 0x0b, 0x2d, 0x91, 0xf3, 0xd6, 0xe0, 0x9c, 0x95, 0xc7, 0xd8, 0x5b, 0x83,
-	0x42, 0xb6, 0xcc, 0xcb, 0x28, 0xb8, 0xeb, 0xfb, 0x32, 0x6c, 0x60, 0x0b, 0xfe, 0x6f, 0xf8, 0xd9,
-	0x37, 0xc1, 0x42, 0x5b, 0xb5, 0xa1, 0xe9, 0xb8, 0x73, 0x74, 0x7d, 0x6d, 0xa9, 0xb2, 0xd6, 0xef,
-	0xc9, 0x77, 0x3e, 0xc6, 0x42, 0x3c, 0xc3, 0xbb, 0x44, 0x07, 0x83, 0x29, 0xd5, 0x3b, 0xe4, 0x79,
-	0x5f, 0xe3, 0xa3, 0xc6, 0xdc, 0xf4, 0x51, 0x23, 0x17, 0x8e, 0x1a, 0xab, 0x43, 0x51, 0x83, 0x90,
-	0xaa, 0x7c, 0x1f, 0x24, 0xc3, 0x32, 0x16, 0x33, 0xca, 0x00, 0x20, 0x22, 0xf2, 0xf8, 0x5e, 0xaa,
-	0x28, 0xfd, 0x9e, 0xbc, 0x40, 0x81, 0x78, 0x31, 0xf7, 0x28, 0xdd, 0x0c, 0xa8, 0x54, 0x17, 0xe8,
-	0xcb, 0xbe, 0xa6, 0x5c, 0x44, 0xc1, 0xb2, 0xe7, 0xcb, 0xb3, 0x35, 0x63, 0x70, 0x72, 0xd1, 0x29,
-	0x26, 0xc7, 0x3c, 0x21, 0x76, 0x0d, 0x4f, 0x88, 0x4f, 0xee, 0x09, 0x65, 0x70, 0x1b, 0x6a, 0x86,
-	0x6f, 0x49, 0x6e, 0x3b, 0x11, 0xf9, 0x98, 0xed, 0x44, 0x00, 0xa5, 0x2c, 0xde, 0x4e, 0xe4, 0xc5,
-	0x35, 0xe3, 0xc3, 0x50, 0xc4, 0xa0, 0x46, 0x4c, 0x82, 0x47, 0x41, 0x09, 0xdb, 0x48, 0x7f, 0x25,
-	0xfc, 0x1f, 0x58, 0xa7, 0xf0, 0xe6, 0xf1, 0xff, 0x6d, 0xb0, 0x64, 0xc2, 0xb3, 0x9a, 0xbf, 0x5d,
-	0x62, 0x58, 0xcb, 0x46, 0xbf, 0x27, 0x27, 0x3e, 0x84, 0x67, 0xdc, 0x8e, 0x79, 0x40, 0xed, 0xc2,
-	0xa3, 0x95, 0x6a, 0xc2, 0x64, 0x20, 0x3e, 0x78, 0xc5, 0xa7, 0x0d, 0x5e, 0xd9, 0x50, 0xf0, 0xe2,
-	0xd8, 0xe6, 0x08, 0xa4, 0x6c, 0x73, 0x12, 0xc6, 0xf6, 0x4f, 0x49, 0xd8, 0xa2, 0x41, 0xed, 0xc6,
-	0xf1, 0xed, 0x53, 0x14, 0x9b, 0x96, 0xa2, 0x8d, 0x10, 0x45, 0xab, 0x43, 0xf1, 0x9d, 0x92, 0x94,
-	0xc6, 0x71, 0x25, 0x20, 0x63, 0x34, 0xfd, 0x26, 0x0e, 0x24, 0x16, 0x74, 0x5e, 0x20, 0x68, 0x3f,
-	0xb3, 0xad, 0x4e, 0xfb, 0xd5, 0x09, 0x0c, 0x1f, 0x81, 0xfb, 0x1a, 0x3c, 0x52, 0x3b, 0x4d, 0xa7,
-	0xd6, 0x86, 0x76, 0xcb, 0x40, 0xc8, 0xb0, 0x4c, 0x94, 0x9c, 0x5b, 0x8b, 0xe5, 0x16, 0x2a, 0x99,
-	0x41, 0x4f, 0x4e, 0x7b, 0x1a, 0x86, 0x40, 0x4a, 0x55, 0xa2, 0xd2, 0x8f, 0x7d, 0xa1, 0xf4, 0x3d,
-	0xb0, 0x62, 0x98, 0x86, 0x63, 0xa8, 0xcd, 0x5a, 0x0b, 0xb6, 0xea, 0xd0, 0x46, 0xc9, 0xdb, 0x58,
-	0xd9, 0xce, 0xa0, 0x27, 0x3f, 0x22, 0xca, 0x42, 0x00, 0xb1, 0xa9, 0x97, 0x29, 0xf2, 0x80, 0x00,
-	0xf9, 0x8c, 0x34, 0x3f, 0x7d, 0x46, 0xca, 0x87, 0x33, 0x52, 0x2a, 0x9c, 0x91, 0x98, 0x23, 0x28,
-	0xdf, 0x01, 0xe9, 0x61, 0x29, 0xcb, 0x4a, 0x6f, 0x83, 0x3b, 0xba, 0x2b, 0xf0, 0x73, 0x52, 0xa6,
-	0xdf, 0x93, 0xe7, 0x31, 0x08, 0xdb, 0x76, 0x85, 0xcc, 0xcd, 0x03, 0x29, 0xd5, 0x79, 0xfc, 0xb8,
-	0xaf, 0x29, 0x5f, 0x90, 0xfd, 0xe9, 0x06, 0xca, 0x99, 0xbb, 0x1d, 0x3f, 0xad, 0xe8, 0xb5, 0xa6,
-	0xf5, 0x1f, 0xc8, 0x43, 0x5f, 0xf6, 0xe4, 0x3a, 0x66, 0xdb, 0x07, 0xc8, 0xa4, 0xdb, 0x3e, 0x20,
-	0xe3, 0x73, 0xd1, 0x5d, 0x1a, 0x38, 0x6f, 0x12, 0xfb, 0x1f, 0x81, 0x65, 0x37, 0xaf, 0x70, 0x31,
-	0x83, 0xa4, 0xa1, 0xcd, 0x7e, 0x4f, 0x5e, 0xfc, 0x10, 0x9e, 0xf1, 0x61, 0xe3, 0xa1, 0x9f, 0x87,
-	0xf8, 0xd0, 0xb1, 0x68, 0xfa, 0xb0, 0x99, 0x64, 0xa2, 0x31, 0x7c, 0x07, 0xe8, 0xa3, 0x7c, 0x07,
-	0x64, 0x8c, 0xef, 0xbf, 0x44, 0xf1, 0x3e, 0x7a, 0x0e, 0x7d, 0x5b, 0xf0, 0x41, 0xe3, 0xbf, 0xcf,
-	0xfc, 0x5b, 0x20, 0xc1, 0xc7, 0xbf, 0x18, 0x0e, 0x59, 0x9c, 0x4b, 0x07, 0xe2, 0x1e, 0x0f, 0x9d,
-	0x05, 0xc5, 0xdb, 0x21, 0x8a, 0xbf, 0xea, 0x53, 0x2c, 0x60, 0x4c, 0x79, 0x0a, 0x14, 0x71, 0x2b,
-	0xa3, 0xfd, 0x27, 0x51, 0x9c, 0xdd, 0x48, 0xea, 0xbb, 0x49, 0x8e, 0x3e, 0x83, 0xf4, 0xbf, 0x19,
-	0x22, 0x2d, 0x15, 0x4e, 0xff, 0xbe, 0x67, 0x3e, 0xc1, 0xce, 0x17, 0x92, 0xfa, 0xdf, 0xb1, 0x51,
-	0xf0, 0xf0, 0x00, 0xe9, 0x65, 0x4d, 0x73, 0xdb, 0x0e, 0xad, 0x9b, 0xc4, 0xd3, 0x3b, 0x20, 0xde,
-	0x41, 0x8c, 0xa5, 0x0d, 0x3f, 0x1c, 0xbb, 0x52, 0x31, 0x47, 0xb8, 0xd3, 0x2c, 0x3c, 0xf3, 0xf5,
-	0x10, 0xc9, 0x4f, 0x7c, 0x92, 0x87, 0xf9, 0x52, 0x64, 0xf0, 0x95, 0x91, 0x0d, 0x7c, 0xd8, 0x75,
-	0x2d, 0x51, 0x85, 0x2d, 0x1a, 0x25, 0xde, 0xb7, 0xad, 0xd6, 0xff, 0xf9, 0x9e, 0x28, 0x12, 0x08,
-	0x48, 0xa3, 0x91, 0x40, 0xd0, 0xca, 0x98, 0xff, 0x59, 0x0c, 0x3b, 0x39, 0x0d, 0x18, 0x2f, 0x21,
-	0xf6, 0xce, 0xe0, 0xa8, 0xfb, 0xa5, 0xc8, 0x0f, 0x05, 0xf0, 0xf8, 0x34, 0x01, 0x7c, 0xee, 0x25,
-	0x6c, 0x93, 0x61, 0xc6, 0xe9, 0x36, 0x19, 0x6e, 0x60, 0xc6, 0xfa, 0x6d, 0x0c, 0x57, 0xd6, 0x9e,
-	0xd9, 0xaa, 0xe9, 0x94, 0x9b, 0x4d, 0xeb, 0x4c, 0x35, 0x67, 0x57, 0x59, 0xdb, 0x03, 0xf3, 0xba,
-	0xab, 0x98, 0x95, 0xd6, 0xb8, 0x33, 0x34, 0x6d, 0x18, 0x73, 0x86, 0xa6, 0x08, 0x49, 0xf5, 0xb4,
-	0x90, 0xa3, 0x62, 0x62, 0xe7, 0x41, 0x81, 0x54, 0xea, 0x0b, 0x5e, 0xa5, 0xbe, 0x50, 0x36, 0xbb,
-	0x95, 0xed, 0xb0, 0x6e, 0xa8, 0xfc, 0xf9, 0xd3, 0xad, 0xc7, 0x23, 0xae, 0x04, 0x0a, 0xcf, 0x48,
-	0xbb, 0x37, 0x04, 0x94, 0x4c, 0xb0, 0xa0, 0x7a, 0x8b, 0xc7, 0x3b, 0x4a, 0x34, 0x48, 0xc9, 0xaf,
-	0x63, 0xb1, 0x0e, 0xee, 0x30, 0x0a, 0x5d, 0xc2, 0x11, 0x84, 0x58, 0x67, 0x81, 0xd6, 0xf3, 0x0b,
-	0x8c, 0xd3, 0xfd, 0xaa, 0x3f, 0x04, 0x2d, 0x6f, 0xd3, 0x05, 0x86, 0x0a, 0x86, 0x41, 0x53, 0xd0,
-	0x82, 0x61, 0x50, 0xc8, 0xac, 0x77, 0x4e, 0x92, 0x6e, 0x15, 0x9e, 0x5a, 0x27, 0xf0, 0x95, 0x35,
-	0x1f, 0xfd, 0xca, 0xe2, 0xe8, 0x4c, 0xf1, 0xb1, 0x2c, 0xc0, 0x0d, 0x4d, 0xd0, 0x21, 0x29, 0x23,
-	0xf4, 0x5f, 0x51, 0xbc, 0x61, 0xf0, 0x08, 0x87, 0x36, 0x54, 0x51, 0xc7, 0xee, 0x96, 0x3b, 0xce,
-	0xb1, 0x65, 0x1b, 0x9f, 0xa8, 0xb3, 0xac, 0x6b, 0xcc, 0x86, 0xdb, 0xbd, 0x20, 0xb7, 0x23, 0xb4,
-	0xc0, 0x2b, 0xb5, 0x40, 0xe9, 0x03, 0x30, 0x87, 0x1f, 0xa9, 0xe7, 0x3f, 0x2e, 0x50, 0x38, 0xb9,
-	0x8b, 0xf2, 0x3c, 0x19, 0x73, 0x52, 0x49, 0x7d, 0xd6, 0x93, 0x6f, 0xf9, 0x97, 0x08, 0xb8, 0x9f,
-	0xf2, 0xfb, 0xcb, 0xf3, 0x7c, 0xa4, 0x4a, 0x94, 0x94, 0xbe, 0x11, 0x36, 0xc6, 0xd3, 0x90, 0x6f,
-	0x8f, 0xe4, 0x55, 0xd9, 0x00, 0xd9, 0xb1, 0x00, 0x66, 0xa2, 0x7f, 0x46, 0x41, 0x86, 0x59, 0xf0,
-	0x95, 0xb1, 0xd1, 0xdb, 0x60, 0xb1, 0x85, 0xf4, 0x9a, 0xd3, 0x6d, 0xc3, 0x5a, 0xc7, 0x6e, 0xd2,
-	0xb4, 0xbf, 0x3a, 0xe8, 0xc9, 0xf7, 0x89, 0x2a, 0xbe, 0x55, 0xa9, 0x82, 0x16, 0xd2, 0x0f, 0xbb,
-	0x6d, 0xf8, 0xc2, 0x6e, 0x96, 0xde, 0x08, 0x1b, 0x24, 0x1b, 0xde, 0x1d, 0xa3, 0x2d, 0x92, 0x03,
-	0xeb, 0xe3, 0x11, 0x7c, 0xc6, 0x77, 0x37, 0xd5, 0x8b, 0xb6, 0xc6, 0xdd, 0xc1, 0xbd, 0x0f, 0xe1,
-	0xa1, 0x75, 0x02, 0x67, 0x97, 0xf6, 0xff, 0x18, 0x01, 0x0f, 0x55, 0x4d, 0x33, 0xdc, 0xa1, 0xd5,
-	0x66, 0xed, 0x08, 0xc2, 0x9a, 0x83, 0x07, 0x48, 0x46, 0xd7, 0x62, 0xb9, 0xc4, 0x4e, 0xca, 0xf3,
-	0xdb, 0xba, 0x8a, 0x20, 0x73, 0xdb, 0x77, 0x2d, 0xc3, 0xac, 0x1c, 0x51, 0xaf, 0x7d, 0x42, 0x43,
-	0xf7, 0x28, 0x2d, 0xca, 0x1f, 0xbe, 0x90, 0x73, 0xba, 0xe1, 0x1c, 0x77, 0xea, 0x85, 0x86, 0xd5,
-	0xa2, 0xb7, 0xb8, 0xf4, 0x67, 0x0b, 0x69, 0x27, 0x45, 0x97, 0x5e, 0x84, 0x15, 0xa2, 0x5f, 0x5f,
-	0x9e, 0xe7, 0x17, 0x9b, 0x50, 0x57, 0x1b, 0xdd, 0x5a, 0xc3, 0x15, 0x90, 0x2d, 0x70, 0xdf, 0xd7,
-	0xec, 0x2f, 0xff, 0x0d, 0xb0, 0xa0, 0x12, 0xda, 0x9c, 0x2e, 0x75, 0x81, 0xa4, 0xd0, 0xe0, 0x3e,
-	0xb4, 0xf4, 0x75, 0xd7, 0x6e, 0xfe, 0x7b, 0xe8, 0x8c, 0x26, 0x20, 0x9b, 0x9e, 0xd1, 0x04, 0xad,
-	0x9e, 0xc5, 0x76, 0x06, 0xf7, 0x40, 0xec, 0x00, 0xe9, 0xd2, 0x31, 0x58, 0x0e, 0x5d, 0x2f, 0xaf,
-	0x17, 0x46, 0x85, 0xdc, 0xa1, 0x1b, 0xd6, 0x74, 0x61, 0x32, 0x1c, 0xab, 0x5f, 0xd5, 0xc1, 0x62,
-	0xe0, 0xfa, 0xf4, 0xa9, 0xa8, 0x3f, 0x8f, 0x4a, 0xbf, 0x3e, 0x09, 0x8a, 0x8d, 0x71, 0x0c, 0x96,
-	0x43, 0x57, 0x84, 0xc2, 0xd5, 0x04, 0x71, 0xe2, 0xd5, 0x8c, 0xbe, 0xa9, 0x93, 0x20, 0x58, 0x0a,
-	0xde, 0xd2, 0x65, 0xaf, 0xa0, 0x83, 0xc0, 0xd2, 0x5b, 0x13, 0xc1, 0xd8, 0x30, 0x35, 0x90, 0xe0,
-	0xef, 0x90, 0x5e, 0x1b, 0xcb, 0x06, 0x1d, 0xe2, 0x6b, 0x13, 0x80, 0xf8, 0x01, 0xf8, 0x4b, 0x12,
-	0xe1, 0x00, 0x1c, 0x48, 0x3c, 0xc0, 0x88, 0xbb, 0x01, 0x97, 0xa8, 0xe0, 0xbd, 0x40, 0xf6, 0x0a,
-	0xa6, 0xaf, 0x22, 0x6a, 0x64, 0x6d, 0x5d, 0x3a, 0x01, 0x2b, 0xe1, 0xba, 0xfa, 0xc6, 0x78, 0xaa,
-	0x19, 0x30, 0x5d, 0x9c, 0x10, 0xc8, 0xaf, 0x29, 0x58, 0x4b, 0xcd, 0x8e, 0xa3, 0xdc, 0x1f, 0x68,
-	0x6b, 0x22, 0x18, 0x3f, 0x4c, 0xb0, 0x68, 0x98, 0x1d, 0x47, 0xfc, 0x04, 0xc3, 0x8c, 0xac, 0x97,
-	0x49, 0x3f, 0x8e, 0x80, 0x55, 0x51, 0xb1, 0x4c, 0x48, 0x8d, 0xa0, 0x43, 0xfa, 0xcd, 0x6b, 0x76,
-	0xe0, 0x0d, 0x18, 0x2e, 0x1d, 0x6d, 0x8c, 0x77, 0x81, 0x09, 0x0c, 0x28, 0x28, 0xc3, 0x48, 0x0e,
-	0x90, 0x46, 0x94, 0x60, 0xf2, 0x22, 0x35, 0xc3, 0xd8, 0xf4, 0xce, 0xe4, 0xd8, 0x00, 0xd1, 0xa2,
-	0x72, 0x84, 0x70, 0x09, 0x82, 0x0e, 0x62, 0xa2, 0xaf, 0xf8, 0x3a, 0x77, 0xd7, 0x3e, 0xe2, 0xcb,
-	0x3c, 0x7f, 0x85, 0xdd, 0x78, 0x1b, 0xef, 0x4c, 0x8e, 0x65, 0xa3, 0xfe, 0x22, 0x02, 0xd2, 0x63,
-	0x0e, 0xd5, 0x42, 0x95, 0xe2, 0x3e, 0xe9, 0xd2, 0xf5, 0xfb, 0xb0, 0xe9, 0xfc, 0x32, 0x02, 0x1e,
-	0x8f, 0x3b, 0x40, 0xee, 0x8a, 0xd9, 0x15, 0x76, 0x4a, 0xbf, 0x33, 0x45, 0x27, 0x3e, 0x75, 0x85,
-	0xbe, 0xc1, 0xd7, 0xc7, 0xae, 0x8f, 0xe1, 0xc4, 0xa9, 0x6b, 0xf4, 0x37, 0xa3, 0xbb, 0xd3, 0xc2,
-	0xdf, 0x8b, 0x1b, 0xe3, 0x67, 0xee, 0x8f, 0x55, 0x9c, 0x10, 0x18, 0xf0, 0x79, 0xd1, 0xb1, 0x50,
-	0xa8, 0x4c, 0xd0, 0x41, 0xec, 0xf3, 0x57, 0x9c, 0x76, 0xd2, 0x73, 0x3f, 0x74, 0x4f, 0x67, 0x95,
-	0x0f, 0x3e, 0xeb, 0x67, 0x22, 0x9f, 0xf7, 0x33, 0x91, 0x7f, 0xf4, 0x33, 0x91, 0x5f, 0x5d, 0x64,
-	0x6e, 0x7d, 0x7e, 0x91, 0xb9, 0xf5, 0xf7, 0x8b, 0xcc, 0xad, 0xef, 0xee, 0x70, 0xe7, 0x3e, 0x32,
-	0xc6, 0x56, 0x53, 0xad, 0x23, 0xfa, 0x5c, 0x3c, 0x7d, 0xb3, 0xf8, 0x03, 0xee, 0x9f, 0x0e, 0xf1,
-	0x39, 0xb0, 0x7e, 0x1b, 0x7f, 0xbe, 0xee, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xca, 0x1a, 0xa1,
-	0x8d, 0x95, 0x28, 0x00, 0x00,
+func (m *MsgRemoveUserFromUserGroup) Reset()         { *m = MsgRemoveUserFromUserGroup{} }
+func (m *MsgRemoveUserFromUserGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveUserFromUserGroup) ProtoMessage()    {}
+func (*MsgRemoveUserFromUserGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{40}
+}
+func (m *MsgRemoveUserFromUserGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveUserFromUserGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveUserFromUserGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveUserFromUserGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveUserFromUserGroup.Merge(m, src)
+}
+func (m *MsgRemoveUserFromUserGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveUserFromUserGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveUserFromUserGroup.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgRemoveUserFromUserGroup proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateSubspace allows to create a subspace
-	CreateSubspace(ctx context.Context, in *MsgCreateSubspace, opts ...grpc.CallOption) (*MsgCreateSubspaceResponse, error)
-	// EditSubspace allows to edit a subspace
-	EditSubspace(ctx context.Context, in *MsgEditSubspace, opts ...grpc.CallOption) (*MsgEditSubspaceResponse, error)
-	// DeleteSubspace allows to delete a subspace
-	DeleteSubspace(ctx context.Context, in *MsgDeleteSubspace, opts ...grpc.CallOption) (*MsgDeleteSubspaceResponse, error)
-	// CreateSection allows to create a new subspace section
-	CreateSection(ctx context.Context, in *MsgCreateSection, opts ...grpc.CallOption) (*MsgCreateSectionResponse, error)
-	// EditSection allows to edit an existing section
-	EditSection(ctx context.Context, in *MsgEditSection, opts ...grpc.CallOption) (*MsgEditSectionResponse, error)
-	// MoveSection allows to move an existing section to another parent
-	MoveSection(ctx context.Context, in *MsgMoveSection, opts ...grpc.CallOption) (*MsgMoveSectionResponse, error)
-	// DeleteSection allows to delete an existing section
-	DeleteSection(ctx context.Context, in *MsgDeleteSection, opts ...grpc.CallOption) (*MsgDeleteSectionResponse, error)
-	// CreateUserGroup allows to create a user group
-	CreateUserGroup(ctx context.Context, in *MsgCreateUserGroup, opts ...grpc.CallOption) (*MsgCreateUserGroupResponse, error)
-	// EditUserGroup allows to edit a user group
-	EditUserGroup(ctx context.Context, in *MsgEditUserGroup, opts ...grpc.CallOption) (*MsgEditUserGroupResponse, error)
-	// MoveUserGroup allows to move a user group from a section to another
-	MoveUserGroup(ctx context.Context, in *MsgMoveUserGroup, opts ...grpc.CallOption) (*MsgMoveUserGroupResponse, error)
-	// SetUserGroupPermissions allows to set the permissions for a specific group
-	SetUserGroupPermissions(ctx context.Context, in *MsgSetUserGroupPermissions, opts ...grpc.CallOption) (*MsgSetUserGroupPermissionsResponse, error)
-	// DeleteUserGroup allows to delete an existing user group
-	DeleteUserGroup(ctx context.Context, in *MsgDeleteUserGroup, opts ...grpc.CallOption) (*MsgDeleteUserGroupResponse, error)
-	// AddUserToUserGroup allows to add a specific user to a specific user group
-	AddUserToUserGroup(ctx context.Context, in *MsgAddUserToUserGroup, opts ...grpc.CallOption) (*MsgAddUserToUserGroupResponse, error)
-	// RemoveUserFromUserGroup allows to remove a specific user from a specific
-	// user group
-	RemoveUserFromUserGroup(ctx context.Context, in *MsgRemoveUserFromUserGroup, opts ...grpc.CallOption) (*MsgRemoveUserFromUserGroupResponse, error)
-	// SetUserPermissions allows to set the permissions for a specific user
-	SetUserPermissions(ctx context.Context, in *MsgSetUserPermissions, opts ...grpc.CallOption) (*MsgSetUserPermissionsResponse, error)
-	// GrantTreasuryAuthorization allows managers who have the permission to grant
-	// a treasury authorization to a user
-	GrantTreasuryAuthorization(ctx context.Context, in *MsgGrantTreasuryAuthorization, opts ...grpc.CallOption) (*MsgGrantTreasuryAuthorizationResponse, error)
-	// RevokeTreasuryAuthorization allows managers who have the permission to
-	// revoke an existing treasury authorization
-	RevokeTreasuryAuthorization(ctx context.Context, in *MsgRevokeTreasuryAuthorization, opts ...grpc.CallOption) (*MsgRevokeTreasuryAuthorizationResponse, error)
-	// GrantAllowance allows the granter to grant a fee allowance to the
-	// grantee
-	GrantAllowance(ctx context.Context, in *MsgGrantAllowance, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
-	// RevokeAllowance allows a granter to revoke any existing treasury allowance
-	// that has been granted to the grantee
-	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
-	// UpdateSubspaceFeeTokens allows subspace admins to update the list of fee
-	// tokens inside the subspace via a governance proposal
-	//
-	// Since: Desmos 6.0.0
-	UpdateSubspaceFeeTokens(ctx context.Context, in *MsgUpdateSubspaceFeeTokens, opts ...grpc.CallOption) (*MsgUpdateSubspaceFeeTokensResponse, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
-}
-
-func (c *msgClient) CreateSubspace(ctx context.Context, in *MsgCreateSubspace, opts ...grpc.CallOption) (*MsgCreateSubspaceResponse, error) {
-	out := new(MsgCreateSubspaceResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/CreateSubspace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveUserFromUserGroup) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) EditSubspace(ctx context.Context, in *MsgEditSubspace, opts ...grpc.CallOption) (*MsgEditSubspaceResponse, error) {
-	out := new(MsgEditSubspaceResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/EditSubspace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveUserFromUserGroup) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) DeleteSubspace(ctx context.Context, in *MsgDeleteSubspace, opts ...grpc.CallOption) (*MsgDeleteSubspaceResponse, error) {
-	out := new(MsgDeleteSubspaceResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/DeleteSubspace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveUserFromUserGroup) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateSection(ctx context.Context, in *MsgCreateSection, opts ...grpc.CallOption) (*MsgCreateSectionResponse, error) {
-	out := new(MsgCreateSectionResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/CreateSection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveUserFromUserGroup) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) EditSection(ctx context.Context, in *MsgEditSection, opts ...grpc.CallOption) (*MsgEditSectionResponse, error) {
-	out := new(MsgEditSectionResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/EditSection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRemoveUserFromUserGroupResponse defines the
+// Msg/RemoveUserFromUserGroupResponse response type
+type MsgRemoveUserFromUserGroupResponse struct {
 }
 
-func (c *msgClient) MoveSection(ctx context.Context, in *MsgMoveSection, opts ...grpc.CallOption) (*MsgMoveSectionResponse, error) {
-	out := new(MsgMoveSectionResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/MoveSection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRemoveUserFromUserGroupResponse) Reset()         { *m = MsgRemoveUserFromUserGroupResponse{} }
+func (m *MsgRemoveUserFromUserGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveUserFromUserGroupResponse) ProtoMessage()    {}
+func (*MsgRemoveUserFromUserGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{41}
 }
-
-func (c *msgClient) DeleteSection(ctx context.Context, in *MsgDeleteSection, opts ...grpc.CallOption) (*MsgDeleteSectionResponse, error) {
-	out := new(MsgDeleteSectionResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/DeleteSection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRemoveUserFromUserGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CreateUserGroup(ctx context.Context, in *MsgCreateUserGroup, opts ...grpc.CallOption) (*MsgCreateUserGroupResponse, error) {
-	out := new(MsgCreateUserGroupResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/CreateUserGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveUserFromUserGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveUserFromUserGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) EditUserGroup(ctx context.Context, in *MsgEditUserGroup, opts ...grpc.CallOption) (*MsgEditUserGroupResponse, error) {
-	out := new(MsgEditUserGroupResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/EditUserGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRemoveUserFromUserGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveUserFromUserGroupResponse.Merge(m, src)
+}
+func (m *MsgRemoveUserFromUserGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveUserFromUserGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveUserFromUserGroupResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) MoveUserGroup(ctx context.Context, in *MsgMoveUserGroup, opts ...grpc.CallOption) (*MsgMoveUserGroupResponse, error) {
-	out := new(MsgMoveUserGroupResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/MoveUserGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveUserFromUserGroupResponse proto.InternalMessageInfo
+
+// MsgCreateInvite represents the message used to create an invite that allows
+// users to join a user group
+type MsgCreateInvite struct {
+	// Id of the subspace inside which the group is
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the group that the invite allows to join
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Hex-encoded SHA-256 hash of the public key derived from the secret that
+	// must be known to redeem the invite
+	SecretHash string `protobuf:"bytes,3,opt,name=secret_hash,json=secretHash,proto3" json:"secret_hash,omitempty" yaml:"secret_hash"`
+	// Maximum number of times the invite can be redeemed
+	MaxUses uint32 `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty" yaml:"max_uses"`
+	// (optional) Time after which the invite can no longer be redeemed
+	ExpirationTime *time.Time `protobuf:"bytes,5,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty" yaml:"expiration_time"`
+	// Address of the user creating the invite
+	Creator string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+}
+
+func (m *MsgCreateInvite) Reset()         { *m = MsgCreateInvite{} }
+func (m *MsgCreateInvite) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateInvite) ProtoMessage()    {}
+func (*MsgCreateInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{42}
+}
+func (m *MsgCreateInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateInvite.Merge(m, src)
+}
+func (m *MsgCreateInvite) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateInvite.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetUserGroupPermissions(ctx context.Context, in *MsgSetUserGroupPermissions, opts ...grpc.CallOption) (*MsgSetUserGroupPermissionsResponse, error) {
-	out := new(MsgSetUserGroupPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/SetUserGroupPermissions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateInvite proto.InternalMessageInfo
+
+func (m *MsgCreateInvite) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) DeleteUserGroup(ctx context.Context, in *MsgDeleteUserGroup, opts ...grpc.CallOption) (*MsgDeleteUserGroupResponse, error) {
-	out := new(MsgDeleteUserGroupResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/DeleteUserGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateInvite) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) AddUserToUserGroup(ctx context.Context, in *MsgAddUserToUserGroup, opts ...grpc.CallOption) (*MsgAddUserToUserGroupResponse, error) {
-	out := new(MsgAddUserToUserGroupResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/AddUserToUserGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateInvite) GetSecretHash() string {
+	if m != nil {
+		return m.SecretHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveUserFromUserGroup(ctx context.Context, in *MsgRemoveUserFromUserGroup, opts ...grpc.CallOption) (*MsgRemoveUserFromUserGroupResponse, error) {
-	out := new(MsgRemoveUserFromUserGroupResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RemoveUserFromUserGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateInvite) GetMaxUses() uint32 {
+	if m != nil {
+		return m.MaxUses
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SetUserPermissions(ctx context.Context, in *MsgSetUserPermissions, opts ...grpc.CallOption) (*MsgSetUserPermissionsResponse, error) {
-	out := new(MsgSetUserPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/SetUserPermissions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateInvite) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) GrantTreasuryAuthorization(ctx context.Context, in *MsgGrantTreasuryAuthorization, opts ...grpc.CallOption) (*MsgGrantTreasuryAuthorizationResponse, error) {
-	out := new(MsgGrantTreasuryAuthorizationResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/GrantTreasuryAuthorization", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateInvite) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RevokeTreasuryAuthorization(ctx context.Context, in *MsgRevokeTreasuryAuthorization, opts ...grpc.CallOption) (*MsgRevokeTreasuryAuthorizationResponse, error) {
-	out := new(MsgRevokeTreasuryAuthorizationResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RevokeTreasuryAuthorization", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgCreateInviteResponse defines the Msg/CreateInvite response type
+type MsgCreateInviteResponse struct {
 }
 
-func (c *msgClient) GrantAllowance(ctx context.Context, in *MsgGrantAllowance, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error) {
-	out := new(MsgGrantAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/GrantAllowance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCreateInviteResponse) Reset()         { *m = MsgCreateInviteResponse{} }
+func (m *MsgCreateInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateInviteResponse) ProtoMessage()    {}
+func (*MsgCreateInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{43}
 }
-
-func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error) {
-	out := new(MsgRevokeAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RevokeAllowance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCreateInviteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateSubspaceFeeTokens(ctx context.Context, in *MsgUpdateSubspaceFeeTokens, opts ...grpc.CallOption) (*MsgUpdateSubspaceFeeTokensResponse, error) {
-	out := new(MsgUpdateSubspaceFeeTokensResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/UpdateSubspaceFeeTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateInviteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateSubspace allows to create a subspace
-	CreateSubspace(context.Context, *MsgCreateSubspace) (*MsgCreateSubspaceResponse, error)
-	// EditSubspace allows to edit a subspace
-	EditSubspace(context.Context, *MsgEditSubspace) (*MsgEditSubspaceResponse, error)
-	// DeleteSubspace allows to delete a subspace
-	DeleteSubspace(context.Context, *MsgDeleteSubspace) (*MsgDeleteSubspaceResponse, error)
-	// CreateSection allows to create a new subspace section
-	CreateSection(context.Context, *MsgCreateSection) (*MsgCreateSectionResponse, error)
-	// EditSection allows to edit an existing section
-	EditSection(context.Context, *MsgEditSection) (*MsgEditSectionResponse, error)
-	// MoveSection allows to move an existing section to another parent
-	MoveSection(context.Context, *MsgMoveSection) (*MsgMoveSectionResponse, error)
-	// DeleteSection allows to delete an existing section
-	DeleteSection(context.Context, *MsgDeleteSection) (*MsgDeleteSectionResponse, error)
-	// CreateUserGroup allows to create a user group
-	CreateUserGroup(context.Context, *MsgCreateUserGroup) (*MsgCreateUserGroupResponse, error)
-	// EditUserGroup allows to edit a user group
-	EditUserGroup(context.Context, *MsgEditUserGroup) (*MsgEditUserGroupResponse, error)
-	// MoveUserGroup allows to move a user group from a section to another
-	MoveUserGroup(context.Context, *MsgMoveUserGroup) (*MsgMoveUserGroupResponse, error)
-	// SetUserGroupPermissions allows to set the permissions for a specific group
-	SetUserGroupPermissions(context.Context, *MsgSetUserGroupPermissions) (*MsgSetUserGroupPermissionsResponse, error)
-	// DeleteUserGroup allows to delete an existing user group
-	DeleteUserGroup(context.Context, *MsgDeleteUserGroup) (*MsgDeleteUserGroupResponse, error)
-	// AddUserToUserGroup allows to add a specific user to a specific user group
-	AddUserToUserGroup(context.Context, *MsgAddUserToUserGroup) (*MsgAddUserToUserGroupResponse, error)
-	// RemoveUserFromUserGroup allows to remove a specific user from a specific
-	// user group
-	RemoveUserFromUserGroup(context.Context, *MsgRemoveUserFromUserGroup) (*MsgRemoveUserFromUserGroupResponse, error)
-	// SetUserPermissions allows to set the permissions for a specific user
-	SetUserPermissions(context.Context, *MsgSetUserPermissions) (*MsgSetUserPermissionsResponse, error)
-	// GrantTreasuryAuthorization allows managers who have the permission to grant
-	// a treasury authorization to a user
-	GrantTreasuryAuthorization(context.Context, *MsgGrantTreasuryAuthorization) (*MsgGrantTreasuryAuthorizationResponse, error)
-	// RevokeTreasuryAuthorization allows managers who have the permission to
-	// revoke an existing treasury authorization
-	RevokeTreasuryAuthorization(context.Context, *MsgRevokeTreasuryAuthorization) (*MsgRevokeTreasuryAuthorizationResponse, error)
-	// GrantAllowance allows the granter to grant a fee allowance to the
-	// grantee
-	GrantAllowance(context.Context, *MsgGrantAllowance) (*MsgGrantAllowanceResponse, error)
-	// RevokeAllowance allows a granter to revoke any existing treasury allowance
-	// that has been granted to the grantee
-	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
-	// UpdateSubspaceFeeTokens allows subspace admins to update the list of fee
-	// tokens inside the subspace via a governance proposal
-	//
-	// Since: Desmos 6.0.0
-	UpdateSubspaceFeeTokens(context.Context, *MsgUpdateSubspaceFeeTokens) (*MsgUpdateSubspaceFeeTokensResponse, error)
+func (m *MsgCreateInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateInviteResponse.Merge(m, src)
+}
+func (m *MsgCreateInviteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateInviteResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgCreateInviteResponse proto.InternalMessageInfo
+
+// MsgDeleteInvite represents the message used to delete an existing invite
+type MsgDeleteInvite struct {
+	// Id of the subspace inside which the group is
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Hash of the secret associated with the invite to be deleted
+	SecretHash string `protobuf:"bytes,2,opt,name=secret_hash,json=secretHash,proto3" json:"secret_hash,omitempty" yaml:"secret_hash"`
+	// User signing the message
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (*UnimplementedMsgServer) CreateSubspace(ctx context.Context, req *MsgCreateSubspace) (*MsgCreateSubspaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSubspace not implemented")
+func (m *MsgDeleteInvite) Reset()         { *m = MsgDeleteInvite{} }
+func (m *MsgDeleteInvite) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteInvite) ProtoMessage()    {}
+func (*MsgDeleteInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{44}
 }
-func (*UnimplementedMsgServer) EditSubspace(ctx context.Context, req *MsgEditSubspace) (*MsgEditSubspaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditSubspace not implemented")
+func (m *MsgDeleteInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteSubspace(ctx context.Context, req *MsgDeleteSubspace) (*MsgDeleteSubspaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubspace not implemented")
+func (m *MsgDeleteInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateSection(ctx context.Context, req *MsgCreateSection) (*MsgCreateSectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSection not implemented")
+func (m *MsgDeleteInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteInvite.Merge(m, src)
 }
-func (*UnimplementedMsgServer) EditSection(ctx context.Context, req *MsgEditSection) (*MsgEditSectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditSection not implemented")
+func (m *MsgDeleteInvite) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) MoveSection(ctx context.Context, req *MsgMoveSection) (*MsgMoveSectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MoveSection not implemented")
+func (m *MsgDeleteInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteInvite.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DeleteSection(ctx context.Context, req *MsgDeleteSection) (*MsgDeleteSectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSection not implemented")
+
+var xxx_messageInfo_MsgDeleteInvite proto.InternalMessageInfo
+
+func (m *MsgDeleteInvite) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) CreateUserGroup(ctx context.Context, req *MsgCreateUserGroup) (*MsgCreateUserGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUserGroup not implemented")
+
+func (m *MsgDeleteInvite) GetSecretHash() string {
+	if m != nil {
+		return m.SecretHash
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) EditUserGroup(ctx context.Context, req *MsgEditUserGroup) (*MsgEditUserGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditUserGroup not implemented")
+
+func (m *MsgDeleteInvite) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) MoveUserGroup(ctx context.Context, req *MsgMoveUserGroup) (*MsgMoveUserGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MoveUserGroup not implemented")
+
+// MsgDeleteInviteResponse defines the Msg/DeleteInvite response type
+type MsgDeleteInviteResponse struct {
 }
-func (*UnimplementedMsgServer) SetUserGroupPermissions(ctx context.Context, req *MsgSetUserGroupPermissions) (*MsgSetUserGroupPermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserGroupPermissions not implemented")
+
+func (m *MsgDeleteInviteResponse) Reset()         { *m = MsgDeleteInviteResponse{} }
+func (m *MsgDeleteInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteInviteResponse) ProtoMessage()    {}
+func (*MsgDeleteInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{45}
 }
-func (*UnimplementedMsgServer) DeleteUserGroup(ctx context.Context, req *MsgDeleteUserGroup) (*MsgDeleteUserGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserGroup not implemented")
+func (m *MsgDeleteInviteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddUserToUserGroup(ctx context.Context, req *MsgAddUserToUserGroup) (*MsgAddUserToUserGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddUserToUserGroup not implemented")
+func (m *MsgDeleteInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteInviteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveUserFromUserGroup(ctx context.Context, req *MsgRemoveUserFromUserGroup) (*MsgRemoveUserFromUserGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromUserGroup not implemented")
+func (m *MsgDeleteInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteInviteResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetUserPermissions(ctx context.Context, req *MsgSetUserPermissions) (*MsgSetUserPermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserPermissions not implemented")
+func (m *MsgDeleteInviteResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) GrantTreasuryAuthorization(ctx context.Context, req *MsgGrantTreasuryAuthorization) (*MsgGrantTreasuryAuthorizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GrantTreasuryAuthorization not implemented")
+func (m *MsgDeleteInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteInviteResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RevokeTreasuryAuthorization(ctx context.Context, req *MsgRevokeTreasuryAuthorization) (*MsgRevokeTreasuryAuthorizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeTreasuryAuthorization not implemented")
+
+var xxx_messageInfo_MsgDeleteInviteResponse proto.InternalMessageInfo
+
+// MsgRedeemInvite represents the message used to join a user group by
+// redeeming an invite
+type MsgRedeemInvite struct {
+	// Id of the subspace inside which the group is
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Public key derived from the invite secret. The hash of this key is
+	// associated with the invite to be redeemed
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" yaml:"public_key"`
+	// Signature of the redemption sign bytes made using the private key derived
+	// from the invite secret. This binds the redemption to the redeemer without
+	// revealing the secret
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty" yaml:"signature"`
+	// Address of the user redeeming the invite
+	Redeemer string `protobuf:"bytes,4,opt,name=redeemer,proto3" json:"redeemer,omitempty" yaml:"redeemer"`
+}
+
+func (m *MsgRedeemInvite) Reset()         { *m = MsgRedeemInvite{} }
+func (m *MsgRedeemInvite) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemInvite) ProtoMessage()    {}
+func (*MsgRedeemInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{46}
+}
+func (m *MsgRedeemInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrantAllowance) (*MsgGrantAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowance not implemented")
+func (m *MsgRedeemInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
+func (m *MsgRedeemInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemInvite.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateSubspaceFeeTokens(ctx context.Context, req *MsgUpdateSubspaceFeeTokens) (*MsgUpdateSubspaceFeeTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubspaceFeeTokens not implemented")
+func (m *MsgRedeemInvite) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRedeemInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemInvite.DiscardUnknown(m)
 }
 
-func _Msg_CreateSubspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateSubspace)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateSubspace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/CreateSubspace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateSubspace(ctx, req.(*MsgCreateSubspace))
+var xxx_messageInfo_MsgRedeemInvite proto.InternalMessageInfo
+
+func (m *MsgRedeemInvite) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_EditSubspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditSubspace)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditSubspace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/EditSubspace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditSubspace(ctx, req.(*MsgEditSubspace))
+func (m *MsgRedeemInvite) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DeleteSubspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteSubspace)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteSubspace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/DeleteSubspace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteSubspace(ctx, req.(*MsgDeleteSubspace))
+func (m *MsgRedeemInvite) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_CreateSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateSection)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateSection(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/CreateSection",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateSection(ctx, req.(*MsgCreateSection))
+func (m *MsgRedeemInvite) GetRedeemer() string {
+	if m != nil {
+		return m.Redeemer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_EditSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditSection)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditSection(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/EditSection",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditSection(ctx, req.(*MsgEditSection))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgRedeemInviteResponse defines the Msg/RedeemInvite response type
+type MsgRedeemInviteResponse struct {
 }
 
-func _Msg_MoveSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMoveSection)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MoveSection(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/MoveSection",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MoveSection(ctx, req.(*MsgMoveSection))
+func (m *MsgRedeemInviteResponse) Reset()         { *m = MsgRedeemInviteResponse{} }
+func (m *MsgRedeemInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemInviteResponse) ProtoMessage()    {}
+func (*MsgRedeemInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{47}
+}
+func (m *MsgRedeemInviteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemInviteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRedeemInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemInviteResponse.Merge(m, src)
+}
+func (m *MsgRedeemInviteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemInviteResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteSection)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteSection(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/DeleteSection",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteSection(ctx, req.(*MsgDeleteSection))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRedeemInviteResponse proto.InternalMessageInfo
+
+// MsgApplyToGroup represents the message used to apply to join a user group
+type MsgApplyToGroup struct {
+	// Id of the subspace inside which the group is
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the group the user wants to join
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// (optional) Message attached to the application
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty" yaml:"message"`
+	// Address of the user applying to join the group
+	Applicant string `protobuf:"bytes,4,opt,name=applicant,proto3" json:"applicant,omitempty" yaml:"applicant"`
 }
 
-func _Msg_CreateUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateUserGroup)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateUserGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/CreateUserGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateUserGroup(ctx, req.(*MsgCreateUserGroup))
+func (m *MsgApplyToGroup) Reset()         { *m = MsgApplyToGroup{} }
+func (m *MsgApplyToGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgApplyToGroup) ProtoMessage()    {}
+func (*MsgApplyToGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{48}
+}
+func (m *MsgApplyToGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApplyToGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApplyToGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgApplyToGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApplyToGroup.Merge(m, src)
+}
+func (m *MsgApplyToGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApplyToGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApplyToGroup.DiscardUnknown(m)
 }
 
-func _Msg_EditUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditUserGroup)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditUserGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/EditUserGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditUserGroup(ctx, req.(*MsgEditUserGroup))
+var xxx_messageInfo_MsgApplyToGroup proto.InternalMessageInfo
+
+func (m *MsgApplyToGroup) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_MoveUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMoveUserGroup)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MoveUserGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/MoveUserGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MoveUserGroup(ctx, req.(*MsgMoveUserGroup))
+func (m *MsgApplyToGroup) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SetUserGroupPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetUserGroupPermissions)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetUserGroupPermissions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/SetUserGroupPermissions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetUserGroupPermissions(ctx, req.(*MsgSetUserGroupPermissions))
+func (m *MsgApplyToGroup) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteUserGroup)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteUserGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/DeleteUserGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteUserGroup(ctx, req.(*MsgDeleteUserGroup))
+func (m *MsgApplyToGroup) GetApplicant() string {
+	if m != nil {
+		return m.Applicant
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddUserToUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddUserToUserGroup)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddUserToUserGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/AddUserToUserGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddUserToUserGroup(ctx, req.(*MsgAddUserToUserGroup))
+// MsgApplyToGroupResponse defines the Msg/ApplyToGroup response type
+type MsgApplyToGroupResponse struct {
+}
+
+func (m *MsgApplyToGroupResponse) Reset()         { *m = MsgApplyToGroupResponse{} }
+func (m *MsgApplyToGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApplyToGroupResponse) ProtoMessage()    {}
+func (*MsgApplyToGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{49}
+}
+func (m *MsgApplyToGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApplyToGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApplyToGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgApplyToGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApplyToGroupResponse.Merge(m, src)
+}
+func (m *MsgApplyToGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApplyToGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApplyToGroupResponse.DiscardUnknown(m)
 }
 
-func _Msg_RemoveUserFromUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveUserFromUserGroup)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApplyToGroupResponse proto.InternalMessageInfo
+
+// MsgApproveGroupApplication represents the message used to approve a user
+// group application
+type MsgApproveGroupApplication struct {
+	// Id of the subspace inside which the group is
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the group the application refers to
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Address of the user that applied to join the group
+	Applicant string `protobuf:"bytes,3,opt,name=applicant,proto3" json:"applicant,omitempty" yaml:"applicant"`
+	// User signing the message
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+}
+
+func (m *MsgApproveGroupApplication) Reset()         { *m = MsgApproveGroupApplication{} }
+func (m *MsgApproveGroupApplication) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveGroupApplication) ProtoMessage()    {}
+func (*MsgApproveGroupApplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{50}
+}
+func (m *MsgApproveGroupApplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveGroupApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveGroupApplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveUserFromUserGroup(ctx, in)
+}
+func (m *MsgApproveGroupApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveGroupApplication.Merge(m, src)
+}
+func (m *MsgApproveGroupApplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveGroupApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveGroupApplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveGroupApplication proto.InternalMessageInfo
+
+func (m *MsgApproveGroupApplication) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/RemoveUserFromUserGroup",
+	return 0
+}
+
+func (m *MsgApproveGroupApplication) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveUserFromUserGroup(ctx, req.(*MsgRemoveUserFromUserGroup))
+	return 0
+}
+
+func (m *MsgApproveGroupApplication) GetApplicant() string {
+	if m != nil {
+		return m.Applicant
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetUserPermissions)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgApproveGroupApplication) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetUserPermissions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/SetUserPermissions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetUserPermissions(ctx, req.(*MsgSetUserPermissions))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_GrantTreasuryAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGrantTreasuryAuthorization)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GrantTreasuryAuthorization(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/GrantTreasuryAuthorization",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GrantTreasuryAuthorization(ctx, req.(*MsgGrantTreasuryAuthorization))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgApproveGroupApplicationResponse defines the Msg/ApproveGroupApplication
+// response type
+type MsgApproveGroupApplicationResponse struct {
 }
 
-func _Msg_RevokeTreasuryAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeTreasuryAuthorization)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeTreasuryAuthorization(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/RevokeTreasuryAuthorization",
+func (m *MsgApproveGroupApplicationResponse) Reset()         { *m = MsgApproveGroupApplicationResponse{} }
+func (m *MsgApproveGroupApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveGroupApplicationResponse) ProtoMessage()    {}
+func (*MsgApproveGroupApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{51}
+}
+func (m *MsgApproveGroupApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveGroupApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveGroupApplicationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeTreasuryAuthorization(ctx, req.(*MsgRevokeTreasuryAuthorization))
+}
+func (m *MsgApproveGroupApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveGroupApplicationResponse.Merge(m, src)
+}
+func (m *MsgApproveGroupApplicationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveGroupApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveGroupApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveGroupApplicationResponse proto.InternalMessageInfo
+
+// MsgRejectGroupApplication represents the message used to reject a user group
+// application
+type MsgRejectGroupApplication struct {
+	// Id of the subspace inside which the group is
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the group the application refers to
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Address of the user that applied to join the group
+	Applicant string `protobuf:"bytes,3,opt,name=applicant,proto3" json:"applicant,omitempty" yaml:"applicant"`
+	// User signing the message
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+}
+
+func (m *MsgRejectGroupApplication) Reset()         { *m = MsgRejectGroupApplication{} }
+func (m *MsgRejectGroupApplication) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectGroupApplication) ProtoMessage()    {}
+func (*MsgRejectGroupApplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{52}
+}
+func (m *MsgRejectGroupApplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectGroupApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectGroupApplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRejectGroupApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectGroupApplication.Merge(m, src)
+}
+func (m *MsgRejectGroupApplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectGroupApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectGroupApplication.DiscardUnknown(m)
 }
 
-func _Msg_GrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGrantAllowance)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRejectGroupApplication proto.InternalMessageInfo
+
+func (m *MsgRejectGroupApplication) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).GrantAllowance(ctx, in)
+	return 0
+}
+
+func (m *MsgRejectGroupApplication) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/GrantAllowance",
+	return 0
+}
+
+func (m *MsgRejectGroupApplication) GetApplicant() string {
+	if m != nil {
+		return m.Applicant
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GrantAllowance(ctx, req.(*MsgGrantAllowance))
+	return ""
+}
+
+func (m *MsgRejectGroupApplication) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeAllowance)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgRejectGroupApplicationResponse defines the Msg/RejectGroupApplication
+// response type
+type MsgRejectGroupApplicationResponse struct {
+}
+
+func (m *MsgRejectGroupApplicationResponse) Reset()         { *m = MsgRejectGroupApplicationResponse{} }
+func (m *MsgRejectGroupApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectGroupApplicationResponse) ProtoMessage()    {}
+func (*MsgRejectGroupApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{53}
+}
+func (m *MsgRejectGroupApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectGroupApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectGroupApplicationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeAllowance(ctx, in)
+}
+func (m *MsgRejectGroupApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectGroupApplicationResponse.Merge(m, src)
+}
+func (m *MsgRejectGroupApplicationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectGroupApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectGroupApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectGroupApplicationResponse proto.InternalMessageInfo
+
+// MsgSetUserPermissions represents the message used to set the permissions of a
+// specific user
+type MsgSetUserPermissions struct {
+	// Id of the subspace inside which to set the permissions
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the section for which to set the permissions
+	SectionID uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
+	// User for which to set the permissions
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	// Permissions to be set to the user
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty" yaml:"permissions"`
+	// User signing the message
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// (optional) Time after which the permissions will be removed
+	ExpirationTime *time.Time `protobuf:"bytes,6,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty" yaml:"expiration_time"`
+}
+
+func (m *MsgSetUserPermissions) Reset()         { *m = MsgSetUserPermissions{} }
+func (m *MsgSetUserPermissions) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUserPermissions) ProtoMessage()    {}
+func (*MsgSetUserPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{54}
+}
+func (m *MsgSetUserPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUserPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUserPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/RevokeAllowance",
+}
+func (m *MsgSetUserPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUserPermissions.Merge(m, src)
+}
+func (m *MsgSetUserPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUserPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUserPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUserPermissions proto.InternalMessageInfo
+
+func (m *MsgSetUserPermissions) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeAllowance(ctx, req.(*MsgRevokeAllowance))
+	return 0
+}
+
+func (m *MsgSetUserPermissions) GetSectionID() uint32 {
+	if m != nil {
+		return m.SectionID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateSubspaceFeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSubspaceFeeTokens)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetUserPermissions) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSubspaceFeeTokens(ctx, in)
+	return ""
+}
+
+func (m *MsgSetUserPermissions) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Msg/UpdateSubspaceFeeTokens",
+	return nil
+}
+
+func (m *MsgSetUserPermissions) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSubspaceFeeTokens(ctx, req.(*MsgUpdateSubspaceFeeTokens))
+	return ""
+}
+
+func (m *MsgSetUserPermissions) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "desmos.subspaces.v3.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateSubspace",
-			Handler:    _Msg_CreateSubspace_Handler,
-		},
-		{
-			MethodName: "EditSubspace",
-			Handler:    _Msg_EditSubspace_Handler,
-		},
-		{
-			MethodName: "DeleteSubspace",
-			Handler:    _Msg_DeleteSubspace_Handler,
-		},
-		{
-			MethodName: "CreateSection",
-			Handler:    _Msg_CreateSection_Handler,
-		},
-		{
-			MethodName: "EditSection",
-			Handler:    _Msg_EditSection_Handler,
-		},
-		{
-			MethodName: "MoveSection",
-			Handler:    _Msg_MoveSection_Handler,
-		},
-		{
-			MethodName: "DeleteSection",
-			Handler:    _Msg_DeleteSection_Handler,
-		},
-		{
-			MethodName: "CreateUserGroup",
-			Handler:    _Msg_CreateUserGroup_Handler,
-		},
-		{
-			MethodName: "EditUserGroup",
-			Handler:    _Msg_EditUserGroup_Handler,
-		},
-		{
-			MethodName: "MoveUserGroup",
-			Handler:    _Msg_MoveUserGroup_Handler,
-		},
-		{
-			MethodName: "SetUserGroupPermissions",
-			Handler:    _Msg_SetUserGroupPermissions_Handler,
-		},
-		{
-			MethodName: "DeleteUserGroup",
-			Handler:    _Msg_DeleteUserGroup_Handler,
-		},
-		{
-			MethodName: "AddUserToUserGroup",
-			Handler:    _Msg_AddUserToUserGroup_Handler,
-		},
-		{
-			MethodName: "RemoveUserFromUserGroup",
-			Handler:    _Msg_RemoveUserFromUserGroup_Handler,
-		},
-		{
-			MethodName: "SetUserPermissions",
-			Handler:    _Msg_SetUserPermissions_Handler,
-		},
-		{
-			MethodName: "GrantTreasuryAuthorization",
-			Handler:    _Msg_GrantTreasuryAuthorization_Handler,
-		},
-		{
-			MethodName: "RevokeTreasuryAuthorization",
-			Handler:    _Msg_RevokeTreasuryAuthorization_Handler,
-		},
-		{
-			MethodName: "GrantAllowance",
-			Handler:    _Msg_GrantAllowance_Handler,
-		},
-		{
-			MethodName: "RevokeAllowance",
-			Handler:    _Msg_RevokeAllowance_Handler,
-		},
-		{
-			MethodName: "UpdateSubspaceFeeTokens",
-			Handler:    _Msg_UpdateSubspaceFeeTokens_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "desmos/subspaces/v3/msgs.proto",
+// MsgSetUserPermissionsResponse defines the Msg/SetPermissionsResponse
+// response type
+type MsgSetUserPermissionsResponse struct {
 }
 
-func (m *MsgCreateSubspace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetUserPermissionsResponse) Reset()         { *m = MsgSetUserPermissionsResponse{} }
+func (m *MsgSetUserPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUserPermissionsResponse) ProtoMessage()    {}
+func (*MsgSetUserPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{55}
+}
+func (m *MsgSetUserPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUserPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUserPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateSubspace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetUserPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUserPermissionsResponse.Merge(m, src)
+}
+func (m *MsgSetUserPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUserPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUserPermissionsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateSubspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetUserPermissionsResponse proto.InternalMessageInfo
+
+// MsgBanUser represents the message used to ban a user from a subspace
+type MsgBanUser struct {
+	// Id of the subspace from which to ban the user
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Address of the user to be banned
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	// (optional) Reason why the user is banned
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	// (optional) Time after which the ban will be lifted
+	ExpirationTime *time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty" yaml:"expiration_time"`
+	// User signing the message
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgCreateSubspaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBanUser) Reset()         { *m = MsgBanUser{} }
+func (m *MsgBanUser) String() string { return proto.CompactTextString(m) }
+func (*MsgBanUser) ProtoMessage()    {}
+func (*MsgBanUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{56}
+}
+func (m *MsgBanUser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBanUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBanUser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateSubspaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBanUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBanUser.Merge(m, src)
+}
+func (m *MsgBanUser) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBanUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBanUser.DiscardUnknown(m)
 }
 
-func (m *MsgCreateSubspaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgBanUser proto.InternalMessageInfo
+
+func (m *MsgBanUser) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgEditSubspace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBanUser) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgEditSubspace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBanUser) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgEditSubspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgBanUser) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgEditSubspaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBanUser) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgEditSubspaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgBanUserResponse defines the Msg/BanUser response type
+type MsgBanUserResponse struct {
 }
 
-func (m *MsgEditSubspaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBanUserResponse) Reset()         { *m = MsgBanUserResponse{} }
+func (m *MsgBanUserResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBanUserResponse) ProtoMessage()    {}
+func (*MsgBanUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{57}
 }
-
-func (m *MsgDeleteSubspace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBanUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBanUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBanUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgBanUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBanUserResponse.Merge(m, src)
+}
+func (m *MsgBanUserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBanUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBanUserResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteSubspace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBanUserResponse proto.InternalMessageInfo
+
+// MsgUnbanUser represents the message used to lift the ban of a user from a
+// subspace
+type MsgUnbanUser struct {
+	// Id of the subspace from which the user has been banned
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Address of the banned user
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	// User signing the message
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgDeleteSubspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgUnbanUser) Reset()         { *m = MsgUnbanUser{} }
+func (m *MsgUnbanUser) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbanUser) ProtoMessage()    {}
+func (*MsgUnbanUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{58}
+}
+func (m *MsgUnbanUser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbanUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbanUser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgUnbanUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbanUser.Merge(m, src)
+}
+func (m *MsgUnbanUser) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbanUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbanUser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbanUser proto.InternalMessageInfo
+
+func (m *MsgUnbanUser) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgDeleteSubspaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnbanUser) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteSubspaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnbanUser) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgDeleteSubspaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUnbanUserResponse defines the Msg/UnbanUser response type
+type MsgUnbanUserResponse struct {
 }
 
-func (m *MsgCreateSection) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnbanUserResponse) Reset()         { *m = MsgUnbanUserResponse{} }
+func (m *MsgUnbanUserResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbanUserResponse) ProtoMessage()    {}
+func (*MsgUnbanUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{59}
+}
+func (m *MsgUnbanUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbanUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbanUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnbanUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbanUserResponse.Merge(m, src)
+}
+func (m *MsgUnbanUserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbanUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbanUserResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateSection) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnbanUserResponse proto.InternalMessageInfo
+
+// MsgSetRateLimit represents the message used to set the rate limit of a
+// message type inside a subspace
+type MsgSetRateLimit struct {
+	// Id of the subspace where to set the rate limit
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Type URL of the message to be limited
+	MsgTypeURL string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// Maximum number of messages that each user can send within the window
+	MaxMessages uint32 `protobuf:"varint,3,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty" yaml:"max_messages"`
+	// Duration of the window used to count the messages sent
+	Window time.Duration `protobuf:"bytes,4,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+	// User signing the message
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgCreateSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x2a
+func (m *MsgSetRateLimit) Reset()         { *m = MsgSetRateLimit{} }
+func (m *MsgSetRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimit) ProtoMessage()    {}
+func (*MsgSetRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{60}
+}
+func (m *MsgSetRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ParentID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.ParentID))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *MsgSetRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimit.Merge(m, src)
+}
+func (m *MsgSetRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetRateLimit) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *MsgSetRateLimit) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgSetRateLimit) GetMaxMessages() uint32 {
+	if m != nil {
+		return m.MaxMessages
 	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *MsgSetRateLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreateSectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetRateLimit) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateSectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetRateLimitResponse defines the Msg/SetRateLimit response type
+type MsgSetRateLimitResponse struct {
 }
 
-func (m *MsgCreateSectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SectionID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSetRateLimitResponse) Reset()         { *m = MsgSetRateLimitResponse{} }
+func (m *MsgSetRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{61}
+}
+func (m *MsgSetRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgEditSection) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgSetRateLimitResponse proto.InternalMessageInfo
+
+// MsgRemoveRateLimit represents the message used to remove the rate limit of a
+// message type from a subspace
+type MsgRemoveRateLimit struct {
+	// Id of the subspace from which to remove the rate limit
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Type URL of the message whose rate limit should be removed
+	MsgTypeURL string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// User signing the message
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgEditSection) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveRateLimit) Reset()         { *m = MsgRemoveRateLimit{} }
+func (m *MsgRemoveRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimit) ProtoMessage()    {}
+func (*MsgRemoveRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{62}
+}
+func (m *MsgRemoveRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimit.Merge(m, src)
+}
+func (m *MsgRemoveRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimit.DiscardUnknown(m)
 }
 
-func (m *MsgEditSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Editor) > 0 {
-		i -= len(m.Editor)
-		copy(dAtA[i:], m.Editor)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Editor)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SectionID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgRemoveRateLimit proto.InternalMessageInfo
+
+func (m *MsgRemoveRateLimit) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgEditSectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveRateLimit) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgEditSectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveRateLimit) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgEditSectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRemoveRateLimitResponse defines the Msg/RemoveRateLimit response type
+type MsgRemoveRateLimitResponse struct {
 }
 
-func (m *MsgMoveSection) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveRateLimitResponse) Reset()         { *m = MsgRemoveRateLimitResponse{} }
+func (m *MsgRemoveRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{63}
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMoveSection) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveRateLimitResponse proto.InternalMessageInfo
+
+// MsgSetFeeTokensConfig represents the message used to set the fee tokens
+// configuration of a subspace
+type MsgSetFeeTokensConfig struct {
+	// Id of the subspace for which to set the configuration
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Denom of the native fee token against which the conversion rates are
+	// expressed
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	// Conversion rates of the additional fee tokens
+	Rates []FeeTokenRate `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates" yaml:"rates"`
+	// Where the additional fee tokens paid inside the subspace should be sent
+	Destination FeeTokensDestination `protobuf:"varint,4,opt,name=destination,proto3,enum=desmos.subspaces.v3.FeeTokensDestination" json:"destination,omitempty" yaml:"destination"`
+	// User signing the message
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgMoveSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgSetFeeTokensConfig) Reset()         { *m = MsgSetFeeTokensConfig{} }
+func (m *MsgSetFeeTokensConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokensConfig) ProtoMessage()    {}
+func (*MsgSetFeeTokensConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{64}
+}
+func (m *MsgSetFeeTokensConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokensConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokensConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.NewParentID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.NewParentID))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *MsgSetFeeTokensConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokensConfig.Merge(m, src)
+}
+func (m *MsgSetFeeTokensConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokensConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokensConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTokensConfig proto.InternalMessageInfo
+
+func (m *MsgSetFeeTokensConfig) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if m.SectionID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *MsgSetFeeTokensConfig) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *MsgSetFeeTokensConfig) GetRates() []FeeTokenRate {
+	if m != nil {
+		return m.Rates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgMoveSectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeeTokensConfig) GetDestination() FeeTokensDestination {
+	if m != nil {
+		return m.Destination
 	}
-	return dAtA[:n], nil
+	return FEE_TOKENS_DESTINATION_FEE_COLLECTOR
 }
 
-func (m *MsgMoveSectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFeeTokensConfig) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgMoveSectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetFeeTokensConfigResponse defines the Msg/SetFeeTokensConfig response
+// type
+type MsgSetFeeTokensConfigResponse struct {
 }
 
-func (m *MsgDeleteSection) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeeTokensConfigResponse) Reset()         { *m = MsgSetFeeTokensConfigResponse{} }
+func (m *MsgSetFeeTokensConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokensConfigResponse) ProtoMessage()    {}
+func (*MsgSetFeeTokensConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{65}
+}
+func (m *MsgSetFeeTokensConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokensConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokensConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetFeeTokensConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokensConfigResponse.Merge(m, src)
+}
+func (m *MsgSetFeeTokensConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokensConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokensConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteSection) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetFeeTokensConfigResponse proto.InternalMessageInfo
+
+// MsgRemoveFeeTokensConfig represents the message used to remove the fee
+// tokens configuration of a subspace
+type MsgRemoveFeeTokensConfig struct {
+	// Id of the subspace from which to remove the configuration
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// User signing the message
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 }
 
-func (m *MsgDeleteSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SectionID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgRemoveFeeTokensConfig) Reset()         { *m = MsgRemoveFeeTokensConfig{} }
+func (m *MsgRemoveFeeTokensConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeTokensConfig) ProtoMessage()    {}
+func (*MsgRemoveFeeTokensConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{66}
+}
+func (m *MsgRemoveFeeTokensConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeTokensConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeTokensConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveFeeTokensConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeTokensConfig.Merge(m, src)
+}
+func (m *MsgRemoveFeeTokensConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeTokensConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeTokensConfig.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteSectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveFeeTokensConfig proto.InternalMessageInfo
+
+func (m *MsgRemoveFeeTokensConfig) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeleteSectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveFeeTokensConfig) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgDeleteSectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRemoveFeeTokensConfigResponse defines the Msg/RemoveFeeTokensConfig
+// response type
+type MsgRemoveFeeTokensConfigResponse struct {
 }
 
-func (m *MsgCreateUserGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveFeeTokensConfigResponse) Reset()         { *m = MsgRemoveFeeTokensConfigResponse{} }
+func (m *MsgRemoveFeeTokensConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeTokensConfigResponse) ProtoMessage()    {}
+func (*MsgRemoveFeeTokensConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{67}
 }
-
-func (m *MsgCreateUserGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveFeeTokensConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCreateUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.InitialMembers) > 0 {
-		for iNdEx := len(m.InitialMembers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.InitialMembers[iNdEx])
-			copy(dAtA[i:], m.InitialMembers[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.InitialMembers[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.DefaultPermissions) > 0 {
-		for iNdEx := len(m.DefaultPermissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DefaultPermissions[iNdEx])
-			copy(dAtA[i:], m.DefaultPermissions[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.DefaultPermissions[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgRemoveFeeTokensConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeTokensConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SectionID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateUserGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveFeeTokensConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeTokensConfigResponse.Merge(m, src)
+}
+func (m *MsgRemoveFeeTokensConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeTokensConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeTokensConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveFeeTokensConfigResponse proto.InternalMessageInfo
+
+// MsgGrantAllowance adds grants for the grantee to spend up allowance of fees
+// from the treasury inside the given subspace
+type MsgGrantAllowance struct {
+	// Id of the subspace inside which where the allowance should be granted
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Address of the user granting the allowance
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty" yaml:"granter"`
+	// Target being granted the allowance
+	Grantee *types.Any `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
+	// Allowance can be any allowance type that implements AllowanceI
+	Allowance *types.Any `protobuf:"bytes,4,opt,name=allowance,proto3" json:"allowance,omitempty" yaml:"allowance"`
 }
 
-func (m *MsgCreateUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GroupID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgGrantAllowance) Reset()         { *m = MsgGrantAllowance{} }
+func (m *MsgGrantAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantAllowance) ProtoMessage()    {}
+func (*MsgGrantAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{68}
+}
+func (m *MsgGrantAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgGrantAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantAllowance.Merge(m, src)
+}
+func (m *MsgGrantAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantAllowance.DiscardUnknown(m)
 }
 
-func (m *MsgEditUserGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGrantAllowance proto.InternalMessageInfo
+
+func (m *MsgGrantAllowance) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgEditUserGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgGrantAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
 }
 
-func (m *MsgEditUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GroupID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgGrantAllowance) GetGrantee() *types.Any {
+	if m != nil {
+		return m.Grantee
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgEditUserGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantAllowance) GetAllowance() *types.Any {
+	if m != nil {
+		return m.Allowance
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgEditUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgGrantAllowanceResponse defines the Msg/GrantAllowanceResponse response
+// type.
+type MsgGrantAllowanceResponse struct {
 }
 
-func (m *MsgEditUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgGrantAllowanceResponse) Reset()         { *m = MsgGrantAllowanceResponse{} }
+func (m *MsgGrantAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantAllowanceResponse) ProtoMessage()    {}
+func (*MsgGrantAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{69}
 }
-
-func (m *MsgMoveUserGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgMoveUserGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgGrantAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantAllowanceResponse.Merge(m, src)
 }
-
-func (m *MsgMoveUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NewSectionID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.NewSectionID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.GroupID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgGrantAllowanceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgMoveUserGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgGrantAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantAllowanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMoveUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgGrantAllowanceResponse proto.InternalMessageInfo
 
-func (m *MsgMoveUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRevokeAllowance removes any existing allowance to the grantee inside the
+// subspace
+type MsgRevokeAllowance struct {
+	// If of the subspace inside which the allowance to be deleted is
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Address of the user that created the allowance
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty" yaml:"granter"`
+	// Target being revoked the allowance
+	Grantee *types.Any `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
 }
 
-func (m *MsgSetUserGroupPermissions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeAllowance) Reset()         { *m = MsgRevokeAllowance{} }
+func (m *MsgRevokeAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllowance) ProtoMessage()    {}
+func (*MsgRevokeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{70}
 }
-
-func (m *MsgSetUserGroupPermissions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSetUserGroupPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permissions[iNdEx])
-			copy(dAtA[i:], m.Permissions[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Permissions[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgRevokeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.GroupID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetUserGroupPermissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllowance.Merge(m, src)
 }
-
-func (m *MsgSetUserGroupPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeAllowance) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSetUserGroupPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllowance.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteUserGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgRevokeAllowance proto.InternalMessageInfo
 
-func (m *MsgDeleteUserGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeAllowance) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
 }
 
-func (m *MsgDeleteUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GroupID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgRevokeAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeleteUserGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeAllowance) GetGrantee() *types.Any {
+	if m != nil {
+		return m.Grantee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeleteUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevokeAllowanceResponse defines the Msg/RevokeAllowanceResponse
+// response type.
+type MsgRevokeAllowanceResponse struct {
 }
 
-func (m *MsgDeleteUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeAllowanceResponse) Reset()         { *m = MsgRevokeAllowanceResponse{} }
+func (m *MsgRevokeAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllowanceResponse) ProtoMessage()    {}
+func (*MsgRevokeAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{71}
 }
-
-func (m *MsgAddUserToUserGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAddUserToUserGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllowanceResponse.Merge(m, src)
+}
+func (m *MsgRevokeAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllowanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddUserToUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_MsgRevokeAllowanceResponse proto.InternalMessageInfo
+
+// MsgGrantTreasuryAuthorization grants an authorization on behalf of the
+// treasury to a user
+type MsgGrantTreasuryAuthorization struct {
+	// Id of the subspace where the authorization should be granted
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Address of the user granting a treasury authorization
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty" yaml:"granter"`
+	// Address of the user who is being granted a treasury authorization
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
+	// Grant represents the authorization to execute the provided methods
+	Grant authz.Grant `protobuf:"bytes,4,opt,name=grant,proto3" json:"grant" yaml:"grant"`
+}
+
+func (m *MsgGrantTreasuryAuthorization) Reset()         { *m = MsgGrantTreasuryAuthorization{} }
+func (m *MsgGrantTreasuryAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantTreasuryAuthorization) ProtoMessage()    {}
+func (*MsgGrantTreasuryAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{72}
+}
+func (m *MsgGrantTreasuryAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantTreasuryAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantTreasuryAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GroupID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *MsgGrantTreasuryAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantTreasuryAuthorization.Merge(m, src)
+}
+func (m *MsgGrantTreasuryAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantTreasuryAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantTreasuryAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantTreasuryAuthorization proto.InternalMessageInfo
+
+func (m *MsgGrantTreasuryAuthorization) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *MsgGrantTreasuryAuthorization) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddUserToUserGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantTreasuryAuthorization) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddUserToUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgGrantTreasuryAuthorization) GetGrant() authz.Grant {
+	if m != nil {
+		return m.Grant
+	}
+	return authz.Grant{}
 }
 
-func (m *MsgAddUserToUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgGrantTreasuryAuthorizationResponse defines the
+// Msg/MsgGrantTreasuryAuthorization response type
+type MsgGrantTreasuryAuthorizationResponse struct {
 }
 
-func (m *MsgRemoveUserFromUserGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantTreasuryAuthorizationResponse) Reset()         { *m = MsgGrantTreasuryAuthorizationResponse{} }
+func (m *MsgGrantTreasuryAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantTreasuryAuthorizationResponse) ProtoMessage()    {}
+func (*MsgGrantTreasuryAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{73}
+}
+func (m *MsgGrantTreasuryAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantTreasuryAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantTreasuryAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgGrantTreasuryAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantTreasuryAuthorizationResponse.Merge(m, src)
+}
+func (m *MsgGrantTreasuryAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantTreasuryAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantTreasuryAuthorizationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveUserFromUserGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgGrantTreasuryAuthorizationResponse proto.InternalMessageInfo
+
+// MsgRevokeTreasuryAuthorization revokes an existing treasury authorization
+// from a user
+type MsgRevokeTreasuryAuthorization struct {
+	// Id of the subspace from which the authorization should be revoked
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Address of the user revoking the treasury authorization
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty" yaml:"granter"`
+	// Address of the user who is being revoked the treasury authorization
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
+	// Type url of the authorized message which is being revoked
+	MsgTypeUrl string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
 }
 
-func (m *MsgRemoveUserFromUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GroupID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgRevokeTreasuryAuthorization) Reset()         { *m = MsgRevokeTreasuryAuthorization{} }
+func (m *MsgRevokeTreasuryAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeTreasuryAuthorization) ProtoMessage()    {}
+func (*MsgRevokeTreasuryAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{74}
+}
+func (m *MsgRevokeTreasuryAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeTreasuryAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeTreasuryAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRevokeTreasuryAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeTreasuryAuthorization.Merge(m, src)
+}
+func (m *MsgRevokeTreasuryAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeTreasuryAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeTreasuryAuthorization.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveUserFromUserGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokeTreasuryAuthorization proto.InternalMessageInfo
+
+func (m *MsgRevokeTreasuryAuthorization) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRemoveUserFromUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeTreasuryAuthorization) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
 }
 
-func (m *MsgRemoveUserFromUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeTreasuryAuthorization) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
 }
 
-func (m *MsgSetUserPermissions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeTreasuryAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetUserPermissions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevokeTreasuryAuthorizationResponse defines the
+// Msg/MsgRevokeTreasuryAuthorization response type
+type MsgRevokeTreasuryAuthorizationResponse struct {
 }
 
-func (m *MsgSetUserPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permissions[iNdEx])
-			copy(dAtA[i:], m.Permissions[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Permissions[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *MsgRevokeTreasuryAuthorizationResponse) Reset() {
+	*m = MsgRevokeTreasuryAuthorizationResponse{}
+}
+func (m *MsgRevokeTreasuryAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeTreasuryAuthorizationResponse) ProtoMessage()    {}
+func (*MsgRevokeTreasuryAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{75}
+}
+func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeTreasuryAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SectionID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeTreasuryAuthorizationResponse.Merge(m, src)
+}
+func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeTreasuryAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeTreasuryAuthorizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeTreasuryAuthorizationResponse proto.InternalMessageInfo
+
+// MsgSetTreasuryVotingConfig represents the message used to set the
+// configuration used to vote the treasury proposals of a subspace
+type MsgSetTreasuryVotingConfig struct {
+	// Id of the subspace for which to set the configuration
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the user group whose members can submit and vote treasury proposals
+	GroupID uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Minimum fraction of the group members that must vote yes for a proposal
+	// to be executed
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold" yaml:"threshold"`
+	// Duration of the voting period of each proposal
+	VotingPeriod time.Duration `protobuf:"bytes,4,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period" yaml:"voting_period"`
+	// User signing the message
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// (optional) Minimum fraction of the group members that must cast a vote,
+	// either yes or no, for a proposal to be executed. If not set, no quorum
+	// is required
+	Quorum *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum,omitempty" yaml:"quorum"`
+}
+
+func (m *MsgSetTreasuryVotingConfig) Reset()         { *m = MsgSetTreasuryVotingConfig{} }
+func (m *MsgSetTreasuryVotingConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTreasuryVotingConfig) ProtoMessage()    {}
+func (*MsgSetTreasuryVotingConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{76}
+}
+func (m *MsgSetTreasuryVotingConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTreasuryVotingConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTreasuryVotingConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetTreasuryVotingConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTreasuryVotingConfig.Merge(m, src)
+}
+func (m *MsgSetTreasuryVotingConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTreasuryVotingConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTreasuryVotingConfig.DiscardUnknown(m)
 }
 
-func (m *MsgSetUserPermissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetTreasuryVotingConfig proto.InternalMessageInfo
+
+func (m *MsgSetTreasuryVotingConfig) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSetUserPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTreasuryVotingConfig) GetGroupID() uint32 {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
 }
 
-func (m *MsgSetUserPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetTreasuryVotingConfig) GetVotingPeriod() time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return 0
 }
 
-func (m *MsgGrantAllowance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTreasuryVotingConfig) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgGrantAllowance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetTreasuryVotingConfigResponse defines the Msg/SetTreasuryVotingConfig
+// response type
+type MsgSetTreasuryVotingConfigResponse struct {
 }
 
-func (m *MsgGrantAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		{
-			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
+func (m *MsgSetTreasuryVotingConfigResponse) Reset()         { *m = MsgSetTreasuryVotingConfigResponse{} }
+func (m *MsgSetTreasuryVotingConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTreasuryVotingConfigResponse) ProtoMessage()    {}
+func (*MsgSetTreasuryVotingConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{77}
+}
+func (m *MsgSetTreasuryVotingConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTreasuryVotingConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTreasuryVotingConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.Grantee != nil {
-		{
-			size, err := m.Grantee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
+}
+func (m *MsgSetTreasuryVotingConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTreasuryVotingConfigResponse.Merge(m, src)
+}
+func (m *MsgSetTreasuryVotingConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTreasuryVotingConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTreasuryVotingConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTreasuryVotingConfigResponse proto.InternalMessageInfo
+
+// MsgSubmitTreasuryProposal represents the message used to submit a proposal
+// to execute a set of messages using the subspace treasury as signer
+type MsgSubmitTreasuryProposal struct {
+	// Id of the subspace inside which to submit the proposal
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Messages to be executed using the treasury as signer
+	Messages []*types.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty" yaml:"messages"`
+	// Address of the user submitting the proposal
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty" yaml:"proposer"`
+}
+
+func (m *MsgSubmitTreasuryProposal) Reset()         { *m = MsgSubmitTreasuryProposal{} }
+func (m *MsgSubmitTreasuryProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitTreasuryProposal) ProtoMessage()    {}
+func (*MsgSubmitTreasuryProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{78}
+}
+func (m *MsgSubmitTreasuryProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitTreasuryProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitTreasuryProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgSubmitTreasuryProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitTreasuryProposal.Merge(m, src)
+}
+func (m *MsgSubmitTreasuryProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitTreasuryProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitTreasuryProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitTreasuryProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitTreasuryProposal) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitTreasuryProposal) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitTreasuryProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
 }
 
-func (m *MsgGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSubmitTreasuryProposalResponse defines the Msg/SubmitTreasuryProposal
+// response type
+type MsgSubmitTreasuryProposalResponse struct {
+	// Id of the newly created proposal
+	ProposalID uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 }
 
-func (m *MsgRevokeAllowance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitTreasuryProposalResponse) Reset()         { *m = MsgSubmitTreasuryProposalResponse{} }
+func (m *MsgSubmitTreasuryProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitTreasuryProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitTreasuryProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{79}
+}
+func (m *MsgSubmitTreasuryProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitTreasuryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitTreasuryProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRevokeAllowance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitTreasuryProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitTreasuryProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitTreasuryProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitTreasuryProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitTreasuryProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRevokeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Grantee != nil {
-		{
-			size, err := m.Grantee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_MsgSubmitTreasuryProposalResponse proto.InternalMessageInfo
 
-func (m *MsgRevokeAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitTreasuryProposalResponse) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRevokeAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgVoteTreasuryProposal represents the message used to vote a treasury
+// proposal
+type MsgVoteTreasuryProposal struct {
+	// Id of the subspace inside which the proposal has been submitted
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the proposal to vote
+	ProposalID uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	// Option chosen by the voter
+	Option TreasuryVoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=desmos.subspaces.v3.TreasuryVoteOption" json:"option,omitempty" yaml:"option"`
+	// Address of the voter
+	Voter string `protobuf:"bytes,4,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
 }
 
-func (m *MsgGrantTreasuryAuthorization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgVoteTreasuryProposal) Reset()         { *m = MsgVoteTreasuryProposal{} }
+func (m *MsgVoteTreasuryProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteTreasuryProposal) ProtoMessage()    {}
+func (*MsgVoteTreasuryProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{80}
 }
-
-func (m *MsgGrantTreasuryAuthorization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteTreasuryProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgGrantTreasuryAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgVoteTreasuryProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteTreasuryProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgGrantTreasuryAuthorizationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgVoteTreasuryProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteTreasuryProposal.Merge(m, src)
 }
-
-func (m *MsgGrantTreasuryAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteTreasuryProposal) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgGrantTreasuryAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgVoteTreasuryProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteTreasuryProposal.DiscardUnknown(m)
 }
 
-func (m *MsgRevokeTreasuryAuthorization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgVoteTreasuryProposal proto.InternalMessageInfo
 
-func (m *MsgRevokeTreasuryAuthorization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteTreasuryProposal) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
+	}
+	return 0
 }
 
-func (m *MsgRevokeTreasuryAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgVoteTreasuryProposal) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgRevokeTreasuryAuthorizationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteTreasuryProposal) GetOption() TreasuryVoteOption {
+	if m != nil {
+		return m.Option
 	}
-	return dAtA[:n], nil
+	return TREASURY_VOTE_OPTION_UNSPECIFIED
 }
 
-func (m *MsgRevokeTreasuryAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteTreasuryProposal) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
 }
 
-func (m *MsgRevokeTreasuryAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgVoteTreasuryProposalResponse defines the Msg/VoteTreasuryProposal
+// response type
+type MsgVoteTreasuryProposalResponse struct {
 }
 
-func (m *MsgUpdateSubspaceFeeTokens) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgVoteTreasuryProposalResponse) Reset()         { *m = MsgVoteTreasuryProposalResponse{} }
+func (m *MsgVoteTreasuryProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteTreasuryProposalResponse) ProtoMessage()    {}
+func (*MsgVoteTreasuryProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{81}
 }
-
-func (m *MsgUpdateSubspaceFeeTokens) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteTreasuryProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateSubspaceFeeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AdditionalFeeTokens) > 0 {
-		for iNdEx := len(m.AdditionalFeeTokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AdditionalFeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgVoteTreasuryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteTreasuryProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SubspaceID != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateSubspaceFeeTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgVoteTreasuryProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteTreasuryProposalResponse.Merge(m, src)
+}
+func (m *MsgVoteTreasuryProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteTreasuryProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteTreasuryProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateSubspaceFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgVoteTreasuryProposalResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateSubspaceFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateSubspaceFeeTokens represents the message to be used to update the
+// accepted fee tokens inside a given subspace, using an on-chain governance
+// proposal
+//
+// Since: Desmos 6.0.0
+type MsgUpdateSubspaceFeeTokens struct {
+	// Id of the subspace where the list of allowed fee tokens will be updated
+	SubspaceID uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// List of tokens allowed to be fee tokens inside the given subspace,
+	// represented as their gas prices
+	AdditionalFeeTokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=additional_fee_tokens,json=additionalFeeTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_fee_tokens" yaml:"additional_fee_tokens"`
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgUpdateSubspaceFeeTokens) Reset()         { *m = MsgUpdateSubspaceFeeTokens{} }
+func (m *MsgUpdateSubspaceFeeTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSubspaceFeeTokens) ProtoMessage()    {}
+func (*MsgUpdateSubspaceFeeTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{82}
 }
-func (m *MsgCreateSubspace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (m *MsgUpdateSubspaceFeeTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCreateSubspaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
+func (m *MsgUpdateSubspaceFeeTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSubspaceFeeTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *MsgEditSubspace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (m *MsgUpdateSubspaceFeeTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSubspaceFeeTokens.Merge(m, src)
 }
-
-func (m *MsgEditSubspaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateSubspaceFeeTokens) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgDeleteSubspace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (m *MsgUpdateSubspaceFeeTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSubspaceFeeTokens.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteSubspaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateSubspaceFeeTokens proto.InternalMessageInfo
+
+func (m *MsgUpdateSubspaceFeeTokens) GetSubspaceID() uint64 {
+	if m != nil {
+		return m.SubspaceID
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgCreateSection) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.ParentID != 0 {
-		n += 1 + sovMsgs(uint64(m.ParentID))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgUpdateSubspaceFeeTokens) GetAdditionalFeeTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AdditionalFeeTokens
 	}
-	return n
+	return nil
 }
 
-func (m *MsgCreateSectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SectionID != 0 {
-		n += 1 + sovMsgs(uint64(m.SectionID))
+func (m *MsgUpdateSubspaceFeeTokens) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return n
+	return ""
 }
 
-func (m *MsgEditSection) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	if m.SectionID != 0 {
-		n += 1 + sovMsgs(uint64(m.SectionID))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Editor)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+// MsgUpdateSubspaceFeeTokensResponse represents the Msg/UpdateSubspaceFeeTokens
+// response type
+//
+// Since: Desmos 6.0.0
+type MsgUpdateSubspaceFeeTokensResponse struct {
 }
 
-func (m *MsgEditSectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSubspaceFeeTokensResponse) Reset()         { *m = MsgUpdateSubspaceFeeTokensResponse{} }
+func (m *MsgUpdateSubspaceFeeTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSubspaceFeeTokensResponse) ProtoMessage()    {}
+func (*MsgUpdateSubspaceFeeTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d68359a074bdd9, []int{83}
+}
+func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSubspaceFeeTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSubspaceFeeTokensResponse.Merge(m, src)
+}
+func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSubspaceFeeTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSubspaceFeeTokensResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMoveSection) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	if m.SectionID != 0 {
-		n += 1 + sovMsgs(uint64(m.SectionID))
+var xxx_messageInfo_MsgUpdateSubspaceFeeTokensResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateSubspace)(nil), "desmos.subspaces.v3.MsgCreateSubspace")
+	proto.RegisterType((*MsgCreateSubspaceResponse)(nil), "desmos.subspaces.v3.MsgCreateSubspaceResponse")
+	proto.RegisterType((*MsgEditSubspace)(nil), "desmos.subspaces.v3.MsgEditSubspace")
+	proto.RegisterType((*MsgEditSubspaceResponse)(nil), "desmos.subspaces.v3.MsgEditSubspaceResponse")
+	proto.RegisterType((*MsgDeleteSubspace)(nil), "desmos.subspaces.v3.MsgDeleteSubspace")
+	proto.RegisterType((*MsgDeleteSubspaceResponse)(nil), "desmos.subspaces.v3.MsgDeleteSubspaceResponse")
+	proto.RegisterType((*MsgArchiveSubspace)(nil), "desmos.subspaces.v3.MsgArchiveSubspace")
+	proto.RegisterType((*MsgArchiveSubspaceResponse)(nil), "desmos.subspaces.v3.MsgArchiveSubspaceResponse")
+	proto.RegisterType((*MsgUnarchiveSubspace)(nil), "desmos.subspaces.v3.MsgUnarchiveSubspace")
+	proto.RegisterType((*MsgUnarchiveSubspaceResponse)(nil), "desmos.subspaces.v3.MsgUnarchiveSubspaceResponse")
+	proto.RegisterType((*MsgRequestSubspaceOwnerTransfer)(nil), "desmos.subspaces.v3.MsgRequestSubspaceOwnerTransfer")
+	proto.RegisterType((*MsgRequestSubspaceOwnerTransferResponse)(nil), "desmos.subspaces.v3.MsgRequestSubspaceOwnerTransferResponse")
+	proto.RegisterType((*MsgCancelSubspaceOwnerTransferRequest)(nil), "desmos.subspaces.v3.MsgCancelSubspaceOwnerTransferRequest")
+	proto.RegisterType((*MsgCancelSubspaceOwnerTransferRequestResponse)(nil), "desmos.subspaces.v3.MsgCancelSubspaceOwnerTransferRequestResponse")
+	proto.RegisterType((*MsgAcceptSubspaceOwnerTransferRequest)(nil), "desmos.subspaces.v3.MsgAcceptSubspaceOwnerTransferRequest")
+	proto.RegisterType((*MsgAcceptSubspaceOwnerTransferRequestResponse)(nil), "desmos.subspaces.v3.MsgAcceptSubspaceOwnerTransferRequestResponse")
+	proto.RegisterType((*MsgRefuseSubspaceOwnerTransferRequest)(nil), "desmos.subspaces.v3.MsgRefuseSubspaceOwnerTransferRequest")
+	proto.RegisterType((*MsgRefuseSubspaceOwnerTransferRequestResponse)(nil), "desmos.subspaces.v3.MsgRefuseSubspaceOwnerTransferRequestResponse")
+	proto.RegisterType((*MsgCreateSection)(nil), "desmos.subspaces.v3.MsgCreateSection")
+	proto.RegisterType((*MsgCreateSectionResponse)(nil), "desmos.subspaces.v3.MsgCreateSectionResponse")
+	proto.RegisterType((*MsgEditSection)(nil), "desmos.subspaces.v3.MsgEditSection")
+	proto.RegisterType((*MsgEditSectionResponse)(nil), "desmos.subspaces.v3.MsgEditSectionResponse")
+	proto.RegisterType((*MsgMoveSection)(nil), "desmos.subspaces.v3.MsgMoveSection")
+	proto.RegisterType((*MsgMoveSectionResponse)(nil), "desmos.subspaces.v3.MsgMoveSectionResponse")
+	proto.RegisterType((*MsgSetSectionVisibility)(nil), "desmos.subspaces.v3.MsgSetSectionVisibility")
+	proto.RegisterType((*MsgSetSectionVisibilityResponse)(nil), "desmos.subspaces.v3.MsgSetSectionVisibilityResponse")
+	proto.RegisterType((*MsgDeleteSection)(nil), "desmos.subspaces.v3.MsgDeleteSection")
+	proto.RegisterType((*MsgDeleteSectionResponse)(nil), "desmos.subspaces.v3.MsgDeleteSectionResponse")
+	proto.RegisterType((*MsgCreateUserGroup)(nil), "desmos.subspaces.v3.MsgCreateUserGroup")
+	proto.RegisterType((*MsgCreateUserGroupResponse)(nil), "desmos.subspaces.v3.MsgCreateUserGroupResponse")
+	proto.RegisterType((*MsgEditUserGroup)(nil), "desmos.subspaces.v3.MsgEditUserGroup")
+	proto.RegisterType((*MsgEditUserGroupResponse)(nil), "desmos.subspaces.v3.MsgEditUserGroupResponse")
+	proto.RegisterType((*MsgMoveUserGroup)(nil), "desmos.subspaces.v3.MsgMoveUserGroup")
+	proto.RegisterType((*MsgMoveUserGroupResponse)(nil), "desmos.subspaces.v3.MsgMoveUserGroupResponse")
+	proto.RegisterType((*MsgSetUserGroupPermissions)(nil), "desmos.subspaces.v3.MsgSetUserGroupPermissions")
+	proto.RegisterType((*MsgSetUserGroupPermissionsResponse)(nil), "desmos.subspaces.v3.MsgSetUserGroupPermissionsResponse")
+	proto.RegisterType((*MsgDeleteUserGroup)(nil), "desmos.subspaces.v3.MsgDeleteUserGroup")
+	proto.RegisterType((*MsgDeleteUserGroupResponse)(nil), "desmos.subspaces.v3.MsgDeleteUserGroupResponse")
+	proto.RegisterType((*MsgAddUserToUserGroup)(nil), "desmos.subspaces.v3.MsgAddUserToUserGroup")
+	proto.RegisterType((*MsgAddUserToUserGroupResponse)(nil), "desmos.subspaces.v3.MsgAddUserToUserGroupResponse")
+	proto.RegisterType((*MsgRemoveUserFromUserGroup)(nil), "desmos.subspaces.v3.MsgRemoveUserFromUserGroup")
+	proto.RegisterType((*MsgRemoveUserFromUserGroupResponse)(nil), "desmos.subspaces.v3.MsgRemoveUserFromUserGroupResponse")
+	proto.RegisterType((*MsgCreateInvite)(nil), "desmos.subspaces.v3.MsgCreateInvite")
+	proto.RegisterType((*MsgCreateInviteResponse)(nil), "desmos.subspaces.v3.MsgCreateInviteResponse")
+	proto.RegisterType((*MsgDeleteInvite)(nil), "desmos.subspaces.v3.MsgDeleteInvite")
+	proto.RegisterType((*MsgDeleteInviteResponse)(nil), "desmos.subspaces.v3.MsgDeleteInviteResponse")
+	proto.RegisterType((*MsgRedeemInvite)(nil), "desmos.subspaces.v3.MsgRedeemInvite")
+	proto.RegisterType((*MsgRedeemInviteResponse)(nil), "desmos.subspaces.v3.MsgRedeemInviteResponse")
+	proto.RegisterType((*MsgApplyToGroup)(nil), "desmos.subspaces.v3.MsgApplyToGroup")
+	proto.RegisterType((*MsgApplyToGroupResponse)(nil), "desmos.subspaces.v3.MsgApplyToGroupResponse")
+	proto.RegisterType((*MsgApproveGroupApplication)(nil), "desmos.subspaces.v3.MsgApproveGroupApplication")
+	proto.RegisterType((*MsgApproveGroupApplicationResponse)(nil), "desmos.subspaces.v3.MsgApproveGroupApplicationResponse")
+	proto.RegisterType((*MsgRejectGroupApplication)(nil), "desmos.subspaces.v3.MsgRejectGroupApplication")
+	proto.RegisterType((*MsgRejectGroupApplicationResponse)(nil), "desmos.subspaces.v3.MsgRejectGroupApplicationResponse")
+	proto.RegisterType((*MsgSetUserPermissions)(nil), "desmos.subspaces.v3.MsgSetUserPermissions")
+	proto.RegisterType((*MsgSetUserPermissionsResponse)(nil), "desmos.subspaces.v3.MsgSetUserPermissionsResponse")
+	proto.RegisterType((*MsgBanUser)(nil), "desmos.subspaces.v3.MsgBanUser")
+	proto.RegisterType((*MsgBanUserResponse)(nil), "desmos.subspaces.v3.MsgBanUserResponse")
+	proto.RegisterType((*MsgUnbanUser)(nil), "desmos.subspaces.v3.MsgUnbanUser")
+	proto.RegisterType((*MsgUnbanUserResponse)(nil), "desmos.subspaces.v3.MsgUnbanUserResponse")
+	proto.RegisterType((*MsgSetRateLimit)(nil), "desmos.subspaces.v3.MsgSetRateLimit")
+	proto.RegisterType((*MsgSetRateLimitResponse)(nil), "desmos.subspaces.v3.MsgSetRateLimitResponse")
+	proto.RegisterType((*MsgRemoveRateLimit)(nil), "desmos.subspaces.v3.MsgRemoveRateLimit")
+	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "desmos.subspaces.v3.MsgRemoveRateLimitResponse")
+	proto.RegisterType((*MsgSetFeeTokensConfig)(nil), "desmos.subspaces.v3.MsgSetFeeTokensConfig")
+	proto.RegisterType((*MsgSetFeeTokensConfigResponse)(nil), "desmos.subspaces.v3.MsgSetFeeTokensConfigResponse")
+	proto.RegisterType((*MsgRemoveFeeTokensConfig)(nil), "desmos.subspaces.v3.MsgRemoveFeeTokensConfig")
+	proto.RegisterType((*MsgRemoveFeeTokensConfigResponse)(nil), "desmos.subspaces.v3.MsgRemoveFeeTokensConfigResponse")
+	proto.RegisterType((*MsgGrantAllowance)(nil), "desmos.subspaces.v3.MsgGrantAllowance")
+	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "desmos.subspaces.v3.MsgGrantAllowanceResponse")
+	proto.RegisterType((*MsgRevokeAllowance)(nil), "desmos.subspaces.v3.MsgRevokeAllowance")
+	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "desmos.subspaces.v3.MsgRevokeAllowanceResponse")
+	proto.RegisterType((*MsgGrantTreasuryAuthorization)(nil), "desmos.subspaces.v3.MsgGrantTreasuryAuthorization")
+	proto.RegisterType((*MsgGrantTreasuryAuthorizationResponse)(nil), "desmos.subspaces.v3.MsgGrantTreasuryAuthorizationResponse")
+	proto.RegisterType((*MsgRevokeTreasuryAuthorization)(nil), "desmos.subspaces.v3.MsgRevokeTreasuryAuthorization")
+	proto.RegisterType((*MsgRevokeTreasuryAuthorizationResponse)(nil), "desmos.subspaces.v3.MsgRevokeTreasuryAuthorizationResponse")
+	proto.RegisterType((*MsgSetTreasuryVotingConfig)(nil), "desmos.subspaces.v3.MsgSetTreasuryVotingConfig")
+	proto.RegisterType((*MsgSetTreasuryVotingConfigResponse)(nil), "desmos.subspaces.v3.MsgSetTreasuryVotingConfigResponse")
+	proto.RegisterType((*MsgSubmitTreasuryProposal)(nil), "desmos.subspaces.v3.MsgSubmitTreasuryProposal")
+	proto.RegisterType((*MsgSubmitTreasuryProposalResponse)(nil), "desmos.subspaces.v3.MsgSubmitTreasuryProposalResponse")
+	proto.RegisterType((*MsgVoteTreasuryProposal)(nil), "desmos.subspaces.v3.MsgVoteTreasuryProposal")
+	proto.RegisterType((*MsgVoteTreasuryProposalResponse)(nil), "desmos.subspaces.v3.MsgVoteTreasuryProposalResponse")
+	proto.RegisterType((*MsgUpdateSubspaceFeeTokens)(nil), "desmos.subspaces.v3.MsgUpdateSubspaceFeeTokens")
+	proto.RegisterType((*MsgUpdateSubspaceFeeTokensResponse)(nil), "desmos.subspaces.v3.MsgUpdateSubspaceFeeTokensResponse")
+}
+
+func init() { proto.RegisterFile("desmos/subspaces/v3/msgs.proto", fileDescriptor_35d68359a074bdd9) }
+
+var fileDescriptor_35d68359a074bdd9 = []byte{
+	// 3743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1b, 0x49,
+	0xfd, 0xaf, 0xed, 0xfc, 0x69, 0x26, 0x69, 0xd2, 0x6e, 0xd3, 0x36, 0xd9, 0xf6, 0x67, 0xa7, 0xdb,
+	0xa4, 0x69, 0xd2, 0xc4, 0xfe, 0x35, 0xed, 0x35, 0x77, 0xbe, 0xdf, 0x0f, 0x29, 0xbe, 0xdc, 0x95,
+	0x70, 0xcd, 0xb5, 0x6c, 0xdb, 0x43, 0x80, 0x4e, 0x66, 0x6d, 0x4f, 0x9c, 0xbd, 0xda, 0xbb, 0xbe,
+	0x9d, 0xb5, 0xdb, 0x9c, 0x84, 0x74, 0x02, 0x84, 0x00, 0x21, 0x71, 0xba, 0x27, 0x04, 0x82, 0x17,
+	0x78, 0x40, 0x88, 0x87, 0x3e, 0xdc, 0x49, 0x3c, 0x21, 0xf1, 0x02, 0x07, 0x12, 0xe8, 0x40, 0x42,
+	0x20, 0x1e, 0xdc, 0x53, 0x2a, 0x51, 0x84, 0x10, 0x42, 0x91, 0x10, 0x12, 0xe2, 0x01, 0xed, 0xce,
+	0xec, 0xec, 0xec, 0x78, 0x77, 0xbd, 0x76, 0xb7, 0x25, 0x70, 0xf7, 0xd2, 0x78, 0x67, 0x3e, 0xdf,
+	0xef, 0xcc, 0x7c, 0xbe, 0x33, 0xdf, 0xf9, 0xce, 0xbf, 0x82, 0x74, 0x05, 0xa2, 0xba, 0x8e, 0x72,
+	0xa8, 0x59, 0x42, 0x0d, 0xa5, 0x0c, 0x51, 0xae, 0x75, 0x31, 0x57, 0x47, 0x55, 0x94, 0x6d, 0x18,
+	0xba, 0xa9, 0x0b, 0x47, 0x71, 0x7e, 0x96, 0xe6, 0x67, 0x5b, 0x17, 0xc5, 0x23, 0x4a, 0x5d, 0xd5,
+	0xf4, 0x9c, 0xfd, 0x2f, 0xc6, 0x89, 0x93, 0x55, 0xbd, 0xaa, 0xdb, 0x3f, 0x73, 0xd6, 0x2f, 0x92,
+	0x3a, 0x5d, 0xd5, 0xf5, 0x6a, 0x0d, 0xe6, 0xec, 0xaf, 0x52, 0x73, 0x2b, 0xa7, 0x68, 0x3b, 0x24,
+	0x2b, 0x5d, 0xd6, 0xed, 0x82, 0x4b, 0x0a, 0x82, 0xb9, 0xd6, 0x85, 0x12, 0x34, 0x95, 0x0b, 0xb9,
+	0xb2, 0xae, 0x6a, 0x8e, 0x28, 0xce, 0x2f, 0x62, 0x9d, 0xf8, 0x83, 0x64, 0x65, 0x78, 0xad, 0xa6,
+	0x5a, 0x87, 0xc8, 0x54, 0xea, 0x0d, 0x47, 0x37, 0x0f, 0xa8, 0x34, 0x0d, 0xc5, 0x54, 0x75, 0x47,
+	0xf7, 0x09, 0x52, 0x76, 0x1d, 0x55, 0x73, 0xad, 0x0b, 0xd6, 0x1f, 0x92, 0x31, 0x43, 0x32, 0x94,
+	0xa6, 0xb9, 0xfd, 0x3a, 0xad, 0x95, 0xfd, 0xe5, 0x20, 0x7c, 0xf9, 0xd2, 0x2b, 0xb0, 0x46, 0x6a,
+	0x27, 0x7d, 0x27, 0x09, 0x8e, 0x6c, 0xa2, 0xea, 0x73, 0x06, 0x54, 0x4c, 0x78, 0x83, 0xc0, 0x84,
+	0x33, 0x60, 0x40, 0x53, 0xea, 0x70, 0x2a, 0x31, 0x93, 0x38, 0x37, 0x52, 0x98, 0xd8, 0x6b, 0x67,
+	0x46, 0x77, 0x94, 0x7a, 0x2d, 0x2f, 0x59, 0xa9, 0x92, 0x6c, 0x67, 0x0a, 0x4f, 0x83, 0xd1, 0x0a,
+	0x44, 0x65, 0x43, 0x6d, 0x58, 0x95, 0x9d, 0x4a, 0xda, 0xd8, 0xe3, 0x7b, 0xed, 0x8c, 0x80, 0xb1,
+	0x4c, 0xa6, 0x24, 0xb3, 0x50, 0xe1, 0x23, 0x60, 0x50, 0xbf, 0xa3, 0x41, 0x63, 0x2a, 0x65, 0xcb,
+	0x9c, 0xdb, 0x6b, 0x67, 0xc6, 0xb0, 0x8c, 0x9d, 0x2c, 0xfd, 0xfa, 0xed, 0xe5, 0x49, 0xc2, 0xe1,
+	0x5a, 0xa5, 0x62, 0x40, 0x84, 0x6e, 0x98, 0x86, 0xaa, 0x55, 0x65, 0x2c, 0x26, 0xac, 0x83, 0xe1,
+	0xb2, 0x55, 0x61, 0xdd, 0x98, 0x1a, 0xb0, 0x35, 0x2c, 0xee, 0xb5, 0x33, 0xe3, 0x58, 0x03, 0xc9,
+	0x08, 0xd6, 0xe1, 0x88, 0xe6, 0x17, 0x3e, 0xf7, 0xf0, 0xde, 0xa2, 0xf3, 0xf5, 0x95, 0x87, 0xf7,
+	0x16, 0xa7, 0x08, 0x5b, 0x1d, 0x7c, 0x48, 0x25, 0x30, 0xdd, 0x91, 0x28, 0x43, 0xd4, 0xd0, 0x35,
+	0x04, 0x85, 0xe7, 0xc1, 0xa8, 0xc3, 0x6f, 0x51, 0xad, 0xd8, 0x9c, 0x0d, 0x14, 0x66, 0x77, 0xdb,
+	0x19, 0xe0, 0x40, 0x37, 0xd6, 0x5d, 0x56, 0x18, 0xa8, 0x24, 0x03, 0xe7, 0x6b, 0xa3, 0x22, 0xed,
+	0x26, 0xc1, 0xc4, 0x26, 0xaa, 0x3e, 0x5f, 0x51, 0x4d, 0x6a, 0x87, 0x78, 0x54, 0x53, 0x73, 0x26,
+	0x7b, 0x30, 0x67, 0xaa, 0x0f, 0x73, 0x0e, 0xf4, 0x67, 0xce, 0x35, 0x30, 0x84, 0xd4, 0xaa, 0xa5,
+	0x60, 0xd0, 0x56, 0xb0, 0xb0, 0xd7, 0xce, 0x1c, 0x22, 0x4d, 0x52, 0xab, 0xa1, 0x1a, 0x88, 0x60,
+	0xfe, 0xac, 0x65, 0x4b, 0xf2, 0x61, 0x99, 0xf2, 0xb8, 0x6b, 0x4a, 0x96, 0x50, 0x69, 0x1a, 0x9c,
+	0xe0, 0x92, 0x1c, 0x33, 0x4a, 0x3f, 0x4d, 0xd8, 0x23, 0x61, 0x1d, 0xd6, 0xa0, 0x09, 0xe3, 0xb6,
+	0x80, 0xdb, 0xc4, 0x64, 0xbf, 0x4d, 0x3c, 0xc7, 0x35, 0x91, 0xe9, 0xad, 0xde, 0x3a, 0x4b, 0x27,
+	0xc1, 0x74, 0x47, 0x22, 0x6d, 0xe6, 0xcf, 0x12, 0x40, 0xd8, 0x44, 0xd5, 0x35, 0xa3, 0xbc, 0xad,
+	0xb6, 0xf6, 0x63, 0x3b, 0x17, 0xb8, 0x76, 0x4e, 0xbb, 0xed, 0xe4, 0x2a, 0x2d, 0x9d, 0x02, 0x62,
+	0x67, 0x2a, 0x6d, 0xe9, 0x2f, 0x12, 0x60, 0x72, 0x13, 0x55, 0x6f, 0x69, 0xca, 0xbe, 0x6d, 0xeb,
+	0x79, 0xae, 0xad, 0x27, 0xdd, 0xb6, 0x76, 0x54, 0x5b, 0x4a, 0x83, 0x53, 0x7e, 0xe9, 0xb4, 0xbd,
+	0x3f, 0x48, 0x82, 0xcc, 0x26, 0xaa, 0xca, 0xf0, 0xb5, 0x26, 0x44, 0xb4, 0x7f, 0x5f, 0xb3, 0x86,
+	0xd8, 0x4d, 0x43, 0xd1, 0xd0, 0x16, 0x34, 0xe2, 0x6a, 0xfa, 0x15, 0x70, 0xd0, 0x80, 0x65, 0xa8,
+	0xb6, 0x68, 0xe3, 0xcf, 0xef, 0xb5, 0x33, 0x13, 0x58, 0xca, 0xc9, 0x09, 0x6e, 0x3e, 0x15, 0xb6,
+	0x39, 0x84, 0x5a, 0x85, 0x4e, 0x05, 0x2c, 0x87, 0x76, 0x7a, 0x18, 0x87, 0x36, 0x20, 0x7f, 0x19,
+	0x73, 0x68, 0x7f, 0x58, 0x1c, 0x9e, 0x75, 0x39, 0x0c, 0xa3, 0x42, 0x5a, 0x00, 0xf3, 0x5d, 0x20,
+	0x94, 0xd9, 0x3f, 0x26, 0xc0, 0x9c, 0xe5, 0xff, 0x15, 0xad, 0x0c, 0x6b, 0x01, 0x50, 0x5b, 0x4d,
+	0x9c, 0x5d, 0x0b, 0xd3, 0x92, 0xec, 0x97, 0x96, 0xa7, 0x38, 0x5a, 0xe6, 0x98, 0xc9, 0x2d, 0xb8,
+	0x1d, 0x52, 0x0e, 0x2c, 0x47, 0x6a, 0x29, 0xe5, 0xe6, 0xaf, 0x98, 0x9b, 0xb5, 0x72, 0x19, 0x36,
+	0xcc, 0x27, 0xc1, 0x4d, 0x5c, 0x7d, 0x2f, 0xbf, 0x6a, 0x31, 0x44, 0x3f, 0x39, 0x8e, 0x42, 0xda,
+	0x43, 0x38, 0xea, 0xde, 0x62, 0x9e, 0x23, 0x19, 0x6e, 0x35, 0x11, 0xfc, 0xef, 0xe1, 0x28, 0xa4,
+	0x3d, 0x84, 0xa3, 0xee, 0x2d, 0xa6, 0x1c, 0xfd, 0x24, 0x05, 0x0e, 0xbb, 0x31, 0x16, 0x2c, 0xdb,
+	0x91, 0xc5, 0x7f, 0x46, 0xfc, 0xf3, 0xff, 0x60, 0xa4, 0xa1, 0x18, 0x50, 0x33, 0xad, 0x3a, 0x5a,
+	0x31, 0xd0, 0xa1, 0xc2, 0xcc, 0x6e, 0x3b, 0x73, 0xf0, 0xba, 0x9d, 0x68, 0xd7, 0xf0, 0x30, 0xd6,
+	0x41, 0x61, 0x92, 0x7c, 0x10, 0xff, 0xde, 0xa8, 0xb0, 0xd1, 0xec, 0x60, 0xdf, 0xd1, 0xac, 0xf0,
+	0x0a, 0x00, 0x2d, 0x15, 0xa9, 0x25, 0xb5, 0xa6, 0x9a, 0x3b, 0x53, 0x43, 0x33, 0x89, 0x73, 0xe3,
+	0x2b, 0x67, 0xb3, 0x3e, 0xeb, 0xa1, 0x2c, 0x21, 0xf7, 0x65, 0x8a, 0x2e, 0x1c, 0xdb, 0x6b, 0x67,
+	0x8e, 0xe0, 0x02, 0x5d, 0x1d, 0x92, 0xcc, 0x28, 0xc4, 0xd1, 0x07, 0x1b, 0x2c, 0x9f, 0xe8, 0x08,
+	0x96, 0xb1, 0x5a, 0xe9, 0x15, 0x30, 0xc5, 0xa7, 0xd1, 0x50, 0x79, 0x0d, 0x00, 0x84, 0x93, 0x1c,
+	0x73, 0x1e, 0x2a, 0x48, 0xbb, 0xed, 0xcc, 0x08, 0x01, 0x6e, 0xac, 0xbb, 0x35, 0x71, 0x81, 0x92,
+	0x3c, 0x42, 0x3e, 0x36, 0x2a, 0xd2, 0x83, 0x24, 0x18, 0x77, 0x42, 0xb8, 0x78, 0x7b, 0x89, 0xb7,
+	0x72, 0xc9, 0x3e, 0x2a, 0x47, 0x3b, 0x5a, 0xaa, 0x87, 0x8e, 0x36, 0x10, 0xbd, 0xa3, 0xad, 0x81,
+	0x21, 0x58, 0x51, 0xdd, 0x8e, 0xc2, 0x4c, 0x0b, 0x38, 0x3d, 0x64, 0x5a, 0xc0, 0x80, 0xfc, 0x9c,
+	0x3d, 0x2d, 0xe0, 0x0f, 0xcb, 0x8c, 0xc7, 0xb8, 0x40, 0x99, 0x18, 0x71, 0x0a, 0x1c, 0xf7, 0xa6,
+	0xd0, 0x71, 0xfa, 0x2b, 0xcc, 0xff, 0xa6, 0xde, 0x82, 0xfb, 0x8f, 0xff, 0x17, 0xc1, 0x21, 0x0d,
+	0xde, 0x29, 0xba, 0xa3, 0x31, 0x65, 0x6b, 0x99, 0xdf, 0x6d, 0x67, 0x46, 0x5f, 0x82, 0x77, 0x98,
+	0x01, 0x39, 0x49, 0xec, 0xc2, 0xa2, 0x25, 0x79, 0x54, 0xa3, 0x20, 0x36, 0xbe, 0x1b, 0xe8, 0x37,
+	0xbe, 0x9b, 0xe3, 0xe2, 0x3b, 0x86, 0x6d, 0x86, 0x40, 0xc2, 0x36, 0x93, 0x42, 0xd9, 0xfe, 0x5b,
+	0xd2, 0x5e, 0xb0, 0xdc, 0x80, 0x66, 0xc7, 0xa8, 0xdd, 0x47, 0xb4, 0x7b, 0x7d, 0x4f, 0x2a, 0x66,
+	0xdf, 0x13, 0x87, 0x21, 0xb2, 0x9c, 0x21, 0xd2, 0xae, 0x21, 0xfc, 0xb8, 0x95, 0x4e, 0x83, 0x4c,
+	0x40, 0x16, 0x35, 0xcd, 0x97, 0x92, 0xe0, 0xb0, 0xbb, 0xcc, 0xda, 0x77, 0x43, 0xc1, 0x25, 0x2d,
+	0xd5, 0x2f, 0x69, 0xf3, 0x1c, 0x69, 0x27, 0x3a, 0x56, 0x9c, 0xa4, 0xff, 0x8a, 0x60, 0x8a, 0x4f,
+	0xa3, 0x34, 0x7d, 0x6b, 0x00, 0x08, 0x74, 0x3e, 0xb8, 0x85, 0xa0, 0x71, 0xc5, 0xd0, 0x9b, 0x8d,
+	0x0f, 0x8e, 0xcf, 0xbe, 0x06, 0x8e, 0x56, 0xe0, 0x96, 0xd2, 0xac, 0x99, 0xc5, 0x06, 0x34, 0xea,
+	0x2a, 0x42, 0xaa, 0xae, 0xa1, 0xa9, 0xc1, 0x99, 0xd4, 0xb9, 0x91, 0x42, 0x7a, 0xaf, 0x9d, 0x11,
+	0x1d, 0x0d, 0x1d, 0x20, 0x49, 0x16, 0x48, 0xea, 0x75, 0x37, 0x51, 0xf8, 0x34, 0x98, 0x50, 0x35,
+	0xd5, 0x54, 0x95, 0x5a, 0xb1, 0x0e, 0xeb, 0x25, 0x68, 0xa0, 0xa9, 0x21, 0x5b, 0xd9, 0xca, 0x5e,
+	0x3b, 0x73, 0x1c, 0x2b, 0xe3, 0x00, 0xc1, 0xa6, 0x1e, 0x27, 0xc8, 0x4d, 0x0c, 0x64, 0x63, 0x91,
+	0xe1, 0xfe, 0x77, 0xd6, 0x16, 0xf9, 0x60, 0x61, 0x9a, 0x0f, 0x16, 0x68, 0x47, 0x90, 0x3e, 0x01,
+	0xc4, 0xce, 0x54, 0x1a, 0x30, 0x3c, 0x03, 0x0e, 0x56, 0xad, 0x04, 0x37, 0x5c, 0x48, 0xef, 0xb6,
+	0x33, 0xc3, 0x36, 0x68, 0x63, 0xdd, 0x8d, 0x69, 0x1d, 0x90, 0x24, 0x0f, 0xdb, 0x3f, 0x37, 0x2a,
+	0xd2, 0x7d, 0x3c, 0x3e, 0xad, 0x39, 0x2c, 0xf6, 0x6e, 0xc7, 0x56, 0x2b, 0xd9, 0x53, 0xb5, 0x9e,
+	0x40, 0x88, 0xf0, 0xa8, 0x7b, 0x69, 0x21, 0xc3, 0xde, 0x43, 0x26, 0x19, 0xf6, 0x9e, 0x34, 0x36,
+	0x4c, 0x38, 0x4c, 0xe6, 0xb4, 0xfd, 0xc4, 0xfe, 0x35, 0x30, 0x6e, 0x4d, 0xf9, 0x8c, 0xcf, 0xc0,
+	0x11, 0xc2, 0xc2, 0x6e, 0x3b, 0x33, 0xf6, 0x12, 0xbc, 0xc3, 0xba, 0x8d, 0x63, 0x6e, 0x88, 0xc0,
+	0xba, 0x8e, 0x31, 0xcd, 0x85, 0xc5, 0x12, 0x24, 0x84, 0xf0, 0xed, 0xa1, 0x8f, 0xf0, 0xed, 0x49,
+	0xa3, 0x7c, 0xff, 0x32, 0x69, 0x8f, 0xa3, 0x1b, 0xd0, 0xb5, 0x05, 0xeb, 0x34, 0xfe, 0xfd, 0xcc,
+	0x3f, 0x0d, 0x46, 0x59, 0xff, 0x97, 0x9a, 0x49, 0x79, 0xbb, 0xb4, 0xc7, 0xef, 0xb1, 0xd0, 0x38,
+	0x28, 0xbe, 0xc0, 0x51, 0x7c, 0xda, 0x33, 0xfd, 0xfb, 0x31, 0x26, 0xcd, 0x02, 0x29, 0x38, 0x97,
+	0xd2, 0xfe, 0x85, 0x24, 0x10, 0xe8, 0xd4, 0xb7, 0x9f, 0x3a, 0x7a, 0x0c, 0xd3, 0x7f, 0xc8, 0x46,
+	0x2c, 0xd7, 0x5e, 0xb2, 0x11, 0xcb, 0xa5, 0xba, 0x1b, 0x93, 0x29, 0x70, 0xcc, 0xda, 0x30, 0xa9,
+	0x54, 0xac, 0xbc, 0x9b, 0xfa, 0x7e, 0xe2, 0xe9, 0x59, 0x30, 0xd0, 0x44, 0x94, 0xa5, 0x79, 0xd7,
+	0x1d, 0x37, 0x51, 0x18, 0x47, 0xb6, 0x50, 0x0c, 0x3d, 0x53, 0x28, 0x83, 0x09, 0x78, 0xb7, 0xa1,
+	0xe2, 0x03, 0xbf, 0xa2, 0x75, 0x34, 0x68, 0x3b, 0xee, 0xd1, 0x15, 0x31, 0x8b, 0x8f, 0x05, 0xb3,
+	0xce, 0xb1, 0x60, 0xf6, 0xa6, 0x73, 0x6e, 0x58, 0x48, 0xbb, 0x33, 0x3d, 0x27, 0x2c, 0xbd, 0x79,
+	0x3f, 0x93, 0x90, 0xc7, 0xdd, 0x54, 0x4b, 0x28, 0xbf, 0xc4, 0x59, 0xf2, 0x14, 0xb3, 0xcf, 0xd5,
+	0x61, 0x14, 0x29, 0x03, 0xfe, 0xc7, 0x37, 0x83, 0xf5, 0xed, 0xa2, 0xbd, 0xb9, 0x53, 0x27, 0xae,
+	0xe8, 0x05, 0x43, 0xaf, 0x7f, 0x68, 0xd4, 0x48, 0xee, 0x26, 0x80, 0x34, 0xe2, 0x6e, 0x02, 0x72,
+	0x29, 0xf3, 0xef, 0xa5, 0xc0, 0x04, 0x8d, 0x96, 0x36, 0xb4, 0x96, 0x6a, 0xc2, 0x7d, 0x40, 0xf7,
+	0x2a, 0x18, 0x45, 0xb0, 0x6c, 0x40, 0xb3, 0xb8, 0xad, 0xa0, 0xed, 0xce, 0x9d, 0x33, 0x26, 0xd3,
+	0x2a, 0xd3, 0xfe, 0xfa, 0xa8, 0x82, 0xb6, 0x85, 0x2c, 0x38, 0x58, 0x57, 0xee, 0x16, 0x9b, 0x08,
+	0x22, 0xb2, 0x6f, 0x76, 0xd4, 0x2d, 0xc8, 0xc9, 0x91, 0xe4, 0xe1, 0xba, 0x72, 0xf7, 0x16, 0x82,
+	0xe8, 0x89, 0x0c, 0x16, 0x36, 0x04, 0x1e, 0xea, 0x3f, 0x04, 0x9e, 0xe7, 0x43, 0xe0, 0xe3, 0x7c,
+	0x08, 0x8c, 0xcd, 0x47, 0x4e, 0x24, 0xd9, 0x24, 0x6a, 0xed, 0x7f, 0x26, 0xc0, 0x04, 0x75, 0xab,
+	0xf1, 0x5a, 0x9b, 0x33, 0x59, 0x32, 0xb2, 0xc9, 0x62, 0x98, 0x57, 0x42, 0xce, 0x6a, 0xd9, 0xa6,
+	0x12, 0x66, 0xd8, 0x24, 0xca, 0xcc, 0x3b, 0xf8, 0xac, 0x5c, 0x86, 0x15, 0x08, 0xeb, 0xf1, 0x32,
+	0x73, 0x09, 0x80, 0x46, 0xb3, 0x54, 0x53, 0xcb, 0xc5, 0xdb, 0x70, 0xc7, 0x26, 0x66, 0x8c, 0xdd,
+	0xa3, 0x70, 0xf3, 0x24, 0x79, 0x04, 0x7f, 0xbc, 0x08, 0x77, 0x84, 0x15, 0x30, 0x62, 0x35, 0x48,
+	0x31, 0x9b, 0x06, 0x0e, 0xed, 0xc7, 0x0a, 0x93, 0xee, 0xb6, 0x2f, 0xcd, 0xb2, 0x16, 0x9e, 0xce,
+	0x6f, 0xbc, 0x49, 0x6f, 0x35, 0x80, 0xba, 0x1a, 0xcf, 0x26, 0x3d, 0xce, 0x09, 0xdd, 0xa4, 0xc7,
+	0x10, 0xbc, 0x37, 0x4b, 0x3f, 0x39, 0x4a, 0x59, 0x8e, 0x08, 0xa5, 0x6c, 0x12, 0xa5, 0xf4, 0x87,
+	0x98, 0xd2, 0xb5, 0x46, 0xa3, 0xb6, 0x73, 0x53, 0xdf, 0x2f, 0x9e, 0x7c, 0x09, 0x0c, 0xd7, 0x21,
+	0x42, 0x4a, 0xd5, 0x59, 0x30, 0x09, 0xee, 0x60, 0x24, 0x19, 0x96, 0x7f, 0xc0, 0xbf, 0x84, 0x8f,
+	0x81, 0x11, 0xa5, 0xd1, 0xa8, 0xa9, 0x65, 0x45, 0x33, 0x09, 0xa5, 0x4b, 0xae, 0x15, 0x68, 0x56,
+	0x30, 0xa7, 0xae, 0x38, 0x8e, 0x7e, 0xdc, 0x6f, 0x8e, 0x55, 0x96, 0x26, 0xc2, 0x2a, 0x9b, 0x44,
+	0x59, 0x7d, 0x1f, 0x4f, 0x95, 0x6b, 0x8d, 0x86, 0xa1, 0xb7, 0xa0, 0x9d, 0xb7, 0x86, 0x55, 0xc6,
+	0xb9, 0x5d, 0xf4, 0x08, 0x04, 0x7b, 0x28, 0x4b, 0x3d, 0x12, 0x65, 0x8f, 0x79, 0xe6, 0x0c, 0xe0,
+	0x90, 0xcc, 0x9c, 0x01, 0xb9, 0xd4, 0x10, 0xed, 0xa4, 0x7d, 0x29, 0x42, 0x86, 0xaf, 0xc2, 0xb2,
+	0xf9, 0xa1, 0x1d, 0xba, 0xd9, 0xe1, 0x7f, 0x39, 0x3b, 0xcc, 0xb0, 0x0e, 0xc5, 0x8f, 0x42, 0xe9,
+	0x0c, 0x38, 0x1d, 0x98, 0x49, 0xad, 0xf0, 0x17, 0xbc, 0x12, 0x20, 0xab, 0xaa, 0xc7, 0xb0, 0x40,
+	0x8d, 0x61, 0x3f, 0xf0, 0x91, 0x82, 0x47, 0x6e, 0x95, 0x3b, 0xd0, 0xcf, 0x2a, 0x77, 0x30, 0xc6,
+	0xb5, 0xc4, 0xd0, 0x93, 0x5c, 0x4b, 0x74, 0x9a, 0x95, 0xac, 0x25, 0x3a, 0x33, 0x68, 0x8f, 0xf8,
+	0x62, 0x0a, 0x80, 0x4d, 0x54, 0x2d, 0x28, 0x9a, 0x85, 0x88, 0xab, 0x1b, 0x38, 0x36, 0x4c, 0xf6,
+	0x63, 0xc3, 0x05, 0x30, 0x64, 0x40, 0x05, 0xd1, 0x33, 0xe0, 0x23, 0xae, 0x25, 0x70, 0xba, 0x24,
+	0x13, 0x80, 0x1f, 0xe3, 0x03, 0xb1, 0x07, 0xa4, 0x31, 0x6c, 0xe9, 0x9d, 0xe6, 0x8c, 0x76, 0xc4,
+	0x35, 0x1a, 0x61, 0x5e, 0x9a, 0x04, 0x82, 0xfb, 0x45, 0xcd, 0xf3, 0x8f, 0x04, 0x18, 0xb3, 0x2f,
+	0x1d, 0x95, 0xf6, 0x91, 0x81, 0x62, 0x88, 0x41, 0xcf, 0x70, 0x84, 0x1c, 0x65, 0x2f, 0x5e, 0x91,
+	0xb6, 0x4a, 0xc7, 0xc1, 0x24, 0xfb, 0x4d, 0x49, 0xf9, 0x26, 0x5e, 0x85, 0xdd, 0x80, 0xa6, 0xac,
+	0x98, 0xf0, 0xaa, 0x5a, 0x57, 0x63, 0xbc, 0xb8, 0x31, 0x56, 0x47, 0xd5, 0xa2, 0xb9, 0xd3, 0x80,
+	0xc5, 0xa6, 0x51, 0x23, 0xfc, 0xcc, 0x59, 0x7a, 0x36, 0x51, 0xf5, 0xe6, 0x4e, 0x03, 0xde, 0x92,
+	0xaf, 0xee, 0xb5, 0x33, 0x47, 0xb1, 0x1e, 0x16, 0x2b, 0xc9, 0xa0, 0x4e, 0x20, 0x46, 0x4d, 0xc8,
+	0x83, 0x31, 0x6b, 0x01, 0x45, 0x22, 0x23, 0x44, 0xb6, 0x39, 0x4f, 0x30, 0xa2, 0x4c, 0xae, 0x24,
+	0x8f, 0xd6, 0x95, 0xbb, 0x9b, 0xe4, 0x4b, 0xf8, 0x38, 0x18, 0xba, 0xa3, 0x6a, 0x15, 0xfd, 0x0e,
+	0xe9, 0xcc, 0xd3, 0x1d, 0x9d, 0x79, 0x9d, 0xdc, 0x50, 0x2e, 0xa4, 0xdf, 0x6d, 0x67, 0x0e, 0xb8,
+	0xf4, 0x63, 0x31, 0xe9, 0xeb, 0xf7, 0x33, 0x89, 0xef, 0x3d, 0xbc, 0xb7, 0x98, 0x90, 0x89, 0xa2,
+	0xc7, 0x7c, 0xc5, 0x93, 0xb5, 0x04, 0x89, 0xc6, 0xd8, 0x24, 0x6a, 0xb8, 0xb7, 0xf0, 0x6e, 0x1d,
+	0x5e, 0x65, 0xef, 0x5f, 0xdb, 0x3d, 0xde, 0xbd, 0x3b, 0xae, 0xf5, 0x64, 0xef, 0x8e, 0x4b, 0xa5,
+	0x94, 0xfd, 0x88, 0xce, 0xd8, 0x2f, 0x40, 0x78, 0x53, 0xbf, 0x0d, 0x35, 0xf4, 0x9c, 0xae, 0x6d,
+	0xa9, 0xd5, 0x18, 0xd7, 0x5b, 0x25, 0x05, 0xc1, 0x62, 0x05, 0x6a, 0x7a, 0x9d, 0x70, 0xc6, 0xac,
+	0xb7, 0xdc, 0x3c, 0x49, 0x1e, 0xb1, 0x3e, 0xd6, 0xad, 0xdf, 0x82, 0x0c, 0x06, 0x0d, 0xc5, 0x84,
+	0x78, 0x1f, 0x79, 0x74, 0xe5, 0xb4, 0xef, 0x61, 0xb3, 0x53, 0x63, 0xab, 0x55, 0x85, 0x69, 0xd2,
+	0x53, 0xc9, 0xcd, 0x64, 0x5b, 0x5a, 0xc2, 0x9d, 0x14, 0xab, 0x12, 0xca, 0xf6, 0xa1, 0x8b, 0xa9,
+	0x6a, 0x0a, 0x3d, 0x74, 0x19, 0x5f, 0x59, 0x08, 0xd5, 0x8c, 0xd6, 0x5d, 0x01, 0xee, 0x7c, 0xc6,
+	0x49, 0xc6, 0xe7, 0x33, 0xce, 0x57, 0x1c, 0x03, 0x21, 0x7c, 0x06, 0xe6, 0xcc, 0xe4, 0xce, 0xc0,
+	0x5c, 0x06, 0xb5, 0xf0, 0x6f, 0x12, 0x60, 0x8a, 0x76, 0x80, 0xc7, 0x64, 0xe4, 0x18, 0xae, 0xca,
+	0xe6, 0xb8, 0x56, 0x67, 0xf8, 0x1e, 0xcd, 0x37, 0x5c, 0x02, 0x33, 0x41, 0x79, 0xb4, 0xed, 0xdf,
+	0x4e, 0xd9, 0x77, 0xbe, 0xaf, 0x18, 0x8a, 0x66, 0xae, 0xd5, 0x6a, 0xfa, 0x1d, 0x45, 0x8b, 0xef,
+	0x7e, 0xf0, 0x3a, 0x18, 0xae, 0x5a, 0x8a, 0x69, 0xab, 0x99, 0x8d, 0x24, 0x92, 0x11, 0xb2, 0x91,
+	0x44, 0x10, 0x82, 0xe2, 0x68, 0xc1, 0x2b, 0xe0, 0xd1, 0x95, 0xc9, 0x0e, 0x6f, 0xbc, 0xa6, 0xed,
+	0x14, 0x2e, 0xf0, 0xba, 0xa1, 0xf4, 0xf3, 0xb7, 0x97, 0x4f, 0xfa, 0x75, 0xde, 0x2b, 0x38, 0xdf,
+	0x29, 0x02, 0x0a, 0x1a, 0x18, 0x51, 0x9c, 0xc6, 0x4f, 0x0d, 0x84, 0x14, 0x92, 0x67, 0x56, 0x24,
+	0x8e, 0x80, 0x55, 0x8c, 0x44, 0x9a, 0xb0, 0x05, 0xa1, 0xad, 0x33, 0x4b, 0xde, 0xa2, 0x64, 0x29,
+	0xa7, 0x1b, 0xb2, 0x5b, 0x04, 0x79, 0x78, 0x41, 0x1a, 0xc8, 0x5d, 0x65, 0xf7, 0x9a, 0x82, 0x5c,
+	0x65, 0xf7, 0x26, 0x52, 0xeb, 0xdd, 0x73, 0xdc, 0x79, 0x4b, 0xbf, 0x0d, 0x3f, 0xb0, 0xe6, 0x23,
+	0xa7, 0xed, 0x0c, 0x9d, 0x1e, 0x67, 0xef, 0xe1, 0x86, 0x3a, 0x7b, 0x4f, 0x2a, 0x25, 0xf4, 0xef,
+	0x49, 0xdb, 0x59, 0xd8, 0x25, 0xdc, 0x34, 0xa0, 0x82, 0x9a, 0xc6, 0xce, 0x5a, 0xd3, 0xdc, 0xd6,
+	0x0d, 0xf5, 0xf5, 0x58, 0x17, 0xca, 0xf1, 0x70, 0xbb, 0xee, 0xe5, 0xd6, 0x47, 0x0b, 0xec, 0xaa,
+	0x05, 0x0a, 0x57, 0xc1, 0xa0, 0xfd, 0x93, 0xf4, 0xfc, 0x93, 0x59, 0x02, 0xc7, 0xef, 0xa8, 0x9c,
+	0x9e, 0x6c, 0x73, 0xc2, 0x4f, 0x22, 0xb6, 0x9c, 0x33, 0x89, 0xd8, 0x1f, 0xf8, 0xda, 0x35, 0x6b,
+	0x8c, 0x59, 0xae, 0x6f, 0xfb, 0xf2, 0x2a, 0xcd, 0x83, 0xb9, 0x50, 0x00, 0x35, 0xd1, 0x1f, 0x92,
+	0x20, 0x4d, 0x2d, 0xf8, 0x81, 0xb1, 0xd1, 0x33, 0x5c, 0x68, 0x85, 0xf7, 0x35, 0x4e, 0x44, 0x08,
+	0xa6, 0xf0, 0xf3, 0x00, 0xd6, 0x20, 0x73, 0xfc, 0xe8, 0xf0, 0xb7, 0xc8, 0x39, 0x70, 0x36, 0x1c,
+	0x41, 0x4d, 0xf2, 0xdb, 0x01, 0xe7, 0xe8, 0xdd, 0xc1, 0xbd, 0xac, 0x9b, 0xaa, 0x56, 0x8d, 0x77,
+	0x0a, 0x7d, 0x84, 0xbd, 0x25, 0x04, 0x46, 0xcc, 0x6d, 0x03, 0xa2, 0x6d, 0xbd, 0x56, 0x21, 0x56,
+	0xb8, 0x65, 0x75, 0xe4, 0xdf, 0xb7, 0x33, 0x67, 0xab, 0xaa, 0xb9, 0xdd, 0x2c, 0x65, 0xcb, 0x7a,
+	0x9d, 0xbc, 0x5a, 0x24, 0x7f, 0x96, 0x51, 0xe5, 0x76, 0xce, 0x62, 0x14, 0x65, 0xd7, 0x61, 0xd9,
+	0xf5, 0xfb, 0x54, 0x91, 0x65, 0x35, 0x40, 0xac, 0xb6, 0x0e, 0xcb, 0x78, 0x10, 0xb8, 0xe5, 0x08,
+	0x10, 0x1c, 0x6a, 0xd9, 0x34, 0x58, 0x37, 0x9a, 0x54, 0xbd, 0xd2, 0x7d, 0x2d, 0x31, 0x47, 0x06,
+	0x17, 0xb9, 0x9a, 0xe9, 0x91, 0x66, 0x96, 0x14, 0x63, 0x38, 0xe3, 0xba, 0x9d, 0x1e, 0xc7, 0xb6,
+	0x09, 0x04, 0x43, 0xaf, 0x35, 0x75, 0xa3, 0x59, 0x27, 0xe7, 0x3d, 0x9b, 0xef, 0xb6, 0x33, 0x89,
+	0x9e, 0xb8, 0x21, 0x05, 0x62, 0x2d, 0x1c, 0x31, 0x32, 0x51, 0xde, 0xe5, 0x0e, 0x82, 0x5f, 0xd7,
+	0x71, 0xef, 0x20, 0xf8, 0xe5, 0xd2, 0xfe, 0xf7, 0x63, 0xbc, 0xb5, 0x79, 0xa3, 0x59, 0xaa, 0xab,
+	0x14, 0x79, 0xdd, 0xd0, 0x1b, 0x3a, 0x52, 0x6a, 0x71, 0x75, 0xbf, 0xcf, 0x80, 0x83, 0x74, 0x2d,
+	0x99, 0x9c, 0x49, 0x05, 0x4e, 0x64, 0x59, 0xe6, 0x00, 0x8f, 0xe0, 0xad, 0x99, 0x8c, 0xbc, 0x61,
+	0xcd, 0x5a, 0x11, 0x3c, 0xf5, 0xa9, 0xd6, 0xf8, 0xa2, 0x5a, 0xad, 0xe3, 0x90, 0x86, 0x5d, 0x69,
+	0xba, 0xee, 0x61, 0x8e, 0x43, 0x9c, 0x9c, 0x90, 0xe3, 0x10, 0x07, 0x92, 0x5f, 0xb1, 0x8f, 0x43,
+	0x9c, 0x4f, 0x6e, 0xf7, 0xd2, 0x9f, 0x25, 0xe9, 0x55, 0x70, 0x3a, 0x30, 0x93, 0x7d, 0xe8, 0xd9,
+	0x20, 0x69, 0x1c, 0x95, 0x0e, 0x94, 0xa5, 0x92, 0x81, 0x4a, 0x32, 0x70, 0xbe, 0x36, 0x2a, 0xd2,
+	0x9f, 0xf0, 0x9d, 0xde, 0x97, 0x75, 0x13, 0x3e, 0x2e, 0x6b, 0x71, 0x35, 0x4d, 0xf6, 0x57, 0x53,
+	0x41, 0x06, 0x43, 0xba, 0xfb, 0x1a, 0x62, 0x7c, 0x65, 0xde, 0x77, 0x31, 0xc4, 0x74, 0x4e, 0x78,
+	0xcd, 0x86, 0xb3, 0x5b, 0x66, 0x3a, 0xb9, 0xa5, 0x36, 0xa4, 0xd3, 0xc7, 0xa2, 0x2d, 0xdd, 0xf4,
+	0x7b, 0x2c, 0x6a, 0x27, 0x87, 0x3c, 0x16, 0xb5, 0xf3, 0xf3, 0xcb, 0x96, 0x75, 0xf1, 0x6f, 0xee,
+	0x22, 0xaf, 0x1f, 0xa1, 0xe4, 0x22, 0xaf, 0x5f, 0x16, 0x1d, 0x3f, 0x5f, 0x4e, 0xd9, 0xfe, 0xfb,
+	0x56, 0xa3, 0xc2, 0xbc, 0xee, 0xa5, 0x2b, 0x86, 0xb8, 0x4c, 0xf2, 0x4e, 0x02, 0x1c, 0x53, 0x2a,
+	0x15, 0xd5, 0x22, 0x41, 0xa9, 0x15, 0xb7, 0x20, 0x2c, 0x9a, 0x76, 0x01, 0x64, 0x38, 0x4d, 0x67,
+	0xfd, 0x86, 0xc8, 0x73, 0xba, 0xaa, 0x15, 0xb6, 0x88, 0x63, 0x3c, 0x85, 0x8b, 0xf0, 0xd5, 0x22,
+	0x7d, 0xff, 0x7e, 0xe6, 0x5c, 0x04, 0x87, 0x65, 0x29, 0x44, 0xdf, 0x78, 0x78, 0x6f, 0x71, 0xac,
+	0x06, 0xab, 0x4a, 0x79, 0xa7, 0x58, 0xb6, 0x12, 0xb0, 0x67, 0x3d, 0xea, 0x6a, 0x76, 0x9b, 0x7f,
+	0x19, 0x8c, 0x28, 0x78, 0xda, 0x23, 0x57, 0xbb, 0x47, 0x0a, 0x53, 0x21, 0x87, 0x10, 0x0e, 0x34,
+	0x7f, 0x09, 0x9f, 0x9f, 0x39, 0xdf, 0x9c, 0xc7, 0x0b, 0x20, 0x9b, 0x78, 0xbc, 0x80, 0x5c, 0xc7,
+	0x62, 0x2b, 0x7f, 0x3e, 0x0b, 0x52, 0x9b, 0xa8, 0x2a, 0x6c, 0x83, 0x71, 0xee, 0xe1, 0xba, 0xff,
+	0xad, 0xf3, 0x8e, 0xb7, 0xdb, 0x62, 0x36, 0x1a, 0x8e, 0x0e, 0xfd, 0x12, 0x18, 0xf3, 0x3c, 0xcc,
+	0x9e, 0x0d, 0x92, 0x67, 0x51, 0xe2, 0x52, 0x14, 0x14, 0x2d, 0x63, 0x1b, 0x8c, 0x73, 0x8f, 0x8f,
+	0x03, 0x5b, 0xe3, 0xc5, 0x89, 0xd9, 0x68, 0x38, 0x5a, 0xd2, 0x6d, 0x30, 0xc1, 0xbf, 0xff, 0x9d,
+	0x0f, 0x52, 0xc1, 0x01, 0xc5, 0x5c, 0x44, 0x20, 0x2d, 0xec, 0x35, 0x70, 0xa4, 0xf3, 0x09, 0xee,
+	0x42, 0x90, 0x96, 0x0e, 0xa8, 0x78, 0x21, 0x32, 0x94, 0x16, 0xf9, 0x56, 0x02, 0x9c, 0x0a, 0x7d,
+	0x06, 0x7b, 0x29, 0x48, 0x67, 0x98, 0x94, 0xf8, 0x7f, 0xfd, 0x48, 0xd1, 0x4a, 0x7d, 0x37, 0x01,
+	0xa4, 0x08, 0x2f, 0x48, 0xf3, 0x81, 0x3d, 0xb3, 0xab, 0xac, 0x58, 0xe8, 0x5f, 0xd6, 0x53, 0xcd,
+	0x08, 0x8f, 0x39, 0x03, 0xab, 0xd9, 0x5d, 0x56, 0x2c, 0xf4, 0x2f, 0xeb, 0xa9, 0x66, 0x84, 0xf7,
+	0x94, 0xf9, 0x60, 0x93, 0x75, 0x93, 0x15, 0x0b, 0xfd, 0xcb, 0xd2, 0x6a, 0x42, 0x70, 0xc8, 0xfb,
+	0xa2, 0x71, 0xae, 0x8b, 0xe3, 0xc1, 0x30, 0x71, 0x39, 0x12, 0x8c, 0x16, 0x53, 0x04, 0xa3, 0xec,
+	0x83, 0xb8, 0x33, 0xa1, 0x7e, 0x87, 0x14, 0x71, 0x3e, 0x02, 0x88, 0x2d, 0x80, 0x7d, 0xf1, 0x15,
+	0x58, 0x00, 0x03, 0x12, 0xcf, 0x47, 0x00, 0xd1, 0x02, 0x5e, 0x07, 0x93, 0xbe, 0x8f, 0x9c, 0x02,
+	0x5d, 0xa8, 0x1f, 0x5a, 0xbc, 0xd4, 0x0b, 0x9a, 0x35, 0x92, 0xf7, 0x15, 0xcf, 0x5c, 0x17, 0x7f,
+	0xda, 0xcd, 0x48, 0xbe, 0x2f, 0x61, 0x2c, 0xaf, 0xcb, 0xbf, 0x82, 0x99, 0x0f, 0x37, 0x33, 0x05,
+	0x8a, 0xb9, 0x88, 0x40, 0xb6, 0x4d, 0xde, 0x97, 0x0f, 0x73, 0x61, 0xe6, 0x76, 0x0b, 0x5a, 0x8e,
+	0x04, 0x63, 0x8b, 0xf1, 0x5e, 0xf1, 0x9f, 0x0b, 0x33, 0x7a, 0x84, 0x62, 0x7c, 0x6f, 0xb7, 0x0b,
+	0x9f, 0x4f, 0x80, 0x13, 0x41, 0x57, 0xdb, 0x73, 0x21, 0x36, 0xf7, 0x13, 0x10, 0x57, 0x7b, 0x14,
+	0x60, 0x0d, 0xc8, 0x5f, 0xf4, 0x9e, 0x0f, 0xef, 0x02, 0x11, 0x0c, 0x18, 0x70, 0x69, 0x5a, 0x30,
+	0x81, 0xe0, 0x73, 0x61, 0x7a, 0x31, 0xd0, 0x75, 0x76, 0x60, 0xc5, 0x95, 0xe8, 0x58, 0x0f, 0xd1,
+	0x41, 0xf7, 0x7a, 0x73, 0xc1, 0xfe, 0xd0, 0x57, 0x40, 0x5c, 0xed, 0x51, 0x80, 0x8d, 0xb6, 0x3c,
+	0x57, 0x5c, 0x67, 0xc3, 0x7b, 0x3f, 0x46, 0x89, 0x4b, 0x51, 0x50, 0x6c, 0x19, 0x9e, 0x8b, 0x95,
+	0xb3, 0xe1, 0x06, 0xea, 0x56, 0x86, 0xdf, 0x35, 0x45, 0xab, 0x0c, 0xcf, 0x15, 0xc5, 0xd9, 0x60,
+	0x42, 0x5c, 0x94, 0xb8, 0x14, 0x05, 0xc5, 0x96, 0xe1, 0xb9, 0xb3, 0x17, 0x58, 0x06, 0x8b, 0x12,
+	0x97, 0xa2, 0xa0, 0x3c, 0xbd, 0x22, 0xe8, 0x0a, 0x5b, 0x2e, 0x44, 0x93, 0x9f, 0x80, 0xb8, 0xda,
+	0xa3, 0x00, 0xad, 0xc5, 0x1b, 0x09, 0x70, 0x3c, 0xe0, 0xfe, 0x56, 0x36, 0x98, 0x32, 0x3f, 0xbc,
+	0x78, 0xb9, 0x37, 0x3c, 0x3b, 0x28, 0x7d, 0xee, 0x2e, 0x2d, 0x76, 0x71, 0x28, 0xac, 0xf3, 0x59,
+	0x89, 0x8e, 0xa5, 0xa5, 0xde, 0x00, 0xc3, 0xce, 0xfd, 0x98, 0x4c, 0x90, 0x38, 0x01, 0x88, 0xf3,
+	0x5d, 0x00, 0x54, 0xe9, 0x27, 0xc1, 0x88, 0x7b, 0xab, 0xe3, 0x74, 0x70, 0x8c, 0x4d, 0x20, 0xe2,
+	0x42, 0x57, 0x08, 0xdb, 0x25, 0x3d, 0x77, 0x23, 0x66, 0x43, 0xda, 0x4c, 0x51, 0xe2, 0x52, 0x14,
+	0x14, 0xeb, 0x8b, 0xf9, 0x63, 0xfc, 0xf9, 0x70, 0x77, 0xe3, 0x96, 0x94, 0x8b, 0x08, 0xe4, 0xcc,
+	0xce, 0x9f, 0x8d, 0x86, 0x99, 0x9d, 0xc3, 0x8a, 0x2b, 0xd1, 0xb1, 0xb4, 0xd4, 0xcf, 0x82, 0x63,
+	0xfe, 0x87, 0xb2, 0xcb, 0xe1, 0xf5, 0xe7, 0xcb, 0x7e, 0xaa, 0x27, 0x38, 0x2d, 0xfe, 0xab, 0x09,
+	0x20, 0x86, 0x9c, 0x04, 0x05, 0xb6, 0x28, 0x58, 0x46, 0xcc, 0xf7, 0x2e, 0x43, 0xab, 0xf3, 0xb5,
+	0x04, 0x38, 0x19, 0x76, 0xea, 0x71, 0x31, 0xb8, 0x95, 0x81, 0x42, 0xe2, 0xb3, 0x7d, 0x08, 0xf1,
+	0x41, 0x89, 0xef, 0xa6, 0x7f, 0x58, 0x50, 0xe2, 0x27, 0x20, 0xae, 0xf6, 0x28, 0xe0, 0xf1, 0x8a,
+	0x01, 0x5b, 0xbf, 0x81, 0x5e, 0xd1, 0x1f, 0x2f, 0x5e, 0xee, 0x0d, 0xcf, 0xc6, 0xee, 0xbe, 0x9b,
+	0x99, 0x81, 0x23, 0xda, 0x0f, 0x2d, 0x5e, 0xea, 0x05, 0xcd, 0x6e, 0x9a, 0x70, 0xa7, 0xf7, 0x67,
+	0x43, 0x3b, 0x19, 0xc5, 0x89, 0xd9, 0x68, 0x38, 0xaf, 0xc7, 0xf1, 0x9e, 0x34, 0xcf, 0x87, 0x77,
+	0x1f, 0xb7, 0xac, 0x5c, 0x44, 0xa0, 0xa7, 0x6f, 0x05, 0x6d, 0x48, 0x06, 0x2a, 0x0b, 0x10, 0x10,
+	0x57, 0x7b, 0x14, 0x70, 0x6a, 0x21, 0x0e, 0xbe, 0x61, 0xed, 0x0b, 0x16, 0xae, 0xbe, 0xbb, 0x9b,
+	0x4e, 0xbc, 0xb7, 0x9b, 0x4e, 0xbc, 0xbf, 0x9b, 0x4e, 0xbc, 0xf9, 0x20, 0x7d, 0xe0, 0xbd, 0x07,
+	0xe9, 0x03, 0xbf, 0x7b, 0x90, 0x3e, 0xf0, 0xa9, 0x15, 0x66, 0xc7, 0x11, 0x97, 0xb1, 0x5c, 0x53,
+	0x4a, 0x88, 0xfc, 0xce, 0xb5, 0x56, 0x73, 0x77, 0x99, 0xff, 0x7b, 0xd2, 0xde, 0x81, 0x2c, 0x0d,
+	0xd9, 0xe7, 0x05, 0x17, 0xff, 0x35, 0x00, 0xd5, 0x2b, 0xb1, 0xf0, 0xcc, 0x53, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateSubspace allows to create a subspace
+	CreateSubspace(ctx context.Context, in *MsgCreateSubspace, opts ...grpc.CallOption) (*MsgCreateSubspaceResponse, error)
+	// EditSubspace allows to edit a subspace
+	EditSubspace(ctx context.Context, in *MsgEditSubspace, opts ...grpc.CallOption) (*MsgEditSubspaceResponse, error)
+	// DeleteSubspace allows to delete a subspace
+	DeleteSubspace(ctx context.Context, in *MsgDeleteSubspace, opts ...grpc.CallOption) (*MsgDeleteSubspaceResponse, error)
+	// ArchiveSubspace allows the subspace owner to archive a subspace, making it
+	// read-only
+	ArchiveSubspace(ctx context.Context, in *MsgArchiveSubspace, opts ...grpc.CallOption) (*MsgArchiveSubspaceResponse, error)
+	// UnarchiveSubspace allows the subspace owner to unarchive a previously
+	// archived subspace
+	UnarchiveSubspace(ctx context.Context, in *MsgUnarchiveSubspace, opts ...grpc.CallOption) (*MsgUnarchiveSubspaceResponse, error)
+	// RequestSubspaceOwnerTransfer allows the subspace owner to send a request to
+	// transfer the subspace ownership to receiver
+	RequestSubspaceOwnerTransfer(ctx context.Context, in *MsgRequestSubspaceOwnerTransfer, opts ...grpc.CallOption) (*MsgRequestSubspaceOwnerTransferResponse, error)
+	// CancelSubspaceOwnerTransferRequest allows sender to cancel an outgoing
+	// subspace owner transfer request
+	CancelSubspaceOwnerTransferRequest(ctx context.Context, in *MsgCancelSubspaceOwnerTransferRequest, opts ...grpc.CallOption) (*MsgCancelSubspaceOwnerTransferRequestResponse, error)
+	// AcceptSubspaceOwnerTransferRequest allows receiver to accept an incoming
+	// subspace owner transfer request
+	AcceptSubspaceOwnerTransferRequest(ctx context.Context, in *MsgAcceptSubspaceOwnerTransferRequest, opts ...grpc.CallOption) (*MsgAcceptSubspaceOwnerTransferRequestResponse, error)
+	// RefuseSubspaceOwnerTransferRequest allows receiver to refuse an incoming
+	// subspace owner transfer request
+	RefuseSubspaceOwnerTransferRequest(ctx context.Context, in *MsgRefuseSubspaceOwnerTransferRequest, opts ...grpc.CallOption) (*MsgRefuseSubspaceOwnerTransferRequestResponse, error)
+	// CreateSection allows to create a new subspace section
+	CreateSection(ctx context.Context, in *MsgCreateSection, opts ...grpc.CallOption) (*MsgCreateSectionResponse, error)
+	// EditSection allows to edit an existing section
+	EditSection(ctx context.Context, in *MsgEditSection, opts ...grpc.CallOption) (*MsgEditSectionResponse, error)
+	// MoveSection allows to move an existing section to another parent
+	MoveSection(ctx context.Context, in *MsgMoveSection, opts ...grpc.CallOption) (*MsgMoveSectionResponse, error)
+	// SetSectionVisibility allows to set the visibility of an existing section
+	SetSectionVisibility(ctx context.Context, in *MsgSetSectionVisibility, opts ...grpc.CallOption) (*MsgSetSectionVisibilityResponse, error)
+	// DeleteSection allows to delete an existing section
+	DeleteSection(ctx context.Context, in *MsgDeleteSection, opts ...grpc.CallOption) (*MsgDeleteSectionResponse, error)
+	// CreateUserGroup allows to create a user group
+	CreateUserGroup(ctx context.Context, in *MsgCreateUserGroup, opts ...grpc.CallOption) (*MsgCreateUserGroupResponse, error)
+	// EditUserGroup allows to edit a user group
+	EditUserGroup(ctx context.Context, in *MsgEditUserGroup, opts ...grpc.CallOption) (*MsgEditUserGroupResponse, error)
+	// MoveUserGroup allows to move a user group from a section to another
+	MoveUserGroup(ctx context.Context, in *MsgMoveUserGroup, opts ...grpc.CallOption) (*MsgMoveUserGroupResponse, error)
+	// SetUserGroupPermissions allows to set the permissions for a specific group
+	SetUserGroupPermissions(ctx context.Context, in *MsgSetUserGroupPermissions, opts ...grpc.CallOption) (*MsgSetUserGroupPermissionsResponse, error)
+	// DeleteUserGroup allows to delete an existing user group
+	DeleteUserGroup(ctx context.Context, in *MsgDeleteUserGroup, opts ...grpc.CallOption) (*MsgDeleteUserGroupResponse, error)
+	// AddUserToUserGroup allows to add a specific user to a specific user group
+	AddUserToUserGroup(ctx context.Context, in *MsgAddUserToUserGroup, opts ...grpc.CallOption) (*MsgAddUserToUserGroupResponse, error)
+	// RemoveUserFromUserGroup allows to remove a specific user from a specific
+	// user group
+	RemoveUserFromUserGroup(ctx context.Context, in *MsgRemoveUserFromUserGroup, opts ...grpc.CallOption) (*MsgRemoveUserFromUserGroupResponse, error)
+	// CreateInvite allows to create an invite that can be redeemed to join a
+	// specific user group
+	CreateInvite(ctx context.Context, in *MsgCreateInvite, opts ...grpc.CallOption) (*MsgCreateInviteResponse, error)
+	// DeleteInvite allows to delete an existing user group invite
+	DeleteInvite(ctx context.Context, in *MsgDeleteInvite, opts ...grpc.CallOption) (*MsgDeleteInviteResponse, error)
+	// RedeemInvite allows a user to join a user group by redeeming an invite
+	RedeemInvite(ctx context.Context, in *MsgRedeemInvite, opts ...grpc.CallOption) (*MsgRedeemInviteResponse, error)
+	// ApplyToGroup allows a user to apply to join a specific user group
+	ApplyToGroup(ctx context.Context, in *MsgApplyToGroup, opts ...grpc.CallOption) (*MsgApplyToGroupResponse, error)
+	// ApproveGroupApplication allows to approve a user group application,
+	// adding the applicant to the group
+	ApproveGroupApplication(ctx context.Context, in *MsgApproveGroupApplication, opts ...grpc.CallOption) (*MsgApproveGroupApplicationResponse, error)
+	// RejectGroupApplication allows to reject a user group application
+	RejectGroupApplication(ctx context.Context, in *MsgRejectGroupApplication, opts ...grpc.CallOption) (*MsgRejectGroupApplicationResponse, error)
+	// SetUserPermissions allows to set the permissions for a specific user
+	SetUserPermissions(ctx context.Context, in *MsgSetUserPermissions, opts ...grpc.CallOption) (*MsgSetUserPermissionsResponse, error)
+	// BanUser allows to ban a user from a subspace
+	BanUser(ctx context.Context, in *MsgBanUser, opts ...grpc.CallOption) (*MsgBanUserResponse, error)
+	// UnbanUser allows to lift the ban of a user from a subspace
+	UnbanUser(ctx context.Context, in *MsgUnbanUser, opts ...grpc.CallOption) (*MsgUnbanUserResponse, error)
+	// SetRateLimit allows to set the rate limit of a message type inside a
+	// subspace
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	// RemoveRateLimit allows to remove the rate limit of a message type from a
+	// subspace
+	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+	// SetFeeTokensConfig allows to set the conversion rates and the destination
+	// of the additional fee tokens accepted inside a subspace
+	SetFeeTokensConfig(ctx context.Context, in *MsgSetFeeTokensConfig, opts ...grpc.CallOption) (*MsgSetFeeTokensConfigResponse, error)
+	// RemoveFeeTokensConfig allows to remove the fee tokens configuration of a
+	// subspace
+	RemoveFeeTokensConfig(ctx context.Context, in *MsgRemoveFeeTokensConfig, opts ...grpc.CallOption) (*MsgRemoveFeeTokensConfigResponse, error)
+	// GrantTreasuryAuthorization allows managers who have the permission to grant
+	// a treasury authorization to a user
+	GrantTreasuryAuthorization(ctx context.Context, in *MsgGrantTreasuryAuthorization, opts ...grpc.CallOption) (*MsgGrantTreasuryAuthorizationResponse, error)
+	// RevokeTreasuryAuthorization allows managers who have the permission to
+	// revoke an existing treasury authorization
+	RevokeTreasuryAuthorization(ctx context.Context, in *MsgRevokeTreasuryAuthorization, opts ...grpc.CallOption) (*MsgRevokeTreasuryAuthorizationResponse, error)
+	// SetTreasuryVotingConfig allows managers who have the permission to set
+	// the user group and the threshold used to vote treasury proposals
+	SetTreasuryVotingConfig(ctx context.Context, in *MsgSetTreasuryVotingConfig, opts ...grpc.CallOption) (*MsgSetTreasuryVotingConfigResponse, error)
+	// SubmitTreasuryProposal allows members of the voting group to submit a
+	// proposal to execute a set of messages using the treasury as signer
+	SubmitTreasuryProposal(ctx context.Context, in *MsgSubmitTreasuryProposal, opts ...grpc.CallOption) (*MsgSubmitTreasuryProposalResponse, error)
+	// VoteTreasuryProposal allows members of the voting group to vote a
+	// treasury proposal
+	VoteTreasuryProposal(ctx context.Context, in *MsgVoteTreasuryProposal, opts ...grpc.CallOption) (*MsgVoteTreasuryProposalResponse, error)
+	// GrantAllowance allows the granter to grant a fee allowance to the
+	// grantee
+	GrantAllowance(ctx context.Context, in *MsgGrantAllowance, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
+	// RevokeAllowance allows a granter to revoke any existing treasury allowance
+	// that has been granted to the grantee
+	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// UpdateSubspaceFeeTokens allows subspace admins to update the list of fee
+	// tokens inside the subspace via a governance proposal
+	//
+	// Since: Desmos 6.0.0
+	UpdateSubspaceFeeTokens(ctx context.Context, in *MsgUpdateSubspaceFeeTokens, opts ...grpc.CallOption) (*MsgUpdateSubspaceFeeTokensResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateSubspace(ctx context.Context, in *MsgCreateSubspace, opts ...grpc.CallOption) (*MsgCreateSubspaceResponse, error) {
+	out := new(MsgCreateSubspaceResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/CreateSubspace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.NewParentID != 0 {
-		n += 1 + sovMsgs(uint64(m.NewParentID))
+	return out, nil
+}
+
+func (c *msgClient) EditSubspace(ctx context.Context, in *MsgEditSubspace, opts ...grpc.CallOption) (*MsgEditSubspaceResponse, error) {
+	out := new(MsgEditSubspaceResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/EditSubspace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DeleteSubspace(ctx context.Context, in *MsgDeleteSubspace, opts ...grpc.CallOption) (*MsgDeleteSubspaceResponse, error) {
+	out := new(MsgDeleteSubspaceResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/DeleteSubspace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMoveSectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ArchiveSubspace(ctx context.Context, in *MsgArchiveSubspace, opts ...grpc.CallOption) (*MsgArchiveSubspaceResponse, error) {
+	out := new(MsgArchiveSubspaceResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/ArchiveSubspace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgDeleteSection) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UnarchiveSubspace(ctx context.Context, in *MsgUnarchiveSubspace, opts ...grpc.CallOption) (*MsgUnarchiveSubspaceResponse, error) {
+	out := new(MsgUnarchiveSubspaceResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/UnarchiveSubspace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	return out, nil
+}
+
+func (c *msgClient) RequestSubspaceOwnerTransfer(ctx context.Context, in *MsgRequestSubspaceOwnerTransfer, opts ...grpc.CallOption) (*MsgRequestSubspaceOwnerTransferResponse, error) {
+	out := new(MsgRequestSubspaceOwnerTransferResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RequestSubspaceOwnerTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.SectionID != 0 {
-		n += 1 + sovMsgs(uint64(m.SectionID))
+	return out, nil
+}
+
+func (c *msgClient) CancelSubspaceOwnerTransferRequest(ctx context.Context, in *MsgCancelSubspaceOwnerTransferRequest, opts ...grpc.CallOption) (*MsgCancelSubspaceOwnerTransferRequestResponse, error) {
+	out := new(MsgCancelSubspaceOwnerTransferRequestResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/CancelSubspaceOwnerTransferRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) AcceptSubspaceOwnerTransferRequest(ctx context.Context, in *MsgAcceptSubspaceOwnerTransferRequest, opts ...grpc.CallOption) (*MsgAcceptSubspaceOwnerTransferRequestResponse, error) {
+	out := new(MsgAcceptSubspaceOwnerTransferRequestResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/AcceptSubspaceOwnerTransferRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgDeleteSectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RefuseSubspaceOwnerTransferRequest(ctx context.Context, in *MsgRefuseSubspaceOwnerTransferRequest, opts ...grpc.CallOption) (*MsgRefuseSubspaceOwnerTransferRequestResponse, error) {
+	out := new(MsgRefuseSubspaceOwnerTransferRequestResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RefuseSubspaceOwnerTransferRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateUserGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateSection(ctx context.Context, in *MsgCreateSection, opts ...grpc.CallOption) (*MsgCreateSectionResponse, error) {
+	out := new(MsgCreateSectionResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/CreateSection", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	return out, nil
+}
+
+func (c *msgClient) EditSection(ctx context.Context, in *MsgEditSection, opts ...grpc.CallOption) (*MsgEditSectionResponse, error) {
+	out := new(MsgEditSectionResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/EditSection", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.SectionID != 0 {
-		n += 1 + sovMsgs(uint64(m.SectionID))
+	return out, nil
+}
+
+func (c *msgClient) MoveSection(ctx context.Context, in *MsgMoveSection, opts ...grpc.CallOption) (*MsgMoveSectionResponse, error) {
+	out := new(MsgMoveSectionResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/MoveSection", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetSectionVisibility(ctx context.Context, in *MsgSetSectionVisibility, opts ...grpc.CallOption) (*MsgSetSectionVisibilityResponse, error) {
+	out := new(MsgSetSectionVisibilityResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/SetSectionVisibility", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DeleteSection(ctx context.Context, in *MsgDeleteSection, opts ...grpc.CallOption) (*MsgDeleteSectionResponse, error) {
+	out := new(MsgDeleteSectionResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/DeleteSection", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DefaultPermissions) > 0 {
-		for _, s := range m.DefaultPermissions {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
-		}
-	}
-	if len(m.InitialMembers) > 0 {
-		for _, s := range m.InitialMembers {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
-		}
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateUserGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupID != 0 {
-		n += 1 + sovMsgs(uint64(m.GroupID))
+func (c *msgClient) CreateUserGroup(ctx context.Context, in *MsgCreateUserGroup, opts ...grpc.CallOption) (*MsgCreateUserGroupResponse, error) {
+	out := new(MsgCreateUserGroupResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/CreateUserGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgEditUserGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
+func (c *msgClient) EditUserGroup(ctx context.Context, in *MsgEditUserGroup, opts ...grpc.CallOption) (*MsgEditUserGroupResponse, error) {
+	out := new(MsgEditUserGroupResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/EditUserGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GroupID != 0 {
-		n += 1 + sovMsgs(uint64(m.GroupID))
+	return out, nil
+}
+
+func (c *msgClient) MoveUserGroup(ctx context.Context, in *MsgMoveUserGroup, opts ...grpc.CallOption) (*MsgMoveUserGroupResponse, error) {
+	out := new(MsgMoveUserGroupResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/MoveUserGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetUserGroupPermissions(ctx context.Context, in *MsgSetUserGroupPermissions, opts ...grpc.CallOption) (*MsgSetUserGroupPermissionsResponse, error) {
+	out := new(MsgSetUserGroupPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/SetUserGroupPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DeleteUserGroup(ctx context.Context, in *MsgDeleteUserGroup, opts ...grpc.CallOption) (*MsgDeleteUserGroupResponse, error) {
+	out := new(MsgDeleteUserGroupResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/DeleteUserGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) AddUserToUserGroup(ctx context.Context, in *MsgAddUserToUserGroup, opts ...grpc.CallOption) (*MsgAddUserToUserGroupResponse, error) {
+	out := new(MsgAddUserToUserGroupResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/AddUserToUserGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgEditUserGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveUserFromUserGroup(ctx context.Context, in *MsgRemoveUserFromUserGroup, opts ...grpc.CallOption) (*MsgRemoveUserFromUserGroupResponse, error) {
+	out := new(MsgRemoveUserFromUserGroupResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RemoveUserFromUserGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgMoveUserGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateInvite(ctx context.Context, in *MsgCreateInvite, opts ...grpc.CallOption) (*MsgCreateInviteResponse, error) {
+	out := new(MsgCreateInviteResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/CreateInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	return out, nil
+}
+
+func (c *msgClient) DeleteInvite(ctx context.Context, in *MsgDeleteInvite, opts ...grpc.CallOption) (*MsgDeleteInviteResponse, error) {
+	out := new(MsgDeleteInviteResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/DeleteInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GroupID != 0 {
-		n += 1 + sovMsgs(uint64(m.GroupID))
+	return out, nil
+}
+
+func (c *msgClient) RedeemInvite(ctx context.Context, in *MsgRedeemInvite, opts ...grpc.CallOption) (*MsgRedeemInviteResponse, error) {
+	out := new(MsgRedeemInviteResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RedeemInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.NewSectionID != 0 {
-		n += 1 + sovMsgs(uint64(m.NewSectionID))
+	return out, nil
+}
+
+func (c *msgClient) ApplyToGroup(ctx context.Context, in *MsgApplyToGroup, opts ...grpc.CallOption) (*MsgApplyToGroupResponse, error) {
+	out := new(MsgApplyToGroupResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/ApplyToGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ApproveGroupApplication(ctx context.Context, in *MsgApproveGroupApplication, opts ...grpc.CallOption) (*MsgApproveGroupApplicationResponse, error) {
+	out := new(MsgApproveGroupApplicationResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/ApproveGroupApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMoveUserGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RejectGroupApplication(ctx context.Context, in *MsgRejectGroupApplication, opts ...grpc.CallOption) (*MsgRejectGroupApplicationResponse, error) {
+	out := new(MsgRejectGroupApplicationResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RejectGroupApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSetUserGroupPermissions) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetUserPermissions(ctx context.Context, in *MsgSetUserPermissions, opts ...grpc.CallOption) (*MsgSetUserPermissionsResponse, error) {
+	out := new(MsgSetUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/SetUserPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	return out, nil
+}
+
+func (c *msgClient) BanUser(ctx context.Context, in *MsgBanUser, opts ...grpc.CallOption) (*MsgBanUserResponse, error) {
+	out := new(MsgBanUserResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/BanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GroupID != 0 {
-		n += 1 + sovMsgs(uint64(m.GroupID))
+	return out, nil
+}
+
+func (c *msgClient) UnbanUser(ctx context.Context, in *MsgUnbanUser, opts ...grpc.CallOption) (*MsgUnbanUserResponse, error) {
+	out := new(MsgUnbanUserResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/UnbanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/SetRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RemoveRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSetUserGroupPermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetFeeTokensConfig(ctx context.Context, in *MsgSetFeeTokensConfig, opts ...grpc.CallOption) (*MsgSetFeeTokensConfigResponse, error) {
+	out := new(MsgSetFeeTokensConfigResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/SetFeeTokensConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgDeleteUserGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveFeeTokensConfig(ctx context.Context, in *MsgRemoveFeeTokensConfig, opts ...grpc.CallOption) (*MsgRemoveFeeTokensConfigResponse, error) {
+	out := new(MsgRemoveFeeTokensConfigResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RemoveFeeTokensConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	return out, nil
+}
+
+func (c *msgClient) GrantTreasuryAuthorization(ctx context.Context, in *MsgGrantTreasuryAuthorization, opts ...grpc.CallOption) (*MsgGrantTreasuryAuthorizationResponse, error) {
+	out := new(MsgGrantTreasuryAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/GrantTreasuryAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GroupID != 0 {
-		n += 1 + sovMsgs(uint64(m.GroupID))
+	return out, nil
+}
+
+func (c *msgClient) RevokeTreasuryAuthorization(ctx context.Context, in *MsgRevokeTreasuryAuthorization, opts ...grpc.CallOption) (*MsgRevokeTreasuryAuthorizationResponse, error) {
+	out := new(MsgRevokeTreasuryAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RevokeTreasuryAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetTreasuryVotingConfig(ctx context.Context, in *MsgSetTreasuryVotingConfig, opts ...grpc.CallOption) (*MsgSetTreasuryVotingConfigResponse, error) {
+	out := new(MsgSetTreasuryVotingConfigResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/SetTreasuryVotingConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgDeleteUserGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SubmitTreasuryProposal(ctx context.Context, in *MsgSubmitTreasuryProposal, opts ...grpc.CallOption) (*MsgSubmitTreasuryProposalResponse, error) {
+	out := new(MsgSubmitTreasuryProposalResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/SubmitTreasuryProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgAddUserToUserGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) VoteTreasuryProposal(ctx context.Context, in *MsgVoteTreasuryProposal, opts ...grpc.CallOption) (*MsgVoteTreasuryProposalResponse, error) {
+	out := new(MsgVoteTreasuryProposalResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/VoteTreasuryProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	if m.GroupID != 0 {
-		n += 1 + sovMsgs(uint64(m.GroupID))
-	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgAddUserToUserGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) GrantAllowance(ctx context.Context, in *MsgGrantAllowance, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error) {
+	out := new(MsgGrantAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/GrantAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgRemoveUserFromUserGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	if m.GroupID != 0 {
-		n += 1 + sovMsgs(uint64(m.GroupID))
-	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error) {
+	out := new(MsgRevokeAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/RevokeAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRemoveUserFromUserGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateSubspaceFeeTokens(ctx context.Context, in *MsgUpdateSubspaceFeeTokens, opts ...grpc.CallOption) (*MsgUpdateSubspaceFeeTokensResponse, error) {
+	out := new(MsgUpdateSubspaceFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Msg/UpdateSubspaceFeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSetUserPermissions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	if m.SectionID != 0 {
-		n += 1 + sovMsgs(uint64(m.SectionID))
-	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
-		}
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateSubspace allows to create a subspace
+	CreateSubspace(context.Context, *MsgCreateSubspace) (*MsgCreateSubspaceResponse, error)
+	// EditSubspace allows to edit a subspace
+	EditSubspace(context.Context, *MsgEditSubspace) (*MsgEditSubspaceResponse, error)
+	// DeleteSubspace allows to delete a subspace
+	DeleteSubspace(context.Context, *MsgDeleteSubspace) (*MsgDeleteSubspaceResponse, error)
+	// ArchiveSubspace allows the subspace owner to archive a subspace, making it
+	// read-only
+	ArchiveSubspace(context.Context, *MsgArchiveSubspace) (*MsgArchiveSubspaceResponse, error)
+	// UnarchiveSubspace allows the subspace owner to unarchive a previously
+	// archived subspace
+	UnarchiveSubspace(context.Context, *MsgUnarchiveSubspace) (*MsgUnarchiveSubspaceResponse, error)
+	// RequestSubspaceOwnerTransfer allows the subspace owner to send a request to
+	// transfer the subspace ownership to receiver
+	RequestSubspaceOwnerTransfer(context.Context, *MsgRequestSubspaceOwnerTransfer) (*MsgRequestSubspaceOwnerTransferResponse, error)
+	// CancelSubspaceOwnerTransferRequest allows sender to cancel an outgoing
+	// subspace owner transfer request
+	CancelSubspaceOwnerTransferRequest(context.Context, *MsgCancelSubspaceOwnerTransferRequest) (*MsgCancelSubspaceOwnerTransferRequestResponse, error)
+	// AcceptSubspaceOwnerTransferRequest allows receiver to accept an incoming
+	// subspace owner transfer request
+	AcceptSubspaceOwnerTransferRequest(context.Context, *MsgAcceptSubspaceOwnerTransferRequest) (*MsgAcceptSubspaceOwnerTransferRequestResponse, error)
+	// RefuseSubspaceOwnerTransferRequest allows receiver to refuse an incoming
+	// subspace owner transfer request
+	RefuseSubspaceOwnerTransferRequest(context.Context, *MsgRefuseSubspaceOwnerTransferRequest) (*MsgRefuseSubspaceOwnerTransferRequestResponse, error)
+	// CreateSection allows to create a new subspace section
+	CreateSection(context.Context, *MsgCreateSection) (*MsgCreateSectionResponse, error)
+	// EditSection allows to edit an existing section
+	EditSection(context.Context, *MsgEditSection) (*MsgEditSectionResponse, error)
+	// MoveSection allows to move an existing section to another parent
+	MoveSection(context.Context, *MsgMoveSection) (*MsgMoveSectionResponse, error)
+	// SetSectionVisibility allows to set the visibility of an existing section
+	SetSectionVisibility(context.Context, *MsgSetSectionVisibility) (*MsgSetSectionVisibilityResponse, error)
+	// DeleteSection allows to delete an existing section
+	DeleteSection(context.Context, *MsgDeleteSection) (*MsgDeleteSectionResponse, error)
+	// CreateUserGroup allows to create a user group
+	CreateUserGroup(context.Context, *MsgCreateUserGroup) (*MsgCreateUserGroupResponse, error)
+	// EditUserGroup allows to edit a user group
+	EditUserGroup(context.Context, *MsgEditUserGroup) (*MsgEditUserGroupResponse, error)
+	// MoveUserGroup allows to move a user group from a section to another
+	MoveUserGroup(context.Context, *MsgMoveUserGroup) (*MsgMoveUserGroupResponse, error)
+	// SetUserGroupPermissions allows to set the permissions for a specific group
+	SetUserGroupPermissions(context.Context, *MsgSetUserGroupPermissions) (*MsgSetUserGroupPermissionsResponse, error)
+	// DeleteUserGroup allows to delete an existing user group
+	DeleteUserGroup(context.Context, *MsgDeleteUserGroup) (*MsgDeleteUserGroupResponse, error)
+	// AddUserToUserGroup allows to add a specific user to a specific user group
+	AddUserToUserGroup(context.Context, *MsgAddUserToUserGroup) (*MsgAddUserToUserGroupResponse, error)
+	// RemoveUserFromUserGroup allows to remove a specific user from a specific
+	// user group
+	RemoveUserFromUserGroup(context.Context, *MsgRemoveUserFromUserGroup) (*MsgRemoveUserFromUserGroupResponse, error)
+	// CreateInvite allows to create an invite that can be redeemed to join a
+	// specific user group
+	CreateInvite(context.Context, *MsgCreateInvite) (*MsgCreateInviteResponse, error)
+	// DeleteInvite allows to delete an existing user group invite
+	DeleteInvite(context.Context, *MsgDeleteInvite) (*MsgDeleteInviteResponse, error)
+	// RedeemInvite allows a user to join a user group by redeeming an invite
+	RedeemInvite(context.Context, *MsgRedeemInvite) (*MsgRedeemInviteResponse, error)
+	// ApplyToGroup allows a user to apply to join a specific user group
+	ApplyToGroup(context.Context, *MsgApplyToGroup) (*MsgApplyToGroupResponse, error)
+	// ApproveGroupApplication allows to approve a user group application,
+	// adding the applicant to the group
+	ApproveGroupApplication(context.Context, *MsgApproveGroupApplication) (*MsgApproveGroupApplicationResponse, error)
+	// RejectGroupApplication allows to reject a user group application
+	RejectGroupApplication(context.Context, *MsgRejectGroupApplication) (*MsgRejectGroupApplicationResponse, error)
+	// SetUserPermissions allows to set the permissions for a specific user
+	SetUserPermissions(context.Context, *MsgSetUserPermissions) (*MsgSetUserPermissionsResponse, error)
+	// BanUser allows to ban a user from a subspace
+	BanUser(context.Context, *MsgBanUser) (*MsgBanUserResponse, error)
+	// UnbanUser allows to lift the ban of a user from a subspace
+	UnbanUser(context.Context, *MsgUnbanUser) (*MsgUnbanUserResponse, error)
+	// SetRateLimit allows to set the rate limit of a message type inside a
+	// subspace
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	// RemoveRateLimit allows to remove the rate limit of a message type from a
+	// subspace
+	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+	// SetFeeTokensConfig allows to set the conversion rates and the destination
+	// of the additional fee tokens accepted inside a subspace
+	SetFeeTokensConfig(context.Context, *MsgSetFeeTokensConfig) (*MsgSetFeeTokensConfigResponse, error)
+	// RemoveFeeTokensConfig allows to remove the fee tokens configuration of a
+	// subspace
+	RemoveFeeTokensConfig(context.Context, *MsgRemoveFeeTokensConfig) (*MsgRemoveFeeTokensConfigResponse, error)
+	// GrantTreasuryAuthorization allows managers who have the permission to grant
+	// a treasury authorization to a user
+	GrantTreasuryAuthorization(context.Context, *MsgGrantTreasuryAuthorization) (*MsgGrantTreasuryAuthorizationResponse, error)
+	// RevokeTreasuryAuthorization allows managers who have the permission to
+	// revoke an existing treasury authorization
+	RevokeTreasuryAuthorization(context.Context, *MsgRevokeTreasuryAuthorization) (*MsgRevokeTreasuryAuthorizationResponse, error)
+	// SetTreasuryVotingConfig allows managers who have the permission to set
+	// the user group and the threshold used to vote treasury proposals
+	SetTreasuryVotingConfig(context.Context, *MsgSetTreasuryVotingConfig) (*MsgSetTreasuryVotingConfigResponse, error)
+	// SubmitTreasuryProposal allows members of the voting group to submit a
+	// proposal to execute a set of messages using the treasury as signer
+	SubmitTreasuryProposal(context.Context, *MsgSubmitTreasuryProposal) (*MsgSubmitTreasuryProposalResponse, error)
+	// VoteTreasuryProposal allows members of the voting group to vote a
+	// treasury proposal
+	VoteTreasuryProposal(context.Context, *MsgVoteTreasuryProposal) (*MsgVoteTreasuryProposalResponse, error)
+	// GrantAllowance allows the granter to grant a fee allowance to the
+	// grantee
+	GrantAllowance(context.Context, *MsgGrantAllowance) (*MsgGrantAllowanceResponse, error)
+	// RevokeAllowance allows a granter to revoke any existing treasury allowance
+	// that has been granted to the grantee
+	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// UpdateSubspaceFeeTokens allows subspace admins to update the list of fee
+	// tokens inside the subspace via a governance proposal
+	//
+	// Since: Desmos 6.0.0
+	UpdateSubspaceFeeTokens(context.Context, *MsgUpdateSubspaceFeeTokens) (*MsgUpdateSubspaceFeeTokensResponse, error)
 }
 
-func (m *MsgSetUserPermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgGrantAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.Grantee != nil {
-		l = m.Grantee.Size()
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServer) CreateSubspace(ctx context.Context, req *MsgCreateSubspace) (*MsgCreateSubspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubspace not implemented")
 }
-
-func (m *MsgGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServer) EditSubspace(ctx context.Context, req *MsgEditSubspace) (*MsgEditSubspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditSubspace not implemented")
 }
-
-func (m *MsgRevokeAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.Grantee != nil {
-		l = m.Grantee.Size()
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServer) DeleteSubspace(ctx context.Context, req *MsgDeleteSubspace) (*MsgDeleteSubspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubspace not implemented")
 }
-
-func (m *MsgRevokeAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServer) ArchiveSubspace(ctx context.Context, req *MsgArchiveSubspace) (*MsgArchiveSubspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveSubspace not implemented")
 }
-
-func (m *MsgGrantTreasuryAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.Grant.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+func (*UnimplementedMsgServer) UnarchiveSubspace(ctx context.Context, req *MsgUnarchiveSubspace) (*MsgUnarchiveSubspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveSubspace not implemented")
 }
-
-func (m *MsgGrantTreasuryAuthorizationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServer) RequestSubspaceOwnerTransfer(ctx context.Context, req *MsgRequestSubspaceOwnerTransfer) (*MsgRequestSubspaceOwnerTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSubspaceOwnerTransfer not implemented")
 }
-
-func (m *MsgRevokeTreasuryAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
-	}
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (*UnimplementedMsgServer) CancelSubspaceOwnerTransferRequest(ctx context.Context, req *MsgCancelSubspaceOwnerTransferRequest) (*MsgCancelSubspaceOwnerTransferRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubspaceOwnerTransferRequest not implemented")
+}
+func (*UnimplementedMsgServer) AcceptSubspaceOwnerTransferRequest(ctx context.Context, req *MsgAcceptSubspaceOwnerTransferRequest) (*MsgAcceptSubspaceOwnerTransferRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSubspaceOwnerTransferRequest not implemented")
+}
+func (*UnimplementedMsgServer) RefuseSubspaceOwnerTransferRequest(ctx context.Context, req *MsgRefuseSubspaceOwnerTransferRequest) (*MsgRefuseSubspaceOwnerTransferRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefuseSubspaceOwnerTransferRequest not implemented")
+}
+func (*UnimplementedMsgServer) CreateSection(ctx context.Context, req *MsgCreateSection) (*MsgCreateSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSection not implemented")
+}
+func (*UnimplementedMsgServer) EditSection(ctx context.Context, req *MsgEditSection) (*MsgEditSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditSection not implemented")
+}
+func (*UnimplementedMsgServer) MoveSection(ctx context.Context, req *MsgMoveSection) (*MsgMoveSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveSection not implemented")
+}
+func (*UnimplementedMsgServer) SetSectionVisibility(ctx context.Context, req *MsgSetSectionVisibility) (*MsgSetSectionVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSectionVisibility not implemented")
+}
+func (*UnimplementedMsgServer) DeleteSection(ctx context.Context, req *MsgDeleteSection) (*MsgDeleteSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSection not implemented")
+}
+func (*UnimplementedMsgServer) CreateUserGroup(ctx context.Context, req *MsgCreateUserGroup) (*MsgCreateUserGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserGroup not implemented")
+}
+func (*UnimplementedMsgServer) EditUserGroup(ctx context.Context, req *MsgEditUserGroup) (*MsgEditUserGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditUserGroup not implemented")
+}
+func (*UnimplementedMsgServer) MoveUserGroup(ctx context.Context, req *MsgMoveUserGroup) (*MsgMoveUserGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveUserGroup not implemented")
+}
+func (*UnimplementedMsgServer) SetUserGroupPermissions(ctx context.Context, req *MsgSetUserGroupPermissions) (*MsgSetUserGroupPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserGroupPermissions not implemented")
+}
+func (*UnimplementedMsgServer) DeleteUserGroup(ctx context.Context, req *MsgDeleteUserGroup) (*MsgDeleteUserGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserGroup not implemented")
+}
+func (*UnimplementedMsgServer) AddUserToUserGroup(ctx context.Context, req *MsgAddUserToUserGroup) (*MsgAddUserToUserGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserToUserGroup not implemented")
+}
+func (*UnimplementedMsgServer) RemoveUserFromUserGroup(ctx context.Context, req *MsgRemoveUserFromUserGroup) (*MsgRemoveUserFromUserGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromUserGroup not implemented")
+}
+func (*UnimplementedMsgServer) CreateInvite(ctx context.Context, req *MsgCreateInvite) (*MsgCreateInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (*UnimplementedMsgServer) DeleteInvite(ctx context.Context, req *MsgDeleteInvite) (*MsgDeleteInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInvite not implemented")
+}
+func (*UnimplementedMsgServer) RedeemInvite(ctx context.Context, req *MsgRedeemInvite) (*MsgRedeemInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemInvite not implemented")
+}
+func (*UnimplementedMsgServer) ApplyToGroup(ctx context.Context, req *MsgApplyToGroup) (*MsgApplyToGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyToGroup not implemented")
+}
+func (*UnimplementedMsgServer) ApproveGroupApplication(ctx context.Context, req *MsgApproveGroupApplication) (*MsgApproveGroupApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveGroupApplication not implemented")
+}
+func (*UnimplementedMsgServer) RejectGroupApplication(ctx context.Context, req *MsgRejectGroupApplication) (*MsgRejectGroupApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectGroupApplication not implemented")
+}
+func (*UnimplementedMsgServer) SetUserPermissions(ctx context.Context, req *MsgSetUserPermissions) (*MsgSetUserPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserPermissions not implemented")
+}
+func (*UnimplementedMsgServer) BanUser(ctx context.Context, req *MsgBanUser) (*MsgBanUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (*UnimplementedMsgServer) UnbanUser(ctx context.Context, req *MsgUnbanUser) (*MsgUnbanUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanUser not implemented")
+}
+func (*UnimplementedMsgServer) SetRateLimit(ctx context.Context, req *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRateLimit(ctx context.Context, req *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeTokensConfig(ctx context.Context, req *MsgSetFeeTokensConfig) (*MsgSetFeeTokensConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTokensConfig not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFeeTokensConfig(ctx context.Context, req *MsgRemoveFeeTokensConfig) (*MsgRemoveFeeTokensConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeTokensConfig not implemented")
+}
+func (*UnimplementedMsgServer) GrantTreasuryAuthorization(ctx context.Context, req *MsgGrantTreasuryAuthorization) (*MsgGrantTreasuryAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantTreasuryAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) RevokeTreasuryAuthorization(ctx context.Context, req *MsgRevokeTreasuryAuthorization) (*MsgRevokeTreasuryAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTreasuryAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) SetTreasuryVotingConfig(ctx context.Context, req *MsgSetTreasuryVotingConfig) (*MsgSetTreasuryVotingConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTreasuryVotingConfig not implemented")
+}
+func (*UnimplementedMsgServer) SubmitTreasuryProposal(ctx context.Context, req *MsgSubmitTreasuryProposal) (*MsgSubmitTreasuryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTreasuryProposal not implemented")
+}
+func (*UnimplementedMsgServer) VoteTreasuryProposal(ctx context.Context, req *MsgVoteTreasuryProposal) (*MsgVoteTreasuryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTreasuryProposal not implemented")
+}
+func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrantAllowance) (*MsgGrantAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowance not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSubspaceFeeTokens(ctx context.Context, req *MsgUpdateSubspaceFeeTokens) (*MsgUpdateSubspaceFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubspaceFeeTokens not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateSubspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSubspace)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSubspace(ctx, in)
 	}
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/CreateSubspace",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSubspace(ctx, req.(*MsgCreateSubspace))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevokeTreasuryAuthorizationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_EditSubspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditSubspace)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).EditSubspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/EditSubspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditSubspace(ctx, req.(*MsgEditSubspace))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSubspaceFeeTokens) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteSubspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteSubspace)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SubspaceID != 0 {
-		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteSubspace(ctx, in)
 	}
-	if len(m.AdditionalFeeTokens) > 0 {
-		for _, e := range m.AdditionalFeeTokens {
-			l = e.Size()
-			n += 1 + l + sovMsgs(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/DeleteSubspace",
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteSubspace(ctx, req.(*MsgDeleteSubspace))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSubspaceFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ArchiveSubspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgArchiveSubspace)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ArchiveSubspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/ArchiveSubspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArchiveSubspace(ctx, req.(*MsgArchiveSubspace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnarchiveSubspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnarchiveSubspace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnarchiveSubspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/UnarchiveSubspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnarchiveSubspace(ctx, req.(*MsgUnarchiveSubspace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestSubspaceOwnerTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestSubspaceOwnerTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestSubspaceOwnerTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/RequestSubspaceOwnerTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestSubspaceOwnerTransfer(ctx, req.(*MsgRequestSubspaceOwnerTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSubspaceOwnerTransferRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSubspaceOwnerTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSubspaceOwnerTransferRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/CancelSubspaceOwnerTransferRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSubspaceOwnerTransferRequest(ctx, req.(*MsgCancelSubspaceOwnerTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptSubspaceOwnerTransferRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptSubspaceOwnerTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptSubspaceOwnerTransferRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/AcceptSubspaceOwnerTransferRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptSubspaceOwnerTransferRequest(ctx, req.(*MsgAcceptSubspaceOwnerTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefuseSubspaceOwnerTransferRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefuseSubspaceOwnerTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefuseSubspaceOwnerTransferRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/RefuseSubspaceOwnerTransferRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefuseSubspaceOwnerTransferRequest(ctx, req.(*MsgRefuseSubspaceOwnerTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/CreateSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSection(ctx, req.(*MsgCreateSection))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func _Msg_EditSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditSection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/EditSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditSection(ctx, req.(*MsgEditSection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MoveSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMoveSection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MoveSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/MoveSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MoveSection(ctx, req.(*MsgMoveSection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSectionVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSectionVisibility)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSectionVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/SetSectionVisibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSectionVisibility(ctx, req.(*MsgSetSectionVisibility))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteSection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/DeleteSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteSection(ctx, req.(*MsgDeleteSection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateUserGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateUserGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/CreateUserGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateUserGroup(ctx, req.(*MsgCreateUserGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditUserGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditUserGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/EditUserGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditUserGroup(ctx, req.(*MsgEditUserGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MoveUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMoveUserGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MoveUserGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/MoveUserGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MoveUserGroup(ctx, req.(*MsgMoveUserGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUserGroupPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUserGroupPermissions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUserGroupPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/SetUserGroupPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUserGroupPermissions(ctx, req.(*MsgSetUserGroupPermissions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteUserGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteUserGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/DeleteUserGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteUserGroup(ctx, req.(*MsgDeleteUserGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddUserToUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddUserToUserGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddUserToUserGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/AddUserToUserGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddUserToUserGroup(ctx, req.(*MsgAddUserToUserGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveUserFromUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveUserFromUserGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveUserFromUserGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/RemoveUserFromUserGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveUserFromUserGroup(ctx, req.(*MsgRemoveUserFromUserGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateInvite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/CreateInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateInvite(ctx, req.(*MsgCreateInvite))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteInvite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/DeleteInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteInvite(ctx, req.(*MsgDeleteInvite))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemInvite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/RedeemInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemInvite(ctx, req.(*MsgRedeemInvite))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApplyToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApplyToGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApplyToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/ApplyToGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApplyToGroup(ctx, req.(*MsgApplyToGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveGroupApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveGroupApplication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveGroupApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/ApproveGroupApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveGroupApplication(ctx, req.(*MsgApproveGroupApplication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectGroupApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectGroupApplication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectGroupApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/RejectGroupApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectGroupApplication(ctx, req.(*MsgRejectGroupApplication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUserPermissions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/SetUserPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUserPermissions(ctx, req.(*MsgSetUserPermissions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBanUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/BanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BanUser(ctx, req.(*MsgBanUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbanUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/UnbanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbanUser(ctx, req.(*MsgUnbanUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/SetRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/RemoveRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeTokensConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTokensConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTokensConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/SetFeeTokensConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTokensConfig(ctx, req.(*MsgSetFeeTokensConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeTokensConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeTokensConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeTokensConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/RemoveFeeTokensConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeTokensConfig(ctx, req.(*MsgRemoveFeeTokensConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantTreasuryAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantTreasuryAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantTreasuryAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/GrantTreasuryAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantTreasuryAuthorization(ctx, req.(*MsgGrantTreasuryAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeTreasuryAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeTreasuryAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeTreasuryAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/RevokeTreasuryAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeTreasuryAuthorization(ctx, req.(*MsgRevokeTreasuryAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTreasuryVotingConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTreasuryVotingConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTreasuryVotingConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/SetTreasuryVotingConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTreasuryVotingConfig(ctx, req.(*MsgSetTreasuryVotingConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitTreasuryProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitTreasuryProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitTreasuryProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/SubmitTreasuryProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitTreasuryProposal(ctx, req.(*MsgSubmitTreasuryProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteTreasuryProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteTreasuryProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteTreasuryProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/VoteTreasuryProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteTreasuryProposal(ctx, req.(*MsgVoteTreasuryProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/GrantAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAllowance(ctx, req.(*MsgGrantAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/RevokeAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAllowance(ctx, req.(*MsgRevokeAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSubspaceFeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSubspaceFeeTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSubspaceFeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Msg/UpdateSubspaceFeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSubspaceFeeTokens(ctx, req.(*MsgUpdateSubspaceFeeTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "desmos.subspaces.v3.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSubspace",
+			Handler:    _Msg_CreateSubspace_Handler,
+		},
+		{
+			MethodName: "EditSubspace",
+			Handler:    _Msg_EditSubspace_Handler,
+		},
+		{
+			MethodName: "DeleteSubspace",
+			Handler:    _Msg_DeleteSubspace_Handler,
+		},
+		{
+			MethodName: "ArchiveSubspace",
+			Handler:    _Msg_ArchiveSubspace_Handler,
+		},
+		{
+			MethodName: "UnarchiveSubspace",
+			Handler:    _Msg_UnarchiveSubspace_Handler,
+		},
+		{
+			MethodName: "RequestSubspaceOwnerTransfer",
+			Handler:    _Msg_RequestSubspaceOwnerTransfer_Handler,
+		},
+		{
+			MethodName: "CancelSubspaceOwnerTransferRequest",
+			Handler:    _Msg_CancelSubspaceOwnerTransferRequest_Handler,
+		},
+		{
+			MethodName: "AcceptSubspaceOwnerTransferRequest",
+			Handler:    _Msg_AcceptSubspaceOwnerTransferRequest_Handler,
+		},
+		{
+			MethodName: "RefuseSubspaceOwnerTransferRequest",
+			Handler:    _Msg_RefuseSubspaceOwnerTransferRequest_Handler,
+		},
+		{
+			MethodName: "CreateSection",
+			Handler:    _Msg_CreateSection_Handler,
+		},
+		{
+			MethodName: "EditSection",
+			Handler:    _Msg_EditSection_Handler,
+		},
+		{
+			MethodName: "MoveSection",
+			Handler:    _Msg_MoveSection_Handler,
+		},
+		{
+			MethodName: "SetSectionVisibility",
+			Handler:    _Msg_SetSectionVisibility_Handler,
+		},
+		{
+			MethodName: "DeleteSection",
+			Handler:    _Msg_DeleteSection_Handler,
+		},
+		{
+			MethodName: "CreateUserGroup",
+			Handler:    _Msg_CreateUserGroup_Handler,
+		},
+		{
+			MethodName: "EditUserGroup",
+			Handler:    _Msg_EditUserGroup_Handler,
+		},
+		{
+			MethodName: "MoveUserGroup",
+			Handler:    _Msg_MoveUserGroup_Handler,
+		},
+		{
+			MethodName: "SetUserGroupPermissions",
+			Handler:    _Msg_SetUserGroupPermissions_Handler,
+		},
+		{
+			MethodName: "DeleteUserGroup",
+			Handler:    _Msg_DeleteUserGroup_Handler,
+		},
+		{
+			MethodName: "AddUserToUserGroup",
+			Handler:    _Msg_AddUserToUserGroup_Handler,
+		},
+		{
+			MethodName: "RemoveUserFromUserGroup",
+			Handler:    _Msg_RemoveUserFromUserGroup_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _Msg_CreateInvite_Handler,
+		},
+		{
+			MethodName: "DeleteInvite",
+			Handler:    _Msg_DeleteInvite_Handler,
+		},
+		{
+			MethodName: "RedeemInvite",
+			Handler:    _Msg_RedeemInvite_Handler,
+		},
+		{
+			MethodName: "ApplyToGroup",
+			Handler:    _Msg_ApplyToGroup_Handler,
+		},
+		{
+			MethodName: "ApproveGroupApplication",
+			Handler:    _Msg_ApproveGroupApplication_Handler,
+		},
+		{
+			MethodName: "RejectGroupApplication",
+			Handler:    _Msg_RejectGroupApplication_Handler,
+		},
+		{
+			MethodName: "SetUserPermissions",
+			Handler:    _Msg_SetUserPermissions_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _Msg_BanUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _Msg_UnbanUser_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimit",
+			Handler:    _Msg_RemoveRateLimit_Handler,
+		},
+		{
+			MethodName: "SetFeeTokensConfig",
+			Handler:    _Msg_SetFeeTokensConfig_Handler,
+		},
+		{
+			MethodName: "RemoveFeeTokensConfig",
+			Handler:    _Msg_RemoveFeeTokensConfig_Handler,
+		},
+		{
+			MethodName: "GrantTreasuryAuthorization",
+			Handler:    _Msg_GrantTreasuryAuthorization_Handler,
+		},
+		{
+			MethodName: "RevokeTreasuryAuthorization",
+			Handler:    _Msg_RevokeTreasuryAuthorization_Handler,
+		},
+		{
+			MethodName: "SetTreasuryVotingConfig",
+			Handler:    _Msg_SetTreasuryVotingConfig_Handler,
+		},
+		{
+			MethodName: "SubmitTreasuryProposal",
+			Handler:    _Msg_SubmitTreasuryProposal_Handler,
+		},
+		{
+			MethodName: "VoteTreasuryProposal",
+			Handler:    _Msg_VoteTreasuryProposal_Handler,
+		},
+		{
+			MethodName: "GrantAllowance",
+			Handler:    _Msg_GrantAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeAllowance",
+			Handler:    _Msg_RevokeAllowance_Handler,
+		},
+		{
+			MethodName: "UpdateSubspaceFeeTokens",
+			Handler:    _Msg_UpdateSubspaceFeeTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "desmos/subspaces/v3/msgs.proto",
+}
+
+func (m *MsgCreateSubspace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSubspace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSubspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSubspaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSubspaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSubspaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditSubspace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditSubspace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditSubspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditSubspaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditSubspaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditSubspaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSubspace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSubspace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSubspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSubspaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSubspaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSubspaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveSubspace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveSubspace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveSubspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveSubspaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveSubspaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveSubspaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnarchiveSubspace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnarchiveSubspace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnarchiveSubspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnarchiveSubspaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnarchiveSubspaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnarchiveSubspaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSubspaceOwnerTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSubspaceOwnerTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSubspaceOwnerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSubspaceOwnerTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSubspaceOwnerTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSubspaceOwnerTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSubspaceOwnerTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSubspaceOwnerTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSubspaceOwnerTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptSubspaceOwnerTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptSubspaceOwnerTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptSubspaceOwnerTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefuseSubspaceOwnerTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefuseSubspaceOwnerTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefuseSubspaceOwnerTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Visibility != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ParentID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ParentID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SectionID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditSection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditSection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SectionID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditSectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditSectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditSectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMoveSection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMoveSection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMoveSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewParentID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.NewParentID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SectionID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMoveSectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMoveSectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMoveSectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSectionVisibility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSectionVisibility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSectionVisibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SectionID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSectionVisibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSectionVisibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSectionVisibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SectionID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateUserGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateUserGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.InitialMembers) > 0 {
+		for iNdEx := len(m.InitialMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InitialMembers[iNdEx])
+			copy(dAtA[i:], m.InitialMembers[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.InitialMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DefaultPermissions) > 0 {
+		for iNdEx := len(m.DefaultPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DefaultPermissions[iNdEx])
+			copy(dAtA[i:], m.DefaultPermissions[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.DefaultPermissions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SectionID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateUserGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditUserGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditUserGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditUserGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMoveUserGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMoveUserGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMoveUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewSectionID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.NewSectionID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMoveUserGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMoveUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMoveUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUserGroupPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUserGroupPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUserGroupPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUserGroupPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUserGroupPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUserGroupPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteUserGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteUserGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteUserGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddUserToUserGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddUserToUserGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddUserToUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMsgs(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddUserToUserGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddUserToUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddUserToUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveUserFromUserGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveUserFromUserGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveUserFromUserGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveUserFromUserGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveUserFromUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveUserFromUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpirationTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMsgs(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SecretHash) > 0 {
+		i -= len(m.SecretHash)
+		copy(dAtA[i:], m.SecretHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SecretHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateInviteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateInviteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateInviteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SecretHash) > 0 {
+		i -= len(m.SecretHash)
+		copy(dAtA[i:], m.SecretHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SecretHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteInviteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteInviteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteInviteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemInviteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemInviteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemInviteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApplyToGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApplyToGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApplyToGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Applicant) > 0 {
+		i -= len(m.Applicant)
+		copy(dAtA[i:], m.Applicant)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Applicant)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApplyToGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApplyToGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApplyToGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveGroupApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveGroupApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveGroupApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Applicant) > 0 {
+		i -= len(m.Applicant)
+		copy(dAtA[i:], m.Applicant)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Applicant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveGroupApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveGroupApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveGroupApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectGroupApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectGroupApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectGroupApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Applicant) > 0 {
+		i -= len(m.Applicant)
+		copy(dAtA[i:], m.Applicant)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Applicant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectGroupApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectGroupApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectGroupApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUserPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUserPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUserPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMsgs(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SectionID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SectionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUserPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUserPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUserPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBanUser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBanUser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBanUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpirationTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMsgs(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBanUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBanUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBanUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbanUser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbanUser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbanUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbanUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbanUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbanUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintMsgs(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxMessages != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.MaxMessages))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokensConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokensConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokensConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Destination != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokensConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokensConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokensConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeTokensConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeTokensConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeTokensConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeTokensConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeTokensConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeTokensConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Grantee != nil {
+		{
+			size, err := m.Grantee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Grantee != nil {
+		{
+			size, err := m.Grantee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantTreasuryAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantTreasuryAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantTreasuryAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantTreasuryAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantTreasuryAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantTreasuryAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeTreasuryAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeTreasuryAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeTreasuryAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeTreasuryAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeTreasuryAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeTreasuryAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTreasuryVotingConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTreasuryVotingConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTreasuryVotingConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quorum != nil {
+		{
+			size := m.Quorum.Size()
+			i -= size
+			if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintMsgs(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GroupID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTreasuryVotingConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTreasuryVotingConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTreasuryVotingConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitTreasuryProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitTreasuryProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitTreasuryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitTreasuryProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitTreasuryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitTreasuryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteTreasuryProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteTreasuryProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteTreasuryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Option != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteTreasuryProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteTreasuryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteTreasuryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSubspaceFeeTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSubspaceFeeTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSubspaceFeeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AdditionalFeeTokens) > 0 {
+		for iNdEx := len(m.AdditionalFeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalFeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SubspaceID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SubspaceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSubspaceFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSubspaceFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSubspaceFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateSubspace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateSubspaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	return n
+}
+
+func (m *MsgEditSubspace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditSubspaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteSubspace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteSubspaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgArchiveSubspace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgArchiveSubspaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnarchiveSubspace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnarchiveSubspaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestSubspaceOwnerTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestSubspaceOwnerTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelSubspaceOwnerTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptSubspaceOwnerTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefuseSubspaceOwnerTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ParentID != 0 {
+		n += 1 + sovMsgs(uint64(m.ParentID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovMsgs(uint64(m.Visibility))
+	}
+	return n
+}
+
+func (m *MsgCreateSectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SectionID != 0 {
+		n += 1 + sovMsgs(uint64(m.SectionID))
+	}
+	return n
+}
+
+func (m *MsgEditSection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.SectionID != 0 {
+		n += 1 + sovMsgs(uint64(m.SectionID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditSectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMoveSection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.SectionID != 0 {
+		n += 1 + sovMsgs(uint64(m.SectionID))
+	}
+	if m.NewParentID != 0 {
+		n += 1 + sovMsgs(uint64(m.NewParentID))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMoveSectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSectionVisibility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.SectionID != 0 {
+		n += 1 + sovMsgs(uint64(m.SectionID))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovMsgs(uint64(m.Visibility))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetSectionVisibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteSection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.SectionID != 0 {
+		n += 1 + sovMsgs(uint64(m.SectionID))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteSectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateUserGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.SectionID != 0 {
+		n += 1 + sovMsgs(uint64(m.SectionID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.DefaultPermissions) > 0 {
+		for _, s := range m.DefaultPermissions {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.InitialMembers) > 0 {
+		for _, s := range m.InitialMembers {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateUserGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	return n
+}
+
+func (m *MsgEditUserGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditUserGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMoveUserGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	if m.NewSectionID != 0 {
+		n += 1 + sovMsgs(uint64(m.NewSectionID))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMoveUserGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetUserGroupPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetUserGroupPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteUserGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteUserGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddUserToUserGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddUserToUserGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveUserFromUserGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveUserFromUserGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	l = len(m.SecretHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovMsgs(uint64(m.MaxUses))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateInviteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.SecretHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteInviteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemInviteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApplyToGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Applicant)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApplyToGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveGroupApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	l = len(m.Applicant)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveGroupApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectGroupApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	l = len(m.Applicant)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectGroupApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetUserPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.SectionID != 0 {
+		n += 1 + sovMsgs(uint64(m.SectionID))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetUserPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBanUser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBanUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbanUser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbanUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.MaxMessages != 0 {
+		n += 1 + sovMsgs(uint64(m.MaxMessages))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeTokensConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if m.Destination != 0 {
+		n += 1 + sovMsgs(uint64(m.Destination))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeeTokensConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeeTokensConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeeTokensConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Grantee != nil {
+		l = m.Grantee.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Grantee != nil {
+		l = m.Grantee.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantTreasuryAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Grant.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgGrantTreasuryAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeTreasuryAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeTreasuryAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTreasuryVotingConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovMsgs(uint64(m.GroupID))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTreasuryVotingConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitTreasuryProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitTreasuryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovMsgs(uint64(m.ProposalID))
+	}
+	return n
+}
+
+func (m *MsgVoteTreasuryProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if m.ProposalID != 0 {
+		n += 1 + sovMsgs(uint64(m.ProposalID))
+	}
+	if m.Option != 0 {
+		n += 1 + sovMsgs(uint64(m.Option))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteTreasuryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSubspaceFeeTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceID != 0 {
+		n += 1 + sovMsgs(uint64(m.SubspaceID))
+	}
+	if len(m.AdditionalFeeTokens) > 0 {
+		for _, e := range m.AdditionalFeeTokens {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSubspaceFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateSubspace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSubspace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSubspace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSubspaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSubspaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSubspaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditSubspace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSubspace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSubspace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditSubspaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSubspaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSubspaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteSubspace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSubspace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSubspace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteSubspaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSubspaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSubspaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArchiveSubspace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArchiveSubspace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArchiveSubspace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArchiveSubspaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArchiveSubspaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArchiveSubspaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnarchiveSubspace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnarchiveSubspace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnarchiveSubspace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnarchiveSubspaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnarchiveSubspaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnarchiveSubspaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestSubspaceOwnerTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestSubspaceOwnerTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestSubspaceOwnerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestSubspaceOwnerTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestSubspaceOwnerTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestSubspaceOwnerTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSubspaceOwnerTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSubspaceOwnerTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSubspaceOwnerTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSubspaceOwnerTransferRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSubspaceOwnerTransferRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSubspaceOwnerTransferRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptSubspaceOwnerTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptSubspaceOwnerTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptSubspaceOwnerTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptSubspaceOwnerTransferRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptSubspaceOwnerTransferRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptSubspaceOwnerTransferRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefuseSubspaceOwnerTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefuseSubspaceOwnerTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefuseSubspaceOwnerTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefuseSubspaceOwnerTransferRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefuseSubspaceOwnerTransferRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefuseSubspaceOwnerTransferRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentID", wireType)
+			}
+			m.ParentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= SectionVisibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+			}
+			m.SectionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditSection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+			}
+			m.SectionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditSectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMoveSection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMoveSection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMoveSection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+			}
+			m.SectionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParentID", wireType)
+			}
+			m.NewParentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewParentID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMoveSectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMoveSectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMoveSectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSectionVisibility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSectionVisibility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSectionVisibility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+			}
+			m.SectionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= SectionVisibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSectionVisibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSectionVisibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSectionVisibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteSection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+			}
+			m.SectionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteSectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateUserGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateUserGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+			}
+			m.SectionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPermissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultPermissions = append(m.DefaultPermissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialMembers = append(m.InitialMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateUserGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateUserGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditUserGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditUserGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditUserGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditUserGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMoveUserGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMoveUserGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMoveUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSectionID", wireType)
+			}
+			m.NewSectionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewSectionID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMoveUserGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMoveUserGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMoveUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetUserGroupPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetUserGroupPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetUserGroupPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetUserGroupPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetUserGroupPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetUserGroupPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteUserGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteUserGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteUserGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteUserGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddUserToUserGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddUserToUserGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddUserToUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddUserToUserGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddUserToUserGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddUserToUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveUserFromUserGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveUserFromUserGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveUserFromUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveUserFromUserGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveUserFromUserGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveUserFromUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateInvite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateInvite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateInvite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
+			}
+			m.SubspaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateInviteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateInviteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateInviteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateSubspace) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteInvite) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5497,17 +15607,17 @@ func (m *MsgCreateSubspace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSubspace: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteInvite: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSubspace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteInvite: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
 			}
-			var stringLen uint64
+			m.SubspaceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5517,27 +15627,14 @@ func (m *MsgCreateSubspace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SubspaceID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5565,43 +15662,11 @@ func (m *MsgCreateSubspace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.SecretHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5629,7 +15694,7 @@ func (m *MsgCreateSubspace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5652,7 +15717,7 @@ func (m *MsgCreateSubspace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateSubspaceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteInviteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5675,31 +15740,12 @@ func (m *MsgCreateSubspaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSubspaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteInviteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSubspaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteInviteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceID", wireType)
-			}
-			m.SubspaceID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubspaceID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5721,7 +15767,7 @@ func (m *MsgCreateSubspaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditSubspace) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemInvite) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5744,10 +15790,10 @@ func (m *MsgEditSubspace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSubspace: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemInvite: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSubspace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemInvite: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5771,9 +15817,9 @@ func (m *MsgEditSubspace) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5783,29 +15829,31 @@ func (m *MsgEditSubspace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5815,59 +15863,29 @@ func (m *MsgEditSubspace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5895,7 +15913,7 @@ func (m *MsgEditSubspace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5918,7 +15936,7 @@ func (m *MsgEditSubspace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditSubspaceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemInviteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5941,10 +15959,10 @@ func (m *MsgEditSubspaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSubspaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemInviteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSubspaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemInviteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5968,7 +15986,7 @@ func (m *MsgEditSubspaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSubspace) Unmarshal(dAtA []byte) error {
+func (m *MsgApplyToGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5991,10 +16009,10 @@ func (m *MsgDeleteSubspace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSubspace: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApplyToGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSubspace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApplyToGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6017,8 +16035,27 @@ func (m *MsgDeleteSubspace) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6046,7 +16083,39 @@ func (m *MsgDeleteSubspace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applicant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applicant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6069,7 +16138,7 @@ func (m *MsgDeleteSubspace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSubspaceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApplyToGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6092,10 +16161,10 @@ func (m *MsgDeleteSubspaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSubspaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApplyToGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSubspaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApplyToGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6119,7 +16188,7 @@ func (m *MsgDeleteSubspaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateSection) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveGroupApplication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6142,10 +16211,10 @@ func (m *MsgCreateSection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSection: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveGroupApplication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveGroupApplication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6168,10 +16237,10 @@ func (m *MsgCreateSection) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
 			}
-			var stringLen uint64
+			m.GroupID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6181,27 +16250,14 @@ func (m *MsgCreateSection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GroupID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applicant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6229,30 +16285,11 @@ func (m *MsgCreateSection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Applicant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentID", wireType)
-			}
-			m.ParentID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ParentID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6280,7 +16317,7 @@ func (m *MsgCreateSection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6303,7 +16340,7 @@ func (m *MsgCreateSection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateSectionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveGroupApplicationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6326,31 +16363,12 @@ func (m *MsgCreateSectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveGroupApplicationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveGroupApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
-			}
-			m.SectionID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SectionID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -6372,7 +16390,7 @@ func (m *MsgCreateSectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditSection) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectGroupApplication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6395,10 +16413,10 @@ func (m *MsgEditSection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSection: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectGroupApplication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectGroupApplication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6422,9 +16440,9 @@ func (m *MsgEditSection) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
 			}
-			m.SectionID = 0
+			m.GroupID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6434,14 +16452,14 @@ func (m *MsgEditSection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SectionID |= uint32(b&0x7F) << shift
+				m.GroupID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applicant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6469,43 +16487,11 @@ func (m *MsgEditSection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Applicant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6533,7 +16519,7 @@ func (m *MsgEditSection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Editor = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6556,7 +16542,7 @@ func (m *MsgEditSection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditSectionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectGroupApplicationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6579,10 +16565,10 @@ func (m *MsgEditSectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectGroupApplicationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectGroupApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6606,7 +16592,7 @@ func (m *MsgEditSectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMoveSection) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUserPermissions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6629,10 +16615,10 @@ func (m *MsgMoveSection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMoveSection: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUserPermissions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMoveSection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUserPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6674,10 +16660,10 @@ func (m *MsgMoveSection) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParentID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			m.NewParentID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6687,12 +16673,57 @@ func (m *MsgMoveSection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewParentID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -6724,6 +16755,42 @@ func (m *MsgMoveSection) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -6745,7 +16812,7 @@ func (m *MsgMoveSection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMoveSectionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUserPermissionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6768,10 +16835,10 @@ func (m *MsgMoveSectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMoveSectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUserPermissionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMoveSectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUserPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6795,7 +16862,7 @@ func (m *MsgMoveSectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSection) Unmarshal(dAtA []byte) error {
+func (m *MsgBanUser) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6818,10 +16885,10 @@ func (m *MsgDeleteSection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSection: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBanUser: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBanUser: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6844,10 +16911,10 @@ func (m *MsgDeleteSection) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			m.SectionID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6857,12 +16924,93 @@ func (m *MsgDeleteSection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SectionID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -6915,7 +17063,7 @@ func (m *MsgDeleteSection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSectionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBanUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6938,10 +17086,10 @@ func (m *MsgDeleteSectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBanUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBanUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6965,7 +17113,7 @@ func (m *MsgDeleteSectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateUserGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbanUser) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6988,10 +17136,10 @@ func (m *MsgCreateUserGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateUserGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbanUser: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbanUser: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7014,123 +17162,8 @@ func (m *MsgCreateUserGroup) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
-			}
-			m.SectionID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SectionID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPermissions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DefaultPermissions = append(m.DefaultPermissions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7158,11 +17191,11 @@ func (m *MsgCreateUserGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialMembers = append(m.InitialMembers, string(dAtA[iNdEx:postIndex]))
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7190,7 +17223,7 @@ func (m *MsgCreateUserGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7213,7 +17246,7 @@ func (m *MsgCreateUserGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateUserGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbanUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7236,31 +17269,12 @@ func (m *MsgCreateUserGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateUserGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbanUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbanUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
-			}
-			m.GroupID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -7282,7 +17296,7 @@ func (m *MsgCreateUserGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditUserGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7305,10 +17319,10 @@ func (m *MsgEditUserGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditUserGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7331,27 +17345,8 @@ func (m *MsgEditUserGroup) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
-			}
-			m.GroupID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7379,13 +17374,32 @@ func (m *MsgEditUserGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
+			}
+			m.MaxMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessages |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7395,23 +17409,24 @@ func (m *MsgEditUserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -7466,7 +17481,7 @@ func (m *MsgEditUserGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditUserGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7489,10 +17504,10 @@ func (m *MsgEditUserGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditUserGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7516,7 +17531,7 @@ func (m *MsgEditUserGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMoveUserGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7539,10 +17554,10 @@ func (m *MsgMoveUserGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMoveUserGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMoveUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7565,10 +17580,10 @@ func (m *MsgMoveUserGroup) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
 			}
-			m.GroupID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7578,31 +17593,25 @@ func (m *MsgMoveUserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSectionID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			m.NewSectionID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewSectionID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -7655,7 +17664,7 @@ func (m *MsgMoveUserGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMoveUserGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7678,10 +17687,10 @@ func (m *MsgMoveUserGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMoveUserGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMoveUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7705,7 +17714,7 @@ func (m *MsgMoveUserGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetUserGroupPermissions) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeTokensConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7728,10 +17737,10 @@ func (m *MsgSetUserGroupPermissions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetUserGroupPermissions: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeTokensConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetUserGroupPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeTokensConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7754,10 +17763,10 @@ func (m *MsgSetUserGroupPermissions) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
-			m.GroupID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7767,16 +17776,29 @@ func (m *MsgSetUserGroupPermissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7786,25 +17808,46 @@ func (m *MsgSetUserGroupPermissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			m.Rates = append(m.Rates, FeeTokenRate{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= FeeTokensDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -7857,7 +17900,7 @@ func (m *MsgSetUserGroupPermissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetUserGroupPermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeTokensConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7880,10 +17923,10 @@ func (m *MsgSetUserGroupPermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetUserGroupPermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeTokensConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetUserGroupPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeTokensConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7907,7 +17950,7 @@ func (m *MsgSetUserGroupPermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteUserGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeTokensConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7930,10 +17973,10 @@ func (m *MsgDeleteUserGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteUserGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeTokensConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeTokensConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7956,25 +17999,6 @@ func (m *MsgDeleteUserGroup) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
-			}
-			m.GroupID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -8027,7 +18051,7 @@ func (m *MsgDeleteUserGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteUserGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeTokensConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8050,10 +18074,10 @@ func (m *MsgDeleteUserGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteUserGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeTokensConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeTokensConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8077,7 +18101,7 @@ func (m *MsgDeleteUserGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddUserToUserGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8100,10 +18124,10 @@ func (m *MsgAddUserToUserGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddUserToUserGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddUserToUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8126,10 +18150,10 @@ func (m *MsgAddUserToUserGroup) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			m.GroupID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8139,16 +18163,29 @@ func (m *MsgAddUserToUserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8158,29 +18195,33 @@ func (m *MsgAddUserToUserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			if m.Grantee == nil {
+				m.Grantee = &types.Any{}
+			}
+			if err := m.Grantee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8190,23 +18231,27 @@ func (m *MsgAddUserToUserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8229,7 +18274,7 @@ func (m *MsgAddUserToUserGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddUserToUserGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8252,10 +18297,10 @@ func (m *MsgAddUserToUserGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddUserToUserGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddUserToUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8279,7 +18324,7 @@ func (m *MsgAddUserToUserGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveUserFromUserGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8302,10 +18347,10 @@ func (m *MsgRemoveUserFromUserGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveUserFromUserGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveUserFromUserGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8328,27 +18373,8 @@ func (m *MsgRemoveUserFromUserGroup) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
-			}
-			m.GroupID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8376,13 +18402,13 @@ func (m *MsgRemoveUserFromUserGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8392,23 +18418,27 @@ func (m *MsgRemoveUserFromUserGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if m.Grantee == nil {
+				m.Grantee = &types.Any{}
+			}
+			if err := m.Grantee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8431,7 +18461,7 @@ func (m *MsgRemoveUserFromUserGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveUserFromUserGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8454,10 +18484,10 @@ func (m *MsgRemoveUserFromUserGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveUserFromUserGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveUserFromUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8481,7 +18511,7 @@ func (m *MsgRemoveUserFromUserGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetUserPermissions) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8504,10 +18534,10 @@ func (m *MsgSetUserPermissions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetUserPermissions: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantTreasuryAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetUserPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantTreasuryAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8530,27 +18560,8 @@ func (m *MsgSetUserPermissions) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionID", wireType)
-			}
-			m.SectionID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SectionID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8578,11 +18589,11 @@ func (m *MsgSetUserPermissions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8610,13 +18621,13 @@ func (m *MsgSetUserPermissions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8626,23 +18637,24 @@ func (m *MsgSetUserPermissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8665,7 +18677,7 @@ func (m *MsgSetUserPermissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetUserPermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantTreasuryAuthorizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8688,10 +18700,10 @@ func (m *MsgSetUserPermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetUserPermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantTreasuryAuthorizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetUserPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantTreasuryAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8715,7 +18727,7 @@ func (m *MsgSetUserPermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8738,10 +18750,10 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeTreasuryAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeTreasuryAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8799,7 +18811,7 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8809,33 +18821,29 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Grantee == nil {
-				m.Grantee = &types.Any{}
-			}
-			if err := m.Grantee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8845,27 +18853,23 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &types.Any{}
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8888,7 +18892,7 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeTreasuryAuthorizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8911,10 +18915,10 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeTreasuryAuthorizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeTreasuryAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8938,7 +18942,7 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTreasuryVotingConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8961,10 +18965,10 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTreasuryVotingConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTreasuryVotingConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8987,8 +18991,27 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9016,11 +19039,13 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9047,10 +19072,75 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Grantee == nil {
-				m.Grantee = &types.Any{}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Grantee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Quorum = &v
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9075,7 +19165,7 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTreasuryVotingConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9098,10 +19188,10 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTreasuryVotingConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTreasuryVotingConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9125,7 +19215,7 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantTreasuryAuthorization) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitTreasuryProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9148,10 +19238,10 @@ func (m *MsgGrantTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantTreasuryAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitTreasuryProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantTreasuryAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitTreasuryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9175,9 +19265,9 @@ func (m *MsgGrantTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -9187,27 +19277,29 @@ func (m *MsgGrantTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9235,40 +19327,7 @@ func (m *MsgGrantTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9291,7 +19350,7 @@ func (m *MsgGrantTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantTreasuryAuthorizationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitTreasuryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9314,12 +19373,31 @@ func (m *MsgGrantTreasuryAuthorizationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantTreasuryAuthorizationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitTreasuryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantTreasuryAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitTreasuryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -9341,7 +19419,7 @@ func (m *MsgGrantTreasuryAuthorizationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeTreasuryAuthorization) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteTreasuryProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9364,10 +19442,10 @@ func (m *MsgRevokeTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeTreasuryAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteTreasuryProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeTreasuryAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteTreasuryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9390,10 +19468,10 @@ func (m *MsgRevokeTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
-			var stringLen uint64
+			m.ProposalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -9403,29 +19481,16 @@ func (m *MsgRevokeTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			var stringLen uint64
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -9435,27 +19500,14 @@ func (m *MsgRevokeTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Option |= TreasuryVoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9483,7 +19535,7 @@ func (m *MsgRevokeTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9506,7 +19558,7 @@ func (m *MsgRevokeTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeTreasuryAuthorizationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteTreasuryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9529,10 +19581,10 @@ func (m *MsgRevokeTreasuryAuthorizationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeTreasuryAuthorizationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteTreasuryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeTreasuryAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteTreasuryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg = &MsgArchiveSubspace{}
+	_ sdk.Msg = &MsgUnarchiveSubspace{}
+
+	_ legacytx.LegacyMsg = &MsgArchiveSubspace{}
+	_ legacytx.LegacyMsg = &MsgUnarchiveSubspace{}
+
+	_ ManageSubspaceMsg = &MsgArchiveSubspace{}
+	_ ManageSubspaceMsg = &MsgUnarchiveSubspace{}
+)
+
+// NewMsgArchiveSubspace returns a new MsgArchiveSubspace instance
+func NewMsgArchiveSubspace(subspaceID uint64, signer string) *MsgArchiveSubspace {
+	return &MsgArchiveSubspace{
+		SubspaceID: subspaceID,
+		Signer:     signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgArchiveSubspace) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgArchiveSubspace) Type() string { return ActionArchiveSubspace }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgArchiveSubspace) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgArchiveSubspace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgArchiveSubspace) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgArchiveSubspace) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgUnarchiveSubspace returns a new MsgUnarchiveSubspace instance
+func NewMsgUnarchiveSubspace(subspaceID uint64, signer string) *MsgUnarchiveSubspace {
+	return &MsgUnarchiveSubspace{
+		SubspaceID: subspaceID,
+		Signer:     signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgUnarchiveSubspace) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgUnarchiveSubspace) Type() string { return ActionUnarchiveSubspace }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgUnarchiveSubspace) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgUnarchiveSubspace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgUnarchiveSubspace) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgUnarchiveSubspace) IsManageSubspaceMsg() {}
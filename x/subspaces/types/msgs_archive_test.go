@@ -0,0 +1,128 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var msgArchiveSubspace = types.NewMsgArchiveSubspace(
+	1,
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgArchiveSubspace_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgArchiveSubspace.Route())
+}
+
+func TestMsgArchiveSubspace_Type(t *testing.T) {
+	require.Equal(t, types.ActionArchiveSubspace, msgArchiveSubspace.Type())
+}
+
+func TestMsgArchiveSubspace_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgArchiveSubspace
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgArchiveSubspace(0, msgArchiveSubspace.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgArchiveSubspace(1, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgArchiveSubspace,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgArchiveSubspace_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgArchiveSubspace","value":{"signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgArchiveSubspace.GetSignBytes()))
+}
+
+func TestMsgArchiveSubspace_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgArchiveSubspace.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgArchiveSubspace.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgUnarchiveSubspace = types.NewMsgUnarchiveSubspace(
+	1,
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgUnarchiveSubspace_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgUnarchiveSubspace.Route())
+}
+
+func TestMsgUnarchiveSubspace_Type(t *testing.T) {
+	require.Equal(t, types.ActionUnarchiveSubspace, msgUnarchiveSubspace.Type())
+}
+
+func TestMsgUnarchiveSubspace_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUnarchiveSubspace
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgUnarchiveSubspace(0, msgUnarchiveSubspace.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgUnarchiveSubspace(1, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgUnarchiveSubspace,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUnarchiveSubspace_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgUnarchiveSubspace","value":{"signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgUnarchiveSubspace.GetSignBytes()))
+}
+
+func TestMsgUnarchiveSubspace_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgUnarchiveSubspace.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgUnarchiveSubspace.GetSigners())
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg = &MsgBanUser{}
+	_ sdk.Msg = &MsgUnbanUser{}
+
+	_ legacytx.LegacyMsg = &MsgBanUser{}
+	_ legacytx.LegacyMsg = &MsgUnbanUser{}
+
+	_ ManageSubspaceMsg = &MsgBanUser{}
+	_ ManageSubspaceMsg = &MsgUnbanUser{}
+)
+
+// NewMsgBanUser returns a new MsgBanUser instance
+func NewMsgBanUser(subspaceID uint64, user string, reason string, expirationTime *time.Time, signer string) *MsgBanUser {
+	return &MsgBanUser{
+		SubspaceID:     subspaceID,
+		User:           user,
+		Reason:         reason,
+		ExpirationTime: expirationTime,
+		Signer:         signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgBanUser) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgBanUser) Type() string { return ActionBanUser }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgBanUser) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.User)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid user address")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	if msg.User == msg.Signer {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "you cannot ban yourself")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgBanUser) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgBanUser) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgBanUser) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgUnbanUser returns a new MsgUnbanUser instance
+func NewMsgUnbanUser(subspaceID uint64, user string, signer string) *MsgUnbanUser {
+	return &MsgUnbanUser{
+		SubspaceID: subspaceID,
+		User:       user,
+		Signer:     signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgUnbanUser) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgUnbanUser) Type() string { return ActionUnbanUser }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgUnbanUser) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.User)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid user address")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgUnbanUser) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgUnbanUser) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgUnbanUser) IsManageSubspaceMsg() {}
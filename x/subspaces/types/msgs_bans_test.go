@@ -0,0 +1,153 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var msgBanUser = types.NewMsgBanUser(
+	1,
+	"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+	"Spam",
+	nil,
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgBanUser_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgBanUser.Route())
+}
+
+func TestMsgBanUser_Type(t *testing.T) {
+	require.Equal(t, types.ActionBanUser, msgBanUser.Type())
+}
+
+func TestMsgBanUser_ValidateBasic(t *testing.T) {
+	expiration := time.Date(2100, 1, 1, 12, 00, 00, 000, time.UTC)
+	testCases := []struct {
+		name      string
+		msg       *types.MsgBanUser
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgBanUser(0, msgBanUser.User, msgBanUser.Reason, nil, msgBanUser.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid user returns error",
+			msg:       types.NewMsgBanUser(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw", msgBanUser.Reason, nil, msgBanUser.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgBanUser(1, msgBanUser.User, msgBanUser.Reason, nil, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name:      "same user and signer returns error",
+			msg:       types.NewMsgBanUser(1, msgBanUser.Signer, msgBanUser.Reason, nil, msgBanUser.Signer),
+			shouldErr: true,
+		},
+		{
+			name: "valid message with expiration returns no error",
+			msg:  types.NewMsgBanUser(1, msgBanUser.User, msgBanUser.Reason, &expiration, msgBanUser.Signer),
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgBanUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgBanUser_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgBanUser","value":{"reason":"Spam","signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1","user":"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"}}`
+	require.Equal(t, expected, string(msgBanUser.GetSignBytes()))
+}
+
+func TestMsgBanUser_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgBanUser.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgBanUser.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgUnbanUser = types.NewMsgUnbanUser(
+	1,
+	"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgUnbanUser_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgUnbanUser.Route())
+}
+
+func TestMsgUnbanUser_Type(t *testing.T) {
+	require.Equal(t, types.ActionUnbanUser, msgUnbanUser.Type())
+}
+
+func TestMsgUnbanUser_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUnbanUser
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgUnbanUser(0, msgUnbanUser.User, msgUnbanUser.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid user returns error",
+			msg:       types.NewMsgUnbanUser(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw", msgUnbanUser.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgUnbanUser(1, msgUnbanUser.User, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgUnbanUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUnbanUser_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgUnbanUser","value":{"signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1","user":"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"}}`
+	require.Equal(t, expected, string(msgUnbanUser.GetSignBytes()))
+}
+
+func TestMsgUnbanUser_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgUnbanUser.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgUnbanUser.GetSigners())
+}
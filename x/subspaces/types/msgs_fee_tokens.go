@@ -0,0 +1,111 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg = &MsgSetFeeTokensConfig{}
+	_ sdk.Msg = &MsgRemoveFeeTokensConfig{}
+
+	_ legacytx.LegacyMsg = &MsgSetFeeTokensConfig{}
+	_ legacytx.LegacyMsg = &MsgRemoveFeeTokensConfig{}
+
+	_ ManageSubspaceMsg = &MsgSetFeeTokensConfig{}
+	_ ManageSubspaceMsg = &MsgRemoveFeeTokensConfig{}
+)
+
+// NewMsgSetFeeTokensConfig returns a new MsgSetFeeTokensConfig instance
+func NewMsgSetFeeTokensConfig(
+	subspaceID uint64, baseDenom string, rates []FeeTokenRate, destination FeeTokensDestination, signer string,
+) *MsgSetFeeTokensConfig {
+	return &MsgSetFeeTokensConfig{
+		SubspaceID:  subspaceID,
+		BaseDenom:   baseDenom,
+		Rates:       rates,
+		Destination: destination,
+		Signer:      signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgSetFeeTokensConfig) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgSetFeeTokensConfig) Type() string { return ActionSetFeeTokensConfig }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSetFeeTokensConfig) ValidateBasic() error {
+	err := NewFeeTokensConfig(msg.SubspaceID, msg.BaseDenom, msg.Rates, msg.Destination).Validate()
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgSetFeeTokensConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgSetFeeTokensConfig) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgSetFeeTokensConfig) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRemoveFeeTokensConfig returns a new MsgRemoveFeeTokensConfig instance
+func NewMsgRemoveFeeTokensConfig(subspaceID uint64, signer string) *MsgRemoveFeeTokensConfig {
+	return &MsgRemoveFeeTokensConfig{
+		SubspaceID: subspaceID,
+		Signer:     signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgRemoveFeeTokensConfig) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgRemoveFeeTokensConfig) Type() string { return ActionRemoveFeeTokensConfig }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRemoveFeeTokensConfig) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgRemoveFeeTokensConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRemoveFeeTokensConfig) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgRemoveFeeTokensConfig) IsManageSubspaceMsg() {}
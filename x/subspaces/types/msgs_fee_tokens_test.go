@@ -0,0 +1,165 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+)
+
+var msgSetFeeTokensConfig = types.NewMsgSetFeeTokensConfig(
+	1,
+	"stake",
+	[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(2), sdk.NewInt(1000))},
+	types.FEE_TOKENS_DESTINATION_TREASURY,
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgSetFeeTokensConfig_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgSetFeeTokensConfig.Route())
+}
+
+func TestMsgSetFeeTokensConfig_Type(t *testing.T) {
+	require.Equal(t, types.ActionSetFeeTokensConfig, msgSetFeeTokensConfig.Type())
+}
+
+func TestMsgSetFeeTokensConfig_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetFeeTokensConfig
+		shouldErr bool
+	}{
+		{
+			name: "invalid subspace id returns error",
+			msg: types.NewMsgSetFeeTokensConfig(
+				0,
+				msgSetFeeTokensConfig.BaseDenom,
+				msgSetFeeTokensConfig.Rates,
+				msgSetFeeTokensConfig.Destination,
+				msgSetFeeTokensConfig.Signer,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid base denom returns error",
+			msg: types.NewMsgSetFeeTokensConfig(
+				1,
+				"",
+				msgSetFeeTokensConfig.Rates,
+				msgSetFeeTokensConfig.Destination,
+				msgSetFeeTokensConfig.Signer,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid rates return error",
+			msg: types.NewMsgSetFeeTokensConfig(
+				1,
+				msgSetFeeTokensConfig.BaseDenom,
+				[]types.FeeTokenRate{types.NewFeeTokenRate("minttoken", sdk.NewDec(-1), sdk.ZeroInt())},
+				msgSetFeeTokensConfig.Destination,
+				msgSetFeeTokensConfig.Signer,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid signer returns error",
+			msg: types.NewMsgSetFeeTokensConfig(
+				1,
+				msgSetFeeTokensConfig.BaseDenom,
+				msgSetFeeTokensConfig.Rates,
+				msgSetFeeTokensConfig.Destination,
+				"cosmos1m0czrla04f7rp3z",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgSetFeeTokensConfig,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetFeeTokensConfig_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgSetFeeTokensConfig","value":{"base_denom":"stake","destination":1,"rates":[{"denom":"minttoken","max_amount":"1000","rate":"2.000000000000000000"}],"signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgSetFeeTokensConfig.GetSignBytes()))
+}
+
+func TestMsgSetFeeTokensConfig_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgSetFeeTokensConfig.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgSetFeeTokensConfig.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgRemoveFeeTokensConfig = types.NewMsgRemoveFeeTokensConfig(
+	1,
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgRemoveFeeTokensConfig_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgRemoveFeeTokensConfig.Route())
+}
+
+func TestMsgRemoveFeeTokensConfig_Type(t *testing.T) {
+	require.Equal(t, types.ActionRemoveFeeTokensConfig, msgRemoveFeeTokensConfig.Type())
+}
+
+func TestMsgRemoveFeeTokensConfig_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgRemoveFeeTokensConfig
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgRemoveFeeTokensConfig(0, msgRemoveFeeTokensConfig.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgRemoveFeeTokensConfig(1, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgRemoveFeeTokensConfig,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveFeeTokensConfig_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgRemoveFeeTokensConfig","value":{"signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgRemoveFeeTokensConfig.GetSignBytes()))
+}
+
+func TestMsgRemoveFeeTokensConfig_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgRemoveFeeTokensConfig.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgRemoveFeeTokensConfig.GetSigners())
+}
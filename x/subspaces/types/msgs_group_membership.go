@@ -0,0 +1,344 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateInvite{}
+	_ sdk.Msg = &MsgDeleteInvite{}
+	_ sdk.Msg = &MsgRedeemInvite{}
+	_ sdk.Msg = &MsgApplyToGroup{}
+	_ sdk.Msg = &MsgApproveGroupApplication{}
+	_ sdk.Msg = &MsgRejectGroupApplication{}
+
+	_ legacytx.LegacyMsg = &MsgCreateInvite{}
+	_ legacytx.LegacyMsg = &MsgDeleteInvite{}
+	_ legacytx.LegacyMsg = &MsgRedeemInvite{}
+	_ legacytx.LegacyMsg = &MsgApplyToGroup{}
+	_ legacytx.LegacyMsg = &MsgApproveGroupApplication{}
+	_ legacytx.LegacyMsg = &MsgRejectGroupApplication{}
+
+	_ ManageSubspaceMsg = &MsgCreateInvite{}
+	_ ManageSubspaceMsg = &MsgDeleteInvite{}
+	_ ManageSubspaceMsg = &MsgRedeemInvite{}
+	_ ManageSubspaceMsg = &MsgApplyToGroup{}
+	_ ManageSubspaceMsg = &MsgApproveGroupApplication{}
+	_ ManageSubspaceMsg = &MsgRejectGroupApplication{}
+)
+
+// NewMsgCreateInvite returns a new MsgCreateInvite instance
+func NewMsgCreateInvite(
+	subspaceID uint64, groupID uint32, secretHash string, maxUses uint32, expirationTime *time.Time, creator string,
+) *MsgCreateInvite {
+	return &MsgCreateInvite{
+		SubspaceID:     subspaceID,
+		GroupID:        groupID,
+		SecretHash:     secretHash,
+		MaxUses:        maxUses,
+		ExpirationTime: expirationTime,
+		Creator:        creator,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgCreateInvite) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgCreateInvite) Type() string { return ActionCreateInvite }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgCreateInvite) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if msg.GroupID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid group id: %d", msg.GroupID)
+	}
+
+	if !IsValidInviteSecretHash(msg.SecretHash) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid secret hash: %s", msg.SecretHash)
+	}
+
+	if msg.MaxUses == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid max uses: %d", msg.MaxUses)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgCreateInvite) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgCreateInvite) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgCreateInvite) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgDeleteInvite returns a new MsgDeleteInvite instance
+func NewMsgDeleteInvite(subspaceID uint64, secretHash string, signer string) *MsgDeleteInvite {
+	return &MsgDeleteInvite{
+		SubspaceID: subspaceID,
+		SecretHash: secretHash,
+		Signer:     signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgDeleteInvite) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgDeleteInvite) Type() string { return ActionDeleteInvite }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgDeleteInvite) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if !IsValidInviteSecretHash(msg.SecretHash) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid secret hash: %s", msg.SecretHash)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgDeleteInvite) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgDeleteInvite) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgDeleteInvite) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRedeemInvite returns a new MsgRedeemInvite instance
+func NewMsgRedeemInvite(subspaceID uint64, publicKey []byte, signature []byte, redeemer string) *MsgRedeemInvite {
+	return &MsgRedeemInvite{
+		SubspaceID: subspaceID,
+		PublicKey:  publicKey,
+		Signature:  signature,
+		Redeemer:   redeemer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgRedeemInvite) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgRedeemInvite) Type() string { return ActionRedeemInvite }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRedeemInvite) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if len(msg.PublicKey) != secp256k1.PubKeySize {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid public key length: %d", len(msg.PublicKey))
+	}
+
+	if len(msg.Signature) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid signature: cannot be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Redeemer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid redeemer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgRedeemInvite) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRedeemInvite) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Redeemer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgRedeemInvite) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgApplyToGroup returns a new MsgApplyToGroup instance
+func NewMsgApplyToGroup(subspaceID uint64, groupID uint32, message string, applicant string) *MsgApplyToGroup {
+	return &MsgApplyToGroup{
+		SubspaceID: subspaceID,
+		GroupID:    groupID,
+		Message:    message,
+		Applicant:  applicant,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgApplyToGroup) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgApplyToGroup) Type() string { return ActionApplyToGroup }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgApplyToGroup) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if msg.GroupID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid group id: %d", msg.GroupID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Applicant)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid applicant address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgApplyToGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgApplyToGroup) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Applicant)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgApplyToGroup) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgApproveGroupApplication returns a new MsgApproveGroupApplication instance
+func NewMsgApproveGroupApplication(subspaceID uint64, groupID uint32, applicant string, signer string) *MsgApproveGroupApplication {
+	return &MsgApproveGroupApplication{
+		SubspaceID: subspaceID,
+		GroupID:    groupID,
+		Applicant:  applicant,
+		Signer:     signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgApproveGroupApplication) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgApproveGroupApplication) Type() string { return ActionApproveGroupApplication }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgApproveGroupApplication) ValidateBasic() error {
+	return validateGroupApplicationReview(msg.SubspaceID, msg.GroupID, msg.Applicant, msg.Signer)
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgApproveGroupApplication) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgApproveGroupApplication) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgApproveGroupApplication) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRejectGroupApplication returns a new MsgRejectGroupApplication instance
+func NewMsgRejectGroupApplication(subspaceID uint64, groupID uint32, applicant string, signer string) *MsgRejectGroupApplication {
+	return &MsgRejectGroupApplication{
+		SubspaceID: subspaceID,
+		GroupID:    groupID,
+		Applicant:  applicant,
+		Signer:     signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgRejectGroupApplication) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgRejectGroupApplication) Type() string { return ActionRejectGroupApplication }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRejectGroupApplication) ValidateBasic() error {
+	return validateGroupApplicationReview(msg.SubspaceID, msg.GroupID, msg.Applicant, msg.Signer)
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgRejectGroupApplication) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRejectGroupApplication) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgRejectGroupApplication) IsManageSubspaceMsg() {}
+
+// validateGroupApplicationReview validates the fields of a message used to approve or reject a group application
+func validateGroupApplicationReview(subspaceID uint64, groupID uint32, applicant string, signer string) error {
+	if subspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", subspaceID)
+	}
+
+	if groupID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid group id: %d", groupID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(applicant)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid applicant address")
+	}
+
+	_, err = sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
@@ -0,0 +1,439 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var msgCreateInvite = types.NewMsgCreateInvite(
+	1,
+	1,
+	"09510a557079d9d47de1410b5b05427afd91580ceff2e8e8cc7d2054ff16bbf2",
+	10,
+	nil,
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgCreateInvite_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgCreateInvite.Route())
+}
+
+func TestMsgCreateInvite_Type(t *testing.T) {
+	require.Equal(t, types.ActionCreateInvite, msgCreateInvite.Type())
+}
+
+func TestMsgCreateInvite_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateInvite
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgCreateInvite(0, 1, msgCreateInvite.SecretHash, 10, nil, msgCreateInvite.Creator),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid group id returns error",
+			msg:       types.NewMsgCreateInvite(1, 0, msgCreateInvite.SecretHash, 10, nil, msgCreateInvite.Creator),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid secret hash returns error",
+			msg:       types.NewMsgCreateInvite(1, 1, "my-super-secret", 10, nil, msgCreateInvite.Creator),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid max uses returns error",
+			msg:       types.NewMsgCreateInvite(1, 1, msgCreateInvite.SecretHash, 0, nil, msgCreateInvite.Creator),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid creator returns error",
+			msg:       types.NewMsgCreateInvite(1, 1, msgCreateInvite.SecretHash, 10, nil, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgCreateInvite,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCreateInvite_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgCreateInvite","value":{"creator":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","group_id":1,"max_uses":10,"secret_hash":"09510a557079d9d47de1410b5b05427afd91580ceff2e8e8cc7d2054ff16bbf2","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgCreateInvite.GetSignBytes()))
+}
+
+func TestMsgCreateInvite_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgCreateInvite.Creator)
+	require.Equal(t, []sdk.AccAddress{addr}, msgCreateInvite.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgDeleteInvite = types.NewMsgDeleteInvite(
+	1,
+	"09510a557079d9d47de1410b5b05427afd91580ceff2e8e8cc7d2054ff16bbf2",
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgDeleteInvite_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgDeleteInvite.Route())
+}
+
+func TestMsgDeleteInvite_Type(t *testing.T) {
+	require.Equal(t, types.ActionDeleteInvite, msgDeleteInvite.Type())
+}
+
+func TestMsgDeleteInvite_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgDeleteInvite
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgDeleteInvite(0, msgDeleteInvite.SecretHash, msgDeleteInvite.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid secret hash returns error",
+			msg:       types.NewMsgDeleteInvite(1, "my-super-secret", msgDeleteInvite.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgDeleteInvite(1, msgDeleteInvite.SecretHash, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgDeleteInvite,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgDeleteInvite_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgDeleteInvite","value":{"secret_hash":"09510a557079d9d47de1410b5b05427afd91580ceff2e8e8cc7d2054ff16bbf2","signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgDeleteInvite.GetSignBytes()))
+}
+
+func TestMsgDeleteInvite_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgDeleteInvite.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgDeleteInvite.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgRedeemInvite = types.NewMsgRedeemInvite(
+	1,
+	[]byte{
+		0x03, 0x11, 0x0e, 0xe5, 0x7f, 0x16, 0x56, 0xc6, 0x00, 0x88, 0x87, 0x7b, 0x58, 0x87, 0xf9, 0xc2, 0x88,
+		0x86, 0x7b, 0x67, 0x3a, 0x63, 0x8f, 0xb0, 0x1f, 0xe6, 0xf7, 0x9f, 0x81, 0x10, 0x7c, 0x2d, 0xe4,
+	},
+	[]byte("signature"),
+	"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+)
+
+func TestMsgRedeemInvite_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgRedeemInvite.Route())
+}
+
+func TestMsgRedeemInvite_Type(t *testing.T) {
+	require.Equal(t, types.ActionRedeemInvite, msgRedeemInvite.Type())
+}
+
+func TestMsgRedeemInvite_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgRedeemInvite
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgRedeemInvite(0, msgRedeemInvite.PublicKey, msgRedeemInvite.Signature, msgRedeemInvite.Redeemer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid public key returns error",
+			msg:       types.NewMsgRedeemInvite(1, msgRedeemInvite.PublicKey[1:], msgRedeemInvite.Signature, msgRedeemInvite.Redeemer),
+			shouldErr: true,
+		},
+		{
+			name:      "empty signature returns error",
+			msg:       types.NewMsgRedeemInvite(1, msgRedeemInvite.PublicKey, nil, msgRedeemInvite.Redeemer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid redeemer returns error",
+			msg:       types.NewMsgRedeemInvite(1, msgRedeemInvite.PublicKey, msgRedeemInvite.Signature, "cosmos1x5pjlvufs4znnhhkwe8v4"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgRedeemInvite,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRedeemInvite_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgRedeemInvite","value":{"public_key":"AxEO5X8WVsYAiId7WIf5woiGe2c6Y4+wH+b3n4EQfC3k","redeemer":"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53","signature":"c2lnbmF0dXJl","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgRedeemInvite.GetSignBytes()))
+}
+
+func TestMsgRedeemInvite_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgRedeemInvite.Redeemer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgRedeemInvite.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgApplyToGroup = types.NewMsgApplyToGroup(
+	1,
+	1,
+	"Let me in",
+	"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+)
+
+func TestMsgApplyToGroup_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgApplyToGroup.Route())
+}
+
+func TestMsgApplyToGroup_Type(t *testing.T) {
+	require.Equal(t, types.ActionApplyToGroup, msgApplyToGroup.Type())
+}
+
+func TestMsgApplyToGroup_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgApplyToGroup
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgApplyToGroup(0, 1, "", msgApplyToGroup.Applicant),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid group id returns error",
+			msg:       types.NewMsgApplyToGroup(1, 0, "", msgApplyToGroup.Applicant),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid applicant returns error",
+			msg:       types.NewMsgApplyToGroup(1, 1, "", "cosmos1x5pjlvufs4znnhhkwe8v4"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgApplyToGroup,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgApplyToGroup_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgApplyToGroup","value":{"applicant":"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53","group_id":1,"message":"Let me in","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgApplyToGroup.GetSignBytes()))
+}
+
+func TestMsgApplyToGroup_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgApplyToGroup.Applicant)
+	require.Equal(t, []sdk.AccAddress{addr}, msgApplyToGroup.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgApproveGroupApplication = types.NewMsgApproveGroupApplication(
+	1,
+	1,
+	"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgApproveGroupApplication_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgApproveGroupApplication.Route())
+}
+
+func TestMsgApproveGroupApplication_Type(t *testing.T) {
+	require.Equal(t, types.ActionApproveGroupApplication, msgApproveGroupApplication.Type())
+}
+
+func TestMsgApproveGroupApplication_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgApproveGroupApplication
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgApproveGroupApplication(0, 1, msgApproveGroupApplication.Applicant, msgApproveGroupApplication.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid group id returns error",
+			msg:       types.NewMsgApproveGroupApplication(1, 0, msgApproveGroupApplication.Applicant, msgApproveGroupApplication.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid applicant returns error",
+			msg:       types.NewMsgApproveGroupApplication(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4", msgApproveGroupApplication.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgApproveGroupApplication(1, 1, msgApproveGroupApplication.Applicant, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgApproveGroupApplication,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgApproveGroupApplication_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgApproveGroupApplication","value":{"applicant":"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53","group_id":1,"signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgApproveGroupApplication.GetSignBytes()))
+}
+
+func TestMsgApproveGroupApplication_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgApproveGroupApplication.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgApproveGroupApplication.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgRejectGroupApplication = types.NewMsgRejectGroupApplication(
+	1,
+	1,
+	"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgRejectGroupApplication_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgRejectGroupApplication.Route())
+}
+
+func TestMsgRejectGroupApplication_Type(t *testing.T) {
+	require.Equal(t, types.ActionRejectGroupApplication, msgRejectGroupApplication.Type())
+}
+
+func TestMsgRejectGroupApplication_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgRejectGroupApplication
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgRejectGroupApplication(0, 1, msgRejectGroupApplication.Applicant, msgRejectGroupApplication.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid group id returns error",
+			msg:       types.NewMsgRejectGroupApplication(1, 0, msgRejectGroupApplication.Applicant, msgRejectGroupApplication.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid applicant returns error",
+			msg:       types.NewMsgRejectGroupApplication(1, 1, "cosmos1x5pjlvufs4znnhhkwe8v4", msgRejectGroupApplication.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgRejectGroupApplication(1, 1, msgRejectGroupApplication.Applicant, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgRejectGroupApplication,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRejectGroupApplication_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgRejectGroupApplication","value":{"applicant":"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53","group_id":1,"signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgRejectGroupApplication.GetSignBytes()))
+}
+
+func TestMsgRejectGroupApplication_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgRejectGroupApplication.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgRejectGroupApplication.GetSigners())
+}
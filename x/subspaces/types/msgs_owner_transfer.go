@@ -0,0 +1,215 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg = &MsgRequestSubspaceOwnerTransfer{}
+	_ sdk.Msg = &MsgCancelSubspaceOwnerTransferRequest{}
+	_ sdk.Msg = &MsgAcceptSubspaceOwnerTransferRequest{}
+	_ sdk.Msg = &MsgRefuseSubspaceOwnerTransferRequest{}
+
+	_ legacytx.LegacyMsg = &MsgRequestSubspaceOwnerTransfer{}
+	_ legacytx.LegacyMsg = &MsgCancelSubspaceOwnerTransferRequest{}
+	_ legacytx.LegacyMsg = &MsgAcceptSubspaceOwnerTransferRequest{}
+	_ legacytx.LegacyMsg = &MsgRefuseSubspaceOwnerTransferRequest{}
+
+	_ ManageSubspaceMsg = &MsgRequestSubspaceOwnerTransfer{}
+	_ ManageSubspaceMsg = &MsgCancelSubspaceOwnerTransferRequest{}
+	_ ManageSubspaceMsg = &MsgAcceptSubspaceOwnerTransferRequest{}
+	_ ManageSubspaceMsg = &MsgRefuseSubspaceOwnerTransferRequest{}
+)
+
+// NewMsgRequestSubspaceOwnerTransfer returns a new MsgRequestSubspaceOwnerTransfer instance
+func NewMsgRequestSubspaceOwnerTransfer(subspaceID uint64, receiver string, sender string) *MsgRequestSubspaceOwnerTransfer {
+	return &MsgRequestSubspaceOwnerTransfer{
+		SubspaceID: subspaceID,
+		Receiver:   receiver,
+		Sender:     sender,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgRequestSubspaceOwnerTransfer) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgRequestSubspaceOwnerTransfer) Type() string { return ActionRequestSubspaceOwnerTransfer }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRequestSubspaceOwnerTransfer) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if msg.Sender == msg.Receiver {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "receiver cannot be the same as sender")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgRequestSubspaceOwnerTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRequestSubspaceOwnerTransfer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgRequestSubspaceOwnerTransfer) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgCancelSubspaceOwnerTransferRequest returns a new MsgCancelSubspaceOwnerTransferRequest instance
+func NewMsgCancelSubspaceOwnerTransferRequest(subspaceID uint64, sender string) *MsgCancelSubspaceOwnerTransferRequest {
+	return &MsgCancelSubspaceOwnerTransferRequest{
+		SubspaceID: subspaceID,
+		Sender:     sender,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgCancelSubspaceOwnerTransferRequest) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgCancelSubspaceOwnerTransferRequest) Type() string {
+	return ActionCancelSubspaceOwnerTransfer
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgCancelSubspaceOwnerTransferRequest) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgCancelSubspaceOwnerTransferRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgCancelSubspaceOwnerTransferRequest) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgCancelSubspaceOwnerTransferRequest) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgAcceptSubspaceOwnerTransferRequest returns a new MsgAcceptSubspaceOwnerTransferRequest instance
+func NewMsgAcceptSubspaceOwnerTransferRequest(subspaceID uint64, receiver string) *MsgAcceptSubspaceOwnerTransferRequest {
+	return &MsgAcceptSubspaceOwnerTransferRequest{
+		SubspaceID: subspaceID,
+		Receiver:   receiver,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgAcceptSubspaceOwnerTransferRequest) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgAcceptSubspaceOwnerTransferRequest) Type() string {
+	return ActionAcceptSubspaceOwnerTransfer
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgAcceptSubspaceOwnerTransferRequest) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgAcceptSubspaceOwnerTransferRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgAcceptSubspaceOwnerTransferRequest) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Receiver)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgAcceptSubspaceOwnerTransferRequest) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRefuseSubspaceOwnerTransferRequest returns a new MsgRefuseSubspaceOwnerTransferRequest instance
+func NewMsgRefuseSubspaceOwnerTransferRequest(subspaceID uint64, receiver string) *MsgRefuseSubspaceOwnerTransferRequest {
+	return &MsgRefuseSubspaceOwnerTransferRequest{
+		SubspaceID: subspaceID,
+		Receiver:   receiver,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgRefuseSubspaceOwnerTransferRequest) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgRefuseSubspaceOwnerTransferRequest) Type() string {
+	return ActionRefuseSubspaceOwnerTransfer
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRefuseSubspaceOwnerTransferRequest) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgRefuseSubspaceOwnerTransferRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRefuseSubspaceOwnerTransferRequest) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Receiver)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgRefuseSubspaceOwnerTransferRequest) IsManageSubspaceMsg() {}
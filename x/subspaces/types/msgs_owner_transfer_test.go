@@ -0,0 +1,250 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var msgRequestSubspaceOwnerTransfer = types.NewMsgRequestSubspaceOwnerTransfer(
+	1,
+	"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgRequestSubspaceOwnerTransfer_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgRequestSubspaceOwnerTransfer.Route())
+}
+
+func TestMsgRequestSubspaceOwnerTransfer_Type(t *testing.T) {
+	require.Equal(t, types.ActionRequestSubspaceOwnerTransfer, msgRequestSubspaceOwnerTransfer.Type())
+}
+
+func TestMsgRequestSubspaceOwnerTransfer_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgRequestSubspaceOwnerTransfer
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgRequestSubspaceOwnerTransfer(0, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid receiver returns error",
+			msg:       types.NewMsgRequestSubspaceOwnerTransfer(1, "cosmos1x5pjlvufs4znnhhkwe8v4", "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid sender returns error",
+			msg:       types.NewMsgRequestSubspaceOwnerTransfer(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53", "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name:      "same sender and receiver returns error",
+			msg:       types.NewMsgRequestSubspaceOwnerTransfer(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5", "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgRequestSubspaceOwnerTransfer,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRequestSubspaceOwnerTransfer_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgRequestSubspaceOwnerTransfer","value":{"receiver":"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53","sender":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgRequestSubspaceOwnerTransfer.GetSignBytes()))
+}
+
+func TestMsgRequestSubspaceOwnerTransfer_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgRequestSubspaceOwnerTransfer.Sender)
+	require.Equal(t, []sdk.AccAddress{addr}, msgRequestSubspaceOwnerTransfer.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgCancelSubspaceOwnerTransferRequest = types.NewMsgCancelSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5")
+
+func TestMsgCancelSubspaceOwnerTransferRequest_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgCancelSubspaceOwnerTransferRequest.Route())
+}
+
+func TestMsgCancelSubspaceOwnerTransferRequest_Type(t *testing.T) {
+	require.Equal(t, types.ActionCancelSubspaceOwnerTransfer, msgCancelSubspaceOwnerTransferRequest.Type())
+}
+
+func TestMsgCancelSubspaceOwnerTransferRequest_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCancelSubspaceOwnerTransferRequest
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgCancelSubspaceOwnerTransferRequest(0, "cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid sender returns error",
+			msg:       types.NewMsgCancelSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgCancelSubspaceOwnerTransferRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelSubspaceOwnerTransferRequest_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgCancelSubspaceOwnerTransfer","value":{"sender":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgCancelSubspaceOwnerTransferRequest.GetSignBytes()))
+}
+
+func TestMsgCancelSubspaceOwnerTransferRequest_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgCancelSubspaceOwnerTransferRequest.Sender)
+	require.Equal(t, []sdk.AccAddress{addr}, msgCancelSubspaceOwnerTransferRequest.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgAcceptSubspaceOwnerTransferRequest = types.NewMsgAcceptSubspaceOwnerTransferRequest(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+
+func TestMsgAcceptSubspaceOwnerTransferRequest_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgAcceptSubspaceOwnerTransferRequest.Route())
+}
+
+func TestMsgAcceptSubspaceOwnerTransferRequest_Type(t *testing.T) {
+	require.Equal(t, types.ActionAcceptSubspaceOwnerTransfer, msgAcceptSubspaceOwnerTransferRequest.Type())
+}
+
+func TestMsgAcceptSubspaceOwnerTransferRequest_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgAcceptSubspaceOwnerTransferRequest
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgAcceptSubspaceOwnerTransferRequest(0, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid receiver returns error",
+			msg:       types.NewMsgAcceptSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgAcceptSubspaceOwnerTransferRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgAcceptSubspaceOwnerTransferRequest_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgAcceptSubspaceOwnerTransfer","value":{"receiver":"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgAcceptSubspaceOwnerTransferRequest.GetSignBytes()))
+}
+
+func TestMsgAcceptSubspaceOwnerTransferRequest_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgAcceptSubspaceOwnerTransferRequest.Receiver)
+	require.Equal(t, []sdk.AccAddress{addr}, msgAcceptSubspaceOwnerTransferRequest.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgRefuseSubspaceOwnerTransferRequest = types.NewMsgRefuseSubspaceOwnerTransferRequest(1, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53")
+
+func TestMsgRefuseSubspaceOwnerTransferRequest_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgRefuseSubspaceOwnerTransferRequest.Route())
+}
+
+func TestMsgRefuseSubspaceOwnerTransferRequest_Type(t *testing.T) {
+	require.Equal(t, types.ActionRefuseSubspaceOwnerTransfer, msgRefuseSubspaceOwnerTransferRequest.Type())
+}
+
+func TestMsgRefuseSubspaceOwnerTransferRequest_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgRefuseSubspaceOwnerTransferRequest
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgRefuseSubspaceOwnerTransferRequest(0, "cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid receiver returns error",
+			msg:       types.NewMsgRefuseSubspaceOwnerTransferRequest(1, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgRefuseSubspaceOwnerTransferRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRefuseSubspaceOwnerTransferRequest_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgRefuseSubspaceOwnerTransfer","value":{"receiver":"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgRefuseSubspaceOwnerTransferRequest.GetSignBytes()))
+}
+
+func TestMsgRefuseSubspaceOwnerTransferRequest_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgRefuseSubspaceOwnerTransferRequest.Receiver)
+	require.Equal(t, []sdk.AccAddress{addr}, msgRefuseSubspaceOwnerTransferRequest.GetSigners())
+}
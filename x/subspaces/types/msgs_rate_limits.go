@@ -0,0 +1,117 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg = &MsgSetRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+
+	_ legacytx.LegacyMsg = &MsgSetRateLimit{}
+	_ legacytx.LegacyMsg = &MsgRemoveRateLimit{}
+
+	_ ManageSubspaceMsg = &MsgSetRateLimit{}
+	_ ManageSubspaceMsg = &MsgRemoveRateLimit{}
+)
+
+// NewMsgSetRateLimit returns a new MsgSetRateLimit instance
+func NewMsgSetRateLimit(subspaceID uint64, msgTypeURL string, maxMessages uint32, window time.Duration, signer string) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		SubspaceID:  subspaceID,
+		MsgTypeURL:  msgTypeURL,
+		MaxMessages: maxMessages,
+		Window:      window,
+		Signer:      signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgSetRateLimit) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgSetRateLimit) Type() string { return ActionSetRateLimit }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSetRateLimit) ValidateBasic() error {
+	err := NewRateLimit(msg.SubspaceID, msg.MsgTypeURL, msg.MaxMessages, msg.Window).Validate()
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgSetRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgSetRateLimit) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRemoveRateLimit returns a new MsgRemoveRateLimit instance
+func NewMsgRemoveRateLimit(subspaceID uint64, msgTypeURL string, signer string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		SubspaceID: subspaceID,
+		MsgTypeURL: msgTypeURL,
+		Signer:     signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgRemoveRateLimit) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgRemoveRateLimit) Type() string { return ActionRemoveRateLimit }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRemoveRateLimit) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	if strings.TrimSpace(msg.MsgTypeURL) == "" {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid message type url: %s", msg.MsgTypeURL)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgRemoveRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgRemoveRateLimit) IsManageSubspaceMsg() {}
@@ -0,0 +1,153 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var msgSetRateLimit = types.NewMsgSetRateLimit(
+	1,
+	"/desmos.posts.v3.MsgCreatePost",
+	10,
+	time.Hour,
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgSetRateLimit_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgSetRateLimit.Route())
+}
+
+func TestMsgSetRateLimit_Type(t *testing.T) {
+	require.Equal(t, types.ActionSetRateLimit, msgSetRateLimit.Type())
+}
+
+func TestMsgSetRateLimit_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetRateLimit
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgSetRateLimit(0, msgSetRateLimit.MsgTypeURL, 10, time.Hour, msgSetRateLimit.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid message type url returns error",
+			msg:       types.NewMsgSetRateLimit(1, "desmos.posts.v3.MsgCreatePost", 10, time.Hour, msgSetRateLimit.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid max messages returns error",
+			msg:       types.NewMsgSetRateLimit(1, msgSetRateLimit.MsgTypeURL, 0, time.Hour, msgSetRateLimit.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid window returns error",
+			msg:       types.NewMsgSetRateLimit(1, msgSetRateLimit.MsgTypeURL, 10, 0, msgSetRateLimit.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgSetRateLimit(1, msgSetRateLimit.MsgTypeURL, 10, time.Hour, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgSetRateLimit,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetRateLimit_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgSetRateLimit","value":{"max_messages":10,"msg_type_url":"/desmos.posts.v3.MsgCreatePost","signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1","window":"3600000000000"}}`
+	require.Equal(t, expected, string(msgSetRateLimit.GetSignBytes()))
+}
+
+func TestMsgSetRateLimit_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgSetRateLimit.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgSetRateLimit.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgRemoveRateLimit = types.NewMsgRemoveRateLimit(
+	1,
+	"/desmos.posts.v3.MsgCreatePost",
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgRemoveRateLimit_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgRemoveRateLimit.Route())
+}
+
+func TestMsgRemoveRateLimit_Type(t *testing.T) {
+	require.Equal(t, types.ActionRemoveRateLimit, msgRemoveRateLimit.Type())
+}
+
+func TestMsgRemoveRateLimit_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgRemoveRateLimit
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgRemoveRateLimit(0, msgRemoveRateLimit.MsgTypeURL, msgRemoveRateLimit.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid message type url returns error",
+			msg:       types.NewMsgRemoveRateLimit(1, "", msgRemoveRateLimit.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgRemoveRateLimit(1, msgRemoveRateLimit.MsgTypeURL, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgRemoveRateLimit,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveRateLimit_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgRemoveRateLimit","value":{"msg_type_url":"/desmos.posts.v3.MsgCreatePost","signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgRemoveRateLimit.GetSignBytes()))
+}
+
+func TestMsgRemoveRateLimit_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgRemoveRateLimit.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgRemoveRateLimit.GetSigners())
+}
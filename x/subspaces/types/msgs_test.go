@@ -231,6 +231,7 @@ var msgCreateSection = types.NewMsgCreateSection(
 	"Test section",
 	"This is a test section",
 	0,
+	types.SECTION_VISIBILITY_PUBLIC,
 	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 )
 
@@ -255,6 +256,7 @@ func TestMsgCreateSection_ValidateBasic(t *testing.T) {
 				msgCreateSection.Name,
 				msgCreateSection.Description,
 				msgCreateSection.ParentID,
+				msgCreateSection.Visibility,
 				msgCreateSection.Creator,
 			),
 			shouldErr: true,
@@ -266,6 +268,19 @@ func TestMsgCreateSection_ValidateBasic(t *testing.T) {
 				"",
 				msgCreateSection.Description,
 				msgCreateSection.ParentID,
+				msgCreateSection.Visibility,
+				msgCreateSection.Creator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid visibility returns error",
+			msg: types.NewMsgCreateSection(
+				msgCreateSection.SubspaceID,
+				msgCreateSection.Name,
+				msgCreateSection.Description,
+				msgCreateSection.ParentID,
+				types.SectionVisibility(10),
 				msgCreateSection.Creator,
 			),
 			shouldErr: true,
@@ -277,6 +292,7 @@ func TestMsgCreateSection_ValidateBasic(t *testing.T) {
 				msgCreateSection.Name,
 				msgCreateSection.Description,
 				msgCreateSection.ParentID,
+				msgCreateSection.Visibility,
 				"",
 			),
 			shouldErr: true,
@@ -480,6 +496,88 @@ func TestMsgMoveSection_GetSigners(t *testing.T) {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+var msgSetSectionVisibility = types.NewMsgSetSectionVisibility(
+	1,
+	1,
+	types.SECTION_VISIBILITY_MEMBERS_ONLY,
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgSetSectionVisibility_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgSetSectionVisibility.Route())
+}
+
+func TestMsgSetSectionVisibility_Type(t *testing.T) {
+	require.Equal(t, types.ActionSetSectionVisibility, msgSetSectionVisibility.Type())
+}
+
+func TestMsgSetSectionVisibility_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetSectionVisibility
+		shouldErr bool
+	}{
+		{
+			name: "invalid subspace id returns error",
+			msg: types.NewMsgSetSectionVisibility(
+				0,
+				msgSetSectionVisibility.SectionID,
+				msgSetSectionVisibility.Visibility,
+				msgSetSectionVisibility.Signer,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid visibility returns error",
+			msg: types.NewMsgSetSectionVisibility(
+				msgSetSectionVisibility.SubspaceID,
+				msgSetSectionVisibility.SectionID,
+				types.SectionVisibility(10),
+				msgSetSectionVisibility.Signer,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid signer returns error",
+			msg: types.NewMsgSetSectionVisibility(
+				msgSetSectionVisibility.SubspaceID,
+				msgSetSectionVisibility.SectionID,
+				msgSetSectionVisibility.Visibility,
+				"",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgSetSectionVisibility,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetSectionVisibility_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgSetSectionVisibility","value":{"section_id":1,"signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1","visibility":1}}`
+	require.Equal(t, expected, string(msgSetSectionVisibility.GetSignBytes()))
+}
+
+func TestMsgSetSectionVisibility_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgSetSectionVisibility.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgSetSectionVisibility.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 var msgDeleteSection = types.NewMsgDeleteSection(
 	1,
 	1,
@@ -999,6 +1097,7 @@ var msgAddUserToGroup = types.NewMsgAddUserToUserGroup(
 	1,
 	1,
 	"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+	nil,
 	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 )
 
@@ -1022,6 +1121,7 @@ func TestMsgAddUserToUserGroup_ValidateBasic(t *testing.T) {
 				0,
 				1,
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				nil,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
@@ -1032,6 +1132,7 @@ func TestMsgAddUserToUserGroup_ValidateBasic(t *testing.T) {
 				1,
 				0,
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				nil,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
@@ -1042,6 +1143,7 @@ func TestMsgAddUserToUserGroup_ValidateBasic(t *testing.T) {
 				1,
 				1,
 				"cosmos1x5pjlvufs4znn",
+				nil,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
@@ -1052,6 +1154,7 @@ func TestMsgAddUserToUserGroup_ValidateBasic(t *testing.T) {
 				1,
 				1,
 				"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
+				nil,
 				"cosmos1m0czrla04f7rp3zg7d",
 			),
 			shouldErr: true,
@@ -1183,6 +1286,7 @@ var msgSetUserPermissions = types.NewMsgSetUserPermissions(
 	0,
 	"cosmos1x5pjlvufs4znnhhkwe8v4tw3kz30f3lxgwza53",
 	types.NewPermissions(types.PermissionEditSubspace),
+	nil,
 	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 )
 
@@ -1207,6 +1311,7 @@ func TestMsgSetUserPermissions_ValidateBasic(t *testing.T) {
 				1,
 				"group",
 				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
@@ -1218,6 +1323,7 @@ func TestMsgSetUserPermissions_ValidateBasic(t *testing.T) {
 				1,
 				"",
 				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
@@ -1229,6 +1335,7 @@ func TestMsgSetUserPermissions_ValidateBasic(t *testing.T) {
 				1,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
 				"cosmos1m0czrla04f7rp3zg7d",
 			),
 			shouldErr: true,
@@ -1240,6 +1347,7 @@ func TestMsgSetUserPermissions_ValidateBasic(t *testing.T) {
 				1,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 				types.NewPermissions(types.PermissionEditSubspace),
+				nil,
 				"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
 			),
 			shouldErr: true,
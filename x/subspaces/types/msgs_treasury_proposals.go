@@ -0,0 +1,197 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+var (
+	_ sdk.Msg = &MsgSetTreasuryVotingConfig{}
+	_ sdk.Msg = &MsgSubmitTreasuryProposal{}
+	_ sdk.Msg = &MsgVoteTreasuryProposal{}
+
+	_ legacytx.LegacyMsg = &MsgSetTreasuryVotingConfig{}
+	_ legacytx.LegacyMsg = &MsgSubmitTreasuryProposal{}
+	_ legacytx.LegacyMsg = &MsgVoteTreasuryProposal{}
+
+	_ ManageSubspaceMsg = &MsgSetTreasuryVotingConfig{}
+	_ ManageSubspaceMsg = &MsgSubmitTreasuryProposal{}
+	_ ManageSubspaceMsg = &MsgVoteTreasuryProposal{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgSubmitTreasuryProposal{}
+)
+
+// NewMsgSetTreasuryVotingConfig returns a new MsgSetTreasuryVotingConfig instance
+func NewMsgSetTreasuryVotingConfig(
+	subspaceID uint64, groupID uint32, threshold sdk.Dec, quorum *sdk.Dec, votingPeriod time.Duration, signer string,
+) *MsgSetTreasuryVotingConfig {
+	return &MsgSetTreasuryVotingConfig{
+		SubspaceID:   subspaceID,
+		GroupID:      groupID,
+		Threshold:    threshold,
+		Quorum:       quorum,
+		VotingPeriod: votingPeriod,
+		Signer:       signer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgSetTreasuryVotingConfig) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgSetTreasuryVotingConfig) Type() string { return ActionSetTreasuryVotingConfig }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSetTreasuryVotingConfig) ValidateBasic() error {
+	err := NewTreasuryVotingConfig(msg.SubspaceID, msg.GroupID, msg.Threshold, msg.Quorum, msg.VotingPeriod).Validate()
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgSetTreasuryVotingConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgSetTreasuryVotingConfig) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgSetTreasuryVotingConfig) IsManageSubspaceMsg() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgSubmitTreasuryProposal returns a new MsgSubmitTreasuryProposal instance
+func NewMsgSubmitTreasuryProposal(subspaceID uint64, messages []sdk.Msg, proposer string) *MsgSubmitTreasuryProposal {
+	msgsAny, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		panic("failed to pack messages to any type")
+	}
+
+	return &MsgSubmitTreasuryProposal{
+		SubspaceID: subspaceID,
+		Messages:   msgsAny,
+		Proposer:   proposer,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgSubmitTreasuryProposal) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgSubmitTreasuryProposal) Type() string { return ActionSubmitTreasuryProposal }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSubmitTreasuryProposal) ValidateBasic() error {
+	if msg.SubspaceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid subspace id: %d", msg.SubspaceID)
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msgs) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "proposal must contain at least one message")
+	}
+
+	for i, m := range msgs {
+		err = m.ValidateBasic()
+		if err != nil {
+			return errors.Wrapf(err, "invalid message at index %d", i)
+		}
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address")
+	}
+
+	return nil
+}
+
+// GetMsgs returns the messages that should be executed by the proposal
+func (msg *MsgSubmitTreasuryProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Messages, "treasury proposal")
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+// The authz Amino codec is used since it knows about the messages of all the modules
+func (msg *MsgSubmitTreasuryProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgSubmitTreasuryProposal) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgSubmitTreasuryProposal) IsManageSubspaceMsg() {}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (msg *MsgSubmitTreasuryProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Messages)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgVoteTreasuryProposal returns a new MsgVoteTreasuryProposal instance
+func NewMsgVoteTreasuryProposal(subspaceID uint64, proposalID uint64, option TreasuryVoteOption, voter string) *MsgVoteTreasuryProposal {
+	return &MsgVoteTreasuryProposal{
+		SubspaceID: subspaceID,
+		ProposalID: proposalID,
+		Option:     option,
+		Voter:      voter,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgVoteTreasuryProposal) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgVoteTreasuryProposal) Type() string { return ActionVoteTreasuryProposal }
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgVoteTreasuryProposal) ValidateBasic() error {
+	err := NewTreasuryVote(msg.SubspaceID, msg.ProposalID, msg.Voter, msg.Option).Validate()
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgVoteTreasuryProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgVoteTreasuryProposal) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{addr}
+}
+
+// IsManageSubspaceMsg implements subspacestypes.ManageSubspaceMsg
+func (msg *MsgVoteTreasuryProposal) IsManageSubspaceMsg() {}
@@ -0,0 +1,258 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/desmos-labs/desmos/v7/x/subspaces/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var msgSetTreasuryVotingConfig = types.NewMsgSetTreasuryVotingConfig(
+	1,
+	1,
+	sdk.NewDecWithPrec(5, 1),
+	nil,
+	time.Hour,
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgSetTreasuryVotingConfig_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgSetTreasuryVotingConfig.Route())
+}
+
+func TestMsgSetTreasuryVotingConfig_Type(t *testing.T) {
+	require.Equal(t, types.ActionSetTreasuryVotingConfig, msgSetTreasuryVotingConfig.Type())
+}
+
+func TestMsgSetTreasuryVotingConfig_ValidateBasic(t *testing.T) {
+	invalidQuorum := sdk.ZeroDec()
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetTreasuryVotingConfig
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgSetTreasuryVotingConfig(0, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour, msgSetTreasuryVotingConfig.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid group id returns error",
+			msg:       types.NewMsgSetTreasuryVotingConfig(1, 0, sdk.NewDecWithPrec(5, 1), nil, time.Hour, msgSetTreasuryVotingConfig.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid threshold returns error",
+			msg:       types.NewMsgSetTreasuryVotingConfig(1, 1, sdk.NewDec(2), nil, time.Hour, msgSetTreasuryVotingConfig.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid quorum returns error",
+			msg:       types.NewMsgSetTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), &invalidQuorum, time.Hour, msgSetTreasuryVotingConfig.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid voting period returns error",
+			msg:       types.NewMsgSetTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, 0, msgSetTreasuryVotingConfig.Signer),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgSetTreasuryVotingConfig(1, 1, sdk.NewDecWithPrec(5, 1), nil, time.Hour, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgSetTreasuryVotingConfig,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetTreasuryVotingConfig_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgSetTreasuryVotingConfig","value":{"group_id":1,"signer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1","threshold":"0.500000000000000000","voting_period":"3600000000000"}}`
+	require.Equal(t, expected, string(msgSetTreasuryVotingConfig.GetSignBytes()))
+}
+
+func TestMsgSetTreasuryVotingConfig_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgSetTreasuryVotingConfig.Signer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgSetTreasuryVotingConfig.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgSubmitTreasuryProposal = types.NewMsgSubmitTreasuryProposal(
+	1,
+	[]sdk.Msg{
+		types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+	},
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgSubmitTreasuryProposal_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgSubmitTreasuryProposal.Route())
+}
+
+func TestMsgSubmitTreasuryProposal_Type(t *testing.T) {
+	require.Equal(t, types.ActionSubmitTreasuryProposal, msgSubmitTreasuryProposal.Type())
+}
+
+func TestMsgSubmitTreasuryProposal_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSubmitTreasuryProposal
+		shouldErr bool
+	}{
+		{
+			name: "invalid subspace id returns error",
+			msg: types.NewMsgSubmitTreasuryProposal(
+				0,
+				[]sdk.Msg{
+					types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+				},
+				msgSubmitTreasuryProposal.Proposer,
+			),
+			shouldErr: true,
+		},
+		{
+			name:      "empty messages returns error",
+			msg:       types.NewMsgSubmitTreasuryProposal(1, nil, msgSubmitTreasuryProposal.Proposer),
+			shouldErr: true,
+		},
+		{
+			name: "invalid message returns error",
+			msg: types.NewMsgSubmitTreasuryProposal(
+				1,
+				[]sdk.Msg{
+					types.NewMsgEditSubspace(0, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+				},
+				msgSubmitTreasuryProposal.Proposer,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid proposer returns error",
+			msg: types.NewMsgSubmitTreasuryProposal(
+				1,
+				[]sdk.Msg{
+					types.NewMsgEditSubspace(1, "This is a new name", types.DoNotModify, types.DoNotModify, "cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn"),
+				},
+				"cosmos1m0czrla04f7rp3z",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgSubmitTreasuryProposal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSubmitTreasuryProposal_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgSubmitTreasuryProposal","value":{"messages":[{"type":"desmos/MsgEditSubspace","value":{"description":"[do-not-modify]","name":"This is a new name","owner":"[do-not-modify]","signer":"cosmos1s0he0z3g92zwsxdj83h0ky9w463sx7gq9mqtgn","subspace_id":"1"}}],"proposer":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5","subspace_id":"1"}}`
+	require.Equal(t, expected, string(msgSubmitTreasuryProposal.GetSignBytes()))
+}
+
+func TestMsgSubmitTreasuryProposal_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgSubmitTreasuryProposal.Proposer)
+	require.Equal(t, []sdk.AccAddress{addr}, msgSubmitTreasuryProposal.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgVoteTreasuryProposal = types.NewMsgVoteTreasuryProposal(
+	1,
+	1,
+	types.TREASURY_VOTE_OPTION_YES,
+	"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5",
+)
+
+func TestMsgVoteTreasuryProposal_Route(t *testing.T) {
+	require.Equal(t, types.RouterKey, msgVoteTreasuryProposal.Route())
+}
+
+func TestMsgVoteTreasuryProposal_Type(t *testing.T) {
+	require.Equal(t, types.ActionVoteTreasuryProposal, msgVoteTreasuryProposal.Type())
+}
+
+func TestMsgVoteTreasuryProposal_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgVoteTreasuryProposal
+		shouldErr bool
+	}{
+		{
+			name:      "invalid subspace id returns error",
+			msg:       types.NewMsgVoteTreasuryProposal(0, 1, types.TREASURY_VOTE_OPTION_YES, msgVoteTreasuryProposal.Voter),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid proposal id returns error",
+			msg:       types.NewMsgVoteTreasuryProposal(1, 0, types.TREASURY_VOTE_OPTION_YES, msgVoteTreasuryProposal.Voter),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid option returns error",
+			msg:       types.NewMsgVoteTreasuryProposal(1, 1, types.TREASURY_VOTE_OPTION_UNSPECIFIED, msgVoteTreasuryProposal.Voter),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid voter returns error",
+			msg:       types.NewMsgVoteTreasuryProposal(1, 1, types.TREASURY_VOTE_OPTION_YES, "cosmos1m0czrla04f7rp3z"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgVoteTreasuryProposal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgVoteTreasuryProposal_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgVoteTreasuryProposal","value":{"option":1,"proposal_id":"1","subspace_id":"1","voter":"cosmos1m0czrla04f7rp3zg7dsgc4kla54q7pc4xt00l5"}}`
+	require.Equal(t, expected, string(msgVoteTreasuryProposal.GetSignBytes()))
+}
+
+func TestMsgVoteTreasuryProposal_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgVoteTreasuryProposal.Voter)
+	require.Equal(t, []sdk.AccAddress{addr}, msgVoteTreasuryProposal.GetSigners())
+}
@@ -29,6 +29,21 @@ var (
 	// PermissionManageAllowances allows users to manage others fee allowances
 	PermissionManageAllowances = RegisterPermission("manage allowances")
 
+	// PermissionReadContent allows users to read the contents of group-restricted sections
+	PermissionReadContent = RegisterPermission("read content")
+
+	// PermissionBanUsers allows users to ban other users from the subspace
+	PermissionBanUsers = RegisterPermission("ban users")
+
+	// PermissionManageRateLimits allows users to manage the subspace rate limits
+	PermissionManageRateLimits = RegisterPermission("manage rate limits")
+
+	// PermissionBypassRateLimits allows users to send messages without being subject to the subspace rate limits
+	PermissionBypassRateLimits = RegisterPermission("bypass rate limits")
+
+	// PermissionManageFeeTokens allows users to manage the conversion rates and destination of the subspace fee tokens
+	PermissionManageFeeTokens = RegisterPermission("manage fee tokens")
+
 	// PermissionEverything allows to do everything.
 	// This should usually be reserved only to the owner (which has it by default)
 	PermissionEverything = RegisterPermission("everything")
@@ -19,6 +19,14 @@ func NewQuerySubspacesRequest(pagination *query.PageRequest) *QuerySubspacesRequ
 	}
 }
 
+// NewQueryIncomingSubspaceOwnerTransferRequestsRequest returns a new QueryIncomingSubspaceOwnerTransferRequestsRequest instance
+func NewQueryIncomingSubspaceOwnerTransferRequestsRequest(receiver string, pagination *query.PageRequest) *QueryIncomingSubspaceOwnerTransferRequestsRequest {
+	return &QueryIncomingSubspaceOwnerTransferRequestsRequest{
+		Receiver:   receiver,
+		Pagination: pagination,
+	}
+}
+
 // NewQuerySectionsRequest returns a new QuerySectionsRequest instance
 func NewQuerySectionsRequest(subspaceID uint64, pagination *query.PageRequest) *QuerySectionsRequest {
 	return &QuerySectionsRequest{
@@ -63,6 +71,59 @@ func NewQueryUserGroupMembersRequest(
 	}
 }
 
+// NewQueryGroupInvitesRequest returns a new QueryGroupInvitesRequest instance
+func NewQueryGroupInvitesRequest(subspaceID uint64, groupID uint32, pagination *query.PageRequest) *QueryGroupInvitesRequest {
+	return &QueryGroupInvitesRequest{
+		SubspaceId: subspaceID,
+		GroupId:    groupID,
+		Pagination: pagination,
+	}
+}
+
+// NewQueryGroupInviteRequest returns a new QueryGroupInviteRequest instance
+func NewQueryGroupInviteRequest(subspaceID uint64, secretHash string) *QueryGroupInviteRequest {
+	return &QueryGroupInviteRequest{
+		SubspaceId: subspaceID,
+		SecretHash: secretHash,
+	}
+}
+
+// NewQueryGroupApplicationsRequest returns a new QueryGroupApplicationsRequest instance
+func NewQueryGroupApplicationsRequest(
+	subspaceID uint64, groupID uint32, pagination *query.PageRequest,
+) *QueryGroupApplicationsRequest {
+	return &QueryGroupApplicationsRequest{
+		SubspaceId: subspaceID,
+		GroupId:    groupID,
+		Pagination: pagination,
+	}
+}
+
+// NewQueryGroupApplicationRequest returns a new QueryGroupApplicationRequest instance
+func NewQueryGroupApplicationRequest(subspaceID uint64, groupID uint32, applicant string) *QueryGroupApplicationRequest {
+	return &QueryGroupApplicationRequest{
+		SubspaceId: subspaceID,
+		GroupId:    groupID,
+		Applicant:  applicant,
+	}
+}
+
+// NewQuerySubspaceBansRequest returns a new QuerySubspaceBansRequest instance
+func NewQuerySubspaceBansRequest(subspaceID uint64, pagination *query.PageRequest) *QuerySubspaceBansRequest {
+	return &QuerySubspaceBansRequest{
+		SubspaceId: subspaceID,
+		Pagination: pagination,
+	}
+}
+
+// NewQueryRateLimitsRequest returns a new QueryRateLimitsRequest instance
+func NewQueryRateLimitsRequest(subspaceID uint64, pagination *query.PageRequest) *QueryRateLimitsRequest {
+	return &QueryRateLimitsRequest{
+		SubspaceId: subspaceID,
+		Pagination: pagination,
+	}
+}
+
 // NewQueryUserPermissionsRequest returns a new QueryPermissionsRequest instance
 func NewQueryUserPermissionsRequest(subspaceID uint64, sectionID uint32, user string) *QueryUserPermissionsRequest {
 	return &QueryUserPermissionsRequest{
@@ -100,6 +161,51 @@ func NewPermissionDetailGroup(subspaceID uint64, sectionID uint32, groupID uint3
 	}
 }
 
+// NewQueryPermissionDetailsRequest returns a new QueryPermissionDetailsRequest instance
+func NewQueryPermissionDetailsRequest(subspaceID uint64, sectionID uint32, user string, permission Permission) *QueryPermissionDetailsRequest {
+	return &QueryPermissionDetailsRequest{
+		SubspaceId: subspaceID,
+		SectionId:  sectionID,
+		User:       user,
+		Permission: permission,
+	}
+}
+
+// NewPermissionSourceOwner returns a new PermissionSource representing the subspace ownership
+func NewPermissionSourceOwner() PermissionSource {
+	return PermissionSource{
+		SectionId: RootSectionID,
+		Sum:       &PermissionSource_Owner_{Owner: &PermissionSource_Owner{}},
+	}
+}
+
+// NewPermissionSourceUser returns a new PermissionSource representing the permissions set directly to a user
+func NewPermissionSourceUser(sectionID uint32, inherited bool, permissions Permissions) PermissionSource {
+	return PermissionSource{
+		SectionId: sectionID,
+		Inherited: inherited,
+		Sum: &PermissionSource_User_{
+			User: &PermissionSource_User{
+				Permissions: permissions,
+			},
+		},
+	}
+}
+
+// NewPermissionSourceGroup returns a new PermissionSource representing the permissions set to a user group
+func NewPermissionSourceGroup(sectionID uint32, inherited bool, groupID uint32, permissions Permissions) PermissionSource {
+	return PermissionSource{
+		SectionId: sectionID,
+		Inherited: inherited,
+		Sum: &PermissionSource_Group_{
+			Group: &PermissionSource_Group{
+				GroupID:     groupID,
+				Permissions: permissions,
+			},
+		},
+	}
+}
+
 // NewQueryUserAllowancesRequest returns a new QueryUserAllowancesRequest instance
 func NewQueryUserAllowancesRequest(subspaceID uint64, grantee string, pagination *query.PageRequest) *QueryUserAllowancesRequest {
 	return &QueryUserAllowancesRequest{
@@ -139,3 +245,63 @@ func (r *QueryGroupAllowancesResponse) UnpackInterfaces(unpacker codectypes.AnyU
 	}
 	return nil
 }
+
+// NewQueryAllowanceUsagesRequest returns a new QueryAllowanceUsagesRequest instance
+func NewQueryAllowanceUsagesRequest(subspaceID uint64, grantee string, pagination *query.PageRequest) *QueryAllowanceUsagesRequest {
+	return &QueryAllowanceUsagesRequest{
+		SubspaceId: subspaceID,
+		Grantee:    grantee,
+		Pagination: pagination,
+	}
+}
+
+// NewQueryFeeTokensConfigRequest returns a new QueryFeeTokensConfigRequest instance
+func NewQueryFeeTokensConfigRequest(subspaceID uint64) *QueryFeeTokensConfigRequest {
+	return &QueryFeeTokensConfigRequest{SubspaceId: subspaceID}
+}
+
+// NewQueryTreasuryVotingConfigRequest returns a new QueryTreasuryVotingConfigRequest instance
+func NewQueryTreasuryVotingConfigRequest(subspaceID uint64) *QueryTreasuryVotingConfigRequest {
+	return &QueryTreasuryVotingConfigRequest{SubspaceId: subspaceID}
+}
+
+// NewQueryTreasuryProposalsRequest returns a new QueryTreasuryProposalsRequest instance
+func NewQueryTreasuryProposalsRequest(subspaceID uint64, pagination *query.PageRequest) *QueryTreasuryProposalsRequest {
+	return &QueryTreasuryProposalsRequest{
+		SubspaceId: subspaceID,
+		Pagination: pagination,
+	}
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (r *QueryTreasuryProposalsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, proposal := range r.Proposals {
+		err := proposal.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewQueryTreasuryProposalRequest returns a new QueryTreasuryProposalRequest instance
+func NewQueryTreasuryProposalRequest(subspaceID uint64, proposalID uint64) *QueryTreasuryProposalRequest {
+	return &QueryTreasuryProposalRequest{
+		SubspaceId: subspaceID,
+		ProposalId: proposalID,
+	}
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (r *QueryTreasuryProposalResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return r.Proposal.UnpackInterfaces(unpacker)
+}
+
+// NewQueryTreasuryProposalVotesRequest returns a new QueryTreasuryProposalVotesRequest instance
+func NewQueryTreasuryProposalVotesRequest(subspaceID uint64, proposalID uint64, pagination *query.PageRequest) *QueryTreasuryProposalVotesRequest {
+	return &QueryTreasuryProposalVotesRequest{
+		SubspaceId: subspaceID,
+		ProposalId: proposalID,
+		Pagination: pagination,
+	}
+}
@@ -216,6 +216,114 @@ func (m *QuerySubspaceResponse) GetSubspace() Subspace {
 	return Subspace{}
 }
 
+// QueryIncomingSubspaceOwnerTransferRequestsRequest is the request type for
+// the Query/IncomingSubspaceOwnerTransferRequests RPC endpoint
+type QueryIncomingSubspaceOwnerTransferRequestsRequest struct {
+	// (optional) Receiver represents the address of the user to which query the
+	// incoming requests for
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) Reset() {
+	*m = QueryIncomingSubspaceOwnerTransferRequestsRequest{}
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryIncomingSubspaceOwnerTransferRequestsRequest) ProtoMessage() {}
+func (*QueryIncomingSubspaceOwnerTransferRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{4}
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncomingSubspaceOwnerTransferRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncomingSubspaceOwnerTransferRequestsRequest.Merge(m, src)
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncomingSubspaceOwnerTransferRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncomingSubspaceOwnerTransferRequestsRequest proto.InternalMessageInfo
+
+// QueryIncomingSubspaceOwnerTransferRequestsResponse is the response type for
+// the Query/IncomingSubspaceOwnerTransferRequests RPC method
+type QueryIncomingSubspaceOwnerTransferRequestsResponse struct {
+	// Requests represent the list of all the subspace owner transfer requests
+	// made towards the receiver
+	Requests []SubspaceOwnerTransferRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	// Pagination defines the pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) Reset() {
+	*m = QueryIncomingSubspaceOwnerTransferRequestsResponse{}
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryIncomingSubspaceOwnerTransferRequestsResponse) ProtoMessage() {}
+func (*QueryIncomingSubspaceOwnerTransferRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{5}
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncomingSubspaceOwnerTransferRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncomingSubspaceOwnerTransferRequestsResponse.Merge(m, src)
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncomingSubspaceOwnerTransferRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncomingSubspaceOwnerTransferRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) GetRequests() []SubspaceOwnerTransferRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QuerySectionsRequest is the request type for Query/Sections RPC method
 type QuerySectionsRequest struct {
 	// Id of the subspace to query the sections for
@@ -228,7 +336,7 @@ func (m *QuerySectionsRequest) Reset()         { *m = QuerySectionsRequest{} }
 func (m *QuerySectionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySectionsRequest) ProtoMessage()    {}
 func (*QuerySectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{4}
+	return fileDescriptor_ca70010567dbc47d, []int{6}
 }
 func (m *QuerySectionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -281,7 +389,7 @@ func (m *QuerySectionsResponse) Reset()         { *m = QuerySectionsResponse{} }
 func (m *QuerySectionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySectionsResponse) ProtoMessage()    {}
 func (*QuerySectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{5}
+	return fileDescriptor_ca70010567dbc47d, []int{7}
 }
 func (m *QuerySectionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +444,7 @@ func (m *QuerySectionRequest) Reset()         { *m = QuerySectionRequest{} }
 func (m *QuerySectionRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySectionRequest) ProtoMessage()    {}
 func (*QuerySectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{6}
+	return fileDescriptor_ca70010567dbc47d, []int{8}
 }
 func (m *QuerySectionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +496,7 @@ func (m *QuerySectionResponse) Reset()         { *m = QuerySectionResponse{} }
 func (m *QuerySectionResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySectionResponse) ProtoMessage()    {}
 func (*QuerySectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{7}
+	return fileDescriptor_ca70010567dbc47d, []int{9}
 }
 func (m *QuerySectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +547,7 @@ func (m *QueryUserGroupsRequest) Reset()         { *m = QueryUserGroupsRequest{}
 func (m *QueryUserGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUserGroupsRequest) ProtoMessage()    {}
 func (*QueryUserGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{8}
+	return fileDescriptor_ca70010567dbc47d, []int{10}
 }
 func (m *QueryUserGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,7 +608,7 @@ func (m *QueryUserGroupsResponse) Reset()         { *m = QueryUserGroupsResponse
 func (m *QueryUserGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUserGroupsResponse) ProtoMessage()    {}
 func (*QueryUserGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{9}
+	return fileDescriptor_ca70010567dbc47d, []int{11}
 }
 func (m *QueryUserGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +663,7 @@ func (m *QueryUserGroupRequest) Reset()         { *m = QueryUserGroupRequest{} }
 func (m *QueryUserGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUserGroupRequest) ProtoMessage()    {}
 func (*QueryUserGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{10}
+	return fileDescriptor_ca70010567dbc47d, []int{12}
 }
 func (m *QueryUserGroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +716,7 @@ func (m *QueryUserGroupResponse) Reset()         { *m = QueryUserGroupResponse{}
 func (m *QueryUserGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUserGroupResponse) ProtoMessage()    {}
 func (*QueryUserGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{11}
+	return fileDescriptor_ca70010567dbc47d, []int{13}
 }
 func (m *QueryUserGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +767,7 @@ func (m *QueryUserGroupMembersRequest) Reset()         { *m = QueryUserGroupMemb
 func (m *QueryUserGroupMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUserGroupMembersRequest) ProtoMessage()    {}
 func (*QueryUserGroupMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{12}
+	return fileDescriptor_ca70010567dbc47d, []int{14}
 }
 func (m *QueryUserGroupMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -720,7 +828,7 @@ func (m *QueryUserGroupMembersResponse) Reset()         { *m = QueryUserGroupMem
 func (m *QueryUserGroupMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUserGroupMembersResponse) ProtoMessage()    {}
 func (*QueryUserGroupMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{13}
+	return fileDescriptor_ca70010567dbc47d, []int{15}
 }
 func (m *QueryUserGroupMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,29 +871,29 @@ func (m *QueryUserGroupMembersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryUserPermissionsRequest is the request type for the Query/UserPermissions
-// RPC method
-type QueryUserPermissionsRequest struct {
-	// Id of the subspace to query the permissions for
+// QueryGroupInvitesRequest is the request type for the Query/GroupInvites RPC
+// method
+type QueryGroupInvitesRequest struct {
+	// Id of the subspace to query the invites for
 	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// Id of the section to query the permissions for
-	SectionId uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
-	// Address of the user to query the permissions for
-	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	// (optional) Id of the user group to query the invites for
+	GroupId uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryUserPermissionsRequest) Reset()         { *m = QueryUserPermissionsRequest{} }
-func (m *QueryUserPermissionsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryUserPermissionsRequest) ProtoMessage()    {}
-func (*QueryUserPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{14}
+func (m *QueryGroupInvitesRequest) Reset()         { *m = QueryGroupInvitesRequest{} }
+func (m *QueryGroupInvitesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupInvitesRequest) ProtoMessage()    {}
+func (*QueryGroupInvitesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{16}
 }
-func (m *QueryUserPermissionsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGroupInvitesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUserPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGroupInvitesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUserPermissionsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGroupInvitesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -795,37 +903,58 @@ func (m *QueryUserPermissionsRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryUserPermissionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUserPermissionsRequest.Merge(m, src)
+func (m *QueryGroupInvitesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupInvitesRequest.Merge(m, src)
 }
-func (m *QueryUserPermissionsRequest) XXX_Size() int {
+func (m *QueryGroupInvitesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUserPermissionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUserPermissionsRequest.DiscardUnknown(m)
+func (m *QueryGroupInvitesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupInvitesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUserPermissionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGroupInvitesRequest proto.InternalMessageInfo
 
-// QueryUserPermissionsRequest is the response type for the
-// Query/UserPermissions method
-type QueryUserPermissionsResponse struct {
-	Permissions []string           `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty" yaml:"permissions"`
-	Details     []PermissionDetail `protobuf:"bytes,2,rep,name=details,proto3" json:"details" yaml:"details"`
+func (m *QueryGroupInvitesRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
+	}
+	return 0
 }
 
-func (m *QueryUserPermissionsResponse) Reset()         { *m = QueryUserPermissionsResponse{} }
-func (m *QueryUserPermissionsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryUserPermissionsResponse) ProtoMessage()    {}
-func (*QueryUserPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{15}
+func (m *QueryGroupInvitesRequest) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
 }
-func (m *QueryUserPermissionsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGroupInvitesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGroupInvitesResponse is the response type for the Query/GroupInvites
+// RPC method
+type QueryGroupInvitesResponse struct {
+	Invites    []GroupInvite       `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGroupInvitesResponse) Reset()         { *m = QueryGroupInvitesResponse{} }
+func (m *QueryGroupInvitesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupInvitesResponse) ProtoMessage()    {}
+func (*QueryGroupInvitesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{17}
+}
+func (m *QueryGroupInvitesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUserPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGroupInvitesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUserPermissionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGroupInvitesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -835,60 +964,53 @@ func (m *QueryUserPermissionsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryUserPermissionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUserPermissionsResponse.Merge(m, src)
+func (m *QueryGroupInvitesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupInvitesResponse.Merge(m, src)
 }
-func (m *QueryUserPermissionsResponse) XXX_Size() int {
+func (m *QueryGroupInvitesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUserPermissionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUserPermissionsResponse.DiscardUnknown(m)
+func (m *QueryGroupInvitesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupInvitesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUserPermissionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGroupInvitesResponse proto.InternalMessageInfo
 
-func (m *QueryUserPermissionsResponse) GetPermissions() []string {
+func (m *QueryGroupInvitesResponse) GetInvites() []GroupInvite {
 	if m != nil {
-		return m.Permissions
+		return m.Invites
 	}
 	return nil
 }
 
-func (m *QueryUserPermissionsResponse) GetDetails() []PermissionDetail {
+func (m *QueryGroupInvitesResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Details
+		return m.Pagination
 	}
 	return nil
 }
 
-// PermissionDetail contains the details data of a permission
-type PermissionDetail struct {
-	// Id of the subspace for which this permission is valid
-	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
-	// Id of the section for which this permission is valid
-	SectionId uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
-	// sum is the oneof that specifies whether this represents a user or
-	// group permission detail
-	//
-	// Types that are valid to be assigned to Sum:
-	//
-	//	*PermissionDetail_User_
-	//	*PermissionDetail_Group_
-	Sum isPermissionDetail_Sum `protobuf_oneof:"sum"`
+// QueryGroupInviteRequest is the request type for the Query/GroupInvite RPC
+// method
+type QueryGroupInviteRequest struct {
+	// Id of the subspace that contains the invite
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Hash of the secret associated with the invite to query
+	SecretHash string `protobuf:"bytes,2,opt,name=secret_hash,json=secretHash,proto3" json:"secret_hash,omitempty" yaml:"secret_hash"`
 }
 
-func (m *PermissionDetail) Reset()         { *m = PermissionDetail{} }
-func (m *PermissionDetail) String() string { return proto.CompactTextString(m) }
-func (*PermissionDetail) ProtoMessage()    {}
-func (*PermissionDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{16}
+func (m *QueryGroupInviteRequest) Reset()         { *m = QueryGroupInviteRequest{} }
+func (m *QueryGroupInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupInviteRequest) ProtoMessage()    {}
+func (*QueryGroupInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{18}
 }
-func (m *PermissionDetail) XXX_Unmarshal(b []byte) error {
+func (m *QueryGroupInviteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PermissionDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGroupInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PermissionDetail.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGroupInviteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -898,84 +1020,50 @@ func (m *PermissionDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *PermissionDetail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PermissionDetail.Merge(m, src)
+func (m *QueryGroupInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupInviteRequest.Merge(m, src)
 }
-func (m *PermissionDetail) XXX_Size() int {
+func (m *QueryGroupInviteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PermissionDetail) XXX_DiscardUnknown() {
-	xxx_messageInfo_PermissionDetail.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PermissionDetail proto.InternalMessageInfo
-
-type isPermissionDetail_Sum interface {
-	isPermissionDetail_Sum()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type PermissionDetail_User_ struct {
-	User *PermissionDetail_User `protobuf:"bytes,3,opt,name=user,proto3,oneof" json:"user,omitempty"`
-}
-type PermissionDetail_Group_ struct {
-	Group *PermissionDetail_Group `protobuf:"bytes,4,opt,name=group,proto3,oneof" json:"group,omitempty"`
+func (m *QueryGroupInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupInviteRequest.DiscardUnknown(m)
 }
 
-func (*PermissionDetail_User_) isPermissionDetail_Sum()  {}
-func (*PermissionDetail_Group_) isPermissionDetail_Sum() {}
+var xxx_messageInfo_QueryGroupInviteRequest proto.InternalMessageInfo
 
-func (m *PermissionDetail) GetSum() isPermissionDetail_Sum {
+func (m *QueryGroupInviteRequest) GetSubspaceId() uint64 {
 	if m != nil {
-		return m.Sum
-	}
-	return nil
-}
-
-func (m *PermissionDetail) GetUser() *PermissionDetail_User {
-	if x, ok := m.GetSum().(*PermissionDetail_User_); ok {
-		return x.User
-	}
-	return nil
-}
-
-func (m *PermissionDetail) GetGroup() *PermissionDetail_Group {
-	if x, ok := m.GetSum().(*PermissionDetail_Group_); ok {
-		return x.Group
+		return m.SubspaceId
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*PermissionDetail) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*PermissionDetail_User_)(nil),
-		(*PermissionDetail_Group_)(nil),
+func (m *QueryGroupInviteRequest) GetSecretHash() string {
+	if m != nil {
+		return m.SecretHash
 	}
+	return ""
 }
 
-// User is a permission that has been set to a specific user
-type PermissionDetail_User struct {
-	// User for which the permission was set
-	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
-	// Permissions set to the user
-	Permission []string `protobuf:"bytes,2,rep,name=permission,proto3" json:"permission,omitempty" yaml:"permission"`
+// QueryGroupInviteResponse is the response type for the Query/GroupInvite RPC
+// method
+type QueryGroupInviteResponse struct {
+	Invite GroupInvite `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite"`
 }
 
-func (m *PermissionDetail_User) Reset()         { *m = PermissionDetail_User{} }
-func (m *PermissionDetail_User) String() string { return proto.CompactTextString(m) }
-func (*PermissionDetail_User) ProtoMessage()    {}
-func (*PermissionDetail_User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{16, 0}
+func (m *QueryGroupInviteResponse) Reset()         { *m = QueryGroupInviteResponse{} }
+func (m *QueryGroupInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupInviteResponse) ProtoMessage()    {}
+func (*QueryGroupInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{19}
 }
-func (m *PermissionDetail_User) XXX_Unmarshal(b []byte) error {
+func (m *QueryGroupInviteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PermissionDetail_User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGroupInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PermissionDetail_User.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGroupInviteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -985,38 +1073,48 @@ func (m *PermissionDetail_User) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *PermissionDetail_User) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PermissionDetail_User.Merge(m, src)
+func (m *QueryGroupInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupInviteResponse.Merge(m, src)
 }
-func (m *PermissionDetail_User) XXX_Size() int {
+func (m *QueryGroupInviteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PermissionDetail_User) XXX_DiscardUnknown() {
-	xxx_messageInfo_PermissionDetail_User.DiscardUnknown(m)
+func (m *QueryGroupInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupInviteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PermissionDetail_User proto.InternalMessageInfo
+var xxx_messageInfo_QueryGroupInviteResponse proto.InternalMessageInfo
 
-// Group is a permission that has been set to a user group
-type PermissionDetail_Group struct {
-	// Unique id of the group
-	GroupID uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
-	// Permissions set to the group
-	Permission []string `protobuf:"bytes,2,rep,name=permission,proto3" json:"permission,omitempty"`
+func (m *QueryGroupInviteResponse) GetInvite() GroupInvite {
+	if m != nil {
+		return m.Invite
+	}
+	return GroupInvite{}
 }
 
-func (m *PermissionDetail_Group) Reset()         { *m = PermissionDetail_Group{} }
-func (m *PermissionDetail_Group) String() string { return proto.CompactTextString(m) }
-func (*PermissionDetail_Group) ProtoMessage()    {}
-func (*PermissionDetail_Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{16, 1}
+// QueryGroupApplicationsRequest is the request type for the
+// Query/GroupApplications RPC method
+type QueryGroupApplicationsRequest struct {
+	// Id of the subspace that contains the group
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the user group to query the applications for
+	GroupId uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *PermissionDetail_Group) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGroupApplicationsRequest) Reset()         { *m = QueryGroupApplicationsRequest{} }
+func (m *QueryGroupApplicationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupApplicationsRequest) ProtoMessage()    {}
+func (*QueryGroupApplicationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{20}
+}
+func (m *QueryGroupApplicationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PermissionDetail_Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGroupApplicationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PermissionDetail_Group.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGroupApplicationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1026,41 +1124,58 @@ func (m *PermissionDetail_Group) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *PermissionDetail_Group) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PermissionDetail_Group.Merge(m, src)
+func (m *QueryGroupApplicationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupApplicationsRequest.Merge(m, src)
 }
-func (m *PermissionDetail_Group) XXX_Size() int {
+func (m *QueryGroupApplicationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PermissionDetail_Group) XXX_DiscardUnknown() {
-	xxx_messageInfo_PermissionDetail_Group.DiscardUnknown(m)
+func (m *QueryGroupApplicationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupApplicationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PermissionDetail_Group proto.InternalMessageInfo
+var xxx_messageInfo_QueryGroupApplicationsRequest proto.InternalMessageInfo
 
-// QueryUserAllowancesRequest is the request type for the Query/UserAllowances
-// RPC method
-type QueryUserAllowancesRequest struct {
-	// Id of the subspace for which to get the grant(s)
-	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// (Optional) Address of the user that was granted an allowance
-	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
-	// pagination defines an pagination for the request
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGroupApplicationsRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
+	}
+	return 0
 }
 
-func (m *QueryUserAllowancesRequest) Reset()         { *m = QueryUserAllowancesRequest{} }
-func (m *QueryUserAllowancesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryUserAllowancesRequest) ProtoMessage()    {}
-func (*QueryUserAllowancesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{17}
+func (m *QueryGroupApplicationsRequest) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
 }
-func (m *QueryUserAllowancesRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGroupApplicationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGroupApplicationsResponse is the response type for the
+// Query/GroupApplications RPC method
+type QueryGroupApplicationsResponse struct {
+	Applications []GroupApplication  `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGroupApplicationsResponse) Reset()         { *m = QueryGroupApplicationsResponse{} }
+func (m *QueryGroupApplicationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupApplicationsResponse) ProtoMessage()    {}
+func (*QueryGroupApplicationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{21}
+}
+func (m *QueryGroupApplicationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUserAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGroupApplicationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUserAllowancesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGroupApplicationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1070,38 +1185,55 @@ func (m *QueryUserAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryUserAllowancesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUserAllowancesRequest.Merge(m, src)
+func (m *QueryGroupApplicationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupApplicationsResponse.Merge(m, src)
 }
-func (m *QueryUserAllowancesRequest) XXX_Size() int {
+func (m *QueryGroupApplicationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUserAllowancesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUserAllowancesRequest.DiscardUnknown(m)
+func (m *QueryGroupApplicationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupApplicationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUserAllowancesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGroupApplicationsResponse proto.InternalMessageInfo
 
-// QueryUserAllowancesResponse is the response type for the Query/UserAllowances
-// RPC method
-type QueryUserAllowancesResponse struct {
-	Grants []Grant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants" yaml:"grants"`
-	// pagination defines an pagination for the response
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGroupApplicationsResponse) GetApplications() []GroupApplication {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
 }
 
-func (m *QueryUserAllowancesResponse) Reset()         { *m = QueryUserAllowancesResponse{} }
-func (m *QueryUserAllowancesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryUserAllowancesResponse) ProtoMessage()    {}
-func (*QueryUserAllowancesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{18}
+func (m *QueryGroupApplicationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryUserAllowancesResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryGroupApplicationRequest is the request type for the
+// Query/GroupApplication RPC method
+type QueryGroupApplicationRequest struct {
+	// Id of the subspace that contains the group
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the user group the application refers to
+	GroupId uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Address of the user that applied to join the group
+	Applicant string `protobuf:"bytes,3,opt,name=applicant,proto3" json:"applicant,omitempty"`
+}
+
+func (m *QueryGroupApplicationRequest) Reset()         { *m = QueryGroupApplicationRequest{} }
+func (m *QueryGroupApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupApplicationRequest) ProtoMessage()    {}
+func (*QueryGroupApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{22}
+}
+func (m *QueryGroupApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUserAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGroupApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUserAllowancesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGroupApplicationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1111,55 +1243,106 @@ func (m *QueryUserAllowancesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryUserAllowancesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUserAllowancesResponse.Merge(m, src)
+func (m *QueryGroupApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupApplicationRequest.Merge(m, src)
 }
-func (m *QueryUserAllowancesResponse) XXX_Size() int {
+func (m *QueryGroupApplicationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUserAllowancesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUserAllowancesResponse.DiscardUnknown(m)
+func (m *QueryGroupApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupApplicationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUserAllowancesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGroupApplicationRequest proto.InternalMessageInfo
 
-func (m *QueryUserAllowancesResponse) GetGrants() []Grant {
+func (m *QueryGroupApplicationRequest) GetSubspaceId() uint64 {
 	if m != nil {
-		return m.Grants
+		return m.SubspaceId
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryUserAllowancesResponse) GetPagination() *query.PageResponse {
+func (m *QueryGroupApplicationRequest) GetGroupId() uint32 {
 	if m != nil {
-		return m.Pagination
+		return m.GroupId
 	}
-	return nil
+	return 0
 }
 
-// QueryGroupAllowancesRequest is the request type for the Query/GroupAllowances
-// RPC method
-type QueryGroupAllowancesRequest struct {
-	// Id of the subspace for which to get the grant(s)
+func (m *QueryGroupApplicationRequest) GetApplicant() string {
+	if m != nil {
+		return m.Applicant
+	}
+	return ""
+}
+
+// QueryGroupApplicationResponse is the response type for the
+// Query/GroupApplication RPC method
+type QueryGroupApplicationResponse struct {
+	Application GroupApplication `protobuf:"bytes,1,opt,name=application,proto3" json:"application"`
+}
+
+func (m *QueryGroupApplicationResponse) Reset()         { *m = QueryGroupApplicationResponse{} }
+func (m *QueryGroupApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupApplicationResponse) ProtoMessage()    {}
+func (*QueryGroupApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{23}
+}
+func (m *QueryGroupApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupApplicationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupApplicationResponse.Merge(m, src)
+}
+func (m *QueryGroupApplicationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupApplicationResponse proto.InternalMessageInfo
+
+func (m *QueryGroupApplicationResponse) GetApplication() GroupApplication {
+	if m != nil {
+		return m.Application
+	}
+	return GroupApplication{}
+}
+
+// QuerySubspaceBansRequest is the request type for the Query/SubspaceBans RPC
+// method
+type QuerySubspaceBansRequest struct {
+	// Id of the subspace to query the bans for
 	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
-	// (optional) Address of the user group that was granted the allowance(s)
-	GroupId uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
-	// pagination defines an pagination for the request
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGroupAllowancesRequest) Reset()         { *m = QueryGroupAllowancesRequest{} }
-func (m *QueryGroupAllowancesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGroupAllowancesRequest) ProtoMessage()    {}
-func (*QueryGroupAllowancesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{19}
+func (m *QuerySubspaceBansRequest) Reset()         { *m = QuerySubspaceBansRequest{} }
+func (m *QuerySubspaceBansRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubspaceBansRequest) ProtoMessage()    {}
+func (*QuerySubspaceBansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{24}
 }
-func (m *QueryGroupAllowancesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySubspaceBansRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGroupAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySubspaceBansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGroupAllowancesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySubspaceBansRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1169,38 +1352,51 @@ func (m *QueryGroupAllowancesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGroupAllowancesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGroupAllowancesRequest.Merge(m, src)
+func (m *QuerySubspaceBansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubspaceBansRequest.Merge(m, src)
 }
-func (m *QueryGroupAllowancesRequest) XXX_Size() int {
+func (m *QuerySubspaceBansRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGroupAllowancesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGroupAllowancesRequest.DiscardUnknown(m)
+func (m *QuerySubspaceBansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubspaceBansRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGroupAllowancesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySubspaceBansRequest proto.InternalMessageInfo
 
-// QueryGroupAllowancesResponse is the response type for the
-// Query/GroupAllowances RPC method
-type QueryGroupAllowancesResponse struct {
-	Grants []Grant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants" yaml:"grants"`
-	// pagination defines an pagination for the response
+func (m *QuerySubspaceBansRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
+	}
+	return 0
+}
+
+func (m *QuerySubspaceBansRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySubspaceBansResponse is the response type for the Query/SubspaceBans RPC
+// method
+type QuerySubspaceBansResponse struct {
+	Bans       []SubspaceBan       `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGroupAllowancesResponse) Reset()         { *m = QueryGroupAllowancesResponse{} }
-func (m *QueryGroupAllowancesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGroupAllowancesResponse) ProtoMessage()    {}
-func (*QueryGroupAllowancesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca70010567dbc47d, []int{20}
+func (m *QuerySubspaceBansResponse) Reset()         { *m = QuerySubspaceBansResponse{} }
+func (m *QuerySubspaceBansResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubspaceBansResponse) ProtoMessage()    {}
+func (*QuerySubspaceBansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{25}
 }
-func (m *QueryGroupAllowancesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySubspaceBansResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGroupAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySubspaceBansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGroupAllowancesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySubspaceBansResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1210,2142 +1406,9836 @@ func (m *QueryGroupAllowancesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGroupAllowancesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGroupAllowancesResponse.Merge(m, src)
+func (m *QuerySubspaceBansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubspaceBansResponse.Merge(m, src)
 }
-func (m *QueryGroupAllowancesResponse) XXX_Size() int {
+func (m *QuerySubspaceBansResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGroupAllowancesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGroupAllowancesResponse.DiscardUnknown(m)
+func (m *QuerySubspaceBansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubspaceBansResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGroupAllowancesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySubspaceBansResponse proto.InternalMessageInfo
 
-func (m *QueryGroupAllowancesResponse) GetGrants() []Grant {
+func (m *QuerySubspaceBansResponse) GetBans() []SubspaceBan {
 	if m != nil {
-		return m.Grants
+		return m.Bans
 	}
 	return nil
 }
 
-func (m *QueryGroupAllowancesResponse) GetPagination() *query.PageResponse {
+func (m *QuerySubspaceBansResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QuerySubspacesRequest)(nil), "desmos.subspaces.v3.QuerySubspacesRequest")
-	proto.RegisterType((*QuerySubspacesResponse)(nil), "desmos.subspaces.v3.QuerySubspacesResponse")
-	proto.RegisterType((*QuerySubspaceRequest)(nil), "desmos.subspaces.v3.QuerySubspaceRequest")
-	proto.RegisterType((*QuerySubspaceResponse)(nil), "desmos.subspaces.v3.QuerySubspaceResponse")
-	proto.RegisterType((*QuerySectionsRequest)(nil), "desmos.subspaces.v3.QuerySectionsRequest")
-	proto.RegisterType((*QuerySectionsResponse)(nil), "desmos.subspaces.v3.QuerySectionsResponse")
-	proto.RegisterType((*QuerySectionRequest)(nil), "desmos.subspaces.v3.QuerySectionRequest")
-	proto.RegisterType((*QuerySectionResponse)(nil), "desmos.subspaces.v3.QuerySectionResponse")
-	proto.RegisterType((*QueryUserGroupsRequest)(nil), "desmos.subspaces.v3.QueryUserGroupsRequest")
-	proto.RegisterType((*QueryUserGroupsResponse)(nil), "desmos.subspaces.v3.QueryUserGroupsResponse")
-	proto.RegisterType((*QueryUserGroupRequest)(nil), "desmos.subspaces.v3.QueryUserGroupRequest")
-	proto.RegisterType((*QueryUserGroupResponse)(nil), "desmos.subspaces.v3.QueryUserGroupResponse")
-	proto.RegisterType((*QueryUserGroupMembersRequest)(nil), "desmos.subspaces.v3.QueryUserGroupMembersRequest")
-	proto.RegisterType((*QueryUserGroupMembersResponse)(nil), "desmos.subspaces.v3.QueryUserGroupMembersResponse")
-	proto.RegisterType((*QueryUserPermissionsRequest)(nil), "desmos.subspaces.v3.QueryUserPermissionsRequest")
-	proto.RegisterType((*QueryUserPermissionsResponse)(nil), "desmos.subspaces.v3.QueryUserPermissionsResponse")
-	proto.RegisterType((*PermissionDetail)(nil), "desmos.subspaces.v3.PermissionDetail")
-	proto.RegisterType((*PermissionDetail_User)(nil), "desmos.subspaces.v3.PermissionDetail.User")
-	proto.RegisterType((*PermissionDetail_Group)(nil), "desmos.subspaces.v3.PermissionDetail.Group")
-	proto.RegisterType((*QueryUserAllowancesRequest)(nil), "desmos.subspaces.v3.QueryUserAllowancesRequest")
-	proto.RegisterType((*QueryUserAllowancesResponse)(nil), "desmos.subspaces.v3.QueryUserAllowancesResponse")
-	proto.RegisterType((*QueryGroupAllowancesRequest)(nil), "desmos.subspaces.v3.QueryGroupAllowancesRequest")
-	proto.RegisterType((*QueryGroupAllowancesResponse)(nil), "desmos.subspaces.v3.QueryGroupAllowancesResponse")
+// QueryRateLimitsRequest is the request type for the Query/RateLimits RPC
+// method
+type QueryRateLimitsRequest struct {
+	// Id of the subspace to query the rate limits for
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("desmos/subspaces/v3/query.proto", fileDescriptor_ca70010567dbc47d) }
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{26}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_ca70010567dbc47d = []byte{
-	// 1334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xf6, 0xe4, 0xa3, 0x8e, 0xc7, 0xea, 0xd7, 0x24, 0xcd, 0x2f, 0xbf, 0x4d, 0x62, 0x47, 0x2b,
-	0xd1, 0xa6, 0x49, 0xe3, 0x6d, 0x9c, 0x56, 0x2d, 0xa9, 0x20, 0x89, 0x1b, 0xf2, 0x21, 0x41, 0x55,
-	0x5c, 0xf5, 0x00, 0x02, 0x45, 0xeb, 0x78, 0x64, 0x2c, 0xd9, 0x5e, 0xd7, 0xb3, 0x0e, 0x44, 0x51,
-	0x04, 0x42, 0x1c, 0x38, 0x70, 0x40, 0x42, 0x82, 0x0b, 0x87, 0x1c, 0x40, 0x02, 0x89, 0x03, 0x07,
-	0x04, 0x88, 0x03, 0x5c, 0xcb, 0x05, 0x2a, 0x7a, 0xe9, 0xc9, 0xa0, 0x04, 0x09, 0x2e, 0x5c, 0xf2,
-	0x17, 0xa0, 0x9d, 0x7d, 0x67, 0x77, 0x76, 0xbd, 0x71, 0xd6, 0x8e, 0x05, 0x5c, 0x22, 0xef, 0xcc,
-	0xfb, 0xf1, 0xbc, 0xcf, 0x3c, 0xf3, 0xf1, 0x06, 0x27, 0xf3, 0x94, 0x95, 0x0d, 0xa6, 0xb1, 0x7a,
-	0x8e, 0x55, 0xf5, 0x4d, 0xca, 0xb4, 0xad, 0x39, 0xed, 0x41, 0x9d, 0xd6, 0xb6, 0x53, 0xd5, 0x9a,
-	0x61, 0x1a, 0x64, 0xd0, 0x36, 0x48, 0x39, 0x06, 0xa9, 0xad, 0x39, 0xe5, 0xbc, 0x5e, 0x2e, 0x56,
-	0x0c, 0x8d, 0xff, 0xb5, 0xed, 0x94, 0xa1, 0x82, 0x51, 0x30, 0xf8, 0x4f, 0xcd, 0xfa, 0x05, 0xa3,
-	0x63, 0x05, 0xc3, 0x28, 0x94, 0xa8, 0xa6, 0x57, 0x8b, 0x9a, 0x5e, 0xa9, 0x18, 0xa6, 0x6e, 0x16,
-	0x8d, 0x0a, 0x83, 0xd9, 0xff, 0x6f, 0x1a, 0x56, 0xec, 0x0d, 0xdb, 0xcd, 0xfe, 0x80, 0xa9, 0x29,
-	0xfb, 0x4b, 0xcb, 0xe9, 0x8c, 0xda, 0x78, 0xb4, 0xad, 0xd9, 0x1c, 0x35, 0xf5, 0x59, 0xad, 0xaa,
-	0x17, 0x8a, 0x15, 0x1e, 0x07, 0x6c, 0x27, 0x82, 0x6a, 0x28, 0x1b, 0x79, 0x5a, 0x82, 0x68, 0xea,
-	0x06, 0xbe, 0xf0, 0xa2, 0x15, 0xe3, 0x9e, 0xb0, 0xc8, 0xd2, 0x07, 0x75, 0xca, 0x4c, 0xb2, 0x82,
-	0xb1, 0x1b, 0x6e, 0x04, 0x4d, 0xa0, 0xc9, 0x78, 0xfa, 0x62, 0x0a, 0x90, 0x58, 0xb9, 0x53, 0x36,
-	0x17, 0x90, 0x3b, 0x75, 0x57, 0x2f, 0x50, 0xf0, 0xcd, 0x4a, 0x9e, 0xea, 0xe7, 0x08, 0x0f, 0xfb,
-	0x33, 0xb0, 0xaa, 0x51, 0x61, 0x94, 0xac, 0xe0, 0x98, 0x03, 0x6c, 0x04, 0x4d, 0xf4, 0x4e, 0xc6,
-	0xd3, 0xe3, 0xa9, 0x00, 0x52, 0x53, 0xc2, 0x35, 0x13, 0x7b, 0xd8, 0x48, 0x46, 0x3e, 0xfb, 0xe3,
-	0xcb, 0x29, 0x94, 0x75, 0x5d, 0xc9, 0xaa, 0x07, 0x6a, 0x0f, 0x87, 0x7a, 0xe9, 0x58, 0xa8, 0x36,
-	0x08, 0x0f, 0xd6, 0x97, 0xf0, 0x90, 0x07, 0xaa, 0xe0, 0xe2, 0x06, 0x8e, 0x8b, 0x6c, 0x1b, 0xc5,
-	0x3c, 0x27, 0xa3, 0x2f, 0x33, 0x7c, 0xd8, 0x48, 0x92, 0x6d, 0xbd, 0x5c, 0x9a, 0x57, 0xa5, 0x49,
-	0x35, 0x8b, 0xc5, 0xd7, 0x7a, 0x7e, 0x7e, 0xe0, 0xdd, 0xbd, 0x64, 0xe4, 0xcf, 0xbd, 0x64, 0x44,
-	0x7d, 0xd5, 0xc7, 0xb3, 0x43, 0xc2, 0x32, 0x1e, 0x10, 0x0e, 0xc0, 0x72, 0x78, 0x0e, 0x1c, 0x4f,
-	0xf5, 0x4d, 0x81, 0x9c, 0x6e, 0x72, 0x19, 0x09, 0xe4, 0xc9, 0x00, 0xe4, 0x32, 0x42, 0xdf, 0x32,
-	0xf7, 0x74, 0xbc, 0xcc, 0x9f, 0x22, 0x51, 0xa0, 0x83, 0x00, 0x0a, 0xbc, 0x8d, 0x07, 0x18, 0x8c,
-	0xc1, 0x22, 0x8f, 0x05, 0x17, 0x68, 0x1b, 0x79, 0xeb, 0x03, 0xc7, 0xee, 0x2d, 0xf1, 0x7d, 0x3c,
-	0x28, 0xc3, 0x0c, 0xcd, 0xd3, 0x38, 0xc6, 0x00, 0xc6, 0x9a, 0xb7, 0x00, 0x9c, 0xce, 0xc6, 0x60,
-	0x64, 0x3d, 0xef, 0x2a, 0x47, 0x84, 0x85, 0xe2, 0x97, 0x70, 0x14, 0x8c, 0x60, 0x71, 0x43, 0xd7,
-	0x2e, 0xfc, 0xd4, 0x6f, 0xc5, 0x06, 0xba, 0xcf, 0x68, 0x6d, 0xb5, 0x66, 0xd4, 0xab, 0xec, 0xa4,
-	0xba, 0x3c, 0xa6, 0x1a, 0x9f, 0x28, 0x7a, 0x4f, 0x22, 0x8a, 0xff, 0x35, 0x41, 0x77, 0x98, 0x39,
-	0x55, 0xe0, 0x23, 0x20, 0x8a, 0x44, 0x20, 0x31, 0x8e, 0xa3, 0x4c, 0x0d, 0x38, 0x76, 0x4f, 0x14,
-	0x6f, 0x09, 0xf1, 0x3a, 0xe9, 0x4e, 0xcc, 0x70, 0x0a, 0x0f, 0x70, 0x94, 0x0e, 0xbf, 0x99, 0xc1,
-	0xc3, 0x46, 0xf2, 0xac, 0xed, 0x25, 0x66, 0xd4, 0x6c, 0x94, 0xff, 0xe4, 0x02, 0x1a, 0xf6, 0x23,
-	0x00, 0xa2, 0x16, 0x70, 0x3f, 0x37, 0x02, 0x01, 0xb5, 0xc1, 0x93, 0xed, 0xa7, 0xfe, 0x8c, 0xf0,
-	0x98, 0x37, 0xf6, 0x0b, 0xb4, 0x9c, 0xa3, 0x35, 0xf6, 0x4f, 0x17, 0xd9, 0x35, 0x5d, 0x7d, 0x8c,
-	0xf0, 0xf8, 0x11, 0x15, 0x01, 0x69, 0x69, 0x1c, 0x2d, 0xdb, 0x43, 0x5c, 0x5e, 0xb1, 0xcc, 0xc8,
-	0x2f, 0x5f, 0xcd, 0x0c, 0x41, 0xa6, 0xa5, 0x7c, 0xbe, 0x46, 0x19, 0xbb, 0x67, 0xd6, 0x8a, 0x95,
-	0x42, 0x56, 0x18, 0x76, 0x4f, 0x4e, 0x8f, 0x11, 0x1e, 0x75, 0xe0, 0xdd, 0xa5, 0xb5, 0x72, 0x91,
-	0x31, 0xf9, 0x50, 0xee, 0x98, 0xef, 0x6b, 0xcd, 0xdb, 0x36, 0x73, 0xe1, 0xb0, 0x91, 0x3c, 0x0f,
-	0x7e, 0xce, 0x9c, 0x2a, 0xef, 0xe6, 0x5b, 0xb8, 0xaf, 0xce, 0x68, 0x8d, 0xf3, 0x1d, 0xcb, 0x5c,
-	0x3a, 0x6c, 0x24, 0xe3, 0xb6, 0xbd, 0x35, 0xaa, 0x1e, 0xc9, 0x0b, 0x77, 0x92, 0x6e, 0xb0, 0xaf,
-	0x65, 0x19, 0x79, 0xaa, 0x02, 0xce, 0x6f, 0xe2, 0x78, 0xd5, 0x1d, 0x06, 0xde, 0xa5, 0xb2, 0xa4,
-	0x49, 0x35, 0x2b, 0x9b, 0x92, 0x57, 0x70, 0x34, 0x4f, 0x4d, 0xbd, 0x58, 0x62, 0x23, 0x3d, 0xfc,
-	0x30, 0x78, 0x2a, 0x50, 0xe4, 0x6e, 0xd2, 0x65, 0x6e, 0x9d, 0x19, 0xb5, 0xb4, 0x7e, 0xd8, 0x48,
-	0x9e, 0xb1, 0x13, 0x40, 0x0c, 0x15, 0x0e, 0x50, 0xf1, 0xf9, 0x6b, 0x2f, 0x3e, 0xe7, 0x77, 0x3d,
-	0xe9, 0x81, 0x4f, 0x16, 0x25, 0x52, 0xe3, 0xe9, 0xa9, 0x50, 0x78, 0xf9, 0x2e, 0x5d, 0x8b, 0xd8,
-	0xcc, 0x92, 0xdb, 0x62, 0x5f, 0xf7, 0xf1, 0x10, 0xd3, 0xe1, 0x42, 0x70, 0xb5, 0xaf, 0x45, 0x60,
-	0x6f, 0x2b, 0xef, 0x20, 0xdc, 0x67, 0x45, 0x75, 0x16, 0x19, 0x75, 0xb0, 0xc8, 0xe4, 0x3a, 0xc6,
-	0xee, 0x72, 0xf0, 0x25, 0x88, 0xc9, 0xba, 0x72, 0xe7, 0xd4, 0xac, 0x64, 0xe8, 0x68, 0x03, 0x29,
-	0x25, 0xdc, 0xcf, 0x81, 0x91, 0xa7, 0xa5, 0x13, 0x01, 0x71, 0x7d, 0x26, 0xf6, 0x1b, 0xc9, 0x28,
-	0x9f, 0x5c, 0x5f, 0x6e, 0x79, 0x38, 0x24, 0x9a, 0x41, 0x04, 0x67, 0x73, 0x7f, 0x65, 0xfa, 0x71,
-	0x2f, 0xab, 0x97, 0xd5, 0xbf, 0x10, 0x56, 0x1c, 0x69, 0x2e, 0x95, 0x4a, 0xc6, 0xeb, 0x7a, 0x45,
-	0x7a, 0xca, 0x76, 0xbc, 0xdf, 0x96, 0x71, 0xb4, 0x50, 0xd3, 0x2b, 0x26, 0xa5, 0x5c, 0x00, 0xb1,
-	0xcc, 0x94, 0x2b, 0x36, 0x98, 0x38, 0x9a, 0x5a, 0xe1, 0xda, 0xad, 0x53, 0xcf, 0xbb, 0x15, 0x47,
-	0x03, 0xeb, 0x85, 0x9d, 0x78, 0xc7, 0xba, 0x5b, 0xf5, 0x8a, 0x29, 0xee, 0x56, 0x25, 0x50, 0x5b,
-	0xab, 0x96, 0x49, 0x46, 0x81, 0x3d, 0x74, 0x5a, 0x2a, 0x4b, 0x6c, 0x21, 0x88, 0xd2, 0xbd, 0x93,
-	0xf1, 0x89, 0x00, 0xce, 0x05, 0xd1, 0xc5, 0x95, 0xfa, 0x97, 0x6e, 0x22, 0x69, 0x4d, 0xbe, 0x11,
-	0xc7, 0x63, 0x53, 0x69, 0xff, 0xf1, 0x45, 0x49, 0xff, 0x74, 0x1a, 0xf7, 0x73, 0xe4, 0xe4, 0x3d,
-	0x84, 0x63, 0x4e, 0x9b, 0x46, 0x82, 0x0f, 0xb5, 0xc0, 0x6e, 0x51, 0x99, 0x0e, 0x65, 0x6b, 0x27,
-	0x57, 0x2f, 0xbe, 0xfd, 0xf8, 0xf7, 0x0f, 0x7a, 0x26, 0x48, 0x42, 0x0b, 0x6a, 0x4f, 0xdd, 0xbe,
-	0xee, 0x23, 0x84, 0x07, 0x84, 0x37, 0xb9, 0x7c, 0x7c, 0x06, 0x01, 0x66, 0x2a, 0x8c, 0x29, 0x60,
-	0xb9, 0xc6, 0xb1, 0xa4, 0xc8, 0x95, 0xd6, 0x58, 0xb4, 0x1d, 0x49, 0x7a, 0xbb, 0xe4, 0x43, 0x0b,
-	0x99, 0xe8, 0x4d, 0x5a, 0x21, 0xf3, 0xb6, 0x63, 0x2d, 0x91, 0xf9, 0xfa, 0x26, 0x75, 0x8e, 0x23,
-	0x9b, 0x21, 0xd3, 0x81, 0xc8, 0x3c, 0x78, 0x34, 0xa7, 0x4f, 0xda, 0x43, 0x38, 0x0a, 0x91, 0xc8,
-	0xe4, 0xb1, 0xc9, 0x04, 0xac, 0xcb, 0x21, 0x2c, 0x01, 0xd5, 0x22, 0x47, 0x35, 0x4f, 0x6e, 0xb6,
-	0x81, 0x4a, 0xdb, 0x71, 0x6f, 0xd2, 0x5d, 0xf2, 0x09, 0xc2, 0xd8, 0xed, 0x07, 0x48, 0x0b, 0xe5,
-	0x34, 0x35, 0x3c, 0xca, 0x95, 0x70, 0xc6, 0x80, 0xf5, 0x16, 0xc7, 0x7a, 0x9d, 0xcc, 0xb5, 0xb3,
-	0xb6, 0x1a, 0x34, 0x17, 0x5f, 0x20, 0x1c, 0x73, 0x62, 0xb6, 0xda, 0x0b, 0xfe, 0x9e, 0x41, 0x99,
-	0x0e, 0x65, 0x0b, 0x18, 0x57, 0x38, 0xc6, 0x45, 0xf2, 0x6c, 0x07, 0x18, 0xb5, 0x1d, 0x71, 0xb2,
-	0xed, 0x92, 0x1f, 0x11, 0x3e, 0xe7, 0x7f, 0x0d, 0x93, 0xd9, 0x10, 0x48, 0xbc, 0xbd, 0x80, 0x92,
-	0x6e, 0xc7, 0x05, 0x6a, 0xb8, 0xc3, 0x6b, 0x58, 0x23, 0x2b, 0x27, 0xab, 0x41, 0x13, 0x0f, 0xf1,
-	0x1f, 0x10, 0x3e, 0xeb, 0x7b, 0x64, 0x92, 0xab, 0xad, 0x71, 0x35, 0xbf, 0xb2, 0x95, 0xd9, 0x36,
-	0x3c, 0xa0, 0x90, 0x55, 0x5e, 0xc8, 0x12, 0x59, 0x68, 0xab, 0x10, 0xe9, 0x25, 0xab, 0xed, 0x58,
-	0xef, 0xa9, 0x5d, 0xf2, 0x1d, 0xc2, 0x67, 0xbc, 0x77, 0x33, 0xd1, 0x5a, 0xc3, 0x69, 0xba, 0x0b,
-	0x95, 0xab, 0xe1, 0x1d, 0x00, 0xfe, 0x73, 0x1c, 0xfe, 0x02, 0x79, 0xa6, 0x2d, 0xf8, 0xba, 0x13,
-	0x48, 0xb3, 0xc0, 0x33, 0xf2, 0x3d, 0xc2, 0x67, 0x7d, 0x97, 0x58, 0x2b, 0xfa, 0x83, 0xaf, 0xf2,
-	0x56, 0xf4, 0x1f, 0x71, 0x43, 0x76, 0xb8, 0x17, 0x24, 0xfc, 0xb6, 0xa4, 0x32, 0xcf, 0x3f, 0xdc,
-	0x4f, 0xa0, 0x47, 0xfb, 0x09, 0xf4, 0xdb, 0x7e, 0x02, 0xbd, 0x7f, 0x90, 0x88, 0x3c, 0x3a, 0x48,
-	0x44, 0x9e, 0x1c, 0x24, 0x22, 0x2f, 0xa7, 0x0b, 0x45, 0xf3, 0xb5, 0x7a, 0x2e, 0xb5, 0x69, 0x94,
-	0x21, 0xc7, 0x4c, 0x49, 0xcf, 0x31, 0x91, 0x6f, 0xeb, 0x86, 0xf6, 0x86, 0x94, 0xc7, 0xdc, 0xae,
-	0x52, 0x96, 0x3b, 0xc5, 0xff, 0x51, 0x3a, 0xf7, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xe0,
-	0xc6, 0x10, 0x10, 0x16, 0x00, 0x00,
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
+	}
+	return 0
 }
 
-func (this *PermissionDetail) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *QueryRateLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
+	return nil
+}
 
-	that1, ok := that.(*PermissionDetail)
-	if !ok {
-		that2, ok := that.(PermissionDetail)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.SubspaceId != that1.SubspaceId {
-		return false
-	}
-	if this.SectionId != that1.SectionId {
-		return false
-	}
-	if that1.Sum == nil {
-		if this.Sum != nil {
-			return false
-		}
-	} else if this.Sum == nil {
-		return false
-	} else if !this.Sum.Equal(that1.Sum) {
-		return false
-	}
-	return true
+// QueryRateLimitsResponse is the response type for the Query/RateLimits RPC
+// method
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimit         `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (this *PermissionDetail_User_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*PermissionDetail_User_)
-	if !ok {
-		that2, ok := that.(PermissionDetail_User_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.User.Equal(that1.User) {
-		return false
-	}
-	return true
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{27}
 }
-func (this *PermissionDetail_Group_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*PermissionDetail_Group_)
-	if !ok {
-		that2, ok := that.(PermissionDetail_Group_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Group.Equal(that1.Group) {
-		return false
-	}
-	return true
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *PermissionDetail_User) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*PermissionDetail_User)
-	if !ok {
-		that2, ok := that.(PermissionDetail_User)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.User != that1.User {
-		return false
-	}
-	if len(this.Permission) != len(that1.Permission) {
-		return false
-	}
-	for i := range this.Permission {
-		if this.Permission[i] != that1.Permission[i] {
-			return false
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *PermissionDetail_Group) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*PermissionDetail_Group)
-	if !ok {
-		that2, ok := that.(PermissionDetail_Group)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.GroupID != that1.GroupID {
-		return false
-	}
-	if len(this.Permission) != len(that1.Permission) {
-		return false
-	}
-	for i := range this.Permission {
-		if this.Permission[i] != that1.Permission[i] {
-			return false
-		}
-	}
-	return true
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Subspaces queries all the subspaces inside Desmos
-	Subspaces(ctx context.Context, in *QuerySubspacesRequest, opts ...grpc.CallOption) (*QuerySubspacesResponse, error)
-	// Subspace queries all the information about the subspace with the given id
-	Subspace(ctx context.Context, in *QuerySubspaceRequest, opts ...grpc.CallOption) (*QuerySubspaceResponse, error)
-	// Sections allows to query for the sections of a specific subspace
-	Sections(ctx context.Context, in *QuerySectionsRequest, opts ...grpc.CallOption) (*QuerySectionsResponse, error)
-	// Section queries all the information about the section with the given id
-	Section(ctx context.Context, in *QuerySectionRequest, opts ...grpc.CallOption) (*QuerySectionResponse, error)
-	// UserGroups queries all the groups that are present inside the subspace with
-	// the given id
-	UserGroups(ctx context.Context, in *QueryUserGroupsRequest, opts ...grpc.CallOption) (*QueryUserGroupsResponse, error)
-	// UserGroup queries the user group having the given id inside the specific
-	// subspace
-	UserGroup(ctx context.Context, in *QueryUserGroupRequest, opts ...grpc.CallOption) (*QueryUserGroupResponse, error)
-	// UserGroupMembers queries all the members of a given user group
-	UserGroupMembers(ctx context.Context, in *QueryUserGroupMembersRequest, opts ...grpc.CallOption) (*QueryUserGroupMembersResponse, error)
-	// UserPermissions queries the permissions for the given user
-	UserPermissions(ctx context.Context, in *QueryUserPermissionsRequest, opts ...grpc.CallOption) (*QueryUserPermissionsResponse, error)
-	// UserAllowances returns all the grants for users.
-	UserAllowances(ctx context.Context, in *QueryUserAllowancesRequest, opts ...grpc.CallOption) (*QueryUserAllowancesResponse, error)
-	// GroupAllowances returns all the grants for groups.
-	GroupAllowances(ctx context.Context, in *QueryGroupAllowancesRequest, opts ...grpc.CallOption) (*QueryGroupAllowancesResponse, error)
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
-}
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
 
-func (c *queryClient) Subspaces(ctx context.Context, in *QuerySubspacesRequest, opts ...grpc.CallOption) (*QuerySubspacesResponse, error) {
-	out := new(QuerySubspacesResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/Subspaces", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Subspace(ctx context.Context, in *QuerySubspaceRequest, opts ...grpc.CallOption) (*QuerySubspaceResponse, error) {
-	out := new(QuerySubspaceResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/Subspace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Sections(ctx context.Context, in *QuerySectionsRequest, opts ...grpc.CallOption) (*QuerySectionsResponse, error) {
-	out := new(QuerySectionsResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/Sections", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryUserPermissionsRequest is the request type for the Query/UserPermissions
+// RPC method
+type QueryUserPermissionsRequest struct {
+	// Id of the subspace to query the permissions for
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the section to query the permissions for
+	SectionId uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
+	// Address of the user to query the permissions for
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
 }
 
-func (c *queryClient) Section(ctx context.Context, in *QuerySectionRequest, opts ...grpc.CallOption) (*QuerySectionResponse, error) {
-	out := new(QuerySectionResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/Section", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryUserPermissionsRequest) Reset()         { *m = QueryUserPermissionsRequest{} }
+func (m *QueryUserPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserPermissionsRequest) ProtoMessage()    {}
+func (*QueryUserPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{28}
 }
-
-func (c *queryClient) UserGroups(ctx context.Context, in *QueryUserGroupsRequest, opts ...grpc.CallOption) (*QueryUserGroupsResponse, error) {
-	out := new(QueryUserGroupsResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/UserGroups", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryUserPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) UserGroup(ctx context.Context, in *QueryUserGroupRequest, opts ...grpc.CallOption) (*QueryUserGroupResponse, error) {
-	out := new(QueryUserGroupResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/UserGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUserPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserPermissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) UserGroupMembers(ctx context.Context, in *QueryUserGroupMembersRequest, opts ...grpc.CallOption) (*QueryUserGroupMembersResponse, error) {
-	out := new(QueryUserGroupMembersResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/UserGroupMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryUserPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserPermissionsRequest.Merge(m, src)
+}
+func (m *QueryUserPermissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserPermissionsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) UserPermissions(ctx context.Context, in *QueryUserPermissionsRequest, opts ...grpc.CallOption) (*QueryUserPermissionsResponse, error) {
-	out := new(QueryUserPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/UserPermissions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryUserPermissionsRequest proto.InternalMessageInfo
+
+// QueryUserPermissionsRequest is the response type for the
+// Query/UserPermissions method
+type QueryUserPermissionsResponse struct {
+	Permissions []string           `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty" yaml:"permissions"`
+	Details     []PermissionDetail `protobuf:"bytes,2,rep,name=details,proto3" json:"details" yaml:"details"`
 }
 
-func (c *queryClient) UserAllowances(ctx context.Context, in *QueryUserAllowancesRequest, opts ...grpc.CallOption) (*QueryUserAllowancesResponse, error) {
-	out := new(QueryUserAllowancesResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/UserAllowances", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUserPermissionsResponse) Reset()         { *m = QueryUserPermissionsResponse{} }
+func (m *QueryUserPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserPermissionsResponse) ProtoMessage()    {}
+func (*QueryUserPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{29}
+}
+func (m *QueryUserPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryUserPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserPermissionsResponse.Merge(m, src)
+}
+func (m *QueryUserPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserPermissionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GroupAllowances(ctx context.Context, in *QueryGroupAllowancesRequest, opts ...grpc.CallOption) (*QueryGroupAllowancesResponse, error) {
-	out := new(QueryGroupAllowancesResponse)
-	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/GroupAllowances", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUserPermissionsResponse proto.InternalMessageInfo
+
+func (m *QueryUserPermissionsResponse) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Subspaces queries all the subspaces inside Desmos
-	Subspaces(context.Context, *QuerySubspacesRequest) (*QuerySubspacesResponse, error)
-	// Subspace queries all the information about the subspace with the given id
-	Subspace(context.Context, *QuerySubspaceRequest) (*QuerySubspaceResponse, error)
-	// Sections allows to query for the sections of a specific subspace
-	Sections(context.Context, *QuerySectionsRequest) (*QuerySectionsResponse, error)
-	// Section queries all the information about the section with the given id
-	Section(context.Context, *QuerySectionRequest) (*QuerySectionResponse, error)
-	// UserGroups queries all the groups that are present inside the subspace with
-	// the given id
-	UserGroups(context.Context, *QueryUserGroupsRequest) (*QueryUserGroupsResponse, error)
-	// UserGroup queries the user group having the given id inside the specific
-	// subspace
-	UserGroup(context.Context, *QueryUserGroupRequest) (*QueryUserGroupResponse, error)
-	// UserGroupMembers queries all the members of a given user group
-	UserGroupMembers(context.Context, *QueryUserGroupMembersRequest) (*QueryUserGroupMembersResponse, error)
-	// UserPermissions queries the permissions for the given user
-	UserPermissions(context.Context, *QueryUserPermissionsRequest) (*QueryUserPermissionsResponse, error)
-	// UserAllowances returns all the grants for users.
-	UserAllowances(context.Context, *QueryUserAllowancesRequest) (*QueryUserAllowancesResponse, error)
-	// GroupAllowances returns all the grants for groups.
-	GroupAllowances(context.Context, *QueryGroupAllowancesRequest) (*QueryGroupAllowancesResponse, error)
+func (m *QueryUserPermissionsResponse) GetDetails() []PermissionDetail {
+	if m != nil {
+		return m.Details
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// PermissionDetail contains the details data of a permission
+type PermissionDetail struct {
+	// Id of the subspace for which this permission is valid
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty"`
+	// Id of the section for which this permission is valid
+	SectionId uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	// sum is the oneof that specifies whether this represents a user or
+	// group permission detail
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*PermissionDetail_User_
+	//	*PermissionDetail_Group_
+	Sum isPermissionDetail_Sum `protobuf_oneof:"sum"`
 }
 
-func (*UnimplementedQueryServer) Subspaces(ctx context.Context, req *QuerySubspacesRequest) (*QuerySubspacesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Subspaces not implemented")
-}
-func (*UnimplementedQueryServer) Subspace(ctx context.Context, req *QuerySubspaceRequest) (*QuerySubspaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Subspace not implemented")
+func (m *PermissionDetail) Reset()         { *m = PermissionDetail{} }
+func (m *PermissionDetail) String() string { return proto.CompactTextString(m) }
+func (*PermissionDetail) ProtoMessage()    {}
+func (*PermissionDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{30}
 }
-func (*UnimplementedQueryServer) Sections(ctx context.Context, req *QuerySectionsRequest) (*QuerySectionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sections not implemented")
+func (m *PermissionDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Section(ctx context.Context, req *QuerySectionRequest) (*QuerySectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Section not implemented")
+func (m *PermissionDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UserGroups(ctx context.Context, req *QueryUserGroupsRequest) (*QueryUserGroupsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserGroups not implemented")
+func (m *PermissionDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionDetail.Merge(m, src)
 }
-func (*UnimplementedQueryServer) UserGroup(ctx context.Context, req *QueryUserGroupRequest) (*QueryUserGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserGroup not implemented")
+func (m *PermissionDetail) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) UserGroupMembers(ctx context.Context, req *QueryUserGroupMembersRequest) (*QueryUserGroupMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserGroupMembers not implemented")
+func (m *PermissionDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionDetail.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) UserPermissions(ctx context.Context, req *QueryUserPermissionsRequest) (*QueryUserPermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserPermissions not implemented")
+
+var xxx_messageInfo_PermissionDetail proto.InternalMessageInfo
+
+type isPermissionDetail_Sum interface {
+	isPermissionDetail_Sum()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
-func (*UnimplementedQueryServer) UserAllowances(ctx context.Context, req *QueryUserAllowancesRequest) (*QueryUserAllowancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserAllowances not implemented")
+
+type PermissionDetail_User_ struct {
+	User *PermissionDetail_User `protobuf:"bytes,3,opt,name=user,proto3,oneof" json:"user,omitempty"`
 }
-func (*UnimplementedQueryServer) GroupAllowances(ctx context.Context, req *QueryGroupAllowancesRequest) (*QueryGroupAllowancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GroupAllowances not implemented")
+type PermissionDetail_Group_ struct {
+	Group *PermissionDetail_Group `protobuf:"bytes,4,opt,name=group,proto3,oneof" json:"group,omitempty"`
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+func (*PermissionDetail_User_) isPermissionDetail_Sum()  {}
+func (*PermissionDetail_Group_) isPermissionDetail_Sum() {}
 
-func _Query_Subspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySubspacesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Subspaces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Query/Subspaces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Subspaces(ctx, req.(*QuerySubspacesRequest))
+func (m *PermissionDetail) GetSum() isPermissionDetail_Sum {
+	if m != nil {
+		return m.Sum
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Subspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySubspaceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Subspace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Query/Subspace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Subspace(ctx, req.(*QuerySubspaceRequest))
+func (m *PermissionDetail) GetUser() *PermissionDetail_User {
+	if x, ok := m.GetSum().(*PermissionDetail_User_); ok {
+		return x.User
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Sections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySectionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Sections(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Query/Sections",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Sections(ctx, req.(*QuerySectionsRequest))
+func (m *PermissionDetail) GetGroup() *PermissionDetail_Group {
+	if x, ok := m.GetSum().(*PermissionDetail_Group_); ok {
+		return x.Group
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Section_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySectionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Section(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Query/Section",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Section(ctx, req.(*QuerySectionRequest))
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PermissionDetail) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PermissionDetail_User_)(nil),
+		(*PermissionDetail_Group_)(nil),
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_UserGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserGroupsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserGroups(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Query/UserGroups",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserGroups(ctx, req.(*QueryUserGroupsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// User is a permission that has been set to a specific user
+type PermissionDetail_User struct {
+	// User for which the permission was set
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	// Permissions set to the user
+	Permission []string `protobuf:"bytes,2,rep,name=permission,proto3" json:"permission,omitempty" yaml:"permission"`
 }
 
-func _Query_UserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserGroupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Query/UserGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserGroup(ctx, req.(*QueryUserGroupRequest))
+func (m *PermissionDetail_User) Reset()         { *m = PermissionDetail_User{} }
+func (m *PermissionDetail_User) String() string { return proto.CompactTextString(m) }
+func (*PermissionDetail_User) ProtoMessage()    {}
+func (*PermissionDetail_User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{30, 0}
+}
+func (m *PermissionDetail_User) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionDetail_User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionDetail_User.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PermissionDetail_User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionDetail_User.Merge(m, src)
+}
+func (m *PermissionDetail_User) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionDetail_User) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionDetail_User.DiscardUnknown(m)
 }
 
-func _Query_UserGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserGroupMembersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserGroupMembers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Query/UserGroupMembers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserGroupMembers(ctx, req.(*QueryUserGroupMembersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_PermissionDetail_User proto.InternalMessageInfo
+
+// Group is a permission that has been set to a user group
+type PermissionDetail_Group struct {
+	// Unique id of the group
+	GroupID uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Permissions set to the group
+	Permission []string `protobuf:"bytes,2,rep,name=permission,proto3" json:"permission,omitempty"`
 }
 
-func _Query_UserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserPermissionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PermissionDetail_Group) Reset()         { *m = PermissionDetail_Group{} }
+func (m *PermissionDetail_Group) String() string { return proto.CompactTextString(m) }
+func (*PermissionDetail_Group) ProtoMessage()    {}
+func (*PermissionDetail_Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{30, 1}
+}
+func (m *PermissionDetail_Group) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionDetail_Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionDetail_Group.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserPermissions(ctx, in)
+}
+func (m *PermissionDetail_Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionDetail_Group.Merge(m, src)
+}
+func (m *PermissionDetail_Group) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionDetail_Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionDetail_Group.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionDetail_Group proto.InternalMessageInfo
+
+// QueryPermissionDetailsRequest is the request type for the
+// Query/PermissionDetails method
+type QueryPermissionDetailsRequest struct {
+	// Id of the subspace to query the permission for
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the section to query the permission for
+	SectionId uint32 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
+	// Address of the user to query the permission for
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	// Permission to be checked
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty" yaml:"permission"`
+}
+
+func (m *QueryPermissionDetailsRequest) Reset()         { *m = QueryPermissionDetailsRequest{} }
+func (m *QueryPermissionDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissionDetailsRequest) ProtoMessage()    {}
+func (*QueryPermissionDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{31}
+}
+func (m *QueryPermissionDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissionDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissionDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Query/UserPermissions",
+}
+func (m *QueryPermissionDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissionDetailsRequest.Merge(m, src)
+}
+func (m *QueryPermissionDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissionDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissionDetailsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPermissionDetailsRequest proto.InternalMessageInfo
+
+// QueryPermissionDetailsResponse is the response type for the
+// Query/PermissionDetails method
+type QueryPermissionDetailsResponse struct {
+	// Tells whether the user has the requested permission
+	HasPermission bool `protobuf:"varint,1,opt,name=has_permission,json=hasPermission,proto3" json:"has_permission,omitempty" yaml:"has_permission"`
+	// Tells whether the user is banned from the subspace. If so, all the sources
+	// are ignored and the user does not have the permission
+	Banned bool `protobuf:"varint,2,opt,name=banned,proto3" json:"banned,omitempty" yaml:"banned"`
+	// List of all the sources that grant the requested permission to the user
+	Sources []PermissionSource `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources" yaml:"sources"`
+}
+
+func (m *QueryPermissionDetailsResponse) Reset()         { *m = QueryPermissionDetailsResponse{} }
+func (m *QueryPermissionDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissionDetailsResponse) ProtoMessage()    {}
+func (*QueryPermissionDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{32}
+}
+func (m *QueryPermissionDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissionDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissionDetailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserPermissions(ctx, req.(*QueryUserPermissionsRequest))
+}
+func (m *QueryPermissionDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissionDetailsResponse.Merge(m, src)
+}
+func (m *QueryPermissionDetailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissionDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissionDetailsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPermissionDetailsResponse proto.InternalMessageInfo
+
+func (m *QueryPermissionDetailsResponse) GetHasPermission() bool {
+	if m != nil {
+		return m.HasPermission
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_UserAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserAllowancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryPermissionDetailsResponse) GetBanned() bool {
+	if m != nil {
+		return m.Banned
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserAllowances(ctx, in)
+	return false
+}
+
+func (m *QueryPermissionDetailsResponse) GetSources() []PermissionSource {
+	if m != nil {
+		return m.Sources
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Query/UserAllowances",
+	return nil
+}
+
+// PermissionSource represents a single source that grants a permission to a
+// user
+type PermissionSource struct {
+	// Id of the section in which the permission has been set
+	SectionId uint32 `protobuf:"varint,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty" yaml:"section_id"`
+	// Tells whether the permission has been inherited from a parent section
+	Inherited bool `protobuf:"varint,2,opt,name=inherited,proto3" json:"inherited,omitempty" yaml:"inherited"`
+	// sum is the oneof that specifies the kind of source granting the permission
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*PermissionSource_Owner_
+	//	*PermissionSource_User_
+	//	*PermissionSource_Group_
+	Sum isPermissionSource_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *PermissionSource) Reset()         { *m = PermissionSource{} }
+func (m *PermissionSource) String() string { return proto.CompactTextString(m) }
+func (*PermissionSource) ProtoMessage()    {}
+func (*PermissionSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{33}
+}
+func (m *PermissionSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserAllowances(ctx, req.(*QueryUserAllowancesRequest))
+}
+func (m *PermissionSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionSource.Merge(m, src)
+}
+func (m *PermissionSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionSource proto.InternalMessageInfo
+
+type isPermissionSource_Sum interface {
+	isPermissionSource_Sum()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type PermissionSource_Owner_ struct {
+	Owner *PermissionSource_Owner `protobuf:"bytes,3,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+}
+type PermissionSource_User_ struct {
+	User *PermissionSource_User `protobuf:"bytes,4,opt,name=user,proto3,oneof" json:"user,omitempty"`
+}
+type PermissionSource_Group_ struct {
+	Group *PermissionSource_Group `protobuf:"bytes,5,opt,name=group,proto3,oneof" json:"group,omitempty"`
+}
+
+func (*PermissionSource_Owner_) isPermissionSource_Sum() {}
+func (*PermissionSource_User_) isPermissionSource_Sum()  {}
+func (*PermissionSource_Group_) isPermissionSource_Sum() {}
+
+func (m *PermissionSource) GetSum() isPermissionSource_Sum {
+	if m != nil {
+		return m.Sum
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GroupAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupAllowancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PermissionSource) GetOwner() *PermissionSource_Owner {
+	if x, ok := m.GetSum().(*PermissionSource_Owner_); ok {
+		return x.Owner
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GroupAllowances(ctx, in)
+	return nil
+}
+
+func (m *PermissionSource) GetUser() *PermissionSource_User {
+	if x, ok := m.GetSum().(*PermissionSource_User_); ok {
+		return x.User
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/desmos.subspaces.v3.Query/GroupAllowances",
+	return nil
+}
+
+func (m *PermissionSource) GetGroup() *PermissionSource_Group {
+	if x, ok := m.GetSum().(*PermissionSource_Group_); ok {
+		return x.Group
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GroupAllowances(ctx, req.(*QueryGroupAllowancesRequest))
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PermissionSource) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PermissionSource_Owner_)(nil),
+		(*PermissionSource_User_)(nil),
+		(*PermissionSource_Group_)(nil),
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "desmos.subspaces.v3.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Subspaces",
-			Handler:    _Query_Subspaces_Handler,
-		},
-		{
-			MethodName: "Subspace",
-			Handler:    _Query_Subspace_Handler,
-		},
-		{
-			MethodName: "Sections",
-			Handler:    _Query_Sections_Handler,
-		},
-		{
-			MethodName: "Section",
-			Handler:    _Query_Section_Handler,
-		},
-		{
-			MethodName: "UserGroups",
-			Handler:    _Query_UserGroups_Handler,
-		},
-		{
-			MethodName: "UserGroup",
-			Handler:    _Query_UserGroup_Handler,
-		},
-		{
-			MethodName: "UserGroupMembers",
-			Handler:    _Query_UserGroupMembers_Handler,
-		},
-		{
-			MethodName: "UserPermissions",
-			Handler:    _Query_UserPermissions_Handler,
-		},
-		{
-			MethodName: "UserAllowances",
-			Handler:    _Query_UserAllowances_Handler,
-		},
-		{
-			MethodName: "GroupAllowances",
-			Handler:    _Query_GroupAllowances_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "desmos/subspaces/v3/query.proto",
+// Owner represents the subspace ownership, which grants all the permissions
+type PermissionSource_Owner struct {
 }
 
-func (m *QuerySubspacesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PermissionSource_Owner) Reset()         { *m = PermissionSource_Owner{} }
+func (m *PermissionSource_Owner) String() string { return proto.CompactTextString(m) }
+func (*PermissionSource_Owner) ProtoMessage()    {}
+func (*PermissionSource_Owner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{33, 0}
 }
-
-func (m *QuerySubspacesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PermissionSource_Owner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QuerySubspacesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *PermissionSource_Owner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionSource_Owner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySubspacesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PermissionSource_Owner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionSource_Owner.Merge(m, src)
+}
+func (m *PermissionSource_Owner) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionSource_Owner) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionSource_Owner.DiscardUnknown(m)
 }
 
-func (m *QuerySubspacesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PermissionSource_Owner proto.InternalMessageInfo
+
+// User is a permission that has been set directly to the user
+type PermissionSource_User struct {
+	// Permissions set to the user
+	Permissions []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty" yaml:"permissions"`
 }
 
-func (m *QuerySubspacesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Subspaces) > 0 {
-		for iNdEx := len(m.Subspaces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subspaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PermissionSource_User) Reset()         { *m = PermissionSource_User{} }
+func (m *PermissionSource_User) String() string { return proto.CompactTextString(m) }
+func (*PermissionSource_User) ProtoMessage()    {}
+func (*PermissionSource_User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{33, 1}
+}
+func (m *PermissionSource_User) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionSource_User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionSource_User.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySubspaceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PermissionSource_User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionSource_User.Merge(m, src)
 }
-
-func (m *QuerySubspaceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PermissionSource_User) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionSource_User) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionSource_User.DiscardUnknown(m)
 }
 
-func (m *QuerySubspaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_PermissionSource_User proto.InternalMessageInfo
+
+// Group is a permission that has been set to a user group
+type PermissionSource_Group struct {
+	// Id of the group
+	GroupID uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Permissions set to the group
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty" yaml:"permissions"`
 }
 
-func (m *QuerySubspaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PermissionSource_Group) Reset()         { *m = PermissionSource_Group{} }
+func (m *PermissionSource_Group) String() string { return proto.CompactTextString(m) }
+func (*PermissionSource_Group) ProtoMessage()    {}
+func (*PermissionSource_Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{33, 2}
+}
+func (m *PermissionSource_Group) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionSource_Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionSource_Group.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PermissionSource_Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionSource_Group.Merge(m, src)
+}
+func (m *PermissionSource_Group) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionSource_Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionSource_Group.DiscardUnknown(m)
 }
 
-func (m *QuerySubspaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PermissionSource_Group proto.InternalMessageInfo
+
+// QueryUserAllowancesRequest is the request type for the Query/UserAllowances
+// RPC method
+type QueryUserAllowancesRequest struct {
+	// Id of the subspace for which to get the grant(s)
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// (Optional) Address of the user that was granted an allowance
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
+	// pagination defines an pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySubspaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Subspace.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryUserAllowancesRequest) Reset()         { *m = QueryUserAllowancesRequest{} }
+func (m *QueryUserAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserAllowancesRequest) ProtoMessage()    {}
+func (*QueryUserAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{34}
+}
+func (m *QueryUserAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySectionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryUserAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserAllowancesRequest.Merge(m, src)
 }
-
-func (m *QuerySectionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUserAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserAllowancesRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryUserAllowancesRequest proto.InternalMessageInfo
+
+// QueryUserAllowancesResponse is the response type for the Query/UserAllowances
+// RPC method
+type QueryUserAllowancesResponse struct {
+	Grants []Grant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants" yaml:"grants"`
+	// pagination defines an pagination for the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUserAllowancesResponse) Reset()         { *m = QueryUserAllowancesResponse{} }
+func (m *QueryUserAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserAllowancesResponse) ProtoMessage()    {}
+func (*QueryUserAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{35}
+}
+func (m *QueryUserAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.SubspaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryUserAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserAllowancesResponse.Merge(m, src)
+}
+func (m *QueryUserAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUserAllowancesResponse proto.InternalMessageInfo
+
+func (m *QueryUserAllowancesResponse) GetGrants() []Grant {
+	if m != nil {
+		return m.Grants
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySectionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUserAllowancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySectionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGroupAllowancesRequest is the request type for the Query/GroupAllowances
+// RPC method
+type QueryGroupAllowancesRequest struct {
+	// Id of the subspace for which to get the grant(s)
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// (optional) Address of the user group that was granted the allowance(s)
+	GroupId uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// pagination defines an pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sections) > 0 {
-		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGroupAllowancesRequest) Reset()         { *m = QueryGroupAllowancesRequest{} }
+func (m *QueryGroupAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAllowancesRequest) ProtoMessage()    {}
+func (*QueryGroupAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{36}
+}
+func (m *QueryGroupAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySectionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGroupAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAllowancesRequest.Merge(m, src)
+}
+func (m *QueryGroupAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAllowancesRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySectionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryGroupAllowancesRequest proto.InternalMessageInfo
+
+// QueryGroupAllowancesResponse is the response type for the
+// Query/GroupAllowances RPC method
+type QueryGroupAllowancesResponse struct {
+	Grants []Grant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants" yaml:"grants"`
+	// pagination defines an pagination for the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SectionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SectionId))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryGroupAllowancesResponse) Reset()         { *m = QueryGroupAllowancesResponse{} }
+func (m *QueryGroupAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAllowancesResponse) ProtoMessage()    {}
+func (*QueryGroupAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{37}
+}
+func (m *QueryGroupAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SubspaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryGroupAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAllowancesResponse.Merge(m, src)
+}
+func (m *QueryGroupAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupAllowancesResponse proto.InternalMessageInfo
+
+func (m *QueryGroupAllowancesResponse) GetGrants() []Grant {
+	if m != nil {
+		return m.Grants
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGroupAllowancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllowanceUsagesRequest is the request type for the Query/AllowanceUsages
+// RPC method
+type QueryAllowanceUsagesRequest struct {
+	// Id of the subspace for which to get the usages
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Address of the user that used the allowances
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee"`
+	// pagination defines an pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Section.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllowanceUsagesRequest) Reset()         { *m = QueryAllowanceUsagesRequest{} }
+func (m *QueryAllowanceUsagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceUsagesRequest) ProtoMessage()    {}
+func (*QueryAllowanceUsagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{38}
+}
+func (m *QueryAllowanceUsagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceUsagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceUsagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllowanceUsagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceUsagesRequest.Merge(m, src)
+}
+func (m *QueryAllowanceUsagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceUsagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceUsagesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUserGroupsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryAllowanceUsagesRequest proto.InternalMessageInfo
 
-func (m *QueryUserGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllowanceUsagesResponse is the response type for the
+// Query/AllowanceUsages RPC method
+type QueryAllowanceUsagesResponse struct {
+	Usages []AllowanceUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages" yaml:"usages"`
+	// pagination defines an pagination for the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryUserGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllowanceUsagesResponse) Reset()         { *m = QueryAllowanceUsagesResponse{} }
+func (m *QueryAllowanceUsagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceUsagesResponse) ProtoMessage()    {}
+func (*QueryAllowanceUsagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{39}
+}
+func (m *QueryAllowanceUsagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceUsagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceUsagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SectionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SectionId))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.SubspaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryAllowanceUsagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceUsagesResponse.Merge(m, src)
+}
+func (m *QueryAllowanceUsagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceUsagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceUsagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceUsagesResponse proto.InternalMessageInfo
+
+func (m *QueryAllowanceUsagesResponse) GetUsages() []AllowanceUsage {
+	if m != nil {
+		return m.Usages
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryUserGroupsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllowanceUsagesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUserGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeTokensConfigRequest is the request type for the
+// Query/FeeTokensConfig RPC method
+type QueryFeeTokensConfigRequest struct {
+	// Id of the subspace to query the fee tokens config for
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
 }
 
-func (m *QueryUserGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFeeTokensConfigRequest) Reset()         { *m = QueryFeeTokensConfigRequest{} }
+func (m *QueryFeeTokensConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokensConfigRequest) ProtoMessage()    {}
+func (*QueryFeeTokensConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{40}
+}
+func (m *QueryFeeTokensConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokensConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokensConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeTokensConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokensConfigRequest.Merge(m, src)
+}
+func (m *QueryFeeTokensConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokensConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokensConfigRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUserGroupRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeTokensConfigRequest proto.InternalMessageInfo
+
+func (m *QueryFeeTokensConfigRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryUserGroupRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeTokensConfigResponse is the response type for the
+// Query/FeeTokensConfig RPC method
+type QueryFeeTokensConfigResponse struct {
+	Config FeeTokensConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
 }
 
-func (m *QueryUserGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryFeeTokensConfigResponse) Reset()         { *m = QueryFeeTokensConfigResponse{} }
+func (m *QueryFeeTokensConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokensConfigResponse) ProtoMessage()    {}
+func (*QueryFeeTokensConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{41}
+}
+func (m *QueryFeeTokensConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokensConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokensConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeTokensConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokensConfigResponse.Merge(m, src)
+}
+func (m *QueryFeeTokensConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokensConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokensConfigResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUserGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeTokensConfigResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTokensConfigResponse) GetConfig() FeeTokensConfig {
+	if m != nil {
+		return m.Config
 	}
-	return dAtA[:n], nil
+	return FeeTokensConfig{}
 }
 
-func (m *QueryUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTreasuryVotingConfigRequest is the request type for the
+// Query/TreasuryVotingConfig RPC method
+type QueryTreasuryVotingConfigRequest struct {
+	// Id of the subspace to query the treasury voting config for
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
 }
 
-func (m *QueryUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTreasuryVotingConfigRequest) Reset()         { *m = QueryTreasuryVotingConfigRequest{} }
+func (m *QueryTreasuryVotingConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryVotingConfigRequest) ProtoMessage()    {}
+func (*QueryTreasuryVotingConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{42}
+}
+func (m *QueryTreasuryVotingConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryVotingConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryVotingConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTreasuryVotingConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryVotingConfigRequest.Merge(m, src)
+}
+func (m *QueryTreasuryVotingConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryVotingConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryVotingConfigRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUserGroupMembersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTreasuryVotingConfigRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryVotingConfigRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryUserGroupMembersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTreasuryVotingConfigResponse is the response type for the
+// Query/TreasuryVotingConfig RPC method
+type QueryTreasuryVotingConfigResponse struct {
+	Config TreasuryVotingConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
 }
 
-func (m *QueryUserGroupMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTreasuryVotingConfigResponse) Reset()         { *m = QueryTreasuryVotingConfigResponse{} }
+func (m *QueryTreasuryVotingConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryVotingConfigResponse) ProtoMessage()    {}
+func (*QueryTreasuryVotingConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{43}
+}
+func (m *QueryTreasuryVotingConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryVotingConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryVotingConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.SubspaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryTreasuryVotingConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryVotingConfigResponse.Merge(m, src)
+}
+func (m *QueryTreasuryVotingConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryVotingConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryVotingConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryVotingConfigResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryVotingConfigResponse) GetConfig() TreasuryVotingConfig {
+	if m != nil {
+		return m.Config
 	}
-	return len(dAtA) - i, nil
+	return TreasuryVotingConfig{}
 }
 
-func (m *QueryUserGroupMembersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryTreasuryProposalsRequest is the request type for the
+// Query/TreasuryProposals RPC method
+type QueryTreasuryProposalsRequest struct {
+	// Id of the subspace to query the treasury proposals for
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryUserGroupMembersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTreasuryProposalsRequest) Reset()         { *m = QueryTreasuryProposalsRequest{} }
+func (m *QueryTreasuryProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryProposalsRequest) ProtoMessage()    {}
+func (*QueryTreasuryProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{44}
 }
-
-func (m *QueryUserGroupMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTreasuryProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Members[iNdEx])
-			copy(dAtA[i:], m.Members[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryTreasuryProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryProposalsRequest.Merge(m, src)
+}
+func (m *QueryTreasuryProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryProposalsRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryUserPermissionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTreasuryProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUserPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTreasuryProposalsResponse is the response type for the
+// Query/TreasuryProposals RPC method
+type QueryTreasuryProposalsResponse struct {
+	Proposals  []TreasuryProposal  `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryUserPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SectionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SectionId))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryTreasuryProposalsResponse) Reset()         { *m = QueryTreasuryProposalsResponse{} }
+func (m *QueryTreasuryProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryProposalsResponse) ProtoMessage()    {}
+func (*QueryTreasuryProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{45}
+}
+func (m *QueryTreasuryProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SubspaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryTreasuryProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryProposalsResponse.Merge(m, src)
+}
+func (m *QueryTreasuryProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryProposalsResponse) GetProposals() []TreasuryProposal {
+	if m != nil {
+		return m.Proposals
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryUserPermissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTreasuryProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUserPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTreasuryProposalRequest is the request type for the
+// Query/TreasuryProposal RPC method
+type QueryTreasuryProposalRequest struct {
+	// Id of the subspace inside which the proposal has been submitted
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the proposal to query
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 }
 
-func (m *QueryUserPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		for iNdEx := len(m.Details) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Details[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryTreasuryProposalRequest) Reset()         { *m = QueryTreasuryProposalRequest{} }
+func (m *QueryTreasuryProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryProposalRequest) ProtoMessage()    {}
+func (*QueryTreasuryProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{46}
+}
+func (m *QueryTreasuryProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permissions[iNdEx])
-			copy(dAtA[i:], m.Permissions[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permissions[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryTreasuryProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryProposalRequest.Merge(m, src)
+}
+func (m *QueryTreasuryProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryProposalRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryProposalRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PermissionDetail) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTreasuryProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PermissionDetail) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTreasuryProposalResponse is the response type for the
+// Query/TreasuryProposal RPC method
+type QueryTreasuryProposalResponse struct {
+	Proposal TreasuryProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
 }
 
-func (m *PermissionDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *QueryTreasuryProposalResponse) Reset()         { *m = QueryTreasuryProposalResponse{} }
+func (m *QueryTreasuryProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryProposalResponse) ProtoMessage()    {}
+func (*QueryTreasuryProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{47}
+}
+func (m *QueryTreasuryProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SectionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SectionId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubspaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryTreasuryProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryProposalResponse.Merge(m, src)
+}
+func (m *QueryTreasuryProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryProposalResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryProposalResponse) GetProposal() TreasuryProposal {
+	if m != nil {
+		return m.Proposal
 	}
-	return len(dAtA) - i, nil
+	return TreasuryProposal{}
 }
 
-func (m *PermissionDetail_User_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTreasuryProposalVotesRequest is the request type for the
+// Query/TreasuryProposalVotes RPC method
+type QueryTreasuryProposalVotesRequest struct {
+	// Id of the subspace inside which the proposal has been submitted
+	SubspaceId uint64 `protobuf:"varint,1,opt,name=subspace_id,json=subspaceId,proto3" json:"subspace_id,omitempty" yaml:"subspace_id"`
+	// Id of the proposal to query the votes for
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *PermissionDetail_User_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.User != nil {
-		{
-			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTreasuryProposalVotesRequest) Reset()         { *m = QueryTreasuryProposalVotesRequest{} }
+func (m *QueryTreasuryProposalVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryProposalVotesRequest) ProtoMessage()    {}
+func (*QueryTreasuryProposalVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{48}
+}
+func (m *QueryTreasuryProposalVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryProposalVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryProposalVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *PermissionDetail_Group_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTreasuryProposalVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryProposalVotesRequest.Merge(m, src)
 }
-
-func (m *PermissionDetail_Group_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Group != nil {
-		{
-			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
+func (m *QueryTreasuryProposalVotesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (m *PermissionDetail_User) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTreasuryProposalVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryProposalVotesRequest.DiscardUnknown(m)
 }
 
-func (m *PermissionDetail_User) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryTreasuryProposalVotesRequest proto.InternalMessageInfo
 
-func (m *PermissionDetail_User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permission) > 0 {
-		for iNdEx := len(m.Permission) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permission[iNdEx])
-			copy(dAtA[i:], m.Permission[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permission[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *QueryTreasuryProposalVotesRequest) GetSubspaceId() uint64 {
+	if m != nil {
+		return m.SubspaceId
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *QueryTreasuryProposalVotesRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PermissionDetail_Group) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTreasuryProposalVotesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PermissionDetail_Group) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTreasuryProposalVotesResponse is the response type for the
+// Query/TreasuryProposalVotes RPC method
+type QueryTreasuryProposalVotesResponse struct {
+	Votes      []TreasuryVote      `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *PermissionDetail_Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permission) > 0 {
-		for iNdEx := len(m.Permission) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permission[iNdEx])
-			copy(dAtA[i:], m.Permission[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permission[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *QueryTreasuryProposalVotesResponse) Reset()         { *m = QueryTreasuryProposalVotesResponse{} }
+func (m *QueryTreasuryProposalVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryProposalVotesResponse) ProtoMessage()    {}
+func (*QueryTreasuryProposalVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca70010567dbc47d, []int{49}
+}
+func (m *QueryTreasuryProposalVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryProposalVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryProposalVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.GroupID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryUserAllowancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTreasuryProposalVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryProposalVotesResponse.Merge(m, src)
 }
-
-func (m *QueryUserAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTreasuryProposalVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryProposalVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryProposalVotesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUserAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SubspaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryTreasuryProposalVotesResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryProposalVotesResponse) GetVotes() []TreasuryVote {
+	if m != nil {
+		return m.Votes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryUserAllowancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTreasuryProposalVotesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUserAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QuerySubspacesRequest)(nil), "desmos.subspaces.v3.QuerySubspacesRequest")
+	proto.RegisterType((*QuerySubspacesResponse)(nil), "desmos.subspaces.v3.QuerySubspacesResponse")
+	proto.RegisterType((*QuerySubspaceRequest)(nil), "desmos.subspaces.v3.QuerySubspaceRequest")
+	proto.RegisterType((*QuerySubspaceResponse)(nil), "desmos.subspaces.v3.QuerySubspaceResponse")
+	proto.RegisterType((*QueryIncomingSubspaceOwnerTransferRequestsRequest)(nil), "desmos.subspaces.v3.QueryIncomingSubspaceOwnerTransferRequestsRequest")
+	proto.RegisterType((*QueryIncomingSubspaceOwnerTransferRequestsResponse)(nil), "desmos.subspaces.v3.QueryIncomingSubspaceOwnerTransferRequestsResponse")
+	proto.RegisterType((*QuerySectionsRequest)(nil), "desmos.subspaces.v3.QuerySectionsRequest")
+	proto.RegisterType((*QuerySectionsResponse)(nil), "desmos.subspaces.v3.QuerySectionsResponse")
+	proto.RegisterType((*QuerySectionRequest)(nil), "desmos.subspaces.v3.QuerySectionRequest")
+	proto.RegisterType((*QuerySectionResponse)(nil), "desmos.subspaces.v3.QuerySectionResponse")
+	proto.RegisterType((*QueryUserGroupsRequest)(nil), "desmos.subspaces.v3.QueryUserGroupsRequest")
+	proto.RegisterType((*QueryUserGroupsResponse)(nil), "desmos.subspaces.v3.QueryUserGroupsResponse")
+	proto.RegisterType((*QueryUserGroupRequest)(nil), "desmos.subspaces.v3.QueryUserGroupRequest")
+	proto.RegisterType((*QueryUserGroupResponse)(nil), "desmos.subspaces.v3.QueryUserGroupResponse")
+	proto.RegisterType((*QueryUserGroupMembersRequest)(nil), "desmos.subspaces.v3.QueryUserGroupMembersRequest")
+	proto.RegisterType((*QueryUserGroupMembersResponse)(nil), "desmos.subspaces.v3.QueryUserGroupMembersResponse")
+	proto.RegisterType((*QueryGroupInvitesRequest)(nil), "desmos.subspaces.v3.QueryGroupInvitesRequest")
+	proto.RegisterType((*QueryGroupInvitesResponse)(nil), "desmos.subspaces.v3.QueryGroupInvitesResponse")
+	proto.RegisterType((*QueryGroupInviteRequest)(nil), "desmos.subspaces.v3.QueryGroupInviteRequest")
+	proto.RegisterType((*QueryGroupInviteResponse)(nil), "desmos.subspaces.v3.QueryGroupInviteResponse")
+	proto.RegisterType((*QueryGroupApplicationsRequest)(nil), "desmos.subspaces.v3.QueryGroupApplicationsRequest")
+	proto.RegisterType((*QueryGroupApplicationsResponse)(nil), "desmos.subspaces.v3.QueryGroupApplicationsResponse")
+	proto.RegisterType((*QueryGroupApplicationRequest)(nil), "desmos.subspaces.v3.QueryGroupApplicationRequest")
+	proto.RegisterType((*QueryGroupApplicationResponse)(nil), "desmos.subspaces.v3.QueryGroupApplicationResponse")
+	proto.RegisterType((*QuerySubspaceBansRequest)(nil), "desmos.subspaces.v3.QuerySubspaceBansRequest")
+	proto.RegisterType((*QuerySubspaceBansResponse)(nil), "desmos.subspaces.v3.QuerySubspaceBansResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "desmos.subspaces.v3.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "desmos.subspaces.v3.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryUserPermissionsRequest)(nil), "desmos.subspaces.v3.QueryUserPermissionsRequest")
+	proto.RegisterType((*QueryUserPermissionsResponse)(nil), "desmos.subspaces.v3.QueryUserPermissionsResponse")
+	proto.RegisterType((*PermissionDetail)(nil), "desmos.subspaces.v3.PermissionDetail")
+	proto.RegisterType((*PermissionDetail_User)(nil), "desmos.subspaces.v3.PermissionDetail.User")
+	proto.RegisterType((*PermissionDetail_Group)(nil), "desmos.subspaces.v3.PermissionDetail.Group")
+	proto.RegisterType((*QueryPermissionDetailsRequest)(nil), "desmos.subspaces.v3.QueryPermissionDetailsRequest")
+	proto.RegisterType((*QueryPermissionDetailsResponse)(nil), "desmos.subspaces.v3.QueryPermissionDetailsResponse")
+	proto.RegisterType((*PermissionSource)(nil), "desmos.subspaces.v3.PermissionSource")
+	proto.RegisterType((*PermissionSource_Owner)(nil), "desmos.subspaces.v3.PermissionSource.Owner")
+	proto.RegisterType((*PermissionSource_User)(nil), "desmos.subspaces.v3.PermissionSource.User")
+	proto.RegisterType((*PermissionSource_Group)(nil), "desmos.subspaces.v3.PermissionSource.Group")
+	proto.RegisterType((*QueryUserAllowancesRequest)(nil), "desmos.subspaces.v3.QueryUserAllowancesRequest")
+	proto.RegisterType((*QueryUserAllowancesResponse)(nil), "desmos.subspaces.v3.QueryUserAllowancesResponse")
+	proto.RegisterType((*QueryGroupAllowancesRequest)(nil), "desmos.subspaces.v3.QueryGroupAllowancesRequest")
+	proto.RegisterType((*QueryGroupAllowancesResponse)(nil), "desmos.subspaces.v3.QueryGroupAllowancesResponse")
+	proto.RegisterType((*QueryAllowanceUsagesRequest)(nil), "desmos.subspaces.v3.QueryAllowanceUsagesRequest")
+	proto.RegisterType((*QueryAllowanceUsagesResponse)(nil), "desmos.subspaces.v3.QueryAllowanceUsagesResponse")
+	proto.RegisterType((*QueryFeeTokensConfigRequest)(nil), "desmos.subspaces.v3.QueryFeeTokensConfigRequest")
+	proto.RegisterType((*QueryFeeTokensConfigResponse)(nil), "desmos.subspaces.v3.QueryFeeTokensConfigResponse")
+	proto.RegisterType((*QueryTreasuryVotingConfigRequest)(nil), "desmos.subspaces.v3.QueryTreasuryVotingConfigRequest")
+	proto.RegisterType((*QueryTreasuryVotingConfigResponse)(nil), "desmos.subspaces.v3.QueryTreasuryVotingConfigResponse")
+	proto.RegisterType((*QueryTreasuryProposalsRequest)(nil), "desmos.subspaces.v3.QueryTreasuryProposalsRequest")
+	proto.RegisterType((*QueryTreasuryProposalsResponse)(nil), "desmos.subspaces.v3.QueryTreasuryProposalsResponse")
+	proto.RegisterType((*QueryTreasuryProposalRequest)(nil), "desmos.subspaces.v3.QueryTreasuryProposalRequest")
+	proto.RegisterType((*QueryTreasuryProposalResponse)(nil), "desmos.subspaces.v3.QueryTreasuryProposalResponse")
+	proto.RegisterType((*QueryTreasuryProposalVotesRequest)(nil), "desmos.subspaces.v3.QueryTreasuryProposalVotesRequest")
+	proto.RegisterType((*QueryTreasuryProposalVotesResponse)(nil), "desmos.subspaces.v3.QueryTreasuryProposalVotesResponse")
 }
 
-func (m *QueryUserAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Grants) > 0 {
-		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
+func init() { proto.RegisterFile("desmos/subspaces/v3/query.proto", fileDescriptor_ca70010567dbc47d) }
 
-func (m *QueryGroupAllowancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_ca70010567dbc47d = []byte{
+	// 2553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xf7, 0x4d, 0xfc, 0xb5, 0xc7, 0xf9, 0xb0, 0x6f, 0x3e, 0x48, 0x26, 0xc9, 0xae, 0x3b, 0xa5,
+	0x6d, 0xe2, 0xc4, 0x3b, 0xf5, 0x3a, 0x1f, 0x6d, 0x0a, 0x24, 0xd9, 0x24, 0x76, 0x9c, 0x26, 0x4d,
+	0xd8, 0x7c, 0x94, 0x52, 0x90, 0x35, 0xb6, 0x27, 0xeb, 0x15, 0xbb, 0x33, 0x9b, 0x99, 0x59, 0x87,
+	0xc8, 0xb2, 0x40, 0x08, 0x21, 0x24, 0xfa, 0x50, 0x09, 0x09, 0x84, 0xe0, 0x21, 0x0f, 0x20, 0xb5,
+	0x2a, 0x42, 0x20, 0xa1, 0xf2, 0x25, 0x11, 0x78, 0x40, 0x0a, 0x42, 0x88, 0xd0, 0x3e, 0x50, 0x21,
+	0xb1, 0x20, 0x07, 0x04, 0x12, 0xa2, 0x0f, 0xfe, 0x0b, 0xd0, 0xdc, 0x39, 0x77, 0xf6, 0xce, 0xec,
+	0xec, 0x78, 0xc6, 0x3b, 0x2a, 0x0d, 0x2f, 0xd6, 0xce, 0x9d, 0x73, 0xce, 0xfd, 0xfd, 0xce, 0x39,
+	0xf7, 0xce, 0xbd, 0xe7, 0x5e, 0x43, 0x6e, 0x41, 0xb3, 0x6a, 0x86, 0xa5, 0x58, 0x8d, 0x39, 0xab,
+	0xae, 0xce, 0x6b, 0x96, 0xb2, 0x34, 0xa9, 0xdc, 0x6e, 0x68, 0xe6, 0xdd, 0x7c, 0xdd, 0x34, 0x6c,
+	0x83, 0xee, 0x70, 0x05, 0xf2, 0x9e, 0x40, 0x7e, 0x69, 0x52, 0x1a, 0x51, 0x6b, 0x15, 0xdd, 0x50,
+	0xd8, 0x5f, 0x57, 0x4e, 0xda, 0x59, 0x36, 0xca, 0x06, 0xfb, 0xa9, 0x38, 0xbf, 0xb0, 0x75, 0x7f,
+	0xd9, 0x30, 0xca, 0x55, 0x4d, 0x51, 0xeb, 0x15, 0x45, 0xd5, 0x75, 0xc3, 0x56, 0xed, 0x8a, 0xa1,
+	0x5b, 0xf8, 0x76, 0xef, 0xbc, 0xe1, 0xd8, 0x9e, 0x75, 0xd5, 0xdc, 0x07, 0x7c, 0x35, 0xe6, 0x3e,
+	0x29, 0x73, 0xaa, 0xa5, 0xb9, 0x78, 0x94, 0xa5, 0x89, 0x39, 0xcd, 0x56, 0x27, 0x94, 0xba, 0x5a,
+	0xae, 0xe8, 0xcc, 0x0e, 0xca, 0x8e, 0x86, 0x71, 0xa8, 0x19, 0x0b, 0x5a, 0x15, 0xad, 0xc9, 0xb3,
+	0xb0, 0xeb, 0x93, 0x8e, 0x8d, 0x6b, 0x5c, 0xa2, 0xa4, 0xdd, 0x6e, 0x68, 0x96, 0x4d, 0xa7, 0x00,
+	0x5a, 0xe6, 0xf6, 0x90, 0x51, 0x72, 0x70, 0xa8, 0xf0, 0x74, 0x1e, 0x91, 0x38, 0x7d, 0xe7, 0x5d,
+	0x5f, 0x60, 0xdf, 0xf9, 0xab, 0x6a, 0x59, 0x43, 0xdd, 0x92, 0xa0, 0x29, 0xbf, 0x49, 0x60, 0x77,
+	0xb0, 0x07, 0xab, 0x6e, 0xe8, 0x96, 0x46, 0xa7, 0x20, 0xe3, 0x01, 0xdb, 0x43, 0x46, 0x37, 0x1f,
+	0x1c, 0x2a, 0x1c, 0xc8, 0x87, 0x38, 0x35, 0xcf, 0x55, 0x8b, 0x99, 0x07, 0xcd, 0x5c, 0xcf, 0x1b,
+	0xff, 0xfc, 0xe1, 0x18, 0x29, 0xb5, 0x54, 0xe9, 0xb4, 0x0f, 0xea, 0x26, 0x06, 0xf5, 0x99, 0x75,
+	0xa1, 0xba, 0x20, 0x7c, 0x58, 0x5f, 0x81, 0x9d, 0x3e, 0xa8, 0xdc, 0x17, 0x27, 0x60, 0x88, 0xf7,
+	0x36, 0x5b, 0x59, 0x60, 0xce, 0xe8, 0x2d, 0xee, 0x5e, 0x6b, 0xe6, 0xe8, 0x5d, 0xb5, 0x56, 0x3d,
+	0x29, 0x0b, 0x2f, 0xe5, 0x12, 0xf0, 0xa7, 0x99, 0x85, 0x93, 0x83, 0x5f, 0xbd, 0x97, 0xeb, 0xf9,
+	0xd7, 0xbd, 0x5c, 0x8f, 0xfc, 0xd9, 0x80, 0x9f, 0x3d, 0x27, 0x9c, 0x83, 0x41, 0xae, 0x80, 0x5e,
+	0x8e, 0xef, 0x03, 0x4f, 0x53, 0xfe, 0x05, 0x81, 0x09, 0x66, 0x7f, 0x46, 0x9f, 0x37, 0x6a, 0x15,
+	0xbd, 0xcc, 0xc5, 0xaf, 0xdc, 0xd1, 0x35, 0xf3, 0xba, 0xa9, 0xea, 0xd6, 0x2d, 0xcd, 0x44, 0x3e,
+	0x5e, 0x8c, 0x8f, 0xc2, 0xa0, 0xa9, 0xcd, 0x6b, 0x95, 0x25, 0xcd, 0x64, 0x7d, 0x67, 0x8a, 0x7b,
+	0xde, 0xf9, 0xf1, 0xf8, 0x4e, 0xf4, 0xdc, 0x99, 0x85, 0x05, 0x53, 0xb3, 0xac, 0x6b, 0xb6, 0x59,
+	0xd1, 0xcb, 0x25, 0x4f, 0x92, 0x4e, 0x85, 0xb8, 0x7b, 0x03, 0x99, 0x21, 0x38, 0xe7, 0x4f, 0x04,
+	0x0a, 0x49, 0xd0, 0xa3, 0xeb, 0x3e, 0xe5, 0xc0, 0x77, 0xdb, 0x30, 0x7d, 0x26, 0x22, 0x5d, 0x17,
+	0x66, 0xcd, 0xe7, 0x4e, 0x6e, 0x2d, 0xbd, 0x8c, 0xfa, 0x02, 0xcf, 0x28, 0x6d, 0xde, 0x79, 0xf6,
+	0x3c, 0x9f, 0x0b, 0xc9, 0x28, 0x31, 0x73, 0xd2, 0x72, 0xb2, 0xfc, 0x3d, 0x02, 0xbb, 0x02, 0x08,
+	0xd0, 0x7b, 0x67, 0x61, 0xd0, 0xc2, 0x36, 0xf4, 0xde, 0xfe, 0x70, 0xef, 0xb9, 0x42, 0xfe, 0xbc,
+	0x43, 0xc5, 0xf4, 0x1c, 0x75, 0x03, 0x76, 0x88, 0x30, 0x63, 0xfb, 0xe9, 0x00, 0x00, 0x82, 0x71,
+	0xde, 0x3b, 0x00, 0xb6, 0x96, 0x32, 0xd8, 0x32, 0xb3, 0xd0, 0x1a, 0xd1, 0xdc, 0x2c, 0x92, 0x3f,
+	0x03, 0x03, 0x28, 0x84, 0x83, 0x2e, 0x36, 0x77, 0xae, 0x27, 0xff, 0x94, 0x4f, 0x6c, 0x37, 0x2c,
+	0xcd, 0x9c, 0x36, 0x8d, 0x46, 0xdd, 0xea, 0x76, 0xbe, 0x58, 0x87, 0x4d, 0x20, 0x29, 0x36, 0x77,
+	0x93, 0x14, 0x1f, 0x69, 0x83, 0xee, 0x79, 0xa6, 0xbf, 0xcc, 0x5a, 0x30, 0x29, 0xb2, 0xa1, 0x8e,
+	0xf1, 0x14, 0x45, 0xd7, 0xa0, 0x62, 0x7a, 0x49, 0xf1, 0x45, 0x9e, 0xbc, 0x5e, 0x77, 0x5d, 0x7b,
+	0x38, 0x0f, 0x83, 0x0c, 0xa5, 0xe7, 0xdf, 0xe2, 0x8e, 0xb5, 0x66, 0x6e, 0xbb, 0xab, 0xc5, 0xdf,
+	0xc8, 0xa5, 0x01, 0xf6, 0x93, 0x25, 0xd0, 0xee, 0x20, 0x02, 0x74, 0xd4, 0x29, 0xe8, 0x63, 0x42,
+	0x98, 0x40, 0x09, 0xfc, 0xe4, 0xea, 0xc9, 0x7f, 0x20, 0xb0, 0xdf, 0x6f, 0xfb, 0xb2, 0x56, 0x9b,
+	0xd3, 0x4c, 0xeb, 0x83, 0x26, 0x99, 0x5a, 0x5e, 0x7d, 0x87, 0xc0, 0x81, 0x0e, 0x8c, 0xd0, 0x69,
+	0x05, 0x18, 0xa8, 0xb9, 0x4d, 0x2c, 0xbd, 0xa2, 0x3e, 0x38, 0x5c, 0x30, 0xbd, 0x74, 0xfa, 0x1d,
+	0x81, 0x3d, 0x0c, 0x1e, 0x83, 0x36, 0xa3, 0x2f, 0x55, 0x6c, 0xed, 0xf1, 0x75, 0xf6, 0x5b, 0x04,
+	0xf6, 0x86, 0xb0, 0x41, 0x47, 0x9f, 0x87, 0x81, 0x8a, 0xdb, 0x84, 0xe3, 0x78, 0x34, 0x34, 0x3f,
+	0x05, 0x5d, 0xdf, 0x24, 0x87, 0xba, 0xe9, 0xf9, 0xfe, 0x6b, 0x7c, 0xca, 0x11, 0x7a, 0xec, 0xda,
+	0xf5, 0x8e, 0xa2, 0x36, 0x6f, 0x6a, 0xf6, 0xec, 0xa2, 0x6a, 0x2d, 0x32, 0x78, 0x19, 0x9f, 0x62,
+	0xeb, 0xa5, 0xa3, 0xc8, 0x9e, 0x2e, 0x38, 0x0f, 0xb3, 0xed, 0x89, 0x20, 0x7c, 0x17, 0xfb, 0x5d,
+	0xf6, 0x38, 0xb0, 0x13, 0x39, 0x0e, 0x55, 0xe5, 0x87, 0x7c, 0x24, 0x30, 0xb9, 0x33, 0xf5, 0x7a,
+	0xb5, 0x32, 0xaf, 0xfa, 0x56, 0x00, 0x8f, 0x5d, 0xbe, 0xdd, 0x27, 0x90, 0xed, 0x44, 0x09, 0x5d,
+	0x77, 0x1d, 0xb6, 0xa8, 0x42, 0x3b, 0x66, 0xde, 0x53, 0x9d, 0x1d, 0x28, 0x58, 0x11, 0xbd, 0xe8,
+	0xb3, 0x92, 0x5e, 0x0e, 0xde, 0xe7, 0x13, 0x6e, 0xb0, 0xef, 0x0f, 0x3c, 0x26, 0xc7, 0x21, 0x83,
+	0x14, 0x75, 0x9b, 0x85, 0x24, 0x6a, 0x22, 0x6c, 0x89, 0xca, 0x56, 0x87, 0xac, 0xf2, 0x22, 0x50,
+	0x82, 0x21, 0xc1, 0x77, 0x98, 0xc1, 0xc9, 0x03, 0x20, 0x1a, 0x91, 0xbf, 0xcd, 0xa7, 0x4d, 0x6f,
+	0x0b, 0xa2, 0xa6, 0x90, 0xc6, 0x29, 0x2e, 0x70, 0xf7, 0x86, 0xa0, 0xf3, 0x3e, 0xd2, 0xbd, 0x73,
+	0xaa, 0x1e, 0x3d, 0x07, 0x0a, 0x8a, 0xa2, 0x0f, 0x98, 0x62, 0x7a, 0xc9, 0xf7, 0x2d, 0xbe, 0x5c,
+	0x2c, 0xa9, 0xb6, 0x76, 0xa9, 0x52, 0xab, 0xd8, 0x1f, 0x1e, 0x1f, 0xfe, 0x80, 0x4f, 0xce, 0x22,
+	0x36, 0xf4, 0xe0, 0x45, 0x18, 0x32, 0x55, 0x5b, 0x9b, 0xad, 0xb2, 0xe6, 0xc8, 0x45, 0xa1, 0xa7,
+	0x2d, 0xba, 0x11, 0x4c, 0xcf, 0x66, 0x7a, 0xce, 0x7c, 0x97, 0xc0, 0x3e, 0x6f, 0xa1, 0x71, 0x55,
+	0x33, 0x6b, 0x15, 0xcb, 0x4a, 0x65, 0x72, 0x3d, 0xda, 0xbe, 0x00, 0x2f, 0xee, 0x5a, 0x6b, 0xe6,
+	0x46, 0xbc, 0x0f, 0x0a, 0xbe, 0x93, 0xc5, 0x75, 0xf9, 0x0b, 0xd0, 0xdb, 0xb0, 0x34, 0x13, 0x47,
+	0xf2, 0x33, 0x6b, 0xcd, 0xdc, 0x90, 0x2b, 0xef, 0xb4, 0xca, 0x1d, 0x07, 0x36, 0x53, 0x12, 0xb6,
+	0xc1, 0x6f, 0x8b, 0x0b, 0x42, 0x1f, 0x2b, 0x8c, 0xc5, 0x73, 0x30, 0x54, 0x6f, 0x35, 0xe3, 0x0a,
+	0x4a, 0xa0, 0x25, 0xbc, 0x94, 0x4b, 0xa2, 0x28, 0xfd, 0x0c, 0x0c, 0x2c, 0x68, 0xb6, 0x5a, 0xa9,
+	0x5a, 0x7b, 0x36, 0x45, 0x4c, 0xca, 0xad, 0x4e, 0xcf, 0x31, 0xe9, 0xe2, 0x3e, 0x27, 0x90, 0x6b,
+	0xcd, 0xdc, 0x36, 0xb7, 0x03, 0xb4, 0x21, 0xe3, 0x2a, 0x81, 0x3f, 0xfe, 0x75, 0x33, 0x0c, 0x07,
+	0x55, 0xbb, 0xdd, 0xba, 0xd1, 0xd3, 0x82, 0x53, 0x87, 0x0a, 0x63, 0xb1, 0xf0, 0xb2, 0xf5, 0xf6,
+	0x85, 0x1e, 0xd7, 0xb3, 0xf4, 0x2c, 0x5f, 0xa1, 0xf7, 0x32, 0x13, 0x87, 0xe3, 0x99, 0x60, 0xf3,
+	0xe2, 0x85, 0x1e, 0x5c, 0xa5, 0x4b, 0x5f, 0x26, 0xd0, 0xeb, 0x58, 0xf5, 0x82, 0x4c, 0x36, 0x10,
+	0x64, 0x7a, 0x0c, 0xa0, 0x15, 0x0e, 0x16, 0x82, 0x8c, 0x98, 0x57, 0xad, 0x77, 0x72, 0x49, 0x10,
+	0xf4, 0x72, 0x83, 0x48, 0x55, 0xe8, 0x63, 0xc0, 0xe8, 0xf3, 0xc2, 0xa7, 0x86, 0xb0, 0xfc, 0xcc,
+	0xae, 0x36, 0x73, 0x03, 0xee, 0x7a, 0xe4, 0x5c, 0xe4, 0x57, 0x27, 0xdb, 0x0e, 0x22, 0xbc, 0xb7,
+	0xd6, 0xaf, 0x62, 0x1f, 0x6c, 0xb6, 0x1a, 0x35, 0xf9, 0xb5, 0x4d, 0xf8, 0xe5, 0x09, 0xba, 0xeb,
+	0x71, 0x1c, 0x72, 0x81, 0x68, 0xf4, 0x32, 0x13, 0x09, 0xa2, 0xd1, 0x23, 0xff, 0x9b, 0xaf, 0x85,
+	0x42, 0xdc, 0x81, 0x63, 0xf5, 0x34, 0x6c, 0x5b, 0x54, 0xad, 0x59, 0xa1, 0x1f, 0xc7, 0x25, 0x83,
+	0xc5, 0xbd, 0x6b, 0xcd, 0xdc, 0x2e, 0xb7, 0x1f, 0xff, 0x7b, 0xb9, 0xb4, 0x75, 0x51, 0xb5, 0x5a,
+	0x06, 0xe9, 0x21, 0xe8, 0x9f, 0x53, 0x75, 0x5d, 0x73, 0x9d, 0x32, 0x58, 0x1c, 0x59, 0x6b, 0xe6,
+	0xb6, 0xba, 0x9a, 0x6e, 0xbb, 0x5c, 0x42, 0x01, 0x67, 0x78, 0x5b, 0x46, 0xc3, 0x74, 0xea, 0xa8,
+	0x9b, 0x63, 0x0d, 0xef, 0x6b, 0x4c, 0x3a, 0x38, 0xbc, 0xd1, 0x06, 0x1f, 0xde, 0xfc, 0xf1, 0xd7,
+	0xbd, 0x30, 0x1c, 0x54, 0x0d, 0x84, 0x8d, 0xc4, 0x0c, 0x5b, 0x01, 0x32, 0x15, 0x7d, 0x51, 0x33,
+	0x2b, 0xb6, 0x47, 0x6b, 0xe7, 0x5a, 0x33, 0x37, 0xec, 0x2a, 0x79, 0xaf, 0xe4, 0x52, 0x4b, 0xcc,
+	0x19, 0xc6, 0xc6, 0x1d, 0x1d, 0x63, 0xbd, 0xfe, 0x30, 0x76, 0xf1, 0xe5, 0x59, 0xb1, 0xcf, 0x19,
+	0xc6, 0x4c, 0xd7, 0x9b, 0x4d, 0x7a, 0x63, 0xcd, 0x26, 0x68, 0x23, 0x7c, 0x36, 0xe9, 0x4b, 0x02,
+	0x23, 0x30, 0x9b, 0x8c, 0x40, 0x1f, 0x03, 0x26, 0x8c, 0xec, 0x8b, 0x38, 0xbf, 0x6c, 0x78, 0x72,
+	0x17, 0x6c, 0x7d, 0x85, 0xa4, 0x30, 0x4d, 0x04, 0x80, 0x6c, 0xda, 0x00, 0x90, 0xf6, 0x09, 0xe4,
+	0x3f, 0x04, 0x24, 0xef, 0xdb, 0x76, 0xa6, 0x5a, 0x35, 0xee, 0xa8, 0xfa, 0x7c, 0x0a, 0xbb, 0xef,
+	0x73, 0x30, 0x50, 0x36, 0x55, 0xdd, 0xd6, 0x34, 0xdc, 0xfe, 0x8d, 0xb5, 0xd2, 0x19, 0x5f, 0x74,
+	0x9e, 0x0d, 0xb8, 0x6a, 0x5a, 0x7b, 0x24, 0xff, 0xb7, 0x7c, 0x5f, 0x28, 0x5f, 0x9c, 0x1e, 0x5e,
+	0x72, 0xca, 0x6c, 0xaa, 0xee, 0xad, 0xa8, 0xa4, 0x0e, 0x6b, 0x74, 0x55, 0xb7, 0x8b, 0x12, 0x8e,
+	0xd2, 0xad, 0x02, 0x2d, 0x3e, 0x48, 0xd1, 0x4a, 0x7a, 0x4b, 0xab, 0xf7, 0x38, 0x70, 0x77, 0x7f,
+	0x90, 0x5e, 0xa4, 0xfe, 0x47, 0xfb, 0x56, 0x21, 0x26, 0x3f, 0xf1, 0xef, 0xff, 0x1e, 0xa3, 0xa0,
+	0xbc, 0xcf, 0x83, 0xe2, 0x81, 0xbe, 0x61, 0xa9, 0xe5, 0xff, 0xe3, 0xe1, 0xf3, 0x2b, 0x1e, 0xaa,
+	0x36, 0xc2, 0x18, 0xaa, 0x97, 0xa1, 0xbf, 0xc1, 0x5a, 0x30, 0x54, 0x4f, 0x86, 0x86, 0xca, 0xaf,
+	0x1d, 0x8c, 0x99, 0x6b, 0x80, 0xc7, 0xcc, 0x7d, 0x4a, 0x2f, 0x66, 0x37, 0x31, 0x64, 0x53, 0x9a,
+	0x76, 0xdd, 0xf8, 0x9c, 0xa6, 0x5b, 0x67, 0x0d, 0xfd, 0x56, 0xa5, 0xdc, 0x6d, 0xc8, 0xe4, 0x32,
+	0xec, 0x0f, 0xb7, 0x8b, 0x9e, 0x99, 0x86, 0xfe, 0x79, 0xd6, 0x82, 0xbb, 0xff, 0x8f, 0x86, 0x7a,
+	0x26, 0xa0, 0xed, 0xab, 0x61, 0xb9, 0xea, 0xf2, 0xab, 0x30, 0xca, 0x3a, 0xba, 0x6e, 0x6a, 0xaa,
+	0xd5, 0x30, 0xef, 0xde, 0x34, 0xec, 0x8a, 0x5e, 0x4e, 0x89, 0xc5, 0x6d, 0x78, 0x22, 0xc2, 0x38,
+	0x52, 0xb9, 0x14, 0xa0, 0x72, 0x28, 0x94, 0x4a, 0x98, 0x89, 0x30, 0x3e, 0xf7, 0x78, 0x4d, 0x8e,
+	0x2b, 0x5c, 0x35, 0x8d, 0xba, 0x61, 0xa9, 0xd5, 0x0f, 0xcf, 0x46, 0xfc, 0x67, 0x7c, 0x5d, 0x19,
+	0x02, 0xd1, 0x9b, 0xa3, 0x32, 0x75, 0xde, 0x18, 0x59, 0x60, 0x0b, 0x9a, 0xf0, 0x1d, 0x9e, 0x7b,
+	0x26, 0xd2, 0xcb, 0xf7, 0xd7, 0xf9, 0x90, 0x0d, 0x76, 0x9c, 0x46, 0x99, 0x97, 0xe3, 0xe5, 0x1f,
+	0x0f, 0x9f, 0xa2, 0xf0, 0xd2, 0x59, 0xb0, 0xe3, 0xd3, 0xcc, 0x82, 0x5c, 0xeb, 0x10, 0x70, 0x21,
+	0xc1, 0x06, 0xb9, 0x78, 0x64, 0xad, 0x2c, 0xca, 0x97, 0x9e, 0x05, 0xf9, 0x2f, 0x24, 0x90, 0xd4,
+	0x5c, 0xfc, 0xa6, 0x91, 0xc6, 0x41, 0xc3, 0x46, 0xdd, 0x90, 0x5a, 0x05, 0xf8, 0x47, 0x04, 0xe4,
+	0x28, 0x7e, 0xe8, 0xd4, 0x22, 0xf4, 0x2d, 0x19, 0xad, 0x83, 0x87, 0x27, 0xd6, 0x1b, 0xb4, 0xbe,
+	0x02, 0xba, 0xab, 0x9a, 0x5a, 0x56, 0x16, 0x56, 0x9f, 0x84, 0x3e, 0x86, 0x99, 0xbe, 0x46, 0x20,
+	0xe3, 0xdd, 0x41, 0xa1, 0xe1, 0x3b, 0x80, 0xd0, 0xab, 0x30, 0xd2, 0xe1, 0x58, 0xb2, 0x6e, 0xe7,
+	0xf2, 0xd3, 0x5f, 0x7a, 0xf7, 0xef, 0x5f, 0xdf, 0x34, 0x4a, 0xb3, 0x4a, 0xd8, 0xdd, 0x1b, 0xef,
+	0x81, 0x7e, 0x93, 0xc0, 0x20, 0xd7, 0xa6, 0x87, 0xd6, 0xef, 0x81, 0x83, 0x19, 0x8b, 0x23, 0x8a,
+	0x58, 0x8e, 0x32, 0x2c, 0x79, 0x7a, 0x24, 0x1a, 0x8b, 0xb2, 0x2c, 0xe4, 0xdc, 0x0a, 0x7d, 0x9f,
+	0xc0, 0x53, 0xb1, 0x2e, 0x62, 0xd0, 0xa9, 0xce, 0x58, 0x92, 0xdc, 0x43, 0x91, 0xa6, 0xbb, 0xb6,
+	0x13, 0x8b, 0x30, 0xdb, 0x09, 0x8e, 0xdb, 0xa8, 0x3c, 0xee, 0xdd, 0xf6, 0xf8, 0x86, 0x13, 0x0a,
+	0x7e, 0xa3, 0x21, 0x2a, 0x14, 0xfe, 0x4b, 0x1c, 0xd2, 0x58, 0x1c, 0x51, 0x44, 0x36, 0xc9, 0x90,
+	0x8d, 0xd3, 0xc3, 0xa1, 0xc8, 0x7c, 0x01, 0x50, 0xbc, 0xdb, 0x15, 0xf7, 0x08, 0x0c, 0xa0, 0x25,
+	0x7a, 0x70, 0xdd, 0xce, 0x38, 0xac, 0x43, 0x31, 0x24, 0x11, 0xd5, 0x69, 0x86, 0xea, 0x24, 0x7d,
+	0x2e, 0x01, 0x2a, 0x65, 0xb9, 0xb5, 0xb7, 0x5f, 0xa1, 0xdf, 0x25, 0x00, 0xad, 0x5b, 0x04, 0x34,
+	0x62, 0xa8, 0xb4, 0x5d, 0x93, 0x90, 0x8e, 0xc4, 0x13, 0x46, 0xac, 0x2f, 0x30, 0xac, 0xc7, 0xe8,
+	0x64, 0x92, 0x64, 0x56, 0xf0, 0x4a, 0xc2, 0xf7, 0x09, 0x64, 0x3c, 0x9b, 0x51, 0x83, 0x3f, 0x78,
+	0xd3, 0x40, 0x3a, 0x1c, 0x4b, 0x16, 0x31, 0x4e, 0x31, 0x8c, 0xa7, 0xe9, 0x27, 0x36, 0x80, 0x51,
+	0x59, 0xe6, 0x9b, 0xa0, 0x15, 0xfa, 0x5b, 0x02, 0xc3, 0xc1, 0x33, 0x74, 0x3a, 0x11, 0x03, 0x89,
+	0xff, 0x06, 0x81, 0x54, 0x48, 0xa2, 0x82, 0x1c, 0x5e, 0x62, 0x1c, 0x2e, 0xd0, 0xa9, 0xee, 0x38,
+	0x28, 0xfc, 0xf8, 0xfe, 0x2d, 0x02, 0x5b, 0xc4, 0x23, 0x6a, 0x3a, 0xde, 0x19, 0x54, 0xc8, 0xc1,
+	0xbc, 0x94, 0x8f, 0x2b, 0x8e, 0xf8, 0x3f, 0xc6, 0xf0, 0x1f, 0xa7, 0x47, 0x13, 0xe1, 0xe7, 0x07,
+	0xde, 0x6f, 0x13, 0x18, 0x12, 0xcc, 0xd2, 0x23, 0xb1, 0x7a, 0xe7, 0x58, 0xc7, 0x63, 0x4a, 0x23,
+	0xd4, 0x8b, 0x0c, 0xea, 0x39, 0x5a, 0xdc, 0x08, 0x54, 0x65, 0x59, 0x38, 0xde, 0x5e, 0xa1, 0x7f,
+	0x24, 0x30, 0xd2, 0x76, 0x32, 0x4b, 0x0b, 0xeb, 0x00, 0x0a, 0x39, 0x99, 0x96, 0x26, 0x13, 0xe9,
+	0x20, 0x95, 0x6b, 0x8c, 0xca, 0x65, 0xfa, 0x62, 0x97, 0x59, 0xe3, 0x3b, 0xf9, 0xfd, 0x33, 0x81,
+	0xe1, 0x60, 0x97, 0x51, 0xc3, 0xa0, 0xc3, 0xb9, 0xae, 0x54, 0x48, 0xa2, 0x82, 0x84, 0x66, 0x19,
+	0xa1, 0x57, 0xe8, 0xcb, 0x29, 0x12, 0x52, 0x96, 0xbd, 0xa3, 0xdc, 0x15, 0xfa, 0x06, 0x81, 0x2d,
+	0xe2, 0x99, 0x65, 0xd4, 0xb8, 0x08, 0x39, 0x79, 0x95, 0xf2, 0x71, 0xc5, 0x91, 0xd0, 0xf3, 0x8c,
+	0xd0, 0x24, 0x9d, 0x48, 0x44, 0x88, 0x1d, 0x82, 0xbe, 0x49, 0x00, 0x5a, 0x47, 0x83, 0x51, 0x93,
+	0x7c, 0xdb, 0xe1, 0xa6, 0x74, 0x24, 0x9e, 0x70, 0xac, 0x0f, 0x52, 0x27, 0x90, 0xa6, 0x6a, 0x6b,
+	0xe3, 0xee, 0x01, 0x25, 0xbd, 0x4f, 0x60, 0x7b, 0xe0, 0xfc, 0x8c, 0x3e, 0x1b, 0x3d, 0x0d, 0xb6,
+	0x1f, 0x20, 0x4a, 0x13, 0x09, 0x34, 0x10, 0xfa, 0x34, 0x83, 0x7e, 0x86, 0x9e, 0x4a, 0x04, 0x5d,
+	0x28, 0x9f, 0x2a, 0xcb, 0x0d, 0x4b, 0x33, 0x57, 0xe8, 0xef, 0x09, 0x8c, 0xb4, 0x9d, 0x2b, 0x44,
+	0x8d, 0xe4, 0x4e, 0x67, 0x32, 0xd2, 0x64, 0x22, 0x1d, 0xe4, 0x71, 0x85, 0xf1, 0x98, 0xa1, 0xd3,
+	0x5d, 0xf2, 0x50, 0xf0, 0x74, 0x90, 0xfe, 0x9c, 0xc0, 0x36, 0x7f, 0x15, 0x94, 0x2a, 0xd1, 0xee,
+	0x6d, 0xab, 0x3a, 0x4a, 0xcf, 0xc6, 0x57, 0x40, 0x1a, 0xe7, 0x19, 0x8d, 0x53, 0xf4, 0xe3, 0x89,
+	0x68, 0xa8, 0x9e, 0x21, 0xc5, 0x21, 0x61, 0xd1, 0x5f, 0x12, 0xd8, 0x1e, 0x28, 0x17, 0x46, 0xa5,
+	0x53, 0x78, 0xd1, 0x54, 0x9a, 0x48, 0xa0, 0xd1, 0xd5, 0x52, 0x42, 0xc0, 0x8f, 0x2b, 0x9f, 0x07,
+	0x04, 0xb6, 0x07, 0x8a, 0x68, 0x51, 0x04, 0xc2, 0x0b, 0x8c, 0xd2, 0x44, 0x02, 0x0d, 0x24, 0x70,
+	0x95, 0x11, 0xb8, 0x48, 0x2f, 0x6c, 0x3c, 0x00, 0x8e, 0x3d, 0x65, 0x19, 0xab, 0x8c, 0x2b, 0x2c,
+	0x16, 0x81, 0xba, 0x55, 0x14, 0x95, 0xf0, 0xc2, 0x9b, 0x34, 0x91, 0x40, 0xa3, 0xab, 0x58, 0xdc,
+	0xd2, 0xb4, 0x71, 0x9b, 0x99, 0x1b, 0x77, 0x2b, 0x50, 0xf4, 0x1d, 0x02, 0x3b, 0xc3, 0xaa, 0x55,
+	0xf4, 0x58, 0x67, 0x4c, 0x11, 0xd5, 0x37, 0xe9, 0x78, 0x52, 0x35, 0xe4, 0xf3, 0x22, 0xe3, 0x73,
+	0x9e, 0x9e, 0x4d, 0xc4, 0xc7, 0x46, 0x93, 0xca, 0x12, 0xb3, 0xc9, 0x49, 0xfd, 0x86, 0xc0, 0x48,
+	0x5b, 0xb9, 0x2a, 0x6a, 0xba, 0xea, 0x54, 0x7e, 0x93, 0x26, 0x13, 0xe9, 0x74, 0x35, 0xed, 0x7a,
+	0x5c, 0x5a, 0x85, 0xb0, 0x87, 0x04, 0x86, 0x83, 0xdd, 0x44, 0x2d, 0x36, 0x3a, 0x94, 0xb9, 0xa4,
+	0x42, 0x12, 0x15, 0x24, 0x71, 0x83, 0x91, 0xb8, 0x42, 0x2f, 0x77, 0x49, 0x42, 0x59, 0x16, 0x8a,
+	0x40, 0x2b, 0xf4, 0x1f, 0x04, 0x76, 0x85, 0xd6, 0x6a, 0xe8, 0xf1, 0xf8, 0x20, 0xc5, 0xe2, 0x95,
+	0x74, 0x22, 0xb1, 0x1e, 0x32, 0x7c, 0x95, 0x31, 0xbc, 0x41, 0xaf, 0xa5, 0xca, 0x50, 0x61, 0xd5,
+	0xa2, 0xe2, 0xa5, 0x07, 0xab, 0x59, 0xf2, 0x70, 0x35, 0x4b, 0xfe, 0xb6, 0x9a, 0x25, 0xaf, 0x3f,
+	0xca, 0xf6, 0x3c, 0x7c, 0x94, 0xed, 0x79, 0xef, 0x51, 0xb6, 0xe7, 0xd3, 0x85, 0x72, 0xc5, 0x5e,
+	0x6c, 0xcc, 0xe5, 0xe7, 0x8d, 0x1a, 0x76, 0x3c, 0x5e, 0x55, 0xe7, 0x2c, 0x0e, 0x62, 0xe9, 0x84,
+	0xf2, 0x79, 0xa1, 0x73, 0xfb, 0x6e, 0x5d, 0xb3, 0xe6, 0xfa, 0xd9, 0x7f, 0x46, 0x4d, 0xfe, 0x77,
+	0x00, 0x4f, 0x93, 0xfc, 0xe1, 0x01, 0x36, 0x00, 0x00,
 }
 
-func (m *QueryGroupAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func (this *PermissionDetail) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryGroupAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	that1, ok := that.(*PermissionDetail)
+	if !ok {
+		that2, ok := that.(PermissionDetail)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.SubspaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
-		i--
-		dAtA[i] = 0x8
+	if this.SubspaceId != that1.SubspaceId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGroupAllowancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.SectionId != that1.SectionId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGroupAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1.Sum == nil {
+		if this.Sum != nil {
+			return false
+		}
+	} else if this.Sum == nil {
+		return false
+	} else if !this.Sum.Equal(that1.Sum) {
+		return false
+	}
+	return true
 }
+func (this *PermissionDetail_User_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryGroupAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	that1, ok := that.(*PermissionDetail_User_)
+	if !ok {
+		that2, ok := that.(PermissionDetail_User_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Grants) > 0 {
-		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !this.User.Equal(that1.User) {
+		return false
+	}
+	return true
 }
+func (this *PermissionDetail_Group_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*PermissionDetail_Group_)
+	if !ok {
+		that2, ok := that.(PermissionDetail_Group_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QuerySubspacesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if !this.Group.Equal(that1.Group) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *QuerySubspacesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PermissionDetail_User) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.Subspaces) > 0 {
-		for _, e := range m.Subspaces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+
+	that1, ok := that.(*PermissionDetail_User)
+	if !ok {
+		that2, ok := that.(PermissionDetail_User)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *QuerySubspaceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.User != that1.User {
+		return false
 	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
+	if len(this.Permission) != len(that1.Permission) {
+		return false
 	}
-	return n
-}
-
-func (m *QuerySubspaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	for i := range this.Permission {
+		if this.Permission[i] != that1.Permission[i] {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = m.Subspace.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return true
 }
+func (this *PermissionDetail_Group) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QuerySectionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PermissionDetail_Group)
+	if !ok {
+		that2, ok := that.(PermissionDetail_Group)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if this.GroupID != that1.GroupID {
+		return false
 	}
-	return n
-}
-
-func (m *QuerySectionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Permission) != len(that1.Permission) {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.Sections) > 0 {
-		for _, e := range m.Sections {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	for i := range this.Permission {
+		if this.Permission[i] != that1.Permission[i] {
+			return false
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return true
 }
+func (this *PermissionSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QuerySectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PermissionSource)
+	if !ok {
+		that2, ok := that.(PermissionSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.SectionId != 0 {
-		n += 1 + sovQuery(uint64(m.SectionId))
-	}
-	return n
-}
-
-func (m *QuerySectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Section.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryUserGroupsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
+	if this.SectionId != that1.SectionId {
+		return false
 	}
-	if m.SectionId != 0 {
-		n += 1 + sovQuery(uint64(m.SectionId))
+	if this.Inherited != that1.Inherited {
+		return false
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if that1.Sum == nil {
+		if this.Sum != nil {
+			return false
+		}
+	} else if this.Sum == nil {
+		return false
+	} else if !this.Sum.Equal(that1.Sum) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *QueryUserGroupsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PermissionSource_Owner_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.Groups) > 0 {
-		for _, e := range m.Groups {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+
+	that1, ok := that.(*PermissionSource_Owner_)
+	if !ok {
+		that2, ok := that.(PermissionSource_Owner_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if !this.Owner.Equal(that1.Owner) {
+		return false
+	}
+	return true
 }
+func (this *PermissionSource_User_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryUserGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PermissionSource_User_)
+	if !ok {
+		that2, ok := that.(PermissionSource_User_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
+	if !this.User.Equal(that1.User) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *QueryUserGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PermissionSource_Group_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = m.Group.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryUserGroupMembersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
+	that1, ok := that.(*PermissionSource_Group_)
+	if !ok {
+		that2, ok := that.(PermissionSource_Group_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if !this.Group.Equal(that1.Group) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *QueryUserGroupMembersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PermissionSource_Owner) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+
+	that1, ok := that.(*PermissionSource_Owner)
+	if !ok {
+		that2, ok := that.(PermissionSource_Owner)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	return true
 }
+func (this *PermissionSource_User) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryUserPermissionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PermissionSource_User)
+	if !ok {
+		that2, ok := that.(PermissionSource_User)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.SectionId != 0 {
-		n += 1 + sovQuery(uint64(m.SectionId))
+	if len(this.Permissions) != len(that1.Permissions) {
+		return false
 	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	for i := range this.Permissions {
+		if this.Permissions[i] != that1.Permissions[i] {
+			return false
+		}
 	}
-	return n
+	return true
 }
-
-func (m *QueryUserPermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (this *PermissionSource_Group) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Details) > 0 {
-		for _, e := range m.Details {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+
+	that1, ok := that.(*PermissionSource_Group)
+	if !ok {
+		that2, ok := that.(PermissionSource_Group)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *PermissionDetail) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
+	if this.GroupID != that1.GroupID {
+		return false
 	}
-	if m.SectionId != 0 {
-		n += 1 + sovQuery(uint64(m.SectionId))
+	if len(this.Permissions) != len(that1.Permissions) {
+		return false
 	}
-	if m.Sum != nil {
-		n += m.Sum.Size()
+	for i := range this.Permissions {
+		if this.Permissions[i] != that1.Permissions[i] {
+			return false
+		}
 	}
-	return n
+	return true
 }
 
-func (m *PermissionDetail_User_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-func (m *PermissionDetail_Group_) Size() (n int) {
-	if m == nil {
-		return 0
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Subspaces queries all the subspaces inside Desmos
+	Subspaces(ctx context.Context, in *QuerySubspacesRequest, opts ...grpc.CallOption) (*QuerySubspacesResponse, error)
+	// Subspace queries all the information about the subspace with the given id
+	Subspace(ctx context.Context, in *QuerySubspaceRequest, opts ...grpc.CallOption) (*QuerySubspaceResponse, error)
+	// IncomingSubspaceOwnerTransferRequests queries all the subspace owner
+	// transfer requests that have been made towards the receiver with the given
+	// address
+	IncomingSubspaceOwnerTransferRequests(ctx context.Context, in *QueryIncomingSubspaceOwnerTransferRequestsRequest, opts ...grpc.CallOption) (*QueryIncomingSubspaceOwnerTransferRequestsResponse, error)
+	// Sections allows to query for the sections of a specific subspace
+	Sections(ctx context.Context, in *QuerySectionsRequest, opts ...grpc.CallOption) (*QuerySectionsResponse, error)
+	// Section queries all the information about the section with the given id
+	Section(ctx context.Context, in *QuerySectionRequest, opts ...grpc.CallOption) (*QuerySectionResponse, error)
+	// UserGroups queries all the groups that are present inside the subspace with
+	// the given id
+	UserGroups(ctx context.Context, in *QueryUserGroupsRequest, opts ...grpc.CallOption) (*QueryUserGroupsResponse, error)
+	// UserGroup queries the user group having the given id inside the specific
+	// subspace
+	UserGroup(ctx context.Context, in *QueryUserGroupRequest, opts ...grpc.CallOption) (*QueryUserGroupResponse, error)
+	// UserGroupMembers queries all the members of a given user group
+	UserGroupMembers(ctx context.Context, in *QueryUserGroupMembersRequest, opts ...grpc.CallOption) (*QueryUserGroupMembersResponse, error)
+	// GroupInvites queries all the user group invites that exist inside the
+	// subspace with the given id
+	GroupInvites(ctx context.Context, in *QueryGroupInvitesRequest, opts ...grpc.CallOption) (*QueryGroupInvitesResponse, error)
+	// GroupInvite queries the user group invite associated with the given
+	// secret hash inside the specific subspace
+	GroupInvite(ctx context.Context, in *QueryGroupInviteRequest, opts ...grpc.CallOption) (*QueryGroupInviteResponse, error)
+	// GroupApplications queries all the pending applications to join the given
+	// user group
+	GroupApplications(ctx context.Context, in *QueryGroupApplicationsRequest, opts ...grpc.CallOption) (*QueryGroupApplicationsResponse, error)
+	// GroupApplication queries the pending application made by the given user to
+	// join the specific user group
+	GroupApplication(ctx context.Context, in *QueryGroupApplicationRequest, opts ...grpc.CallOption) (*QueryGroupApplicationResponse, error)
+	// SubspaceBans queries all the active bans inside the subspace with the
+	// given id
+	SubspaceBans(ctx context.Context, in *QuerySubspaceBansRequest, opts ...grpc.CallOption) (*QuerySubspaceBansResponse, error)
+	// RateLimits queries all the rate limits set inside the subspace with the
+	// given id
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// UserPermissions queries the permissions for the given user
+	UserPermissions(ctx context.Context, in *QueryUserPermissionsRequest, opts ...grpc.CallOption) (*QueryUserPermissionsResponse, error)
+	// PermissionDetails queries all the sources that grant the given permission
+	// to the given user
+	PermissionDetails(ctx context.Context, in *QueryPermissionDetailsRequest, opts ...grpc.CallOption) (*QueryPermissionDetailsResponse, error)
+	// UserAllowances returns all the grants for users.
+	UserAllowances(ctx context.Context, in *QueryUserAllowancesRequest, opts ...grpc.CallOption) (*QueryUserAllowancesResponse, error)
+	// GroupAllowances returns all the grants for groups.
+	GroupAllowances(ctx context.Context, in *QueryGroupAllowancesRequest, opts ...grpc.CallOption) (*QueryGroupAllowancesResponse, error)
+	// AllowanceUsages returns the usages of the message limits allowances made
+	// by a user during the current period
+	AllowanceUsages(ctx context.Context, in *QueryAllowanceUsagesRequest, opts ...grpc.CallOption) (*QueryAllowanceUsagesResponse, error)
+	// FeeTokensConfig queries the fee tokens configuration of the subspace with
+	// the given id
+	FeeTokensConfig(ctx context.Context, in *QueryFeeTokensConfigRequest, opts ...grpc.CallOption) (*QueryFeeTokensConfigResponse, error)
+	// TreasuryVotingConfig queries the treasury voting configuration of the
+	// subspace with the given id
+	TreasuryVotingConfig(ctx context.Context, in *QueryTreasuryVotingConfigRequest, opts ...grpc.CallOption) (*QueryTreasuryVotingConfigResponse, error)
+	// TreasuryProposals queries all the treasury proposals of the subspace with
+	// the given id
+	TreasuryProposals(ctx context.Context, in *QueryTreasuryProposalsRequest, opts ...grpc.CallOption) (*QueryTreasuryProposalsResponse, error)
+	// TreasuryProposal queries the treasury proposal with the given id
+	TreasuryProposal(ctx context.Context, in *QueryTreasuryProposalRequest, opts ...grpc.CallOption) (*QueryTreasuryProposalResponse, error)
+	// TreasuryProposalVotes queries all the votes of the treasury proposal with
+	// the given id
+	TreasuryProposalVotes(ctx context.Context, in *QueryTreasuryProposalVotesRequest, opts ...grpc.CallOption) (*QueryTreasuryProposalVotesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Subspaces(ctx context.Context, in *QuerySubspacesRequest, opts ...grpc.CallOption) (*QuerySubspacesResponse, error) {
+	out := new(QuerySubspacesResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/Subspaces", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Group != nil {
-		l = m.Group.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Subspace(ctx context.Context, in *QuerySubspaceRequest, opts ...grpc.CallOption) (*QuerySubspaceResponse, error) {
+	out := new(QuerySubspaceResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/Subspace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
-func (m *PermissionDetail_User) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) IncomingSubspaceOwnerTransferRequests(ctx context.Context, in *QueryIncomingSubspaceOwnerTransferRequestsRequest, opts ...grpc.CallOption) (*QueryIncomingSubspaceOwnerTransferRequestsResponse, error) {
+	out := new(QueryIncomingSubspaceOwnerTransferRequestsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/IncomingSubspaceOwnerTransferRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Sections(ctx context.Context, in *QuerySectionsRequest, opts ...grpc.CallOption) (*QuerySectionsResponse, error) {
+	out := new(QuerySectionsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/Sections", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Permission) > 0 {
-		for _, s := range m.Permission {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) Section(ctx context.Context, in *QuerySectionRequest, opts ...grpc.CallOption) (*QuerySectionResponse, error) {
+	out := new(QuerySectionResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/Section", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *PermissionDetail_Group) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) UserGroups(ctx context.Context, in *QueryUserGroupsRequest, opts ...grpc.CallOption) (*QueryUserGroupsResponse, error) {
+	out := new(QueryUserGroupsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/UserGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.GroupID != 0 {
-		n += 1 + sovQuery(uint64(m.GroupID))
+	return out, nil
+}
+
+func (c *queryClient) UserGroup(ctx context.Context, in *QueryUserGroupRequest, opts ...grpc.CallOption) (*QueryUserGroupResponse, error) {
+	out := new(QueryUserGroupResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/UserGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Permission) > 0 {
-		for _, s := range m.Permission {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) UserGroupMembers(ctx context.Context, in *QueryUserGroupMembersRequest, opts ...grpc.CallOption) (*QueryUserGroupMembersResponse, error) {
+	out := new(QueryUserGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/UserGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryUserAllowancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GroupInvites(ctx context.Context, in *QueryGroupInvitesRequest, opts ...grpc.CallOption) (*QueryGroupInvitesResponse, error) {
+	out := new(QueryGroupInvitesResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/GroupInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
+	return out, nil
+}
+
+func (c *queryClient) GroupInvite(ctx context.Context, in *QueryGroupInviteRequest, opts ...grpc.CallOption) (*QueryGroupInviteResponse, error) {
+	out := new(QueryGroupInviteResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/GroupInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GroupApplications(ctx context.Context, in *QueryGroupApplicationsRequest, opts ...grpc.CallOption) (*QueryGroupApplicationsResponse, error) {
+	out := new(QueryGroupApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/GroupApplications", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GroupApplication(ctx context.Context, in *QueryGroupApplicationRequest, opts ...grpc.CallOption) (*QueryGroupApplicationResponse, error) {
+	out := new(QueryGroupApplicationResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/GroupApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryUserAllowancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SubspaceBans(ctx context.Context, in *QuerySubspaceBansRequest, opts ...grpc.CallOption) (*QuerySubspaceBansResponse, error) {
+	out := new(QuerySubspaceBansResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/SubspaceBans", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Grants) > 0 {
-		for _, e := range m.Grants {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserPermissions(ctx context.Context, in *QueryUserPermissionsRequest, opts ...grpc.CallOption) (*QueryUserPermissionsResponse, error) {
+	out := new(QueryUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/UserPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PermissionDetails(ctx context.Context, in *QueryPermissionDetailsRequest, opts ...grpc.CallOption) (*QueryPermissionDetailsResponse, error) {
+	out := new(QueryPermissionDetailsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/PermissionDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserAllowances(ctx context.Context, in *QueryUserAllowancesRequest, opts ...grpc.CallOption) (*QueryUserAllowancesResponse, error) {
+	out := new(QueryUserAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/UserAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupAllowances(ctx context.Context, in *QueryGroupAllowancesRequest, opts ...grpc.CallOption) (*QueryGroupAllowancesResponse, error) {
+	out := new(QueryGroupAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/GroupAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowanceUsages(ctx context.Context, in *QueryAllowanceUsagesRequest, opts ...grpc.CallOption) (*QueryAllowanceUsagesResponse, error) {
+	out := new(QueryAllowanceUsagesResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/AllowanceUsages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokensConfig(ctx context.Context, in *QueryFeeTokensConfigRequest, opts ...grpc.CallOption) (*QueryFeeTokensConfigResponse, error) {
+	out := new(QueryFeeTokensConfigResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/FeeTokensConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryVotingConfig(ctx context.Context, in *QueryTreasuryVotingConfigRequest, opts ...grpc.CallOption) (*QueryTreasuryVotingConfigResponse, error) {
+	out := new(QueryTreasuryVotingConfigResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/TreasuryVotingConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryProposals(ctx context.Context, in *QueryTreasuryProposalsRequest, opts ...grpc.CallOption) (*QueryTreasuryProposalsResponse, error) {
+	out := new(QueryTreasuryProposalsResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/TreasuryProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryProposal(ctx context.Context, in *QueryTreasuryProposalRequest, opts ...grpc.CallOption) (*QueryTreasuryProposalResponse, error) {
+	out := new(QueryTreasuryProposalResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/TreasuryProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryProposalVotes(ctx context.Context, in *QueryTreasuryProposalVotesRequest, opts ...grpc.CallOption) (*QueryTreasuryProposalVotesResponse, error) {
+	out := new(QueryTreasuryProposalVotesResponse)
+	err := c.cc.Invoke(ctx, "/desmos.subspaces.v3.Query/TreasuryProposalVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Subspaces queries all the subspaces inside Desmos
+	Subspaces(context.Context, *QuerySubspacesRequest) (*QuerySubspacesResponse, error)
+	// Subspace queries all the information about the subspace with the given id
+	Subspace(context.Context, *QuerySubspaceRequest) (*QuerySubspaceResponse, error)
+	// IncomingSubspaceOwnerTransferRequests queries all the subspace owner
+	// transfer requests that have been made towards the receiver with the given
+	// address
+	IncomingSubspaceOwnerTransferRequests(context.Context, *QueryIncomingSubspaceOwnerTransferRequestsRequest) (*QueryIncomingSubspaceOwnerTransferRequestsResponse, error)
+	// Sections allows to query for the sections of a specific subspace
+	Sections(context.Context, *QuerySectionsRequest) (*QuerySectionsResponse, error)
+	// Section queries all the information about the section with the given id
+	Section(context.Context, *QuerySectionRequest) (*QuerySectionResponse, error)
+	// UserGroups queries all the groups that are present inside the subspace with
+	// the given id
+	UserGroups(context.Context, *QueryUserGroupsRequest) (*QueryUserGroupsResponse, error)
+	// UserGroup queries the user group having the given id inside the specific
+	// subspace
+	UserGroup(context.Context, *QueryUserGroupRequest) (*QueryUserGroupResponse, error)
+	// UserGroupMembers queries all the members of a given user group
+	UserGroupMembers(context.Context, *QueryUserGroupMembersRequest) (*QueryUserGroupMembersResponse, error)
+	// GroupInvites queries all the user group invites that exist inside the
+	// subspace with the given id
+	GroupInvites(context.Context, *QueryGroupInvitesRequest) (*QueryGroupInvitesResponse, error)
+	// GroupInvite queries the user group invite associated with the given
+	// secret hash inside the specific subspace
+	GroupInvite(context.Context, *QueryGroupInviteRequest) (*QueryGroupInviteResponse, error)
+	// GroupApplications queries all the pending applications to join the given
+	// user group
+	GroupApplications(context.Context, *QueryGroupApplicationsRequest) (*QueryGroupApplicationsResponse, error)
+	// GroupApplication queries the pending application made by the given user to
+	// join the specific user group
+	GroupApplication(context.Context, *QueryGroupApplicationRequest) (*QueryGroupApplicationResponse, error)
+	// SubspaceBans queries all the active bans inside the subspace with the
+	// given id
+	SubspaceBans(context.Context, *QuerySubspaceBansRequest) (*QuerySubspaceBansResponse, error)
+	// RateLimits queries all the rate limits set inside the subspace with the
+	// given id
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// UserPermissions queries the permissions for the given user
+	UserPermissions(context.Context, *QueryUserPermissionsRequest) (*QueryUserPermissionsResponse, error)
+	// PermissionDetails queries all the sources that grant the given permission
+	// to the given user
+	PermissionDetails(context.Context, *QueryPermissionDetailsRequest) (*QueryPermissionDetailsResponse, error)
+	// UserAllowances returns all the grants for users.
+	UserAllowances(context.Context, *QueryUserAllowancesRequest) (*QueryUserAllowancesResponse, error)
+	// GroupAllowances returns all the grants for groups.
+	GroupAllowances(context.Context, *QueryGroupAllowancesRequest) (*QueryGroupAllowancesResponse, error)
+	// AllowanceUsages returns the usages of the message limits allowances made
+	// by a user during the current period
+	AllowanceUsages(context.Context, *QueryAllowanceUsagesRequest) (*QueryAllowanceUsagesResponse, error)
+	// FeeTokensConfig queries the fee tokens configuration of the subspace with
+	// the given id
+	FeeTokensConfig(context.Context, *QueryFeeTokensConfigRequest) (*QueryFeeTokensConfigResponse, error)
+	// TreasuryVotingConfig queries the treasury voting configuration of the
+	// subspace with the given id
+	TreasuryVotingConfig(context.Context, *QueryTreasuryVotingConfigRequest) (*QueryTreasuryVotingConfigResponse, error)
+	// TreasuryProposals queries all the treasury proposals of the subspace with
+	// the given id
+	TreasuryProposals(context.Context, *QueryTreasuryProposalsRequest) (*QueryTreasuryProposalsResponse, error)
+	// TreasuryProposal queries the treasury proposal with the given id
+	TreasuryProposal(context.Context, *QueryTreasuryProposalRequest) (*QueryTreasuryProposalResponse, error)
+	// TreasuryProposalVotes queries all the votes of the treasury proposal with
+	// the given id
+	TreasuryProposalVotes(context.Context, *QueryTreasuryProposalVotesRequest) (*QueryTreasuryProposalVotesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Subspaces(ctx context.Context, req *QuerySubspacesRequest) (*QuerySubspacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subspaces not implemented")
+}
+func (*UnimplementedQueryServer) Subspace(ctx context.Context, req *QuerySubspaceRequest) (*QuerySubspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subspace not implemented")
+}
+func (*UnimplementedQueryServer) IncomingSubspaceOwnerTransferRequests(ctx context.Context, req *QueryIncomingSubspaceOwnerTransferRequestsRequest) (*QueryIncomingSubspaceOwnerTransferRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncomingSubspaceOwnerTransferRequests not implemented")
+}
+func (*UnimplementedQueryServer) Sections(ctx context.Context, req *QuerySectionsRequest) (*QuerySectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sections not implemented")
+}
+func (*UnimplementedQueryServer) Section(ctx context.Context, req *QuerySectionRequest) (*QuerySectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Section not implemented")
+}
+func (*UnimplementedQueryServer) UserGroups(ctx context.Context, req *QueryUserGroupsRequest) (*QueryUserGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGroups not implemented")
+}
+func (*UnimplementedQueryServer) UserGroup(ctx context.Context, req *QueryUserGroupRequest) (*QueryUserGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGroup not implemented")
+}
+func (*UnimplementedQueryServer) UserGroupMembers(ctx context.Context, req *QueryUserGroupMembersRequest) (*QueryUserGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGroupMembers not implemented")
+}
+func (*UnimplementedQueryServer) GroupInvites(ctx context.Context, req *QueryGroupInvitesRequest) (*QueryGroupInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupInvites not implemented")
+}
+func (*UnimplementedQueryServer) GroupInvite(ctx context.Context, req *QueryGroupInviteRequest) (*QueryGroupInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupInvite not implemented")
+}
+func (*UnimplementedQueryServer) GroupApplications(ctx context.Context, req *QueryGroupApplicationsRequest) (*QueryGroupApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupApplications not implemented")
+}
+func (*UnimplementedQueryServer) GroupApplication(ctx context.Context, req *QueryGroupApplicationRequest) (*QueryGroupApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupApplication not implemented")
+}
+func (*UnimplementedQueryServer) SubspaceBans(ctx context.Context, req *QuerySubspaceBansRequest) (*QuerySubspaceBansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubspaceBans not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) UserPermissions(ctx context.Context, req *QueryUserPermissionsRequest) (*QueryUserPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPermissions not implemented")
+}
+func (*UnimplementedQueryServer) PermissionDetails(ctx context.Context, req *QueryPermissionDetailsRequest) (*QueryPermissionDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PermissionDetails not implemented")
+}
+func (*UnimplementedQueryServer) UserAllowances(ctx context.Context, req *QueryUserAllowancesRequest) (*QueryUserAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserAllowances not implemented")
+}
+func (*UnimplementedQueryServer) GroupAllowances(ctx context.Context, req *QueryGroupAllowancesRequest) (*QueryGroupAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupAllowances not implemented")
+}
+func (*UnimplementedQueryServer) AllowanceUsages(ctx context.Context, req *QueryAllowanceUsagesRequest) (*QueryAllowanceUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowanceUsages not implemented")
+}
+func (*UnimplementedQueryServer) FeeTokensConfig(ctx context.Context, req *QueryFeeTokensConfigRequest) (*QueryFeeTokensConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokensConfig not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryVotingConfig(ctx context.Context, req *QueryTreasuryVotingConfigRequest) (*QueryTreasuryVotingConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryVotingConfig not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryProposals(ctx context.Context, req *QueryTreasuryProposalsRequest) (*QueryTreasuryProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryProposals not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryProposal(ctx context.Context, req *QueryTreasuryProposalRequest) (*QueryTreasuryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryProposal not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryProposalVotes(ctx context.Context, req *QueryTreasuryProposalVotesRequest) (*QueryTreasuryProposalVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryProposalVotes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Subspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubspacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subspaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/Subspaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subspaces(ctx, req.(*QuerySubspacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Subspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/Subspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subspace(ctx, req.(*QuerySubspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncomingSubspaceOwnerTransferRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncomingSubspaceOwnerTransferRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncomingSubspaceOwnerTransferRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/IncomingSubspaceOwnerTransferRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncomingSubspaceOwnerTransferRequests(ctx, req.(*QueryIncomingSubspaceOwnerTransferRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/Sections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sections(ctx, req.(*QuerySectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Section_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Section(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/Section",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Section(ctx, req.(*QuerySectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/UserGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserGroups(ctx, req.(*QueryUserGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/UserGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserGroup(ctx, req.(*QueryUserGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/UserGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserGroupMembers(ctx, req.(*QueryUserGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/GroupInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupInvites(ctx, req.(*QueryGroupInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/GroupInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupInvite(ctx, req.(*QueryGroupInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/GroupApplications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupApplications(ctx, req.(*QueryGroupApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/GroupApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupApplication(ctx, req.(*QueryGroupApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubspaceBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubspaceBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubspaceBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/SubspaceBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubspaceBans(ctx, req.(*QuerySubspaceBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/UserPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserPermissions(ctx, req.(*QueryUserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PermissionDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPermissionDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PermissionDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/PermissionDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PermissionDetails(ctx, req.(*QueryPermissionDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/UserAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserAllowances(ctx, req.(*QueryUserAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/GroupAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAllowances(ctx, req.(*QueryGroupAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowanceUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowanceUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/AllowanceUsages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowanceUsages(ctx, req.(*QueryAllowanceUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokensConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokensConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokensConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/FeeTokensConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokensConfig(ctx, req.(*QueryFeeTokensConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryVotingConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryVotingConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryVotingConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/TreasuryVotingConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryVotingConfig(ctx, req.(*QueryTreasuryVotingConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/TreasuryProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryProposals(ctx, req.(*QueryTreasuryProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/TreasuryProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryProposal(ctx, req.(*QueryTreasuryProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryProposalVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryProposalVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryProposalVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/desmos.subspaces.v3.Query/TreasuryProposalVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryProposalVotes(ctx, req.(*QueryTreasuryProposalVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "desmos.subspaces.v3.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Subspaces",
+			Handler:    _Query_Subspaces_Handler,
+		},
+		{
+			MethodName: "Subspace",
+			Handler:    _Query_Subspace_Handler,
+		},
+		{
+			MethodName: "IncomingSubspaceOwnerTransferRequests",
+			Handler:    _Query_IncomingSubspaceOwnerTransferRequests_Handler,
+		},
+		{
+			MethodName: "Sections",
+			Handler:    _Query_Sections_Handler,
+		},
+		{
+			MethodName: "Section",
+			Handler:    _Query_Section_Handler,
+		},
+		{
+			MethodName: "UserGroups",
+			Handler:    _Query_UserGroups_Handler,
+		},
+		{
+			MethodName: "UserGroup",
+			Handler:    _Query_UserGroup_Handler,
+		},
+		{
+			MethodName: "UserGroupMembers",
+			Handler:    _Query_UserGroupMembers_Handler,
+		},
+		{
+			MethodName: "GroupInvites",
+			Handler:    _Query_GroupInvites_Handler,
+		},
+		{
+			MethodName: "GroupInvite",
+			Handler:    _Query_GroupInvite_Handler,
+		},
+		{
+			MethodName: "GroupApplications",
+			Handler:    _Query_GroupApplications_Handler,
+		},
+		{
+			MethodName: "GroupApplication",
+			Handler:    _Query_GroupApplication_Handler,
+		},
+		{
+			MethodName: "SubspaceBans",
+			Handler:    _Query_SubspaceBans_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "UserPermissions",
+			Handler:    _Query_UserPermissions_Handler,
+		},
+		{
+			MethodName: "PermissionDetails",
+			Handler:    _Query_PermissionDetails_Handler,
+		},
+		{
+			MethodName: "UserAllowances",
+			Handler:    _Query_UserAllowances_Handler,
+		},
+		{
+			MethodName: "GroupAllowances",
+			Handler:    _Query_GroupAllowances_Handler,
+		},
+		{
+			MethodName: "AllowanceUsages",
+			Handler:    _Query_AllowanceUsages_Handler,
+		},
+		{
+			MethodName: "FeeTokensConfig",
+			Handler:    _Query_FeeTokensConfig_Handler,
+		},
+		{
+			MethodName: "TreasuryVotingConfig",
+			Handler:    _Query_TreasuryVotingConfig_Handler,
+		},
+		{
+			MethodName: "TreasuryProposals",
+			Handler:    _Query_TreasuryProposals_Handler,
+		},
+		{
+			MethodName: "TreasuryProposal",
+			Handler:    _Query_TreasuryProposal_Handler,
+		},
+		{
+			MethodName: "TreasuryProposalVotes",
+			Handler:    _Query_TreasuryProposalVotes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "desmos/subspaces/v3/query.proto",
+}
+
+func (m *QuerySubspacesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubspacesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubspacesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubspacesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubspacesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubspacesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspaces) > 0 {
+		for iNdEx := len(m.Subspaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subspaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubspaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubspaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubspaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubspaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubspaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubspaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Subspace.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySectionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySectionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySectionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySectionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sections) > 0 {
+		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SectionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SectionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Section.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SectionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SectionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserGroupMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserGroupMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserGroupMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserGroupMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserGroupMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserGroupMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupInvitesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupInvitesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupInvitesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupInvitesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupInvitesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupInvitesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Invites) > 0 {
+		for iNdEx := len(m.Invites) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invites[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupInviteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupInviteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupInviteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecretHash) > 0 {
+		i -= len(m.SecretHash)
+		copy(dAtA[i:], m.SecretHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SecretHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupInviteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupInviteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupInviteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Invite.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupApplicationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupApplicationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupApplicationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupApplicationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupApplicationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupApplicationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Applications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupApplicationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupApplicationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupApplicationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Applicant) > 0 {
+		i -= len(m.Applicant)
+		copy(dAtA[i:], m.Applicant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Applicant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Application.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubspaceBansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubspaceBansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubspaceBansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubspaceBansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubspaceBansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubspaceBansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bans) > 0 {
+		for iNdEx := len(m.Bans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserPermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SectionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SectionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		for iNdEx := len(m.Details) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Details[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.SectionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SectionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionDetail_User_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionDetail_User_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PermissionDetail_Group_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionDetail_Group_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Group != nil {
+		{
+			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PermissionDetail_User) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionDetail_User) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionDetail_User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permission) > 0 {
+		for iNdEx := len(m.Permission) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permission[iNdEx])
+			copy(dAtA[i:], m.Permission[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permission[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionDetail_Group) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionDetail_Group) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionDetail_Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permission) > 0 {
+		for iNdEx := len(m.Permission) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permission[iNdEx])
+			copy(dAtA[i:], m.Permission[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permission[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissionDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permission) > 0 {
+		i -= len(m.Permission)
+		copy(dAtA[i:], m.Permission)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Permission)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SectionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SectionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissionDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Banned {
+		i--
+		if m.Banned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasPermission {
+		i--
+		if m.HasPermission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Inherited {
+		i--
+		if m.Inherited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SectionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SectionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionSource_Owner_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionSource_Owner_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Owner != nil {
+		{
+			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PermissionSource_User_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionSource_User_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PermissionSource_Group_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionSource_Group_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Group != nil {
+		{
+			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PermissionSource_Owner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionSource_Owner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionSource_Owner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionSource_User) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionSource_User) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionSource_User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionSource_Group) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionSource_Group) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionSource_Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceUsagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceUsagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceUsagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceUsagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceUsagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceUsagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryVotingConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryVotingConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryVotingConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryVotingConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryVotingConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryVotingConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryProposalVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryProposalVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryProposalVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubspaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubspaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryProposalVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryProposalVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryProposalVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySubspacesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubspacesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subspaces) > 0 {
+		for _, e := range m.Subspaces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubspaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	return n
+}
+
+func (m *QuerySubspaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subspace.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySectionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sections) > 0 {
+		for _, e := range m.Sections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.SectionId != 0 {
+		n += 1 + sovQuery(uint64(m.SectionId))
+	}
+	return n
+}
+
+func (m *QuerySectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Section.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUserGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.SectionId != 0 {
+		n += 1 + sovQuery(uint64(m.SectionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryUserGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Group.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUserGroupMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserGroupMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupInvitesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupInvitesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invites) > 0 {
+		for _, e := range m.Invites {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupInviteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	l = len(m.SecretHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupInviteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Invite.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGroupApplicationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupApplicationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Applications) > 0 {
+		for _, e := range m.Applications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupApplicationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	l = len(m.Applicant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Application.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySubspaceBansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubspaceBansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bans) > 0 {
+		for _, e := range m.Bans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.SectionId != 0 {
+		n += 1 + sovQuery(uint64(m.SectionId))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Details) > 0 {
+		for _, e := range m.Details {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PermissionDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.SectionId != 0 {
+		n += 1 + sovQuery(uint64(m.SectionId))
+	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *PermissionDetail_User_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *PermissionDetail_Group_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != nil {
+		l = m.Group.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *PermissionDetail_User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Permission) > 0 {
+		for _, s := range m.Permission {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PermissionDetail_Group) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupID != 0 {
+		n += 1 + sovQuery(uint64(m.GroupID))
+	}
+	if len(m.Permission) > 0 {
+		for _, s := range m.Permission {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPermissionDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.SectionId != 0 {
+		n += 1 + sovQuery(uint64(m.SectionId))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Permission)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPermissionDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasPermission {
+		n += 2
+	}
+	if m.Banned {
+		n += 2
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PermissionSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SectionId != 0 {
+		n += 1 + sovQuery(uint64(m.SectionId))
+	}
+	if m.Inherited {
+		n += 2
+	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *PermissionSource_Owner_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *PermissionSource_User_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *PermissionSource_Group_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != nil {
+		l = m.Group.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *PermissionSource_Owner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PermissionSource_User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PermissionSource_Group) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupID != 0 {
+		n += 1 + sovQuery(uint64(m.GroupID))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUserAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceUsagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceUsagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokensConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	return n
+}
+
+func (m *QueryFeeTokensConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTreasuryVotingConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	return n
+}
+
+func (m *QueryTreasuryVotingConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTreasuryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTreasuryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTreasuryProposalVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubspaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SubspaceId))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryProposalVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySubspacesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubspacesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubspacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubspacesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubspacesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubspacesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspaces = append(m.Subspaces, Subspace{})
+			if err := m.Subspaces[len(m.Subspaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubspaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubspaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubspaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubspaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubspaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubspaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subspace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncomingSubspaceOwnerTransferRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncomingSubspaceOwnerTransferRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncomingSubspaceOwnerTransferRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncomingSubspaceOwnerTransferRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncomingSubspaceOwnerTransferRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, SubspaceOwnerTransferRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySectionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySectionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySectionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySectionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sections = append(m.Sections, Section{})
+			if err := m.Sections[len(m.Sections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+			}
+			m.SectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Section", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Section.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserGroupsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserGroupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+			}
+			m.SectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserGroupsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, UserGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserGroupMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserGroupMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserGroupMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserGroupMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserGroupMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupInvitesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupInvitesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupInvitesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupInvitesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupInvitesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupInvitesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invites", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invites = append(m.Invites, GroupInvite{})
+			if err := m.Invites[len(m.Invites)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupInviteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupInviteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupInviteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupInviteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupInviteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupInviteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invite", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Invite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupApplicationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupApplicationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupApplicationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupApplicationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupApplicationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupApplicationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, GroupApplication{})
+			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupApplicationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupApplicationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applicant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applicant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubspaceBansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubspaceBansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubspaceBansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubspaceBansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubspaceBansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubspaceBansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bans = append(m.Bans, SubspaceBan{})
+			if err := m.Bans[len(m.Bans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserPermissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserPermissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+			}
+			m.SectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = append(m.Details, PermissionDetail{})
+			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryGroupAllowancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubspaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SubspaceId))
-	}
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryGroupAllowancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Grants) > 0 {
-		for _, e := range m.Grants {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *PermissionDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+			}
+			m.SubspaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubspaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+			}
+			m.SectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PermissionDetail_User{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &PermissionDetail_User_{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PermissionDetail_Group{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &PermissionDetail_Group_{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySubspacesRequest) Unmarshal(dAtA []byte) error {
+func (m *PermissionDetail_User) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3368,17 +11258,17 @@ func (m *QuerySubspacesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubspacesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubspacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3388,27 +11278,55 @@ func (m *QuerySubspacesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Permission = append(m.Permission, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3431,7 +11349,7 @@ func (m *QuerySubspacesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubspacesResponse) Unmarshal(dAtA []byte) error {
+func (m *PermissionDetail_Group) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3454,17 +11372,17 @@ func (m *QuerySubspacesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubspacesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubspacesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subspaces", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
 			}
-			var msglen int
+			m.GroupID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3474,31 +11392,16 @@ func (m *QuerySubspacesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subspaces = append(m.Subspaces, Subspace{})
-			if err := m.Subspaces[len(m.Subspaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3508,27 +11411,23 @@ func (m *QuerySubspacesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Permission = append(m.Permission, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3551,7 +11450,7 @@ func (m *QuerySubspacesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubspaceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPermissionDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3574,10 +11473,10 @@ func (m *QuerySubspaceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubspaceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPermissionDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubspaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPermissionDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3599,6 +11498,89 @@ func (m *QuerySubspaceRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+			}
+			m.SectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3620,7 +11602,7 @@ func (m *QuerySubspaceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubspaceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPermissionDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3643,15 +11625,55 @@ func (m *QuerySubspaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubspaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPermissionDetailsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubspaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPermissionDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPermission", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPermission = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Banned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Banned = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3678,7 +11700,8 @@ func (m *QuerySubspaceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subspace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, PermissionSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3703,7 +11726,7 @@ func (m *QuerySubspaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySectionsRequest) Unmarshal(dAtA []byte) error {
+func (m *PermissionSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3726,17 +11749,17 @@ func (m *QuerySectionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySectionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PermissionSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PermissionSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
 			}
-			m.SubspaceId = 0
+			m.SectionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3746,16 +11769,16 @@ func (m *QuerySectionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubspaceId |= uint64(b&0x7F) << shift
+				m.SectionId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inherited", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3765,81 +11788,50 @@ func (m *QuerySectionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Inherited = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySectionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &PermissionSource_Owner{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySectionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Sum = &PermissionSource_Owner_{v}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3866,14 +11858,15 @@ func (m *QuerySectionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sections = append(m.Sections, Section{})
-			if err := m.Sections[len(m.Sections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &PermissionSource_User{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &PermissionSource_User_{v}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3900,12 +11893,11 @@ func (m *QuerySectionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &PermissionSource_Group{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &PermissionSource_Group_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3928,7 +11920,7 @@ func (m *QuerySectionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySectionRequest) Unmarshal(dAtA []byte) error {
+func (m *PermissionSource_Owner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3951,50 +11943,12 @@ func (m *QuerySectionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySectionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Owner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Owner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
-			}
-			m.SubspaceId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubspaceId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
-			}
-			m.SectionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SectionId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4016,7 +11970,7 @@ func (m *QuerySectionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySectionResponse) Unmarshal(dAtA []byte) error {
+func (m *PermissionSource_User) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4039,17 +11993,17 @@ func (m *QuerySectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Section", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4059,24 +12013,23 @@ func (m *QuerySectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Section.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4099,7 +12052,7 @@ func (m *QuerySectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserGroupsRequest) Unmarshal(dAtA []byte) error {
+func (m *PermissionSource_Group) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4122,17 +12075,17 @@ func (m *QueryUserGroupsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserGroupsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
 			}
-			m.SubspaceId = 0
+			m.GroupID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4142,35 +12095,16 @@ func (m *QueryUserGroupsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubspaceId |= uint64(b&0x7F) << shift
+				m.GroupID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
-			}
-			m.SectionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SectionId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4180,27 +12114,23 @@ func (m *QueryUserGroupsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4223,7 +12153,7 @@ func (m *QueryUserGroupsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserGroupsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUserAllowancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4246,17 +12176,17 @@ func (m *QueryUserGroupsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserGroupsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUserAllowancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUserAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
 			}
-			var msglen int
+			m.SubspaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4266,31 +12196,16 @@ func (m *QueryUserGroupsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SubspaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Groups = append(m.Groups, UserGroup{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4300,83 +12215,29 @@ func (m *QueryUserGroupsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryUserGroupRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserGroupRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.SubspaceId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4386,30 +12247,28 @@ func (m *QueryUserGroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubspaceId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4431,7 +12290,7 @@ func (m *QueryUserGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUserAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4454,15 +12313,15 @@ func (m *QueryUserGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUserAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUserAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4489,7 +12348,44 @@ func (m *QueryUserGroupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grants = append(m.Grants, Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4514,7 +12410,7 @@ func (m *QueryUserGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserGroupMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupAllowancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4537,10 +12433,10 @@ func (m *QueryUserGroupMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserGroupMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupAllowancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserGroupMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4638,7 +12534,7 @@ func (m *QueryUserGroupMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserGroupMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4661,17 +12557,17 @@ func (m *QueryUserGroupMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserGroupMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4681,23 +12577,25 @@ func (m *QueryUserGroupMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			m.Grants = append(m.Grants, Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4756,7 +12654,7 @@ func (m *QueryUserGroupMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserPermissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowanceUsagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4779,10 +12677,10 @@ func (m *QueryUserPermissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserPermissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowanceUsagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowanceUsagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4805,10 +12703,10 @@ func (m *QueryUserPermissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			m.SectionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4818,16 +12716,29 @@ func (m *QueryUserPermissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SectionId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4837,23 +12748,27 @@ func (m *QueryUserPermissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4876,7 +12791,7 @@ func (m *QueryUserPermissionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserPermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowanceUsagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4899,17 +12814,17 @@ func (m *QueryUserPermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserPermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowanceUsagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowanceUsagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4919,27 +12834,29 @@ func (m *QueryUserPermissionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			m.Usages = append(m.Usages, AllowanceUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4966,8 +12883,10 @@ func (m *QueryUserPermissionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = append(m.Details, PermissionDetail{})
-			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4992,7 +12911,7 @@ func (m *QueryUserPermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PermissionDetail) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeTokensConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5015,10 +12934,10 @@ func (m *PermissionDetail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PermissionDetail: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeTokensConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PermissionDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeTokensConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5040,63 +12959,59 @@ func (m *PermissionDetail) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
-			}
-			m.SectionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SectionId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PermissionDetail_User{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Sum = &PermissionDetail_User_{v}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5123,11 +13038,9 @@ func (m *PermissionDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PermissionDetail_Group{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &PermissionDetail_Group_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5150,7 +13063,7 @@ func (m *PermissionDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PermissionDetail_User) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryVotingConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5173,17 +13086,17 @@ func (m *PermissionDetail_User) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryVotingConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryVotingConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
 			}
-			var stringLen uint64
+			m.SubspaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5193,29 +13106,66 @@ func (m *PermissionDetail_User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SubspaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryVotingConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryVotingConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryVotingConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5225,23 +13175,24 @@ func (m *PermissionDetail_User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permission = append(m.Permission, string(dAtA[iNdEx:postIndex]))
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5264,7 +13215,7 @@ func (m *PermissionDetail_User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PermissionDetail_Group) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5287,17 +13238,17 @@ func (m *PermissionDetail_Group) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
 			}
-			m.GroupID = 0
+			m.SubspaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5307,16 +13258,16 @@ func (m *PermissionDetail_Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= uint32(b&0x7F) << shift
+				m.SubspaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5326,23 +13277,27 @@ func (m *PermissionDetail_Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permission = append(m.Permission, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5365,7 +13320,7 @@ func (m *PermissionDetail_Group) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserAllowancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5388,36 +13343,17 @@ func (m *QueryUserAllowancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserAllowancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
-			}
-			m.SubspaceId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubspaceId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5427,25 +13363,27 @@ func (m *QueryUserAllowancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Proposals = append(m.Proposals, TreasuryProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5475,7 +13413,7 @@ func (m *QueryUserAllowancesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5502,7 +13440,7 @@ func (m *QueryUserAllowancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5525,17 +13463,17 @@ func (m *QueryUserAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceId", wireType)
 			}
-			var msglen int
+			m.SubspaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5545,29 +13483,83 @@ func (m *QueryUserAllowancesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SubspaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, Grant{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5594,10 +13586,7 @@ func (m *QueryUserAllowancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5622,7 +13611,7 @@ func (m *QueryUserAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupAllowancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryProposalVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5645,10 +13634,10 @@ func (m *QueryGroupAllowancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupAllowancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryProposalVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryProposalVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5672,9 +13661,9 @@ func (m *QueryGroupAllowancesRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.GroupId = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5684,7 +13673,7 @@ func (m *QueryGroupAllowancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5746,7 +13735,7 @@ func (m *QueryGroupAllowancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryProposalVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5769,15 +13758,15 @@ func (m *QueryGroupAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryProposalVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryProposalVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5804,8 +13793,8 @@ func (m *QueryGroupAllowancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, Grant{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, TreasuryVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
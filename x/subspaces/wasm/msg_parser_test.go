@@ -182,6 +182,7 @@ func TestMsgsParser_ParseCustomMsgs(t *testing.T) {
 					1,
 					1,
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					nil,
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 				),
 			),
@@ -191,6 +192,7 @@ func TestMsgsParser_ParseCustomMsgs(t *testing.T) {
 					1,
 					1,
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
+					nil,
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 				),
 			},
@@ -223,6 +225,7 @@ func TestMsgsParser_ParseCustomMsgs(t *testing.T) {
 					0,
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					types.NewPermissions(types.PermissionEverything),
+					nil,
 					"cosmos1vkuuth0rak58x36m7wuzj7ztttxh26fhqcfxm0",
 				),
 			),
@@ -233,6 +236,7 @@ func TestMsgsParser_ParseCustomMsgs(t *testing.T) {
 					0,
 					"cosmos1qzskhrcjnkdz2ln4yeafzsdwht8ch08j4wed69",
 					types.NewPermissions(types.PermissionEverything),
+					nil,
 					"cosmos1vkuuth0rak58x36m7wuzj7ztttxh26fhqcfxm0",
 				),
 			},
@@ -205,7 +205,7 @@ func (suite *TestSuite) TestSubspacesWasmQuerier_QueryCustom() {
 					types.NewPermissions(poststypes.PermissionWrite),
 				))
 
-				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm")
+				suite.k.AddUserToGroup(ctx, 1, 1, "cosmos1a0cj0j6ujn2xap8p40y6648d0w2npytw3xvenm", nil)
 			},
 			shouldErr: false,
 			expResponse: suite.cdc.MustMarshalJSON(
@@ -62,6 +62,16 @@ func (s *IntegrationTestSuite) SetupSuite() {
 			),
 		},
 		nil, nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	subspacesDataBz, err := cfg.Codec.MarshalJSON(subspacesGenesis)